@@ -7,6 +7,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
 )
 
 var _ sdk.AnteDecorator = AuthzLimiterDecorator{}
@@ -37,6 +39,8 @@ func (ald AuthzLimiterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 // When searchOnlyInAuthzMsgs is enabled, only authz MsgGrant and MsgExec are blocked, if they contain unauthorized msg types.
 // Otherwise any msg matching the disabled types are blocked, regardless of being in an authz msg or not.
 //
+// Msgs wrapped in a hard MsgFlashBorrow are always searched, as they are executed without passing through the ante handler.
+//
 // This method is recursive as MsgExec's can wrap other MsgExecs.
 func (ald AuthzLimiterDecorator) checkForDisabledMsg(msgs []sdk.Msg, searchOnlyInAuthzMsgs bool) error {
 	for _, msg := range msgs {
@@ -71,6 +75,19 @@ func (ald AuthzLimiterDecorator) checkForDisabledMsg(msgs []sdk.Msg, searchOnlyI
 			if err := ald.checkForDisabledMsg(innerMsgs, false); err != nil {
 				return err
 			}
+
+		case typeURL == sdk.MsgTypeURL(&hardtypes.MsgFlashBorrow{}):
+			m, ok := msg.(*hardtypes.MsgFlashBorrow)
+			if !ok {
+				panic("unexpected msg type")
+			}
+			innerMsgs, err := m.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := ald.checkForDisabledMsg(innerMsgs, false); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
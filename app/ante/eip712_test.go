@@ -186,6 +186,8 @@ func (suite *EIP712TestSuite) SetupTest() {
 			LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
 			CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 			KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+			LiquidationCloseFactor:           sdk.OneDec(),
+			KeeperIncentive:                  sdk.ZeroInt(),
 			SpotMarketID:                     "usdc:usd",
 			LiquidationMarketID:              "usdc:usd:30",
 			ConversionFactor:                 sdkmath.NewInt(18),
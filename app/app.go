@@ -240,29 +240,30 @@ var (
 	// If these are changed, the permissions stored in accounts
 	// must also be migrated during a chain upgrade.
 	mAccPerms = map[string][]string{
-		authtypes.FeeCollectorName:      nil,
-		distrtypes.ModuleName:           nil,
-		stakingtypes.BondedPoolName:     {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName:  {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:             {authtypes.Burner},
-		ibctransfertypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
-		evmtypes.ModuleName:             {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
-		evmutiltypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
-		kavadisttypes.KavaDistMacc:      {authtypes.Minter},
-		auctiontypes.ModuleName:         nil,
-		issuancetypes.ModuleAccountName: {authtypes.Minter, authtypes.Burner},
-		bep3types.ModuleName:            {authtypes.Burner, authtypes.Minter},
-		swaptypes.ModuleName:            nil,
-		cdptypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
-		cdptypes.LiquidatorMacc:         {authtypes.Minter, authtypes.Burner},
-		hardtypes.ModuleAccountName:     {authtypes.Minter},
-		savingstypes.ModuleAccountName:  nil,
-		liquidtypes.ModuleAccountName:   {authtypes.Minter, authtypes.Burner},
-		earntypes.ModuleAccountName:     nil,
-		kavadisttypes.FundModuleAccount: nil,
-		minttypes.ModuleName:            {authtypes.Minter},
-		communitytypes.ModuleName:       nil,
-		precisebanktypes.ModuleName:     {authtypes.Minter, authtypes.Burner}, // used for reserve account to back fractional amounts
+		authtypes.FeeCollectorName:              nil,
+		distrtypes.ModuleName:                   nil,
+		stakingtypes.BondedPoolName:             {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:          {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:                     {authtypes.Burner},
+		ibctransfertypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
+		evmtypes.ModuleName:                     {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+		evmutiltypes.ModuleName:                 {authtypes.Minter, authtypes.Burner},
+		kavadisttypes.KavaDistMacc:              {authtypes.Minter},
+		auctiontypes.ModuleName:                 nil,
+		issuancetypes.ModuleAccountName:         {authtypes.Minter, authtypes.Burner},
+		bep3types.ModuleName:                    {authtypes.Burner, authtypes.Minter},
+		swaptypes.ModuleName:                    {authtypes.Minter, authtypes.Burner},
+		cdptypes.ModuleName:                     {authtypes.Minter, authtypes.Burner},
+		cdptypes.LiquidatorMacc:                 {authtypes.Minter, authtypes.Burner},
+		hardtypes.ModuleAccountName:             {authtypes.Minter},
+		savingstypes.ModuleAccountName:          nil,
+		liquidtypes.ModuleAccountName:           {authtypes.Minter, authtypes.Burner},
+		liquidtypes.RedemptionBufferAccountName: nil,
+		earntypes.ModuleAccountName:             nil,
+		kavadisttypes.FundModuleAccount:         nil,
+		minttypes.ModuleName:                    {authtypes.Minter},
+		communitytypes.ModuleName:               nil,
+		precisebanktypes.ModuleName:             {authtypes.Minter, authtypes.Burner}, // used for reserve account to back fractional amounts
 	}
 )
 
@@ -282,6 +283,9 @@ type Options struct {
 	EVMTrace              string
 	EVMMaxGasWanted       uint64
 	TelemetryOptions      metricstypes.TelemetryOptions
+
+	// IncentiveDisableLegacyEvents stops incentive emitting legacy untyped events alongside its typed events.
+	IncentiveDisableLegacyEvents bool
 }
 
 // DefaultOptions is a sensible default Options value.
@@ -343,8 +347,9 @@ type App struct {
 	precisebankKeeper     precisebankkeeper.Keeper
 
 	// make scoped keepers public for test purposes
-	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
-	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
+	ScopedIBCKeeper       capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper  capabilitykeeper.ScopedKeeper
+	ScopedPricefeedKeeper capabilitykeeper.ScopedKeeper
 
 	// the module manager
 	mm *module.Manager
@@ -397,7 +402,7 @@ func NewApp(
 		savingstypes.StoreKey, earntypes.StoreKey, minttypes.StoreKey,
 		consensusparamtypes.StoreKey, crisistypes.StoreKey, precisebanktypes.StoreKey,
 	)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, evmtypes.TransientKey, feemarkettypes.TransientKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, evmtypes.TransientKey, feemarkettypes.TransientKey, hardtypes.TransientStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	// Authority for gov proposals, using the x/gov module account address
@@ -438,6 +443,7 @@ func NewApp(
 	hardSubspace := app.paramsKeeper.Subspace(hardtypes.ModuleName)
 	incentiveSubspace := app.paramsKeeper.Subspace(incentivetypes.ModuleName)
 	savingsSubspace := app.paramsKeeper.Subspace(savingstypes.ModuleName)
+	liquidSubspace := app.paramsKeeper.Subspace(liquidtypes.ModuleName)
 	ibcSubspace := app.paramsKeeper.Subspace(ibcexported.ModuleName)
 	ibctransferSubspace := app.paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	packetforwardSubspace := app.paramsKeeper.Subspace(packetforwardtypes.ModuleName).WithKeyTable(packetforwardtypes.ParamKeyTable())
@@ -454,6 +460,7 @@ func NewApp(
 	app.capabilityKeeper = capabilitykeeper.NewKeeper(appCodec, keys[capabilitytypes.StoreKey], memKeys[capabilitytypes.MemStoreKey])
 	scopedIBCKeeper := app.capabilityKeeper.ScopeToModule(ibcexported.ModuleName)
 	scopedTransferKeeper := app.capabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	scopedPricefeedKeeper := app.capabilityKeeper.ScopeToModule(pricefeedtypes.ModuleName)
 	app.capabilityKeeper.Seal()
 
 	// add keepers
@@ -465,13 +472,17 @@ func NewApp(
 		sdk.GetConfig().GetBech32AccountAddrPrefix(),
 		govAuthAddrStr,
 	)
-	app.bankKeeper = bankkeeper.NewBaseKeeper(
+	baseBankKeeper := bankkeeper.NewBaseKeeper(
 		appCodec,
 		keys[banktypes.StoreKey],
 		app.accountKeeper,
 		app.loadBlockedMaccAddrs(),
 		govAuthAddrStr,
 	)
+	// all modules send coins through the derivative bank keeper, so the liquid hooks are called whenever staking
+	// derivatives change owner. It reads the liquid keeper by reference, as the liquid keeper is created later.
+	derivativeBankKeeper := liquidkeeper.NewDerivativeBankKeeper(&app.liquidKeeper, baseBankKeeper)
+	app.bankKeeper = derivativeBankKeeper
 	app.stakingKeeper = stakingkeeper.NewKeeper(
 		appCodec,
 		keys[stakingtypes.StoreKey],
@@ -548,7 +559,10 @@ func NewApp(
 		evmutilSubspace,
 		app.bankKeeper,
 		app.accountKeeper,
+		govAuthAddr,
 	)
+	// balance hooks must be registered before the keeper is copied into the evm bank keeper
+	app.evmutilKeeper.SetHooks(evmutiltypes.NewMultiBalanceHooks())
 
 	// TODO: Pass this to evmkeeper.NewKeeper() instead of evmutilKeeper
 	app.precisebankKeeper = precisebankkeeper.NewKeeper(
@@ -595,10 +609,12 @@ func NewApp(
 		scopedTransferKeeper,
 	)
 	app.packetForwardKeeper.SetTransferKeeper(app.transferKeeper)
+	app.evmutilKeeper.SetTransferKeeper(app.transferKeeper)
 	transferModule := transfer.NewAppModule(app.transferKeeper)
 
 	// allow ibc packet forwarding for ibc transfers.
 	// transfer stack contains (from top to bottom):
+	// - Evmutil Middleware
 	// - Packet Forward Middleware
 	// - Transfer
 	var transferStack ibcporttypes.IBCModule
@@ -610,10 +626,22 @@ func NewApp(
 		packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
 		packetforwardkeeper.DefaultRefundTransferPacketTimeoutTimestamp,
 	)
+	// deploy erc20 contracts for auto deploy cosmos denoms on first ibc receipt
+	transferStack = evmutil.NewIBCMiddleware(transferStack, app.evmutilKeeper)
 
-	// Create static IBC router, add transfer route, then set and seal it
+	app.pricefeedKeeper = pricefeedkeeper.NewKeeper(
+		appCodec,
+		keys[pricefeedtypes.StoreKey],
+		pricefeedSubspace,
+		&app.ibcKeeper.PortKeeper,
+		scopedPricefeedKeeper,
+		app.stakingKeeper,
+	)
+
+	// Create static IBC router, add transfer and pricefeed routes, then set and seal it
 	ibcRouter := ibcporttypes.NewRouter()
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
+	ibcRouter.AddRoute(pricefeedtypes.ModuleName, pricefeed.NewIBCModule(app.pricefeedKeeper))
 	app.ibcKeeper.SetRouter(ibcRouter)
 
 	app.auctionKeeper = auctionkeeper.NewKeeper(
@@ -638,11 +666,6 @@ func NewApp(
 		bep3Subspace,
 		app.ModuleAccountAddrs(),
 	)
-	app.pricefeedKeeper = pricefeedkeeper.NewKeeper(
-		appCodec,
-		keys[pricefeedtypes.StoreKey],
-		pricefeedSubspace,
-	)
 	swapKeeper := swapkeeper.NewKeeper(
 		appCodec,
 		keys[swaptypes.StoreKey],
@@ -663,14 +686,18 @@ func NewApp(
 	hardKeeper := hardkeeper.NewKeeper(
 		appCodec,
 		keys[hardtypes.StoreKey],
+		tkeys[hardtypes.TransientStoreKey],
 		hardSubspace,
 		app.accountKeeper,
 		app.bankKeeper,
 		app.pricefeedKeeper,
 		app.auctionKeeper,
+		app.MsgServiceRouter(),
+		govAuthAddr,
 	)
 	app.liquidKeeper = liquidkeeper.NewDefaultKeeper(
 		appCodec,
+		liquidSubspace,
 		app.accountKeeper,
 		app.bankKeeper,
 		app.stakingKeeper,
@@ -694,6 +721,8 @@ func NewApp(
 		&hardKeeper,
 		&savingsKeeper,
 		&app.distrKeeper,
+		&swapKeeper,
+		govAuthAddr,
 	)
 
 	app.kavadistKeeper = kavadistkeeper.NewKeeper(
@@ -747,11 +776,21 @@ func NewApp(
 		app.mintKeeper,
 		app.distrKeeper,
 		app.pricefeedKeeper,
+		govAuthAddr,
 	)
+	// incentive hooks must be registered before the keeper is copied into the incentive module
+	app.incentiveKeeper.SetHooks(incentivetypes.NewMultiIncentiveHooks(earnKeeper.IncentiveHooks()))
+	if options.IncentiveDisableLegacyEvents {
+		app.incentiveKeeper.DisableLegacyEvents()
+	}
 	app.routerKeeper = routerkeeper.NewKeeper(
 		&app.earnKeeper,
 		app.liquidKeeper,
 		app.stakingKeeper,
+		&app.hardKeeper,
+		&app.cdpKeeper,
+		&swapKeeper,
+		app.bankKeeper,
 	)
 
 	// create committee keeper with router
@@ -784,7 +823,9 @@ func NewApp(
 	app.cdpKeeper = *cdpKeeper.SetHooks(cdptypes.NewMultiCDPHooks(app.incentiveKeeper.Hooks()))
 	app.hardKeeper = *hardKeeper.SetHooks(hardtypes.NewMultiHARDHooks(app.incentiveKeeper.Hooks()))
 	app.savingsKeeper = savingsKeeper // savings incentive hooks disabled
-	app.earnKeeper = *earnKeeper.SetHooks(app.incentiveKeeper.Hooks())
+	app.earnKeeper = *earnKeeper.SetHooks(earntypes.NewMultiEarnHooks(app.incentiveKeeper.Hooks()))
+	// liquid hooks are read by the derivative bank keeper from app.liquidKeeper
+	app.liquidKeeper.SetHooks(liquidtypes.NewMultiLiquidHooks(app.incentiveKeeper.Hooks()))
 
 	// create gov keeper with router
 	// NOTE this must be done after any keepers referenced in the gov router (ie committee) are defined
@@ -825,7 +866,10 @@ func NewApp(
 	app.mm = module.NewManager(
 		genutil.NewAppModule(app.accountKeeper, app.stakingKeeper, app.BaseApp.DeliverTx, encodingConfig.TxConfig),
 		auth.NewAppModule(appCodec, app.accountKeeper, authsims.RandomGenesisAccounts, authSubspace),
-		bank.NewAppModule(appCodec, app.bankKeeper, app.accountKeeper, bankSubspace),
+		liquid.NewBankAppModule(
+			bank.NewAppModule(appCodec, baseBankKeeper, app.accountKeeper, bankSubspace),
+			derivativeBankKeeper,
+		),
 		capability.NewAppModule(appCodec, *app.capabilityKeeper, false), // todo: confirm if this is okay to not be sealed
 		staking.NewAppModule(appCodec, app.stakingKeeper, app.accountKeeper, app.bankKeeper, stakingSubspace),
 		distr.NewAppModule(appCodec, app.distrKeeper, app.accountKeeper, app.bankKeeper, app.stakingKeeper, distrSubspace),
@@ -930,6 +974,8 @@ func NewApp(
 		evmtypes.ModuleName,
 		// fee market module must go after evm module in order to retrieve the block gas used.
 		feemarkettypes.ModuleName,
+		// evmutil module must go after evm and fee market modules in order to sweep the dust they leave.
+		evmutiltypes.ModuleName,
 		pricefeedtypes.ModuleName,
 		// Add all remaining modules with an empty end blocker below since cosmos 0.45.0 requires it
 		capabilitytypes.ModuleName,
@@ -955,7 +1001,6 @@ func NewApp(
 		ibctransfertypes.ModuleName,
 		paramstypes.ModuleName,
 		authz.ModuleName,
-		evmutiltypes.ModuleName,
 		savingstypes.ModuleName,
 		liquidtypes.ModuleName,
 		earntypes.ModuleName,
@@ -1036,6 +1081,9 @@ func NewApp(
 	//  staking.NewAppModule(app.stakingKeeper, app.accountKeeper, app.accountKeeper, app.bankKeeper),
 	//  evm.NewAppModule(app.evmKeeper, app.accountKeeper),
 	// 	slashing.NewAppModule(app.slashingKeeper, app.accountKeeper, app.stakingKeeper),
+	// 	evmutil.NewAppModule(app.evmutilKeeper, app.bankKeeper, app.accountKeeper),
+	// 	incentive.NewAppModule(app.incentiveKeeper, app.accountKeeper, app.bankKeeper, app.cdpKeeper),
+	// 	hard.NewAppModule(app.hardKeeper, app.accountKeeper, app.bankKeeper, app.pricefeedKeeper),
 	// )
 	// app.sm.RegisterStoreDecoders()
 
@@ -1087,6 +1135,7 @@ func NewApp(
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedPricefeedKeeper = scopedPricefeedKeeper
 
 	return app
 }
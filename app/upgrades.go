@@ -1,3 +1,31 @@
 package app
 
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	incentivekeeper "github.com/kava-labs/kava/x/incentive/keeper"
+	incentivetypes "github.com/kava-labs/kava/x/incentive/types"
+)
+
 func (app App) RegisterUpgradeHandlers() {}
+
+// AddressMigration is an account consolidated from one address into another.
+type AddressMigration struct {
+	From sdk.AccAddress
+	To   sdk.AccAddress
+}
+
+// MergeIncentiveClaims merges the incentive claims of each migrated address into the claims of the address it was
+// consolidated into. Upgrade handlers that migrate accounts call it so rewards accrued by old addresses are not lost.
+// Addresses without claims are skipped.
+func MergeIncentiveClaims(ctx sdk.Context, incentiveKeeper incentivekeeper.Keeper, migrations []AddressMigration) error {
+	for _, migration := range migrations {
+		err := incentiveKeeper.MergeClaims(ctx, migration.From, migration.To)
+		if err != nil && !errors.Is(err, incentivetypes.ErrNoClaimsFound) {
+			return err
+		}
+	}
+	return nil
+}
@@ -32,6 +32,8 @@ const (
 	flagMempoolEnableAuth    = "mempool.enable-authentication"
 	flagMempoolAuthAddresses = "mempool.authorized-addresses"
 	flagSkipLoadLatest       = "skip-load-latest"
+
+	flagIncentiveDisableLegacyEvents = "incentive.disable-legacy-events"
 )
 
 // appCreator holds functions used by the sdk server to control the kava app.
@@ -119,6 +121,8 @@ func (ac appCreator) newApp(
 			EVMTrace:              cast.ToString(appOpts.Get(ethermintflags.EVMTracer)),
 			EVMMaxGasWanted:       cast.ToUint64(appOpts.Get(ethermintflags.EVMMaxTxGasWanted)),
 			TelemetryOptions:      metricstypes.TelemetryOptionsFromAppOpts(appOpts),
+
+			IncentiveDisableLegacyEvents: cast.ToBool(appOpts.Get(flagIncentiveDisableLegacyEvents)),
 		},
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(strings.Replace(cast.ToString(appOpts.Get(server.FlagMinGasPrices)), ";", ",", -1)),
@@ -172,6 +176,7 @@ func (ac appCreator) appExport(
 // addStartCmdFlags adds flags to the server start command.
 func (ac appCreator) addStartCmdFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	startCmd.Flags().Bool(flagIncentiveDisableLegacyEvents, false, "Emit only typed incentive events, dropping the legacy untyped events")
 }
 
 // accAddressesFromBech32 converts a slice of bech32 encoded addresses into a slice of address types.
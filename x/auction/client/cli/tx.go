@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -24,6 +25,7 @@ func GetTxCmd() *cobra.Command {
 
 	cmds := []*cobra.Command{
 		GetCmdPlaceBid(),
+		GetCmdPlaceBids(),
 	}
 
 	for _, cmd := range cmds {
@@ -68,3 +70,47 @@ func GetCmdPlaceBid() *cobra.Command {
 		},
 	}
 }
+
+// GetCmdPlaceBids cli command for placing bids on several auctions at once
+func GetCmdPlaceBids() *cobra.Command {
+	return &cobra.Command{
+		Use:     "bids [auction-id:amount]...",
+		Short:   "place bids on several auctions",
+		Long:    "Place bids on several auctions of any type in one transaction, updating the latest bid amount of each auction. No bid is placed if the bidder cannot pay for all of them.",
+		Example: fmt.Sprintf("  $ %s tx %s bids 34:1000usdx 35:500usdx --from myKeyName", version.AppName, types.ModuleName),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bids := make([]types.AuctionBid, len(args))
+			for i, arg := range args {
+				idArg, amtArg, found := strings.Cut(arg, ":")
+				if !found {
+					return fmt.Errorf("bid '%s' not in the format auction-id:amount", arg)
+				}
+
+				id, err := strconv.ParseUint(idArg, 10, 64)
+				if err != nil {
+					return fmt.Errorf("auction-id '%s' not a valid uint", idArg)
+				}
+
+				amt, err := sdk.ParseCoinNormalized(amtArg)
+				if err != nil {
+					return err
+				}
+
+				bids[i] = types.NewAuctionBid(id, amt)
+			}
+
+			msg := types.NewMsgPlaceBids(clientCtx.GetFromAddress().String(), bids)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
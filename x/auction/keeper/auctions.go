@@ -8,6 +8,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/kava-labs/kava/x/auction/types"
@@ -137,11 +138,50 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 		return errorsmod.Wrapf(types.ErrAuctionNotFound, "%d", auctionID)
 	}
 
+	return k.placeBid(ctx, k.GetParams(ctx), auction, bidder, newAmount)
+}
+
+// PlaceBids places bids from one bidder on several auctions.
+//
+// The coins paid for every bid are checked against the bidder's spendable balance before any bid is placed, and the
+// params are read once for all bids, so bidders can act on many auctions without paying for repeated lookups.
+func (k Keeper) PlaceBids(ctx sdk.Context, bidder sdk.AccAddress, bids []types.AuctionBid) error {
+	auctions := make([]types.Auction, len(bids))
+	required := sdk.NewCoins()
+	for i, bid := range bids {
+		auction, found := k.GetAuction(ctx, bid.AuctionId)
+		if !found {
+			return errorsmod.Wrapf(types.ErrAuctionNotFound, "%d", bid.AuctionId)
+		}
+		auctions[i] = auction
+		required = required.Add(bidCost(auction, bidder, bid.Amount)...)
+	}
+
+	spendable := k.bankKeeper.SpendableCoins(ctx, bidder)
+	if !spendable.IsAllGTE(required) {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s < %s", spendable, required)
+	}
+
+	params := k.GetParams(ctx)
+	for i, bid := range bids {
+		if err := k.placeBid(ctx, params, auctions[i], bidder, bid.Amount); err != nil {
+			return errorsmod.Wrapf(err, "auction %d", bid.AuctionId)
+		}
+	}
+
+	return nil
+}
+
+// placeBid places a bid on an auction loaded from the store, moving coins and storing the updated auction.
+func (k Keeper) placeBid(ctx sdk.Context, params types.Params, auction types.Auction, bidder sdk.AccAddress, newAmount sdk.Coin) error {
 	// validation common to all auctions
 	if ctx.BlockTime().After(auction.GetEndTime()) {
-		return errorsmod.Wrapf(types.ErrAuctionHasExpired, "%d", auctionID)
+		return errorsmod.Wrapf(types.ErrAuctionHasExpired, "%d", auction.GetID())
 	}
 
+	// auctions are updated in place, so the end time in the byTime index is recorded before bidding
+	previousEndTime := auction.GetEndTime()
+
 	// move coins and return updated auction
 	var (
 		err            error
@@ -149,14 +189,14 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 	)
 	switch auctionType := auction.(type) {
 	case *types.SurplusAuction:
-		updatedAuction, err = k.PlaceBidSurplus(ctx, auctionType, bidder, newAmount)
+		updatedAuction, err = k.PlaceBidSurplus(ctx, params, auctionType, bidder, newAmount)
 	case *types.DebtAuction:
-		updatedAuction, err = k.PlaceBidDebt(ctx, auctionType, bidder, newAmount)
+		updatedAuction, err = k.PlaceBidDebt(ctx, params, auctionType, bidder, newAmount)
 	case *types.CollateralAuction:
 		if !auctionType.IsReversePhase() {
-			updatedAuction, err = k.PlaceForwardBidCollateral(ctx, auctionType, bidder, newAmount)
+			updatedAuction, err = k.PlaceForwardBidCollateral(ctx, params, auctionType, bidder, newAmount)
 		} else {
-			updatedAuction, err = k.PlaceReverseBidCollateral(ctx, auctionType, bidder, newAmount)
+			updatedAuction, err = k.PlaceReverseBidCollateral(ctx, params, auctionType, bidder, newAmount)
 		}
 	default:
 		err = errorsmod.Wrap(types.ErrUnrecognizedAuctionType, auction.GetType())
@@ -166,13 +206,52 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 		return err
 	}
 
-	k.SetAuction(ctx, updatedAuction)
+	k.updateAuction(ctx, updatedAuction, previousEndTime)
 
 	return nil
 }
 
+// bidCost returns the coins a bidder pays to place a bid on an auction. Invalid bids cost nothing, as they are
+// rejected when placed.
+func bidCost(auction types.Auction, bidder sdk.AccAddress, amount sdk.Coin) sdk.Coins {
+	switch auctionType := auction.(type) {
+	case *types.SurplusAuction:
+		return forwardBidCost(auctionType.Bidder, auctionType.Bid, bidder, amount)
+	case *types.DebtAuction:
+		return reverseBidCost(auctionType.Bidder, auctionType.Bid, bidder)
+	case *types.CollateralAuction:
+		if !auctionType.IsReversePhase() {
+			return forwardBidCost(auctionType.Bidder, auctionType.Bid, bidder, amount)
+		}
+		return reverseBidCost(auctionType.Bidder, auctionType.Bid, bidder)
+	default:
+		return sdk.NewCoins()
+	}
+}
+
+// forwardBidCost returns the coins paid for a forward bid, which repays the previous bidder and pays the increase
+// in bid, or only the increase if the bidder is replacing their own bid.
+func forwardBidCost(previousBidder sdk.AccAddress, previousBid sdk.Coin, bidder sdk.AccAddress, bid sdk.Coin) sdk.Coins {
+	if bid.Denom != previousBid.Denom || bid.IsLT(previousBid) {
+		return sdk.NewCoins()
+	}
+	if bidder.Equals(previousBidder) {
+		return sdk.NewCoins(bid.Sub(previousBid))
+	}
+	return sdk.NewCoins(bid)
+}
+
+// reverseBidCost returns the coins paid for a reverse bid, which repays the previous bidder unless the bidder is
+// replacing their own bid.
+func reverseBidCost(previousBidder sdk.AccAddress, previousBid sdk.Coin, bidder sdk.AccAddress) sdk.Coins {
+	if bidder.Equals(previousBidder) {
+		return sdk.NewCoins()
+	}
+	return sdk.NewCoins(previousBid)
+}
+
 // PlaceBidSurplus places a forward bid on a surplus auction, moving coins and returning the updated auction.
-func (k Keeper) PlaceBidSurplus(ctx sdk.Context, auction *types.SurplusAuction, bidder sdk.AccAddress, bid sdk.Coin) (*types.SurplusAuction, error) {
+func (k Keeper) PlaceBidSurplus(ctx sdk.Context, params types.Params, auction *types.SurplusAuction, bidder sdk.AccAddress, bid sdk.Coin) (*types.SurplusAuction, error) {
 	// Validate new bid
 	if bid.Denom != auction.Bid.Denom {
 		return auction, errorsmod.Wrapf(types.ErrInvalidBidDenom, "%s ≠ %s", bid.Denom, auction.Bid.Denom)
@@ -180,7 +259,7 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, auction *types.SurplusAuction,
 	minNewBidAmt := auction.Bid.Amount.Add( // new bids must be some % greater than old bid, and at least 1 larger to avoid replacing an old bid at no cost
 		sdk.MaxInt(
 			sdkmath.NewInt(1),
-			sdk.NewDecFromInt(auction.Bid.Amount).Mul(k.GetParams(ctx).IncrementSurplus).RoundInt(),
+			sdk.NewDecFromInt(auction.Bid.Amount).Mul(params.IncrementSurplus).RoundInt(),
 		),
 	)
 	if bid.Amount.LT(minNewBidAmt) {
@@ -215,10 +294,10 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, auction *types.SurplusAuction,
 	auction.Bidder = bidder
 	auction.Bid = bid
 	if !auction.HasReceivedBids {
-		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		auction.MaxEndTime = ctx.BlockTime().Add(params.MaxAuctionDuration) // set maximum ending time on receipt of first bid
 		auction.HasReceivedBids = true
 	}
-	auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).ForwardBidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
+	auction.EndTime = earliestTime(ctx.BlockTime().Add(params.ForwardBidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -234,7 +313,7 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, auction *types.SurplusAuction,
 }
 
 // PlaceForwardBidCollateral places a forward bid on a collateral auction, moving coins and returning the updated auction.
-func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction *types.CollateralAuction, bidder sdk.AccAddress, bid sdk.Coin) (*types.CollateralAuction, error) {
+func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, params types.Params, auction *types.CollateralAuction, bidder sdk.AccAddress, bid sdk.Coin) (*types.CollateralAuction, error) {
 	// Validate new bid
 	if bid.Denom != auction.Bid.Denom {
 		return auction, errorsmod.Wrapf(types.ErrInvalidBidDenom, "%s ≠ %s", bid.Denom, auction.Bid.Denom)
@@ -245,7 +324,7 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction *types.Collat
 	minNewBidAmt := auction.Bid.Amount.Add( // new bids must be some % greater than old bid, and at least 1 larger to avoid replacing an old bid at no cost
 		sdk.MaxInt(
 			sdkmath.NewInt(1),
-			sdk.NewDecFromInt(auction.Bid.Amount).Mul(k.GetParams(ctx).IncrementCollateral).RoundInt(),
+			sdk.NewDecFromInt(auction.Bid.Amount).Mul(params.IncrementCollateral).RoundInt(),
 		),
 	)
 	minNewBidAmt = sdk.MinInt(minNewBidAmt, auction.MaxBid.Amount) // allow new bids to hit MaxBid even though it may be less than the increment %
@@ -291,15 +370,15 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction *types.Collat
 	auction.Bidder = bidder
 	auction.Bid = bid
 	if !auction.HasReceivedBids {
-		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		auction.MaxEndTime = ctx.BlockTime().Add(params.MaxAuctionDuration) // set maximum ending time on receipt of first bid
 		auction.HasReceivedBids = true
 	}
 
 	// If this forward bid converts this to a reverse, increase timeout with ReverseBidDuration
 	if auction.IsReversePhase() {
-		auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).ReverseBidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
+		auction.EndTime = earliestTime(ctx.BlockTime().Add(params.ReverseBidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
 	} else {
-		auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).ForwardBidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
+		auction.EndTime = earliestTime(ctx.BlockTime().Add(params.ForwardBidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -316,7 +395,7 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction *types.Collat
 }
 
 // PlaceReverseBidCollateral places a reverse bid on a collateral auction, moving coins and returning the updated auction.
-func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction *types.CollateralAuction, bidder sdk.AccAddress, lot sdk.Coin) (*types.CollateralAuction, error) {
+func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, params types.Params, auction *types.CollateralAuction, bidder sdk.AccAddress, lot sdk.Coin) (*types.CollateralAuction, error) {
 	// Validate new bid
 	if lot.Denom != auction.Lot.Denom {
 		return auction, errorsmod.Wrapf(types.ErrInvalidLotDenom, "%s ≠ %s", lot.Denom, auction.Lot.Denom)
@@ -327,7 +406,7 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction *types.Collat
 	maxNewLotAmt := auction.Lot.Amount.Sub( // new lot must be some % less than old lot, and at least 1 smaller to avoid replacing an old bid at no cost
 		sdk.MaxInt(
 			sdkmath.NewInt(1),
-			sdk.NewDecFromInt(auction.Lot.Amount).Mul(k.GetParams(ctx).IncrementCollateral).RoundInt(),
+			sdk.NewDecFromInt(auction.Lot.Amount).Mul(params.IncrementCollateral).RoundInt(),
 		),
 	)
 	if lot.Amount.GT(maxNewLotAmt) {
@@ -371,10 +450,10 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction *types.Collat
 	auction.Bidder = bidder
 	auction.Lot = lot
 	if !auction.HasReceivedBids {
-		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		auction.MaxEndTime = ctx.BlockTime().Add(params.MaxAuctionDuration) // set maximum ending time on receipt of first bid
 		auction.HasReceivedBids = true
 	}
-	auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).ReverseBidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
+	auction.EndTime = earliestTime(ctx.BlockTime().Add(params.ReverseBidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -390,7 +469,7 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction *types.Collat
 }
 
 // PlaceBidDebt places a reverse bid on a debt auction, moving coins and returning the updated auction.
-func (k Keeper) PlaceBidDebt(ctx sdk.Context, auction *types.DebtAuction, bidder sdk.AccAddress, lot sdk.Coin) (*types.DebtAuction, error) {
+func (k Keeper) PlaceBidDebt(ctx sdk.Context, params types.Params, auction *types.DebtAuction, bidder sdk.AccAddress, lot sdk.Coin) (*types.DebtAuction, error) {
 	// Validate new bid
 	if lot.Denom != auction.Lot.Denom {
 		return auction, errorsmod.Wrapf(types.ErrInvalidLotDenom, "%s ≠ %s", lot.Denom, auction.Lot.Denom)
@@ -398,7 +477,7 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, auction *types.DebtAuction, bidder
 	maxNewLotAmt := auction.Lot.Amount.Sub( // new lot must be some % less than old lot, and at least 1 smaller to avoid replacing an old bid at no cost
 		sdk.MaxInt(
 			sdkmath.NewInt(1),
-			sdk.NewDecFromInt(auction.Lot.Amount).Mul(k.GetParams(ctx).IncrementDebt).RoundInt(),
+			sdk.NewDecFromInt(auction.Lot.Amount).Mul(params.IncrementDebt).RoundInt(),
 		),
 	)
 	if lot.Amount.GT(maxNewLotAmt) {
@@ -445,10 +524,10 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, auction *types.DebtAuction, bidder
 	auction.Bidder = bidder
 	auction.Lot = lot
 	if !auction.HasReceivedBids {
-		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		auction.MaxEndTime = ctx.BlockTime().Add(params.MaxAuctionDuration) // set maximum ending time on receipt of first bid
 		auction.HasReceivedBids = true
 	}
-	auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).ForwardBidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
+	auction.EndTime = earliestTime(ctx.BlockTime().Add(params.ForwardBidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
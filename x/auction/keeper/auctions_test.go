@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/kava-labs/kava/x/auction/testutil"
@@ -194,6 +195,112 @@ func (suite *auctionTestSuite) TestCollateralAuctionDebtRemaining() {
 	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 110), c("debt", 100)))
 }
 
+func (suite *auctionTestSuite) TestPlaceBids() {
+	buyer := suite.Addrs[0]
+	returnAddrs := suite.Addrs[1:]
+	returnWeights := is(30, 20, 10)
+	sellerModName := suite.ModAcc.Name
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	surplusID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, sellerModName, c("token1", 20), "token2")
+	suite.NoError(err)
+	collateralID, err := suite.Keeper.StartCollateralAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	suite.NoError(err)
+
+	err = suite.Keeper.PlaceBids(suite.Ctx, buyer, []types.AuctionBid{
+		types.NewAuctionBid(surplusID, c("token2", 10)),
+		types.NewAuctionBid(collateralID, c("token2", 20)),
+	})
+	suite.NoError(err)
+
+	// Check bidder's coins have decreased by both bids
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 100), c("token2", 70)))
+
+	// Check both auctions were updated and moved in the byTime index
+	expectedEndTime := suite.Ctx.BlockTime().Add(types.DefaultForwardBidDuration)
+	for _, id := range []uint64{surplusID, collateralID} {
+		auction, found := suite.Keeper.GetAuction(suite.Ctx, id)
+		suite.True(found)
+		suite.Equal(buyer, auction.GetBidder())
+		suite.Equal(expectedEndTime, auction.GetEndTime())
+	}
+	var indexed []uint64
+	suite.Keeper.IterateAuctionsByTime(suite.Ctx, expectedEndTime, func(id uint64) bool {
+		indexed = append(indexed, id)
+		return false
+	})
+	suite.ElementsMatch([]uint64{surplusID, collateralID}, indexed)
+
+	// Both auctions close once expired
+	ctx := suite.Ctx.WithBlockTime(expectedEndTime)
+	suite.NoError(suite.Keeper.CloseExpiredAuctions(ctx))
+	suite.Empty(suite.Keeper.GetAllAuctions(ctx))
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 140), c("token2", 70)))
+}
+
+func (suite *auctionTestSuite) TestPlaceBids_InsufficientFunds() {
+	buyer := suite.Addrs[0]
+	sellerModName := suite.ModAcc.Name
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100)))
+
+	firstID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, sellerModName, c("token1", 20), "token2")
+	suite.NoError(err)
+	secondID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, sellerModName, c("token1", 20), "token2")
+	suite.NoError(err)
+
+	// each bid is affordable, but not both
+	err = suite.Keeper.PlaceBids(suite.Ctx, buyer, []types.AuctionBid{
+		types.NewAuctionBid(firstID, c("token2", 60)),
+		types.NewAuctionBid(secondID, c("token2", 60)),
+	})
+	suite.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// no bid is placed
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 100), c("token2", 100)))
+	for _, id := range []uint64{firstID, secondID} {
+		auction, found := suite.Keeper.GetAuction(suite.Ctx, id)
+		suite.True(found)
+		suite.True(auction.GetBid().IsZero())
+	}
+}
+
+func (suite *auctionTestSuite) TestPlaceBids_ReplacingOwnBid() {
+	buyer := suite.Addrs[0]
+	sellerModName := suite.ModAcc.Name
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100)))
+
+	auctionID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, sellerModName, c("token1", 20), "token2")
+	suite.NoError(err)
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token2", 60)))
+
+	// only the increase in bid is paid when raising an existing bid
+	err = suite.Keeper.PlaceBids(suite.Ctx, buyer, []types.AuctionBid{
+		types.NewAuctionBid(auctionID, c("token2", 90)),
+	})
+	suite.NoError(err)
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 100), c("token2", 10)))
+}
+
+func (suite *auctionTestSuite) TestPlaceBids_Errors() {
+	buyer := suite.Addrs[0]
+	sellerModName := suite.ModAcc.Name
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100)))
+
+	auctionID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, sellerModName, c("token1", 20), "token2")
+	suite.NoError(err)
+
+	err = suite.Keeper.PlaceBids(suite.Ctx, buyer, []types.AuctionBid{
+		types.NewAuctionBid(auctionID, c("token2", 10)),
+		types.NewAuctionBid(auctionID+1, c("token2", 10)),
+	})
+	suite.ErrorIs(err, types.ErrAuctionNotFound)
+
+	err = suite.Keeper.PlaceBids(suite.Ctx, buyer, []types.AuctionBid{
+		types.NewAuctionBid(auctionID, c("token1", 10)),
+	})
+	suite.ErrorIs(err, types.ErrInvalidBidDenom)
+}
+
 func (suite *auctionTestSuite) TestStartSurplusAuction() {
 	someTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
 	type args struct {
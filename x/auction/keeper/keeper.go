@@ -136,6 +136,18 @@ func (k Keeper) SetAuction(ctx sdk.Context, auction types.Auction) {
 	k.InsertIntoByTimeIndex(ctx, auction.GetEndTime(), auction.GetID())
 }
 
+// updateAuction puts a modified auction into the store, moving it in the byTime index only if its end time changed.
+// Unlike SetAuction it does not read the stored auction, as the caller provides the end time that was indexed.
+func (k Keeper) updateAuction(ctx sdk.Context, auction types.Auction, previousEndTime time.Time) {
+	if !auction.GetEndTime().Equal(previousEndTime) {
+		k.removeFromByTimeIndex(ctx, previousEndTime, auction.GetID())
+		k.InsertIntoByTimeIndex(ctx, auction.GetEndTime(), auction.GetID())
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionKeyPrefix)
+	store.Set(types.GetAuctionKey(auction.GetID()), k.MustMarshalAuction(auction))
+}
+
 // GetAuction gets an auction from the store.
 func (k Keeper) GetAuction(ctx sdk.Context, auctionID uint64) (types.Auction, bool) {
 	var auction types.Auction
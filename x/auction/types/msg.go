@@ -8,8 +8,14 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// MaxBidsPerMsg is the maximum number of bids that can be placed with a MsgPlaceBids.
+const MaxBidsPerMsg = 50
+
 // ensure Msg interface compliance at compile time
-var _ sdk.Msg = &MsgPlaceBid{}
+var (
+	_ sdk.Msg = &MsgPlaceBid{}
+	_ sdk.Msg = &MsgPlaceBids{}
+)
 
 // NewMsgPlaceBid returns a new MsgPlaceBid.
 func NewMsgPlaceBid(auctionID uint64, bidder string, amt sdk.Coin) MsgPlaceBid {
@@ -55,3 +61,70 @@ func (msg MsgPlaceBid) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{bidder}
 }
+
+// NewAuctionBid returns a new AuctionBid.
+func NewAuctionBid(auctionID uint64, amt sdk.Coin) AuctionBid {
+	return AuctionBid{
+		AuctionId: auctionID,
+		Amount:    amt,
+	}
+}
+
+// NewMsgPlaceBids returns a new MsgPlaceBids.
+func NewMsgPlaceBids(bidder string, bids []AuctionBid) MsgPlaceBids {
+	return MsgPlaceBids{
+		Bidder: bidder,
+		Bids:   bids,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgPlaceBids) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgPlaceBids) Type() string { return "place_bids" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgPlaceBids) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "bidder address cannot be empty or invalid")
+	}
+	if len(msg.Bids) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "bids cannot be empty")
+	}
+	if len(msg.Bids) > MaxBidsPerMsg {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot place more than %d bids", MaxBidsPerMsg)
+	}
+
+	seen := make(map[uint64]bool, len(msg.Bids))
+	for _, bid := range msg.Bids {
+		if bid.AuctionId == 0 {
+			return errors.New("auction id cannot be zero")
+		}
+		if seen[bid.AuctionId] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate bid on auction %d", bid.AuctionId)
+		}
+		seen[bid.AuctionId] = true
+
+		if !bid.Amount.IsValid() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "bid amount %s", bid.Amount)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgPlaceBids) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgPlaceBids) GetSigners() []sdk.AccAddress {
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{bidder}
+}
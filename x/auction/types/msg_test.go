@@ -50,3 +50,60 @@ func TestMsgPlaceBid_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgPlaceBids_ValidateBasic(t *testing.T) {
+	tooManyBids := make([]AuctionBid, MaxBidsPerMsg+1)
+	for i := range tooManyBids {
+		tooManyBids[i] = NewAuctionBid(uint64(i+1), c("token", 10))
+	}
+
+	tests := []struct {
+		name       string
+		msg        MsgPlaceBids
+		expectPass bool
+	}{
+		{
+			"normal",
+			NewMsgPlaceBids(testAccAddress1, []AuctionBid{NewAuctionBid(1, c("token", 10)), NewAuctionBid(2, c("token", 0))}),
+			true,
+		},
+		{
+			"empty address",
+			NewMsgPlaceBids("", []AuctionBid{NewAuctionBid(1, c("token", 10))}),
+			false,
+		},
+		{
+			"no bids",
+			NewMsgPlaceBids(testAccAddress1, nil),
+			false,
+		},
+		{
+			"too many bids",
+			NewMsgPlaceBids(testAccAddress1, tooManyBids),
+			false,
+		},
+		{
+			"zero id",
+			NewMsgPlaceBids(testAccAddress1, []AuctionBid{NewAuctionBid(1, c("token", 10)), NewAuctionBid(0, c("token", 10))}),
+			false,
+		},
+		{
+			"duplicate auction",
+			NewMsgPlaceBids(testAccAddress1, []AuctionBid{NewAuctionBid(1, c("token", 10)), NewAuctionBid(1, c("token", 20))}),
+			false,
+		},
+		{
+			"negative amount",
+			NewMsgPlaceBids(testAccAddress1, []AuctionBid{NewAuctionBid(1, sdk.Coin{Denom: "token", Amount: sdkmath.NewInt(-10)})}),
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.msg.ValidateBasic(), tc.name)
+		} else {
+			require.Error(t, tc.msg.ValidateBasic(), tc.name)
+		}
+	}
+}
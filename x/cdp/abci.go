@@ -39,26 +39,37 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 		if err != nil {
 			panic(err)
 		}
+		k.SnapshotInterestFactor(ctx, cp.Type)
 
 		if skipSyncronizeAndLiquidations {
-			ctx.Logger().Debug(fmt.Sprintf("skipping x/cdp SynchronizeInterestForRiskyCDPs and LiquidateCdps for %s", cp.Type))
+			ctx.Logger().Debug(fmt.Sprintf("skipping x/cdp SynchronizeInterestForRiskyCDPs, TopUpProtectedCdps and LiquidateCdps for %s", cp.Type))
 			continue
 		}
 
-		ctx.Logger().Debug(fmt.Sprintf("running x/cdp SynchronizeInterestForRiskyCDPs and LiquidateCdps for %s", cp.Type))
+		ctx.Logger().Debug(fmt.Sprintf("running x/cdp SynchronizeInterestForRiskyCDPs, TopUpProtectedCdps and LiquidateCdps for %s", cp.Type))
 
 		err = k.SynchronizeInterestForRiskyCDPs(ctx, sdk.MaxSortableDec, cp)
 		if err != nil {
 			panic(err)
 		}
 
+		err = k.TopUpProtectedCdps(ctx, cp.Type)
+		if err != nil && !errors.Is(err, pricefeedtypes.ErrNoValidPrice) {
+			panic(err)
+		}
+
 		err = k.LiquidateCdps(ctx, cp.LiquidationMarketID, cp.Type, cp.LiquidationRatio, cp.CheckCollateralizationIndexCount)
 		if err != nil && !errors.Is(err, pricefeedtypes.ErrNoValidPrice) {
 			panic(err)
 		}
 	}
 
-	err := k.RunSurplusAndDebtAuctions(ctx)
+	err := k.AccumulateSavings(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.RunSurplusAndDebtAuctions(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -141,6 +141,8 @@ func (suite *ModuleTestSuite) TestBeginBlockNewCdpTypeSetsGlobalInterest() {
 		LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
 		CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 		KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+		LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+		KeeperIncentive:                  sdkmath.ZeroInt(),
 		SpotMarketID:                     "usdc:usd",
 		LiquidationMarketID:              "usdc:usd",
 		ConversionFactor:                 sdkmath.NewInt(6),
@@ -155,6 +157,8 @@ func (suite *ModuleTestSuite) TestBeginBlockNewCdpTypeSetsGlobalInterest() {
 		LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
 		CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 		KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+		LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+		KeeperIncentive:                  sdkmath.ZeroInt(),
 		SpotMarketID:                     "usdt:usd",
 		LiquidationMarketID:              "usdt:usd",
 		ConversionFactor:                 sdkmath.NewInt(18),
@@ -22,6 +22,7 @@ const (
 	flagOwner          = "owner"
 	flagID             = "id"
 	flagRatio          = "ratio" // returns CDPs under the given collateralization ratio threshold
+	flagMargin         = "margin"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -36,8 +37,18 @@ func GetQueryCmd() *cobra.Command {
 		QueryCdpCmd(),
 		QueryGetCdpsCmd(),
 		QueryCdpDepositsCmd(),
+		QueryCdpProtectionCmd(),
 		QueryParamsCmd(),
 		QueryGetAccounts(),
+		QueryDebtLimitsCmd(),
+		QueryStabilityFeesCmd(),
+		QueryPriceStatusesCmd(),
+		QueryInterestFactorSnapshotsCmd(),
+		QueryAccruedFeesCmd(),
+		QueryLiquidationQueueCmd(),
+		QuerySavingsRateCmd(),
+		QuerySavingsDepositCmd(),
+		QuerySimulateDrawCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -221,6 +232,44 @@ $ %s query %s deposits kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw atom-a
 	}
 }
 
+// QueryCdpProtectionCmd returns the command handler for querying the liquidation protection of a particular cdp
+func QueryCdpProtectionCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "protection [owner-addr] [collateral-type]",
+		Short: "get the liquidation protection of a cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the reserve address, target ratio and allowance that protect a CDP from liquidation.
+
+Example:
+$ %s query %s protection kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw atom-a
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Protection(context.Background(), &types.QueryProtectionRequest{
+				Owner:          args[0],
+				CollateralType: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
 // QueryParamsCmd returns the command handler for cdp parameter querying
 func QueryParamsCmd() *cobra.Command {
 	return &cobra.Command{
@@ -270,3 +319,385 @@ func QueryGetAccounts() *cobra.Command {
 		},
 	}
 }
+
+// QueryDebtLimitsCmd returns the command handler for querying debt against debt limits
+func QueryDebtLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debt-limits",
+		Short: "get the total principal of collateral types against their debt limits",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the total principal and debt limit utilization of each collateral type, and of all collateral types against the global debt limit.
+
+Example:
+$ %s query %s debt-limits
+$ %s query %s debt-limits --collateral-type atom-a
+`, version.AppName, types.ModuleName, version.AppName, types.ModuleName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			collateralType, err := cmd.Flags().GetString(flagCollateralType)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DebtLimits(context.Background(), &types.QueryDebtLimitsRequest{
+				CollateralType: collateralType,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagCollateralType, "", "(optional) filter by collateral type")
+
+	return cmd
+}
+
+// QueryStabilityFeesCmd returns the command handler for querying stability fees
+func QueryStabilityFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stability-fees",
+		Short: "get the stability fees of collateral types",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the stability fee of each collateral type as a per second rate, an APR and an APY.
+
+Example:
+$ %s query %s stability-fees
+$ %s query %s stability-fees --collateral-type atom-a
+`, version.AppName, types.ModuleName, version.AppName, types.ModuleName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			collateralType, err := cmd.Flags().GetString(flagCollateralType)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StabilityFees(context.Background(), &types.QueryStabilityFeesRequest{
+				CollateralType: collateralType,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagCollateralType, "", "(optional) filter by collateral type")
+
+	return cmd
+}
+
+// QueryPriceStatusesCmd returns the command handler for querying the price status of collateral types
+func QueryPriceStatusesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-statuses",
+		Short: "get the price status of collateral types",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get when a price was last posted for each collateral type, and whether drawing debt is paused
+because the pricefeed is down or the price is older than the collateral type's price staleness threshold.
+
+Example:
+$ %s query %s price-statuses
+$ %s query %s price-statuses --collateral-type atom-a
+`, version.AppName, types.ModuleName, version.AppName, types.ModuleName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			collateralType, err := cmd.Flags().GetString(flagCollateralType)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PriceStatuses(context.Background(), &types.QueryPriceStatusesRequest{
+				CollateralType: collateralType,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagCollateralType, "", "(optional) filter by collateral type")
+
+	return cmd
+}
+
+// QueryInterestFactorSnapshotsCmd returns the command handler for querying interest factor snapshots
+func QueryInterestFactorSnapshotsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "interest-factor-snapshots",
+		Short: "get the recorded interest factor snapshots of collateral types",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the interest factor snapshots recorded every interest factor snapshot interval blocks.
+
+Example:
+$ %s query %s interest-factor-snapshots
+$ %s query %s interest-factor-snapshots --collateral-type atom-a --page=2 --limit=100
+`, version.AppName, types.ModuleName, version.AppName, types.ModuleName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			collateralType, err := cmd.Flags().GetString(flagCollateralType)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.InterestFactorSnapshots(context.Background(), &types.QueryInterestFactorSnapshotsRequest{
+				CollateralType: collateralType,
+				Pagination:     pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagCollateralType, "", "(optional) filter by collateral type")
+	flags.AddPaginationFlagsToCmd(cmd, "interest-factor-snapshots")
+
+	return cmd
+}
+
+// QueryAccruedFeesCmd returns the command handler for querying the fees accrued by a cdp between two heights
+func QueryAccruedFeesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "accrued-fees [owner-addr] [collateral-type] [start-height] [end-height]",
+		Short: "get the fees accrued by a cdp between two heights",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the fees accrued by a CDP between the interest factor snapshots at or before two block heights.
+An end height of zero uses the current interest factor.
+
+Example:
+$ %s query %s accrued-fees kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw atom-a 1000 2000
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			startHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start height: %w", err)
+			}
+			endHeight, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end height: %w", err)
+			}
+
+			res, err := queryClient.AccruedFees(context.Background(), &types.QueryAccruedFeesRequest{
+				Owner:          args[0],
+				CollateralType: args[1],
+				StartHeight:    startHeight,
+				EndHeight:      endHeight,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// QueryLiquidationQueueCmd returns the command handler for querying cdps close to liquidation
+func QueryLiquidationQueueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidation-queue [collateral-type]",
+		Short: "get the cdps of a collateral type within a margin of their liquidation ratio",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the CDPs of a collateral type with a collateralization ratio under their liquidation ratio
+plus a margin, lowest collateralized first. Without a margin, only CDPs under the liquidation ratio are returned.
+
+Example:
+$ %s query %s liquidation-queue atom-a
+$ %s query %s liquidation-queue atom-a --margin 0.1 --limit 100
+`, version.AppName, types.ModuleName, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			margin, err := cmd.Flags().GetString(flagMargin)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LiquidationQueue(context.Background(), &types.QueryLiquidationQueueRequest{
+				CollateralType: args[0],
+				Margin:         margin,
+				Pagination:     pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagMargin, "", "(optional) fraction above the liquidation ratio to include cdps under, for example 0.1")
+	flags.AddPaginationFlagsToCmd(cmd, "liquidation-queue")
+
+	return cmd
+}
+
+// QuerySavingsRateCmd returns the command handler for querying the savings rate
+func QuerySavingsRateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "savings-rate",
+		Short: "get the savings rate",
+		Long:  "get the savings rate paid to usdx savings deposits, its apy and the total savings.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SavingsRate(context.Background(), &types.QuerySavingsRateRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// QuerySavingsDepositCmd returns the command handler for querying a savings deposit
+func QuerySavingsDepositCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "savings-deposit [depositor-addr]",
+		Short: "get the savings deposit of a depositor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the savings deposit of a depositor, including the interest accrued at the savings rate.
+
+Example:
+$ %s query %s savings-deposit kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SavingsDeposit(context.Background(), &types.QuerySavingsDepositRequest{
+				Depositor: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// QuerySimulateDrawCmd returns the command handler for simulating drawing debt from a cdp
+func QuerySimulateDrawCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "simulate-draw [owner-addr] [collateral-type] [amount]",
+		Short: "simulate drawing debt from a cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the collateralization ratio, max drawable amount and fees of a CDP after drawing debt, and
+whether the draw would succeed.
+
+Example:
+$ %s query %s simulate-draw kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw atom-a 1000000usdx
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SimulateDraw(context.Background(), &types.QuerySimulateDrawRequest{
+				Owner:          args[0],
+				CollateralType: args[1],
+				Amount:         amount,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
@@ -15,6 +15,11 @@ import (
 	"github.com/kava-labs/kava/x/cdp/types"
 )
 
+const (
+	flagCdpCollateralType = "cdp-collateral-type"
+	flagFeeDenom          = "fee-denom"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cdpTxCmd := &cobra.Command{
@@ -29,6 +34,13 @@ func GetTxCmd() *cobra.Command {
 		GetCmdDraw(),
 		GetCmdRepay(),
 		GetCmdLiquidate(),
+		GetCmdSetProtection(),
+		GetCmdRemoveProtection(),
+		GetCmdApproveProtection(),
+		GetCmdTransferCdp(),
+		GetCmdRedeemUSDX(),
+		GetCmdDepositSavings(),
+		GetCmdWithdrawSavings(),
 	}
 
 	for _, cmd := range cmds {
@@ -78,7 +90,7 @@ $ %s tx %s create 10000000uatom 1000usdx atom-a --from myKeyName
 
 // GetCmdDeposit cli command for depositing to a cdp.
 func GetCmdDeposit() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "deposit [owner-addr] [collateral] [collateral-type]",
 		Short: "deposit collateral to an existing cdp",
 		Long: strings.TrimSpace(
@@ -86,6 +98,9 @@ func GetCmdDeposit() *cobra.Command {
 
 Example:
 $ %s tx %s deposit kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom atom-a --from myKeyName
+
+Add atom collateral to the bnb-a cdp of the sender as additional collateral backing its debt:
+$ %[1]s tx %[2]s deposit kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom atom-a --cdp-collateral-type bnb-a --from myKeyName
 `, version.AppName, types.ModuleName)),
 		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -103,6 +118,10 @@ $ %s tx %s deposit kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom ato
 				return err
 			}
 			msg := types.NewMsgDeposit(owner, clientCtx.GetFromAddress(), collateral, args[2])
+			msg.CdpCollateralType, err = cmd.Flags().GetString(flagCdpCollateralType)
+			if err != nil {
+				return err
+			}
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -110,11 +129,15 @@ $ %s tx %s deposit kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom ato
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().String(flagCdpCollateralType, "", "collateral type of the owner's cdp to deposit to as additional collateral")
+
+	return cmd
 }
 
 // GetCmdWithdraw cli command for withdrawing from a cdp.
 func GetCmdWithdraw() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "withdraw [owner-addr] [collateral] [collateral-type]",
 		Short: "withdraw collateral from an existing cdp",
 		Long: strings.TrimSpace(
@@ -122,6 +145,9 @@ func GetCmdWithdraw() *cobra.Command {
 
 Example:
 $ %s tx %s withdraw kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom atom-a --from myKeyName
+
+Remove atom additional collateral from the bnb-a cdp of the sender:
+$ %[1]s tx %[2]s withdraw kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom atom-a --cdp-collateral-type bnb-a --from myKeyName
 `, version.AppName, types.ModuleName)),
 		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -139,6 +165,10 @@ $ %s tx %s withdraw kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom at
 				return err
 			}
 			msg := types.NewMsgWithdraw(owner, clientCtx.GetFromAddress(), collateral, args[2])
+			msg.CdpCollateralType, err = cmd.Flags().GetString(flagCdpCollateralType)
+			if err != nil {
+				return err
+			}
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -146,6 +176,10 @@ $ %s tx %s withdraw kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom at
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().String(flagCdpCollateralType, "", "collateral type of the owner's cdp to withdraw from as additional collateral")
+
+	return cmd
 }
 
 // GetCmdDraw cli command for depositing to a cdp.
@@ -182,7 +216,7 @@ $ %s tx %s draw atom-a 1000usdx --from myKeyName
 
 // GetCmdRepay cli command for depositing to a cdp.
 func GetCmdRepay() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "repay [collateral-name] [debt]",
 		Short: "repay debt to an existing cdp",
 		Long: strings.TrimSpace(
@@ -190,6 +224,9 @@ func GetCmdRepay() *cobra.Command {
 
 Example:
 $ %s tx %s repay atom-a 1000usdx --from myKeyName
+
+Pay the accrued fees in ukava at the pricefeed rate, and repay principal with the usdx:
+$ %[1]s tx %[2]s repay atom-a 1000usdx --fee-denom ukava --from myKeyName
 `, version.AppName, types.ModuleName)),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -203,6 +240,10 @@ $ %s tx %s repay atom-a 1000usdx --from myKeyName
 				return err
 			}
 			msg := types.NewMsgRepayDebt(clientCtx.GetFromAddress(), args[0], payment)
+			msg.FeeDenom, err = cmd.Flags().GetString(flagFeeDenom)
+			if err != nil {
+				return err
+			}
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -210,6 +251,10 @@ $ %s tx %s repay atom-a 1000usdx --from myKeyName
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().String(flagFeeDenom, "", "denom to pay the accrued fees in, defaults to the debt denom")
+
+	return cmd
 }
 
 // GetCmdLiquidate cli command for liquidating a cdp.
@@ -243,3 +288,236 @@ $ %s tx %s liquidate kava1y70y90wzmnf00e63efk2lycgqwepthdmyzsfzm  btcb-a --from
 		},
 	}
 }
+
+// GetCmdSetProtection cli command for protecting a cdp from liquidation with collateral from a reserve address.
+func GetCmdSetProtection() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-protection [collateral-type] [reserve-address] [target-ratio]",
+		Short: "protect a cdp from liquidation with collateral from a reserve address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Designate a reserve address that tops up the collateral of a cdp whenever its collateralization ratio
+falls below the target ratio. The reserve must approve an allowance with approve-protection before any collateral is
+deposited from it.
+
+Example:
+$ %s tx %s set-protection atom-a kava1y70y90wzmnf00e63efk2lycgqwepthdmyzsfzm 2.0 --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			reserve, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			targetRatio, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSetProtection(clientCtx.GetFromAddress(), reserve, args[0], targetRatio)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdRemoveProtection cli command for removing the liquidation protection of a cdp.
+func GetCmdRemoveProtection() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove-protection [collateral-type]",
+		Short: "remove the liquidation protection of a cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the reserve address that protects a cdp from liquidation.
+
+Example:
+$ %s tx %s remove-protection atom-a --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetProtection(clientCtx.GetFromAddress(), nil, args[0], sdk.ZeroDec())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdApproveProtection cli command for a reserve address to approve collateral for a protected cdp.
+func GetCmdApproveProtection() *cobra.Command {
+	return &cobra.Command{
+		Use:   "approve-protection [cdp-owner-address] [collateral-type] [allowance]",
+		Short: "approve collateral to be deposited from a reserve address to the cdp it protects",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the amount of collateral that may be deposited from the signing reserve address to the cdp it
+protects from liquidation. The allowance replaces any previous allowance and decreases as collateral is deposited.
+
+Example:
+$ %s tx %s approve-protection kava1y70y90wzmnf00e63efk2lycgqwepthdmyzsfzm atom-a 1000000uatom --from myReserveKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			allowance, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgApproveProtection(clientCtx.GetFromAddress(), owner, args[1], allowance)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdTransferCdp cli command for moving a cdp to a new owner.
+func GetCmdTransferCdp() *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer [recipient-address] [collateral-type]",
+		Short: "move a cdp to a new owner",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Move the signer's cdp of a collateral type, along with the signer's deposit to it, to a new owner
+that does not have a cdp of the same collateral type.
+
+Example:
+$ %s tx %s transfer kava1y70y90wzmnf00e63efk2lycgqwepthdmyzsfzm atom-a --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgTransferCdp(clientCtx.GetFromAddress(), recipient, args[1])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdRedeemUSDX returns the command handler for redeeming usdx for collateral
+func GetCmdRedeemUSDX() *cobra.Command {
+	return &cobra.Command{
+		Use:   "redeem [collateral-type] [amount]",
+		Short: "redeem usdx for collateral of the lowest collateralized cdps",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Redeem usdx at face value for collateral of the lowest collateralized cdps of a collateral type,
+at the spot price less the redemption fee. Any amount that cannot be redeemed is kept by the signer.
+
+Example:
+$ %s tx %s redeem atom-a 1000000000usdx --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRedeemUSDX(clientCtx.GetFromAddress(), args[0], amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdDepositSavings returns the command handler for depositing usdx to a savings deposit
+func GetCmdDepositSavings() *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposit-savings [amount]",
+		Short: "deposit usdx to earn the savings rate",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deposit usdx to a savings deposit, which earns the savings rate paid from stability fees.
+
+Example:
+$ %s tx %s deposit-savings 1000000000usdx --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDepositSavings(clientCtx.GetFromAddress(), amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdWithdrawSavings returns the command handler for withdrawing usdx from a savings deposit
+func GetCmdWithdrawSavings() *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-savings [amount]",
+		Short: "withdraw usdx from a savings deposit",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw usdx, including accrued interest, from a savings deposit.
+
+Example:
+$ %s tx %s withdraw-savings 1000000000usdx --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgWithdrawSavings(clientCtx.GetFromAddress(), amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
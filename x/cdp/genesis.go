@@ -84,17 +84,30 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, pk types.PricefeedKeeper, ak
 	for _, d := range gs.Deposits {
 		k.SetDeposit(ctx, d)
 	}
+
+	for _, p := range gs.Protections {
+		k.SetProtection(ctx, p)
+	}
+
+	for _, s := range gs.InterestFactorSnapshots {
+		k.SetInterestFactorSnapshot(ctx, s)
+	}
+
+	k.SetSavingsAccumulator(ctx, gs.SavingsAccumulator)
+	for _, d := range gs.SavingsDeposits {
+		k.SetSavingsDeposit(ctx, d)
+	}
 }
 
 // ExportGenesis export genesis state for cdp module
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 
+	// cdps are exported in store order with their deposits, synchronized without writing to the store
 	cdps := types.CDPs{}
 	deposits := types.Deposits{}
 	k.IterateAllCdps(ctx, func(cdp types.CDP) (stop bool) {
-		syncedCdp := k.SynchronizeInterest(ctx, cdp)
-		cdps = append(cdps, syncedCdp)
+		cdps = append(cdps, k.CalculateSynchronizedInterest(ctx, cdp))
 		k.IterateDeposits(ctx, cdp.ID, func(deposit types.Deposit) (stop bool) {
 			deposits = append(deposits, deposit)
 			return false
@@ -129,5 +142,12 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		totalPrincipals = append(totalPrincipals, genTotalPrincipal)
 	}
 
-	return types.NewGenesisState(params, cdps, deposits, cdpID, debtDenom, govDenom, previousAccumTimes, totalPrincipals)
+	protections := k.GetAllProtections(ctx)
+	snapshots := k.GetAllInterestFactorSnapshots(ctx)
+	savingsAccumulator := k.GetSavingsAccumulator(ctx)
+	savingsDeposits := k.GetAllSavingsDeposits(ctx)
+
+	gs := types.NewGenesisState(params, cdps, deposits, cdpID, debtDenom, govDenom, previousAccumTimes, totalPrincipals, protections, snapshots, savingsAccumulator, savingsDeposits)
+	gs.ExportChecksum = types.ExportChecksum(cdps, deposits)
+	return gs
 }
@@ -131,7 +131,8 @@ func (suite *GenesisTestSuite) TestInvalidGenState() {
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
 			gs := types.NewGenesisState(tc.args.params, tc.args.cdps, tc.args.deposits, tc.args.startingID,
-				tc.args.debtDenom, tc.args.govDenom, tc.args.genAccumTimes, tc.args.genTotalPrincipals)
+				tc.args.debtDenom, tc.args.govDenom, tc.args.genAccumTimes, tc.args.genTotalPrincipals, types.Protections{}, types.InterestFactorSnapshots{},
+				types.DefaultSavingsAccumulator(), types.SavingsDeposits{})
 			err := gs.Validate()
 			if tc.errArgs.expectPass {
 				suite.Require().NoError(err)
@@ -211,6 +212,9 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 			DebtAuctionThreshold:     types.DefaultDebtThreshold,
 			DebtAuctionLot:           types.DefaultDebtLot,
 			LiquidationBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+			FeePaymentParam:          types.DefaultFeePaymentParam,
+			RedemptionFee:            types.DefaultRedemptionFee,
+			SavingsRate:              types.DefaultSavingsRate,
 			CollateralParams: types.CollateralParams{
 				{
 					Denom:                            "xrp",
@@ -223,6 +227,8 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 					SpotMarketID:                     "xrp:usd",
 					LiquidationMarketID:              "xrp:usd",
 					KeeperRewardPercentage:           d("0.01"),
+					LiquidationCloseFactor:           d("1.0"),
+					KeeperIncentive:                  i(0),
 					CheckCollateralizationIndexCount: i(10),
 					ConversionFactor:                 i(6),
 				},
@@ -237,6 +243,8 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 					SpotMarketID:                     "btc:usd",
 					LiquidationMarketID:              "btc:usd",
 					KeeperRewardPercentage:           d("0.01"),
+					LiquidationCloseFactor:           d("1.0"),
+					KeeperIncentive:                  i(0),
 					CheckCollateralizationIndexCount: i(10),
 					ConversionFactor:                 i(8),
 				},
@@ -257,7 +265,11 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 			types.NewGenesisAccumulationTime("btc-a", suite.genTime, sdk.OneDec()),
 			types.NewGenesisAccumulationTime("xrp-a", suite.genTime, sdk.OneDec()),
 		},
-		TotalPrincipals: genTotalPrincipals,
+		TotalPrincipals:    genTotalPrincipals,
+		SavingsAccumulator: types.NewSavingsAccumulator(sdk.OneDec(), suite.genTime, i(10000000)),
+		SavingsDeposits: types.SavingsDeposits{
+			types.NewSavingsDeposit(suite.addrs[1], c("usdx", 10000000), sdk.OneDec(), c("usdx", 0)),
+		},
 	}
 
 	suite.NotPanics(func() {
@@ -304,6 +316,10 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 
 	// Update CDPs
 	expectedGenesis.CDPs = suite.keeper.GetAllCdps(suite.ctx)
+	expectedGenesis.ExportChecksum = types.ExportChecksum(expectedGenesis.CDPs, expectedGenesis.Deposits)
+
+	// Update savings accrual time
+	expectedGenesis.SavingsAccumulator.PreviousAccrualTime = suite.ctx.BlockTime()
 
 	exportedGenesis := cdp.ExportGenesis(suite.ctx, suite.keeper)
 
@@ -60,6 +60,8 @@ func NewCDPGenState(cdc codec.JSONCodec, asset string, liquidationRatio sdk.Dec)
 					SpotMarketID:                     asset + ":usd",
 					LiquidationMarketID:              asset + ":usd",
 					KeeperRewardPercentage:           d("0.01"),
+					LiquidationCloseFactor:           d("1.0"),
+					KeeperIncentive:                  i(0),
 					CheckCollateralizationIndexCount: i(10),
 				},
 			},
@@ -131,6 +133,8 @@ func NewCDPGenStateMulti(cdc codec.JSONCodec) app.GenesisState {
 					SpotMarketID:                     "xrp:usd",
 					LiquidationMarketID:              "xrp:usd",
 					KeeperRewardPercentage:           d("0.01"),
+					LiquidationCloseFactor:           d("1.0"),
+					KeeperIncentive:                  i(0),
 					CheckCollateralizationIndexCount: i(10),
 					ConversionFactor:                 i(6),
 				},
@@ -145,6 +149,8 @@ func NewCDPGenStateMulti(cdc codec.JSONCodec) app.GenesisState {
 					SpotMarketID:                     "btc:usd",
 					LiquidationMarketID:              "btc:usd",
 					KeeperRewardPercentage:           d("0.01"),
+					LiquidationCloseFactor:           d("1.0"),
+					KeeperIncentive:                  i(0),
 					CheckCollateralizationIndexCount: i(10),
 					ConversionFactor:                 i(8),
 				},
@@ -3,8 +3,10 @@ package keeper
 import (
 	"fmt"
 	"sort"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -30,6 +32,10 @@ func (k Keeper) AddCdp(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coi
 	if err != nil {
 		return err
 	}
+	err = k.ValidateDrawsEnabled(ctx, collateralType)
+	if err != nil {
+		return err
+	}
 
 	err = k.ValidateDebtLimit(ctx, collateralType, principal)
 	if err != nil {
@@ -85,6 +91,7 @@ func (k Keeper) AddCdp(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coi
 	k.SetNextCdpID(ctx, id+1)
 
 	k.hooks.AfterCDPCreated(ctx, cdp)
+	k.hooks.AfterDebtChanged(ctx, owner, collateralType, principal.Amount)
 
 	// emit events for cdp creation, deposit, and draw
 	ctx.EventManager().EmitEvent(
@@ -253,6 +260,7 @@ func (k Keeper) DeleteCDP(ctx sdk.Context, cdp types.CDP) error {
 		return errorsmod.Wrapf(types.ErrDenomPrefixNotFound, "%s", cdp.Collateral.Denom)
 	}
 	store.Delete(types.CdpKey(cdp.Type, cdp.ID))
+	k.DeleteProtection(ctx, cdp.Type, cdp.ID)
 	return nil
 }
 
@@ -468,6 +476,22 @@ func (k Keeper) ValidateCollateralizationRatio(ctx sdk.Context, collateral sdk.C
 	return nil
 }
 
+// ValidateCombinedCollateralizationRatio validates that a cdp's primary and additional collateral are above the combined
+// liquidation ratio for the input principal. Cdps without additional collateral are validated by ValidateCollateralizationRatio.
+func (k Keeper) ValidateCombinedCollateralizationRatio(ctx sdk.Context, collateral sdk.Coin, additionalCollateral types.CollateralPositions, collateralType string, principal sdk.Coin, fees sdk.Coin) error {
+	if len(additionalCollateral) == 0 {
+		return k.ValidateCollateralizationRatio(ctx, collateral, collateralType, principal, fees)
+	}
+	collateralizationRatio, liquidationRatio, err := k.CalculateCombinedCollateralizationRatio(ctx, collateral, additionalCollateral, collateralType, principal, fees, spot)
+	if err != nil {
+		return err
+	}
+	if collateralizationRatio.LT(liquidationRatio) {
+		return errorsmod.Wrapf(types.ErrInvalidCollateralRatio, "collateral %s, additional collateral %s, collateral ratio %s, combined liquidation ratio %s", collateral.Denom, additionalCollateral, collateralizationRatio, liquidationRatio)
+	}
+	return nil
+}
+
 // ValidateBalance validates that the input account has sufficient spendable funds
 func (k Keeper) ValidateBalance(ctx sdk.Context, amount sdk.Coin, sender sdk.AccAddress) error {
 	acc := k.accountKeeper.GetAccount(ctx, sender)
@@ -509,7 +533,7 @@ func (k Keeper) LoadAugmentedCDP(ctx sdk.Context, cdp types.CDP) types.Augmented
 		cdp.InterestFactor = globalInterestFactor
 	}
 	// calculate collateralization ratio
-	collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, cdp.Collateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees, liquidation)
+	collateralizationRatio, _, err := k.CalculateCombinedCollateralizationRatio(ctx, cdp.Collateral, cdp.AdditionalCollateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees, liquidation)
 	if err != nil {
 		return types.AugmentedCDP{CDP: cdp}
 	}
@@ -537,17 +561,18 @@ func (k Keeper) LoadCDPResponse(ctx sdk.Context, cdp types.CDP) types.CDPRespons
 		cdp.InterestFactor = globalInterestFactor
 	}
 	// calculate collateralization ratio
-	collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, cdp.Collateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees, liquidation)
+	collateralizationRatio, _, err := k.CalculateCombinedCollateralizationRatio(ctx, cdp.Collateral, cdp.AdditionalCollateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees, liquidation)
 	if err != nil {
 		return types.CDPResponse{
-			ID:              cdp.ID,
-			Owner:           cdp.Owner.String(),
-			Type:            cdp.Type,
-			Collateral:      cdp.Collateral,
-			Principal:       cdp.Principal,
-			AccumulatedFees: cdp.AccumulatedFees,
-			FeesUpdated:     cdp.FeesUpdated,
-			InterestFactor:  cdp.InterestFactor.String(),
+			ID:                   cdp.ID,
+			Owner:                cdp.Owner.String(),
+			Type:                 cdp.Type,
+			Collateral:           cdp.Collateral,
+			Principal:            cdp.Principal,
+			AccumulatedFees:      cdp.AccumulatedFees,
+			FeesUpdated:          cdp.FeesUpdated,
+			InterestFactor:       cdp.InterestFactor.String(),
+			AdditionalCollateral: cdp.AdditionalCollateral,
 		}
 	}
 	// convert collateral value to debt coin
@@ -563,22 +588,12 @@ func (k Keeper) CalculateCollateralizationRatio(ctx sdk.Context, collateral sdk.
 	if collateral.IsZero() {
 		return sdk.ZeroDec(), nil
 	}
-	var marketID string
-	switch pfType {
-	case spot:
-		marketID = k.getSpotMarketID(ctx, collateralType)
-	case liquidation:
-		marketID = k.getliquidationMarketID(ctx, collateralType)
-	default:
-		return sdk.Dec{}, pfType.IsValid()
-	}
-
-	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+	price, err := k.getCollateralPrice(ctx, collateralType, pfType)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
 	collateralBaseUnits := k.convertCollateralToBaseUnits(ctx, collateral, collateralType)
-	collateralValue := collateralBaseUnits.Mul(price.Price)
+	collateralValue := collateralBaseUnits.Mul(price)
 
 	prinicpalBaseUnits := k.convertDebtToBaseUnits(ctx, principal)
 	principalTotal := prinicpalBaseUnits
@@ -589,15 +604,76 @@ func (k Keeper) CalculateCollateralizationRatio(ctx sdk.Context, collateral sdk.
 	return collateralRatio, nil
 }
 
+// CalculateCombinedCollateralizationRatio returns the collateralization ratio of a cdp's primary and additional collateral
+// to the input debt plus fees, and the combined liquidation ratio the cdp must stay above.
+//
+// The combined liquidation ratio is the harmonic mean of each collateral type's liquidation ratio weighted by the value of
+// its collateral, so a cdp is above it exactly when the sum of each collateral's value divided by its own liquidation ratio
+// covers the debt. A cdp without additional collateral has the liquidation ratio of its collateral type.
+func (k Keeper) CalculateCombinedCollateralizationRatio(ctx sdk.Context, collateral sdk.Coin, additionalCollateral types.CollateralPositions, collateralType string, principal sdk.Coin, fees sdk.Coin, pfType pricefeedType) (sdk.Dec, sdk.Dec, error) {
+	positions := append(types.CollateralPositions{types.NewCollateralPosition(collateralType, collateral)}, additionalCollateral...)
+
+	totalValue := sdk.ZeroDec()
+	weightedValue := sdk.ZeroDec()
+	for _, position := range positions {
+		if position.Amount.IsZero() {
+			continue
+		}
+		value, err := k.calculateCollateralValue(ctx, position.Amount, position.CollateralType, pfType)
+		if err != nil {
+			return sdk.Dec{}, sdk.Dec{}, err
+		}
+		totalValue = totalValue.Add(value)
+		weightedValue = weightedValue.Add(value.Quo(k.getLiquidationRatio(ctx, position.CollateralType)))
+	}
+
+	liquidationRatio := k.getLiquidationRatio(ctx, collateralType)
+	if weightedValue.IsPositive() {
+		liquidationRatio = totalValue.Quo(weightedValue)
+	}
+
+	principalTotal := k.convertDebtToBaseUnits(ctx, principal).Add(k.convertDebtToBaseUnits(ctx, fees))
+	return totalValue.Quo(principalTotal), liquidationRatio, nil
+}
+
+// calculateCollateralValue returns the value of the input collateral in base units of the debt
+func (k Keeper) calculateCollateralValue(ctx sdk.Context, collateral sdk.Coin, collateralType string, pfType pricefeedType) (sdk.Dec, error) {
+	price, err := k.getCollateralPrice(ctx, collateralType, pfType)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return k.convertCollateralToBaseUnits(ctx, collateral, collateralType).Mul(price), nil
+}
+
 // CalculateCollateralizationRatioFromAbsoluteRatio takes a coin's denom and an absolute ratio and returns the respective collateralization ratio
 func (k Keeper) CalculateCollateralizationRatioFromAbsoluteRatio(ctx sdk.Context, collateralType string, absoluteRatio sdk.Dec, pfType pricefeedType) (sdk.Dec, error) {
 	// get price of collateral
+	price, err := k.getCollateralPrice(ctx, collateralType, pfType)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	// convert absolute ratio to collateralization ratio
+	respectiveCollateralRatio := absoluteRatio.Quo(price)
+	return respectiveCollateralRatio, nil
+}
+
+// getCollateralPrice returns the price of a collateral type from its spot or liquidation market. The liquidation price is
+// the time-weighted average of the liquidation market when the collateral type has a liquidation twap duration.
+func (k Keeper) getCollateralPrice(ctx sdk.Context, collateralType string, pfType pricefeedType) (sdk.Dec, error) {
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		panic(fmt.Sprintf("collateral not found: %s", collateralType))
+	}
+
 	var marketID string
 	switch pfType {
 	case spot:
-		marketID = k.getSpotMarketID(ctx, collateralType)
+		marketID = cp.SpotMarketID
 	case liquidation:
-		marketID = k.getliquidationMarketID(ctx, collateralType)
+		if cp.LiquidationTwapDuration > 0 {
+			return k.pricefeedKeeper.GetTwap(ctx, cp.LiquidationMarketID, cp.LiquidationTwapDuration)
+		}
+		marketID = cp.LiquidationMarketID
 	default:
 		return sdk.Dec{}, pfType.IsValid()
 	}
@@ -606,9 +682,7 @@ func (k Keeper) CalculateCollateralizationRatioFromAbsoluteRatio(ctx sdk.Context
 	if err != nil {
 		return sdk.Dec{}, err
 	}
-	// convert absolute ratio to collateralization ratio
-	respectiveCollateralRatio := absoluteRatio.Quo(price.Price)
-	return respectiveCollateralRatio, nil
+	return price.Price, nil
 }
 
 // SetMarketStatus sets the status of the input market, true means the market is up and running, false means it is down
@@ -636,21 +710,116 @@ func (k Keeper) UpdatePricefeedStatus(ctx sdk.Context, marketID string) (ok bool
 		return false
 	}
 	k.SetMarketStatus(ctx, marketID, true)
+	k.updateMarketPriceUpdate(ctx, marketID)
 	return true
 }
 
+// SetMarketPriceUpdate sets when a price was last posted to a market
+func (k Keeper) SetMarketPriceUpdate(ctx sdk.Context, update types.MarketPriceUpdate) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MarketPriceUpdateKeyPrefix)
+	store.Set([]byte(update.MarketID), k.cdc.MustMarshal(&update))
+}
+
+// GetMarketPriceUpdate returns when a price was last posted to a market
+func (k Keeper) GetMarketPriceUpdate(ctx sdk.Context, marketID string) (types.MarketPriceUpdate, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MarketPriceUpdateKeyPrefix)
+	bz := store.Get([]byte(marketID))
+	if bz == nil {
+		return types.MarketPriceUpdate{}, false
+	}
+	var update types.MarketPriceUpdate
+	k.cdc.MustUnmarshal(bz, &update)
+	return update, true
+}
+
+// updateMarketPriceUpdate records the block time as the time a price was last posted to the market if the latest expiry
+// of its posted prices has changed, as it does when an oracle posts a new price
+func (k Keeper) updateMarketPriceUpdate(ctx sdk.Context, marketID string) {
+	var latestExpiry time.Time
+	for _, pp := range k.pricefeedKeeper.GetRawPrices(ctx, marketID) {
+		if pp.Expiry.After(latestExpiry) {
+			latestExpiry = pp.Expiry
+		}
+	}
+	update, found := k.GetMarketPriceUpdate(ctx, marketID)
+	if found && latestExpiry.Equal(update.LatestExpiry) {
+		return
+	}
+	k.SetMarketPriceUpdate(ctx, types.MarketPriceUpdate{
+		MarketID:     marketID,
+		LatestExpiry: latestExpiry,
+		UpdatedAt:    ctx.BlockTime(),
+	})
+}
+
+// GetPriceUpdated returns when a price was last posted to the less recently updated of the spot and liquidation markets
+// of a collateral type. Markets a price has not been recorded for yet are ignored.
+func (k Keeper) GetPriceUpdated(ctx sdk.Context, cp types.CollateralParam) (time.Time, bool) {
+	var updated time.Time
+	found := false
+	for _, marketID := range []string{cp.SpotMarketID, cp.LiquidationMarketID} {
+		update, ok := k.GetMarketPriceUpdate(ctx, marketID)
+		if !ok {
+			continue
+		}
+		if !found || update.UpdatedAt.Before(updated) {
+			updated = update.UpdatedAt
+		}
+		found = true
+	}
+	return updated, found
+}
+
+// IsPriceStale returns true if a price has not been posted to the spot or liquidation market of a collateral type for
+// longer than its price staleness threshold
+func (k Keeper) IsPriceStale(ctx sdk.Context, cp types.CollateralParam) bool {
+	if cp.PriceStalenessThreshold <= 0 {
+		return false
+	}
+	updated, found := k.GetPriceUpdated(ctx, cp)
+	if !found {
+		return false
+	}
+	return ctx.BlockTime().Sub(updated) > cp.PriceStalenessThreshold
+}
+
+// ValidateDrawsEnabled validates that drawing debt and withdrawing collateral are not paused for a collateral type
+// because of a stale price
+func (k Keeper) ValidateDrawsEnabled(ctx sdk.Context, collateralType string) error {
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		return errorsmod.Wrap(types.ErrCollateralNotSupported, collateralType)
+	}
+	if k.IsPriceStale(ctx, cp) {
+		return errorsmod.Wrapf(types.ErrPriceStale, "collateral type %s", collateralType)
+	}
+	return nil
+}
+
 // converts the input collateral to base units (ie multiplies the input by 10^(-ConversionFactor))
 func (k Keeper) convertCollateralToBaseUnits(ctx sdk.Context, collateral sdk.Coin, collateralType string) (baseUnits sdk.Dec) {
 	cp, _ := k.GetCollateral(ctx, collateralType)
 	return sdk.NewDecFromInt(collateral.Amount).Mul(sdk.NewDecFromIntWithPrec(sdk.OneInt(), cp.ConversionFactor.Int64()))
 }
 
+// converts the input base units to an amount of collateral (ie multiplies the input by 10^ConversionFactor), rounding up
+func (k Keeper) convertBaseUnitsToCollateral(ctx sdk.Context, baseUnits sdk.Dec, collateralType string) sdkmath.Int {
+	cp, _ := k.GetCollateral(ctx, collateralType)
+	return baseUnits.Mul(sdk.NewDecFromInt(sdkmath.NewIntWithDecimal(1, int(cp.ConversionFactor.Int64())))).Ceil().TruncateInt()
+}
+
 // converts the input debt to base units (ie multiplies the input by 10^(-ConversionFactor))
 func (k Keeper) convertDebtToBaseUnits(ctx sdk.Context, debt sdk.Coin) (baseUnits sdk.Dec) {
 	dp, _ := k.GetDebtParam(ctx, debt.Denom)
 	return sdk.NewDecFromInt(debt.Amount).Mul(sdk.NewDecFromIntWithPrec(sdk.OneInt(), dp.ConversionFactor.Int64()))
 }
 
+// converts the input base units to an amount of debt (ie multiplies the input by 10^ConversionFactor), rounding down
+func (k Keeper) convertBaseUnitsToDebt(ctx sdk.Context, baseUnits sdk.Dec, denom string) sdkmath.Int {
+	dp, _ := k.GetDebtParam(ctx, denom)
+	return baseUnits.Mul(sdk.NewDecFromInt(sdkmath.NewIntWithDecimal(1, int(dp.ConversionFactor.Int64())))).TruncateInt()
+}
+
 type pricefeedType string
 
 const (
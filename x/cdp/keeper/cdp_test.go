@@ -234,6 +234,17 @@ func (suite *CdpTestSuite) TestIterateCdpsByCollateralType() {
 	suite.Equal(uint64(3), ids[0])
 }
 
+func (suite *CdpTestSuite) TestGetTotalCollateral() {
+	suite.Equal(sdk.ZeroInt(), suite.keeper.GetTotalCollateral(suite.ctx, "xrp-a"))
+
+	for _, c := range cdps() {
+		suite.NoError(suite.keeper.SetCDP(suite.ctx, c))
+	}
+	suite.Equal(sdkmath.NewInt(1110000000), suite.keeper.GetTotalCollateral(suite.ctx, "xrp-a"))
+	suite.Equal(sdkmath.NewInt(1000000000), suite.keeper.GetTotalCollateral(suite.ctx, "btc-a"))
+	suite.Equal(sdk.ZeroInt(), suite.keeper.GetTotalCollateral(suite.ctx, "bnb-a"))
+}
+
 func (suite *CdpTestSuite) TestIterateCdpsByCollateralRatio() {
 	cdps := cdps()
 	for _, c := range cdps {
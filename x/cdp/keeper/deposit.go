@@ -61,6 +61,10 @@ func (k Keeper) WithdrawCollateral(ctx sdk.Context, owner, depositor sdk.AccAddr
 	if err != nil {
 		return err
 	}
+	err = k.ValidateDrawsEnabled(ctx, collateralType)
+	if err != nil {
+		return err
+	}
 	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
 	if !found {
 		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s, collateral %s", owner, collateral.Denom)
@@ -75,13 +79,20 @@ func (k Keeper) WithdrawCollateral(ctx sdk.Context, owner, depositor sdk.AccAddr
 	k.hooks.BeforeCDPModified(ctx, cdp)
 	cdp = k.SynchronizeInterest(ctx, cdp)
 
-	collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, cdp.Collateral.Sub(collateral), cdp.Type, cdp.Principal, cdp.AccumulatedFees, spot)
-	if err != nil {
-		return err
-	}
-	liquidationRatio := k.getLiquidationRatio(ctx, cdp.Type)
-	if collateralizationRatio.LT(liquidationRatio) {
-		return errorsmod.Wrapf(types.ErrInvalidCollateralRatio, "collateral %s, collateral ratio %s, liquidation ration %s", collateral.Denom, collateralizationRatio, liquidationRatio)
+	if cdp.HasAdditionalCollateral() {
+		err = k.ValidateCombinedCollateralizationRatio(ctx, cdp.Collateral.Sub(collateral), cdp.AdditionalCollateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees)
+		if err != nil {
+			return err
+		}
+	} else {
+		collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, cdp.Collateral.Sub(collateral), cdp.Type, cdp.Principal, cdp.AccumulatedFees, spot)
+		if err != nil {
+			return err
+		}
+		liquidationRatio := k.getLiquidationRatio(ctx, cdp.Type)
+		if collateralizationRatio.LT(liquidationRatio) {
+			return errorsmod.Wrapf(types.ErrInvalidCollateralRatio, "collateral %s, collateral ratio %s, liquidation ration %s", collateral.Denom, collateralizationRatio, liquidationRatio)
+		}
 	}
 
 	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, sdk.NewCoins(collateral))
@@ -115,6 +126,103 @@ func (k Keeper) WithdrawCollateral(ctx sdk.Context, owner, depositor sdk.AccAddr
 	return nil
 }
 
+// DepositAdditionalCollateral adds collateral of a collateral type to the owner's cdp of another collateral type,
+// where it backs the cdp debt alongside the cdp's own collateral
+func (k Keeper) DepositAdditionalCollateral(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coin, collateralType, cdpCollateralType string) error {
+	err := k.ValidateCollateral(ctx, collateral, collateralType)
+	if err != nil {
+		return err
+	}
+	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, cdpCollateralType)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s, collateral %s", owner, cdpCollateralType)
+	}
+	if cdp.Type == collateralType {
+		return errorsmod.Wrapf(types.ErrInvalidCollateral, "additional collateral type %s is the cdp collateral type", collateralType)
+	}
+	err = k.ValidateBalance(ctx, collateral, owner)
+	if err != nil {
+		return err
+	}
+	k.hooks.BeforeCDPModified(ctx, cdp)
+	cdp = k.SynchronizeInterest(ctx, cdp)
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(collateral))
+	if err != nil {
+		return err
+	}
+
+	cdp.AdditionalCollateral = cdp.AdditionalCollateral.Add(collateralType, collateral)
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpDeposit,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, collateral.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+		),
+	)
+
+	return k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
+}
+
+// WithdrawAdditionalCollateral removes additional collateral of a collateral type from the owner's cdp of another
+// collateral type if it does not put the cdp below the combined liquidation ratio
+func (k Keeper) WithdrawAdditionalCollateral(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coin, collateralType, cdpCollateralType string) error {
+	err := k.ValidateCollateral(ctx, collateral, collateralType)
+	if err != nil {
+		return err
+	}
+	// the withdrawal is valued at the prices of both the cdp's and the additional collateral type
+	for _, ct := range []string{cdpCollateralType, collateralType} {
+		err = k.ValidateDrawsEnabled(ctx, ct)
+		if err != nil {
+			return err
+		}
+	}
+	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, cdpCollateralType)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s, collateral %s", owner, cdpCollateralType)
+	}
+	position, found := cdp.AdditionalCollateral.Get(collateralType)
+	if !found {
+		return errorsmod.Wrapf(types.ErrDepositNotFound, "cdp %d has no additional collateral %s", cdp.ID, collateralType)
+	}
+	if collateral.Amount.GT(position.Amount.Amount) {
+		return errorsmod.Wrapf(types.ErrInvalidWithdrawAmount, "collateral %s, additional collateral %s", collateral, position.Amount)
+	}
+	k.hooks.BeforeCDPModified(ctx, cdp)
+	cdp = k.SynchronizeInterest(ctx, cdp)
+
+	additionalCollateral := cdp.AdditionalCollateral.Sub(collateralType, collateral)
+	err = k.ValidateCombinedCollateralizationRatio(ctx, cdp.Collateral, additionalCollateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees)
+	if err != nil {
+		return err
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(collateral))
+	if err != nil {
+		panic(err)
+	}
+
+	cdp.AdditionalCollateral = additionalCollateral
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
+	err = k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpWithdrawal,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, collateral.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+		),
+	)
+
+	return nil
+}
+
 // GetDeposit returns the deposit of a depositor on a particular cdp from the store
 func (k Keeper) GetDeposit(ctx sdk.Context, cdpID uint64, depositor sdk.AccAddress) (deposit types.Deposit, found bool) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositKeyPrefix)
@@ -134,6 +134,45 @@ func (suite *DepositTestSuite) TestWithdrawCollateral() {
 	suite.Require().True(errors.Is(err, types.ErrDepositNotFound))
 }
 
+func (suite *DepositTestSuite) TestAdditionalCollateral() {
+	bk := suite.app.GetBankKeeper()
+
+	err := suite.keeper.DepositAdditionalCollateral(suite.ctx, suite.addrs[0], c("btc", 1000000), "btc-a", "bnb-a")
+	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
+	err = suite.keeper.DepositAdditionalCollateral(suite.ctx, suite.addrs[0], c("xrp", 1000000), "xrp-a", "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrInvalidCollateral))
+
+	// $100 of xrp at a 2.0 liquidation ratio and $80 of btc at a 1.5 liquidation ratio back up to ~103.33 usdx
+	err = suite.keeper.DepositAdditionalCollateral(suite.ctx, suite.addrs[0], c("btc", 1000000), "btc-a", "xrp-a")
+	suite.NoError(err)
+	cd, _ := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(1))
+	suite.Equal(c("xrp", 400000000), cd.Collateral)
+	suite.Equal(types.CollateralPositions{types.NewCollateralPosition("btc-a", c("btc", 1000000))}, cd.AdditionalCollateral)
+	suite.Equal(i(499000000), bk.GetBalance(suite.ctx, suite.addrs[0], "btc").Amount)
+
+	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("usdx", 94000000))
+	suite.Require().True(errors.Is(err, types.ErrInvalidCollateralRatio))
+	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("usdx", 90000000))
+	suite.NoError(err)
+
+	err = suite.keeper.WithdrawAdditionalCollateral(suite.ctx, suite.addrs[0], c("btc", 1000001), "btc-a", "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrInvalidWithdrawAmount))
+	err = suite.keeper.WithdrawAdditionalCollateral(suite.ctx, suite.addrs[0], c("btc", 1000000), "btc-a", "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrInvalidCollateralRatio))
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 30000000), "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrInvalidCollateralRatio))
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 20000000), "xrp-a")
+	suite.NoError(err)
+
+	// repaying all debt returns the additional collateral to the owner
+	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("usdx", 100000000))
+	suite.NoError(err)
+	_, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(1))
+	suite.False(found)
+	suite.Equal(i(500000000), bk.GetBalance(suite.ctx, suite.addrs[0], "btc").Amount)
+	suite.Equal(i(500000000), bk.GetBalance(suite.ctx, suite.addrs[0], "xrp").Amount)
+}
+
 func TestDepositTestSuite(t *testing.T) {
 	suite.Run(t, new(DepositTestSuite))
 }
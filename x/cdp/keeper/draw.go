@@ -4,9 +4,11 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/cdp/types"
+	communitytypes "github.com/kava-labs/kava/x/community/types"
 )
 
 // AddPrincipal adds debt to a cdp if the additional debt does not put the cdp below the liquidation ratio
@@ -20,6 +22,10 @@ func (k Keeper) AddPrincipal(ctx sdk.Context, owner sdk.AccAddress, collateralTy
 	if err != nil {
 		return err
 	}
+	err = k.ValidateDrawsEnabled(ctx, cdp.Type)
+	if err != nil {
+		return err
+	}
 
 	err = k.ValidateDebtLimit(ctx, cdp.Type, principal)
 	if err != nil {
@@ -28,7 +34,7 @@ func (k Keeper) AddPrincipal(ctx sdk.Context, owner sdk.AccAddress, collateralTy
 	k.hooks.BeforeCDPModified(ctx, cdp)
 	cdp = k.SynchronizeInterest(ctx, cdp)
 
-	err = k.ValidateCollateralizationRatio(ctx, cdp.Collateral, cdp.Type, cdp.Principal.Add(principal), cdp.AccumulatedFees)
+	err = k.ValidateCombinedCollateralizationRatio(ctx, cdp.Collateral, cdp.AdditionalCollateral, cdp.Type, cdp.Principal.Add(principal), cdp.AccumulatedFees)
 	if err != nil {
 		return err
 	}
@@ -63,12 +69,73 @@ func (k Keeper) AddPrincipal(ctx sdk.Context, owner sdk.AccAddress, collateralTy
 
 	// increment total principal for the input collateral type
 	k.IncrementTotalPrincipal(ctx, cdp.Type, principal)
+	k.hooks.AfterDebtChanged(ctx, cdp.Owner, cdp.Type, principal.Amount)
 
 	// set cdp state and indexes in the store
 	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
 	return k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
 }
 
+// ValidateDraw validates that drawing the input principal from a cdp would succeed, checking the same conditions as
+// AddPrincipal. The cdp's fees must already be synchronized.
+func (k Keeper) ValidateDraw(ctx sdk.Context, cdp types.CDP, principal sdk.Coin) error {
+	err := k.ValidatePrincipalDraw(ctx, principal, cdp.Principal.Denom)
+	if err != nil {
+		return err
+	}
+	err = k.ValidateDrawsEnabled(ctx, cdp.Type)
+	if err != nil {
+		return err
+	}
+	err = k.ValidateDebtLimit(ctx, cdp.Type, principal)
+	if err != nil {
+		return err
+	}
+	return k.ValidateCombinedCollateralizationRatio(ctx, cdp.Collateral, cdp.AdditionalCollateral, cdp.Type, cdp.Principal.Add(principal), cdp.AccumulatedFees)
+}
+
+// CalculateMaxDraw returns the most principal a cdp can draw at the current spot prices without exceeding the debt
+// limits. The cdp's fees must already be synchronized.
+func (k Keeper) CalculateMaxDraw(ctx sdk.Context, cdp types.CDP) (sdk.Coin, error) {
+	maxDraw := sdk.NewCoin(cdp.Principal.Denom, sdk.ZeroInt())
+	if k.ValidateDrawsEnabled(ctx, cdp.Type) != nil {
+		return maxDraw, nil
+	}
+	cp, found := k.GetCollateral(ctx, cdp.Type)
+	if !found {
+		return maxDraw, errorsmod.Wrap(types.ErrCollateralNotSupported, cdp.Type)
+	}
+
+	// the debt each collateral position can back is its value divided by its own liquidation ratio
+	positions := append(types.CollateralPositions{types.NewCollateralPosition(cdp.Type, cdp.Collateral)}, cdp.AdditionalCollateral...)
+	debtCapacity := sdk.ZeroDec()
+	for _, position := range positions {
+		if position.Amount.IsZero() {
+			continue
+		}
+		value, err := k.calculateCollateralValue(ctx, position.Amount, position.CollateralType, spot)
+		if err != nil {
+			return maxDraw, err
+		}
+		debtCapacity = debtCapacity.Add(value.Quo(k.getLiquidationRatio(ctx, position.CollateralType)))
+	}
+
+	amount := k.convertBaseUnitsToDebt(ctx, debtCapacity, cdp.Principal.Denom).Sub(cdp.GetTotalPrincipal().Amount)
+
+	totalPrincipal := k.GetTotalPrincipal(ctx, cdp.Type, cdp.Principal.Denom)
+	amount = sdk.MinInt(amount, cp.DebtLimit.Amount.Sub(totalPrincipal))
+	amount = sdk.MinInt(amount, k.GetParams(ctx).GlobalDebtLimit.Amount.Sub(totalPrincipal))
+
+	// step down past any rounding differences from the ratio check made when drawing
+	for amount.IsPositive() && k.ValidateDraw(ctx, cdp, sdk.NewCoin(cdp.Principal.Denom, amount)) != nil {
+		amount = amount.SubRaw(1)
+	}
+	if amount.IsPositive() {
+		maxDraw.Amount = amount
+	}
+	return maxDraw, nil
+}
+
 // RepayPrincipal removes debt from the cdp
 // If all debt is repaid, the collateral is returned to depositors and the cdp is removed from the store
 func (k Keeper) RepayPrincipal(ctx sdk.Context, owner sdk.AccAddress, collateralType string, payment sdk.Coin) error {
@@ -135,6 +202,8 @@ func (k Keeper) RepayPrincipal(ctx sdk.Context, owner sdk.AccAddress, collateral
 			types.EventTypeCdpRepay,
 			sdk.NewAttribute(sdk.AttributeKeyAmount, feePayment.Add(principalPayment).String()),
 			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyFeePayment, feePayment.String()),
+			sdk.NewAttribute(types.AttributeKeyPrincipalPayment, principalPayment.String()),
 		),
 	)
 
@@ -148,6 +217,7 @@ func (k Keeper) RepayPrincipal(ctx sdk.Context, owner sdk.AccAddress, collateral
 
 	// decrement the total principal for the input collateral type
 	k.DecrementTotalPrincipal(ctx, cdp.Type, feePayment.Add(principalPayment))
+	k.hooks.AfterDebtChanged(ctx, cdp.Owner, cdp.Type, feePayment.Add(principalPayment).Amount.Neg())
 
 	// if the debt is fully paid, return collateral to depositors,
 	// and remove the cdp and indexes from the store
@@ -174,6 +244,86 @@ func (k Keeper) RepayPrincipal(ctx sdk.Context, owner sdk.AccAddress, collateral
 	return k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
 }
 
+// RepayFees pays the accumulated fees of a cdp in the asset of the fee payment param, at the price of its market.
+// The payment is burned or sent to the community pool, and the surplus debt asset minted when the fees accrued is
+// burned from the liquidator module account, as if it had been sold in a surplus auction.
+func (k Keeper) RepayFees(ctx sdk.Context, owner sdk.AccAddress, collateralType string, feeDenom string) error {
+	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s, denom %s", owner, collateralType)
+	}
+	fp, enabled := k.GetFeePaymentParam(ctx)
+	if !enabled || fp.Denom != feeDenom {
+		return errorsmod.Wrapf(types.ErrInvalidPayment, "cdp %d: fees cannot be paid in %s", cdp.ID, feeDenom)
+	}
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, fp.MarketID)
+	if err != nil {
+		return err
+	}
+
+	k.hooks.BeforeCDPModified(ctx, cdp)
+	cdp = k.SynchronizeInterest(ctx, cdp)
+
+	feePayment := cdp.AccumulatedFees
+	if !feePayment.IsPositive() {
+		return nil
+	}
+
+	// value of the fees divided by the price of the fee asset, multiplied by 10^ConversionFactor and rounded up
+	feeValue := k.convertDebtToBaseUnits(ctx, feePayment)
+	amount := feeValue.Quo(price.Price).Mul(sdk.NewDecFromInt(sdkmath.NewIntWithDecimal(1, int(fp.ConversionFactor.Int64())))).Ceil().TruncateInt()
+	payment := sdk.NewCoin(fp.Denom, amount)
+
+	err = k.ValidateBalance(ctx, payment, owner)
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(payment))
+	if err != nil {
+		return err
+	}
+	if fp.Burn {
+		err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(payment))
+	} else {
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, communitytypes.ModuleAccountName, sdk.NewCoins(payment))
+	}
+	if err != nil {
+		return err
+	}
+
+	// burn the corresponding amount of debt coins
+	debtDenom := k.GetDebtDenom(ctx)
+	coinsToBurn := sdk.NewCoin(debtDenom, sdk.MinInt(feePayment.Amount, k.getModAccountDebt(ctx, types.ModuleName)))
+	if err := k.BurnDebtCoins(ctx, types.ModuleName, debtDenom, coinsToBurn); err != nil {
+		panic(err)
+	}
+
+	// burn the surplus minted for the fees, which is no longer needed to cover them
+	surplus := sdk.NewCoin(feePayment.Denom, sdk.MinInt(feePayment.Amount, k.GetTotalSurplus(ctx, types.LiquidatorMacc)))
+	if surplus.IsPositive() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(surplus)); err != nil {
+			panic(err)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpRepay,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, payment.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyFeePayment, feePayment.String()),
+			sdk.NewAttribute(types.AttributeKeyPrincipalPayment, sdk.NewCoin(feePayment.Denom, sdk.ZeroInt()).String()),
+		),
+	)
+
+	cdp.AccumulatedFees = cdp.AccumulatedFees.Sub(feePayment)
+	k.DecrementTotalPrincipal(ctx, cdp.Type, feePayment)
+	k.hooks.AfterDebtChanged(ctx, cdp.Owner, cdp.Type, feePayment.Amount.Neg())
+
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
+	return k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
+}
+
 // ValidatePaymentCoins validates that the input coins are valid for repaying debt
 func (k Keeper) ValidatePaymentCoins(ctx sdk.Context, cdp types.CDP, payment sdk.Coin) error {
 	debt := cdp.GetTotalPrincipal()
@@ -187,7 +337,8 @@ func (k Keeper) ValidatePaymentCoins(ctx sdk.Context, cdp types.CDP, payment sdk
 	return nil
 }
 
-// ReturnCollateral returns collateral to depositors on a cdp and removes deposits from the store
+// ReturnCollateral returns collateral to depositors on a cdp and removes deposits from the store.
+// Additional collateral is returned to the cdp owner.
 func (k Keeper) ReturnCollateral(ctx sdk.Context, cdp types.CDP) {
 	deposits := k.GetDeposits(ctx, cdp.ID)
 	for _, deposit := range deposits {
@@ -196,6 +347,11 @@ func (k Keeper) ReturnCollateral(ctx sdk.Context, cdp types.CDP) {
 		}
 		k.DeleteDeposit(ctx, cdp.ID, deposit.Depositor)
 	}
+	for _, position := range cdp.AdditionalCollateral {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cdp.Owner, sdk.NewCoins(position.Amount)); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // calculatePayment divides the input payment into the portions that will be used to repay fees and principal
@@ -15,6 +15,7 @@ import (
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/cdp/keeper"
 	"github.com/kava-labs/kava/x/cdp/types"
+	communitytypes "github.com/kava-labs/kava/x/community/types"
 )
 
 type DrawTestSuite struct {
@@ -138,6 +139,51 @@ func (suite *DrawTestSuite) TestRepayPrincipalOverpay() {
 	suite.False(found)
 }
 
+func (suite *DrawTestSuite) TestRepayFees() {
+	err := suite.keeper.AccumulateInterest(suite.ctx, "xrp-a")
+	suite.Require().NoError(err)
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour * 24 * 365))
+	err = suite.keeper.AccumulateInterest(ctx, "xrp-a")
+	suite.Require().NoError(err)
+
+	// fees can only be paid in the debt asset until the fee payment param is set
+	err = suite.keeper.RepayFees(ctx, suite.addrs[0], "xrp-a", "btc")
+	suite.Require().True(errors.Is(err, types.ErrInvalidPayment))
+
+	params := suite.keeper.GetParams(ctx)
+	params.FeePaymentParam = types.NewFeePaymentParam("btc", "btc:usd", i(8), false)
+	suite.keeper.SetParams(ctx, params)
+
+	err = suite.keeper.RepayFees(ctx, suite.addrs[0], "xrp-a", "ukava")
+	suite.Require().True(errors.Is(err, types.ErrInvalidPayment))
+
+	cdp, found := suite.keeper.GetCDP(ctx, "xrp-a", 1)
+	suite.Require().True(found)
+	cdp = suite.keeper.SynchronizeInterest(ctx, cdp)
+	fees := cdp.AccumulatedFees
+	suite.Require().True(fees.IsPositive())
+
+	err = suite.keeper.RepayFees(ctx, suite.addrs[0], "xrp-a", "btc")
+	suite.Require().NoError(err)
+
+	cdp, found = suite.keeper.GetCDP(ctx, "xrp-a", 1)
+	suite.Require().True(found)
+	suite.Equal(c("usdx", 10000000), cdp.Principal)
+	suite.Equal(c("usdx", 0), cdp.AccumulatedFees)
+	suite.Equal(i(10000000), suite.keeper.GetTotalPrincipal(ctx, "xrp-a", "usdx"))
+
+	// fees are paid at 8000 usd per btc, rounded up
+	payment := sdk.NewDecFromInt(fees.Amount).QuoInt64(1000000).QuoInt64(8000).MulInt64(100000000).Ceil().TruncateInt()
+	ak := suite.app.GetAccountKeeper()
+	bk := suite.app.GetBankKeeper()
+	suite.Equal(i(500000000).Sub(payment), bk.GetBalance(ctx, suite.addrs[0], "btc").Amount)
+	suite.Equal(payment, bk.GetBalance(ctx, ak.GetModuleAddress(communitytypes.ModuleAccountName), "btc").Amount)
+
+	// the debt coins and surplus minted for the fees are burned
+	suite.Equal(cs(c("xrp", 400000000), c("debt", 10000000)), bk.GetAllBalances(ctx, ak.GetModuleAddress(types.ModuleName)))
+	suite.Equal(i(0), suite.keeper.GetTotalSurplus(ctx, types.LiquidatorMacc))
+}
+
 func (suite *DrawTestSuite) TestPricefeedFailure() {
 	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour * 2))
 	pfk := suite.app.GetPriceFeedKeeper()
@@ -150,6 +196,78 @@ func (suite *DrawTestSuite) TestPricefeedFailure() {
 	suite.NoError(err)
 }
 
+func (suite *DrawTestSuite) TestStalePrice() {
+	pfk := suite.app.GetPriceFeedKeeper()
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CollateralParams[0].PriceStalenessThreshold = 10 * time.Minute
+	suite.keeper.SetParams(suite.ctx, params)
+
+	postPrice := func(ctx sdk.Context) {
+		_, err := pfk.SetPrice(ctx, sdk.AccAddress{}, "xrp:usd", d("0.25"), ctx.BlockTime().Add(time.Hour))
+		suite.Require().NoError(err)
+		_, err = pfk.SetPrice(ctx, sdk.AccAddress{}, "xrp:usd:30", d("0.25"), ctx.BlockTime().Add(time.Hour))
+		suite.Require().NoError(err)
+		suite.Require().True(suite.keeper.UpdatePricefeedStatus(ctx, "xrp:usd"))
+		suite.Require().True(suite.keeper.UpdatePricefeedStatus(ctx, "xrp:usd:30"))
+	}
+	postPrice(suite.ctx)
+
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(5 * time.Minute))
+	err := suite.keeper.AddPrincipal(ctx, suite.addrs[0], "xrp-a", c("usdx", 10000000))
+	suite.Require().NoError(err)
+
+	// the current price is still valid, but no price has been posted within the threshold
+	ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(20 * time.Minute))
+	suite.Require().True(suite.keeper.UpdatePricefeedStatus(ctx, "xrp:usd"))
+	err = suite.keeper.AddPrincipal(ctx, suite.addrs[0], "xrp-a", c("usdx", 10000000))
+	suite.Require().True(errors.Is(err, types.ErrPriceStale))
+	err = suite.keeper.AddCdp(ctx, suite.addrs[1], c("xrp", 100000000), c("usdx", 10000000), "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrPriceStale))
+	err = suite.keeper.WithdrawCollateral(ctx, suite.addrs[0], suite.addrs[0], c("xrp", 10000000), "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrPriceStale))
+
+	// repayments and deposits are not paused
+	err = suite.keeper.RepayPrincipal(ctx, suite.addrs[0], "xrp-a", c("usdx", 5000000))
+	suite.Require().NoError(err)
+	err = suite.keeper.DepositCollateral(ctx, suite.addrs[0], suite.addrs[0], c("xrp", 10000000), "xrp-a")
+	suite.Require().NoError(err)
+
+	// other collateral types are not paused
+	err = suite.keeper.AddCdp(ctx, suite.addrs[0], c("btc", 100000000), c("usdx", 10000000), "btc-a")
+	suite.Require().NoError(err)
+
+	// a new price unpauses draws and withdrawals
+	postPrice(ctx)
+	err = suite.keeper.AddPrincipal(ctx, suite.addrs[0], "xrp-a", c("usdx", 10000000))
+	suite.Require().NoError(err)
+	err = suite.keeper.WithdrawCollateral(ctx, suite.addrs[0], suite.addrs[0], c("xrp", 10000000), "xrp-a")
+	suite.Require().NoError(err)
+}
+
+func (suite *DrawTestSuite) TestCalculateMaxDraw() {
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(1))
+	suite.Require().True(found)
+
+	// 400 xrp at 0.25 backs 50 usdx at a liquidation ratio of 2.0
+	maxDraw, err := suite.keeper.CalculateMaxDraw(suite.ctx, cdp)
+	suite.Require().NoError(err)
+	suite.Equal(c("usdx", 40000000), maxDraw)
+	suite.NoError(suite.keeper.ValidateDraw(suite.ctx, cdp, maxDraw))
+	suite.ErrorIs(suite.keeper.ValidateDraw(suite.ctx, cdp, maxDraw.AddAmount(i(1))), types.ErrInvalidCollateralRatio)
+
+	// the max draw is capped by the collateral type's debt limit
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CollateralParams[0].DebtLimit = c("usdx", 30000000)
+	suite.Require().Equal("xrp-a", params.CollateralParams[0].Type)
+	suite.keeper.SetParams(suite.ctx, params)
+
+	maxDraw, err = suite.keeper.CalculateMaxDraw(suite.ctx, cdp)
+	suite.Require().NoError(err)
+	suite.Equal(c("usdx", 20000000), maxDraw)
+	suite.NoError(suite.keeper.ValidateDraw(suite.ctx, cdp, maxDraw))
+	suite.ErrorIs(suite.keeper.ValidateDraw(suite.ctx, cdp, maxDraw.AddAmount(i(1))), types.ErrExceedsDebtLimit)
+}
+
 func (suite *DrawTestSuite) TestModuleAccountFailure() {
 	ctx := suite.ctx.WithBlockHeader(suite.ctx.BlockHeader())
 	ak := suite.app.GetAccountKeeper()
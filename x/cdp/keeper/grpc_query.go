@@ -5,6 +5,8 @@ import (
 	"sort"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -139,6 +141,7 @@ func (s QueryServer) TotalCollateral(c context.Context, req *types.QueryTotalCol
 			for _, cdp := range cdps {
 				collateral = collateral.Add(cdp.Collateral.Amount)
 			}
+			collateral = collateral.Add(s.keeper.GetTotalAdditionalCollateral(ctx, collateralTypes[i]))
 
 			totalCollateral = totalCollateral.Sub(collateral)
 
@@ -238,6 +241,142 @@ func (s QueryServer) Deposits(c context.Context, req *types.QueryDepositsRequest
 	}, nil
 }
 
+// Protection queries the liquidation protection of the CDP owned by an address for a collateral type.
+func (s QueryServer) Protection(c context.Context, req *types.QueryProtectionRequest) (*types.QueryProtectionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address")
+	}
+
+	_, valid := s.keeper.GetCollateral(ctx, req.CollateralType)
+	if !valid {
+		return nil, errorsmod.Wrap(types.ErrInvalidCollateral, req.CollateralType)
+	}
+
+	cdp, found := s.keeper.GetCdpByOwnerAndCollateralType(ctx, owner, req.CollateralType)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s, denom %s", req.Owner, req.CollateralType)
+	}
+
+	protection, found := s.keeper.GetProtection(ctx, cdp.Type, cdp.ID)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrProtectionNotFound, "cdp %d", cdp.ID)
+	}
+
+	return &types.QueryProtectionResponse{
+		Protection: protection,
+	}, nil
+}
+
+// DebtLimits queries the total principal of each collateral type against its debt limit, and the total principal
+// of all collateral types against the global debt limit.
+func (s QueryServer) DebtLimits(c context.Context, req *types.QueryDebtLimitsRequest) (*types.QueryDebtLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	params := s.keeper.GetParams(ctx)
+	if req.CollateralType != "" {
+		if _, found := s.keeper.GetCollateral(ctx, req.CollateralType); !found {
+			return nil, errorsmod.Wrap(types.ErrInvalidCollateral, req.CollateralType)
+		}
+	}
+
+	globalPrincipal := sdk.ZeroInt()
+	var debtLimits types.CollateralDebtLimits
+	for _, cp := range params.CollateralParams {
+		// Hardcoded to default USDX
+		principal := s.keeper.GetTotalPrincipal(ctx, cp.Type, types.DefaultStableDenom)
+		globalPrincipal = globalPrincipal.Add(principal)
+
+		if req.CollateralType != "" && cp.Type != req.CollateralType {
+			continue
+		}
+		debtLimits = append(debtLimits, types.CollateralDebtLimit{
+			CollateralType: cp.Type,
+			TotalPrincipal: sdk.NewCoin(types.DefaultStableDenom, principal),
+			DebtLimit:      cp.DebtLimit,
+			Utilization:    debtUtilization(principal, cp.DebtLimit.Amount).String(),
+		})
+	}
+
+	return &types.QueryDebtLimitsResponse{
+		DebtLimits:        debtLimits,
+		GlobalPrincipal:   sdk.NewCoin(types.DefaultStableDenom, globalPrincipal),
+		GlobalDebtLimit:   params.GlobalDebtLimit,
+		GlobalUtilization: debtUtilization(globalPrincipal, params.GlobalDebtLimit.Amount).String(),
+	}, nil
+}
+
+// StabilityFees queries the stability fee of each collateral type as a per second rate and its annual equivalents.
+func (s QueryServer) StabilityFees(c context.Context, req *types.QueryStabilityFeesRequest) (*types.QueryStabilityFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	params := s.keeper.GetParams(ctx)
+	if req.CollateralType != "" {
+		if _, found := s.keeper.GetCollateral(ctx, req.CollateralType); !found {
+			return nil, errorsmod.Wrap(types.ErrInvalidCollateral, req.CollateralType)
+		}
+	}
+
+	var stabilityFees types.CollateralStabilityFees
+	for _, cp := range params.CollateralParams {
+		if req.CollateralType != "" && cp.Type != req.CollateralType {
+			continue
+		}
+		stabilityFees = append(stabilityFees, types.CollateralStabilityFee{
+			CollateralType: cp.Type,
+			StabilityFee:   cp.StabilityFee.String(),
+			APR:            StabilityFeeAPR(cp.StabilityFee).String(),
+			APY:            StabilityFeeAPY(cp.StabilityFee).String(),
+		})
+	}
+
+	return &types.QueryStabilityFeesResponse{
+		StabilityFees: stabilityFees,
+	}, nil
+}
+
+// PriceStatuses queries when prices were last posted for each collateral type and whether drawing debt is paused.
+func (s QueryServer) PriceStatuses(c context.Context, req *types.QueryPriceStatusesRequest) (*types.QueryPriceStatusesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	params := s.keeper.GetParams(ctx)
+	if req.CollateralType != "" {
+		if _, found := s.keeper.GetCollateral(ctx, req.CollateralType); !found {
+			return nil, errorsmod.Wrap(types.ErrInvalidCollateral, req.CollateralType)
+		}
+	}
+
+	var priceStatuses types.CollateralPriceStatuses
+	for _, cp := range params.CollateralParams {
+		if req.CollateralType != "" && cp.Type != req.CollateralType {
+			continue
+		}
+		pricefeedUp := s.keeper.GetMarketStatus(ctx, cp.SpotMarketID) && s.keeper.GetMarketStatus(ctx, cp.LiquidationMarketID)
+		updated, _ := s.keeper.GetPriceUpdated(ctx, cp)
+		priceStatuses = append(priceStatuses, types.CollateralPriceStatus{
+			CollateralType:          cp.Type,
+			PricefeedUp:             pricefeedUp,
+			PriceUpdated:            updated,
+			PriceStalenessThreshold: cp.PriceStalenessThreshold,
+			DrawsPaused:             !pricefeedUp || s.keeper.IsPriceStale(ctx, cp),
+		})
+	}
+
+	return &types.QueryPriceStatusesResponse{
+		PriceStatuses: priceStatuses,
+	}, nil
+}
+
+// debtUtilization returns the fraction of a debt limit used by the input principal, zero if the limit is zero
+func debtUtilization(principal, debtLimit sdkmath.Int) sdk.Dec {
+	if !debtLimit.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(principal).QuoInt(debtLimit)
+}
+
 // FilterCDPs queries the store for all CDPs that match query req
 func GrpcFilterCDPs(ctx sdk.Context, k Keeper, req types.QueryCdpsRequest) (types.CDPResponses, error) {
 	// TODO: Ideally use query.Paginate() here over existing FilterCDPs. However
@@ -295,3 +434,268 @@ func GrpcFilterCDPs(ctx sdk.Context, k Keeper, req types.QueryCdpsRequest) (type
 
 	return cdpResponses, nil
 }
+
+// InterestFactorSnapshots queries the recorded snapshots of the interest factor of collateral types.
+func (s QueryServer) InterestFactorSnapshots(c context.Context, req *types.QueryInterestFactorSnapshotsRequest) (*types.QueryInterestFactorSnapshotsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(s.keeper.key), types.InterestFactorSnapshotPrefix)
+	if req.CollateralType != "" {
+		if _, found := s.keeper.GetCollateral(ctx, req.CollateralType); !found {
+			return nil, errorsmod.Wrap(types.ErrInvalidCollateral, req.CollateralType)
+		}
+		store = prefix.NewStore(store, types.DenomIterKey(req.CollateralType))
+	}
+
+	var snapshots types.InterestFactorSnapshots
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var snapshot types.InterestFactorSnapshot
+		if err := s.keeper.cdc.Unmarshal(value, &snapshot); err != nil {
+			return err
+		}
+		snapshots = append(snapshots, snapshot)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInterestFactorSnapshotsResponse{
+		Snapshots:  snapshots,
+		Pagination: pageRes,
+	}, nil
+}
+
+// AccruedFees queries the fees accrued by a cdp between two block heights, using interest factor snapshots.
+func (s QueryServer) AccruedFees(c context.Context, req *types.QueryAccruedFeesRequest) (*types.QueryAccruedFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address")
+	}
+	if req.EndHeight != 0 && req.EndHeight < req.StartHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "end height %d is before start height %d", req.EndHeight, req.StartHeight)
+	}
+
+	_, valid := s.keeper.GetCollateral(ctx, req.CollateralType)
+	if !valid {
+		return nil, errorsmod.Wrap(types.ErrInvalidCollateral, req.CollateralType)
+	}
+
+	cdp, found := s.keeper.GetCdpByOwnerAndCollateralType(ctx, owner, req.CollateralType)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s, denom %s", req.Owner, req.CollateralType)
+	}
+
+	start, found := s.keeper.GetInterestFactorSnapshotAtHeight(ctx, req.CollateralType, req.StartHeight)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInterestFactorSnapshotNotFound, "collateral type %s, height %d", req.CollateralType, req.StartHeight)
+	}
+
+	var end types.InterestFactorSnapshot
+	if req.EndHeight == 0 {
+		interestFactor, _ := s.keeper.GetInterestFactor(ctx, req.CollateralType)
+		end = types.NewInterestFactorSnapshot(req.CollateralType, ctx.BlockHeight(), ctx.BlockTime(), interestFactor)
+	} else {
+		end, found = s.keeper.GetInterestFactorSnapshotAtHeight(ctx, req.CollateralType, req.EndHeight)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrInterestFactorSnapshotNotFound, "collateral type %s, height %d", req.CollateralType, req.EndHeight)
+		}
+	}
+
+	return &types.QueryAccruedFeesResponse{
+		Fees:  s.keeper.CalculateAccruedFees(cdp, start, end),
+		Start: start,
+		End:   end,
+	}, nil
+}
+
+// LiquidationQueue queries the cdps of a collateral type within a margin of their liquidation ratio, lowest
+// collateralized first, by iterating the collateral ratio index.
+func (s QueryServer) LiquidationQueue(c context.Context, req *types.QueryLiquidationQueueRequest) (*types.QueryLiquidationQueueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	cp, found := s.keeper.GetCollateral(ctx, req.CollateralType)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrInvalidCollateral, req.CollateralType)
+	}
+
+	margin := sdk.ZeroDec()
+	if req.Margin != "" {
+		var err error
+		margin, err = sdk.NewDecFromStr(req.Margin)
+		if err != nil || margin.IsNegative() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid margin")
+		}
+	}
+	targetRatio := cp.LiquidationRatio.Mul(sdk.OneDec().Add(margin))
+
+	// the index is ordered by collateral:debt ratio, so the target ratio is normalized by the liquidation price
+	normalizedRatio, err := s.keeper.CalculateCollateralizationRatioFromAbsoluteRatio(ctx, req.CollateralType, targetRatio, liquidation)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrPricefeedDown, cp.LiquidationMarketID)
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	store := prefix.NewStore(ctx.KVStore(s.keeper.key), types.CollateralRatioIndexPrefix)
+	start := types.CollateralRatioIterKey(req.CollateralType, sdk.ZeroDec())
+	if pageReq.Key != nil {
+		start = pageReq.Key
+	}
+	iterator := store.Iterator(start, types.CollateralRatioIterKey(req.CollateralType, normalizedRatio))
+	defer iterator.Close()
+
+	var cdps types.CDPResponses
+	var nextKey []byte
+	var count uint64
+	for ; iterator.Valid(); iterator.Next() {
+		_, id, _ := types.SplitCollateralRatioKey(iterator.Key())
+		cdp, found := s.keeper.GetCDP(ctx, req.CollateralType, id)
+		if !found {
+			return nil, status.Errorf(codes.Internal, "cdp %d does not exist", id)
+		}
+		// cdps are indexed by their primary collateral only, so cdps with additional collateral are checked against
+		// the target ratio with their combined collateralization ratio
+		if cdp.HasAdditionalCollateral() {
+			ratio, _, err := s.keeper.CalculateCombinedCollateralizationRatio(ctx, cdp.Collateral, cdp.AdditionalCollateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees, liquidation)
+			if err != nil || ratio.GTE(targetRatio) {
+				continue
+			}
+		}
+
+		count++
+		if count <= pageReq.Offset {
+			continue
+		}
+		if uint64(len(cdps)) == limit {
+			nextKey = iterator.Key()
+			if !pageReq.CountTotal {
+				break
+			}
+			continue
+		}
+		cdps = append(cdps, s.keeper.LoadCDPResponse(ctx, cdp))
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if pageReq.CountTotal {
+		pageRes.Total = count
+	}
+
+	return &types.QueryLiquidationQueueResponse{
+		Cdps:       cdps,
+		Pagination: pageRes,
+	}, nil
+}
+
+// SavingsRate queries the savings rate paid to savings deposits and the total savings.
+func (s QueryServer) SavingsRate(c context.Context, req *types.QuerySavingsRateRequest) (*types.QuerySavingsRateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	savingsRate := s.keeper.GetParams(ctx).SavingsRate
+	if savingsRate.IsNil() || savingsRate.IsZero() {
+		savingsRate = sdk.OneDec()
+	}
+
+	return &types.QuerySavingsRateResponse{
+		SavingsRate: savingsRate.String(),
+		APY:         StabilityFeeAPY(savingsRate).String(),
+		Accumulator: s.keeper.GetSavingsAccumulator(ctx),
+	}, nil
+}
+
+// SavingsDeposit queries the savings deposit of a depositor, including accrued interest.
+func (s QueryServer) SavingsDeposit(c context.Context, req *types.QuerySavingsDepositRequest) (*types.QuerySavingsDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	depositor, err := sdk.AccAddressFromBech32(req.Depositor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address")
+	}
+
+	deposit, found := s.keeper.GetSavingsDeposit(ctx, depositor)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrSavingsDepositNotFound, "depositor %s", req.Depositor)
+	}
+
+	return &types.QuerySavingsDepositResponse{
+		Deposit: s.keeper.SynchronizeSavingsDeposit(ctx, deposit),
+	}, nil
+}
+
+// SimulateDraw queries the collateralization ratio, max drawable amount and fees of a cdp after a proposed draw.
+func (s QueryServer) SimulateDraw(c context.Context, req *types.QuerySimulateDrawRequest) (*types.QuerySimulateDrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address")
+	}
+	if !req.Amount.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount %s", req.Amount)
+	}
+
+	collateralParam, valid := s.keeper.GetCollateral(ctx, req.CollateralType)
+	if !valid {
+		return nil, errorsmod.Wrap(types.ErrInvalidCollateral, req.CollateralType)
+	}
+
+	cdp, found := s.keeper.GetCdpByOwnerAndCollateralType(ctx, owner, req.CollateralType)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s, denom %s", req.Owner, req.CollateralType)
+	}
+	if err := s.keeper.ValidatePrincipalDraw(ctx, req.Amount, cdp.Principal.Denom); err != nil {
+		return nil, err
+	}
+
+	// add the fees drawing would synchronize to the cdp, without writing it to the store
+	cdp.AccumulatedFees = cdp.AccumulatedFees.Add(s.keeper.CalculateNewInterest(ctx, cdp))
+	principal := cdp.Principal.Add(req.Amount)
+
+	var collateralizationRatio, liquidationRatio sdk.Dec
+	if len(cdp.AdditionalCollateral) == 0 {
+		collateralizationRatio, err = s.keeper.CalculateCollateralizationRatio(ctx, cdp.Collateral, cdp.Type, principal, cdp.AccumulatedFees, spot)
+		liquidationRatio = s.keeper.getLiquidationRatio(ctx, cdp.Type)
+	} else {
+		collateralizationRatio, liquidationRatio, err = s.keeper.CalculateCombinedCollateralizationRatio(ctx, cdp.Collateral, cdp.AdditionalCollateral, cdp.Type, principal, cdp.AccumulatedFees, spot)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	maxDraw, err := s.keeper.CalculateMaxDraw(ctx, cdp)
+	if err != nil {
+		return nil, err
+	}
+
+	// annual fees are rounded like the fees synchronized to a cdp
+	debt := principal.Add(cdp.AccumulatedFees).Amount
+	annualInterestFactor := CalculateInterestFactor(collateralParam.StabilityFee, sdkmath.NewInt(secondsPerYear))
+	annualFees := sdk.NewDecFromInt(debt).Mul(annualInterestFactor).RoundInt().Sub(debt)
+
+	res := &types.QuerySimulateDrawResponse{
+		CollateralizationRatio: collateralizationRatio.String(),
+		LiquidationRatio:       liquidationRatio.String(),
+		MaxDraw:                maxDraw,
+		AccumulatedFees:        cdp.AccumulatedFees,
+		ProjectedAnnualFees:    sdk.NewCoin(cdp.AccumulatedFees.Denom, annualFees),
+		Valid:                  true,
+	}
+	if err := s.keeper.ValidateDraw(ctx, cdp, req.Amount); err != nil {
+		res.Valid = false
+		res.InvalidReason = err.Error()
+	}
+	return res, nil
+}
@@ -135,6 +135,351 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryTotalCollateral() {
 	}, "busd total collateral should be 0")
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcQueryDebtLimits() {
+	suite.addCdp()
+
+	res, err := suite.queryServer.DebtLimits(sdk.WrapSDKContext(suite.ctx), &types.QueryDebtLimitsRequest{})
+	suite.Require().NoError(err)
+
+	suite.Len(res.DebtLimits, 4, "debt limits should include all collateral params")
+	suite.Contains(res.DebtLimits, types.CollateralDebtLimit{
+		CollateralType: "xrp-a",
+		TotalPrincipal: sdk.NewCoin("usdx", sdkmath.NewInt(10000000)),
+		DebtLimit:      sdk.NewCoin("usdx", sdkmath.NewInt(500000000000)),
+		Utilization:    d("0.00002").String(),
+	}, "debt limits should include added cdp")
+	suite.Equal(sdk.NewCoin("usdx", sdkmath.NewInt(10000000)), res.GlobalPrincipal)
+	suite.Equal(sdk.NewCoin("usdx", sdkmath.NewInt(2000000000000)), res.GlobalDebtLimit)
+	suite.Equal(d("0.000005").String(), res.GlobalUtilization)
+
+	res, err = suite.queryServer.DebtLimits(sdk.WrapSDKContext(suite.ctx), &types.QueryDebtLimitsRequest{CollateralType: "busd-a"})
+	suite.Require().NoError(err)
+	suite.Equal(types.CollateralDebtLimits{{
+		CollateralType: "busd-a",
+		TotalPrincipal: sdk.NewCoin("usdx", sdkmath.ZeroInt()),
+		DebtLimit:      sdk.NewCoin("usdx", sdkmath.NewInt(500000000000)),
+		Utilization:    sdk.ZeroDec().String(),
+	}}, res.DebtLimits)
+	suite.Equal(sdk.NewCoin("usdx", sdkmath.NewInt(10000000)), res.GlobalPrincipal, "global principal should include all collateral types")
+
+	_, err = suite.queryServer.DebtLimits(sdk.WrapSDKContext(suite.ctx), &types.QueryDebtLimitsRequest{CollateralType: "kava-a"})
+	suite.Require().ErrorIs(err, types.ErrInvalidCollateral)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryStabilityFees() {
+	res, err := suite.queryServer.StabilityFees(sdk.WrapSDKContext(suite.ctx), &types.QueryStabilityFeesRequest{})
+	suite.Require().NoError(err)
+	suite.Len(res.StabilityFees, 4, "stability fees should include all collateral params")
+
+	res, err = suite.queryServer.StabilityFees(sdk.WrapSDKContext(suite.ctx), &types.QueryStabilityFeesRequest{CollateralType: "xrp-a"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.StabilityFees, 1)
+	fee := res.StabilityFees[0]
+	suite.Equal("xrp-a", fee.CollateralType)
+	suite.Equal(d("1.000000001547125958").String(), fee.StabilityFee)
+	// ~5% apy is ~4.88% apr
+	suite.Equal(d("0.048790164211488000").String(), fee.APR)
+	suite.True(sdk.MustNewDecFromStr(fee.APY).Sub(d("0.05")).Abs().LT(d("0.000001")), "apy should be ~5%%, got %s", fee.APY)
+
+	res, err = suite.queryServer.StabilityFees(sdk.WrapSDKContext(suite.ctx), &types.QueryStabilityFeesRequest{CollateralType: "busd-a"})
+	suite.Require().NoError(err)
+	suite.Equal(types.CollateralStabilityFees{{
+		CollateralType: "busd-a",
+		StabilityFee:   sdk.OneDec().String(),
+		APR:            sdk.ZeroDec().String(),
+		APY:            sdk.ZeroDec().String(),
+	}}, res.StabilityFees)
+
+	_, err = suite.queryServer.StabilityFees(sdk.WrapSDKContext(suite.ctx), &types.QueryStabilityFeesRequest{CollateralType: "kava-a"})
+	suite.Require().ErrorIs(err, types.ErrInvalidCollateral)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryPriceStatuses() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CollateralParams[0].PriceStalenessThreshold = time.Hour
+	suite.keeper.SetParams(suite.ctx, params)
+
+	pk := suite.tApp.GetPriceFeedKeeper()
+	updated := suite.ctx.BlockTime()
+	for _, marketID := range []string{"xrp:usd", "xrp:usd:30"} {
+		_, err := pk.SetPrice(suite.ctx, sdk.AccAddress{}, marketID, d("0.25"), updated.Add(3*time.Hour))
+		suite.Require().NoError(err)
+		suite.True(suite.keeper.UpdatePricefeedStatus(suite.ctx, marketID))
+	}
+
+	res, err := suite.queryServer.PriceStatuses(sdk.WrapSDKContext(suite.ctx), &types.QueryPriceStatusesRequest{})
+	suite.Require().NoError(err)
+	suite.Len(res.PriceStatuses, 4, "price statuses should include all collateral params")
+
+	res, err = suite.queryServer.PriceStatuses(sdk.WrapSDKContext(suite.ctx), &types.QueryPriceStatusesRequest{CollateralType: "xrp-a"})
+	suite.Require().NoError(err)
+	suite.Equal(types.CollateralPriceStatuses{{
+		CollateralType:          "xrp-a",
+		PricefeedUp:             true,
+		PriceUpdated:            updated,
+		PriceStalenessThreshold: time.Hour,
+		DrawsPaused:             false,
+	}}, res.PriceStatuses)
+
+	// draws are paused once no price has been posted within the threshold
+	ctx := suite.ctx.WithBlockTime(updated.Add(2 * time.Hour))
+	res, err = suite.queryServer.PriceStatuses(sdk.WrapSDKContext(ctx), &types.QueryPriceStatusesRequest{CollateralType: "xrp-a"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.PriceStatuses, 1)
+	suite.True(res.PriceStatuses[0].DrawsPaused)
+
+	_, err = suite.queryServer.PriceStatuses(sdk.WrapSDKContext(suite.ctx), &types.QueryPriceStatusesRequest{CollateralType: "kava-a"})
+	suite.Require().ErrorIs(err, types.ErrInvalidCollateral)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryInterestFactorSnapshots() {
+	snapshots := types.InterestFactorSnapshots{
+		types.NewInterestFactorSnapshot("btc-a", 10, suite.now, d("1.01")),
+		types.NewInterestFactorSnapshot("xrp-a", 10, suite.now, d("1.02")),
+		types.NewInterestFactorSnapshot("xrp-a", 20, suite.now.Add(time.Hour), d("1.03")),
+	}
+	for _, snapshot := range snapshots {
+		suite.keeper.SetInterestFactorSnapshot(suite.ctx, snapshot)
+	}
+
+	res, err := suite.queryServer.InterestFactorSnapshots(sdk.WrapSDKContext(suite.ctx), &types.QueryInterestFactorSnapshotsRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(snapshots, res.Snapshots)
+
+	res, err = suite.queryServer.InterestFactorSnapshots(sdk.WrapSDKContext(suite.ctx), &types.QueryInterestFactorSnapshotsRequest{
+		CollateralType: "xrp-a",
+		Pagination: &query.PageRequest{
+			Limit: 1,
+		},
+	})
+	suite.Require().NoError(err)
+	suite.Equal(snapshots[1:2], res.Snapshots)
+	suite.NotNil(res.Pagination.NextKey)
+
+	_, err = suite.queryServer.InterestFactorSnapshots(sdk.WrapSDKContext(suite.ctx), &types.QueryInterestFactorSnapshotsRequest{CollateralType: "kava-a"})
+	suite.Require().ErrorIs(err, types.ErrInvalidCollateral)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryAccruedFees() {
+	suite.addCdp()
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.Require().True(found)
+
+	start := types.NewInterestFactorSnapshot("xrp-a", 10, suite.now, sdk.OneDec())
+	end := types.NewInterestFactorSnapshot("xrp-a", 20, suite.now.Add(time.Hour), d("1.05"))
+	suite.keeper.SetInterestFactorSnapshot(suite.ctx, start)
+	suite.keeper.SetInterestFactorSnapshot(suite.ctx, end)
+
+	// heights resolve to the latest snapshot at or before them
+	res, err := suite.queryServer.AccruedFees(sdk.WrapSDKContext(suite.ctx), &types.QueryAccruedFeesRequest{
+		Owner:          cdp.Owner.String(),
+		CollateralType: "xrp-a",
+		StartHeight:    15,
+		EndHeight:      25,
+	})
+	suite.Require().NoError(err)
+	suite.Equal(c("usdx", 500000), res.Fees)
+	suite.Equal(start, res.Start)
+	suite.Equal(end, res.End)
+
+	// an end height of zero uses the current interest factor
+	suite.keeper.SetInterestFactor(suite.ctx, "xrp-a", d("1.1"))
+	ctx := suite.ctx.WithBlockHeight(30)
+	res, err = suite.queryServer.AccruedFees(sdk.WrapSDKContext(ctx), &types.QueryAccruedFeesRequest{
+		Owner:          cdp.Owner.String(),
+		CollateralType: "xrp-a",
+		StartHeight:    10,
+	})
+	suite.Require().NoError(err)
+	suite.Equal(c("usdx", 1000000), res.Fees)
+	suite.Equal(types.NewInterestFactorSnapshot("xrp-a", 30, ctx.BlockTime(), d("1.1")), res.End)
+
+	_, err = suite.queryServer.AccruedFees(sdk.WrapSDKContext(suite.ctx), &types.QueryAccruedFeesRequest{
+		Owner:          cdp.Owner.String(),
+		CollateralType: "xrp-a",
+		StartHeight:    5,
+		EndHeight:      20,
+	})
+	suite.Require().ErrorIs(err, types.ErrInterestFactorSnapshotNotFound)
+
+	_, err = suite.queryServer.AccruedFees(sdk.WrapSDKContext(suite.ctx), &types.QueryAccruedFeesRequest{
+		Owner:          cdp.Owner.String(),
+		CollateralType: "xrp-a",
+		StartHeight:    20,
+		EndHeight:      10,
+	})
+	suite.Require().Error(err)
+
+	_, err = suite.queryServer.AccruedFees(sdk.WrapSDKContext(suite.ctx), &types.QueryAccruedFeesRequest{
+		Owner:          suite.addrs[1].String(),
+		CollateralType: "xrp-a",
+		StartHeight:    10,
+	})
+	suite.Require().ErrorIs(err, types.ErrCdpNotFound)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryLiquidationQueue() {
+	pk := suite.tApp.GetPriceFeedKeeper()
+	for _, marketID := range []string{"xrp:usd", "xrp:usd:30"} {
+		err := pk.SetCurrentPrices(suite.ctx, marketID)
+		suite.Require().NoError(err)
+	}
+
+	// collateralization ratios of 2.22, 2.5 and 5.0 at an xrp price of 0.25
+	for j, principal := range []int64{45000000, 40000000, 20000000} {
+		err := suite.tApp.FundAccount(suite.ctx, suite.addrs[j], cs(c("xrp", 400000000)))
+		suite.Require().NoError(err)
+		err = suite.keeper.AddCdp(suite.ctx, suite.addrs[j], c("xrp", 400000000), c("usdx", principal), "xrp-a")
+		suite.Require().NoError(err)
+	}
+	owners := func(cdps types.CDPResponses) (owners []string) {
+		for _, cdp := range cdps {
+			owners = append(owners, cdp.Owner)
+		}
+		return
+	}
+
+	res, err := suite.queryServer.LiquidationQueue(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidationQueueRequest{CollateralType: "xrp-a"})
+	suite.Require().NoError(err)
+	suite.Empty(res.Cdps, "no cdps should be under the liquidation ratio")
+
+	res, err = suite.queryServer.LiquidationQueue(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidationQueueRequest{
+		CollateralType: "xrp-a",
+		Margin:         "0.3",
+	})
+	suite.Require().NoError(err)
+	suite.Equal([]string{suite.addrs[0].String(), suite.addrs[1].String()}, owners(res.Cdps))
+	suite.Nil(res.Pagination.NextKey)
+
+	// pages continue from the next key
+	res, err = suite.queryServer.LiquidationQueue(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidationQueueRequest{
+		CollateralType: "xrp-a",
+		Margin:         "0.3",
+		Pagination:     &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Equal([]string{suite.addrs[0].String()}, owners(res.Cdps))
+	suite.Equal(uint64(2), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+	res, err = suite.queryServer.LiquidationQueue(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidationQueueRequest{
+		CollateralType: "xrp-a",
+		Margin:         "0.3",
+		Pagination:     &query.PageRequest{Limit: 1, Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Equal([]string{suite.addrs[1].String()}, owners(res.Cdps))
+	suite.Nil(res.Pagination.NextKey)
+
+	// cdps under the liquidation ratio at the liquidation price are returned without a margin
+	_, err = pk.SetPrice(suite.ctx, sdk.AccAddress{}, "xrp:usd:30", d("0.2"), suite.now.Add(time.Hour))
+	suite.Require().NoError(err)
+	err = pk.SetCurrentPrices(suite.ctx, "xrp:usd:30")
+	suite.Require().NoError(err)
+	res, err = suite.queryServer.LiquidationQueue(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidationQueueRequest{CollateralType: "xrp-a"})
+	suite.Require().NoError(err)
+	suite.Equal([]string{suite.addrs[0].String()}, owners(res.Cdps))
+
+	_, err = suite.queryServer.LiquidationQueue(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidationQueueRequest{
+		CollateralType: "xrp-a",
+		Margin:         "-0.1",
+	})
+	suite.Require().Error(err)
+	_, err = suite.queryServer.LiquidationQueue(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidationQueueRequest{CollateralType: "kava-a"})
+	suite.Require().ErrorIs(err, types.ErrInvalidCollateral)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQuerySavingsRate() {
+	res, err := suite.queryServer.SavingsRate(sdk.WrapSDKContext(suite.ctx), &types.QuerySavingsRateRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(sdk.OneDec().String(), res.SavingsRate)
+	suite.Equal(sdk.ZeroDec().String(), res.APY)
+	suite.Equal(types.DefaultSavingsAccumulator(), res.Accumulator)
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.SavingsRate = d("1.000000001547125958")
+	suite.keeper.SetParams(suite.ctx, params)
+	accumulator := types.NewSavingsAccumulator(d("1.05"), suite.now, sdkmath.NewInt(10000000))
+	suite.keeper.SetSavingsAccumulator(suite.ctx, accumulator)
+
+	res, err = suite.queryServer.SavingsRate(sdk.WrapSDKContext(suite.ctx), &types.QuerySavingsRateRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(d("1.000000001547125958").String(), res.SavingsRate)
+	suite.True(sdk.MustNewDecFromStr(res.APY).Sub(d("0.05")).Abs().LT(d("0.000001")), "apy should be ~5%%, got %s", res.APY)
+	suite.Equal(accumulator, res.Accumulator)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQuerySavingsDeposit() {
+	suite.keeper.SetSavingsAccumulator(suite.ctx, types.NewSavingsAccumulator(d("1.1"), suite.now, sdkmath.NewInt(11000000)))
+	suite.keeper.SetSavingsDeposit(suite.ctx, types.NewSavingsDeposit(suite.addrs[0], c("usdx", 10000000), sdk.OneDec(), c("usdx", 0)))
+
+	// the deposit includes the interest accrued since it was last synchronized
+	res, err := suite.queryServer.SavingsDeposit(sdk.WrapSDKContext(suite.ctx), &types.QuerySavingsDepositRequest{
+		Depositor: suite.addrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Equal(types.NewSavingsDeposit(suite.addrs[0], c("usdx", 11000000), d("1.1"), c("usdx", 1000000)), res.Deposit)
+
+	_, err = suite.queryServer.SavingsDeposit(sdk.WrapSDKContext(suite.ctx), &types.QuerySavingsDepositRequest{
+		Depositor: suite.addrs[1].String(),
+	})
+	suite.Require().ErrorIs(err, types.ErrSavingsDepositNotFound)
+
+	_, err = suite.queryServer.SavingsDeposit(sdk.WrapSDKContext(suite.ctx), &types.QuerySavingsDepositRequest{
+		Depositor: "invalid",
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQuerySimulateDraw() {
+	suite.addCdp()
+
+	// 100 xrp at 0.25 backs 12.5 usdx at a liquidation ratio of 2.0, of which 10 usdx is drawn
+	res, err := suite.queryServer.SimulateDraw(sdk.WrapSDKContext(suite.ctx), &types.QuerySimulateDrawRequest{
+		Owner:          suite.addrs[0].String(),
+		CollateralType: "xrp-a",
+		Amount:         c("usdx", 2000000),
+	})
+	suite.Require().NoError(err)
+	suite.Equal(d("2.083333333333333333").String(), res.CollateralizationRatio)
+	suite.Equal(d("2.0").String(), res.LiquidationRatio)
+	suite.Equal(c("usdx", 2500000), res.MaxDraw)
+	suite.Equal(c("usdx", 0), res.AccumulatedFees)
+	// ~5% apy on 12 usdx
+	suite.True(res.ProjectedAnnualFees.Amount.Sub(i(600000)).Abs().LTE(i(1)), "annual fees should be ~600000usdx, got %s", res.ProjectedAnnualFees)
+	suite.True(res.Valid)
+	suite.Empty(res.InvalidReason)
+
+	// the max draw is exactly the most the keeper allows to be drawn
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.Require().True(found)
+	suite.NoError(suite.keeper.ValidateDraw(suite.ctx, cdp, res.MaxDraw))
+	suite.ErrorIs(suite.keeper.ValidateDraw(suite.ctx, cdp, res.MaxDraw.AddAmount(i(1))), types.ErrInvalidCollateralRatio)
+
+	res, err = suite.queryServer.SimulateDraw(sdk.WrapSDKContext(suite.ctx), &types.QuerySimulateDrawRequest{
+		Owner:          suite.addrs[0].String(),
+		CollateralType: "xrp-a",
+		Amount:         c("usdx", 3000000),
+	})
+	suite.Require().NoError(err)
+	suite.Equal(d("1.923076923076923077").String(), res.CollateralizationRatio)
+	suite.False(res.Valid)
+	suite.Contains(res.InvalidReason, types.ErrInvalidCollateralRatio.Error())
+
+	_, err = suite.queryServer.SimulateDraw(sdk.WrapSDKContext(suite.ctx), &types.QuerySimulateDrawRequest{
+		Owner:          suite.addrs[0].String(),
+		CollateralType: "xrp-a",
+		Amount:         c("xrp", 1000000),
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidDebtRequest)
+
+	_, err = suite.queryServer.SimulateDraw(sdk.WrapSDKContext(suite.ctx), &types.QuerySimulateDrawRequest{
+		Owner:          suite.addrs[1].String(),
+		CollateralType: "xrp-a",
+		Amount:         c("usdx", 1000000),
+	})
+	suite.Require().ErrorIs(err, types.ErrCdpNotFound)
+}
+
 func (suite *grpcQueryTestSuite) TestGrpcQueryCdps() {
 	suite.addCdp()
 
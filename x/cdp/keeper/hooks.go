@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/kava-labs/kava/x/cdp/types"
 )
@@ -21,3 +22,10 @@ func (k Keeper) BeforeCDPModified(ctx sdk.Context, cdp types.CDP) {
 		k.hooks.BeforeCDPModified(ctx, cdp)
 	}
 }
+
+// AfterDebtChanged - call hook if registered
+func (k Keeper) AfterDebtChanged(ctx sdk.Context, owner sdk.AccAddress, collateralType string, delta sdkmath.Int) {
+	if k.hooks != nil {
+		k.hooks.AfterDebtChanged(ctx, owner, collateralType, delta)
+	}
+}
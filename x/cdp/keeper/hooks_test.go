@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// debtChange is a call to AfterDebtChanged
+type debtChange struct {
+	owner          sdk.AccAddress
+	collateralType string
+	delta          sdkmath.Int
+}
+
+// fakeCDPHooks records the debt changes it is called with
+type fakeCDPHooks struct {
+	debtChanges []debtChange
+}
+
+var _ types.CDPHooks = &fakeCDPHooks{}
+
+func (h *fakeCDPHooks) AfterCDPCreated(sdk.Context, types.CDP)   {}
+func (h *fakeCDPHooks) BeforeCDPModified(sdk.Context, types.CDP) {}
+func (h *fakeCDPHooks) AfterDebtChanged(_ sdk.Context, owner sdk.AccAddress, collateralType string, delta sdkmath.Int) {
+	h.debtChanges = append(h.debtChanges, debtChange{owner: owner, collateralType: collateralType, delta: delta})
+}
+
+type HooksTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	hooks  *fakeCDPHooks
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *HooksTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	authGS := app.NewFundedGenStateWithSameCoins(cdc, cs(c("xrp", 500000000), c("usdx", 10000000)), addrs)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.addrs = addrs
+
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.keeper.ClearHooks()
+	suite.hooks = &fakeCDPHooks{}
+	suite.keeper.SetHooks(suite.hooks)
+}
+
+func (suite *HooksTestSuite) TestAfterDebtChanged() {
+	owner, recipient := suite.addrs[0], suite.addrs[1]
+
+	err := suite.keeper.AddCdp(suite.ctx, owner, c("xrp", 400000000), c("usdx", 10000000), "xrp-a")
+	suite.Require().NoError(err)
+	err = suite.keeper.AddPrincipal(suite.ctx, owner, "xrp-a", c("usdx", 5000000))
+	suite.Require().NoError(err)
+
+	// fees are reported when they are synchronized to the cdp
+	err = suite.keeper.AccumulateInterest(suite.ctx, "xrp-a")
+	suite.Require().NoError(err)
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(365 * 24 * time.Hour))
+	err = suite.keeper.AccumulateInterest(suite.ctx, "xrp-a")
+	suite.Require().NoError(err)
+	err = suite.keeper.RepayPrincipal(suite.ctx, owner, "xrp-a", c("usdx", 1000000))
+	suite.Require().NoError(err)
+
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, owner, "xrp-a")
+	suite.Require().True(found)
+	debt := cdp.GetTotalPrincipal().Amount
+	fees := debt.Sub(i(14000000))
+	suite.Require().True(fees.IsPositive())
+
+	err = suite.keeper.TransferCdp(suite.ctx, owner, recipient, "xrp-a")
+	suite.Require().NoError(err)
+
+	suite.Equal([]debtChange{
+		{owner: owner, collateralType: "xrp-a", delta: i(10000000)},
+		{owner: owner, collateralType: "xrp-a", delta: i(5000000)},
+		{owner: owner, collateralType: "xrp-a", delta: fees},
+		{owner: owner, collateralType: "xrp-a", delta: i(-1000000)},
+		{owner: owner, collateralType: "xrp-a", delta: debt.Neg()},
+		{owner: recipient, collateralType: "xrp-a", delta: debt},
+	}, suite.hooks.debtChanges)
+
+	// failed draws do not call hooks
+	err = suite.keeper.AddPrincipal(suite.ctx, recipient, "xrp-a", c("usdx", 1000000000))
+	suite.Require().ErrorIs(err, types.ErrInvalidCollateralRatio)
+	suite.Len(suite.hooks.debtChanges, 6)
+}
+
+func (suite *HooksTestSuite) TestAfterDebtChanged_Liquidation() {
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("xrp", 400000000), c("usdx", 40000000), "xrp-a")
+	suite.Require().NoError(err)
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.Require().True(found)
+
+	err = suite.keeper.SeizeCollateral(suite.ctx, cdp)
+	suite.Require().NoError(err)
+
+	suite.Equal([]debtChange{
+		{owner: suite.addrs[0], collateralType: "xrp-a", delta: i(40000000)},
+		{owner: suite.addrs[0], collateralType: "xrp-a", delta: i(-40000000)},
+	}, suite.hooks.debtChanges)
+}
+
+func TestHooksTestSuite(t *testing.T) {
+	suite.Run(t, new(HooksTestSuite))
+}
@@ -60,6 +60,8 @@ func NewCDPGenState(cdc codec.JSONCodec, asset string, liquidationRatio sdk.Dec)
 					SpotMarketID:                     asset + ":usd",
 					LiquidationMarketID:              asset + ":usd",
 					KeeperRewardPercentage:           d("0.01"),
+					LiquidationCloseFactor:           d("1.0"),
+					KeeperIncentive:                  i(0),
 					CheckCollateralizationIndexCount: i(10),
 					ConversionFactor:                 i(6),
 				},
@@ -174,6 +176,8 @@ func NewCDPGenStateMulti(cdc codec.JSONCodec) app.GenesisState {
 					SpotMarketID:                     "xrp:usd",
 					LiquidationMarketID:              "xrp:usd:30",
 					KeeperRewardPercentage:           d("0.01"),
+					LiquidationCloseFactor:           d("1.0"),
+					KeeperIncentive:                  i(0),
 					CheckCollateralizationIndexCount: i(10),
 					ConversionFactor:                 i(6),
 				},
@@ -188,6 +192,8 @@ func NewCDPGenStateMulti(cdc codec.JSONCodec) app.GenesisState {
 					SpotMarketID:                     "btc:usd",
 					LiquidationMarketID:              "btc:usd:30",
 					KeeperRewardPercentage:           d("0.01"),
+					LiquidationCloseFactor:           d("1.0"),
+					KeeperIncentive:                  i(0),
 					CheckCollateralizationIndexCount: i(10),
 					ConversionFactor:                 i(8),
 				},
@@ -202,6 +208,8 @@ func NewCDPGenStateMulti(cdc codec.JSONCodec) app.GenesisState {
 					SpotMarketID:                     "bnb:usd",
 					LiquidationMarketID:              "bnb:usd:30",
 					KeeperRewardPercentage:           d("0.01"),
+					LiquidationCloseFactor:           d("1.0"),
+					KeeperIncentive:                  i(0),
 					CheckCollateralizationIndexCount: i(10),
 					ConversionFactor:                 i(8),
 				},
@@ -216,6 +224,8 @@ func NewCDPGenStateMulti(cdc codec.JSONCodec) app.GenesisState {
 					SpotMarketID:                     "busd:usd",
 					LiquidationMarketID:              "busd:usd:30",
 					KeeperRewardPercentage:           d("0.01"),
+					LiquidationCloseFactor:           d("1.0"),
+					KeeperIncentive:                  i(0),
 					CheckCollateralizationIndexCount: i(10),
 					ConversionFactor:                 i(8),
 				},
@@ -268,6 +278,8 @@ func NewCDPGenStateHighDebtLimit(cdc codec.JSONCodec) app.GenesisState {
 					SpotMarketID:                     "xrp:usd",
 					LiquidationMarketID:              "xrp:usd",
 					KeeperRewardPercentage:           d("0.01"),
+					LiquidationCloseFactor:           d("1.0"),
+					KeeperIncentive:                  i(0),
 					CheckCollateralizationIndexCount: i(10),
 					ConversionFactor:                 i(6),
 				},
@@ -282,6 +294,8 @@ func NewCDPGenStateHighDebtLimit(cdc codec.JSONCodec) app.GenesisState {
 					SpotMarketID:                     "btc:usd",
 					LiquidationMarketID:              "btc:usd",
 					KeeperRewardPercentage:           d("0.01"),
+					LiquidationCloseFactor:           d("1.0"),
+					KeeperIncentive:                  i(0),
 					CheckCollateralizationIndexCount: i(10),
 					ConversionFactor:                 i(8),
 				},
@@ -13,6 +13,9 @@ import (
 
 var scalingFactor = 1e18
 
+// secondsPerYear is the number of seconds in a year, used to annualize stability fees
+const secondsPerYear = 31536000
+
 // AccumulateInterest calculates the new interest that has accrued for the input collateral type based on the total amount of principal
 // that has been created with that collateral type and the amount of time that has passed since interest was last accumulated
 func (k Keeper) AccumulateInterest(ctx sdk.Context, ctype string) error {
@@ -104,6 +107,18 @@ func CalculateInterestFactor(perSecondInterestRate sdk.Dec, secondsElapsed sdkma
 	return sdk.NewDecFromBigInt(interestFactorMantissa.BigInt()).QuoInt(scalingFactorInt)
 }
 
+// StabilityFeeAPR returns the annual percentage rate of a per second stability fee, without compounding.
+// For example, a stability fee of 1.000000001547125958 has an APR of ~0.0488.
+func StabilityFeeAPR(stabilityFee sdk.Dec) sdk.Dec {
+	return stabilityFee.Sub(sdk.OneDec()).MulInt64(secondsPerYear)
+}
+
+// StabilityFeeAPY returns the annual percentage yield of a per second stability fee, compounded each second.
+// For example, a stability fee of 1.000000001547125958 has an APY of ~0.05.
+func StabilityFeeAPY(stabilityFee sdk.Dec) sdk.Dec {
+	return CalculateInterestFactor(stabilityFee, sdkmath.NewInt(secondsPerYear)).Sub(sdk.OneDec())
+}
+
 // SynchronizeInterest updates the input cdp object to reflect the current accumulated interest, updates the cdp state in the store,
 // and returns the updated cdp object
 func (k Keeper) SynchronizeInterest(ctx sdk.Context, cdp types.CDP) types.CDP {
@@ -143,7 +158,35 @@ func (k Keeper) SynchronizeInterest(ctx sdk.Context, cdp types.CDP) types.CDP {
 	if err := k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio); err != nil {
 		panic(err)
 	}
+	if accumulatedInterest.IsPositive() {
+		k.hooks.AfterDebtChanged(ctx, cdp.Owner, cdp.Type, accumulatedInterest.Amount)
+	}
+
+	return cdp
+}
+
+// CalculateSynchronizedInterest returns the cdp with its interest synchronized as SynchronizeInterest would, without
+// writing it to the store or calling hooks
+func (k Keeper) CalculateSynchronizedInterest(ctx sdk.Context, cdp types.CDP) types.CDP {
+	globalInterestFactor, found := k.GetInterestFactor(ctx, cdp.Type)
+	if !found {
+		cdp.InterestFactor = sdk.OneDec()
+		cdp.FeesUpdated = ctx.BlockTime()
+		return cdp
+	}
 
+	accumulatedInterest := k.CalculateNewInterest(ctx, cdp)
+	prevAccrualTime, found := k.GetPreviousAccrualTime(ctx, cdp.Type)
+	if !found {
+		return cdp
+	}
+	if accumulatedInterest.IsZero() && cdp.FeesUpdated.Equal(prevAccrualTime) {
+		return cdp
+	}
+
+	cdp.AccumulatedFees = cdp.AccumulatedFees.Add(accumulatedInterest)
+	cdp.FeesUpdated = prevAccrualTime
+	cdp.InterestFactor = globalInterestFactor
 	return cdp
 }
 
@@ -254,6 +297,13 @@ func (k Keeper) SynchronizeInterestForRiskyCDPs(ctx sdk.Context, targetRatio sdk
 		bz = k.cdc.MustMarshal(&cdp)
 		cdpStore.Set(types.CdpKey(cdp.Type, cdp.ID), bz)
 		collateralRatioStore.Set(types.CollateralRatioKey(cdp.Type, cdp.ID, updatedCollateralRatio), types.GetCdpIDBytes(cdp.ID))
+
+		//
+		// HOOK
+		//
+		if accumulatedInterest.IsPositive() {
+			k.hooks.AfterDebtChanged(ctx, cdp.Owner, cdp.Type, accumulatedInterest)
+		}
 	}
 
 	return nil
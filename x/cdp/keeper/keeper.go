@@ -57,6 +57,11 @@ func (k *Keeper) SetHooks(hooks types.CDPHooks) *Keeper {
 	return k
 }
 
+// ClearHooks clears the hooks on the keeper
+func (k *Keeper) ClearHooks() {
+	k.hooks = nil
+}
+
 // CdpDenomIndexIterator returns an sdk.Iterator for all cdps with matching collateral denom
 func (k Keeper) CdpDenomIndexIterator(ctx sdk.Context, collateralType string) sdk.Iterator {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpKeyPrefix)
@@ -118,6 +123,30 @@ func (k Keeper) IterateCdpsByCollateralRatio(ctx sdk.Context, collateralType str
 	}
 }
 
+// GetTotalCollateral returns the amount of collateral deposited in cdps of a collateral type, not including collateral
+// held as additional collateral by cdps of other collateral types
+func (k Keeper) GetTotalCollateral(ctx sdk.Context, collateralType string) sdkmath.Int {
+	total := sdk.ZeroInt()
+	k.IterateCdpsByCollateralType(ctx, collateralType, func(cdp types.CDP) bool {
+		total = total.Add(cdp.Collateral.Amount)
+		return false
+	})
+	return total
+}
+
+// GetTotalAdditionalCollateral returns the amount of collateral of a collateral type held as additional collateral by
+// cdps of other collateral types
+func (k Keeper) GetTotalAdditionalCollateral(ctx sdk.Context, collateralType string) sdkmath.Int {
+	total := sdk.ZeroInt()
+	k.IterateAllCdps(ctx, func(cdp types.CDP) bool {
+		if position, found := cdp.AdditionalCollateral.Get(collateralType); found {
+			total = total.Add(position.Amount.Amount)
+		}
+		return false
+	})
+	return total
+}
+
 // GetSliceOfCDPsByRatioAndType returns a slice of cdps of size equal to the input cutoffCount
 // sorted by target ratio in ascending order (ie, the lowest collateral:debt ratio cdps are returned first)
 func (k Keeper) GetSliceOfCDPsByRatioAndType(ctx sdk.Context, cutoffCount sdkmath.Int, targetRatio sdk.Dec, collateralType string) (cdps types.CDPs) {
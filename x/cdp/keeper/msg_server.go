@@ -57,7 +57,11 @@ func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 		return nil, err
 	}
 
-	err = k.keeper.DepositCollateral(ctx, owner, depositor, msg.Collateral, msg.CollateralType)
+	if msg.IsAdditionalCollateral() {
+		err = k.keeper.DepositAdditionalCollateral(ctx, owner, msg.Collateral, msg.CollateralType, msg.CdpCollateralType)
+	} else {
+		err = k.keeper.DepositCollateral(ctx, owner, depositor, msg.Collateral, msg.CollateralType)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +89,11 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*typ
 		return nil, err
 	}
 
-	err = k.keeper.WithdrawCollateral(ctx, owner, depositor, msg.Collateral, msg.CollateralType)
+	if msg.IsAdditionalCollateral() {
+		err = k.keeper.WithdrawAdditionalCollateral(ctx, owner, msg.Collateral, msg.CollateralType, msg.CdpCollateralType)
+	} else {
+		err = k.keeper.WithdrawCollateral(ctx, owner, depositor, msg.Collateral, msg.CollateralType)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -131,9 +139,18 @@ func (k msgServer) RepayDebt(goCtx context.Context, msg *types.MsgRepayDebt) (*t
 		return nil, err
 	}
 
-	err = k.keeper.RepayPrincipal(ctx, sender, msg.CollateralType, msg.Payment)
-	if err != nil {
-		return nil, err
+	if msg.FeeDenom != "" && msg.FeeDenom != msg.Payment.Denom {
+		err = k.keeper.RepayFees(ctx, sender, msg.CollateralType, msg.FeeDenom)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.Payment.IsPositive() {
+		err = k.keeper.RepayPrincipal(ctx, sender, msg.CollateralType, msg.Payment)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -173,3 +190,160 @@ func (k msgServer) Liquidate(goCtx context.Context, msg *types.MsgLiquidate) (*t
 	)
 	return &types.MsgLiquidateResponse{}, nil
 }
+
+func (k msgServer) SetProtection(goCtx context.Context, msg *types.MsgSetProtection) (*types.MsgSetProtectionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.IsRemoval() {
+		err = k.keeper.RemoveCdpProtection(ctx, owner, msg.CollateralType)
+	} else {
+		var reserve sdk.AccAddress
+		reserve, err = sdk.AccAddressFromBech32(msg.Reserve)
+		if err != nil {
+			return nil, err
+		}
+		err = k.keeper.SetCdpProtection(ctx, owner, reserve, msg.CollateralType, msg.TargetRatio)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	)
+	return &types.MsgSetProtectionResponse{}, nil
+}
+
+func (k msgServer) ApproveProtection(goCtx context.Context, msg *types.MsgApproveProtection) (*types.MsgApproveProtectionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	reserve, err := sdk.AccAddressFromBech32(msg.Reserve)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.ApproveCdpProtection(ctx, reserve, owner, msg.CollateralType, msg.Allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Reserve),
+		),
+	)
+	return &types.MsgApproveProtectionResponse{}, nil
+}
+
+func (k msgServer) TransferCdp(goCtx context.Context, msg *types.MsgTransferCdp) (*types.MsgTransferCdpResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.TransferCdp(ctx, owner, recipient, msg.CollateralType)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	)
+	return &types.MsgTransferCdpResponse{}, nil
+}
+
+func (k msgServer) RedeemUSDX(goCtx context.Context, msg *types.MsgRedeemUSDX) (*types.MsgRedeemUSDXResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = k.keeper.RedeemUSDX(ctx, sender, msg.CollateralType, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgRedeemUSDXResponse{}, nil
+}
+
+func (k msgServer) DepositSavings(goCtx context.Context, msg *types.MsgDepositSavings) (*types.MsgDepositSavingsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.DepositSavings(ctx, depositor, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor),
+		),
+	)
+	return &types.MsgDepositSavingsResponse{}, nil
+}
+
+func (k msgServer) WithdrawSavings(goCtx context.Context, msg *types.MsgWithdrawSavings) (*types.MsgWithdrawSavingsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.WithdrawSavings(ctx, depositor, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor),
+		),
+	)
+	return &types.MsgWithdrawSavingsResponse{}, nil
+}
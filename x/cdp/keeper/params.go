@@ -51,20 +51,10 @@ func (k Keeper) GetDebtParam(ctx sdk.Context, denom string) (types.DebtParam, bo
 	return types.DebtParam{}, false
 }
 
-func (k Keeper) getSpotMarketID(ctx sdk.Context, collateralType string) string {
-	cp, found := k.GetCollateral(ctx, collateralType)
-	if !found {
-		panic(fmt.Sprintf("collateral not found: %s", collateralType))
-	}
-	return cp.SpotMarketID
-}
-
-func (k Keeper) getliquidationMarketID(ctx sdk.Context, collateralType string) string {
-	cp, found := k.GetCollateral(ctx, collateralType)
-	if !found {
-		panic(fmt.Sprintf("collateral not found: %s", collateralType))
-	}
-	return cp.LiquidationMarketID
+// GetFeePaymentParam returns the fee payment param, and false if fees can only be paid in the debt asset
+func (k Keeper) GetFeePaymentParam(ctx sdk.Context) (types.FeePaymentParam, bool) {
+	fp := k.GetParams(ctx).FeePaymentParam
+	return fp, fp.IsEnabled()
 }
 
 func (k Keeper) getLiquidationRatio(ctx sdk.Context, collateralType string) sdk.Dec {
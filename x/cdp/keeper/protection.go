@@ -0,0 +1,259 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// SetCdpProtection sets the reserve address and target ratio that protect the owner's cdp from liquidation. If the
+// reserve changes, the allowance approved by the previous reserve is discarded.
+func (k Keeper) SetCdpProtection(ctx sdk.Context, owner, reserve sdk.AccAddress, collateralType string, targetRatio sdk.Dec) error {
+	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s, collateral %s", owner, collateralType)
+	}
+	liquidationRatio := k.getLiquidationRatio(ctx, collateralType)
+	if targetRatio.LTE(liquidationRatio) {
+		return errorsmod.Wrapf(types.ErrInvalidTargetRatio, "target ratio %s, liquidation ratio %s", targetRatio, liquidationRatio)
+	}
+	cp, _ := k.GetCollateral(ctx, collateralType)
+
+	protection, found := k.GetProtection(ctx, collateralType, cdp.ID)
+	if !found || !protection.Reserve.Equals(reserve) {
+		protection = types.NewProtection(cdp.ID, collateralType, reserve, targetRatio, sdk.NewCoin(cp.Denom, sdk.ZeroInt()))
+	}
+	protection.TargetRatio = targetRatio
+	k.SetProtection(ctx, protection)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpProtection,
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyReserve, reserve.String()),
+		),
+	)
+	return nil
+}
+
+// RemoveCdpProtection removes the liquidation protection of the owner's cdp
+func (k Keeper) RemoveCdpProtection(ctx sdk.Context, owner sdk.AccAddress, collateralType string) error {
+	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s, collateral %s", owner, collateralType)
+	}
+	if _, found := k.GetProtection(ctx, collateralType, cdp.ID); !found {
+		return errorsmod.Wrapf(types.ErrProtectionNotFound, "cdp %d", cdp.ID)
+	}
+	k.DeleteProtection(ctx, collateralType, cdp.ID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpProtection,
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyReserve, ""),
+		),
+	)
+	return nil
+}
+
+// ApproveCdpProtection sets the amount of collateral the reserve of a cdp's liquidation protection allows to be
+// deposited from it to the cdp
+func (k Keeper) ApproveCdpProtection(ctx sdk.Context, reserve, owner sdk.AccAddress, collateralType string, allowance sdk.Coin) error {
+	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s, collateral %s", owner, collateralType)
+	}
+	protection, found := k.GetProtection(ctx, collateralType, cdp.ID)
+	if !found || !protection.Reserve.Equals(reserve) {
+		return errorsmod.Wrapf(types.ErrProtectionNotFound, "cdp %d, reserve %s", cdp.ID, reserve)
+	}
+	if allowance.Denom != cdp.Collateral.Denom {
+		return errorsmod.Wrapf(types.ErrInvalidCollateral, "allowance %s, collateral %s", allowance.Denom, cdp.Collateral.Denom)
+	}
+	protection.Allowance = allowance
+	k.SetProtection(ctx, protection)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpProtection,
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyReserve, reserve.String()),
+			sdk.NewAttribute(types.AttributeKeyAllowance, allowance.String()),
+		),
+	)
+	return nil
+}
+
+// maxProtectionTopUpChecks is the maximum number of protected cdps of a collateral type checked for a top up each block
+const maxProtectionTopUpChecks = 100
+
+// TopUpProtectedCdps deposits collateral from the reserves of protected cdps of the input collateral type whose
+// collateralization ratio, at the liquidation price, is below their target ratio. Each top up is capped by the
+// reserve's allowance and spendable balance, and a failed top up is skipped without affecting other cdps.
+// At most maxProtectionTopUpChecks protections are checked per call, continuing from where the previous call stopped.
+func (k Keeper) TopUpProtectedCdps(ctx sdk.Context, collateralType string) error {
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		return errorsmod.Wrap(types.ErrCollateralNotSupported, collateralType)
+	}
+	price, err := k.getCollateralPrice(ctx, collateralType, liquidation)
+	if err != nil {
+		return err
+	}
+
+	protections := k.getProtectionTopUpBatch(ctx, collateralType)
+	for _, protection := range protections {
+		cdp, found := k.GetCDP(ctx, collateralType, protection.CdpID)
+		if !found {
+			continue
+		}
+		fees := cdp.AccumulatedFees.Add(k.CalculateNewInterest(ctx, cdp))
+		ratio, _, err := k.CalculateCombinedCollateralizationRatio(ctx, cdp.Collateral, cdp.AdditionalCollateral, cdp.Type, cdp.Principal, fees, liquidation)
+		if err != nil || ratio.GTE(protection.TargetRatio) {
+			continue
+		}
+
+		// value of collateral needed to bring the cdp back to its target ratio: target * debt - collateral value
+		debtValue := k.convertDebtToBaseUnits(ctx, cdp.Principal).Add(k.convertDebtToBaseUnits(ctx, fees))
+		deficit := protection.TargetRatio.Sub(ratio).Mul(debtValue)
+		amount := k.convertBaseUnitsToCollateral(ctx, deficit.Quo(price), collateralType)
+
+		spendable := k.bankKeeper.SpendableCoins(ctx, protection.Reserve).AmountOf(cp.Denom)
+		amount = sdk.MinInt(amount, sdk.MinInt(protection.Allowance.Amount, spendable))
+		if !amount.IsPositive() {
+			continue
+		}
+		topUp := sdk.NewCoin(cp.Denom, amount)
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.DepositCollateral(cacheCtx, cdp.Owner, protection.Reserve, topUp, collateralType); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to top up protected cdp %d: %s", cdp.ID, err))
+			continue
+		}
+		write()
+
+		protection.Allowance = protection.Allowance.Sub(topUp)
+		k.SetProtection(ctx, protection)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpTopUp,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, topUp.String()),
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+				sdk.NewAttribute(types.AttributeKeyReserve, protection.Reserve.String()),
+			),
+		)
+	}
+	return nil
+}
+
+// getProtectionTopUpBatch returns up to maxProtectionTopUpChecks protections of a collateral type, starting from the
+// cursor and wrapping around to the first protection, and moves the cursor past them.
+func (k Keeper) getProtectionTopUpBatch(ctx sdk.Context, collateralType string) types.Protections {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ProtectionKeyPrefix)
+	first := types.DenomIterKey(collateralType)
+	cursor := types.CdpKey(collateralType, k.getProtectionCursor(ctx, collateralType))
+
+	var protections types.Protections
+	collect := func(iterator sdk.Iterator) {
+		defer iterator.Close()
+		for ; iterator.Valid() && len(protections) < maxProtectionTopUpChecks; iterator.Next() {
+			var protection types.Protection
+			k.cdc.MustUnmarshal(iterator.Value(), &protection)
+			protections = append(protections, protection)
+		}
+	}
+	collect(store.Iterator(cursor, sdk.PrefixEndBytes(first)))
+	collect(store.Iterator(first, cursor))
+
+	if len(protections) > 0 {
+		k.setProtectionCursor(ctx, collateralType, protections[len(protections)-1].CdpID+1)
+	}
+	return protections
+}
+
+// getProtectionCursor returns the cdp id the next batch of protection top ups of a collateral type starts from
+func (k Keeper) getProtectionCursor(ctx sdk.Context, collateralType string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ProtectionCursorKeyPrefix)
+	bz := store.Get([]byte(collateralType))
+	if bz == nil {
+		return 0
+	}
+	return types.GetCdpIDFromBytes(bz)
+}
+
+// setProtectionCursor sets the cdp id the next batch of protection top ups of a collateral type starts from
+func (k Keeper) setProtectionCursor(ctx sdk.Context, collateralType string, cdpID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ProtectionCursorKeyPrefix)
+	store.Set([]byte(collateralType), types.GetCdpIDBytes(cdpID))
+}
+
+// GetProtection returns the liquidation protection of a cdp from the store
+func (k Keeper) GetProtection(ctx sdk.Context, collateralType string, cdpID uint64) (protection types.Protection, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ProtectionKeyPrefix)
+	bz := store.Get(types.CdpKey(collateralType, cdpID))
+	if bz == nil {
+		return protection, false
+	}
+	k.cdc.MustUnmarshal(bz, &protection)
+	return protection, true
+}
+
+// SetProtection sets the liquidation protection of a cdp in the store
+func (k Keeper) SetProtection(ctx sdk.Context, protection types.Protection) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ProtectionKeyPrefix)
+	bz := k.cdc.MustMarshal(&protection)
+	store.Set(types.CdpKey(protection.CollateralType, protection.CdpID), bz)
+}
+
+// DeleteProtection deletes the liquidation protection of a cdp from the store
+func (k Keeper) DeleteProtection(ctx sdk.Context, collateralType string, cdpID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ProtectionKeyPrefix)
+	store.Delete(types.CdpKey(collateralType, cdpID))
+}
+
+// IterateProtectionsByCollateralType iterates over the liquidation protections of cdps of a collateral type and performs a callback function
+func (k Keeper) IterateProtectionsByCollateralType(ctx sdk.Context, collateralType string, cb func(protection types.Protection) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ProtectionKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomIterKey(collateralType))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var protection types.Protection
+		k.cdc.MustUnmarshal(iterator.Value(), &protection)
+
+		if cb(protection) {
+			break
+		}
+	}
+}
+
+// IterateAllProtections iterates over all liquidation protections and performs a callback function
+func (k Keeper) IterateAllProtections(ctx sdk.Context, cb func(protection types.Protection) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ProtectionKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var protection types.Protection
+		k.cdc.MustUnmarshal(iterator.Value(), &protection)
+
+		if cb(protection) {
+			break
+		}
+	}
+}
+
+// GetAllProtections returns all liquidation protections from the store
+func (k Keeper) GetAllProtections(ctx sdk.Context) (protections types.Protections) {
+	k.IterateAllProtections(ctx, func(protection types.Protection) bool {
+		protections = append(protections, protection)
+		return false
+	})
+	return
+}
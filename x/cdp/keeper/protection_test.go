@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+type ProtectionTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *ProtectionTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	authGS := app.NewFundedGenStateWithCoins(
+		cdc,
+		[]sdk.Coins{
+			cs(c("xrp", 500000000), c("btc", 500000000)),
+			cs(c("xrp", 200000000)),
+			cs(c("xrp", 200000000)),
+		},
+		addrs,
+	)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+
+	// $100 of xrp backing 45 usdx, a collateralization ratio of ~2.22 against a 2.0 liquidation ratio
+	err := suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 400000000), c("usdx", 45000000), "xrp-a")
+	suite.Require().NoError(err)
+}
+
+func (suite *ProtectionTestSuite) TestSetCdpProtection() {
+	err := suite.keeper.SetCdpProtection(suite.ctx, suite.addrs[1], suite.addrs[2], "xrp-a", d("2.5"))
+	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
+	err = suite.keeper.SetCdpProtection(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp-a", d("2.0"))
+	suite.Require().True(errors.Is(err, types.ErrInvalidTargetRatio))
+
+	err = suite.keeper.SetCdpProtection(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp-a", d("2.5"))
+	suite.Require().NoError(err)
+	protection, found := suite.keeper.GetProtection(suite.ctx, "xrp-a", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewProtection(1, "xrp-a", suite.addrs[1], d("2.5"), c("xrp", 0)), protection)
+
+	err = suite.keeper.ApproveCdpProtection(suite.ctx, suite.addrs[2], suite.addrs[0], "xrp-a", c("xrp", 1000000))
+	suite.Require().True(errors.Is(err, types.ErrProtectionNotFound))
+	err = suite.keeper.ApproveCdpProtection(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp-a", c("btc", 1000000))
+	suite.Require().True(errors.Is(err, types.ErrInvalidCollateral))
+	err = suite.keeper.ApproveCdpProtection(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp-a", c("xrp", 1000000))
+	suite.Require().NoError(err)
+
+	// updating the target ratio keeps the allowance, changing the reserve discards it
+	err = suite.keeper.SetCdpProtection(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp-a", d("3.0"))
+	suite.Require().NoError(err)
+	protection, _ = suite.keeper.GetProtection(suite.ctx, "xrp-a", 1)
+	suite.Require().Equal(types.NewProtection(1, "xrp-a", suite.addrs[1], d("3.0"), c("xrp", 1000000)), protection)
+	err = suite.keeper.SetCdpProtection(suite.ctx, suite.addrs[0], suite.addrs[2], "xrp-a", d("3.0"))
+	suite.Require().NoError(err)
+	protection, _ = suite.keeper.GetProtection(suite.ctx, "xrp-a", 1)
+	suite.Require().Equal(types.NewProtection(1, "xrp-a", suite.addrs[2], d("3.0"), c("xrp", 0)), protection)
+
+	err = suite.keeper.RemoveCdpProtection(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.Require().NoError(err)
+	_, found = suite.keeper.GetProtection(suite.ctx, "xrp-a", 1)
+	suite.Require().False(found)
+	err = suite.keeper.RemoveCdpProtection(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrProtectionNotFound))
+}
+
+func (suite *ProtectionTestSuite) TestTopUpProtectedCdps() {
+	bk := suite.app.GetBankKeeper()
+
+	err := suite.keeper.SetCdpProtection(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp-a", d("2.5"))
+	suite.Require().NoError(err)
+
+	// nothing is deposited without an allowance
+	err = suite.keeper.TopUpProtectedCdps(suite.ctx, "xrp-a")
+	suite.Require().NoError(err)
+	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().Equal(c("xrp", 400000000), cdp.Collateral)
+
+	// the top up is capped by the allowance
+	err = suite.keeper.ApproveCdpProtection(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp-a", c("xrp", 30000000))
+	suite.Require().NoError(err)
+	err = suite.keeper.TopUpProtectedCdps(suite.ctx, "xrp-a")
+	suite.Require().NoError(err)
+	cdp, _ = suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().Equal(c("xrp", 430000000), cdp.Collateral)
+	protection, _ := suite.keeper.GetProtection(suite.ctx, "xrp-a", 1)
+	suite.Require().Equal(c("xrp", 0), protection.Allowance)
+
+	// $112.50 of collateral brings 45 usdx of debt to the 2.5 target ratio
+	err = suite.keeper.ApproveCdpProtection(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp-a", c("xrp", 100000000))
+	suite.Require().NoError(err)
+	err = suite.keeper.TopUpProtectedCdps(suite.ctx, "xrp-a")
+	suite.Require().NoError(err)
+	cdp, _ = suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().Equal(c("xrp", 450000000), cdp.Collateral)
+	protection, _ = suite.keeper.GetProtection(suite.ctx, "xrp-a", 1)
+	suite.Require().Equal(c("xrp", 80000000), protection.Allowance)
+	suite.Require().Equal(i(150000000), bk.GetBalance(suite.ctx, suite.addrs[1], "xrp").Amount)
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, 1, suite.addrs[1])
+	suite.Require().True(found)
+	suite.Require().Equal(c("xrp", 50000000), deposit.Amount)
+
+	// a cdp at its target ratio is not topped up
+	err = suite.keeper.TopUpProtectedCdps(suite.ctx, "xrp-a")
+	suite.Require().NoError(err)
+	cdp, _ = suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().Equal(c("xrp", 450000000), cdp.Collateral)
+
+	// closing the cdp removes its protection and returns the reserve's deposit
+	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("usdx", 45000000))
+	suite.Require().NoError(err)
+	_, found = suite.keeper.GetProtection(suite.ctx, "xrp-a", 1)
+	suite.Require().False(found)
+	suite.Require().Equal(i(200000000), bk.GetBalance(suite.ctx, suite.addrs[1], "xrp").Amount)
+}
+
+func (suite *ProtectionTestSuite) TestTopUpProtectedCdpsInBatches() {
+	err := suite.keeper.SetCdpProtection(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp-a", d("2.5"))
+	suite.Require().NoError(err)
+	err = suite.keeper.ApproveCdpProtection(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp-a", c("xrp", 30000000))
+	suite.Require().NoError(err)
+	// protections of closed cdps fill up the rest of the first batch and all of the second
+	for id := uint64(2); id <= 200; id++ {
+		suite.keeper.SetProtection(suite.ctx, types.NewProtection(id, "xrp-a", suite.addrs[1], d("2.5"), c("xrp", 0)))
+	}
+
+	err = suite.keeper.TopUpProtectedCdps(suite.ctx, "xrp-a")
+	suite.Require().NoError(err)
+	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().Equal(c("xrp", 430000000), cdp.Collateral)
+
+	// the second batch does not reach cdp 1
+	err = suite.keeper.ApproveCdpProtection(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp-a", c("xrp", 100000000))
+	suite.Require().NoError(err)
+	err = suite.keeper.TopUpProtectedCdps(suite.ctx, "xrp-a")
+	suite.Require().NoError(err)
+	cdp, _ = suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().Equal(c("xrp", 430000000), cdp.Collateral)
+
+	// the third batch wraps around to the first protection
+	err = suite.keeper.TopUpProtectedCdps(suite.ctx, "xrp-a")
+	suite.Require().NoError(err)
+	cdp, _ = suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().Equal(c("xrp", 450000000), cdp.Collateral)
+}
+
+func TestProtectionTestSuite(t *testing.T) {
+	suite.Run(t, new(ProtectionTestSuite))
+}
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// maxRedemptionCdps is the maximum number of cdps a single redemption is made against
+const maxRedemptionCdps = 100
+
+// RedeemUSDX redeems debt asset at face value for collateral of the lowest collateralized cdps of a collateral type, at
+// the spot price, less the redemption fee. The debt of each cdp is repaid in turn, fees first, until the amount is
+// redeemed. Cdps below the liquidation ratio or with additional collateral are skipped, and a cdp is only partially
+// redeemed against if its remaining debt is at least the debt floor. Any amount that could not be redeemed stays with
+// the redeemer, and the redeemed amount is returned.
+func (k Keeper) RedeemUSDX(ctx sdk.Context, redeemer sdk.AccAddress, collateralType string, amount sdk.Coin) (sdk.Coin, error) {
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrCollateralNotSupported, collateralType)
+	}
+	dp, found := k.GetDebtParam(ctx, amount.Denom)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidPayment, "redemption denom %s not found", amount.Denom)
+	}
+	err := k.ValidateBalance(ctx, amount, redeemer)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.SpotMarketID)
+	if err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrPricefeedDown, cp.SpotMarketID)
+	}
+	redemptionFee := k.GetParams(ctx).RedemptionFee
+	if redemptionFee.IsNil() {
+		redemptionFee = sdk.ZeroDec()
+	}
+
+	var cdps types.CDPs
+	k.IterateCdpsByCollateralRatio(ctx, collateralType, types.MaxSortableDec, func(cdp types.CDP) bool {
+		cdps = append(cdps, cdp)
+		return len(cdps) >= maxRedemptionCdps
+	})
+
+	remaining := amount
+	for _, cdp := range cdps {
+		if !remaining.IsPositive() {
+			break
+		}
+		if cdp.HasAdditionalCollateral() || cdp.Principal.Denom != amount.Denom {
+			continue
+		}
+		k.hooks.BeforeCDPModified(ctx, cdp)
+		cdp = k.SynchronizeInterest(ctx, cdp)
+
+		ratio, err := k.CalculateCollateralizationRatio(ctx, cdp.Collateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees, spot)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		if ratio.LT(cp.LiquidationRatio) {
+			continue
+		}
+
+		debt := cdp.GetTotalPrincipal()
+		payment := sdk.NewCoin(debt.Denom, sdk.MinInt(remaining.Amount, debt.Amount))
+		if payment.Amount.LT(debt.Amount) && debt.Amount.Sub(payment.Amount).LT(dp.DebtFloor) {
+			payment.Amount = debt.Amount.Sub(dp.DebtFloor)
+		}
+		if !payment.IsPositive() {
+			continue
+		}
+
+		// collateral worth the payment at the spot price, rounded down, less the redemption fee rounded up
+		value := k.convertDebtToBaseUnits(ctx, payment).Quo(price.Price)
+		worth := value.Mul(sdk.NewDecFromInt(sdkmath.NewIntWithDecimal(1, int(cp.ConversionFactor.Int64())))).TruncateInt()
+		fee := sdk.NewDecFromInt(worth).Mul(redemptionFee).Ceil().TruncateInt()
+		collateral := sdk.NewCoin(cdp.Collateral.Denom, worth.Sub(fee))
+		if !collateral.IsPositive() || collateral.Amount.GT(cdp.Collateral.Amount) {
+			continue
+		}
+
+		err = k.redeemCdp(ctx, redeemer, cdp, payment, collateral, sdk.NewCoin(collateral.Denom, fee))
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		remaining = remaining.Sub(payment)
+	}
+
+	redeemed := amount.Sub(remaining)
+	if !redeemed.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrNoRedeemableCdps, "collateral type %s", collateralType)
+	}
+	return redeemed, nil
+}
+
+// redeemCdp repays the payment of a cdp's debt from the redeemer and sends the redeemed collateral, taken from the
+// cdp's deposits in proportion to their size, to the redeemer. If all debt is repaid, the remaining collateral is
+// returned to depositors and the cdp is removed from the store.
+func (k Keeper) redeemCdp(ctx sdk.Context, redeemer sdk.AccAddress, cdp types.CDP, payment, collateral, fee sdk.Coin) error {
+	feePayment, principalPayment := k.calculatePayment(ctx, cdp.GetTotalPrincipal(), cdp.AccumulatedFees, payment)
+
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, redeemer, types.ModuleName, sdk.NewCoins(payment))
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(payment))
+	if err != nil {
+		panic(err)
+	}
+
+	debtDenom := k.GetDebtDenom(ctx)
+	coinsToBurn := sdk.NewCoin(debtDenom, sdk.MinInt(payment.Amount, k.getModAccountDebt(ctx, types.ModuleName)))
+	err = k.BurnDebtCoins(ctx, types.ModuleName, debtDenom, coinsToBurn)
+	if err != nil {
+		panic(err)
+	}
+
+	k.seizeDeposits(ctx, cdp.ID, cdp.Collateral, collateral)
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, redeemer, sdk.NewCoins(collateral))
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpRedemption,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, payment.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyCollateral, collateral.String()),
+			sdk.NewAttribute(types.AttributeKeyRedemptionFee, fee.String()),
+		),
+	)
+
+	cdp.Collateral = cdp.Collateral.Sub(collateral)
+	cdp.Principal = cdp.Principal.Sub(principalPayment)
+	cdp.AccumulatedFees = cdp.AccumulatedFees.Sub(feePayment)
+	k.DecrementTotalPrincipal(ctx, cdp.Type, payment)
+	k.hooks.AfterDebtChanged(ctx, cdp.Owner, cdp.Type, payment.Amount.Neg())
+
+	if cdp.Principal.IsZero() && cdp.AccumulatedFees.IsZero() {
+		k.ReturnCollateral(ctx, cdp)
+		k.RemoveCdpOwnerIndex(ctx, cdp)
+		err := k.DeleteCdpAndCollateralRatioIndex(ctx, cdp)
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpClose,
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			),
+		)
+		return nil
+	}
+
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
+	return k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+type RedeemTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *RedeemTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	authGS := app.NewFundedGenStateWithCoins(
+		cdc,
+		[]sdk.Coins{
+			cs(c("xrp", 400000000)),
+			cs(c("xrp", 400000000)),
+			cs(c("btc", 100000000)),
+		},
+		addrs,
+	)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.RedemptionFee = types.DefaultRedemptionFee
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// collateralization ratios of 2.5 and 5.0 at an xrp price of 0.25
+	err := suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 400000000), c("usdx", 40000000), "xrp-a")
+	suite.Require().NoError(err)
+	err = suite.keeper.AddCdp(suite.ctx, addrs[1], c("xrp", 400000000), c("usdx", 20000000), "xrp-a")
+	suite.Require().NoError(err)
+	err = suite.keeper.AddCdp(suite.ctx, addrs[2], c("btc", 100000000), c("usdx", 100000000), "btc-a")
+	suite.Require().NoError(err)
+}
+
+func (suite *RedeemTestSuite) TestRedeemUSDX() {
+	bk := suite.app.GetBankKeeper()
+	redeemer := suite.addrs[2]
+
+	// the lowest collateralized cdp is redeemed against down to the debt floor, then the next one
+	redeemed, err := suite.keeper.RedeemUSDX(suite.ctx, redeemer, "xrp-a", c("usdx", 35000000))
+	suite.Require().NoError(err)
+	suite.Require().Equal(c("usdx", 35000000), redeemed)
+	// 120 xrp for 30 usdx and 20 xrp for 5 usdx, less the 0.5% redemption fee
+	suite.Require().Equal(c("xrp", 139300000), bk.GetBalance(suite.ctx, redeemer, "xrp"))
+	suite.Require().Equal(c("usdx", 65000000), bk.GetBalance(suite.ctx, redeemer, "usdx"))
+
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.Require().True(found)
+	suite.Require().Equal(c("xrp", 280600000), cdp.Collateral)
+	suite.Require().Equal(c("usdx", 10000000), cdp.Principal)
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, cdp.ID, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().Equal(c("xrp", 280600000), deposit.Amount)
+
+	cdp, found = suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[1], "xrp-a")
+	suite.Require().True(found)
+	suite.Require().Equal(c("xrp", 380100000), cdp.Collateral)
+	suite.Require().Equal(c("usdx", 15000000), cdp.Principal)
+	suite.Require().Equal(i(25000000), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx"))
+
+	// redemptions raise the collateralization ratio, so the other cdp is now the lowest collateralized. A cdp whose
+	// debt is fully redeemed is closed and its remaining collateral returned.
+	redeemed, err = suite.keeper.RedeemUSDX(suite.ctx, redeemer, "xrp-a", c("usdx", 15000000))
+	suite.Require().NoError(err)
+	suite.Require().Equal(c("usdx", 15000000), redeemed)
+	_, found = suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[1], "xrp-a")
+	suite.Require().False(found)
+	suite.Require().Equal(c("xrp", 320400000), bk.GetBalance(suite.ctx, suite.addrs[1], "xrp"))
+	cdp, found = suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.Require().True(found)
+	suite.Require().Equal(c("usdx", 10000000), cdp.Principal)
+
+	// cdps at the debt floor cannot be redeemed against
+	_, err = suite.keeper.RedeemUSDX(suite.ctx, redeemer, "xrp-a", c("usdx", 1000000))
+	suite.Require().True(errors.Is(err, types.ErrNoRedeemableCdps))
+
+	_, err = suite.keeper.RedeemUSDX(suite.ctx, redeemer, "xrp-a", c("usdx", 100000000))
+	suite.Require().True(errors.Is(err, types.ErrInsufficientBalance))
+	_, err = suite.keeper.RedeemUSDX(suite.ctx, redeemer, "dai-a", c("usdx", 1000000))
+	suite.Require().True(errors.Is(err, types.ErrCollateralNotSupported))
+}
+
+func TestRedeemTestSuite(t *testing.T) {
+	suite.Run(t, new(RedeemTestSuite))
+}
@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// DepositSavings sends debt asset from the depositor to the cdp module account, adding it to the depositor's savings
+// deposit to earn the savings rate
+func (k Keeper) DepositSavings(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coin) error {
+	savingsDenom := k.GetParams(ctx).DebtParam.Denom
+	if amount.Denom != savingsDenom {
+		return errorsmod.Wrapf(types.ErrInvalidDeposit, "savings deposit denom %s, expected %s", amount.Denom, savingsDenom)
+	}
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	accumulator := k.GetSavingsAccumulator(ctx)
+	deposit, found := k.GetSavingsDeposit(ctx, depositor)
+	if !found {
+		deposit = types.NewSavingsDeposit(depositor, sdk.NewCoin(savingsDenom, sdk.ZeroInt()), accumulator.SavingsFactor, sdk.NewCoin(savingsDenom, sdk.ZeroInt()))
+	}
+	deposit = k.SynchronizeSavingsDeposit(ctx, deposit)
+	deposit.Amount = deposit.Amount.Add(amount)
+	k.SetSavingsDeposit(ctx, deposit)
+
+	accumulator.TotalSavings = accumulator.TotalSavings.Add(amount.Amount)
+	k.SetSavingsAccumulator(ctx, accumulator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSavingsDeposit,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+		),
+	)
+	return nil
+}
+
+// WithdrawSavings sends debt asset from the cdp module account to the depositor, removing it from the depositor's
+// savings deposit. The deposit is deleted once it is fully withdrawn.
+func (k Keeper) WithdrawSavings(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coin) error {
+	deposit, found := k.GetSavingsDeposit(ctx, depositor)
+	if !found {
+		return errorsmod.Wrapf(types.ErrSavingsDepositNotFound, "depositor %s", depositor)
+	}
+	deposit = k.SynchronizeSavingsDeposit(ctx, deposit)
+	if amount.Denom != deposit.Amount.Denom || amount.Amount.GT(deposit.Amount.Amount) {
+		return errorsmod.Wrapf(types.ErrInvalidWithdrawAmount, "%s > %s", amount, deposit.Amount)
+	}
+
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	deposit.Amount = deposit.Amount.Sub(amount)
+	if deposit.Amount.IsZero() {
+		k.DeleteSavingsDeposit(ctx, depositor)
+	} else {
+		k.SetSavingsDeposit(ctx, deposit)
+	}
+
+	accumulator := k.GetSavingsAccumulator(ctx)
+	accumulator.TotalSavings = accumulator.TotalSavings.Sub(sdk.MinInt(amount.Amount, accumulator.TotalSavings))
+	k.SetSavingsAccumulator(ctx, accumulator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSavingsWithdrawal,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+		),
+	)
+	return nil
+}
+
+// AccumulateSavings grows the savings factor by the savings rate for the time since savings last accrued, and moves
+// the interest owed to savings deposits from the surplus in the liquidator module account to the cdp module account.
+// If the surplus cannot cover the interest, all of it is paid and the savings factor grows by that amount instead.
+func (k Keeper) AccumulateSavings(ctx sdk.Context) error {
+	accumulator := k.GetSavingsAccumulator(ctx)
+	if accumulator.PreviousAccrualTime.IsZero() {
+		accumulator.PreviousAccrualTime = ctx.BlockTime()
+		k.SetSavingsAccumulator(ctx, accumulator)
+		return nil
+	}
+
+	timeElapsed := int64(math.RoundToEven(
+		ctx.BlockTime().Sub(accumulator.PreviousAccrualTime).Seconds(),
+	))
+	if timeElapsed <= 0 {
+		return nil
+	}
+
+	savingsRate := k.GetParams(ctx).SavingsRate
+	if savingsRate.IsNil() || savingsRate.LTE(sdk.OneDec()) || !accumulator.TotalSavings.IsPositive() {
+		accumulator.PreviousAccrualTime = ctx.BlockTime()
+		k.SetSavingsAccumulator(ctx, accumulator)
+		return nil
+	}
+
+	savingsFactor := CalculateInterestFactor(savingsRate, sdkmath.NewInt(timeElapsed))
+	// interest is rounded up so the module always holds enough to pay every deposit
+	interest := savingsFactor.MulInt(accumulator.TotalSavings).Ceil().TruncateInt().Sub(accumulator.TotalSavings)
+	if interest.IsZero() {
+		// in the case accumulated interest rounds to zero, exit early without updating accrual time
+		return nil
+	}
+
+	savingsDenom := k.GetParams(ctx).DebtParam.Denom
+	surplus := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(types.LiquidatorMacc), savingsDenom).Amount
+	if interest.GT(surplus) {
+		interest = surplus
+		savingsFactor = sdk.OneDec().Add(sdk.NewDecFromInt(interest).QuoInt(accumulator.TotalSavings))
+	}
+	if interest.IsPositive() {
+		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.LiquidatorMacc, types.ModuleName, sdk.NewCoins(sdk.NewCoin(savingsDenom, interest)))
+		if err != nil {
+			return err
+		}
+	}
+
+	accumulator.SavingsFactor = accumulator.SavingsFactor.Mul(savingsFactor)
+	accumulator.TotalSavings = accumulator.TotalSavings.Add(interest)
+	accumulator.PreviousAccrualTime = ctx.BlockTime()
+	k.SetSavingsAccumulator(ctx, accumulator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSavingsAccrual,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(savingsDenom, interest).String()),
+			sdk.NewAttribute(types.AttributeKeySavingsFactor, accumulator.SavingsFactor.String()),
+		),
+	)
+	return nil
+}
+
+// SynchronizeSavingsDeposit returns the savings deposit with the interest accrued since it was last synchronized added
+// to its amount. The deposit is not updated in the store.
+func (k Keeper) SynchronizeSavingsDeposit(ctx sdk.Context, deposit types.SavingsDeposit) types.SavingsDeposit {
+	savingsFactor := k.GetSavingsAccumulator(ctx).SavingsFactor
+	if deposit.SavingsFactor.Equal(savingsFactor) {
+		return deposit
+	}
+
+	// the deposit amount is rounded down so the module always holds enough to pay every deposit
+	amount := sdk.NewDecFromInt(deposit.Amount.Amount).Mul(savingsFactor).Quo(deposit.SavingsFactor).TruncateInt()
+	interest := sdk.NewCoin(deposit.Amount.Denom, amount.Sub(deposit.Amount.Amount))
+
+	deposit.Amount = deposit.Amount.Add(interest)
+	deposit.AccruedInterest = deposit.AccruedInterest.Add(interest)
+	deposit.SavingsFactor = savingsFactor
+	return deposit
+}
+
+// GetSavingsAccumulator returns the savings accumulator from the store, or the default accumulator if it is not set
+func (k Keeper) GetSavingsAccumulator(ctx sdk.Context) types.SavingsAccumulator {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.SavingsAccumulatorKey)
+	if bz == nil {
+		return types.DefaultSavingsAccumulator()
+	}
+	var accumulator types.SavingsAccumulator
+	k.cdc.MustUnmarshal(bz, &accumulator)
+	return accumulator
+}
+
+// SetSavingsAccumulator sets the savings accumulator in the store
+func (k Keeper) SetSavingsAccumulator(ctx sdk.Context, accumulator types.SavingsAccumulator) {
+	store := ctx.KVStore(k.key)
+	accumulator = accumulator.Normalize()
+	bz := k.cdc.MustMarshal(&accumulator)
+	store.Set(types.SavingsAccumulatorKey, bz)
+}
+
+// GetSavingsDeposit returns the savings deposit of a depositor from the store
+func (k Keeper) GetSavingsDeposit(ctx sdk.Context, depositor sdk.AccAddress) (deposit types.SavingsDeposit, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsDepositKeyPrefix)
+	bz := store.Get(depositor)
+	if bz == nil {
+		return deposit, false
+	}
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return deposit, true
+}
+
+// SetSavingsDeposit sets the savings deposit of a depositor in the store
+func (k Keeper) SetSavingsDeposit(ctx sdk.Context, deposit types.SavingsDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsDepositKeyPrefix)
+	bz := k.cdc.MustMarshal(&deposit)
+	store.Set(deposit.Depositor, bz)
+}
+
+// DeleteSavingsDeposit deletes the savings deposit of a depositor from the store
+func (k Keeper) DeleteSavingsDeposit(ctx sdk.Context, depositor sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsDepositKeyPrefix)
+	store.Delete(depositor)
+}
+
+// IterateSavingsDeposits iterates over all savings deposits and performs a callback function
+func (k Keeper) IterateSavingsDeposits(ctx sdk.Context, cb func(deposit types.SavingsDeposit) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsDepositKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.SavingsDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+
+		if cb(deposit) {
+			break
+		}
+	}
+}
+
+// GetAllSavingsDeposits returns all savings deposits from the store
+func (k Keeper) GetAllSavingsDeposits(ctx sdk.Context) (deposits types.SavingsDeposits) {
+	k.IterateSavingsDeposits(ctx, func(deposit types.SavingsDeposit) bool {
+		deposits = append(deposits, deposit)
+		return false
+	})
+	return
+}
@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+type SavingsTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *SavingsTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	authGS := app.NewFundedGenStateWithSameCoins(cdc, cs(c("usdx", 100000000), c("xrp", 100000000)), addrs)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.SavingsRate = sdk.MustNewDecFromStr("1.000000001547125958") // 5% apy
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+func (suite *SavingsTestSuite) fundSurplus(amount sdkmath.Int) {
+	err := suite.app.FundModuleAccount(suite.ctx, types.LiquidatorMacc, cs(sdk.NewCoin("usdx", amount)))
+	suite.Require().NoError(err)
+}
+
+func (suite *SavingsTestSuite) balance(addr sdk.AccAddress) sdkmath.Int {
+	return suite.app.GetBankKeeper().GetBalance(suite.ctx, addr, "usdx").Amount
+}
+
+func (suite *SavingsTestSuite) moduleBalance(name string) sdkmath.Int {
+	return suite.balance(suite.app.GetAccountKeeper().GetModuleAddress(name))
+}
+
+func (suite *SavingsTestSuite) TestDepositWithdrawSavings() {
+	err := suite.keeper.DepositSavings(suite.ctx, suite.addrs[0], c("usdx", 60000000))
+	suite.Require().NoError(err)
+	err = suite.keeper.DepositSavings(suite.ctx, suite.addrs[0], c("usdx", 40000000))
+	suite.Require().NoError(err)
+
+	deposit, found := suite.keeper.GetSavingsDeposit(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Equal(c("usdx", 100000000), deposit.Amount)
+	suite.Equal(sdk.OneDec(), deposit.SavingsFactor)
+	suite.Equal(i(0), suite.balance(suite.addrs[0]))
+	suite.Equal(i(100000000), suite.moduleBalance(types.ModuleName))
+	suite.Equal(i(100000000), suite.keeper.GetSavingsAccumulator(suite.ctx).TotalSavings)
+
+	err = suite.keeper.DepositSavings(suite.ctx, suite.addrs[1], c("xrp", 1000000))
+	suite.Require().ErrorIs(err, types.ErrInvalidDeposit)
+
+	err = suite.keeper.WithdrawSavings(suite.ctx, suite.addrs[0], c("usdx", 100000001))
+	suite.Require().ErrorIs(err, types.ErrInvalidWithdrawAmount)
+	err = suite.keeper.WithdrawSavings(suite.ctx, suite.addrs[1], c("usdx", 1))
+	suite.Require().ErrorIs(err, types.ErrSavingsDepositNotFound)
+
+	err = suite.keeper.WithdrawSavings(suite.ctx, suite.addrs[0], c("usdx", 30000000))
+	suite.Require().NoError(err)
+	deposit, found = suite.keeper.GetSavingsDeposit(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Equal(c("usdx", 70000000), deposit.Amount)
+	suite.Equal(i(30000000), suite.balance(suite.addrs[0]))
+	suite.Equal(i(70000000), suite.keeper.GetSavingsAccumulator(suite.ctx).TotalSavings)
+
+	// a fully withdrawn deposit is deleted
+	err = suite.keeper.WithdrawSavings(suite.ctx, suite.addrs[0], c("usdx", 70000000))
+	suite.Require().NoError(err)
+	_, found = suite.keeper.GetSavingsDeposit(suite.ctx, suite.addrs[0])
+	suite.False(found)
+	suite.Equal(i(100000000), suite.balance(suite.addrs[0]))
+	suite.Equal(i(0), suite.keeper.GetSavingsAccumulator(suite.ctx).TotalSavings)
+}
+
+func (suite *SavingsTestSuite) TestAccumulateSavings() {
+	suite.fundSurplus(i(100000000))
+
+	// the first accrual only starts the clock
+	err := suite.keeper.AccumulateSavings(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Equal(suite.ctx.BlockTime(), suite.keeper.GetSavingsAccumulator(suite.ctx).PreviousAccrualTime)
+
+	err = suite.keeper.DepositSavings(suite.ctx, suite.addrs[0], c("usdx", 100000000))
+	suite.Require().NoError(err)
+	err = suite.keeper.DepositSavings(suite.ctx, suite.addrs[1], c("usdx", 50000000))
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(365 * 24 * time.Hour))
+	err = suite.keeper.AccumulateSavings(suite.ctx)
+	suite.Require().NoError(err)
+
+	accumulator := suite.keeper.GetSavingsAccumulator(suite.ctx)
+	expectedFactor := keeper.CalculateInterestFactor(suite.keeper.GetParams(suite.ctx).SavingsRate, i(365*24*60*60))
+	expectedInterest := expectedFactor.MulInt(i(150000000)).Ceil().TruncateInt().Sub(i(150000000))
+	suite.Equal(expectedFactor, accumulator.SavingsFactor)
+	suite.Equal(i(150000000).Add(expectedInterest), accumulator.TotalSavings)
+	suite.Equal(suite.ctx.BlockTime(), accumulator.PreviousAccrualTime)
+	suite.Equal(i(100000000).Sub(expectedInterest), suite.moduleBalance(types.LiquidatorMacc))
+	suite.Equal(accumulator.TotalSavings, suite.moduleBalance(types.ModuleName))
+
+	// deposits accrue interest in proportion to their amount
+	deposit, found := suite.keeper.GetSavingsDeposit(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	deposit = suite.keeper.SynchronizeSavingsDeposit(suite.ctx, deposit)
+	suite.Equal(expectedFactor.MulInt(i(100000000)).TruncateInt(), deposit.Amount.Amount)
+	suite.Equal(deposit.Amount.Amount.Sub(i(100000000)), deposit.AccruedInterest.Amount)
+	suite.True(deposit.AccruedInterest.IsPositive())
+
+	// both deposits can be withdrawn in full with their interest
+	for _, addr := range suite.addrs {
+		deposit, found := suite.keeper.GetSavingsDeposit(suite.ctx, addr)
+		suite.Require().True(found)
+		deposit = suite.keeper.SynchronizeSavingsDeposit(suite.ctx, deposit)
+		err = suite.keeper.WithdrawSavings(suite.ctx, addr, deposit.Amount)
+		suite.Require().NoError(err)
+	}
+	suite.Empty(suite.keeper.GetAllSavingsDeposits(suite.ctx))
+	suite.False(suite.moduleBalance(types.ModuleName).IsNegative())
+}
+
+func (suite *SavingsTestSuite) TestAccumulateSavings_LimitedBySurplus() {
+	suite.fundSurplus(i(1000))
+
+	err := suite.keeper.AccumulateSavings(suite.ctx)
+	suite.Require().NoError(err)
+	err = suite.keeper.DepositSavings(suite.ctx, suite.addrs[0], c("usdx", 100000000))
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(365 * 24 * time.Hour))
+	err = suite.keeper.AccumulateSavings(suite.ctx)
+	suite.Require().NoError(err)
+
+	// the whole surplus is paid and the savings factor only grows by that much
+	accumulator := suite.keeper.GetSavingsAccumulator(suite.ctx)
+	suite.Equal(i(100001000), accumulator.TotalSavings)
+	suite.Equal(sdk.MustNewDecFromStr("1.00001"), accumulator.SavingsFactor)
+	suite.Equal(i(0), suite.moduleBalance(types.LiquidatorMacc))
+
+	deposit, found := suite.keeper.GetSavingsDeposit(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	deposit = suite.keeper.SynchronizeSavingsDeposit(suite.ctx, deposit)
+	suite.Equal(c("usdx", 100001000), deposit.Amount)
+}
+
+func (suite *SavingsTestSuite) TestAccumulateSavings_NoSavingsRate() {
+	suite.fundSurplus(i(100000000))
+	params := suite.keeper.GetParams(suite.ctx)
+	params.SavingsRate = sdk.OneDec()
+	suite.keeper.SetParams(suite.ctx, params)
+
+	err := suite.keeper.AccumulateSavings(suite.ctx)
+	suite.Require().NoError(err)
+	err = suite.keeper.DepositSavings(suite.ctx, suite.addrs[0], c("usdx", 100000000))
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(365 * 24 * time.Hour))
+	err = suite.keeper.AccumulateSavings(suite.ctx)
+	suite.Require().NoError(err)
+
+	accumulator := suite.keeper.GetSavingsAccumulator(suite.ctx)
+	suite.Equal(sdk.OneDec(), accumulator.SavingsFactor)
+	suite.Equal(i(100000000), accumulator.TotalSavings)
+	suite.Equal(suite.ctx.BlockTime(), accumulator.PreviousAccrualTime)
+	suite.Equal(i(100000000), suite.moduleBalance(types.LiquidatorMacc))
+}
+
+func TestSavingsTestSuite(t *testing.T) {
+	suite.Run(t, new(SavingsTestSuite))
+}
@@ -12,7 +12,7 @@ import (
 
 // AttemptKeeperLiquidation liquidates the cdp with the input collateral type and owner if it is below the required collateralization ratio
 // if the cdp is liquidated, the keeper that sent the transaction is rewarded a percentage of the collateral according to that collateral types'
-// keeper reward percentage.
+// keeper reward percentage, and is paid that collateral types' keeper incentive from the liquidator module account's surplus.
 func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper, owner sdk.AccAddress, collateralType string) error {
 	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
 	if !found {
@@ -21,7 +21,7 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper, owner sdk.AccA
 	k.hooks.BeforeCDPModified(ctx, cdp)
 	cdp = k.SynchronizeInterest(ctx, cdp)
 
-	err := k.ValidateLiquidation(ctx, cdp.Collateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees)
+	err := k.validateCdpLiquidation(ctx, cdp)
 	if err != nil {
 		return err
 	}
@@ -29,7 +29,26 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper, owner sdk.AccA
 	if err != nil {
 		return err
 	}
-	return k.SeizeCollateral(ctx, cdp)
+	err = k.LiquidateCdp(ctx, cdp)
+	if err != nil {
+		return err
+	}
+	return k.payoutKeeperIncentive(ctx, keeper, cdp)
+}
+
+// LiquidateCdp liquidates the input cdp. If the liquidation close factor of its collateral type is below one, only the
+// collateral needed to cover that fraction of its debt plus the liquidation penalty is seized, unless the cdp has
+// additional collateral, or the remaining debt would be below the debt floor, or all of its collateral would be needed.
+// Otherwise all of its collateral is seized.
+func (k Keeper) LiquidateCdp(ctx sdk.Context, cdp types.CDP) error {
+	collateral, debt, partial, err := k.calculatePartialLiquidation(ctx, cdp)
+	if err != nil {
+		return err
+	}
+	if !partial {
+		return k.SeizeCollateral(ctx, cdp)
+	}
+	return k.SeizePartialCollateral(ctx, cdp, collateral, debt)
 }
 
 // SeizeCollateral liquidates the collateral in the input cdp.
@@ -39,6 +58,7 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper, owner sdk.AccA
 // 3. Debt coins are sent from the cdp module to the liquidator module account
 // 4. The total amount of principal outstanding for that collateral type is decremented
 // (this is the equivalent of saying that fees are no longer accumulated by a cdp once it gets liquidated)
+// Additional collateral is sent to the liquidator module account and auctioned with the owner as the return address.
 func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) error {
 	// Calculate the previous collateral ratio
 	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
@@ -49,7 +69,14 @@ func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) error {
 	modAccountDebt := k.getModAccountDebt(ctx, types.ModuleName)
 	debt = sdk.MinInt(debt, modAccountDebt)
 	debtCoin := sdk.NewCoin(k.GetDebtDenom(ctx), debt)
-	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(debtCoin))
+
+	// split the debt between the collateral types before moving any coins, as it depends on prices
+	depositsDebt, additionalDebts, err := k.allocateLiquidatedDebt(ctx, cdp, debt)
+	if err != nil {
+		return err
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(debtCoin))
 	if err != nil {
 		return err
 	}
@@ -72,14 +99,45 @@ func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) error {
 		)
 	}
 
-	err = k.AuctionCollateral(ctx, deposits, cdp.Type, debt, cdp.Principal.Denom)
+	// liquidate additional collateral, which is held on behalf of the cdp owner
+	additionalDeposits := make(types.Deposits, len(cdp.AdditionalCollateral))
+	for i, position := range cdp.AdditionalCollateral {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(position.Amount)); err != nil {
+			return err
+		}
+		additionalDeposits[i] = types.NewDeposit(cdp.ID, cdp.Owner, position.Amount)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpLiquidation,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+				sdk.NewAttribute(types.AttributeKeyDeposit, additionalDeposits[i].String()),
+			),
+		)
+	}
+
+	err = k.AuctionCollateral(ctx, deposits, cdp.Type, depositsDebt, cdp.Principal.Denom)
 	if err != nil {
 		return err
 	}
+	for i, position := range cdp.AdditionalCollateral {
+		err = k.AuctionCollateral(ctx, types.Deposits{additionalDeposits[i]}, position.CollateralType, additionalDebts[i], cdp.Principal.Denom)
+		if err != nil {
+			return err
+		}
+	}
 
 	// Decrement total principal for this collateral type
 	coinsToDecrement := cdp.GetTotalPrincipal()
 	k.DecrementTotalPrincipal(ctx, cdp.Type, coinsToDecrement)
+	k.hooks.AfterDebtChanged(ctx, cdp.Owner, cdp.Type, coinsToDecrement.Amount.Neg())
+
+	seizedCollateral := sdk.NewCoins(cdp.Collateral)
+	for _, position := range cdp.AdditionalCollateral {
+		seizedCollateral = seizedCollateral.Add(position.Amount)
+	}
+	emitLiquidationAmountsEvent(ctx, cdp.ID, seizedCollateral, coinsToDecrement, sdk.NewCoins(), sdk.NewCoins())
 
 	// Delete CDP from state
 	k.RemoveCdpOwnerIndex(ctx, cdp)
@@ -87,6 +145,145 @@ func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) error {
 	return k.DeleteCDP(ctx, cdp)
 }
 
+// SeizePartialCollateral liquidates the input amounts of collateral and debt from the input cdp, leaving the cdp open
+// with the remainder. Collateral is seized from each deposit in proportion to its size and auctioned to recover the
+// seized debt, and the seized debt repays the cdp's accumulated fees before its principal.
+func (k Keeper) SeizePartialCollateral(ctx sdk.Context, cdp types.CDP, collateral sdk.Coin, debt sdk.Coin) error {
+	if collateral.Denom != cdp.Collateral.Denom || !collateral.IsPositive() || collateral.IsGTE(cdp.Collateral) {
+		return errorsmod.Wrapf(types.ErrInvalidCollateral, "seized collateral %s, cdp collateral %s", collateral, cdp.Collateral)
+	}
+	if debt.Denom != cdp.Principal.Denom || !debt.IsPositive() || debt.IsGTE(cdp.GetTotalPrincipal()) {
+		return errorsmod.Wrapf(types.ErrInvalidPayment, "seized debt %s, cdp debt %s", debt, cdp.GetTotalPrincipal())
+	}
+
+	// Move debt coins for the seized debt from cdp to liquidator account
+	debtAmount := sdk.MinInt(debt.Amount, k.getModAccountDebt(ctx, types.ModuleName))
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(k.GetDebtDenom(ctx), debtAmount)))
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(collateral))
+	if err != nil {
+		return err
+	}
+
+	seizedDeposits := k.seizeDeposits(ctx, cdp.ID, cdp.Collateral, collateral)
+	for _, dep := range seizedDeposits {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpLiquidation,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+				sdk.NewAttribute(types.AttributeKeyDeposit, dep.String()),
+			),
+		)
+	}
+
+	err = k.AuctionCollateral(ctx, seizedDeposits, cdp.Type, debtAmount, cdp.Principal.Denom)
+	if err != nil {
+		return err
+	}
+
+	k.DecrementTotalPrincipal(ctx, cdp.Type, debt)
+	k.hooks.AfterDebtChanged(ctx, cdp.Owner, cdp.Type, debt.Amount.Neg())
+
+	feePayment := sdk.NewCoin(debt.Denom, sdk.MinInt(debt.Amount, cdp.AccumulatedFees.Amount))
+	cdp.AccumulatedFees = cdp.AccumulatedFees.Sub(feePayment)
+	cdp.Principal = cdp.Principal.Sub(debt.Sub(feePayment))
+	cdp.Collateral = cdp.Collateral.Sub(collateral)
+
+	emitLiquidationAmountsEvent(ctx, cdp.ID, sdk.NewCoins(collateral), debt, sdk.NewCoins(cdp.Collateral), sdk.NewCoins(cdp.GetTotalPrincipal()))
+
+	ratio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
+	return k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, ratio)
+}
+
+// calculatePartialLiquidation returns the collateral and debt to seize in a partial liquidation of the input cdp,
+// and false if the cdp should instead be liquidated in full
+func (k Keeper) calculatePartialLiquidation(ctx sdk.Context, cdp types.CDP) (sdk.Coin, sdk.Coin, bool, error) {
+	cp, found := k.GetCollateral(ctx, cdp.Type)
+	if !found {
+		return sdk.Coin{}, sdk.Coin{}, false, errorsmod.Wrapf(types.ErrInvalidCollateral, "%s", cdp.Type)
+	}
+	closeFactor := cp.LiquidationCloseFactor
+	if cdp.HasAdditionalCollateral() || closeFactor.IsNil() || !closeFactor.IsPositive() || closeFactor.GTE(sdk.OneDec()) {
+		return sdk.Coin{}, sdk.Coin{}, false, nil
+	}
+
+	totalDebt := cdp.GetTotalPrincipal()
+	debt := sdk.NewCoin(totalDebt.Denom, sdk.NewDecFromInt(totalDebt.Amount).Mul(closeFactor).Ceil().TruncateInt())
+	dp, _ := k.GetDebtParam(ctx, totalDebt.Denom)
+	if !debt.IsPositive() || totalDebt.Amount.Sub(debt.Amount).LT(dp.DebtFloor) {
+		return sdk.Coin{}, sdk.Coin{}, false, nil
+	}
+
+	price, err := k.getCollateralPrice(ctx, cdp.Type, liquidation)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, false, err
+	}
+	if !price.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, false, nil
+	}
+
+	// collateral worth the seized debt plus the liquidation penalty at the liquidation price
+	value := k.convertDebtToBaseUnits(ctx, debt).Mul(sdk.OneDec().Add(cp.LiquidationPenalty))
+	amount := k.convertBaseUnitsToCollateral(ctx, value.Quo(price), cdp.Type)
+	if amount.GTE(cdp.Collateral.Amount) {
+		return sdk.Coin{}, sdk.Coin{}, false, nil
+	}
+	return sdk.NewCoin(cdp.Collateral.Denom, amount), debt, true, nil
+}
+
+// seizeDeposits removes the seized collateral from the deposits of a cdp in proportion to their size and returns the
+// amounts seized from each deposit. The rounding remainder is seized from the deposits in order.
+func (k Keeper) seizeDeposits(ctx sdk.Context, cdpID uint64, totalCollateral, seized sdk.Coin) types.Deposits {
+	deposits := k.GetDeposits(ctx, cdpID)
+	seizedDeposits := make(types.Deposits, len(deposits))
+	remainder := seized.Amount
+	for i, dep := range deposits {
+		amount := dep.Amount.Amount.Mul(seized.Amount).Quo(totalCollateral.Amount)
+		seizedDeposits[i] = types.NewDeposit(cdpID, dep.Depositor, sdk.NewCoin(seized.Denom, amount))
+		remainder = remainder.Sub(amount)
+	}
+	for i, dep := range deposits {
+		if !remainder.IsPositive() {
+			break
+		}
+		amount := sdk.MinInt(remainder, dep.Amount.Amount.Sub(seizedDeposits[i].Amount.Amount))
+		seizedDeposits[i].Amount = seizedDeposits[i].Amount.AddAmount(amount)
+		remainder = remainder.Sub(amount)
+	}
+
+	var nonZero types.Deposits
+	for i, dep := range deposits {
+		dep.Amount = dep.Amount.Sub(seizedDeposits[i].Amount)
+		if dep.Amount.IsZero() {
+			k.DeleteDeposit(ctx, dep.CdpID, dep.Depositor)
+		} else {
+			k.SetDeposit(ctx, dep)
+		}
+		if seizedDeposits[i].Amount.IsPositive() {
+			nonZero = append(nonZero, seizedDeposits[i])
+		}
+	}
+	return nonZero
+}
+
+// emitLiquidationAmountsEvent emits the collateral and debt seized from a liquidated cdp and the amounts remaining in it
+func emitLiquidationAmountsEvent(ctx sdk.Context, cdpID uint64, seizedCollateral sdk.Coins, seizedDebt sdk.Coin, remainingCollateral, remainingDebt sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpLiquidation,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdpID)),
+			sdk.NewAttribute(types.AttributeKeySeizedCollateral, seizedCollateral.String()),
+			sdk.NewAttribute(types.AttributeKeySeizedDebt, seizedDebt.String()),
+			sdk.NewAttribute(types.AttributeKeyRemainingCollateral, remainingCollateral.String()),
+			sdk.NewAttribute(types.AttributeKeyRemainingDebt, remainingDebt.String()),
+		),
+	)
+}
+
 // LiquidateCdps seizes collateral from all CDPs below the input liquidation ratio
 func (k Keeper) LiquidateCdps(ctx sdk.Context, marketID string, collateralType string, liquidationRatio sdk.Dec, count sdkmath.Int) error {
 	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
@@ -101,10 +298,20 @@ func (k Keeper) LiquidateCdps(ctx sdk.Context, marketID string, collateralType s
 	// liquidation ratio = 1.5
 	// normalizedRatio = (1/(0.5/1.5)) = 3
 	normalizedRatio := sdk.OneDec().Quo(priceDivLiqRatio)
-	cdpsToLiquidate := k.GetSliceOfCDPsByRatioAndType(ctx, count, normalizedRatio, collateralType)
+	// cdps are indexed by their primary collateral only, so cdps with additional collateral are checked against their
+	// combined liquidation ratio and skipped if it holds or can't be calculated. Skipped cdps don't count towards the
+	// number of cdps checked, so they can't crowd out liquidatable cdps further along the index.
+	var cdpsToLiquidate types.CDPs
+	k.IterateCdpsByCollateralRatio(ctx, collateralType, normalizedRatio, func(c types.CDP) bool {
+		if c.HasAdditionalCollateral() && k.validateCdpLiquidation(ctx, c) != nil {
+			return false
+		}
+		cdpsToLiquidate = append(cdpsToLiquidate, c)
+		return count.LTE(sdkmath.NewInt(int64(len(cdpsToLiquidate))))
+	})
 	for _, c := range cdpsToLiquidate {
 		k.hooks.BeforeCDPModified(ctx, c)
-		err := k.SeizeCollateral(ctx, c)
+		err := k.LiquidateCdp(ctx, c)
 		if err != nil {
 			return err
 		}
@@ -131,6 +338,66 @@ func (k Keeper) ValidateLiquidation(ctx sdk.Context, collateral sdk.Coin, collat
 	return nil
 }
 
+// validateCdpLiquidation validates that a cdp is below its liquidation ratio. Cdps with additional collateral are
+// validated against their combined liquidation ratio.
+func (k Keeper) validateCdpLiquidation(ctx sdk.Context, cdp types.CDP) error {
+	if !cdp.HasAdditionalCollateral() {
+		return k.ValidateLiquidation(ctx, cdp.Collateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees)
+	}
+	collateralizationRatio, liquidationRatio, err := k.CalculateCombinedCollateralizationRatio(ctx, cdp.Collateral, cdp.AdditionalCollateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees, liquidation)
+	if err != nil {
+		return err
+	}
+	if collateralizationRatio.GTE(liquidationRatio) {
+		return errorsmod.Wrapf(types.ErrNotLiquidatable, "collateral %s, additional collateral %s, collateral ratio %s, combined liquidation ratio %s", cdp.Collateral.Denom, cdp.AdditionalCollateral, collateralizationRatio, liquidationRatio)
+	}
+	return nil
+}
+
+// allocateLiquidatedDebt splits the debt of a liquidated cdp between its primary collateral and each of its additional
+// collateral positions in proportion to their value at liquidation prices. The rounding remainder is allocated to the
+// primary collateral, or to the first valued additional collateral if the primary collateral has no value.
+func (k Keeper) allocateLiquidatedDebt(ctx sdk.Context, cdp types.CDP, debt sdkmath.Int) (sdkmath.Int, []sdkmath.Int, error) {
+	if !cdp.HasAdditionalCollateral() {
+		return debt, nil, nil
+	}
+
+	primaryValue, err := k.calculateCollateralValue(ctx, cdp.Collateral, cdp.Type, liquidation)
+	if err != nil {
+		return sdkmath.Int{}, nil, err
+	}
+	totalValue := primaryValue
+	additionalValues := make([]sdk.Dec, len(cdp.AdditionalCollateral))
+	for i, position := range cdp.AdditionalCollateral {
+		additionalValues[i], err = k.calculateCollateralValue(ctx, position.Amount, position.CollateralType, liquidation)
+		if err != nil {
+			return sdkmath.Int{}, nil, err
+		}
+		totalValue = totalValue.Add(additionalValues[i])
+	}
+
+	additionalDebts := make([]sdkmath.Int, len(cdp.AdditionalCollateral))
+	allocated := sdk.ZeroInt()
+	for i, value := range additionalValues {
+		additionalDebts[i] = sdk.ZeroInt()
+		if totalValue.IsPositive() {
+			additionalDebts[i] = sdk.NewDecFromInt(debt).Mul(value).Quo(totalValue).TruncateInt()
+		}
+		allocated = allocated.Add(additionalDebts[i])
+	}
+
+	remainder := debt.Sub(allocated)
+	if primaryValue.IsZero() {
+		for i, value := range additionalValues {
+			if value.IsPositive() {
+				additionalDebts[i] = additionalDebts[i].Add(remainder)
+				return sdk.ZeroInt(), additionalDebts, nil
+			}
+		}
+	}
+	return remainder, additionalDebts, nil
+}
+
 func (k Keeper) getModAccountDebt(ctx sdk.Context, accountName string) sdkmath.Int {
 	macc := k.accountKeeper.GetModuleAccount(ctx, accountName)
 	return k.bankKeeper.GetBalance(ctx, macc.GetAddress(), k.GetDebtDenom(ctx)).Amount
@@ -168,3 +435,34 @@ func (k Keeper) payoutKeeperLiquidationReward(ctx sdk.Context, keeper sdk.AccAdd
 	}
 	return cdp, nil
 }
+
+// payoutKeeperIncentive pays the keeper incentive of a collateral type to the keeper that liquidated a cdp of that
+// type, from the liquidator module account's surplus and up to the surplus available
+func (k Keeper) payoutKeeperIncentive(ctx sdk.Context, keeper sdk.AccAddress, cdp types.CDP) error {
+	collateralParam, found := k.GetCollateral(ctx, cdp.Type)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidCollateral, "%s", cdp.Type)
+	}
+	if collateralParam.KeeperIncentive.IsNil() {
+		return nil
+	}
+	amount := sdk.MinInt(collateralParam.KeeperIncentive, k.GetTotalSurplus(ctx, types.LiquidatorMacc))
+	if !amount.IsPositive() {
+		return nil
+	}
+	incentive := sdk.NewCoin(k.GetParams(ctx).DebtParam.Denom, amount)
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.LiquidatorMacc, keeper, sdk.NewCoins(incentive))
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpKeeperIncentive,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, incentive.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyKeeper, keeper.String()),
+		),
+	)
+	return nil
+}
@@ -190,6 +190,144 @@ func (suite *SeizeTestSuite) TestSeizeCollateralMultiDeposit() {
 	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
 }
 
+func (suite *SeizeTestSuite) TestLiquidateCdpsAdditionalCollateral() {
+	ak := suite.app.GetAccountKeeper()
+	bk := suite.app.GetBankKeeper()
+
+	// $1000 of xrp at a 2.0 liquidation ratio and $800 of btc at a 1.5 liquidation ratio back up to ~1033.33 usdx
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("xrp", 4000000000), c("usdx", 400000000), "xrp-a")
+	suite.NoError(err)
+	err = suite.keeper.DepositAdditionalCollateral(suite.ctx, suite.addrs[0], c("btc", 10000000), "btc-a", "xrp-a")
+	suite.NoError(err)
+	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("usdx", 500000000))
+	suite.NoError(err)
+
+	// the xrp collateral alone is below the liquidation ratio, but the combined collateral backs ~933.33 usdx
+	suite.setPrice(d("0.2"), "xrp:usd:30")
+	err = suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd:30", "xrp-a", d("2.0"), i(10))
+	suite.NoError(err)
+	_, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(1))
+	suite.True(found)
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrNotLiquidatable))
+
+	// the combined collateral backs ~866.67 usdx
+	suite.setPrice(d("7000"), "btc:usd:30")
+	tpb := suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx")
+	err = suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd:30", "xrp-a", d("2.0"), i(10))
+	suite.NoError(err)
+	_, found = suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(1))
+	suite.False(found)
+	tpa := suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx")
+	suite.Equal(i(900000000), tpb.Sub(tpa))
+
+	auctionMacc := ak.GetModuleAccount(suite.ctx, auctiontypes.ModuleName)
+	suite.Equal(cs(c("debt", 900000000), c("xrp", 4000000000), c("btc", 10000000)), bk.GetAllBalances(suite.ctx, auctionMacc.GetAddress()))
+
+	// debt is split by collateral value, $800 of xrp and $700 of btc
+	auctionKeeper := suite.app.GetAuctionKeeper()
+	xrpAuction, found := auctionKeeper.GetAuction(suite.ctx, auctiontypes.DefaultNextAuctionID)
+	suite.True(found)
+	suite.Equal(c("xrp", 4000000000), xrpAuction.GetLot())
+	suite.Equal(c("debt", 480000000), xrpAuction.(*auctiontypes.CollateralAuction).CorrespondingDebt)
+	btcAuction, found := auctionKeeper.GetAuction(suite.ctx, auctiontypes.DefaultNextAuctionID+1)
+	suite.True(found)
+	suite.Equal(c("btc", 10000000), btcAuction.GetLot())
+	suite.Equal(c("debt", 420000000), btcAuction.(*auctiontypes.CollateralAuction).CorrespondingDebt)
+}
+
+func (suite *SeizeTestSuite) TestLiquidateCdpsSkippedCdpsAreNotCounted() {
+	// $1000 of xrp and $800 of btc back 900 usdx
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("xrp", 4000000000), c("usdx", 400000000), "xrp-a")
+	suite.NoError(err)
+	err = suite.keeper.DepositAdditionalCollateral(suite.ctx, suite.addrs[0], c("btc", 10000000), "btc-a", "xrp-a")
+	suite.NoError(err)
+	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp-a", c("usdx", 500000000))
+	suite.NoError(err)
+	// $1250 of xrp back 600 usdx, at a higher collateral ratio than the first cdp
+	err = suite.keeper.AddCdp(suite.ctx, suite.addrs[1], c("xrp", 5000000000), c("usdx", 600000000), "xrp-a")
+	suite.NoError(err)
+
+	// both cdps are below the liquidation ratio by their xrp collateral, but the first is held up by its btc
+	suite.setPrice(d("0.2"), "xrp:usd:30")
+	err = suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd:30", "xrp-a", d("2.0"), i(1))
+	suite.NoError(err)
+
+	_, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(1))
+	suite.True(found)
+	_, found = suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(2))
+	suite.False(found)
+}
+
+func (suite *SeizeTestSuite) TestPartialLiquidation() {
+	ak := suite.app.GetAccountKeeper()
+	bk := suite.app.GetBankKeeper()
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CollateralParams[0].LiquidationCloseFactor = d("0.5")
+	params.CollateralParams[0].KeeperIncentive = i(1000000)
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// $1000 of xrp backing 400 usdx
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("xrp", 4000000000), c("usdx", 400000000), "xrp-a")
+	suite.NoError(err)
+
+	// half of the debt is liquidated, seizing 200 usdx * 1.05 / $0.18 of xrp
+	suite.setPrice(d("0.18"), "xrp:usd:30")
+	tpb := suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx")
+	err = suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd:30", "xrp-a", d("2.0"), i(10))
+	suite.NoError(err)
+	tpa := suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx")
+	suite.Equal(i(200000000), tpb.Sub(tpa))
+
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(1))
+	suite.Require().True(found)
+	suite.Equal(c("xrp", 2833333333), cdp.Collateral)
+	suite.Equal(c("usdx", 200000000), cdp.GetTotalPrincipal())
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, cdp.ID, suite.addrs[0])
+	suite.True(found)
+	suite.Equal(c("xrp", 2833333333), deposit.Amount)
+
+	auctionMacc := ak.GetModuleAccount(suite.ctx, auctiontypes.ModuleName)
+	suite.Equal(cs(c("debt", 200000000), c("xrp", 1166666667)), bk.GetAllBalances(suite.ctx, auctionMacc.GetAddress()))
+
+	var liquidationEvent sdk.Event
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeCdpLiquidation && len(event.Attributes) > 3 {
+			liquidationEvent = event
+		}
+	}
+	suite.Require().Equal(
+		[]abci.EventAttribute{
+			{Key: sdk.AttributeKeyModule, Value: types.AttributeValueCategory},
+			{Key: types.AttributeKeyCdpID, Value: "1"},
+			{Key: types.AttributeKeySeizedCollateral, Value: "1166666667xrp"},
+			{Key: types.AttributeKeySeizedDebt, Value: "200000000usdx"},
+			{Key: types.AttributeKeyRemainingCollateral, Value: "2833333333xrp"},
+			{Key: types.AttributeKeyRemainingDebt, Value: "200000000usdx"},
+		},
+		liquidationEvent.Attributes,
+	)
+
+	// the keeper incentive is paid from the liquidator's surplus, up to the surplus available
+	err = bk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 500000)))
+	suite.NoError(err)
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrNotLiquidatable))
+
+	suite.setPrice(d("0.12"), "xrp:usd:30")
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp-a")
+	suite.NoError(err)
+	suite.Equal(i(500000), bk.GetBalance(suite.ctx, suite.addrs[1], "usdx").Amount)
+	suite.Equal(i(10000000000+28333333), bk.GetBalance(suite.ctx, suite.addrs[1], "xrp").Amount)
+
+	// the keeper reward is paid before 100 usdx * 1.05 / $0.12 of xrp is seized
+	cdp, found = suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(1))
+	suite.Require().True(found)
+	suite.Equal(c("xrp", 2833333333-28333333-875000000), cdp.Collateral)
+	suite.Equal(c("usdx", 100000000), cdp.GetTotalPrincipal())
+}
+
 func (suite *SeizeTestSuite) TestLiquidateCdps() {
 	suite.createCdps()
 	ak := suite.app.GetAccountKeeper()
@@ -211,6 +349,32 @@ func (suite *SeizeTestSuite) TestLiquidateCdps() {
 	suite.Equal(10, xrpLiquidations)
 }
 
+func (suite *SeizeTestSuite) TestValidateLiquidation_Twap() {
+	pfKeeper := suite.app.GetPriceFeedKeeper()
+	pfParams := pfKeeper.GetParams(suite.ctx)
+	pfParams.TwapWindow = 2 * time.Hour
+	pfKeeper.SetParams(suite.ctx, pfParams)
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CollateralParams[0].LiquidationTwapDuration = time.Hour
+	suite.keeper.SetParams(suite.ctx, params)
+
+	suite.setPrice(d("0.25"), "xrp:usd:30")
+	collateral, principal := c("xrp", 400000000), c("usdx", 40000000)
+	err := suite.keeper.ValidateLiquidation(suite.ctx, collateral, "xrp-a", principal, c("usdx", 0))
+	suite.Require().ErrorIs(err, types.ErrNotLiquidatable)
+
+	// a sudden drop in the current price does not move the liquidation price
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	suite.setPrice(d("0.15"), "xrp:usd:30")
+	err = suite.keeper.ValidateLiquidation(suite.ctx, collateral, "xrp-a", principal, c("usdx", 0))
+	suite.Require().ErrorIs(err, types.ErrNotLiquidatable)
+
+	// the cdp is liquidatable once the average falls below the liquidation ratio
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(45 * time.Minute))
+	err = suite.keeper.ValidateLiquidation(suite.ctx, collateral, "xrp-a", principal, c("usdx", 0))
+	suite.Require().NoError(err)
+}
+
 func (suite *SeizeTestSuite) TestApplyLiquidationPenalty() {
 	penalty := suite.keeper.ApplyLiquidationPenalty(suite.ctx, "xrp-a", i(1000))
 	suite.Equal(i(50), penalty)
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// SnapshotInterestFactor stores a snapshot of the current interest factor of a collateral type if the block height is a
+// multiple of the snapshot interval
+func (k Keeper) SnapshotInterestFactor(ctx sdk.Context, collateralType string) {
+	interval := k.GetParams(ctx).InterestFactorSnapshotInterval
+	if interval <= 0 || ctx.BlockHeight()%interval != 0 {
+		return
+	}
+	interestFactor, found := k.GetInterestFactor(ctx, collateralType)
+	if !found {
+		return
+	}
+	k.SetInterestFactorSnapshot(ctx, types.NewInterestFactorSnapshot(collateralType, ctx.BlockHeight(), ctx.BlockTime(), interestFactor))
+}
+
+// SetInterestFactorSnapshot sets a snapshot of the interest factor of a collateral type in the store
+func (k Keeper) SetInterestFactorSnapshot(ctx sdk.Context, snapshot types.InterestFactorSnapshot) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.InterestFactorSnapshotPrefix)
+	bz := k.cdc.MustMarshal(&snapshot)
+	store.Set(types.InterestFactorSnapshotKey(snapshot.CollateralType, snapshot.Height), bz)
+}
+
+// GetInterestFactorSnapshotAtHeight returns the latest snapshot of the interest factor of a collateral type at or before
+// the input height
+func (k Keeper) GetInterestFactorSnapshotAtHeight(ctx sdk.Context, collateralType string, height int64) (types.InterestFactorSnapshot, bool) {
+	if height < 0 {
+		return types.InterestFactorSnapshot{}, false
+	}
+	store := prefix.NewStore(ctx.KVStore(k.key), types.InterestFactorSnapshotPrefix)
+	iterator := store.ReverseIterator(types.DenomIterKey(collateralType), types.InterestFactorSnapshotKey(collateralType, height+1))
+
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.InterestFactorSnapshot{}, false
+	}
+	var snapshot types.InterestFactorSnapshot
+	k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+	return snapshot, true
+}
+
+// IterateInterestFactorSnapshots iterates over the interest factor snapshots of a collateral type in order of height and performs a callback function
+func (k Keeper) IterateInterestFactorSnapshots(ctx sdk.Context, collateralType string, cb func(snapshot types.InterestFactorSnapshot) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.InterestFactorSnapshotPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomIterKey(collateralType))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.InterestFactorSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		if cb(snapshot) {
+			break
+		}
+	}
+}
+
+// IterateAllInterestFactorSnapshots iterates over all interest factor snapshots and performs a callback function
+func (k Keeper) IterateAllInterestFactorSnapshots(ctx sdk.Context, cb func(snapshot types.InterestFactorSnapshot) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.InterestFactorSnapshotPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.InterestFactorSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		if cb(snapshot) {
+			break
+		}
+	}
+}
+
+// GetAllInterestFactorSnapshots returns all interest factor snapshots from the store
+func (k Keeper) GetAllInterestFactorSnapshots(ctx sdk.Context) (snapshots types.InterestFactorSnapshots) {
+	k.IterateAllInterestFactorSnapshots(ctx, func(snapshot types.InterestFactorSnapshot) bool {
+		snapshots = append(snapshots, snapshot)
+		return false
+	})
+	return
+}
+
+// CalculateAccruedFees returns the fees a cdp accrued between two snapshots of its collateral type's interest factor.
+// The cdp's debt is assumed to have changed only by accruing fees since the start snapshot, so its debt at any later
+// interest factor is its total principal scaled from the interest factor it was last synchronized at.
+func (k Keeper) CalculateAccruedFees(cdp types.CDP, start, end types.InterestFactorSnapshot) sdk.Coin {
+	debt := sdk.NewDecFromInt(cdp.GetTotalPrincipal().Amount)
+	fees := debt.Mul(end.InterestFactor.Sub(start.InterestFactor)).Quo(cdp.InterestFactor).RoundInt()
+	return sdk.NewCoin(cdp.AccumulatedFees.Denom, fees)
+}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+)
+
+type SnapshotTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *SnapshotTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 10, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	authGS := app.NewFundedGenStateWithCoins(cdc, []sdk.Coins{cs(c("xrp", 400000000))}, addrs)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.InterestFactorSnapshotInterval = 10
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+func (suite *SnapshotTestSuite) TestSnapshotInterestFactor() {
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("xrp", 400000000), c("usdx", 40000000), "xrp-a")
+	suite.Require().NoError(err)
+
+	// snapshots are only taken at multiples of the interval
+	for _, ctx := range []sdk.Context{
+		suite.ctx,
+		suite.ctx.WithBlockHeight(15).WithBlockTime(suite.ctx.BlockTime().Add(24 * time.Hour)),
+		suite.ctx.WithBlockHeight(20).WithBlockTime(suite.ctx.BlockTime().Add(365 * 24 * time.Hour)),
+	} {
+		err := suite.keeper.AccumulateInterest(ctx, "xrp-a")
+		suite.Require().NoError(err)
+		suite.keeper.SnapshotInterestFactor(ctx, "xrp-a")
+	}
+
+	snapshots := suite.keeper.GetAllInterestFactorSnapshots(suite.ctx)
+	suite.Require().Len(snapshots, 2)
+	suite.Equal(int64(10), snapshots[0].Height)
+	suite.Equal(sdk.OneDec(), snapshots[0].InterestFactor)
+	suite.Equal(int64(20), snapshots[1].Height)
+	interestFactor, _ := suite.keeper.GetInterestFactor(suite.ctx, "xrp-a")
+	suite.Equal(interestFactor, snapshots[1].InterestFactor)
+
+	// heights resolve to the latest snapshot at or before them
+	snapshot, found := suite.keeper.GetInterestFactorSnapshotAtHeight(suite.ctx, "xrp-a", 15)
+	suite.Require().True(found)
+	suite.Equal(snapshots[0], snapshot)
+	snapshot, found = suite.keeper.GetInterestFactorSnapshotAtHeight(suite.ctx, "xrp-a", 25)
+	suite.Require().True(found)
+	suite.Equal(snapshots[1], snapshot)
+	_, found = suite.keeper.GetInterestFactorSnapshotAtHeight(suite.ctx, "xrp-a", 9)
+	suite.False(found)
+	_, found = suite.keeper.GetInterestFactorSnapshotAtHeight(suite.ctx, "btc-a", 20)
+	suite.False(found)
+
+	// the fees accrued between the snapshots are the fees synchronized to the cdp
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.Require().True(found)
+	fees := suite.keeper.CalculateAccruedFees(cdp, snapshots[0], snapshots[1])
+	suite.True(fees.IsPositive())
+	cdp = suite.keeper.SynchronizeInterest(suite.ctx, cdp)
+	suite.Equal(cdp.AccumulatedFees, fees)
+
+	// fees accrued after synchronizing are scaled from the cdp's new interest factor
+	suite.Equal(fees, suite.keeper.CalculateAccruedFees(cdp, snapshots[0], snapshots[1]))
+}
+
+func (suite *SnapshotTestSuite) TestSnapshotInterestFactor_Disabled() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.InterestFactorSnapshotInterval = 0
+	suite.keeper.SetParams(suite.ctx, params)
+
+	err := suite.keeper.AccumulateInterest(suite.ctx, "xrp-a")
+	suite.Require().NoError(err)
+	suite.keeper.SnapshotInterestFactor(suite.ctx, "xrp-a")
+	suite.Empty(suite.keeper.GetAllInterestFactorSnapshots(suite.ctx))
+}
+
+func TestSnapshotTestSuite(t *testing.T) {
+	suite.Run(t, new(SnapshotTestSuite))
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// TransferCdp moves the owner's cdp of the input collateral type to the recipient, which must not have a cdp of the
+// same collateral type. The owner's deposit to the cdp is moved to the recipient, while deposits of other depositors
+// are unchanged. Any liquidation protection of the cdp is removed.
+func (k Keeper) TransferCdp(ctx sdk.Context, owner, recipient sdk.AccAddress, collateralType string) error {
+	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s, collateral %s", owner, collateralType)
+	}
+	if _, found := k.GetCdpByOwnerAndCollateralType(ctx, recipient, collateralType); found {
+		return errorsmod.Wrapf(types.ErrCdpAlreadyExists, "owner %s, collateral %s", recipient, collateralType)
+	}
+
+	// sync the rewards of the previous owner before the cdp changes owner
+	k.hooks.BeforeCDPModified(ctx, cdp)
+
+	if deposit, found := k.GetDeposit(ctx, cdp.ID, owner); found {
+		k.DeleteDeposit(ctx, cdp.ID, owner)
+		if recipientDeposit, found := k.GetDeposit(ctx, cdp.ID, recipient); found {
+			deposit.Amount = deposit.Amount.Add(recipientDeposit.Amount)
+		}
+		deposit.Depositor = recipient
+		k.SetDeposit(ctx, deposit)
+	}
+
+	k.RemoveCdpOwnerIndex(ctx, cdp)
+	cdp.Owner = recipient
+	if err := k.SetCDP(ctx, cdp); err != nil {
+		return err
+	}
+	k.IndexCdpByOwner(ctx, cdp)
+
+	// the protection was set up by the previous owner, so its reserve must not keep topping up the cdp
+	if _, found := k.GetProtection(ctx, cdp.Type, cdp.ID); found {
+		k.DeleteProtection(ctx, cdp.Type, cdp.ID)
+	}
+
+	// start the rewards of the new owner from the current reward index
+	k.hooks.AfterCDPCreated(ctx, cdp)
+
+	// the cdp's debt moves from the previous owner to the new owner
+	k.hooks.AfterDebtChanged(ctx, owner, cdp.Type, cdp.GetTotalPrincipal().Amount.Neg())
+	k.hooks.AfterDebtChanged(ctx, recipient, cdp.Type, cdp.GetTotalPrincipal().Amount)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpTransfer,
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+		),
+	)
+	return nil
+}
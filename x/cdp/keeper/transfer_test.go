@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+type TransferTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *TransferTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	authGS := app.NewFundedGenStateWithCoins(
+		cdc,
+		[]sdk.Coins{
+			cs(c("xrp", 500000000), c("btc", 500000000)),
+			cs(c("xrp", 200000000)),
+			cs(c("xrp", 200000000)),
+		},
+		addrs,
+	)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+
+	err := suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 400000000), c("usdx", 10000000), "xrp-a")
+	suite.Require().NoError(err)
+}
+
+func (suite *TransferTestSuite) TestTransferCdp() {
+	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[2], c("xrp", 100000000), "xrp-a")
+	suite.Require().NoError(err)
+
+	err = suite.keeper.TransferCdp(suite.ctx, suite.addrs[1], suite.addrs[2], "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
+
+	err = suite.keeper.TransferCdp(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp-a")
+	suite.Require().NoError(err)
+
+	_, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.Require().False(found)
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[1], "xrp-a")
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), cdp.ID)
+	suite.Require().Equal(suite.addrs[1], cdp.Owner)
+	suite.Require().Equal(c("xrp", 500000000), cdp.Collateral)
+	suite.Require().Equal(c("usdx", 10000000), cdp.Principal)
+
+	// the owner's deposit moves to the recipient, other deposits are unchanged
+	_, found = suite.keeper.GetDeposit(suite.ctx, cdp.ID, suite.addrs[0])
+	suite.Require().False(found)
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, cdp.ID, suite.addrs[1])
+	suite.Require().True(found)
+	suite.Require().Equal(c("xrp", 400000000), deposit.Amount)
+	deposit, found = suite.keeper.GetDeposit(suite.ctx, cdp.ID, suite.addrs[2])
+	suite.Require().True(found)
+	suite.Require().Equal(c("xrp", 100000000), deposit.Amount)
+
+	// the recipient manages the cdp as its owner
+	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[1], "xrp-a", c("usdx", 10000000))
+	suite.Require().NoError(err)
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], c("xrp", 100000000), "xrp-a")
+	suite.Require().NoError(err)
+
+	// the cdp cannot be moved to an owner of a cdp of the same collateral type
+	err = suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("xrp", 100000000), c("usdx", 10000000), "xrp-a")
+	suite.Require().NoError(err)
+	err = suite.keeper.TransferCdp(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrCdpAlreadyExists))
+}
+
+func (suite *TransferTestSuite) TestTransferCdp_Protection() {
+	err := suite.keeper.SetCdpProtection(suite.ctx, suite.addrs[0], suite.addrs[2], "xrp-a", d("2.5"))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.ApproveCdpProtection(suite.ctx, suite.addrs[2], suite.addrs[0], "xrp-a", c("xrp", 100000000))
+	suite.Require().NoError(err)
+
+	// the reserve no longer protects the cdp once it has a new owner
+	err = suite.keeper.TransferCdp(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp-a")
+	suite.Require().NoError(err)
+	_, found := suite.keeper.GetProtection(suite.ctx, "xrp-a", 1)
+	suite.Require().False(found)
+}
+
+func (suite *TransferTestSuite) TestAuthzGrants() {
+	ak := suite.app.GetAuthzKeeper()
+	owner, grantee := suite.addrs[0], suite.addrs[1]
+
+	for _, msg := range []sdk.Msg{&types.MsgDeposit{}, &types.MsgWithdraw{}, &types.MsgDrawDebt{}, &types.MsgRepayDebt{}} {
+		err := ak.SaveGrant(suite.ctx, grantee, owner, authz.NewGenericAuthorization(sdk.MsgTypeURL(msg)), nil)
+		suite.Require().NoError(err)
+	}
+
+	deposit := types.NewMsgDeposit(owner, owner, c("xrp", 100000000), "xrp-a")
+	draw := types.NewMsgDrawDebt(owner, "xrp-a", c("usdx", 10000000))
+	repay := types.NewMsgRepayDebt(owner, "xrp-a", c("usdx", 5000000))
+	withdraw := types.NewMsgWithdraw(owner, owner, c("xrp", 100000000), "xrp-a")
+	_, err := ak.DispatchActions(suite.ctx, grantee, []sdk.Msg{&deposit, &draw, &repay, &withdraw})
+	suite.Require().NoError(err)
+
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, owner, "xrp-a")
+	suite.Require().True(found)
+	suite.Require().Equal(c("xrp", 400000000), cdp.Collateral)
+	suite.Require().Equal(c("usdx", 15000000), cdp.Principal)
+	suite.Require().Equal(c("usdx", 15000000), suite.app.GetBankKeeper().GetBalance(suite.ctx, owner, "usdx"))
+
+	// grants are scoped to each message type
+	transfer := types.NewMsgTransferCdp(owner, grantee, "xrp-a")
+	_, err = ak.DispatchActions(suite.ctx, grantee, []sdk.Msg{&transfer})
+	suite.Require().Error(err)
+
+	err = ak.SaveGrant(suite.ctx, grantee, owner, authz.NewGenericAuthorization(sdk.MsgTypeURL(&types.MsgTransferCdp{})), nil)
+	suite.Require().NoError(err)
+	_, err = ak.DispatchActions(suite.ctx, grantee, []sdk.Msg{&transfer})
+	suite.Require().NoError(err)
+	_, found = suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, grantee, "xrp-a")
+	suite.Require().True(found)
+}
+
+func TestTransferTestSuite(t *testing.T) {
+	suite.Run(t, new(TransferTestSuite))
+}
@@ -63,6 +63,12 @@ func (cdp CDP) Validate() error {
 	if strings.TrimSpace(cdp.Type) == "" {
 		return fmt.Errorf("cdp type cannot be empty")
 	}
+	if err := cdp.AdditionalCollateral.Validate(); err != nil {
+		return err
+	}
+	if _, found := cdp.AdditionalCollateral.Get(cdp.Type); found {
+		return fmt.Errorf("additional collateral type cannot be the cdp type %s", cdp.Type)
+	}
 	return nil
 }
 
@@ -85,6 +91,11 @@ func (cdp CDP) GetNormalizedPrincipal() (sdk.Dec, error) {
 	return sdk.NewDecFromInt(unsyncedDebt).Quo(cdp.InterestFactor), nil
 }
 
+// HasAdditionalCollateral returns true if the cdp holds collateral of types other than its own type
+func (cdp CDP) HasAdditionalCollateral() bool {
+	return len(cdp.AdditionalCollateral) > 0
+}
+
 // CDPs a collection of CDP objects
 type CDPs []CDP
 
@@ -110,14 +121,15 @@ type AugmentedCDP struct {
 func NewAugmentedCDP(cdp CDP, collateralValue sdk.Coin, collateralizationRatio sdk.Dec) AugmentedCDP {
 	augmentedCDP := AugmentedCDP{
 		CDP: CDP{
-			ID:              cdp.ID,
-			Owner:           cdp.Owner,
-			Type:            cdp.Type,
-			Collateral:      cdp.Collateral,
-			Principal:       cdp.Principal,
-			AccumulatedFees: cdp.AccumulatedFees,
-			FeesUpdated:     cdp.FeesUpdated,
-			InterestFactor:  cdp.InterestFactor,
+			ID:                   cdp.ID,
+			Owner:                cdp.Owner,
+			Type:                 cdp.Type,
+			Collateral:           cdp.Collateral,
+			Principal:            cdp.Principal,
+			AccumulatedFees:      cdp.AccumulatedFees,
+			FeesUpdated:          cdp.FeesUpdated,
+			InterestFactor:       cdp.InterestFactor,
+			AdditionalCollateral: cdp.AdditionalCollateral,
 		},
 		CollateralValue:        collateralValue,
 		CollateralizationRatio: collateralizationRatio,
@@ -176,12 +188,22 @@ func NewCDPResponse(cdp CDP, collateralValue sdk.Coin, collateralizationRatio sd
 		InterestFactor:         cdp.InterestFactor.String(),
 		CollateralValue:        collateralValue,
 		CollateralizationRatio: collateralizationRatio.String(),
+		AdditionalCollateral:   cdp.AdditionalCollateral,
 	}
 }
 
 // CDPResponses a collection of CDPResponse objects
 type CDPResponses []CDPResponse
 
+// CollateralDebtLimits a collection of CollateralDebtLimit objects
+type CollateralDebtLimits []CollateralDebtLimit
+
+// CollateralStabilityFees a collection of CollateralStabilityFee objects
+type CollateralStabilityFees []CollateralStabilityFee
+
+// CollateralPriceStatuses a collection of CollateralPriceStatus objects
+type CollateralPriceStatuses []CollateralPriceStatus
+
 // TotalPrincipals a collection of TotalPrincipal objects
 type TotalPrincipals []TotalPrincipal
 
@@ -203,3 +225,89 @@ func NewTotalCollateral(collateralType string, amount sdk.Coin) TotalCollateral
 		Amount:         amount,
 	}
 }
+
+// NewCollateralPosition returns a new CollateralPosition
+func NewCollateralPosition(collateralType string, amount sdk.Coin) CollateralPosition {
+	return CollateralPosition{
+		CollateralType: collateralType,
+		Amount:         amount,
+	}
+}
+
+// Validate performs a basic validation of the collateral position fields.
+func (cp CollateralPosition) Validate() error {
+	if strings.TrimSpace(cp.CollateralType) == "" {
+		return errors.New("additional collateral type cannot be empty")
+	}
+	if !cp.Amount.IsValid() || !cp.Amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "additional collateral %s", cp.Amount)
+	}
+	return nil
+}
+
+// CollateralPositions a collection of CollateralPosition objects
+type CollateralPositions []CollateralPosition
+
+// Validate validates each collateral position and checks that no collateral type is repeated
+func (cps CollateralPositions) Validate() error {
+	seenTypes := make(map[string]bool)
+	for _, cp := range cps {
+		if err := cp.Validate(); err != nil {
+			return err
+		}
+		if seenTypes[cp.CollateralType] {
+			return fmt.Errorf("duplicate additional collateral type %s", cp.CollateralType)
+		}
+		seenTypes[cp.CollateralType] = true
+	}
+	return nil
+}
+
+// Get returns the collateral position of a collateral type
+func (cps CollateralPositions) Get(collateralType string) (CollateralPosition, bool) {
+	for _, cp := range cps {
+		if cp.CollateralType == collateralType {
+			return cp, true
+		}
+	}
+	return CollateralPosition{}, false
+}
+
+// Add returns the collateral positions with the amount added to the position of its collateral type, appending a
+// new position if there is none
+func (cps CollateralPositions) Add(collateralType string, amount sdk.Coin) CollateralPositions {
+	updated := make(CollateralPositions, 0, len(cps)+1)
+	found := false
+	for _, cp := range cps {
+		if cp.CollateralType == collateralType {
+			cp.Amount = cp.Amount.Add(amount)
+			found = true
+		}
+		updated = append(updated, cp)
+	}
+	if !found {
+		updated = append(updated, NewCollateralPosition(collateralType, amount))
+	}
+	return updated
+}
+
+// Sub returns the collateral positions with the amount subtracted from the position of its collateral type,
+// removing the position if it is emptied. It panics if the position does not exist or holds less than the amount.
+func (cps CollateralPositions) Sub(collateralType string, amount sdk.Coin) CollateralPositions {
+	updated := make(CollateralPositions, 0, len(cps))
+	found := false
+	for _, cp := range cps {
+		if cp.CollateralType == collateralType {
+			cp.Amount = cp.Amount.Sub(amount)
+			found = true
+			if cp.Amount.IsZero() {
+				continue
+			}
+		}
+		updated = append(updated, cp)
+	}
+	if !found {
+		panic(fmt.Sprintf("additional collateral type %s not found", collateralType))
+	}
+	return updated
+}
@@ -18,6 +18,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDrawDebt{}, "cdp/MsgDrawDebt", nil)
 	cdc.RegisterConcrete(&MsgRepayDebt{}, "cdp/MsgRepayDebt", nil)
 	cdc.RegisterConcrete(&MsgLiquidate{}, "cdp/MsgLiquidate", nil)
+	cdc.RegisterConcrete(&MsgSetProtection{}, "cdp/MsgSetProtection", nil)
+	cdc.RegisterConcrete(&MsgApproveProtection{}, "cdp/MsgApproveProtection", nil)
+	cdc.RegisterConcrete(&MsgTransferCdp{}, "cdp/MsgTransferCdp", nil)
+	cdc.RegisterConcrete(&MsgRedeemUSDX{}, "cdp/MsgRedeemUSDX", nil)
+	cdc.RegisterConcrete(&MsgDepositSavings{}, "cdp/MsgDepositSavings", nil)
+	cdc.RegisterConcrete(&MsgWithdrawSavings{}, "cdp/MsgWithdrawSavings", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -28,6 +34,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDrawDebt{},
 		&MsgRepayDebt{},
 		&MsgLiquidate{},
+		&MsgSetProtection{},
+		&MsgApproveProtection{},
+		&MsgTransferCdp{},
+		&MsgRedeemUSDX{},
+		&MsgDepositSavings{},
+		&MsgWithdrawSavings{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
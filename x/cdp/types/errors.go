@@ -49,4 +49,16 @@ var (
 	ErrInsufficientBalance = errorsmod.Register(ModuleName, 22, "insufficient balance")
 	// ErrNotLiquidatable error for when an cdp is not liquidatable
 	ErrNotLiquidatable = errorsmod.Register(ModuleName, 23, "cdp collateral ratio not below liquidation ratio")
+	// ErrProtectionNotFound error for when a cdp has no liquidation protection
+	ErrProtectionNotFound = errorsmod.Register(ModuleName, 24, "protection not found")
+	// ErrInvalidTargetRatio error for when a protection target ratio is not above the liquidation ratio
+	ErrInvalidTargetRatio = errorsmod.Register(ModuleName, 25, "protection target ratio must be above the liquidation ratio")
+	// ErrNoRedeemableCdps error for when no cdps of a collateral type can be redeemed against
+	ErrNoRedeemableCdps = errorsmod.Register(ModuleName, 26, "no redeemable cdps")
+	// ErrPriceStale error for when drawing debt is paused because the price of a collateral type is stale
+	ErrPriceStale = errorsmod.Register(ModuleName, 27, "price is stale, drawing debt is paused")
+	// ErrInterestFactorSnapshotNotFound error for when no interest factor snapshot exists at or before a height
+	ErrInterestFactorSnapshotNotFound = errorsmod.Register(ModuleName, 28, "interest factor snapshot not found")
+	// ErrSavingsDepositNotFound error for when an address has no savings deposit
+	ErrSavingsDepositNotFound = errorsmod.Register(ModuleName, 29, "savings deposit not found")
 )
@@ -2,17 +2,40 @@ package types
 
 // Event types for cdp module
 const (
-	EventTypeCreateCdp         = "create_cdp"
-	EventTypeCdpDeposit        = "cdp_deposit"
-	EventTypeCdpDraw           = "cdp_draw"
-	EventTypeCdpRepay          = "cdp_repayment"
-	EventTypeCdpClose          = "cdp_close"
-	EventTypeCdpWithdrawal     = "cdp_withdrawal"
-	EventTypeCdpLiquidation    = "cdp_liquidation"
-	EventTypeBeginBlockerFatal = "cdp_begin_block_error"
+	EventTypeCreateCdp          = "create_cdp"
+	EventTypeCdpDeposit         = "cdp_deposit"
+	EventTypeCdpDraw            = "cdp_draw"
+	EventTypeCdpRepay           = "cdp_repayment"
+	EventTypeCdpClose           = "cdp_close"
+	EventTypeCdpWithdrawal      = "cdp_withdrawal"
+	EventTypeCdpLiquidation     = "cdp_liquidation"
+	EventTypeBeginBlockerFatal  = "cdp_begin_block_error"
+	EventTypeCdpProtection      = "cdp_protection"
+	EventTypeCdpTopUp           = "cdp_top_up"
+	EventTypeCdpKeeperIncentive = "cdp_keeper_incentive"
+	EventTypeCdpTransfer        = "cdp_transfer"
+	EventTypeCdpRedemption      = "cdp_redemption"
+	EventTypeSavingsDeposit     = "cdp_savings_deposit"
+	EventTypeSavingsWithdrawal  = "cdp_savings_withdrawal"
+	EventTypeSavingsAccrual     = "cdp_savings_accrual"
 
-	AttributeKeyCdpID      = "cdp_id"
-	AttributeKeyDeposit    = "deposit"
-	AttributeValueCategory = "cdp"
-	AttributeKeyError      = "error_message"
+	AttributeKeyCdpID               = "cdp_id"
+	AttributeKeyDeposit             = "deposit"
+	AttributeValueCategory          = "cdp"
+	AttributeKeyError               = "error_message"
+	AttributeKeyReserve             = "reserve"
+	AttributeKeyAllowance           = "allowance"
+	AttributeKeyKeeper              = "keeper"
+	AttributeKeySeizedCollateral    = "seized_collateral"
+	AttributeKeySeizedDebt          = "seized_debt"
+	AttributeKeyRemainingCollateral = "remaining_collateral"
+	AttributeKeyRemainingDebt       = "remaining_debt"
+	AttributeKeyFeePayment          = "fee_payment"
+	AttributeKeyPrincipalPayment    = "principal_payment"
+	AttributeKeyOwner               = "owner"
+	AttributeKeyRecipient           = "recipient"
+	AttributeKeyCollateral          = "collateral"
+	AttributeKeyRedemptionFee       = "redemption_fee"
+	AttributeKeyDepositor           = "depositor"
+	AttributeKeySavingsFactor       = "savings_factor"
 )
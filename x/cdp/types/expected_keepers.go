@@ -30,6 +30,8 @@ var _ BankKeeper = (bankkeeper.Keeper)(nil)
 // PricefeedKeeper defines the expected interface for the pricefeed
 type PricefeedKeeper interface {
 	GetCurrentPrice(sdk.Context, string) (pftypes.CurrentPrice, error)
+	GetTwap(sdk.Context, string, time.Duration) (sdk.Dec, error)
+	GetRawPrices(sdk.Context, string) pftypes.PostedPrices
 	GetParams(sdk.Context) pftypes.Params
 	// These are used for testing TODO replace mockApp with keeper in tests to remove these
 	SetParams(sdk.Context, pftypes.Params)
@@ -59,4 +61,5 @@ type AccountKeeper interface {
 type CDPHooks interface {
 	AfterCDPCreated(ctx sdk.Context, cdp CDP)
 	BeforeCDPModified(ctx sdk.Context, cdp CDP)
+	AfterDebtChanged(ctx sdk.Context, owner sdk.AccAddress, collateralType string, delta sdkmath.Int)
 }
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	"strings"
 	"time"
@@ -12,7 +15,8 @@ import (
 // NewGenesisState returns a new genesis state
 func NewGenesisState(params Params, cdps CDPs, deposits Deposits, startingCdpID uint64,
 	debtDenom, govDenom string, prevAccumTimes GenesisAccumulationTimes,
-	totalPrincipals GenesisTotalPrincipals,
+	totalPrincipals GenesisTotalPrincipals, protections Protections, snapshots InterestFactorSnapshots,
+	savingsAccumulator SavingsAccumulator, savingsDeposits SavingsDeposits,
 ) GenesisState {
 	return GenesisState{
 		Params:                    params,
@@ -23,6 +27,10 @@ func NewGenesisState(params Params, cdps CDPs, deposits Deposits, startingCdpID
 		GovDenom:                  govDenom,
 		PreviousAccumulationTimes: prevAccumTimes,
 		TotalPrincipals:           totalPrincipals,
+		Protections:               protections,
+		InterestFactorSnapshots:   snapshots,
+		SavingsAccumulator:        savingsAccumulator,
+		SavingsDeposits:           savingsDeposits,
 	}
 }
 
@@ -37,6 +45,10 @@ func DefaultGenesisState() GenesisState {
 		DefaultGovDenom,
 		GenesisAccumulationTimes{},
 		GenesisTotalPrincipals{},
+		Protections{},
+		InterestFactorSnapshots{},
+		DefaultSavingsAccumulator(),
+		SavingsDeposits{},
 	)
 }
 
@@ -63,6 +75,28 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.Protections.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.InterestFactorSnapshots.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.SavingsAccumulator.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.SavingsDeposits.Validate(); err != nil {
+		return err
+	}
+
+	if gs.ExportChecksum != "" {
+		if checksum := ExportChecksum(gs.CDPs, gs.Deposits); gs.ExportChecksum != checksum {
+			return fmt.Errorf("export checksum %s does not match cdps and deposits checksum %s", gs.ExportChecksum, checksum)
+		}
+	}
+
 	if err := sdk.ValidateDenom(gs.DebtDenom); err != nil {
 		return fmt.Errorf(fmt.Sprintf("debt denom invalid: %v", err))
 	}
@@ -74,6 +108,31 @@ func (gs GenesisState) Validate() error {
 	return nil
 }
 
+// ExportChecksum returns the hex encoded sha256 checksum of the cdps and deposits, in order. Each cdp and deposit is
+// hashed as its length prefixed protobuf encoding, so the checksum changes if any of them are changed or reordered.
+func ExportChecksum(cdps CDPs, deposits Deposits) string {
+	hash := sha256.New()
+	write := func(bz []byte) {
+		hash.Write(binary.AppendUvarint(nil, uint64(len(bz))))
+		hash.Write(bz)
+	}
+	for _, cdp := range cdps {
+		bz, err := cdp.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		write(bz)
+	}
+	for _, deposit := range deposits {
+		bz, err := deposit.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		write(bz)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
 // NewGenesisTotalPrincipal returns a new GenesisTotalPrincipal
 func NewGenesisTotalPrincipal(ctype string, principal sdkmath.Int) GenesisTotalPrincipal {
 	return GenesisTotalPrincipal{
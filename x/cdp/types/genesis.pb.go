@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -41,6 +42,13 @@ type GenesisState struct {
 	GovDenom                  string                   `protobuf:"bytes,6,opt,name=gov_denom,json=govDenom,proto3" json:"gov_denom,omitempty"`
 	PreviousAccumulationTimes GenesisAccumulationTimes `protobuf:"bytes,7,rep,name=previous_accumulation_times,json=previousAccumulationTimes,proto3,castrepeated=GenesisAccumulationTimes" json:"previous_accumulation_times"`
 	TotalPrincipals           GenesisTotalPrincipals   `protobuf:"bytes,8,rep,name=total_principals,json=totalPrincipals,proto3,castrepeated=GenesisTotalPrincipals" json:"total_principals"`
+	Protections               Protections              `protobuf:"bytes,9,rep,name=protections,proto3,castrepeated=Protections" json:"protections"`
+	InterestFactorSnapshots   InterestFactorSnapshots  `protobuf:"bytes,10,rep,name=interest_factor_snapshots,json=interestFactorSnapshots,proto3,castrepeated=InterestFactorSnapshots" json:"interest_factor_snapshots"`
+	SavingsAccumulator        SavingsAccumulator       `protobuf:"bytes,11,opt,name=savings_accumulator,json=savingsAccumulator,proto3" json:"savings_accumulator"`
+	SavingsDeposits           SavingsDeposits          `protobuf:"bytes,12,rep,name=savings_deposits,json=savingsDeposits,proto3,castrepeated=SavingsDeposits" json:"savings_deposits"`
+	// export_checksum is the hex encoded sha256 checksum of the cdps and deposits, set on export and checked on import
+	// if not empty
+	ExportChecksum string `protobuf:"bytes,13,opt,name=export_checksum,json=exportChecksum,proto3" json:"export_checksum,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -132,6 +140,41 @@ func (m *GenesisState) GetTotalPrincipals() GenesisTotalPrincipals {
 	return nil
 }
 
+func (m *GenesisState) GetProtections() Protections {
+	if m != nil {
+		return m.Protections
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInterestFactorSnapshots() InterestFactorSnapshots {
+	if m != nil {
+		return m.InterestFactorSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSavingsAccumulator() SavingsAccumulator {
+	if m != nil {
+		return m.SavingsAccumulator
+	}
+	return SavingsAccumulator{}
+}
+
+func (m *GenesisState) GetSavingsDeposits() SavingsDeposits {
+	if m != nil {
+		return m.SavingsDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExportChecksum() string {
+	if m != nil {
+		return m.ExportChecksum
+	}
+	return ""
+}
+
 // Params defines the parameters for the cdp module.
 type Params struct {
 	CollateralParams         CollateralParams                       `protobuf:"bytes,1,rep,name=collateral_params,json=collateralParams,proto3,castrepeated=CollateralParams" json:"collateral_params"`
@@ -143,6 +186,14 @@ type Params struct {
 	DebtAuctionLot           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=debt_auction_lot,json=debtAuctionLot,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"debt_auction_lot"`
 	CircuitBreaker           bool                                   `protobuf:"varint,8,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
 	LiquidationBlockInterval int64                                  `protobuf:"varint,9,opt,name=liquidation_block_interval,json=liquidationBlockInterval,proto3" json:"liquidation_block_interval,omitempty"`
+	FeePaymentParam          FeePaymentParam                        `protobuf:"bytes,10,opt,name=fee_payment_param,json=feePaymentParam,proto3" json:"fee_payment_param"`
+	// redemption_fee is the fraction of redeemed collateral left in the redeemed CDPs
+	RedemptionFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=redemption_fee,json=redemptionFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"redemption_fee"`
+	// interest_factor_snapshot_interval is the number of blocks between snapshots of each collateral type's interest
+	// factor, zero disables snapshots
+	InterestFactorSnapshotInterval int64 `protobuf:"varint,12,opt,name=interest_factor_snapshot_interval,json=interestFactorSnapshotInterval,proto3" json:"interest_factor_snapshot_interval,omitempty"`
+	// savings_rate is the per second interest factor savings deposits grow by, funded by stability fees
+	SavingsRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=savings_rate,json=savingsRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"savings_rate"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -213,6 +264,85 @@ func (m *Params) GetLiquidationBlockInterval() int64 {
 	return 0
 }
 
+func (m *Params) GetFeePaymentParam() FeePaymentParam {
+	if m != nil {
+		return m.FeePaymentParam
+	}
+	return FeePaymentParam{}
+}
+
+func (m *Params) GetInterestFactorSnapshotInterval() int64 {
+	if m != nil {
+		return m.InterestFactorSnapshotInterval
+	}
+	return 0
+}
+
+// FeePaymentParam defines governance params for paying accrued fees in an asset other than the debt asset
+type FeePaymentParam struct {
+	// denom of the asset fees can be paid in, fees can only be paid in the debt asset when empty
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// market_id is the price feed identifier for the price of the asset
+	MarketID         string                                 `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	ConversionFactor github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=conversion_factor,json=conversionFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"conversion_factor"`
+	// burn is true if fee payments are burned, and false if they are sent to the community pool
+	Burn bool `protobuf:"varint,4,opt,name=burn,proto3" json:"burn,omitempty"`
+}
+
+func (m *FeePaymentParam) Reset()         { *m = FeePaymentParam{} }
+func (m *FeePaymentParam) String() string { return proto.CompactTextString(m) }
+func (*FeePaymentParam) ProtoMessage()    {}
+func (*FeePaymentParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4494a90aaab0034, []int{2}
+}
+func (m *FeePaymentParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeePaymentParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeePaymentParam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeePaymentParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePaymentParam.Merge(m, src)
+}
+func (m *FeePaymentParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeePaymentParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePaymentParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeePaymentParam proto.InternalMessageInfo
+
+func (m *FeePaymentParam) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeePaymentParam) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *FeePaymentParam) GetBurn() bool {
+	if m != nil {
+		return m.Burn
+	}
+	return false
+}
+
 // DebtParam defines governance params for debt assets
 type DebtParam struct {
 	Denom            string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -225,7 +355,7 @@ func (m *DebtParam) Reset()         { *m = DebtParam{} }
 func (m *DebtParam) String() string { return proto.CompactTextString(m) }
 func (*DebtParam) ProtoMessage()    {}
 func (*DebtParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4494a90aaab0034, []int{2}
+	return fileDescriptor_e4494a90aaab0034, []int{3}
 }
 func (m *DebtParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -282,13 +412,26 @@ type CollateralParam struct {
 	KeeperRewardPercentage           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=keeper_reward_percentage,json=keeperRewardPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"keeper_reward_percentage"`
 	CheckCollateralizationIndexCount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=check_collateralization_index_count,json=checkCollateralizationIndexCount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"check_collateralization_index_count"`
 	ConversionFactor                 github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=conversion_factor,json=conversionFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"conversion_factor"`
+	// liquidation_close_factor is the fraction of a cdp's debt liquidated at once. A value of 1 seizes all of the cdp's
+	// collateral, lower values seize only the collateral needed to cover that fraction of the debt plus the penalty.
+	LiquidationCloseFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=liquidation_close_factor,json=liquidationCloseFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_close_factor"`
+	// keeper_incentive is the amount of the debt asset paid from the liquidator module account's surplus to a keeper
+	// that liquidates a cdp of this collateral type.
+	KeeperIncentive github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,14,opt,name=keeper_incentive,json=keeperIncentive,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"keeper_incentive"`
+	// price_staleness_threshold is the maximum time since a price was last posted to the spot or liquidation market
+	// before drawing debt is paused for the collateral type. Deposits, withdrawals and repayments are not paused. A zero
+	// value disables the check.
+	PriceStalenessThreshold time.Duration `protobuf:"bytes,15,opt,name=price_staleness_threshold,json=priceStalenessThreshold,proto3,stdduration" json:"price_staleness_threshold"`
+	// liquidation_twap_duration is the duration of the time-weighted average of the liquidation market used as the
+	// liquidation price instead of its current median price. A zero value uses the current price.
+	LiquidationTwapDuration time.Duration `protobuf:"bytes,16,opt,name=liquidation_twap_duration,json=liquidationTwapDuration,proto3,stdduration" json:"liquidation_twap_duration"`
 }
 
 func (m *CollateralParam) Reset()         { *m = CollateralParam{} }
 func (m *CollateralParam) String() string { return proto.CompactTextString(m) }
 func (*CollateralParam) ProtoMessage()    {}
 func (*CollateralParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4494a90aaab0034, []int{3}
+	return fileDescriptor_e4494a90aaab0034, []int{4}
 }
 func (m *CollateralParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -352,6 +495,20 @@ func (m *CollateralParam) GetLiquidationMarketID() string {
 	return ""
 }
 
+func (m *CollateralParam) GetPriceStalenessThreshold() time.Duration {
+	if m != nil {
+		return m.PriceStalenessThreshold
+	}
+	return 0
+}
+
+func (m *CollateralParam) GetLiquidationTwapDuration() time.Duration {
+	if m != nil {
+		return m.LiquidationTwapDuration
+	}
+	return 0
+}
+
 // GenesisAccumulationTime defines the previous distribution time and its corresponding denom
 type GenesisAccumulationTime struct {
 	CollateralType           string                                 `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
@@ -363,7 +520,7 @@ func (m *GenesisAccumulationTime) Reset()         { *m = GenesisAccumulationTime
 func (m *GenesisAccumulationTime) String() string { return proto.CompactTextString(m) }
 func (*GenesisAccumulationTime) ProtoMessage()    {}
 func (*GenesisAccumulationTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4494a90aaab0034, []int{4}
+	return fileDescriptor_e4494a90aaab0034, []int{5}
 }
 func (m *GenesisAccumulationTime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -416,7 +573,7 @@ func (m *GenesisTotalPrincipal) Reset()         { *m = GenesisTotalPrincipal{} }
 func (m *GenesisTotalPrincipal) String() string { return proto.CompactTextString(m) }
 func (*GenesisTotalPrincipal) ProtoMessage()    {}
 func (*GenesisTotalPrincipal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4494a90aaab0034, []int{5}
+	return fileDescriptor_e4494a90aaab0034, []int{6}
 }
 func (m *GenesisTotalPrincipal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -455,6 +612,7 @@ func (m *GenesisTotalPrincipal) GetCollateralType() string {
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.cdp.v1beta1.GenesisState")
 	proto.RegisterType((*Params)(nil), "kava.cdp.v1beta1.Params")
+	proto.RegisterType((*FeePaymentParam)(nil), "kava.cdp.v1beta1.FeePaymentParam")
 	proto.RegisterType((*DebtParam)(nil), "kava.cdp.v1beta1.DebtParam")
 	proto.RegisterType((*CollateralParam)(nil), "kava.cdp.v1beta1.CollateralParam")
 	proto.RegisterType((*GenesisAccumulationTime)(nil), "kava.cdp.v1beta1.GenesisAccumulationTime")
@@ -464,83 +622,107 @@ func init() {
 func init() { proto.RegisterFile("kava/cdp/v1beta1/genesis.proto", fileDescriptor_e4494a90aaab0034) }
 
 var fileDescriptor_e4494a90aaab0034 = []byte{
-	// 1208 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x6e, 0x1a, 0xc7,
-	0x17, 0xf7, 0xda, 0xc4, 0x81, 0xb1, 0x63, 0xf0, 0xc4, 0x49, 0xd6, 0x8e, 0xfe, 0xc0, 0xdf, 0x55,
-	0x1b, 0x7a, 0x11, 0x50, 0x52, 0x29, 0x52, 0xa5, 0xa8, 0x69, 0xd6, 0x28, 0x11, 0x4a, 0x2a, 0xa1,
-	0xb5, 0xaf, 0xda, 0x8b, 0xd5, 0xec, 0xec, 0x80, 0x47, 0x2c, 0x3b, 0xdb, 0x99, 0x81, 0x26, 0x79,
-	0x85, 0xaa, 0x6a, 0xd4, 0x97, 0xa8, 0x14, 0xf5, 0xb2, 0x0f, 0x91, 0xde, 0x45, 0xbd, 0xaa, 0x7a,
-	0x41, 0x2a, 0xf2, 0x22, 0xd5, 0x7c, 0x00, 0x6b, 0x30, 0x52, 0x64, 0xd3, 0x1b, 0x76, 0xe7, 0x7c,
-	0xfc, 0xce, 0xc7, 0x9c, 0x73, 0xf6, 0x00, 0xca, 0x3d, 0x34, 0x44, 0x0d, 0x1c, 0xa5, 0x8d, 0xe1,
-	0xbd, 0x90, 0x48, 0x74, 0xaf, 0xd1, 0x25, 0x09, 0x11, 0x54, 0xd4, 0x53, 0xce, 0x24, 0x83, 0x25,
-	0xc5, 0xaf, 0xe3, 0x28, 0xad, 0x5b, 0xfe, 0x41, 0x19, 0x33, 0xd1, 0x67, 0xa2, 0x11, 0x22, 0x41,
-	0xa6, 0x4a, 0x98, 0xd1, 0xc4, 0x68, 0x1c, 0xec, 0x1b, 0x7e, 0xa0, 0x4f, 0x0d, 0x73, 0xb0, 0xac,
-	0xbd, 0x2e, 0xeb, 0x32, 0x43, 0x57, 0x6f, 0x96, 0x5a, 0xe9, 0x32, 0xd6, 0x8d, 0x49, 0x43, 0x9f,
-	0xc2, 0x41, 0xa7, 0x21, 0x69, 0x9f, 0x08, 0x89, 0xfa, 0xa9, 0x15, 0x38, 0x58, 0xf0, 0x51, 0xf9,
-	0xa3, 0x79, 0x87, 0x7f, 0xe4, 0xc0, 0xf6, 0x53, 0xe3, 0xf1, 0xb1, 0x44, 0x92, 0xc0, 0x07, 0x60,
-	0x33, 0x45, 0x1c, 0xf5, 0x85, 0xeb, 0x54, 0x9d, 0xda, 0xd6, 0x7d, 0xb7, 0x3e, 0x1f, 0x41, 0xbd,
-	0xad, 0xf9, 0x5e, 0xee, 0xed, 0xa8, 0xb2, 0xe6, 0x5b, 0x69, 0xf8, 0x08, 0xe4, 0x70, 0x94, 0x0a,
-	0x77, 0xbd, 0xba, 0x51, 0xdb, 0xba, 0x7f, 0x63, 0x51, 0xeb, 0xa8, 0xd9, 0xf6, 0xf6, 0x94, 0xca,
-	0x78, 0x54, 0xc9, 0x1d, 0x35, 0xdb, 0xe2, 0xcd, 0x7b, 0xf3, 0xf4, 0xb5, 0x22, 0x7c, 0x0a, 0xf2,
-	0x11, 0x49, 0x99, 0xa0, 0x52, 0xb8, 0x1b, 0x1a, 0x64, 0x7f, 0x11, 0xa4, 0x69, 0x24, 0xbc, 0x92,
-	0x02, 0x7a, 0xf3, 0xbe, 0x92, 0xb7, 0x04, 0xe1, 0x4f, 0x95, 0xe1, 0x97, 0xa0, 0x28, 0x24, 0xe2,
-	0x92, 0x26, 0xdd, 0x00, 0x47, 0x69, 0x40, 0x23, 0x37, 0x57, 0x75, 0x6a, 0x39, 0x6f, 0x77, 0x3c,
-	0xaa, 0x5c, 0x3b, 0xb6, 0xac, 0xa3, 0x28, 0x6d, 0x35, 0xfd, 0x6b, 0x22, 0x73, 0x8c, 0xe0, 0xff,
-	0x00, 0x88, 0x48, 0x28, 0x83, 0x88, 0x24, 0xac, 0xef, 0x5e, 0xa9, 0x3a, 0xb5, 0x82, 0x5f, 0x50,
-	0x94, 0xa6, 0x22, 0xc0, 0xdb, 0xa0, 0xd0, 0x65, 0x43, 0xcb, 0xdd, 0xd4, 0xdc, 0x7c, 0x97, 0x0d,
-	0x0d, 0xf3, 0x47, 0x07, 0xdc, 0x4e, 0x39, 0x19, 0x52, 0x36, 0x10, 0x01, 0xc2, 0x78, 0xd0, 0x1f,
-	0xc4, 0x48, 0x52, 0x96, 0x04, 0xfa, 0x3e, 0xdc, 0xab, 0x3a, 0xa6, 0xcf, 0x17, 0x63, 0xb2, 0xe9,
-	0x7f, 0x9c, 0x51, 0x39, 0xa1, 0x7d, 0xe2, 0x55, 0x6d, 0x8c, 0xee, 0x12, 0x01, 0xe1, 0xef, 0x4f,
-	0xec, 0x2d, 0xb0, 0x20, 0x07, 0x25, 0xc9, 0x24, 0x8a, 0x83, 0x94, 0xd3, 0x04, 0xd3, 0x14, 0xc5,
-	0xc2, 0xcd, 0x6b, 0x0f, 0xee, 0x2c, 0xf5, 0xe0, 0x44, 0x29, 0xb4, 0x27, 0xf2, 0x5e, 0xd9, 0xda,
-	0xbf, 0x79, 0x2e, 0x5b, 0xf8, 0x45, 0x79, 0x96, 0x70, 0xf8, 0xdb, 0x26, 0xd8, 0x34, 0xb5, 0x01,
-	0x4f, 0xc1, 0x2e, 0x66, 0x71, 0x8c, 0x24, 0xe1, 0xca, 0x87, 0x49, 0x41, 0x29, 0xfb, 0xff, 0x3f,
-	0xa7, 0x34, 0xa6, 0xa2, 0x5a, 0xdd, 0x73, 0xad, 0xe5, 0xd2, 0x1c, 0x43, 0xf8, 0x25, 0x3c, 0x47,
-	0x81, 0x5f, 0xdb, 0x2b, 0xd3, 0x36, 0xdc, 0x75, 0x5d, 0xb3, 0xb7, 0xcf, 0x2b, 0x9c, 0x50, 0x1a,
-	0x70, 0x53, 0xb6, 0xfa, 0x56, 0x35, 0x01, 0x3e, 0x03, 0xbb, 0xdd, 0x98, 0x85, 0x28, 0x0e, 0x34,
-	0x50, 0x4c, 0xfb, 0x54, 0xba, 0x1b, 0x1a, 0x68, 0xbf, 0x6e, 0xfb, 0x4f, 0x35, 0x6b, 0xc6, 0x5d,
-	0x9a, 0x58, 0x98, 0xa2, 0xd1, 0x54, 0xe8, 0xcf, 0x95, 0x1e, 0x7c, 0x01, 0xf6, 0xc5, 0x80, 0xa7,
-	0xb1, 0xaa, 0x81, 0x01, 0x36, 0xd7, 0x7f, 0xca, 0x89, 0x38, 0x65, 0xb1, 0x29, 0xc3, 0x82, 0xf7,
-	0x50, 0x69, 0xfe, 0x3d, 0xaa, 0x7c, 0xd6, 0xa5, 0xf2, 0x74, 0x10, 0xd6, 0x31, 0xeb, 0xdb, 0x36,
-	0xb7, 0x8f, 0xbb, 0x22, 0xea, 0x35, 0xe4, 0xcb, 0x94, 0x88, 0x7a, 0x2b, 0x91, 0x7f, 0xfe, 0x7e,
-	0x17, 0x58, 0x2f, 0x5a, 0x89, 0xf4, 0x6f, 0x59, 0xf8, 0xc7, 0x06, 0xfd, 0x64, 0x02, 0x0e, 0x63,
-	0x70, 0x7d, 0xde, 0x72, 0xcc, 0xa4, 0x29, 0xe2, 0x4b, 0xda, 0xdc, 0x3d, 0x6b, 0xf3, 0x39, 0x93,
-	0x90, 0x83, 0x9b, 0x3a, 0x5b, 0x8b, 0x41, 0x6e, 0xae, 0xc0, 0xe0, 0x9e, 0xc2, 0x5e, 0x88, 0xb0,
-	0x03, 0x4a, 0x67, 0x6c, 0xaa, 0xf0, 0xae, 0xae, 0xc0, 0xda, 0x4e, 0xc6, 0x9a, 0x8a, 0xed, 0x0e,
-	0x28, 0x62, 0xca, 0xf1, 0x80, 0xca, 0x20, 0xe4, 0x04, 0xf5, 0x08, 0x77, 0xf3, 0x55, 0xa7, 0x96,
-	0xf7, 0x77, 0x2c, 0xd9, 0x33, 0x54, 0xf8, 0x10, 0x1c, 0xc4, 0xf4, 0xfb, 0x01, 0x8d, 0x4c, 0x9f,
-	0x87, 0x31, 0xc3, 0xbd, 0x80, 0x26, 0x92, 0xf0, 0x21, 0x8a, 0xdd, 0x42, 0xd5, 0xa9, 0x6d, 0xf8,
-	0x6e, 0x46, 0xc2, 0x53, 0x02, 0x2d, 0xcb, 0x3f, 0xfc, 0x65, 0x1d, 0x14, 0xa6, 0x65, 0x09, 0xf7,
-	0xc0, 0x15, 0x33, 0x57, 0x1c, 0x3d, 0x57, 0xcc, 0x41, 0xb9, 0xc2, 0x49, 0x87, 0x70, 0x92, 0x60,
-	0x12, 0x20, 0x21, 0x88, 0xd4, 0x25, 0x5e, 0xf0, 0x77, 0xa6, 0xe4, 0xc7, 0x8a, 0x0a, 0xa9, 0x6a,
-	0xb8, 0x64, 0x48, 0xb8, 0x50, 0x9e, 0x74, 0x10, 0x96, 0x8c, 0xeb, 0x22, 0xbe, 0x6c, 0x72, 0x4a,
-	0x33, 0xd8, 0x27, 0x1a, 0x15, 0x7e, 0x67, 0x3b, 0xae, 0x13, 0x33, 0xc6, 0x57, 0x52, 0xd3, 0xba,
-	0x19, 0x9f, 0x28, 0xb8, 0xc3, 0x9f, 0xf3, 0xa0, 0x38, 0xd7, 0xf5, 0x4b, 0x52, 0x03, 0x41, 0x4e,
-	0xe1, 0xd9, 0x7c, 0xe8, 0x77, 0x95, 0x85, 0xec, 0x85, 0x70, 0xf5, 0xb8, 0x40, 0x16, 0x9a, 0x04,
-	0x67, 0x3c, 0x6c, 0x12, 0xec, 0x97, 0x32, 0xb0, 0xbe, 0xfa, 0x85, 0x5f, 0xd9, 0x2c, 0x98, 0x71,
-	0x91, 0xfb, 0xb8, 0x71, 0xa1, 0x03, 0x35, 0x83, 0x02, 0x01, 0xf5, 0xed, 0x09, 0x69, 0x4c, 0xe5,
-	0xcb, 0xa0, 0x43, 0xc8, 0x05, 0x1a, 0x75, 0xd1, 0xcd, 0xed, 0x29, 0xe4, 0x13, 0x42, 0x60, 0x00,
-	0xb6, 0x27, 0xad, 0x22, 0xe8, 0x2b, 0xb2, 0x92, 0xce, 0xdc, 0xb2, 0x88, 0xc7, 0xf4, 0x15, 0x81,
-	0x7d, 0x70, 0x3d, 0x9b, 0xee, 0x94, 0x24, 0x28, 0x96, 0x2f, 0x2f, 0xd0, 0x93, 0x8b, 0x91, 0xc0,
-	0x0c, 0x70, 0xdb, 0xe0, 0xc2, 0x07, 0x60, 0x47, 0xa4, 0x4c, 0x06, 0x7d, 0xc4, 0x7b, 0x44, 0xaa,
-	0xef, 0x7a, 0x5e, 0x5b, 0x2a, 0x8d, 0x47, 0x95, 0xed, 0xe3, 0x94, 0xc9, 0x6f, 0x34, 0xa3, 0xd5,
-	0xf4, 0xb7, 0xc5, 0xec, 0x14, 0xc1, 0x67, 0xe0, 0x46, 0xd6, 0xcd, 0x99, 0x7a, 0x41, 0xab, 0xdf,
-	0x1a, 0x8f, 0x2a, 0xd7, 0x9f, 0xcf, 0x04, 0xa6, 0x28, 0xd9, 0xe0, 0xa6, 0x60, 0x43, 0xe0, 0xf6,
-	0x08, 0x49, 0x09, 0x0f, 0x38, 0xf9, 0x01, 0xf1, 0x28, 0x48, 0x09, 0xc7, 0x24, 0x91, 0xa8, 0x4b,
-	0x5c, 0xb0, 0x82, 0xc0, 0x6f, 0x1a, 0x74, 0x5f, 0x83, 0xb7, 0xa7, 0xd8, 0x6a, 0xbd, 0xf8, 0x04,
-	0x9f, 0x12, 0xdc, 0x0b, 0x66, 0x9f, 0x40, 0xfa, 0xca, 0x44, 0x44, 0x93, 0x88, 0xbc, 0x08, 0x30,
-	0x1b, 0x24, 0xd2, 0xdd, 0x5a, 0xc1, 0x25, 0x57, 0xb5, 0xa1, 0xa3, 0x79, 0x3b, 0x2d, 0x65, 0xe6,
-	0x48, 0x59, 0x39, 0x7f, 0xdc, 0x6c, 0xff, 0x17, 0xe3, 0xe6, 0xf0, 0xa7, 0x75, 0x70, 0x6b, 0xc9,
-	0x06, 0xa4, 0x27, 0xf5, 0x6c, 0xcd, 0xd0, 0xe3, 0xc0, 0xcc, 0x88, 0x9d, 0x19, 0xf9, 0x44, 0x0d,
-	0x86, 0x10, 0x1c, 0x2c, 0xdf, 0xcd, 0xec, 0xd6, 0x70, 0x50, 0x37, 0x8b, 0x74, 0x7d, 0xb2, 0x48,
-	0xd7, 0x4f, 0x26, 0x8b, 0xb4, 0x97, 0x57, 0x41, 0xbd, 0x7e, 0x5f, 0x71, 0x7c, 0x77, 0xd9, 0xce,
-	0x05, 0x09, 0x28, 0xea, 0xd9, 0x4f, 0x84, 0xbc, 0xf8, 0x00, 0x5e, 0x2c, 0x88, 0x9d, 0x09, 0xa8,
-	0xcd, 0xc7, 0xaf, 0x0e, 0xb8, 0x71, 0xee, 0x46, 0xf6, 0xf1, 0xd9, 0x20, 0xa0, 0x38, 0xb7, 0x1c,
-	0x9a, 0x29, 0x7a, 0xd9, 0xef, 0xe8, 0xd9, 0x85, 0xd0, 0x7b, 0xf4, 0x76, 0x5c, 0x76, 0xde, 0x8d,
-	0xcb, 0xce, 0x3f, 0xe3, 0xb2, 0xf3, 0xfa, 0x43, 0x79, 0xed, 0xdd, 0x87, 0xf2, 0xda, 0x5f, 0x1f,
-	0xca, 0x6b, 0xdf, 0x7e, 0x9a, 0xc1, 0x57, 0xab, 0xda, 0xdd, 0x18, 0x85, 0x42, 0xbf, 0x35, 0x5e,
-	0xe8, 0x3f, 0x2a, 0xda, 0x44, 0xb8, 0xa9, 0x6f, 0xe2, 0x8b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x5c, 0xdf, 0x27, 0x18, 0x65, 0x0d, 0x00, 0x00,
+	// 1589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4b, 0x6f, 0x1a, 0xc1,
+	0x1d, 0xf7, 0xda, 0xd8, 0x81, 0x01, 0x03, 0x1e, 0x3b, 0xf6, 0xe0, 0xb4, 0x40, 0xdc, 0x87, 0x9d,
+	0x43, 0x40, 0x49, 0xa5, 0x48, 0x95, 0xa2, 0xa6, 0xc6, 0xc8, 0x11, 0x4a, 0x2a, 0x59, 0x8b, 0x4f,
+	0xcd, 0x61, 0x35, 0xec, 0x0e, 0x78, 0xe4, 0x65, 0x67, 0xbb, 0x33, 0x10, 0x3b, 0xf7, 0x9e, 0xaa,
+	0x4a, 0x51, 0x4e, 0xfd, 0x06, 0x95, 0x72, 0xac, 0xfa, 0x21, 0x72, 0x4c, 0x7b, 0xaa, 0x7a, 0x70,
+	0x2a, 0xf2, 0x45, 0xaa, 0x79, 0x2c, 0x2c, 0x2f, 0x29, 0x96, 0x68, 0x2f, 0x36, 0xfb, 0x7f, 0xfc,
+	0xfe, 0x8f, 0x9d, 0xff, 0x6f, 0x66, 0x16, 0x94, 0xaf, 0xf1, 0x10, 0xd7, 0x5d, 0x2f, 0xac, 0x0f,
+	0x9f, 0x75, 0x88, 0xc0, 0xcf, 0xea, 0x3d, 0x12, 0x10, 0x4e, 0x79, 0x2d, 0x8c, 0x98, 0x60, 0xb0,
+	0x28, 0xf5, 0x35, 0xd7, 0x0b, 0x6b, 0x46, 0x7f, 0x58, 0x76, 0x19, 0xef, 0x33, 0x5e, 0xef, 0x60,
+	0x4e, 0xc6, 0x4e, 0x2e, 0xa3, 0x81, 0xf6, 0x38, 0x2c, 0x69, 0xbd, 0xa3, 0x9e, 0xea, 0xfa, 0xc1,
+	0xa8, 0xf6, 0x7a, 0xac, 0xc7, 0xb4, 0x5c, 0xfe, 0x32, 0xd2, 0x72, 0x8f, 0xb1, 0x9e, 0x4f, 0xea,
+	0xea, 0xa9, 0x33, 0xe8, 0xd6, 0xbd, 0x41, 0x84, 0x05, 0x65, 0x31, 0x60, 0x65, 0x56, 0x2f, 0x68,
+	0x9f, 0x70, 0x81, 0xfb, 0xa1, 0x31, 0x38, 0x9c, 0xab, 0xc1, 0xf5, 0x8c, 0xee, 0xe8, 0x53, 0x1a,
+	0xe4, 0x5e, 0xeb, 0x8a, 0xda, 0x02, 0x0b, 0x02, 0x5f, 0x80, 0xad, 0x10, 0x47, 0xb8, 0xcf, 0x91,
+	0x55, 0xb5, 0x4e, 0xb2, 0xcf, 0x51, 0x6d, 0xb6, 0xc2, 0xda, 0x85, 0xd2, 0x37, 0x52, 0x5f, 0xee,
+	0x2a, 0x6b, 0xb6, 0xb1, 0x86, 0xaf, 0x40, 0xca, 0xf5, 0x42, 0x8e, 0xd6, 0xab, 0x1b, 0x27, 0xd9,
+	0xe7, 0x0f, 0xe7, 0xbd, 0xce, 0x9a, 0x17, 0x8d, 0x3d, 0xe9, 0x32, 0xba, 0xab, 0xa4, 0xce, 0x9a,
+	0x17, 0xfc, 0xf3, 0x37, 0xfd, 0xdf, 0x56, 0x8e, 0xf0, 0x35, 0x48, 0x7b, 0x24, 0x64, 0x9c, 0x0a,
+	0x8e, 0x36, 0x14, 0x48, 0x69, 0x1e, 0xa4, 0xa9, 0x2d, 0x1a, 0x45, 0x09, 0xf4, 0xf9, 0x5b, 0x25,
+	0x6d, 0x04, 0xdc, 0x1e, 0x3b, 0xc3, 0x5f, 0x83, 0x02, 0x17, 0x38, 0x12, 0x34, 0xe8, 0x39, 0xae,
+	0x17, 0x3a, 0xd4, 0x43, 0xa9, 0xaa, 0x75, 0x92, 0x6a, 0xec, 0x8c, 0xee, 0x2a, 0xdb, 0x6d, 0xa3,
+	0x3a, 0xf3, 0xc2, 0x56, 0xd3, 0xde, 0xe6, 0x89, 0x47, 0x0f, 0xfe, 0x14, 0x00, 0x8f, 0x74, 0x84,
+	0xe3, 0x91, 0x80, 0xf5, 0xd1, 0x66, 0xd5, 0x3a, 0xc9, 0xd8, 0x19, 0x29, 0x69, 0x4a, 0x01, 0x7c,
+	0x04, 0x32, 0x3d, 0x36, 0x34, 0xda, 0x2d, 0xa5, 0x4d, 0xf7, 0xd8, 0x50, 0x2b, 0xff, 0x64, 0x81,
+	0x47, 0x61, 0x44, 0x86, 0x94, 0x0d, 0xb8, 0x83, 0x5d, 0x77, 0xd0, 0x1f, 0xf8, 0xea, 0x35, 0x39,
+	0xea, 0x7d, 0xa0, 0x07, 0xaa, 0xa6, 0x27, 0xf3, 0x35, 0x99, 0xf6, 0x9f, 0x26, 0x5c, 0x2e, 0x69,
+	0x9f, 0x34, 0xaa, 0xa6, 0x46, 0xb4, 0xc4, 0x80, 0xdb, 0xa5, 0x38, 0xde, 0x9c, 0x0a, 0x46, 0xa0,
+	0x28, 0x98, 0xc0, 0xbe, 0x13, 0x46, 0x34, 0x70, 0x69, 0x88, 0x7d, 0x8e, 0xd2, 0x2a, 0x83, 0xe3,
+	0xa5, 0x19, 0x5c, 0x4a, 0x87, 0x8b, 0xd8, 0xbe, 0x51, 0x36, 0xf1, 0xf7, 0x17, 0xaa, 0xb9, 0x5d,
+	0x10, 0xd3, 0x02, 0xd8, 0x06, 0x59, 0xb9, 0xa8, 0x88, 0x2b, 0xd3, 0xe0, 0x28, 0xa3, 0xc2, 0xfd,
+	0x64, 0xc1, 0xfa, 0x19, 0x1b, 0x35, 0x76, 0x4d, 0x8c, 0xec, 0x44, 0xc6, 0xed, 0x24, 0x0a, 0xfc,
+	0xa3, 0x05, 0x4a, 0x34, 0x10, 0x24, 0x22, 0x5c, 0x38, 0x5d, 0xec, 0x0a, 0x16, 0x39, 0x3c, 0xc0,
+	0x21, 0xbf, 0x62, 0x82, 0x23, 0xa0, 0x62, 0x9c, 0xcc, 0xc7, 0x68, 0x19, 0x97, 0x73, 0xe5, 0xd1,
+	0x36, 0x0e, 0x8d, 0x8a, 0x89, 0x77, 0xb0, 0x58, 0xcf, 0xed, 0x03, 0xba, 0x58, 0x01, 0xdf, 0x81,
+	0x5d, 0x8e, 0x87, 0x34, 0xe8, 0x25, 0x5e, 0x2e, 0x8b, 0x50, 0x56, 0x0d, 0xc9, 0xcf, 0xe7, 0x13,
+	0x68, 0x6b, 0xe3, 0xd3, 0x89, 0xad, 0x19, 0x18, 0xc8, 0xe7, 0x34, 0xd0, 0x03, 0xc5, 0x18, 0x7c,
+	0x3c, 0x03, 0x39, 0x55, 0x5a, 0x75, 0x29, 0x72, 0x3c, 0x0a, 0x07, 0xa6, 0xa4, 0xc2, 0xb4, 0x9c,
+	0xdb, 0x05, 0x3e, 0x2d, 0x80, 0xc7, 0xa0, 0x40, 0x6e, 0x42, 0x16, 0x09, 0xc7, 0xbd, 0x22, 0xee,
+	0x35, 0x1f, 0xf4, 0xd1, 0xb6, 0x5a, 0xc4, 0x79, 0x2d, 0x3e, 0x33, 0xd2, 0xa3, 0x4f, 0x19, 0xb0,
+	0xa5, 0x87, 0x1c, 0x5e, 0x81, 0x1d, 0x97, 0xf9, 0x3e, 0x16, 0x24, 0x92, 0x8b, 0x29, 0x66, 0x06,
+	0x99, 0xda, 0xe3, 0x05, 0x33, 0x3e, 0x36, 0x55, 0xee, 0x0d, 0x64, 0x72, 0x2b, 0xce, 0x28, 0xb8,
+	0x5d, 0x74, 0x67, 0x24, 0xf0, 0xb7, 0x66, 0xf6, 0x54, 0x0c, 0xb4, 0xae, 0xfa, 0xfa, 0x68, 0x11,
+	0x03, 0x74, 0x84, 0x06, 0xd7, 0xed, 0xcc, 0x78, 0xb1, 0x00, 0xbe, 0x01, 0x3b, 0x3d, 0x9f, 0x75,
+	0xb0, 0xef, 0x28, 0x20, 0x9f, 0xf6, 0xa9, 0x40, 0x1b, 0x0a, 0xa8, 0x54, 0x33, 0x44, 0x2b, 0x59,
+	0x39, 0x91, 0x2e, 0x0d, 0x0c, 0x4c, 0x41, 0x7b, 0x4a, 0xf4, 0xb7, 0xd2, 0x0f, 0xde, 0x80, 0x12,
+	0x1f, 0x44, 0xa1, 0x2f, 0x87, 0x79, 0xe0, 0xea, 0x39, 0xbe, 0x8a, 0x08, 0xbf, 0x62, 0xbe, 0xe6,
+	0x93, 0x4c, 0xe3, 0xa5, 0xf4, 0xfc, 0xf7, 0x5d, 0xe5, 0x97, 0x3d, 0x2a, 0xae, 0x06, 0x9d, 0x9a,
+	0xcb, 0xfa, 0x86, 0xcf, 0xcd, 0xbf, 0xa7, 0xdc, 0xbb, 0xae, 0x8b, 0xdb, 0x90, 0x70, 0xb9, 0x1e,
+	0xff, 0xf9, 0xf7, 0xa7, 0xc0, 0x64, 0xd1, 0x0a, 0x84, 0x7d, 0x60, 0xe0, 0x4f, 0x35, 0xfa, 0x65,
+	0x0c, 0x0e, 0x7d, 0xb0, 0x3b, 0x1b, 0xd9, 0x67, 0x02, 0x6d, 0xae, 0x20, 0xe6, 0xce, 0x74, 0xcc,
+	0xb7, 0x4c, 0xc0, 0x08, 0xec, 0xab, 0x6e, 0xcd, 0x17, 0xb9, 0xb5, 0x82, 0x80, 0x7b, 0x12, 0x7b,
+	0xae, 0xc2, 0x2e, 0x28, 0x4e, 0xc5, 0x94, 0xe5, 0x3d, 0x58, 0x41, 0xb4, 0x7c, 0x22, 0x9a, 0xac,
+	0xed, 0x18, 0x14, 0x5c, 0x1a, 0xb9, 0x03, 0x2a, 0x9c, 0x4e, 0x44, 0xf0, 0x35, 0x89, 0x50, 0xba,
+	0x6a, 0x9d, 0xa4, 0xed, 0xbc, 0x11, 0x37, 0xb4, 0x14, 0xbe, 0x04, 0x87, 0x3e, 0xfd, 0xc3, 0x80,
+	0x7a, 0x9a, 0xb0, 0x3b, 0x3e, 0x73, 0xaf, 0x1d, 0xc5, 0x04, 0x43, 0xec, 0xa3, 0x4c, 0xd5, 0x3a,
+	0xd9, 0xb0, 0x51, 0xc2, 0xa2, 0x21, 0x0d, 0x5a, 0x46, 0x0f, 0xdb, 0x60, 0xa7, 0x4b, 0x88, 0x13,
+	0xe2, 0xdb, 0x3e, 0x09, 0xe2, 0x05, 0x0c, 0xaa, 0xd6, 0xe2, 0x19, 0x39, 0x27, 0xe4, 0x42, 0x5b,
+	0x26, 0x97, 0x71, 0xa1, 0x3b, 0x2d, 0x86, 0x2e, 0xc8, 0x47, 0xc4, 0x23, 0xfd, 0x50, 0x65, 0xd4,
+	0x25, 0x04, 0x65, 0xef, 0xdd, 0xa1, 0x26, 0x71, 0x13, 0x1d, 0x6a, 0x12, 0xd7, 0xde, 0x9e, 0x60,
+	0x9e, 0x13, 0x02, 0x5b, 0xe0, 0xf1, 0x32, 0x6e, 0x9d, 0x94, 0x9f, 0x53, 0xe5, 0x97, 0x17, 0x13,
+	0xe3, 0xb8, 0x09, 0x0e, 0xc8, 0xc5, 0x14, 0x16, 0x61, 0x41, 0xd0, 0xf6, 0x0a, 0xb2, 0xcd, 0x1a,
+	0x44, 0x1b, 0x0b, 0x72, 0xf4, 0x0f, 0x0b, 0x14, 0x66, 0x7a, 0x07, 0xf7, 0xc0, 0xa6, 0xde, 0x8c,
+	0x2d, 0xc5, 0x63, 0xfa, 0x01, 0x3e, 0x01, 0x99, 0x3e, 0x8e, 0xae, 0x89, 0x90, 0x5b, 0xff, 0xba,
+	0xca, 0x23, 0x37, 0xba, 0xab, 0xa4, 0x7f, 0xa7, 0x84, 0xad, 0xa6, 0x9d, 0xd6, 0xea, 0x96, 0x07,
+	0xa9, 0xa4, 0xb7, 0x60, 0x48, 0x22, 0xae, 0xba, 0xac, 0x4a, 0x43, 0x1b, 0xf7, 0x4e, 0x7d, 0x7e,
+	0x29, 0x16, 0x27, 0xb0, 0xba, 0x61, 0x10, 0x82, 0x54, 0x67, 0x10, 0x05, 0x8a, 0x3b, 0xd2, 0xb6,
+	0xfa, 0x7d, 0xf4, 0x69, 0x1d, 0x64, 0xc6, 0x84, 0xb6, 0xa4, 0x9a, 0x63, 0x50, 0x88, 0x48, 0x97,
+	0x44, 0x24, 0x70, 0x89, 0x83, 0x39, 0x27, 0x42, 0xd7, 0x64, 0xe7, 0xc7, 0xe2, 0x53, 0x29, 0xfd,
+	0x7f, 0xd6, 0xf2, 0xce, 0x70, 0x75, 0xd7, 0x67, 0x2c, 0x5a, 0x09, 0x1b, 0x2a, 0x1a, 0x3f, 0x97,
+	0x70, 0x47, 0x7f, 0xcb, 0x82, 0xc2, 0xcc, 0x7e, 0xb1, 0xa4, 0x35, 0x10, 0xa4, 0x24, 0x9e, 0xe9,
+	0x87, 0xfa, 0x2d, 0xbb, 0x90, 0x1c, 0x65, 0x75, 0x52, 0x46, 0x1b, 0x2b, 0x58, 0x8c, 0xc5, 0x04,
+	0xac, 0x2d, 0xff, 0xc2, 0xdf, 0x00, 0x90, 0xd8, 0x68, 0x52, 0x3f, 0xb6, 0xd1, 0x64, 0xbc, 0xf1,
+	0x16, 0x83, 0x81, 0x3c, 0x7e, 0x76, 0xa8, 0x4f, 0xc5, 0xad, 0x9a, 0xf0, 0xcd, 0x15, 0xa4, 0x99,
+	0x1b, 0x43, 0xca, 0x01, 0x77, 0x40, 0x2e, 0x26, 0x59, 0x4e, 0x3f, 0x90, 0x95, 0x70, 0x7a, 0xd6,
+	0x20, 0xb6, 0xe9, 0x07, 0x02, 0xfb, 0x60, 0x37, 0xd9, 0xee, 0x90, 0x04, 0xd8, 0x17, 0xb7, 0xe8,
+	0xc1, 0x0a, 0x2a, 0x81, 0x09, 0xe0, 0x0b, 0x8d, 0x0b, 0x5f, 0x80, 0x3c, 0x0f, 0x99, 0x70, 0x26,
+	0xf3, 0x9d, 0x56, 0x91, 0x8a, 0xa3, 0xbb, 0x4a, 0xae, 0x1d, 0x32, 0x31, 0x9e, 0xf1, 0x1c, 0x9f,
+	0x3c, 0x79, 0xf0, 0x0d, 0x78, 0x98, 0x4c, 0x73, 0xe2, 0x9e, 0x51, 0xee, 0x07, 0xa3, 0xbb, 0xca,
+	0xee, 0xdb, 0x89, 0xc1, 0x18, 0x65, 0xd7, 0x9f, 0x13, 0x7a, 0x70, 0x08, 0xd0, 0x35, 0x21, 0x21,
+	0x89, 0x9c, 0x88, 0xbc, 0xc7, 0x91, 0xe7, 0x84, 0x24, 0x72, 0x49, 0x20, 0x70, 0x8f, 0x20, 0xb0,
+	0x82, 0xc2, 0xf7, 0x35, 0xba, 0xad, 0xc0, 0x2f, 0xc6, 0xd8, 0xf2, 0x86, 0xf1, 0x33, 0x75, 0x72,
+	0x73, 0x26, 0x87, 0x27, 0xfa, 0x41, 0x57, 0x44, 0x03, 0x8f, 0xdc, 0x38, 0x2e, 0x1b, 0x04, 0x02,
+	0x65, 0x57, 0xf0, 0x92, 0xab, 0x2a, 0xd0, 0xd9, 0x6c, 0x9c, 0x96, 0x0c, 0x73, 0x26, 0xa3, 0x2c,
+	0xa6, 0x9b, 0xdc, 0xff, 0x84, 0x6e, 0x86, 0x20, 0xb9, 0xf9, 0x3a, 0xae, 0xcf, 0x38, 0x89, 0x23,
+	0xae, 0x62, 0x9f, 0xd9, 0x4f, 0xa0, 0x9f, 0x49, 0x70, 0x13, 0xb7, 0x07, 0x8a, 0xe6, 0x45, 0xd3,
+	0x40, 0xbe, 0x04, 0x3a, 0x24, 0x28, 0xbf, 0x82, 0x0a, 0x0b, 0x1a, 0xb5, 0x15, 0x83, 0x42, 0x07,
+	0x94, 0xc2, 0x88, 0xba, 0xc4, 0xe1, 0x02, 0xfb, 0x24, 0x20, 0x9c, 0x27, 0xce, 0x61, 0x05, 0x43,
+	0x2c, 0xfa, 0x9a, 0x5f, 0x8b, 0xaf, 0xf9, 0xb5, 0xa6, 0xf9, 0x0c, 0xd0, 0x48, 0xcb, 0x64, 0xfe,
+	0xf2, 0xad, 0x62, 0xd9, 0x07, 0x0a, 0xa5, 0x1d, 0x83, 0x4c, 0x4e, 0x5c, 0x0e, 0x28, 0x25, 0x3b,
+	0x28, 0xde, 0xe3, 0xd0, 0x89, 0x3f, 0x23, 0xa0, 0xe2, 0x3d, 0x02, 0x24, 0x50, 0x2e, 0xdf, 0xe3,
+	0x30, 0x36, 0x39, 0xfa, 0xf3, 0x3a, 0x38, 0x58, 0x72, 0x4f, 0x55, 0xc7, 0xb0, 0xc9, 0x1d, 0x42,
+	0x31, 0xb6, 0xa6, 0xf1, 0xfc, 0x44, 0x7c, 0x29, 0xb9, 0xbb, 0x03, 0x0e, 0x97, 0xdf, 0xa0, 0xcd,
+	0x95, 0xe0, 0x70, 0x2e, 0xcd, 0xcb, 0xf8, 0x73, 0x87, 0xce, 0xf3, 0xa3, 0xcc, 0x13, 0x2d, 0xbb,
+	0x19, 0x43, 0x02, 0x0a, 0x33, 0x47, 0x9e, 0x95, 0xec, 0x0e, 0xf9, 0xe9, 0xe3, 0xd1, 0xd1, 0x5f,
+	0x2d, 0xf0, 0x70, 0xe1, 0xbd, 0xf9, 0xc7, 0xbb, 0x41, 0x40, 0x61, 0xe6, 0x0a, 0x8f, 0xd6, 0xef,
+	0x9d, 0xe9, 0x82, 0x43, 0xf2, 0xf4, 0xb5, 0xbd, 0xf1, 0xea, 0xcb, 0xa8, 0x6c, 0x7d, 0x1d, 0x95,
+	0xad, 0xff, 0x8c, 0xca, 0xd6, 0xc7, 0xef, 0xe5, 0xb5, 0xaf, 0xdf, 0xcb, 0x6b, 0xff, 0xfa, 0x5e,
+	0x5e, 0xfb, 0xfd, 0x2f, 0x12, 0xf8, 0xf2, 0x18, 0xfb, 0xd4, 0xc7, 0x1d, 0xae, 0x7e, 0xd5, 0x6f,
+	0xd4, 0xe7, 0x24, 0x15, 0xa2, 0xb3, 0xa5, 0xde, 0xc4, 0xaf, 0xfe, 0x3b, 0x00, 0xb8, 0xa9, 0xc2,
+	0x71, 0x2b, 0x13, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -563,6 +745,65 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExportChecksum) > 0 {
+		i -= len(m.ExportChecksum)
+		copy(dAtA[i:], m.ExportChecksum)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ExportChecksum)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.SavingsDeposits) > 0 {
+		for iNdEx := len(m.SavingsDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SavingsDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size, err := m.SavingsAccumulator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.InterestFactorSnapshots) > 0 {
+		for iNdEx := len(m.InterestFactorSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InterestFactorSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Protections) > 0 {
+		for iNdEx := len(m.Protections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Protections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.TotalPrincipals) > 0 {
 		for iNdEx := len(m.TotalPrincipals) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -671,6 +912,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SavingsRate.Size()
+		i -= size
+		if _, err := m.SavingsRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.InterestFactorSnapshotInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.InterestFactorSnapshotInterval))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.RedemptionFee.Size()
+		i -= size
+		if _, err := m.RedemptionFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.FeePaymentParam.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
 	if m.LiquidationBlockInterval != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LiquidationBlockInterval))
 		i--
@@ -763,6 +1039,63 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeePaymentParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeePaymentParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePaymentParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Burn {
+		i--
+		if m.Burn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ConversionFactor.Size()
+		i -= size
+		if _, err := m.ConversionFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DebtParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -840,6 +1173,44 @@ func (m *CollateralParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.LiquidationTwapDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LiquidationTwapDuration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintGenesis(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.PriceStalenessThreshold, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PriceStalenessThreshold):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintGenesis(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.KeeperIncentive.Size()
+		i -= size
+		if _, err := m.KeeperIncentive.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.LiquidationCloseFactor.Size()
+		i -= size
+		if _, err := m.LiquidationCloseFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
 	{
 		size := m.ConversionFactor.Size()
 		i -= size
@@ -981,12 +1352,12 @@ func (m *GenesisAccumulationTime) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	}
 	i--
 	dAtA[i] = 0x1a
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreviousAccumulationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreviousAccumulationTime):])
-	if err5 != nil {
-		return 0, err5
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreviousAccumulationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreviousAccumulationTime):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n5
-	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i -= n9
+	i = encodeVarintGenesis(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x12
 	if len(m.CollateralType) > 0 {
@@ -1093,6 +1464,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Protections) > 0 {
+		for _, e := range m.Protections {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InterestFactorSnapshots) > 0 {
+		for _, e := range m.InterestFactorSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.SavingsAccumulator.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SavingsDeposits) > 0 {
+		for _, e := range m.SavingsDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.ExportChecksum)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -1126,10 +1521,19 @@ func (m *Params) Size() (n int) {
 	if m.LiquidationBlockInterval != 0 {
 		n += 1 + sovGenesis(uint64(m.LiquidationBlockInterval))
 	}
+	l = m.FeePaymentParam.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.RedemptionFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.InterestFactorSnapshotInterval != 0 {
+		n += 1 + sovGenesis(uint64(m.InterestFactorSnapshotInterval))
+	}
+	l = m.SavingsRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
-func (m *DebtParam) Size() (n int) {
+func (m *FeePaymentParam) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1139,18 +1543,19 @@ func (m *DebtParam) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	l = len(m.ReferenceAsset)
+	l = len(m.MarketID)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	l = m.ConversionFactor.Size()
 	n += 1 + l + sovGenesis(uint64(l))
-	l = m.DebtFloor.Size()
-	n += 1 + l + sovGenesis(uint64(l))
+	if m.Burn {
+		n += 2
+	}
 	return n
 }
 
-func (m *CollateralParam) Size() (n int) {
+func (m *DebtParam) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1160,13 +1565,34 @@ func (m *CollateralParam) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	l = len(m.Type)
+	l = len(m.ReferenceAsset)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	l = m.LiquidationRatio.Size()
+	l = m.ConversionFactor.Size()
 	n += 1 + l + sovGenesis(uint64(l))
-	l = m.DebtLimit.Size()
+	l = m.DebtFloor.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *CollateralParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.LiquidationRatio.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DebtLimit.Size()
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.StabilityFee.Size()
 	n += 1 + l + sovGenesis(uint64(l))
@@ -1188,6 +1614,14 @@ func (m *CollateralParam) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.ConversionFactor.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.LiquidationCloseFactor.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.KeeperIncentive.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PriceStalenessThreshold)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LiquidationTwapDuration)
+	n += 2 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1510,6 +1944,173 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protections = append(m.Protections, Protection{})
+			if err := m.Protections[len(m.Protections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestFactorSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterestFactorSnapshots = append(m.InterestFactorSnapshots, InterestFactorSnapshot{})
+			if err := m.InterestFactorSnapshots[len(m.InterestFactorSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SavingsAccumulator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SavingsAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SavingsDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SavingsDeposits = append(m.SavingsDeposits, SavingsDeposit{})
+			if err := m.SavingsDeposits[len(m.SavingsDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExportChecksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExportChecksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1562,7 +2163,282 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralParams = append(m.CollateralParams, CollateralParam{})
+			if err := m.CollateralParams[len(m.CollateralParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtParam", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtParam.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalDebtLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalDebtLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SurplusAuctionThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SurplusAuctionThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SurplusAuctionLot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SurplusAuctionLot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtAuctionThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtAuctionThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtAuctionLot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtAuctionLot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CircuitBreaker = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationBlockInterval", wireType)
+			}
+			m.LiquidationBlockInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidationBlockInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePaymentParam", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1589,16 +2465,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralParams = append(m.CollateralParams, CollateralParam{})
-			if err := m.CollateralParams[len(m.CollateralParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeePaymentParam.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DebtParam", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1608,30 +2483,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DebtParam.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RedemptionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalDebtLimit", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestFactorSnapshotInterval", wireType)
 			}
-			var msglen int
+			m.InterestFactorSnapshotInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1641,28 +2517,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.InterestFactorSnapshotInterval |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.GlobalDebtLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SurplusAuctionThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SavingsRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1690,13 +2552,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SurplusAuctionThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SavingsRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeePaymentParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePaymentParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePaymentParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SurplusAuctionLot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1724,13 +2636,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SurplusAuctionLot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DebtAuctionThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1758,13 +2668,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DebtAuctionThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DebtAuctionLot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFactor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1792,13 +2700,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DebtAuctionLot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1815,26 +2723,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.CircuitBreaker = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationBlockInterval", wireType)
-			}
-			m.LiquidationBlockInterval = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LiquidationBlockInterval |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+			m.Burn = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2466,6 +3355,140 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationCloseFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationCloseFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeeperIncentive", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeeperIncentive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceStalenessThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.PriceStalenessThreshold, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationTwapDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.LiquidationTwapDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
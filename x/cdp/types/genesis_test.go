@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/kava-labs/kava/x/cdp/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -47,3 +48,27 @@ func TestGenesisTotalPrincipal(t *testing.T) {
 		})
 	}
 }
+
+func TestExportChecksum(t *testing.T) {
+	deposits := types.Deposits{
+		types.NewDeposit(1, sdk.AccAddress("depositor1"), sdk.NewInt64Coin("xrp", 100)),
+		types.NewDeposit(1, sdk.AccAddress("depositor2"), sdk.NewInt64Coin("xrp", 200)),
+	}
+	checksum := types.ExportChecksum(types.CDPs{}, deposits)
+	require.Len(t, checksum, 64)
+	assert.Equal(t, checksum, types.ExportChecksum(types.CDPs{}, deposits))
+
+	// reordering or changing deposits changes the checksum
+	assert.NotEqual(t, checksum, types.ExportChecksum(types.CDPs{}, types.Deposits{deposits[1], deposits[0]}))
+	assert.NotEqual(t, checksum, types.ExportChecksum(types.CDPs{}, deposits[:1]))
+
+	gs := types.DefaultGenesisState()
+	gs.Deposits = deposits
+	gs.ExportChecksum = checksum
+	require.NoError(t, gs.Validate())
+
+	gs.Deposits = deposits[:1]
+	err := gs.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "export checksum")
+}
@@ -1,6 +1,9 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // MultiCDPHooks combine multiple cdp hooks, all hook functions are run in array sequence
 type MultiCDPHooks []CDPHooks
@@ -23,3 +26,10 @@ func (h MultiCDPHooks) AfterCDPCreated(ctx sdk.Context, cdp CDP) {
 		h[i].AfterCDPCreated(ctx, cdp)
 	}
 }
+
+// AfterDebtChanged runs after the debt of a cdp changes
+func (h MultiCDPHooks) AfterDebtChanged(ctx sdk.Context, owner sdk.AccAddress, collateralType string, delta sdkmath.Int) {
+	for i := range h {
+		h[i].AfterDebtChanged(ctx, owner, collateralType, delta)
+	}
+}
@@ -42,20 +42,32 @@ var sep = []byte(":")
 // - 0x08:previousDistributionTime
 // - 0x09<marketID>:downTime
 // - 0x10:totalDistributed
+// - 0x14<collateralDenomPrefix>:<cdpID_Bytes>: Protection
+// - 0x15<marketID>: MarketPriceUpdate
+// - 0x16<collateralDenomPrefix>:<height_Bytes>: InterestFactorSnapshot
+// - 0x17: SavingsAccumulator
+// - 0x18<depositorAddr_bytes>: SavingsDeposit
+// - 0x19<collateralDenomPrefix>: cdpID the next protection top up batch starts from
 
 // KVStore key prefixes
 var (
-	CdpIDKeyPrefix             = []byte{0x01}
-	CdpKeyPrefix               = []byte{0x02}
-	CollateralRatioIndexPrefix = []byte{0x03}
-	CdpIDKey                   = []byte{0x04}
-	DebtDenomKey               = []byte{0x05}
-	GovDenomKey                = []byte{0x06}
-	DepositKeyPrefix           = []byte{0x07}
-	PrincipalKeyPrefix         = []byte{0x08}
-	PricefeedStatusKeyPrefix   = []byte{0x10}
-	PreviousAccrualTimePrefix  = []byte{0x12}
-	InterestFactorPrefix       = []byte{0x13}
+	CdpIDKeyPrefix               = []byte{0x01}
+	CdpKeyPrefix                 = []byte{0x02}
+	CollateralRatioIndexPrefix   = []byte{0x03}
+	CdpIDKey                     = []byte{0x04}
+	DebtDenomKey                 = []byte{0x05}
+	GovDenomKey                  = []byte{0x06}
+	DepositKeyPrefix             = []byte{0x07}
+	PrincipalKeyPrefix           = []byte{0x08}
+	PricefeedStatusKeyPrefix     = []byte{0x10}
+	PreviousAccrualTimePrefix    = []byte{0x12}
+	InterestFactorPrefix         = []byte{0x13}
+	ProtectionKeyPrefix          = []byte{0x14}
+	MarketPriceUpdateKeyPrefix   = []byte{0x15}
+	InterestFactorSnapshotPrefix = []byte{0x16}
+	SavingsAccumulatorKey        = []byte{0x17}
+	SavingsDepositKeyPrefix      = []byte{0x18}
+	ProtectionCursorKeyPrefix    = []byte{0x19}
 )
 
 // GetCdpIDBytes returns the byte representation of the cdpID
@@ -81,6 +93,11 @@ func SplitCdpKey(key []byte) (string, uint64) {
 	return string(split[0]), GetCdpIDFromBytes(split[1])
 }
 
+// InterestFactorSnapshotKey key of the snapshot of a collateral type's interest factor at a height in the store
+func InterestFactorSnapshotKey(collateralType string, height int64) []byte {
+	return createKey([]byte(collateralType), sep, sdk.Uint64ToBigEndian(uint64(height)))
+}
+
 // DenomIterKey returns the key for iterating over cdps of a certain denom in the store
 func DenomIterKey(collateralType string) []byte {
 	return append([]byte(collateralType), sep...)
@@ -18,6 +18,12 @@ var (
 	_ sdk.Msg = &MsgDrawDebt{}
 	_ sdk.Msg = &MsgRepayDebt{}
 	_ sdk.Msg = &MsgLiquidate{}
+	_ sdk.Msg = &MsgSetProtection{}
+	_ sdk.Msg = &MsgApproveProtection{}
+	_ sdk.Msg = &MsgTransferCdp{}
+	_ sdk.Msg = &MsgRedeemUSDX{}
+	_ sdk.Msg = &MsgDepositSavings{}
+	_ sdk.Msg = &MsgWithdrawSavings{}
 )
 
 // NewMsgCreateCDP returns a new MsgPlaceBid.
@@ -103,9 +109,17 @@ func (msg MsgDeposit) ValidateBasic() error {
 	if strings.TrimSpace(msg.CollateralType) == "" {
 		return fmt.Errorf("collateral type cannot be empty")
 	}
+	if msg.IsAdditionalCollateral() && msg.Depositor != msg.Owner {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "additional collateral can only be managed by the cdp owner")
+	}
 	return nil
 }
 
+// IsAdditionalCollateral returns true if the msg targets the additional collateral of a cdp of another collateral type
+func (msg MsgDeposit) IsAdditionalCollateral() bool {
+	return msg.CdpCollateralType != "" && msg.CdpCollateralType != msg.CollateralType
+}
+
 // GetSignBytes gets the canonical byte representation of the Msg.
 func (msg MsgDeposit) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(&msg)
@@ -154,9 +168,17 @@ func (msg MsgWithdraw) ValidateBasic() error {
 	if strings.TrimSpace(msg.CollateralType) == "" {
 		return fmt.Errorf("collateral type cannot be empty")
 	}
+	if msg.IsAdditionalCollateral() && msg.Depositor != msg.Owner {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "additional collateral can only be managed by the cdp owner")
+	}
 	return nil
 }
 
+// IsAdditionalCollateral returns true if the msg targets the additional collateral of a cdp of another collateral type
+func (msg MsgWithdraw) IsAdditionalCollateral() bool {
+	return msg.CdpCollateralType != "" && msg.CdpCollateralType != msg.CollateralType
+}
+
 // GetSignBytes gets the canonical byte representation of the Msg.
 func (msg MsgWithdraw) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(&msg)
@@ -243,7 +265,13 @@ func (msg MsgRepayDebt) ValidateBasic() error {
 	if strings.TrimSpace(msg.CollateralType) == "" {
 		return errors.New("cdp collateral type cannot be blank")
 	}
-	if msg.Payment.IsZero() || !msg.Payment.IsValid() {
+	if msg.FeeDenom != "" {
+		if err := sdk.ValidateDenom(msg.FeeDenom); err != nil {
+			return errorsmod.Wrap(ErrInvalidPayment, err.Error())
+		}
+	}
+	// a zero payment only pays fees in the fee denom
+	if !msg.Payment.IsValid() || (msg.Payment.IsZero() && (msg.FeeDenom == "" || msg.FeeDenom == msg.Payment.Denom)) {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "payment amount %s", msg.Payment)
 	}
 	return nil
@@ -310,3 +338,295 @@ func (msg MsgLiquidate) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{keeper}
 }
+
+// NewMsgSetProtection returns a new MsgSetProtection.
+func NewMsgSetProtection(owner, reserve sdk.AccAddress, ctype string, targetRatio sdk.Dec) MsgSetProtection {
+	var reserveAddr string
+	if !reserve.Empty() {
+		reserveAddr = reserve.String()
+	}
+	return MsgSetProtection{
+		Owner:          owner.String(),
+		CollateralType: ctype,
+		Reserve:        reserveAddr,
+		TargetRatio:    targetRatio,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSetProtection) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSetProtection) Type() string { return "set_protection" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSetProtection) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address %s", err)
+	}
+	if strings.TrimSpace(msg.CollateralType) == "" {
+		return errorsmod.Wrap(ErrInvalidCollateral, "collateral type cannot be empty")
+	}
+	// an empty reserve removes the protection
+	if msg.IsRemoval() {
+		return nil
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Reserve)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid reserve address %s", err)
+	}
+	if msg.Reserve == msg.Owner {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "reserve cannot be the cdp owner")
+	}
+	if msg.TargetRatio.IsNil() || !msg.TargetRatio.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidTargetRatio, "target ratio %s", msg.TargetRatio)
+	}
+	return nil
+}
+
+// IsRemoval returns true if the message removes the protection of the cdp
+func (msg MsgSetProtection) IsRemoval() bool {
+	return strings.TrimSpace(msg.Reserve) == ""
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSetProtection) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSetProtection) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// NewMsgApproveProtection returns a new MsgApproveProtection.
+func NewMsgApproveProtection(reserve, owner sdk.AccAddress, ctype string, allowance sdk.Coin) MsgApproveProtection {
+	return MsgApproveProtection{
+		Reserve:        reserve.String(),
+		Owner:          owner.String(),
+		CollateralType: ctype,
+		Allowance:      allowance,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgApproveProtection) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgApproveProtection) Type() string { return "approve_protection" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgApproveProtection) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Reserve)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid reserve address %s", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address %s", err)
+	}
+	if strings.TrimSpace(msg.CollateralType) == "" {
+		return errorsmod.Wrap(ErrInvalidCollateral, "collateral type cannot be empty")
+	}
+	if !msg.Allowance.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "allowance amount %s", msg.Allowance)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgApproveProtection) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgApproveProtection) GetSigners() []sdk.AccAddress {
+	reserve, err := sdk.AccAddressFromBech32(msg.Reserve)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{reserve}
+}
+
+// NewMsgTransferCdp returns a new MsgTransferCdp.
+func NewMsgTransferCdp(owner, recipient sdk.AccAddress, ctype string) MsgTransferCdp {
+	return MsgTransferCdp{
+		Owner:          owner.String(),
+		Recipient:      recipient.String(),
+		CollateralType: ctype,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgTransferCdp) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgTransferCdp) Type() string { return "transfer_cdp" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgTransferCdp) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address %s", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address %s", err)
+	}
+	if msg.Recipient == msg.Owner {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "recipient cannot be the cdp owner")
+	}
+	if strings.TrimSpace(msg.CollateralType) == "" {
+		return errorsmod.Wrap(ErrInvalidCollateral, "collateral type cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgTransferCdp) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgTransferCdp) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// NewMsgRedeemUSDX returns a new MsgRedeemUSDX.
+func NewMsgRedeemUSDX(sender sdk.AccAddress, ctype string, amount sdk.Coin) MsgRedeemUSDX {
+	return MsgRedeemUSDX{
+		Sender:         sender.String(),
+		CollateralType: ctype,
+		Amount:         amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRedeemUSDX) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRedeemUSDX) Type() string { return "redeem_usdx" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRedeemUSDX) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+	if strings.TrimSpace(msg.CollateralType) == "" {
+		return errorsmod.Wrap(ErrInvalidCollateral, "collateral type cannot be empty")
+	}
+	if msg.Amount.IsZero() || !msg.Amount.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "redemption amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRedeemUSDX) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRedeemUSDX) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgDepositSavings returns a new MsgDepositSavings.
+func NewMsgDepositSavings(depositor sdk.AccAddress, amount sdk.Coin) MsgDepositSavings {
+	return MsgDepositSavings{
+		Depositor: depositor.String(),
+		Amount:    amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgDepositSavings) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgDepositSavings) Type() string { return "deposit_savings" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgDepositSavings) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor address %s", err)
+	}
+	if msg.Amount.IsZero() || !msg.Amount.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "savings deposit amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgDepositSavings) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgDepositSavings) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{depositor}
+}
+
+// NewMsgWithdrawSavings returns a new MsgWithdrawSavings.
+func NewMsgWithdrawSavings(depositor sdk.AccAddress, amount sdk.Coin) MsgWithdrawSavings {
+	return MsgWithdrawSavings{
+		Depositor: depositor.String(),
+		Amount:    amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgWithdrawSavings) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgWithdrawSavings) Type() string { return "withdraw_savings" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgWithdrawSavings) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor address %s", err)
+	}
+	if msg.Amount.IsZero() || !msg.Amount.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "savings withdrawal amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgWithdrawSavings) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgWithdrawSavings) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{depositor}
+}
@@ -50,19 +50,23 @@ func TestMsgCreateCDP(t *testing.T) {
 
 func TestMsgDeposit(t *testing.T) {
 	tests := []struct {
-		description    string
-		sender         sdk.AccAddress
-		depositor      sdk.AccAddress
-		collateral     sdk.Coin
-		collateralType string
-		expectPass     bool
+		description       string
+		sender            sdk.AccAddress
+		depositor         sdk.AccAddress
+		collateral        sdk.Coin
+		collateralType    string
+		cdpCollateralType string
+		expectPass        bool
 	}{
-		{"deposit", addrs[0], addrs[1], coinsSingle, "type-a", true},
-		{"deposit same owner", addrs[0], addrs[0], coinsSingle, "type-a", true},
-		{"deposit no collateral", addrs[0], addrs[1], coinsZero, "type-a", false},
-		{"deposit empty owner", sdk.AccAddress{}, addrs[1], coinsSingle, "type-a", false},
-		{"deposit empty depositor", addrs[0], sdk.AccAddress{}, coinsSingle, "type-a", false},
-		{"deposit empty type", addrs[0], addrs[0], coinsSingle, "", false},
+		{"deposit", addrs[0], addrs[1], coinsSingle, "type-a", "", true},
+		{"deposit same owner", addrs[0], addrs[0], coinsSingle, "type-a", "", true},
+		{"deposit no collateral", addrs[0], addrs[1], coinsZero, "type-a", "", false},
+		{"deposit empty owner", sdk.AccAddress{}, addrs[1], coinsSingle, "type-a", "", false},
+		{"deposit empty depositor", addrs[0], sdk.AccAddress{}, coinsSingle, "type-a", "", false},
+		{"deposit empty type", addrs[0], addrs[0], coinsSingle, "", "", false},
+		{"deposit additional collateral", addrs[0], addrs[0], coinsSingle, "type-a", "type-b", true},
+		{"deposit additional collateral same type", addrs[0], addrs[1], coinsSingle, "type-a", "type-a", true},
+		{"deposit additional collateral not owner", addrs[0], addrs[1], coinsSingle, "type-a", "type-b", false},
 	}
 
 	for _, tc := range tests {
@@ -72,6 +76,7 @@ func TestMsgDeposit(t *testing.T) {
 			tc.collateral,
 			tc.collateralType,
 		)
+		msg.CdpCollateralType = tc.cdpCollateralType
 		if tc.expectPass {
 			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
 		} else {
@@ -82,19 +87,23 @@ func TestMsgDeposit(t *testing.T) {
 
 func TestMsgWithdraw(t *testing.T) {
 	tests := []struct {
-		description    string
-		sender         sdk.AccAddress
-		depositor      sdk.AccAddress
-		collateral     sdk.Coin
-		collateralType string
-		expectPass     bool
+		description       string
+		sender            sdk.AccAddress
+		depositor         sdk.AccAddress
+		collateral        sdk.Coin
+		collateralType    string
+		cdpCollateralType string
+		expectPass        bool
 	}{
-		{"withdraw", addrs[0], addrs[1], coinsSingle, "type-a", true},
-		{"withdraw", addrs[0], addrs[0], coinsSingle, "type-a", true},
-		{"withdraw no collateral", addrs[0], addrs[1], coinsZero, "type-a", false},
-		{"withdraw empty owner", sdk.AccAddress{}, addrs[1], coinsSingle, "type-a", false},
-		{"withdraw empty depositor", addrs[0], sdk.AccAddress{}, coinsSingle, "type-a", false},
-		{"withdraw empty type", addrs[0], addrs[0], coinsSingle, "", false},
+		{"withdraw", addrs[0], addrs[1], coinsSingle, "type-a", "", true},
+		{"withdraw", addrs[0], addrs[0], coinsSingle, "type-a", "", true},
+		{"withdraw no collateral", addrs[0], addrs[1], coinsZero, "type-a", "", false},
+		{"withdraw empty owner", sdk.AccAddress{}, addrs[1], coinsSingle, "type-a", "", false},
+		{"withdraw empty depositor", addrs[0], sdk.AccAddress{}, coinsSingle, "type-a", "", false},
+		{"withdraw empty type", addrs[0], addrs[0], coinsSingle, "", "", false},
+		{"withdraw additional collateral", addrs[0], addrs[0], coinsSingle, "type-a", "type-b", true},
+		{"withdraw additional collateral same type", addrs[0], addrs[1], coinsSingle, "type-a", "type-a", true},
+		{"withdraw additional collateral not owner", addrs[0], addrs[1], coinsSingle, "type-a", "type-b", false},
 	}
 
 	for _, tc := range tests {
@@ -104,6 +113,7 @@ func TestMsgWithdraw(t *testing.T) {
 			tc.collateral,
 			tc.collateralType,
 		)
+		msg.CdpCollateralType = tc.cdpCollateralType
 		if tc.expectPass {
 			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
 		} else {
@@ -146,12 +156,16 @@ func TestMsgRepayDebt(t *testing.T) {
 		sender      sdk.AccAddress
 		denom       string
 		payment     sdk.Coin
+		feeDenom    string
 		expectPass  bool
 	}{
-		{"repay debt", addrs[0], sdk.DefaultBondDenom, coinsSingle, true},
-		{"repay debt no payment", addrs[0], sdk.DefaultBondDenom, coinsZero, false},
-		{"repay debt empty owner", sdk.AccAddress{}, sdk.DefaultBondDenom, coinsSingle, false},
-		{"repay debt empty denom", sdk.AccAddress{}, "", coinsSingle, false},
+		{"repay debt", addrs[0], sdk.DefaultBondDenom, coinsSingle, "", true},
+		{"repay debt no payment", addrs[0], sdk.DefaultBondDenom, coinsZero, "", false},
+		{"repay debt empty owner", sdk.AccAddress{}, sdk.DefaultBondDenom, coinsSingle, "", false},
+		{"repay debt empty denom", sdk.AccAddress{}, "", coinsSingle, "", false},
+		{"repay debt fees in fee denom", addrs[0], sdk.DefaultBondDenom, coinsSingle, "ukava", true},
+		{"repay only fees in fee denom", addrs[0], sdk.DefaultBondDenom, coinsZero, "ukava", true},
+		{"repay debt invalid fee denom", addrs[0], sdk.DefaultBondDenom, coinsSingle, "1ukava", false},
 	}
 
 	for _, tc := range tests {
@@ -160,6 +174,153 @@ func TestMsgRepayDebt(t *testing.T) {
 			tc.denom,
 			tc.payment,
 		)
+		msg.FeeDenom = tc.feeDenom
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgSetProtection(t *testing.T) {
+	tests := []struct {
+		description string
+		owner       sdk.AccAddress
+		reserve     sdk.AccAddress
+		ctype       string
+		targetRatio sdk.Dec
+		expectPass  bool
+	}{
+		{"set protection", addrs[0], addrs[1], "xrp-a", sdk.MustNewDecFromStr("2.5"), true},
+		{"remove protection", addrs[0], nil, "xrp-a", sdk.ZeroDec(), true},
+		{"set protection reserve is owner", addrs[0], addrs[0], "xrp-a", sdk.MustNewDecFromStr("2.5"), false},
+		{"set protection zero target ratio", addrs[0], addrs[1], "xrp-a", sdk.ZeroDec(), false},
+		{"set protection empty owner", sdk.AccAddress{}, addrs[1], "xrp-a", sdk.MustNewDecFromStr("2.5"), false},
+		{"set protection empty collateral type", addrs[0], addrs[1], "", sdk.MustNewDecFromStr("2.5"), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgSetProtection(tc.owner, tc.reserve, tc.ctype, tc.targetRatio)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgApproveProtection(t *testing.T) {
+	tests := []struct {
+		description string
+		reserve     sdk.AccAddress
+		owner       sdk.AccAddress
+		ctype       string
+		allowance   sdk.Coin
+		expectPass  bool
+	}{
+		{"approve protection", addrs[1], addrs[0], "xrp-a", coinsSingle, true},
+		{"approve zero allowance", addrs[1], addrs[0], "xrp-a", coinsZero, true},
+		{"approve protection empty reserve", sdk.AccAddress{}, addrs[0], "xrp-a", coinsSingle, false},
+		{"approve protection empty owner", addrs[1], sdk.AccAddress{}, "xrp-a", coinsSingle, false},
+		{"approve protection empty collateral type", addrs[1], addrs[0], "", coinsSingle, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgApproveProtection(tc.reserve, tc.owner, tc.ctype, tc.allowance)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgTransferCdp(t *testing.T) {
+	tests := []struct {
+		description string
+		owner       sdk.AccAddress
+		recipient   sdk.AccAddress
+		ctype       string
+		expectPass  bool
+	}{
+		{"transfer cdp", addrs[0], addrs[1], "xrp-a", true},
+		{"transfer cdp empty owner", sdk.AccAddress{}, addrs[1], "xrp-a", false},
+		{"transfer cdp empty recipient", addrs[0], sdk.AccAddress{}, "xrp-a", false},
+		{"transfer cdp to owner", addrs[0], addrs[0], "xrp-a", false},
+		{"transfer cdp empty collateral type", addrs[0], addrs[1], "", false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgTransferCdp(tc.owner, tc.recipient, tc.ctype)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgRedeemUSDX(t *testing.T) {
+	tests := []struct {
+		description string
+		sender      sdk.AccAddress
+		ctype       string
+		amount      sdk.Coin
+		expectPass  bool
+	}{
+		{"redeem usdx", addrs[0], "xrp-a", sdk.NewInt64Coin("usdx", 10000000), true},
+		{"redeem usdx empty sender", sdk.AccAddress{}, "xrp-a", sdk.NewInt64Coin("usdx", 10000000), false},
+		{"redeem usdx empty collateral type", addrs[0], "", sdk.NewInt64Coin("usdx", 10000000), false},
+		{"redeem usdx zero amount", addrs[0], "xrp-a", sdk.NewInt64Coin("usdx", 0), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgRedeemUSDX(tc.sender, tc.ctype, tc.amount)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgDepositSavings(t *testing.T) {
+	tests := []struct {
+		description string
+		depositor   sdk.AccAddress
+		amount      sdk.Coin
+		expectPass  bool
+	}{
+		{"deposit savings", addrs[0], sdk.NewInt64Coin("usdx", 10000000), true},
+		{"deposit savings empty depositor", sdk.AccAddress{}, sdk.NewInt64Coin("usdx", 10000000), false},
+		{"deposit savings zero amount", addrs[0], sdk.NewInt64Coin("usdx", 0), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgDepositSavings(tc.depositor, tc.amount)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgWithdrawSavings(t *testing.T) {
+	tests := []struct {
+		description string
+		depositor   sdk.AccAddress
+		amount      sdk.Coin
+		expectPass  bool
+	}{
+		{"withdraw savings", addrs[0], sdk.NewInt64Coin("usdx", 10000000), true},
+		{"withdraw savings empty depositor", sdk.AccAddress{}, sdk.NewInt64Coin("usdx", 10000000), false},
+		{"withdraw savings zero amount", addrs[0], sdk.NewInt64Coin("usdx", 0), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgWithdrawSavings(tc.depositor, tc.amount)
 		if tc.expectPass {
 			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
 		} else {
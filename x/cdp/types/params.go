@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -22,6 +23,10 @@ var (
 	KeySurplusThreshold                   = []byte("SurplusThreshold")
 	KeySurplusLot                         = []byte("SurplusLot")
 	KeyBeginBlockerExecutionBlockInterval = []byte("BeginBlockerExecutionBlockInterval")
+	KeyFeePaymentParam                    = []byte("FeePaymentParam")
+	KeyRedemptionFee                      = []byte("RedemptionFee")
+	KeyInterestFactorSnapshotInterval     = []byte("InterestFactorSnapshotInterval")
+	KeySavingsRate                        = []byte("SavingsRate")
 	DefaultGlobalDebt                     = sdk.NewCoin(DefaultStableDenom, sdk.ZeroInt())
 	DefaultCircuitBreaker                 = false
 	DefaultCollateralParams               = CollateralParams{}
@@ -31,6 +36,9 @@ var (
 		ConversionFactor: sdkmath.NewInt(6),
 		DebtFloor:        sdkmath.NewInt(10000000),
 	}
+	DefaultFeePaymentParam = FeePaymentParam{
+		ConversionFactor: sdkmath.ZeroInt(),
+	}
 	DefaultCdpStartingID    = uint64(1)
 	DefaultDebtDenom        = "debt"
 	DefaultGovDenom         = "ukava"
@@ -38,27 +46,36 @@ var (
 	DefaultSurplusThreshold = sdkmath.NewInt(500000000000)
 	DefaultDebtThreshold    = sdkmath.NewInt(100000000000)
 	DefaultSurplusLot       = sdkmath.NewInt(10000000000)
+	DefaultRedemptionFee    = sdk.MustNewDecFromStr("0.005")
+	DefaultSavingsRate      = sdk.OneDec()
 	DefaultDebtLot          = sdkmath.NewInt(10000000000)
 	stabilityFeeMax         = sdk.MustNewDecFromStr("1.000000051034942716") // 500% APR
 	// Run every block
 	DefaultBeginBlockerExecutionBlockInterval = int64(1)
+	// About once an hour with 6 second blocks
+	DefaultInterestFactorSnapshotInterval = int64(600)
 )
 
 // NewParams returns a new params object
 func NewParams(
 	debtLimit sdk.Coin, collateralParams CollateralParams, debtParam DebtParam, surplusThreshold,
 	surplusLot, debtThreshold, debtLot sdkmath.Int, breaker bool, beginBlockerExecutionBlockInterval int64,
+	feePaymentParam FeePaymentParam, redemptionFee sdk.Dec, interestFactorSnapshotInterval int64, savingsRate sdk.Dec,
 ) Params {
 	return Params{
-		GlobalDebtLimit:          debtLimit,
-		CollateralParams:         collateralParams,
-		DebtParam:                debtParam,
-		SurplusAuctionThreshold:  surplusThreshold,
-		SurplusAuctionLot:        surplusLot,
-		DebtAuctionThreshold:     debtThreshold,
-		DebtAuctionLot:           debtLot,
-		CircuitBreaker:           breaker,
-		LiquidationBlockInterval: beginBlockerExecutionBlockInterval,
+		GlobalDebtLimit:                debtLimit,
+		CollateralParams:               collateralParams,
+		DebtParam:                      debtParam,
+		SurplusAuctionThreshold:        surplusThreshold,
+		SurplusAuctionLot:              surplusLot,
+		DebtAuctionThreshold:           debtThreshold,
+		DebtAuctionLot:                 debtLot,
+		CircuitBreaker:                 breaker,
+		LiquidationBlockInterval:       beginBlockerExecutionBlockInterval,
+		FeePaymentParam:                feePaymentParam,
+		RedemptionFee:                  redemptionFee,
+		InterestFactorSnapshotInterval: interestFactorSnapshotInterval,
+		SavingsRate:                    savingsRate,
 	}
 }
 
@@ -67,7 +84,8 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultGlobalDebt, DefaultCollateralParams, DefaultDebtParam, DefaultSurplusThreshold,
 		DefaultSurplusLot, DefaultDebtThreshold, DefaultDebtLot,
-		DefaultCircuitBreaker, DefaultBeginBlockerExecutionBlockInterval,
+		DefaultCircuitBreaker, DefaultBeginBlockerExecutionBlockInterval, DefaultFeePaymentParam,
+		DefaultRedemptionFee, DefaultInterestFactorSnapshotInterval, DefaultSavingsRate,
 	)
 }
 
@@ -75,6 +93,7 @@ func DefaultParams() Params {
 func NewCollateralParam(
 	denom, ctype string, liqRatio sdk.Dec, debtLimit sdk.Coin, stabilityFee sdk.Dec, auctionSize sdkmath.Int,
 	liqPenalty sdk.Dec, spotMarketID, liquidationMarketID string, keeperReward sdk.Dec, checkIndexCount sdkmath.Int, conversionFactor sdkmath.Int,
+	closeFactor sdk.Dec, keeperIncentive sdkmath.Int, priceStalenessThreshold time.Duration,
 ) CollateralParam {
 	return CollateralParam{
 		Denom:                            denom,
@@ -89,6 +108,9 @@ func NewCollateralParam(
 		KeeperRewardPercentage:           keeperReward,
 		CheckCollateralizationIndexCount: checkIndexCount,
 		ConversionFactor:                 conversionFactor,
+		LiquidationCloseFactor:           closeFactor,
+		KeeperIncentive:                  keeperIncentive,
+		PriceStalenessThreshold:          priceStalenessThreshold,
 	}
 }
 
@@ -108,6 +130,21 @@ func NewDebtParam(denom, refAsset string, conversionFactor, debtFloor sdkmath.In
 // DebtParams array of DebtParam
 type DebtParams []DebtParam
 
+// NewFeePaymentParam returns a new FeePaymentParam
+func NewFeePaymentParam(denom, marketID string, conversionFactor sdkmath.Int, burn bool) FeePaymentParam {
+	return FeePaymentParam{
+		Denom:            denom,
+		MarketID:         marketID,
+		ConversionFactor: conversionFactor,
+		Burn:             burn,
+	}
+}
+
+// IsEnabled returns true if fees can be paid in the asset of the param
+func (fp FeePaymentParam) IsEnabled() bool {
+	return fp.Denom != ""
+}
+
 // ParamKeyTable Key declaration for parameters
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -127,6 +164,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDebtThreshold, &p.DebtAuctionThreshold, validateDebtAuctionThresholdParam),
 		paramtypes.NewParamSetPair(KeyDebtLot, &p.DebtAuctionLot, validateDebtAuctionLotParam),
 		paramtypes.NewParamSetPair(KeyBeginBlockerExecutionBlockInterval, &p.LiquidationBlockInterval, validateBeginBlockerExecutionBlockIntervalParam),
+		paramtypes.NewParamSetPair(KeyFeePaymentParam, &p.FeePaymentParam, validateFeePaymentParam),
+		paramtypes.NewParamSetPair(KeyRedemptionFee, &p.RedemptionFee, validateRedemptionFeeParam),
+		paramtypes.NewParamSetPair(KeyInterestFactorSnapshotInterval, &p.InterestFactorSnapshotInterval, validateInterestFactorSnapshotIntervalParam),
+		paramtypes.NewParamSetPair(KeySavingsRate, &p.SavingsRate, validateSavingsRateParam),
 	}
 }
 
@@ -168,6 +209,26 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateFeePaymentParam(p.FeePaymentParam); err != nil {
+		return err
+	}
+
+	if err := validateRedemptionFeeParam(p.RedemptionFee); err != nil {
+		return err
+	}
+
+	if err := validateInterestFactorSnapshotIntervalParam(p.InterestFactorSnapshotInterval); err != nil {
+		return err
+	}
+
+	if err := validateSavingsRateParam(p.SavingsRate); err != nil {
+		return err
+	}
+
+	if p.FeePaymentParam.Denom == p.DebtParam.Denom {
+		return fmt.Errorf("fee payment denom %s cannot be the debt denom", p.FeePaymentParam.Denom)
+	}
+
 	if len(p.CollateralParams) == 0 { // default value OK
 		return nil
 	}
@@ -278,6 +339,19 @@ func validateCollateralParams(i interface{}) error {
 		if cp.CheckCollateralizationIndexCount.IsNegative() {
 			return fmt.Errorf("keeper reward percentage should be positive, is %s for %s", cp.CheckCollateralizationIndexCount, cp.Denom)
 		}
+		// a zero (unset) liquidation close factor seizes all collateral, like a close factor of one
+		if !cp.LiquidationCloseFactor.IsNil() && (cp.LiquidationCloseFactor.IsNegative() || cp.LiquidationCloseFactor.GT(sdk.OneDec())) {
+			return fmt.Errorf("liquidation close factor should be between 0 and 1, is %s for %s", cp.LiquidationCloseFactor, cp.Denom)
+		}
+		if !cp.KeeperIncentive.IsNil() && cp.KeeperIncentive.IsNegative() {
+			return fmt.Errorf("keeper incentive should not be negative, is %s for %s", cp.KeeperIncentive, cp.Denom)
+		}
+		if cp.PriceStalenessThreshold < 0 {
+			return fmt.Errorf("price staleness threshold should not be negative, is %s for %s", cp.PriceStalenessThreshold, cp.Denom)
+		}
+		if cp.LiquidationTwapDuration < 0 {
+			return fmt.Errorf("liquidation twap duration should not be negative, is %s for %s", cp.LiquidationTwapDuration, cp.Denom)
+		}
 	}
 
 	return nil
@@ -368,3 +442,74 @@ func validateBeginBlockerExecutionBlockIntervalParam(i interface{}) error {
 
 	return nil
 }
+
+func validateInterestFactorSnapshotIntervalParam(i interface{}) error {
+	interval, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if interval < 0 {
+		return fmt.Errorf("interest factor snapshot interval param should not be negative: %d", interval)
+	}
+
+	return nil
+}
+
+func validateFeePaymentParam(i interface{}) error {
+	feePaymentParam, ok := i.(FeePaymentParam)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !feePaymentParam.IsEnabled() { // default value OK
+		return nil
+	}
+
+	if err := sdk.ValidateDenom(feePaymentParam.Denom); err != nil {
+		return fmt.Errorf("fee payment denom invalid %s", feePaymentParam.Denom)
+	}
+
+	if strings.TrimSpace(feePaymentParam.MarketID) == "" {
+		return fmt.Errorf("fee payment market id cannot be blank %v", feePaymentParam)
+	}
+
+	if feePaymentParam.ConversionFactor.IsNil() || !feePaymentParam.ConversionFactor.IsPositive() {
+		return fmt.Errorf("fee payment conversion factor should be positive, is %s", feePaymentParam.ConversionFactor)
+	}
+
+	return nil
+}
+
+func validateRedemptionFeeParam(i interface{}) error {
+	redemptionFee, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if redemptionFee.IsNil() {
+		return nil
+	}
+	if redemptionFee.IsNegative() || redemptionFee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("redemption fee should be ≥ 0 and < 1, is %s", redemptionFee)
+	}
+
+	return nil
+}
+
+func validateSavingsRateParam(i interface{}) error {
+	savingsRate, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// a zero (unset) savings rate pays no interest, like a savings rate of one
+	if savingsRate.IsNil() || savingsRate.IsZero() {
+		return nil
+	}
+	if savingsRate.LT(sdk.OneDec()) || savingsRate.GT(stabilityFeeMax) {
+		return fmt.Errorf("savings rate must be ≥ 1.0, ≤ %s, is %s", stabilityFeeMax, savingsRate)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -29,6 +30,10 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		debtLot                            sdkmath.Int
 		breaker                            bool
 		beginBlockerExecutionBlockInterval int64
+		feePaymentParam                    types.FeePaymentParam
+		redemptionFee                      sdk.Dec
+		interestFactorSnapshotInterval     int64
+		savingsRate                        sdk.Dec
 	}
 	type errArgs struct {
 		expectPass bool
@@ -74,6 +79,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						SpotMarketID:                     "bnb:usd",
 						LiquidationMarketID:              "bnb:usd",
 						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
 						ConversionFactor:                 sdkmath.NewInt(8),
 						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 					},
@@ -112,6 +119,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						SpotMarketID:                     "bnb:usd",
 						LiquidationMarketID:              "bnb:usd",
 						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
 						ConversionFactor:                 sdkmath.NewInt(8),
 						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 					},
@@ -150,6 +159,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						SpotMarketID:                     "bnb:usd",
 						LiquidationMarketID:              "bnb:usd",
 						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
 						ConversionFactor:                 sdkmath.NewInt(8),
 						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 					},
@@ -188,6 +199,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						SpotMarketID:                     "bnb:usd",
 						LiquidationMarketID:              "bnb:usd",
 						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
 						ConversionFactor:                 sdkmath.NewInt(8),
 						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 					},
@@ -202,6 +215,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						SpotMarketID:                     "xrp:usd",
 						LiquidationMarketID:              "xrp:usd",
 						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
 						ConversionFactor:                 sdkmath.NewInt(6),
 						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 					},
@@ -240,6 +255,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						SpotMarketID:                     "bnb:usd",
 						LiquidationMarketID:              "bnb:usd",
 						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
 						ConversionFactor:                 sdkmath.NewInt(8),
 						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 					},
@@ -254,6 +271,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						SpotMarketID:                     "xrp:usd",
 						LiquidationMarketID:              "xrp:usd",
 						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
 						ConversionFactor:                 sdkmath.NewInt(6),
 						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 					},
@@ -292,6 +311,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						SpotMarketID:                     "bnb:usd",
 						LiquidationMarketID:              "bnb:usd",
 						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
 						ConversionFactor:                 sdkmath.NewInt(8),
 						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 					},
@@ -306,6 +327,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						SpotMarketID:                     "xrp:usd",
 						LiquidationMarketID:              "xrp:usd",
 						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
 						ConversionFactor:                 sdkmath.NewInt(6),
 						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 					},
@@ -343,6 +366,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						SpotMarketID:                     "bnb:usd",
 						LiquidationMarketID:              "bnb:usd",
 						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
 						ConversionFactor:                 sdkmath.NewInt(8),
 						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 					},
@@ -381,6 +406,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						SpotMarketID:                     "",
 						LiquidationMarketID:              "",
 						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
 						ConversionFactor:                 sdkmath.NewInt(8),
 						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 					},
@@ -419,6 +446,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						SpotMarketID:                     "bnb:usd",
 						LiquidationMarketID:              "bnb:usd",
 						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
 						ConversionFactor:                 sdkmath.NewInt(8),
 						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 					},
@@ -433,6 +462,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						SpotMarketID:                     "bnb:usd",
 						LiquidationMarketID:              "bnb:usd",
 						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
 						ConversionFactor:                 sdkmath.NewInt(8),
 						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 					},
@@ -471,6 +502,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						SpotMarketID:                     "bnb:usd",
 						LiquidationMarketID:              "bnb:usd",
 						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
 						ConversionFactor:                 sdkmath.NewInt(8),
 						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 					},
@@ -485,6 +518,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						SpotMarketID:                     "bnb:usd",
 						LiquidationMarketID:              "bnb:usd",
 						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
 						ConversionFactor:                 sdkmath.NewInt(8),
 						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 					},
@@ -523,6 +558,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						SpotMarketID:                     "bnb:usd",
 						LiquidationMarketID:              "bnb:usd",
 						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
 						ConversionFactor:                 sdkmath.NewInt(8),
 						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 					},
@@ -561,6 +598,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						SpotMarketID:                     "bnb:usd",
 						LiquidationMarketID:              "bnb:usd",
 						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
 						ConversionFactor:                 sdkmath.NewInt(8),
 						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 					},
@@ -599,6 +638,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						SpotMarketID:                     "bnb:usd",
 						LiquidationMarketID:              "bnb:usd",
 						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
 						ConversionFactor:                 sdkmath.NewInt(8),
 						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 					},
@@ -637,6 +678,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						SpotMarketID:                     "bnb:usd",
 						LiquidationMarketID:              "bnb:usd",
 						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
 						ConversionFactor:                 sdkmath.NewInt(8),
 						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 					},
@@ -675,6 +718,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						SpotMarketID:                     "bnb:usd",
 						LiquidationMarketID:              "bnb:usd",
 						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
 						ConversionFactor:                 sdkmath.NewInt(8),
 						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 					},
@@ -692,6 +737,218 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				contains:   "liquidation ratio must be > 0",
 			},
 		},
+		{
+			name: "valid collateral params partial liquidation",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1_000_000_000_000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50_000_000_000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("0.5"),
+						KeeperIncentive:                  sdkmath.NewInt(1_000_000),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+					},
+				},
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+			},
+			errArgs: errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			name: "invalid collateral params negative liquidation close factor",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1_000_000_000_000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50_000_000_000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("-0.5"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+					},
+				},
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "liquidation close factor should be between 0 and 1",
+			},
+		},
+		{
+			name: "invalid collateral params liquidation close factor above one",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1_000_000_000_000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50_000_000_000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.1"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+					},
+				},
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "liquidation close factor should be between 0 and 1",
+			},
+		},
+		{
+			name: "invalid collateral params negative keeper incentive",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1_000_000_000_000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50_000_000_000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("0.5"),
+						KeeperIncentive:                  sdkmath.NewInt(-1),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+					},
+				},
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "keeper incentive should not be negative",
+			},
+		},
+		{
+			name: "invalid collateral params negative price staleness threshold",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1_000_000_000_000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50_000_000_000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("0.5"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
+						PriceStalenessThreshold:          -time.Hour,
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+					},
+				},
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "price staleness threshold should not be negative",
+			},
+		},
+		{
+			name: "invalid collateral params negative liquidation twap duration",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1_000_000_000_000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50_000_000_000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("0.5"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
+						LiquidationTwapDuration:          -time.Hour,
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+					},
+				},
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "liquidation twap duration should not be negative",
+			},
+		},
 		{
 			name: "invalid debt param empty denom",
 			args: args{
@@ -708,6 +965,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 						SpotMarketID:                     "bnb:usd",
 						LiquidationMarketID:              "bnb:usd",
 						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						LiquidationCloseFactor:           sdk.MustNewDecFromStr("1.0"),
+						KeeperIncentive:                  sdkmath.ZeroInt(),
 						ConversionFactor:                 sdkmath.NewInt(8),
 						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
 					},
@@ -856,10 +1115,238 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				contains:   "begin blocker execution block interval param should be positive",
 			},
 		},
+		{
+			name: "valid fee payment param",
+			args: args{
+				globalDebtLimit:                    types.DefaultGlobalDebt,
+				collateralParams:                   types.DefaultCollateralParams,
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				feePaymentParam:                    types.NewFeePaymentParam("ukava", "kava:usd", sdkmath.NewInt(6), true),
+			},
+			errArgs: errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			name: "invalid fee payment param blank market id",
+			args: args{
+				globalDebtLimit:                    types.DefaultGlobalDebt,
+				collateralParams:                   types.DefaultCollateralParams,
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				feePaymentParam:                    types.NewFeePaymentParam("ukava", "", sdkmath.NewInt(6), true),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "fee payment market id cannot be blank",
+			},
+		},
+		{
+			name: "invalid fee payment param zero conversion factor",
+			args: args{
+				globalDebtLimit:                    types.DefaultGlobalDebt,
+				collateralParams:                   types.DefaultCollateralParams,
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				feePaymentParam:                    types.NewFeePaymentParam("ukava", "kava:usd", sdkmath.ZeroInt(), false),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "fee payment conversion factor should be positive",
+			},
+		},
+		{
+			name: "invalid fee payment param debt denom",
+			args: args{
+				globalDebtLimit:                    types.DefaultGlobalDebt,
+				collateralParams:                   types.DefaultCollateralParams,
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				feePaymentParam:                    types.NewFeePaymentParam("usdx", "usdx:usd", sdkmath.NewInt(6), false),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "fee payment denom usdx cannot be the debt denom",
+			},
+		},
+		{
+			name: "valid redemption fee",
+			args: args{
+				globalDebtLimit:                    types.DefaultGlobalDebt,
+				collateralParams:                   types.DefaultCollateralParams,
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				redemptionFee:                      sdk.MustNewDecFromStr("0.01"),
+			},
+			errArgs: errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			name: "negative redemption fee",
+			args: args{
+				globalDebtLimit:                    types.DefaultGlobalDebt,
+				collateralParams:                   types.DefaultCollateralParams,
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				redemptionFee:                      sdk.MustNewDecFromStr("-0.01"),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "redemption fee should be ≥ 0 and < 1",
+			},
+		},
+		{
+			name: "redemption fee of one",
+			args: args{
+				globalDebtLimit:                    types.DefaultGlobalDebt,
+				collateralParams:                   types.DefaultCollateralParams,
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				redemptionFee:                      sdk.MustNewDecFromStr("1.0"),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "redemption fee should be ≥ 0 and < 1",
+			},
+		},
+		{
+			name: "valid interest factor snapshot interval",
+			args: args{
+				globalDebtLimit:                    types.DefaultGlobalDebt,
+				collateralParams:                   types.DefaultCollateralParams,
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				interestFactorSnapshotInterval:     types.DefaultInterestFactorSnapshotInterval,
+			},
+			errArgs: errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			name: "negative interest factor snapshot interval",
+			args: args{
+				globalDebtLimit:                    types.DefaultGlobalDebt,
+				collateralParams:                   types.DefaultCollateralParams,
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				interestFactorSnapshotInterval:     -1,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "interest factor snapshot interval param should not be negative",
+			},
+		},
+		{
+			name: "valid savings rate",
+			args: args{
+				globalDebtLimit:                    types.DefaultGlobalDebt,
+				collateralParams:                   types.DefaultCollateralParams,
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				savingsRate:                        sdk.MustNewDecFromStr("1.000000001547125958"),
+			},
+			errArgs: errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			name: "savings rate below one",
+			args: args{
+				globalDebtLimit:                    types.DefaultGlobalDebt,
+				collateralParams:                   types.DefaultCollateralParams,
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				savingsRate:                        sdk.MustNewDecFromStr("0.999999999"),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "savings rate must be ≥ 1.0",
+			},
+		},
+		{
+			name: "savings rate above max",
+			args: args{
+				globalDebtLimit:                    types.DefaultGlobalDebt,
+				collateralParams:                   types.DefaultCollateralParams,
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				savingsRate:                        sdk.MustNewDecFromStr("1.000000051034942717"),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "savings rate must be ≥ 1.0",
+			},
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			params := types.NewParams(tc.args.globalDebtLimit, tc.args.collateralParams, tc.args.debtParam, tc.args.surplusThreshold, tc.args.surplusLot, tc.args.debtThreshold, tc.args.debtLot, tc.args.breaker, tc.args.beginBlockerExecutionBlockInterval)
+			params := types.NewParams(tc.args.globalDebtLimit, tc.args.collateralParams, tc.args.debtParam, tc.args.surplusThreshold, tc.args.surplusLot, tc.args.debtThreshold, tc.args.debtLot, tc.args.breaker, tc.args.beginBlockerExecutionBlockInterval, tc.args.feePaymentParam, tc.args.redemptionFee, tc.args.interestFactorSnapshotInterval, tc.args.savingsRate)
 			err := params.Validate()
 			if tc.errArgs.expectPass {
 				suite.Require().NoError(err)
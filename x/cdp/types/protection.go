@@ -0,0 +1,60 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewProtection returns a new Protection with the input allowance
+func NewProtection(cdpID uint64, collateralType string, reserve sdk.AccAddress, targetRatio sdk.Dec, allowance sdk.Coin) Protection {
+	return Protection{
+		CdpID:          cdpID,
+		CollateralType: collateralType,
+		Reserve:        reserve,
+		TargetRatio:    targetRatio,
+		Allowance:      allowance,
+	}
+}
+
+// Validate performs a basic validation of the protection fields.
+func (p Protection) Validate() error {
+	if p.CdpID == 0 {
+		return errors.New("protection's cdp id cannot be 0")
+	}
+	if strings.TrimSpace(p.CollateralType) == "" {
+		return errors.New("protection's collateral type cannot be empty")
+	}
+	if p.Reserve.Empty() {
+		return errors.New("protection's reserve cannot be empty")
+	}
+	if p.TargetRatio.IsNil() || !p.TargetRatio.IsPositive() {
+		return fmt.Errorf("protection's target ratio must be positive, is %s", p.TargetRatio)
+	}
+	if !p.Allowance.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "protection allowance %s", p.Allowance)
+	}
+	return nil
+}
+
+// Protections a collection of Protection objects
+type Protections []Protection
+
+// Validate validates each protection and checks that no cdp is protected twice
+func (ps Protections) Validate() error {
+	seen := make(map[uint64]bool)
+	for _, p := range ps {
+		if seen[p.CdpID] {
+			return fmt.Errorf("duplicate protection for cdp %d", p.CdpID)
+		}
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		seen[p.CdpID] = true
+	}
+	return nil
+}
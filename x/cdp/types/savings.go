@@ -0,0 +1,95 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewSavingsDeposit returns a new SavingsDeposit
+func NewSavingsDeposit(depositor sdk.AccAddress, amount sdk.Coin, savingsFactor sdk.Dec, accruedInterest sdk.Coin) SavingsDeposit {
+	return SavingsDeposit{
+		Depositor:       depositor,
+		Amount:          amount,
+		SavingsFactor:   savingsFactor,
+		AccruedInterest: accruedInterest,
+	}
+}
+
+// Validate performs a basic validation of the savings deposit fields.
+func (d SavingsDeposit) Validate() error {
+	if d.Depositor.Empty() {
+		return errors.New("savings deposit's depositor cannot be empty")
+	}
+	if !d.Amount.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "savings deposit amount %s", d.Amount)
+	}
+	if !d.AccruedInterest.IsValid() || d.AccruedInterest.Denom != d.Amount.Denom {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "savings deposit accrued interest %s", d.AccruedInterest)
+	}
+	if d.SavingsFactor.IsNil() || d.SavingsFactor.LT(sdk.OneDec()) {
+		return fmt.Errorf("savings deposit's savings factor must be at least 1, is %s", d.SavingsFactor)
+	}
+	return nil
+}
+
+// SavingsDeposits a collection of SavingsDeposit objects
+type SavingsDeposits []SavingsDeposit
+
+// Validate validates each savings deposit and checks that no depositor has two deposits
+func (ds SavingsDeposits) Validate() error {
+	seen := make(map[string]bool)
+	for _, d := range ds {
+		if seen[d.Depositor.String()] {
+			return fmt.Errorf("duplicate savings deposit for %s", d.Depositor)
+		}
+		if err := d.Validate(); err != nil {
+			return err
+		}
+		seen[d.Depositor.String()] = true
+	}
+	return nil
+}
+
+// NewSavingsAccumulator returns a new SavingsAccumulator
+func NewSavingsAccumulator(savingsFactor sdk.Dec, previousAccrualTime time.Time, totalSavings sdkmath.Int) SavingsAccumulator {
+	return SavingsAccumulator{
+		SavingsFactor:       savingsFactor,
+		PreviousAccrualTime: previousAccrualTime,
+		TotalSavings:        totalSavings,
+	}
+}
+
+// DefaultSavingsAccumulator returns the accumulator before any savings are deposited, which starts accruing at the
+// first block
+func DefaultSavingsAccumulator() SavingsAccumulator {
+	return NewSavingsAccumulator(sdk.OneDec(), time.Time{}, sdkmath.ZeroInt())
+}
+
+// Normalize returns the accumulator with an unset (zero) savings factor or total savings replaced by their defaults
+func (a SavingsAccumulator) Normalize() SavingsAccumulator {
+	if a.SavingsFactor.IsNil() || a.SavingsFactor.IsZero() {
+		a.SavingsFactor = sdk.OneDec()
+	}
+	if a.TotalSavings.IsNil() {
+		a.TotalSavings = sdkmath.ZeroInt()
+	}
+	return a
+}
+
+// Validate performs a basic validation of the savings accumulator fields. An unset (zero) savings factor or total
+// savings are valid and are treated as their defaults.
+func (a SavingsAccumulator) Validate() error {
+	if !a.SavingsFactor.IsNil() && !a.SavingsFactor.IsZero() && a.SavingsFactor.LT(sdk.OneDec()) {
+		return fmt.Errorf("savings factor must be at least 1, is %s", a.SavingsFactor)
+	}
+	if !a.TotalSavings.IsNil() && a.TotalSavings.IsNegative() {
+		return fmt.Errorf("total savings cannot be negative, is %s", a.TotalSavings)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewInterestFactorSnapshot returns a new InterestFactorSnapshot
+func NewInterestFactorSnapshot(collateralType string, height int64, snapshotTime time.Time, interestFactor sdk.Dec) InterestFactorSnapshot {
+	return InterestFactorSnapshot{
+		CollateralType: collateralType,
+		Height:         height,
+		Time:           snapshotTime,
+		InterestFactor: interestFactor,
+	}
+}
+
+// Validate performs a basic validation of the snapshot fields.
+func (s InterestFactorSnapshot) Validate() error {
+	if strings.TrimSpace(s.CollateralType) == "" {
+		return errors.New("snapshot's collateral type cannot be empty")
+	}
+	if s.Height < 0 {
+		return fmt.Errorf("snapshot's height cannot be negative, is %d", s.Height)
+	}
+	if s.InterestFactor.IsNil() || s.InterestFactor.LT(sdk.OneDec()) {
+		return fmt.Errorf("snapshot's interest factor must be at least 1, is %s", s.InterestFactor)
+	}
+	return nil
+}
+
+// InterestFactorSnapshots a collection of InterestFactorSnapshot objects
+type InterestFactorSnapshots []InterestFactorSnapshot
+
+// Validate validates each snapshot and checks that no collateral type has two snapshots at the same height
+func (ss InterestFactorSnapshots) Validate() error {
+	seen := make(map[string]bool)
+	for _, s := range ss {
+		key := fmt.Sprintf("%s:%d", s.CollateralType, s.Height)
+		if seen[key] {
+			return fmt.Errorf("duplicate interest factor snapshot for %s at height %d", s.CollateralType, s.Height)
+		}
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		seen[key] = true
+	}
+	return nil
+}
@@ -246,6 +246,10 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 		"spot_market_id": "bnb:usd",
 		"liquidation_market_id": "bnb:usd",
 		"keeper_reward_percentage": "0",
+		"liquidation_close_factor": "0",
+		"keeper_incentive": "0",
+		"price_staleness_threshold": "0",
+		"liquidation_twap_duration": "0",
 		"check_collateralization_index_count": "0",
 		"conversion_factor": "6"
 	}`
@@ -260,6 +264,10 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 		"spot_market_id": "btc:usd",
 		"liquidation_market_id": "btc:usd",
 		"keeper_reward_percentage": "0.12",
+		"liquidation_close_factor": "0",
+		"keeper_incentive": "0",
+		"price_staleness_threshold": "0",
+		"liquidation_twap_duration": "0",
 		"check_collateralization_index_count": "1",
 		"conversion_factor": "8"
 	}`
@@ -292,6 +300,10 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 					"spot_market_id": "bnbc:usd",
 					"liquidation_market_id": "bnb:usd",
 					"keeper_reward_percentage": "0",
+					"liquidation_close_factor": "0",
+					"keeper_incentive": "0",
+					"price_staleness_threshold": "0",
+					"liquidation_twap_duration": "0",
 					"check_collateralization_index_count": "0",
 					"conversion_factor": "9"
 				},
@@ -306,6 +318,10 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 					"spot_market_id": "btc:usd",
 					"liquidation_market_id": "btc:usd",
 					"keeper_reward_percentage": "0.000000000000000000",
+					"liquidation_close_factor": "0",
+					"keeper_incentive": "0",
+					"price_staleness_threshold": "0",
+					"liquidation_twap_duration": "0",
 					"check_collateralization_index_count": "1",
 					"conversion_factor": "8"
 				}]`,
@@ -377,6 +393,10 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 					"spot_market_id": "btc:usd",
 					"liquidation_market_id": "btc:usd",
 					"keeper_reward_percentage": "0.12",
+					"liquidation_close_factor": "0",
+					"keeper_incentive": "0",
+					"price_staleness_threshold": "0",
+					"liquidation_twap_duration": "0",
 					"check_collateralization_index_count": "1",
 					"conversion_factor": "8"
 				}`),
@@ -419,6 +439,10 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 					"spot_market_id": "btc:usd",
 					"liquidation_market_id": "btc:usd",
 					"keeper_reward_percentage": "0.12",
+					"liquidation_close_factor": "0",
+					"keeper_incentive": "0",
+					"price_staleness_threshold": "0",
+					"liquidation_twap_duration": "0",
 					"conversion_factor": "8"
 				}`),
 			},
@@ -446,6 +470,10 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 					"spot_market_id": "btc:usd",
 					"liquidation_market_id": "btc:usd",
 					"keeper_reward_percentage": "0.12",
+					"liquidation_close_factor": "0",
+					"keeper_incentive": "0",
+					"price_staleness_threshold": "0",
+					"liquidation_twap_duration": "0",
 					"check_collateralization_index_count": "1",
 					"conversion_factor": "8"
 				}`),
@@ -474,6 +502,10 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 					"spot_market_id": "btc:usd",
 					"liquidation_market_id": "btc:usd",
 					"keeper_reward_percentage": "0.12",
+					"liquidation_close_factor": "0",
+					"keeper_incentive": "0",
+					"price_staleness_threshold": "0",
+					"liquidation_twap_duration": "0",
 					"check_collateralization_index_count": "1",
 					"conversion_factor": "8"
 				}`),
@@ -503,6 +535,10 @@ func (s *ParamsChangeTestSuite) TestMultiSubparams_CdpCollateralParams() {
 					"spot_market_id": "btc:usd",
 					"liquidation_market_id": "btc:usd",
 					"keeper_reward_percentage": "0.12",
+					"liquidation_close_factor": "0",
+					"keeper_incentive": "0",
+					"price_staleness_threshold": "0",
+					"liquidation_twap_duration": "0",
 					"check_collateralization_index_count": "1",
 					"conversion_factor": "8"
 				}`),
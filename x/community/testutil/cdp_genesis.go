@@ -31,6 +31,8 @@ func NewCDPGenState(cdc codec.JSONCodec, denom, asset string, liquidationRatio s
 					SpotMarketID:                     asset + ":usd",
 					LiquidationMarketID:              asset + ":usd",
 					KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+					LiquidationCloseFactor:           sdk.OneDec(),
+					KeeperIncentive:                  sdk.ZeroInt(),
 					CheckCollateralizationIndexCount: sdk.NewInt(10),
 					ConversionFactor:                 sdk.NewInt(6),
 				},
@@ -36,6 +36,9 @@ func GetQueryCmd() *cobra.Command {
 		queryVaultCmd(),
 		queryDepositsCmd(),
 		queryTotalSupplyCmd(),
+		queryStrategiesCmd(),
+		queryVaultMetricsCmd(),
+		queryPendingWithdrawalsCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -206,3 +209,110 @@ func queryTotalSupplyCmd() *cobra.Command {
 		},
 	}
 }
+
+func queryStrategiesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "strategies",
+		Short: "get registered earn strategies",
+		Long:  "Get the registered earn strategies and the health of the vaults that use them.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Strategies(context.Background(), &types.QueryStrategiesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryVaultMetricsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "vault-metrics [denom]",
+		Short: "get earn vault share prices and estimated apys",
+		Long:  "Get the share price, total value, strategy allocations and estimated apy of all earn vaults, or of the vaults of a denom.",
+		Args:  cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf(`%[1]s q %[2]s vault-metrics
+%[1]s q %[2]s vault-metrics usdx`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryVaultMetricsRequest{}
+			if len(args) > 0 {
+				req.Denom = args[0]
+			}
+
+			res, err := queryClient.VaultMetrics(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryPendingWithdrawalsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-withdrawals",
+		Short: "get earn vault pending withdrawals",
+		Long:  "Get the requested withdrawals of earn vaults with a withdrawal delay for all or specific accounts and vaults.",
+		Args:  cobra.NoArgs,
+		Example: fmt.Sprintf(`%[1]s q %[2]s pending-withdrawals
+%[1]s q %[2]s pending-withdrawals --owner kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny --denom usdx
+%[1]s q %[2]s pending-withdrawals --denom usdx`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ownerBech, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingWithdrawals(context.Background(), &types.QueryPendingWithdrawalsRequest{
+				Owner:      ownerBech,
+				Denom:      denom,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "pending-withdrawals")
+
+	cmd.Flags().String(flagOwner, "", "(optional) filter for pending withdrawals by owner address")
+	cmd.Flags().String(flagDenom, "", "(optional) filter for pending withdrawals by vault denom")
+
+	return cmd
+}
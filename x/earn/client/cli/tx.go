@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -29,6 +30,10 @@ func GetTxCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		getCmdDeposit(),
 		getCmdWithdraw(),
+		getCmdRequestWithdrawal(),
+		getCmdClaimWithdrawal(),
+		getCmdEnableAutoCompound(),
+		getCmdDisableAutoCompound(),
 	}
 
 	for _, cmd := range cmds {
@@ -112,6 +117,125 @@ func getCmdWithdraw() *cobra.Command {
 	}
 }
 
+func getCmdRequestWithdrawal() *cobra.Command {
+	return &cobra.Command{
+		Use:   "request-withdrawal [amount] [strategy]",
+		Short: "request a withdrawal of coins from an earn vault with a withdrawal delay",
+		Example: fmt.Sprintf(
+			`%s tx %s request-withdrawal 10000000ukava savings --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			strategy := types.NewStrategyTypeFromString(args[1])
+			if !strategy.IsValid() {
+				return fmt.Errorf("invalid strategy type: %s", args[1])
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgRequestWithdrawal(fromAddr.String(), amount, strategy)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdClaimWithdrawal() *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim-withdrawal [id]",
+		Short: "claim a released pending withdrawal from an earn vault",
+		Example: fmt.Sprintf(
+			`%s tx %s claim-withdrawal 1 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid withdrawal id: %w", err)
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgClaimWithdrawal(fromAddr.String(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdEnableAutoCompound() *cobra.Command {
+	return &cobra.Command{
+		Use:   "enable-auto-compound [vault-denom]",
+		Short: "compound claimed earn rewards into an earn vault",
+		Example: fmt.Sprintf(
+			`%s tx %s enable-auto-compound usdx --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgSetAutoCompound(fromAddr.String(), args[0], true)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdDisableAutoCompound() *cobra.Command {
+	return &cobra.Command{
+		Use:   "disable-auto-compound",
+		Short: "stop compounding claimed earn rewards",
+		Example: fmt.Sprintf(
+			`%s tx %s disable-auto-compound --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgSetAutoCompound(fromAddr.String(), "", false)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
 // GetCmdSubmitCommunityPoolDepositProposal implements the command to submit a community-pool deposit proposal
 func GetCmdSubmitCommunityPoolDepositProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -50,6 +50,27 @@ func InitGenesis(
 		k.SetVaultRecord(ctx, vaultRecord)
 	}
 
+	// Unreleased pending withdrawals are still held by vault strategies
+	pendingTotals := sdk.NewCoins()
+
+	for _, withdrawal := range gs.PendingWithdrawals {
+		if !withdrawal.Released {
+			pendingTotals = pendingTotals.Add(withdrawal.Amount)
+		}
+
+		k.SetPendingWithdrawal(ctx, withdrawal)
+	}
+
+	for _, total := range pendingTotals {
+		k.SetPendingWithdrawalTotal(ctx, total.Denom, total.Amount)
+	}
+
+	k.SetNextWithdrawalID(ctx, gs.NextWithdrawalID)
+
+	for _, autoCompound := range gs.AutoCompounds {
+		k.SetAutoCompound(ctx, autoCompound)
+	}
+
 	k.SetParams(ctx, gs.Params)
 }
 
@@ -58,6 +79,16 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 	vaultRecords := k.GetAllVaultRecords(ctx)
 	vaultShareRecords := k.GetAllVaultShareRecords(ctx)
+	pendingWithdrawals := k.GetAllPendingWithdrawals(ctx)
+	nextWithdrawalID := k.GetNextWithdrawalID(ctx)
+	autoCompounds := k.GetAllAutoCompounds(ctx)
 
-	return types.NewGenesisState(params, vaultRecords, vaultShareRecords)
+	return types.NewGenesisState(
+		params,
+		vaultRecords,
+		vaultShareRecords,
+		pendingWithdrawals,
+		nextWithdrawalID,
+		autoCompounds,
+	)
 }
@@ -35,6 +35,9 @@ func (suite *genesisTestSuite) Test_InitGenesis_ValidationPanic() {
 			},
 		},
 		types.VaultShareRecords{},
+		types.PendingWithdrawals{},
+		types.DefaultNextWithdrawalID,
+		types.AutoCompounds{},
 	)
 
 	suite.Panics(func() {
@@ -90,6 +93,21 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 				),
 			},
 		},
+		types.PendingWithdrawals{
+			types.NewPendingWithdrawal(1, depositor_1, sdk.NewInt64Coin("usdx", 100000), 10),
+			{
+				ID:            3,
+				Owner:         depositor_2,
+				Amount:        sdk.NewInt64Coin("usdx", 200000),
+				ReleaseHeight: 5,
+				Released:      true,
+			},
+			types.NewPendingWithdrawal(4, depositor_2, sdk.NewInt64Coin("usdx", 300000), 12),
+		},
+		5,
+		types.AutoCompounds{
+			types.NewAutoCompound(depositor_2, "usdx"),
+		},
 	)
 
 	earn.InitGenesis(suite.Ctx, suite.Keeper, suite.AccountKeeper, state)
@@ -106,6 +124,18 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 	suite.Equal(state.VaultShareRecords[0], shareRecord1)
 	suite.Equal(state.VaultShareRecords[1], shareRecord2)
 
+	withdrawal, found := suite.Keeper.GetPendingWithdrawal(suite.Ctx, 3)
+	suite.Require().True(found)
+	suite.Equal(state.PendingWithdrawals[1], withdrawal)
+
+	// Only unreleased withdrawals are still held by the vault strategy
+	suite.Equal(sdk.NewInt(400000), suite.Keeper.GetPendingWithdrawalTotal(suite.Ctx, "usdx"))
+	suite.Equal(uint64(5), suite.Keeper.GetNextWithdrawalID(suite.Ctx))
+
+	autoCompound, found := suite.Keeper.GetAutoCompound(suite.Ctx, depositor_2)
+	suite.Require().True(found)
+	suite.Equal(state.AutoCompounds[0], autoCompound)
+
 	exportedState := earn.ExportGenesis(suite.Ctx, suite.Keeper)
 	suite.Equal(state, exportedState)
 }
@@ -158,6 +188,13 @@ func (suite *genesisTestSuite) Test_Marshall() {
 				),
 			},
 		},
+		types.PendingWithdrawals{
+			types.NewPendingWithdrawal(1, depositor_1, sdk.NewInt64Coin("usdx", 100000), 10),
+		},
+		2,
+		types.AutoCompounds{
+			types.NewAutoCompound(depositor_1, "ukava"),
+		},
 	)
 
 	encodingCfg := app.MakeEncodingConfig()
@@ -0,0 +1,247 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/earn/types"
+	incentivetypes "github.com/kava-labs/kava/x/incentive/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+const (
+	// CompoundTwapWindow is the window of the pool time weighted average price
+	// that reward swaps are priced at.
+	CompoundTwapWindow = time.Hour
+)
+
+// CompoundSlippageLimit is the max slippage of a reward swap output from its
+// output at the pool time weighted average price.
+var CompoundSlippageLimit = sdk.MustNewDecFromStr("0.05")
+
+// ----------------------------------------------------------------------------
+// AutoCompound -- depositors compounding their claimed earn rewards
+
+// GetAutoCompound returns the auto compound of a depositor.
+func (k *Keeper) GetAutoCompound(
+	ctx sdk.Context,
+	depositor sdk.AccAddress,
+) (types.AutoCompound, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AutoCompoundKeyPrefix)
+
+	bz := store.Get(types.AutoCompoundKey(depositor))
+	if bz == nil {
+		return types.AutoCompound{}, false
+	}
+
+	var autoCompound types.AutoCompound
+	k.cdc.MustUnmarshal(bz, &autoCompound)
+
+	return autoCompound, true
+}
+
+// SetAutoCompound sets the auto compound of a depositor.
+func (k *Keeper) SetAutoCompound(
+	ctx sdk.Context,
+	autoCompound types.AutoCompound,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AutoCompoundKeyPrefix)
+	bz := k.cdc.MustMarshal(&autoCompound)
+	store.Set(types.AutoCompoundKey(autoCompound.Depositor), bz)
+}
+
+// DeleteAutoCompound deletes the auto compound of a depositor.
+func (k *Keeper) DeleteAutoCompound(
+	ctx sdk.Context,
+	depositor sdk.AccAddress,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AutoCompoundKeyPrefix)
+	store.Delete(types.AutoCompoundKey(depositor))
+}
+
+// IterateAutoCompounds iterates over all auto compounds in the store and
+// performs a callback function.
+func (k Keeper) IterateAutoCompounds(
+	ctx sdk.Context,
+	cb func(autoCompound types.AutoCompound) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AutoCompoundKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var autoCompound types.AutoCompound
+		k.cdc.MustUnmarshal(iterator.Value(), &autoCompound)
+		if cb(autoCompound) {
+			break
+		}
+	}
+}
+
+// GetAllAutoCompounds returns all auto compounds from the store.
+func (k Keeper) GetAllAutoCompounds(ctx sdk.Context) types.AutoCompounds {
+	var autoCompounds types.AutoCompounds
+
+	k.IterateAutoCompounds(ctx, func(autoCompound types.AutoCompound) bool {
+		autoCompounds = append(autoCompounds, autoCompound)
+		return false
+	})
+
+	return autoCompounds
+}
+
+// EnableAutoCompound opts a depositor in to compounding their claimed earn
+// rewards into a vault, replacing any vault previously set.
+func (k *Keeper) EnableAutoCompound(
+	ctx sdk.Context,
+	depositor sdk.AccAddress,
+	vaultDenom string,
+) error {
+	if _, found := k.GetAllowedVault(ctx, vaultDenom); !found {
+		return types.ErrInvalidVaultDenom
+	}
+
+	k.SetAutoCompound(ctx, types.NewAutoCompound(depositor, vaultDenom))
+
+	return nil
+}
+
+// CompoundRewards swaps reward coins held by a depositor into the denom of a
+// vault and deposits them into it, returning the amount deposited. Rewards
+// the depositor cannot spend, such as vesting rewards, are not compounded.
+//
+// Each swap must output at least the reward's value at the pool time weighted
+// average price, less CompoundSlippageLimit, so a pool price moved within the
+// block cannot be used to take the rewards. Rewards of pools without a time
+// weighted average price are not compounded.
+func (k *Keeper) CompoundRewards(
+	ctx sdk.Context,
+	depositor sdk.AccAddress,
+	vaultDenom string,
+	rewards sdk.Coins,
+) (sdk.Coin, error) {
+	allowedVault, found := k.GetAllowedVault(ctx, vaultDenom)
+	if !found {
+		return sdk.Coin{}, types.ErrInvalidVaultDenom
+	}
+
+	spendable := k.bankKeeper.SpendableCoins(ctx, depositor)
+	compounded := sdk.NewCoin(vaultDenom, sdk.ZeroInt())
+
+	for _, reward := range rewards {
+		reward.Amount = sdk.MinInt(reward.Amount, spendable.AmountOf(reward.Denom))
+		if !reward.IsPositive() {
+			continue
+		}
+
+		if reward.Denom == vaultDenom {
+			compounded = compounded.Add(reward)
+			continue
+		}
+
+		expected, err := k.twapSwapOutput(ctx, reward, vaultDenom)
+		if err != nil {
+			return sdk.Coin{}, fmt.Errorf("failed to price %s reward swap: %w", reward.Denom, err)
+		}
+
+		// The quote is taken from the same state the swap is executed in, so
+		// the swap output matches it exactly.
+		output, _, _, err := k.swapKeeper.QuoteSwapExactForTokens(ctx, reward, nil, vaultDenom)
+		if err != nil {
+			return sdk.Coin{}, fmt.Errorf("failed to quote %s reward swap: %w", reward.Denom, err)
+		}
+
+		if err := k.swapKeeper.SwapExactForTokens(ctx, depositor, reward, expected, CompoundSlippageLimit); err != nil {
+			return sdk.Coin{}, fmt.Errorf("failed to swap %s reward: %w", reward.Denom, err)
+		}
+
+		compounded = compounded.Add(output)
+	}
+
+	if compounded.IsZero() {
+		return compounded, nil
+	}
+
+	if err := k.Deposit(ctx, depositor, compounded, allowedVault.Strategies[0]); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return compounded, nil
+}
+
+// twapSwapOutput returns the value of a reward in a vault denom at the time
+// weighted average price of their swap pool.
+func (k *Keeper) twapSwapOutput(ctx sdk.Context, reward sdk.Coin, vaultDenom string) (sdk.Coin, error) {
+	twapA, twapB, err := k.swapKeeper.GetPoolTwap(ctx, swaptypes.PoolID(reward.Denom, vaultDenom), CompoundTwapWindow)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// Pool ids sort their denoms, with the price of the first denom as twap a
+	price := twapB
+	if reward.Denom < vaultDenom {
+		price = twapA
+	}
+
+	output := sdk.NewCoin(vaultDenom, sdk.NewDecFromInt(reward.Amount).Mul(price).TruncateInt())
+	if !output.IsPositive() {
+		return sdk.Coin{}, fmt.Errorf("%s is worth no %s at the pool time weighted average price", reward, vaultDenom)
+	}
+	return output, nil
+}
+
+// IncentiveHooks wraps the keeper to compound earn rewards when they are
+// claimed from the incentive module.
+type IncentiveHooks struct {
+	k *Keeper
+}
+
+var _ incentivetypes.IncentiveHooks = IncentiveHooks{}
+
+// IncentiveHooks returns the incentive hooks of the keeper.
+func (k *Keeper) IncentiveHooks() IncentiveHooks {
+	return IncentiveHooks{k}
+}
+
+// AfterClaimPayout compounds the paid out earn rewards of depositors that
+// opted in. Compounding failures are logged and leave the rewards with the
+// depositor, without failing the claim.
+func (h IncentiveHooks) AfterClaimPayout(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	coins sdk.Coins,
+	claimType string,
+) {
+	if claimType != incentivetypes.EarnClaimType {
+		return
+	}
+
+	autoCompound, found := h.k.GetAutoCompound(ctx, owner)
+	if !found {
+		return
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	compounded, err := h.k.CompoundRewards(cacheCtx, owner, autoCompound.VaultDenom, coins)
+	if err != nil {
+		h.k.Logger(ctx).Info(fmt.Sprintf("could not compound earn rewards of %s: %s", owner, err))
+		return
+	}
+	if compounded.IsZero() {
+		return
+	}
+	writeCache()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, compounded.Denom),
+			sdk.NewAttribute(types.AttributeKeyDepositor, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyRewards, coins.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, compounded.Amount.String()),
+		),
+	)
+}
@@ -0,0 +1,214 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/earn/testutil"
+	"github.com/kava-labs/kava/x/earn/types"
+	incentivetypes "github.com/kava-labs/kava/x/incentive/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+type autoCompoundTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *autoCompoundTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+	suite.Keeper.SetParams(suite.Ctx, types.DefaultParams())
+}
+
+func TestAutoCompoundTestSuite(t *testing.T) {
+	suite.Run(t, new(autoCompoundTestSuite))
+}
+
+// createSwapPool creates a ukava:usdx swap pool funded by a separate account
+func (suite *autoCompoundTestSuite) createSwapPool() {
+	swapKeeper := suite.App.GetSwapKeeper()
+	swapKeeper.SetParams(suite.Ctx, swaptypes.NewParams(
+		swaptypes.NewAllowedPools(swaptypes.NewAllowedPool("ukava", "usdx")),
+		sdk.ZeroDec(),
+		swaptypes.DefaultTwapMaxWindowSeconds,
+	))
+
+	liquidity := sdk.NewCoins(sdk.NewInt64Coin("ukava", 1_000_000_000), sdk.NewInt64Coin("usdx", 2_000_000_000))
+	provider := suite.CreateAccount(liquidity, 1)
+	err := swapKeeper.Deposit(
+		suite.Ctx,
+		provider.GetAddress(),
+		sdk.NewInt64Coin("ukava", 1_000_000_000),
+		sdk.NewInt64Coin("usdx", 2_000_000_000),
+		sdk.MustNewDecFromStr("0.01"),
+	)
+	suite.Require().NoError(err)
+}
+
+// observePoolPrices records pool price observations a minute apart, giving the
+// pools a time weighted average price at their current price
+func (suite *autoCompoundTestSuite) observePoolPrices() {
+	swapKeeper := suite.App.GetSwapKeeper()
+	swapKeeper.UpdatePoolPriceObservations(suite.Ctx)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Minute))
+	swapKeeper.UpdatePoolPriceObservations(suite.Ctx)
+}
+
+func (suite *autoCompoundTestSuite) TestEnableAutoCompound() {
+	vaultDenom := "usdx"
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	acc := suite.CreateAccount(sdk.NewCoins(), 0)
+
+	err := suite.Keeper.EnableAutoCompound(suite.Ctx, acc.GetAddress(), "busd")
+	suite.Require().ErrorIs(err, types.ErrInvalidVaultDenom)
+
+	_, found := suite.Keeper.GetAutoCompound(suite.Ctx, acc.GetAddress())
+	suite.Require().False(found)
+
+	err = suite.Keeper.EnableAutoCompound(suite.Ctx, acc.GetAddress(), vaultDenom)
+	suite.Require().NoError(err)
+
+	autoCompound, found := suite.Keeper.GetAutoCompound(suite.Ctx, acc.GetAddress())
+	suite.Require().True(found)
+	suite.Equal(types.NewAutoCompound(acc.GetAddress(), vaultDenom), autoCompound)
+
+	suite.Keeper.DeleteAutoCompound(suite.Ctx, acc.GetAddress())
+
+	_, found = suite.Keeper.GetAutoCompound(suite.Ctx, acc.GetAddress())
+	suite.Require().False(found)
+}
+
+func (suite *autoCompoundTestSuite) TestAfterClaimPayout_Compounds() {
+	vaultDenom := "usdx"
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	suite.createSwapPool()
+	suite.observePoolPrices()
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000), sdk.NewInt64Coin("usdx", 500))
+	acc := suite.CreateAccount(rewards, 0)
+
+	err := suite.Keeper.EnableAutoCompound(suite.Ctx, acc.GetAddress(), vaultDenom)
+	suite.Require().NoError(err)
+
+	swapOutput, _, _, err := suite.App.GetSwapKeeper().QuoteSwapExactForTokens(
+		suite.Ctx,
+		sdk.NewInt64Coin("ukava", 1000),
+		nil,
+		vaultDenom,
+	)
+	suite.Require().NoError(err)
+	suite.Require().True(swapOutput.IsPositive())
+
+	suite.Keeper.IncentiveHooks().AfterClaimPayout(suite.Ctx, acc.GetAddress(), rewards, incentivetypes.EarnClaimType)
+
+	compounded := swapOutput.AddAmount(sdk.NewInt(500))
+
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins())
+	suite.VaultTotalValuesEqual(sdk.NewCoins(compounded))
+
+	shares, found := suite.Keeper.GetVaultAccountShares(suite.Ctx, acc.GetAddress())
+	suite.Require().True(found)
+	suite.Equal(types.NewVaultShares(types.NewVaultShare(vaultDenom, sdk.NewDecFromInt(compounded.Amount))), shares)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeAutoCompound,
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, vaultDenom),
+		sdk.NewAttribute(types.AttributeKeyDepositor, acc.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyRewards, rewards.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, compounded.Amount.String()),
+	))
+}
+
+func (suite *autoCompoundTestSuite) TestAfterClaimPayout_NotCompounded() {
+	vaultDenom := "usdx"
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("usdx", 500))
+	optedIn := suite.CreateAccount(rewards, 0)
+	optedOut := suite.CreateAccount(rewards, 1)
+
+	err := suite.Keeper.EnableAutoCompound(suite.Ctx, optedIn.GetAddress(), vaultDenom)
+	suite.Require().NoError(err)
+
+	// Rewards of other claim types are not compounded
+	suite.Keeper.IncentiveHooks().AfterClaimPayout(suite.Ctx, optedIn.GetAddress(), rewards, incentivetypes.SavingsClaimType)
+	// Depositors that did not opt in keep their rewards
+	suite.Keeper.IncentiveHooks().AfterClaimPayout(suite.Ctx, optedOut.GetAddress(), rewards, incentivetypes.EarnClaimType)
+
+	suite.AccountBalanceEqual(optedIn.GetAddress(), rewards)
+	suite.AccountBalanceEqual(optedOut.GetAddress(), rewards)
+	suite.VaultTotalValuesEqual(sdk.NewCoins())
+}
+
+func (suite *autoCompoundTestSuite) TestAfterClaimPayout_Failure() {
+	vaultDenom := "usdx"
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	suite.SetVaultDepositLimits(vaultDenom, sdk.NewInt(100), sdk.ZeroInt())
+	suite.createSwapPool()
+	suite.observePoolPrices()
+
+	// The ukava reward swap succeeds, but the deposit exceeds the vault cap
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000), sdk.NewInt64Coin("usdx", 500))
+	acc := suite.CreateAccount(rewards, 0)
+
+	err := suite.Keeper.EnableAutoCompound(suite.Ctx, acc.GetAddress(), vaultDenom)
+	suite.Require().NoError(err)
+
+	suite.Keeper.IncentiveHooks().AfterClaimPayout(suite.Ctx, acc.GetAddress(), rewards, incentivetypes.EarnClaimType)
+
+	// The swap is reverted along with the failed deposit
+	suite.AccountBalanceEqual(acc.GetAddress(), rewards)
+	suite.VaultTotalValuesEqual(sdk.NewCoins())
+}
+
+func (suite *autoCompoundTestSuite) TestAfterClaimPayout_NoTwap() {
+	vaultDenom := "usdx"
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	suite.createSwapPool()
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000), sdk.NewInt64Coin("usdx", 500))
+	acc := suite.CreateAccount(rewards, 0)
+
+	err := suite.Keeper.EnableAutoCompound(suite.Ctx, acc.GetAddress(), vaultDenom)
+	suite.Require().NoError(err)
+
+	// Without price observations the reward swap has no minimum output
+	suite.Keeper.IncentiveHooks().AfterClaimPayout(suite.Ctx, acc.GetAddress(), rewards, incentivetypes.EarnClaimType)
+
+	suite.AccountBalanceEqual(acc.GetAddress(), rewards)
+	suite.VaultTotalValuesEqual(sdk.NewCoins())
+}
+
+func (suite *autoCompoundTestSuite) TestAfterClaimPayout_PriceMoved() {
+	vaultDenom := "usdx"
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	suite.createSwapPool()
+	suite.observePoolPrices()
+
+	// Selling ukava into the pool within the block lowers its price by far more
+	// than the slippage limit from the time weighted average price
+	seller := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin("ukava", 500_000_000)), 1)
+	swapKeeper := suite.App.GetSwapKeeper()
+	err := swapKeeper.SwapExactForTokens(
+		suite.Ctx,
+		seller.GetAddress(),
+		sdk.NewInt64Coin("ukava", 500_000_000),
+		sdk.NewInt64Coin("usdx", 1),
+		sdk.OneDec(),
+	)
+	suite.Require().NoError(err)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000), sdk.NewInt64Coin("usdx", 500))
+	acc := suite.CreateAccount(rewards, 0)
+
+	err = suite.Keeper.EnableAutoCompound(suite.Ctx, acc.GetAddress(), vaultDenom)
+	suite.Require().NoError(err)
+
+	suite.Keeper.IncentiveHooks().AfterClaimPayout(suite.Ctx, acc.GetAddress(), rewards, incentivetypes.EarnClaimType)
+
+	suite.AccountBalanceEqual(acc.GetAddress(), rewards)
+	suite.VaultTotalValuesEqual(sdk.NewCoins())
+}
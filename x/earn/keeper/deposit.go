@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/earn/types"
@@ -37,6 +38,10 @@ func (k *Keeper) Deposit(
 		return types.ErrAccountDepositNotAllowed
 	}
 
+	if err := k.checkDepositLimits(ctx, allowedVault, depositor, amount); err != nil {
+		return err
+	}
+
 	// Check if VaultRecord exists, create if not exist
 	vaultRecord, found := k.GetVaultRecord(ctx, amount.Denom)
 	if !found {
@@ -54,6 +59,10 @@ func (k *Keeper) Deposit(
 		return err
 	}
 
+	if err := strategy.CheckHealth(ctx, amount.Denom); err != nil {
+		return errorsmod.Wrap(types.ErrStrategyUnhealthy, err.Error())
+	}
+
 	// Transfer amount to module account
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(
 		ctx,
@@ -77,10 +86,11 @@ func (k *Keeper) Deposit(
 		return fmt.Errorf("failed to convert assets to shares: %w", err)
 	}
 
-	isNew := vaultShareRecord.Shares.AmountOf(amount.Denom).IsZero()
+	oldShares := vaultShareRecord.Shares.AmountOf(amount.Denom)
+	isNew := oldShares.IsZero()
 	if !isNew {
 		// If deposits for this vault already exists, call hook with user's existing shares
-		k.BeforeVaultDepositModified(ctx, amount.Denom, depositor, vaultShareRecord.Shares.AmountOf(amount.Denom))
+		k.BeforeVaultDepositModified(ctx, amount.Denom, depositor, oldShares)
 	}
 
 	// Increment VaultRecord total shares and account shares
@@ -96,6 +106,8 @@ func (k *Keeper) Deposit(
 		k.AfterVaultDepositCreated(ctx, amount.Denom, depositor, shares.Amount)
 	}
 
+	k.AfterVaultSharesModified(ctx, depositor, amount.Denom, oldShares, vaultShareRecord.Shares.AmountOf(amount.Denom))
+
 	// Deposit to the strategy
 	if err := strategy.Deposit(ctx, amount); err != nil {
 		return err
@@ -114,6 +126,61 @@ func (k *Keeper) Deposit(
 	return nil
 }
 
+// checkDepositLimits returns an error if depositing the amount would exceed
+// the deposit cap of the vault or the deposit limit of the account. Caps and
+// limits apply per vault denom, so each bkava vault is capped separately.
+func (k *Keeper) checkDepositLimits(
+	ctx sdk.Context,
+	allowedVault types.AllowedVault,
+	depositor sdk.AccAddress,
+	amount sdk.Coin,
+) error {
+	if allowedVault.HasDepositCap() {
+		totalValue, err := k.GetVaultTotalValue(ctx, amount.Denom)
+		if err != nil {
+			return err
+		}
+
+		if totalValue.Amount.Add(amount.Amount).GT(allowedVault.DepositCap) {
+			return errorsmod.Wrapf(
+				types.ErrVaultDepositCapExceeded,
+				"%s vault value %s plus deposit %s exceeds cap %s",
+				amount.Denom,
+				totalValue.Amount,
+				amount.Amount,
+				allowedVault.DepositCap,
+			)
+		}
+	}
+
+	if allowedVault.HasAccountDepositLimit() {
+		accountValue := sdk.ZeroInt()
+
+		shares, found := k.GetVaultAccountShares(ctx, depositor)
+		if found && shares.AmountOf(amount.Denom).IsPositive() {
+			value, err := k.ConvertToAssets(ctx, shares.GetShare(amount.Denom))
+			if err != nil {
+				return err
+			}
+
+			accountValue = value.Amount
+		}
+
+		if accountValue.Add(amount.Amount).GT(allowedVault.AccountDepositLimit) {
+			return errorsmod.Wrapf(
+				types.ErrAccountLimitExceeded,
+				"account %s vault value %s plus deposit %s exceeds limit %s",
+				amount.Denom,
+				accountValue,
+				amount.Amount,
+				allowedVault.AccountDepositLimit,
+			)
+		}
+	}
+
+	return nil
+}
+
 // DepositFromModuleAccount adds the provided amount from a depositor module
 // account to a vault. The vault is specified by the denom in the amount.
 func (k *Keeper) DepositFromModuleAccount(
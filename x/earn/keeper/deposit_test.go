@@ -4,6 +4,7 @@ import (
 	"os"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -168,6 +169,62 @@ func (suite *depositTestSuite) TestDeposit_PrivateVault() {
 	suite.Require().NoError(err, "private vault should allow deposits from allowed addresses")
 }
 
+func (suite *depositTestSuite) TestDeposit_DepositCap() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+
+	acc1 := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+	acc2 := suite.CreateAccount(sdk.NewCoins(startBalance), 1)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	suite.SetVaultDepositLimits(vaultDenom, sdkmath.NewInt(500), sdkmath.ZeroInt())
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc1.GetAddress(), sdk.NewInt64Coin(vaultDenom, 300), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	err = suite.Keeper.Deposit(suite.Ctx, acc2.GetAddress(), sdk.NewInt64Coin(vaultDenom, 201), types.STRATEGY_TYPE_HARD)
+	suite.Require().ErrorIs(err, types.ErrVaultDepositCapExceeded)
+	suite.AccountBalanceEqual(acc2.GetAddress(), sdk.NewCoins(startBalance))
+
+	// Deposits up to the cap are allowed
+	err = suite.Keeper.Deposit(suite.Ctx, acc2.GetAddress(), sdk.NewInt64Coin(vaultDenom, 200), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+	suite.VaultTotalValuesEqual(sdk.NewCoins(sdk.NewInt64Coin(vaultDenom, 500)))
+
+	err = suite.Keeper.Deposit(suite.Ctx, acc1.GetAddress(), sdk.NewInt64Coin(vaultDenom, 1), types.STRATEGY_TYPE_HARD)
+	suite.Require().ErrorIs(err, types.ErrVaultDepositCapExceeded)
+}
+
+func (suite *depositTestSuite) TestDeposit_AccountDepositLimit() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+
+	acc1 := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+	acc2 := suite.CreateAccount(sdk.NewCoins(startBalance), 1)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	suite.SetVaultDepositLimits(vaultDenom, sdkmath.ZeroInt(), sdkmath.NewInt(300))
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc1.GetAddress(), sdk.NewInt64Coin(vaultDenom, 301), types.STRATEGY_TYPE_HARD)
+	suite.Require().ErrorIs(err, types.ErrAccountLimitExceeded)
+
+	err = suite.Keeper.Deposit(suite.Ctx, acc1.GetAddress(), sdk.NewInt64Coin(vaultDenom, 200), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	// Existing deposits count towards the limit
+	err = suite.Keeper.Deposit(suite.Ctx, acc1.GetAddress(), sdk.NewInt64Coin(vaultDenom, 101), types.STRATEGY_TYPE_HARD)
+	suite.Require().ErrorIs(err, types.ErrAccountLimitExceeded)
+
+	err = suite.Keeper.Deposit(suite.Ctx, acc1.GetAddress(), sdk.NewInt64Coin(vaultDenom, 100), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	// The limit applies to each account separately
+	err = suite.Keeper.Deposit(suite.Ctx, acc2.GetAddress(), sdk.NewInt64Coin(vaultDenom, 300), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.VaultTotalValuesEqual(sdk.NewCoins(sdk.NewInt64Coin(vaultDenom, 600)))
+}
+
 func (suite *depositTestSuite) TestDeposit_bKava() {
 	vaultDenom := "bkava"
 	coinDenom := testutil.TestBkavaDenoms[0]
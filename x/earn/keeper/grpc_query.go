@@ -8,7 +8,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/kava-labs/kava/x/earn/types"
 )
@@ -85,12 +87,14 @@ func (s queryServer) Vaults(
 		}
 
 		vaults = append(vaults, types.VaultResponse{
-			Denom:             record.TotalShares.Denom,
-			Strategies:        allowedVault.Strategies,
-			IsPrivateVault:    allowedVault.IsPrivateVault,
-			AllowedDepositors: addressSliceToStringSlice(allowedVault.AllowedDepositors),
-			TotalShares:       record.TotalShares.Amount.String(),
-			TotalValue:        totalValue.Amount,
+			Denom:               record.TotalShares.Denom,
+			Strategies:          allowedVault.Strategies,
+			IsPrivateVault:      allowedVault.IsPrivateVault,
+			AllowedDepositors:   addressSliceToStringSlice(allowedVault.AllowedDepositors),
+			TotalShares:         record.TotalShares.Amount.String(),
+			TotalValue:          totalValue.Amount,
+			DepositCap:          allowedVault.DepositCap,
+			AccountDepositLimit: allowedVault.AccountDepositLimit,
 		})
 
 		// Mark this allowed vault as visited
@@ -122,8 +126,10 @@ func (s queryServer) Vaults(
 			IsPrivateVault:    allowedVault.IsPrivateVault,
 			AllowedDepositors: addressSliceToStringSlice(allowedVault.AllowedDepositors),
 			// No shares, no value
-			TotalShares: sdk.ZeroDec().String(),
-			TotalValue:  sdk.ZeroInt(),
+			TotalShares:         sdk.ZeroDec().String(),
+			TotalValue:          sdk.ZeroInt(),
+			DepositCap:          allowedVault.DepositCap,
+			AccountDepositLimit: allowedVault.AccountDepositLimit,
 		})
 	}
 
@@ -174,12 +180,14 @@ func (s queryServer) Vault(
 
 	vault := types.VaultResponse{
 		// VaultRecord denom instead of AllowedVault.Denom for full bkava denom
-		Denom:             vaultRecord.TotalShares.Denom,
-		Strategies:        allowedVault.Strategies,
-		IsPrivateVault:    allowedVault.IsPrivateVault,
-		AllowedDepositors: addressSliceToStringSlice(allowedVault.AllowedDepositors),
-		TotalShares:       vaultRecord.TotalShares.Amount.String(),
-		TotalValue:        totalValue.Amount,
+		Denom:               vaultRecord.TotalShares.Denom,
+		Strategies:          allowedVault.Strategies,
+		IsPrivateVault:      allowedVault.IsPrivateVault,
+		AllowedDepositors:   addressSliceToStringSlice(allowedVault.AllowedDepositors),
+		TotalShares:         vaultRecord.TotalShares.Amount.String(),
+		TotalValue:          totalValue.Amount,
+		DepositCap:          allowedVault.DepositCap,
+		AccountDepositLimit: allowedVault.AccountDepositLimit,
 	}
 
 	return &types.QueryVaultResponse{
@@ -229,8 +237,10 @@ func (s queryServer) getAggregateBkavaVault(
 			IsPrivateVault:    allowedVault.IsPrivateVault,
 			AllowedDepositors: addressSliceToStringSlice(allowedVault.AllowedDepositors),
 			// Empty for shares, as adding up all shares is not useful information
-			TotalShares: "0",
-			TotalValue:  vaultValue.Amount,
+			TotalShares:         "0",
+			TotalValue:          vaultValue.Amount,
+			DepositCap:          allowedVault.DepositCap,
+			AccountDepositLimit: allowedVault.AccountDepositLimit,
 		},
 	}, nil
 }
@@ -336,6 +346,186 @@ func (s queryServer) TotalSupply(
 	}, vaultRecordErr
 }
 
+// Strategies implements the gRPC service handler for querying x/earn registered
+// strategies and the health of the allowed vaults that use them.
+func (s queryServer) Strategies(
+	ctx context.Context,
+	req *types.QueryStrategiesRequest,
+) (*types.QueryStrategiesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	allowedVaults := s.keeper.GetAllowedVaults(sdkCtx)
+
+	strategies := []types.StrategyResponse{}
+	for _, strategy := range s.keeper.GetStrategies() {
+		vaults := []types.StrategyVaultHealth{}
+		for _, allowedVault := range allowedVaults {
+			if !allowedVault.IsStrategyAllowed(strategy.GetStrategyType()) {
+				continue
+			}
+
+			health := types.StrategyVaultHealth{
+				Denom:   allowedVault.Denom,
+				Healthy: true,
+			}
+			if err := strategy.CheckHealth(sdkCtx, allowedVault.Denom); err != nil {
+				health.Healthy = false
+				health.Error = err.Error()
+			}
+			vaults = append(vaults, health)
+		}
+
+		strategies = append(strategies, types.StrategyResponse{
+			StrategyType: strategy.GetStrategyType(),
+			Vaults:       vaults,
+		})
+	}
+
+	return &types.QueryStrategiesResponse{
+		Strategies: strategies,
+	}, nil
+}
+
+// VaultMetrics implements the gRPC service handler for querying x/earn vault
+// share prices, values, strategy allocations and estimated APYs.
+func (s queryServer) VaultMetrics(
+	ctx context.Context,
+	req *types.QueryVaultMetricsRequest,
+) (*types.QueryVaultMetricsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Denom != "" && req.Denom != bkavaDenom {
+		if _, found := s.keeper.GetAllowedVault(sdkCtx, req.Denom); !found {
+			return nil, status.Errorf(codes.NotFound, "vault not found with specified denom")
+		}
+
+		metrics, err := s.keeper.GetVaultMetrics(sdkCtx, req.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.QueryVaultMetricsResponse{
+			Vaults: []types.VaultMetrics{metrics},
+		}, nil
+	}
+
+	vaults := []types.VaultMetrics{}
+	visited := make(map[string]bool)
+
+	var vaultRecordsErr error
+
+	// Iterate over vault records to get all bkava-* vaults, which share the
+	// bkava allowed vault
+	s.keeper.IterateVaultRecords(sdkCtx, func(record types.VaultRecord) bool {
+		allowedVaultDenom := record.TotalShares.Denom
+		if strings.HasPrefix(record.TotalShares.Denom, bkavaPrefix) {
+			allowedVaultDenom = bkavaDenom
+		}
+
+		if req.Denom != "" && req.Denom != allowedVaultDenom {
+			return false
+		}
+
+		metrics, err := s.keeper.GetVaultMetrics(sdkCtx, record.TotalShares.Denom)
+		if err != nil {
+			vaultRecordsErr = err
+			return true
+		}
+
+		vaults = append(vaults, metrics)
+		visited[allowedVaultDenom] = true
+
+		return false
+	})
+
+	if vaultRecordsErr != nil {
+		return nil, vaultRecordsErr
+	}
+
+	// Add the allowed vaults that have no deposits
+	for _, allowedVault := range s.keeper.GetAllowedVaults(sdkCtx) {
+		if visited[allowedVault.Denom] || (req.Denom != "" && req.Denom != allowedVault.Denom) {
+			continue
+		}
+
+		metrics, err := s.keeper.GetVaultMetrics(sdkCtx, allowedVault.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		vaults = append(vaults, metrics)
+	}
+
+	return &types.QueryVaultMetricsResponse{
+		Vaults: vaults,
+	}, nil
+}
+
+// PendingWithdrawals implements the gRPC service handler for querying x/earn
+// pending withdrawals of vaults with a withdrawal delay.
+func (s queryServer) PendingWithdrawals(
+	ctx context.Context,
+	req *types.QueryPendingWithdrawalsRequest,
+) (*types.QueryPendingWithdrawalsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	var owner sdk.AccAddress
+	if req.Owner != "" {
+		var err error
+		owner, err = sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid address")
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(sdkCtx.KVStore(s.keeper.key), types.PendingWithdrawalKeyPrefix)
+
+	withdrawals := []types.PendingWithdrawalResponse{}
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, shouldAccumulate bool) (bool, error) {
+		var withdrawal types.PendingWithdrawal
+		if err := s.keeper.cdc.Unmarshal(value, &withdrawal); err != nil {
+			return false, err
+		}
+
+		if owner != nil && !withdrawal.Owner.Equals(owner) {
+			return false, nil
+		}
+
+		if req.Denom != "" && withdrawal.Amount.Denom != req.Denom {
+			return false, nil
+		}
+
+		if shouldAccumulate {
+			withdrawals = append(withdrawals, types.PendingWithdrawalResponse{
+				ID:            withdrawal.ID,
+				Owner:         withdrawal.Owner.String(),
+				Amount:        withdrawal.Amount,
+				ReleaseHeight: withdrawal.ReleaseHeight,
+				Released:      withdrawal.Released,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryPendingWithdrawalsResponse{
+		PendingWithdrawals: withdrawals,
+		Pagination:         pageRes,
+	}, nil
+}
+
 // getOneAccountOneVaultDeposit returns deposits for a specific vault and a specific
 // account
 func (s queryServer) getOneAccountOneVaultDeposit(
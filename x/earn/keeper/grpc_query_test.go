@@ -75,12 +75,14 @@ func (suite *grpcQueryTestSuite) TestVaults_ZeroSupply() {
 		suite.Require().NoError(err)
 		suite.Require().Equal(
 			types.VaultResponse{
-				Denom:             "usdx",
-				Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD},
-				IsPrivateVault:    false,
-				AllowedDepositors: nil,
-				TotalShares:       sdk.NewDec(0).String(),
-				TotalValue:        sdkmath.NewInt(0),
+				Denom:               "usdx",
+				Strategies:          []types.StrategyType{types.STRATEGY_TYPE_HARD},
+				IsPrivateVault:      false,
+				AllowedDepositors:   nil,
+				TotalShares:         sdk.NewDec(0).String(),
+				TotalValue:          sdkmath.NewInt(0),
+				DepositCap:          sdk.ZeroInt(),
+				AccountDepositLimit: sdk.ZeroInt(),
 			},
 			res.Vault,
 		)
@@ -91,20 +93,24 @@ func (suite *grpcQueryTestSuite) TestVaults_ZeroSupply() {
 		suite.Require().NoError(err)
 		suite.Require().ElementsMatch([]types.VaultResponse{
 			{
-				Denom:             "usdx",
-				Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD},
-				IsPrivateVault:    false,
-				AllowedDepositors: nil,
-				TotalShares:       sdk.ZeroDec().String(),
-				TotalValue:        sdk.ZeroInt(),
+				Denom:               "usdx",
+				Strategies:          []types.StrategyType{types.STRATEGY_TYPE_HARD},
+				IsPrivateVault:      false,
+				AllowedDepositors:   nil,
+				TotalShares:         sdk.ZeroDec().String(),
+				TotalValue:          sdk.ZeroInt(),
+				DepositCap:          sdk.ZeroInt(),
+				AccountDepositLimit: sdk.ZeroInt(),
 			},
 			{
-				Denom:             "busd",
-				Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD},
-				IsPrivateVault:    false,
-				AllowedDepositors: nil,
-				TotalShares:       sdk.ZeroDec().String(),
-				TotalValue:        sdk.ZeroInt(),
+				Denom:               "busd",
+				Strategies:          []types.StrategyType{types.STRATEGY_TYPE_HARD},
+				IsPrivateVault:      false,
+				AllowedDepositors:   nil,
+				TotalShares:         sdk.ZeroDec().String(),
+				TotalValue:          sdk.ZeroInt(),
+				DepositCap:          sdk.ZeroInt(),
+				AccountDepositLimit: sdk.ZeroInt(),
 			},
 		},
 			res.Vaults,
@@ -139,20 +145,24 @@ func (suite *grpcQueryTestSuite) TestVaults_WithSupply() {
 	suite.Require().ElementsMatch(
 		[]types.VaultResponse{
 			{
-				Denom:             vaultDenom,
-				Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD},
-				IsPrivateVault:    false,
-				AllowedDepositors: nil,
-				TotalShares:       sdk.NewDecFromInt(depositAmount.Amount).String(),
-				TotalValue:        depositAmount.Amount,
+				Denom:               vaultDenom,
+				Strategies:          []types.StrategyType{types.STRATEGY_TYPE_HARD},
+				IsPrivateVault:      false,
+				AllowedDepositors:   nil,
+				TotalShares:         sdk.NewDecFromInt(depositAmount.Amount).String(),
+				TotalValue:          depositAmount.Amount,
+				DepositCap:          sdk.ZeroInt(),
+				AccountDepositLimit: sdk.ZeroInt(),
 			},
 			{
-				Denom:             vault2Denom,
-				Strategies:        []types.StrategyType{types.STRATEGY_TYPE_SAVINGS},
-				IsPrivateVault:    false,
-				AllowedDepositors: nil,
-				TotalShares:       sdk.NewDecFromInt(deposit2Amount.Amount).String(),
-				TotalValue:        deposit2Amount.Amount,
+				Denom:               vault2Denom,
+				Strategies:          []types.StrategyType{types.STRATEGY_TYPE_SAVINGS},
+				IsPrivateVault:      false,
+				AllowedDepositors:   nil,
+				TotalShares:         sdk.NewDecFromInt(deposit2Amount.Amount).String(),
+				TotalValue:          deposit2Amount.Amount,
+				DepositCap:          sdk.ZeroInt(),
+				AccountDepositLimit: sdk.ZeroInt(),
 			},
 		},
 		res.Vaults,
@@ -185,28 +195,34 @@ func (suite *grpcQueryTestSuite) TestVaults_MixedSupply() {
 	suite.Require().ElementsMatch(
 		[]types.VaultResponse{
 			{
-				Denom:             vaultDenom,
-				Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD},
-				IsPrivateVault:    false,
-				AllowedDepositors: nil,
-				TotalShares:       sdk.ZeroDec().String(),
-				TotalValue:        sdk.ZeroInt(),
+				Denom:               vaultDenom,
+				Strategies:          []types.StrategyType{types.STRATEGY_TYPE_HARD},
+				IsPrivateVault:      false,
+				AllowedDepositors:   nil,
+				TotalShares:         sdk.ZeroDec().String(),
+				TotalValue:          sdk.ZeroInt(),
+				DepositCap:          sdk.ZeroInt(),
+				AccountDepositLimit: sdk.ZeroInt(),
 			},
 			{
-				Denom:             vault2Denom,
-				Strategies:        []types.StrategyType{types.STRATEGY_TYPE_HARD},
-				IsPrivateVault:    false,
-				AllowedDepositors: nil,
-				TotalShares:       sdk.ZeroDec().String(),
-				TotalValue:        sdk.ZeroInt(),
+				Denom:               vault2Denom,
+				Strategies:          []types.StrategyType{types.STRATEGY_TYPE_HARD},
+				IsPrivateVault:      false,
+				AllowedDepositors:   nil,
+				TotalShares:         sdk.ZeroDec().String(),
+				TotalValue:          sdk.ZeroInt(),
+				DepositCap:          sdk.ZeroInt(),
+				AccountDepositLimit: sdk.ZeroInt(),
 			},
 			{
-				Denom:             vault3Denom,
-				Strategies:        []types.StrategyType{types.STRATEGY_TYPE_SAVINGS},
-				IsPrivateVault:    false,
-				AllowedDepositors: nil,
-				TotalShares:       sdk.NewDecFromInt(depositAmount.Amount).String(),
-				TotalValue:        depositAmount.Amount,
+				Denom:               vault3Denom,
+				Strategies:          []types.StrategyType{types.STRATEGY_TYPE_SAVINGS},
+				IsPrivateVault:      false,
+				AllowedDepositors:   nil,
+				TotalShares:         sdk.NewDecFromInt(depositAmount.Amount).String(),
+				TotalValue:          depositAmount.Amount,
+				DepositCap:          sdk.ZeroInt(),
+				AccountDepositLimit: sdk.ZeroInt(),
 			},
 		},
 		res.Vaults,
@@ -626,10 +642,12 @@ func (suite *grpcQueryTestSuite) TestVault_bKava_Single() {
 			Strategies: types.StrategyTypes{
 				types.STRATEGY_TYPE_SAVINGS,
 			},
-			IsPrivateVault:    false,
-			AllowedDepositors: []string(nil),
-			TotalShares:       "100.000000000000000000",
-			TotalValue:        sdkmath.NewInt(100),
+			IsPrivateVault:      false,
+			AllowedDepositors:   []string(nil),
+			TotalShares:         "100.000000000000000000",
+			TotalValue:          sdkmath.NewInt(100),
+			DepositCap:          sdk.ZeroInt(),
+			AccountDepositLimit: sdk.ZeroInt(),
 		},
 		res.Vault,
 	)
@@ -683,8 +701,10 @@ func (suite *grpcQueryTestSuite) TestVault_bKava_Aggregate() {
 			IsPrivateVault:    false,
 			AllowedDepositors: []string(nil),
 			// No shares for aggregate
-			TotalShares: "0",
-			TotalValue:  expectedValue,
+			TotalShares:         "0",
+			TotalValue:          expectedValue,
+			DepositCap:          sdk.ZeroInt(),
+			AccountDepositLimit: sdk.ZeroInt(),
 		},
 		res.Vault,
 	)
@@ -831,6 +851,191 @@ func (suite *grpcQueryTestSuite) TestTotalSupply() {
 }
 
 // createUnbondedValidator creates an unbonded validator with the given amount of self-delegation.
+func (suite *grpcQueryTestSuite) TestStrategies() {
+	suite.CreateVault("usdx", types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	suite.CreateVault("ukava", types.StrategyTypes{types.STRATEGY_TYPE_SAVINGS}, false, nil)
+	// hard does not have a money market for the hard denom
+	suite.CreateVault("hard", types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	res, err := suite.queryClient.Strategies(context.Background(), &types.QueryStrategiesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(
+		[]types.StrategyResponse{
+			{
+				StrategyType: types.STRATEGY_TYPE_HARD,
+				Vaults: []types.StrategyVaultHealth{
+					{Denom: "usdx", Healthy: true},
+					{Denom: "hard", Healthy: false, Error: "hard money market for hard not found"},
+				},
+			},
+			{
+				StrategyType: types.STRATEGY_TYPE_SAVINGS,
+				Vaults: []types.StrategyVaultHealth{
+					{Denom: "ukava", Healthy: true},
+				},
+			},
+		},
+		res.Strategies,
+	)
+}
+
+func (suite *grpcQueryTestSuite) TestVaultMetrics() {
+	strategy := &externalStrategy{}
+	suite.Keeper.RegisterStrategy(strategy)
+
+	suite.CreateVault("busd", types.StrategyTypes{externalStrategyType}, false, nil)
+	suite.CreateVault("usdx", types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	depositAmount := sdk.NewInt64Coin("busd", 100)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, externalStrategyType)
+	suite.Require().NoError(err)
+
+	// strategy earns yield, increasing the share price
+	strategy.deposits = strategy.deposits.Add(sdk.NewInt64Coin("busd", 10))
+
+	busdMetrics := types.VaultMetrics{
+		Denom:       "busd",
+		TotalShares: sdk.NewDec(100),
+		TotalValue:  sdkmath.NewInt(110),
+		SharePrice:  sdk.MustNewDecFromStr("1.1"),
+		Allocations: []types.StrategyAllocation{
+			{StrategyType: externalStrategyType, Amount: sdkmath.NewInt(110), Apy: sdk.MustNewDecFromStr("0.05")},
+		},
+		Apy: sdk.MustNewDecFromStr("0.05"),
+	}
+	usdxMetrics := types.VaultMetrics{
+		Denom:       "usdx",
+		TotalShares: sdk.ZeroDec(),
+		TotalValue:  sdk.ZeroInt(),
+		SharePrice:  sdk.OneDec(),
+		Allocations: []types.StrategyAllocation{
+			{StrategyType: types.STRATEGY_TYPE_HARD, Amount: sdk.ZeroInt(), Apy: sdk.ZeroDec()},
+		},
+		Apy: sdk.ZeroDec(),
+	}
+
+	suite.Run("all", func() {
+		res, err := suite.queryClient.VaultMetrics(context.Background(), &types.QueryVaultMetricsRequest{})
+		suite.Require().NoError(err)
+		suite.Require().Equal([]types.VaultMetrics{busdMetrics, usdxMetrics}, res.Vaults)
+	})
+
+	suite.Run("single", func() {
+		res, err := suite.queryClient.VaultMetrics(context.Background(), &types.QueryVaultMetricsRequest{Denom: "usdx"})
+		suite.Require().NoError(err)
+		suite.Require().Equal([]types.VaultMetrics{usdxMetrics}, res.Vaults)
+	})
+
+	suite.Run("not found", func() {
+		_, err := suite.queryClient.VaultMetrics(context.Background(), &types.QueryVaultMetricsRequest{Denom: "ukava"})
+		suite.Require().Error(err)
+		suite.Require().ErrorIs(err, status.Errorf(codes.NotFound, "vault not found with specified denom"))
+	})
+}
+
+func (suite *grpcQueryTestSuite) TestPendingWithdrawals() {
+	suite.Keeper.RegisterStrategy(&externalStrategy{})
+
+	suite.CreateVault("usdx", types.StrategyTypes{externalStrategyType}, false, nil)
+	suite.CreateVault("busd", types.StrategyTypes{externalStrategyType}, false, nil)
+	suite.SetVaultWithdrawalDelay("usdx", 10)
+	suite.SetVaultWithdrawalDelay("busd", 10)
+
+	startBalance := sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000), sdk.NewInt64Coin("busd", 1000))
+	acc1 := suite.CreateAccount(startBalance, 0).GetAddress()
+	acc2 := suite.CreateAccount(startBalance, 1).GetAddress()
+
+	for _, acc := range []sdk.AccAddress{acc1, acc2} {
+		for _, coin := range startBalance {
+			err := suite.Keeper.Deposit(suite.Ctx, acc, coin, externalStrategyType)
+			suite.Require().NoError(err)
+		}
+	}
+
+	_, err := suite.Keeper.RequestWithdrawal(suite.Ctx, acc1, sdk.NewInt64Coin("usdx", 100), externalStrategyType)
+	suite.Require().NoError(err)
+	_, err = suite.Keeper.RequestWithdrawal(suite.Ctx, acc1, sdk.NewInt64Coin("busd", 200), externalStrategyType)
+	suite.Require().NoError(err)
+	_, err = suite.Keeper.RequestWithdrawal(suite.Ctx, acc2, sdk.NewInt64Coin("usdx", 300), externalStrategyType)
+	suite.Require().NoError(err)
+
+	releaseHeight := suite.Ctx.BlockHeight() + 10
+	withdrawal1 := types.PendingWithdrawalResponse{
+		ID:            1,
+		Owner:         acc1.String(),
+		Amount:        sdk.NewInt64Coin("usdx", 100),
+		ReleaseHeight: releaseHeight,
+	}
+	withdrawal2 := types.PendingWithdrawalResponse{
+		ID:            2,
+		Owner:         acc1.String(),
+		Amount:        sdk.NewInt64Coin("busd", 200),
+		ReleaseHeight: releaseHeight,
+	}
+	withdrawal3 := types.PendingWithdrawalResponse{
+		ID:            3,
+		Owner:         acc2.String(),
+		Amount:        sdk.NewInt64Coin("usdx", 300),
+		ReleaseHeight: releaseHeight,
+	}
+
+	suite.Run("all", func() {
+		res, err := suite.queryClient.PendingWithdrawals(context.Background(), &types.QueryPendingWithdrawalsRequest{})
+		suite.Require().NoError(err)
+		suite.Require().Equal(
+			[]types.PendingWithdrawalResponse{withdrawal1, withdrawal2, withdrawal3},
+			res.PendingWithdrawals,
+		)
+	})
+
+	suite.Run("owner", func() {
+		res, err := suite.queryClient.PendingWithdrawals(context.Background(), &types.QueryPendingWithdrawalsRequest{
+			Owner: acc1.String(),
+		})
+		suite.Require().NoError(err)
+		suite.Require().Equal(
+			[]types.PendingWithdrawalResponse{withdrawal1, withdrawal2},
+			res.PendingWithdrawals,
+		)
+	})
+
+	suite.Run("owner and denom", func() {
+		res, err := suite.queryClient.PendingWithdrawals(context.Background(), &types.QueryPendingWithdrawalsRequest{
+			Owner: acc1.String(),
+			Denom: "usdx",
+		})
+		suite.Require().NoError(err)
+		suite.Require().Equal(
+			[]types.PendingWithdrawalResponse{withdrawal1},
+			res.PendingWithdrawals,
+		)
+	})
+
+	suite.Run("released", func() {
+		suite.Keeper.ReleasePendingWithdrawals(suite.Ctx.WithBlockHeight(releaseHeight))
+
+		res, err := suite.queryClient.PendingWithdrawals(context.Background(), &types.QueryPendingWithdrawalsRequest{
+			Denom: "usdx",
+		})
+		suite.Require().NoError(err)
+
+		withdrawal1.Released = true
+		withdrawal3.Released = true
+		suite.Require().Equal(
+			[]types.PendingWithdrawalResponse{withdrawal1, withdrawal3},
+			res.PendingWithdrawals,
+		)
+	})
+
+	suite.Run("invalid owner", func() {
+		_, err := suite.queryClient.PendingWithdrawals(context.Background(), &types.QueryPendingWithdrawalsRequest{
+			Owner: "invalid",
+		})
+		suite.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "Invalid address"))
+	})
+}
+
 func (suite *grpcQueryTestSuite) createUnbondedValidator(address sdk.ValAddress, selfDelegation sdk.Coin, minSelfDelegation sdkmath.Int) error {
 	msg, err := stakingtypes.NewMsgCreateValidator(
 		address,
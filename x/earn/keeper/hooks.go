@@ -32,3 +32,16 @@ func (k Keeper) BeforeVaultDepositModified(
 		k.hooks.BeforeVaultDepositModified(ctx, vaultDenom, depositor, sharesOwned)
 	}
 }
+
+// AfterVaultSharesModified - call hook if registered
+func (k Keeper) AfterVaultSharesModified(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	vaultDenom string,
+	oldShares sdk.Dec,
+	newShares sdk.Dec,
+) {
+	if k.hooks != nil {
+		k.hooks.AfterVaultSharesModified(ctx, owner, vaultDenom, oldShares, newShares)
+	}
+}
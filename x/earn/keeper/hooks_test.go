@@ -30,6 +30,9 @@ func (suite *hookTestSuite) TestHooks_DepositAndWithdraw() {
 	earnHooks := mocks.NewEarnHooks(suite.T())
 	suite.Keeper.SetHooks(earnHooks)
 
+	// share changes are covered by TestHooks_AfterVaultSharesModified
+	earnHooks.On("AfterVaultSharesModified", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
 	vault1Denom := "usdx"
 	vault2Denom := "ukava"
 	acc1deposit1Amount := sdk.NewInt64Coin(vault1Denom, 100)
@@ -499,6 +502,7 @@ func (suite *hookTestSuite) TestHooks_HookOrdering() {
 	suite.Keeper.ClearHooks()
 	earnHooks := &mocks.EarnHooks{}
 	suite.Keeper.SetHooks(earnHooks)
+	earnHooks.On("AfterVaultSharesModified", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
 
 	vaultDenom := "usdx"
 	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
@@ -537,3 +541,51 @@ func (suite *hookTestSuite) TestHooks_HookOrdering() {
 	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
 	suite.Require().NoError(err)
 }
+
+func (suite *hookTestSuite) TestHooks_AfterVaultSharesModified() {
+	suite.Keeper.ClearHooks()
+	earnHooks := mocks.NewEarnHooks(suite.T())
+	suite.Keeper.SetHooks(earnHooks)
+
+	earnHooks.On("AfterVaultDepositCreated", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	earnHooks.On("BeforeVaultDepositModified", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+	depositAmount := sdk.NewInt64Coin(vaultDenom, 100)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+
+	// first deposit - shares are 1:1
+	earnHooks.On("AfterVaultSharesModified", suite.Ctx, acc.GetAddress(), vaultDenom, sdk.ZeroDec(), sdk.NewDec(100)).
+		Run(func(args mock.Arguments) {
+			shares, found := suite.Keeper.GetVaultAccountShares(suite.Ctx, acc.GetAddress())
+			suite.Require().True(found, "expected hook to be called after shares are updated")
+			suite.Require().Equal(sdk.NewDec(100), shares.AmountOf(vaultDenom))
+		}).
+		Once()
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	// second deposit
+	earnHooks.On("AfterVaultSharesModified", suite.Ctx, acc.GetAddress(), vaultDenom, sdk.NewDec(100), sdk.NewDec(200)).Once()
+	err = suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	// partial withdraw
+	earnHooks.On("AfterVaultSharesModified", suite.Ctx, acc.GetAddress(), vaultDenom, sdk.NewDec(200), sdk.NewDec(100)).Once()
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	// full withdraw removes all shares
+	earnHooks.On("AfterVaultSharesModified", suite.Ctx, acc.GetAddress(), vaultDenom, sdk.NewDec(100), sdk.ZeroDec()).
+		Run(func(args mock.Arguments) {
+			_, found := suite.Keeper.GetVaultAccountShares(suite.Ctx, acc.GetAddress())
+			suite.Require().False(found, "expected hook to be called after shares are removed")
+		}).
+		Once()
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+}
@@ -1,8 +1,12 @@
 package keeper
 
 import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/kava-labs/kava/x/earn/types"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -24,6 +28,15 @@ type Keeper struct {
 
 	// Keeper for community pool transfers
 	distKeeper types.DistributionKeeper
+
+	// Keeper for swapping incentive rewards into vault denoms
+	swapKeeper types.SwapKeeper
+
+	// strategies are the registered strategies by strategy type
+	strategies map[types.StrategyType]Strategy
+
+	// the address capable of executing governance operations such as migrating vaults. Usually the gov module account.
+	authority sdk.AccAddress
 }
 
 // NewKeeper creates a new keeper
@@ -37,12 +50,17 @@ func NewKeeper(
 	hardKeeper types.HardKeeper,
 	savingsKeeper types.SavingsKeeper,
 	distKeeper types.DistributionKeeper,
+	swapKeeper types.SwapKeeper,
+	authority sdk.AccAddress,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
 	}
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", err))
+	}
 
-	return Keeper{
+	k := Keeper{
 		key:           key,
 		cdc:           cdc,
 		paramSubspace: paramstore,
@@ -52,7 +70,22 @@ func NewKeeper(
 		hardKeeper:    hardKeeper,
 		savingsKeeper: savingsKeeper,
 		distKeeper:    distKeeper,
+		swapKeeper:    swapKeeper,
+		strategies:    make(map[types.StrategyType]Strategy),
+		authority:     authority,
 	}
+
+	// The built in strategies only use the keepers above, which are shared by
+	// all copies of the keeper.
+	k.RegisterStrategy((*HardStrategy)(&k))
+	k.RegisterStrategy((*SavingsStrategy)(&k))
+
+	return k
+}
+
+// GetAuthority returns the earn module's authority.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
 }
 
 // SetHooks adds hooks to the keeper.
@@ -68,3 +101,8 @@ func (k *Keeper) SetHooks(sh types.EarnHooks) *Keeper {
 func (k *Keeper) ClearHooks() {
 	k.hooks = nil
 }
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
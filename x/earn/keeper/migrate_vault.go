@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+// vaultMigrationState is the state of a single vault denom before migration,
+// used to report the change in value caused by the migration.
+type vaultMigrationState struct {
+	denom       string
+	totalShares sdk.Dec
+	value       sdk.Coin
+	sharePrice  sdk.Dec
+}
+
+// MigrateVault moves all funds of a vault from its current strategy to
+// another strategy and updates the vault params to use the new strategy.
+// Vault shares are unchanged, so any difference between the amount withdrawn
+// and the amount held by the new strategy is reflected in the share price.
+// For the bkava vault, the funds of every bkava derivative denom are moved.
+func (k *Keeper) MigrateVault(
+	ctx sdk.Context,
+	vaultDenom string,
+	fromStrategyType types.StrategyType,
+	toStrategyType types.StrategyType,
+) (sdk.Coins, error) {
+	allowedVault, found := k.getAllowedVaultRaw(ctx, vaultDenom)
+	if !found {
+		return nil, types.ErrInvalidVaultDenom
+	}
+
+	if !allowedVault.IsStrategyAllowed(fromStrategyType) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidVaultStrategy,
+			"%s vault does not use strategy %s",
+			vaultDenom,
+			fromStrategyType,
+		)
+	}
+
+	if allowedVault.IsStrategyAllowed(toStrategyType) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidVaultStrategy,
+			"%s vault already uses strategy %s",
+			vaultDenom,
+			toStrategyType,
+		)
+	}
+
+	fromStrategy, err := k.GetStrategy(fromStrategyType)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidVaultStrategy, err.Error())
+	}
+
+	toStrategy, err := k.GetStrategy(toStrategyType)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidVaultStrategy, err.Error())
+	}
+
+	var before []vaultMigrationState
+	for _, record := range k.GetAllVaultRecords(ctx) {
+		denom := record.TotalShares.Denom
+		if recordVault, found := k.GetAllowedVault(ctx, denom); !found || recordVault.Denom != allowedVault.Denom {
+			continue
+		}
+
+		value, err := k.GetVaultTotalValue(ctx, denom)
+		if err != nil {
+			return nil, err
+		}
+
+		sharePrice, err := k.GetVaultSharePrice(ctx, denom)
+		if err != nil {
+			return nil, err
+		}
+
+		before = append(before, vaultMigrationState{
+			denom:       denom,
+			totalShares: record.TotalShares.Amount,
+			value:       value,
+			sharePrice:  sharePrice,
+		})
+	}
+
+	// All funds held by the strategy are moved, including unreleased pending
+	// withdrawals, as they are released from the vault's current strategy.
+	migrated := sdk.NewCoins()
+	for _, state := range before {
+		amount, err := fromStrategy.GetEstimatedTotalAssets(ctx, state.denom)
+		if err != nil {
+			return nil, err
+		}
+
+		if !amount.IsPositive() {
+			continue
+		}
+
+		if err := toStrategy.CheckHealth(ctx, state.denom); err != nil {
+			return nil, errorsmod.Wrap(types.ErrStrategyUnhealthy, err.Error())
+		}
+
+		if err := fromStrategy.Withdraw(ctx, amount); err != nil {
+			return nil, fmt.Errorf("failed to withdraw from strategy %s: %w", fromStrategyType, err)
+		}
+
+		if err := toStrategy.Deposit(ctx, amount); err != nil {
+			return nil, fmt.Errorf("failed to deposit to strategy %s: %w", toStrategyType, err)
+		}
+
+		migrated = migrated.Add(amount)
+	}
+
+	params := k.GetParams(ctx)
+	for i, vault := range params.AllowedVaults {
+		if vault.Denom != allowedVault.Denom {
+			continue
+		}
+
+		for j, strategyType := range vault.Strategies {
+			if strategyType == fromStrategyType {
+				params.AllowedVaults[i].Strategies[j] = toStrategyType
+			}
+		}
+	}
+	k.SetParams(ctx, params)
+
+	for _, state := range before {
+		value, err := k.GetVaultTotalValue(ctx, state.denom)
+		if err != nil {
+			return nil, err
+		}
+
+		sharePrice, err := k.GetVaultSharePrice(ctx, state.denom)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeVaultMigration,
+				sdk.NewAttribute(types.AttributeKeyVaultDenom, state.denom),
+				sdk.NewAttribute(types.AttributeKeyFromStrategy, fromStrategyType.String()),
+				sdk.NewAttribute(types.AttributeKeyToStrategy, toStrategyType.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, migrated.AmountOf(state.denom).String()),
+				sdk.NewAttribute(types.AttributeKeyTotalShares, state.totalShares.String()),
+				sdk.NewAttribute(types.AttributeKeyValueBefore, state.value.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyValueAfter, value.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeySharePriceBefore, state.sharePrice.String()),
+				sdk.NewAttribute(types.AttributeKeySharePriceAfter, sharePrice.String()),
+			),
+		)
+	}
+
+	return migrated, nil
+}
@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/earn/testutil"
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+type migrateVaultTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *migrateVaultTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+	suite.Keeper.SetParams(suite.Ctx, types.DefaultParams())
+}
+
+func TestMigrateVaultTestSuite(t *testing.T) {
+	suite.Run(t, new(migrateVaultTestSuite))
+}
+
+func (suite *migrateVaultTestSuite) TestMigrateVault() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+	depositAmount := sdk.NewInt64Coin(vaultDenom, 100)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	acc1 := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+	acc2 := suite.CreateAccount(sdk.NewCoins(startBalance), 1)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc1.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+	err = suite.Keeper.Deposit(suite.Ctx, acc2.GetAddress(), depositAmount.AddAmount(depositAmount.Amount), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	totalDeposit := sdk.NewInt64Coin(vaultDenom, 300)
+	suite.HardDepositAmountEqual(sdk.NewCoins(totalDeposit))
+
+	migrated, err := suite.Keeper.MigrateVault(suite.Ctx, vaultDenom, types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewCoins(totalDeposit), migrated)
+
+	// Funds are moved to the new strategy
+	suite.HardDepositAmountEqual(sdk.NewCoins())
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(totalDeposit))
+	suite.VaultTotalValuesEqual(sdk.NewCoins(totalDeposit))
+
+	allowedVault, found := suite.Keeper.GetAllowedVault(suite.Ctx, vaultDenom)
+	suite.Require().True(found)
+	suite.Equal(types.StrategyTypes{types.STRATEGY_TYPE_SAVINGS}, allowedVault.Strategies)
+
+	// Shares are unchanged
+	suite.VaultTotalSharesEqual(types.NewVaultShares(types.NewVaultShare(vaultDenom, sdk.NewDec(300))))
+	shares, found := suite.Keeper.GetVaultAccountShares(suite.Ctx, acc1.GetAddress())
+	suite.Require().True(found)
+	suite.Equal(types.NewVaultShares(types.NewVaultShare(vaultDenom, sdk.NewDec(100))), shares)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeVaultMigration,
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, vaultDenom),
+		sdk.NewAttribute(types.AttributeKeyFromStrategy, types.STRATEGY_TYPE_HARD.String()),
+		sdk.NewAttribute(types.AttributeKeyToStrategy, types.STRATEGY_TYPE_SAVINGS.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, totalDeposit.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyTotalShares, sdk.NewDec(300).String()),
+		sdk.NewAttribute(types.AttributeKeyValueBefore, totalDeposit.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyValueAfter, totalDeposit.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeySharePriceBefore, sdk.OneDec().String()),
+		sdk.NewAttribute(types.AttributeKeySharePriceAfter, sdk.OneDec().String()),
+	))
+
+	// Deposits and withdrawals use the new strategy
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc1.GetAddress(), depositAmount, types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().NoError(err)
+	suite.AccountBalanceEqual(acc1.GetAddress(), sdk.NewCoins(startBalance))
+
+	err = suite.Keeper.Deposit(suite.Ctx, acc1.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().ErrorIs(err, types.ErrInvalidVaultStrategy)
+}
+
+func (suite *migrateVaultTestSuite) TestMigrateVault_Empty() {
+	vaultDenom := "usdx"
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	migrated, err := suite.Keeper.MigrateVault(suite.Ctx, vaultDenom, types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().NoError(err)
+	suite.True(migrated.IsZero())
+
+	allowedVault, found := suite.Keeper.GetAllowedVault(suite.Ctx, vaultDenom)
+	suite.Require().True(found)
+	suite.Equal(types.StrategyTypes{types.STRATEGY_TYPE_SAVINGS}, allowedVault.Strategies)
+}
+
+func (suite *migrateVaultTestSuite) TestMigrateVault_Invalid() {
+	suite.CreateVault("usdx", types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	_, err := suite.Keeper.MigrateVault(suite.Ctx, "busd", types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().ErrorIs(err, types.ErrInvalidVaultDenom)
+
+	_, err = suite.Keeper.MigrateVault(suite.Ctx, "usdx", types.STRATEGY_TYPE_SAVINGS, types.STRATEGY_TYPE_HARD)
+	suite.Require().ErrorIs(err, types.ErrInvalidVaultStrategy)
+	suite.Require().ErrorContains(err, "does not use strategy")
+
+	_, err = suite.Keeper.MigrateVault(suite.Ctx, "usdx", types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_HARD)
+	suite.Require().ErrorIs(err, types.ErrInvalidVaultStrategy)
+	suite.Require().ErrorContains(err, "already uses strategy")
+}
+
+func (suite *migrateVaultTestSuite) TestMigrateVault_UnhealthyStrategy() {
+	// savings does not support kava deposits
+	vaultDenom := "kava"
+	depositAmount := sdk.NewInt64Coin(vaultDenom, 100)
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	_, err = suite.Keeper.MigrateVault(suite.Ctx, vaultDenom, types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().ErrorIs(err, types.ErrStrategyUnhealthy)
+
+	allowedVault, found := suite.Keeper.GetAllowedVault(suite.Ctx, vaultDenom)
+	suite.Require().True(found)
+	suite.Equal(types.StrategyTypes{types.STRATEGY_TYPE_HARD}, allowedVault.Strategies)
+}
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/kava-labs/kava/x/earn/types"
 )
@@ -68,3 +70,110 @@ func (m msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*typ
 
 	return &types.MsgWithdrawResponse{}, nil
 }
+
+// RequestWithdrawal handles MsgRequestWithdrawal messages
+func (m msgServer) RequestWithdrawal(goCtx context.Context, msg *types.MsgRequestWithdrawal) (*types.MsgRequestWithdrawalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawal, err := m.keeper.RequestWithdrawal(ctx, from, msg.Amount, msg.Strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, from.String()),
+		),
+	)
+
+	return &types.MsgRequestWithdrawalResponse{
+		ID:            withdrawal.ID,
+		ReleaseHeight: withdrawal.ReleaseHeight,
+	}, nil
+}
+
+// ClaimWithdrawal handles MsgClaimWithdrawal messages
+func (m msgServer) ClaimWithdrawal(goCtx context.Context, msg *types.MsgClaimWithdrawal) (*types.MsgClaimWithdrawalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := m.keeper.ClaimWithdrawal(ctx, from, msg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, from.String()),
+		),
+	)
+
+	return &types.MsgClaimWithdrawalResponse{
+		Amount: amount,
+	}, nil
+}
+
+// SetAutoCompound handles MsgSetAutoCompound messages
+func (m msgServer) SetAutoCompound(goCtx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Enabled {
+		if err := m.keeper.EnableAutoCompound(ctx, depositor, msg.VaultDenom); err != nil {
+			return nil, err
+		}
+	} else {
+		m.keeper.DeleteAutoCompound(ctx, depositor)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, depositor.String()),
+		),
+	)
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
+// MigrateVault handles MsgMigrateVault messages. It can only be executed by
+// the module authority.
+func (m msgServer) MigrateVault(goCtx context.Context, msg *types.MsgMigrateVault) (*types.MsgMigrateVaultResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if m.keeper.GetAuthority().String() != msg.Authority {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority; expected %s, got %s",
+			m.keeper.GetAuthority(),
+			msg.Authority,
+		)
+	}
+
+	migrated, err := m.keeper.MigrateVault(ctx, msg.VaultDenom, msg.FromStrategy, msg.ToStrategy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMigrateVaultResponse{
+		Migrated: migrated,
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/kava-labs/kava/x/earn/keeper"
@@ -137,3 +138,100 @@ func (suite *msgServerTestSuite) TestWithdraw() {
 		),
 	)
 }
+
+func (suite *msgServerTestSuite) TestRequestAndClaimWithdrawal() {
+	vaultDenom := "usdx"
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	suite.SetVaultWithdrawalDelay(vaultDenom, 5)
+
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+	depositAmount := sdk.NewInt64Coin(vaultDenom, 100)
+
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+
+	msgDeposit := types.NewMsgDeposit(acc.GetAddress().String(), depositAmount, types.STRATEGY_TYPE_HARD)
+	_, err := suite.msgServer.Deposit(sdk.WrapSDKContext(suite.Ctx), msgDeposit)
+	suite.Require().NoError(err)
+
+	msgRequest := types.NewMsgRequestWithdrawal(acc.GetAddress().String(), depositAmount, types.STRATEGY_TYPE_HARD)
+	res, err := suite.msgServer.RequestWithdrawal(sdk.WrapSDKContext(suite.Ctx), msgRequest)
+	suite.Require().NoError(err)
+	suite.Equal(uint64(1), res.ID)
+	suite.Equal(suite.Ctx.BlockHeight()+5, res.ReleaseHeight)
+
+	// Msg server module
+	suite.EventsContains(
+		suite.GetEvents(),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, acc.GetAddress().String()),
+		),
+	)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(res.ReleaseHeight)
+	suite.Keeper.ReleasePendingWithdrawals(suite.Ctx)
+
+	msgClaim := types.NewMsgClaimWithdrawal(acc.GetAddress().String(), res.ID)
+	claimRes, err := suite.msgServer.ClaimWithdrawal(sdk.WrapSDKContext(suite.Ctx), msgClaim)
+	suite.Require().NoError(err)
+	suite.Equal(depositAmount, claimRes.Amount)
+
+	// Bank: Send withdrawal Module account -> Account
+	suite.EventsContains(
+		suite.GetEvents(),
+		sdk.NewEvent(
+			banktypes.EventTypeTransfer,
+			sdk.NewAttribute(banktypes.AttributeKeyRecipient, acc.GetAddress().String()),
+			sdk.NewAttribute(banktypes.AttributeKeySender, moduleAccAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, depositAmount.String()),
+		),
+	)
+
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(startBalance))
+}
+
+func (suite *msgServerTestSuite) TestSetAutoCompound() {
+	vaultDenom := "usdx"
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	acc := suite.CreateAccount(sdk.NewCoins(), 0)
+
+	msgEnable := types.NewMsgSetAutoCompound(acc.GetAddress().String(), "busd", true)
+	_, err := suite.msgServer.SetAutoCompound(sdk.WrapSDKContext(suite.Ctx), msgEnable)
+	suite.Require().ErrorIs(err, types.ErrInvalidVaultDenom)
+
+	msgEnable = types.NewMsgSetAutoCompound(acc.GetAddress().String(), vaultDenom, true)
+	_, err = suite.msgServer.SetAutoCompound(sdk.WrapSDKContext(suite.Ctx), msgEnable)
+	suite.Require().NoError(err)
+
+	autoCompound, found := suite.Keeper.GetAutoCompound(suite.Ctx, acc.GetAddress())
+	suite.Require().True(found)
+	suite.Equal(vaultDenom, autoCompound.VaultDenom)
+
+	msgDisable := types.NewMsgSetAutoCompound(acc.GetAddress().String(), "", false)
+	_, err = suite.msgServer.SetAutoCompound(sdk.WrapSDKContext(suite.Ctx), msgDisable)
+	suite.Require().NoError(err)
+
+	_, found = suite.Keeper.GetAutoCompound(suite.Ctx, acc.GetAddress())
+	suite.Require().False(found)
+}
+
+func (suite *msgServerTestSuite) TestMigrateVault() {
+	vaultDenom := "usdx"
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	acc := suite.CreateAccount(sdk.NewCoins(), 0)
+
+	msg := types.NewMsgMigrateVault(acc.GetAddress().String(), vaultDenom, types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS)
+	_, err := suite.msgServer.MigrateVault(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	msg.Authority = suite.Keeper.GetAuthority().String()
+	_, err = suite.msgServer.MigrateVault(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+
+	allowedVault, found := suite.Keeper.GetAllowedVault(suite.Ctx, vaultDenom)
+	suite.Require().True(found)
+	suite.Equal(types.StrategyTypes{types.STRATEGY_TYPE_SAVINGS}, allowedVault.Strategies)
+}
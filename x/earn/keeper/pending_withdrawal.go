@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+// ----------------------------------------------------------------------------
+// PendingWithdrawal -- requested withdrawals of delayed vaults
+
+// GetNextWithdrawalID returns the id of the next pending withdrawal.
+func (k *Keeper) GetNextWithdrawalID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.key)
+
+	bz := store.Get(types.NextWithdrawalIDKey)
+	if bz == nil {
+		return types.DefaultNextWithdrawalID
+	}
+
+	return types.Uint64FromBytes(bz)
+}
+
+// SetNextWithdrawalID sets the id of the next pending withdrawal.
+func (k *Keeper) SetNextWithdrawalID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.NextWithdrawalIDKey, types.Uint64ToBytes(id))
+}
+
+// GetPendingWithdrawal returns the pending withdrawal for a given id.
+func (k *Keeper) GetPendingWithdrawal(
+	ctx sdk.Context,
+	id uint64,
+) (types.PendingWithdrawal, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PendingWithdrawalKeyPrefix)
+
+	bz := store.Get(types.PendingWithdrawalKey(id))
+	if bz == nil {
+		return types.PendingWithdrawal{}, false
+	}
+
+	var withdrawal types.PendingWithdrawal
+	k.cdc.MustUnmarshal(bz, &withdrawal)
+
+	return withdrawal, true
+}
+
+// SetPendingWithdrawal sets the pending withdrawal for a given id. Unreleased
+// withdrawals are indexed by release height to be released in the EndBlocker.
+func (k *Keeper) SetPendingWithdrawal(
+	ctx sdk.Context,
+	withdrawal types.PendingWithdrawal,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PendingWithdrawalKeyPrefix)
+	bz := k.cdc.MustMarshal(&withdrawal)
+	store.Set(types.PendingWithdrawalKey(withdrawal.ID), bz)
+
+	heightStore := prefix.NewStore(ctx.KVStore(k.key), types.PendingWithdrawalByHeightPrefix)
+	heightKey := types.PendingWithdrawalByHeightKey(withdrawal.ReleaseHeight, withdrawal.ID)
+	if withdrawal.Released {
+		heightStore.Delete(heightKey)
+	} else {
+		heightStore.Set(heightKey, types.Uint64ToBytes(withdrawal.ID))
+	}
+}
+
+// DeletePendingWithdrawal deletes the pending withdrawal for a given id.
+func (k *Keeper) DeletePendingWithdrawal(
+	ctx sdk.Context,
+	withdrawal types.PendingWithdrawal,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PendingWithdrawalKeyPrefix)
+	store.Delete(types.PendingWithdrawalKey(withdrawal.ID))
+
+	heightStore := prefix.NewStore(ctx.KVStore(k.key), types.PendingWithdrawalByHeightPrefix)
+	heightStore.Delete(types.PendingWithdrawalByHeightKey(withdrawal.ReleaseHeight, withdrawal.ID))
+}
+
+// IteratePendingWithdrawals iterates over all pending withdrawals in the store
+// in order of id and performs a callback function.
+func (k Keeper) IteratePendingWithdrawals(
+	ctx sdk.Context,
+	cb func(withdrawal types.PendingWithdrawal) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PendingWithdrawalKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var withdrawal types.PendingWithdrawal
+		k.cdc.MustUnmarshal(iterator.Value(), &withdrawal)
+		if cb(withdrawal) {
+			break
+		}
+	}
+}
+
+// IterateMaturedPendingWithdrawals iterates over the unreleased pending
+// withdrawals with a release height at or below the given height, in order of
+// release height, and performs a callback function.
+func (k Keeper) IterateMaturedPendingWithdrawals(
+	ctx sdk.Context,
+	height int64,
+	cb func(withdrawal types.PendingWithdrawal) (stop bool),
+) {
+	heightStore := prefix.NewStore(ctx.KVStore(k.key), types.PendingWithdrawalByHeightPrefix)
+	iterator := heightStore.Iterator(nil, sdk.PrefixEndBytes(types.PendingWithdrawalHeightPrefix(height)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		withdrawal, found := k.GetPendingWithdrawal(ctx, types.Uint64FromBytes(iterator.Value()))
+		if !found {
+			panic("pending withdrawal height index refers to a missing withdrawal")
+		}
+		if cb(withdrawal) {
+			break
+		}
+	}
+}
+
+// GetAllPendingWithdrawals returns all pending withdrawals from the store.
+func (k Keeper) GetAllPendingWithdrawals(ctx sdk.Context) types.PendingWithdrawals {
+	var withdrawals types.PendingWithdrawals
+
+	k.IteratePendingWithdrawals(ctx, func(withdrawal types.PendingWithdrawal) bool {
+		withdrawals = append(withdrawals, withdrawal)
+		return false
+	})
+
+	return withdrawals
+}
+
+// GetPendingWithdrawalTotal returns the total amount of unreleased pending
+// withdrawals of a vault denom. These funds are still held by the vault
+// strategy but no longer belong to the vault share holders.
+func (k *Keeper) GetPendingWithdrawalTotal(ctx sdk.Context, denom string) sdk.Int {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PendingWithdrawalTotalKeyPrefix)
+
+	bz := store.Get(types.VaultKey(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var total sdk.Int
+	if err := total.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return total
+}
+
+// SetPendingWithdrawalTotal sets the total amount of unreleased pending
+// withdrawals of a vault denom, deleting it if zero.
+func (k *Keeper) SetPendingWithdrawalTotal(ctx sdk.Context, denom string, total sdk.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PendingWithdrawalTotalKeyPrefix)
+
+	if total.IsZero() {
+		store.Delete(types.VaultKey(denom))
+		return
+	}
+
+	bz, err := total.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(types.VaultKey(denom), bz)
+}
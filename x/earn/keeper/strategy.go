@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/kava-labs/kava/x/earn/types"
@@ -25,16 +26,51 @@ type Strategy interface {
 
 	// Withdraw the specified amount of coins from this strategy.
 	Withdraw(ctx sdk.Context, amount sdk.Coin) error
+
+	// CheckHealth returns an error if the strategy can not currently accept
+	// deposits of the specified denom.
+	CheckHealth(ctx sdk.Context, denom string) error
+
+	// GetEstimatedAPY returns the current estimated APY of deposits of the
+	// specified denom in this strategy, excluding any incentive rewards.
+	GetEstimatedAPY(ctx sdk.Context, denom string) (sdk.Dec, error)
+}
+
+// RegisterStrategy adds a strategy to the keeper so vaults can use its
+// strategy type. The strategy type must be valid, so external strategies must
+// first register their type with types.RegisterStrategyType. Panics if a
+// strategy is already registered for the type.
+func (k *Keeper) RegisterStrategy(strategy Strategy) *Keeper {
+	strategyType := strategy.GetStrategyType()
+	if !strategyType.IsValid() {
+		panic(fmt.Sprintf("cannot register strategy with invalid type %s", strategyType))
+	}
+	if _, found := k.strategies[strategyType]; found {
+		panic(fmt.Sprintf("cannot register strategy %s twice", strategyType))
+	}
+	k.strategies[strategyType] = strategy
+	return k
 }
 
 // GetStrategy returns the strategy for the given strategy type.
 func (k *Keeper) GetStrategy(strategyType types.StrategyType) (Strategy, error) {
-	switch strategyType {
-	case types.STRATEGY_TYPE_HARD:
-		return (*HardStrategy)(k), nil
-	case types.STRATEGY_TYPE_SAVINGS:
-		return (*SavingsStrategy)(k), nil
-	default:
+	strategy, found := k.strategies[strategyType]
+	if !found {
 		return nil, fmt.Errorf("unknown strategy type: %s", strategyType)
 	}
+
+	return strategy, nil
+}
+
+// GetStrategies returns all registered strategies ordered by strategy type.
+func (k *Keeper) GetStrategies() []Strategy {
+	strategies := make([]Strategy, 0, len(k.strategies))
+	for _, strategy := range k.strategies {
+		strategies = append(strategies, strategy)
+	}
+	sort.Slice(strategies, func(i, j int) bool {
+		return strategies[i].GetStrategyType() < strategies[j].GetStrategyType()
+	})
+
+	return strategies
 }
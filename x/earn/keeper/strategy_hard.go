@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/kava-labs/kava/x/earn/types"
 )
@@ -47,3 +49,25 @@ func (s *HardStrategy) Withdraw(ctx sdk.Context, amount sdk.Coin) error {
 	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	return s.hardKeeper.Withdraw(ctx, macc.GetAddress(), sdk.NewCoins(amount))
 }
+
+// CheckHealth returns an error if hard does not have a money market for the
+// denom.
+func (s *HardStrategy) CheckHealth(ctx sdk.Context, denom string) error {
+	if _, found := s.hardKeeper.GetMoneyMarket(ctx, denom); !found {
+		return fmt.Errorf("hard money market for %s not found", denom)
+	}
+
+	return nil
+}
+
+// GetEstimatedAPY returns the current supply APY of the hard money market for
+// the denom, or zero if hard does not have a money market for the denom.
+func (s *HardStrategy) GetEstimatedAPY(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	moneyMarket, found := s.hardKeeper.GetMoneyMarket(ctx, denom)
+	if !found {
+		return sdk.ZeroDec(), nil
+	}
+
+	_, supplyAPY, err := s.hardKeeper.GetInterestRates(ctx, moneyMarket)
+	return supplyAPY, err
+}
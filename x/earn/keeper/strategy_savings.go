@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/kava-labs/kava/x/earn/types"
 )
@@ -47,3 +49,18 @@ func (s *SavingsStrategy) Withdraw(ctx sdk.Context, amount sdk.Coin) error {
 	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	return s.savingsKeeper.Withdraw(ctx, macc.GetAddress(), sdk.NewCoins(amount))
 }
+
+// CheckHealth returns an error if savings does not support the denom.
+func (s *SavingsStrategy) CheckHealth(ctx sdk.Context, denom string) error {
+	if !s.savingsKeeper.IsDenomSupported(ctx, denom) {
+		return fmt.Errorf("savings does not support %s", denom)
+	}
+
+	return nil
+}
+
+// GetEstimatedAPY returns zero, as savings deposits do not earn interest. Any
+// yield of savings deposits is paid as incentive rewards.
+func (s *SavingsStrategy) GetEstimatedAPY(_ sdk.Context, _ string) (sdk.Dec, error) {
+	return sdk.ZeroDec(), nil
+}
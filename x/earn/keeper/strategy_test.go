@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/earn/keeper"
+	"github.com/kava-labs/kava/x/earn/testutil"
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+// externalStrategyType is the type of the external test strategy
+const externalStrategyType = types.StrategyType(100)
+
+func init() {
+	types.RegisterStrategyType(externalStrategyType, "external")
+}
+
+// externalStrategy is a strategy registered at app wiring time that holds
+// deposits in the earn module account
+type externalStrategy struct {
+	deposits  sdk.Coins
+	unhealthy bool
+	locked    bool
+}
+
+var _ keeper.Strategy = (*externalStrategy)(nil)
+
+func (s *externalStrategy) GetStrategyType() types.StrategyType {
+	return externalStrategyType
+}
+
+func (s *externalStrategy) GetEstimatedTotalAssets(_ sdk.Context, denom string) (sdk.Coin, error) {
+	return sdk.NewCoin(denom, s.deposits.AmountOf(denom)), nil
+}
+
+func (s *externalStrategy) Deposit(_ sdk.Context, amount sdk.Coin) error {
+	s.deposits = s.deposits.Add(amount)
+	return nil
+}
+
+func (s *externalStrategy) Withdraw(_ sdk.Context, amount sdk.Coin) error {
+	if s.locked {
+		return errors.New("external strategy liquidity is locked")
+	}
+	s.deposits = s.deposits.Sub(amount)
+	return nil
+}
+
+func (s *externalStrategy) GetEstimatedAPY(_ sdk.Context, _ string) (sdk.Dec, error) {
+	return sdk.MustNewDecFromStr("0.05"), nil
+}
+
+func (s *externalStrategy) CheckHealth(_ sdk.Context, _ string) error {
+	if s.unhealthy {
+		return errors.New("external strategy is paused")
+	}
+	return nil
+}
+
+type strategyTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *strategyTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+	suite.Keeper.SetParams(suite.Ctx, types.DefaultParams())
+}
+
+func TestStrategyTestSuite(t *testing.T) {
+	suite.Run(t, new(strategyTestSuite))
+}
+
+func (suite *strategyTestSuite) TestGetStrategies() {
+	strategies := suite.Keeper.GetStrategies()
+	suite.Require().Len(strategies, 2)
+	suite.Equal(types.STRATEGY_TYPE_HARD, strategies[0].GetStrategyType())
+	suite.Equal(types.STRATEGY_TYPE_SAVINGS, strategies[1].GetStrategyType())
+
+	_, err := suite.Keeper.GetStrategy(externalStrategyType)
+	suite.Require().EqualError(err, "unknown strategy type: 100")
+}
+
+func (suite *strategyTestSuite) TestRegisterStrategy() {
+	strategy := &externalStrategy{}
+	suite.Keeper.RegisterStrategy(strategy)
+
+	registered, err := suite.Keeper.GetStrategy(externalStrategyType)
+	suite.Require().NoError(err)
+	suite.Equal(strategy, registered)
+	suite.Len(suite.Keeper.GetStrategies(), 3)
+
+	suite.PanicsWithValue("cannot register strategy 100 twice", func() {
+		suite.Keeper.RegisterStrategy(&externalStrategy{})
+	})
+	suite.PanicsWithValue("cannot register strategy with invalid type 101", func() {
+		suite.Keeper.RegisterStrategy(&unregisteredStrategy{})
+	})
+}
+
+func (suite *strategyTestSuite) TestDeposit_ExternalStrategy() {
+	strategy := &externalStrategy{}
+	suite.Keeper.RegisterStrategy(strategy)
+
+	vaultDenom := "usdx"
+	depositAmount := sdk.NewInt64Coin(vaultDenom, 100)
+	suite.CreateVault(vaultDenom, types.StrategyTypes{externalStrategyType}, false, nil)
+
+	acc := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin(vaultDenom, 1000)), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, externalStrategyType)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewCoins(depositAmount), strategy.deposits)
+	suite.VaultTotalValuesEqual(sdk.NewCoins(depositAmount))
+
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), depositAmount, externalStrategyType)
+	suite.Require().NoError(err)
+	suite.True(strategy.deposits.IsZero())
+}
+
+func (suite *strategyTestSuite) TestDeposit_StrategyUnhealthy() {
+	strategy := &externalStrategy{unhealthy: true}
+	suite.Keeper.RegisterStrategy(strategy)
+
+	suite.CreateVault("usdx", types.StrategyTypes{externalStrategyType}, false, nil)
+	// hard does not have a ukava money market
+	suite.CreateVault("ukava", types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	startBalance := sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000), sdk.NewInt64Coin("ukava", 1000))
+	acc := suite.CreateAccount(startBalance, 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("usdx", 100), externalStrategyType)
+	suite.Require().ErrorIs(err, types.ErrStrategyUnhealthy)
+	suite.Require().ErrorContains(err, "external strategy is paused")
+
+	err = suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("ukava", 100), types.STRATEGY_TYPE_HARD)
+	suite.Require().ErrorIs(err, types.ErrStrategyUnhealthy)
+	suite.Require().ErrorContains(err, "hard money market for ukava not found")
+
+	suite.AccountBalanceEqual(acc.GetAddress(), startBalance)
+}
+
+// unregisteredStrategy is a strategy with a type that is not registered
+type unregisteredStrategy struct {
+	externalStrategy
+}
+
+func (s *unregisteredStrategy) GetStrategyType() types.StrategyType {
+	return types.StrategyType(101)
+}
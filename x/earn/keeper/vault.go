@@ -26,6 +26,9 @@ func (k *Keeper) GetVaultTotalShares(
 // **Note:** This does not include the tokens held in bank by the module
 // account. If it were to be included, also note that the module account is
 // unblocked and can receive funds from bank sends.
+//
+// Unreleased pending withdrawals are still held by the strategy but are
+// excluded, as they no longer belong to the vault share holders.
 func (k *Keeper) GetVaultTotalValue(
 	ctx sdk.Context,
 	denom string,
@@ -41,7 +44,17 @@ func (k *Keeper) GetVaultTotalValue(
 	}
 
 	// Denom can be different from allowedVault.Denom for bkava
-	return strategy.GetEstimatedTotalAssets(ctx, denom)
+	totalAssets, err := strategy.GetEstimatedTotalAssets(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	pendingTotal := k.GetPendingWithdrawalTotal(ctx, denom)
+	if pendingTotal.GTE(totalAssets.Amount) {
+		return sdk.NewCoin(denom, sdk.ZeroInt()), nil
+	}
+
+	return totalAssets.SubAmount(pendingTotal), nil
 }
 
 // GetVaultAccountShares returns the shares for a single address for all vaults.
@@ -71,3 +84,77 @@ func (k *Keeper) GetVaultAccountValue(
 
 	return k.ConvertToAssets(ctx, accShares.GetShare(denom))
 }
+
+// GetVaultMetrics returns the share price, total value, strategy allocations
+// and estimated APY of a vault. The denom may be the full denom of a bkava
+// vault.
+func (k *Keeper) GetVaultMetrics(
+	ctx sdk.Context,
+	denom string,
+) (types.VaultMetrics, error) {
+	allowedVault, found := k.GetAllowedVault(ctx, denom)
+	if !found {
+		return types.VaultMetrics{}, types.ErrInvalidVaultDenom
+	}
+
+	totalShares := sdk.ZeroDec()
+	if shares, found := k.GetVaultTotalShares(ctx, denom); found {
+		totalShares = shares.Amount
+	}
+
+	sharePrice, err := k.GetVaultSharePrice(ctx, denom)
+	if err != nil {
+		return types.VaultMetrics{}, err
+	}
+
+	totalValue, err := k.GetVaultTotalValue(ctx, denom)
+	if err != nil {
+		return types.VaultMetrics{}, err
+	}
+
+	// Allocations include unreleased pending withdrawals, as they are still
+	// held by the strategies.
+	totalAllocated := sdk.ZeroInt()
+	weightedAPY := sdk.ZeroDec()
+	allocations := []types.StrategyAllocation{}
+	for _, strategyType := range allowedVault.Strategies {
+		strategy, err := k.GetStrategy(strategyType)
+		if err != nil {
+			return types.VaultMetrics{}, types.ErrInvalidVaultStrategy
+		}
+
+		amount, err := strategy.GetEstimatedTotalAssets(ctx, denom)
+		if err != nil {
+			return types.VaultMetrics{}, err
+		}
+
+		apy, err := strategy.GetEstimatedAPY(ctx, denom)
+		if err != nil {
+			return types.VaultMetrics{}, err
+		}
+
+		allocations = append(allocations, types.StrategyAllocation{
+			StrategyType: strategyType,
+			Amount:       amount.Amount,
+			Apy:          apy,
+		})
+		totalAllocated = totalAllocated.Add(amount.Amount)
+		weightedAPY = weightedAPY.Add(apy.MulInt(amount.Amount))
+	}
+
+	// Empty vaults use the APY of the first strategy, which new deposits are
+	// made to.
+	vaultAPY := allocations[0].Apy
+	if totalAllocated.IsPositive() {
+		vaultAPY = weightedAPY.QuoInt(totalAllocated)
+	}
+
+	return types.VaultMetrics{
+		Denom:       denom,
+		TotalShares: totalShares,
+		TotalValue:  totalValue.Amount,
+		SharePrice:  sharePrice,
+		Allocations: allocations,
+		Apy:         vaultAPY,
+	}, nil
+}
@@ -70,6 +70,23 @@ func (k *Keeper) ConvertToAssets(ctx sdk.Context, share types.VaultShare) (sdk.C
 	return sdk.NewCoin(share.Denom, value.TruncateInt()), nil
 }
 
+// GetVaultSharePrice returns the value of one share of a vault in the vault
+// denom, which both deposits and withdrawals convert at. The share price is one
+// if no shares have been issued.
+func (k *Keeper) GetVaultSharePrice(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	totalShares, found := k.GetVaultTotalShares(ctx, denom)
+	if !found || totalShares.Amount.IsZero() {
+		return sdk.OneDec(), nil
+	}
+
+	totalValue, err := k.GetVaultTotalValue(ctx, denom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return sdk.NewDecFromInt(totalValue.Amount).Quo(totalShares.Amount), nil
+}
+
 // ShareIsDust returns true if the share value is less than 1 coin
 func (k *Keeper) ShareIsDust(ctx sdk.Context, share types.VaultShare) (bool, error) {
 	coin, err := k.ConvertToAssets(ctx, share)
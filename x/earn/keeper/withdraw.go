@@ -17,42 +17,108 @@ func (k *Keeper) Withdraw(
 	wantAmount sdk.Coin,
 	withdrawStrategy types.StrategyType,
 ) (sdk.Coin, error) {
+	allowedVault, withdrawShares, withdrawAmount, err := k.prepareWithdraw(ctx, from, wantAmount, withdrawStrategy)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// Vaults with a withdrawal delay must use RequestWithdrawal
+	if allowedVault.WithdrawalDelayBlocks > 0 {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			types.ErrWithdrawalDelayed,
+			"%s vault has a withdrawal delay of %d blocks",
+			allowedVault.Denom,
+			allowedVault.WithdrawalDelayBlocks,
+		)
+	}
+
+	// Get the strategy for the vault
+	strategy, err := k.GetStrategy(allowedVault.Strategies[0])
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// Not necessary to check if amount denom is allowed for the strategy, as
+	// there would be no vault record if it weren't allowed.
+
+	// Withdraw the withdrawAmount from the strategy
+	if err := strategy.Withdraw(ctx, withdrawAmount); err != nil {
+		return sdk.Coin{}, fmt.Errorf("failed to withdraw from strategy: %w", err)
+	}
+
+	// Send coins back to account, must withdraw from strategy first or the
+	// module account may not have any funds to send.
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		types.ModuleName,
+		from,
+		sdk.NewCoins(withdrawAmount),
+	); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	withdrawShares, err = k.burnWithdrawShares(ctx, from, withdrawAmount, withdrawShares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVaultWithdraw,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, withdrawAmount.Denom),
+			sdk.NewAttribute(types.AttributeKeyOwner, from.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, withdrawShares.Amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, withdrawAmount.Amount.String()),
+		),
+	)
+
+	return withdrawAmount, nil
+}
+
+// prepareWithdraw checks that an account can withdraw the amount of tokens
+// from a vault, returning the vault, the shares to remove and the truncated
+// value of those shares.
+func (k *Keeper) prepareWithdraw(
+	ctx sdk.Context,
+	from sdk.AccAddress,
+	wantAmount sdk.Coin,
+	withdrawStrategy types.StrategyType,
+) (types.AllowedVault, types.VaultShare, sdk.Coin, error) {
 	// Get AllowedVault, if not found (not a valid vault), return error
 	allowedVault, found := k.GetAllowedVault(ctx, wantAmount.Denom)
 	if !found {
-		return sdk.Coin{}, types.ErrInvalidVaultDenom
+		return types.AllowedVault{}, types.VaultShare{}, sdk.Coin{}, types.ErrInvalidVaultDenom
 	}
 
 	if wantAmount.IsZero() {
-		return sdk.Coin{}, types.ErrInsufficientAmount
+		return types.AllowedVault{}, types.VaultShare{}, sdk.Coin{}, types.ErrInsufficientAmount
 	}
 
 	// Check if withdraw strategy is supported by vault
 	if !allowedVault.IsStrategyAllowed(withdrawStrategy) {
-		return sdk.Coin{}, types.ErrInvalidVaultStrategy
+		return types.AllowedVault{}, types.VaultShare{}, sdk.Coin{}, types.ErrInvalidVaultStrategy
 	}
 
 	// Check if VaultRecord exists
-	vaultRecord, found := k.GetVaultRecord(ctx, wantAmount.Denom)
-	if !found {
-		return sdk.Coin{}, types.ErrVaultRecordNotFound
+	if _, found := k.GetVaultRecord(ctx, wantAmount.Denom); !found {
+		return types.AllowedVault{}, types.VaultShare{}, sdk.Coin{}, types.ErrVaultRecordNotFound
 	}
 
 	// Get account share record for the vault
 	vaultShareRecord, found := k.GetVaultShareRecord(ctx, from)
 	if !found {
-		return sdk.Coin{}, types.ErrVaultShareRecordNotFound
+		return types.AllowedVault{}, types.VaultShare{}, sdk.Coin{}, types.ErrVaultShareRecordNotFound
 	}
 
 	withdrawShares, err := k.ConvertToShares(ctx, wantAmount)
 	if err != nil {
-		return sdk.Coin{}, fmt.Errorf("failed to convert assets to shares: %w", err)
+		return types.AllowedVault{}, types.VaultShare{}, sdk.Coin{}, fmt.Errorf("failed to convert assets to shares: %w", err)
 	}
 
 	accCurrentShares := vaultShareRecord.Shares.AmountOf(wantAmount.Denom)
 	// Check if account is not withdrawing more shares than they have
 	if accCurrentShares.LT(withdrawShares.Amount) {
-		return sdk.Coin{}, errorsmod.Wrapf(
+		return types.AllowedVault{}, types.VaultShare{}, sdk.Coin{}, errorsmod.Wrapf(
 			types.ErrInsufficientValue,
 			"account has less %s vault shares than withdraw shares, %s < %s",
 			wantAmount.Denom,
@@ -64,17 +130,17 @@ func (k *Keeper) Withdraw(
 	// Convert shares to amount to get truncated true share value
 	withdrawAmount, err := k.ConvertToAssets(ctx, withdrawShares)
 	if err != nil {
-		return sdk.Coin{}, fmt.Errorf("failed to convert shares to assets: %w", err)
+		return types.AllowedVault{}, types.VaultShare{}, sdk.Coin{}, fmt.Errorf("failed to convert shares to assets: %w", err)
 	}
 
 	accountValue, err := k.GetVaultAccountValue(ctx, wantAmount.Denom, from)
 	if err != nil {
-		return sdk.Coin{}, fmt.Errorf("failed to get account value: %w", err)
+		return types.AllowedVault{}, types.VaultShare{}, sdk.Coin{}, fmt.Errorf("failed to get account value: %w", err)
 	}
 
 	// Check if withdrawAmount > account value
 	if withdrawAmount.Amount.GT(accountValue.Amount) {
-		return sdk.Coin{}, errorsmod.Wrapf(
+		return types.AllowedVault{}, types.VaultShare{}, sdk.Coin{}, errorsmod.Wrapf(
 			types.ErrInsufficientValue,
 			"account has less %s vault value than withdraw amount, %s < %s",
 			withdrawAmount.Denom,
@@ -83,31 +149,31 @@ func (k *Keeper) Withdraw(
 		)
 	}
 
-	// Get the strategy for the vault
-	strategy, err := k.GetStrategy(allowedVault.Strategies[0])
-	if err != nil {
-		return sdk.Coin{}, err
-	}
-
-	// Not necessary to check if amount denom is allowed for the strategy, as
-	// there would be no vault record if it weren't allowed.
+	return allowedVault, withdrawShares, withdrawAmount, nil
+}
 
-	// Withdraw the withdrawAmount from the strategy
-	if err := strategy.Withdraw(ctx, withdrawAmount); err != nil {
-		return sdk.Coin{}, fmt.Errorf("failed to withdraw from strategy: %w", err)
+// burnWithdrawShares removes withdrawn shares from the vault and account
+// records, including any remaining dust shares. It returns the shares that
+// were removed. The withdrawn funds must already be removed from the vault
+// value before calling this.
+func (k *Keeper) burnWithdrawShares(
+	ctx sdk.Context,
+	from sdk.AccAddress,
+	withdrawAmount sdk.Coin,
+	withdrawShares types.VaultShare,
+) (types.VaultShare, error) {
+	vaultRecord, found := k.GetVaultRecord(ctx, withdrawAmount.Denom)
+	if !found {
+		return types.VaultShare{}, types.ErrVaultRecordNotFound
 	}
 
-	// Send coins back to account, must withdraw from strategy first or the
-	// module account may not have any funds to send.
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
-		ctx,
-		types.ModuleName,
-		from,
-		sdk.NewCoins(withdrawAmount),
-	); err != nil {
-		return sdk.Coin{}, err
+	vaultShareRecord, found := k.GetVaultShareRecord(ctx, from)
+	if !found {
+		return types.VaultShare{}, types.ErrVaultShareRecordNotFound
 	}
 
+	accCurrentShares := vaultShareRecord.Shares.AmountOf(withdrawAmount.Denom)
+
 	// Check if new account balance of shares results in account share value
 	// of < 1 of a sdk.Coin. This share value is not able to be withdrawn and
 	// should just be removed.
@@ -116,7 +182,7 @@ func (k *Keeper) Withdraw(
 		vaultShareRecord.Shares.GetShare(withdrawAmount.Denom).Sub(withdrawShares),
 	)
 	if err != nil {
-		return sdk.Coin{}, err
+		return types.VaultShare{}, err
 	}
 
 	if isDust {
@@ -127,7 +193,7 @@ func (k *Keeper) Withdraw(
 	}
 
 	// Call hook before record is modified with the user's current shares
-	k.BeforeVaultDepositModified(ctx, wantAmount.Denom, from, accCurrentShares)
+	k.BeforeVaultDepositModified(ctx, withdrawAmount.Denom, from, accCurrentShares)
 
 	// Decrement VaultRecord and VaultShareRecord supplies - must delete same
 	// amounts
@@ -138,17 +204,9 @@ func (k *Keeper) Withdraw(
 	k.UpdateVaultRecord(ctx, vaultRecord)
 	k.UpdateVaultShareRecord(ctx, vaultShareRecord)
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeVaultWithdraw,
-			sdk.NewAttribute(types.AttributeKeyVaultDenom, withdrawAmount.Denom),
-			sdk.NewAttribute(types.AttributeKeyOwner, from.String()),
-			sdk.NewAttribute(types.AttributeKeyShares, withdrawShares.Amount.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, withdrawAmount.Amount.String()),
-		),
-	)
+	k.AfterVaultSharesModified(ctx, from, withdrawAmount.Denom, accCurrentShares, vaultShareRecord.Shares.AmountOf(withdrawAmount.Denom))
 
-	return withdrawAmount, nil
+	return withdrawShares, nil
 }
 
 // WithdrawFromModuleAccount removes the amount of supplied tokens from a vault and transfers it
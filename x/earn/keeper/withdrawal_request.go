@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+// RequestWithdrawal removes the amount of supplied tokens from a vault with a
+// withdrawal delay and creates a pending withdrawal for it. The tokens are
+// withdrawn from the vault strategy once the delay has passed, after which
+// the withdrawal can be claimed.
+func (k *Keeper) RequestWithdrawal(
+	ctx sdk.Context,
+	from sdk.AccAddress,
+	wantAmount sdk.Coin,
+	withdrawStrategy types.StrategyType,
+) (types.PendingWithdrawal, error) {
+	allowedVault, withdrawShares, withdrawAmount, err := k.prepareWithdraw(ctx, from, wantAmount, withdrawStrategy)
+	if err != nil {
+		return types.PendingWithdrawal{}, err
+	}
+
+	if allowedVault.WithdrawalDelayBlocks == 0 {
+		return types.PendingWithdrawal{}, errorsmod.Wrapf(
+			types.ErrWithdrawalNotDelayed,
+			"%s vault withdrawals are immediate",
+			allowedVault.Denom,
+		)
+	}
+
+	// The funds stay in the strategy until released, but no longer belong to
+	// the vault, so they are excluded from the vault value before the shares
+	// are removed.
+	pendingTotal := k.GetPendingWithdrawalTotal(ctx, withdrawAmount.Denom)
+	k.SetPendingWithdrawalTotal(ctx, withdrawAmount.Denom, pendingTotal.Add(withdrawAmount.Amount))
+
+	withdrawShares, err = k.burnWithdrawShares(ctx, from, withdrawAmount, withdrawShares)
+	if err != nil {
+		return types.PendingWithdrawal{}, err
+	}
+
+	id := k.GetNextWithdrawalID(ctx)
+	k.SetNextWithdrawalID(ctx, id+1)
+
+	withdrawal := types.NewPendingWithdrawal(
+		id,
+		from,
+		withdrawAmount,
+		ctx.BlockHeight()+int64(allowedVault.WithdrawalDelayBlocks),
+	)
+	k.SetPendingWithdrawal(ctx, withdrawal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawalRequested,
+			sdk.NewAttribute(types.AttributeKeyWithdrawalID, fmt.Sprint(withdrawal.ID)),
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, withdrawAmount.Denom),
+			sdk.NewAttribute(types.AttributeKeyOwner, from.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, withdrawShares.Amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, withdrawAmount.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReleaseHeight, fmt.Sprint(withdrawal.ReleaseHeight)),
+		),
+	)
+
+	return withdrawal, nil
+}
+
+// ReleasePendingWithdrawals withdraws the funds of matured pending
+// withdrawals from their vault strategies to the module account, making them
+// claimable. Withdrawals that the strategy cannot currently fulfill are
+// retried in following blocks.
+func (k *Keeper) ReleasePendingWithdrawals(ctx sdk.Context) {
+	var matured types.PendingWithdrawals
+	k.IterateMaturedPendingWithdrawals(ctx, ctx.BlockHeight(), func(withdrawal types.PendingWithdrawal) bool {
+		matured = append(matured, withdrawal)
+		return false
+	})
+
+	for _, withdrawal := range matured {
+		if err := k.releasePendingWithdrawal(ctx, withdrawal); err != nil {
+			k.Logger(ctx).Info(fmt.Sprintf("could not release pending withdrawal %d: %s", withdrawal.ID, err))
+		}
+	}
+}
+
+// releasePendingWithdrawal withdraws the funds of a single pending withdrawal
+// from its vault strategy. State is only modified if the strategy withdraw
+// succeeds.
+func (k *Keeper) releasePendingWithdrawal(ctx sdk.Context, withdrawal types.PendingWithdrawal) error {
+	allowedVault, found := k.GetAllowedVault(ctx, withdrawal.Amount.Denom)
+	if !found {
+		return types.ErrInvalidVaultDenom
+	}
+
+	strategy, err := k.GetStrategy(allowedVault.Strategies[0])
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := strategy.Withdraw(cacheCtx, withdrawal.Amount); err != nil {
+		return fmt.Errorf("failed to withdraw from strategy: %w", err)
+	}
+	writeCache()
+
+	pendingTotal := k.GetPendingWithdrawalTotal(ctx, withdrawal.Amount.Denom)
+	k.SetPendingWithdrawalTotal(ctx, withdrawal.Amount.Denom, pendingTotal.Sub(withdrawal.Amount.Amount))
+
+	withdrawal.Released = true
+	k.SetPendingWithdrawal(ctx, withdrawal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawalReleased,
+			sdk.NewAttribute(types.AttributeKeyWithdrawalID, fmt.Sprint(withdrawal.ID)),
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, withdrawal.Amount.Denom),
+			sdk.NewAttribute(types.AttributeKeyOwner, withdrawal.Owner.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, withdrawal.Amount.Amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// ClaimWithdrawal transfers the funds of a released pending withdrawal to its
+// owner and removes it.
+func (k *Keeper) ClaimWithdrawal(
+	ctx sdk.Context,
+	from sdk.AccAddress,
+	id uint64,
+) (sdk.Coin, error) {
+	withdrawal, found := k.GetPendingWithdrawal(ctx, id)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrWithdrawalNotFound, "%d", id)
+	}
+
+	if !withdrawal.Owner.Equals(from) {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"pending withdrawal %d is not owned by %s",
+			id,
+			from,
+		)
+	}
+
+	if !withdrawal.Released {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			types.ErrWithdrawalNotReleased,
+			"pending withdrawal %d is released at height %d",
+			id,
+			withdrawal.ReleaseHeight,
+		)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		types.ModuleName,
+		from,
+		sdk.NewCoins(withdrawal.Amount),
+	); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.DeletePendingWithdrawal(ctx, withdrawal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawalClaimed,
+			sdk.NewAttribute(types.AttributeKeyWithdrawalID, fmt.Sprint(withdrawal.ID)),
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, withdrawal.Amount.Denom),
+			sdk.NewAttribute(types.AttributeKeyOwner, from.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, withdrawal.Amount.Amount.String()),
+		),
+	)
+
+	return withdrawal.Amount, nil
+}
@@ -0,0 +1,253 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/earn/testutil"
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+type withdrawalRequestTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *withdrawalRequestTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+	suite.Keeper.SetParams(suite.Ctx, types.DefaultParams())
+}
+
+func TestWithdrawalRequestTestSuite(t *testing.T) {
+	suite.Run(t, new(withdrawalRequestTestSuite))
+}
+
+func (suite *withdrawalRequestTestSuite) TestRequestWithdrawal_NotDelayed() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+	depositAmount := sdk.NewInt64Coin(vaultDenom, 100)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	_, err = suite.Keeper.RequestWithdrawal(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().ErrorIs(err, types.ErrWithdrawalNotDelayed)
+
+	suite.Empty(suite.Keeper.GetAllPendingWithdrawals(suite.Ctx))
+	suite.VaultTotalValuesEqual(sdk.NewCoins(depositAmount))
+}
+
+func (suite *withdrawalRequestTestSuite) TestWithdraw_Delayed() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+	depositAmount := sdk.NewInt64Coin(vaultDenom, 100)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	suite.SetVaultWithdrawalDelay(vaultDenom, 10)
+
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().ErrorIs(err, types.ErrWithdrawalDelayed)
+
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(startBalance.Sub(depositAmount)))
+	suite.VaultTotalValuesEqual(sdk.NewCoins(depositAmount))
+}
+
+func (suite *withdrawalRequestTestSuite) TestRequestWithdrawal_ExceedBalance() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+	depositAmount := sdk.NewInt64Coin(vaultDenom, 100)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	suite.SetVaultWithdrawalDelay(vaultDenom, 10)
+
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	_, err = suite.Keeper.RequestWithdrawal(
+		suite.Ctx,
+		acc.GetAddress(),
+		sdk.NewInt64Coin(vaultDenom, 200),
+		types.STRATEGY_TYPE_HARD,
+	)
+	suite.Require().ErrorIs(err, types.ErrInsufficientValue)
+
+	suite.Empty(suite.Keeper.GetAllPendingWithdrawals(suite.Ctx))
+	suite.Equal(sdk.ZeroInt(), suite.Keeper.GetPendingWithdrawalTotal(suite.Ctx, vaultDenom))
+}
+
+func (suite *withdrawalRequestTestSuite) TestRequestAndClaimWithdrawal() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+	depositAmount := sdk.NewInt64Coin(vaultDenom, 100)
+	withdrawAmount := sdk.NewInt64Coin(vaultDenom, 40)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	suite.SetVaultWithdrawalDelay(vaultDenom, 10)
+
+	acc1 := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+	acc2 := suite.CreateAccount(sdk.NewCoins(startBalance), 1)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc1.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+	err = suite.Keeper.Deposit(suite.Ctx, acc2.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	requestHeight := suite.Ctx.BlockHeight()
+	withdrawal, err := suite.Keeper.RequestWithdrawal(suite.Ctx, acc1.GetAddress(), withdrawAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+	suite.Equal(
+		types.NewPendingWithdrawal(1, acc1.GetAddress(), withdrawAmount, requestHeight+10),
+		withdrawal,
+	)
+	suite.Equal(uint64(2), suite.Keeper.GetNextWithdrawalID(suite.Ctx))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeWithdrawalRequested,
+		sdk.NewAttribute(types.AttributeKeyWithdrawalID, "1"),
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, vaultDenom),
+		sdk.NewAttribute(types.AttributeKeyOwner, acc1.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyShares, "40.000000000000000000"),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, withdrawAmount.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyReleaseHeight, "11"),
+	))
+
+	// Shares are removed immediately, while the funds stay in the strategy and
+	// are excluded from the vault value
+	acc1Shares, found := suite.Keeper.GetVaultAccountShares(suite.Ctx, acc1.GetAddress())
+	suite.Require().True(found)
+	suite.Equal(types.NewVaultShares(types.NewVaultShare(vaultDenom, sdk.NewDec(60))), acc1Shares)
+	suite.VaultTotalSharesEqual(types.NewVaultShares(types.NewVaultShare(vaultDenom, sdk.NewDec(160))))
+	suite.VaultTotalValuesEqual(sdk.NewCoins(sdk.NewInt64Coin(vaultDenom, 160)))
+	suite.HardDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(vaultDenom, 200)))
+
+	// Share price is unchanged for other depositors
+	acc2Value, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, vaultDenom, acc2.GetAddress())
+	suite.Require().NoError(err)
+	suite.Equal(depositAmount, acc2Value)
+
+	// Not claimable before release
+	_, err = suite.Keeper.ClaimWithdrawal(suite.Ctx, acc1.GetAddress(), withdrawal.ID)
+	suite.Require().ErrorIs(err, types.ErrWithdrawalNotReleased)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(withdrawal.ReleaseHeight - 1)
+	suite.Keeper.ReleasePendingWithdrawals(suite.Ctx)
+	withdrawal, found = suite.Keeper.GetPendingWithdrawal(suite.Ctx, withdrawal.ID)
+	suite.Require().True(found)
+	suite.False(withdrawal.Released)
+
+	// Released at the release height
+	suite.Ctx = suite.Ctx.WithBlockHeight(withdrawal.ReleaseHeight)
+	suite.Keeper.ReleasePendingWithdrawals(suite.Ctx)
+	withdrawal, found = suite.Keeper.GetPendingWithdrawal(suite.Ctx, withdrawal.ID)
+	suite.Require().True(found)
+	suite.True(withdrawal.Released)
+
+	suite.HardDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(vaultDenom, 160)))
+	suite.ModuleAccountBalanceEqual(sdk.NewCoins(withdrawAmount))
+	suite.VaultTotalValuesEqual(sdk.NewCoins(sdk.NewInt64Coin(vaultDenom, 160)))
+	suite.Equal(sdk.ZeroInt(), suite.Keeper.GetPendingWithdrawalTotal(suite.Ctx, vaultDenom))
+
+	// Only the owner can claim
+	_, err = suite.Keeper.ClaimWithdrawal(suite.Ctx, acc2.GetAddress(), withdrawal.ID)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	claimed, err := suite.Keeper.ClaimWithdrawal(suite.Ctx, acc1.GetAddress(), withdrawal.ID)
+	suite.Require().NoError(err)
+	suite.Equal(withdrawAmount, claimed)
+
+	suite.AccountBalanceEqual(
+		acc1.GetAddress(),
+		sdk.NewCoins(startBalance.Sub(depositAmount).Add(withdrawAmount)),
+	)
+	suite.ModuleAccountBalanceEqual(sdk.NewCoins())
+
+	_, found = suite.Keeper.GetPendingWithdrawal(suite.Ctx, withdrawal.ID)
+	suite.False(found)
+
+	_, err = suite.Keeper.ClaimWithdrawal(suite.Ctx, acc1.GetAddress(), withdrawal.ID)
+	suite.Require().ErrorIs(err, types.ErrWithdrawalNotFound)
+}
+
+func (suite *withdrawalRequestTestSuite) TestRequestWithdrawal_FullBalance() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+	depositAmount := sdk.NewInt64Coin(vaultDenom, 100)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	suite.SetVaultWithdrawalDelay(vaultDenom, 5)
+
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	_, err = suite.Keeper.RequestWithdrawal(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	_, found := suite.Keeper.GetVaultRecord(suite.Ctx, vaultDenom)
+	suite.False(found, "vault record should be removed when all shares are withdrawn")
+	_, found = suite.Keeper.GetVaultShareRecord(suite.Ctx, acc.GetAddress())
+	suite.False(found, "vault share record should be removed when all shares are withdrawn")
+
+	// A new deposit is issued shares 1:1, excluding the pending funds
+	err = suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+	suite.VaultTotalValuesEqual(sdk.NewCoins(depositAmount))
+	suite.VaultTotalSharesEqual(types.NewVaultShares(
+		types.NewVaultShare(vaultDenom, sdk.NewDecFromInt(depositAmount.Amount)),
+	))
+}
+
+func (suite *withdrawalRequestTestSuite) TestReleasePendingWithdrawals_RetriesLockedStrategy() {
+	vaultDenom := "usdx"
+	startBalance := sdk.NewInt64Coin(vaultDenom, 1000)
+	depositAmount := sdk.NewInt64Coin(vaultDenom, 100)
+
+	strategy := &externalStrategy{}
+	suite.Keeper.RegisterStrategy(strategy)
+
+	suite.CreateVault(vaultDenom, types.StrategyTypes{externalStrategyType}, false, nil)
+	suite.SetVaultWithdrawalDelay(vaultDenom, 1)
+
+	acc := suite.CreateAccount(sdk.NewCoins(startBalance), 0)
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, externalStrategyType)
+	suite.Require().NoError(err)
+
+	withdrawal, err := suite.Keeper.RequestWithdrawal(suite.Ctx, acc.GetAddress(), depositAmount, externalStrategyType)
+	suite.Require().NoError(err)
+
+	// Locked liquidity is not released and stays pending
+	strategy.locked = true
+	suite.Ctx = suite.Ctx.WithBlockHeight(withdrawal.ReleaseHeight)
+	suite.Keeper.ReleasePendingWithdrawals(suite.Ctx)
+
+	withdrawal, found := suite.Keeper.GetPendingWithdrawal(suite.Ctx, withdrawal.ID)
+	suite.Require().True(found)
+	suite.False(withdrawal.Released)
+	suite.Equal(depositAmount.Amount, suite.Keeper.GetPendingWithdrawalTotal(suite.Ctx, vaultDenom))
+	suite.Equal(sdk.NewCoins(depositAmount), strategy.deposits)
+
+	// Retried in a later block once unlocked
+	strategy.locked = false
+	suite.Ctx = suite.Ctx.WithBlockHeight(withdrawal.ReleaseHeight + 1)
+	suite.Keeper.ReleasePendingWithdrawals(suite.Ctx)
+
+	withdrawal, found = suite.Keeper.GetPendingWithdrawal(suite.Ctx, withdrawal.ID)
+	suite.Require().True(found)
+	suite.True(withdrawal.Released)
+	suite.Equal(sdk.ZeroInt(), suite.Keeper.GetPendingWithdrawalTotal(suite.Ctx, vaultDenom))
+	suite.True(strategy.deposits.IsZero())
+
+	_, err = suite.Keeper.ClaimWithdrawal(suite.Ctx, acc.GetAddress(), withdrawal.ID)
+	suite.Require().NoError(err)
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(startBalance))
+}
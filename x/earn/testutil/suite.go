@@ -162,8 +162,12 @@ func (suite *Suite) SetupTest() {
 				TestBkavaDenoms[1],
 				TestBkavaDenoms[2],
 			},
+			nil,
+			nil,
 		),
 		nil,
+		nil,
+		nil,
 	)
 
 	stakingParams := stakingtypes.DefaultParams()
@@ -267,6 +271,35 @@ func (suite *Suite) CreateVault(
 	)
 }
 
+// SetVaultWithdrawalDelay sets the withdrawal delay of an existing vault in
+// the keeper parameters
+func (suite *Suite) SetVaultWithdrawalDelay(vaultDenom string, delayBlocks uint64) {
+	params := suite.Keeper.GetParams(suite.Ctx)
+
+	for i, vault := range params.AllowedVaults {
+		if vault.Denom == vaultDenom {
+			params.AllowedVaults[i].WithdrawalDelayBlocks = delayBlocks
+		}
+	}
+
+	suite.Keeper.SetParams(suite.Ctx, params)
+}
+
+// SetVaultDepositLimits sets the deposit cap and account deposit limit of an
+// existing vault in the keeper parameters
+func (suite *Suite) SetVaultDepositLimits(vaultDenom string, depositCap, accountDepositLimit sdkmath.Int) {
+	params := suite.Keeper.GetParams(suite.Ctx)
+
+	for i, vault := range params.AllowedVaults {
+		if vault.Denom == vaultDenom {
+			params.AllowedVaults[i].DepositCap = depositCap
+			params.AllowedVaults[i].AccountDepositLimit = accountDepositLimit
+		}
+	}
+
+	suite.Keeper.SetParams(suite.Ctx, params)
+}
+
 // AccountBalanceEqual asserts that the coins match the account balance
 func (suite *Suite) AccountBalanceEqual(addr sdk.AccAddress, coins sdk.Coins) {
 	balance := suite.BankKeeper.GetAllBalances(suite.Ctx, addr)
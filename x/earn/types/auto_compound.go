@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewAutoCompound returns a new AutoCompound.
+func NewAutoCompound(depositor sdk.AccAddress, vaultDenom string) AutoCompound {
+	return AutoCompound{
+		Depositor:  depositor,
+		VaultDenom: vaultDenom,
+	}
+}
+
+// Validate returns an error if an AutoCompound is invalid.
+func (ac AutoCompound) Validate() error {
+	if ac.Depositor.Empty() {
+		return fmt.Errorf("auto compound depositor is empty")
+	}
+
+	if err := sdk.ValidateDenom(ac.VaultDenom); err != nil {
+		return fmt.Errorf("invalid auto compound vault denom for %s: %w", ac.Depositor, err)
+	}
+
+	return nil
+}
+
+// AutoCompounds is a slice of AutoCompound.
+type AutoCompounds []AutoCompound
+
+// Validate returns an error if a slice of AutoCompounds is invalid.
+func (acs AutoCompounds) Validate() error {
+	depositors := make(map[string]bool)
+
+	for _, ac := range acs {
+		if err := ac.Validate(); err != nil {
+			return err
+		}
+
+		if depositors[ac.Depositor.String()] {
+			return fmt.Errorf("duplicate auto compound depositor %s", ac.Depositor)
+		}
+
+		depositors[ac.Depositor.String()] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+func TestAutoCompoundsValidate(t *testing.T) {
+	type errArgs struct {
+		expectPass bool
+		contains   string
+	}
+
+	depositor1 := sdk.AccAddress("depositor1")
+	depositor2 := sdk.AccAddress("depositor2")
+
+	tests := []struct {
+		name          string
+		autoCompounds types.AutoCompounds
+		errArgs       errArgs
+	}{
+		{
+			name: "valid",
+			autoCompounds: types.AutoCompounds{
+				types.NewAutoCompound(depositor1, "usdx"),
+				types.NewAutoCompound(depositor2, "usdx"),
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name:          "valid - empty",
+			autoCompounds: types.AutoCompounds{},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - empty depositor",
+			autoCompounds: types.AutoCompounds{
+				types.NewAutoCompound(nil, "usdx"),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "auto compound depositor is empty",
+			},
+		},
+		{
+			name: "invalid - vault denom",
+			autoCompounds: types.AutoCompounds{
+				types.NewAutoCompound(depositor1, ""),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "invalid auto compound vault denom",
+			},
+		},
+		{
+			name: "invalid - duplicate depositor",
+			autoCompounds: types.AutoCompounds{
+				types.NewAutoCompound(depositor1, "usdx"),
+				types.NewAutoCompound(depositor1, "ukava"),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "duplicate auto compound depositor",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.autoCompounds.Validate()
+
+			if tt.errArgs.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errArgs.contains)
+			}
+		})
+	}
+}
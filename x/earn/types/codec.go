@@ -15,6 +15,10 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDeposit{}, "earn/MsgDeposit", nil)
 	cdc.RegisterConcrete(&MsgWithdraw{}, "earn/MsgWithdraw", nil)
+	cdc.RegisterConcrete(&MsgRequestWithdrawal{}, "earn/MsgRequestWithdrawal", nil)
+	cdc.RegisterConcrete(&MsgClaimWithdrawal{}, "earn/MsgClaimWithdrawal", nil)
+	cdc.RegisterConcrete(&MsgSetAutoCompound{}, "earn/MsgSetAutoCompound", nil)
+	cdc.RegisterConcrete(&MsgMigrateVault{}, "earn/MsgMigrateVault", nil)
 	cdc.RegisterConcrete(&CommunityPoolDepositProposal{}, "kava/CommunityPoolDepositProposal", nil)
 	cdc.RegisterConcrete(&CommunityPoolWithdrawProposal{}, "kava/CommunityPoolWithdrawProposal", nil)
 }
@@ -25,6 +29,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgDeposit{},
 		&MsgWithdraw{},
+		&MsgRequestWithdrawal{},
+		&MsgClaimWithdrawal{},
+		&MsgSetAutoCompound{},
+		&MsgMigrateVault{},
 	)
 	registry.RegisterImplementations((*govv1beta1.Content)(nil),
 		&CommunityPoolDepositProposal{},
@@ -11,4 +11,11 @@ var (
 	ErrVaultRecordNotFound      = errorsmod.Register(ModuleName, 6, "vault record not found")
 	ErrVaultShareRecordNotFound = errorsmod.Register(ModuleName, 7, "vault share record not found")
 	ErrAccountDepositNotAllowed = errorsmod.Register(ModuleName, 8, "account is not allowed to deposit to this vault")
+	ErrStrategyUnhealthy        = errorsmod.Register(ModuleName, 9, "strategy can not accept deposits")
+	ErrWithdrawalDelayed        = errorsmod.Register(ModuleName, 10, "vault withdrawals must be requested")
+	ErrWithdrawalNotDelayed     = errorsmod.Register(ModuleName, 11, "vault does not have a withdrawal delay")
+	ErrWithdrawalNotFound       = errorsmod.Register(ModuleName, 12, "pending withdrawal not found")
+	ErrWithdrawalNotReleased    = errorsmod.Register(ModuleName, 13, "pending withdrawal has not been released")
+	ErrVaultDepositCapExceeded  = errorsmod.Register(ModuleName, 14, "vault deposit cap exceeded")
+	ErrAccountLimitExceeded     = errorsmod.Register(ModuleName, 15, "account deposit limit exceeded")
 )
@@ -9,4 +9,22 @@ const (
 	AttributeKeyDepositor  = "depositor"
 	AttributeKeyShares     = "shares"
 	AttributeKeyOwner      = "owner"
+
+	EventTypeWithdrawalRequested = "vault_withdrawal_requested"
+	EventTypeWithdrawalReleased  = "vault_withdrawal_released"
+	EventTypeWithdrawalClaimed   = "vault_withdrawal_claimed"
+	AttributeKeyWithdrawalID     = "withdrawal_id"
+	AttributeKeyReleaseHeight    = "release_height"
+
+	EventTypeAutoCompound = "vault_auto_compound"
+	AttributeKeyRewards   = "rewards"
+
+	EventTypeVaultMigration      = "vault_migration"
+	AttributeKeyFromStrategy     = "from_strategy"
+	AttributeKeyToStrategy       = "to_strategy"
+	AttributeKeyTotalShares      = "total_shares"
+	AttributeKeyValueBefore      = "value_before"
+	AttributeKeyValueAfter       = "value_after"
+	AttributeKeySharePriceBefore = "share_price_before"
+	AttributeKeySharePriceAfter  = "share_price_after"
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -20,6 +22,7 @@ type AccountKeeper interface {
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
@@ -46,6 +49,8 @@ type HardKeeper interface {
 	Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error
 
 	GetSyncedDeposit(ctx sdk.Context, depositor sdk.AccAddress) (hardtypes.Deposit, bool)
+	GetMoneyMarket(ctx sdk.Context, denom string) (hardtypes.MoneyMarket, bool)
+	GetInterestRates(ctx sdk.Context, moneyMarket hardtypes.MoneyMarket) (borrowAPY sdk.Dec, supplyAPY sdk.Dec, err error)
 }
 
 // SavingsKeeper defines the expected interface needed for the savings strategy.
@@ -54,10 +59,19 @@ type SavingsKeeper interface {
 	Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error
 
 	GetDeposit(ctx sdk.Context, depositor sdk.AccAddress) (savingstypes.Deposit, bool)
+	IsDenomSupported(ctx sdk.Context, denom string) bool
+}
+
+// SwapKeeper defines the expected interface needed to compound incentive rewards.
+type SwapKeeper interface {
+	QuoteSwapExactForTokens(ctx sdk.Context, exactCoinA sdk.Coin, intermediateDenoms []string, denomB string) (sdk.Coin, []sdk.Coin, sdk.Dec, error)
+	SwapExactForTokens(ctx sdk.Context, requester sdk.AccAddress, exactCoinA, coinB sdk.Coin, slippageLimit sdk.Dec) error
+	GetPoolTwap(ctx sdk.Context, poolID string, window time.Duration) (sdk.Dec, sdk.Dec, error)
 }
 
 // EarnHooks are event hooks called when a user's deposit to a earn vault changes.
 type EarnHooks interface {
 	AfterVaultDepositCreated(ctx sdk.Context, vaultDenom string, depositor sdk.AccAddress, sharesOwned sdk.Dec)
 	BeforeVaultDepositModified(ctx sdk.Context, vaultDenom string, depositor sdk.AccAddress, sharesOwned sdk.Dec)
+	AfterVaultSharesModified(ctx sdk.Context, owner sdk.AccAddress, vaultDenom string, oldShares, newShares sdk.Dec)
 }
@@ -1,15 +1,23 @@
 package types
 
+import "fmt"
+
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(
 	params Params,
 	vaultRecords VaultRecords,
 	vaultShareRecords VaultShareRecords,
+	pendingWithdrawals PendingWithdrawals,
+	nextWithdrawalID uint64,
+	autoCompounds AutoCompounds,
 ) GenesisState {
 	return GenesisState{
-		Params:            params,
-		VaultRecords:      vaultRecords,
-		VaultShareRecords: vaultShareRecords,
+		Params:             params,
+		VaultRecords:       vaultRecords,
+		VaultShareRecords:  vaultShareRecords,
+		PendingWithdrawals: pendingWithdrawals,
+		NextWithdrawalID:   nextWithdrawalID,
+		AutoCompounds:      autoCompounds,
 	}
 }
 
@@ -27,6 +35,28 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.PendingWithdrawals.Validate(); err != nil {
+		return err
+	}
+
+	if gs.NextWithdrawalID == 0 {
+		return fmt.Errorf("next withdrawal id cannot be 0")
+	}
+
+	for _, pw := range gs.PendingWithdrawals {
+		if pw.ID >= gs.NextWithdrawalID {
+			return fmt.Errorf(
+				"pending withdrawal id %d must be less than next withdrawal id %d",
+				pw.ID,
+				gs.NextWithdrawalID,
+			)
+		}
+	}
+
+	if err := gs.AutoCompounds.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -36,5 +66,8 @@ func DefaultGenesisState() GenesisState {
 		DefaultParams(),
 		VaultRecords{},
 		VaultShareRecords{},
+		PendingWithdrawals{},
+		DefaultNextWithdrawalID,
+		AutoCompounds{},
 	)
 }
@@ -31,6 +31,12 @@ type GenesisState struct {
 	VaultRecords VaultRecords `protobuf:"bytes,2,rep,name=vault_records,json=vaultRecords,proto3,castrepeated=VaultRecords" json:"vault_records"`
 	// share_records defines the owned shares of each vault
 	VaultShareRecords VaultShareRecords `protobuf:"bytes,3,rep,name=vault_share_records,json=vaultShareRecords,proto3,castrepeated=VaultShareRecords" json:"vault_share_records"`
+	// pending_withdrawals defines the requested withdrawals of delayed vaults
+	PendingWithdrawals PendingWithdrawals `protobuf:"bytes,4,rep,name=pending_withdrawals,json=pendingWithdrawals,proto3,castrepeated=PendingWithdrawals" json:"pending_withdrawals"`
+	// next_withdrawal_id defines the id of the next pending withdrawal
+	NextWithdrawalID uint64 `protobuf:"varint,5,opt,name=next_withdrawal_id,json=nextWithdrawalId,proto3" json:"next_withdrawal_id,omitempty"`
+	// auto_compounds defines the depositors that compound their claimed rewards
+	AutoCompounds AutoCompounds `protobuf:"bytes,6,rep,name=auto_compounds,json=autoCompounds,proto3,castrepeated=AutoCompounds" json:"auto_compounds"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +93,27 @@ func (m *GenesisState) GetVaultShareRecords() VaultShareRecords {
 	return nil
 }
 
+func (m *GenesisState) GetPendingWithdrawals() PendingWithdrawals {
+	if m != nil {
+		return m.PendingWithdrawals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextWithdrawalID() uint64 {
+	if m != nil {
+		return m.NextWithdrawalID
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAutoCompounds() AutoCompounds {
+	if m != nil {
+		return m.AutoCompounds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.earn.v1beta1.GenesisState")
 }
@@ -94,26 +121,33 @@ func init() {
 func init() { proto.RegisterFile("kava/earn/v1beta1/genesis.proto", fileDescriptor_514fe130cb964f8c) }
 
 var fileDescriptor_514fe130cb964f8c = []byte{
-	// 294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x4f, 0x4d, 0x2c, 0xca, 0xd3, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x29, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58,
-	0x10, 0x85, 0x52, 0x72, 0x98, 0x26, 0x15, 0x24, 0x16, 0x25, 0xe6, 0x42, 0x0d, 0x92, 0x92, 0xc5,
-	0x94, 0x2f, 0x4b, 0x2c, 0xcd, 0x29, 0x81, 0x48, 0x2b, 0x4d, 0x62, 0xe2, 0xe2, 0x71, 0x87, 0xd8,
-	0x1c, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xce, 0xc5, 0x06, 0xd1, 0x2f, 0xc1, 0xa8, 0xc0, 0xa8,
-	0xc1, 0x6d, 0x24, 0xa9, 0x87, 0xe1, 0x12, 0xbd, 0x00, 0xb0, 0x02, 0x27, 0x96, 0x13, 0xf7, 0xe4,
-	0x19, 0x82, 0xa0, 0xca, 0x85, 0x22, 0xb9, 0x78, 0xc1, 0x06, 0xc7, 0x17, 0xa5, 0x26, 0xe7, 0x17,
-	0xa5, 0x14, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0xc9, 0x61, 0xd1, 0x1f, 0x06, 0x52, 0x17,
-	0x04, 0x56, 0xe6, 0x24, 0x02, 0x32, 0x64, 0xd5, 0x7d, 0x79, 0x1e, 0x24, 0xc1, 0xe2, 0x20, 0x9e,
-	0x32, 0x24, 0x9e, 0x50, 0x1e, 0x97, 0x30, 0xc4, 0xe8, 0xe2, 0x8c, 0xc4, 0xa2, 0x54, 0xb8, 0x05,
-	0xcc, 0x60, 0x0b, 0x94, 0x71, 0x59, 0x10, 0x0c, 0x52, 0x0c, 0xb5, 0x45, 0x12, 0x6a, 0x8b, 0x20,
-	0xba, 0x4c, 0x71, 0x90, 0x60, 0x19, 0xba, 0x90, 0x93, 0xc3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e,
-	0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37,
-	0x1e, 0xcb, 0x31, 0x44, 0xa9, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea,
-	0x83, 0xac, 0xd5, 0xcd, 0x49, 0x4c, 0x2a, 0x06, 0xb3, 0xf4, 0x2b, 0x20, 0x81, 0x5c, 0x52, 0x59,
-	0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x5d, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8d, 0x54,
-	0x3b, 0x56, 0xe8, 0x01, 0x00, 0x00,
+	// 411 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0xcb, 0xd3, 0x30,
+	0x18, 0xc7, 0x5b, 0xdf, 0xba, 0x43, 0xde, 0x4d, 0xde, 0x65, 0x13, 0xba, 0x82, 0xe9, 0x50, 0x91,
+	0x5d, 0x6c, 0xd9, 0x3c, 0x78, 0xd5, 0x2a, 0x88, 0x17, 0x91, 0x0e, 0x14, 0x05, 0x29, 0xe9, 0x1a,
+	0xba, 0xe2, 0x96, 0xd4, 0x26, 0xed, 0xe6, 0xb7, 0xf0, 0x63, 0x88, 0x9f, 0x64, 0xc7, 0x1d, 0x3d,
+	0x4d, 0xe9, 0xbe, 0x88, 0x34, 0x0d, 0xb3, 0xae, 0xf3, 0x96, 0x3c, 0xcf, 0xef, 0xf9, 0xff, 0x1e,
+	0x42, 0x80, 0xfd, 0x19, 0x17, 0xd8, 0x25, 0x38, 0xa3, 0x6e, 0x31, 0x0d, 0x89, 0xc0, 0x53, 0x37,
+	0x26, 0x94, 0xf0, 0x84, 0x3b, 0x69, 0xc6, 0x04, 0x83, 0xfd, 0x0a, 0x70, 0x2a, 0xc0, 0x51, 0x80,
+	0x35, 0x8c, 0x59, 0xcc, 0x64, 0xd7, 0xad, 0x4e, 0x35, 0x68, 0xa1, 0x76, 0x52, 0x8a, 0x33, 0xbc,
+	0x56, 0x41, 0xd6, 0xbd, 0x76, 0xbf, 0xc0, 0xf9, 0x4a, 0xd4, 0xed, 0xfb, 0xdf, 0x0d, 0xd0, 0x7d,
+	0x55, 0x9b, 0xe7, 0x02, 0x0b, 0x02, 0x9f, 0x82, 0x4e, 0x3d, 0x6f, 0xea, 0x63, 0x7d, 0x72, 0x3d,
+	0x1b, 0x39, 0xad, 0x4d, 0x9c, 0xb7, 0x12, 0xf0, 0x8c, 0xdd, 0xc1, 0xd6, 0x7c, 0x85, 0xc3, 0x0f,
+	0xa0, 0x27, 0x83, 0x83, 0x8c, 0x2c, 0x58, 0x16, 0x71, 0xf3, 0xd6, 0xf8, 0x6a, 0x72, 0x3d, 0x43,
+	0x17, 0xe6, 0xdf, 0x55, 0x9c, 0x2f, 0x31, 0x6f, 0x58, 0x85, 0xfc, 0xf8, 0x65, 0x77, 0x1b, 0x45,
+	0xee, 0x77, 0x8b, 0xc6, 0x0d, 0x52, 0x30, 0xa8, 0xa3, 0xf9, 0x12, 0x67, 0xe4, 0x24, 0xb8, 0x92,
+	0x82, 0x07, 0xff, 0x13, 0xcc, 0x2b, 0x58, 0x59, 0x46, 0xca, 0xd2, 0x3f, 0xef, 0x70, 0xbf, 0x5f,
+	0x9c, 0x97, 0xe0, 0x17, 0x30, 0x48, 0x09, 0x8d, 0x12, 0x1a, 0x07, 0x9b, 0x44, 0x2c, 0xa3, 0x0c,
+	0x6f, 0xf0, 0x8a, 0x9b, 0x86, 0xf4, 0x3d, 0xbc, 0xf4, 0x20, 0x35, 0xfd, 0xfe, 0x04, 0x7b, 0x96,
+	0x12, 0xc2, 0x56, 0x8b, 0xfb, 0x30, 0x6d, 0xd5, 0xa0, 0x07, 0x20, 0x25, 0x5b, 0xd1, 0xf0, 0x05,
+	0x49, 0x64, 0xde, 0x1e, 0xeb, 0x13, 0xc3, 0x1b, 0x96, 0x07, 0xfb, 0xe6, 0x0d, 0xd9, 0x8a, 0xbf,
+	0x03, 0xaf, 0x5f, 0xfa, 0x37, 0xf4, 0xdf, 0x4a, 0x04, 0x3f, 0x81, 0x3b, 0x38, 0x17, 0x2c, 0x58,
+	0xb0, 0x75, 0xca, 0x72, 0x1a, 0x71, 0xb3, 0x23, 0x37, 0xb6, 0x2f, 0x6c, 0xfc, 0x3c, 0x17, 0xec,
+	0x85, 0xe2, 0xbc, 0xbb, 0x6a, 0xd9, 0x5e, 0xb3, 0xca, 0xfd, 0x1e, 0x6e, 0x5e, 0xbd, 0x67, 0xbb,
+	0x12, 0xe9, 0xfb, 0x12, 0xe9, 0xbf, 0x4b, 0xa4, 0x7f, 0x3b, 0x22, 0x6d, 0x7f, 0x44, 0xda, 0xcf,
+	0x23, 0xd2, 0x3e, 0x3e, 0x8a, 0x13, 0xb1, 0xcc, 0x43, 0x67, 0xc1, 0xd6, 0x6e, 0xa5, 0x7a, 0xbc,
+	0xc2, 0x21, 0x97, 0x27, 0x77, 0x5b, 0x7f, 0x3d, 0xf1, 0x35, 0x25, 0x3c, 0xec, 0xc8, 0x3f, 0xf7,
+	0xe4, 0xcf, 0x00, 0xa9, 0x07, 0xe8, 0x53, 0xfe, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -136,6 +170,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoCompounds) > 0 {
+		for iNdEx := len(m.AutoCompounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.NextWithdrawalID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextWithdrawalID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PendingWithdrawals) > 0 {
+		for iNdEx := len(m.PendingWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingWithdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.VaultShareRecords) > 0 {
 		for iNdEx := len(m.VaultShareRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -208,6 +275,21 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PendingWithdrawals) > 0 {
+		for _, e := range m.PendingWithdrawals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextWithdrawalID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextWithdrawalID))
+	}
+	if len(m.AutoCompounds) > 0 {
+		for _, e := range m.AutoCompounds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -347,6 +429,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingWithdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingWithdrawals = append(m.PendingWithdrawals, PendingWithdrawal{})
+			if err := m.PendingWithdrawals[len(m.PendingWithdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextWithdrawalID", wireType)
+			}
+			m.NextWithdrawalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextWithdrawalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompounds = append(m.AutoCompounds, AutoCompound{})
+			if err := m.AutoCompounds[len(m.AutoCompounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,34 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// MultiEarnHooks combine multiple earn hooks, all hook functions are run in array sequence
+type MultiEarnHooks []EarnHooks
+
+var _ EarnHooks = MultiEarnHooks{}
+
+// NewMultiEarnHooks returns a new MultiEarnHooks
+func NewMultiEarnHooks(hooks ...EarnHooks) MultiEarnHooks {
+	return hooks
+}
+
+// AfterVaultDepositCreated runs after a vault deposit is created
+func (h MultiEarnHooks) AfterVaultDepositCreated(ctx sdk.Context, vaultDenom string, depositor sdk.AccAddress, sharesOwned sdk.Dec) {
+	for i := range h {
+		h[i].AfterVaultDepositCreated(ctx, vaultDenom, depositor, sharesOwned)
+	}
+}
+
+// BeforeVaultDepositModified runs before a vault deposit is modified
+func (h MultiEarnHooks) BeforeVaultDepositModified(ctx sdk.Context, vaultDenom string, depositor sdk.AccAddress, sharesOwned sdk.Dec) {
+	for i := range h {
+		h[i].BeforeVaultDepositModified(ctx, vaultDenom, depositor, sharesOwned)
+	}
+}
+
+// AfterVaultSharesModified runs after the vault shares of an account are modified
+func (h MultiEarnHooks) AfterVaultSharesModified(ctx sdk.Context, owner sdk.AccAddress, vaultDenom string, oldShares, newShares sdk.Dec) {
+	for i := range h {
+		h[i].AfterVaultSharesModified(ctx, owner, vaultDenom, oldShares, newShares)
+	}
+}
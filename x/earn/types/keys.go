@@ -1,6 +1,10 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName name that will be used throughout the module
@@ -23,6 +27,13 @@ const (
 var (
 	VaultRecordKeyPrefix      = []byte{0x01} // denom -> vault
 	VaultShareRecordKeyPrefix = []byte{0x02} // depositor address -> vault shares
+
+	PendingWithdrawalKeyPrefix      = []byte{0x03} // id -> pending withdrawal
+	PendingWithdrawalByHeightPrefix = []byte{0x04} // release height | id -> id
+	PendingWithdrawalTotalKeyPrefix = []byte{0x05} // denom -> unreleased pending withdrawal amount
+	NextWithdrawalIDKey             = []byte{0x06} // next pending withdrawal id
+
+	AutoCompoundKeyPrefix = []byte{0x07} // depositor address -> auto compound
 )
 
 // VaultKey returns a key generated from a vault denom
@@ -34,3 +45,37 @@ func VaultKey(denom string) []byte {
 func DepositorVaultSharesKey(depositor sdk.AccAddress) []byte {
 	return depositor.Bytes()
 }
+
+// AutoCompoundKey returns a key from a depositor address
+func AutoCompoundKey(depositor sdk.AccAddress) []byte {
+	return depositor.Bytes()
+}
+
+// PendingWithdrawalKey returns a key from a pending withdrawal id
+func PendingWithdrawalKey(id uint64) []byte {
+	return Uint64ToBytes(id)
+}
+
+// PendingWithdrawalHeightPrefix returns the key prefix of the pending
+// withdrawals released at a block height.
+func PendingWithdrawalHeightPrefix(releaseHeight int64) []byte {
+	return Uint64ToBytes(uint64(releaseHeight))
+}
+
+// PendingWithdrawalByHeightKey returns a key from a release height and a
+// pending withdrawal id, ordered by release height.
+func PendingWithdrawalByHeightKey(releaseHeight int64, id uint64) []byte {
+	return append(PendingWithdrawalHeightPrefix(releaseHeight), Uint64ToBytes(id)...)
+}
+
+// Uint64ToBytes converts a uint64 into fixed length bytes for use in store keys.
+func Uint64ToBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// Uint64FromBytes converts fixed length bytes back into a uint64.
+func Uint64FromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
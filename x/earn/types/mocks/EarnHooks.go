@@ -22,6 +22,11 @@ func (_m *EarnHooks) BeforeVaultDepositModified(ctx types.Context, vaultDenom st
 	_m.Called(ctx, vaultDenom, depositor, sharesOwned)
 }
 
+// AfterVaultSharesModified provides a mock function with given fields: ctx, owner, vaultDenom, oldShares, newShares
+func (_m *EarnHooks) AfterVaultSharesModified(ctx types.Context, owner types.AccAddress, vaultDenom string, oldShares types.Dec, newShares types.Dec) {
+	_m.Called(ctx, owner, vaultDenom, oldShares, newShares)
+}
+
 type mockConstructorTestingTNewEarnHooks interface {
 	mock.TestingT
 	Cleanup(func())
@@ -12,12 +12,26 @@ var (
 	_ sdk.Msg            = &MsgWithdraw{}
 	_ legacytx.LegacyMsg = &MsgDeposit{}
 	_ legacytx.LegacyMsg = &MsgWithdraw{}
+	_ sdk.Msg            = &MsgRequestWithdrawal{}
+	_ sdk.Msg            = &MsgClaimWithdrawal{}
+	_ legacytx.LegacyMsg = &MsgRequestWithdrawal{}
+	_ legacytx.LegacyMsg = &MsgClaimWithdrawal{}
+	_ sdk.Msg            = &MsgSetAutoCompound{}
+	_ legacytx.LegacyMsg = &MsgSetAutoCompound{}
+	_ sdk.Msg            = &MsgMigrateVault{}
+	_ legacytx.LegacyMsg = &MsgMigrateVault{}
 )
 
 // legacy message types
 const (
 	TypeMsgDeposit  = "earn_msg_deposit"
 	TypeMsgWithdraw = "earn_msg_withdraw"
+
+	TypeMsgRequestWithdrawal = "earn_msg_request_withdrawal"
+	TypeMsgClaimWithdrawal   = "earn_msg_claim_withdrawal"
+
+	TypeMsgSetAutoCompound = "earn_msg_set_auto_compound"
+	TypeMsgMigrateVault    = "earn_msg_migrate_vault"
 )
 
 // NewMsgDeposit returns a new MsgDeposit.
@@ -123,3 +137,218 @@ func (msg MsgWithdraw) Route() string {
 func (msg MsgWithdraw) Type() string {
 	return TypeMsgWithdraw
 }
+
+// NewMsgRequestWithdrawal returns a new MsgRequestWithdrawal.
+func NewMsgRequestWithdrawal(from string, amount sdk.Coin, strategy StrategyType) *MsgRequestWithdrawal {
+	return &MsgRequestWithdrawal{
+		From:     from,
+		Amount:   amount,
+		Strategy: strategy,
+	}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRequestWithdrawal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.From); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := msg.Amount.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if err := msg.Strategy.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRequestWithdrawal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRequestWithdrawal) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRequestWithdrawal) Route() string {
+	return RouterKey
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRequestWithdrawal) Type() string {
+	return TypeMsgRequestWithdrawal
+}
+
+// NewMsgClaimWithdrawal returns a new MsgClaimWithdrawal.
+func NewMsgClaimWithdrawal(from string, id uint64) *MsgClaimWithdrawal {
+	return &MsgClaimWithdrawal{
+		From: from,
+		ID:   id,
+	}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgClaimWithdrawal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.From); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if msg.ID == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "withdrawal id cannot be 0")
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgClaimWithdrawal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgClaimWithdrawal) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgClaimWithdrawal) Route() string {
+	return RouterKey
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgClaimWithdrawal) Type() string {
+	return TypeMsgClaimWithdrawal
+}
+
+// NewMsgSetAutoCompound returns a new MsgSetAutoCompound.
+func NewMsgSetAutoCompound(depositor string, vaultDenom string, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		Depositor:  depositor,
+		VaultDenom: vaultDenom,
+		Enabled:    enabled,
+	}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSetAutoCompound) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if msg.Enabled {
+		if err := sdk.ValidateDenom(msg.VaultDenom); err != nil {
+			return errorsmod.Wrap(ErrInvalidVaultDenom, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{depositor}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgSetAutoCompound) Route() string {
+	return RouterKey
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgSetAutoCompound) Type() string {
+	return TypeMsgSetAutoCompound
+}
+
+// NewMsgMigrateVault returns a new MsgMigrateVault.
+func NewMsgMigrateVault(
+	authority string,
+	vaultDenom string,
+	fromStrategy StrategyType,
+	toStrategy StrategyType,
+) *MsgMigrateVault {
+	return &MsgMigrateVault{
+		Authority:    authority,
+		VaultDenom:   vaultDenom,
+		FromStrategy: fromStrategy,
+		ToStrategy:   toStrategy,
+	}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgMigrateVault) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(msg.VaultDenom); err != nil {
+		return errorsmod.Wrap(ErrInvalidVaultDenom, err.Error())
+	}
+
+	if err := msg.FromStrategy.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := msg.ToStrategy.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if msg.FromStrategy == msg.ToStrategy {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "from and to strategies must be different")
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgMigrateVault) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgMigrateVault) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{authority}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgMigrateVault) Route() string {
+	return RouterKey
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgMigrateVault) Type() string {
+	return TypeMsgMigrateVault
+}
@@ -5,9 +5,35 @@ import (
 	"strings"
 )
 
+// registeredStrategyTypes are the names of the strategy types registered by
+// external strategies, in addition to the built in hard and savings strategies.
+var registeredStrategyTypes = map[StrategyType]string{}
+
+// RegisterStrategyType registers the type and name of an external strategy so
+// it is valid in params and messages. It must be called at app wiring time,
+// before the strategy is registered with the earn keeper. Panics if the type
+// or name is already in use.
+func RegisterStrategyType(strategyType StrategyType, name string) {
+	if strategyType == STRATEGY_TYPE_UNSPECIFIED || strategyType.IsValid() {
+		panic(fmt.Sprintf("strategy type %s is already in use", strategyType))
+	}
+
+	name = strings.ToLower(name)
+	if NewStrategyTypeFromString(name) != STRATEGY_TYPE_UNSPECIFIED {
+		panic(fmt.Sprintf("strategy name %s is already in use", name))
+	}
+
+	registeredStrategyTypes[strategyType] = name
+}
+
 // IsValid returns true if the StrategyType status is valid and false otherwise.
 func (s StrategyType) IsValid() bool {
-	return s == STRATEGY_TYPE_HARD || s == STRATEGY_TYPE_SAVINGS
+	if s == STRATEGY_TYPE_HARD || s == STRATEGY_TYPE_SAVINGS {
+		return true
+	}
+
+	_, found := registeredStrategyTypes[s]
+	return found
 }
 
 // Validate returns an error if the StrategyType is invalid.
@@ -26,9 +52,15 @@ func NewStrategyTypeFromString(str string) StrategyType {
 		return STRATEGY_TYPE_HARD
 	case "savings":
 		return STRATEGY_TYPE_SAVINGS
-	default:
-		return STRATEGY_TYPE_UNSPECIFIED
 	}
+
+	for strategyType, name := range registeredStrategyTypes {
+		if name == strings.ToLower(str) {
+			return strategyType
+		}
+	}
+
+	return STRATEGY_TYPE_UNSPECIFIED
 }
 
 // StrategyTypes defines a slice of StrategyType
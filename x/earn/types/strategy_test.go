@@ -118,3 +118,27 @@ func TestValidateStrategyTypes(t *testing.T) {
 		})
 	}
 }
+
+func TestRegisterStrategyType(t *testing.T) {
+	strategyType := types.StrategyType(100)
+	require.False(t, strategyType.IsValid())
+
+	types.RegisterStrategyType(strategyType, "Liquid")
+
+	require.True(t, strategyType.IsValid())
+	require.NoError(t, types.StrategyTypes{strategyType}.Validate())
+	require.Equal(t, strategyType, types.NewStrategyTypeFromString("liquid"))
+
+	require.PanicsWithValue(t, "strategy type 100 is already in use", func() {
+		types.RegisterStrategyType(strategyType, "other")
+	})
+	require.PanicsWithValue(t, "strategy type STRATEGY_TYPE_HARD is already in use", func() {
+		types.RegisterStrategyType(types.STRATEGY_TYPE_HARD, "other")
+	})
+	require.PanicsWithValue(t, "strategy type STRATEGY_TYPE_UNSPECIFIED is already in use", func() {
+		types.RegisterStrategyType(types.STRATEGY_TYPE_UNSPECIFIED, "other")
+	})
+	require.PanicsWithValue(t, "strategy name savings is already in use", func() {
+		types.RegisterStrategyType(types.StrategyType(101), "Savings")
+	})
+}
@@ -93,10 +93,12 @@ func NewAllowedVault(
 	allowedDepositors []sdk.AccAddress,
 ) AllowedVault {
 	return AllowedVault{
-		Denom:             denom,
-		Strategies:        strategyTypes,
-		IsPrivateVault:    isPrivateVault,
-		AllowedDepositors: allowedDepositors,
+		Denom:               denom,
+		Strategies:          strategyTypes,
+		IsPrivateVault:      isPrivateVault,
+		AllowedDepositors:   allowedDepositors,
+		DepositCap:          sdk.ZeroInt(),
+		AccountDepositLimit: sdk.ZeroInt(),
 	}
 }
 
@@ -116,9 +118,28 @@ func (a *AllowedVault) Validate() error {
 		return fmt.Errorf("non-private vaults cannot have any AllowedDepositors")
 	}
 
+	if !a.DepositCap.IsNil() && a.DepositCap.IsNegative() {
+		return fmt.Errorf("deposit cap cannot be negative: %s", a.DepositCap)
+	}
+
+	if !a.AccountDepositLimit.IsNil() && a.AccountDepositLimit.IsNegative() {
+		return fmt.Errorf("account deposit limit cannot be negative: %s", a.AccountDepositLimit)
+	}
+
 	return a.Strategies.Validate()
 }
 
+// HasDepositCap returns true if the total value of the vault is capped.
+func (a *AllowedVault) HasDepositCap() bool {
+	return !a.DepositCap.IsNil() && a.DepositCap.IsPositive()
+}
+
+// HasAccountDepositLimit returns true if the value each account can hold in
+// the vault is limited.
+func (a *AllowedVault) HasAccountDepositLimit() bool {
+	return !a.AccountDepositLimit.IsNil() && a.AccountDepositLimit.IsPositive()
+}
+
 // IsStrategyAllowed returns true if the given strategy type is allowed for the
 // vault.
 func (a *AllowedVault) IsStrategyAllowed(strategy StrategyType) bool {
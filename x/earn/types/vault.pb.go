@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -40,6 +41,18 @@ type AllowedVault struct {
 	// are not allowed to deposit into this vault. If IsPrivateVault is false,
 	// this should be empty and ignored.
 	AllowedDepositors []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,rep,name=allowed_depositors,json=allowedDepositors,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"allowed_depositors,omitempty"`
+	// WithdrawalDelayBlocks is the number of blocks a withdrawal must wait
+	// between being requested and being claimable. If zero, withdrawals are
+	// immediate. If non-zero, withdrawals must be requested and later claimed,
+	// for strategies where the underlying liquidity may be temporarily locked.
+	WithdrawalDelayBlocks uint64 `protobuf:"varint,5,opt,name=withdrawal_delay_blocks,json=withdrawalDelayBlocks,proto3" json:"withdrawal_delay_blocks,omitempty"`
+	// DepositCap is the maximum total value of denom coins the vault can hold.
+	// Deposits that would exceed it are rejected. If zero, there is no cap.
+	DepositCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=deposit_cap,json=depositCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"deposit_cap"`
+	// AccountDepositLimit is the maximum value of denom coins a single account
+	// can hold in the vault. Deposits that would exceed it are rejected. If
+	// zero, there is no limit.
+	AccountDepositLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=account_deposit_limit,json=accountDepositLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"account_deposit_limit"`
 }
 
 func (m *AllowedVault) Reset()         { *m = AllowedVault{} }
@@ -103,6 +116,13 @@ func (m *AllowedVault) GetAllowedDepositors() []github_com_cosmos_cosmos_sdk_typ
 	return nil
 }
 
+func (m *AllowedVault) GetWithdrawalDelayBlocks() uint64 {
+	if m != nil {
+		return m.WithdrawalDelayBlocks
+	}
+	return 0
+}
+
 // VaultRecord is the state of a vault.
 type VaultRecord struct {
 	// TotalShares is the total distributed number of shares in the vault.
@@ -249,48 +269,206 @@ func (m *VaultShare) GetDenom() string {
 	return ""
 }
 
+// PendingWithdrawal defines a requested withdrawal from a vault that has a
+// withdrawal delay.
+type PendingWithdrawal struct {
+	// ID is the unique identifier of the withdrawal.
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Owner is the address the withdrawn funds are claimable by.
+	Owner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	// Amount is the amount of vault denom coins withdrawn.
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// ReleaseHeight is the block height after which the funds are withdrawn
+	// from the vault strategy.
+	ReleaseHeight int64 `protobuf:"varint,4,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty"`
+	// Released is true if the funds have been withdrawn from the vault strategy
+	// and the withdrawal can be claimed.
+	Released bool `protobuf:"varint,5,opt,name=released,proto3" json:"released,omitempty"`
+}
+
+func (m *PendingWithdrawal) Reset()         { *m = PendingWithdrawal{} }
+func (m *PendingWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*PendingWithdrawal) ProtoMessage()    {}
+func (*PendingWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_884eb89509fbdc04, []int{4}
+}
+func (m *PendingWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingWithdrawal.Merge(m, src)
+}
+func (m *PendingWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingWithdrawal proto.InternalMessageInfo
+
+func (m *PendingWithdrawal) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *PendingWithdrawal) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *PendingWithdrawal) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *PendingWithdrawal) GetReleaseHeight() int64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
+}
+
+func (m *PendingWithdrawal) GetReleased() bool {
+	if m != nil {
+		return m.Released
+	}
+	return false
+}
+
+// AutoCompound defines a depositor that opted in to having their earn
+// incentive rewards re-deposited into a vault when claimed.
+type AutoCompound struct {
+	// Depositor is the address whose claimed rewards are compounded.
+	Depositor github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=depositor,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"depositor,omitempty"`
+	// VaultDenom is the denom of the vault the rewards are deposited into.
+	VaultDenom string `protobuf:"bytes,2,opt,name=vault_denom,json=vaultDenom,proto3" json:"vault_denom,omitempty"`
+}
+
+func (m *AutoCompound) Reset()         { *m = AutoCompound{} }
+func (m *AutoCompound) String() string { return proto.CompactTextString(m) }
+func (*AutoCompound) ProtoMessage()    {}
+func (*AutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_884eb89509fbdc04, []int{5}
+}
+func (m *AutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompound.Merge(m, src)
+}
+func (m *AutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompound proto.InternalMessageInfo
+
+func (m *AutoCompound) GetDepositor() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Depositor
+	}
+	return nil
+}
+
+func (m *AutoCompound) GetVaultDenom() string {
+	if m != nil {
+		return m.VaultDenom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*AllowedVault)(nil), "kava.earn.v1beta1.AllowedVault")
 	proto.RegisterType((*VaultRecord)(nil), "kava.earn.v1beta1.VaultRecord")
 	proto.RegisterType((*VaultShareRecord)(nil), "kava.earn.v1beta1.VaultShareRecord")
 	proto.RegisterType((*VaultShare)(nil), "kava.earn.v1beta1.VaultShare")
+	proto.RegisterType((*PendingWithdrawal)(nil), "kava.earn.v1beta1.PendingWithdrawal")
+	proto.RegisterType((*AutoCompound)(nil), "kava.earn.v1beta1.AutoCompound")
 }
 
 func init() { proto.RegisterFile("kava/earn/v1beta1/vault.proto", fileDescriptor_884eb89509fbdc04) }
 
 var fileDescriptor_884eb89509fbdc04 = []byte{
-	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xb7, 0x93, 0x10, 0xd1, 0x4b, 0xa8, 0x1a, 0xb7, 0x83, 0xa9, 0x54, 0xdb, 0xca, 0x80, 0xbc,
-	0xd8, 0x56, 0xcb, 0x86, 0x18, 0x88, 0x15, 0x21, 0xc4, 0x84, 0xae, 0x85, 0x81, 0x25, 0xba, 0xd8,
-	0x47, 0x6a, 0xd5, 0xc9, 0x45, 0x7e, 0x17, 0x97, 0x2c, 0x7c, 0x06, 0x46, 0x24, 0x16, 0xe6, 0xce,
-	0xfd, 0x0c, 0xa8, 0x63, 0xd5, 0x09, 0x31, 0xa4, 0x28, 0xf9, 0x16, 0x4c, 0xe8, 0xfe, 0xa8, 0x8e,
-	0x14, 0x10, 0x0c, 0x4c, 0xb9, 0xfb, 0xbd, 0xf7, 0x7e, 0x7f, 0x5e, 0x7c, 0xe8, 0xe0, 0x8c, 0x94,
-	0x24, 0xa2, 0xa4, 0x98, 0x44, 0xe5, 0xe1, 0x90, 0x72, 0x72, 0x18, 0x95, 0x64, 0x96, 0xf3, 0x70,
-	0x5a, 0x30, 0xce, 0xac, 0x8e, 0x28, 0x87, 0xa2, 0x1c, 0xea, 0xf2, 0xfe, 0xc3, 0x84, 0xc1, 0x98,
-	0xc1, 0x40, 0x36, 0x44, 0xea, 0xa2, 0xba, 0xf7, 0xf7, 0x46, 0x6c, 0xc4, 0x14, 0x2e, 0x4e, 0x1a,
-	0xf5, 0x36, 0x25, 0x80, 0x17, 0x84, 0xd3, 0xd1, 0x5c, 0x75, 0x74, 0x3f, 0xd7, 0x50, 0xbb, 0x97,
-	0xe7, 0xec, 0x9c, 0xa6, 0x6f, 0x84, 0xb8, 0xb5, 0x87, 0xee, 0xa5, 0x74, 0xc2, 0xc6, 0xb6, 0xe9,
-	0x99, 0xfe, 0x16, 0x56, 0x17, 0x0b, 0x23, 0xa4, 0x07, 0x33, 0x0a, 0x76, 0xcd, 0xab, 0xfb, 0xdb,
-	0x47, 0x6e, 0xb8, 0xe1, 0x30, 0x3c, 0xd6, 0xec, 0x27, 0xf3, 0x29, 0x8d, 0x3b, 0x17, 0xb7, 0xee,
-	0x83, 0x75, 0x04, 0xf0, 0x1a, 0x8b, 0xe5, 0xa3, 0x9d, 0x4c, 0x64, 0xc9, 0x4a, 0xc2, 0xe9, 0x40,
-	0x46, 0xb7, 0xeb, 0x9e, 0xe9, 0xdf, 0xc7, 0xdb, 0x19, 0xbc, 0x52, 0xb0, 0xf2, 0x74, 0x8e, 0x2c,
-	0xa2, 0x3c, 0x0e, 0x52, 0x3a, 0x65, 0x90, 0x71, 0x56, 0x80, 0xdd, 0xf0, 0xea, 0x7e, 0x3b, 0x7e,
-	0xf1, 0x73, 0xe1, 0x06, 0xa3, 0x8c, 0x9f, 0xce, 0x86, 0x61, 0xc2, 0xc6, 0x7a, 0x2b, 0xfa, 0x27,
-	0x80, 0xf4, 0x2c, 0xe2, 0x42, 0x39, 0xec, 0x25, 0x49, 0x2f, 0x4d, 0x0b, 0x0a, 0x70, 0x73, 0x19,
-	0xec, 0xea, 0xdd, 0x69, 0x24, 0x9e, 0x73, 0x0a, 0xb8, 0xa3, 0x35, 0xfa, 0x77, 0x12, 0xdd, 0xd7,
-	0xa8, 0x25, 0x1d, 0x60, 0x9a, 0xb0, 0x22, 0xb5, 0x9e, 0xa3, 0x36, 0x67, 0x9c, 0xe4, 0x03, 0x38,
-	0x25, 0x05, 0x05, 0xb9, 0xa2, 0xd6, 0xd1, 0xc1, 0x6f, 0xf6, 0x20, 0xa7, 0x8e, 0x45, 0x57, 0xdc,
-	0xb8, 0x5a, 0xb8, 0x06, 0x6e, 0xc9, 0x41, 0x89, 0x40, 0xf7, 0xab, 0x89, 0x76, 0xaa, 0x0e, 0x4d,
-	0xfe, 0x0e, 0x6d, 0xdd, 0x85, 0x93, 0xcc, 0xff, 0x33, 0x5b, 0x45, 0x6d, 0xbd, 0x44, 0x4d, 0x6d,
-	0x5f, 0xfc, 0x8d, 0x7f, 0xb5, 0xbf, 0x2b, 0xec, 0x5f, 0xdc, 0xba, 0xad, 0x0a, 0x03, 0xac, 0x19,
-	0xba, 0x1f, 0x10, 0xaa, 0xe0, 0x3f, 0x7c, 0x3a, 0x27, 0xa8, 0x49, 0xc6, 0x6c, 0x36, 0xe1, 0x76,
-	0x4d, 0xc0, 0xf1, 0x53, 0x41, 0xf8, 0x7d, 0xe1, 0x3e, 0xfa, 0x87, 0x60, 0x7d, 0x9a, 0xdc, 0x5c,
-	0x06, 0x48, 0x27, 0xea, 0xd3, 0x04, 0x6b, 0xae, 0x27, 0x8d, 0x4f, 0x5f, 0x5c, 0x23, 0x7e, 0x76,
-	0xb5, 0x74, 0xcc, 0xeb, 0xa5, 0x63, 0xfe, 0x58, 0x3a, 0xe6, 0xc7, 0x95, 0x63, 0x5c, 0xaf, 0x1c,
-	0xe3, 0xdb, 0xca, 0x31, 0xde, 0xae, 0xb3, 0x8b, 0x7c, 0x41, 0x4e, 0x86, 0x20, 0x4f, 0xd1, 0x7b,
-	0xf5, 0x20, 0xa4, 0xc2, 0xb0, 0x29, 0x9f, 0xc1, 0xe3, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa0,
-	0xa9, 0xba, 0x64, 0x8d, 0x03, 0x00, 0x00,
+	// 727 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x41, 0x6b, 0x13, 0x4d,
+	0x18, 0xce, 0x26, 0x69, 0xbe, 0x76, 0x92, 0x96, 0x66, 0xda, 0x7e, 0xdf, 0xb6, 0xd0, 0x6c, 0x08,
+	0x7c, 0x92, 0x4b, 0x36, 0xb4, 0x82, 0x82, 0x78, 0x30, 0xdb, 0x20, 0xad, 0x78, 0x28, 0xd3, 0xaa,
+	0x20, 0xe8, 0x32, 0xd9, 0x1d, 0x93, 0xa1, 0x9b, 0x9d, 0xb0, 0x33, 0x49, 0xcc, 0xc5, 0xab, 0x57,
+	0x6f, 0x7a, 0xf4, 0xe0, 0xa9, 0xe7, 0xfe, 0x06, 0xe9, 0xb1, 0xf4, 0x24, 0x1e, 0x52, 0x49, 0xff,
+	0x85, 0x27, 0x99, 0xd9, 0x71, 0x13, 0xa8, 0xa2, 0x42, 0xf1, 0xb4, 0x3b, 0xcf, 0xfb, 0xbe, 0xcf,
+	0x3c, 0xf3, 0xbe, 0xcf, 0x0c, 0xd8, 0x3c, 0xc2, 0x03, 0x5c, 0x27, 0x38, 0x0a, 0xeb, 0x83, 0xad,
+	0x16, 0x11, 0x78, 0xab, 0x3e, 0xc0, 0xfd, 0x40, 0xd8, 0xbd, 0x88, 0x09, 0x06, 0x8b, 0x32, 0x6c,
+	0xcb, 0xb0, 0xad, 0xc3, 0x1b, 0x25, 0x8f, 0xf1, 0x2e, 0xe3, 0xf5, 0x16, 0xe6, 0x24, 0xa9, 0xf1,
+	0x18, 0x0d, 0xe3, 0x92, 0x8d, 0xf5, 0x38, 0xee, 0xaa, 0x55, 0x3d, 0x5e, 0xe8, 0xd0, 0x6a, 0x9b,
+	0xb5, 0x59, 0x8c, 0xcb, 0x3f, 0x8d, 0x96, 0xaf, 0x4a, 0xe0, 0x22, 0xc2, 0x82, 0xb4, 0x47, 0x71,
+	0x46, 0xe5, 0x43, 0x16, 0x14, 0x1a, 0x41, 0xc0, 0x86, 0xc4, 0x7f, 0x2c, 0xc5, 0xc1, 0x55, 0x30,
+	0xe7, 0x93, 0x90, 0x75, 0x4d, 0xa3, 0x6c, 0x54, 0x17, 0x50, 0xbc, 0x80, 0x08, 0x00, 0x5d, 0x48,
+	0x09, 0x37, 0xd3, 0xe5, 0x4c, 0x75, 0x69, 0xdb, 0xb2, 0xaf, 0x9c, 0xc0, 0x3e, 0xd0, 0xec, 0x87,
+	0xa3, 0x1e, 0x71, 0x8a, 0xc7, 0x17, 0xd6, 0xe2, 0x2c, 0xc2, 0xd1, 0x0c, 0x0b, 0xac, 0x82, 0x65,
+	0x2a, 0xcf, 0x42, 0x07, 0x58, 0x10, 0x57, 0xb5, 0xc6, 0xcc, 0x94, 0x8d, 0xea, 0x3c, 0x5a, 0xa2,
+	0x7c, 0x3f, 0x86, 0x63, 0x4d, 0x43, 0x00, 0x71, 0xac, 0xd1, 0xf5, 0x49, 0x8f, 0x71, 0x2a, 0x58,
+	0xc4, 0xcd, 0x6c, 0x39, 0x53, 0x2d, 0x38, 0xbb, 0x5f, 0xc7, 0x56, 0xad, 0x4d, 0x45, 0xa7, 0xdf,
+	0xb2, 0x3d, 0xd6, 0xd5, 0x5d, 0xd1, 0x9f, 0x1a, 0xf7, 0x8f, 0xea, 0x42, 0xee, 0x6c, 0x37, 0x3c,
+	0xaf, 0xe1, 0xfb, 0x11, 0xe1, 0xfc, 0xfc, 0xa4, 0xb6, 0xa2, 0x7b, 0xa7, 0x11, 0x67, 0x24, 0x08,
+	0x47, 0x45, 0xbd, 0x47, 0x33, 0xd9, 0x02, 0xde, 0x02, 0xff, 0x0d, 0xa9, 0xe8, 0xf8, 0x11, 0x1e,
+	0xe2, 0xc0, 0xf5, 0x49, 0x80, 0x47, 0x6e, 0x2b, 0x60, 0xde, 0x11, 0x37, 0xe7, 0xca, 0x46, 0x35,
+	0x8b, 0xd6, 0xa6, 0xe1, 0xa6, 0x8c, 0x3a, 0x2a, 0x08, 0x9f, 0x81, 0xbc, 0x16, 0xea, 0x7a, 0xb8,
+	0x67, 0xe6, 0x64, 0x2b, 0x9d, 0xbb, 0xa7, 0x63, 0x2b, 0xf5, 0x79, 0x6c, 0xdd, 0xf8, 0x0d, 0xb5,
+	0x7b, 0xa1, 0x38, 0x3f, 0xa9, 0x01, 0x2d, 0x73, 0x2f, 0x14, 0x08, 0x68, 0xc2, 0x1d, 0xdc, 0x83,
+	0x3d, 0xb0, 0x86, 0x3d, 0x8f, 0xf5, 0x43, 0xf1, 0xbd, 0x1f, 0x6e, 0x40, 0xbb, 0x54, 0x98, 0xff,
+	0x5c, 0xc3, 0x46, 0x2b, 0x9a, 0x5a, 0xb7, 0xe1, 0xa1, 0x24, 0xae, 0x3c, 0x02, 0x79, 0x35, 0x0a,
+	0x44, 0x3c, 0x16, 0xf9, 0xf0, 0x3e, 0x28, 0x08, 0x26, 0x70, 0xe0, 0xf2, 0x0e, 0x8e, 0x08, 0x57,
+	0x5e, 0xc9, 0x6f, 0x6f, 0xfe, 0xc0, 0x10, 0xaa, 0xea, 0x40, 0x66, 0x39, 0x59, 0x29, 0x0b, 0xe5,
+	0x55, 0xa1, 0x42, 0x78, 0xe5, 0xa3, 0x01, 0x96, 0xa7, 0x19, 0x9a, 0xfc, 0x05, 0x58, 0x48, 0xa6,
+	0xac, 0x98, 0xaf, 0x73, 0xc8, 0x53, 0x6a, 0xf8, 0x00, 0xe4, 0xb4, 0x7c, 0xe9, 0xe7, 0x5f, 0xca,
+	0x5f, 0x91, 0xf2, 0x8f, 0x2f, 0xac, 0xfc, 0x14, 0xe3, 0x48, 0x33, 0x54, 0x5e, 0x01, 0x30, 0x85,
+	0x7f, 0x72, 0x87, 0x0e, 0x41, 0x0e, 0x77, 0x65, 0x67, 0xcd, 0xf4, 0x1f, 0x8f, 0xa9, 0x49, 0xbc,
+	0x99, 0x31, 0x35, 0x89, 0x87, 0x34, 0xd7, 0x9d, 0xec, 0xbb, 0xf7, 0x56, 0xaa, 0xf2, 0x3a, 0x0d,
+	0x8a, 0xfb, 0x24, 0xf4, 0x69, 0xd8, 0x7e, 0x92, 0x38, 0x12, 0xfe, 0x0b, 0xd2, 0xd4, 0x57, 0x22,
+	0xb2, 0x4e, 0x6e, 0x32, 0xb6, 0xd2, 0x7b, 0x4d, 0x94, 0xa6, 0x3e, 0x7c, 0x0e, 0xe6, 0xd8, 0x30,
+	0x24, 0x91, 0x99, 0xbe, 0xe6, 0xee, 0xc6, 0xb4, 0xf0, 0x76, 0x72, 0xd2, 0x8c, 0x32, 0xc6, 0xba,
+	0xad, 0x93, 0xe5, 0xc3, 0x96, 0xf4, 0x76, 0x87, 0xd1, 0x50, 0x9b, 0x42, 0xa7, 0xc3, 0xff, 0xc1,
+	0x52, 0x44, 0x02, 0x82, 0x39, 0x71, 0x3b, 0x84, 0xb6, 0x3b, 0xc2, 0xcc, 0x96, 0x8d, 0x6a, 0x06,
+	0x2d, 0x6a, 0x74, 0x57, 0x81, 0x70, 0x03, 0xcc, 0x6b, 0xc0, 0x57, 0xf7, 0x70, 0x1e, 0x25, 0xeb,
+	0xca, 0x5b, 0x03, 0x14, 0x1a, 0x7d, 0xc1, 0x76, 0x58, 0xb7, 0xc7, 0xfa, 0xe1, 0xdf, 0xb3, 0x93,
+	0x05, 0xf2, 0xea, 0x0d, 0x73, 0xe3, 0xd1, 0xab, 0x19, 0x23, 0xa0, 0xa0, 0xa6, 0x44, 0x9c, 0x7b,
+	0xa7, 0x93, 0x92, 0x71, 0x36, 0x29, 0x19, 0x5f, 0x26, 0x25, 0xe3, 0xcd, 0x65, 0x29, 0x75, 0x76,
+	0x59, 0x4a, 0x7d, 0xba, 0x2c, 0xa5, 0x9e, 0xce, 0x3a, 0x40, 0x7a, 0xb0, 0x16, 0xe0, 0x16, 0x57,
+	0x7f, 0xf5, 0x97, 0xf1, 0xeb, 0xad, 0xf4, 0xb4, 0x72, 0xea, 0xcd, 0xbe, 0xf9, 0x6d, 0x00, 0x14,
+	0x95, 0x27, 0x66, 0x5a, 0x06, 0x00, 0x00,
 }
 
 func (m *AllowedVault) Marshal() (dAtA []byte, err error) {
@@ -313,6 +491,31 @@ func (m *AllowedVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.AccountDepositLimit.Size()
+		i -= size
+		if _, err := m.AccountDepositLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.DepositCap.Size()
+		i -= size
+		if _, err := m.DepositCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.WithdrawalDelayBlocks != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.WithdrawalDelayBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.AllowedDepositors) > 0 {
 		for iNdEx := len(m.AllowedDepositors) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedDepositors[iNdEx])
@@ -477,6 +680,103 @@ func (m *VaultShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Released {
+		i--
+		if m.Released {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VaultDenom) > 0 {
+		i -= len(m.VaultDenom)
+		copy(dAtA[i:], m.VaultDenom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.VaultDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVault(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVault(v)
 	base := offset
@@ -514,6 +814,13 @@ func (m *AllowedVault) Size() (n int) {
 			n += 1 + l + sovVault(uint64(l))
 		}
 	}
+	if m.WithdrawalDelayBlocks != 0 {
+		n += 1 + sovVault(uint64(m.WithdrawalDelayBlocks))
+	}
+	l = m.DepositCap.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.AccountDepositLimit.Size()
+	n += 1 + l + sovVault(uint64(l))
 	return n
 }
 
@@ -562,6 +869,47 @@ func (m *VaultShare) Size() (n int) {
 	return n
 }
 
+func (m *PendingWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovVault(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovVault(uint64(l))
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovVault(uint64(m.ReleaseHeight))
+	}
+	if m.Released {
+		n += 2
+	}
+	return n
+}
+
+func (m *AutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = len(m.VaultDenom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	return n
+}
+
 func sovVault(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -750,25 +1098,112 @@ func (m *AllowedVault) Unmarshal(dAtA []byte) error {
 			m.AllowedDepositors = append(m.AllowedDepositors, make([]byte, postIndex-iNdEx))
 			copy(m.AllowedDepositors[len(m.AllowedDepositors)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipVault(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalDelayBlocks", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			m.WithdrawalDelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalDelayBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthVault
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
+			if err := m.DepositCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountDepositLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccountDepositLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
 	return nil
 }
 func (m *VaultRecord) Unmarshal(dAtA []byte) error {
@@ -1088,6 +1523,297 @@ func (m *VaultShare) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
+			}
+			m.ReleaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Released = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVault(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
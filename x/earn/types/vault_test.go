@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -308,6 +309,48 @@ func TestAllowedVaultsValidate(t *testing.T) {
 				contains:   "non-private vaults cannot have any AllowedDepositors",
 			},
 		},
+		{
+			name: "valid - deposit cap and account limit",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:               "usdx",
+					Strategies:          []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					DepositCap:          sdkmath.NewInt(1000),
+					AccountDepositLimit: sdkmath.NewInt(100),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - negative deposit cap",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:      "usdx",
+					Strategies: []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					DepositCap: sdkmath.NewInt(-1),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "deposit cap cannot be negative: -1",
+			},
+		},
+		{
+			name: "invalid - negative account deposit limit",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:               "usdx",
+					Strategies:          []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					AccountDepositLimit: sdkmath.NewInt(-1),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "account deposit limit cannot be negative: -1",
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultNextWithdrawalID is the id of the first pending withdrawal.
+const DefaultNextWithdrawalID uint64 = 1
+
+// NewPendingWithdrawal returns a new unreleased PendingWithdrawal.
+func NewPendingWithdrawal(
+	id uint64,
+	owner sdk.AccAddress,
+	amount sdk.Coin,
+	releaseHeight int64,
+) PendingWithdrawal {
+	return PendingWithdrawal{
+		ID:            id,
+		Owner:         owner,
+		Amount:        amount,
+		ReleaseHeight: releaseHeight,
+		Released:      false,
+	}
+}
+
+// Validate returns an error if a PendingWithdrawal is invalid.
+func (pw PendingWithdrawal) Validate() error {
+	if pw.ID == 0 {
+		return fmt.Errorf("pending withdrawal id cannot be 0")
+	}
+
+	if pw.Owner.Empty() {
+		return fmt.Errorf("pending withdrawal %d owner is empty", pw.ID)
+	}
+
+	if err := pw.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid pending withdrawal %d amount: %w", pw.ID, err)
+	}
+
+	if !pw.Amount.IsPositive() {
+		return fmt.Errorf("pending withdrawal %d amount must be positive", pw.ID)
+	}
+
+	if pw.ReleaseHeight <= 0 {
+		return fmt.Errorf("pending withdrawal %d release height must be positive", pw.ID)
+	}
+
+	return nil
+}
+
+// PendingWithdrawals is a slice of PendingWithdrawal.
+type PendingWithdrawals []PendingWithdrawal
+
+// Validate returns an error if a slice of PendingWithdrawals is invalid.
+func (pws PendingWithdrawals) Validate() error {
+	ids := make(map[uint64]bool)
+
+	for _, pw := range pws {
+		if err := pw.Validate(); err != nil {
+			return err
+		}
+
+		if ids[pw.ID] {
+			return fmt.Errorf("duplicate pending withdrawal id %d", pw.ID)
+		}
+
+		ids[pw.ID] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/earn/types"
+)
+
+func TestPendingWithdrawalsValidate(t *testing.T) {
+	type errArgs struct {
+		expectPass bool
+		contains   string
+	}
+
+	owner := sdk.AccAddress("owner1")
+
+	tests := []struct {
+		name        string
+		withdrawals types.PendingWithdrawals
+		errArgs     errArgs
+	}{
+		{
+			name: "valid",
+			withdrawals: types.PendingWithdrawals{
+				types.NewPendingWithdrawal(1, owner, sdk.NewInt64Coin("usdx", 100), 10),
+				types.NewPendingWithdrawal(2, owner, sdk.NewInt64Coin("ukava", 100), 10),
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name:        "valid - empty",
+			withdrawals: types.PendingWithdrawals{},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - zero id",
+			withdrawals: types.PendingWithdrawals{
+				types.NewPendingWithdrawal(0, owner, sdk.NewInt64Coin("usdx", 100), 10),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "pending withdrawal id cannot be 0",
+			},
+		},
+		{
+			name: "invalid - empty owner",
+			withdrawals: types.PendingWithdrawals{
+				types.NewPendingWithdrawal(1, nil, sdk.NewInt64Coin("usdx", 100), 10),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "pending withdrawal 1 owner is empty",
+			},
+		},
+		{
+			name: "invalid - zero amount",
+			withdrawals: types.PendingWithdrawals{
+				types.NewPendingWithdrawal(1, owner, sdk.NewInt64Coin("usdx", 0), 10),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "pending withdrawal 1 amount must be positive",
+			},
+		},
+		{
+			name: "invalid - zero release height",
+			withdrawals: types.PendingWithdrawals{
+				types.NewPendingWithdrawal(1, owner, sdk.NewInt64Coin("usdx", 100), 0),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "pending withdrawal 1 release height must be positive",
+			},
+		},
+		{
+			name: "invalid - duplicate id",
+			withdrawals: types.PendingWithdrawals{
+				types.NewPendingWithdrawal(1, owner, sdk.NewInt64Coin("usdx", 100), 10),
+				types.NewPendingWithdrawal(1, owner, sdk.NewInt64Coin("ukava", 100), 10),
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "duplicate pending withdrawal id 1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.withdrawals.Validate()
+
+			if tt.errArgs.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errArgs.contains)
+			}
+		})
+	}
+}
+
+func TestGenesisStateValidateNextWithdrawalID(t *testing.T) {
+	owner := sdk.AccAddress("owner1")
+	withdrawals := types.PendingWithdrawals{
+		types.NewPendingWithdrawal(3, owner, sdk.NewInt64Coin("usdx", 100), 10),
+	}
+
+	gs := types.DefaultGenesisState()
+	require.NoError(t, gs.Validate())
+
+	gs.NextWithdrawalID = 0
+	require.ErrorContains(t, gs.Validate(), "next withdrawal id cannot be 0")
+
+	gs.PendingWithdrawals = withdrawals
+	gs.NextWithdrawalID = 3
+	require.ErrorContains(t, gs.Validate(), "pending withdrawal id 3 must be less than next withdrawal id 3")
+
+	gs.NextWithdrawalID = 4
+	require.NoError(t, gs.Validate())
+}
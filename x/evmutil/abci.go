@@ -0,0 +1,28 @@
+package evmutil
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/evmutil/keeper"
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+// EndBlocker sweeps the next batch of fractional akava dust balances when the
+// dust sweep is enabled. A failed sweep is logged and discarded, so it cannot halt the chain.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if !k.GetParams(ctx).EnableDustSweep {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.SweepDust(cacheCtx); err != nil {
+		k.Logger(ctx).Error("failed to sweep dust", "error", err)
+		return
+	}
+	write()
+}
@@ -0,0 +1,43 @@
+package evmutil_test
+
+import (
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/evmutil"
+	"github.com/kava-labs/kava/x/evmutil/testutil"
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+type abciTestSuite struct {
+	testutil.Suite
+}
+
+func TestABCITestSuite(t *testing.T) {
+	suite.Run(t, new(abciTestSuite))
+}
+
+func (suite *abciTestSuite) TestEndBlocker_DustSweep() {
+	multiplier := suite.Keeper.GetParams(suite.Ctx).ConversionMultiplier
+	dust := multiplier.QuoRaw(2)
+	feeCollectorAddr := suite.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	suite.Require().NoError(suite.Keeper.SetBalance(suite.Ctx, feeCollectorAddr, dust))
+
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.DustSweepThreshold = multiplier
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	// disabled by default
+	evmutil.EndBlocker(suite.Ctx, suite.Keeper)
+	suite.Equal(dust, suite.Keeper.GetBalance(suite.Ctx, feeCollectorAddr))
+	suite.EventsDoNotContain(suite.GetEvents(), types.EventTypeSweepDust)
+
+	params.EnableDustSweep = true
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	evmutil.EndBlocker(suite.Ctx, suite.Keeper)
+	suite.True(suite.Keeper.GetBalance(suite.Ctx, feeCollectorAddr).IsZero())
+	suite.Equal(dust, suite.Keeper.GetBalance(suite.Ctx, suite.AccountKeeper.GetModuleAddress(types.ModuleName)))
+}
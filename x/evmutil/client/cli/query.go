@@ -26,6 +26,10 @@ func GetQueryCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		QueryParamsCmd(),
 		QueryDeployedCosmosCoinContractsCmd(),
+		QueryBackingStatusCmd(),
+		QueryConversionRecordsCmd(),
+		QueryCosmosCoinERC20BalanceCmd(),
+		QueryConversionRateLimitsCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -103,3 +107,134 @@ func QueryDeployedCosmosCoinContractsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// QueryBackingStatusCmd queries whether all akava minor balances are backed by the module account
+func QueryBackingStatusCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "backing-status",
+		Short: "Query whether all akava minor balances are backed by ukava in the module account",
+		Example: fmt.Sprintf(
+			"%[1]s q %[2]s backing-status",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BackingStatus(context.Background(), &types.QueryBackingStatusRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// QueryConversionRecordsCmd queries the log of recent conversions between sdk.Coin and ERC20
+func QueryConversionRecordsCmd() *cobra.Command {
+	cmdName := "conversion-records"
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("%s [flags]", cmdName),
+		Short: "Query the log of recent conversions between sdk.Coin and ERC20",
+		Example: fmt.Sprintf(
+			"%[1]s q %[2]s %[3]s\n%[1]s q %[2]s %[3]s --reverse --limit 10",
+			version.AppName, types.ModuleName, cmdName,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			page, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ConversionRecords(context.Background(), &types.QueryConversionRecordsRequest{
+				Pagination: page,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmdName)
+
+	return cmd
+}
+
+// QueryCosmosCoinERC20BalanceCmd queries the ERC20 balance of an address for a cosmos denom's deployed contract
+func QueryCosmosCoinERC20BalanceCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "cosmos-coin-erc20-balance [denom] [address]",
+		Short: "Query the ERC20 and native balances of an address for a cosmos coin with a deployed ERC20 contract",
+		Long:  "Query the ERC20 and native balances of an address for a cosmos coin with a deployed ERC20 contract. The address may be a bech32 or hex address.",
+		Example: fmt.Sprintf(
+			`%[1]s q %[2]s cosmos-coin-erc20-balance hard kava10wlnqzyss4accfqmyxwx5jy5x9nfkwh6qm7n4t
+%[1]s q %[2]s cosmos-coin-erc20-balance hard 0x7Bbf300890857b8c241b219C6a489431669b3aFA`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CosmosCoinERC20Balance(context.Background(), &types.QueryCosmosCoinERC20BalanceRequest{
+				CosmosDenom: args[0],
+				Address:     args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// QueryConversionRateLimitsCmd queries the conversion rate limits and their usage within the current window
+func QueryConversionRateLimitsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "conversion-rate-limits [denom]",
+		Short: "Query the conversion rate limits and the amount converted within their current windows",
+		Example: fmt.Sprintf(
+			`%[1]s q %[2]s conversion-rate-limits
+%[1]s q %[2]s conversion-rate-limits hard`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryConversionRateLimitsRequest{}
+			if len(args) > 0 {
+				req.Denom = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ConversionRateLimits(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -13,6 +14,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 
 	"github.com/kava-labs/kava/x/evmutil/types"
 )
@@ -30,8 +33,11 @@ func GetTxCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		getCmdConvertEvmERC20FromCoin(),
 		getCmdConvertEvmERC20ToCoin(),
+		getCmdConvertEvmERC20AndTransfer(),
 		getCmdMsgConvertCosmosCoinToERC20(),
 		getCmdMsgConvertCosmosCoinFromERC20(),
+		getCmdMsgConvertCosmosCoinsToERC20Batch(),
+		getCmdMsgSyncERC20Metadata(),
 	}
 
 	for _, cmd := range cmds {
@@ -124,6 +130,93 @@ func getCmdConvertEvmERC20ToCoin() *cobra.Command {
 	}
 }
 
+const (
+	flagPacketTimeoutHeight    = "packet-timeout-height"
+	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	flagMemo                   = "memo"
+)
+
+func getCmdConvertEvmERC20AndTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-evm-erc20-and-transfer [Kava ERC20 address] [amount] [src-channel] [receiver]",
+		Short: "EVM-native asset: converts an ERC20 on EVM co-chain to a coin and sends it to another chain over IBC",
+		Long: `Converts an ERC20 on EVM co-chain to a coin owned by the sender and sends the coin over IBC in a single transaction.
+If either step fails, neither is applied.
+The packet timeout timestamp is relative to the current local time. Set it to 0 to disable the timestamp timeout.`,
+		Example: fmt.Sprintf(`
+%[1]s tx %[2]s convert-evm-erc20-and-transfer 0xeA7100edA2f805356291B0E55DaD448599a72C6d 1000000000000000 channel-0 cosmos10wlnqzyss4accfqmyxwx5jy5x9nfkwh6qm7n4t --from <key> --gas 1000000
+`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			initiator, err := ParseAddrFromHexOrBech32(signer.String())
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("contractAddr '%s' is not a hex address", args[0])
+			}
+			contractAddr := types.NewInternalEVMAddress(common.HexToAddress(args[0]))
+
+			amount, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("amount '%s' is invalid", args[1])
+			}
+
+			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+			if err != nil {
+				return err
+			}
+			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+			if err != nil {
+				return err
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			if timeoutTimestamp != 0 {
+				timeoutTimestamp += uint64(time.Now().UnixNano())
+			}
+
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgConvertERC20AndTransfer(
+				types.NewInternalEVMAddress(initiator),
+				contractAddr,
+				amount,
+				args[2],
+				args[3],
+				timeoutHeight,
+				timeoutTimestamp,
+				memo,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(flagPacketTimeoutHeight, "0-0", "Packet timeout block height in the format {revision}-{height}. The timeout is disabled when set to 0-0.")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, ibctransfertypes.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. The timeout is disabled when set to 0.")
+	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
+
+	return cmd
+}
+
 func getCmdMsgConvertCosmosCoinToERC20() *cobra.Command {
 	return &cobra.Command{
 		Use:   "convert-cosmos-coin-to-erc20 [receiver_0x_address] [amount] [flags]",
@@ -199,3 +292,67 @@ func getCmdMsgConvertCosmosCoinFromERC20() *cobra.Command {
 		},
 	}
 }
+
+func getCmdMsgConvertCosmosCoinsToERC20Batch() *cobra.Command {
+	return &cobra.Command{
+		Use:   "convert-cosmos-coins-to-erc20-batch [receiver_0x_address] [amounts] [flags]",
+		Short: "Cosmos-native assets: converts multiple coins on Cosmos co-chain to their ERC20s on EVM co-chain",
+		Example: fmt.Sprintf(
+			`Convert 500 ATOM and 100 HARD and send ERC20s to 0x03db6b11F47d074a532b9eb8a98aB7AdA5845087:
+  %s tx %s convert-cosmos-coins-to-erc20-batch 0x03db6b11F47d074a532b9eb8a98aB7AdA5845087 100000000hard,500000000ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 --from <key> --gas 4000000`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			receiver := args[0]
+			if !common.IsHexAddress(receiver) {
+				return fmt.Errorf("receiver '%s' is an invalid hex address", args[0])
+			}
+
+			amounts, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgConvertCosmosCoinsToERC20Batch(signer.String(), receiver, amounts)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdMsgSyncERC20Metadata() *cobra.Command {
+	return &cobra.Command{
+		Use:   "sync-erc20-metadata [denom] [flags]",
+		Short: "Cosmos-native assets: redeploys a coin's ERC20 with its current bank denom metadata",
+		Long:  "Redeploys the ERC20 contract of a cosmos-native asset with the name, symbol, and decimals of its current bank denom metadata. Only possible while the deployed ERC20 has no outstanding supply.",
+		Example: fmt.Sprintf(
+			`%s tx %s sync-erc20-metadata ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 --from <key> --gas 2000000`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgSyncERC20Metadata(signer.String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
@@ -25,10 +25,35 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, gs *types.GenesisState,
 	for _, account := range gs.Accounts {
 		keeper.SetAccount(ctx, account)
 	}
+
+	for _, contract := range gs.DeployedCosmosCoinContracts {
+		if err := keeper.SetDeployedCosmosCoinContract(ctx, contract.CosmosDenom, *contract.Address); err != nil {
+			panic(fmt.Sprintf("failed to set deployed cosmos coin contract for %s: %s", contract.CosmosDenom, err))
+		}
+	}
+
+	for _, contract := range gs.FrozenCosmosCoinContracts {
+		// addresses are checked by genesis validation
+		address, _ := types.NewInternalEVMAddressFromString(contract)
+		keeper.SetCosmosCoinContractFrozen(ctx, address, true)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	accounts := keeper.GetAllAccounts(ctx)
-	return types.NewGenesisState(accounts, keeper.GetParams(ctx))
+
+	deployedContracts := types.DeployedCosmosCoinContracts{}
+	keeper.IterateAllDeployedCosmosCoinContracts(ctx, func(contract types.DeployedCosmosCoinContract) bool {
+		deployedContracts = append(deployedContracts, contract)
+		return false
+	})
+
+	frozenContracts := []string{}
+	keeper.IterateAllFrozenCosmosCoinContracts(ctx, func(address types.InternalEVMAddress) bool {
+		frozenContracts = append(frozenContracts, address.Hex())
+		return false
+	})
+
+	return types.NewGenesisState(accounts, keeper.GetParams(ctx), deployedContracts, frozenContracts)
 }
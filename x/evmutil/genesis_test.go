@@ -26,6 +26,8 @@ func (s *genesisTestSuite) TestInitGenesis_SetAccounts() {
 			{Address: s.Addrs[0], Balance: sdkmath.NewInt(100)},
 		},
 		types.DefaultParams(),
+		types.DeployedCosmosCoinContracts{},
+		[]string{},
 	)
 	accounts := s.Keeper.GetAllAccounts(s.Ctx)
 	s.Require().Len(accounts, 0)
@@ -47,6 +49,8 @@ func (s *genesisTestSuite) TestInitGenesis_SetParams() {
 	gs := types.NewGenesisState(
 		[]types.Account{},
 		params,
+		types.DeployedCosmosCoinContracts{},
+		[]string{},
 	)
 	evmutil.InitGenesis(s.Ctx, s.Keeper, gs, s.AccountKeeper)
 	params = s.Keeper.GetParams(s.Ctx)
@@ -60,6 +64,8 @@ func (s *genesisTestSuite) TestInitGenesis_ValidateFail() {
 			{Address: s.Addrs[0], Balance: sdkmath.NewInt(-100)},
 		},
 		types.DefaultParams(),
+		types.DeployedCosmosCoinContracts{},
+		[]string{},
 	)
 	s.Require().Panics(func() {
 		evmutil.InitGenesis(s.Ctx, s.Keeper, gs, s.AccountKeeper)
@@ -70,6 +76,8 @@ func (s *genesisTestSuite) TestInitGenesis_ModuleAccount() {
 	gs := types.NewGenesisState(
 		[]types.Account{},
 		types.DefaultParams(),
+		types.DeployedCosmosCoinContracts{},
+		[]string{},
 	)
 	s.Require().NotPanics(func() {
 		evmutil.InitGenesis(s.Ctx, s.Keeper, gs, s.AccountKeeper)
@@ -104,9 +112,56 @@ func (s *genesisTestSuite) TestExportGenesis() {
 		},
 	}
 	s.Keeper.SetParams(s.Ctx, params)
+	contract := types.NewDeployedCosmosCoinContract("hard", testutil.RandomInternalEVMAddress())
+	s.Require().NoError(s.Keeper.SetDeployedCosmosCoinContract(s.Ctx, contract.CosmosDenom, *contract.Address))
 	gs := evmutil.ExportGenesis(s.Ctx, s.Keeper)
 	s.Require().Equal(gs.Accounts, accounts)
 	s.Require().Equal(params, gs.Params)
+	s.Require().Equal(types.DeployedCosmosCoinContracts{contract}, gs.DeployedCosmosCoinContracts)
+	s.Require().Empty(gs.FrozenCosmosCoinContracts)
+}
+
+func (s *genesisTestSuite) TestInitGenesis_SetDeployedCosmosCoinContracts() {
+	contracts := types.DeployedCosmosCoinContracts{
+		types.NewDeployedCosmosCoinContract("hard", testutil.RandomInternalEVMAddress()),
+		types.NewDeployedCosmosCoinContract("swp", testutil.RandomInternalEVMAddress()),
+	}
+	gs := types.NewGenesisState(
+		[]types.Account{},
+		types.DefaultParams(),
+		contracts,
+		[]string{},
+	)
+	evmutil.InitGenesis(s.Ctx, s.Keeper, gs, s.AccountKeeper)
+
+	for _, contract := range contracts {
+		address, found := s.Keeper.GetDeployedCosmosCoinContract(s.Ctx, contract.CosmosDenom)
+		s.Require().True(found)
+		s.Require().Equal(*contract.Address, address)
+	}
+
+	// contracts round-trip through export
+	exported := evmutil.ExportGenesis(s.Ctx, s.Keeper)
+	s.Require().Equal(contracts, exported.DeployedCosmosCoinContracts)
+}
+
+func (s *genesisTestSuite) TestInitGenesis_SetFrozenCosmosCoinContracts() {
+	frozen := testutil.RandomInternalEVMAddress()
+	current := testutil.RandomInternalEVMAddress()
+	gs := types.NewGenesisState(
+		[]types.Account{},
+		types.DefaultParams(),
+		types.DeployedCosmosCoinContracts{types.NewDeployedCosmosCoinContract("hard", current)},
+		[]string{frozen.Hex()},
+	)
+	evmutil.InitGenesis(s.Ctx, s.Keeper, gs, s.AccountKeeper)
+
+	s.Require().True(s.Keeper.IsCosmosCoinContractFrozen(s.Ctx, frozen))
+	s.Require().False(s.Keeper.IsCosmosCoinContractFrozen(s.Ctx, current))
+
+	// frozen contracts round-trip through export
+	exported := evmutil.ExportGenesis(s.Ctx, s.Keeper)
+	s.Require().Equal([]string{frozen.Hex()}, exported.FrozenCosmosCoinContracts)
 }
 
 func TestGenesisTestSuite(t *testing.T) {
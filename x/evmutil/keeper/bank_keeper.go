@@ -13,15 +13,16 @@ import (
 )
 
 const (
-	// EvmDenom is the gas denom used by the evm
-	EvmDenom = "akava"
+	// EvmDenom is the default gas denom used by the evm, see the EvmDenom param
+	EvmDenom = types.DefaultEvmDenom
 
-	// CosmosDenom is the gas denom used by the kava app
-	CosmosDenom = "ukava"
+	// CosmosDenom is the default gas denom used by the kava app, see the CosmosDenom param
+	CosmosDenom = types.DefaultCosmosDenom
 )
 
-// ConversionMultiplier is the conversion multiplier between akava and ukava
-var ConversionMultiplier = sdkmath.NewInt(1_000_000_000_000)
+// ConversionMultiplier is the default conversion multiplier between akava and ukava,
+// see the ConversionMultiplier param
+var ConversionMultiplier = types.DefaultConversionMultiplier
 
 var _ evmtypes.BankKeeper = EvmBankKeeper{}
 
@@ -47,15 +48,24 @@ func NewEvmBankKeeper(akavaKeeper Keeper, bk types.BankKeeper, ak types.AccountK
 
 // GetBalance returns the total **spendable** balance of akava for a given account by address.
 func (k EvmBankKeeper) GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
-	if denom != EvmDenom {
-		panic(fmt.Errorf("only evm denom %s is supported by EvmBankKeeper", EvmDenom))
+	params := k.akavaKeeper.GetParams(ctx)
+	if denom != params.EvmDenom {
+		panic(fmt.Errorf("only evm denom %s is supported by EvmBankKeeper", params.EvmDenom))
 	}
 
+	return sdk.NewCoin(params.EvmDenom, k.SpendableBalance(ctx, addr))
+}
+
+// SpendableBalance returns the akava balance of an address that is available to
+// be spent. Locked ukava, such as the unvested coins of a vesting account, is
+// excluded. The balance checks of the evm ante handler read this amount through
+// GetBalance.
+func (k EvmBankKeeper) SpendableBalance(ctx sdk.Context, addr sdk.AccAddress) sdkmath.Int {
+	params := k.akavaKeeper.GetParams(ctx)
 	spendableCoins := k.bk.SpendableCoins(ctx, addr)
-	ukava := spendableCoins.AmountOf(CosmosDenom)
+	ukava := spendableCoins.AmountOf(params.CosmosDenom)
 	akava := k.akavaKeeper.GetBalance(ctx, addr)
-	total := ukava.Mul(ConversionMultiplier).Add(akava)
-	return sdk.NewCoin(EvmDenom, total)
+	return ukava.Mul(params.ConversionMultiplier).Add(akava)
 }
 
 // SendCoins transfers akava coins from a AccAddress to an AccAddress.
@@ -70,7 +80,7 @@ func (k EvmBankKeeper) SendCoins(ctx sdk.Context, senderAddr sdk.AccAddress, rec
 // It will panic if the module account does not exist. An error is returned if the recipient
 // address is black-listed or if sending the tokens fails.
 func (k EvmBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
-	ukava, akava, err := SplitAkavaCoins(amt)
+	ukava, akava, err := SplitAkavaCoins(amt, k.akavaKeeper.GetParams(ctx))
 	if err != nil {
 		return err
 	}
@@ -96,11 +106,21 @@ func (k EvmBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModul
 // SendCoinsFromAccountToModule transfers akava coins from an AccAddress to a ModuleAccount.
 // It will panic if the module account does not exist.
 func (k EvmBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
-	ukava, akavaNeeded, err := SplitAkavaCoins(amt)
+	params := k.akavaKeeper.GetParams(ctx)
+	ukava, akavaNeeded, err := SplitAkavaCoins(amt, params)
 	if err != nil {
 		return err
 	}
 
+	// check up front so locked coins fail the send before any balance is moved
+	spendable := k.SpendableBalance(ctx, senderAddr)
+	if amount := amt.AmountOf(params.EvmDenom); spendable.LT(amount) {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInsufficientFunds,
+			"spendable balance %s%s is smaller than %s%s", spendable, params.EvmDenom, amount, params.EvmDenom,
+		)
+	}
+
 	if ukava.IsPositive() {
 		if err := k.bk.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, sdk.NewCoins(ukava)); err != nil {
 			return err
@@ -122,7 +142,7 @@ func (k EvmBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr
 // MintCoins mints akava coins by minting the equivalent ukava coins and any remaining akava coins.
 // It will panic if the module account does not exist or is unauthorized.
 func (k EvmBankKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
-	ukava, akava, err := SplitAkavaCoins(amt)
+	ukava, akava, err := SplitAkavaCoins(amt, k.akavaKeeper.GetParams(ctx))
 	if err != nil {
 		return err
 	}
@@ -144,7 +164,7 @@ func (k EvmBankKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coi
 // BurnCoins burns akava coins by burning the equivalent ukava coins and any remaining akava coins.
 // It will panic if the module account does not exist or is unauthorized.
 func (k EvmBankKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
-	ukava, akava, err := SplitAkavaCoins(amt)
+	ukava, akava, err := SplitAkavaCoins(amt, k.akavaKeeper.GetParams(ctx))
 	if err != nil {
 		return err
 	}
@@ -181,13 +201,14 @@ func (k EvmBankKeeper) ConvertOneUkavaToAkavaIfNeeded(ctx sdk.Context, addr sdk.
 		return nil
 	}
 
-	ukavaToStore := sdk.NewCoins(sdk.NewCoin(CosmosDenom, sdk.OneInt()))
+	params := k.akavaKeeper.GetParams(ctx)
+	ukavaToStore := sdk.NewCoins(sdk.NewCoin(params.CosmosDenom, sdk.OneInt()))
 	if err := k.bk.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, ukavaToStore); err != nil {
 		return err
 	}
 
 	// add 1ukava equivalent of akava to addr
-	akavaToReceive := ConversionMultiplier
+	akavaToReceive := params.ConversionMultiplier
 	if err := k.akavaKeeper.AddBalance(ctx, addr, akavaToReceive); err != nil {
 		return err
 	}
@@ -197,8 +218,9 @@ func (k EvmBankKeeper) ConvertOneUkavaToAkavaIfNeeded(ctx sdk.Context, addr sdk.
 
 // ConvertAkavaToUkava converts all available akava to ukava for a given AccAddress.
 func (k EvmBankKeeper) ConvertAkavaToUkava(ctx sdk.Context, addr sdk.AccAddress) error {
+	params := k.akavaKeeper.GetParams(ctx)
 	totalAkava := k.akavaKeeper.GetBalance(ctx, addr)
-	ukava, _, err := SplitAkavaCoins(sdk.NewCoins(sdk.NewCoin(EvmDenom, totalAkava)))
+	ukava, _, err := SplitAkavaCoins(sdk.NewCoins(sdk.NewCoin(params.EvmDenom, totalAkava)), params)
 	if err != nil {
 		return err
 	}
@@ -210,7 +232,7 @@ func (k EvmBankKeeper) ConvertAkavaToUkava(ctx sdk.Context, addr sdk.AccAddress)
 	}
 
 	// remove akava used for converting to ukava
-	akavaToBurn := ukavaToReceive.Mul(ConversionMultiplier)
+	akavaToBurn := ukavaToReceive.Mul(params.ConversionMultiplier)
 	finalBal := totalAkava.Sub(akavaToBurn)
 	if err := k.akavaKeeper.SetBalance(ctx, addr, finalBal); err != nil {
 		return err
@@ -232,36 +254,37 @@ func (k EvmBankKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
 	return addr
 }
 
-// SplitAkavaCoins splits akava coins to the equivalent ukava coins and any remaining akava balance.
+// SplitAkavaCoins splits akava coins to the equivalent ukava coins and any remaining akava balance,
+// using the denoms and conversion multiplier of the given params.
 // An error will be returned if the coins are not valid or if the coins are not the akava denom.
-func SplitAkavaCoins(coins sdk.Coins) (sdk.Coin, sdkmath.Int, error) {
+func SplitAkavaCoins(coins sdk.Coins, params types.Params) (sdk.Coin, sdkmath.Int, error) {
 	akava := sdk.ZeroInt()
-	ukava := sdk.NewCoin(CosmosDenom, sdk.ZeroInt())
+	ukava := sdk.NewCoin(params.CosmosDenom, sdk.ZeroInt())
 
 	if len(coins) == 0 {
 		return ukava, akava, nil
 	}
 
-	if err := ValidateEvmCoins(coins); err != nil {
+	if err := ValidateEvmCoins(coins, params.EvmDenom); err != nil {
 		return ukava, akava, err
 	}
 
 	// note: we should always have len(coins) == 1 here since coins cannot have dup denoms after we validate.
 	coin := coins[0]
-	remainingBalance := coin.Amount.Mod(ConversionMultiplier)
+	remainingBalance := coin.Amount.Mod(params.ConversionMultiplier)
 	if remainingBalance.IsPositive() {
 		akava = remainingBalance
 	}
-	ukavaAmount := coin.Amount.Quo(ConversionMultiplier)
+	ukavaAmount := coin.Amount.Quo(params.ConversionMultiplier)
 	if ukavaAmount.IsPositive() {
-		ukava = sdk.NewCoin(CosmosDenom, ukavaAmount)
+		ukava = sdk.NewCoin(params.CosmosDenom, ukavaAmount)
 	}
 
 	return ukava, akava, nil
 }
 
-// ValidateEvmCoins validates the coins from evm is valid and is the EvmDenom (akava).
-func ValidateEvmCoins(coins sdk.Coins) error {
+// ValidateEvmCoins validates the coins from evm is valid and is the evm denom (akava).
+func ValidateEvmCoins(coins sdk.Coins, evmDenom string) error {
 	if len(coins) == 0 {
 		return nil
 	}
@@ -272,8 +295,8 @@ func ValidateEvmCoins(coins sdk.Coins) error {
 	}
 
 	// validate that coin denom is akava
-	if len(coins) != 1 || coins[0].Denom != EvmDenom {
-		errMsg := fmt.Sprintf("invalid evm coin denom, only %s is supported", EvmDenom)
+	if len(coins) != 1 || coins[0].Denom != evmDenom {
+		errMsg := fmt.Sprintf("invalid evm coin denom, only %s is supported", evmDenom)
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, errMsg)
 	}
 
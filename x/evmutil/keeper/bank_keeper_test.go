@@ -7,6 +7,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	tmtime "github.com/cometbft/cometbft/types/time"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/suite"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -49,6 +50,59 @@ func (suite *evmBankKeeperTestSuite) TestGetBalance_ReturnsSpendable() {
 	suite.Require().Equal(sdkmath.NewIntFromUint64(5_000_000_000_100), coin.Amount)
 }
 
+func (suite *evmBankKeeperTestSuite) TestSpendableBalance() {
+	vestingCoins := sdk.NewCoins(sdk.NewInt64Coin("ukava", 10))
+	unlockedCoins := sdk.NewCoins(sdk.NewInt64Coin("ukava", 2))
+	startingAkava := sdkmath.NewInt(100)
+
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	bacc := authtypes.NewBaseAccountWithAddress(suite.Addrs[0])
+	vacc := vesting.NewContinuousVestingAccount(bacc, vestingCoins, now.Unix(), endTime.Unix())
+	suite.AccountKeeper.SetAccount(suite.Ctx, vacc)
+
+	err := suite.App.FundAccount(suite.Ctx, suite.Addrs[0], vestingCoins.Add(unlockedCoins...))
+	suite.Require().NoError(err)
+	err = suite.Keeper.SetBalance(suite.Ctx, suite.Addrs[0], startingAkava)
+	suite.Require().NoError(err)
+
+	// only the unlocked ukava is spendable before vesting starts
+	balance := suite.EvmBankKeeper.SpendableBalance(suite.Ctx, suite.Addrs[0])
+	suite.Require().Equal(sdkmath.NewInt(2_000_000_000_100), balance)
+
+	ctx := suite.Ctx.WithBlockTime(now.Add(12 * time.Hour))
+	balance = suite.EvmBankKeeper.SpendableBalance(ctx, suite.Addrs[0])
+	suite.Require().Equal(sdkmath.NewInt(7_000_000_000_100), balance)
+	suite.Require().Equal(balance, suite.EvmBankKeeper.GetBalance(ctx, suite.Addrs[0], "akava").Amount)
+}
+
+func (suite *evmBankKeeperTestSuite) TestSendCoinsFromAccountToModule_LockedCoins() {
+	vestingCoins := sdk.NewCoins(sdk.NewInt64Coin("ukava", 10))
+	unlockedCoins := sdk.NewCoins(sdk.NewInt64Coin("ukava", 2))
+
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	bacc := authtypes.NewBaseAccountWithAddress(suite.Addrs[0])
+	vacc := vesting.NewContinuousVestingAccount(bacc, vestingCoins, now.Unix(), endTime.Unix())
+	suite.AccountKeeper.SetAccount(suite.Ctx, vacc)
+
+	err := suite.App.FundAccount(suite.Ctx, suite.Addrs[0], vestingCoins.Add(unlockedCoins...))
+	suite.Require().NoError(err)
+
+	// sending more than the unlocked balance fails without moving any funds
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin("akava", 2_000_000_000_001))
+	err = suite.EvmBankKeeper.SendCoinsFromAccountToModule(suite.Ctx, suite.Addrs[0], evmtypes.ModuleName, sendCoins)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	suite.Require().Equal(sdkmath.NewInt(12), suite.BankKeeper.GetBalance(suite.Ctx, suite.Addrs[0], "ukava").Amount)
+	suite.Require().Equal(sdk.ZeroInt(), suite.Keeper.GetBalance(suite.Ctx, suite.Addrs[0]))
+
+	// the full unlocked balance can be sent
+	sendCoins = sdk.NewCoins(sdk.NewInt64Coin("akava", 2_000_000_000_000))
+	err = suite.EvmBankKeeper.SendCoinsFromAccountToModule(suite.Ctx, suite.Addrs[0], evmtypes.ModuleName, sendCoins)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.ZeroInt(), suite.EvmBankKeeper.SpendableBalance(suite.Ctx, suite.Addrs[0]))
+}
+
 func (suite *evmBankKeeperTestSuite) TestGetBalance_NotEvmDenom() {
 	suite.Require().Panics(func() {
 		suite.EvmBankKeeper.GetBalance(suite.Ctx, suite.Addrs[0], "ukava")
@@ -640,7 +694,7 @@ func (suite *evmBankKeeperTestSuite) TestValidateEvmCoins() {
 	}
 	for _, tt := range tests {
 		suite.Run(tt.name, func() {
-			err := keeper.ValidateEvmCoins(tt.coins)
+			err := keeper.ValidateEvmCoins(tt.coins, keeper.EvmDenom)
 			if tt.shouldErr {
 				suite.Require().Error(err)
 			} else {
@@ -781,7 +835,7 @@ func (suite *evmBankKeeperTestSuite) TestSplitAkavaCoins() {
 	}
 	for _, tt := range tests {
 		suite.Run(tt.name, func() {
-			ukava, akava, err := keeper.SplitAkavaCoins(tt.coins)
+			ukava, akava, err := keeper.SplitAkavaCoins(tt.coins, types.DefaultParams())
 			if tt.shouldErr {
 				suite.Require().Error(err)
 			} else {
@@ -25,6 +25,9 @@ func (k *Keeper) ConvertCosmosCoinToERC20(
 	if !allowed {
 		return errorsmod.Wrapf(types.ErrSDKConversionNotEnabled, amount.Denom)
 	}
+	if err := k.TrackConversion(ctx, amount); err != nil {
+		return err
+	}
 
 	// send coins from initiator to the module account
 	// do this before possible contract deploy to prevent unnecessary store interactions
@@ -40,6 +43,9 @@ func (k *Keeper) ConvertCosmosCoinToERC20(
 	if err != nil {
 		return err
 	}
+	if k.IsCosmosCoinContractFrozen(ctx, contractAddress) {
+		return errorsmod.Wrapf(types.ErrContractFrozen, "%s", contractAddress.Hex())
+	}
 
 	// mint erc20 tokens for the user
 	err = k.MintERC20(ctx, contractAddress, receiver, amount.Amount.BigInt())
@@ -54,6 +60,10 @@ func (k *Keeper) ConvertCosmosCoinToERC20(
 		sdk.NewAttribute(types.AttributeKeyERC20Address, contractAddress.Hex()),
 		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
 	))
+	k.AppendConversionRecord(
+		ctx, initiator.String(), receiver.String(), contractAddress.Hex(), amount,
+		types.CONVERSION_DIRECTION_COIN_TO_ERC20,
+	)
 
 	return nil
 }
@@ -73,6 +83,12 @@ func (k *Keeper) ConvertCosmosCoinFromERC20(
 		// no contract deployed
 		return errorsmod.Wrapf(types.ErrInvalidCosmosDenom, fmt.Sprintf("no erc20 contract found for %s", coin.Denom))
 	}
+	if k.IsCosmosCoinContractFrozen(ctx, contractAddress) {
+		return errorsmod.Wrapf(types.ErrContractFrozen, "%s", contractAddress.Hex())
+	}
+	if err := k.TrackConversion(ctx, coin); err != nil {
+		return err
+	}
 
 	// verify sufficient balance
 	balance, err := k.QueryERC20BalanceOf(ctx, contractAddress, initiator)
@@ -102,6 +118,10 @@ func (k *Keeper) ConvertCosmosCoinFromERC20(
 		sdk.NewAttribute(types.AttributeKeyERC20Address, contractAddress.Hex()),
 		sdk.NewAttribute(types.AttributeKeyAmount, coin.String()),
 	))
+	k.AppendConversionRecord(
+		ctx, initiator.String(), receiver.String(), contractAddress.Hex(), coin,
+		types.CONVERSION_DIRECTION_ERC20_TO_COIN,
+	)
 
 	return nil
 }
@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	"fmt"
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/kava-labs/kava/x/evmutil/types"
 )
@@ -67,6 +69,10 @@ func (k Keeper) ConvertCoinToERC20(
 		return err
 	}
 
+	if err := k.TrackConversion(ctx, coin); err != nil {
+		return err
+	}
+
 	if err := k.BurnConversionPairCoin(ctx, pair, coin, initiatorAccount); err != nil {
 		return err
 	}
@@ -90,6 +96,10 @@ func (k Keeper) ConvertCoinToERC20(
 		sdk.NewAttribute(types.AttributeKeyERC20Address, pair.GetAddress().String()),
 		sdk.NewAttribute(types.AttributeKeyAmount, coin.String()),
 	))
+	k.AppendConversionRecord(
+		ctx, initiatorAccount.String(), receiverAccount.String(), pair.GetAddress().String(), coin,
+		types.CONVERSION_DIRECTION_COIN_TO_ERC20,
+	)
 
 	return nil
 }
@@ -103,11 +113,65 @@ func (k Keeper) ConvertERC20ToCoin(
 	contractAddr types.InternalEVMAddress,
 	amount sdkmath.Int,
 ) error {
+	_, err := k.convertERC20ToCoin(ctx, initiator, receiver, contractAddr, amount)
+	return err
+}
+
+// ConvertERC20AndTransfer converts an ERC20 coin from the originating account to
+// an sdk.Coin owned by the same account, then sends the coin over IBC as
+// described by msg. Both steps are reverted if either fails. It returns the
+// sequence of the sent transfer packet.
+func (k Keeper) ConvertERC20AndTransfer(
+	ctx sdk.Context,
+	msg types.MsgConvertERC20AndTransfer,
+) (uint64, error) {
+	initiator, err := types.NewInternalEVMAddressFromString(msg.Initiator)
+	if err != nil {
+		return 0, fmt.Errorf("invalid initiator address: %w", err)
+	}
+
+	contractAddr, err := types.NewInternalEVMAddressFromString(msg.KavaERC20Address)
+	if err != nil {
+		return 0, fmt.Errorf("invalid contract address: %w", err)
+	}
+
+	if k.transferKeeper == nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrLogic, "ibc transfer keeper not set")
+	}
+
+	// run in a cached context so a failed transfer does not leave converted coins behind
+	cacheCtx, write := ctx.CacheContext()
+
+	sender := sdk.AccAddress(initiator.Bytes())
+	coin, err := k.convertERC20ToCoin(cacheCtx, initiator, sender, contractAddr, msg.Amount)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(cacheCtx), msg.NewMsgTransfer(coin))
+	if err != nil {
+		return 0, err
+	}
+
+	write()
+
+	return res.Sequence, nil
+}
+
+// convertERC20ToCoin converts an ERC20 coin from the originating account to an
+// sdk.Coin to the receiver account and returns the minted coin.
+func (k Keeper) convertERC20ToCoin(
+	ctx sdk.Context,
+	initiator types.InternalEVMAddress,
+	receiver sdk.AccAddress,
+	contractAddr types.InternalEVMAddress,
+	amount sdkmath.Int,
+) (sdk.Coin, error) {
 	// Check that the contract is enabled to convert to coin
 	pair, err := k.GetEnabledConversionPairFromERC20Address(ctx, contractAddr)
 	if err != nil {
 		// contract not in enabled conversion pair list
-		return err
+		return sdk.Coin{}, err
 	}
 
 	amountToLock := amount.BigInt()
@@ -116,19 +180,22 @@ func (k Keeper) ConvertERC20ToCoin(
 	if isBep3Asset(pair.Denom) {
 		amountToMint, amountToLock, err = bep3ERC20AmountToCoinMintAndERC20LockAmount(amount.BigInt())
 		if err != nil {
-			return err
+			return sdk.Coin{}, err
 		}
 	}
 
 	// lock erc20 tokens
 	if err := k.LockERC20Tokens(ctx, pair, amountToLock, initiator); err != nil {
-		return err
+		return sdk.Coin{}, err
 	}
 
 	// mint conversion pair coin
 	coin, err := k.MintConversionPairCoin(ctx, pair, amountToMint, receiver)
 	if err != nil {
-		return err
+		return sdk.Coin{}, err
+	}
+	if err := k.TrackConversion(ctx, coin); err != nil {
+		return sdk.Coin{}, err
 	}
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
@@ -138,8 +205,12 @@ func (k Keeper) ConvertERC20ToCoin(
 		sdk.NewAttribute(types.AttributeKeyReceiver, receiver.String()),
 		sdk.NewAttribute(types.AttributeKeyAmount, coin.String()),
 	))
+	k.AppendConversionRecord(
+		ctx, initiator.String(), receiver.String(), contractAddr.String(), coin,
+		types.CONVERSION_DIRECTION_ERC20_TO_COIN,
+	)
 
-	return nil
+	return coin, nil
 }
 
 // UnlockERC20Tokens transfers the given amount of a conversion pair ERC20 token
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+// GetNextConversionRecordID returns the id of the next conversion record
+func (k Keeper) GetNextConversionRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextConversionRecordIDKey)
+	if bz == nil {
+		return 1
+	}
+	return types.GetConversionRecordIDFromBytes(bz)
+}
+
+// SetNextConversionRecordID sets the id of the next conversion record
+func (k Keeper) SetNextConversionRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextConversionRecordIDKey, types.GetConversionRecordIDBytes(id))
+}
+
+// GetConversionRecord returns a conversion record from the store
+func (k Keeper) GetConversionRecord(ctx sdk.Context, id uint64) (types.ConversionRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ConversionRecordKeyPrefix)
+	bz := store.Get(types.GetConversionRecordIDBytes(id))
+	if bz == nil {
+		return types.ConversionRecord{}, false
+	}
+	var record types.ConversionRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// AppendConversionRecord stores a new conversion record made at the current
+// block height. Once more than MaxConversionRecords are stored, the oldest
+// record is pruned.
+func (k Keeper) AppendConversionRecord(
+	ctx sdk.Context,
+	initiator string,
+	receiver string,
+	erc20Address string,
+	amount sdk.Coin,
+	direction types.ConversionDirection,
+) {
+	id := k.GetNextConversionRecordID(ctx)
+	record := types.NewConversionRecord(id, initiator, receiver, erc20Address, amount, direction, ctx.BlockHeight())
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ConversionRecordKeyPrefix)
+	store.Set(types.GetConversionRecordIDBytes(id), k.cdc.MustMarshal(&record))
+	if id > types.MaxConversionRecords {
+		store.Delete(types.GetConversionRecordIDBytes(id - types.MaxConversionRecords))
+	}
+
+	k.SetNextConversionRecordID(ctx, id+1)
+}
+
+// IterateConversionRecords iterates over all stored conversion records in id
+// order. If true is returned from the callback, iteration is halted.
+func (k Keeper) IterateConversionRecords(ctx sdk.Context, cb func(types.ConversionRecord) bool) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ConversionRecordKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ConversionRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/evmutil/testutil"
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+type conversionRecordTestSuite struct {
+	testutil.Suite
+}
+
+func TestConversionRecordTestSuite(t *testing.T) {
+	suite.Run(t, new(conversionRecordTestSuite))
+}
+
+func (suite *conversionRecordTestSuite) TestAppendConversionRecord() {
+	suite.Equal(uint64(1), suite.Keeper.GetNextConversionRecordID(suite.Ctx))
+
+	amount := sdk.NewInt64Coin("magic", 100)
+	erc20Address := testutil.RandomInternalEVMAddress().Hex()
+	suite.Keeper.AppendConversionRecord(
+		suite.Ctx,
+		suite.Addrs[0].String(),
+		suite.Key1Addr.Hex(),
+		erc20Address,
+		amount,
+		types.CONVERSION_DIRECTION_COIN_TO_ERC20,
+	)
+
+	record, found := suite.Keeper.GetConversionRecord(suite.Ctx, 1)
+	suite.Require().True(found)
+	suite.Equal(types.NewConversionRecord(
+		1,
+		suite.Addrs[0].String(),
+		suite.Key1Addr.Hex(),
+		erc20Address,
+		amount,
+		types.CONVERSION_DIRECTION_COIN_TO_ERC20,
+		suite.Ctx.BlockHeight(),
+	), record)
+	suite.Equal(uint64(2), suite.Keeper.GetNextConversionRecordID(suite.Ctx))
+}
+
+func (suite *conversionRecordTestSuite) TestAppendConversionRecord_Prunes() {
+	// start just below the cap to avoid writing MaxConversionRecords records
+	suite.Keeper.SetNextConversionRecordID(suite.Ctx, types.MaxConversionRecords)
+
+	for i := 0; i < 3; i++ {
+		suite.Keeper.AppendConversionRecord(
+			suite.Ctx, "initiator", "receiver", "erc20", sdk.NewInt64Coin("magic", 1),
+			types.CONVERSION_DIRECTION_ERC20_TO_COIN,
+		)
+	}
+
+	// records MaxConversionRecords+1 and MaxConversionRecords+2 pruned ids 1 and 2
+	_, found := suite.Keeper.GetConversionRecord(suite.Ctx, 1)
+	suite.False(found)
+	_, found = suite.Keeper.GetConversionRecord(suite.Ctx, 2)
+	suite.False(found)
+
+	var ids []uint64
+	suite.Keeper.IterateConversionRecords(suite.Ctx, func(record types.ConversionRecord) bool {
+		ids = append(ids, record.ID)
+		return false
+	})
+	suite.Equal([]uint64{
+		types.MaxConversionRecords,
+		types.MaxConversionRecords + 1,
+		types.MaxConversionRecords + 2,
+	}, ids)
+}
+
+func (suite *conversionRecordTestSuite) TestConvertCosmosCoin_AppendsRecords() {
+	denom := "magic"
+	tokenInfo := types.NewAllowedCosmosCoinERC20Token(denom, "Magic Coin", "MAGIC", 6)
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.AllowedCosmosDenoms = types.NewAllowedCosmosCoinERC20Tokens(tokenInfo)
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	amount := sdk.NewInt64Coin(denom, 1e6)
+	suite.Require().NoError(suite.App.FundAccount(suite.Ctx, suite.Addrs[0], sdk.NewCoins(amount)))
+
+	err := suite.Keeper.ConvertCosmosCoinToERC20(suite.Ctx, suite.Addrs[0], suite.Key1Addr, amount)
+	suite.Require().NoError(err)
+	err = suite.Keeper.ConvertCosmosCoinFromERC20(suite.Ctx, suite.Key1Addr, suite.Addrs[1], amount)
+	suite.Require().NoError(err)
+
+	contractAddress, found := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, denom)
+	suite.Require().True(found)
+
+	toERC20, found := suite.Keeper.GetConversionRecord(suite.Ctx, 1)
+	suite.Require().True(found)
+	suite.Equal(types.CONVERSION_DIRECTION_COIN_TO_ERC20, toERC20.Direction)
+	suite.Equal(suite.Addrs[0].String(), toERC20.Initiator)
+	suite.Equal(contractAddress.Hex(), toERC20.ERC20Address)
+	suite.Equal(amount, toERC20.Amount)
+
+	fromERC20, found := suite.Keeper.GetConversionRecord(suite.Ctx, 2)
+	suite.Require().True(found)
+	suite.Equal(types.CONVERSION_DIRECTION_ERC20_TO_COIN, fromERC20.Direction)
+	suite.Equal(suite.Addrs[1].String(), fromERC20.Receiver)
+	suite.Equal(amount, fromERC20.Amount)
+}
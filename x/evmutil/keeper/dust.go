@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+// DustSweepBatchSize is the number of accounts checked for dust by each call to SweepDust.
+const DustSweepBatchSize = 100
+
+// SweepDust consolidates the fractional akava balances below the dust sweep
+// threshold left on module accounts into the x/evmutil module account's own
+// fractional balance. Each call checks the next DustSweepBatchSize accounts,
+// continuing from where the previous call stopped, so the whole account store is
+// swept over several calls.
+//
+// Only module accounts are swept, user balances are never touched. Once the
+// consolidated balance reaches whole ukava units, they are removed from it, and
+// the ukava reserve that was backing them is left as the x/evmutil module
+// account's x/bank balance, so no balance is destroyed.
+func (k Keeper) SweepDust(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+
+	for _, account := range k.nextDustSweepBatch(ctx) {
+		if account.Balance.IsZero() || account.Balance.GTE(params.DustSweepThreshold) {
+			continue
+		}
+		if account.Address.Equals(moduleAddr) {
+			continue
+		}
+		if _, ok := k.accountKeeper.GetAccount(ctx, account.Address).(authtypes.ModuleAccountI); !ok {
+			continue
+		}
+
+		if err := k.SendBalance(ctx, account.Address, moduleAddr, account.Balance); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSweepDust,
+			sdk.NewAttribute(types.AttributeKeyAccount, account.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewCoin(params.EvmDenom, account.Balance).String()),
+		))
+	}
+
+	consolidated := k.GetBalance(ctx, moduleAddr)
+	ukava := consolidated.Quo(params.ConversionMultiplier)
+	if !ukava.IsPositive() {
+		return nil
+	}
+
+	if err := k.SetBalance(ctx, moduleAddr, consolidated.Mod(params.ConversionMultiplier)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeConvertDust,
+		sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewCoin(params.CosmosDenom, ukava).String()),
+	))
+
+	return nil
+}
+
+// nextDustSweepBatch returns the next DustSweepBatchSize accounts, starting from the stored
+// dust sweep cursor, and moves the cursor past them. The cursor is cleared once the last
+// account is reached, so the next batch starts from the first account again.
+func (k Keeper) nextDustSweepBatch(ctx sdk.Context) []types.Account {
+	store := ctx.KVStore(k.storeKey)
+
+	start := store.Get(types.DustSweepCursorKey)
+	if start == nil {
+		start = types.AccountStoreKeyPrefix
+	}
+	// collect first, as the cursor cannot be changed while iterating the store
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(types.AccountStoreKeyPrefix))
+
+	var accounts []types.Account
+	for ; len(accounts) < DustSweepBatchSize && iterator.Valid(); iterator.Next() {
+		var acc types.Account
+		if err := k.cdc.Unmarshal(iterator.Value(), &acc); err != nil {
+			panic(err)
+		}
+		accounts = append(accounts, acc)
+	}
+	var next []byte
+	if iterator.Valid() {
+		next = iterator.Key()
+	}
+	iterator.Close()
+
+	if next != nil {
+		store.Set(types.DustSweepCursorKey, next)
+	} else {
+		store.Delete(types.DustSweepCursorKey)
+	}
+
+	return accounts
+}
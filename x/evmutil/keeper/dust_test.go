@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/evmutil/keeper"
+	"github.com/kava-labs/kava/x/evmutil/testutil"
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+type dustTestSuite struct {
+	testutil.Suite
+}
+
+func TestDustTestSuite(t *testing.T) {
+	suite.Run(t, new(dustTestSuite))
+}
+
+func (suite *dustTestSuite) setDustSweepThreshold(threshold sdkmath.Int) {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.DustSweepThreshold = threshold
+	suite.Keeper.SetParams(suite.Ctx, params)
+}
+
+// newModuleAccount creates a module account to hold dust.
+func (suite *dustTestSuite) newModuleAccount(name string) sdk.AccAddress {
+	acc := authtypes.NewEmptyModuleAccount(name)
+	suite.AccountKeeper.SetAccount(suite.Ctx, suite.AccountKeeper.NewAccount(suite.Ctx, acc))
+	return acc.GetAddress()
+}
+
+func (suite *dustTestSuite) TestSweepDust() {
+	multiplier := suite.Keeper.GetParams(suite.Ctx).ConversionMultiplier
+	feeCollectorAddr := suite.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	otherModuleAddr := suite.newModuleAccount("dusty")
+	moduleAddr := suite.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.setDustSweepThreshold(multiplier.QuoRaw(10).MulRaw(8))
+
+	// the module account holds the ukava reserve backing the fractional balances
+	suite.Require().NoError(suite.App.FundModuleAccount(suite.Ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("ukava", 2))))
+
+	dust1 := multiplier.QuoRaw(10).MulRaw(6)
+	dust2 := multiplier.QuoRaw(10).MulRaw(7)
+	aboveThreshold := multiplier.QuoRaw(10).MulRaw(9)
+	userDust := multiplier.QuoRaw(10)
+	suite.Require().NoError(suite.Keeper.SetBalance(suite.Ctx, feeCollectorAddr, dust1))
+	suite.Require().NoError(suite.Keeper.SetBalance(suite.Ctx, otherModuleAddr, dust2))
+	suite.Require().NoError(suite.Keeper.SetBalance(suite.Ctx, suite.Addrs[0], userDust))
+	suite.Require().NoError(suite.Keeper.SetBalance(suite.Ctx, suite.Addrs[1], aboveThreshold))
+	supplyBefore := suite.BankKeeper.GetSupply(suite.Ctx, "ukava")
+
+	suite.Require().NoError(suite.Keeper.SweepDust(suite.Ctx))
+
+	suite.True(suite.Keeper.GetBalance(suite.Ctx, feeCollectorAddr).IsZero())
+	suite.True(suite.Keeper.GetBalance(suite.Ctx, otherModuleAddr).IsZero())
+	// user accounts are never swept
+	suite.Equal(userDust, suite.Keeper.GetBalance(suite.Ctx, suite.Addrs[0]))
+	suite.Equal(aboveThreshold, suite.Keeper.GetBalance(suite.Ctx, suite.Addrs[1]))
+	// one whole ukava is converted out of the consolidated dust, and its reserve is kept as the module's x/bank balance
+	suite.Equal(dust1.Add(dust2).Sub(multiplier), suite.Keeper.GetBalance(suite.Ctx, moduleAddr))
+	suite.Equal(sdkmath.NewInt(2), suite.BankKeeper.GetBalance(suite.Ctx, moduleAddr, "ukava").Amount)
+	suite.Equal(supplyBefore, suite.BankKeeper.GetSupply(suite.Ctx, "ukava"))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeSweepDust,
+		sdk.NewAttribute(types.AttributeKeyAccount, feeCollectorAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewCoin("akava", dust1).String()),
+	))
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeSweepDust,
+		sdk.NewAttribute(types.AttributeKeyAccount, otherModuleAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewCoin("akava", dust2).String()),
+	))
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeConvertDust,
+		sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewCoin("ukava", sdkmath.OneInt()).String()),
+	))
+}
+
+func (suite *dustTestSuite) TestSweepDust_BelowOneUkava() {
+	multiplier := suite.Keeper.GetParams(suite.Ctx).ConversionMultiplier
+	feeCollectorAddr := suite.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	moduleAddr := suite.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.setDustSweepThreshold(multiplier)
+
+	dust := multiplier.QuoRaw(2)
+	suite.Require().NoError(suite.Keeper.SetBalance(suite.Ctx, feeCollectorAddr, dust))
+
+	suite.Require().NoError(suite.Keeper.SweepDust(suite.Ctx))
+
+	suite.True(suite.Keeper.GetBalance(suite.Ctx, feeCollectorAddr).IsZero())
+	suite.Equal(dust, suite.Keeper.GetBalance(suite.Ctx, moduleAddr))
+	suite.EventsDoNotContain(suite.GetEvents(), types.EventTypeConvertDust)
+}
+
+func (suite *dustTestSuite) TestSweepDust_ZeroThreshold() {
+	feeCollectorAddr := suite.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	dust := suite.Keeper.GetParams(suite.Ctx).ConversionMultiplier.QuoRaw(2)
+	suite.Require().NoError(suite.Keeper.SetBalance(suite.Ctx, feeCollectorAddr, dust))
+
+	suite.Require().NoError(suite.Keeper.SweepDust(suite.Ctx))
+
+	suite.Equal(dust, suite.Keeper.GetBalance(suite.Ctx, feeCollectorAddr))
+	suite.EventsDoNotContain(suite.GetEvents(), types.EventTypeSweepDust)
+}
+
+func (suite *dustTestSuite) TestSweepDust_Batches() {
+	multiplier := suite.Keeper.GetParams(suite.Ctx).ConversionMultiplier
+	suite.setDustSweepThreshold(multiplier)
+
+	numAccounts := keeper.DustSweepBatchSize + keeper.DustSweepBatchSize/2
+	dust := sdkmath.OneInt()
+	addrs := make([]sdk.AccAddress, numAccounts)
+	for i := range addrs {
+		addrs[i] = suite.newModuleAccount(fmt.Sprintf("dusty-%d", i))
+		suite.Require().NoError(suite.Keeper.SetBalance(suite.Ctx, addrs[i], dust))
+	}
+	countSwept := func() int {
+		swept := 0
+		for _, addr := range addrs {
+			if suite.Keeper.GetBalance(suite.Ctx, addr).IsZero() {
+				swept++
+			}
+		}
+		return swept
+	}
+
+	// the first sweep only checks one batch of accounts
+	suite.Require().NoError(suite.Keeper.SweepDust(suite.Ctx))
+	suite.Equal(keeper.DustSweepBatchSize, countSwept())
+
+	// the next sweep continues from where the first stopped, and reaches the last account
+	suite.Require().NoError(suite.Keeper.SweepDust(suite.Ctx))
+	suite.Equal(numAccounts, countSwept())
+
+	// the sweep then starts over from the first account
+	suite.Require().NoError(suite.Keeper.SetBalance(suite.Ctx, addrs[0], dust))
+	suite.Require().NoError(suite.Keeper.SweepDust(suite.Ctx))
+	suite.Require().NoError(suite.Keeper.SweepDust(suite.Ctx))
+	suite.Equal(numAccounts, countSwept())
+}
@@ -6,6 +6,7 @@ import (
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -19,7 +20,10 @@ import (
 const (
 	erc20BalanceOfMethod   = "balanceOf"
 	erc20BurnMethod        = "burn"
+	erc20DecimalsMethod    = "decimals"
 	erc20MintMethod        = "mint"
+	erc20NameMethod        = "name"
+	erc20SymbolMethod      = "symbol"
 	erc20TotalSupplyMethod = "totalSupply"
 )
 
@@ -75,7 +79,7 @@ func (k Keeper) DeployKavaWrappedCosmosCoinERC20Contract(
 	ctx sdk.Context,
 	token types.AllowedCosmosCoinERC20Token,
 ) (types.InternalEVMAddress, error) {
-	if err := token.Validate(); err != nil {
+	if err := token.ValidateMetadata(); err != nil {
 		return types.InternalEVMAddress{}, errorsmod.Wrapf(err, "failed to deploy erc20 for sdk denom %s", token.CosmosDenom)
 	}
 
@@ -114,7 +118,8 @@ func (k Keeper) DeployKavaWrappedCosmosCoinERC20Contract(
 }
 
 // GetOrDeployCosmosCoinERC20Contract checks the module store for a deployed contract for the given
-// token info and returns it if preset. Otherwise, it deploys and registers the contract.
+// token info and returns it if preset. Otherwise, it deploys and registers the contract, using the
+// x/bank denom metadata of the token where present.
 func (k *Keeper) GetOrDeployCosmosCoinERC20Contract(
 	ctx sdk.Context,
 	tokenInfo types.AllowedCosmosCoinERC20Token,
@@ -126,7 +131,7 @@ func (k *Keeper) GetOrDeployCosmosCoinERC20Contract(
 	}
 
 	// deploy a new contract
-	contractAddress, err := k.DeployKavaWrappedCosmosCoinERC20Contract(ctx, tokenInfo)
+	contractAddress, err := k.DeployKavaWrappedCosmosCoinERC20Contract(ctx, k.GetERC20TokenMetadata(ctx, tokenInfo))
 	if err != nil {
 		return contractAddress, err
 	}
@@ -139,6 +144,38 @@ func (k *Keeper) GetOrDeployCosmosCoinERC20Contract(
 	return contractAddress, err
 }
 
+// AutoDeployCosmosCoinERC20Contract deploys the ERC20 contract for a cosmos denom
+// if the denom is in both the AutoDeployCosmosDenoms and AllowedCosmosDenoms params
+// and no contract has been deployed yet. Returns true if a contract was deployed.
+func (k *Keeper) AutoDeployCosmosCoinERC20Contract(
+	ctx sdk.Context,
+	cosmosDenom string,
+) (bool, error) {
+	if !k.GetParams(ctx).IsAutoDeployCosmosDenom(cosmosDenom) {
+		return false, nil
+	}
+	if _, found := k.GetDeployedCosmosCoinContract(ctx, cosmosDenom); found {
+		return false, nil
+	}
+	tokenInfo, allowed := k.GetAllowedTokenMetadata(ctx, cosmosDenom)
+	if !allowed {
+		return false, nil
+	}
+
+	contractAddress, err := k.GetOrDeployCosmosCoinERC20Contract(ctx, tokenInfo)
+	if err != nil {
+		return false, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAutoDeployCosmosCoinERC20,
+		sdk.NewAttribute(types.AttributeKeyCosmosDenom, cosmosDenom),
+		sdk.NewAttribute(types.AttributeKeyERC20Address, contractAddress.Hex()),
+	))
+
+	return true, nil
+}
+
 // MintERC20 mints the given amount of an ERC20 token to an address. This is
 // unchecked and should only be called after permission and enabled ERC20 checks.
 func (k Keeper) MintERC20(
@@ -226,6 +263,138 @@ func (k Keeper) QueryERC20TotalSupply(
 	return unpackERC20ResToBigInt(res, erc20TotalSupplyMethod)
 }
 
+// GetBalanceOf returns the ERC20 balance of the account in the contract deployed for the
+// given cosmos denom, along with the address of that contract. An error is returned if no
+// contract has been deployed for the denom.
+func (k Keeper) GetBalanceOf(
+	ctx sdk.Context,
+	cosmosDenom string,
+	account types.InternalEVMAddress,
+) (types.InternalEVMAddress, sdkmath.Int, error) {
+	contractAddress, found := k.GetDeployedCosmosCoinContract(ctx, cosmosDenom)
+	if !found {
+		return types.InternalEVMAddress{}, sdkmath.Int{}, errorsmod.Wrapf(
+			types.ErrInvalidCosmosDenom, "no erc20 contract deployed for denom %s", cosmosDenom,
+		)
+	}
+
+	balance, err := k.QueryERC20BalanceOf(ctx, contractAddress, account)
+	if err != nil {
+		return types.InternalEVMAddress{}, sdkmath.Int{}, err
+	}
+
+	return contractAddress, sdkmath.NewIntFromBigInt(balance), nil
+}
+
+// GetERC20TokenMetadata returns the token info with its name, symbol, and decimals taken from the
+// x/bank denom metadata of its cosmos denom. If no bank metadata exists, the token info is returned as is.
+func (k Keeper) GetERC20TokenMetadata(
+	ctx sdk.Context,
+	tokenInfo types.AllowedCosmosCoinERC20Token,
+) types.AllowedCosmosCoinERC20Token {
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, tokenInfo.CosmosDenom)
+	if !found {
+		return tokenInfo
+	}
+	return tokenInfo.WithBankMetadata(metadata)
+}
+
+// QueryERC20Metadata makes contract calls to the name, symbol, and decimals methods of the ERC20
+// contract to get the metadata it was deployed with.
+func (k Keeper) QueryERC20Metadata(
+	ctx sdk.Context,
+	cosmosDenom string,
+	contractAddr types.InternalEVMAddress,
+) (types.AllowedCosmosCoinERC20Token, error) {
+	outputs := make(map[string]interface{}, 3)
+	for _, method := range []string{erc20NameMethod, erc20SymbolMethod, erc20DecimalsMethod} {
+		res, err := k.CallEVM(
+			ctx,
+			types.ERC20KavaWrappedCosmosCoinContract.ABI,
+			types.ModuleEVMAddress,
+			contractAddr,
+			method,
+		)
+		if err != nil {
+			return types.AllowedCosmosCoinERC20Token{}, err
+		}
+		if res.Failed() {
+			return types.AllowedCosmosCoinERC20Token{}, status.Error(codes.Internal, res.VmError)
+		}
+
+		anyOutput, err := types.ERC20KavaWrappedCosmosCoinContract.ABI.Unpack(method, res.Ret)
+		if err != nil {
+			return types.AllowedCosmosCoinERC20Token{}, fmt.Errorf("failed to unpack method %v response: %w", method, err)
+		}
+		if len(anyOutput) != 1 {
+			return types.AllowedCosmosCoinERC20Token{}, fmt.Errorf(
+				"invalid ERC20 %v call return outputs %v, expected %v", method, len(anyOutput), 1,
+			)
+		}
+		outputs[method] = anyOutput[0]
+	}
+
+	name, okName := outputs[erc20NameMethod].(string)
+	symbol, okSymbol := outputs[erc20SymbolMethod].(string)
+	decimals, okDecimals := outputs[erc20DecimalsMethod].(uint8)
+	if !okName || !okSymbol || !okDecimals {
+		return types.AllowedCosmosCoinERC20Token{}, fmt.Errorf("invalid ERC20 metadata return types for contract %s", contractAddr)
+	}
+
+	return types.NewAllowedCosmosCoinERC20Token(cosmosDenom, name, symbol, uint32(decimals)), nil
+}
+
+// SyncERC20Metadata replaces the ERC20 contract deployed for a cosmos denom with one deployed
+// with the denom's current x/bank metadata. Deployed contract metadata is immutable, so this is
+// only possible while the deployed contract has no outstanding supply.
+// Returns the address of the replaced contract and the newly deployed contract.
+func (k *Keeper) SyncERC20Metadata(
+	ctx sdk.Context,
+	cosmosDenom string,
+) (types.InternalEVMAddress, types.InternalEVMAddress, error) {
+	tokenInfo, allowed := k.GetAllowedTokenMetadata(ctx, cosmosDenom)
+	if !allowed {
+		return types.InternalEVMAddress{}, types.InternalEVMAddress{}, errorsmod.Wrapf(types.ErrSDKConversionNotEnabled, cosmosDenom)
+	}
+	previousAddress, found := k.GetDeployedCosmosCoinContract(ctx, cosmosDenom)
+	if !found {
+		return types.InternalEVMAddress{}, types.InternalEVMAddress{}, errorsmod.Wrapf(
+			types.ErrInvalidCosmosDenom, "no erc20 contract deployed for denom %s", cosmosDenom,
+		)
+	}
+
+	tokenInfo = k.GetERC20TokenMetadata(ctx, tokenInfo)
+	deployed, err := k.QueryERC20Metadata(ctx, cosmosDenom, previousAddress)
+	if err != nil {
+		return types.InternalEVMAddress{}, types.InternalEVMAddress{}, err
+	}
+	if deployed.Equal(tokenInfo) {
+		return types.InternalEVMAddress{}, types.InternalEVMAddress{}, errorsmod.Wrapf(
+			types.ErrERC20MetadataNotSynced, "metadata of contract %s is already in sync", previousAddress,
+		)
+	}
+
+	supply, err := k.QueryERC20TotalSupply(ctx, previousAddress)
+	if err != nil {
+		return types.InternalEVMAddress{}, types.InternalEVMAddress{}, err
+	}
+	if supply.Sign() != 0 {
+		return types.InternalEVMAddress{}, types.InternalEVMAddress{}, errorsmod.Wrapf(
+			types.ErrERC20MetadataNotSynced, "contract %s has outstanding supply %s", previousAddress, supply,
+		)
+	}
+
+	contractAddress, err := k.DeployKavaWrappedCosmosCoinERC20Contract(ctx, tokenInfo)
+	if err != nil {
+		return types.InternalEVMAddress{}, types.InternalEVMAddress{}, err
+	}
+	if err := k.SetDeployedCosmosCoinContract(ctx, cosmosDenom, contractAddress); err != nil {
+		return types.InternalEVMAddress{}, types.InternalEVMAddress{}, err
+	}
+
+	return previousAddress, contractAddress, nil
+}
+
 func unpackERC20ResToBigInt(res *evmtypes.MsgEthereumTxResponse, methodName string) (*big.Int, error) {
 	if res.Failed() {
 		if res.VmError == vm.ErrExecutionReverted.Error() {
@@ -4,6 +4,8 @@ import (
 	"math/big"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 
@@ -209,6 +211,30 @@ func (suite *ERC20TestSuite) TestGetOrDeployCosmosCoinERC20Contract() {
 		suite.Equal(contractAddress, registeredAddress)
 	})
 
+	suite.Run("deploys contract with x/bank denom metadata", func() {
+		suite.SetupTest()
+		denom := "magic"
+		// allowed token only specifies the denom, metadata comes from x/bank
+		tokenInfo := types.AllowedCosmosCoinERC20Token{CosmosDenom: denom}
+		suite.BankKeeper.SetDenomMetaData(suite.Ctx, banktypes.Metadata{
+			Base:    denom,
+			Display: "MAGIC",
+			Name:    "Magic Coin",
+			Symbol:  "MAGIC",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: denom, Exponent: 0},
+				{Denom: "MAGIC", Exponent: 6},
+			},
+		})
+
+		contractAddress, err := suite.Keeper.GetOrDeployCosmosCoinERC20Contract(suite.Ctx, tokenInfo)
+		suite.Require().NoError(err)
+
+		deployed, err := suite.Keeper.QueryERC20Metadata(suite.Ctx, denom, contractAddress)
+		suite.Require().NoError(err)
+		suite.Equal(types.NewAllowedCosmosCoinERC20Token(denom, "Magic Coin", "MAGIC", 6), deployed)
+	})
+
 	// this can only happen if governance passes a bad allowed token
 	suite.Run("fails when token can't be deployed", func() {
 		suite.SetupTest()
@@ -230,3 +256,108 @@ func (suite *ERC20TestSuite) TestGetOrDeployCosmosCoinERC20Contract() {
 		suite.False(found)
 	})
 }
+
+func (suite *ERC20TestSuite) TestQueryERC20Metadata() {
+	tokenInfo := types.NewAllowedCosmosCoinERC20Token("magic", "Magic Coin", "MAGIC", 6)
+	contractAddress, err := suite.Keeper.DeployKavaWrappedCosmosCoinERC20Contract(suite.Ctx, tokenInfo)
+	suite.Require().NoError(err)
+
+	deployed, err := suite.Keeper.QueryERC20Metadata(suite.Ctx, "magic", contractAddress)
+	suite.Require().NoError(err)
+	suite.Equal(tokenInfo, deployed)
+}
+
+func (suite *ERC20TestSuite) TestGetBalanceOf() {
+	denom := "magic"
+	tokenInfo := types.NewAllowedCosmosCoinERC20Token(denom, "Magic Coin", "MAGIC", 6)
+
+	suite.Run("fails when no contract is deployed", func() {
+		suite.SetupTest()
+		_, _, err := suite.Keeper.GetBalanceOf(suite.Ctx, denom, suite.Key1Addr)
+		suite.ErrorIs(err, types.ErrInvalidCosmosDenom)
+	})
+
+	suite.Run("returns balance of deployed contract", func() {
+		suite.SetupTest()
+		contractAddress, err := suite.Keeper.GetOrDeployCosmosCoinERC20Contract(suite.Ctx, tokenInfo)
+		suite.Require().NoError(err)
+
+		addr, bal, err := suite.Keeper.GetBalanceOf(suite.Ctx, denom, suite.Key1Addr)
+		suite.Require().NoError(err)
+		suite.Equal(contractAddress, addr)
+		suite.Equal(sdk.ZeroInt(), bal)
+
+		err = suite.Keeper.MintERC20(suite.Ctx, contractAddress, suite.Key1Addr, big.NewInt(1e6))
+		suite.Require().NoError(err)
+
+		addr, bal, err = suite.Keeper.GetBalanceOf(suite.Ctx, denom, suite.Key1Addr)
+		suite.Require().NoError(err)
+		suite.Equal(contractAddress, addr)
+		suite.Equal(sdk.NewInt(1e6), bal)
+	})
+}
+
+func (suite *ERC20TestSuite) TestAutoDeployCosmosCoinERC20Contract() {
+	denom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	tokenInfo := types.NewAllowedCosmosCoinERC20Token(denom, "Kava EVM Atom", "ATOM", 6)
+
+	setParams := func(allowed bool, autoDeploy bool) {
+		params := suite.Keeper.GetParams(suite.Ctx)
+		params.AllowedCosmosDenoms = types.NewAllowedCosmosCoinERC20Tokens()
+		if allowed {
+			params.AllowedCosmosDenoms = types.NewAllowedCosmosCoinERC20Tokens(tokenInfo)
+		}
+		params.AutoDeployCosmosDenoms = []string{}
+		if autoDeploy {
+			params.AutoDeployCosmosDenoms = []string{denom}
+		}
+		suite.Keeper.SetParams(suite.Ctx, params)
+	}
+
+	suite.Run("skips denoms not configured for auto deploy", func() {
+		suite.SetupTest()
+		setParams(true, false)
+
+		deployed, err := suite.Keeper.AutoDeployCosmosCoinERC20Contract(suite.Ctx, denom)
+		suite.NoError(err)
+		suite.False(deployed)
+		_, found := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, denom)
+		suite.False(found)
+	})
+
+	suite.Run("skips auto deploy denoms without allowed token metadata", func() {
+		suite.SetupTest()
+		setParams(false, true)
+
+		deployed, err := suite.Keeper.AutoDeployCosmosCoinERC20Contract(suite.Ctx, denom)
+		suite.NoError(err)
+		suite.False(deployed)
+		_, found := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, denom)
+		suite.False(found)
+	})
+
+	suite.Run("deploys once and emits an event", func() {
+		suite.SetupTest()
+		setParams(true, true)
+
+		deployed, err := suite.Keeper.AutoDeployCosmosCoinERC20Contract(suite.Ctx, denom)
+		suite.NoError(err)
+		suite.True(deployed)
+
+		contractAddress, found := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, denom)
+		suite.True(found)
+		suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+			types.EventTypeAutoDeployCosmosCoinERC20,
+			sdk.NewAttribute(types.AttributeKeyCosmosDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Address, contractAddress.Hex()),
+		))
+
+		// second receipt does not redeploy
+		deployed, err = suite.Keeper.AutoDeployCosmosCoinERC20Contract(suite.Ctx, denom)
+		suite.NoError(err)
+		suite.False(deployed)
+		after, found := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, denom)
+		suite.True(found)
+		suite.Equal(contractAddress, after)
+	})
+}
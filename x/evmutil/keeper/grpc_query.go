@@ -2,13 +2,16 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/kava-labs/kava/x/evmutil/types"
 )
@@ -57,6 +60,88 @@ func (s queryServer) DeployedCosmosCoinContracts(
 	return res, err
 }
 
+// BackingStatus reports whether the sum of all minor balances is backed by the
+// ukava held in the module account.
+func (s queryServer) BackingStatus(
+	goCtx context.Context,
+	req *types.QueryBackingStatusRequest,
+) (*types.QueryBackingStatusResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := s.keeper.GetParams(ctx)
+	totalMinorBalances := s.keeper.GetTotalMinorBalances(ctx)
+	moduleBalance := s.keeper.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), params.CosmosDenom)
+
+	return &types.QueryBackingStatusResponse{
+		TotalFractionalBalances: totalMinorBalances,
+		ModuleBalance:           moduleBalance,
+		FullyBacked:             totalMinorBalances.LTE(moduleBalance.Amount.Mul(params.ConversionMultiplier)),
+	}, nil
+}
+
+// ConversionRecords returns a page of the most recent conversion records
+func (s queryServer) ConversionRecords(
+	goCtx context.Context,
+	req *types.QueryConversionRecordsRequest,
+) (*types.QueryConversionRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	recordStore := prefix.NewStore(ctx.KVStore(s.keeper.storeKey), types.ConversionRecordKeyPrefix)
+
+	records := []types.ConversionRecord{}
+	pageRes, err := query.Paginate(recordStore, req.Pagination, func(_, value []byte) error {
+		var record types.ConversionRecord
+		if err := s.keeper.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryConversionRecordsResponse{
+		Records:    records,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ConversionRateLimits returns the conversion rate limits and their usage within the current window
+func (s queryServer) ConversionRateLimits(
+	goCtx context.Context,
+	req *types.QueryConversionRateLimitsRequest,
+) (*types.QueryConversionRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.Denom == "" {
+		return &types.QueryConversionRateLimitsResponse{
+			Usages: s.keeper.GetAllConversionRateLimitUsages(ctx),
+		}, nil
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom: %s", err)
+	}
+	usage, found := s.keeper.GetConversionRateLimitUsage(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no conversion rate limit for %s", req.Denom)
+	}
+
+	return &types.QueryConversionRateLimitsResponse{
+		Usages: []types.ConversionRateLimitUsage{usage},
+	}, nil
+}
+
 // getAllDeployedCosmosCoinContractsPage gets a page of deployed contracts (no filtering)
 func getAllDeployedCosmosCoinContractsPage(
 	k *Keeper, ctx sdk.Context, pagination *query.PageRequest,
@@ -111,3 +196,50 @@ func getDeployedCosmosCoinContractsByDenoms(
 		DeployedCosmosCoinContracts: contracts,
 	}, nil
 }
+
+// CosmosCoinERC20Balance returns the ERC20 balance of an address for a cosmos denom's deployed
+// contract, alongside the address' native balance and the amount locked in the module account.
+func (s queryServer) CosmosCoinERC20Balance(
+	goCtx context.Context,
+	req *types.QueryCosmosCoinERC20BalanceRequest,
+) (*types.QueryCosmosCoinERC20BalanceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.CosmosDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cosmos denom: %s", err)
+	}
+	account, err := parseEVMAddress(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	contractAddress, balance, err := s.keeper.GetBalanceOf(ctx, req.CosmosDenom, account)
+	if err != nil {
+		if types.ErrInvalidCosmosDenom.Is(err) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return &types.QueryCosmosCoinERC20BalanceResponse{
+		ContractAddress:     contractAddress.Hex(),
+		Erc20Balance:        balance,
+		NativeBalance:       s.keeper.bankKeeper.GetBalance(ctx, sdk.AccAddress(account.Bytes()), req.CosmosDenom),
+		ModuleLockedBalance: s.keeper.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), req.CosmosDenom),
+	}, nil
+}
+
+// parseEVMAddress parses an address given either as a hex or a bech32 string.
+func parseEVMAddress(address string) (types.InternalEVMAddress, error) {
+	if common.IsHexAddress(address) {
+		return types.NewInternalEVMAddressFromString(address)
+	}
+
+	accAddr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return types.InternalEVMAddress{}, fmt.Errorf("address must be a hex or bech32 address: %s", address)
+	}
+	return types.BytesToInternalEVMAddress(accAddr), nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -186,3 +187,173 @@ func (suite *grpcQueryTestSuite) TestQueryDeployedCosmosCoinContracts() {
 		suite.ErrorContains(err, "maximum of 100 denoms allowed per request")
 	})
 }
+
+func (suite *grpcQueryTestSuite) TestQueryBackingStatus() {
+	suite.Run("fully backed with no minor balances", func() {
+		res, err := suite.QueryClient.BackingStatus(
+			context.Background(),
+			&types.QueryBackingStatusRequest{},
+		)
+		suite.Require().NoError(err)
+		suite.True(res.FullyBacked)
+		suite.Equal(sdk.ZeroInt(), res.TotalFractionalBalances)
+	})
+
+	// minor balances without any ukava in the module account are not backed
+	err := suite.Keeper.SetBalance(suite.Ctx, app.RandomAddress(), keeper.ConversionMultiplier.QuoRaw(2))
+	suite.Require().NoError(err)
+	err = suite.Keeper.SetBalance(suite.Ctx, app.RandomAddress(), keeper.ConversionMultiplier.QuoRaw(2))
+	suite.Require().NoError(err)
+
+	suite.Run("not backed when module balance is short", func() {
+		res, err := suite.QueryClient.BackingStatus(
+			context.Background(),
+			&types.QueryBackingStatusRequest{},
+		)
+		suite.Require().NoError(err)
+		suite.False(res.FullyBacked)
+		suite.Equal(keeper.ConversionMultiplier, res.TotalFractionalBalances)
+		suite.True(res.ModuleBalance.IsZero())
+	})
+
+	err = suite.App.FundModuleAccount(
+		suite.Ctx,
+		types.ModuleName,
+		sdk.NewCoins(sdk.NewInt64Coin(keeper.CosmosDenom, 1)),
+	)
+	suite.Require().NoError(err)
+
+	suite.Run("fully backed when module balance covers minor balances", func() {
+		res, err := suite.QueryClient.BackingStatus(
+			context.Background(),
+			&types.QueryBackingStatusRequest{},
+		)
+		suite.Require().NoError(err)
+		suite.True(res.FullyBacked)
+		suite.Equal(sdk.NewInt64Coin(keeper.CosmosDenom, 1), res.ModuleBalance)
+	})
+}
+
+// grpcQueryEVMTestSuite tests queries that require a context able to execute EVM calls
+type grpcQueryEVMTestSuite struct {
+	testutil.Suite
+}
+
+func TestGrpcQueryEVMTestSuite(t *testing.T) {
+	suite.Run(t, new(grpcQueryEVMTestSuite))
+}
+
+func (suite *grpcQueryEVMTestSuite) TestQueryCosmosCoinERC20Balance() {
+	denom := "magic"
+	initiator := app.RandomAddress()
+	receiver := types.BytesToInternalEVMAddress(initiator)
+
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.AllowedCosmosDenoms = types.NewAllowedCosmosCoinERC20Tokens(
+		types.NewAllowedCosmosCoinERC20Token(denom, "Magic Coin", "MAGIC", 6),
+	)
+	suite.Keeper.SetParams(suite.Ctx, params)
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+
+	suite.Run("fails when no contract is deployed", func() {
+		_, err := queryServer.CosmosCoinERC20Balance(
+			sdk.WrapSDKContext(suite.Ctx),
+			&types.QueryCosmosCoinERC20BalanceRequest{CosmosDenom: denom, Address: receiver.Hex()},
+		)
+		suite.ErrorContains(err, "no erc20 contract deployed for denom magic")
+	})
+
+	suite.Run("fails with invalid address", func() {
+		_, err := queryServer.CosmosCoinERC20Balance(
+			sdk.WrapSDKContext(suite.Ctx),
+			&types.QueryCosmosCoinERC20BalanceRequest{CosmosDenom: denom, Address: "nope"},
+		)
+		suite.ErrorContains(err, "address must be a hex or bech32 address")
+	})
+
+	// convert some coins to deploy the contract & lock funds in the module account
+	err := suite.App.FundAccount(suite.Ctx, initiator, sdk.NewCoins(sdk.NewInt64Coin(denom, 1e6)))
+	suite.Require().NoError(err)
+	err = suite.Keeper.ConvertCosmosCoinToERC20(suite.Ctx, initiator, receiver, sdk.NewInt64Coin(denom, 4e5))
+	suite.Require().NoError(err)
+	contractAddress, found := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, denom)
+	suite.Require().True(found)
+
+	for _, address := range []string{receiver.Hex(), initiator.String()} {
+		suite.Run(fmt.Sprintf("returns balances for %s", address), func() {
+			res, err := queryServer.CosmosCoinERC20Balance(
+				sdk.WrapSDKContext(suite.Ctx),
+				&types.QueryCosmosCoinERC20BalanceRequest{CosmosDenom: denom, Address: address},
+			)
+			suite.Require().NoError(err)
+			suite.Equal(contractAddress.Hex(), res.ContractAddress)
+			suite.Equal(sdk.NewInt(4e5), res.Erc20Balance)
+			suite.Equal(sdk.NewInt64Coin(denom, 6e5), res.NativeBalance)
+			suite.Equal(sdk.NewInt64Coin(denom, 4e5), res.ModuleLockedBalance)
+		})
+	}
+}
+
+func (suite *grpcQueryTestSuite) TestQueryConversionRecords() {
+	for i := 0; i < 3; i++ {
+		suite.Keeper.AppendConversionRecord(
+			suite.Ctx, "initiator", "receiver", "erc20", sdk.NewInt64Coin("magic", int64(i+1)),
+			types.CONVERSION_DIRECTION_COIN_TO_ERC20,
+		)
+	}
+
+	res, err := suite.QueryClient.ConversionRecords(
+		context.Background(),
+		&types.QueryConversionRecordsRequest{},
+	)
+	suite.Require().NoError(err)
+	suite.Len(res.Records, 3)
+	suite.Equal(uint64(1), res.Records[0].ID)
+
+	res, err = suite.QueryClient.ConversionRecords(
+		context.Background(),
+		&types.QueryConversionRecordsRequest{
+			Pagination: &query.PageRequest{Limit: 2, Reverse: true},
+		},
+	)
+	suite.Require().NoError(err)
+	suite.Len(res.Records, 2)
+	suite.Equal(uint64(3), res.Records[0].ID)
+	suite.Equal(uint64(2), res.Records[1].ID)
+	suite.NotNil(res.Pagination.NextKey)
+}
+
+func (suite *grpcQueryTestSuite) TestQueryConversionRateLimits() {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.ConversionRateLimits = types.NewConversionRateLimits(
+		types.NewConversionRateLimit("magic", sdkmath.NewInt(100), 10),
+		types.NewConversionRateLimit("other", sdkmath.NewInt(50), 5),
+	)
+	suite.Keeper.SetParams(suite.Ctx, params)
+	suite.Require().NoError(suite.Keeper.TrackConversion(suite.Ctx, sdk.NewInt64Coin("magic", 30)))
+
+	res, err := suite.QueryClient.ConversionRateLimits(
+		context.Background(),
+		&types.QueryConversionRateLimitsRequest{},
+	)
+	suite.Require().NoError(err)
+	suite.Equal([]types.ConversionRateLimitUsage{
+		types.NewConversionRateLimitUsage(params.ConversionRateLimits[0], sdkmath.NewInt(30)),
+		types.NewConversionRateLimitUsage(params.ConversionRateLimits[1], sdkmath.ZeroInt()),
+	}, res.Usages)
+
+	res, err = suite.QueryClient.ConversionRateLimits(
+		context.Background(),
+		&types.QueryConversionRateLimitsRequest{Denom: "magic"},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Usages, 1)
+	suite.Equal(sdkmath.NewInt(30), res.Usages[0].UsedAmount)
+	suite.Equal(sdkmath.NewInt(70), res.Usages[0].RemainingAmount)
+
+	_, err = suite.QueryClient.ConversionRateLimits(
+		context.Background(),
+		&types.QueryConversionRateLimitsRequest{Denom: "unlimited"},
+	)
+	suite.ErrorContains(err, "no conversion rate limit for unlimited")
+}
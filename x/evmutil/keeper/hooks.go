@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+// Implements BalanceHooks interface
+var _ types.BalanceHooks = Keeper{}
+
+// AfterBalanceChange - call hook if registered
+func (k Keeper) AfterBalanceChange(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	previousBalance sdkmath.Int,
+	newBalance sdkmath.Int,
+) {
+	if k.hooks != nil {
+		k.hooks.AfterBalanceChange(ctx, addr, previousBalance, newBalance)
+	}
+}
+
+// AfterSendBalance - call hook if registered
+func (k Keeper) AfterSendBalance(
+	ctx sdk.Context,
+	senderAddr sdk.AccAddress,
+	recipientAddr sdk.AccAddress,
+	amt sdkmath.Int,
+) {
+	if k.hooks != nil {
+		k.hooks.AfterSendBalance(ctx, senderAddr, recipientAddr, amt)
+	}
+}
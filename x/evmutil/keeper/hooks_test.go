@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/kava-labs/kava/x/evmutil/testutil"
+	"github.com/kava-labs/kava/x/evmutil/types/mocks"
+)
+
+type hookTestSuite struct {
+	testutil.Suite
+}
+
+func TestHookTestSuite(t *testing.T) {
+	suite.Run(t, new(hookTestSuite))
+}
+
+func (suite *hookTestSuite) TestHooks_SetBalance() {
+	suite.Keeper.ClearHooks()
+	balanceHooks := mocks.NewBalanceHooks(suite.T())
+	suite.Keeper.SetHooks(balanceHooks)
+
+	addr := suite.Addrs[0]
+
+	// creating a balance calls AfterBalanceChange from zero
+	balanceHooks.On("AfterBalanceChange", mock.Anything, addr, intEq(sdkmath.ZeroInt()), intEq(sdkmath.NewInt(100))).Once()
+	suite.Require().NoError(suite.Keeper.SetBalance(suite.Ctx, addr, sdkmath.NewInt(100)))
+
+	// setting an unchanged balance does not call hooks
+	suite.Require().NoError(suite.Keeper.SetBalance(suite.Ctx, addr, sdkmath.NewInt(100)))
+
+	balanceHooks.On("AfterBalanceChange", mock.Anything, addr, intEq(sdkmath.NewInt(100)), intEq(sdkmath.NewInt(150))).Once()
+	suite.Require().NoError(suite.Keeper.AddBalance(suite.Ctx, addr, sdkmath.NewInt(50)))
+
+	balanceHooks.On("AfterBalanceChange", mock.Anything, addr, intEq(sdkmath.NewInt(150)), intEq(sdkmath.ZeroInt())).Once()
+	suite.Require().NoError(suite.Keeper.RemoveBalance(suite.Ctx, addr, sdkmath.NewInt(150)))
+}
+
+func (suite *hookTestSuite) TestHooks_SendBalance() {
+	suite.Keeper.ClearHooks()
+	balanceHooks := mocks.NewBalanceHooks(suite.T())
+
+	sender := suite.Addrs[0]
+	recipient := suite.Addrs[1]
+	suite.Require().NoError(suite.Keeper.SetBalance(suite.Ctx, sender, sdkmath.NewInt(100)))
+	suite.Keeper.SetHooks(balanceHooks)
+
+	balanceHooks.On("AfterBalanceChange", mock.Anything, sender, intEq(sdkmath.NewInt(100)), intEq(sdkmath.NewInt(40))).Once()
+	balanceHooks.On("AfterBalanceChange", mock.Anything, recipient, intEq(sdkmath.ZeroInt()), intEq(sdkmath.NewInt(60))).Once()
+	balanceHooks.On("AfterSendBalance", mock.Anything, sender, recipient, intEq(sdkmath.NewInt(60))).Once()
+	suite.Require().NoError(suite.Keeper.SendBalance(suite.Ctx, sender, recipient, sdkmath.NewInt(60)))
+
+	// failed sends do not call hooks
+	err := suite.Keeper.SendBalance(suite.Ctx, sender, recipient, sdkmath.NewInt(1000))
+	suite.Require().Error(err)
+}
+
+// intEq matches sdkmath.Int arguments by value, as zero values may differ in
+// their internal representation.
+func intEq(expected sdkmath.Int) interface{} {
+	return mock.MatchedBy(func(actual sdkmath.Int) bool {
+		return expected.Equal(actual)
+	})
+}
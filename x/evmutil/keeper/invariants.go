@@ -43,14 +43,11 @@ func FullyBackedInvariant(bankK types.BankKeeper, k Keeper) sdk.Invariant {
 	message := sdk.FormatInvariant(types.ModuleName, "fully backed broken", "sum of minor balances greater than module account")
 
 	return func(ctx sdk.Context) (string, bool) {
-		totalMinorBalances := sdk.ZeroInt()
-		k.IterateAllAccounts(ctx, func(acc types.Account) bool {
-			totalMinorBalances = totalMinorBalances.Add(acc.Balance)
-			return false
-		})
+		params := k.GetParams(ctx)
+		totalMinorBalances := k.GetTotalMinorBalances(ctx)
 
 		bankAddr := authtypes.NewModuleAddress(types.ModuleName)
-		bankBalance := bankK.GetBalance(ctx, bankAddr, CosmosDenom).Amount.Mul(ConversionMultiplier)
+		bankBalance := bankK.GetBalance(ctx, bankAddr, params.CosmosDenom).Amount.Mul(params.ConversionMultiplier)
 
 		broken = totalMinorBalances.GT(bankBalance)
 
@@ -64,8 +61,9 @@ func SmallBalancesInvariant(_ types.BankKeeper, k Keeper) sdk.Invariant {
 	message := sdk.FormatInvariant(types.ModuleName, "small balances broken", "minor balances not all less than overflow")
 
 	return func(ctx sdk.Context) (string, bool) {
+		conversionMultiplier := k.GetParams(ctx).ConversionMultiplier
 		k.IterateAllAccounts(ctx, func(account types.Account) bool {
-			if account.Balance.GTE(ConversionMultiplier) {
+			if account.Balance.GTE(conversionMultiplier) {
 				broken = true
 				return true
 			}
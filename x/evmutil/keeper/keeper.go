@@ -5,6 +5,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,12 +18,15 @@ import (
 // Keeper of the evmutil store.
 // This keeper stores additional data related to evm accounts.
 type Keeper struct {
-	cdc           codec.Codec
-	storeKey      storetypes.StoreKey
-	paramSubspace paramtypes.Subspace
-	bankKeeper    types.BankKeeper
-	evmKeeper     types.EvmKeeper
-	accountKeeper types.AccountKeeper
+	cdc            codec.Codec
+	storeKey       storetypes.StoreKey
+	paramSubspace  paramtypes.Subspace
+	bankKeeper     types.BankKeeper
+	evmKeeper      types.EvmKeeper
+	accountKeeper  types.AccountKeeper
+	transferKeeper types.TransferKeeper
+	authority      sdk.AccAddress
+	hooks          types.BalanceHooks
 }
 
 // NewKeeper creates an evmutil keeper.
@@ -32,7 +36,12 @@ func NewKeeper(
 	params paramtypes.Subspace,
 	bk types.BankKeeper,
 	ak types.AccountKeeper,
+	authority sdk.AccAddress,
 ) Keeper {
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", err))
+	}
+
 	if !params.HasKeyTable() {
 		params = params.WithKeyTable(types.ParamKeyTable())
 	}
@@ -43,13 +52,49 @@ func NewKeeper(
 		paramSubspace: params,
 		bankKeeper:    bk,
 		accountKeeper: ak,
+		authority:     authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the x/evmutil module's authority.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
+// Codec returns the codec used by the keeper to marshal store values.
+func (k Keeper) Codec() codec.Codec {
+	return k.cdc
+}
+
+// SetHooks adds hooks to the keeper.
+// Hooks must be set before the keeper is copied into other keepers, such as the EvmBankKeeper.
+func (k *Keeper) SetHooks(hooks types.BalanceHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set evmutil hooks twice")
 	}
+	k.hooks = hooks
+	return k
+}
+
+// ClearHooks clears the hooks on the keeper
+func (k *Keeper) ClearHooks() {
+	k.hooks = nil
 }
 
 func (k *Keeper) SetEvmKeeper(evmKeeper types.EvmKeeper) {
 	k.evmKeeper = evmKeeper
 }
 
+// SetTransferKeeper sets the IBC transfer keeper used to send converted coins to other chains.
+func (k *Keeper) SetTransferKeeper(transferKeeper types.TransferKeeper) {
+	k.transferKeeper = transferKeeper
+}
+
 // GetAllAccounts returns all accounts.
 func (k Keeper) GetAllAccounts(ctx sdk.Context) (accounts []types.Account) {
 	k.IterateAllAccounts(ctx, func(account types.Account) bool {
@@ -77,6 +122,16 @@ func (k Keeper) IterateAllAccounts(ctx sdk.Context, cb func(types.Account) bool)
 	}
 }
 
+// GetTotalMinorBalances returns the sum of all akava minor balances.
+func (k Keeper) GetTotalMinorBalances(ctx sdk.Context) sdkmath.Int {
+	total := sdk.ZeroInt()
+	k.IterateAllAccounts(ctx, func(acc types.Account) bool {
+		total = total.Add(acc.Balance)
+		return false
+	})
+	return total
+}
+
 // GetAccount returns the account for a given address.
 func (k Keeper) GetAccount(ctx sdk.Context, addr sdk.AccAddress) *types.Account {
 	store := ctx.KVStore(k.storeKey)
@@ -125,11 +180,14 @@ func (k Keeper) GetBalance(ctx sdk.Context, addr sdk.AccAddress) sdkmath.Int {
 }
 
 // SetBalance sets the total balance of akava for a given account by address.
+// The AfterBalanceChange hook is called if the balance changed.
 func (k Keeper) SetBalance(ctx sdk.Context, addr sdk.AccAddress, bal sdkmath.Int) error {
+	previousBalance := sdk.ZeroInt()
 	account := k.GetAccount(ctx, addr)
 	if account == nil {
 		account = types.NewAccount(addr, bal)
 	} else {
+		previousBalance = account.Balance
 		account.Balance = bal
 	}
 
@@ -137,7 +195,14 @@ func (k Keeper) SetBalance(ctx sdk.Context, addr sdk.AccAddress, bal sdkmath.Int
 		return err
 	}
 
-	return k.SetAccount(ctx, *account)
+	if err := k.SetAccount(ctx, *account); err != nil {
+		return err
+	}
+
+	if !previousBalance.Equal(bal) {
+		k.AfterBalanceChange(ctx, addr, previousBalance, bal)
+	}
+	return nil
 }
 
 // SendBalance transfers the akava balance from sender addr to recipient addr.
@@ -159,7 +224,12 @@ func (k Keeper) SendBalance(ctx sdk.Context, senderAddr sdk.AccAddress, recipien
 	}
 
 	receiverBal := k.GetBalance(ctx, recipientAddr).Add(amt)
-	return k.SetBalance(ctx, recipientAddr, receiverBal)
+	if err := k.SetBalance(ctx, recipientAddr, receiverBal); err != nil {
+		return err
+	}
+
+	k.AfterSendBalance(ctx, senderAddr, recipientAddr, amt)
+	return nil
 }
 
 // AddBalance increments the akava balance of an address.
@@ -230,3 +300,82 @@ func (k Keeper) IterateAllDeployedCosmosCoinContracts(ctx sdk.Context, cb func(t
 		}
 	}
 }
+
+// UpdateDeployedCosmosCoinContract repoints the cosmos denom to a new ERC20 contract address.
+// The replaced contract must have no outstanding supply, as its holders could no longer convert
+// back to the cosmos denom. If freeze is true, conversions of the replaced contract are frozen. If the new contract address
+// is empty, the denom keeps its current contract and freeze sets whether its conversions are frozen.
+// Returns the contract address the denom was mapped to before the update.
+func (k *Keeper) UpdateDeployedCosmosCoinContract(
+	ctx sdk.Context,
+	cosmosDenom string,
+	newContractAddress types.InternalEVMAddress,
+	freeze bool,
+) (types.InternalEVMAddress, error) {
+	previousAddress, found := k.GetDeployedCosmosCoinContract(ctx, cosmosDenom)
+	if !found {
+		return types.InternalEVMAddress{}, errorsmod.Wrapf(
+			types.ErrInvalidCosmosDenom, "no erc20 contract deployed for denom %s", cosmosDenom,
+		)
+	}
+
+	if newContractAddress.IsNil() {
+		k.SetCosmosCoinContractFrozen(ctx, previousAddress, freeze)
+		return previousAddress, nil
+	}
+
+	if newContractAddress.Equal(previousAddress) {
+		return types.InternalEVMAddress{}, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "denom %s is already mapped to contract %s", cosmosDenom, newContractAddress,
+		)
+	}
+
+	supply, err := k.QueryERC20TotalSupply(ctx, previousAddress)
+	if err != nil {
+		return types.InternalEVMAddress{}, err
+	}
+	if supply.Sign() != 0 {
+		return types.InternalEVMAddress{}, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "contract %s has outstanding supply %s", previousAddress, supply,
+		)
+	}
+
+	if err := k.SetDeployedCosmosCoinContract(ctx, cosmosDenom, newContractAddress); err != nil {
+		return types.InternalEVMAddress{}, err
+	}
+	if freeze {
+		k.SetCosmosCoinContractFrozen(ctx, previousAddress, true)
+	}
+
+	return previousAddress, nil
+}
+
+// SetCosmosCoinContractFrozen sets whether conversions of a deployed cosmos coin contract are frozen.
+func (k Keeper) SetCosmosCoinContractFrozen(ctx sdk.Context, contractAddress types.InternalEVMAddress, frozen bool) {
+	store := ctx.KVStore(k.storeKey)
+	storeKey := types.FrozenCosmosCoinContractKey(contractAddress)
+	if frozen {
+		store.Set(storeKey, []byte{0x01})
+	} else {
+		store.Delete(storeKey)
+	}
+}
+
+// IsCosmosCoinContractFrozen returns true if conversions of the deployed cosmos coin contract are frozen.
+func (k Keeper) IsCosmosCoinContractFrozen(ctx sdk.Context, contractAddress types.InternalEVMAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.FrozenCosmosCoinContractKey(contractAddress))
+}
+
+// IterateAllFrozenCosmosCoinContracts iterates through all deployed cosmos coin contracts whose conversions are frozen.
+func (k Keeper) IterateAllFrozenCosmosCoinContracts(ctx sdk.Context, cb func(types.InternalEVMAddress) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FrozenCosmosCoinContractKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.BytesToInternalEVMAddress(iterator.Key()[len(types.FrozenCosmosCoinContractKeyPrefix):])) {
+			break
+		}
+	}
+}
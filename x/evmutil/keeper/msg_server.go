@@ -3,8 +3,11 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/kava-labs/kava/x/evmutil/types"
 )
@@ -107,6 +110,30 @@ func (s msgServer) ConvertERC20ToCoin(
 	return &types.MsgConvertERC20ToCoinResponse{}, nil
 }
 
+// ConvertERC20AndTransfer handles a MsgConvertERC20AndTransfer message to convert
+// Kava EVM tokens to sdk.Coin and send them to another chain over IBC.
+func (s msgServer) ConvertERC20AndTransfer(
+	goCtx context.Context,
+	msg *types.MsgConvertERC20AndTransfer,
+) (*types.MsgConvertERC20AndTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sequence, err := s.keeper.ConvertERC20AndTransfer(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Initiator),
+		),
+	)
+
+	return &types.MsgConvertERC20AndTransferResponse{Sequence: sequence}, nil
+}
+
 ////////////////////////////
 // Cosmos SDK-native assets -> EVM
 ////////////////////////////
@@ -187,3 +214,136 @@ func (s msgServer) ConvertCosmosCoinFromERC20(
 
 	return &types.MsgConvertCosmosCoinFromERC20Response{}, nil
 }
+
+// ConvertCosmosCoinsToERC20Batch converts multiple native sdk.Coins to their ERC20s.
+// Each coin is converted as in ConvertCosmosCoinToERC20, deploying contracts as needed.
+// If any single conversion fails, the whole batch fails.
+func (s msgServer) ConvertCosmosCoinsToERC20Batch(
+	goCtx context.Context,
+	msg *types.MsgConvertCosmosCoinsToERC20Batch,
+) (*types.MsgConvertCosmosCoinsToERC20BatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	initiator, err := sdk.AccAddressFromBech32(msg.Initiator)
+	if err != nil {
+		return nil, fmt.Errorf("invalid initiator address: %w", err)
+	}
+
+	receiver, err := types.NewInternalEVMAddressFromString(msg.Receiver)
+	if err != nil {
+		return nil, fmt.Errorf("invalid receiver address: %w", err)
+	}
+
+	for _, amount := range msg.Amounts {
+		if err := s.keeper.ConvertCosmosCoinToERC20(
+			ctx,
+			initiator,
+			receiver,
+			amount,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Initiator),
+		),
+	)
+
+	return &types.MsgConvertCosmosCoinsToERC20BatchResponse{}, nil
+}
+
+// SyncERC20Metadata redeploys the ERC20 contract of a cosmos coin with its current x/bank metadata.
+func (s msgServer) SyncERC20Metadata(
+	goCtx context.Context,
+	msg *types.MsgSyncERC20Metadata,
+) (*types.MsgSyncERC20MetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Initiator); err != nil {
+		return nil, fmt.Errorf("invalid initiator address: %w", err)
+	}
+
+	previousAddress, contractAddress, err := s.keeper.SyncERC20Metadata(ctx, msg.CosmosDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenInfo, err := s.keeper.QueryERC20Metadata(ctx, msg.CosmosDenom, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSyncERC20Metadata,
+			sdk.NewAttribute(types.AttributeKeyCosmosDenom, msg.CosmosDenom),
+			sdk.NewAttribute(types.AttributeKeyERC20Address, contractAddress.Hex()),
+			sdk.NewAttribute(types.AttributeKeyPreviousERC20Address, previousAddress.Hex()),
+			sdk.NewAttribute(types.AttributeKeyName, tokenInfo.Name),
+			sdk.NewAttribute(types.AttributeKeySymbol, tokenInfo.Symbol),
+			sdk.NewAttribute(types.AttributeKeyDecimals, strconv.FormatUint(uint64(tokenInfo.Decimals), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Initiator),
+		),
+	})
+
+	return &types.MsgSyncERC20MetadataResponse{ContractAddress: contractAddress.Hex()}, nil
+}
+
+////////////////////////////
+// Governance
+////////////////////////////
+
+// UpdateDeployedCosmosCoinContract handles a governance MsgUpdateDeployedCosmosCoinContract to
+// repoint a cosmos denom to a new ERC20 contract and/or freeze conversions of a deployed contract.
+func (s msgServer) UpdateDeployedCosmosCoinContract(
+	goCtx context.Context,
+	msg *types.MsgUpdateDeployedCosmosCoinContract,
+) (*types.MsgUpdateDeployedCosmosCoinContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if s.keeper.GetAuthority().String() != msg.Authority {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority; expected %s, got %s",
+			s.keeper.GetAuthority(),
+			msg.Authority,
+		)
+	}
+
+	var newContractAddress types.InternalEVMAddress
+	if msg.ContractAddress != "" {
+		var err error
+		newContractAddress, err = types.NewInternalEVMAddressFromString(msg.ContractAddress)
+		if err != nil {
+			return nil, fmt.Errorf("invalid contract address: %w", err)
+		}
+	}
+
+	previousAddress, err := s.keeper.UpdateDeployedCosmosCoinContract(ctx, msg.CosmosDenom, newContractAddress, msg.Freeze)
+	if err != nil {
+		return nil, err
+	}
+
+	currentAddress := previousAddress
+	if !newContractAddress.IsNil() {
+		currentAddress = newContractAddress
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateDeployedCosmosCoinContract,
+		sdk.NewAttribute(types.AttributeKeyCosmosDenom, msg.CosmosDenom),
+		sdk.NewAttribute(types.AttributeKeyERC20Address, currentAddress.Hex()),
+		sdk.NewAttribute(types.AttributeKeyPreviousERC20Address, previousAddress.Hex()),
+		sdk.NewAttribute(types.AttributeKeyFrozen, strconv.FormatBool(msg.Freeze)),
+	))
+
+	return &types.MsgUpdateDeployedCosmosCoinContractResponse{}, nil
+}
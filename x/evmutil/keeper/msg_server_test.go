@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"math/big"
 	"testing"
 
@@ -8,6 +9,9 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
@@ -271,6 +275,120 @@ func (suite *MsgServerSuite) TestConvertERC20ToCoin() {
 	}
 }
 
+// fakeTransferKeeper records the IBC transfers it is asked to send
+type fakeTransferKeeper struct {
+	transfers []*ibctransfertypes.MsgTransfer
+	err       error
+}
+
+func (k *fakeTransferKeeper) Transfer(
+	_ context.Context,
+	msg *ibctransfertypes.MsgTransfer,
+) (*ibctransfertypes.MsgTransferResponse, error) {
+	if k.err != nil {
+		return nil, k.err
+	}
+	k.transfers = append(k.transfers, msg)
+	return &ibctransfertypes.MsgTransferResponse{Sequence: uint64(len(k.transfers))}, nil
+}
+
+func (suite *MsgServerSuite) TestConvertERC20AndTransfer() {
+	contractAddr := suite.DeployERC20()
+	pair := types.NewConversionPair(contractAddr, "erc20/usdc")
+
+	invoker := testutil.MustNewInternalEVMAddressFromString("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	invokerCosmosAddr := sdk.AccAddress(invoker.Bytes())
+	pairStartingBal := big.NewInt(10_000_000)
+	err := suite.Keeper.MintERC20(suite.Ctx, pair.GetAddress(), invoker, pairStartingBal)
+	suite.Require().NoError(err)
+
+	// create user account, otherwise `CallEVMWithData` will fail due to failing to get user account when finding its sequence.
+	err = suite.App.FundAccount(suite.Ctx, invokerCosmosAddr, sdk.NewCoins(sdk.NewCoin(pair.Denom, sdk.ZeroInt())))
+	suite.Require().NoError(err)
+
+	newMsg := func(amount sdkmath.Int) types.MsgConvertERC20AndTransfer {
+		return types.NewMsgConvertERC20AndTransfer(
+			invoker, contractAddr, amount,
+			"channel-0", "cosmos1qy352eufqy352eufqy352eufqy35qqqptw34ca",
+			clienttypes.NewHeight(1, 100), 0, "memo",
+		)
+	}
+
+	tests := []struct {
+		name        string
+		msg         types.MsgConvertERC20AndTransfer
+		transferErr error
+		contains    string
+	}{
+		{
+			"valid",
+			newMsg(sdkmath.NewInt(10_000)),
+			nil,
+			"",
+		},
+		{
+			"invalid - insufficient erc20 balance",
+			newMsg(sdkmath.NewIntFromBigInt(pairStartingBal).Add(sdk.OneInt())),
+			nil,
+			"transfer amount exceeds balance",
+		},
+		{
+			"invalid - transfer fails",
+			newMsg(sdkmath.NewInt(10_000)),
+			ibctransfertypes.ErrSendDisabled,
+			ibctransfertypes.ErrSendDisabled.Error(),
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			transferKeeper := &fakeTransferKeeper{err: tc.transferErr}
+			k := suite.App.GetEvmutilKeeper()
+			k.SetTransferKeeper(transferKeeper)
+			msgServer := keeper.NewMsgServerImpl(k)
+
+			ctx, _ := suite.Ctx.CacheContext()
+			res, err := msgServer.ConvertERC20AndTransfer(sdk.WrapSDKContext(ctx), &tc.msg)
+
+			erc20Bal, queryErr := suite.Keeper.QueryERC20BalanceOf(ctx, pair.GetAddress(), invoker)
+			suite.Require().NoError(queryErr)
+
+			if tc.contains != "" {
+				suite.Require().ErrorContains(err, tc.contains)
+				suite.Require().Empty(transferKeeper.transfers)
+
+				// nothing is converted when the transfer cannot be sent
+				suite.Require().Equal(pairStartingBal, erc20Bal, "user erc20 balance is invalid")
+				coinBal := suite.BankKeeper.GetBalance(ctx, invokerCosmosAddr, pair.Denom)
+				suite.Require().True(coinBal.IsZero(), "user coin balance is invalid")
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(1), res.Sequence)
+
+			expectedBal := sdkmath.NewIntFromBigInt(pairStartingBal).Sub(tc.msg.Amount)
+			suite.Require().Equal(expectedBal.BigInt(), erc20Bal, "user erc20 balance is invalid")
+
+			// the converted coin is sent over ibc by the initiator
+			suite.Require().Len(transferKeeper.transfers, 1)
+			suite.Require().Equal(
+				ibctransfertypes.NewMsgTransfer(
+					ibctransfertypes.PortID,
+					tc.msg.SourceChannel,
+					sdk.NewCoin(pair.Denom, tc.msg.Amount),
+					invokerCosmosAddr.String(),
+					tc.msg.Receiver,
+					tc.msg.TimeoutHeight,
+					tc.msg.TimeoutTimestamp,
+					tc.msg.Memo,
+				),
+				transferKeeper.transfers[0],
+			)
+		})
+	}
+}
+
 func (suite *MsgServerSuite) TestConvertCosmosCoinToERC20_InitialContractDeploy() {
 	allowedDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
 	initialFunding := int64(1e10)
@@ -510,6 +628,60 @@ func (suite *MsgServerSuite) TestConvertCosmosCoinToERC20_AlreadyDeployedContrac
 	suite.Equal(amount.MulRaw(2).BigInt(), totalSupply[0].(*big.Int))
 }
 
+func (suite *MsgServerSuite) TestConvertCosmosCoinsToERC20Batch() {
+	atomDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	hardDenom := "hard"
+	fundedAccount := app.RandomAddress()
+	receiver := testutil.RandomInternalEVMAddress()
+
+	suite.SetupTest()
+
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.AllowedCosmosDenoms = types.NewAllowedCosmosCoinERC20Tokens(
+		types.NewAllowedCosmosCoinERC20Token(atomDenom, "Kava EVM Atom", "ATOM", 6),
+		types.NewAllowedCosmosCoinERC20Token(hardDenom, "Kava EVM Hard", "HARD", 6),
+	)
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	err := suite.App.FundAccount(suite.Ctx, fundedAccount, sdk.NewCoins(
+		sdk.NewInt64Coin(atomDenom, 1e10),
+		sdk.NewInt64Coin(hardDenom, 1e10),
+		sdk.NewInt64Coin("unallowed", 1e10),
+	))
+	suite.Require().NoError(err)
+
+	suite.Run("fails entire batch when any denom is not allowed", func() {
+		msg := types.NewMsgConvertCosmosCoinsToERC20Batch(
+			fundedAccount.String(),
+			receiver.Hex(),
+			sdk.NewCoins(sdk.NewInt64Coin(atomDenom, 1e6), sdk.NewInt64Coin("unallowed", 1e6)),
+		)
+		// state changes of a failed tx are discarded, use a cache context to mimic that
+		cacheCtx, _ := suite.Ctx.CacheContext()
+		_, err := suite.msgServer.ConvertCosmosCoinsToERC20Batch(cacheCtx, &msg)
+		suite.ErrorIs(err, types.ErrSDKConversionNotEnabled)
+	})
+
+	suite.Run("converts every coin in the batch", func() {
+		amounts := sdk.NewCoins(sdk.NewInt64Coin(atomDenom, 6e8), sdk.NewInt64Coin(hardDenom, 3e8))
+		msg := types.NewMsgConvertCosmosCoinsToERC20Batch(fundedAccount.String(), receiver.Hex(), amounts)
+		_, err := suite.msgServer.ConvertCosmosCoinsToERC20Batch(suite.Ctx, &msg)
+		suite.Require().NoError(err)
+
+		for _, coin := range amounts {
+			contractAddress, found := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, coin.Denom)
+			suite.Require().True(found)
+
+			bal, err := suite.Keeper.QueryERC20BalanceOf(suite.Ctx, contractAddress, receiver)
+			suite.Require().NoError(err)
+			suite.Equal(coin.Amount.BigInt(), bal)
+		}
+
+		moduleAddr := suite.App.GetAccountKeeper().GetModuleAddress(types.ModuleName)
+		suite.App.CheckBalance(suite.T(), suite.Ctx, moduleAddr, amounts)
+	})
+}
+
 func (suite *MsgServerSuite) TestConvertCosmosCoinFromERC20() {
 	denom := "magic"
 	tokenInfo := types.NewAllowedCosmosCoinERC20Token(denom, "Cosmos Coin", "MAGIC", 6)
@@ -728,3 +900,218 @@ func (suite *MsgServerSuite) TestConvertCosmosCoinForRemovedDenom() {
 		suite.True(sdkBal.IsZero())
 	})
 }
+
+func (suite *MsgServerSuite) TestUpdateDeployedCosmosCoinContract() {
+	denom := "magic"
+	tokenInfo := types.NewAllowedCosmosCoinERC20Token(denom, "MAGIC COIN", "MAGIC", 6)
+	authority := suite.Keeper.GetAuthority().String()
+	account := app.RandomAddress()
+	evmAddr := types.BytesToInternalEVMAddress(account.Bytes())
+	coin := func(amt int64) sdk.Coin { return sdk.NewInt64Coin(denom, amt) }
+
+	var contractAddress types.InternalEVMAddress
+	setup := func() {
+		suite.SetupTest()
+
+		params := suite.Keeper.GetParams(suite.Ctx)
+		params.AllowedCosmosDenoms = append(params.AllowedCosmosDenoms, tokenInfo)
+		suite.Keeper.SetParams(suite.Ctx, params)
+
+		suite.NoError(suite.App.FundAccount(suite.Ctx, account, sdk.NewCoins(coin(1e10))))
+		err := suite.Keeper.ConvertCosmosCoinToERC20(suite.Ctx, account, evmAddr, coin(5e9))
+		suite.Require().NoError(err)
+
+		var found bool
+		contractAddress, found = suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, denom)
+		suite.Require().True(found)
+	}
+
+	suite.Run("rejects non-authority signer", func() {
+		setup()
+		msg := types.NewMsgUpdateDeployedCosmosCoinContract(account.String(), denom, "", true)
+		_, err := suite.msgServer.UpdateDeployedCosmosCoinContract(suite.Ctx, &msg)
+		suite.ErrorContains(err, "invalid authority")
+		suite.False(suite.Keeper.IsCosmosCoinContractFrozen(suite.Ctx, contractAddress))
+	})
+
+	suite.Run("rejects denom without deployed contract", func() {
+		setup()
+		msg := types.NewMsgUpdateDeployedCosmosCoinContract(authority, "unknown", "", true)
+		_, err := suite.msgServer.UpdateDeployedCosmosCoinContract(suite.Ctx, &msg)
+		suite.ErrorIs(err, types.ErrInvalidCosmosDenom)
+	})
+
+	suite.Run("rejects repointing to the current contract", func() {
+		setup()
+		msg := types.NewMsgUpdateDeployedCosmosCoinContract(authority, denom, contractAddress.Hex(), false)
+		_, err := suite.msgServer.UpdateDeployedCosmosCoinContract(suite.Ctx, &msg)
+		suite.ErrorContains(err, "already mapped to contract")
+	})
+
+	suite.Run("freezes and unfreezes conversions of the current contract", func() {
+		setup()
+		msg := types.NewMsgUpdateDeployedCosmosCoinContract(authority, denom, "", true)
+		_, err := suite.msgServer.UpdateDeployedCosmosCoinContract(suite.Ctx, &msg)
+		suite.Require().NoError(err)
+		suite.True(suite.Keeper.IsCosmosCoinContractFrozen(suite.Ctx, contractAddress))
+		suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+			types.EventTypeUpdateDeployedCosmosCoinContract,
+			sdk.NewAttribute(types.AttributeKeyCosmosDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Address, contractAddress.Hex()),
+			sdk.NewAttribute(types.AttributeKeyPreviousERC20Address, contractAddress.Hex()),
+			sdk.NewAttribute(types.AttributeKeyFrozen, "true"),
+		))
+
+		toERC20 := types.NewMsgConvertCosmosCoinToERC20(account.String(), evmAddr.Hex(), coin(1e9))
+		ctx, _ := suite.Ctx.CacheContext()
+		_, err = suite.msgServer.ConvertCosmosCoinToERC20(ctx, &toERC20)
+		suite.ErrorIs(err, types.ErrContractFrozen)
+
+		fromERC20 := types.NewMsgConvertCosmosCoinFromERC20(evmAddr.Hex(), account.String(), coin(1e9))
+		ctx, _ = suite.Ctx.CacheContext()
+		_, err = suite.msgServer.ConvertCosmosCoinFromERC20(ctx, &fromERC20)
+		suite.ErrorIs(err, types.ErrContractFrozen)
+
+		msg = types.NewMsgUpdateDeployedCosmosCoinContract(authority, denom, "", false)
+		_, err = suite.msgServer.UpdateDeployedCosmosCoinContract(suite.Ctx, &msg)
+		suite.Require().NoError(err)
+		suite.False(suite.Keeper.IsCosmosCoinContractFrozen(suite.Ctx, contractAddress))
+
+		_, err = suite.msgServer.ConvertCosmosCoinFromERC20(suite.Ctx, &fromERC20)
+		suite.NoError(err)
+	})
+
+	suite.Run("rejects repointing while previous contract has outstanding supply", func() {
+		setup()
+		newContract, err := suite.Keeper.DeployKavaWrappedCosmosCoinERC20Contract(suite.Ctx, tokenInfo)
+		suite.Require().NoError(err)
+
+		msg := types.NewMsgUpdateDeployedCosmosCoinContract(authority, denom, newContract.Hex(), true)
+		_, err = suite.msgServer.UpdateDeployedCosmosCoinContract(suite.Ctx, &msg)
+		suite.ErrorContains(err, "has outstanding supply")
+
+		registered, found := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, denom)
+		suite.True(found)
+		suite.Equal(contractAddress, registered)
+		suite.False(suite.Keeper.IsCosmosCoinContractFrozen(suite.Ctx, contractAddress))
+	})
+
+	suite.Run("repoints denom to new contract and freezes previous", func() {
+		setup()
+		newContract, err := suite.Keeper.DeployKavaWrappedCosmosCoinERC20Contract(suite.Ctx, tokenInfo)
+		suite.Require().NoError(err)
+
+		// the previous contract's supply is converted back before repointing
+		err = suite.Keeper.ConvertCosmosCoinFromERC20(suite.Ctx, evmAddr, account, coin(5e9))
+		suite.Require().NoError(err)
+
+		msg := types.NewMsgUpdateDeployedCosmosCoinContract(authority, denom, newContract.Hex(), true)
+		_, err = suite.msgServer.UpdateDeployedCosmosCoinContract(suite.Ctx, &msg)
+		suite.Require().NoError(err)
+
+		registered, found := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, denom)
+		suite.True(found)
+		suite.Equal(newContract, registered)
+		suite.True(suite.Keeper.IsCosmosCoinContractFrozen(suite.Ctx, contractAddress))
+		suite.False(suite.Keeper.IsCosmosCoinContractFrozen(suite.Ctx, newContract))
+
+		// conversions now mint on the new contract
+		toERC20 := types.NewMsgConvertCosmosCoinToERC20(account.String(), evmAddr.Hex(), coin(1e9))
+		_, err = suite.msgServer.ConvertCosmosCoinToERC20(suite.Ctx, &toERC20)
+		suite.Require().NoError(err)
+		bal, err := suite.Keeper.QueryERC20BalanceOf(suite.Ctx, newContract, evmAddr)
+		suite.Require().NoError(err)
+		suite.BigIntsEqual(big.NewInt(1e9), bal, "expected conversion to mint on new contract")
+	})
+}
+
+func (suite *MsgServerSuite) TestSyncERC20Metadata() {
+	denom := "magic"
+	tokenInfo := types.NewAllowedCosmosCoinERC20Token(denom, "Old Magic", "OLDMAGIC", 0)
+	bankMetadata := banktypes.Metadata{
+		Base:    denom,
+		Display: "MAGIC",
+		Name:    "Magic Coin",
+		Symbol:  "MAGIC",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "MAGIC", Exponent: 6},
+		},
+	}
+	account := app.RandomAddress()
+	evmAddr := types.BytesToInternalEVMAddress(account.Bytes())
+	coin := func(amt int64) sdk.Coin { return sdk.NewInt64Coin(denom, amt) }
+
+	var contractAddress types.InternalEVMAddress
+	setup := func() {
+		suite.SetupTest()
+
+		params := suite.Keeper.GetParams(suite.Ctx)
+		params.AllowedCosmosDenoms = append(params.AllowedCosmosDenoms, tokenInfo)
+		suite.Keeper.SetParams(suite.Ctx, params)
+
+		// deploy contract with param metadata, then round trip conversion so no supply is outstanding
+		suite.NoError(suite.App.FundAccount(suite.Ctx, account, sdk.NewCoins(coin(1e10))))
+		err := suite.Keeper.ConvertCosmosCoinToERC20(suite.Ctx, account, evmAddr, coin(1e10))
+		suite.Require().NoError(err)
+		err = suite.Keeper.ConvertCosmosCoinFromERC20(suite.Ctx, evmAddr, account, coin(1e10))
+		suite.Require().NoError(err)
+
+		var found bool
+		contractAddress, found = suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, denom)
+		suite.Require().True(found)
+	}
+
+	suite.Run("fails when metadata is already in sync", func() {
+		setup()
+		msg := types.NewMsgSyncERC20Metadata(account.String(), denom)
+		_, err := suite.msgServer.SyncERC20Metadata(suite.Ctx, &msg)
+		suite.ErrorContains(err, "already in sync")
+	})
+
+	suite.Run("fails when denom is not allowed", func() {
+		setup()
+		msg := types.NewMsgSyncERC20Metadata(account.String(), "unknown")
+		_, err := suite.msgServer.SyncERC20Metadata(suite.Ctx, &msg)
+		suite.ErrorIs(err, types.ErrSDKConversionNotEnabled)
+	})
+
+	suite.Run("fails when contract has outstanding supply", func() {
+		setup()
+		suite.BankKeeper.SetDenomMetaData(suite.Ctx, bankMetadata)
+		err := suite.Keeper.ConvertCosmosCoinToERC20(suite.Ctx, account, evmAddr, coin(1))
+		suite.Require().NoError(err)
+
+		msg := types.NewMsgSyncERC20Metadata(account.String(), denom)
+		_, err = suite.msgServer.SyncERC20Metadata(suite.Ctx, &msg)
+		suite.ErrorContains(err, "has outstanding supply")
+	})
+
+	suite.Run("redeploys contract with bank metadata", func() {
+		setup()
+		suite.BankKeeper.SetDenomMetaData(suite.Ctx, bankMetadata)
+
+		msg := types.NewMsgSyncERC20Metadata(account.String(), denom)
+		res, err := suite.msgServer.SyncERC20Metadata(suite.Ctx, &msg)
+		suite.Require().NoError(err)
+
+		newContract, found := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, denom)
+		suite.True(found)
+		suite.NotEqual(contractAddress, newContract)
+		suite.Equal(newContract.Hex(), res.ContractAddress)
+
+		deployed, err := suite.Keeper.QueryERC20Metadata(suite.Ctx, denom, newContract)
+		suite.Require().NoError(err)
+		suite.Equal(types.NewAllowedCosmosCoinERC20Token(denom, "Magic Coin", "MAGIC", 6), deployed)
+
+		suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+			types.EventTypeSyncERC20Metadata,
+			sdk.NewAttribute(types.AttributeKeyCosmosDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Address, newContract.Hex()),
+			sdk.NewAttribute(types.AttributeKeyPreviousERC20Address, contractAddress.Hex()),
+			sdk.NewAttribute(types.AttributeKeyName, "Magic Coin"),
+			sdk.NewAttribute(types.AttributeKeySymbol, "MAGIC"),
+			sdk.NewAttribute(types.AttributeKeyDecimals, "6"),
+		))
+	})
+}
@@ -5,7 +5,10 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/kava-labs/kava/x/evmutil/keeper"
 	"github.com/kava-labs/kava/x/evmutil/testutil"
@@ -53,6 +56,7 @@ func (suite *ParamsTestSuite) TestHistoricParamsQuery() {
 		oldParamStore,
 		suite.App.GetBankKeeper(),
 		suite.App.GetAccountKeeper(),
+		authtypes.NewModuleAddress(govtypes.ModuleName),
 	)
 
 	// prior to making GetParams() use GetParamSetIfExists, this would panic.
@@ -61,6 +65,25 @@ func (suite *ParamsTestSuite) TestHistoricParamsQuery() {
 	})
 }
 
+func (suite *ParamsTestSuite) TestDenomParamsAreGovUpdatable() {
+	subspace, found := suite.App.GetParamsKeeper().GetSubspace(types.ModuleName)
+	suite.Require().True(found)
+
+	suite.Require().NoError(subspace.Update(suite.Ctx, types.KeyEvmDenom, []byte(`"aother"`)))
+	suite.Require().NoError(subspace.Update(suite.Ctx, types.KeyCosmosDenom, []byte(`"uother"`)))
+	suite.Require().NoError(subspace.Update(suite.Ctx, types.KeyConversionMultiplier, []byte(`"1000"`)))
+
+	params := suite.Keeper.GetParams(suite.Ctx)
+	suite.Equal("aother", params.EvmDenom)
+	suite.Equal("uother", params.CosmosDenom)
+	suite.Equal(sdkmath.NewInt(1000), params.ConversionMultiplier)
+
+	// invalid values are rejected
+	suite.Error(subspace.Update(suite.Ctx, types.KeyEvmDenom, []byte(`""`)))
+	suite.Error(subspace.Update(suite.Ctx, types.KeyConversionMultiplier, []byte(`"0"`)))
+	suite.Equal(params, suite.Keeper.GetParams(suite.Ctx))
+}
+
 func (suite *keeperTestSuite) TestGetAllowedTokenMetadata() {
 	suite.SetupTest()
 
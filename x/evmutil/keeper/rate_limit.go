@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+// GetConversionRateLimitUsage returns the usage of the denom's conversion rate
+// limit within the window ending at the current block, and false if the denom
+// is not rate limited.
+func (k Keeper) GetConversionRateLimitUsage(ctx sdk.Context, denom string) (types.ConversionRateLimitUsage, bool) {
+	limit, found := k.GetParams(ctx).ConversionRateLimits.Get(denom)
+	if !found {
+		return types.ConversionRateLimitUsage{}, false
+	}
+	return types.NewConversionRateLimitUsage(limit, k.getConversionRateLimitUsed(ctx, limit)), true
+}
+
+// GetAllConversionRateLimitUsages returns the usage of every conversion rate
+// limit within the window ending at the current block.
+func (k Keeper) GetAllConversionRateLimitUsages(ctx sdk.Context) []types.ConversionRateLimitUsage {
+	limits := k.GetParams(ctx).ConversionRateLimits
+	usages := make([]types.ConversionRateLimitUsage, 0, len(limits))
+	for _, limit := range limits {
+		usages = append(usages, types.NewConversionRateLimitUsage(limit, k.getConversionRateLimitUsed(ctx, limit)))
+	}
+	return usages
+}
+
+// TrackConversion records the conversion of coin at the current block height.
+// It returns ErrConversionRateLimitExceeded if the conversion would take the
+// amount of the denom converted within the window over its rate limit.
+// Conversions of denoms without a rate limit are not tracked.
+func (k Keeper) TrackConversion(ctx sdk.Context, coin sdk.Coin) error {
+	limit, found := k.GetParams(ctx).ConversionRateLimits.Get(coin.Denom)
+	if !found {
+		return nil
+	}
+
+	k.pruneConversionRateLimitUsage(ctx, limit)
+
+	used := k.getConversionRateLimitUsed(ctx, limit)
+	if used.Add(coin.Amount).GT(limit.MaxAmount) {
+		return errorsmod.Wrapf(
+			types.ErrConversionRateLimitExceeded,
+			"%s exceeds remaining %s%s of %d block window",
+			coin, limit.MaxAmount.Sub(used), coin.Denom, limit.WindowBlocks,
+		)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.ConversionRateLimitUsageKey(coin.Denom, uint64(ctx.BlockHeight()))
+	blockUsed := sdkmath.ZeroInt()
+	if bz := store.Get(key); bz != nil {
+		blockUsed = k.unmarshalConversionRateLimitAmount(bz)
+	}
+	bz, err := blockUsed.Add(coin.Amount).Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+
+	return nil
+}
+
+// getConversionRateLimitUsed returns the amount of the limit's denom converted
+// within the window ending at the current block.
+func (k Keeper) getConversionRateLimitUsed(ctx sdk.Context, limit types.ConversionRateLimit) sdkmath.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ConversionRateLimitUsageDenomPrefix(limit.Denom))
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(windowStart(ctx, limit)), nil)
+	defer iterator.Close()
+
+	used := sdkmath.ZeroInt()
+	for ; iterator.Valid(); iterator.Next() {
+		used = used.Add(k.unmarshalConversionRateLimitAmount(iterator.Value()))
+	}
+	return used
+}
+
+// pruneConversionRateLimitUsage deletes the usage of the limit's denom recorded
+// at blocks that have left the window.
+func (k Keeper) pruneConversionRateLimitUsage(ctx sdk.Context, limit types.ConversionRateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ConversionRateLimitUsageDenomPrefix(limit.Denom))
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(windowStart(ctx, limit)))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) unmarshalConversionRateLimitAmount(bz []byte) sdkmath.Int {
+	var amount sdkmath.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+// windowStart returns the first block height within the limit's window ending
+// at the current block.
+func windowStart(ctx sdk.Context, limit types.ConversionRateLimit) uint64 {
+	height := uint64(ctx.BlockHeight())
+	if height < limit.WindowBlocks {
+		return 0
+	}
+	return height - limit.WindowBlocks + 1
+}
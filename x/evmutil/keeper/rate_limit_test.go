@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/evmutil/testutil"
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+type rateLimitTestSuite struct {
+	testutil.Suite
+}
+
+func TestRateLimitTestSuite(t *testing.T) {
+	suite.Run(t, new(rateLimitTestSuite))
+}
+
+func (suite *rateLimitTestSuite) setRateLimits(limits ...types.ConversionRateLimit) {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.ConversionRateLimits = types.NewConversionRateLimits(limits...)
+	suite.Keeper.SetParams(suite.Ctx, params)
+}
+
+func (suite *rateLimitTestSuite) requireUsed(denom string, expected int64) {
+	usage, found := suite.Keeper.GetConversionRateLimitUsage(suite.Ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(expected), usage.UsedAmount)
+}
+
+func (suite *rateLimitTestSuite) TestTrackConversion_NotLimited() {
+	suite.Require().NoError(suite.Keeper.TrackConversion(suite.Ctx, sdk.NewInt64Coin("magic", 1e18)))
+
+	_, found := suite.Keeper.GetConversionRateLimitUsage(suite.Ctx, "magic")
+	suite.False(found)
+	suite.Empty(suite.Keeper.GetAllConversionRateLimitUsages(suite.Ctx))
+}
+
+func (suite *rateLimitTestSuite) TestTrackConversion_RollingWindow() {
+	suite.setRateLimits(types.NewConversionRateLimit("magic", sdkmath.NewInt(100), 3))
+	startHeight := suite.Ctx.BlockHeight()
+
+	suite.Require().NoError(suite.Keeper.TrackConversion(suite.Ctx, sdk.NewInt64Coin("magic", 60)))
+	suite.Require().NoError(suite.Keeper.TrackConversion(suite.Ctx, sdk.NewInt64Coin("magic", 20)))
+	suite.requireUsed("magic", 80)
+
+	// other denoms do not count towards the limit
+	suite.Require().NoError(suite.Keeper.TrackConversion(suite.Ctx, sdk.NewInt64Coin("other", 1000)))
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(startHeight + 1)
+	err := suite.Keeper.TrackConversion(suite.Ctx, sdk.NewInt64Coin("magic", 21))
+	suite.Require().ErrorIs(err, types.ErrConversionRateLimitExceeded)
+	suite.Require().NoError(suite.Keeper.TrackConversion(suite.Ctx, sdk.NewInt64Coin("magic", 20)))
+	suite.requireUsed("magic", 100)
+
+	// the first block is still within the 3 block window
+	suite.Ctx = suite.Ctx.WithBlockHeight(startHeight + 2)
+	err = suite.Keeper.TrackConversion(suite.Ctx, sdk.NewInt64Coin("magic", 1))
+	suite.Require().ErrorIs(err, types.ErrConversionRateLimitExceeded)
+	suite.requireUsed("magic", 100)
+
+	// the first block has left the window
+	suite.Ctx = suite.Ctx.WithBlockHeight(startHeight + 3)
+	suite.requireUsed("magic", 20)
+	suite.Require().NoError(suite.Keeper.TrackConversion(suite.Ctx, sdk.NewInt64Coin("magic", 80)))
+	suite.requireUsed("magic", 100)
+
+	usage, found := suite.Keeper.GetConversionRateLimitUsage(suite.Ctx, "magic")
+	suite.Require().True(found)
+	suite.True(usage.RemainingAmount.IsZero())
+
+	// usage of the first block was pruned
+	store := suite.Ctx.KVStore(suite.App.GetKVStoreKey(types.StoreKey))
+	suite.False(store.Has(types.ConversionRateLimitUsageKey("magic", uint64(startHeight))))
+	suite.True(store.Has(types.ConversionRateLimitUsageKey("magic", uint64(startHeight+1))))
+}
+
+func (suite *rateLimitTestSuite) TestConvertCosmosCoin_RateLimited() {
+	denom := "magic"
+	tokenInfo := types.NewAllowedCosmosCoinERC20Token(denom, "Magic Coin", "MAGIC", 6)
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.AllowedCosmosDenoms = types.NewAllowedCosmosCoinERC20Tokens(tokenInfo)
+	params.ConversionRateLimits = types.NewConversionRateLimits(
+		types.NewConversionRateLimit(denom, sdkmath.NewInt(150), 10),
+	)
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	suite.Require().NoError(suite.App.FundAccount(suite.Ctx, suite.Addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))))
+
+	amount := sdk.NewInt64Coin(denom, 100)
+	err := suite.Keeper.ConvertCosmosCoinToERC20(suite.Ctx, suite.Addrs[0], suite.Key1Addr, amount)
+	suite.Require().NoError(err)
+
+	// conversions in both directions count towards the limit
+	err = suite.Keeper.ConvertCosmosCoinToERC20(suite.Ctx, suite.Addrs[0], suite.Key1Addr, amount)
+	suite.Require().ErrorIs(err, types.ErrConversionRateLimitExceeded)
+	err = suite.Keeper.ConvertCosmosCoinFromERC20(suite.Ctx, suite.Key1Addr, suite.Addrs[1], amount)
+	suite.Require().ErrorIs(err, types.ErrConversionRateLimitExceeded)
+
+	err = suite.Keeper.ConvertCosmosCoinFromERC20(suite.Ctx, suite.Key1Addr, suite.Addrs[1], sdk.NewInt64Coin(denom, 50))
+	suite.Require().NoError(err)
+	suite.requireUsed(denom, 150)
+}
@@ -0,0 +1,26 @@
+package v4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+// MigrateStore performs in-place store migrations for consensus version 4
+// V4 adds the evm_denom, cosmos_denom and conversion_multiplier params, seeded
+// from the values previously hardcoded in the keeper.
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	migrateParamsStore(ctx, paramstore)
+	return nil
+}
+
+// migrateParamsStore ensures the param key table exists and has the denom & conversion multiplier properties
+func migrateParamsStore(ctx sdk.Context, paramstore paramtypes.Subspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+	paramstore.Set(ctx, types.KeyEvmDenom, types.DefaultEvmDenom)
+	paramstore.Set(ctx, types.KeyCosmosDenom, types.DefaultCosmosDenom)
+	paramstore.Set(ctx, types.KeyConversionMultiplier, types.DefaultConversionMultiplier)
+}
@@ -0,0 +1,64 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v4evmutil "github.com/kava-labs/kava/x/evmutil/migrations/v4"
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+func TestStoreMigrationAddsKeyTableIncludingNewParams(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	evmutilKey := sdk.NewKVStoreKey(types.ModuleName)
+	tEvmutilKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(evmutilKey, tEvmutilKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, evmutilKey, tEvmutilKey, types.ModuleName)
+
+	// Check params don't exist before
+	require.False(t, paramstore.Has(ctx, types.KeyEvmDenom))
+	require.False(t, paramstore.Has(ctx, types.KeyCosmosDenom))
+	require.False(t, paramstore.Has(ctx, types.KeyConversionMultiplier))
+
+	// Run migrations.
+	err := v4evmutil.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	require.True(t, paramstore.Has(ctx, types.KeyEvmDenom))
+	require.True(t, paramstore.Has(ctx, types.KeyCosmosDenom))
+	require.True(t, paramstore.Has(ctx, types.KeyConversionMultiplier))
+}
+
+func TestStoreMigrationSeedsParamsFromPreviousConstants(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	evmutilKey := sdk.NewKVStoreKey(types.ModuleName)
+	tEvmutilKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(evmutilKey, tEvmutilKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, evmutilKey, tEvmutilKey, types.ModuleName)
+	paramstore.WithKeyTable(types.ParamKeyTable())
+
+	// expect it to have key table
+	require.True(t, paramstore.HasKeyTable())
+
+	// Run migrations.
+	err := v4evmutil.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params match the previously hardcoded values.
+	var evmDenom, cosmosDenom string
+	var conversionMultiplier sdkmath.Int
+	paramstore.Get(ctx, types.KeyEvmDenom, &evmDenom)
+	paramstore.Get(ctx, types.KeyCosmosDenom, &cosmosDenom)
+	paramstore.Get(ctx, types.KeyConversionMultiplier, &conversionMultiplier)
+	require.Equal(t, "akava", evmDenom)
+	require.Equal(t, "ukava", cosmosDenom)
+	require.Equal(t, sdkmath.NewInt(1_000_000_000_000), conversionMultiplier)
+}
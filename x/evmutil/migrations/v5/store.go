@@ -0,0 +1,26 @@
+package v5
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+// MigrateStore performs in-place store migrations for consensus version 5
+// V5 adds the conversion_rate_limits param, with no denoms rate limited, and the
+// enable_dust_sweep and dust_sweep_threshold params, with the dust sweep disabled.
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	migrateParamsStore(ctx, paramstore)
+	return nil
+}
+
+// migrateParamsStore ensures the param key table exists and has the conversion rate limits & dust sweep properties
+func migrateParamsStore(ctx sdk.Context, paramstore paramtypes.Subspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+	paramstore.Set(ctx, types.KeyConversionRateLimits, types.DefaultConversionRateLimits)
+	paramstore.Set(ctx, types.KeyEnableDustSweep, types.DefaultEnableDustSweep)
+	paramstore.Set(ctx, types.KeyDustSweepThreshold, types.DefaultDustSweepThreshold)
+}
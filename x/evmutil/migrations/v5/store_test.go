@@ -0,0 +1,63 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v5evmutil "github.com/kava-labs/kava/x/evmutil/migrations/v5"
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+func TestStoreMigrationAddsKeyTableIncludingNewParams(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	evmutilKey := sdk.NewKVStoreKey(types.ModuleName)
+	tEvmutilKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(evmutilKey, tEvmutilKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, evmutilKey, tEvmutilKey, types.ModuleName)
+
+	// Check params don't exist before
+	require.False(t, paramstore.Has(ctx, types.KeyConversionRateLimits))
+	require.False(t, paramstore.Has(ctx, types.KeyEnableDustSweep))
+	require.False(t, paramstore.Has(ctx, types.KeyDustSweepThreshold))
+
+	// Run migrations.
+	err := v5evmutil.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	require.True(t, paramstore.Has(ctx, types.KeyConversionRateLimits))
+	require.True(t, paramstore.Has(ctx, types.KeyEnableDustSweep))
+	require.True(t, paramstore.Has(ctx, types.KeyDustSweepThreshold))
+}
+
+func TestStoreMigrationSetsNoRateLimitsAndDisablesDustSweep(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	evmutilKey := sdk.NewKVStoreKey(types.ModuleName)
+	tEvmutilKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(evmutilKey, tEvmutilKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, evmutilKey, tEvmutilKey, types.ModuleName)
+	paramstore.WithKeyTable(types.ParamKeyTable())
+
+	// Run migrations.
+	err := v5evmutil.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	var limits types.ConversionRateLimits
+	paramstore.Get(ctx, types.KeyConversionRateLimits, &limits)
+	require.Empty(t, limits)
+
+	enableDustSweep := true
+	paramstore.Get(ctx, types.KeyEnableDustSweep, &enableDustSweep)
+	require.False(t, enableDustSweep)
+
+	var threshold sdkmath.Int
+	paramstore.Get(ctx, types.KeyDustSweepThreshold, &threshold)
+	require.True(t, threshold.IsZero())
+}
@@ -0,0 +1,58 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding evmutil type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.AccountStoreKeyPrefix):
+			var accountA, accountB types.Account
+			cdc.MustUnmarshal(kvA.Value, &accountA)
+			cdc.MustUnmarshal(kvB.Value, &accountB)
+			return fmt.Sprintf("%v\n%v", accountA, accountB)
+
+		case bytes.Equal(kvA.Key[:1], types.DeployedCosmosCoinContractKeyPrefix):
+			contractA := types.BytesToInternalEVMAddress(kvA.Value)
+			contractB := types.BytesToInternalEVMAddress(kvB.Value)
+			return fmt.Sprintf("%s\n%s", contractA, contractB)
+
+		case bytes.Equal(kvA.Key[:1], types.ConversionRecordKeyPrefix):
+			var recordA, recordB types.ConversionRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		case bytes.Equal(kvA.Key[:1], types.NextConversionRecordIDKey):
+			idA := types.GetConversionRecordIDFromBytes(kvA.Value)
+			idB := types.GetConversionRecordIDFromBytes(kvB.Value)
+			return fmt.Sprintf("%d\n%d", idA, idB)
+
+		case bytes.Equal(kvA.Key[:1], types.FrozenCosmosCoinContractKeyPrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.ConversionRateLimitUsageKeyPrefix):
+			var usedA, usedB sdkmath.Int
+			if err := usedA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := usedB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%s\n%s", usedA, usedB)
+
+		default:
+			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/evmutil/simulation"
+	"github.com/kava-labs/kava/x/evmutil/testutil"
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	addr := sdk.AccAddress("test_address")
+	account := types.Account{Address: addr, Balance: sdkmath.NewInt(100)}
+	contract := testutil.RandomInternalEVMAddress()
+	record := types.NewConversionRecord(
+		1, addr.String(), contract.String(), contract.String(),
+		sdk.NewInt64Coin("ukava", 10), types.CONVERSION_DIRECTION_COIN_TO_ERC20, 5,
+	)
+
+	used, err := sdkmath.NewInt(50).Marshal()
+	require.NoError(t, err)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.AccountStoreKey(addr), Value: cdc.MustMarshal(&account)},
+			{Key: types.DeployedCosmosCoinContractKey("ukava"), Value: contract.Bytes()},
+			{Key: append(types.ConversionRecordKeyPrefix, types.GetConversionRecordIDBytes(1)...), Value: cdc.MustMarshal(&record)},
+			{Key: types.NextConversionRecordIDKey, Value: types.GetConversionRecordIDBytes(2)},
+			{Key: types.FrozenCosmosCoinContractKey(contract), Value: []byte{0x01}},
+			{Key: types.ConversionRateLimitUsageKey("ukava", 5), Value: used},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Account", fmt.Sprintf("%v\n%v", account, account)},
+		{"DeployedCosmosCoinContract", fmt.Sprintf("%s\n%s", contract, contract)},
+		{"ConversionRecord", fmt.Sprintf("%v\n%v", record, record)},
+		{"NextConversionRecordID", "2\n2"},
+		{"FrozenCosmosCoinContract", fmt.Sprintf("%v\n%v", []byte{0x01}, []byte{0x01})},
+		{"ConversionRateLimitUsage", "50\n50"},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+// RandomizedGenState generates a random GenesisState for evmutil.
+//
+// Genesis starts without any fractional balances, as each account's akava must
+// be backed by ukava held in the module account, which the simulated bank
+// genesis does not provide. Balances are instead created by the simulation
+// operations.
+func RandomizedGenState(simState *module.SimulationState) {
+	evmutilGenesis := types.NewGenesisState([]types.Account{}, types.DefaultParams(), types.DeployedCosmosCoinContracts{}, []string{})
+
+	bz, err := json.MarshalIndent(evmutilGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, bz)
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(evmutilGenesis)
+}
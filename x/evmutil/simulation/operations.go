@@ -0,0 +1,204 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/kava-labs/kava/x/evmutil/keeper"
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightEvmMint     = "op_weight_evm_mint"     //nolint:gosec
+	OpWeightEvmBurn     = "op_weight_evm_burn"     //nolint:gosec
+	OpWeightEvmTransfer = "op_weight_evm_transfer" //nolint:gosec
+
+	DefaultWeightEvmMint     = 50
+	DefaultWeightEvmBurn     = 30
+	DefaultWeightEvmTransfer = 100
+
+	// OpEvmMint, OpEvmBurn and OpEvmTransfer name the simulated operations.
+	// They are not msgs, as x/evm moves akava by calling the EvmBankKeeper directly.
+	OpEvmMint     = "evm_mint"
+	OpEvmBurn     = "evm_burn"
+	OpEvmTransfer = "evm_transfer"
+)
+
+// maxSimulatedUkava bounds the whole ukava part of simulated akava amounts.
+var maxSimulatedUkava = sdkmath.NewInt(1_000_000)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, k keeper.Keeper, bk types.BankKeeper, ak types.AccountKeeper,
+) simulation.WeightedOperations {
+	var weightEvmMint, weightEvmBurn, weightEvmTransfer int
+
+	appParams.GetOrGenerate(cdc, OpWeightEvmMint, &weightEvmMint, nil,
+		func(_ *rand.Rand) {
+			weightEvmMint = DefaultWeightEvmMint
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightEvmBurn, &weightEvmBurn, nil,
+		func(_ *rand.Rand) {
+			weightEvmBurn = DefaultWeightEvmBurn
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightEvmTransfer, &weightEvmTransfer, nil,
+		func(_ *rand.Rand) {
+			weightEvmTransfer = DefaultWeightEvmTransfer
+		},
+	)
+
+	evmBankKeeper := keeper.NewEvmBankKeeper(k, bk, ak)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightEvmMint, SimulateEvmMint(k, evmBankKeeper)),
+		simulation.NewWeightedOperation(weightEvmBurn, SimulateEvmBurn(k, evmBankKeeper)),
+		simulation.NewWeightedOperation(weightEvmTransfer, SimulateEvmTransfer(k, evmBankKeeper)),
+	}
+}
+
+// SimulateEvmMint mints a random whole ukava amount of akava to a random
+// account, the same way x/evm credits an account when committing its statedb.
+func SimulateEvmMint(k keeper.Keeper, ebk keeper.EvmBankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		params := k.GetParams(ctx)
+		acc, _ := simtypes.RandomAcc(r, accs)
+
+		// x/evm only mints fractional amounts when they are paired with a burn in the
+		// same statedb commit, as a fractional mint on its own cannot be backed by
+		// ukava. Fractional amounts are covered by the transfer operation instead.
+		amount := simtypes.RandomAmount(r, maxSimulatedUkava).Mul(params.ConversionMultiplier)
+		if amount.IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, OpEvmMint, "zero amount"), nil, nil
+		}
+
+		balanceBefore := ebk.GetBalance(ctx, acc.Address, params.EvmDenom).Amount
+		if err := mintAkava(ctx, ebk, acc.Address, sdk.NewCoins(sdk.NewCoin(params.EvmDenom, amount))); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, OpEvmMint, "unable to mint akava"), nil, err
+		}
+
+		if err := checkBalance(ctx, ebk, params, acc.Address, balanceBefore.Add(amount)); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, OpEvmMint, "unexpected balance"), nil, err
+		}
+
+		return simtypes.NewOperationMsgBasic(types.ModuleName, OpEvmMint, "", true, nil), nil, nil
+	}
+}
+
+// SimulateEvmBurn burns a random part of a random account's akava balance, the
+// same way x/evm debits an account when committing its statedb.
+func SimulateEvmBurn(k keeper.Keeper, ebk keeper.EvmBankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		params := k.GetParams(ctx)
+		acc, _ := simtypes.RandomAcc(r, accs)
+
+		balanceBefore := ebk.GetBalance(ctx, acc.Address, params.EvmDenom).Amount
+		amount := randomAkavaAmount(r, params, balanceBefore)
+		if amount.IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, OpEvmBurn, "zero amount"), nil, nil
+		}
+
+		if err := burnAkava(ctx, ebk, acc.Address, sdk.NewCoins(sdk.NewCoin(params.EvmDenom, amount))); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, OpEvmBurn, "unable to burn akava"), nil, err
+		}
+
+		if err := checkBalance(ctx, ebk, params, acc.Address, balanceBefore.Sub(amount)); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, OpEvmBurn, "unexpected balance"), nil, err
+		}
+
+		return simtypes.NewOperationMsgBasic(types.ModuleName, OpEvmBurn, "", true, nil), nil, nil
+	}
+}
+
+// SimulateEvmTransfer moves a random amount of akava between two random
+// accounts. As when x/evm commits a value transfer, the sender's new balance is
+// set by burning and the recipient's by minting.
+func SimulateEvmTransfer(k keeper.Keeper, ebk keeper.EvmBankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		params := k.GetParams(ctx)
+		sender, _ := simtypes.RandomAcc(r, accs)
+		recipient, _ := simtypes.RandomAcc(r, accs)
+		if sender.Address.Equals(recipient.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, OpEvmTransfer, "sender and recipient are the same"), nil, nil
+		}
+
+		senderBefore := ebk.GetBalance(ctx, sender.Address, params.EvmDenom).Amount
+		recipientBefore := ebk.GetBalance(ctx, recipient.Address, params.EvmDenom).Amount
+
+		amount := randomAkavaAmount(r, params, senderBefore)
+		if amount.IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, OpEvmTransfer, "zero amount"), nil, nil
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(params.EvmDenom, amount))
+
+		if err := burnAkava(ctx, ebk, sender.Address, coins); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, OpEvmTransfer, "unable to debit sender"), nil, err
+		}
+		if err := mintAkava(ctx, ebk, recipient.Address, coins); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, OpEvmTransfer, "unable to credit recipient"), nil, err
+		}
+
+		if err := checkBalance(ctx, ebk, params, sender.Address, senderBefore.Sub(amount)); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, OpEvmTransfer, "unexpected sender balance"), nil, err
+		}
+		if err := checkBalance(ctx, ebk, params, recipient.Address, recipientBefore.Add(amount)); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, OpEvmTransfer, "unexpected recipient balance"), nil, err
+		}
+
+		return simtypes.NewOperationMsgBasic(types.ModuleName, OpEvmTransfer, "", true, nil), nil, nil
+	}
+}
+
+// mintAkava mints coins to the evm module account and sends them to addr.
+func mintAkava(ctx sdk.Context, ebk keeper.EvmBankKeeper, addr sdk.AccAddress, coins sdk.Coins) error {
+	if err := ebk.MintCoins(ctx, evmtypes.ModuleName, coins); err != nil {
+		return err
+	}
+	return ebk.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, addr, coins)
+}
+
+// burnAkava sends coins from addr to the evm module account and burns them.
+func burnAkava(ctx sdk.Context, ebk keeper.EvmBankKeeper, addr sdk.AccAddress, coins sdk.Coins) error {
+	if err := ebk.SendCoinsFromAccountToModule(ctx, addr, evmtypes.ModuleName, coins); err != nil {
+		return err
+	}
+	return ebk.BurnCoins(ctx, evmtypes.ModuleName, coins)
+}
+
+// checkBalance returns an error if the akava balance of addr is not the expected amount.
+func checkBalance(ctx sdk.Context, ebk keeper.EvmBankKeeper, params types.Params, addr sdk.AccAddress, expected sdkmath.Int) error {
+	actual := ebk.GetBalance(ctx, addr, params.EvmDenom).Amount
+	if !actual.Equal(expected) {
+		return fmt.Errorf("expected %s balance of %s to be %s, got %s", params.EvmDenom, addr, expected, actual)
+	}
+	return nil
+}
+
+// randomAkavaAmount returns a random amount in [0, max]. Half of the amounts
+// are taken from below the conversion multiplier, so that fractional-only
+// balances are exercised as often as whole ukava amounts.
+func randomAkavaAmount(r *rand.Rand, params types.Params, max sdkmath.Int) sdkmath.Int {
+	if !max.IsPositive() {
+		return sdkmath.ZeroInt()
+	}
+	if r.Intn(2) == 0 {
+		return simtypes.RandomAmount(r, max)
+	}
+	return simtypes.RandomAmount(r, sdkmath.MinInt(max, params.ConversionMultiplier))
+}
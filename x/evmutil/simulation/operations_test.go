@@ -0,0 +1,67 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/kava-labs/kava/x/evmutil/keeper"
+	"github.com/kava-labs/kava/x/evmutil/simulation"
+	"github.com/kava-labs/kava/x/evmutil/testutil"
+)
+
+type operationsTestSuite struct {
+	testutil.Suite
+}
+
+func TestOperationsTestSuite(t *testing.T) {
+	suite.Run(t, new(operationsTestSuite))
+}
+
+func (suite *operationsTestSuite) TestWeightedOperations() {
+	appParams := make(simtypes.AppParams)
+	ops := simulation.WeightedOperations(
+		appParams, suite.App.AppCodec(), suite.Keeper, suite.BankKeeper, suite.AccountKeeper,
+	)
+
+	expected := []int{
+		simulation.DefaultWeightEvmMint,
+		simulation.DefaultWeightEvmBurn,
+		simulation.DefaultWeightEvmTransfer,
+	}
+	suite.Require().Len(ops, len(expected))
+	for i, op := range ops {
+		suite.Equal(expected[i], op.Weight())
+	}
+}
+
+func (suite *operationsTestSuite) TestOperations() {
+	r := rand.New(rand.NewSource(1))
+	accs := simtypes.RandomAccounts(r, 3)
+
+	ops := []simtypes.Operation{
+		simulation.SimulateEvmMint(suite.Keeper, suite.EvmBankKeeper),
+		simulation.SimulateEvmBurn(suite.Keeper, suite.EvmBankKeeper),
+		simulation.SimulateEvmTransfer(suite.Keeper, suite.EvmBankKeeper),
+	}
+
+	executed := 0
+	for i := 0; i < 300; i++ {
+		op := ops[r.Intn(len(ops))]
+		opMsg, futureOps, err := op(r, suite.App.BaseApp, suite.Ctx, accs, suite.Ctx.ChainID())
+		suite.Require().NoError(err)
+		suite.Require().Len(futureOps, 0)
+		if opMsg.OK {
+			executed++
+		}
+
+		msg, broken := keeper.FullyBackedInvariant(suite.BankKeeper, suite.Keeper)(suite.Ctx)
+		suite.Require().False(broken, msg)
+		msg, broken = keeper.SmallBalancesInvariant(suite.BankKeeper, suite.Keeper)(suite.Ctx)
+		suite.Require().False(broken, msg)
+	}
+	suite.Greater(executed, 0)
+}
@@ -17,6 +17,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgConvertERC20ToCoin{}, "evmutil/MsgConvertERC20ToCoin")
 	legacy.RegisterAminoMsg(cdc, &MsgConvertCosmosCoinToERC20{}, "evmutil/MsgConvertCosmosCoinToERC20")
 	legacy.RegisterAminoMsg(cdc, &MsgConvertCosmosCoinFromERC20{}, "evmutil/MsgConvertCosmosCoinFromERC20")
+	// amino names are limited to 39 characters
+	legacy.RegisterAminoMsg(cdc, &MsgConvertCosmosCoinsToERC20Batch{}, "evmutil/MsgConvertCosmosCoinsBatch")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateDeployedCosmosCoinContract{}, "evmutil/MsgUpdateCosmosCoinContract")
+	legacy.RegisterAminoMsg(cdc, &MsgSyncERC20Metadata{}, "evmutil/MsgSyncERC20Metadata")
+	legacy.RegisterAminoMsg(cdc, &MsgConvertERC20AndTransfer{}, "evmutil/MsgConvertERC20AndTransfer")
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -25,6 +30,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgConvertERC20ToCoin{},
 		&MsgConvertCosmosCoinToERC20{},
 		&MsgConvertCosmosCoinFromERC20{},
+		&MsgConvertCosmosCoinsToERC20Batch{},
+		&MsgUpdateDeployedCosmosCoinContract{},
+		&MsgSyncERC20Metadata{},
+		&MsgConvertERC20AndTransfer{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -8,6 +8,7 @@ import (
 	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -106,6 +107,39 @@ func NewDeployedCosmosCoinContract(denom string, address InternalEVMAddress) Dep
 	}
 }
 
+// Validate returns an error if the DeployedCosmosCoinContract is invalid.
+func (contract DeployedCosmosCoinContract) Validate() error {
+	if err := sdk.ValidateDenom(contract.CosmosDenom); err != nil {
+		return fmt.Errorf("deployed cosmos coin contract denom invalid: %v", err)
+	}
+	if contract.Address == nil || contract.Address.IsNil() {
+		return fmt.Errorf("deployed cosmos coin contract address for %s cannot be zero value", contract.CosmosDenom)
+	}
+	return nil
+}
+
+// DeployedCosmosCoinContracts defines a slice of DeployedCosmosCoinContract.
+type DeployedCosmosCoinContracts []DeployedCosmosCoinContract
+
+// Validate returns an error if any contract is invalid or if a denom has more than one contract.
+func (contracts DeployedCosmosCoinContracts) Validate() error {
+	denoms := map[string]bool{}
+
+	for _, contract := range contracts {
+		if denoms[contract.CosmosDenom] {
+			return fmt.Errorf("found duplicate deployed cosmos coin contract denom %s", contract.CosmosDenom)
+		}
+
+		if err := contract.Validate(); err != nil {
+			return err
+		}
+
+		denoms[contract.CosmosDenom] = true
+	}
+
+	return nil
+}
+
 // NewAllowedCosmosCoinERC20Token returns an AllowedCosmosCoinERC20Token
 func NewAllowedCosmosCoinERC20Token(
 	cosmosDenom, name, symbol string,
@@ -119,13 +153,29 @@ func NewAllowedCosmosCoinERC20Token(
 	}
 }
 
-// Validate validates the fields of a single AllowedCosmosCoinERC20Token
+// Validate validates the fields of a single AllowedCosmosCoinERC20Token.
+// Name and symbol may be empty, in which case they are taken from the x/bank
+// denom metadata of the cosmos denom when the contract is deployed.
 func (token AllowedCosmosCoinERC20Token) Validate() error {
-	// disallow empty string fields
 	if err := sdk.ValidateDenom(token.CosmosDenom); err != nil {
 		return fmt.Errorf("allowed cosmos coin erc20 token's sdk denom is invalid: %v", err)
 	}
 
+	// ensure decimals will properly cast to uint8 of erc20 spec
+	if token.Decimals > math.MaxUint8 {
+		return fmt.Errorf("allowed cosmos coin erc20 token's decimals must be less than 256, found %d", token.Decimals)
+	}
+
+	return nil
+}
+
+// ValidateMetadata validates that the token has all the metadata required to deploy its ERC20 contract.
+func (token AllowedCosmosCoinERC20Token) ValidateMetadata() error {
+	if err := token.Validate(); err != nil {
+		return err
+	}
+
+	// disallow empty string fields
 	if token.Name == "" {
 		return errors.New("allowed cosmos coin erc20 token's name cannot be empty")
 	}
@@ -134,14 +184,28 @@ func (token AllowedCosmosCoinERC20Token) Validate() error {
 		return errors.New("allowed cosmos coin erc20 token's symbol cannot be empty")
 	}
 
-	// ensure decimals will properly cast to uint8 of erc20 spec
-	if token.Decimals > math.MaxUint8 {
-		return fmt.Errorf("allowed cosmos coin erc20 token's decimals must be less than 256, found %d", token.Decimals)
-	}
-
 	return nil
 }
 
+// WithBankMetadata returns the token with its name, symbol, and decimals taken from the
+// x/bank denom metadata. Fields missing from the bank metadata are left unchanged.
+// Decimals are the exponent of the metadata's display denom unit.
+func (token AllowedCosmosCoinERC20Token) WithBankMetadata(metadata banktypes.Metadata) AllowedCosmosCoinERC20Token {
+	if metadata.Name != "" {
+		token.Name = metadata.Name
+	}
+	if metadata.Symbol != "" {
+		token.Symbol = metadata.Symbol
+	}
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			token.Decimals = unit.Exponent
+			break
+		}
+	}
+	return token
+}
+
 // AllowedCosmosCoinERC20Tokens defines a slice of AllowedCosmosCoinERC20Token
 type AllowedCosmosCoinERC20Tokens []AllowedCosmosCoinERC20Token
 
@@ -114,9 +114,56 @@ func (m *AllowedCosmosCoinERC20Token) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AllowedCosmosCoinERC20Token proto.InternalMessageInfo
 
+// DeployedCosmosCoinContract defines a deployed token contract to the evm representing a native cosmos-sdk coin
+type DeployedCosmosCoinContract struct {
+	CosmosDenom string              `protobuf:"bytes,1,opt,name=cosmos_denom,json=cosmosDenom,proto3" json:"cosmos_denom,omitempty"`
+	Address     *InternalEVMAddress `protobuf:"bytes,2,opt,name=address,proto3,customtype=InternalEVMAddress" json:"address,omitempty"`
+}
+
+func (m *DeployedCosmosCoinContract) Reset()         { *m = DeployedCosmosCoinContract{} }
+func (m *DeployedCosmosCoinContract) String() string { return proto.CompactTextString(m) }
+func (*DeployedCosmosCoinContract) ProtoMessage()    {}
+func (*DeployedCosmosCoinContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1396d08199817d0, []int{2}
+}
+func (m *DeployedCosmosCoinContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeployedCosmosCoinContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeployedCosmosCoinContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeployedCosmosCoinContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeployedCosmosCoinContract.Merge(m, src)
+}
+func (m *DeployedCosmosCoinContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeployedCosmosCoinContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeployedCosmosCoinContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeployedCosmosCoinContract proto.InternalMessageInfo
+
+func (m *DeployedCosmosCoinContract) GetCosmosDenom() string {
+	if m != nil {
+		return m.CosmosDenom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ConversionPair)(nil), "kava.evmutil.v1beta1.ConversionPair")
 	proto.RegisterType((*AllowedCosmosCoinERC20Token)(nil), "kava.evmutil.v1beta1.AllowedCosmosCoinERC20Token")
+	proto.RegisterType((*DeployedCosmosCoinContract)(nil), "kava.evmutil.v1beta1.DeployedCosmosCoinContract")
 }
 
 func init() {
@@ -124,30 +171,33 @@ func init() {
 }
 
 var fileDescriptor_e1396d08199817d0 = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x91, 0xcf, 0x4a, 0xeb, 0x40,
-	0x18, 0xc5, 0x33, 0xf7, 0xf6, 0x96, 0xde, 0xb1, 0x4a, 0x19, 0x8a, 0x84, 0x0a, 0xd3, 0xd8, 0x55,
-	0x15, 0x4c, 0xda, 0xba, 0x73, 0xd7, 0xc6, 0x82, 0x50, 0x10, 0x09, 0xae, 0xdc, 0x84, 0x49, 0x32,
-	0xd4, 0xd0, 0x24, 0x5f, 0xc9, 0xa4, 0xb1, 0x05, 0x1f, 0xc0, 0x95, 0xf8, 0x08, 0x2e, 0x7d, 0x14,
-	0x97, 0x5d, 0xba, 0x2a, 0x35, 0x7d, 0x0b, 0x57, 0x92, 0x49, 0xe8, 0xee, 0x3b, 0xe7, 0x3b, 0xe7,
-	0xc7, 0xfc, 0xc1, 0xe7, 0x33, 0x96, 0x32, 0x83, 0xa7, 0xe1, 0x22, 0xf1, 0x03, 0x23, 0xed, 0x3b,
-	0x3c, 0x61, 0x7d, 0xc3, 0x85, 0x28, 0xe5, 0xb1, 0xf0, 0x21, 0xb2, 0xe7, 0xcc, 0x8f, 0xf5, 0x79,
-	0x0c, 0x09, 0x90, 0x66, 0x9e, 0xd5, 0xcb, 0xac, 0x5e, 0x66, 0x5b, 0xcd, 0x29, 0x4c, 0x41, 0x06,
-	0x8c, 0x7c, 0x2a, 0xb2, 0x9d, 0x67, 0x7c, 0x64, 0xee, 0x21, 0x77, 0xcc, 0x8f, 0xc9, 0x2d, 0x26,
-	0x79, 0xdf, 0xe6, 0xb1, 0x3b, 0xe8, 0xd9, 0xcc, 0xf3, 0x62, 0x2e, 0x84, 0x8a, 0x34, 0xd4, 0xad,
-	0x8f, 0xb4, 0x6c, 0xd3, 0x6e, 0x4c, 0x58, 0xca, 0xc6, 0x96, 0x39, 0xe8, 0x0d, 0x8b, 0xdd, 0xcf,
-	0xa6, 0x5d, 0xbb, 0xe1, 0xcb, 0xd1, 0x2a, 0xe1, 0xc2, 0x6a, 0xe4, 0xdd, 0x71, 0x5e, 0x2d, 0xb7,
-	0xa4, 0x89, 0xff, 0x79, 0x3c, 0x82, 0x50, 0xfd, 0xa3, 0xa1, 0xee, 0x7f, 0xab, 0x10, 0x57, 0x95,
-	0x97, 0xf7, 0xb6, 0xd2, 0x79, 0x45, 0xf8, 0x64, 0x18, 0x04, 0xf0, 0xc4, 0x3d, 0x13, 0x44, 0x08,
-	0xc2, 0x04, 0x3f, 0x92, 0xec, 0x7b, 0x98, 0xf1, 0x88, 0x9c, 0xe2, 0xba, 0x2b, 0x7d, 0xbb, 0x40,
-	0x20, 0x89, 0x38, 0x28, 0xbc, 0xeb, 0xdc, 0x22, 0x04, 0x57, 0x22, 0x16, 0xf2, 0x92, 0x2e, 0x67,
-	0x72, 0x8c, 0xab, 0x62, 0x15, 0x3a, 0x10, 0xa8, 0x7f, 0xa5, 0x5b, 0x2a, 0xd2, 0xc2, 0x35, 0x8f,
-	0xbb, 0x7e, 0xc8, 0x02, 0xa1, 0x56, 0x34, 0xd4, 0x3d, 0xb4, 0xf6, 0xba, 0x38, 0xd0, 0x68, 0xb2,
-	0xfd, 0xa6, 0xe8, 0x23, 0xa3, 0xe8, 0x33, 0xa3, 0x68, 0x9d, 0x51, 0xb4, 0xcd, 0x28, 0x7a, 0xdb,
-	0x51, 0x65, 0xbd, 0xa3, 0xca, 0xd7, 0x8e, 0x2a, 0x0f, 0x67, 0x53, 0x3f, 0x79, 0x5c, 0x38, 0xba,
-	0x0b, 0xa1, 0x91, 0xdf, 0xf5, 0x22, 0x60, 0x8e, 0x90, 0x93, 0xb1, 0xdc, 0xff, 0x4f, 0xb2, 0x9a,
-	0x73, 0xe1, 0x54, 0xe5, 0x13, 0x5f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x95, 0x40, 0xc5, 0x63,
-	0xbc, 0x01, 0x00, 0x00,
+	// 403 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x77, 0x74, 0xad, 0x75, 0xac, 0x52, 0x86, 0xa5, 0x2c, 0x2b, 0x4c, 0x62, 0x4f, 0xab,
+	0x60, 0xb2, 0xad, 0x37, 0x6f, 0x4d, 0xba, 0xa0, 0x14, 0x45, 0x82, 0x78, 0xf0, 0x12, 0x26, 0xc9,
+	0xb0, 0x86, 0x4e, 0xe6, 0xc5, 0x99, 0x69, 0x6c, 0xc0, 0x0f, 0xe0, 0x49, 0xfc, 0x08, 0x1e, 0xfd,
+	0x28, 0x1e, 0x7b, 0x14, 0x0f, 0x4b, 0xcd, 0x7e, 0x0b, 0x4f, 0x92, 0x49, 0x36, 0x78, 0xf4, 0xf6,
+	0xde, 0xff, 0xfd, 0xde, 0x9f, 0x3f, 0x6f, 0x06, 0x3f, 0x3e, 0x67, 0x15, 0xf3, 0x79, 0x55, 0x5c,
+	0x98, 0x5c, 0xf8, 0xd5, 0x51, 0xc2, 0x0d, 0x3b, 0xf2, 0x53, 0x90, 0x15, 0x57, 0x3a, 0x07, 0x19,
+	0x97, 0x2c, 0x57, 0x5e, 0xa9, 0xc0, 0x00, 0x99, 0xb4, 0xac, 0xd7, 0xb3, 0x5e, 0xcf, 0xce, 0x26,
+	0x2b, 0x58, 0x81, 0x05, 0xfc, 0xb6, 0xea, 0xd8, 0xc3, 0x4f, 0xf8, 0x7e, 0x38, 0x98, 0xbc, 0x66,
+	0xb9, 0x22, 0xaf, 0x30, 0x69, 0xf7, 0x63, 0xae, 0xd2, 0xe3, 0x45, 0xcc, 0xb2, 0x4c, 0x71, 0xad,
+	0xa7, 0xc8, 0x45, 0xf3, 0xbd, 0xc0, 0x6d, 0xd6, 0xce, 0xfe, 0x19, 0xab, 0xd8, 0x32, 0x0a, 0x8f,
+	0x17, 0x27, 0xdd, 0xec, 0xcf, 0xda, 0xd9, 0x7d, 0xce, 0x2f, 0x83, 0xda, 0x70, 0x1d, 0xed, 0xb7,
+	0xbb, 0x4b, 0x95, 0x0e, 0x53, 0x32, 0xc1, 0xb7, 0x32, 0x2e, 0xa1, 0x98, 0xde, 0x70, 0xd1, 0xfc,
+	0x4e, 0xd4, 0x35, 0xcf, 0xc6, 0x9f, 0xbf, 0x39, 0xa3, 0xc3, 0x2f, 0x08, 0x3f, 0x38, 0x11, 0x02,
+	0x3e, 0xf2, 0x2c, 0x04, 0x5d, 0x80, 0x0e, 0x21, 0x97, 0xd6, 0xfb, 0x0d, 0x9c, 0x73, 0x49, 0x1e,
+	0xe2, 0xbd, 0xd4, 0xea, 0x71, 0x67, 0x81, 0xac, 0xc5, 0xdd, 0x4e, 0x3b, 0x6d, 0x25, 0x42, 0xf0,
+	0x58, 0xb2, 0x82, 0xf7, 0xee, 0xb6, 0x26, 0x07, 0x78, 0x47, 0xd7, 0x45, 0x02, 0x62, 0x7a, 0xd3,
+	0xaa, 0x7d, 0x47, 0x66, 0x78, 0x37, 0xe3, 0x69, 0x5e, 0x30, 0xa1, 0xa7, 0x63, 0x17, 0xcd, 0xef,
+	0x45, 0x43, 0xdf, 0x07, 0xfa, 0x80, 0x67, 0xa7, 0xbc, 0x14, 0x50, 0xff, 0x1b, 0x28, 0x04, 0x69,
+	0x14, 0x4b, 0xcd, 0xff, 0xc4, 0x59, 0xe0, 0xdb, 0xdb, 0x93, 0xd9, 0x44, 0xc1, 0xc1, 0xaf, 0xb5,
+	0x43, 0x5e, 0x48, 0xc3, 0x95, 0x64, 0x62, 0xf9, 0xf6, 0x65, 0x7f, 0x96, 0x68, 0x8b, 0x05, 0x67,
+	0xd7, 0xbf, 0x29, 0xfa, 0xde, 0x50, 0xf4, 0xa3, 0xa1, 0xe8, 0xaa, 0xa1, 0xe8, 0xba, 0xa1, 0xe8,
+	0xeb, 0x86, 0x8e, 0xae, 0x36, 0x74, 0xf4, 0x73, 0x43, 0x47, 0xef, 0x1e, 0xad, 0x72, 0xf3, 0xfe,
+	0x22, 0xf1, 0x52, 0x28, 0xfc, 0xf6, 0xbc, 0x4f, 0x04, 0x4b, 0xb4, 0xad, 0xfc, 0xcb, 0xe1, 0x4b,
+	0x98, 0xba, 0xe4, 0x3a, 0xd9, 0xb1, 0xaf, 0xfa, 0xf4, 0xef, 0x00, 0x69, 0x82, 0xe7, 0xc8, 0x2f,
+	0x02, 0x00, 0x00,
 }
 
 func (this *ConversionPair) VerboseEqual(that interface{}) error {
@@ -282,6 +332,74 @@ func (this *AllowedCosmosCoinERC20Token) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DeployedCosmosCoinContract) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*DeployedCosmosCoinContract)
+	if !ok {
+		that2, ok := that.(DeployedCosmosCoinContract)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *DeployedCosmosCoinContract")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *DeployedCosmosCoinContract but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *DeployedCosmosCoinContract but is not nil && this == nil")
+	}
+	if this.CosmosDenom != that1.CosmosDenom {
+		return fmt.Errorf("CosmosDenom this(%v) Not Equal that(%v)", this.CosmosDenom, that1.CosmosDenom)
+	}
+	if that1.Address == nil {
+		if this.Address != nil {
+			return fmt.Errorf("this.Address != nil && that1.Address == nil")
+		}
+	} else if !this.Address.Equal(*that1.Address) {
+		return fmt.Errorf("Address this(%v) Not Equal that(%v)", this.Address, that1.Address)
+	}
+	return nil
+}
+func (this *DeployedCosmosCoinContract) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeployedCosmosCoinContract)
+	if !ok {
+		that2, ok := that.(DeployedCosmosCoinContract)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CosmosDenom != that1.CosmosDenom {
+		return false
+	}
+	if that1.Address == nil {
+		if this.Address != nil {
+			return false
+		}
+	} else if !this.Address.Equal(*that1.Address) {
+		return false
+	}
+	return true
+}
 func (m *ConversionPair) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -368,6 +486,48 @@ func (m *AllowedCosmosCoinERC20Token) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *DeployedCosmosCoinContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeployedCosmosCoinContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeployedCosmosCoinContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Address != nil {
+		{
+			size := m.Address.Size()
+			i -= size
+			if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConversionPair(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CosmosDenom) > 0 {
+		i -= len(m.CosmosDenom)
+		copy(dAtA[i:], m.CosmosDenom)
+		i = encodeVarintConversionPair(dAtA, i, uint64(len(m.CosmosDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintConversionPair(dAtA []byte, offset int, v uint64) int {
 	offset -= sovConversionPair(v)
 	base := offset
@@ -420,6 +580,23 @@ func (m *AllowedCosmosCoinERC20Token) Size() (n int) {
 	return n
 }
 
+func (m *DeployedCosmosCoinContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosDenom)
+	if l > 0 {
+		n += 1 + l + sovConversionPair(uint64(l))
+	}
+	if m.Address != nil {
+		l = m.Address.Size()
+		n += 1 + l + sovConversionPair(uint64(l))
+	}
+	return n
+}
+
 func sovConversionPair(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -707,6 +884,124 @@ func (m *AllowedCosmosCoinERC20Token) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DeployedCosmosCoinContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConversionPair
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeployedCosmosCoinContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeployedCosmosCoinContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConversionPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConversionPair
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConversionPair
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConversionPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConversionPair
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConversionPair
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v InternalEVMAddress
+			m.Address = &v
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConversionPair(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConversionPair
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipConversionPair(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
 	"github.com/kava-labs/kava/x/evmutil/testutil"
 	"github.com/kava-labs/kava/x/evmutil/types"
 	"github.com/stretchr/testify/require"
@@ -259,24 +261,14 @@ func TestAllowedCosmosCoinERC20Token_Validate(t *testing.T) {
 			expErr: "sdk denom is invalid",
 		},
 		{
-			name: "invalid - Empty Name",
+			name: "valid - Empty Name & Symbol, taken from bank metadata",
 			token: types.AllowedCosmosCoinERC20Token{
 				CosmosDenom: "example_denom",
 				Name:        "",
-				Symbol:      "ETK",
-				Decimals:    6,
-			},
-			expErr: "name cannot be empty",
-		},
-		{
-			name: "invalid - Empty Symbol",
-			token: types.AllowedCosmosCoinERC20Token{
-				CosmosDenom: "example_denom",
-				Name:        "Example Token",
 				Symbol:      "",
 				Decimals:    6,
 			},
-			expErr: "symbol cannot be empty",
+			expErr: "",
 		},
 		{
 			name:   "invalid - decimals higher than uint8",
@@ -297,6 +289,69 @@ func TestAllowedCosmosCoinERC20Token_Validate(t *testing.T) {
 	}
 }
 
+func TestAllowedCosmosCoinERC20Token_ValidateMetadata(t *testing.T) {
+	testCases := []struct {
+		name   string
+		token  types.AllowedCosmosCoinERC20Token
+		expErr string
+	}{
+		{
+			name:   "valid",
+			token:  types.NewAllowedCosmosCoinERC20Token("uatom", "Kava-wrapped ATOM", "kATOM", 6),
+			expErr: "",
+		},
+		{
+			name:   "invalid - Empty Name",
+			token:  types.NewAllowedCosmosCoinERC20Token("example_denom", "", "ETK", 6),
+			expErr: "name cannot be empty",
+		},
+		{
+			name:   "invalid - Empty Symbol",
+			token:  types.NewAllowedCosmosCoinERC20Token("example_denom", "Example Token", "", 6),
+			expErr: "symbol cannot be empty",
+		},
+		{
+			name:   "invalid - invalid token",
+			token:  types.NewAllowedCosmosCoinERC20Token("", "Example Token", "ETK", 6),
+			expErr: "sdk denom is invalid",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.token.ValidateMetadata()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr, "Expected validation error")
+			} else {
+				require.NoError(t, err, "Expected no validation error")
+			}
+		})
+	}
+}
+
+func TestAllowedCosmosCoinERC20Token_WithBankMetadata(t *testing.T) {
+	token := types.NewAllowedCosmosCoinERC20Token("uatom", "Param ATOM", "pATOM", 0)
+
+	t.Run("overrides metadata from bank", func(t *testing.T) {
+		synced := token.WithBankMetadata(banktypes.Metadata{
+			Base:    "uatom",
+			Display: "atom",
+			Name:    "Cosmos Hub Atom",
+			Symbol:  "ATOM",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: "uatom", Exponent: 0},
+				{Denom: "atom", Exponent: 6},
+			},
+		})
+		require.Equal(t, types.NewAllowedCosmosCoinERC20Token("uatom", "Cosmos Hub Atom", "ATOM", 6), synced)
+	})
+
+	t.Run("keeps fields missing from bank metadata", func(t *testing.T) {
+		synced := token.WithBankMetadata(banktypes.Metadata{Base: "uatom", Symbol: "ATOM"})
+		require.Equal(t, types.NewAllowedCosmosCoinERC20Token("uatom", "Param ATOM", "ATOM", 0), synced)
+	})
+}
+
 func TestAllowedCosmosCoinERC20Tokens_Validate(t *testing.T) {
 	token1 := types.NewAllowedCosmosCoinERC20Token("denom1", "Token 1", "TK1", 6)
 	token2 := types.NewAllowedCosmosCoinERC20Token("denom2", "Token 2", "TK2", 0)
@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxConversionRecords is the number of most recent conversion records kept in
+// the store. Older records are pruned as new ones are added.
+const MaxConversionRecords uint64 = 10_000
+
+// NewConversionRecord returns a new ConversionRecord
+func NewConversionRecord(
+	id uint64,
+	initiator string,
+	receiver string,
+	erc20Address string,
+	amount sdk.Coin,
+	direction ConversionDirection,
+	height int64,
+) ConversionRecord {
+	return ConversionRecord{
+		ID:           id,
+		Initiator:    initiator,
+		Receiver:     receiver,
+		ERC20Address: erc20Address,
+		Amount:       amount,
+		Direction:    direction,
+		Height:       height,
+	}
+}
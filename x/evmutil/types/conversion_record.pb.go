@@ -0,0 +1,679 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/evmutil/v1beta1/conversion_record.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ConversionDirection is the direction of a conversion between sdk.Coin and ERC20
+type ConversionDirection int32
+
+const (
+	// CONVERSION_DIRECTION_UNSPECIFIED represents an unspecified direction
+	CONVERSION_DIRECTION_UNSPECIFIED ConversionDirection = 0
+	// CONVERSION_DIRECTION_COIN_TO_ERC20 represents a conversion from sdk.Coin to ERC20
+	CONVERSION_DIRECTION_COIN_TO_ERC20 ConversionDirection = 1
+	// CONVERSION_DIRECTION_ERC20_TO_COIN represents a conversion from ERC20 to sdk.Coin
+	CONVERSION_DIRECTION_ERC20_TO_COIN ConversionDirection = 2
+)
+
+var ConversionDirection_name = map[int32]string{
+	0: "CONVERSION_DIRECTION_UNSPECIFIED",
+	1: "CONVERSION_DIRECTION_COIN_TO_ERC20",
+	2: "CONVERSION_DIRECTION_ERC20_TO_COIN",
+}
+
+var ConversionDirection_value = map[string]int32{
+	"CONVERSION_DIRECTION_UNSPECIFIED":   0,
+	"CONVERSION_DIRECTION_COIN_TO_ERC20": 1,
+	"CONVERSION_DIRECTION_ERC20_TO_COIN": 2,
+}
+
+func (x ConversionDirection) String() string {
+	return proto.EnumName(ConversionDirection_name, int32(x))
+}
+
+func (ConversionDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1fe4e9225a4c4444, []int{0}
+}
+
+// ConversionRecord is an entry in the audit log of conversions between sdk.Coin and ERC20
+type ConversionRecord struct {
+	// id is the sequential identifier of the record
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// initiator is the bech32 or 0x hex address that initiated the conversion
+	Initiator string `protobuf:"bytes,2,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	// receiver is the bech32 or 0x hex address that received the converted funds
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// erc20_address is the 0x hex address of the ERC20 contract
+	ERC20Address string `protobuf:"bytes,4,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// amount is the converted amount, expressed as an sdk.Coin
+	Amount types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+	// direction is the direction of the conversion
+	Direction ConversionDirection `protobuf:"varint,6,opt,name=direction,proto3,enum=kava.evmutil.v1beta1.ConversionDirection" json:"direction,omitempty"`
+	// height is the block height the conversion was made at
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ConversionRecord) Reset()         { *m = ConversionRecord{} }
+func (m *ConversionRecord) String() string { return proto.CompactTextString(m) }
+func (*ConversionRecord) ProtoMessage()    {}
+func (*ConversionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fe4e9225a4c4444, []int{0}
+}
+func (m *ConversionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionRecord.Merge(m, src)
+}
+func (m *ConversionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionRecord proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("kava.evmutil.v1beta1.ConversionDirection", ConversionDirection_name, ConversionDirection_value)
+	proto.RegisterType((*ConversionRecord)(nil), "kava.evmutil.v1beta1.ConversionRecord")
+}
+
+func init() {
+	proto.RegisterFile("kava/evmutil/v1beta1/conversion_record.proto", fileDescriptor_1fe4e9225a4c4444)
+}
+
+var fileDescriptor_1fe4e9225a4c4444 = []byte{
+	// 462 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x6e, 0xd3, 0x40,
+	0x14, 0xc6, 0x3d, 0xae, 0x31, 0x74, 0x28, 0xc8, 0x1a, 0xaa, 0xca, 0x44, 0x68, 0x62, 0x55, 0x08,
+	0xb9, 0x08, 0xc6, 0x6d, 0x10, 0x42, 0x62, 0x47, 0x1c, 0x83, 0x2c, 0xa4, 0x04, 0x4d, 0x0b, 0x0b,
+	0x36, 0x96, 0xff, 0x8c, 0x92, 0x11, 0x8d, 0xa7, 0x1a, 0x4f, 0x2c, 0xb8, 0x41, 0x97, 0x70, 0x03,
+	0x24, 0x36, 0xdc, 0x81, 0x0b, 0x74, 0xd9, 0x25, 0xab, 0xa8, 0x38, 0x17, 0x41, 0x76, 0xdc, 0x84,
+	0x45, 0xd8, 0x7d, 0xef, 0xcd, 0xef, 0x9b, 0xa7, 0xef, 0xe9, 0xc1, 0x27, 0x9f, 0xe2, 0x32, 0xf6,
+	0x58, 0x39, 0x9d, 0x29, 0x7e, 0xea, 0x95, 0x47, 0x09, 0x53, 0xf1, 0x91, 0x97, 0x8a, 0xbc, 0x64,
+	0xb2, 0xe0, 0x22, 0x8f, 0x24, 0x4b, 0x85, 0xcc, 0xc8, 0x99, 0x14, 0x4a, 0xa0, 0xdd, 0x9a, 0x26,
+	0x2d, 0x4d, 0x5a, 0xba, 0x83, 0x53, 0x51, 0x4c, 0x45, 0xe1, 0x25, 0x71, 0xc1, 0xfe, 0xf9, 0x82,
+	0xe7, 0x4b, 0x57, 0x67, 0x77, 0x2c, 0xc6, 0xa2, 0x91, 0x5e, 0xad, 0x96, 0xdd, 0xfd, 0x5f, 0x3a,
+	0xb4, 0xfc, 0xd5, 0x1c, 0xda, 0x8c, 0x41, 0x7b, 0x50, 0xe7, 0x99, 0x0d, 0x1c, 0xe0, 0x1a, 0x7d,
+	0xb3, 0x9a, 0x77, 0xf5, 0x70, 0x40, 0x75, 0x9e, 0xa1, 0x07, 0x70, 0x9b, 0xe7, 0x5c, 0xf1, 0x58,
+	0x09, 0x69, 0xeb, 0x0e, 0x70, 0xb7, 0xe9, 0xba, 0x81, 0x3a, 0xf0, 0x96, 0x64, 0x29, 0xe3, 0x25,
+	0x93, 0xf6, 0x56, 0xf3, 0xb8, 0xaa, 0xd1, 0x73, 0x78, 0x87, 0xc9, 0xb4, 0x77, 0x18, 0xc5, 0x59,
+	0x26, 0x59, 0x51, 0xd8, 0x46, 0x0d, 0xf4, 0xad, 0x6a, 0xde, 0xdd, 0x09, 0xa8, 0xdf, 0x3b, 0x7c,
+	0xb5, 0xec, 0xd3, 0x9d, 0x06, 0x6b, 0x2b, 0xf4, 0x02, 0x9a, 0xf1, 0x54, 0xcc, 0x72, 0x65, 0xdf,
+	0x70, 0x80, 0x7b, 0xbb, 0x77, 0x9f, 0x2c, 0x43, 0x92, 0x3a, 0xe4, 0x75, 0x72, 0xe2, 0x0b, 0x9e,
+	0xf7, 0x8d, 0x8b, 0x79, 0x57, 0xa3, 0x2d, 0x8e, 0xde, 0xc0, 0xed, 0x8c, 0x4b, 0x96, 0x2a, 0x2e,
+	0x72, 0xdb, 0x74, 0x80, 0x7b, 0xb7, 0x77, 0x40, 0x36, 0xad, 0x8d, 0xac, 0xc3, 0x0f, 0xae, 0x0d,
+	0x74, 0xed, 0x45, 0x7b, 0xd0, 0x9c, 0x30, 0x3e, 0x9e, 0x28, 0xfb, 0xa6, 0x03, 0xdc, 0x2d, 0xda,
+	0x56, 0x2f, 0x8d, 0xf3, 0xef, 0x5d, 0xed, 0xf1, 0x37, 0x00, 0xef, 0x6d, 0xf8, 0x00, 0x3d, 0x84,
+	0x8e, 0x3f, 0x1a, 0x7e, 0x08, 0xe8, 0x71, 0x38, 0x1a, 0x46, 0x83, 0x90, 0x06, 0xfe, 0x49, 0xad,
+	0xde, 0x0f, 0x8f, 0xdf, 0x05, 0x7e, 0xf8, 0x3a, 0x0c, 0x06, 0x96, 0x86, 0x1e, 0xc1, 0xfd, 0x8d,
+	0x94, 0x3f, 0x0a, 0x87, 0xd1, 0xc9, 0x28, 0x6a, 0x16, 0x63, 0x81, 0xff, 0x72, 0xcd, 0x7b, 0x0d,
+	0xd6, 0x06, 0x4b, 0xef, 0x18, 0xe7, 0x3f, 0xb0, 0xd6, 0x7f, 0x7b, 0xf5, 0x07, 0x83, 0x9f, 0x15,
+	0x06, 0x17, 0x15, 0x06, 0x97, 0x15, 0x06, 0x57, 0x15, 0x06, 0x5f, 0x17, 0x58, 0xbb, 0x5c, 0x60,
+	0xed, 0xf7, 0x02, 0x6b, 0x1f, 0x0f, 0xc6, 0x5c, 0x4d, 0x66, 0x09, 0x49, 0xc5, 0xd4, 0xab, 0x77,
+	0xf2, 0xf4, 0x34, 0x4e, 0x8a, 0x46, 0x79, 0x9f, 0x57, 0x47, 0xa8, 0xbe, 0x9c, 0xb1, 0x22, 0x31,
+	0x9b, 0x2b, 0x79, 0xf6, 0x77, 0x00, 0x24, 0x94, 0x03, 0x8b, 0xa1, 0x02, 0x00, 0x00,
+}
+
+func (this *ConversionRecord) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*ConversionRecord)
+	if !ok {
+		that2, ok := that.(ConversionRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ConversionRecord")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ConversionRecord but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ConversionRecord but is not nil && this == nil")
+	}
+	if this.ID != that1.ID {
+		return fmt.Errorf("ID this(%v) Not Equal that(%v)", this.ID, that1.ID)
+	}
+	if this.Initiator != that1.Initiator {
+		return fmt.Errorf("Initiator this(%v) Not Equal that(%v)", this.Initiator, that1.Initiator)
+	}
+	if this.Receiver != that1.Receiver {
+		return fmt.Errorf("Receiver this(%v) Not Equal that(%v)", this.Receiver, that1.Receiver)
+	}
+	if this.ERC20Address != that1.ERC20Address {
+		return fmt.Errorf("ERC20Address this(%v) Not Equal that(%v)", this.ERC20Address, that1.ERC20Address)
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return fmt.Errorf("Amount this(%v) Not Equal that(%v)", this.Amount, that1.Amount)
+	}
+	if this.Direction != that1.Direction {
+		return fmt.Errorf("Direction this(%v) Not Equal that(%v)", this.Direction, that1.Direction)
+	}
+	if this.Height != that1.Height {
+		return fmt.Errorf("Height this(%v) Not Equal that(%v)", this.Height, that1.Height)
+	}
+	return nil
+}
+func (this *ConversionRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConversionRecord)
+	if !ok {
+		that2, ok := that.(ConversionRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Initiator != that1.Initiator {
+		return false
+	}
+	if this.Receiver != that1.Receiver {
+		return false
+	}
+	if this.ERC20Address != that1.ERC20Address {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	if this.Direction != that1.Direction {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (m *ConversionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintConversionRecord(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Direction != 0 {
+		i = encodeVarintConversionRecord(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintConversionRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ERC20Address) > 0 {
+		i -= len(m.ERC20Address)
+		copy(dAtA[i:], m.ERC20Address)
+		i = encodeVarintConversionRecord(dAtA, i, uint64(len(m.ERC20Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintConversionRecord(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Initiator) > 0 {
+		i -= len(m.Initiator)
+		copy(dAtA[i:], m.Initiator)
+		i = encodeVarintConversionRecord(dAtA, i, uint64(len(m.Initiator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintConversionRecord(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintConversionRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovConversionRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ConversionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovConversionRecord(uint64(m.ID))
+	}
+	l = len(m.Initiator)
+	if l > 0 {
+		n += 1 + l + sovConversionRecord(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovConversionRecord(uint64(l))
+	}
+	l = len(m.ERC20Address)
+	if l > 0 {
+		n += 1 + l + sovConversionRecord(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovConversionRecord(uint64(l))
+	if m.Direction != 0 {
+		n += 1 + sovConversionRecord(uint64(m.Direction))
+	}
+	if m.Height != 0 {
+		n += 1 + sovConversionRecord(uint64(m.Height))
+	}
+	return n
+}
+
+func sovConversionRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozConversionRecord(x uint64) (n int) {
+	return sovConversionRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ConversionRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConversionRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConversionRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConversionRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConversionRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConversionRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Initiator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConversionRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConversionRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConversionRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ERC20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConversionRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConversionRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConversionRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ERC20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConversionRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConversionRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConversionRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConversionRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= ConversionDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConversionRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConversionRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConversionRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipConversionRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowConversionRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConversionRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConversionRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthConversionRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupConversionRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthConversionRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthConversionRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowConversionRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupConversionRecord = fmt.Errorf("proto: unexpected end of group")
+)
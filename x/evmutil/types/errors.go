@@ -12,4 +12,7 @@ var (
 	ErrInvalidCosmosDenom           = errorsmod.Register(ModuleName, 7, "invalid cosmos denom")
 	ErrSDKConversionNotEnabled      = errorsmod.Register(ModuleName, 8, "sdk.Coin not enabled to convert to ERC20 token")
 	ErrInsufficientConversionAmount = errorsmod.Register(ModuleName, 9, "insufficient conversion amount")
+	ErrContractFrozen               = errorsmod.Register(ModuleName, 10, "conversions of erc20 contract are frozen")
+	ErrERC20MetadataNotSynced       = errorsmod.Register(ModuleName, 11, "erc20 metadata cannot be synced")
+	ErrConversionRateLimitExceeded  = errorsmod.Register(ModuleName, 12, "conversion rate limit exceeded")
 )
@@ -11,6 +11,13 @@ const (
 	EventTypeConvertCosmosCoinToERC20   = "convert_cosmos_coin_to_erc20"
 	EventTypeConvertCosmosCoinFromERC20 = "convert_cosmos_coin_from_erc20"
 
+	EventTypeAutoDeployCosmosCoinERC20        = "auto_deploy_cosmos_coin_erc20"
+	EventTypeUpdateDeployedCosmosCoinContract = "update_deployed_cosmos_coin_contract"
+	EventTypeSyncERC20Metadata                = "sync_erc20_metadata"
+
+	EventTypeSweepDust   = "sweep_dust"
+	EventTypeConvertDust = "convert_dust"
+
 	// Event Attributes - Common
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyAmount   = "amount"
@@ -18,4 +25,17 @@ const (
 	// Event Attributes - Conversions
 	AttributeKeyInitiator    = "initiator"
 	AttributeKeyERC20Address = "erc20_address"
+	AttributeKeyCosmosDenom  = "cosmos_denom"
+
+	// Event Attributes - Deployed contract updates
+	AttributeKeyPreviousERC20Address = "previous_erc20_address"
+	AttributeKeyFrozen               = "frozen"
+
+	// Event Attributes - Metadata syncs
+	AttributeKeyName     = "name"
+	AttributeKeySymbol   = "symbol"
+	AttributeKeyDecimals = "decimals"
+
+	// Event Attributes - Dust sweeps
+	AttributeKeyAccount = "account"
 )
@@ -8,10 +8,17 @@ import (
 )
 
 // NewGenesisState returns a new genesis state object for the module.
-func NewGenesisState(accounts []Account, params Params) *GenesisState {
+func NewGenesisState(
+	accounts []Account,
+	params Params,
+	deployedContracts DeployedCosmosCoinContracts,
+	frozenContracts []string,
+) *GenesisState {
 	return &GenesisState{
-		Accounts: accounts,
-		Params:   params,
+		Accounts:                    accounts,
+		Params:                      params,
+		DeployedCosmosCoinContracts: deployedContracts,
+		FrozenCosmosCoinContracts:   frozenContracts,
 	}
 }
 
@@ -20,6 +27,8 @@ func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(
 		[]Account{},
 		DefaultParams(),
+		DeployedCosmosCoinContracts{},
+		[]string{},
 	)
 }
 
@@ -42,6 +51,25 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.DeployedCosmosCoinContracts.Validate(); err != nil {
+		return err
+	}
+
+	seenFrozenContracts := make(map[string]bool)
+	for _, contract := range gs.FrozenCosmosCoinContracts {
+		address, err := NewInternalEVMAddressFromString(contract)
+		if err != nil {
+			return fmt.Errorf("invalid frozen contract address: %w", err)
+		}
+		if address.IsNil() {
+			return fmt.Errorf("frozen contract address cannot be zero value %s", contract)
+		}
+		if seenFrozenContracts[address.Hex()] {
+			return fmt.Errorf("duplicate frozen contract %s", contract)
+		}
+		seenFrozenContracts[address.Hex()] = true
+	}
+
 	return nil
 }
 
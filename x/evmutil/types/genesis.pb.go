@@ -31,6 +31,12 @@ type GenesisState struct {
 	Accounts []Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// deployed_cosmos_coin_contracts are the ERC20 contracts deployed by the module
+	// to represent cosmos-sdk coins in the evm, keyed by cosmos denom.
+	DeployedCosmosCoinContracts DeployedCosmosCoinContracts `protobuf:"bytes,3,rep,name=deployed_cosmos_coin_contracts,json=deployedCosmosCoinContracts,proto3,castrepeated=DeployedCosmosCoinContracts" json:"deployed_cosmos_coin_contracts"`
+	// frozen_cosmos_coin_contracts are the EVM hex addresses of deployed cosmos coin
+	// contracts whose conversions are frozen.
+	FrozenCosmosCoinContracts []string `protobuf:"bytes,4,rep,name=frozen_cosmos_coin_contracts,json=frozenCosmosCoinContracts,proto3" json:"frozen_cosmos_coin_contracts,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -114,6 +120,25 @@ type Params struct {
 	// allowed_cosmos_denoms is a list of denom & erc20 token metadata pairs.
 	// if a denom is in the list, it is allowed to be converted to an erc20 in the evm.
 	AllowedCosmosDenoms AllowedCosmosCoinERC20Tokens `protobuf:"bytes,1,rep,name=allowed_cosmos_denoms,json=allowedCosmosDenoms,proto3,castrepeated=AllowedCosmosCoinERC20Tokens" json:"allowed_cosmos_denoms"`
+	// auto_deploy_cosmos_denoms is a list of cosmos denoms whose ERC20 contract is deployed
+	// automatically the first time the denom is received over IBC.
+	// each denom must also be present in allowed_cosmos_denoms to be deployed.
+	AutoDeployCosmosDenoms []string `protobuf:"bytes,5,rep,name=auto_deploy_cosmos_denoms,json=autoDeployCosmosDenoms,proto3" json:"auto_deploy_cosmos_denoms,omitempty"`
+	// evm_denom is the 18 decimal denom used for gas and balances in the evm.
+	EvmDenom string `protobuf:"bytes,6,opt,name=evm_denom,json=evmDenom,proto3" json:"evm_denom,omitempty"`
+	// cosmos_denom is the sdk.Coin denom backing evm_denom balances.
+	CosmosDenom string `protobuf:"bytes,7,opt,name=cosmos_denom,json=cosmosDenom,proto3" json:"cosmos_denom,omitempty"`
+	// conversion_multiplier is the number of evm_denom units equal to one cosmos_denom unit.
+	ConversionMultiplier github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=conversion_multiplier,json=conversionMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"conversion_multiplier"`
+	// conversion_rate_limits limits the amount of a denom that can be converted
+	// between sdk.Coin and ERC20 within a rolling window of blocks.
+	ConversionRateLimits ConversionRateLimits `protobuf:"bytes,9,rep,name=conversion_rate_limits,json=conversionRateLimits,proto3,castrepeated=ConversionRateLimits" json:"conversion_rate_limits"`
+	// enable_dust_sweep enables the end blocker that sweeps fractional evm_denom balances
+	// below dust_sweep_threshold from module accounts, a batch of accounts per block.
+	EnableDustSweep bool `protobuf:"varint,10,opt,name=enable_dust_sweep,json=enableDustSweep,proto3" json:"enable_dust_sweep,omitempty"`
+	// dust_sweep_threshold is the fractional evm_denom balance below which a module account's
+	// balance is swept as dust. It must not be greater than conversion_multiplier.
+	DustSweepThreshold github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=dust_sweep_threshold,json=dustSweepThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"dust_sweep_threshold"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -163,6 +188,41 @@ func (m *Params) GetAllowedCosmosDenoms() AllowedCosmosCoinERC20Tokens {
 	return nil
 }
 
+func (m *Params) GetAutoDeployCosmosDenoms() []string {
+	if m != nil {
+		return m.AutoDeployCosmosDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetEvmDenom() string {
+	if m != nil {
+		return m.EvmDenom
+	}
+	return ""
+}
+
+func (m *Params) GetCosmosDenom() string {
+	if m != nil {
+		return m.CosmosDenom
+	}
+	return ""
+}
+
+func (m *Params) GetConversionRateLimits() ConversionRateLimits {
+	if m != nil {
+		return m.ConversionRateLimits
+	}
+	return nil
+}
+
+func (m *Params) GetEnableDustSweep() bool {
+	if m != nil {
+		return m.EnableDustSweep
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.evmutil.v1beta1.GenesisState")
 	proto.RegisterType((*Account)(nil), "kava.evmutil.v1beta1.Account")
@@ -174,38 +234,53 @@ func init() {
 }
 
 var fileDescriptor_d916ab97b8e628c2 = []byte{
-	// 489 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xde, 0xa9, 0x21, 0xd1, 0x69, 0x41, 0xd8, 0x56, 0x8d, 0xa5, 0xce, 0x96, 0x50, 0x24, 0x0a,
-	0xbb, 0x6b, 0xe2, 0xad, 0x08, 0xd2, 0x8d, 0xa2, 0xc5, 0x4b, 0x59, 0xc5, 0x83, 0x97, 0xf0, 0x76,
-	0x77, 0x88, 0x4b, 0x76, 0x67, 0xc2, 0xce, 0x24, 0xb5, 0xff, 0x40, 0xf0, 0xa0, 0xfe, 0x03, 0x8f,
-	0xe2, 0xb9, 0x3f, 0xa2, 0xe0, 0xa5, 0xf4, 0x24, 0x1e, 0x62, 0x4d, 0xfe, 0x85, 0x27, 0xd9, 0x99,
-	0x49, 0xa8, 0x21, 0x8a, 0xa7, 0x9d, 0x7d, 0xf3, 0x7d, 0xef, 0xfb, 0xe6, 0x7b, 0x0f, 0x37, 0xfa,
-	0x30, 0x02, 0x9f, 0x8e, 0xf2, 0xa1, 0x4c, 0x33, 0x7f, 0xd4, 0x8a, 0xa8, 0x84, 0x96, 0xdf, 0xa3,
-	0x8c, 0x8a, 0x54, 0x78, 0x83, 0x82, 0x4b, 0x6e, 0x6f, 0x94, 0x18, 0xcf, 0x60, 0x3c, 0x83, 0xd9,
-	0xbc, 0x19, 0x73, 0x91, 0x73, 0xd1, 0x55, 0x18, 0x5f, 0xff, 0x68, 0xc2, 0xe6, 0x46, 0x8f, 0xf7,
-	0xb8, 0xae, 0x97, 0x27, 0x53, 0xbd, 0xbb, 0x54, 0x2a, 0xe6, 0x6c, 0x44, 0x0b, 0x91, 0x72, 0xd6,
-	0x1d, 0x40, 0x5a, 0x68, 0x6c, 0xe3, 0x23, 0xc2, 0x6b, 0x4f, 0xb4, 0x89, 0xe7, 0x12, 0x24, 0xb5,
-	0x1f, 0xe2, 0xcb, 0x10, 0xc7, 0x7c, 0xc8, 0xa4, 0xa8, 0xa3, 0xed, 0x4b, 0xcd, 0xd5, 0xf6, 0x2d,
-	0x6f, 0x99, 0x2d, 0x6f, 0x4f, 0xa3, 0x82, 0xca, 0xc9, 0xd8, 0xb1, 0xc2, 0x39, 0xc9, 0xde, 0xc5,
-	0xd5, 0x01, 0x14, 0x90, 0x8b, 0xfa, 0xca, 0x36, 0x6a, 0xae, 0xb6, 0xb7, 0x96, 0xd3, 0x0f, 0x14,
-	0xc6, 0xb0, 0x0d, 0x63, 0xb7, 0xf2, 0xf6, 0x93, 0x63, 0x35, 0xbe, 0x22, 0x5c, 0x33, 0xdd, 0xed,
-	0x08, 0xd7, 0x20, 0x49, 0x0a, 0x2a, 0x4a, 0x37, 0xa8, 0xb9, 0x16, 0x3c, 0xfd, 0x35, 0x76, 0xdc,
-	0x5e, 0x2a, 0x5f, 0x0f, 0x23, 0x2f, 0xe6, 0xb9, 0xc9, 0xc3, 0x7c, 0x5c, 0x91, 0xf4, 0x7d, 0x79,
-	0x34, 0xa0, 0xa2, 0xb4, 0xb7, 0xa7, 0x89, 0x67, 0xc7, 0xee, 0xba, 0x49, 0xcd, 0x54, 0x82, 0x23,
-	0x49, 0x45, 0x38, 0x6b, 0x6c, 0xbf, 0xc4, 0xb5, 0x08, 0x32, 0x60, 0x31, 0x55, 0x96, 0xaf, 0x04,
-	0x0f, 0x4a, 0x53, 0xdf, 0xc7, 0xce, 0xed, 0xff, 0xd0, 0xd9, 0x67, 0xf2, 0xec, 0xd8, 0xc5, 0x46,
-	0x60, 0x9f, 0xc9, 0x70, 0xd6, 0xcc, 0xbc, 0xe6, 0xfd, 0x0a, 0xae, 0xea, 0xc7, 0xda, 0x87, 0xb8,
-	0x4e, 0x19, 0x44, 0x19, 0x4d, 0xba, 0x0b, 0xd3, 0x10, 0xf5, 0x8a, 0xca, 0x7a, 0x67, 0x79, 0x58,
-	0x9d, 0x39, 0xfa, 0x00, 0xd2, 0x22, 0xb8, 0x51, 0xfa, 0xfb, 0xf2, 0xc3, 0xb9, 0xfa, 0x67, 0x5d,
-	0x84, 0xd7, 0x4d, 0xfb, 0x85, 0xba, 0xfd, 0x0e, 0xe1, 0x6b, 0x90, 0x65, 0xfc, 0x50, 0x29, 0xab,
-	0x6d, 0x4a, 0x28, 0xe3, 0xf9, 0x6c, 0xc4, 0xad, 0xbf, 0x8c, 0x58, 0x53, 0x3a, 0x8a, 0xd1, 0xe1,
-	0x29, 0x7b, 0x1c, 0x76, 0xda, 0xf7, 0x5e, 0xf0, 0x3e, 0x65, 0xc1, 0x8e, 0xf1, 0xb0, 0xf5, 0x0f,
-	0x90, 0x08, 0xd7, 0xe1, 0xe2, 0xed, 0x23, 0xa5, 0x19, 0x3c, 0x3b, 0xff, 0x49, 0xd0, 0xe7, 0x09,
-	0x41, 0x27, 0x13, 0x82, 0x4e, 0x27, 0x04, 0x9d, 0x4f, 0x08, 0xfa, 0x30, 0x25, 0xd6, 0xe9, 0x94,
-	0x58, 0xdf, 0xa6, 0xc4, 0x7a, 0x75, 0xe7, 0x42, 0xf0, 0xa5, 0x33, 0x37, 0x83, 0x48, 0xa8, 0x93,
-	0xff, 0x66, 0xbe, 0xd8, 0x2a, 0xff, 0xa8, 0xaa, 0xf6, 0xf8, 0xfe, 0xef, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xa1, 0xec, 0x74, 0x78, 0x60, 0x03, 0x00, 0x00,
+	// 731 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4f, 0x13, 0x4f,
+	0x14, 0xef, 0x02, 0xdf, 0xfe, 0x98, 0x92, 0x90, 0xef, 0x50, 0x70, 0x81, 0xba, 0xad, 0x88, 0xa6,
+	0x90, 0xb4, 0x85, 0x7a, 0x92, 0x98, 0x10, 0xb6, 0x18, 0x25, 0x6a, 0x42, 0x16, 0xe2, 0xc1, 0xcb,
+	0x66, 0xba, 0x3b, 0x96, 0x0d, 0xbb, 0x33, 0x75, 0x67, 0x5a, 0xc4, 0xc4, 0xbb, 0x89, 0x17, 0x8f,
+	0xc6, 0x93, 0x47, 0xe3, 0x99, 0x3f, 0x82, 0xc4, 0x0b, 0xe1, 0x64, 0x3c, 0x20, 0x96, 0x3f, 0xc0,
+	0xbb, 0x27, 0xb3, 0x3b, 0xd3, 0x6e, 0x21, 0x5b, 0xf5, 0xc0, 0x89, 0xe5, 0xbd, 0xcf, 0x8f, 0xd7,
+	0xcf, 0x7b, 0x19, 0x30, 0xbf, 0x87, 0x3a, 0xa8, 0x8a, 0x3b, 0x5e, 0x9b, 0x3b, 0x6e, 0xb5, 0xb3,
+	0xd2, 0xc0, 0x1c, 0xad, 0x54, 0x9b, 0x98, 0x60, 0xe6, 0xb0, 0x4a, 0xcb, 0xa7, 0x9c, 0xc2, 0x5c,
+	0x80, 0xa9, 0x48, 0x4c, 0x45, 0x62, 0x66, 0x67, 0x2c, 0xca, 0x3c, 0xca, 0xcc, 0x10, 0x53, 0x15,
+	0xff, 0x08, 0xc2, 0x6c, 0xae, 0x49, 0x9b, 0x54, 0xd4, 0x83, 0x2f, 0x59, 0x5d, 0x8a, 0xb5, 0xb2,
+	0x28, 0xe9, 0x60, 0x9f, 0x39, 0x94, 0x98, 0x2d, 0xe4, 0xf8, 0x12, 0x7b, 0x2b, 0x16, 0xeb, 0x23,
+	0x8e, 0x4d, 0xd7, 0xf1, 0x1c, 0x2e, 0x60, 0xf3, 0x3f, 0x47, 0xc0, 0xf8, 0x03, 0x31, 0xeb, 0x36,
+	0x47, 0x1c, 0xc3, 0x35, 0x90, 0x46, 0x96, 0x45, 0xdb, 0x84, 0x33, 0x55, 0x29, 0x8e, 0x96, 0xb2,
+	0xb5, 0xeb, 0x95, 0xb8, 0xe9, 0x2b, 0xeb, 0x02, 0xa5, 0x8f, 0x1d, 0x9d, 0x16, 0x12, 0x46, 0x9f,
+	0x04, 0x57, 0x41, 0xb2, 0x85, 0x7c, 0xe4, 0x31, 0x75, 0xa4, 0xa8, 0x94, 0xb2, 0xb5, 0x7c, 0x3c,
+	0x7d, 0x2b, 0xc4, 0x48, 0xb6, 0x64, 0xc0, 0x0f, 0x0a, 0xd0, 0x6c, 0xdc, 0x72, 0xe9, 0x01, 0xb6,
+	0x4d, 0x99, 0x8e, 0x45, 0x1d, 0x62, 0x5a, 0x94, 0x70, 0x1f, 0x59, 0x9c, 0xa9, 0xa3, 0xe1, 0x4c,
+	0xcb, 0xf1, 0xa2, 0x1b, 0x92, 0x5b, 0x0f, 0xa9, 0x75, 0xea, 0x90, 0xba, 0x24, 0xea, 0x37, 0x03,
+	0xa3, 0xcf, 0xdf, 0x0b, 0x73, 0xc3, 0x31, 0xcc, 0x98, 0xb3, 0x87, 0x37, 0xe1, 0x1a, 0xc8, 0x3f,
+	0xf7, 0xe9, 0x2b, 0x4c, 0x86, 0x4c, 0x36, 0x56, 0x1c, 0x2d, 0x65, 0x8c, 0x19, 0x81, 0x89, 0x11,
+	0x58, 0x1d, 0x7b, 0xf3, 0xb1, 0x90, 0x98, 0xff, 0xa2, 0x80, 0x94, 0xcc, 0x0e, 0x36, 0x40, 0x0a,
+	0xd9, 0xb6, 0x8f, 0x59, 0x90, 0xb5, 0x52, 0x1a, 0xd7, 0x1f, 0xfe, 0x3a, 0x2d, 0x94, 0x9b, 0x0e,
+	0xdf, 0x6d, 0x37, 0x2a, 0x16, 0xf5, 0xe4, 0x51, 0xc8, 0x3f, 0x65, 0x66, 0xef, 0x55, 0xf9, 0x41,
+	0x0b, 0xb3, 0x20, 0xfc, 0x75, 0x41, 0x3c, 0x39, 0x2c, 0x4f, 0x8a, 0x76, 0x45, 0x56, 0xf4, 0x03,
+	0x8e, 0x99, 0xd1, 0x13, 0x86, 0x4f, 0x41, 0xaa, 0x81, 0x5c, 0x44, 0x2c, 0x1c, 0x2e, 0x24, 0xa3,
+	0xdf, 0x0b, 0x92, 0xf8, 0x76, 0x5a, 0xb8, 0xfd, 0x0f, 0x3e, 0x9b, 0x84, 0x9f, 0x1c, 0x96, 0x81,
+	0x34, 0xd8, 0x24, 0xdc, 0xe8, 0x89, 0xc9, 0x5f, 0xf3, 0x3e, 0x09, 0x92, 0x62, 0x95, 0x70, 0x1f,
+	0xa8, 0x98, 0xa0, 0x86, 0x1b, 0xae, 0xee, 0xc2, 0x49, 0x8a, 0x6c, 0xb2, 0xb5, 0x85, 0xf8, 0xad,
+	0xd5, 0xfb, 0xe8, 0x2d, 0xe4, 0xf8, 0xfa, 0x35, 0xb9, 0xa9, 0x89, 0x8b, 0x75, 0x66, 0x4c, 0x4b,
+	0xf9, 0x4b, 0x75, 0xf8, 0x56, 0x01, 0x53, 0xc8, 0x75, 0xe9, 0x7e, 0x74, 0x34, 0x36, 0x26, 0xd4,
+	0xeb, 0x1d, 0xf0, 0xca, 0x90, 0x03, 0x16, 0x94, 0x68, 0x53, 0xf7, 0x8d, 0x7a, 0x6d, 0x79, 0x87,
+	0xee, 0x61, 0xa2, 0x2f, 0xc8, 0x19, 0xf2, 0x7f, 0x00, 0x31, 0x63, 0x12, 0x0d, 0x76, 0x37, 0x42,
+	0x4f, 0x78, 0x17, 0xcc, 0xa0, 0x36, 0xa7, 0xa6, 0x38, 0xa5, 0x4b, 0x03, 0xfd, 0x17, 0xde, 0xc8,
+	0x74, 0x00, 0x10, 0x77, 0x78, 0x81, 0x3a, 0x07, 0x32, 0xb8, 0xe3, 0x09, 0xac, 0x9a, 0x0c, 0x96,
+	0x65, 0xa4, 0x71, 0xc7, 0x0b, 0xbb, 0xf0, 0x06, 0x18, 0x1f, 0xd4, 0x52, 0x53, 0x61, 0x3f, 0x6b,
+	0x45, 0x02, 0xf0, 0x05, 0x98, 0x1a, 0x48, 0xde, 0x6b, 0xbb, 0xdc, 0x69, 0xb9, 0x0e, 0xf6, 0xd5,
+	0xf4, 0x15, 0x2c, 0x3e, 0x17, 0x49, 0x3f, 0xe9, 0x2b, 0xc3, 0xd7, 0x60, 0x7a, 0xc0, 0x32, 0x7a,
+	0x5e, 0x98, 0x9a, 0x09, 0xb3, 0x5f, 0xfc, 0xdb, 0xca, 0x0d, 0xc4, 0xf1, 0xe3, 0x80, 0xa1, 0xe7,
+	0x65, 0xe6, 0xb9, 0x98, 0x26, 0x1b, 0xb4, 0x8f, 0xaa, 0x70, 0x09, 0xfc, 0x2f, 0x8e, 0xc2, 0xb4,
+	0xdb, 0x8c, 0x9b, 0x6c, 0x1f, 0xe3, 0x96, 0x0a, 0x8a, 0x4a, 0x29, 0x6d, 0x4c, 0x88, 0xc6, 0x46,
+	0x9b, 0xf1, 0xed, 0xa0, 0x0c, 0x09, 0xc8, 0x45, 0x20, 0x93, 0xef, 0xfa, 0x98, 0xed, 0x52, 0xd7,
+	0x56, 0xb3, 0x57, 0x10, 0x0e, 0xb4, 0x7b, 0x36, 0x3b, 0x3d, 0x5d, 0xfd, 0xd1, 0xd9, 0x0f, 0x4d,
+	0xf9, 0xd4, 0xd5, 0x94, 0xa3, 0xae, 0xa6, 0x1c, 0x77, 0x35, 0xe5, 0xac, 0xab, 0x29, 0xef, 0xce,
+	0xb5, 0xc4, 0xf1, 0xb9, 0x96, 0xf8, 0x7a, 0xae, 0x25, 0x9e, 0x2d, 0x0e, 0x78, 0x05, 0x31, 0x95,
+	0x5d, 0xd4, 0x60, 0xe1, 0x57, 0xf5, 0x65, 0xff, 0xe9, 0x0e, 0x2d, 0x1b, 0xc9, 0xf0, 0xb9, 0xbe,
+	0xf3, 0x7b, 0x00, 0xe0, 0x7b, 0x31, 0x23, 0x6e, 0x06, 0x00, 0x00,
 }
 
 func (this *GenesisState) VerboseEqual(that interface{}) error {
@@ -244,6 +319,22 @@ func (this *GenesisState) VerboseEqual(that interface{}) error {
 	if !this.Params.Equal(&that1.Params) {
 		return fmt.Errorf("Params this(%v) Not Equal that(%v)", this.Params, that1.Params)
 	}
+	if len(this.DeployedCosmosCoinContracts) != len(that1.DeployedCosmosCoinContracts) {
+		return fmt.Errorf("DeployedCosmosCoinContracts this(%v) Not Equal that(%v)", len(this.DeployedCosmosCoinContracts), len(that1.DeployedCosmosCoinContracts))
+	}
+	for i := range this.DeployedCosmosCoinContracts {
+		if !this.DeployedCosmosCoinContracts[i].Equal(&that1.DeployedCosmosCoinContracts[i]) {
+			return fmt.Errorf("DeployedCosmosCoinContracts this[%v](%v) Not Equal that[%v](%v)", i, this.DeployedCosmosCoinContracts[i], i, that1.DeployedCosmosCoinContracts[i])
+		}
+	}
+	if len(this.FrozenCosmosCoinContracts) != len(that1.FrozenCosmosCoinContracts) {
+		return fmt.Errorf("FrozenCosmosCoinContracts this(%v) Not Equal that(%v)", len(this.FrozenCosmosCoinContracts), len(that1.FrozenCosmosCoinContracts))
+	}
+	for i := range this.FrozenCosmosCoinContracts {
+		if this.FrozenCosmosCoinContracts[i] != that1.FrozenCosmosCoinContracts[i] {
+			return fmt.Errorf("FrozenCosmosCoinContracts this[%v](%v) Not Equal that[%v](%v)", i, this.FrozenCosmosCoinContracts[i], i, that1.FrozenCosmosCoinContracts[i])
+		}
+	}
 	return nil
 }
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -276,6 +367,22 @@ func (this *GenesisState) Equal(that interface{}) bool {
 	if !this.Params.Equal(&that1.Params) {
 		return false
 	}
+	if len(this.DeployedCosmosCoinContracts) != len(that1.DeployedCosmosCoinContracts) {
+		return false
+	}
+	for i := range this.DeployedCosmosCoinContracts {
+		if !this.DeployedCosmosCoinContracts[i].Equal(&that1.DeployedCosmosCoinContracts[i]) {
+			return false
+		}
+	}
+	if len(this.FrozenCosmosCoinContracts) != len(that1.FrozenCosmosCoinContracts) {
+		return false
+	}
+	for i := range this.FrozenCosmosCoinContracts {
+		if this.FrozenCosmosCoinContracts[i] != that1.FrozenCosmosCoinContracts[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *Account) VerboseEqual(that interface{}) error {
@@ -379,6 +486,37 @@ func (this *Params) VerboseEqual(that interface{}) error {
 			return fmt.Errorf("AllowedCosmosDenoms this[%v](%v) Not Equal that[%v](%v)", i, this.AllowedCosmosDenoms[i], i, that1.AllowedCosmosDenoms[i])
 		}
 	}
+	if len(this.AutoDeployCosmosDenoms) != len(that1.AutoDeployCosmosDenoms) {
+		return fmt.Errorf("AutoDeployCosmosDenoms this(%v) Not Equal that(%v)", len(this.AutoDeployCosmosDenoms), len(that1.AutoDeployCosmosDenoms))
+	}
+	for i := range this.AutoDeployCosmosDenoms {
+		if this.AutoDeployCosmosDenoms[i] != that1.AutoDeployCosmosDenoms[i] {
+			return fmt.Errorf("AutoDeployCosmosDenoms this[%v](%v) Not Equal that[%v](%v)", i, this.AutoDeployCosmosDenoms[i], i, that1.AutoDeployCosmosDenoms[i])
+		}
+	}
+	if this.EvmDenom != that1.EvmDenom {
+		return fmt.Errorf("EvmDenom this(%v) Not Equal that(%v)", this.EvmDenom, that1.EvmDenom)
+	}
+	if this.CosmosDenom != that1.CosmosDenom {
+		return fmt.Errorf("CosmosDenom this(%v) Not Equal that(%v)", this.CosmosDenom, that1.CosmosDenom)
+	}
+	if !this.ConversionMultiplier.Equal(that1.ConversionMultiplier) {
+		return fmt.Errorf("ConversionMultiplier this(%v) Not Equal that(%v)", this.ConversionMultiplier, that1.ConversionMultiplier)
+	}
+	if len(this.ConversionRateLimits) != len(that1.ConversionRateLimits) {
+		return fmt.Errorf("ConversionRateLimits this(%v) Not Equal that(%v)", len(this.ConversionRateLimits), len(that1.ConversionRateLimits))
+	}
+	for i := range this.ConversionRateLimits {
+		if !this.ConversionRateLimits[i].Equal(&that1.ConversionRateLimits[i]) {
+			return fmt.Errorf("ConversionRateLimits this[%v](%v) Not Equal that[%v](%v)", i, this.ConversionRateLimits[i], i, that1.ConversionRateLimits[i])
+		}
+	}
+	if this.EnableDustSweep != that1.EnableDustSweep {
+		return fmt.Errorf("EnableDustSweep this(%v) Not Equal that(%v)", this.EnableDustSweep, that1.EnableDustSweep)
+	}
+	if !this.DustSweepThreshold.Equal(that1.DustSweepThreshold) {
+		return fmt.Errorf("DustSweepThreshold this(%v) Not Equal that(%v)", this.DustSweepThreshold, that1.DustSweepThreshold)
+	}
 	return nil
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -416,6 +554,37 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.AutoDeployCosmosDenoms) != len(that1.AutoDeployCosmosDenoms) {
+		return false
+	}
+	for i := range this.AutoDeployCosmosDenoms {
+		if this.AutoDeployCosmosDenoms[i] != that1.AutoDeployCosmosDenoms[i] {
+			return false
+		}
+	}
+	if this.EvmDenom != that1.EvmDenom {
+		return false
+	}
+	if this.CosmosDenom != that1.CosmosDenom {
+		return false
+	}
+	if !this.ConversionMultiplier.Equal(that1.ConversionMultiplier) {
+		return false
+	}
+	if len(this.ConversionRateLimits) != len(that1.ConversionRateLimits) {
+		return false
+	}
+	for i := range this.ConversionRateLimits {
+		if !this.ConversionRateLimits[i].Equal(&that1.ConversionRateLimits[i]) {
+			return false
+		}
+	}
+	if this.EnableDustSweep != that1.EnableDustSweep {
+		return false
+	}
+	if !this.DustSweepThreshold.Equal(that1.DustSweepThreshold) {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -438,6 +607,29 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FrozenCosmosCoinContracts) > 0 {
+		for iNdEx := len(m.FrozenCosmosCoinContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenCosmosCoinContracts[iNdEx])
+			copy(dAtA[i:], m.FrozenCosmosCoinContracts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenCosmosCoinContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DeployedCosmosCoinContracts) > 0 {
+		for iNdEx := len(m.DeployedCosmosCoinContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeployedCosmosCoinContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -525,6 +717,73 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.DustSweepThreshold.Size()
+		i -= size
+		if _, err := m.DustSweepThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.EnableDustSweep {
+		i--
+		if m.EnableDustSweep {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ConversionRateLimits) > 0 {
+		for iNdEx := len(m.ConversionRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConversionRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size := m.ConversionMultiplier.Size()
+		i -= size
+		if _, err := m.ConversionMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.CosmosDenom) > 0 {
+		i -= len(m.CosmosDenom)
+		copy(dAtA[i:], m.CosmosDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CosmosDenom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.EvmDenom) > 0 {
+		i -= len(m.EvmDenom)
+		copy(dAtA[i:], m.EvmDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EvmDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AutoDeployCosmosDenoms) > 0 {
+		for iNdEx := len(m.AutoDeployCosmosDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoDeployCosmosDenoms[iNdEx])
+			copy(dAtA[i:], m.AutoDeployCosmosDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoDeployCosmosDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.EnabledConversionPairs) > 0 {
 		for iNdEx := len(m.EnabledConversionPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -581,6 +840,18 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DeployedCosmosCoinContracts) > 0 {
+		for _, e := range m.DeployedCosmosCoinContracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenCosmosCoinContracts) > 0 {
+		for _, s := range m.FrozenCosmosCoinContracts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -617,6 +888,33 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoDeployCosmosDenoms) > 0 {
+		for _, s := range m.AutoDeployCosmosDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.EvmDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.CosmosDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.ConversionMultiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ConversionRateLimits) > 0 {
+		for _, e := range m.ConversionRateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EnableDustSweep {
+		n += 2
+	}
+	l = m.DustSweepThreshold.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -722,6 +1020,72 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployedCosmosCoinContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployedCosmosCoinContracts = append(m.DeployedCosmosCoinContracts, DeployedCosmosCoinContract{})
+			if err := m.DeployedCosmosCoinContracts[len(m.DeployedCosmosCoinContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenCosmosCoinContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenCosmosCoinContracts = append(m.FrozenCosmosCoinContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -958,6 +1322,224 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoDeployCosmosDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoDeployCosmosDenoms = append(m.AutoDeployCosmosDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionRateLimits = append(m.ConversionRateLimits, ConversionRateLimit{})
+			if err := m.ConversionRateLimits[len(m.ConversionRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableDustSweep", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableDustSweep = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustSweepThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DustSweepThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
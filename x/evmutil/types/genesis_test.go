@@ -14,10 +14,12 @@ import (
 func TestGenesisState_Validate(t *testing.T) {
 	_, addrs := app.GeneratePrivKeyAddressPairs(2)
 	tests := []struct {
-		name     string
-		accounts []types.Account
-		success  bool
-		params   types.Params
+		name      string
+		accounts  []types.Account
+		success   bool
+		params    types.Params
+		contracts types.DeployedCosmosCoinContracts
+		frozen    []string
 	}{
 		{
 			name: "dup addresses",
@@ -55,18 +57,78 @@ func TestGenesisState_Validate(t *testing.T) {
 			),
 			success: false,
 		},
+		{
+			name:   "duplicate deployed contract denom",
+			params: types.DefaultParams(),
+			contracts: types.DeployedCosmosCoinContracts{
+				types.NewDeployedCosmosCoinContract("hard", types.NewInternalEVMAddress(common.HexToAddress("0x01"))),
+				types.NewDeployedCosmosCoinContract("hard", types.NewInternalEVMAddress(common.HexToAddress("0x02"))),
+			},
+			success: false,
+		},
+		{
+			name:   "zero deployed contract address",
+			params: types.DefaultParams(),
+			contracts: types.DeployedCosmosCoinContracts{
+				types.NewDeployedCosmosCoinContract("hard", types.NewInternalEVMAddress(common.Address{})),
+			},
+			success: false,
+		},
+		{
+			name:   "missing deployed contract address",
+			params: types.DefaultParams(),
+			contracts: types.DeployedCosmosCoinContracts{
+				{CosmosDenom: "hard"},
+			},
+			success: false,
+		},
+		{
+			name:   "invalid deployed contract denom",
+			params: types.DefaultParams(),
+			contracts: types.DeployedCosmosCoinContracts{
+				types.NewDeployedCosmosCoinContract("", types.NewInternalEVMAddress(common.HexToAddress("0x01"))),
+			},
+			success: false,
+		},
+		{
+			name:    "invalid frozen contract address",
+			params:  types.DefaultParams(),
+			frozen:  []string{"0xinvalidaddress"},
+			success: false,
+		},
+		{
+			name:    "zero frozen contract address",
+			params:  types.DefaultParams(),
+			frozen:  []string{common.Address{}.Hex()},
+			success: false,
+		},
+		{
+			name:   "duplicate frozen contract",
+			params: types.DefaultParams(),
+			frozen: []string{
+				"0x0000000000000000000000000000000000000001",
+				"0x0000000000000000000000000000000000000001",
+			},
+			success: false,
+		},
 		{
 			name: "valid state",
 			accounts: []types.Account{
 				{Address: addrs[0], Balance: sdkmath.NewInt(100)},
 				{Address: addrs[1], Balance: sdkmath.NewInt(150)},
 			},
+			params: types.DefaultParams(),
+			contracts: types.DeployedCosmosCoinContracts{
+				types.NewDeployedCosmosCoinContract("hard", types.NewInternalEVMAddress(common.HexToAddress("0x01"))),
+				types.NewDeployedCosmosCoinContract("swp", types.NewInternalEVMAddress(common.HexToAddress("0x02"))),
+			},
+			frozen:  []string{"0x0000000000000000000000000000000000000003"},
 			success: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gs := types.NewGenesisState(tt.accounts, tt.params)
+			gs := types.NewGenesisState(tt.accounts, tt.params, tt.contracts, tt.frozen)
 			err := gs.Validate()
 			if tt.success {
 				require.NoError(t, err)
@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MultiBalanceHooks combine multiple balance hooks, all hook functions are run in array sequence
+type MultiBalanceHooks []BalanceHooks
+
+var _ BalanceHooks = MultiBalanceHooks{}
+
+// NewMultiBalanceHooks returns a new MultiBalanceHooks
+func NewMultiBalanceHooks(hooks ...BalanceHooks) MultiBalanceHooks {
+	return hooks
+}
+
+// AfterBalanceChange runs after the fractional balance of an account is changed
+func (h MultiBalanceHooks) AfterBalanceChange(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	previousBalance sdkmath.Int,
+	newBalance sdkmath.Int,
+) {
+	for i := range h {
+		h[i].AfterBalanceChange(ctx, addr, previousBalance, newBalance)
+	}
+}
+
+// AfterSendBalance runs after a fractional balance is sent between accounts
+func (h MultiBalanceHooks) AfterSendBalance(
+	ctx sdk.Context,
+	senderAddr sdk.AccAddress,
+	recipientAddr sdk.AccAddress,
+	amt sdkmath.Int,
+) {
+	for i := range h {
+		h[i].AfterSendBalance(ctx, senderAddr, recipientAddr, amt)
+	}
+}
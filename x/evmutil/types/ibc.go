@@ -0,0 +1,19 @@
+package types
+
+import (
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+// IBCReceivedDenom returns the denom that is credited on this chain when an ICS20
+// packet with the given packet denom is received.
+func IBCReceivedDenom(sourcePort, sourceChannel, destPort, destChannel, packetDenom string) string {
+	if transfertypes.ReceiverChainIsSource(sourcePort, sourceChannel, packetDenom) {
+		// the token is returning to this chain, remove the prefix added by the sender
+		voucherPrefix := transfertypes.GetDenomPrefix(sourcePort, sourceChannel)
+		unprefixedDenom := packetDenom[len(voucherPrefix):]
+		return transfertypes.ParseDenomTrace(unprefixedDenom).IBCDenom()
+	}
+
+	prefixedDenom := transfertypes.GetPrefixedDenom(destPort, destChannel, packetDenom)
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+func TestIBCReceivedDenom(t *testing.T) {
+	testCases := []struct {
+		name          string
+		sourcePort    string
+		sourceChannel string
+		destPort      string
+		destChannel   string
+		packetDenom   string
+		expected      string
+	}{
+		{
+			name:          "native counterparty denom is prefixed and hashed",
+			sourcePort:    "transfer",
+			sourceChannel: "channel-0",
+			destPort:      "transfer",
+			destChannel:   "channel-1",
+			packetDenom:   "uatom",
+			expected:      "ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9",
+		},
+		{
+			name:          "returning native denom is unprefixed",
+			sourcePort:    "transfer",
+			sourceChannel: "channel-0",
+			destPort:      "transfer",
+			destChannel:   "channel-1",
+			packetDenom:   "transfer/channel-0/hard",
+			expected:      "hard",
+		},
+		{
+			name:          "returning multi-hop denom is unprefixed and hashed",
+			sourcePort:    "transfer",
+			sourceChannel: "channel-0",
+			destPort:      "transfer",
+			destChannel:   "channel-1",
+			packetDenom:   "transfer/channel-0/transfer/channel-5/uatom",
+			expected:      "ibc/BA313C4A19DFBF943586C0387E6B11286F9E416B4DD27574E6909CABE0E342FA",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			denom := types.IBCReceivedDenom(tc.sourcePort, tc.sourceChannel, tc.destPort, tc.destChannel, tc.packetDenom)
+			require.Equal(t, tc.expected, denom)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/binary"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -23,6 +25,16 @@ var (
 	AccountStoreKeyPrefix = []byte{0x00}
 	// DeployedCosmosCoinContractKeyPrefix is the key for storing deployed KavaWrappedCosmosCoinERC20s contract addresses
 	DeployedCosmosCoinContractKeyPrefix = []byte{0x01}
+	// ConversionRecordKeyPrefix is the prefix for keys that store conversion records
+	ConversionRecordKeyPrefix = []byte{0x02}
+	// NextConversionRecordIDKey is the key for the id of the next conversion record
+	NextConversionRecordIDKey = []byte{0x03}
+	// FrozenCosmosCoinContractKeyPrefix is the prefix for keys that mark deployed contracts whose conversions are frozen
+	FrozenCosmosCoinContractKeyPrefix = []byte{0x04}
+	// ConversionRateLimitUsageKeyPrefix is the prefix for keys that store the amount of a denom converted in a block
+	ConversionRateLimitUsageKeyPrefix = []byte{0x05}
+	// DustSweepCursorKey is the key for the account store key the next dust sweep batch starts from
+	DustSweepCursorKey = []byte{0x09}
 )
 
 // AccountStoreKey turns an address to a key used to get the account from the store
@@ -41,6 +53,34 @@ func DenomFromDeployedCosmosCoinContractKey(key []byte) string {
 	return string(key[1:])
 }
 
+// FrozenCosmosCoinContractKey gives the store key that marks conversions of the given contract as frozen
+func FrozenCosmosCoinContractKey(contractAddress InternalEVMAddress) []byte {
+	return append(FrozenCosmosCoinContractKeyPrefix, contractAddress.Bytes()...)
+}
+
+// ConversionRateLimitUsageDenomPrefix gives the store key prefix under which the per block
+// conversion usage of the denom is stored
+func ConversionRateLimitUsageDenomPrefix(denom string) []byte {
+	return append(ConversionRateLimitUsageKeyPrefix, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// ConversionRateLimitUsageKey gives the store key that holds the amount of the denom converted at the block height
+func ConversionRateLimitUsageKey(denom string, height uint64) []byte {
+	return append(ConversionRateLimitUsageDenomPrefix(denom), sdk.Uint64ToBigEndian(height)...)
+}
+
+// GetConversionRecordIDBytes returns the big endian byte representation of a conversion record id
+func GetConversionRecordIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetConversionRecordIDFromBytes returns the conversion record id from its byte representation
+func GetConversionRecordIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
 // ModuleAddress is the native module address for EVM
 var ModuleEVMAddress common.Address
 
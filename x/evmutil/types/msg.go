@@ -6,6 +6,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -15,11 +17,19 @@ var (
 	_ legacytx.LegacyMsg = &MsgConvertCoinToERC20{}
 	_ sdk.Msg            = &MsgConvertERC20ToCoin{}
 	_ legacytx.LegacyMsg = &MsgConvertERC20ToCoin{}
+	_ sdk.Msg            = &MsgConvertERC20AndTransfer{}
+	_ legacytx.LegacyMsg = &MsgConvertERC20AndTransfer{}
 
 	_ sdk.Msg            = &MsgConvertCosmosCoinToERC20{}
 	_ legacytx.LegacyMsg = &MsgConvertCosmosCoinToERC20{}
 	_ sdk.Msg            = &MsgConvertCosmosCoinFromERC20{}
 	_ legacytx.LegacyMsg = &MsgConvertCosmosCoinFromERC20{}
+	_ sdk.Msg            = &MsgConvertCosmosCoinsToERC20Batch{}
+	_ legacytx.LegacyMsg = &MsgConvertCosmosCoinsToERC20Batch{}
+	_ sdk.Msg            = &MsgUpdateDeployedCosmosCoinContract{}
+	_ legacytx.LegacyMsg = &MsgUpdateDeployedCosmosCoinContract{}
+	_ sdk.Msg            = &MsgSyncERC20Metadata{}
+	_ legacytx.LegacyMsg = &MsgSyncERC20Metadata{}
 )
 
 // legacy message types
@@ -27,10 +37,21 @@ const (
 	TypeMsgConvertCoinToERC20 = "evmutil_convert_coin_to_erc20"
 	TypeMsgConvertERC20ToCoin = "evmutil_convert_erc20_to_coin"
 
+	TypeMsgConvertERC20AndTransfer = "evmutil_convert_erc20_and_transfer"
+
 	TypeMsgConvertCosmosCoinToERC20   = "evmutil_convert_cosmos_coin_to_erc20"
 	TypeMsgConvertCosmosCoinFromERC20 = "evmutil_convert_cosmos_coin_from_erc20"
+
+	TypeMsgConvertCosmosCoinsToERC20Batch = "evmutil_convert_cosmos_coins_to_erc20_batch"
+	TypeMsgSyncERC20Metadata              = "evmutil_sync_erc20_metadata"
+
+	TypeMsgUpdateDeployedCosmosCoinContract = "evmutil_update_deployed_cosmos_coin_contract"
 )
 
+// MaxCosmosCoinsBatchSize is the maximum number of coins that can be converted in
+// a single MsgConvertCosmosCoinsToERC20Batch.
+const MaxCosmosCoinsBatchSize = 20
+
 ////////////////////////////
 // EVM-native assets -> Cosmos SDK
 ////////////////////////////
@@ -159,6 +180,96 @@ func (msg MsgConvertERC20ToCoin) Type() string {
 	return TypeMsgConvertERC20ToCoin
 }
 
+// NewMsgConvertERC20AndTransfer returns a new MsgConvertERC20AndTransfer
+func NewMsgConvertERC20AndTransfer(
+	initiator InternalEVMAddress,
+	contractAddr InternalEVMAddress,
+	amount sdkmath.Int,
+	sourceChannel string,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) MsgConvertERC20AndTransfer {
+	return MsgConvertERC20AndTransfer{
+		Initiator:        initiator.String(),
+		KavaERC20Address: contractAddr.String(),
+		Amount:           amount,
+		SourceChannel:    sourceChannel,
+		Receiver:         receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgConvertERC20AndTransfer) GetSigners() []sdk.AccAddress {
+	addr := common.HexToAddress(msg.Initiator)
+	sender := sdk.AccAddress(addr.Bytes())
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgConvertERC20AndTransfer) ValidateBasic() error {
+	if !common.IsHexAddress(msg.Initiator) {
+		return errorsmod.Wrap(
+			sdkerrors.ErrInvalidAddress,
+			"initiator is not a valid hex address",
+		)
+	}
+
+	if !common.IsHexAddress(msg.KavaERC20Address) {
+		return errorsmod.Wrap(
+			sdkerrors.ErrInvalidAddress,
+			"erc20 contract address is not a valid hex address",
+		)
+	}
+
+	if msg.Amount.IsNil() || msg.Amount.LTE(sdk.ZeroInt()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "amount cannot be zero or less")
+	}
+
+	if msg.TimeoutHeight.IsZero() && msg.TimeoutTimestamp == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "timeout height and timeout timestamp cannot both be zero")
+	}
+
+	// the converted coin denom is only known on chain, so validate the rest of the
+	// transfer with a placeholder token
+	transfer := msg.NewMsgTransfer(sdk.NewCoin("placeholder", msg.Amount))
+	return transfer.ValidateBasic()
+}
+
+// NewMsgTransfer returns the IBC transfer of the given converted coin from the initiator.
+func (msg MsgConvertERC20AndTransfer) NewMsgTransfer(token sdk.Coin) *ibctransfertypes.MsgTransfer {
+	sender := sdk.AccAddress(common.HexToAddress(msg.Initiator).Bytes())
+	return ibctransfertypes.NewMsgTransfer(
+		ibctransfertypes.PortID,
+		msg.SourceChannel,
+		token,
+		sender.String(),
+		msg.Receiver,
+		msg.TimeoutHeight,
+		msg.TimeoutTimestamp,
+		msg.Memo,
+	)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgConvertERC20AndTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgConvertERC20AndTransfer) Route() string {
+	return RouterKey
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgConvertERC20AndTransfer) Type() string {
+	return TypeMsgConvertERC20AndTransfer
+}
+
 ////////////////////////////
 // Cosmos SDK-native assets -> EVM
 ////////////////////////////
@@ -264,3 +375,164 @@ func (MsgConvertCosmosCoinFromERC20) Route() string { return RouterKey }
 
 // Type implements legacytx.LegacyMsg
 func (MsgConvertCosmosCoinFromERC20) Type() string { return TypeMsgConvertCosmosCoinFromERC20 }
+
+// NewMsgConvertCosmosCoinsToERC20Batch returns a new MsgConvertCosmosCoinsToERC20Batch
+func NewMsgConvertCosmosCoinsToERC20Batch(
+	initiator string,
+	receiver string,
+	amounts sdk.Coins,
+) MsgConvertCosmosCoinsToERC20Batch {
+	return MsgConvertCosmosCoinsToERC20Batch{
+		Initiator: initiator,
+		Receiver:  receiver,
+		Amounts:   amounts,
+	}
+}
+
+// GetSigners implements types.Msg
+func (msg MsgConvertCosmosCoinsToERC20Batch) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Initiator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic implements types.Msg
+func (msg MsgConvertCosmosCoinsToERC20Batch) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Initiator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid initiator address (%s): %s", msg.Initiator, err.Error())
+	}
+
+	if !common.IsHexAddress(msg.Receiver) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "receiver is not a valid hex address (%s)", msg.Receiver)
+	}
+
+	if msg.Amounts.Empty() || !msg.Amounts.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "'%s'", msg.Amounts)
+	}
+
+	if len(msg.Amounts) > MaxCosmosCoinsBatchSize {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "batch size %d exceeds maximum of %d", len(msg.Amounts), MaxCosmosCoinsBatchSize)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg MsgConvertCosmosCoinsToERC20Batch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// Route implements legacytx.LegacyMsg
+func (MsgConvertCosmosCoinsToERC20Batch) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (MsgConvertCosmosCoinsToERC20Batch) Type() string { return TypeMsgConvertCosmosCoinsToERC20Batch }
+
+// NewMsgSyncERC20Metadata returns a new MsgSyncERC20Metadata
+func NewMsgSyncERC20Metadata(initiator string, cosmosDenom string) MsgSyncERC20Metadata {
+	return MsgSyncERC20Metadata{
+		Initiator:   initiator,
+		CosmosDenom: cosmosDenom,
+	}
+}
+
+// GetSigners implements types.Msg
+func (msg MsgSyncERC20Metadata) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Initiator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic implements types.Msg
+func (msg MsgSyncERC20Metadata) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Initiator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid initiator address (%s): %s", msg.Initiator, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(msg.CosmosDenom); err != nil {
+		return errorsmod.Wrap(ErrInvalidCosmosDenom, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg MsgSyncERC20Metadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// Route implements legacytx.LegacyMsg
+func (MsgSyncERC20Metadata) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (MsgSyncERC20Metadata) Type() string { return TypeMsgSyncERC20Metadata }
+
+////////////////////////////
+// Governance
+////////////////////////////
+
+// NewMsgUpdateDeployedCosmosCoinContract returns a new MsgUpdateDeployedCosmosCoinContract
+func NewMsgUpdateDeployedCosmosCoinContract(
+	authority string,
+	cosmosDenom string,
+	contractAddress string,
+	freeze bool,
+) MsgUpdateDeployedCosmosCoinContract {
+	return MsgUpdateDeployedCosmosCoinContract{
+		Authority:       authority,
+		CosmosDenom:     cosmosDenom,
+		ContractAddress: contractAddress,
+		Freeze:          freeze,
+	}
+}
+
+// GetSigners implements types.Msg
+func (msg MsgUpdateDeployedCosmosCoinContract) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic implements types.Msg
+func (msg MsgUpdateDeployedCosmosCoinContract) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s): %s", msg.Authority, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(msg.CosmosDenom); err != nil {
+		return errorsmod.Wrap(ErrInvalidCosmosDenom, err.Error())
+	}
+
+	if msg.ContractAddress != "" {
+		if !common.IsHexAddress(msg.ContractAddress) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "contract address is not a valid hex address (%s)", msg.ContractAddress)
+		}
+		if common.HexToAddress(msg.ContractAddress) == (common.Address{}) {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "contract address cannot be the zero address")
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg MsgUpdateDeployedCosmosCoinContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// Route implements legacytx.LegacyMsg
+func (MsgUpdateDeployedCosmosCoinContract) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (MsgUpdateDeployedCosmosCoinContract) Type() string {
+	return TypeMsgUpdateDeployedCosmosCoinContract
+}
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/kava-labs/kava/app"
@@ -10,6 +11,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 )
 
 func TestMsgConvertCoinToERC20(t *testing.T) {
@@ -209,6 +211,112 @@ func TestMsgConvertERC20ToCoin(t *testing.T) {
 	}
 }
 
+func TestMsgConvertERC20AndTransfer(t *testing.T) {
+	app.SetSDKConfig()
+
+	validMsg := func() types.MsgConvertERC20AndTransfer {
+		return types.MsgConvertERC20AndTransfer{
+			Initiator:        "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2",
+			KavaERC20Address: "0x404F9466d758eA33eA84CeBE9E444b06533b369e",
+			Amount:           sdkmath.NewInt(1234),
+			SourceChannel:    "channel-0",
+			Receiver:         "cosmos1qy352eufqy352eufqy352eufqy35qqqptw34ca",
+			TimeoutHeight:    clienttypes.NewHeight(1, 100),
+			TimeoutTimestamp: 0,
+			Memo:             "memo",
+		}
+	}
+
+	tests := []struct {
+		name     string
+		malleate func(*types.MsgConvertERC20AndTransfer)
+		contains string
+	}{
+		{
+			"valid",
+			func(*types.MsgConvertERC20AndTransfer) {},
+			"",
+		},
+		{
+			"valid - timeout timestamp only",
+			func(msg *types.MsgConvertERC20AndTransfer) {
+				msg.TimeoutHeight = clienttypes.ZeroHeight()
+				msg.TimeoutTimestamp = 1
+			},
+			"",
+		},
+		{
+			"invalid - initiator address",
+			func(msg *types.MsgConvertERC20AndTransfer) {
+				msg.Initiator = "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc"
+			},
+			"initiator is not a valid hex address",
+		},
+		{
+			"invalid - contract address",
+			func(msg *types.MsgConvertERC20AndTransfer) {
+				msg.KavaERC20Address = "0x404F9466d758eA33eA84CeBE9E444b06533b369"
+			},
+			"erc20 contract address is not a valid hex address",
+		},
+		{
+			"invalid - zero amount",
+			func(msg *types.MsgConvertERC20AndTransfer) {
+				msg.Amount = sdkmath.ZeroInt()
+			},
+			"amount cannot be zero or less",
+		},
+		{
+			"invalid - no timeout",
+			func(msg *types.MsgConvertERC20AndTransfer) {
+				msg.TimeoutHeight = clienttypes.ZeroHeight()
+				msg.TimeoutTimestamp = 0
+			},
+			"timeout height and timeout timestamp cannot both be zero",
+		},
+		{
+			"invalid - source channel",
+			func(msg *types.MsgConvertERC20AndTransfer) {
+				msg.SourceChannel = "x"
+			},
+			"invalid source channel ID",
+		},
+		{
+			"invalid - empty receiver",
+			func(msg *types.MsgConvertERC20AndTransfer) {
+				msg.Receiver = ""
+			},
+			"missing recipient address",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := validMsg()
+			tc.malleate(&msg)
+			err := msg.ValidateBasic()
+
+			if tc.contains == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.contains)
+			}
+		})
+	}
+}
+
+func TestMsgConvertERC20AndTransfer_GetSigners(t *testing.T) {
+	app.SetSDKConfig()
+
+	initiator := testutil.RandomInternalEVMAddress()
+	msg := types.NewMsgConvertERC20AndTransfer(
+		initiator, testutil.RandomInternalEVMAddress(), sdkmath.NewInt(1),
+		"channel-0", "receiver", clienttypes.NewHeight(1, 100), 0, "",
+	)
+	require.Equal(t, []sdk.AccAddress{sdk.AccAddress(initiator.Bytes())}, msg.GetSigners())
+}
+
 func TestConvertCosmosCoinToERC20_ValidateBasic(t *testing.T) {
 	validKavaAddr := app.RandomAddress()
 	validHexAddr, _ := testutil.RandomEvmAccount()
@@ -320,6 +428,95 @@ func TestConvertCosmosCoinToERC20_GetSigners(t *testing.T) {
 	})
 }
 
+func TestConvertCosmosCoinsToERC20Batch_ValidateBasic(t *testing.T) {
+	validKavaAddr := app.RandomAddress()
+	validHexAddr, _ := testutil.RandomEvmAccount()
+	validAmounts := sdk.NewCoins(sdk.NewInt64Coin("hard", 5e3), sdk.NewInt64Coin("magic", 1e3))
+
+	tooManyAmounts := sdk.NewCoins()
+	for i := 0; i <= types.MaxCosmosCoinsBatchSize; i++ {
+		tooManyAmounts = tooManyAmounts.Add(sdk.NewInt64Coin(fmt.Sprintf("denom%d", i), 1))
+	}
+
+	testCases := []struct {
+		name        string
+		initiator   string
+		receiver    string
+		amounts     sdk.Coins
+		expectedErr string
+	}{
+		{
+			name:        "valid",
+			initiator:   validKavaAddr.String(),
+			receiver:    validHexAddr.String(),
+			amounts:     validAmounts,
+			expectedErr: "",
+		},
+		{
+			name:        "invalid - invalid initiator",
+			initiator:   "not-an-address",
+			receiver:    validHexAddr.String(),
+			amounts:     validAmounts,
+			expectedErr: "invalid initiator address",
+		},
+		{
+			name:        "invalid - sending to kava addr",
+			initiator:   validKavaAddr.String(),
+			receiver:    app.RandomAddress().String(),
+			amounts:     validAmounts,
+			expectedErr: "receiver is not a valid hex address",
+		},
+		{
+			name:        "invalid - empty amounts",
+			initiator:   validKavaAddr.String(),
+			receiver:    validHexAddr.String(),
+			amounts:     sdk.NewCoins(),
+			expectedErr: "invalid coins",
+		},
+		{
+			name:        "invalid - duplicate denoms",
+			initiator:   validKavaAddr.String(),
+			receiver:    validHexAddr.String(),
+			amounts:     sdk.Coins{sdk.NewInt64Coin("hard", 1), sdk.NewInt64Coin("hard", 2)},
+			expectedErr: "invalid coins",
+		},
+		{
+			name:        "invalid - zero amount",
+			initiator:   validKavaAddr.String(),
+			receiver:    validHexAddr.String(),
+			amounts:     sdk.Coins{sdk.NewInt64Coin("hard", 0)},
+			expectedErr: "invalid coins",
+		},
+		{
+			name:        "invalid - too many coins",
+			initiator:   validKavaAddr.String(),
+			receiver:    validHexAddr.String(),
+			amounts:     tooManyAmounts,
+			expectedErr: "exceeds maximum",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgConvertCosmosCoinsToERC20Batch(
+				tc.initiator,
+				tc.receiver,
+				tc.amounts,
+			)
+			err := msg.ValidateBasic()
+
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "evmutil", msg.Route())
+				require.Equal(t, "evmutil_convert_cosmos_coins_to_erc20_batch", msg.Type())
+				require.NotPanics(t, func() { _ = msg.GetSignBytes() })
+			}
+		})
+	}
+}
+
 func TestConvertCosmosCoinFromERC20_ValidateBasic(t *testing.T) {
 	validHexAddr := testutil.RandomEvmAddress()
 	validKavaAddr := app.RandomAddress()
@@ -431,3 +628,126 @@ func TestConvertCosmosCoinFromERC20_GetSigners(t *testing.T) {
 		})
 	})
 }
+
+func TestUpdateDeployedCosmosCoinContract_ValidateBasic(t *testing.T) {
+	validAuthority := app.RandomAddress().String()
+	validHexAddr := testutil.RandomEvmAddress().Hex()
+
+	testCases := []struct {
+		name            string
+		authority       string
+		cosmosDenom     string
+		contractAddress string
+		expectedErr     string
+	}{
+		{
+			name:            "valid - repoint",
+			authority:       validAuthority,
+			cosmosDenom:     "magic",
+			contractAddress: validHexAddr,
+			expectedErr:     "",
+		},
+		{
+			name:            "valid - no new contract",
+			authority:       validAuthority,
+			cosmosDenom:     "magic",
+			contractAddress: "",
+			expectedErr:     "",
+		},
+		{
+			name:            "invalid - bad authority",
+			authority:       "not-an-address",
+			cosmosDenom:     "magic",
+			contractAddress: validHexAddr,
+			expectedErr:     "invalid authority address",
+		},
+		{
+			name:            "invalid - bad denom",
+			authority:       validAuthority,
+			cosmosDenom:     "",
+			contractAddress: validHexAddr,
+			expectedErr:     "invalid cosmos denom",
+		},
+		{
+			name:            "invalid - kava addr contract",
+			authority:       validAuthority,
+			cosmosDenom:     "magic",
+			contractAddress: app.RandomAddress().String(),
+			expectedErr:     "contract address is not a valid hex address",
+		},
+		{
+			name:            "invalid - zero address contract",
+			authority:       validAuthority,
+			cosmosDenom:     "magic",
+			contractAddress: "0x0000000000000000000000000000000000000000",
+			expectedErr:     "contract address cannot be the zero address",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgUpdateDeployedCosmosCoinContract(
+				tc.authority,
+				tc.cosmosDenom,
+				tc.contractAddress,
+				true,
+			)
+			err := msg.ValidateBasic()
+
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "evmutil", msg.Route())
+				require.Equal(t, "evmutil_update_deployed_cosmos_coin_contract", msg.Type())
+				require.NotPanics(t, func() { _ = msg.GetSignBytes() })
+			}
+		})
+	}
+}
+
+func TestSyncERC20Metadata_ValidateBasic(t *testing.T) {
+	validKavaAddr := app.RandomAddress()
+
+	testCases := []struct {
+		name        string
+		initiator   string
+		cosmosDenom string
+		expectedErr string
+	}{
+		{
+			name:        "valid",
+			initiator:   validKavaAddr.String(),
+			cosmosDenom: "magic",
+			expectedErr: "",
+		},
+		{
+			name:        "invalid - invalid initiator",
+			initiator:   "not-an-address",
+			cosmosDenom: "magic",
+			expectedErr: "invalid initiator address",
+		},
+		{
+			name:        "invalid - invalid denom",
+			initiator:   validKavaAddr.String(),
+			cosmosDenom: "",
+			expectedErr: "invalid cosmos denom",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgSyncERC20Metadata(tc.initiator, tc.cosmosDenom)
+			err := msg.ValidateBasic()
+
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "evmutil", msg.Route())
+				require.Equal(t, "evmutil_sync_erc20_metadata", msg.Type())
+				require.NotPanics(t, func() { _ = msg.GetSignBytes() })
+			}
+		})
+	}
+}
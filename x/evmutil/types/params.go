@@ -1,15 +1,38 @@
 package types
 
 import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Parameter keys and default values
 var (
-	KeyEnabledConversionPairs  = []byte("EnabledConversionPairs")
-	DefaultConversionPairs     = ConversionPairs{}
-	KeyAllowedCosmosDenoms     = []byte("AllowedCosmosDenoms")
-	DefaultAllowedCosmosDenoms = AllowedCosmosCoinERC20Tokens{}
+	KeyEnabledConversionPairs     = []byte("EnabledConversionPairs")
+	DefaultConversionPairs        = ConversionPairs{}
+	KeyAllowedCosmosDenoms        = []byte("AllowedCosmosDenoms")
+	DefaultAllowedCosmosDenoms    = AllowedCosmosCoinERC20Tokens{}
+	KeyAutoDeployCosmosDenoms     = []byte("AutoDeployCosmosDenoms")
+	DefaultAutoDeployCosmosDenoms = []string{}
+	KeyEvmDenom                   = []byte("EvmDenom")
+	KeyCosmosDenom                = []byte("CosmosDenom")
+	KeyConversionMultiplier       = []byte("ConversionMultiplier")
+	DefaultConversionMultiplier   = sdkmath.NewInt(1_000_000_000_000)
+	KeyConversionRateLimits       = []byte("ConversionRateLimits")
+	DefaultConversionRateLimits   = ConversionRateLimits{}
+	KeyEnableDustSweep            = []byte("EnableDustSweep")
+	DefaultEnableDustSweep        = false
+	KeyDustSweepThreshold         = []byte("DustSweepThreshold")
+	DefaultDustSweepThreshold     = sdkmath.ZeroInt()
+)
+
+const (
+	// DefaultEvmDenom is the default gas denom used by the evm
+	DefaultEvmDenom = "akava"
+	// DefaultCosmosDenom is the default gas denom used by the kava app
+	DefaultCosmosDenom = "ukava"
 )
 
 // ParamKeyTable for evmutil module.
@@ -23,10 +46,18 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyEnabledConversionPairs, &p.EnabledConversionPairs, validateConversionPairs),
 		paramtypes.NewParamSetPair(KeyAllowedCosmosDenoms, &p.AllowedCosmosDenoms, validateAllowedCosmosCoinERC20Tokens),
+		paramtypes.NewParamSetPair(KeyAutoDeployCosmosDenoms, &p.AutoDeployCosmosDenoms, validateAutoDeployCosmosDenoms),
+		paramtypes.NewParamSetPair(KeyEvmDenom, &p.EvmDenom, validateDenom),
+		paramtypes.NewParamSetPair(KeyCosmosDenom, &p.CosmosDenom, validateDenom),
+		paramtypes.NewParamSetPair(KeyConversionMultiplier, &p.ConversionMultiplier, validateConversionMultiplier),
+		paramtypes.NewParamSetPair(KeyConversionRateLimits, &p.ConversionRateLimits, validateConversionRateLimits),
+		paramtypes.NewParamSetPair(KeyEnableDustSweep, &p.EnableDustSweep, validateEnableDustSweep),
+		paramtypes.NewParamSetPair(KeyDustSweepThreshold, &p.DustSweepThreshold, validateDustSweepThreshold),
 	}
 }
 
-// NewParams returns new evmutil module Params.
+// NewParams returns new evmutil module Params using the default evm denom,
+// cosmos denom, and conversion multiplier.
 func NewParams(
 	conversionPairs ConversionPairs,
 	allowedCosmosDenoms AllowedCosmosCoinERC20Tokens,
@@ -34,6 +65,10 @@ func NewParams(
 	return Params{
 		EnabledConversionPairs: conversionPairs,
 		AllowedCosmosDenoms:    allowedCosmosDenoms,
+		EvmDenom:               DefaultEvmDenom,
+		CosmosDenom:            DefaultCosmosDenom,
+		ConversionMultiplier:   DefaultConversionMultiplier,
+		DustSweepThreshold:     DefaultDustSweepThreshold,
 	}
 }
 
@@ -53,5 +88,102 @@ func (p *Params) Validate() error {
 	if err := p.AllowedCosmosDenoms.Validate(); err != nil {
 		return err
 	}
+	if err := validateAutoDeployCosmosDenoms(p.AutoDeployCosmosDenoms); err != nil {
+		return err
+	}
+	if err := validateDenom(p.EvmDenom); err != nil {
+		return err
+	}
+	if err := validateDenom(p.CosmosDenom); err != nil {
+		return err
+	}
+	if p.EvmDenom == p.CosmosDenom {
+		return fmt.Errorf("evm denom and cosmos denom must be different: %s", p.EvmDenom)
+	}
+	if err := validateConversionMultiplier(p.ConversionMultiplier); err != nil {
+		return err
+	}
+	if err := p.ConversionRateLimits.Validate(); err != nil {
+		return err
+	}
+	if err := validateDustSweepThreshold(p.DustSweepThreshold); err != nil {
+		return err
+	}
+	if p.DustSweepThreshold.GT(p.ConversionMultiplier) {
+		return fmt.Errorf(
+			"dust sweep threshold %s must not be greater than the conversion multiplier %s",
+			p.DustSweepThreshold, p.ConversionMultiplier,
+		)
+	}
+	return nil
+}
+
+// IsAutoDeployCosmosDenom returns true if the denom's ERC20 contract should be
+// deployed automatically on first IBC receipt.
+func (p Params) IsAutoDeployCosmosDenom(denom string) bool {
+	for _, d := range p.AutoDeployCosmosDenoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+func validateAutoDeployCosmosDenoms(i interface{}) error {
+	denoms, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid auto deploy denom %s: %w", denom, err)
+		}
+		if seen[denom] {
+			return fmt.Errorf("found duplicate auto deploy denom %s", denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
+
+func validateEnableDustSweep(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateDustSweepThreshold(i interface{}) error {
+	threshold, ok := i.(sdkmath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if threshold.IsNil() || threshold.IsNegative() {
+		return fmt.Errorf("dust sweep threshold must be non-negative: %s", threshold)
+	}
+	return nil
+}
+
+func validateDenom(i interface{}) error {
+	denom, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return sdk.ValidateDenom(denom)
+}
+
+func validateConversionMultiplier(i interface{}) error {
+	multiplier, ok := i.(sdkmath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if multiplier.IsNil() || !multiplier.IsPositive() {
+		return fmt.Errorf("conversion multiplier must be positive: %s", multiplier)
+	}
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"sigs.k8s.io/yaml"
 
+	sdkmath "cosmossdk.io/math"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/kava-labs/kava/app"
@@ -97,6 +98,127 @@ func (suite *ParamsTestSuite) TestParamSetPairs_AllowedCosmosDenoms() {
 	suite.Require().EqualError(paramSetPair.ValidatorFn(struct{}{}), "invalid parameter type: struct {}")
 }
 
+func (suite *ParamsTestSuite) TestParamSetPairs_AutoDeployCosmosDenoms() {
+	suite.Require().Equal([]byte("AutoDeployCosmosDenoms"), types.KeyAutoDeployCosmosDenoms)
+	defaultParams := types.DefaultParams()
+
+	var paramSetPair *paramstypes.ParamSetPair
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if bytes.Equal(pair.Key, types.KeyAutoDeployCosmosDenoms) {
+			paramSetPair = &pair
+			break
+		}
+	}
+	suite.Require().NotNil(paramSetPair)
+
+	denoms, ok := paramSetPair.Value.(*[]string)
+	suite.Require().True(ok)
+	suite.Require().Equal(denoms, &defaultParams.AutoDeployCosmosDenoms)
+
+	suite.Require().Nil(paramSetPair.ValidatorFn(*denoms))
+	suite.Require().EqualError(paramSetPair.ValidatorFn(struct{}{}), "invalid parameter type: struct {}")
+	suite.Require().ErrorContains(paramSetPair.ValidatorFn([]string{"hard", "hard"}), "found duplicate auto deploy denom")
+	suite.Require().ErrorContains(paramSetPair.ValidatorFn([]string{""}), "invalid auto deploy denom")
+}
+
+func (suite *ParamsTestSuite) TestParamSetPairs_Denoms() {
+	suite.Require().Equal([]byte("EvmDenom"), types.KeyEvmDenom)
+	suite.Require().Equal([]byte("CosmosDenom"), types.KeyCosmosDenom)
+	defaultParams := types.DefaultParams()
+	suite.Require().Equal("akava", defaultParams.EvmDenom)
+	suite.Require().Equal("ukava", defaultParams.CosmosDenom)
+
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if !bytes.Equal(pair.Key, types.KeyEvmDenom) && !bytes.Equal(pair.Key, types.KeyCosmosDenom) {
+			continue
+		}
+		denom, ok := pair.Value.(*string)
+		suite.Require().True(ok)
+		suite.Require().Nil(pair.ValidatorFn(*denom))
+		suite.Require().EqualError(pair.ValidatorFn(struct{}{}), "invalid parameter type: struct {}")
+		suite.Require().Error(pair.ValidatorFn(""))
+	}
+}
+
+func (suite *ParamsTestSuite) TestParamSetPairs_ConversionMultiplier() {
+	suite.Require().Equal([]byte("ConversionMultiplier"), types.KeyConversionMultiplier)
+	defaultParams := types.DefaultParams()
+	suite.Require().Equal(sdkmath.NewInt(1_000_000_000_000), defaultParams.ConversionMultiplier)
+
+	var paramSetPair *paramstypes.ParamSetPair
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if bytes.Equal(pair.Key, types.KeyConversionMultiplier) {
+			paramSetPair = &pair
+			break
+		}
+	}
+	suite.Require().NotNil(paramSetPair)
+
+	multiplier, ok := paramSetPair.Value.(*sdkmath.Int)
+	suite.Require().True(ok)
+	suite.Require().Equal(multiplier, &defaultParams.ConversionMultiplier)
+
+	suite.Require().Nil(paramSetPair.ValidatorFn(*multiplier))
+	suite.Require().EqualError(paramSetPair.ValidatorFn(struct{}{}), "invalid parameter type: struct {}")
+	suite.Require().ErrorContains(paramSetPair.ValidatorFn(sdkmath.ZeroInt()), "conversion multiplier must be positive")
+	suite.Require().ErrorContains(paramSetPair.ValidatorFn(sdkmath.NewInt(-1)), "conversion multiplier must be positive")
+}
+
+func (suite *ParamsTestSuite) TestParamSetPairs_EnableDustSweep() {
+	suite.Require().Equal([]byte("EnableDustSweep"), types.KeyEnableDustSweep)
+	defaultParams := types.DefaultParams()
+	suite.Require().False(defaultParams.EnableDustSweep)
+
+	var paramSetPair *paramstypes.ParamSetPair
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if bytes.Equal(pair.Key, types.KeyEnableDustSweep) {
+			paramSetPair = &pair
+			break
+		}
+	}
+	suite.Require().NotNil(paramSetPair)
+
+	enabled, ok := paramSetPair.Value.(*bool)
+	suite.Require().True(ok)
+	suite.Require().Equal(enabled, &defaultParams.EnableDustSweep)
+
+	suite.Require().Nil(paramSetPair.ValidatorFn(true))
+	suite.Require().EqualError(paramSetPair.ValidatorFn(struct{}{}), "invalid parameter type: struct {}")
+}
+
+func (suite *ParamsTestSuite) TestParamSetPairs_DustSweepThreshold() {
+	suite.Require().Equal([]byte("DustSweepThreshold"), types.KeyDustSweepThreshold)
+	defaultParams := types.DefaultParams()
+	suite.Require().True(defaultParams.DustSweepThreshold.IsZero())
+
+	var paramSetPair *paramstypes.ParamSetPair
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if bytes.Equal(pair.Key, types.KeyDustSweepThreshold) {
+			paramSetPair = &pair
+			break
+		}
+	}
+	suite.Require().NotNil(paramSetPair)
+
+	threshold, ok := paramSetPair.Value.(*sdkmath.Int)
+	suite.Require().True(ok)
+	suite.Require().Equal(threshold, &defaultParams.DustSweepThreshold)
+
+	suite.Require().Nil(paramSetPair.ValidatorFn(*threshold))
+	suite.Require().Nil(paramSetPair.ValidatorFn(sdkmath.NewInt(1e6)))
+	suite.Require().EqualError(paramSetPair.ValidatorFn(struct{}{}), "invalid parameter type: struct {}")
+	suite.Require().ErrorContains(paramSetPair.ValidatorFn(sdkmath.NewInt(-1)), "dust sweep threshold must be non-negative")
+}
+
+func (suite *ParamsTestSuite) TestParams_IsAutoDeployCosmosDenom() {
+	params := types.DefaultParams()
+	suite.False(params.IsAutoDeployCosmosDenom("hard"))
+
+	params.AutoDeployCosmosDenoms = []string{"hard"}
+	suite.True(params.IsAutoDeployCosmosDenom("hard"))
+	suite.False(params.IsAutoDeployCosmosDenom("swp"))
+}
+
 func (suite *ParamsTestSuite) TestParams_Validate() {
 	validConversionPairs := types.NewConversionPairs(
 		types.NewConversionPair(
@@ -146,6 +268,63 @@ func (suite *ParamsTestSuite) TestParams_Validate() {
 			params: types.NewParams(validConversionPairs, invalidAllowedCosmosDenoms),
 			expErr: "invalid token",
 		},
+		{
+			name: "invalid - empty evm denom",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.EvmDenom = ""
+				return p
+			}(),
+			expErr: "invalid denom",
+		},
+		{
+			name: "invalid - evm denom same as cosmos denom",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.EvmDenom = p.CosmosDenom
+				return p
+			}(),
+			expErr: "evm denom and cosmos denom must be different",
+		},
+		{
+			name: "invalid - zero conversion multiplier",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.ConversionMultiplier = sdkmath.ZeroInt()
+				return p
+			}(),
+			expErr: "conversion multiplier must be positive",
+		},
+		{
+			name: "invalid - duplicate conversion rate limit",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.ConversionRateLimits = types.NewConversionRateLimits(
+					types.NewConversionRateLimit("hard", sdkmath.NewInt(100), 10),
+					types.NewConversionRateLimit("hard", sdkmath.NewInt(200), 10),
+				)
+				return p
+			}(),
+			expErr: "found duplicate conversion rate limit denom",
+		},
+		{
+			name: "valid - dust sweep threshold equal to conversion multiplier",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.DustSweepThreshold = p.ConversionMultiplier
+				return p
+			}(),
+			expErr: "",
+		},
+		{
+			name: "invalid - dust sweep threshold greater than conversion multiplier",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.DustSweepThreshold = p.ConversionMultiplier.AddRaw(1)
+				return p
+			}(),
+			expErr: "dust sweep threshold 1000000000001 must not be greater than the conversion multiplier",
+		},
 	}
 
 	for _, tc := range testCases {
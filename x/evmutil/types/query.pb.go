@@ -5,7 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -214,24 +217,22 @@ func (m *QueryDeployedCosmosCoinContractsResponse) GetPagination() *query.PageRe
 	return nil
 }
 
-// DeployedCosmosCoinContract defines a deployed token contract to the evm representing a native cosmos-sdk coin
-type DeployedCosmosCoinContract struct {
-	CosmosDenom string              `protobuf:"bytes,1,opt,name=cosmos_denom,json=cosmosDenom,proto3" json:"cosmos_denom,omitempty"`
-	Address     *InternalEVMAddress `protobuf:"bytes,2,opt,name=address,proto3,customtype=InternalEVMAddress" json:"address,omitempty"`
+// QueryBackingStatusRequest defines the request type for querying the akava backing status.
+type QueryBackingStatusRequest struct {
 }
 
-func (m *DeployedCosmosCoinContract) Reset()         { *m = DeployedCosmosCoinContract{} }
-func (m *DeployedCosmosCoinContract) String() string { return proto.CompactTextString(m) }
-func (*DeployedCosmosCoinContract) ProtoMessage()    {}
-func (*DeployedCosmosCoinContract) Descriptor() ([]byte, []int) {
+func (m *QueryBackingStatusRequest) Reset()         { *m = QueryBackingStatusRequest{} }
+func (m *QueryBackingStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBackingStatusRequest) ProtoMessage()    {}
+func (*QueryBackingStatusRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4a8d0512331709e7, []int{4}
 }
-func (m *DeployedCosmosCoinContract) XXX_Unmarshal(b []byte) error {
+func (m *QueryBackingStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeployedCosmosCoinContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBackingStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeployedCosmosCoinContract.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBackingStatusRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -241,71 +242,474 @@ func (m *DeployedCosmosCoinContract) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *DeployedCosmosCoinContract) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeployedCosmosCoinContract.Merge(m, src)
+func (m *QueryBackingStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBackingStatusRequest.Merge(m, src)
 }
-func (m *DeployedCosmosCoinContract) XXX_Size() int {
+func (m *QueryBackingStatusRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeployedCosmosCoinContract) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeployedCosmosCoinContract.DiscardUnknown(m)
+func (m *QueryBackingStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBackingStatusRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeployedCosmosCoinContract proto.InternalMessageInfo
+var xxx_messageInfo_QueryBackingStatusRequest proto.InternalMessageInfo
 
-func (m *DeployedCosmosCoinContract) GetCosmosDenom() string {
+// QueryBackingStatusResponse defines the response type for querying the akava backing status.
+type QueryBackingStatusResponse struct {
+	// total_fractional_balances is the sum of all fractional akava balances held in the module.
+	TotalFractionalBalances cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=total_fractional_balances,json=totalFractionalBalances,proto3,customtype=cosmossdk.io/math.Int" json:"total_fractional_balances"`
+	// module_balance is the ukava balance of the evmutil module account.
+	ModuleBalance types.Coin `protobuf:"bytes,2,opt,name=module_balance,json=moduleBalance,proto3" json:"module_balance"`
+	// fully_backed is true when the module balance covers all fractional balances.
+	FullyBacked bool `protobuf:"varint,3,opt,name=fully_backed,json=fullyBacked,proto3" json:"fully_backed,omitempty"`
+}
+
+func (m *QueryBackingStatusResponse) Reset()         { *m = QueryBackingStatusResponse{} }
+func (m *QueryBackingStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBackingStatusResponse) ProtoMessage()    {}
+func (*QueryBackingStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d0512331709e7, []int{5}
+}
+func (m *QueryBackingStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBackingStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBackingStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBackingStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBackingStatusResponse.Merge(m, src)
+}
+func (m *QueryBackingStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBackingStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBackingStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBackingStatusResponse proto.InternalMessageInfo
+
+func (m *QueryBackingStatusResponse) GetModuleBalance() types.Coin {
+	if m != nil {
+		return m.ModuleBalance
+	}
+	return types.Coin{}
+}
+
+func (m *QueryBackingStatusResponse) GetFullyBacked() bool {
+	if m != nil {
+		return m.FullyBacked
+	}
+	return false
+}
+
+// QueryConversionRecordsRequest defines the request type for Query/ConversionRecords method.
+type QueryConversionRecordsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConversionRecordsRequest) Reset()         { *m = QueryConversionRecordsRequest{} }
+func (m *QueryConversionRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConversionRecordsRequest) ProtoMessage()    {}
+func (*QueryConversionRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d0512331709e7, []int{6}
+}
+func (m *QueryConversionRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConversionRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConversionRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConversionRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConversionRecordsRequest.Merge(m, src)
+}
+func (m *QueryConversionRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConversionRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConversionRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConversionRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryConversionRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryConversionRecordsResponse defines the response type for Query/ConversionRecords method.
+type QueryConversionRecordsResponse struct {
+	// records is a list of conversion records, ordered by id
+	Records []ConversionRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConversionRecordsResponse) Reset()         { *m = QueryConversionRecordsResponse{} }
+func (m *QueryConversionRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConversionRecordsResponse) ProtoMessage()    {}
+func (*QueryConversionRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d0512331709e7, []int{7}
+}
+func (m *QueryConversionRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConversionRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConversionRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConversionRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConversionRecordsResponse.Merge(m, src)
+}
+func (m *QueryConversionRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConversionRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConversionRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConversionRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryConversionRecordsResponse) GetRecords() []ConversionRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryConversionRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCosmosCoinERC20BalanceRequest defines the request type for Query/CosmosCoinERC20Balance method.
+type QueryCosmosCoinERC20BalanceRequest struct {
+	// cosmos_denom is the denom of the sdk.Coin whose deployed ERC20 contract is queried.
+	CosmosDenom string `protobuf:"bytes,1,opt,name=cosmos_denom,json=cosmosDenom,proto3" json:"cosmos_denom,omitempty"`
+	// address is the account to query, either as a bech32 or a hex address.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryCosmosCoinERC20BalanceRequest) Reset()         { *m = QueryCosmosCoinERC20BalanceRequest{} }
+func (m *QueryCosmosCoinERC20BalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCosmosCoinERC20BalanceRequest) ProtoMessage()    {}
+func (*QueryCosmosCoinERC20BalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d0512331709e7, []int{8}
+}
+func (m *QueryCosmosCoinERC20BalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCosmosCoinERC20BalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCosmosCoinERC20BalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCosmosCoinERC20BalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCosmosCoinERC20BalanceRequest.Merge(m, src)
+}
+func (m *QueryCosmosCoinERC20BalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCosmosCoinERC20BalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCosmosCoinERC20BalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCosmosCoinERC20BalanceRequest proto.InternalMessageInfo
+
+func (m *QueryCosmosCoinERC20BalanceRequest) GetCosmosDenom() string {
 	if m != nil {
 		return m.CosmosDenom
 	}
 	return ""
 }
 
+func (m *QueryCosmosCoinERC20BalanceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryCosmosCoinERC20BalanceResponse defines the response type for Query/CosmosCoinERC20Balance method.
+type QueryCosmosCoinERC20BalanceResponse struct {
+	// contract_address is the hex address of the deployed ERC20 contract for the denom.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// erc20_balance is the balanceOf the address in the deployed ERC20 contract.
+	Erc20Balance cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=erc20_balance,json=erc20Balance,proto3,customtype=cosmossdk.io/math.Int" json:"erc20_balance"`
+	// native_balance is the sdk.Coin balance of the address for the denom.
+	NativeBalance types.Coin `protobuf:"bytes,3,opt,name=native_balance,json=nativeBalance,proto3" json:"native_balance"`
+	// module_locked_balance is the sdk.Coin amount locked in the module account backing all ERC20 tokens for the denom.
+	ModuleLockedBalance types.Coin `protobuf:"bytes,4,opt,name=module_locked_balance,json=moduleLockedBalance,proto3" json:"module_locked_balance"`
+}
+
+func (m *QueryCosmosCoinERC20BalanceResponse) Reset()         { *m = QueryCosmosCoinERC20BalanceResponse{} }
+func (m *QueryCosmosCoinERC20BalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCosmosCoinERC20BalanceResponse) ProtoMessage()    {}
+func (*QueryCosmosCoinERC20BalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d0512331709e7, []int{9}
+}
+func (m *QueryCosmosCoinERC20BalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCosmosCoinERC20BalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCosmosCoinERC20BalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCosmosCoinERC20BalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCosmosCoinERC20BalanceResponse.Merge(m, src)
+}
+func (m *QueryCosmosCoinERC20BalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCosmosCoinERC20BalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCosmosCoinERC20BalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCosmosCoinERC20BalanceResponse proto.InternalMessageInfo
+
+func (m *QueryCosmosCoinERC20BalanceResponse) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *QueryCosmosCoinERC20BalanceResponse) GetNativeBalance() types.Coin {
+	if m != nil {
+		return m.NativeBalance
+	}
+	return types.Coin{}
+}
+
+func (m *QueryCosmosCoinERC20BalanceResponse) GetModuleLockedBalance() types.Coin {
+	if m != nil {
+		return m.ModuleLockedBalance
+	}
+	return types.Coin{}
+}
+
+// QueryConversionRateLimitsRequest defines the request type for Query/ConversionRateLimits method.
+type QueryConversionRateLimitsRequest struct {
+	// denom optionally filters the response to the rate limit of a single denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryConversionRateLimitsRequest) Reset()         { *m = QueryConversionRateLimitsRequest{} }
+func (m *QueryConversionRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConversionRateLimitsRequest) ProtoMessage()    {}
+func (*QueryConversionRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d0512331709e7, []int{10}
+}
+func (m *QueryConversionRateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConversionRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConversionRateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConversionRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConversionRateLimitsRequest.Merge(m, src)
+}
+func (m *QueryConversionRateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConversionRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConversionRateLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConversionRateLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryConversionRateLimitsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryConversionRateLimitsResponse defines the response type for Query/ConversionRateLimits method.
+type QueryConversionRateLimitsResponse struct {
+	// usages is the usage of each conversion rate limit within its current window.
+	Usages []ConversionRateLimitUsage `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages"`
+}
+
+func (m *QueryConversionRateLimitsResponse) Reset()         { *m = QueryConversionRateLimitsResponse{} }
+func (m *QueryConversionRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConversionRateLimitsResponse) ProtoMessage()    {}
+func (*QueryConversionRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8d0512331709e7, []int{11}
+}
+func (m *QueryConversionRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConversionRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConversionRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConversionRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConversionRateLimitsResponse.Merge(m, src)
+}
+func (m *QueryConversionRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConversionRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConversionRateLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConversionRateLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryConversionRateLimitsResponse) GetUsages() []ConversionRateLimitUsage {
+	if m != nil {
+		return m.Usages
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "kava.evmutil.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "kava.evmutil.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryDeployedCosmosCoinContractsRequest)(nil), "kava.evmutil.v1beta1.QueryDeployedCosmosCoinContractsRequest")
 	proto.RegisterType((*QueryDeployedCosmosCoinContractsResponse)(nil), "kava.evmutil.v1beta1.QueryDeployedCosmosCoinContractsResponse")
-	proto.RegisterType((*DeployedCosmosCoinContract)(nil), "kava.evmutil.v1beta1.DeployedCosmosCoinContract")
+	proto.RegisterType((*QueryBackingStatusRequest)(nil), "kava.evmutil.v1beta1.QueryBackingStatusRequest")
+	proto.RegisterType((*QueryBackingStatusResponse)(nil), "kava.evmutil.v1beta1.QueryBackingStatusResponse")
+	proto.RegisterType((*QueryConversionRecordsRequest)(nil), "kava.evmutil.v1beta1.QueryConversionRecordsRequest")
+	proto.RegisterType((*QueryConversionRecordsResponse)(nil), "kava.evmutil.v1beta1.QueryConversionRecordsResponse")
+	proto.RegisterType((*QueryCosmosCoinERC20BalanceRequest)(nil), "kava.evmutil.v1beta1.QueryCosmosCoinERC20BalanceRequest")
+	proto.RegisterType((*QueryCosmosCoinERC20BalanceResponse)(nil), "kava.evmutil.v1beta1.QueryCosmosCoinERC20BalanceResponse")
+	proto.RegisterType((*QueryConversionRateLimitsRequest)(nil), "kava.evmutil.v1beta1.QueryConversionRateLimitsRequest")
+	proto.RegisterType((*QueryConversionRateLimitsResponse)(nil), "kava.evmutil.v1beta1.QueryConversionRateLimitsResponse")
 }
 
 func init() { proto.RegisterFile("kava/evmutil/v1beta1/query.proto", fileDescriptor_4a8d0512331709e7) }
 
 var fileDescriptor_4a8d0512331709e7 = []byte{
-	// 542 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0x8f, 0x0b, 0x14, 0xea, 0x8e, 0x8b, 0xa9, 0xd0, 0xd4, 0x55, 0xe9, 0x08, 0x88, 0x75, 0x48,
-	0x38, 0x5b, 0x41, 0x1c, 0x26, 0x40, 0xa2, 0x1d, 0x20, 0x0e, 0x48, 0x2c, 0x07, 0x0e, 0x5c, 0x2a,
-	0x27, 0xb1, 0x42, 0x44, 0x6a, 0xa7, 0xb1, 0x5b, 0x51, 0x71, 0x83, 0x0b, 0x47, 0x24, 0xbe, 0x40,
-	0x3f, 0xce, 0x8e, 0x93, 0xb8, 0xa0, 0x1d, 0x26, 0xd4, 0x72, 0x40, 0x9c, 0xf8, 0x08, 0xa8, 0xb6,
-	0xbb, 0x15, 0x91, 0xb6, 0x88, 0x9b, 0xf5, 0xfc, 0x7b, 0xfe, 0xfd, 0x79, 0x2f, 0x81, 0x9b, 0x6f,
-	0xc8, 0x80, 0xb8, 0x74, 0xd0, 0xed, 0xcb, 0x38, 0x71, 0x07, 0xbb, 0x3e, 0x95, 0x64, 0xd7, 0xed,
-	0xf5, 0x69, 0x36, 0xc4, 0x69, 0xc6, 0x25, 0x47, 0x95, 0x29, 0x02, 0x1b, 0x04, 0x36, 0x88, 0xea,
-	0xad, 0x80, 0x8b, 0x2e, 0x17, 0xae, 0x4f, 0x04, 0xd5, 0xf0, 0xd3, 0xe6, 0x94, 0x44, 0x31, 0x23,
-	0x32, 0xe6, 0x4c, 0xbf, 0x50, 0xad, 0x44, 0x3c, 0xe2, 0xea, 0xe8, 0x4e, 0x4f, 0xa6, 0x5a, 0x8b,
-	0x38, 0x8f, 0x12, 0xea, 0x92, 0x34, 0x76, 0x09, 0x63, 0x5c, 0xaa, 0x16, 0x61, 0x6e, 0x9d, 0x5c,
-	0x5d, 0x11, 0x65, 0x54, 0xc4, 0x06, 0xe3, 0x54, 0x20, 0x3a, 0x98, 0x32, 0xbf, 0x20, 0x19, 0xe9,
-	0x0a, 0x8f, 0xf6, 0xfa, 0x54, 0x48, 0xe7, 0x00, 0x5e, 0xf9, 0xa3, 0x2a, 0x52, 0xce, 0x04, 0x45,
-	0x7b, 0xb0, 0x98, 0xaa, 0xca, 0x3a, 0xd8, 0x04, 0x8d, 0x72, 0xb3, 0x86, 0xf3, 0x7c, 0x61, 0xdd,
-	0xd5, 0x3a, 0x7f, 0x78, 0x52, 0xb7, 0x3c, 0xd3, 0xe1, 0x8c, 0x00, 0xdc, 0x52, 0x6f, 0xee, 0xd3,
-	0x34, 0xe1, 0x43, 0x1a, 0xb6, 0x95, 0xf9, 0x36, 0x8f, 0x59, 0x9b, 0x33, 0x99, 0x91, 0x40, 0xce,
-	0xe8, 0xd1, 0x75, 0x78, 0x59, 0x47, 0xd3, 0x09, 0x29, 0xe3, 0x8a, 0xee, 0x5c, 0xa3, 0xe4, 0xad,
-	0xe9, 0xe2, 0xbe, 0xaa, 0xa1, 0x27, 0x10, 0x9e, 0xa5, 0xb4, 0x5e, 0x50, 0x82, 0x6e, 0x62, 0x0d,
-	0xc1, 0xd3, 0x48, 0xb1, 0x9e, 0xc0, 0x99, 0xaa, 0x88, 0x1a, 0x02, 0x6f, 0xae, 0x73, 0xef, 0xd2,
-	0xc7, 0x51, 0xdd, 0xfa, 0x31, 0xaa, 0x5b, 0xce, 0x2f, 0x00, 0x1b, 0xab, 0x25, 0x9a, 0x2c, 0xde,
-	0x41, 0x3b, 0x34, 0xb0, 0x8e, 0x11, 0x1b, 0xf0, 0x98, 0x75, 0x82, 0x19, 0x52, 0x89, 0x2e, 0x37,
-	0x77, 0xf2, 0x33, 0x5a, 0x4c, 0x61, 0x72, 0xdb, 0x08, 0x17, 0x8b, 0x40, 0x4f, 0x73, 0xbc, 0x6f,
-	0xad, 0xf4, 0xae, 0x95, 0xcf, 0x9b, 0x77, 0x7a, 0xb0, 0xba, 0x58, 0x09, 0xba, 0x06, 0xd7, 0xe6,
-	0xe7, 0xa0, 0xa6, 0x5e, 0xf2, 0xca, 0x73, 0x63, 0x40, 0x3b, 0xf0, 0x22, 0x09, 0xc3, 0x8c, 0x0a,
-	0xa1, 0x64, 0x94, 0x5a, 0x57, 0x8f, 0x4f, 0xea, 0xe8, 0x19, 0x93, 0x34, 0x63, 0x24, 0x79, 0xfc,
-	0xf2, 0xf9, 0x23, 0x7d, 0xeb, 0xcd, 0x60, 0xcd, 0x9f, 0x05, 0x78, 0x41, 0xa5, 0x8c, 0x3e, 0x00,
-	0x58, 0xd4, 0xbb, 0x82, 0x1a, 0xf9, 0x29, 0xfd, 0xbd, 0x9a, 0xd5, 0xed, 0x7f, 0x40, 0x6a, 0xa3,
-	0xce, 0x8d, 0xf7, 0x5f, 0xbe, 0x7f, 0x2e, 0xd8, 0xa8, 0xe6, 0xe6, 0x7e, 0x08, 0x7a, 0x31, 0xd1,
-	0x31, 0x80, 0x1b, 0x4b, 0x06, 0x8e, 0x1e, 0x2c, 0x21, 0x5c, 0xbd, 0xcb, 0xd5, 0x87, 0xff, 0xdb,
-	0x6e, 0x4c, 0xdc, 0x57, 0x26, 0xee, 0xa1, 0xbb, 0xf9, 0x26, 0x96, 0xef, 0x60, 0xab, 0x7d, 0x38,
-	0xb6, 0xc1, 0xd1, 0xd8, 0x06, 0xdf, 0xc6, 0x36, 0xf8, 0x34, 0xb1, 0xad, 0xa3, 0x89, 0x6d, 0x7d,
-	0x9d, 0xd8, 0xd6, 0xab, 0xed, 0x28, 0x96, 0xaf, 0xfb, 0x3e, 0x0e, 0x78, 0x57, 0xbd, 0x7c, 0x3b,
-	0x21, 0xbe, 0xd0, 0x1c, 0x6f, 0x4f, 0x59, 0xe4, 0x30, 0xa5, 0xc2, 0x2f, 0xaa, 0x5f, 0xc5, 0x9d,
-	0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1f, 0xfa, 0x86, 0x41, 0xe8, 0x04, 0x00, 0x00,
+	// 1042 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x15, 0xe3, 0xc4, 0xb1, 0xc7, 0x76, 0xda, 0x6e, 0x94, 0xd6, 0x96, 0x5d, 0xda, 0x66, 0x52,
+	0x47, 0x76, 0x1d, 0x52, 0x51, 0x8c, 0x34, 0x49, 0x3f, 0x80, 0x48, 0xa9, 0x8a, 0x00, 0x3e, 0x24,
+	0x0c, 0x7a, 0xe9, 0x85, 0x58, 0x91, 0x1b, 0x86, 0x30, 0xc5, 0x95, 0xc9, 0x95, 0x50, 0x23, 0xe8,
+	0xa5, 0xbd, 0xf4, 0x58, 0xa0, 0x7f, 0xc0, 0x3f, 0xa1, 0x05, 0x7a, 0x2d, 0x7a, 0xcd, 0xd1, 0x68,
+	0x2e, 0x45, 0x0e, 0x41, 0x61, 0xf7, 0xd0, 0x63, 0x7e, 0x42, 0xc1, 0xfd, 0x90, 0x25, 0x87, 0xa2,
+	0x64, 0xa3, 0x37, 0x71, 0x38, 0xf3, 0xe6, 0xbd, 0xd9, 0xc7, 0x59, 0xc1, 0xca, 0x0e, 0xee, 0x62,
+	0x8b, 0x74, 0x5b, 0x1d, 0x16, 0x84, 0x56, 0xf7, 0x66, 0x93, 0x30, 0x7c, 0xd3, 0xda, 0xed, 0x90,
+	0x78, 0xcf, 0x6c, 0xc7, 0x94, 0x51, 0x54, 0x4c, 0x33, 0x4c, 0x99, 0x61, 0xca, 0x8c, 0xd2, 0x86,
+	0x4b, 0x93, 0x16, 0x4d, 0xac, 0x26, 0x4e, 0x88, 0x48, 0xef, 0x15, 0xb7, 0xb1, 0x1f, 0x44, 0x98,
+	0x05, 0x34, 0x12, 0x08, 0x25, 0xbd, 0x3f, 0x57, 0x65, 0xb9, 0x34, 0x50, 0xef, 0x17, 0xc4, 0x7b,
+	0x87, 0x3f, 0x59, 0xe2, 0x41, 0xbe, 0x2a, 0xfa, 0xd4, 0xa7, 0x22, 0x9e, 0xfe, 0x92, 0xd1, 0x25,
+	0x9f, 0x52, 0x3f, 0x24, 0x16, 0x6e, 0x07, 0x16, 0x8e, 0x22, 0xca, 0x78, 0x37, 0x55, 0xb3, 0x91,
+	0x29, 0xc9, 0xa5, 0x51, 0x97, 0xc4, 0x49, 0x40, 0x23, 0xa7, 0x8d, 0x83, 0x58, 0xe6, 0x6e, 0x8e,
+	0xca, 0x8d, 0x89, 0x4b, 0x63, 0x4f, 0x66, 0x1b, 0x99, 0xd9, 0x3e, 0x89, 0x48, 0x12, 0xa8, 0xee,
+	0x1f, 0x65, 0xe6, 0xc4, 0x98, 0x11, 0x27, 0x0c, 0x5a, 0x01, 0x13, 0x69, 0x46, 0x11, 0xd0, 0xe3,
+	0x74, 0x6a, 0x8f, 0x70, 0x8c, 0x5b, 0x89, 0x4d, 0x76, 0x3b, 0x24, 0x61, 0xc6, 0x63, 0xb8, 0x3c,
+	0x10, 0x4d, 0xda, 0x34, 0x4a, 0x08, 0xba, 0x07, 0x93, 0x6d, 0x1e, 0x99, 0xd7, 0x56, 0xb4, 0xf2,
+	0x4c, 0x75, 0xc9, 0xcc, 0x3a, 0x13, 0x53, 0x54, 0xd5, 0xce, 0xbf, 0x78, 0xbd, 0x5c, 0xb0, 0x65,
+	0x85, 0xb1, 0xaf, 0xc1, 0x75, 0x8e, 0xf9, 0x80, 0xb4, 0x43, 0xba, 0x47, 0xbc, 0x3a, 0x9f, 0x6f,
+	0x9d, 0x06, 0x51, 0x9d, 0x46, 0x2c, 0xc6, 0x2e, 0x53, 0xed, 0xd1, 0x55, 0x98, 0x93, 0x47, 0xe1,
+	0x91, 0x88, 0xf2, 0x76, 0x13, 0xe5, 0x69, 0x7b, 0x56, 0x04, 0x1f, 0xf0, 0x18, 0x6a, 0x00, 0x1c,
+	0x9f, 0xf0, 0xfc, 0x39, 0x4e, 0x68, 0xcd, 0x94, 0xa7, 0x96, 0x1e, 0xb1, 0x29, 0xdc, 0x73, 0xcc,
+	0xca, 0x27, 0xb2, 0x81, 0xdd, 0x57, 0x79, 0x6f, 0xea, 0xc7, 0xfd, 0xe5, 0xc2, 0xbf, 0xfb, 0xcb,
+	0x05, 0xe3, 0x8d, 0x06, 0xe5, 0xd1, 0x14, 0xe5, 0x2c, 0x9e, 0x83, 0xee, 0xc9, 0x34, 0x47, 0x92,
+	0x4d, 0xad, 0xe4, 0xb8, 0x2a, 0x93, 0x93, 0x9e, 0xa9, 0x56, 0xb2, 0x67, 0x34, 0xbc, 0x85, 0x9c,
+	0xdb, 0xa2, 0x37, 0x9c, 0x04, 0xfa, 0x2a, 0x43, 0xfb, 0xf5, 0x91, 0xda, 0x05, 0xf3, 0x7e, 0xf1,
+	0xc6, 0x22, 0x2c, 0x70, 0xc5, 0x35, 0xec, 0xee, 0x04, 0x91, 0xff, 0x84, 0x61, 0xd6, 0xe9, 0xb9,
+	0xe0, 0x8d, 0x06, 0xa5, 0xac, 0xb7, 0x72, 0x02, 0x3e, 0x2c, 0x30, 0xca, 0x70, 0xe8, 0x3c, 0x4d,
+	0x49, 0x05, 0x34, 0xc2, 0xa1, 0xd3, 0xc4, 0x21, 0x8e, 0x5c, 0x22, 0x0c, 0x32, 0x5d, 0xfb, 0x38,
+	0x95, 0xf2, 0xea, 0xf5, 0xf2, 0x15, 0x41, 0x2d, 0xf1, 0x76, 0xcc, 0x80, 0x5a, 0x2d, 0xcc, 0x9e,
+	0x99, 0x0f, 0x23, 0xf6, 0xe7, 0x6f, 0x37, 0x40, 0x72, 0x7e, 0x18, 0x31, 0xfb, 0x03, 0x8e, 0xd6,
+	0xe8, 0x81, 0xd5, 0x24, 0x16, 0x6a, 0xc0, 0xa5, 0x16, 0xf5, 0x3a, 0x21, 0x51, 0xf0, 0x52, 0xf1,
+	0xc2, 0x80, 0x62, 0xa5, 0x35, 0x9d, 0x94, 0x9c, 0xe1, 0x9c, 0x28, 0x93, 0x40, 0x68, 0x15, 0x66,
+	0x9f, 0x76, 0xc2, 0x70, 0xcf, 0x69, 0x62, 0x77, 0x87, 0x78, 0xf3, 0x13, 0x2b, 0x5a, 0x79, 0xca,
+	0x9e, 0xe1, 0xb1, 0x1a, 0x0f, 0x19, 0x3e, 0x7c, 0xc8, 0x15, 0xd7, 0x7b, 0x5f, 0x9e, 0xcd, 0x3f,
+	0xbc, 0x9e, 0x35, 0x07, 0x5d, 0xa7, 0x9d, 0xd5, 0x75, 0xc6, 0xaf, 0x1a, 0xe8, 0xc3, 0x3a, 0xc9,
+	0xf9, 0x36, 0xe0, 0xa2, 0xf8, 0xea, 0x95, 0x95, 0xd6, 0xb2, 0xad, 0x74, 0x12, 0x41, 0x8a, 0x57,
+	0xc5, 0xff, 0x9f, 0x59, 0x30, 0x18, 0x92, 0xb2, 0x72, 0xe4, 0x97, 0x76, 0xbd, 0x5a, 0x91, 0xe3,
+	0x55, 0x13, 0x5a, 0x85, 0xd9, 0xfe, 0x8f, 0x57, 0x38, 0xc1, 0x9e, 0xe9, 0xfb, 0x76, 0xd1, 0x3c,
+	0x5c, 0xc4, 0x9e, 0x17, 0x93, 0x24, 0xe1, 0x74, 0xa6, 0x6d, 0xf5, 0x68, 0xfc, 0x71, 0x0e, 0xae,
+	0xe6, 0xf6, 0x90, 0xb3, 0x59, 0x87, 0x77, 0xd5, 0x87, 0xe6, 0x28, 0x28, 0xd1, 0xe8, 0x1d, 0x15,
+	0xbf, 0x2f, 0xc2, 0xe8, 0x11, 0xcc, 0x91, 0xd8, 0xad, 0x56, 0x06, 0xcc, 0x73, 0x4a, 0x6b, 0xce,
+	0x72, 0x04, 0xe5, 0xa3, 0x06, 0x5c, 0x4a, 0x27, 0xd2, 0x3d, 0xf6, 0xe3, 0xc4, 0x98, 0x7e, 0x14,
+	0x65, 0x0a, 0xe7, 0x09, 0x5c, 0x91, 0xbe, 0x0e, 0x69, 0xea, 0xbe, 0x1e, 0xdc, 0xf9, 0xf1, 0xe0,
+	0x2e, 0x8b, 0xea, 0x6d, 0x5e, 0x2c, 0x41, 0x8d, 0x3b, 0xb0, 0x72, 0xd2, 0x57, 0x98, 0x91, 0xed,
+	0x74, 0xe5, 0xf7, 0x4c, 0x5c, 0x84, 0x0b, 0xfd, 0x67, 0x23, 0x1e, 0x8c, 0x5d, 0x58, 0xcd, 0xa9,
+	0x94, 0x83, 0xdf, 0x86, 0xc9, 0x4e, 0x82, 0x7d, 0xa2, 0x3c, 0x69, 0x8e, 0xf4, 0xa4, 0xc2, 0xf8,
+	0x3a, 0x2d, 0x53, 0x97, 0x82, 0xc0, 0xa8, 0xbe, 0x9c, 0x82, 0x0b, 0xbc, 0x27, 0xfa, 0x41, 0x83,
+	0x49, 0x71, 0x6f, 0xa0, 0x72, 0x36, 0xe4, 0xdb, 0xd7, 0x54, 0x69, 0x7d, 0x8c, 0x4c, 0xc1, 0xdb,
+	0xb8, 0xf6, 0xfd, 0xcb, 0x7f, 0x7e, 0x3e, 0xa7, 0xa3, 0x25, 0x2b, 0xf3, 0x5e, 0x14, 0x97, 0x14,
+	0x7a, 0xa5, 0xc1, 0x62, 0xce, 0xf2, 0x47, 0x9f, 0xe7, 0x34, 0x1c, 0x7d, 0xaf, 0x95, 0xbe, 0x38,
+	0x6b, 0xb9, 0x14, 0xf1, 0x19, 0x17, 0x71, 0x1b, 0x6d, 0x65, 0x8b, 0xc8, 0xbf, 0x8f, 0xd0, 0xbe,
+	0x06, 0x73, 0x03, 0x9b, 0x1c, 0x59, 0x39, 0x7c, 0xb2, 0x6e, 0x84, 0x52, 0x65, 0xfc, 0x02, 0x49,
+	0x79, 0x93, 0x53, 0x5e, 0x43, 0xd7, 0xb2, 0x29, 0x37, 0x45, 0x91, 0x93, 0x08, 0x42, 0xbf, 0x68,
+	0xf0, 0xde, 0x5b, 0x0b, 0x11, 0xdd, 0xca, 0xe9, 0x3a, 0x6c, 0x51, 0x97, 0xb6, 0x4e, 0x57, 0x24,
+	0xe9, 0x56, 0x38, 0xdd, 0x0d, 0x54, 0xb6, 0xc6, 0xfb, 0x43, 0x96, 0xa0, 0x03, 0x0d, 0xde, 0xcf,
+	0x5e, 0x56, 0xe8, 0x4e, 0x2e, 0x85, 0x9c, 0x1d, 0x5a, 0xba, 0x7b, 0x86, 0x4a, 0xa9, 0xe0, 0x3e,
+	0x57, 0xf0, 0x29, 0xba, 0x3b, 0x4c, 0xc1, 0xb1, 0x35, 0x06, 0xd6, 0xa2, 0xf5, 0x5c, 0xee, 0xd1,
+	0xef, 0xd0, 0xef, 0x1a, 0x14, 0xb3, 0x96, 0x00, 0xba, 0x3d, 0xde, 0x4c, 0x4f, 0xee, 0x9b, 0xd2,
+	0x27, 0xa7, 0xae, 0x93, 0x62, 0xb6, 0xb8, 0x18, 0x13, 0x6d, 0x8e, 0x3e, 0x8e, 0xde, 0x1f, 0xdb,
+	0xa4, 0x56, 0x7f, 0x71, 0xa8, 0x6b, 0x07, 0x87, 0xba, 0xf6, 0xf7, 0xa1, 0xae, 0xfd, 0x74, 0xa4,
+	0x17, 0x0e, 0x8e, 0xf4, 0xc2, 0x5f, 0x47, 0x7a, 0xe1, 0x9b, 0x75, 0x3f, 0x60, 0xcf, 0x3a, 0x4d,
+	0xd3, 0xa5, 0x2d, 0x8e, 0x78, 0x23, 0xc4, 0xcd, 0x44, 0x60, 0x7f, 0xdb, 0x43, 0x67, 0x7b, 0x6d,
+	0x92, 0x34, 0x27, 0xf9, 0xff, 0xe3, 0x5b, 0xff, 0x0d, 0x00, 0x8a, 0x40, 0x87, 0x53, 0x99, 0x0c,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -324,6 +728,15 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// DeployedCosmosCoinContracts queries a list cosmos coin denom and their deployed erc20 address
 	DeployedCosmosCoinContracts(ctx context.Context, in *QueryDeployedCosmosCoinContractsRequest, opts ...grpc.CallOption) (*QueryDeployedCosmosCoinContractsResponse, error)
+	// BackingStatus queries whether the sum of all fractional akava balances is backed by the module account ukava balance.
+	BackingStatus(ctx context.Context, in *QueryBackingStatusRequest, opts ...grpc.CallOption) (*QueryBackingStatusResponse, error)
+	// ConversionRecords queries the log of recent conversions between sdk.Coin and ERC20
+	ConversionRecords(ctx context.Context, in *QueryConversionRecordsRequest, opts ...grpc.CallOption) (*QueryConversionRecordsResponse, error)
+	// CosmosCoinERC20Balance queries the ERC20 balance of an address for a cosmos denom's deployed contract,
+	// alongside the native sdk.Coin balance of the address and the amount locked in the module account.
+	CosmosCoinERC20Balance(ctx context.Context, in *QueryCosmosCoinERC20BalanceRequest, opts ...grpc.CallOption) (*QueryCosmosCoinERC20BalanceResponse, error)
+	// ConversionRateLimits queries the conversion rate limits and their usage within the current window.
+	ConversionRateLimits(ctx context.Context, in *QueryConversionRateLimitsRequest, opts ...grpc.CallOption) (*QueryConversionRateLimitsResponse, error)
 }
 
 type queryClient struct {
@@ -352,12 +765,57 @@ func (c *queryClient) DeployedCosmosCoinContracts(ctx context.Context, in *Query
 	return out, nil
 }
 
+func (c *queryClient) BackingStatus(ctx context.Context, in *QueryBackingStatusRequest, opts ...grpc.CallOption) (*QueryBackingStatusResponse, error) {
+	out := new(QueryBackingStatusResponse)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Query/BackingStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConversionRecords(ctx context.Context, in *QueryConversionRecordsRequest, opts ...grpc.CallOption) (*QueryConversionRecordsResponse, error) {
+	out := new(QueryConversionRecordsResponse)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Query/ConversionRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CosmosCoinERC20Balance(ctx context.Context, in *QueryCosmosCoinERC20BalanceRequest, opts ...grpc.CallOption) (*QueryCosmosCoinERC20BalanceResponse, error) {
+	out := new(QueryCosmosCoinERC20BalanceResponse)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Query/CosmosCoinERC20Balance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConversionRateLimits(ctx context.Context, in *QueryConversionRateLimitsRequest, opts ...grpc.CallOption) (*QueryConversionRateLimitsResponse, error) {
+	out := new(QueryConversionRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Query/ConversionRateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters of the evmutil module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// DeployedCosmosCoinContracts queries a list cosmos coin denom and their deployed erc20 address
 	DeployedCosmosCoinContracts(context.Context, *QueryDeployedCosmosCoinContractsRequest) (*QueryDeployedCosmosCoinContractsResponse, error)
+	// BackingStatus queries whether the sum of all fractional akava balances is backed by the module account ukava balance.
+	BackingStatus(context.Context, *QueryBackingStatusRequest) (*QueryBackingStatusResponse, error)
+	// ConversionRecords queries the log of recent conversions between sdk.Coin and ERC20
+	ConversionRecords(context.Context, *QueryConversionRecordsRequest) (*QueryConversionRecordsResponse, error)
+	// CosmosCoinERC20Balance queries the ERC20 balance of an address for a cosmos denom's deployed contract,
+	// alongside the native sdk.Coin balance of the address and the amount locked in the module account.
+	CosmosCoinERC20Balance(context.Context, *QueryCosmosCoinERC20BalanceRequest) (*QueryCosmosCoinERC20BalanceResponse, error)
+	// ConversionRateLimits queries the conversion rate limits and their usage within the current window.
+	ConversionRateLimits(context.Context, *QueryConversionRateLimitsRequest) (*QueryConversionRateLimitsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -370,6 +828,18 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) DeployedCosmosCoinContracts(ctx context.Context, req *QueryDeployedCosmosCoinContractsRequest) (*QueryDeployedCosmosCoinContractsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeployedCosmosCoinContracts not implemented")
 }
+func (*UnimplementedQueryServer) BackingStatus(ctx context.Context, req *QueryBackingStatusRequest) (*QueryBackingStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackingStatus not implemented")
+}
+func (*UnimplementedQueryServer) ConversionRecords(ctx context.Context, req *QueryConversionRecordsRequest) (*QueryConversionRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConversionRecords not implemented")
+}
+func (*UnimplementedQueryServer) CosmosCoinERC20Balance(ctx context.Context, req *QueryCosmosCoinERC20BalanceRequest) (*QueryCosmosCoinERC20BalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CosmosCoinERC20Balance not implemented")
+}
+func (*UnimplementedQueryServer) ConversionRateLimits(ctx context.Context, req *QueryConversionRateLimitsRequest) (*QueryConversionRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConversionRateLimits not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -411,32 +881,120 @@ func _Query_DeployedCosmosCoinContracts_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kava.evmutil.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "DeployedCosmosCoinContracts",
-			Handler:    _Query_DeployedCosmosCoinContracts_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kava/evmutil/v1beta1/query.proto",
-}
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BackingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBackingStatusRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
+	if interceptor == nil {
+		return srv.(QueryServer).BackingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Query/BackingStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BackingStatus(ctx, req.(*QueryBackingStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConversionRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConversionRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConversionRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Query/ConversionRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConversionRecords(ctx, req.(*QueryConversionRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CosmosCoinERC20Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCosmosCoinERC20BalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CosmosCoinERC20Balance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Query/CosmosCoinERC20Balance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CosmosCoinERC20Balance(ctx, req.(*QueryCosmosCoinERC20BalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConversionRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConversionRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConversionRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Query/ConversionRateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConversionRateLimits(ctx, req.(*QueryConversionRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kava.evmutil.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "DeployedCosmosCoinContracts",
+			Handler:    _Query_DeployedCosmosCoinContracts_Handler,
+		},
+		{
+			MethodName: "BackingStatus",
+			Handler:    _Query_BackingStatus_Handler,
+		},
+		{
+			MethodName: "ConversionRecords",
+			Handler:    _Query_ConversionRecords_Handler,
+		},
+		{
+			MethodName: "CosmosCoinERC20Balance",
+			Handler:    _Query_CosmosCoinERC20Balance_Handler,
+		},
+		{
+			MethodName: "ConversionRateLimits",
+			Handler:    _Query_ConversionRateLimits_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kava/evmutil/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
 
 func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
@@ -577,7 +1135,7 @@ func (m *QueryDeployedCosmosCoinContractsResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
-func (m *DeployedCosmosCoinContract) Marshal() (dAtA []byte, err error) {
+func (m *QueryBackingStatusRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -587,131 +1145,1075 @@ func (m *DeployedCosmosCoinContract) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeployedCosmosCoinContract) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBackingStatusRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeployedCosmosCoinContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBackingStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Address != nil {
-		{
-			size := m.Address.Size()
-			i -= size
-			if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CosmosDenom) > 0 {
-		i -= len(m.CosmosDenom)
-		copy(dAtA[i:], m.CosmosDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CosmosDenom)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBackingStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryBackingStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBackingStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.FullyBacked {
+		i--
+		if m.FullyBacked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.ModuleBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TotalFractionalBalances.Size()
+		i -= size
+		if _, err := m.TotalFractionalBalances.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDeployedCosmosCoinContractsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryConversionRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CosmosDenoms) > 0 {
-		for _, s := range m.CosmosDenoms {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDeployedCosmosCoinContractsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryConversionRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.DeployedCosmosCoinContracts) > 0 {
-		for _, e := range m.DeployedCosmosCoinContracts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DeployedCosmosCoinContract) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCosmosCoinERC20BalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCosmosCoinERC20BalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCosmosCoinERC20BalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CosmosDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CosmosDenom) > 0 {
+		i -= len(m.CosmosDenom)
+		copy(dAtA[i:], m.CosmosDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CosmosDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCosmosCoinERC20BalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCosmosCoinERC20BalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCosmosCoinERC20BalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ModuleLockedBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.NativeBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Erc20Balance.Size()
+		i -= size
+		if _, err := m.Erc20Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConversionRateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConversionRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Usages) > 0 {
+		for iNdEx := len(m.Usages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDeployedCosmosCoinContractsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CosmosDenoms) > 0 {
+		for _, s := range m.CosmosDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeployedCosmosCoinContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeployedCosmosCoinContracts) > 0 {
+		for _, e := range m.DeployedCosmosCoinContracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBackingStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBackingStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.Address != nil {
-		l = m.Address.Size()
+	var l int
+	_ = l
+	l = m.TotalFractionalBalances.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ModuleBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.FullyBacked {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryConversionRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConversionRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCosmosCoinERC20BalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCosmosCoinERC20BalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	l = m.Erc20Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NativeBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ModuleLockedBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConversionRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConversionRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Usages) > 0 {
+		for _, e := range m.Usages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeployedCosmosCoinContractsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeployedCosmosCoinContractsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeployedCosmosCoinContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosDenoms = append(m.CosmosDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeployedCosmosCoinContractsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeployedCosmosCoinContractsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeployedCosmosCoinContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployedCosmosCoinContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployedCosmosCoinContracts = append(m.DeployedCosmosCoinContracts, DeployedCosmosCoinContract{})
+			if err := m.DeployedCosmosCoinContracts[len(m.DeployedCosmosCoinContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBackingStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBackingStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBackingStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBackingStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBackingStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBackingStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFractionalBalances", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFractionalBalances.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ModuleBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullyBacked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FullyBacked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConversionRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -734,12 +2236,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConversionRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConversionRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -761,7 +2299,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConversionRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -784,15 +2322,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConversionRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConversionRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -819,7 +2357,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, ConversionRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -844,7 +2419,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDeployedCosmosCoinContractsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCosmosCoinERC20BalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -867,15 +2442,15 @@ func (m *QueryDeployedCosmosCoinContractsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDeployedCosmosCoinContractsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCosmosCoinERC20BalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDeployedCosmosCoinContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCosmosCoinERC20BalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -903,13 +2478,13 @@ func (m *QueryDeployedCosmosCoinContractsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosDenoms = append(m.CosmosDenoms, string(dAtA[iNdEx:postIndex]))
+			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -919,27 +2494,23 @@ func (m *QueryDeployedCosmosCoinContractsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -962,7 +2533,7 @@ func (m *QueryDeployedCosmosCoinContractsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDeployedCosmosCoinContractsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCosmosCoinERC20BalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -985,15 +2556,81 @@ func (m *QueryDeployedCosmosCoinContractsResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDeployedCosmosCoinContractsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCosmosCoinERC20BalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDeployedCosmosCoinContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCosmosCoinERC20BalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployedCosmosCoinContracts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeBalance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1020,14 +2657,13 @@ func (m *QueryDeployedCosmosCoinContractsResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeployedCosmosCoinContracts = append(m.DeployedCosmosCoinContracts, DeployedCosmosCoinContract{})
-			if err := m.DeployedCosmosCoinContracts[len(m.DeployedCosmosCoinContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NativeBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleLockedBalance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1054,10 +2690,7 @@ func (m *QueryDeployedCosmosCoinContractsResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ModuleLockedBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1082,7 +2715,7 @@ func (m *QueryDeployedCosmosCoinContractsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *DeployedCosmosCoinContract) Unmarshal(dAtA []byte) error {
+func (m *QueryConversionRateLimitsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1105,15 +2738,15 @@ func (m *DeployedCosmosCoinContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeployedCosmosCoinContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConversionRateLimitsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeployedCosmosCoinContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConversionRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1141,13 +2774,63 @@ func (m *DeployedCosmosCoinContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConversionRateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConversionRateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConversionRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1157,25 +2840,23 @@ func (m *DeployedCosmosCoinContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v InternalEVMAddress
-			m.Address = &v
-			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Usages = append(m.Usages, ConversionRateLimitUsage{})
+			if err := m.Usages[len(m.Usages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
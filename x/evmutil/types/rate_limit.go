@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewConversionRateLimit returns a new ConversionRateLimit.
+func NewConversionRateLimit(denom string, maxAmount sdkmath.Int, windowBlocks uint64) ConversionRateLimit {
+	return ConversionRateLimit{
+		Denom:        denom,
+		MaxAmount:    maxAmount,
+		WindowBlocks: windowBlocks,
+	}
+}
+
+// Validate returns an error if the ConversionRateLimit is invalid.
+func (limit ConversionRateLimit) Validate() error {
+	if err := sdk.ValidateDenom(limit.Denom); err != nil {
+		return fmt.Errorf("conversion rate limit denom invalid: %v", err)
+	}
+	if limit.MaxAmount.IsNil() || !limit.MaxAmount.IsPositive() {
+		return fmt.Errorf("conversion rate limit max amount must be positive: %s", limit.MaxAmount)
+	}
+	if limit.WindowBlocks == 0 {
+		return fmt.Errorf("conversion rate limit window blocks must be positive")
+	}
+	return nil
+}
+
+// ConversionRateLimits defines a slice of ConversionRateLimit.
+type ConversionRateLimits []ConversionRateLimit
+
+// NewConversionRateLimits returns ConversionRateLimits from the provided values.
+func NewConversionRateLimits(limits ...ConversionRateLimit) ConversionRateLimits {
+	return ConversionRateLimits(limits)
+}
+
+// Validate returns an error if any rate limit is invalid or if a denom is limited more than once.
+func (limits ConversionRateLimits) Validate() error {
+	denoms := map[string]bool{}
+
+	for _, limit := range limits {
+		if denoms[limit.Denom] {
+			return fmt.Errorf("found duplicate conversion rate limit denom %s", limit.Denom)
+		}
+
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+
+		denoms[limit.Denom] = true
+	}
+
+	return nil
+}
+
+// Get returns the rate limit of the denom, and false if the denom is not rate limited.
+func (limits ConversionRateLimits) Get(denom string) (ConversionRateLimit, bool) {
+	for _, limit := range limits {
+		if limit.Denom == denom {
+			return limit, true
+		}
+	}
+	return ConversionRateLimit{}, false
+}
+
+// validateConversionRateLimits validates an interface as ConversionRateLimits
+func validateConversionRateLimits(i interface{}) error {
+	limits, ok := i.(ConversionRateLimits)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return limits.Validate()
+}
+
+// NewConversionRateLimitUsage returns a new ConversionRateLimitUsage for the
+// limit given the amount used within its current window.
+func NewConversionRateLimitUsage(limit ConversionRateLimit, used sdkmath.Int) ConversionRateLimitUsage {
+	remaining := limit.MaxAmount.Sub(used)
+	if remaining.IsNegative() {
+		remaining = sdkmath.ZeroInt()
+	}
+	return ConversionRateLimitUsage{
+		Limit:           limit,
+		UsedAmount:      used,
+		RemainingAmount: remaining,
+	}
+}
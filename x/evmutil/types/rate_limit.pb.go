@@ -0,0 +1,823 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/evmutil/v1beta1/rate_limit.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ConversionRateLimit limits the total amount of a denom that can be converted
+// between sdk.Coin and ERC20, in either direction, within a rolling window of blocks.
+type ConversionRateLimit struct {
+	// denom is the sdk.Coin denom the limit applies to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// max_amount is the maximum amount of denom that can be converted within the window.
+	MaxAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=max_amount,json=maxAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_amount"`
+	// window_blocks is the number of most recent blocks, including the current block,
+	// whose conversions count towards the limit.
+	WindowBlocks uint64 `protobuf:"varint,3,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+}
+
+func (m *ConversionRateLimit) Reset()         { *m = ConversionRateLimit{} }
+func (m *ConversionRateLimit) String() string { return proto.CompactTextString(m) }
+func (*ConversionRateLimit) ProtoMessage()    {}
+func (*ConversionRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e28f08ae27a014a, []int{0}
+}
+func (m *ConversionRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionRateLimit.Merge(m, src)
+}
+func (m *ConversionRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionRateLimit proto.InternalMessageInfo
+
+func (m *ConversionRateLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ConversionRateLimit) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+// ConversionRateLimitUsage is the current usage of a conversion rate limit.
+type ConversionRateLimitUsage struct {
+	// limit is the conversion rate limit.
+	Limit ConversionRateLimit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit"`
+	// used_amount is the amount of the denom converted within the current window.
+	UsedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=used_amount,json=usedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"used_amount"`
+	// remaining_amount is the amount of the denom that can still be converted within the current window.
+	RemainingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=remaining_amount,json=remainingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining_amount"`
+}
+
+func (m *ConversionRateLimitUsage) Reset()         { *m = ConversionRateLimitUsage{} }
+func (m *ConversionRateLimitUsage) String() string { return proto.CompactTextString(m) }
+func (*ConversionRateLimitUsage) ProtoMessage()    {}
+func (*ConversionRateLimitUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e28f08ae27a014a, []int{1}
+}
+func (m *ConversionRateLimitUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionRateLimitUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionRateLimitUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionRateLimitUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionRateLimitUsage.Merge(m, src)
+}
+func (m *ConversionRateLimitUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionRateLimitUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionRateLimitUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionRateLimitUsage proto.InternalMessageInfo
+
+func (m *ConversionRateLimitUsage) GetLimit() ConversionRateLimit {
+	if m != nil {
+		return m.Limit
+	}
+	return ConversionRateLimit{}
+}
+
+func init() {
+	proto.RegisterType((*ConversionRateLimit)(nil), "kava.evmutil.v1beta1.ConversionRateLimit")
+	proto.RegisterType((*ConversionRateLimitUsage)(nil), "kava.evmutil.v1beta1.ConversionRateLimitUsage")
+}
+
+func init() {
+	proto.RegisterFile("kava/evmutil/v1beta1/rate_limit.proto", fileDescriptor_3e28f08ae27a014a)
+}
+
+var fileDescriptor_3e28f08ae27a014a = []byte{
+	// 378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xbf, 0x8e, 0xd3, 0x40,
+	0x10, 0xc6, 0xbd, 0xf9, 0x83, 0x94, 0x0d, 0x08, 0x64, 0x52, 0x98, 0x14, 0x9b, 0x28, 0x08, 0x94,
+	0x14, 0xb1, 0x15, 0x68, 0x69, 0x30, 0xa2, 0x88, 0xa0, 0xb2, 0x44, 0x03, 0x42, 0xd6, 0x3a, 0x5e,
+	0x99, 0x55, 0xbc, 0xbb, 0x91, 0x77, 0xed, 0x84, 0xb7, 0xe0, 0x31, 0xa0, 0xa0, 0xbb, 0x87, 0x48,
+	0x19, 0x5d, 0x75, 0xba, 0x22, 0xca, 0x39, 0x2f, 0x72, 0xf2, 0xae, 0x2f, 0xba, 0x22, 0x65, 0x2a,
+	0xef, 0x7c, 0xfe, 0x66, 0x7e, 0x33, 0xa3, 0x81, 0x6f, 0x96, 0xb8, 0xc0, 0x1e, 0x29, 0x58, 0xae,
+	0x68, 0xea, 0x15, 0xb3, 0x88, 0x28, 0x3c, 0xf3, 0x32, 0xac, 0x48, 0x98, 0x52, 0x46, 0x95, 0xbb,
+	0xca, 0x84, 0x12, 0x76, 0xaf, 0xb2, 0xb9, 0xb5, 0xcd, 0xad, 0x6d, 0xfd, 0x57, 0x0b, 0x21, 0x99,
+	0x90, 0xa1, 0xf6, 0x78, 0x26, 0x30, 0x09, 0xfd, 0x5e, 0x22, 0x12, 0x61, 0xf4, 0xea, 0x65, 0xd4,
+	0xd1, 0x7f, 0x00, 0x5f, 0x7e, 0x12, 0xbc, 0x20, 0x99, 0xa4, 0x82, 0x07, 0x58, 0x91, 0xaf, 0x15,
+	0xc4, 0xee, 0xc1, 0x76, 0x4c, 0xb8, 0x60, 0x0e, 0x18, 0x82, 0x71, 0x27, 0x30, 0x81, 0xfd, 0x03,
+	0x42, 0x86, 0x37, 0x21, 0x66, 0x22, 0xe7, 0xca, 0x69, 0x54, 0xbf, 0xfc, 0x0f, 0xdb, 0xfd, 0xc0,
+	0xba, 0xdd, 0x0f, 0xde, 0x26, 0x54, 0xfd, 0xca, 0x23, 0x77, 0x21, 0x58, 0x0d, 0xae, 0x3f, 0x53,
+	0x19, 0x2f, 0x3d, 0xf5, 0x7b, 0x45, 0xa4, 0x3b, 0xe7, 0xea, 0xfa, 0x6a, 0x0a, 0xeb, 0xbe, 0xe6,
+	0x5c, 0x05, 0x1d, 0x86, 0x37, 0x1f, 0x75, 0x39, 0xfb, 0x35, 0x7c, 0xb6, 0xa6, 0x3c, 0x16, 0xeb,
+	0x30, 0x4a, 0xc5, 0x62, 0x29, 0x9d, 0xe6, 0x10, 0x8c, 0x5b, 0xc1, 0x53, 0x23, 0xfa, 0x5a, 0x1b,
+	0xfd, 0x6b, 0x40, 0xe7, 0x4c, 0xbf, 0xdf, 0x24, 0x4e, 0x88, 0xfd, 0x19, 0xb6, 0xf5, 0x8a, 0x74,
+	0xd3, 0xdd, 0x77, 0x13, 0xf7, 0xdc, 0x8e, 0xdc, 0x33, 0xe9, 0x7e, 0xab, 0x1a, 0x22, 0x30, 0xd9,
+	0xf6, 0x4f, 0xd8, 0xcd, 0x25, 0x89, 0x2f, 0x39, 0x26, 0xac, 0x0a, 0xd6, 0x73, 0x26, 0xf0, 0x45,
+	0x46, 0x18, 0xa6, 0x9c, 0xf2, 0xe4, 0x81, 0xd1, 0xbc, 0x00, 0xe3, 0xf9, 0xa9, 0xaa, 0x01, 0xf9,
+	0x5f, 0x0e, 0x77, 0x08, 0xfc, 0x2d, 0x11, 0xd8, 0x96, 0x08, 0xec, 0x4a, 0x04, 0x0e, 0x25, 0x02,
+	0x7f, 0x8e, 0xc8, 0xda, 0x1d, 0x91, 0x75, 0x73, 0x44, 0xd6, 0xf7, 0xc9, 0x23, 0x50, 0xb5, 0xab,
+	0x69, 0x8a, 0x23, 0xa9, 0x5f, 0xde, 0xe6, 0x74, 0x82, 0x9a, 0x17, 0x3d, 0xd1, 0xf7, 0xf2, 0xfe,
+	0x7e, 0x00, 0x7a, 0x88, 0x3b, 0x8a, 0x9f, 0x02, 0x00, 0x00,
+}
+
+func (this *ConversionRateLimit) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*ConversionRateLimit)
+	if !ok {
+		that2, ok := that.(ConversionRateLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ConversionRateLimit")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ConversionRateLimit but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ConversionRateLimit but is not nil && this == nil")
+	}
+	if this.Denom != that1.Denom {
+		return fmt.Errorf("Denom this(%v) Not Equal that(%v)", this.Denom, that1.Denom)
+	}
+	if !this.MaxAmount.Equal(that1.MaxAmount) {
+		return fmt.Errorf("MaxAmount this(%v) Not Equal that(%v)", this.MaxAmount, that1.MaxAmount)
+	}
+	if this.WindowBlocks != that1.WindowBlocks {
+		return fmt.Errorf("WindowBlocks this(%v) Not Equal that(%v)", this.WindowBlocks, that1.WindowBlocks)
+	}
+	return nil
+}
+func (this *ConversionRateLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConversionRateLimit)
+	if !ok {
+		that2, ok := that.(ConversionRateLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.MaxAmount.Equal(that1.MaxAmount) {
+		return false
+	}
+	if this.WindowBlocks != that1.WindowBlocks {
+		return false
+	}
+	return true
+}
+func (this *ConversionRateLimitUsage) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*ConversionRateLimitUsage)
+	if !ok {
+		that2, ok := that.(ConversionRateLimitUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ConversionRateLimitUsage")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ConversionRateLimitUsage but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ConversionRateLimitUsage but is not nil && this == nil")
+	}
+	if !this.Limit.Equal(&that1.Limit) {
+		return fmt.Errorf("Limit this(%v) Not Equal that(%v)", this.Limit, that1.Limit)
+	}
+	if !this.UsedAmount.Equal(that1.UsedAmount) {
+		return fmt.Errorf("UsedAmount this(%v) Not Equal that(%v)", this.UsedAmount, that1.UsedAmount)
+	}
+	if !this.RemainingAmount.Equal(that1.RemainingAmount) {
+		return fmt.Errorf("RemainingAmount this(%v) Not Equal that(%v)", this.RemainingAmount, that1.RemainingAmount)
+	}
+	return nil
+}
+func (this *ConversionRateLimitUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConversionRateLimitUsage)
+	if !ok {
+		that2, ok := that.(ConversionRateLimitUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Limit.Equal(&that1.Limit) {
+		return false
+	}
+	if !this.UsedAmount.Equal(that1.UsedAmount) {
+		return false
+	}
+	if !this.RemainingAmount.Equal(that1.RemainingAmount) {
+		return false
+	}
+	return true
+}
+func (m *ConversionRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowBlocks != 0 {
+		i = encodeVarintRateLimit(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRateLimit(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConversionRateLimitUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionRateLimitUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionRateLimitUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RemainingAmount.Size()
+		i -= size
+		if _, err := m.RemainingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.UsedAmount.Size()
+		i -= size
+		if _, err := m.UsedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRateLimit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRateLimit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ConversionRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRateLimit(uint64(l))
+	}
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	if m.WindowBlocks != 0 {
+		n += 1 + sovRateLimit(uint64(m.WindowBlocks))
+	}
+	return n
+}
+
+func (m *ConversionRateLimitUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	l = m.UsedAmount.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	l = m.RemainingAmount.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	return n
+}
+
+func sovRateLimit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRateLimit(x uint64) (n int) {
+	return sovRateLimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ConversionRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionRateLimitUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionRateLimitUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionRateLimitUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UsedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRateLimit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRateLimit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRateLimit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRateLimit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRateLimit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRateLimit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRateLimit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRateLimit = fmt.Errorf("proto: unexpected end of group")
+)
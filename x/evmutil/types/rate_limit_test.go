@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/evmutil/types"
+)
+
+func TestConversionRateLimits_Validate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		limits types.ConversionRateLimits
+		expErr string
+	}{
+		{
+			name:   "valid - empty",
+			limits: types.NewConversionRateLimits(),
+		},
+		{
+			name: "valid - multiple denoms",
+			limits: types.NewConversionRateLimits(
+				types.NewConversionRateLimit("hard", sdkmath.NewInt(100), 10),
+				types.NewConversionRateLimit("swp", sdkmath.NewInt(1), 1),
+			),
+		},
+		{
+			name: "invalid - duplicate denom",
+			limits: types.NewConversionRateLimits(
+				types.NewConversionRateLimit("hard", sdkmath.NewInt(100), 10),
+				types.NewConversionRateLimit("hard", sdkmath.NewInt(1), 1),
+			),
+			expErr: "found duplicate conversion rate limit denom hard",
+		},
+		{
+			name:   "invalid - invalid denom",
+			limits: types.NewConversionRateLimits(types.NewConversionRateLimit("", sdkmath.NewInt(100), 10)),
+			expErr: "conversion rate limit denom invalid",
+		},
+		{
+			name:   "invalid - zero max amount",
+			limits: types.NewConversionRateLimits(types.NewConversionRateLimit("hard", sdkmath.ZeroInt(), 10)),
+			expErr: "max amount must be positive",
+		},
+		{
+			name:   "invalid - nil max amount",
+			limits: types.NewConversionRateLimits(types.NewConversionRateLimit("hard", sdkmath.Int{}, 10)),
+			expErr: "max amount must be positive",
+		},
+		{
+			name:   "invalid - zero window",
+			limits: types.NewConversionRateLimits(types.NewConversionRateLimit("hard", sdkmath.NewInt(100), 0)),
+			expErr: "window blocks must be positive",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.limits.Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewConversionRateLimitUsage(t *testing.T) {
+	limit := types.NewConversionRateLimit("hard", sdkmath.NewInt(100), 10)
+
+	usage := types.NewConversionRateLimitUsage(limit, sdkmath.NewInt(30))
+	require.Equal(t, sdkmath.NewInt(70), usage.RemainingAmount)
+
+	// the limit may be lowered below the amount already used
+	usage = types.NewConversionRateLimitUsage(limit, sdkmath.NewInt(130))
+	require.True(t, usage.RemainingAmount.IsZero())
+}
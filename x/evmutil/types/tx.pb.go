@@ -12,6 +12,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	types1 "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -438,187 +439,694 @@ func (m *MsgConvertCosmosCoinFromERC20Response) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConvertCosmosCoinFromERC20Response proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgConvertCoinToERC20)(nil), "kava.evmutil.v1beta1.MsgConvertCoinToERC20")
-	proto.RegisterType((*MsgConvertCoinToERC20Response)(nil), "kava.evmutil.v1beta1.MsgConvertCoinToERC20Response")
-	proto.RegisterType((*MsgConvertERC20ToCoin)(nil), "kava.evmutil.v1beta1.MsgConvertERC20ToCoin")
-	proto.RegisterType((*MsgConvertERC20ToCoinResponse)(nil), "kava.evmutil.v1beta1.MsgConvertERC20ToCoinResponse")
-	proto.RegisterType((*MsgConvertCosmosCoinToERC20)(nil), "kava.evmutil.v1beta1.MsgConvertCosmosCoinToERC20")
-	proto.RegisterType((*MsgConvertCosmosCoinToERC20Response)(nil), "kava.evmutil.v1beta1.MsgConvertCosmosCoinToERC20Response")
-	proto.RegisterType((*MsgConvertCosmosCoinFromERC20)(nil), "kava.evmutil.v1beta1.MsgConvertCosmosCoinFromERC20")
-	proto.RegisterType((*MsgConvertCosmosCoinFromERC20Response)(nil), "kava.evmutil.v1beta1.MsgConvertCosmosCoinFromERC20Response")
+// MsgConvertCosmosCoinsToERC20Batch defines a conversion of multiple cosmos sdk.Coins to ERC20s for cosmos-native assets.
+type MsgConvertCosmosCoinsToERC20Batch struct {
+	// Kava bech32 address initiating the conversion.
+	Initiator string `protobuf:"bytes,1,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	// EVM hex address that will receive the ERC20 tokens.
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// Amounts are the sdk.Coins to convert, each to its own ERC20 contract.
+	Amounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amounts"`
 }
 
-func init() { proto.RegisterFile("kava/evmutil/v1beta1/tx.proto", fileDescriptor_6e82783c6c58f89c) }
-
-var fileDescriptor_6e82783c6c58f89c = []byte{
-	// 559 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x41, 0x6b, 0xd4, 0x4c,
-	0x18, 0xde, 0x69, 0x4b, 0xf9, 0x76, 0xbe, 0x4b, 0x19, 0x56, 0x48, 0xa3, 0xcd, 0x96, 0x95, 0x6a,
-	0x45, 0x36, 0xe9, 0xee, 0x8a, 0x20, 0x7a, 0x71, 0x97, 0x0a, 0xa5, 0xf4, 0x12, 0xf7, 0xe4, 0x65,
-	0x99, 0x64, 0x87, 0x18, 0xda, 0x64, 0x96, 0xcc, 0x6c, 0xa8, 0x3f, 0x40, 0x10, 0x11, 0xd1, 0x3f,
-	0xe0, 0xd9, 0x1f, 0xd0, 0x1f, 0xd1, 0x63, 0xe9, 0x49, 0x3c, 0x2c, 0x35, 0xfb, 0x47, 0x64, 0x92,
-	0x49, 0x3a, 0xd6, 0x98, 0xb5, 0x22, 0x78, 0xda, 0xcc, 0xbc, 0xcf, 0xf3, 0xbe, 0xcf, 0xf3, 0xbe,
-	0x33, 0xb3, 0x70, 0xe3, 0x10, 0xc7, 0xd8, 0x22, 0x71, 0x30, 0xe5, 0xfe, 0x91, 0x15, 0x77, 0x1c,
-	0xc2, 0x71, 0xc7, 0xe2, 0xc7, 0xe6, 0x24, 0xa2, 0x9c, 0xa2, 0x86, 0x08, 0x9b, 0x32, 0x6c, 0xca,
-	0xb0, 0x6e, 0xb8, 0x94, 0x05, 0x94, 0x59, 0x0e, 0x66, 0xa4, 0xe0, 0xb8, 0xd4, 0x0f, 0x33, 0x96,
-	0xbe, 0x9e, 0xc5, 0x47, 0xe9, 0xca, 0xca, 0x16, 0x32, 0xd4, 0xf0, 0xa8, 0x47, 0xb3, 0x7d, 0xf1,
-	0x95, 0xed, 0xb6, 0x3e, 0x01, 0x78, 0xe3, 0x80, 0x79, 0x03, 0x1a, 0xc6, 0x24, 0xe2, 0x03, 0xea,
-	0x87, 0x43, 0xba, 0x6b, 0x0f, 0xba, 0x3b, 0xe8, 0x21, 0xac, 0xfb, 0xa1, 0xcf, 0x7d, 0xcc, 0x69,
-	0xa4, 0x81, 0x4d, 0xb0, 0x5d, 0xef, 0x6b, 0xe7, 0x27, 0xed, 0x86, 0x4c, 0xfa, 0x74, 0x3c, 0x8e,
-	0x08, 0x63, 0xcf, 0x79, 0xe4, 0x87, 0x9e, 0x7d, 0x09, 0x45, 0x3a, 0xfc, 0x2f, 0x22, 0x2e, 0xf1,
-	0x63, 0x12, 0x69, 0x4b, 0x82, 0x66, 0x17, 0x6b, 0xd4, 0x81, 0xab, 0x38, 0xa0, 0xd3, 0x90, 0x6b,
-	0xcb, 0x9b, 0x60, 0xfb, 0xff, 0xee, 0xba, 0x29, 0xb3, 0x09, 0x3f, 0xb9, 0x49, 0x53, 0xa8, 0xb0,
-	0x25, 0xb0, 0xd5, 0x84, 0x1b, 0xa5, 0xfa, 0x6c, 0xc2, 0x26, 0x34, 0x64, 0xa4, 0xf5, 0x7a, 0x49,
-	0x75, 0x90, 0xc6, 0x86, 0x54, 0x00, 0xd1, 0xad, 0x9f, 0x1c, 0xa8, 0x3a, 0x1f, 0x5c, 0xd5, 0x59,
-	0x61, 0xef, 0xd2, 0x41, 0x1f, 0x22, 0x31, 0x98, 0x11, 0x89, 0xdc, 0xee, 0xce, 0x08, 0x67, 0xa8,
-	0xd4, 0x4d, 0xbd, 0xdf, 0x48, 0x66, 0xcd, 0xb5, 0x7d, 0x1c, 0xe3, 0x54, 0x84, 0xcc, 0x60, 0xaf,
-	0x09, 0xfc, 0xae, 0x80, 0xcb, 0x1d, 0x34, 0x2c, 0xba, 0xb0, 0x92, 0xf2, 0x9e, 0x9c, 0xce, 0x9a,
-	0xb5, 0xaf, 0xb3, 0xe6, 0x1d, 0xcf, 0xe7, 0x2f, 0xa7, 0x8e, 0xe9, 0xd2, 0x40, 0x8e, 0x4e, 0xfe,
-	0xb4, 0xd9, 0xf8, 0xd0, 0xe2, 0xaf, 0x26, 0x84, 0x99, 0x7b, 0x21, 0x3f, 0x3f, 0x69, 0x43, 0xa9,
-	0x72, 0x2f, 0xe4, 0xe5, 0x8d, 0x52, 0xda, 0x50, 0x34, 0xea, 0x2d, 0x80, 0x37, 0xd5, 0x56, 0x8a,
-	0x0c, 0xea, 0xc0, 0xab, 0xdb, 0xf5, 0x97, 0xc7, 0xba, 0x05, 0x6f, 0x57, 0x68, 0x29, 0x34, 0xbf,
-	0x03, 0x3f, 0x8e, 0x3f, 0xc7, 0x3d, 0x8b, 0x68, 0xf0, 0x0f, 0x54, 0xdf, 0x85, 0x5b, 0x95, 0x6a,
-	0x72, 0xdd, 0xdd, 0x8f, 0x2b, 0x70, 0xf9, 0x80, 0x79, 0x28, 0x86, 0xa8, 0xe4, 0x6a, 0xdd, 0x37,
-	0xcb, 0x2e, 0xb7, 0x59, 0x7a, 0xce, 0xf5, 0xde, 0x35, 0xc0, 0x79, 0x7d, 0xa5, 0xae, 0x7a, 0x21,
-	0x16, 0xd6, 0x55, 0xc0, 0x8b, 0xeb, 0x96, 0x9c, 0x31, 0xf4, 0x06, 0x40, 0xed, 0x97, 0x07, 0xac,
-	0xb3, 0xd8, 0xc9, 0x15, 0x8a, 0xfe, 0xe8, 0xda, 0x94, 0x42, 0xca, 0x7b, 0x00, 0xf5, 0x8a, 0x73,
-	0xd3, 0xfb, 0xfd, 0xcc, 0x05, 0x49, 0x7f, 0xfc, 0x07, 0xa4, 0x5c, 0x50, 0x7f, 0xff, 0xe2, 0x9b,
-	0x01, 0x3e, 0x27, 0x06, 0x38, 0x4d, 0x0c, 0x70, 0x96, 0x18, 0xe0, 0x22, 0x31, 0xc0, 0x87, 0xb9,
-	0x51, 0x3b, 0x9b, 0x1b, 0xb5, 0x2f, 0x73, 0xa3, 0xf6, 0xe2, 0x9e, 0xf2, 0x00, 0x88, 0x42, 0xed,
-	0x23, 0xec, 0xb0, 0xf4, 0xcb, 0x3a, 0x2e, 0xfe, 0x29, 0xd2, 0x77, 0xc0, 0x59, 0x4d, 0x9f, 0xef,
-	0xde, 0xf7, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa2, 0x5a, 0x1f, 0x90, 0x46, 0x06, 0x00, 0x00,
+func (m *MsgConvertCosmosCoinsToERC20Batch) Reset()         { *m = MsgConvertCosmosCoinsToERC20Batch{} }
+func (m *MsgConvertCosmosCoinsToERC20Batch) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertCosmosCoinsToERC20Batch) ProtoMessage()    {}
+func (*MsgConvertCosmosCoinsToERC20Batch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82783c6c58f89c, []int{8}
 }
-
-func (this *MsgConvertCoinToERC20) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
+func (m *MsgConvertCosmosCoinsToERC20Batch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertCosmosCoinsToERC20Batch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertCosmosCoinsToERC20Batch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that == nil && this != nil")
+		return b[:n], nil
 	}
+}
+func (m *MsgConvertCosmosCoinsToERC20Batch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertCosmosCoinsToERC20Batch.Merge(m, src)
+}
+func (m *MsgConvertCosmosCoinsToERC20Batch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertCosmosCoinsToERC20Batch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertCosmosCoinsToERC20Batch.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgConvertCoinToERC20)
-	if !ok {
-		that2, ok := that.(MsgConvertCoinToERC20)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *MsgConvertCoinToERC20")
-		}
-	}
-	if that1 == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that is type *MsgConvertCoinToERC20 but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *MsgConvertCoinToERC20 but is not nil && this == nil")
-	}
-	if this.Initiator != that1.Initiator {
-		return fmt.Errorf("Initiator this(%v) Not Equal that(%v)", this.Initiator, that1.Initiator)
+var xxx_messageInfo_MsgConvertCosmosCoinsToERC20Batch proto.InternalMessageInfo
+
+func (m *MsgConvertCosmosCoinsToERC20Batch) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
 	}
-	if this.Receiver != that1.Receiver {
-		return fmt.Errorf("Receiver this(%v) Not Equal that(%v)", this.Receiver, that1.Receiver)
+	return ""
+}
+
+func (m *MsgConvertCosmosCoinsToERC20Batch) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	if !this.Amount.Equal(that1.Amount) {
-		return fmt.Errorf("Amount this(%v) Not Equal that(%v)", this.Amount, that1.Amount)
+	return ""
+}
+
+func (m *MsgConvertCosmosCoinsToERC20Batch) GetAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amounts
 	}
 	return nil
 }
-func (this *MsgConvertCoinToERC20) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*MsgConvertCoinToERC20)
-	if !ok {
-		that2, ok := that.(MsgConvertCoinToERC20)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// MsgConvertCosmosCoinsToERC20BatchResponse defines the response value from Msg/MsgConvertCosmosCoinsToERC20Batch.
+type MsgConvertCosmosCoinsToERC20BatchResponse struct {
+}
+
+func (m *MsgConvertCosmosCoinsToERC20BatchResponse) Reset() {
+	*m = MsgConvertCosmosCoinsToERC20BatchResponse{}
+}
+func (m *MsgConvertCosmosCoinsToERC20BatchResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgConvertCosmosCoinsToERC20BatchResponse) ProtoMessage() {}
+func (*MsgConvertCosmosCoinsToERC20BatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82783c6c58f89c, []int{9}
+}
+func (m *MsgConvertCosmosCoinsToERC20BatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertCosmosCoinsToERC20BatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertCosmosCoinsToERC20BatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Initiator != that1.Initiator {
-		return false
+}
+func (m *MsgConvertCosmosCoinsToERC20BatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertCosmosCoinsToERC20BatchResponse.Merge(m, src)
+}
+func (m *MsgConvertCosmosCoinsToERC20BatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertCosmosCoinsToERC20BatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertCosmosCoinsToERC20BatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertCosmosCoinsToERC20BatchResponse proto.InternalMessageInfo
+
+// MsgUpdateDeployedCosmosCoinContract defines a governance update of the ERC20 contract deployed for a cosmos denom.
+type MsgUpdateDeployedCosmosCoinContract struct {
+	// authority is the address that controls the module (defaults to x/gov).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// cosmos_denom is the denom of the sdk.Coin whose deployed contract is updated.
+	CosmosDenom string `protobuf:"bytes,2,opt,name=cosmos_denom,json=cosmosDenom,proto3" json:"cosmos_denom,omitempty"`
+	// contract_address is the EVM hex address of the new ERC20 contract for the denom.
+	// If empty, the denom remains mapped to its current contract.
+	ContractAddress string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// freeze freezes conversions of the contract being replaced. If contract_address is empty,
+	// it instead sets whether conversions of the current contract are frozen.
+	Freeze bool `protobuf:"varint,4,opt,name=freeze,proto3" json:"freeze,omitempty"`
+}
+
+func (m *MsgUpdateDeployedCosmosCoinContract) Reset()         { *m = MsgUpdateDeployedCosmosCoinContract{} }
+func (m *MsgUpdateDeployedCosmosCoinContract) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDeployedCosmosCoinContract) ProtoMessage()    {}
+func (*MsgUpdateDeployedCosmosCoinContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82783c6c58f89c, []int{10}
+}
+func (m *MsgUpdateDeployedCosmosCoinContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDeployedCosmosCoinContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDeployedCosmosCoinContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Receiver != that1.Receiver {
-		return false
+}
+func (m *MsgUpdateDeployedCosmosCoinContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDeployedCosmosCoinContract.Merge(m, src)
+}
+func (m *MsgUpdateDeployedCosmosCoinContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDeployedCosmosCoinContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDeployedCosmosCoinContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDeployedCosmosCoinContract proto.InternalMessageInfo
+
+func (m *MsgUpdateDeployedCosmosCoinContract) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
+	return ""
+}
+
+func (m *MsgUpdateDeployedCosmosCoinContract) GetCosmosDenom() string {
+	if m != nil {
+		return m.CosmosDenom
 	}
-	return true
+	return ""
 }
-func (this *MsgConvertCoinToERC20Response) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that == nil && this != nil")
+
+func (m *MsgUpdateDeployedCosmosCoinContract) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
+	return ""
+}
 
-	that1, ok := that.(*MsgConvertCoinToERC20Response)
-	if !ok {
-		that2, ok := that.(MsgConvertCoinToERC20Response)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *MsgConvertCoinToERC20Response")
-		}
+func (m *MsgUpdateDeployedCosmosCoinContract) GetFreeze() bool {
+	if m != nil {
+		return m.Freeze
 	}
-	if that1 == nil {
-		if this == nil {
-			return nil
+	return false
+}
+
+// MsgUpdateDeployedCosmosCoinContractResponse defines the response value from Msg/UpdateDeployedCosmosCoinContract.
+type MsgUpdateDeployedCosmosCoinContractResponse struct {
+}
+
+func (m *MsgUpdateDeployedCosmosCoinContractResponse) Reset() {
+	*m = MsgUpdateDeployedCosmosCoinContractResponse{}
+}
+func (m *MsgUpdateDeployedCosmosCoinContractResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateDeployedCosmosCoinContractResponse) ProtoMessage() {}
+func (*MsgUpdateDeployedCosmosCoinContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82783c6c58f89c, []int{11}
+}
+func (m *MsgUpdateDeployedCosmosCoinContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDeployedCosmosCoinContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDeployedCosmosCoinContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that is type *MsgConvertCoinToERC20Response but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *MsgConvertCoinToERC20Response but is not nil && this == nil")
+		return b[:n], nil
 	}
-	return nil
 }
-func (this *MsgConvertCoinToERC20Response) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *MsgUpdateDeployedCosmosCoinContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDeployedCosmosCoinContractResponse.Merge(m, src)
+}
+func (m *MsgUpdateDeployedCosmosCoinContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDeployedCosmosCoinContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDeployedCosmosCoinContractResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgConvertCoinToERC20Response)
-	if !ok {
-		that2, ok := that.(MsgConvertCoinToERC20Response)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_MsgUpdateDeployedCosmosCoinContractResponse proto.InternalMessageInfo
+
+// MsgSyncERC20Metadata defines a re-sync of a cosmos coin's ERC20 contract metadata with its x/bank denom metadata.
+type MsgSyncERC20Metadata struct {
+	// Kava bech32 address initiating the sync.
+	Initiator string `protobuf:"bytes,1,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	// cosmos_denom is the denom of the sdk.Coin whose ERC20 contract metadata is synced.
+	CosmosDenom string `protobuf:"bytes,2,opt,name=cosmos_denom,json=cosmosDenom,proto3" json:"cosmos_denom,omitempty"`
+}
+
+func (m *MsgSyncERC20Metadata) Reset()         { *m = MsgSyncERC20Metadata{} }
+func (m *MsgSyncERC20Metadata) String() string { return proto.CompactTextString(m) }
+func (*MsgSyncERC20Metadata) ProtoMessage()    {}
+func (*MsgSyncERC20Metadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82783c6c58f89c, []int{12}
+}
+func (m *MsgSyncERC20Metadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSyncERC20Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSyncERC20Metadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *MsgSyncERC20Metadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSyncERC20Metadata.Merge(m, src)
+}
+func (m *MsgSyncERC20Metadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSyncERC20Metadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSyncERC20Metadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSyncERC20Metadata proto.InternalMessageInfo
+
+func (m *MsgSyncERC20Metadata) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
 	}
-	return true
+	return ""
 }
-func (this *MsgConvertERC20ToCoin) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that == nil && this != nil")
+
+func (m *MsgSyncERC20Metadata) GetCosmosDenom() string {
+	if m != nil {
+		return m.CosmosDenom
 	}
+	return ""
+}
 
-	that1, ok := that.(*MsgConvertERC20ToCoin)
-	if !ok {
-		that2, ok := that.(MsgConvertERC20ToCoin)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *MsgConvertERC20ToCoin")
+// MsgSyncERC20MetadataResponse defines the response value from Msg/SyncERC20Metadata.
+type MsgSyncERC20MetadataResponse struct {
+	// contract_address is the EVM hex address of the ERC20 contract deployed with the synced metadata.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *MsgSyncERC20MetadataResponse) Reset()         { *m = MsgSyncERC20MetadataResponse{} }
+func (m *MsgSyncERC20MetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSyncERC20MetadataResponse) ProtoMessage()    {}
+func (*MsgSyncERC20MetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82783c6c58f89c, []int{13}
+}
+func (m *MsgSyncERC20MetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSyncERC20MetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSyncERC20MetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSyncERC20MetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSyncERC20MetadataResponse.Merge(m, src)
+}
+func (m *MsgSyncERC20MetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSyncERC20MetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSyncERC20MetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSyncERC20MetadataResponse proto.InternalMessageInfo
+
+func (m *MsgSyncERC20MetadataResponse) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// MsgConvertERC20AndTransfer defines a conversion from Kava ERC20 to sdk.Coin for EVM-native assets,
+// followed by an IBC transfer of the converted sdk.Coin.
+type MsgConvertERC20AndTransfer struct {
+	// EVM 0x hex address initiating the conversion. Its Kava bech32 equivalent sends the IBC transfer.
+	Initiator string `protobuf:"bytes,1,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	// EVM 0x hex address of the ERC20 contract.
+	KavaERC20Address string `protobuf:"bytes,2,opt,name=kava_erc20_address,json=kavaErc20Address,proto3" json:"kava_erc20_address,omitempty"`
+	// ERC20 token amount to convert and transfer.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// IBC channel of the transfer port by which the packet will be sent.
+	SourceChannel string `protobuf:"bytes,4,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	// Address that will receive the sdk.Coin on the destination chain.
+	Receiver string `protobuf:"bytes,5,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// Timeout height relative to the current block height. The timeout is disabled when set to 0.
+	TimeoutHeight types1.Height `protobuf:"bytes,6,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height"`
+	// Timeout timestamp in absolute nanoseconds since unix epoch. The timeout is disabled when set to 0.
+	TimeoutTimestamp uint64 `protobuf:"varint,7,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// Optional memo included in the transfer packet.
+	Memo string `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *MsgConvertERC20AndTransfer) Reset()         { *m = MsgConvertERC20AndTransfer{} }
+func (m *MsgConvertERC20AndTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertERC20AndTransfer) ProtoMessage()    {}
+func (*MsgConvertERC20AndTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82783c6c58f89c, []int{14}
+}
+func (m *MsgConvertERC20AndTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertERC20AndTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertERC20AndTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertERC20AndTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertERC20AndTransfer.Merge(m, src)
+}
+func (m *MsgConvertERC20AndTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertERC20AndTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertERC20AndTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertERC20AndTransfer proto.InternalMessageInfo
+
+func (m *MsgConvertERC20AndTransfer) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
+	}
+	return ""
+}
+
+func (m *MsgConvertERC20AndTransfer) GetKavaERC20Address() string {
+	if m != nil {
+		return m.KavaERC20Address
+	}
+	return ""
+}
+
+func (m *MsgConvertERC20AndTransfer) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
+	}
+	return ""
+}
+
+func (m *MsgConvertERC20AndTransfer) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *MsgConvertERC20AndTransfer) GetTimeoutHeight() types1.Height {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return types1.Height{}
+}
+
+func (m *MsgConvertERC20AndTransfer) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *MsgConvertERC20AndTransfer) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+// MsgConvertERC20AndTransferResponse defines the response value from Msg/ConvertERC20AndTransfer.
+type MsgConvertERC20AndTransferResponse struct {
+	// Sequence number of the IBC transfer packet.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *MsgConvertERC20AndTransferResponse) Reset()         { *m = MsgConvertERC20AndTransferResponse{} }
+func (m *MsgConvertERC20AndTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertERC20AndTransferResponse) ProtoMessage()    {}
+func (*MsgConvertERC20AndTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82783c6c58f89c, []int{15}
+}
+func (m *MsgConvertERC20AndTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertERC20AndTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertERC20AndTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertERC20AndTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertERC20AndTransferResponse.Merge(m, src)
+}
+func (m *MsgConvertERC20AndTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertERC20AndTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertERC20AndTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertERC20AndTransferResponse proto.InternalMessageInfo
+
+func (m *MsgConvertERC20AndTransferResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgConvertCoinToERC20)(nil), "kava.evmutil.v1beta1.MsgConvertCoinToERC20")
+	proto.RegisterType((*MsgConvertCoinToERC20Response)(nil), "kava.evmutil.v1beta1.MsgConvertCoinToERC20Response")
+	proto.RegisterType((*MsgConvertERC20ToCoin)(nil), "kava.evmutil.v1beta1.MsgConvertERC20ToCoin")
+	proto.RegisterType((*MsgConvertERC20ToCoinResponse)(nil), "kava.evmutil.v1beta1.MsgConvertERC20ToCoinResponse")
+	proto.RegisterType((*MsgConvertCosmosCoinToERC20)(nil), "kava.evmutil.v1beta1.MsgConvertCosmosCoinToERC20")
+	proto.RegisterType((*MsgConvertCosmosCoinToERC20Response)(nil), "kava.evmutil.v1beta1.MsgConvertCosmosCoinToERC20Response")
+	proto.RegisterType((*MsgConvertCosmosCoinFromERC20)(nil), "kava.evmutil.v1beta1.MsgConvertCosmosCoinFromERC20")
+	proto.RegisterType((*MsgConvertCosmosCoinFromERC20Response)(nil), "kava.evmutil.v1beta1.MsgConvertCosmosCoinFromERC20Response")
+	proto.RegisterType((*MsgConvertCosmosCoinsToERC20Batch)(nil), "kava.evmutil.v1beta1.MsgConvertCosmosCoinsToERC20Batch")
+	proto.RegisterType((*MsgConvertCosmosCoinsToERC20BatchResponse)(nil), "kava.evmutil.v1beta1.MsgConvertCosmosCoinsToERC20BatchResponse")
+	proto.RegisterType((*MsgUpdateDeployedCosmosCoinContract)(nil), "kava.evmutil.v1beta1.MsgUpdateDeployedCosmosCoinContract")
+	proto.RegisterType((*MsgUpdateDeployedCosmosCoinContractResponse)(nil), "kava.evmutil.v1beta1.MsgUpdateDeployedCosmosCoinContractResponse")
+	proto.RegisterType((*MsgSyncERC20Metadata)(nil), "kava.evmutil.v1beta1.MsgSyncERC20Metadata")
+	proto.RegisterType((*MsgSyncERC20MetadataResponse)(nil), "kava.evmutil.v1beta1.MsgSyncERC20MetadataResponse")
+	proto.RegisterType((*MsgConvertERC20AndTransfer)(nil), "kava.evmutil.v1beta1.MsgConvertERC20AndTransfer")
+	proto.RegisterType((*MsgConvertERC20AndTransferResponse)(nil), "kava.evmutil.v1beta1.MsgConvertERC20AndTransferResponse")
+}
+
+func init() { proto.RegisterFile("kava/evmutil/v1beta1/tx.proto", fileDescriptor_6e82783c6c58f89c) }
+
+var fileDescriptor_6e82783c6c58f89c = []byte{
+	// 993 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xc4, 0x26, 0x75, 0x5e, 0x69, 0x49, 0x57, 0x06, 0xdc, 0xa5, 0x59, 0xa7, 0x46, 0x81,
+	0x84, 0xc8, 0xbb, 0xb1, 0x83, 0xf8, 0x2f, 0x41, 0xec, 0x16, 0x88, 0xaa, 0x5c, 0xb6, 0xe6, 0xc2,
+	0xc5, 0x1a, 0xaf, 0xa7, 0xf6, 0xaa, 0xde, 0x19, 0x77, 0x67, 0x6c, 0x35, 0xdc, 0x2b, 0x21, 0x84,
+	0x10, 0x67, 0x0e, 0x28, 0x47, 0x04, 0xd7, 0x7e, 0x03, 0x38, 0xf4, 0x84, 0xaa, 0x9e, 0x10, 0x87,
+	0x50, 0x9c, 0x0b, 0x1f, 0x03, 0xed, 0xee, 0x78, 0xe2, 0x24, 0x6b, 0xaf, 0x63, 0x22, 0x71, 0xf2,
+	0xcc, 0x9b, 0xf7, 0x7b, 0xef, 0xf7, 0xde, 0x9b, 0x79, 0xcf, 0x0b, 0x2b, 0xf7, 0xf1, 0x00, 0x5b,
+	0x64, 0xe0, 0xf5, 0x85, 0xdb, 0xb5, 0x06, 0xe5, 0x26, 0x11, 0xb8, 0x6c, 0x89, 0x87, 0x66, 0xcf,
+	0x67, 0x82, 0x69, 0xb9, 0xe0, 0xd8, 0x94, 0xc7, 0xa6, 0x3c, 0xd6, 0x0d, 0x87, 0x71, 0x8f, 0x71,
+	0xab, 0x89, 0x39, 0x51, 0x18, 0x87, 0xb9, 0x34, 0x42, 0xe9, 0xd7, 0xa3, 0xf3, 0x46, 0xb8, 0xb3,
+	0xa2, 0x8d, 0x3c, 0xca, 0xb5, 0x59, 0x9b, 0x45, 0xf2, 0x60, 0x25, 0xa5, 0x05, 0xb7, 0xe9, 0x58,
+	0x0e, 0xf3, 0x89, 0xe5, 0x74, 0x5d, 0x42, 0x85, 0x35, 0x28, 0xcb, 0x55, 0xa4, 0x50, 0xfc, 0x11,
+	0xc1, 0xcb, 0x7b, 0xbc, 0x5d, 0x63, 0x74, 0x40, 0x7c, 0x51, 0x63, 0x2e, 0xad, 0xb3, 0xdb, 0x76,
+	0xad, 0xb2, 0xa5, 0xbd, 0x03, 0x4b, 0x2e, 0x75, 0x85, 0x8b, 0x05, 0xf3, 0xf3, 0x68, 0x15, 0xad,
+	0x2f, 0x55, 0xf3, 0xcf, 0x1e, 0x97, 0x72, 0xd2, 0xeb, 0x4e, 0xab, 0xe5, 0x13, 0xce, 0xef, 0x0a,
+	0xdf, 0xa5, 0x6d, 0xfb, 0x58, 0x55, 0xd3, 0x21, 0xeb, 0x13, 0x87, 0xb8, 0x03, 0xe2, 0xe7, 0x17,
+	0x02, 0x98, 0xad, 0xf6, 0x5a, 0x19, 0x16, 0xb1, 0xc7, 0xfa, 0x54, 0xe4, 0xd3, 0xab, 0x68, 0xfd,
+	0x72, 0xe5, 0xba, 0x29, 0xad, 0x05, 0x01, 0x8f, 0xb2, 0x60, 0x06, 0x2c, 0x6c, 0xa9, 0x58, 0x2c,
+	0xc0, 0x4a, 0x2c, 0x3f, 0x9b, 0xf0, 0x1e, 0xa3, 0x9c, 0x14, 0x1f, 0x2d, 0x8c, 0x47, 0x10, 0x9e,
+	0xd5, 0x59, 0xa0, 0xa8, 0xdd, 0x38, 0x13, 0xc1, 0x38, 0xcf, 0xb7, 0x4f, 0xf3, 0x9c, 0x12, 0xde,
+	0x71, 0x04, 0x55, 0xd0, 0x82, 0xca, 0x35, 0x88, 0xef, 0x54, 0xb6, 0x1a, 0x38, 0xd2, 0x0a, 0xa3,
+	0x59, 0xaa, 0xe6, 0x86, 0x87, 0x85, 0xe5, 0x3b, 0x78, 0x80, 0x43, 0x12, 0xd2, 0x82, 0xbd, 0x1c,
+	0xe8, 0xdf, 0xf6, 0x1d, 0x25, 0xd1, 0xea, 0x2a, 0x0b, 0x99, 0x10, 0xf7, 0xd1, 0x93, 0xc3, 0x42,
+	0xea, 0xcf, 0xc3, 0xc2, 0x1b, 0x6d, 0x57, 0x74, 0xfa, 0x4d, 0xd3, 0x61, 0x9e, 0xac, 0xad, 0xfc,
+	0x29, 0xf1, 0xd6, 0x7d, 0x4b, 0xec, 0xf7, 0x08, 0x37, 0x77, 0xa9, 0x78, 0xf6, 0xb8, 0x04, 0x92,
+	0xe5, 0x2e, 0x15, 0xf1, 0x89, 0x1a, 0x4b, 0x83, 0x4a, 0xd4, 0x37, 0x08, 0x5e, 0x1b, 0x4f, 0x65,
+	0x60, 0x61, 0xbc, 0xe0, 0xd3, 0xd3, 0x75, 0xc1, 0x65, 0x5d, 0x83, 0xd7, 0xa7, 0x70, 0x51, 0x9c,
+	0xbf, 0x45, 0xb0, 0x12, 0xa7, 0xf7, 0xa9, 0xcf, 0xbc, 0xff, 0x81, 0xf5, 0x9b, 0xb0, 0x36, 0x95,
+	0x8d, 0xe2, 0xfd, 0x1b, 0x82, 0x9b, 0x71, 0x9a, 0x5c, 0x06, 0x58, 0xc5, 0xc2, 0xe9, 0xfc, 0x07,
+	0xee, 0x04, 0x2e, 0x45, 0x94, 0x82, 0xbb, 0x97, 0x9e, 0x4a, 0xbe, 0xba, 0x15, 0x5c, 0xaf, 0x9f,
+	0xff, 0x2a, 0xac, 0xcf, 0x70, 0xbd, 0x42, 0x8e, 0xf6, 0xc8, 0x76, 0x71, 0x13, 0x36, 0x12, 0xa3,
+	0x50, 0x31, 0xff, 0x8a, 0xc2, 0x9a, 0x7e, 0xd1, 0x6b, 0x61, 0x41, 0x6e, 0x91, 0x5e, 0x97, 0xed,
+	0x93, 0xd6, 0x31, 0xa8, 0xc6, 0xa8, 0xf0, 0xb1, 0x23, 0x82, 0xc6, 0x82, 0xfb, 0xa2, 0xc3, 0x7c,
+	0x57, 0xec, 0x27, 0x37, 0x16, 0xa5, 0xaa, 0xdd, 0x84, 0x17, 0x65, 0xfb, 0x6b, 0x11, 0xca, 0x3c,
+	0x99, 0x93, 0xcb, 0x91, 0xec, 0x56, 0x20, 0xd2, 0x36, 0x60, 0xd9, 0x91, 0x6e, 0x4e, 0xbe, 0x4d,
+	0xfb, 0xa5, 0x91, 0x7c, 0xf4, 0x08, 0x5f, 0x81, 0xc5, 0x7b, 0x3e, 0x21, 0x5f, 0x91, 0xf0, 0x11,
+	0x66, 0x6d, 0xb9, 0x2b, 0x96, 0x60, 0x73, 0x86, 0x20, 0x54, 0xd0, 0x0f, 0x20, 0xb7, 0xc7, 0xdb,
+	0x77, 0xf7, 0xa9, 0x13, 0x66, 0x64, 0x8f, 0x08, 0xdc, 0xc2, 0x02, 0xcf, 0xdd, 0x3d, 0x93, 0x83,
+	0x2c, 0xee, 0xc2, 0x8d, 0x38, 0x97, 0x23, 0x4a, 0xb1, 0x49, 0x40, 0xb1, 0x49, 0x28, 0xfe, 0x92,
+	0x06, 0xfd, 0x54, 0xd3, 0xd8, 0xa1, 0xad, 0xba, 0x8f, 0x29, 0xbf, 0x47, 0xfc, 0x84, 0xfb, 0x19,
+	0xdf, 0x0a, 0x17, 0xe6, 0x6c, 0x85, 0xe9, 0x8b, 0x6b, 0x85, 0xda, 0x1a, 0x5c, 0xe5, 0xac, 0xef,
+	0x3b, 0xa4, 0xe1, 0x74, 0x30, 0xa5, 0xa4, 0x1b, 0x35, 0x5a, 0xfb, 0x4a, 0x24, 0xad, 0x45, 0xc2,
+	0x13, 0x0f, 0xec, 0x85, 0x53, 0x0f, 0xec, 0x33, 0xb8, 0x2a, 0x5c, 0x8f, 0xb0, 0xbe, 0x68, 0x74,
+	0x88, 0xdb, 0xee, 0x88, 0xfc, 0x62, 0xd8, 0x24, 0x74, 0xd3, 0x6d, 0x3a, 0x66, 0x30, 0x51, 0x4d,
+	0x39, 0x47, 0x07, 0x65, 0xf3, 0xf3, 0x50, 0xa3, 0x9a, 0x09, 0xc8, 0xdb, 0x57, 0x24, 0x2e, 0x12,
+	0x6a, 0x9b, 0x70, 0x6d, 0x64, 0x28, 0xf8, 0xe5, 0x02, 0x7b, 0xbd, 0xfc, 0xa5, 0x55, 0xb4, 0x9e,
+	0xb1, 0x97, 0xe5, 0x41, 0x7d, 0x24, 0xd7, 0x34, 0xc8, 0x78, 0xc4, 0x63, 0xf9, 0x6c, 0xc8, 0x26,
+	0x5c, 0x7f, 0x90, 0x3d, 0x38, 0x28, 0xa4, 0xfe, 0x39, 0x28, 0xa4, 0x8a, 0x9f, 0x40, 0x71, 0x72,
+	0xb1, 0x54, 0xf9, 0x75, 0xc8, 0x72, 0xf2, 0xa0, 0x4f, 0xa8, 0x43, 0xc2, 0x9a, 0x65, 0x6c, 0xb5,
+	0xaf, 0xfc, 0x9e, 0x85, 0xf4, 0x1e, 0x6f, 0x6b, 0x03, 0xd0, 0x62, 0x26, 0xfe, 0xa6, 0x19, 0xf7,
+	0xa7, 0xc4, 0x8c, 0x1d, 0xbf, 0xfa, 0xf6, 0x39, 0x94, 0x15, 0xb7, 0x63, 0xbf, 0xe3, 0x73, 0x3a,
+	0xd1, 0xef, 0x98, 0xb2, 0xbe, 0x7d, 0x0e, 0x65, 0xe5, 0xf7, 0x6b, 0x04, 0xf9, 0x89, 0x73, 0xaf,
+	0x9c, 0x1c, 0xc9, 0x29, 0x88, 0xfe, 0xfe, 0xb9, 0x21, 0x8a, 0xca, 0x77, 0x08, 0xf4, 0x29, 0xe3,
+	0x6c, 0x7b, 0x76, 0xcb, 0x0a, 0xa4, 0x7f, 0x38, 0x07, 0x48, 0x11, 0xfa, 0x01, 0x81, 0x91, 0x30,
+	0xa7, 0xde, 0x9d, 0xdd, 0xfe, 0x09, 0xa0, 0xfe, 0xf1, 0x9c, 0x40, 0x45, 0xee, 0x00, 0xc1, 0x6a,
+	0xe2, 0x40, 0x99, 0x5c, 0x8d, 0x24, 0xa8, 0xbe, 0x33, 0x37, 0x54, 0x51, 0xe4, 0x70, 0xed, 0x6c,
+	0xfb, 0x7f, 0x6b, 0xa2, 0xdd, 0x33, 0xba, 0x7a, 0x65, 0x76, 0x5d, 0xe5, 0xf4, 0x11, 0x82, 0x57,
+	0x27, 0x75, 0xed, 0xad, 0x99, 0x5e, 0xc8, 0x18, 0x42, 0x7f, 0xef, 0xbc, 0x88, 0x11, 0x8f, 0xea,
+	0x9d, 0xe7, 0x7f, 0x1b, 0xe8, 0xa7, 0xa1, 0x81, 0x9e, 0x0c, 0x0d, 0xf4, 0x74, 0x68, 0xa0, 0xe7,
+	0x43, 0x03, 0x7d, 0x7f, 0x64, 0xa4, 0x9e, 0x1e, 0x19, 0xa9, 0x3f, 0x8e, 0x8c, 0xd4, 0x97, 0x1b,
+	0x63, 0x9d, 0x3c, 0xf0, 0x52, 0xea, 0xe2, 0x26, 0x0f, 0x57, 0xd6, 0x43, 0xf5, 0x79, 0x14, 0x36,
+	0xf4, 0xe6, 0x62, 0xf8, 0x49, 0xb2, 0xfd, 0xef, 0x00, 0x56, 0x98, 0xca, 0xcb, 0x3b, 0x0d, 0x00,
+	0x00,
+}
+
+func (this *MsgConvertCoinToERC20) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*MsgConvertCoinToERC20)
+	if !ok {
+		that2, ok := that.(MsgConvertCoinToERC20)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgConvertCoinToERC20")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgConvertCoinToERC20 but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgConvertCoinToERC20 but is not nil && this == nil")
+	}
+	if this.Initiator != that1.Initiator {
+		return fmt.Errorf("Initiator this(%v) Not Equal that(%v)", this.Initiator, that1.Initiator)
+	}
+	if this.Receiver != that1.Receiver {
+		return fmt.Errorf("Receiver this(%v) Not Equal that(%v)", this.Receiver, that1.Receiver)
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return fmt.Errorf("Amount this(%v) Not Equal that(%v)", this.Amount, that1.Amount)
+	}
+	return nil
+}
+func (this *MsgConvertCoinToERC20) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgConvertCoinToERC20)
+	if !ok {
+		that2, ok := that.(MsgConvertCoinToERC20)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Initiator != that1.Initiator {
+		return false
+	}
+	if this.Receiver != that1.Receiver {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (this *MsgConvertCoinToERC20Response) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*MsgConvertCoinToERC20Response)
+	if !ok {
+		that2, ok := that.(MsgConvertCoinToERC20Response)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgConvertCoinToERC20Response")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgConvertCoinToERC20Response but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgConvertCoinToERC20Response but is not nil && this == nil")
+	}
+	return nil
+}
+func (this *MsgConvertCoinToERC20Response) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgConvertCoinToERC20Response)
+	if !ok {
+		that2, ok := that.(MsgConvertCoinToERC20Response)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgConvertERC20ToCoin) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*MsgConvertERC20ToCoin)
+	if !ok {
+		that2, ok := that.(MsgConvertERC20ToCoin)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgConvertERC20ToCoin")
 		}
 	}
 	if that1 == nil {
@@ -952,636 +1460,2306 @@ func (this *MsgConvertCosmosCoinFromERC20Response) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *MsgConvertCosmosCoinsToERC20Batch) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// ConvertCoinToERC20 defines a method for converting sdk.Coin to Kava ERC20.
-	ConvertCoinToERC20(ctx context.Context, in *MsgConvertCoinToERC20, opts ...grpc.CallOption) (*MsgConvertCoinToERC20Response, error)
-	// ConvertERC20ToCoin defines a method for converting Kava ERC20 to sdk.Coin.
-	ConvertERC20ToCoin(ctx context.Context, in *MsgConvertERC20ToCoin, opts ...grpc.CallOption) (*MsgConvertERC20ToCoinResponse, error)
-	// ConvertCosmosCoinToERC20 defines a method for converting a cosmos sdk.Coin to an ERC20.
-	ConvertCosmosCoinToERC20(ctx context.Context, in *MsgConvertCosmosCoinToERC20, opts ...grpc.CallOption) (*MsgConvertCosmosCoinToERC20Response, error)
-	// ConvertCosmosCoinFromERC20 defines a method for converting a cosmos sdk.Coin to an ERC20.
-	ConvertCosmosCoinFromERC20(ctx context.Context, in *MsgConvertCosmosCoinFromERC20, opts ...grpc.CallOption) (*MsgConvertCosmosCoinFromERC20Response, error)
-}
-
-type msgClient struct {
-	cc grpc1.ClientConn
-}
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
-}
-
-func (c *msgClient) ConvertCoinToERC20(ctx context.Context, in *MsgConvertCoinToERC20, opts ...grpc.CallOption) (*MsgConvertCoinToERC20Response, error) {
-	out := new(MsgConvertCoinToERC20Response)
-	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/ConvertCoinToERC20", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgConvertCosmosCoinsToERC20Batch)
+	if !ok {
+		that2, ok := that.(MsgConvertCosmosCoinsToERC20Batch)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgConvertCosmosCoinsToERC20Batch")
+		}
 	}
-	return out, nil
-}
-
-func (c *msgClient) ConvertERC20ToCoin(ctx context.Context, in *MsgConvertERC20ToCoin, opts ...grpc.CallOption) (*MsgConvertERC20ToCoinResponse, error) {
-	out := new(MsgConvertERC20ToCoinResponse)
-	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/ConvertERC20ToCoin", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgConvertCosmosCoinsToERC20Batch but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgConvertCosmosCoinsToERC20Batch but is not nil && this == nil")
 	}
-	return out, nil
-}
-
-func (c *msgClient) ConvertCosmosCoinToERC20(ctx context.Context, in *MsgConvertCosmosCoinToERC20, opts ...grpc.CallOption) (*MsgConvertCosmosCoinToERC20Response, error) {
-	out := new(MsgConvertCosmosCoinToERC20Response)
-	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/ConvertCosmosCoinToERC20", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if this.Initiator != that1.Initiator {
+		return fmt.Errorf("Initiator this(%v) Not Equal that(%v)", this.Initiator, that1.Initiator)
 	}
-	return out, nil
-}
-
-func (c *msgClient) ConvertCosmosCoinFromERC20(ctx context.Context, in *MsgConvertCosmosCoinFromERC20, opts ...grpc.CallOption) (*MsgConvertCosmosCoinFromERC20Response, error) {
-	out := new(MsgConvertCosmosCoinFromERC20Response)
-	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/ConvertCosmosCoinFromERC20", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if this.Receiver != that1.Receiver {
+		return fmt.Errorf("Receiver this(%v) Not Equal that(%v)", this.Receiver, that1.Receiver)
 	}
-	return out, nil
-}
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// ConvertCoinToERC20 defines a method for converting sdk.Coin to Kava ERC20.
-	ConvertCoinToERC20(context.Context, *MsgConvertCoinToERC20) (*MsgConvertCoinToERC20Response, error)
-	// ConvertERC20ToCoin defines a method for converting Kava ERC20 to sdk.Coin.
-	ConvertERC20ToCoin(context.Context, *MsgConvertERC20ToCoin) (*MsgConvertERC20ToCoinResponse, error)
-	// ConvertCosmosCoinToERC20 defines a method for converting a cosmos sdk.Coin to an ERC20.
-	ConvertCosmosCoinToERC20(context.Context, *MsgConvertCosmosCoinToERC20) (*MsgConvertCosmosCoinToERC20Response, error)
-	// ConvertCosmosCoinFromERC20 defines a method for converting a cosmos sdk.Coin to an ERC20.
-	ConvertCosmosCoinFromERC20(context.Context, *MsgConvertCosmosCoinFromERC20) (*MsgConvertCosmosCoinFromERC20Response, error)
-}
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
-}
-
-func (*UnimplementedMsgServer) ConvertCoinToERC20(ctx context.Context, req *MsgConvertCoinToERC20) (*MsgConvertCoinToERC20Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoinToERC20 not implemented")
-}
-func (*UnimplementedMsgServer) ConvertERC20ToCoin(ctx context.Context, req *MsgConvertERC20ToCoin) (*MsgConvertERC20ToCoinResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertERC20ToCoin not implemented")
-}
-func (*UnimplementedMsgServer) ConvertCosmosCoinToERC20(ctx context.Context, req *MsgConvertCosmosCoinToERC20) (*MsgConvertCosmosCoinToERC20Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertCosmosCoinToERC20 not implemented")
-}
-func (*UnimplementedMsgServer) ConvertCosmosCoinFromERC20(ctx context.Context, req *MsgConvertCosmosCoinFromERC20) (*MsgConvertCosmosCoinFromERC20Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertCosmosCoinFromERC20 not implemented")
-}
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+	if len(this.Amounts) != len(that1.Amounts) {
+		return fmt.Errorf("Amounts this(%v) Not Equal that(%v)", len(this.Amounts), len(that1.Amounts))
+	}
+	for i := range this.Amounts {
+		if !this.Amounts[i].Equal(&that1.Amounts[i]) {
+			return fmt.Errorf("Amounts this[%v](%v) Not Equal that[%v](%v)", i, this.Amounts[i], i, that1.Amounts[i])
+		}
+	}
+	return nil
 }
+func (this *MsgConvertCosmosCoinsToERC20Batch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Msg_ConvertCoinToERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConvertCoinToERC20)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*MsgConvertCosmosCoinsToERC20Batch)
+	if !ok {
+		that2, ok := that.(MsgConvertCosmosCoinsToERC20Batch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConvertCoinToERC20(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kava.evmutil.v1beta1.Msg/ConvertCoinToERC20",
+	if this.Initiator != that1.Initiator {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConvertCoinToERC20(ctx, req.(*MsgConvertCoinToERC20))
+	if this.Receiver != that1.Receiver {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	if len(this.Amounts) != len(that1.Amounts) {
+		return false
+	}
+	for i := range this.Amounts {
+		if !this.Amounts[i].Equal(&that1.Amounts[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *MsgConvertCosmosCoinsToERC20BatchResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func _Msg_ConvertERC20ToCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConvertERC20ToCoin)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*MsgConvertCosmosCoinsToERC20BatchResponse)
+	if !ok {
+		that2, ok := that.(MsgConvertCosmosCoinsToERC20BatchResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgConvertCosmosCoinsToERC20BatchResponse")
+		}
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConvertERC20ToCoin(ctx, in)
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgConvertCosmosCoinsToERC20BatchResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgConvertCosmosCoinsToERC20BatchResponse but is not nil && this == nil")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kava.evmutil.v1beta1.Msg/ConvertERC20ToCoin",
+	return nil
+}
+func (this *MsgConvertCosmosCoinsToERC20BatchResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConvertERC20ToCoin(ctx, req.(*MsgConvertERC20ToCoin))
+
+	that1, ok := that.(*MsgConvertCosmosCoinsToERC20BatchResponse)
+	if !ok {
+		that2, ok := that.(MsgConvertCosmosCoinsToERC20BatchResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return interceptor(ctx, in, info, handler)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *MsgUpdateDeployedCosmosCoinContract) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func _Msg_ConvertCosmosCoinToERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConvertCosmosCoinToERC20)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*MsgUpdateDeployedCosmosCoinContract)
+	if !ok {
+		that2, ok := that.(MsgUpdateDeployedCosmosCoinContract)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgUpdateDeployedCosmosCoinContract")
+		}
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConvertCosmosCoinToERC20(ctx, in)
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgUpdateDeployedCosmosCoinContract but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgUpdateDeployedCosmosCoinContract but is not nil && this == nil")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kava.evmutil.v1beta1.Msg/ConvertCosmosCoinToERC20",
+	if this.Authority != that1.Authority {
+		return fmt.Errorf("Authority this(%v) Not Equal that(%v)", this.Authority, that1.Authority)
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConvertCosmosCoinToERC20(ctx, req.(*MsgConvertCosmosCoinToERC20))
+	if this.CosmosDenom != that1.CosmosDenom {
+		return fmt.Errorf("CosmosDenom this(%v) Not Equal that(%v)", this.CosmosDenom, that1.CosmosDenom)
 	}
-	return interceptor(ctx, in, info, handler)
+	if this.ContractAddress != that1.ContractAddress {
+		return fmt.Errorf("ContractAddress this(%v) Not Equal that(%v)", this.ContractAddress, that1.ContractAddress)
+	}
+	if this.Freeze != that1.Freeze {
+		return fmt.Errorf("Freeze this(%v) Not Equal that(%v)", this.Freeze, that1.Freeze)
+	}
+	return nil
 }
+func (this *MsgUpdateDeployedCosmosCoinContract) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Msg_ConvertCosmosCoinFromERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConvertCosmosCoinFromERC20)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*MsgUpdateDeployedCosmosCoinContract)
+	if !ok {
+		that2, ok := that.(MsgUpdateDeployedCosmosCoinContract)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConvertCosmosCoinFromERC20(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kava.evmutil.v1beta1.Msg/ConvertCosmosCoinFromERC20",
+	if this.Authority != that1.Authority {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConvertCosmosCoinFromERC20(ctx, req.(*MsgConvertCosmosCoinFromERC20))
+	if this.CosmosDenom != that1.CosmosDenom {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.Freeze != that1.Freeze {
+		return false
+	}
+	return true
 }
+func (this *MsgUpdateDeployedCosmosCoinContractResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kava.evmutil.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ConvertCoinToERC20",
-			Handler:    _Msg_ConvertCoinToERC20_Handler,
-		},
-		{
-			MethodName: "ConvertERC20ToCoin",
-			Handler:    _Msg_ConvertERC20ToCoin_Handler,
-		},
-		{
-			MethodName: "ConvertCosmosCoinToERC20",
-			Handler:    _Msg_ConvertCosmosCoinToERC20_Handler,
-		},
-		{
-			MethodName: "ConvertCosmosCoinFromERC20",
-			Handler:    _Msg_ConvertCosmosCoinFromERC20_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kava/evmutil/v1beta1/tx.proto",
-}
-
-func (m *MsgConvertCoinToERC20) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgUpdateDeployedCosmosCoinContractResponse)
+	if !ok {
+		that2, ok := that.(MsgUpdateDeployedCosmosCoinContractResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgUpdateDeployedCosmosCoinContractResponse")
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgUpdateDeployedCosmosCoinContractResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgUpdateDeployedCosmosCoinContractResponse but is not nil && this == nil")
+	}
+	return nil
 }
+func (this *MsgUpdateDeployedCosmosCoinContractResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgConvertCoinToERC20) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*MsgUpdateDeployedCosmosCoinContractResponse)
+	if !ok {
+		that2, ok := that.(MsgUpdateDeployedCosmosCoinContractResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *MsgSyncERC20Metadata) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *MsgConvertCoinToERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != nil {
-		{
-			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	that1, ok := that.(*MsgSyncERC20Metadata)
+	if !ok {
+		that2, ok := that.(MsgSyncERC20Metadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgSyncERC20Metadata")
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgSyncERC20Metadata but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgSyncERC20Metadata but is not nil && this == nil")
 	}
-	if len(m.Initiator) > 0 {
-		i -= len(m.Initiator)
-		copy(dAtA[i:], m.Initiator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Initiator)))
-		i--
-		dAtA[i] = 0xa
+	if this.Initiator != that1.Initiator {
+		return fmt.Errorf("Initiator this(%v) Not Equal that(%v)", this.Initiator, that1.Initiator)
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgConvertCoinToERC20Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.CosmosDenom != that1.CosmosDenom {
+		return fmt.Errorf("CosmosDenom this(%v) Not Equal that(%v)", this.CosmosDenom, that1.CosmosDenom)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgConvertCoinToERC20Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgConvertCoinToERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return nil
 }
+func (this *MsgSyncERC20Metadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgConvertERC20ToCoin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgSyncERC20Metadata)
+	if !ok {
+		that2, ok := that.(MsgSyncERC20Metadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Initiator != that1.Initiator {
+		return false
+	}
+	if this.CosmosDenom != that1.CosmosDenom {
+		return false
+	}
+	return true
 }
+func (this *MsgSyncERC20MetadataResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *MsgConvertERC20ToCoin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*MsgSyncERC20MetadataResponse)
+	if !ok {
+		that2, ok := that.(MsgSyncERC20MetadataResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgSyncERC20MetadataResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgSyncERC20MetadataResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgSyncERC20MetadataResponse but is not nil && this == nil")
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return fmt.Errorf("ContractAddress this(%v) Not Equal that(%v)", this.ContractAddress, that1.ContractAddress)
+	}
+	return nil
 }
+func (this *MsgSyncERC20MetadataResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgConvertERC20ToCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*MsgSyncERC20MetadataResponse)
+	if !ok {
+		that2, ok := that.(MsgSyncERC20MetadataResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.KavaERC20Address) > 0 {
-		i -= len(m.KavaERC20Address)
-		copy(dAtA[i:], m.KavaERC20Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.KavaERC20Address)))
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Initiator) > 0 {
-		i -= len(m.Initiator)
-		copy(dAtA[i:], m.Initiator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Initiator)))
-		i--
-		dAtA[i] = 0xa
+	if this.ContractAddress != that1.ContractAddress {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *MsgConvertERC20AndTransferResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *MsgConvertERC20ToCoinResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgConvertERC20AndTransferResponse)
+	if !ok {
+		that2, ok := that.(MsgConvertERC20AndTransferResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgConvertERC20AndTransferResponse")
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgConvertERC20AndTransferResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgConvertERC20AndTransferResponse but is not nil && this == nil")
+	}
+	if this.Sequence != that1.Sequence {
+		return fmt.Errorf("Sequence this(%v) Not Equal that(%v)", this.Sequence, that1.Sequence)
+	}
+	return nil
 }
+func (this *MsgConvertERC20AndTransferResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgConvertERC20ToCoinResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*MsgConvertERC20AndTransferResponse)
+	if !ok {
+		that2, ok := that.(MsgConvertERC20AndTransferResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Sequence != that1.Sequence {
+		return false
+	}
+	return true
 }
 
-func (m *MsgConvertERC20ToCoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ConvertCoinToERC20 defines a method for converting sdk.Coin to Kava ERC20.
+	ConvertCoinToERC20(ctx context.Context, in *MsgConvertCoinToERC20, opts ...grpc.CallOption) (*MsgConvertCoinToERC20Response, error)
+	// ConvertERC20ToCoin defines a method for converting Kava ERC20 to sdk.Coin.
+	ConvertERC20ToCoin(ctx context.Context, in *MsgConvertERC20ToCoin, opts ...grpc.CallOption) (*MsgConvertERC20ToCoinResponse, error)
+	// ConvertCosmosCoinToERC20 defines a method for converting a cosmos sdk.Coin to an ERC20.
+	ConvertCosmosCoinToERC20(ctx context.Context, in *MsgConvertCosmosCoinToERC20, opts ...grpc.CallOption) (*MsgConvertCosmosCoinToERC20Response, error)
+	// ConvertCosmosCoinFromERC20 defines a method for converting a cosmos sdk.Coin to an ERC20.
+	ConvertCosmosCoinFromERC20(ctx context.Context, in *MsgConvertCosmosCoinFromERC20, opts ...grpc.CallOption) (*MsgConvertCosmosCoinFromERC20Response, error)
+	// ConvertCosmosCoinsToERC20Batch defines a method for converting multiple cosmos sdk.Coins to their ERC20s.
+	ConvertCosmosCoinsToERC20Batch(ctx context.Context, in *MsgConvertCosmosCoinsToERC20Batch, opts ...grpc.CallOption) (*MsgConvertCosmosCoinsToERC20BatchResponse, error)
+	// UpdateDeployedCosmosCoinContract defines a governance method for repointing a cosmos denom
+	// to a new ERC20 contract and freezing conversions of deployed contracts.
+	UpdateDeployedCosmosCoinContract(ctx context.Context, in *MsgUpdateDeployedCosmosCoinContract, opts ...grpc.CallOption) (*MsgUpdateDeployedCosmosCoinContractResponse, error)
+	// SyncERC20Metadata defines a method for redeploying a cosmos coin's ERC20 contract with its current x/bank denom metadata.
+	SyncERC20Metadata(ctx context.Context, in *MsgSyncERC20Metadata, opts ...grpc.CallOption) (*MsgSyncERC20MetadataResponse, error)
+	// ConvertERC20AndTransfer defines a method for converting Kava ERC20 to sdk.Coin and sending
+	// the sdk.Coin to another chain over IBC in a single transaction.
+	ConvertERC20AndTransfer(ctx context.Context, in *MsgConvertERC20AndTransfer, opts ...grpc.CallOption) (*MsgConvertERC20AndTransferResponse, error)
 }
 
-func (m *MsgConvertCosmosCoinToERC20) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgConvertCosmosCoinToERC20) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgConvertCosmosCoinToERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != nil {
-		{
-			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Initiator) > 0 {
-		i -= len(m.Initiator)
-		copy(dAtA[i:], m.Initiator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Initiator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ConvertCoinToERC20(ctx context.Context, in *MsgConvertCoinToERC20, opts ...grpc.CallOption) (*MsgConvertCoinToERC20Response, error) {
+	out := new(MsgConvertCoinToERC20Response)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/ConvertCoinToERC20", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgConvertCosmosCoinToERC20Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ConvertERC20ToCoin(ctx context.Context, in *MsgConvertERC20ToCoin, opts ...grpc.CallOption) (*MsgConvertERC20ToCoinResponse, error) {
+	out := new(MsgConvertERC20ToCoinResponse)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/ConvertERC20ToCoin", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgConvertCosmosCoinToERC20Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ConvertCosmosCoinToERC20(ctx context.Context, in *MsgConvertCosmosCoinToERC20, opts ...grpc.CallOption) (*MsgConvertCosmosCoinToERC20Response, error) {
+	out := new(MsgConvertCosmosCoinToERC20Response)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/ConvertCosmosCoinToERC20", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgConvertCosmosCoinToERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ConvertCosmosCoinFromERC20(ctx context.Context, in *MsgConvertCosmosCoinFromERC20, opts ...grpc.CallOption) (*MsgConvertCosmosCoinFromERC20Response, error) {
+	out := new(MsgConvertCosmosCoinFromERC20Response)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/ConvertCosmosCoinFromERC20", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgConvertCosmosCoinFromERC20) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ConvertCosmosCoinsToERC20Batch(ctx context.Context, in *MsgConvertCosmosCoinsToERC20Batch, opts ...grpc.CallOption) (*MsgConvertCosmosCoinsToERC20BatchResponse, error) {
+	out := new(MsgConvertCosmosCoinsToERC20BatchResponse)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/ConvertCosmosCoinsToERC20Batch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgConvertCosmosCoinFromERC20) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateDeployedCosmosCoinContract(ctx context.Context, in *MsgUpdateDeployedCosmosCoinContract, opts ...grpc.CallOption) (*MsgUpdateDeployedCosmosCoinContractResponse, error) {
+	out := new(MsgUpdateDeployedCosmosCoinContractResponse)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/UpdateDeployedCosmosCoinContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgConvertCosmosCoinFromERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != nil {
-		{
-			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Initiator) > 0 {
-		i -= len(m.Initiator)
-		copy(dAtA[i:], m.Initiator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Initiator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SyncERC20Metadata(ctx context.Context, in *MsgSyncERC20Metadata, opts ...grpc.CallOption) (*MsgSyncERC20MetadataResponse, error) {
+	out := new(MsgSyncERC20MetadataResponse)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/SyncERC20Metadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgConvertCosmosCoinFromERC20Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ConvertERC20AndTransfer(ctx context.Context, in *MsgConvertERC20AndTransfer, opts ...grpc.CallOption) (*MsgConvertERC20AndTransferResponse, error) {
+	out := new(MsgConvertERC20AndTransferResponse)
+	err := c.cc.Invoke(ctx, "/kava.evmutil.v1beta1.Msg/ConvertERC20AndTransfer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgConvertCosmosCoinFromERC20Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ConvertCoinToERC20 defines a method for converting sdk.Coin to Kava ERC20.
+	ConvertCoinToERC20(context.Context, *MsgConvertCoinToERC20) (*MsgConvertCoinToERC20Response, error)
+	// ConvertERC20ToCoin defines a method for converting Kava ERC20 to sdk.Coin.
+	ConvertERC20ToCoin(context.Context, *MsgConvertERC20ToCoin) (*MsgConvertERC20ToCoinResponse, error)
+	// ConvertCosmosCoinToERC20 defines a method for converting a cosmos sdk.Coin to an ERC20.
+	ConvertCosmosCoinToERC20(context.Context, *MsgConvertCosmosCoinToERC20) (*MsgConvertCosmosCoinToERC20Response, error)
+	// ConvertCosmosCoinFromERC20 defines a method for converting a cosmos sdk.Coin to an ERC20.
+	ConvertCosmosCoinFromERC20(context.Context, *MsgConvertCosmosCoinFromERC20) (*MsgConvertCosmosCoinFromERC20Response, error)
+	// ConvertCosmosCoinsToERC20Batch defines a method for converting multiple cosmos sdk.Coins to their ERC20s.
+	ConvertCosmosCoinsToERC20Batch(context.Context, *MsgConvertCosmosCoinsToERC20Batch) (*MsgConvertCosmosCoinsToERC20BatchResponse, error)
+	// UpdateDeployedCosmosCoinContract defines a governance method for repointing a cosmos denom
+	// to a new ERC20 contract and freezing conversions of deployed contracts.
+	UpdateDeployedCosmosCoinContract(context.Context, *MsgUpdateDeployedCosmosCoinContract) (*MsgUpdateDeployedCosmosCoinContractResponse, error)
+	// SyncERC20Metadata defines a method for redeploying a cosmos coin's ERC20 contract with its current x/bank denom metadata.
+	SyncERC20Metadata(context.Context, *MsgSyncERC20Metadata) (*MsgSyncERC20MetadataResponse, error)
+	// ConvertERC20AndTransfer defines a method for converting Kava ERC20 to sdk.Coin and sending
+	// the sdk.Coin to another chain over IBC in a single transaction.
+	ConvertERC20AndTransfer(context.Context, *MsgConvertERC20AndTransfer) (*MsgConvertERC20AndTransferResponse, error)
 }
 
-func (m *MsgConvertCosmosCoinFromERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedMsgServer) ConvertCoinToERC20(ctx context.Context, req *MsgConvertCoinToERC20) (*MsgConvertCoinToERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoinToERC20 not implemented")
 }
-func (m *MsgConvertCoinToERC20) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Initiator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Amount != nil {
-		l = m.Amount.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (*UnimplementedMsgServer) ConvertERC20ToCoin(ctx context.Context, req *MsgConvertERC20ToCoin) (*MsgConvertERC20ToCoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertERC20ToCoin not implemented")
+}
+func (*UnimplementedMsgServer) ConvertCosmosCoinToERC20(ctx context.Context, req *MsgConvertCosmosCoinToERC20) (*MsgConvertCosmosCoinToERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertCosmosCoinToERC20 not implemented")
+}
+func (*UnimplementedMsgServer) ConvertCosmosCoinFromERC20(ctx context.Context, req *MsgConvertCosmosCoinFromERC20) (*MsgConvertCosmosCoinFromERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertCosmosCoinFromERC20 not implemented")
+}
+func (*UnimplementedMsgServer) ConvertCosmosCoinsToERC20Batch(ctx context.Context, req *MsgConvertCosmosCoinsToERC20Batch) (*MsgConvertCosmosCoinsToERC20BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertCosmosCoinsToERC20Batch not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDeployedCosmosCoinContract(ctx context.Context, req *MsgUpdateDeployedCosmosCoinContract) (*MsgUpdateDeployedCosmosCoinContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeployedCosmosCoinContract not implemented")
+}
+func (*UnimplementedMsgServer) SyncERC20Metadata(ctx context.Context, req *MsgSyncERC20Metadata) (*MsgSyncERC20MetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncERC20Metadata not implemented")
+}
+func (*UnimplementedMsgServer) ConvertERC20AndTransfer(ctx context.Context, req *MsgConvertERC20AndTransfer) (*MsgConvertERC20AndTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertERC20AndTransfer not implemented")
 }
 
-func (m *MsgConvertCoinToERC20Response) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgConvertERC20ToCoin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Initiator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_ConvertCoinToERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertCoinToERC20)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertCoinToERC20(ctx, in)
 	}
-	l = len(m.KavaERC20Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Msg/ConvertCoinToERC20",
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertCoinToERC20(ctx, req.(*MsgConvertCoinToERC20))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConvertERC20ToCoinResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ConvertERC20ToCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertERC20ToCoin)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertERC20ToCoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Msg/ConvertERC20ToCoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertERC20ToCoin(ctx, req.(*MsgConvertERC20ToCoin))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConvertCosmosCoinToERC20) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ConvertCosmosCoinToERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertCosmosCoinToERC20)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Initiator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertCosmosCoinToERC20(ctx, in)
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Msg/ConvertCosmosCoinToERC20",
 	}
-	if m.Amount != nil {
-		l = m.Amount.Size()
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertCosmosCoinToERC20(ctx, req.(*MsgConvertCosmosCoinToERC20))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConvertCosmosCoinToERC20Response) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ConvertCosmosCoinFromERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertCosmosCoinFromERC20)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertCosmosCoinFromERC20(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Msg/ConvertCosmosCoinFromERC20",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertCosmosCoinFromERC20(ctx, req.(*MsgConvertCosmosCoinFromERC20))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConvertCosmosCoinFromERC20) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ConvertCosmosCoinsToERC20Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertCosmosCoinsToERC20Batch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertCosmosCoinsToERC20Batch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Msg/ConvertCosmosCoinsToERC20Batch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertCosmosCoinsToERC20Batch(ctx, req.(*MsgConvertCosmosCoinsToERC20Batch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDeployedCosmosCoinContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDeployedCosmosCoinContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDeployedCosmosCoinContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Msg/UpdateDeployedCosmosCoinContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDeployedCosmosCoinContract(ctx, req.(*MsgUpdateDeployedCosmosCoinContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SyncERC20Metadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSyncERC20Metadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SyncERC20Metadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Msg/SyncERC20Metadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SyncERC20Metadata(ctx, req.(*MsgSyncERC20Metadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertERC20AndTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertERC20AndTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertERC20AndTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.evmutil.v1beta1.Msg/ConvertERC20AndTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertERC20AndTransfer(ctx, req.(*MsgConvertERC20AndTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kava.evmutil.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ConvertCoinToERC20",
+			Handler:    _Msg_ConvertCoinToERC20_Handler,
+		},
+		{
+			MethodName: "ConvertERC20ToCoin",
+			Handler:    _Msg_ConvertERC20ToCoin_Handler,
+		},
+		{
+			MethodName: "ConvertCosmosCoinToERC20",
+			Handler:    _Msg_ConvertCosmosCoinToERC20_Handler,
+		},
+		{
+			MethodName: "ConvertCosmosCoinFromERC20",
+			Handler:    _Msg_ConvertCosmosCoinFromERC20_Handler,
+		},
+		{
+			MethodName: "ConvertCosmosCoinsToERC20Batch",
+			Handler:    _Msg_ConvertCosmosCoinsToERC20Batch_Handler,
+		},
+		{
+			MethodName: "UpdateDeployedCosmosCoinContract",
+			Handler:    _Msg_UpdateDeployedCosmosCoinContract_Handler,
+		},
+		{
+			MethodName: "SyncERC20Metadata",
+			Handler:    _Msg_SyncERC20Metadata_Handler,
+		},
+		{
+			MethodName: "ConvertERC20AndTransfer",
+			Handler:    _Msg_ConvertERC20AndTransfer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kava/evmutil/v1beta1/tx.proto",
+}
+
+func (m *MsgConvertCoinToERC20) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCoinToERC20) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCoinToERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Initiator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Amount != nil {
-		l = m.Amount.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Initiator) > 0 {
+		i -= len(m.Initiator)
+		copy(dAtA[i:], m.Initiator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Initiator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCoinToERC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCoinToERC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCoinToERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertERC20ToCoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertERC20ToCoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertERC20ToCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.KavaERC20Address) > 0 {
+		i -= len(m.KavaERC20Address)
+		copy(dAtA[i:], m.KavaERC20Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KavaERC20Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Initiator) > 0 {
+		i -= len(m.Initiator)
+		copy(dAtA[i:], m.Initiator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Initiator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertERC20ToCoinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertERC20ToCoinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertERC20ToCoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCosmosCoinToERC20) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCosmosCoinToERC20) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCosmosCoinToERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Initiator) > 0 {
+		i -= len(m.Initiator)
+		copy(dAtA[i:], m.Initiator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Initiator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCosmosCoinToERC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCosmosCoinToERC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCosmosCoinToERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCosmosCoinFromERC20) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCosmosCoinFromERC20) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCosmosCoinFromERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Initiator) > 0 {
+		i -= len(m.Initiator)
+		copy(dAtA[i:], m.Initiator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Initiator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCosmosCoinFromERC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCosmosCoinFromERC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCosmosCoinFromERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCosmosCoinsToERC20Batch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCosmosCoinsToERC20Batch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCosmosCoinsToERC20Batch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Initiator) > 0 {
+		i -= len(m.Initiator)
+		copy(dAtA[i:], m.Initiator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Initiator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCosmosCoinsToERC20BatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCosmosCoinsToERC20BatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCosmosCoinsToERC20BatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDeployedCosmosCoinContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDeployedCosmosCoinContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDeployedCosmosCoinContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Freeze {
+		i--
+		if m.Freeze {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CosmosDenom) > 0 {
+		i -= len(m.CosmosDenom)
+		copy(dAtA[i:], m.CosmosDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDeployedCosmosCoinContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDeployedCosmosCoinContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDeployedCosmosCoinContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSyncERC20Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSyncERC20Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSyncERC20Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CosmosDenom) > 0 {
+		i -= len(m.CosmosDenom)
+		copy(dAtA[i:], m.CosmosDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Initiator) > 0 {
+		i -= len(m.Initiator)
+		copy(dAtA[i:], m.Initiator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Initiator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSyncERC20MetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSyncERC20MetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSyncERC20MetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertERC20AndTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertERC20AndTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertERC20AndTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.KavaERC20Address) > 0 {
+		i -= len(m.KavaERC20Address)
+		copy(dAtA[i:], m.KavaERC20Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KavaERC20Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Initiator) > 0 {
+		i -= len(m.Initiator)
+		copy(dAtA[i:], m.Initiator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Initiator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertERC20AndTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertERC20AndTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertERC20AndTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgConvertCoinToERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Initiator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertCoinToERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertERC20ToCoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Initiator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KavaERC20Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgConvertERC20ToCoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertCosmosCoinToERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Initiator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertCosmosCoinToERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertCosmosCoinFromERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Initiator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertCosmosCoinFromERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertCosmosCoinsToERC20Batch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Initiator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgConvertCosmosCoinsToERC20BatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateDeployedCosmosCoinContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CosmosDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Freeze {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateDeployedCosmosCoinContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSyncERC20Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Initiator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CosmosDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSyncERC20MetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertERC20AndTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Initiator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KavaERC20Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertERC20AndTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgConvertCoinToERC20) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoinToERC20: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoinToERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Initiator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCoinToERC20Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoinToERC20Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoinToERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC20ToCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20ToCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20ToCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Initiator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KavaERC20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KavaERC20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC20ToCoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20ToCoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20ToCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCosmosCoinToERC20) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCosmosCoinToERC20: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCosmosCoinToERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Initiator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgConvertCosmosCoinFromERC20Response) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgConvertCosmosCoinToERC20Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCosmosCoinToERC20Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCosmosCoinToERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgConvertCoinToERC20) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertCosmosCoinFromERC20) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1604,10 +3782,10 @@ func (m *MsgConvertCoinToERC20) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoinToERC20: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertCosmosCoinFromERC20: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoinToERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertCosmosCoinFromERC20: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1731,7 +3909,7 @@ func (m *MsgConvertCoinToERC20) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCoinToERC20Response) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertCosmosCoinFromERC20Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1754,10 +3932,10 @@ func (m *MsgConvertCoinToERC20Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoinToERC20Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertCosmosCoinFromERC20Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoinToERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertCosmosCoinFromERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1781,7 +3959,7 @@ func (m *MsgConvertCoinToERC20Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertERC20ToCoin) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertCosmosCoinsToERC20Batch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1804,10 +3982,10 @@ func (m *MsgConvertERC20ToCoin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertERC20ToCoin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertCosmosCoinsToERC20Batch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertERC20ToCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertCosmosCoinsToERC20Batch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1876,7 +4054,141 @@ func (m *MsgConvertERC20ToCoin) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KavaERC20Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amounts = append(m.Amounts, types.Coin{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCosmosCoinsToERC20BatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCosmosCoinsToERC20BatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCosmosCoinsToERC20BatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDeployedCosmosCoinContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDeployedCosmosCoinContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDeployedCosmosCoinContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1904,11 +4216,11 @@ func (m *MsgConvertERC20ToCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KavaERC20Address = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1936,10 +4248,60 @@ func (m *MsgConvertERC20ToCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freeze", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Freeze = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1961,7 +4323,7 @@ func (m *MsgConvertERC20ToCoin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertERC20ToCoinResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateDeployedCosmosCoinContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1984,10 +4346,10 @@ func (m *MsgConvertERC20ToCoinResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertERC20ToCoinResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateDeployedCosmosCoinContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertERC20ToCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateDeployedCosmosCoinContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2011,7 +4373,7 @@ func (m *MsgConvertERC20ToCoinResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCosmosCoinToERC20) Unmarshal(dAtA []byte) error {
+func (m *MsgSyncERC20Metadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2034,10 +4396,10 @@ func (m *MsgConvertCosmosCoinToERC20) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCosmosCoinToERC20: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSyncERC20Metadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCosmosCoinToERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSyncERC20Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2074,7 +4436,7 @@ func (m *MsgConvertCosmosCoinToERC20) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2102,43 +4464,7 @@ func (m *MsgConvertCosmosCoinToERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Amount == nil {
-				m.Amount = &types.Coin{}
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2161,7 +4487,7 @@ func (m *MsgConvertCosmosCoinToERC20) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCosmosCoinToERC20Response) Unmarshal(dAtA []byte) error {
+func (m *MsgSyncERC20MetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2184,12 +4510,44 @@ func (m *MsgConvertCosmosCoinToERC20Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCosmosCoinToERC20Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSyncERC20MetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCosmosCoinToERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSyncERC20MetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2211,7 +4569,7 @@ func (m *MsgConvertCosmosCoinToERC20Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCosmosCoinFromERC20) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertERC20AndTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2234,10 +4592,10 @@ func (m *MsgConvertCosmosCoinFromERC20) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCosmosCoinFromERC20: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertERC20AndTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCosmosCoinFromERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertERC20AndTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2274,7 +4632,7 @@ func (m *MsgConvertCosmosCoinFromERC20) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KavaERC20Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2302,12 +4660,110 @@ func (m *MsgConvertCosmosCoinFromERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.KavaERC20Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -2333,13 +4789,61 @@ func (m *MsgConvertCosmosCoinFromERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Amount == nil {
-				m.Amount = &types.Coin{}
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2361,7 +4865,7 @@ func (m *MsgConvertCosmosCoinFromERC20) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCosmosCoinFromERC20Response) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertERC20AndTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2384,12 +4888,31 @@ func (m *MsgConvertCosmosCoinFromERC20Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCosmosCoinFromERC20Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertERC20AndTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCosmosCoinFromERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertERC20AndTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
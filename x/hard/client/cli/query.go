@@ -19,6 +19,11 @@ const (
 	flagName  = "name"
 	flagDenom = "denom"
 	flagOwner = "owner"
+
+	flagDeposit  = "deposit"
+	flagWithdraw = "withdraw"
+	flagBorrow   = "borrow"
+	flagRepay    = "repay"
 )
 
 // GetQueryCmd returns the cli query commands for the  module
@@ -43,6 +48,9 @@ func GetQueryCmd() *cobra.Command {
 		queryInterestRateCmd(),
 		queryReserves(),
 		queryInterestFactorsCmd(),
+		queryRateHistoryCmd(),
+		queryAccountHealthCmd(),
+		queryInvariantsCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -532,3 +540,124 @@ func queryInterestFactorsCmd() *cobra.Command {
 
 	return cmd
 }
+
+func queryRateHistoryCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rate-history [denom]",
+		Short: "get the recent utilization and interest rates of a money market",
+		Long: fmt.Sprintf(`get the utilization ratio, borrow APY, and supply APY of a money market at its last %d interest accruals, from oldest to newest.`,
+			types.RateHistoryLength),
+		Example: fmt.Sprintf(`%s q %s rate-history bnb`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RateHistory(context.Background(), &types.QueryRateHistoryRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryAccountHealthCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-health [owner]",
+		Short: "get the health of an address's deposit and borrow",
+		Long: `get an address's LTV, health factor, and the prices of its deposited coins at which its borrow can be liquidated.
+Hypothetical deposits, withdrawals, borrows, and repayments can be simulated with flags.`,
+		Example: fmt.Sprintf(`%[1]s q %[2]s account-health kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny
+%[1]s q %[2]s account-health kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny --withdraw 1000000bnb --borrow 10000000usdx`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			simulatedCoins := make(map[string]sdk.Coins)
+			for _, flag := range []string{flagDeposit, flagWithdraw, flagBorrow, flagRepay} {
+				coinsStr, err := cmd.Flags().GetString(flag)
+				if err != nil {
+					return err
+				}
+				if len(coinsStr) == 0 {
+					continue
+				}
+				coins, err := sdk.ParseCoinsNormalized(coinsStr)
+				if err != nil {
+					return err
+				}
+				simulatedCoins[flag] = coins
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(simulatedCoins) == 0 {
+				res, err := queryClient.AccountHealth(context.Background(), &types.QueryAccountHealthRequest{
+					Owner: owner.String(),
+				})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			res, err := queryClient.SimulateAccountHealth(context.Background(), &types.QuerySimulateAccountHealthRequest{
+				Owner:    owner.String(),
+				Deposit:  simulatedCoins[flagDeposit],
+				Withdraw: simulatedCoins[flagWithdraw],
+				Borrow:   simulatedCoins[flagBorrow],
+				Repay:    simulatedCoins[flagRepay],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagDeposit, "", "(optional) simulate depositing coins")
+	cmd.Flags().String(flagWithdraw, "", "(optional) simulate withdrawing coins")
+	cmd.Flags().String(flagBorrow, "", "(optional) simulate borrowing coins")
+	cmd.Flags().String(flagRepay, "", "(optional) simulate repaying coins")
+
+	return cmd
+}
+
+func queryInvariantsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "invariants",
+		Short:   "run the hard module invariants",
+		Long:    "Run the hard module invariants against the current state and get whether each of them is broken.",
+		Example: fmt.Sprintf(`%s q %s invariants`, version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Invariants(context.Background(), &types.QueryInvariantsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 
 	"github.com/kava-labs/kava/x/hard/types"
 )
@@ -31,6 +32,12 @@ func GetTxCmd() *cobra.Command {
 		getCmdBorrow(),
 		getCmdRepay(),
 		getCmdLiquidate(),
+		getCmdFlashBorrow(),
+		getCmdSetEMode(),
+		getCmdDepositAndBorrow(),
+		getCmdRepayAndWithdraw(),
+		getCmdLiquidateDirect(),
+		getCmdTransferPosition(),
 	}
 
 	for _, cmd := range cmds {
@@ -203,3 +210,194 @@ func getCmdLiquidate() *cobra.Command {
 		},
 	}
 }
+
+func getCmdFlashBorrow() *cobra.Command {
+	return &cobra.Command{
+		Use:   "flash-borrow [amount] [tx-json-file]",
+		Short: "flash borrow tokens from the hard protocol",
+		Long: strings.TrimSpace(`flash borrows tokens from the hard protocol, executes the msgs in the tx file, then repays the tokens plus the flash loan fee.
+The msgs must be signed by the borrower.`),
+		Args: cobra.ExactArgs(2),
+		Example: fmt.Sprintf(
+			`%s tx bank send <key> <recipient> 1000000000ukava --generate-only > tx.json && %s tx %s flash-borrow 1000000000ukava tx.json --from <key>`, version.AppName, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgFlashBorrow(clientCtx.GetFromAddress(), coins, theTx.GetMsgs())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdSetEMode() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-emode [category]",
+		Short: "enable or disable an e-mode category for your account",
+		Long: strings.TrimSpace(`enables an e-mode category for your account, allowing a higher loan-to-value for deposits of markets in the category.
+In e-mode only markets in the category can be borrowed. Pass an empty category to disable e-mode.`),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(
+			`%s tx %s set-emode usd --from <key>
+%s tx %s set-emode "" --from <key>`, version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetEMode(clientCtx.GetFromAddress(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdDepositAndBorrow() *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposit-and-borrow [deposit-amount] [borrow-amount]",
+		Short: "deposit coins to and borrow coins from the hard protocol in a single tx",
+		Long:  strings.TrimSpace(`deposits coins to the hard protocol then borrows coins against the updated deposit, failing both if either fails`),
+		Args:  cobra.ExactArgs(2),
+		Example: fmt.Sprintf(
+			`%s tx %s deposit-and-borrow 10000000bnb 1000000000ukava --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			depositCoins, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			borrowCoins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositAndBorrow(clientCtx.GetFromAddress(), depositCoins, borrowCoins)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdRepayAndWithdraw() *cobra.Command {
+	return &cobra.Command{
+		Use:   "repay-and-withdraw [repay-amount] [withdraw-amount]",
+		Short: "repay coins to and withdraw coins from the hard protocol in a single tx",
+		Long:  strings.TrimSpace(`repays coins borrowed from the hard protocol then withdraws coins from the deposit, failing both if either fails`),
+		Args:  cobra.ExactArgs(2),
+		Example: fmt.Sprintf(
+			`%s tx %s repay-and-withdraw 1000000000ukava 10000000bnb --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			repayCoins, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			withdrawCoins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRepayAndWithdraw(clientCtx.GetFromAddress(), repayCoins, withdrawCoins)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdLiquidateDirect() *cobra.Command {
+	return &cobra.Command{
+		Use:   "liquidate-direct [borrower-addr]",
+		Short: "repay the borrow of a small position that's over its loan-to-value ratio in exchange for its deposits",
+		Long: strings.TrimSpace(`repays the whole borrow of a position that's over its loan-to-value ratio and receives its deposits at a discount, without an auction.
+Only positions with a borrow value below the direct liquidation threshold of every borrowed market can be liquidated directly.`),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(
+			`%s tx %s liquidate-direct kava1hgcfsuwc889wtdmt8pjy7qffua9dd2tralu64j --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			borrower, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLiquidateDirect(clientCtx.GetFromAddress(), borrower)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdTransferPosition() *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer-position [recipient-addr]",
+		Short: "move all of your deposits and borrows to another account",
+		Long: strings.TrimSpace(`moves all of the sender's deposits and borrows to the recipient, adding them to any existing position of the recipient.
+The recipient's combined position must be within its loan-to-value ratio.
+The transaction must be signed by both the sender and the recipient, so generate it with --generate-only and sign it with both keys.`),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(
+			`%s tx %s transfer-position kava1hgcfsuwc889wtdmt8pjy7qffua9dd2tralu64j --from <key> --generate-only > tx.json`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferPosition(clientCtx.GetFromAddress(), recipient)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
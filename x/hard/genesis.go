@@ -39,6 +39,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, accountKeeper types.AccountKe
 	k.SetBorrowedCoins(ctx, gs.TotalBorrowed)
 	k.SetTotalReserves(ctx, gs.TotalReserves)
 
+	for _, ea := range gs.EModeAccounts {
+		k.SetEModeCategory(ctx, ea.Address, ea.Category)
+	}
+
 	// check if the module account exists
 	DepositModuleAccount := accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
 	if DepositModuleAccount == nil {
@@ -109,8 +113,14 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		gats = append(gats, gat)
 
 	}
-	return types.NewGenesisState(
+	gs := types.NewGenesisState(
 		params, gats, deposits, borrows,
 		totalSupplied, totalBorrowed, totalReserves,
 	)
+
+	k.IterateEModeCategories(ctx, func(addr sdk.AccAddress, category string) bool {
+		gs.EModeAccounts = append(gs.EModeAccounts, types.NewEModeAccount(addr, category))
+		return false
+	})
+	return gs
 }
@@ -64,6 +64,8 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 		},
 		sdk.NewDec(10),
 	)
+	params.MoneyMarkets[0].EModeCategory = "kava"
+	params.MoneyMarkets[0].EModeLoanToValue = sdk.MustNewDecFromStr("0.9")
 
 	deposits := types.Deposits{
 		types.NewDeposit(
@@ -116,6 +118,9 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 		totalBorrowed,
 		sdk.Coins{},
 	)
+	hardGenesis.EModeAccounts = types.EModeAccounts{
+		types.NewEModeAccount(suite.addrs[1], "kava"),
+	}
 
 	suite.NotPanics(
 		func() {
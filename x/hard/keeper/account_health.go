@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// GetAccountHealth returns the health of an address's synced deposit and borrow at current prices.
+func (k Keeper) GetAccountHealth(ctx sdk.Context, owner sdk.AccAddress) (types.AccountHealth, error) {
+	deposit, found := k.GetSyncedDeposit(ctx, owner)
+	if !found {
+		deposit = types.NewDeposit(owner, sdk.NewCoins(), types.SupplyInterestFactors{})
+	}
+	borrow, found := k.GetSyncedBorrow(ctx, owner)
+	if !found {
+		borrow = types.NewBorrow(owner, sdk.NewCoins(), types.BorrowInterestFactors{})
+	}
+	return k.CalculateAccountHealth(ctx, deposit, borrow)
+}
+
+// SimulateAccountHealth returns the health of an address's synced deposit and borrow at current prices after the
+// deposit, withdraw, borrow and repay coins are applied. Withdrawals and repayments are capped at the deposited and
+// borrowed amounts, as they are for MsgWithdraw and MsgRepay.
+func (k Keeper) SimulateAccountHealth(ctx sdk.Context, owner sdk.AccAddress, depositCoins, withdrawCoins, borrowCoins, repayCoins sdk.Coins) (types.AccountHealth, error) {
+	deposit, found := k.GetSyncedDeposit(ctx, owner)
+	if !found {
+		deposit = types.NewDeposit(owner, sdk.NewCoins(), types.SupplyInterestFactors{})
+	}
+	borrow, found := k.GetSyncedBorrow(ctx, owner)
+	if !found {
+		borrow = types.NewBorrow(owner, sdk.NewCoins(), types.BorrowInterestFactors{})
+	}
+
+	deposit.Amount = subCapped(deposit.Amount.Add(depositCoins...), withdrawCoins)
+	borrow.Amount = subCapped(borrow.Amount.Add(borrowCoins...), repayCoins)
+
+	return k.CalculateAccountHealth(ctx, deposit, borrow)
+}
+
+// CalculateAccountHealth returns the health of a deposit and borrow at current prices.
+func (k Keeper) CalculateAccountHealth(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (types.AccountHealth, error) {
+	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	if err != nil {
+		return types.AccountHealth{}, err
+	}
+
+	depositUSDValues := make(map[string]sdk.Dec)
+	totalDepositUSDValue := sdk.ZeroDec()
+	totalBorrowLimitUSDValue := sdk.ZeroDec()
+	for _, coin := range deposit.Amount {
+		lData := liqMap[coin.Denom]
+		usdValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
+		depositUSDValues[coin.Denom] = usdValue
+		totalDepositUSDValue = totalDepositUSDValue.Add(usdValue)
+		totalBorrowLimitUSDValue = totalBorrowLimitUSDValue.Add(usdValue.Mul(lData.ltv))
+	}
+
+	borrowUSDValues := make(map[string]sdk.Dec)
+	totalBorrowUSDValue := sdk.ZeroDec()
+	for _, coin := range borrow.Amount {
+		lData := liqMap[coin.Denom]
+		usdValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
+		borrowUSDValues[coin.Denom] = usdValue
+		totalBorrowUSDValue = totalBorrowUSDValue.Add(usdValue)
+	}
+
+	ltv := sdk.ZeroDec()
+	if totalDepositUSDValue.IsPositive() {
+		ltv = totalBorrowUSDValue.Quo(totalDepositUSDValue)
+	}
+	healthFactor := sdk.ZeroDec()
+	if totalBorrowUSDValue.IsPositive() {
+		healthFactor = totalBorrowLimitUSDValue.Quo(totalBorrowUSDValue)
+	}
+
+	// The borrow can be liquidated when its USD value is greater than the borrow limit. Changing the price of a coin
+	// to p changes the borrow limit by the deposited units times the LTV times p, and the borrow by the borrowed units
+	// times p, so the liquidation price is found where the two are equal.
+	liquidationPrices := []types.CollateralLiquidationPrice{}
+	for _, coin := range deposit.Amount {
+		lData := liqMap[coin.Denom]
+		conversionFactor := sdk.NewDecFromInt(lData.conversionFactor)
+
+		depositUnits := sdk.NewDecFromInt(coin.Amount).Quo(conversionFactor)
+		borrowUnits := sdk.NewDecFromInt(borrow.Amount.AmountOf(coin.Denom)).Quo(conversionFactor)
+		borrowUSDValue, found := borrowUSDValues[coin.Denom]
+		if !found {
+			borrowUSDValue = sdk.ZeroDec()
+		}
+
+		otherBorrowUSDValue := totalBorrowUSDValue.Sub(borrowUSDValue)
+		otherBorrowLimitUSDValue := totalBorrowLimitUSDValue.Sub(depositUSDValues[coin.Denom].Mul(lData.ltv))
+
+		liquidationPrice := sdk.ZeroDec()
+		shortfall := otherBorrowUSDValue.Sub(otherBorrowLimitUSDValue)
+		unitsAtRisk := depositUnits.Mul(lData.ltv).Sub(borrowUnits)
+		if shortfall.IsPositive() && unitsAtRisk.IsPositive() {
+			liquidationPrice = shortfall.Quo(unitsAtRisk)
+		}
+
+		liquidationPrices = append(liquidationPrices, types.CollateralLiquidationPrice{
+			Denom:            coin.Denom,
+			Price:            lData.price,
+			LiquidationPrice: liquidationPrice,
+		})
+	}
+
+	return types.AccountHealth{
+		Owner:               deposit.Depositor.String(),
+		Deposit:             deposit.Amount,
+		Borrow:              borrow.Amount,
+		DepositUSDValue:     totalDepositUSDValue,
+		BorrowUSDValue:      totalBorrowUSDValue,
+		BorrowLimitUSDValue: totalBorrowLimitUSDValue,
+		LTV:                 ltv,
+		HealthFactor:        healthFactor,
+		LiquidationPrices:   liquidationPrices,
+	}, nil
+}
+
+// subCapped subtracts coins from a set of coins, removing at most the amount of each denom in the set.
+func subCapped(coins, subtract sdk.Coins) sdk.Coins {
+	result := coins
+	for _, coin := range subtract {
+		amount := sdk.MinInt(coin.Amount, result.AmountOf(coin.Denom))
+		result = result.Sub(sdk.NewCoin(coin.Denom, amount))
+	}
+	return result
+}
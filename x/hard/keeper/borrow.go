@@ -13,17 +13,6 @@ import (
 
 // Borrow funds
 func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins) error {
-	// Set any new denoms' global borrow index to 1.0
-	for _, coin := range coins {
-		_, foundInterestFactor := k.GetBorrowInterestFactor(ctx, coin.Denom)
-		if !foundInterestFactor {
-			_, foundMm := k.GetMoneyMarket(ctx, coin.Denom)
-			if foundMm {
-				k.SetBorrowInterestFactor(ctx, coin.Denom, sdk.OneDec())
-			}
-		}
-	}
-
 	// Call incentive hooks
 	existingDeposit, hasExistingDeposit := k.GetDeposit(ctx, borrower)
 	if hasExistingDeposit {
@@ -37,6 +26,25 @@ func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins
 	k.SyncSupplyInterest(ctx, borrower)
 	k.SyncBorrowInterest(ctx, borrower)
 
+	return k.borrow(ctx, borrower, coins)
+}
+
+// borrow validates the borrow and transfers coins from the module account to the borrower, updating their borrow.
+// The borrower's supply and borrow interest must already be synced.
+func (k Keeper) borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins) error {
+	k.accrueTouchedMarkets(ctx, coins)
+
+	// Set any new denoms' global borrow index to 1.0
+	for _, coin := range coins {
+		_, foundInterestFactor := k.GetBorrowInterestFactor(ctx, coin.Denom)
+		if !foundInterestFactor {
+			_, foundMm := k.GetMoneyMarket(ctx, coin.Denom)
+			if foundMm {
+				k.SetBorrowInterestFactor(ctx, coin.Denom, sdk.OneDec())
+			}
+		}
+	}
+
 	// Validate borrow amount within user and protocol limits
 	err := k.ValidateBorrow(ctx, borrower, coins)
 	if err != nil {
@@ -94,7 +102,7 @@ func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins
 	// it has already been included in the total borrowed coins by the BeginBlocker.
 	k.IncrementBorrowedCoins(ctx, coins)
 
-	if !hasExistingBorrow {
+	if !foundBorrow {
 		k.AfterBorrowCreated(ctx, borrow)
 	} else {
 		k.AfterBorrowModified(ctx, borrow)
@@ -132,6 +140,21 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 		return errorsmod.Wrapf(types.ErrExceedsProtocolBorrowableBalance, "requested borrow %s > available to borrow %s", amount, fundsAvailableToBorrow)
 	}
 
+	// Accounts in e-mode can only borrow from markets in their e-mode category
+	emodeCategory, inEMode := k.GetEModeCategory(ctx, borrower)
+	if inEMode {
+		if err := k.validateEModeBorrow(ctx, emodeCategory, amount); err != nil {
+			return err
+		}
+	}
+
+	// Collateral from isolated markets can only back borrows of the markets' isolated debt denoms
+	if deposit, found := k.GetDeposit(ctx, borrower); found {
+		if err := k.validateIsolatedBorrow(ctx, deposit.Amount, amount); err != nil {
+			return err
+		}
+	}
+
 	// Get the proposed borrow USD value
 	proprosedBorrowUSDValue := sdk.ZeroDec()
 	for _, coin := range amount {
@@ -141,11 +164,11 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 		}
 
 		// Calculate this coin's USD value and add it borrow's total USD value
-		assetPriceInfo, err := k.pricefeedKeeper.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
+		assetPrice, err := k.GetMoneyMarketPrice(ctx, moneyMarket)
 		if err != nil {
 			return errorsmod.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
 		}
-		coinUSDValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price)
+		coinUSDValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPrice)
 
 		// Validate the requested borrow value for the asset against the money market's global borrow limit
 		if moneyMarket.BorrowLimit.HasMaxLimit {
@@ -179,12 +202,12 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 		}
 
 		// Calculate the borrowable amount and add it to the user's total borrowable amount
-		assetPriceInfo, err := k.pricefeedKeeper.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
+		assetPrice, err := k.GetMoneyMarketPrice(ctx, moneyMarket)
 		if err != nil {
 			return errorsmod.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
 		}
-		depositUSDValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price)
-		borrowableAmountForDeposit := depositUSDValue.Mul(moneyMarket.BorrowLimit.LoanToValue)
+		depositUSDValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPrice)
+		borrowableAmountForDeposit := depositUSDValue.Mul(moneyMarket.LoanToValueForEMode(emodeCategory))
 		totalBorrowableAmount = totalBorrowableAmount.Add(borrowableAmountForDeposit)
 	}
 
@@ -199,11 +222,11 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 			}
 
 			// Calculate this borrow coin's USD value and add it to the total previous borrowed USD value
-			assetPriceInfo, err := k.pricefeedKeeper.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
+			assetPrice, err := k.GetMoneyMarketPrice(ctx, moneyMarket)
 			if err != nil {
 				return errorsmod.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
 			}
-			coinUSDValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price)
+			coinUSDValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPrice)
 			existingBorrowUSDValue = existingBorrowUSDValue.Add(coinUSDValue)
 		}
 	}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// DepositAndBorrow deposits coins and borrows against the updated deposit, syncing the account's interest once for
+// both actions
+func (k Keeper) DepositAndBorrow(ctx sdk.Context, depositor sdk.AccAddress, depositCoins, borrowCoins sdk.Coins) error {
+	// Call incentive hooks
+	existingDeposit, hasExistingDeposit := k.GetDeposit(ctx, depositor)
+	if hasExistingDeposit {
+		k.BeforeDepositModified(ctx, existingDeposit)
+	}
+	existingBorrow, hasExistingBorrow := k.GetBorrow(ctx, depositor)
+	if hasExistingBorrow {
+		k.BeforeBorrowModified(ctx, existingBorrow)
+	}
+
+	k.SyncSupplyInterest(ctx, depositor)
+	k.SyncBorrowInterest(ctx, depositor)
+
+	if err := k.deposit(ctx, depositor, depositCoins); err != nil {
+		return err
+	}
+	return k.borrow(ctx, depositor, borrowCoins)
+}
+
+// RepayAndWithdraw repays an account's borrow and withdraws from its deposit against the reduced borrow, syncing the
+// account's interest once for both actions
+func (k Keeper) RepayAndWithdraw(ctx sdk.Context, sender sdk.AccAddress, repayCoins, withdrawCoins sdk.Coins) error {
+	// Call incentive hooks
+	existingDeposit, found := k.GetDeposit(ctx, sender)
+	if !found {
+		return errorsmod.Wrapf(types.ErrDepositNotFound, "no deposit found for %s", sender)
+	}
+	existingBorrow, found := k.GetBorrow(ctx, sender)
+	if !found {
+		return types.ErrBorrowNotFound
+	}
+	k.BeforeDepositModified(ctx, existingDeposit)
+	k.BeforeBorrowModified(ctx, existingBorrow)
+
+	k.SyncBorrowInterest(ctx, sender)
+	k.SyncSupplyInterest(ctx, sender)
+
+	if err := k.repay(ctx, sender, sender, repayCoins); err != nil {
+		return err
+	}
+	return k.withdraw(ctx, sender, withdrawCoins)
+}
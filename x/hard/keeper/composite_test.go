@@ -0,0 +1,234 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func (suite *KeeperTestSuite) setupCompositeTest(depositor sdk.AccAddress) {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+
+	authGS := app.NewFundedGenStateWithCoins(
+		tApp.AppCodec(),
+		[]sdk.Coins{cs(c("ukava", 100*KAVA_CF))},
+		[]sdk.AccAddress{depositor},
+	)
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+	hardGS := types.NewGenesisState(types.NewParams(
+		types.MoneyMarkets{
+			types.NewMoneyMarket("usdx", types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.8")), "usdx:usd", sdkmath.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+			types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")), "kava:usd", sdkmath.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+		},
+		sdk.NewDec(10),
+	), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+	)
+
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{
+				MarketID:      "usdx:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("1.00"),
+				Expiry:        time.Now().Add(1 * time.Hour),
+			},
+			{
+				MarketID:      "kava:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("2.00"),
+				Expiry:        time.Now().Add(1 * time.Hour),
+			},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)},
+	)
+
+	err := tApp.GetBankKeeper().MintCoins(ctx, types.ModuleAccountName, cs(c("usdx", 1000*USDX_CF)))
+	suite.Require().NoError(err)
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+}
+
+func (suite *KeeperTestSuite) TestDepositAndBorrow() {
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("depositor")))
+
+	type args struct {
+		depositCoins sdk.Coins
+		borrowCoins  sdk.Coins
+	}
+	type errArgs struct {
+		expectPass bool
+		contains   string
+	}
+	type depositAndBorrowTest struct {
+		name    string
+		args    args
+		errArgs errArgs
+	}
+
+	testCases := []depositAndBorrowTest{
+		{
+			"valid",
+			args{
+				depositCoins: cs(c("ukava", 100*KAVA_CF)),
+				borrowCoins:  cs(c("usdx", 160*USDX_CF)),
+			},
+			errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			"invalid: borrow exceeds deposit loan to value",
+			args{
+				depositCoins: cs(c("ukava", 100*KAVA_CF)),
+				borrowCoins:  cs(c("usdx", 161*USDX_CF)),
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "exceeds the allowable amount as determined by the collateralization ratio",
+			},
+		},
+		{
+			"invalid: deposit denom not found",
+			args{
+				depositCoins: cs(c("bnb", 1*BNB_CF)),
+				borrowCoins:  cs(c("usdx", 10*USDX_CF)),
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "invalid deposit denom",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.setupCompositeTest(depositor)
+
+			err := suite.keeper.DepositAndBorrow(suite.ctx, depositor, tc.args.depositCoins, tc.args.borrowCoins)
+			if tc.errArgs.expectPass {
+				suite.Require().NoError(err)
+
+				deposit, found := suite.keeper.GetDeposit(suite.ctx, depositor)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.args.depositCoins, deposit.Amount)
+
+				borrow, found := suite.keeper.GetBorrow(suite.ctx, depositor)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.args.borrowCoins, borrow.Amount)
+
+				balance := suite.app.GetBankKeeper().GetAllBalances(suite.ctx, depositor)
+				suite.Require().Equal(cs(c("ukava", 100*KAVA_CF)).Sub(tc.args.depositCoins...).Add(tc.args.borrowCoins...), balance)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errArgs.contains)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRepayAndWithdraw() {
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("depositor")))
+
+	type args struct {
+		repayCoins      sdk.Coins
+		withdrawCoins   sdk.Coins
+		expectedDeposit sdk.Coins
+		expectedBorrow  sdk.Coins
+	}
+	type errArgs struct {
+		expectPass bool
+		contains   string
+	}
+	type repayAndWithdrawTest struct {
+		name    string
+		args    args
+		errArgs errArgs
+	}
+
+	testCases := []repayAndWithdrawTest{
+		{
+			"valid: close position",
+			args{
+				repayCoins:      cs(c("usdx", 80*USDX_CF)),
+				withdrawCoins:   cs(c("ukava", 100*KAVA_CF)),
+				expectedDeposit: sdk.NewCoins(),
+				expectedBorrow:  sdk.NewCoins(),
+			},
+			errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			"valid: partial",
+			args{
+				repayCoins:      cs(c("usdx", 40*USDX_CF)),
+				withdrawCoins:   cs(c("ukava", 75*KAVA_CF)),
+				expectedDeposit: cs(c("ukava", 25*KAVA_CF)),
+				expectedBorrow:  cs(c("usdx", 40*USDX_CF)),
+			},
+			errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			"invalid: withdraw exceeds loan to value after repay",
+			args{
+				repayCoins:    cs(c("usdx", 40*USDX_CF)),
+				withdrawCoins: cs(c("ukava", 76*KAVA_CF)),
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "proposed withdraw outside loan-to-value range",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.setupCompositeTest(depositor)
+
+			err := suite.keeper.DepositAndBorrow(suite.ctx, depositor, cs(c("ukava", 100*KAVA_CF)), cs(c("usdx", 80*USDX_CF)))
+			suite.Require().NoError(err)
+
+			err = suite.keeper.RepayAndWithdraw(suite.ctx, depositor, tc.args.repayCoins, tc.args.withdrawCoins)
+			if tc.errArgs.expectPass {
+				suite.Require().NoError(err)
+
+				deposit, _ := suite.keeper.GetDeposit(suite.ctx, depositor)
+				suite.Require().Equal(tc.args.expectedDeposit, sdk.NewCoins(deposit.Amount...))
+
+				borrow, _ := suite.keeper.GetBorrow(suite.ctx, depositor)
+				suite.Require().Equal(tc.args.expectedBorrow, sdk.NewCoins(borrow.Amount...))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errArgs.contains)
+			}
+		})
+	}
+}
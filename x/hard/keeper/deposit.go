@@ -13,6 +13,23 @@ import (
 
 // Deposit deposit
 func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error {
+	// Call incentive hook
+	existingDeposit, hasExistingDeposit := k.GetDeposit(ctx, depositor)
+	if hasExistingDeposit {
+		k.BeforeDepositModified(ctx, existingDeposit)
+	}
+
+	// Sync any outstanding interest
+	k.SyncSupplyInterest(ctx, depositor)
+
+	return k.deposit(ctx, depositor, coins)
+}
+
+// deposit transfers coins from the depositor to the module account and updates their deposit. The depositor's
+// supply interest must already be synced.
+func (k Keeper) deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error {
+	k.accrueTouchedMarkets(ctx, coins)
+
 	// Set any new denoms' global supply index to 1.0
 	for _, coin := range coins {
 		_, foundInterestFactor := k.GetSupplyInterestFactor(ctx, coin.Denom)
@@ -24,20 +41,18 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 		}
 	}
 
-	// Call incentive hook
-	existingDeposit, hasExistingDeposit := k.GetDeposit(ctx, depositor)
-	if hasExistingDeposit {
-		k.BeforeDepositModified(ctx, existingDeposit)
-	}
-
-	// Sync any outstanding interest
-	k.SyncSupplyInterest(ctx, depositor)
-
 	err := k.ValidateDeposit(ctx, coins)
 	if err != nil {
 		return err
 	}
 
+	// Isolated collateral can't be added to a position with borrows it can't back
+	if borrow, found := k.GetBorrow(ctx, depositor); found {
+		if err := k.validateIsolatedBorrow(ctx, coins, borrow.Amount); err != nil {
+			return err
+		}
+	}
+
 	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleAccountName, coins)
 	if err != nil {
 		if errors.Is(err, sdkerrors.ErrInsufficientFunds) {
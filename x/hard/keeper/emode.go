@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// SetEMode enables an e-mode category for an account, or disables e-mode if the category is empty. In e-mode an
+// account can only borrow coins of markets in the category, and its deposits of markets in the category use the
+// markets' e-mode loan to value.
+func (k Keeper) SetEMode(ctx sdk.Context, addr sdk.AccAddress, category string) error {
+	if category != "" {
+		if !k.hasEModeCategory(ctx, category) {
+			return errorsmod.Wrapf(types.ErrEModeCategoryNotFound, "%s", category)
+		}
+		if borrow, found := k.GetBorrow(ctx, addr); found {
+			if err := k.validateEModeBorrow(ctx, category, borrow.Amount); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Check the position with the new category before committing it, as leaving e-mode lowers the loan to value
+	cacheCtx, write := ctx.CacheContext()
+	k.SetEModeCategory(cacheCtx, addr, category)
+	deposit, foundDeposit := k.GetSyncedDeposit(cacheCtx, addr)
+	borrow, foundBorrow := k.GetSyncedBorrow(cacheCtx, addr)
+	if foundDeposit && foundBorrow {
+		valid, err := k.IsWithinValidLtvRange(cacheCtx, deposit, borrow)
+		if err != nil {
+			return err
+		}
+		if !valid {
+			return errorsmod.Wrapf(types.ErrInsufficientLoanToValue, "borrow would exceed the allowable amount in e-mode category '%s'", category)
+		}
+	}
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardSetEMode,
+			sdk.NewAttribute(types.AttributeKeyOwner, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyEModeCategory, category),
+		),
+	)
+	return nil
+}
+
+// validateEModeBorrow returns an error if any of the coins are borrowed from a market outside of the e-mode category
+func (k Keeper) validateEModeBorrow(ctx sdk.Context, category string, coins sdk.Coins) error {
+	for _, coin := range coins {
+		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			return errorsmod.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", coin.Denom)
+		}
+		if moneyMarket.EModeCategory != category {
+			return errorsmod.Wrapf(types.ErrInvalidEModeBorrow, "%s is not in e-mode category %s", coin.Denom, category)
+		}
+	}
+	return nil
+}
+
+// hasEModeCategory returns true if any money market belongs to the e-mode category
+func (k Keeper) hasEModeCategory(ctx sdk.Context, category string) bool {
+	return k.GetAllMoneyMarkets(ctx).HasEModeCategory(category)
+}
@@ -0,0 +1,308 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func (suite *KeeperTestSuite) setupEModeTest(borrower sdk.AccAddress) {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+
+	authGS := app.NewFundedGenStateWithCoins(
+		tApp.AppCodec(),
+		[]sdk.Coins{cs(c("busd", 100*USDX_CF), c("ukava", 10*KAVA_CF))},
+		[]sdk.AccAddress{borrower},
+	)
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+	newStableMarket := func(denom, spotMarketID string) types.MoneyMarket {
+		mm := types.NewMoneyMarket(denom, types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.8")), spotMarketID, sdkmath.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+		mm.EModeCategory = "usd"
+		mm.EModeLoanToValue = sdk.MustNewDecFromStr("0.95")
+		return mm
+	}
+	hardGS := types.NewGenesisState(types.NewParams(
+		types.MoneyMarkets{
+			newStableMarket("usdx", "usdx:usd"),
+			newStableMarket("busd", "busd:usd"),
+			types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")), "kava:usd", sdkmath.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+		},
+		sdk.NewDec(10),
+	), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+	)
+
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "busd:usd", BaseAsset: "busd", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{
+				MarketID:      "usdx:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("1.00"),
+				Expiry:        time.Now().Add(1 * time.Hour),
+			},
+			{
+				MarketID:      "busd:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("1.00"),
+				Expiry:        time.Now().Add(1 * time.Hour),
+			},
+			{
+				MarketID:      "kava:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("2.00"),
+				Expiry:        time.Now().Add(1 * time.Hour),
+			},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)},
+	)
+
+	err := tApp.GetBankKeeper().MintCoins(ctx, types.ModuleAccountName, cs(c("ukava", 1000*KAVA_CF), c("usdx", 1000*USDX_CF)))
+	suite.Require().NoError(err)
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+}
+
+func (suite *KeeperTestSuite) TestEModeBorrow() {
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+
+	type args struct {
+		category    string
+		depositCoin sdk.Coin
+		borrowCoins sdk.Coins
+	}
+	type errArgs struct {
+		expectPass bool
+		contains   string
+	}
+	type emodeBorrowTest struct {
+		name    string
+		args    args
+		errArgs errArgs
+	}
+
+	testCases := []emodeBorrowTest{
+		{
+			"valid: e-mode loan to value",
+			args{
+				category:    "usd",
+				depositCoin: c("busd", 100*USDX_CF),
+				borrowCoins: cs(c("usdx", 95*USDX_CF)),
+			},
+			errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			"valid: base loan to value for collateral outside the category",
+			args{
+				category:    "usd",
+				depositCoin: c("ukava", 10*KAVA_CF),
+				borrowCoins: cs(c("usdx", 16*USDX_CF)),
+			},
+			errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			"invalid: exceeds base loan to value without e-mode",
+			args{
+				category:    "",
+				depositCoin: c("busd", 100*USDX_CF),
+				borrowCoins: cs(c("usdx", 90*USDX_CF)),
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "exceeds the allowable amount as determined by the collateralization ratio",
+			},
+		},
+		{
+			"invalid: exceeds e-mode loan to value",
+			args{
+				category:    "usd",
+				depositCoin: c("busd", 100*USDX_CF),
+				borrowCoins: cs(c("usdx", 96*USDX_CF)),
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "exceeds the allowable amount as determined by the collateralization ratio",
+			},
+		},
+		{
+			"invalid: borrow outside of e-mode category",
+			args{
+				category:    "usd",
+				depositCoin: c("busd", 100*USDX_CF),
+				borrowCoins: cs(c("ukava", 1*KAVA_CF)),
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "borrow not in e-mode category",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.setupEModeTest(borrower)
+
+			err := suite.keeper.Deposit(suite.ctx, borrower, cs(tc.args.depositCoin))
+			suite.Require().NoError(err)
+
+			err = suite.keeper.SetEMode(suite.ctx, borrower, tc.args.category)
+			suite.Require().NoError(err)
+
+			err = suite.keeper.Borrow(suite.ctx, borrower, tc.args.borrowCoins)
+			if tc.errArgs.expectPass {
+				suite.Require().NoError(err)
+
+				borrow, found := suite.keeper.GetBorrow(suite.ctx, borrower)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.args.borrowCoins, borrow.Amount)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errArgs.contains)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetEMode() {
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+
+	type args struct {
+		initialCategory string
+		borrowCoins     sdk.Coins
+		category        string
+	}
+	type errArgs struct {
+		expectPass bool
+		contains   string
+	}
+	type setEModeTest struct {
+		name    string
+		args    args
+		errArgs errArgs
+	}
+
+	testCases := []setEModeTest{
+		{
+			"valid: enable e-mode",
+			args{
+				category: "usd",
+			},
+			errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			"valid: enable e-mode with borrows in the category",
+			args{
+				borrowCoins: cs(c("usdx", 50*USDX_CF)),
+				category:    "usd",
+			},
+			errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			"valid: disable e-mode when the position is within the base loan to value",
+			args{
+				initialCategory: "usd",
+				borrowCoins:     cs(c("usdx", 50*USDX_CF)),
+				category:        "",
+			},
+			errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			"invalid: category not found",
+			args{
+				category: "eth",
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "e-mode category not found",
+			},
+		},
+		{
+			"invalid: existing borrows outside of the category",
+			args{
+				borrowCoins: cs(c("ukava", 10*KAVA_CF)),
+				category:    "usd",
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "borrow not in e-mode category",
+			},
+		},
+		{
+			"invalid: disable e-mode when the position requires it",
+			args{
+				initialCategory: "usd",
+				borrowCoins:     cs(c("usdx", 90*USDX_CF)),
+				category:        "",
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "not enough collateral supplied by account",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.setupEModeTest(borrower)
+
+			err := suite.keeper.Deposit(suite.ctx, borrower, cs(c("busd", 100*USDX_CF)))
+			suite.Require().NoError(err)
+
+			if tc.args.initialCategory != "" {
+				err = suite.keeper.SetEMode(suite.ctx, borrower, tc.args.initialCategory)
+				suite.Require().NoError(err)
+			}
+			if !tc.args.borrowCoins.Empty() {
+				err = suite.keeper.Borrow(suite.ctx, borrower, tc.args.borrowCoins)
+				suite.Require().NoError(err)
+			}
+
+			err = suite.keeper.SetEMode(suite.ctx, borrower, tc.args.category)
+			category, found := suite.keeper.GetEModeCategory(suite.ctx, borrower)
+			if tc.errArgs.expectPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.args.category != "", found)
+				suite.Require().Equal(tc.args.category, category)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errArgs.contains)
+				suite.Require().Equal(tc.args.initialCategory, category)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// FlashBorrow lends coins to the borrower, executes msgs signed by the borrower, then collects the coins back plus
+// the money markets' flash loan fees. The fee is credited to suppliers. An error reverts the whole flash loan.
+func (k Keeper) FlashBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount sdk.Coins, msgs []sdk.Msg) (sdk.Coins, error) {
+	if amount.IsZero() {
+		return nil, types.ErrBorrowEmptyCoins
+	}
+
+	fee, err := k.calculateFlashLoanFee(ctx, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Accrue interest before the loan changes the markets' cash, as msgs executed during the loan can accrue them
+	k.accrueTouchedMarkets(ctx, amount)
+
+	// The reserve coins aren't available for users to borrow
+	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	hardMaccCoins := k.bankKeeper.GetAllBalances(ctx, macc.GetAddress())
+	reserveCoins, foundReserveCoins := k.GetTotalReserves(ctx)
+	if !foundReserveCoins {
+		reserveCoins = sdk.NewCoins()
+	}
+	fundsAvailableToBorrow, isNegative := hardMaccCoins.SafeSub(reserveCoins...)
+	if isNegative {
+		return nil, errorsmod.Wrapf(types.ErrReservesExceedCash, "reserves %s > cash %s", reserveCoins, hardMaccCoins)
+	}
+	if amount.IsAnyGT(fundsAvailableToBorrow) {
+		return nil, errorsmod.Wrapf(types.ErrExceedsProtocolBorrowableBalance, "requested borrow %s > available to borrow %s", amount, fundsAvailableToBorrow)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, borrower, amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.executeFlashLoanMsgs(ctx, borrower, msgs); err != nil {
+		return nil, err
+	}
+
+	repayment := amount.Add(fee...)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, borrower, types.ModuleAccountName, repayment); err != nil {
+		if errors.Is(err, sdkerrors.ErrInsufficientFunds) {
+			return nil, errorsmod.Wrapf(types.ErrFlashLoanNotRepaid, "borrower cannot repay %s", repayment)
+		}
+		return nil, err
+	}
+
+	for _, coin := range fee {
+		k.creditFlashLoanFee(ctx, coin)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardFlashBorrow,
+			sdk.NewAttribute(types.AttributeKeyBorrower, borrower.String()),
+			sdk.NewAttribute(types.AttributeKeyBorrowCoins, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyFlashLoanFee, fee.String()),
+		),
+	)
+
+	return fee, nil
+}
+
+// calculateFlashLoanFee returns the fee charged for a flash loan, rounded up so suppliers are never underpaid.
+func (k Keeper) calculateFlashLoanFee(ctx sdk.Context, amount sdk.Coins) (sdk.Coins, error) {
+	fee := sdk.NewCoins()
+	for _, coin := range amount {
+		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", coin.Denom)
+		}
+		if moneyMarket.FlashLoanFee.IsNil() {
+			continue
+		}
+		feeAmount := sdk.NewDecFromInt(coin.Amount).Mul(moneyMarket.FlashLoanFee).Ceil().TruncateInt()
+		fee = fee.Add(sdk.NewCoin(coin.Denom, feeAmount))
+	}
+	return fee, nil
+}
+
+// executeFlashLoanMsgs executes msgs with the msg router. Each msg must only be signed by the borrower, as msgs
+// executed here do not have their signatures checked, and must be an allowed type, as they skip the ante handler.
+func (k Keeper) executeFlashLoanMsgs(ctx sdk.Context, borrower sdk.AccAddress, msgs []sdk.Msg) error {
+	for i, msg := range msgs {
+		if !types.IsFlashLoanMsgAllowed(sdk.MsgTypeURL(msg)) {
+			return errorsmod.Wrapf(types.ErrInvalidFlashLoanMsg, "msg %d type %s cannot be executed within a flash loan", i, sdk.MsgTypeURL(msg))
+		}
+
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(borrower) {
+			return errorsmod.Wrapf(types.ErrInvalidFlashLoanMsg, "msg %d must only be signed by the borrower", i)
+		}
+
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to execute flash loan msg %d", i)
+		}
+
+		events := res.GetEvents()
+		sdkEvents := make([]sdk.Event, len(events))
+		for j, event := range events {
+			sdkEvents[j] = sdk.Event(event)
+		}
+		ctx.EventManager().EmitEvents(sdkEvents)
+	}
+	return nil
+}
+
+// creditFlashLoanFee distributes a flash loan fee to suppliers by increasing the supply interest factor, in the same
+// way interest is distributed.
+func (k Keeper) creditFlashLoanFee(ctx sdk.Context, fee sdk.Coin) {
+	if fee.IsZero() {
+		return
+	}
+
+	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	cashPrior := k.bankKeeper.GetBalance(ctx, macc.GetAddress(), fee.Denom).Amount.Sub(fee.Amount)
+
+	borrowedPrior := sdk.ZeroInt()
+	if borrowedCoins, found := k.GetBorrowedCoins(ctx); found {
+		borrowedPrior = borrowedCoins.AmountOf(fee.Denom)
+	}
+	reservesPrior := sdk.ZeroInt()
+	if reserves, found := k.GetTotalReserves(ctx); found {
+		reservesPrior = reserves.AmountOf(fee.Denom)
+	}
+
+	supplyInterestFactorPrior, found := k.GetSupplyInterestFactor(ctx, fee.Denom)
+	if !found {
+		supplyInterestFactorPrior = sdk.OneDec()
+	}
+
+	supplyInterestFactor := CalculateSupplyInterestFactor(sdk.NewDecFromInt(fee.Amount), sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(borrowedPrior), sdk.NewDecFromInt(reservesPrior))
+	k.SetSupplyInterestFactor(ctx, fee.Denom, supplyInterestFactorPrior.Mul(supplyInterestFactor))
+	k.IncrementSuppliedCoins(ctx, sdk.NewCoins(fee))
+}
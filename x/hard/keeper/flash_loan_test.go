@@ -0,0 +1,254 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func (suite *KeeperTestSuite) TestFlashBorrow() {
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("depositor")))
+	recipient := sdk.AccAddress(crypto.AddressHash([]byte("recipient")))
+
+	type args struct {
+		borrowCoins         sdk.Coins
+		msgs                []sdk.Msg
+		expectedFee         sdk.Coins
+		expectedBorrowerEnd sdk.Coins
+	}
+	type errArgs struct {
+		expectPass bool
+		contains   string
+	}
+	type flashBorrowTest struct {
+		name    string
+		args    args
+		errArgs errArgs
+	}
+
+	testCases := []flashBorrowTest{
+		{
+			"valid",
+			args{
+				borrowCoins: cs(c("ukava", 100*KAVA_CF)),
+				msgs: []sdk.Msg{
+					banktypes.NewMsgSend(borrower, recipient, cs(c("ukava", 1*KAVA_CF))),
+				},
+				expectedFee:         cs(c("ukava", 100_000)),
+				expectedBorrowerEnd: cs(c("ukava", 10*KAVA_CF-1*KAVA_CF-100_000)),
+			},
+			errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			"valid: fee rounds up",
+			args{
+				borrowCoins: cs(c("ukava", 1001)),
+				msgs: []sdk.Msg{
+					banktypes.NewMsgSend(borrower, recipient, cs(c("ukava", 1))),
+				},
+				expectedFee:         cs(c("ukava", 2)),
+				expectedBorrowerEnd: cs(c("ukava", 10*KAVA_CF-1-2)),
+			},
+			errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			"valid: zero fee market",
+			args{
+				borrowCoins: cs(c("usdx", 100*USDX_CF)),
+				msgs: []sdk.Msg{
+					banktypes.NewMsgSend(borrower, recipient, cs(c("ukava", 1*KAVA_CF))),
+				},
+				expectedFee:         sdk.NewCoins(),
+				expectedBorrowerEnd: cs(c("ukava", 9*KAVA_CF)),
+			},
+			errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			"invalid: loan not repaid",
+			args{
+				borrowCoins: cs(c("ukava", 100*KAVA_CF)),
+				msgs: []sdk.Msg{
+					banktypes.NewMsgSend(borrower, recipient, cs(c("ukava", 100*KAVA_CF))),
+				},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "flash loan not repaid",
+			},
+		},
+		{
+			"invalid: msg signed by another account",
+			args{
+				borrowCoins: cs(c("ukava", 100*KAVA_CF)),
+				msgs: []sdk.Msg{
+					banktypes.NewMsgSend(depositor, recipient, cs(c("ukava", 1*KAVA_CF))),
+				},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "must only be signed by the borrower",
+			},
+		},
+		{
+			"invalid: no money market",
+			args{
+				borrowCoins: cs(c("bnb", 1*BNB_CF)),
+				msgs: []sdk.Msg{
+					banktypes.NewMsgSend(borrower, recipient, cs(c("ukava", 1*KAVA_CF))),
+				},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "no money market found",
+			},
+		},
+		{
+			"invalid: exceeds available balance",
+			args{
+				borrowCoins: cs(c("ukava", 2000*KAVA_CF)),
+				msgs: []sdk.Msg{
+					banktypes.NewMsgSend(borrower, recipient, cs(c("ukava", 1*KAVA_CF))),
+				},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "exceeds borrowable module account balance",
+			},
+		},
+		{
+			"invalid: nested flash borrow",
+			args{
+				borrowCoins: cs(c("ukava", 100*KAVA_CF)),
+				msgs: []sdk.Msg{
+					&types.MsgFlashBorrow{Borrower: borrower.String(), Amount: cs(c("ukava", 100*KAVA_CF))},
+				},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "cannot be executed within a flash loan",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+
+			authGS := app.NewFundedGenStateWithCoins(
+				tApp.AppCodec(),
+				[]sdk.Coins{cs(c("ukava", 10*KAVA_CF)), cs(c("ukava", 100*KAVA_CF))},
+				[]sdk.AccAddress{borrower, depositor},
+			)
+
+			model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+			kavaMarket := types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")), "kava:usd", sdkmath.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+			kavaMarket.FlashLoanFee = sdk.MustNewDecFromStr("0.001")
+			hardGS := types.NewGenesisState(types.NewParams(
+				types.MoneyMarkets{
+					types.NewMoneyMarket("usdx", types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("1")), "usdx:usd", sdkmath.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+					kavaMarket,
+				},
+				sdk.NewDec(10),
+			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+			)
+
+			pricefeedGS := pricefeedtypes.GenesisState{
+				Params: pricefeedtypes.Params{
+					Markets: []pricefeedtypes.Market{
+						{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+					},
+				},
+				PostedPrices: []pricefeedtypes.PostedPrice{
+					{
+						MarketID:      "usdx:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("1.00"),
+						Expiry:        time.Now().Add(1 * time.Hour),
+					},
+					{
+						MarketID:      "kava:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("2.00"),
+						Expiry:        time.Now().Add(1 * time.Hour),
+					},
+				},
+			}
+
+			tApp.InitializeFromGenesisStates(authGS,
+				app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+				app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)},
+			)
+
+			bankKeeper := tApp.GetBankKeeper()
+			initialModuleCoins := cs(c("ukava", 1000*KAVA_CF), c("usdx", 1000*USDX_CF))
+			err := bankKeeper.MintCoins(ctx, types.ModuleAccountName, initialModuleCoins)
+			suite.Require().NoError(err)
+
+			suite.app = tApp
+			suite.ctx = ctx
+			suite.keeper = tApp.GetHardKeeper()
+
+			hard.BeginBlocker(suite.ctx, suite.keeper)
+
+			depositCoins := cs(c("ukava", 100*KAVA_CF))
+			err = suite.keeper.Deposit(suite.ctx, depositor, depositCoins)
+			suite.Require().NoError(err)
+
+			supplyFactorPrior, found := suite.keeper.GetSupplyInterestFactor(suite.ctx, "ukava")
+			suite.Require().True(found)
+
+			fee, err := suite.keeper.FlashBorrow(suite.ctx, borrower, tc.args.borrowCoins, tc.args.msgs)
+			if tc.errArgs.expectPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.args.expectedFee, fee)
+
+				// Borrower pays the fee and keeps the results of its msgs
+				suite.Require().Equal(tc.args.expectedBorrowerEnd, bankKeeper.GetAllBalances(suite.ctx, borrower))
+
+				// Module account receives the loan back plus the fee
+				mAcc := suite.getModuleAccount(types.ModuleAccountName)
+				expectedModuleCoins := initialModuleCoins.Add(depositCoins...).Add(fee...)
+				suite.Require().Equal(expectedModuleCoins, bankKeeper.GetAllBalances(suite.ctx, mAcc.GetAddress()))
+
+				// Fee is credited to suppliers
+				suppliedCoins, found := suite.keeper.GetSuppliedCoins(suite.ctx)
+				suite.Require().True(found)
+				suite.Require().Equal(depositCoins.Add(fee...), suppliedCoins)
+
+				supplyFactor, _ := suite.keeper.GetSupplyInterestFactor(suite.ctx, "ukava")
+				if fee.AmountOf("ukava").IsPositive() {
+					suite.Require().True(supplyFactor.GT(supplyFactorPrior))
+				} else {
+					suite.Require().Equal(supplyFactorPrior, supplyFactor)
+				}
+
+				// Flash loans do not create a borrow position
+				_, found = suite.keeper.GetBorrow(suite.ctx, borrower)
+				suite.Require().False(found)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errArgs.contains)
+			}
+		})
+	}
+}
@@ -432,33 +432,13 @@ func (s queryServer) InterestRate(ctx context.Context, req *types.QueryInterestR
 
 	// Calculate the borrow and supply APY interest rates for each money market
 	for _, moneyMarket := range moneyMarkets {
-		denom := moneyMarket.Denom
-		macc := s.accountKeeper.GetModuleAccount(sdkCtx, types.ModuleName)
-		cash := s.bankKeeper.GetBalance(sdkCtx, macc.GetAddress(), denom).Amount
-
-		borrowed := sdk.NewCoin(denom, sdk.ZeroInt())
-		borrowedCoins, foundBorrowedCoins := s.keeper.GetBorrowedCoins(sdkCtx)
-		if foundBorrowedCoins {
-			borrowed = sdk.NewCoin(denom, borrowedCoins.AmountOf(denom))
-		}
-
-		reserves, foundReserves := s.keeper.GetTotalReserves(sdkCtx)
-		if !foundReserves {
-			reserves = sdk.NewCoins()
-		}
-
-		// CalculateBorrowRate calculates the current interest rate based on utilization (the fraction of supply that has ien borrowed)
-		borrowAPY, err := CalculateBorrowRate(moneyMarket.InterestRateModel, sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
+		borrowAPY, realSupplyAPY, err := s.keeper.GetInterestRates(sdkCtx, moneyMarket)
 		if err != nil {
 			return nil, err
 		}
 
-		utilRatio := CalculateUtilizationRatio(sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
-		fullSupplyAPY := borrowAPY.Mul(utilRatio)
-		realSupplyAPY := fullSupplyAPY.Mul(sdk.OneDec().Sub(moneyMarket.ReserveFactor))
-
 		moneyMarketInterestRate := types.MoneyMarketInterestRate{
-			Denom:              denom,
+			Denom:              moneyMarket.Denom,
 			SupplyInterestRate: realSupplyAPY.String(),
 			BorrowInterestRate: borrowAPY.String(),
 		}
@@ -544,3 +524,79 @@ func (s queryServer) InterestFactors(ctx context.Context, req *types.QueryIntere
 		InterestFactors: interestFactors,
 	}, nil
 }
+
+func (s queryServer) RateHistory(ctx context.Context, req *types.QueryRateHistoryRequest) (*types.QueryRateHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, found := s.keeper.GetMoneyMarket(sdkCtx, req.Denom); !found {
+		return nil, types.ErrMoneyMarketNotFound
+	}
+
+	return &types.QueryRateHistoryResponse{
+		RatePoints: s.keeper.GetRateHistory(sdkCtx, req.Denom),
+	}, nil
+}
+
+func (s queryServer) AccountHealth(ctx context.Context, req *types.QueryAccountHealthRequest) (*types.QueryAccountHealthResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	accountHealth, err := s.keeper.GetAccountHealth(sdkCtx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountHealthResponse{
+		AccountHealth: accountHealth,
+	}, nil
+}
+
+func (s queryServer) SimulateAccountHealth(ctx context.Context, req *types.QuerySimulateAccountHealthRequest) (*types.QueryAccountHealthResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	for _, coins := range []sdk.Coins{req.Deposit, req.Withdraw, req.Borrow, req.Repay} {
+		if !coins.IsValid() {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "%s", coins)
+		}
+	}
+
+	accountHealth, err := s.keeper.SimulateAccountHealth(sdkCtx, owner, req.Deposit, req.Withdraw, req.Borrow, req.Repay)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountHealthResponse{
+		AccountHealth: accountHealth,
+	}, nil
+}
+
+func (s queryServer) Invariants(ctx context.Context, req *types.QueryInvariantsRequest) (*types.QueryInvariantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryInvariantsResponse{
+		Invariants: s.keeper.RunInvariants(sdk.UnwrapSDKContext(ctx)),
+	}, nil
+}
@@ -65,7 +65,9 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryParams() {
 	defaultHARDState := NewHARDGenState(suite.tApp.AppCodec())
 	suite.tApp.AppCodec().MustUnmarshalJSON(defaultHARDState[types.ModuleName], &expected)
 
-	suite.Equal(expected.Params, res.Params, "params should equal test genesis state")
+	// Compare encoded params as empty repeated fields decode to empty slices from json but nil from the store
+	cdc := suite.tApp.AppCodec()
+	suite.Equal(string(cdc.MustMarshalJSON(&expected.Params)), string(cdc.MustMarshalJSON(&res.Params)), "params should equal test genesis state")
 }
 
 func (suite *grpcQueryTestSuite) TestGrpcQueryAccounts() {
@@ -525,6 +527,198 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryReserves() {
 	}, res)
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcQueryInvariants() {
+	suite.addDeposits()
+
+	res, err := suite.queryServer.Invariants(sdk.WrapSDKContext(suite.ctx), &types.QueryInvariantsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Invariants, 5)
+	for _, result := range res.Invariants {
+		suite.False(result.Broken, result.Route)
+	}
+
+	suite.keeper.SetBorrowedCoins(suite.ctx, cs(c("usdx", 1e15)))
+
+	res, err = suite.queryServer.Invariants(sdk.WrapSDKContext(suite.ctx), &types.QueryInvariantsRequest{})
+	suite.Require().NoError(err)
+	for _, result := range res.Invariants {
+		suite.Equal(result.Route == "total-supplied", result.Broken, result.Route)
+	}
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryRateHistory() {
+	suite.addDeposits()
+	suite.addBorrows()
+
+	// Accrue interest on the usdx borrows an hour later
+	suite.ctx = suite.ctx.WithBlockHeight(10).WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	err := suite.keeper.AccrueInterest(suite.ctx, "usdx")
+	suite.Require().NoError(err)
+
+	res, err := suite.queryServer.RateHistory(sdk.WrapSDKContext(suite.ctx), &types.QueryRateHistoryRequest{
+		Denom: "usdx",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.RatePoints, 1)
+
+	point := res.RatePoints[0]
+	suite.Equal(int64(10), point.Height)
+	suite.True(suite.ctx.BlockTime().Equal(point.Time))
+	suite.True(point.UtilizationRatio.IsPositive())
+	suite.True(point.BorrowRate.IsPositive())
+	suite.True(point.SupplyRate.IsPositive())
+	suite.True(point.SupplyRate.LT(point.BorrowRate))
+
+	res, err = suite.queryServer.RateHistory(sdk.WrapSDKContext(suite.ctx), &types.QueryRateHistoryRequest{
+		Denom: "bnb",
+	})
+	suite.Require().NoError(err)
+	suite.Empty(res.RatePoints)
+
+	_, err = suite.queryServer.RateHistory(sdk.WrapSDKContext(suite.ctx), &types.QueryRateHistoryRequest{
+		Denom: "bun",
+	})
+	suite.Require().ErrorIs(err, types.ErrMoneyMarketNotFound)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryAccountHealth() {
+	owner := suite.addrs[1]
+	err := suite.keeper.Deposit(suite.ctx, owner, cs(c("bnb", 20000000)))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, owner, cs(c("usdx", 1000000000)))
+	suite.Require().NoError(err)
+
+	res, err := suite.queryServer.AccountHealth(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountHealthRequest{
+		Owner: owner.String(),
+	})
+	suite.Require().NoError(err)
+
+	// 20 bnb at $618.13 with an LTV of 0.5 backs $1000 of usdx
+	suite.Equal(types.AccountHealth{
+		Owner:               owner.String(),
+		Deposit:             cs(c("bnb", 20000000)),
+		Borrow:              cs(c("usdx", 1000000000)),
+		DepositUSDValue:     sdk.MustNewDecFromStr("12362.6"),
+		BorrowUSDValue:      sdk.MustNewDecFromStr("1000"),
+		BorrowLimitUSDValue: sdk.MustNewDecFromStr("6181.3"),
+		LTV:                 sdk.MustNewDecFromStr("1000").Quo(sdk.MustNewDecFromStr("12362.6")),
+		HealthFactor:        sdk.MustNewDecFromStr("6.1813"),
+		LiquidationPrices: []types.CollateralLiquidationPrice{
+			{
+				Denom:            "bnb",
+				Price:            sdk.MustNewDecFromStr("618.13"),
+				LiquidationPrice: sdk.MustNewDecFromStr("100"),
+			},
+		},
+	}, res.AccountHealth)
+
+	_, err = suite.queryServer.AccountHealth(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountHealthRequest{
+		Owner: "invalid",
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryAccountHealth_Empty() {
+	res, err := suite.queryServer.AccountHealth(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountHealthRequest{
+		Owner: suite.addrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.True(res.AccountHealth.DepositUSDValue.IsZero())
+	suite.True(res.AccountHealth.BorrowUSDValue.IsZero())
+	suite.True(res.AccountHealth.HealthFactor.IsZero())
+	suite.Empty(res.AccountHealth.LiquidationPrices)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQuerySimulateAccountHealth() {
+	owner := suite.addrs[1]
+	err := suite.keeper.Deposit(suite.ctx, owner, cs(c("bnb", 20000000)))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, owner, cs(c("usdx", 1000000000)))
+	suite.Require().NoError(err)
+
+	tests := []struct {
+		giveName              string
+		giveRequest           types.QuerySimulateAccountHealthRequest
+		wantDeposit           sdk.Coins
+		wantBorrow            sdk.Coins
+		wantHealthFactor      sdk.Dec
+		wantLiquidationPrices []types.CollateralLiquidationPrice
+		shouldError           bool
+	}{
+		{
+			"withdraw and borrow",
+			types.QuerySimulateAccountHealthRequest{
+				Owner:    owner.String(),
+				Withdraw: cs(c("bnb", 10000000)),
+				Borrow:   cs(c("usdx", 1000000000)),
+			},
+			cs(c("bnb", 10000000)),
+			cs(c("usdx", 2000000000)),
+			sdk.MustNewDecFromStr("1.545325"),
+			[]types.CollateralLiquidationPrice{
+				{
+					Denom:            "bnb",
+					Price:            sdk.MustNewDecFromStr("618.13"),
+					LiquidationPrice: sdk.MustNewDecFromStr("400"),
+				},
+			},
+			false,
+		},
+		{
+			"repay more than borrowed",
+			types.QuerySimulateAccountHealthRequest{
+				Owner: owner.String(),
+				Repay: cs(c("usdx", 5000000000)),
+			},
+			cs(c("bnb", 20000000)),
+			sdk.NewCoins(),
+			sdk.ZeroDec(),
+			[]types.CollateralLiquidationPrice{
+				{
+					Denom:            "bnb",
+					Price:            sdk.MustNewDecFromStr("618.13"),
+					LiquidationPrice: sdk.ZeroDec(),
+				},
+			},
+			false,
+		},
+		{
+			"deposit without a money market",
+			types.QuerySimulateAccountHealthRequest{
+				Owner:   owner.String(),
+				Deposit: cs(c("xrp", 1000000)),
+			},
+			nil,
+			nil,
+			sdk.Dec{},
+			nil,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.giveName, func() {
+			res, err := suite.queryServer.SimulateAccountHealth(sdk.WrapSDKContext(suite.ctx), &tt.giveRequest)
+
+			if tt.shouldError {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+
+				suite.Equal(tt.wantDeposit, res.AccountHealth.Deposit)
+				suite.True(tt.wantBorrow.IsEqual(res.AccountHealth.Borrow))
+				suite.Equal(tt.wantHealthFactor, res.AccountHealth.HealthFactor)
+				suite.Equal(tt.wantLiquidationPrices, res.AccountHealth.LiquidationPrices)
+			}
+		})
+	}
+
+	// The stored position is unchanged
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, owner)
+	suite.Require().True(found)
+	suite.Equal(cs(c("bnb", 20000000)), deposit.Amount)
+}
+
 func TestGrpcQueryTestSuite(t *testing.T) {
 	suite.Run(t, new(grpcQueryTestSuite))
 }
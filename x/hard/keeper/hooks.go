@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/hard/types"
@@ -50,3 +51,10 @@ func (k Keeper) AfterBorrowModified(ctx sdk.Context, borrow types.Borrow) {
 		k.hooks.AfterBorrowModified(ctx, borrow)
 	}
 }
+
+// AfterInterestAccrued - call hook if registered
+func (k Keeper) AfterInterestAccrued(ctx sdk.Context, denom string, suppliedInterest, reserveInterest sdkmath.Int) {
+	if k.hooks != nil {
+		k.hooks.AfterInterestAccrued(ctx, denom, suppliedInterest, reserveInterest)
+	}
+}
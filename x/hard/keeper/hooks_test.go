@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// interestAccruedCall records the arguments of an AfterInterestAccrued hook call
+type interestAccruedCall struct {
+	denom            string
+	suppliedInterest sdkmath.Int
+	reserveInterest  sdkmath.Int
+}
+
+// interestHooks records AfterInterestAccrued hook calls
+type interestHooks struct {
+	calls []interestAccruedCall
+}
+
+var _ types.HARDHooks = &interestHooks{}
+
+func (h *interestHooks) AfterDepositCreated(_ sdk.Context, _ types.Deposit)   {}
+func (h *interestHooks) BeforeDepositModified(_ sdk.Context, _ types.Deposit) {}
+func (h *interestHooks) AfterDepositModified(_ sdk.Context, _ types.Deposit)  {}
+func (h *interestHooks) AfterBorrowCreated(_ sdk.Context, _ types.Borrow)     {}
+func (h *interestHooks) BeforeBorrowModified(_ sdk.Context, _ types.Borrow)   {}
+func (h *interestHooks) AfterBorrowModified(_ sdk.Context, _ types.Borrow)    {}
+
+func (h *interestHooks) AfterInterestAccrued(_ sdk.Context, denom string, suppliedInterest, reserveInterest sdkmath.Int) {
+	h.calls = append(h.calls, interestAccruedCall{denom, suppliedInterest, reserveInterest})
+}
+
+func (suite *KeeperTestSuite) TestHooks_AfterInterestAccrued() {
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("depositor")))
+	suite.setupCompositeTest(depositor)
+
+	err := suite.keeper.DepositAndBorrow(suite.ctx, depositor, cs(c("ukava", 100*KAVA_CF)), cs(c("usdx", 80*USDX_CF)))
+	suite.Require().NoError(err)
+
+	hooks := &interestHooks{}
+	suite.keeper.ClearHooks()
+	suite.keeper.SetHooks(hooks)
+
+	borrowedPrior, _ := suite.keeper.GetBorrowedCoins(suite.ctx)
+	suppliedPrior, _ := suite.keeper.GetSuppliedCoins(suite.ctx)
+	reservesPrior, _ := suite.keeper.GetTotalReserves(suite.ctx)
+
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(365 * 24 * time.Hour))
+	hard.BeginBlocker(ctx, suite.keeper)
+
+	// Only the borrowed market accrues interest
+	suite.Require().Len(hooks.calls, 1)
+	call := hooks.calls[0]
+	suite.Require().Equal("usdx", call.denom)
+	suite.Require().True(call.suppliedInterest.IsPositive())
+	suite.Require().True(call.reserveInterest.IsPositive())
+
+	// Hook amounts match the interest split written to the store
+	borrowed, _ := suite.keeper.GetBorrowedCoins(ctx)
+	supplied, _ := suite.keeper.GetSuppliedCoins(ctx)
+	reserves, _ := suite.keeper.GetTotalReserves(ctx)
+	suite.Require().Equal(call.suppliedInterest.Add(call.reserveInterest), borrowed.AmountOf("usdx").Sub(borrowedPrior.AmountOf("usdx")))
+	suite.Require().Equal(call.suppliedInterest, supplied.AmountOf("usdx").Sub(suppliedPrior.AmountOf("usdx")))
+	suite.Require().Equal(call.reserveInterest, reserves.AmountOf("usdx").Sub(reservesPrior.AmountOf("usdx")))
+}
@@ -1,10 +1,12 @@
 package keeper
 
 import (
+	"bytes"
 	"math"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/hard/types"
@@ -16,12 +18,15 @@ var (
 )
 
 // ApplyInterestRateUpdates translates the current interest rate models from the params to the store,
-// with each money market accruing interest.
+// with each money market accruing interest. Money markets with unchanged params that accrued interest within their
+// max accrual interval are skipped, as they accrue interest when their deposits or borrows next change.
 func (k Keeper) ApplyInterestRateUpdates(ctx sdk.Context) {
 	denomSet := map[string]bool{}
 
 	params := k.GetParams(ctx)
 	for _, mm := range params.MoneyMarkets {
+		denomSet[mm.Denom] = true
+
 		// Set any new money markets in the store
 		moneyMarket, found := k.GetMoneyMarket(ctx, mm.Denom)
 		if !found {
@@ -29,8 +34,12 @@ func (k Keeper) ApplyInterestRateUpdates(ctx sdk.Context) {
 			k.SetMoneyMarket(ctx, mm.Denom, moneyMarket)
 		}
 
+		if moneyMarket.Equal(mm) && !k.isAccrualDue(ctx, moneyMarket) {
+			continue
+		}
+
 		// Accrue interest according to the current money markets in the store
-		err := k.AccrueInterest(ctx, mm.Denom)
+		err := k.accrueMarketOnce(ctx, mm.Denom)
 		if err != nil {
 			panic(err)
 		}
@@ -39,14 +48,13 @@ func (k Keeper) ApplyInterestRateUpdates(ctx sdk.Context) {
 		if !moneyMarket.Equal(mm) {
 			k.SetMoneyMarket(ctx, mm.Denom, mm)
 		}
-		denomSet[mm.Denom] = true
 	}
 
 	// Edge case: money markets removed from params that still exist in the store
 	k.IterateMoneyMarkets(ctx, func(denom string, i types.MoneyMarket) bool {
 		if !denomSet[denom] {
 			// Accrue interest according to current store money market
-			err := k.AccrueInterest(ctx, denom)
+			err := k.accrueMarketOnce(ctx, denom)
 			if err != nil {
 				panic(err)
 			}
@@ -58,6 +66,45 @@ func (k Keeper) ApplyInterestRateUpdates(ctx sdk.Context) {
 	})
 }
 
+// isAccrualDue returns true if a money market has never accrued interest or last accrued interest at least its max
+// accrual interval ago
+func (k Keeper) isAccrualDue(ctx sdk.Context, mm types.MoneyMarket) bool {
+	previousAccrualTime, found := k.GetPreviousAccrualTime(ctx, mm.Denom)
+	if !found {
+		return true
+	}
+	return ctx.BlockTime().Sub(previousAccrualTime) >= mm.MaxAccrualInterval
+}
+
+// accrueTouchedMarkets accrues interest for the money markets of coins whose deposits or borrows are about to change,
+// so that interest up to the current block accrues at the market's prior utilization
+func (k Keeper) accrueTouchedMarkets(ctx sdk.Context, coins sdk.Coins) {
+	for _, coin := range coins {
+		// Positions can hold coins of money markets that have been removed
+		if _, found := k.GetMoneyMarket(ctx, coin.Denom); !found {
+			continue
+		}
+		if err := k.accrueMarketOnce(ctx, coin.Denom); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// accrueMarketOnce accrues interest for a money market unless it has already accrued interest in the current block.
+// Markets are flagged with the block time they accrued at in the transient store, which is cleared every block.
+func (k Keeper) accrueMarketOnce(ctx sdk.Context, denom string) error {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.AccruedMarketsPrefix)
+	blockTime := sdk.FormatTimeBytes(ctx.BlockTime())
+	if bytes.Equal(store.Get([]byte(denom)), blockTime) {
+		return nil
+	}
+	if err := k.AccrueInterest(ctx, denom); err != nil {
+		return err
+	}
+	store.Set([]byte(denom), blockTime)
+	return nil
+}
+
 // AccrueInterest applies accrued interest to total borrows and reserves by calculating
 // interest from the last checkpoint time and writing the updated values to the store.
 func (k Keeper) AccrueInterest(ctx sdk.Context, denom string) error {
@@ -70,7 +117,7 @@ func (k Keeper) AccrueInterest(ctx sdk.Context, denom string) error {
 	timeElapsed := int64(math.RoundToEven(
 		ctx.BlockTime().Sub(previousAccrualTime).Seconds(),
 	))
-	if timeElapsed == 0 {
+	if timeElapsed <= 0 {
 		return nil
 	}
 
@@ -153,23 +200,59 @@ func (k Keeper) AccrueInterest(ctx sdk.Context, denom string) error {
 	k.SetTotalReserves(ctx, reservesPrior.Add(sdk.NewCoin(denom, reservesNew)))
 	k.SetPreviousAccrualTime(ctx, denom, ctx.BlockTime())
 
+	// Record the rates the interest accrued at in the money market's rate history
+	utilRatio := CalculateUtilizationRatio(sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(borrowedPrior.Amount), sdk.NewDecFromInt(reservesPrior.AmountOf(denom)))
+	supplyRateApy := borrowRateApy.Mul(utilRatio).Mul(sdk.OneDec().Sub(mm.ReserveFactor))
+	k.AddRatePoint(ctx, denom, types.NewRatePoint(ctx.BlockHeight(), ctx.BlockTime(), utilRatio, borrowRateApy, supplyRateApy))
+
+	// Call hook with the interest split between suppliers and reserves
+	k.AfterInterestAccrued(ctx, denom, supplyInterestNew, reservesNew)
+
 	return nil
 }
 
+// GetInterestRates returns the current borrow and supply APY of a money market, based on the utilization of the
+// money market's current cash, borrows and reserves. The supply APY is the borrow APY paid on the utilized supply,
+// less the reserve factor.
+func (k Keeper) GetInterestRates(ctx sdk.Context, moneyMarket types.MoneyMarket) (borrowAPY sdk.Dec, supplyAPY sdk.Dec, err error) {
+	denom := moneyMarket.Denom
+	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	cash := k.bankKeeper.GetBalance(ctx, macc.GetAddress(), denom).Amount
+
+	borrowed := sdk.NewCoin(denom, sdk.ZeroInt())
+	borrowedCoins, foundBorrowedCoins := k.GetBorrowedCoins(ctx)
+	if foundBorrowedCoins {
+		borrowed = sdk.NewCoin(denom, borrowedCoins.AmountOf(denom))
+	}
+
+	reserves, foundReserves := k.GetTotalReserves(ctx)
+	if !foundReserves {
+		reserves = sdk.NewCoins()
+	}
+
+	// CalculateBorrowRate calculates the current interest rate based on utilization (the fraction of supply that has been borrowed)
+	borrowAPY, err = CalculateBorrowRate(moneyMarket.InterestRateModel, sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	utilRatio := CalculateUtilizationRatio(sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
+	fullSupplyAPY := borrowAPY.Mul(utilRatio)
+	supplyAPY = fullSupplyAPY.Mul(sdk.OneDec().Sub(moneyMarket.ReserveFactor))
+
+	return borrowAPY, supplyAPY, nil
+}
+
 // CalculateBorrowRate calculates the borrow rate, which is the current APY expressed as a decimal
-// based on the current utilization.
+// based on the current utilization and the money market's rate model.
 func CalculateBorrowRate(model types.InterestRateModel, cash, borrows, reserves sdk.Dec) (sdk.Dec, error) {
-	utilRatio := CalculateUtilizationRatio(cash, borrows, reserves)
-
-	// Calculate normal borrow rate (under kink)
-	if utilRatio.LTE(model.Kink) {
-		return utilRatio.Mul(model.BaseMultiplier).Add(model.BaseRateAPY), nil
+	rateModel, err := model.RateModel()
+	if err != nil {
+		return sdk.Dec{}, err
 	}
 
-	// Calculate jump borrow rate (over kink)
-	normalRate := model.Kink.Mul(model.BaseMultiplier).Add(model.BaseRateAPY)
-	excessUtil := utilRatio.Sub(model.Kink)
-	return excessUtil.Mul(model.JumpMultiplier).Add(normalRate), nil
+	utilRatio := CalculateUtilizationRatio(cash, borrows, reserves)
+	return rateModel.BorrowRate(utilRatio), nil
 }
 
 // CalculateUtilizationRatio calculates an asset's current utilization rate
@@ -226,6 +309,8 @@ func (k Keeper) SyncBorrowInterest(ctx sdk.Context, addr sdk.AccAddress) {
 	if !found {
 		return
 	}
+	k.accrueTouchedMarkets(ctx, borrow.Amount)
+
 	for _, coin := range borrow.Amount {
 		// Locate the borrow interest factor item by coin denom in the user's list of borrow indexes
 		foundAtIndex := -1
@@ -266,6 +351,7 @@ func (k Keeper) SyncSupplyInterest(ctx sdk.Context, addr sdk.AccAddress) {
 	if !found {
 		return
 	}
+	k.accrueTouchedMarkets(ctx, deposit.Amount)
 
 	for _, coin := range deposit.Amount {
 		// Locate the deposit index item by coin denom in the user's list of deposit indexes
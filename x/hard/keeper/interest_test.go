@@ -113,6 +113,11 @@ func (suite *InterestTestSuite) TestCalculateBorrowRate() {
 	// 	- JumpMultiplier:   0.5
 	normalModel := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
 
+	// Two kink model is the normal model with:
+	// 	- SecondKink:           0.9
+	// 	- SecondJumpMultiplier: 2.0
+	twoKinkModel := types.NewTwoKinkInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("2"))
+
 	testCases := []test{
 		{
 			"normal no jump",
@@ -209,6 +214,26 @@ func (suite *InterestTestSuite) TestCalculateBorrowRate() {
 				expectedValue: sdk.MustNewDecFromStr("0.0"),
 			},
 		},
+		{
+			"two kink below second kink",
+			args{
+				cash:          sdk.MustNewDecFromStr("1000"),
+				borrows:       sdk.MustNewDecFromStr("5000"),
+				reserves:      sdk.MustNewDecFromStr("100"),
+				model:         twoKinkModel,
+				expectedValue: sdk.MustNewDecFromStr("0.103728813559322034"),
+			},
+		},
+		{
+			"two kink above second kink",
+			args{
+				cash:          sdk.MustNewDecFromStr("1000"),
+				borrows:       sdk.MustNewDecFromStr("5000000000000"),
+				reserves:      sdk.MustNewDecFromStr("100"),
+				model:         twoKinkModel,
+				expectedValue: sdk.MustNewDecFromStr("0.329999999640000000"),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1435,6 +1460,62 @@ func (suite *KeeperTestSuite) TestSupplyInterest() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMaxAccrualInterval() {
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("depositor")))
+	suite.setupCompositeTest(depositor)
+	startTime := suite.ctx.BlockTime()
+
+	err := suite.keeper.DepositAndBorrow(suite.ctx, depositor, cs(c("ukava", 100*KAVA_CF)), cs(c("usdx", 100*USDX_CF)))
+	suite.Require().NoError(err)
+
+	// Only accrue usdx interest in the begin blocker once an hour
+	params := suite.keeper.GetParams(suite.ctx)
+	for i, mm := range params.MoneyMarkets {
+		if mm.Denom == "usdx" {
+			params.MoneyMarkets[i].MaxAccrualInterval = time.Hour
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	requirePreviousAccrualTime := func(ctx sdk.Context, denom string, expected time.Time) {
+		previousAccrualTime, found := suite.keeper.GetPreviousAccrualTime(ctx, denom)
+		suite.Require().True(found)
+		suite.Require().Equal(expected.UTC(), previousAccrualTime.UTC())
+	}
+
+	// The changed money market accrues interest before its params are updated
+	ctx := suite.ctx.WithBlockTime(startTime.Add(10 * time.Minute))
+	hard.BeginBlocker(ctx, suite.keeper)
+	requirePreviousAccrualTime(ctx, "usdx", ctx.BlockTime())
+
+	// Idle usdx doesn't accrue interest within the interval, while ukava accrues interest every block
+	ctx = suite.ctx.WithBlockTime(startTime.Add(20 * time.Minute))
+	hard.BeginBlocker(ctx, suite.keeper)
+	requirePreviousAccrualTime(ctx, "usdx", startTime.Add(10*time.Minute))
+	requirePreviousAccrualTime(ctx, "ukava", ctx.BlockTime())
+	borrowInterestFactorPrior, found := suite.keeper.GetBorrowInterestFactor(ctx, "usdx")
+	suite.Require().True(found)
+
+	// Repaying usdx accrues its interest before the borrow changes
+	ctx = suite.ctx.WithBlockTime(startTime.Add(30 * time.Minute))
+	hard.BeginBlocker(ctx, suite.keeper)
+	requirePreviousAccrualTime(ctx, "usdx", startTime.Add(10*time.Minute))
+	err = suite.keeper.Repay(ctx, depositor, depositor, cs(c("usdx", 1*USDX_CF)))
+	suite.Require().NoError(err)
+	requirePreviousAccrualTime(ctx, "usdx", ctx.BlockTime())
+	borrowInterestFactor, found := suite.keeper.GetBorrowInterestFactor(ctx, "usdx")
+	suite.Require().True(found)
+	suite.Require().True(borrowInterestFactor.GT(borrowInterestFactorPrior))
+
+	// usdx accrues interest in the begin blocker once the interval has elapsed
+	ctx = suite.ctx.WithBlockTime(startTime.Add(80 * time.Minute))
+	hard.BeginBlocker(ctx, suite.keeper)
+	requirePreviousAccrualTime(ctx, "usdx", startTime.Add(30*time.Minute))
+	ctx = suite.ctx.WithBlockTime(startTime.Add(100 * time.Minute))
+	hard.BeginBlocker(ctx, suite.keeper)
+	requirePreviousAccrualTime(ctx, "usdx", ctx.BlockTime())
+}
+
 func TestInterestTestSuite(t *testing.T) {
 	suite.Run(t, new(InterestTestSuite))
 }
@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// invariantRoute is a hard module invariant and the route it is registered under
+type invariantRoute struct {
+	route     string
+	invariant func(k Keeper) sdk.Invariant
+}
+
+// invariantRoutes are the hard module invariants, in the order they are run
+var invariantRoutes = []invariantRoute{
+	{"deposits", DepositsInvariant},
+	{"borrows", BorrowsInvariant},
+	{"total-supplied", TotalSuppliedInvariant},
+	{"interest-factors", InterestFactorsInvariant},
+	{"position-indexes", PositionIndexesInvariant},
+}
+
+// RegisterInvariants registers the hard module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	for _, r := range invariantRoutes {
+		ir.RegisterRoute(types.ModuleName, r.route, r.invariant(k))
+	}
+}
+
+// AllInvariants runs all invariants of the hard module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, ir := range invariantRoutes {
+			if res, stop := ir.invariant(k)(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// RunInvariants runs every hard module invariant and returns their results
+func (k Keeper) RunInvariants(ctx sdk.Context) []types.InvariantResult {
+	results := make([]types.InvariantResult, 0, len(invariantRoutes))
+	for _, ir := range invariantRoutes {
+		message, broken := ir.invariant(k)(ctx)
+		results = append(results, types.InvariantResult{
+			Route:   ir.route,
+			Broken:  broken,
+			Message: message,
+		})
+	}
+	return results
+}
+
+// DepositsInvariant iterates all deposits and asserts that they are valid
+func DepositsInvariant(k Keeper) sdk.Invariant {
+	message := sdk.FormatInvariant(types.ModuleName, "validate deposits broken", "deposit invalid")
+
+	return func(ctx sdk.Context) (string, bool) {
+		broken := false
+		k.IterateDeposits(ctx, func(deposit types.Deposit) bool {
+			broken = deposit.Validate() != nil
+			return broken
+		})
+		return message, broken
+	}
+}
+
+// BorrowsInvariant iterates all borrows and asserts that they are valid
+func BorrowsInvariant(k Keeper) sdk.Invariant {
+	message := sdk.FormatInvariant(types.ModuleName, "validate borrows broken", "borrow invalid")
+
+	return func(ctx sdk.Context) (string, bool) {
+		broken := false
+		k.IterateBorrows(ctx, func(borrow types.Borrow) bool {
+			broken = borrow.Validate() != nil
+			return broken
+		})
+		return message, broken
+	}
+}
+
+// TotalSuppliedInvariant asserts that the total supplied and reserves of each denom cover the total borrowed.
+// Accrued interest is added to the total borrowed and split between the total supplied and the reserves, and
+// borrows are paid out of supplied coins, so the difference is the module account's cash, which can't go negative.
+// Reserves are not part of the total supplied, so at full utilization the total supplied alone can be below the
+// total borrowed.
+func TotalSuppliedInvariant(k Keeper) sdk.Invariant {
+	message := sdk.FormatInvariant(types.ModuleName, "total supplied broken", "total supplied and reserves below total borrowed")
+
+	return func(ctx sdk.Context) (string, bool) {
+		supplied, _ := k.GetSuppliedCoins(ctx)
+		reserves, _ := k.GetTotalReserves(ctx)
+		borrowed, _ := k.GetBorrowedCoins(ctx)
+
+		broken := !supplied.Add(reserves...).IsAllGTE(borrowed)
+		return message, broken
+	}
+}
+
+// InterestFactorsInvariant asserts that all supply and borrow interest factors are at least one. Interest factors
+// start at one and only increase as interest accrues.
+func InterestFactorsInvariant(k Keeper) sdk.Invariant {
+	message := sdk.FormatInvariant(types.ModuleName, "interest factors broken", "interest factor below one")
+
+	return func(ctx sdk.Context) (string, bool) {
+		broken := false
+		k.IterateSupplyInterestFactors(ctx, func(_ string, factor sdk.Dec) bool {
+			broken = factor.LT(sdk.OneDec())
+			return broken
+		})
+		if broken {
+			return message, broken
+		}
+
+		k.IterateBorrowInterestFactors(ctx, func(_ string, factor sdk.Dec) bool {
+			broken = factor.LT(sdk.OneDec())
+			return broken
+		})
+		return message, broken
+	}
+}
+
+// PositionIndexesInvariant asserts that no deposit or borrow holds an interest factor above the current interest
+// factor of its denom. Interest factors only increase, so a higher index means the position was synced with wrong
+// factors, and syncing it again would calculate negative interest.
+func PositionIndexesInvariant(k Keeper) sdk.Invariant {
+	message := sdk.FormatInvariant(types.ModuleName, "position interest indexes broken", "position interest factor above current interest factor")
+
+	return func(ctx sdk.Context) (string, bool) {
+		broken := false
+		k.IterateDeposits(ctx, func(deposit types.Deposit) bool {
+			for _, index := range deposit.Index {
+				factor, found := k.GetSupplyInterestFactor(ctx, index.Denom)
+				if !found || index.Value.GT(factor) {
+					broken = true
+					return true
+				}
+			}
+			return false
+		})
+		if broken {
+			return message, broken
+		}
+
+		k.IterateBorrows(ctx, func(borrow types.Borrow) bool {
+			for _, index := range borrow.Index {
+				factor, found := k.GetBorrowInterestFactor(ctx, index.Denom)
+				if !found || index.Value.GT(factor) {
+					broken = true
+					return true
+				}
+			}
+			return false
+		})
+		return message, broken
+	}
+}
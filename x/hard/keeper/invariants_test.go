@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cometbft/cometbft/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/keeper"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("depositor")))
+
+	// setupValidState creates a deposit and borrow and accrues interest on them
+	setupValidState := func() {
+		suite.setupCompositeTest(depositor)
+		suite.Require().NoError(suite.keeper.DepositAndBorrow(suite.ctx, depositor, cs(c("ukava", 100*KAVA_CF)), cs(c("ukava", 40*KAVA_CF))))
+		suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(24 * time.Hour))
+		suite.Require().NoError(suite.keeper.AccrueInterest(suite.ctx, "ukava"))
+	}
+
+	testCases := []struct {
+		name      string
+		invariant func(k keeper.Keeper) sdk.Invariant
+		breakIt   func()
+	}{
+		{
+			"deposits",
+			keeper.DepositsInvariant,
+			func() {
+				suite.keeper.SetDeposit(suite.ctx, types.NewDeposit(depositor, sdk.Coins{sdk.Coin{Denom: "ukava", Amount: sdk.NewInt(-1)}}, types.SupplyInterestFactors{}))
+			},
+		},
+		{
+			"borrows",
+			keeper.BorrowsInvariant,
+			func() {
+				suite.keeper.SetBorrow(suite.ctx, types.NewBorrow(depositor, sdk.Coins{sdk.Coin{Denom: "usdx", Amount: sdk.NewInt(-1)}}, types.BorrowInterestFactors{}))
+			},
+		},
+		{
+			"total-supplied",
+			keeper.TotalSuppliedInvariant,
+			func() {
+				borrowed, _ := suite.keeper.GetBorrowedCoins(suite.ctx)
+				suite.keeper.SetBorrowedCoins(suite.ctx, borrowed.Add(c("ukava", 1000*KAVA_CF)))
+			},
+		},
+		{
+			"interest-factors",
+			keeper.InterestFactorsInvariant,
+			func() {
+				suite.keeper.SetBorrowInterestFactor(suite.ctx, "ukava", sdk.MustNewDecFromStr("0.99"))
+			},
+		},
+		{
+			"position-indexes",
+			keeper.PositionIndexesInvariant,
+			func() {
+				factor, found := suite.keeper.GetSupplyInterestFactor(suite.ctx, "ukava")
+				suite.Require().True(found)
+				suite.keeper.SetSupplyInterestFactor(suite.ctx, "ukava", factor.Sub(sdk.MustNewDecFromStr("0.01")))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			setupValidState()
+
+			for _, result := range suite.keeper.RunInvariants(suite.ctx) {
+				suite.False(result.Broken, result.Route)
+			}
+			message, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+			suite.False(broken, message)
+
+			tc.breakIt()
+
+			message, broken = tc.invariant(suite.keeper)(suite.ctx)
+			suite.True(broken)
+			suite.Contains(message, types.ModuleName)
+
+			_, broken = keeper.AllInvariants(suite.keeper)(suite.ctx)
+			suite.True(broken)
+			for _, result := range suite.keeper.RunInvariants(suite.ctx) {
+				if result.Route == tc.name {
+					suite.True(result.Broken)
+					suite.Equal(message, result.Message)
+				}
+			}
+		})
+	}
+}
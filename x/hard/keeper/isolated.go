@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// validateIsolatedBorrow returns an error if any of the borrowed coins can't be backed by the isolated market
+// collateral in a position's deposit. A position holding collateral from an isolated market can only borrow the
+// market's isolated debt denoms.
+func (k Keeper) validateIsolatedBorrow(ctx sdk.Context, depositCoins, borrowCoins sdk.Coins) error {
+	for _, depCoin := range depositCoins {
+		moneyMarket, found := k.GetMoneyMarket(ctx, depCoin.Denom)
+		if !found {
+			continue
+		}
+		for _, coin := range borrowCoins {
+			if !moneyMarket.CanBackBorrow(coin.Denom) {
+				return errorsmod.Wrapf(types.ErrInvalidIsolatedBorrow, "isolated collateral %s cannot back borrows of %s", depCoin.Denom, coin.Denom)
+			}
+		}
+	}
+	return nil
+}
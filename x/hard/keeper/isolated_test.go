@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func (suite *KeeperTestSuite) setupIsolatedTest(borrower sdk.AccAddress) {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+
+	authGS := app.NewFundedGenStateWithCoins(
+		tApp.AppCodec(),
+		[]sdk.Coins{cs(c("busd", 100*USDX_CF), c("ukava", 100*KAVA_CF))},
+		[]sdk.AccAddress{borrower},
+	)
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+	kavaMarket := types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")), "kava:usd", sdkmath.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	kavaMarket.Isolated = true
+	kavaMarket.IsolatedDebtDenoms = []string{"usdx"}
+	hardGS := types.NewGenesisState(types.NewParams(
+		types.MoneyMarkets{
+			types.NewMoneyMarket("usdx", types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.8")), "usdx:usd", sdkmath.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+			types.NewMoneyMarket("busd", types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.8")), "busd:usd", sdkmath.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+			kavaMarket,
+		},
+		sdk.NewDec(10),
+	), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+	)
+
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "busd:usd", BaseAsset: "busd", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{
+				MarketID:      "usdx:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("1.00"),
+				Expiry:        time.Now().Add(1 * time.Hour),
+			},
+			{
+				MarketID:      "busd:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("1.00"),
+				Expiry:        time.Now().Add(1 * time.Hour),
+			},
+			{
+				MarketID:      "kava:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("2.00"),
+				Expiry:        time.Now().Add(1 * time.Hour),
+			},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)},
+	)
+
+	err := tApp.GetBankKeeper().MintCoins(ctx, types.ModuleAccountName, cs(c("ukava", 1000*KAVA_CF), c("usdx", 1000*USDX_CF), c("busd", 1000*USDX_CF)))
+	suite.Require().NoError(err)
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+}
+
+func (suite *KeeperTestSuite) TestIsolatedBorrow() {
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+
+	type args struct {
+		depositCoins sdk.Coins
+		borrowCoins  sdk.Coins
+	}
+	type errArgs struct {
+		expectPass bool
+		contains   string
+	}
+	type isolatedBorrowTest struct {
+		name    string
+		args    args
+		errArgs errArgs
+	}
+
+	testCases := []isolatedBorrowTest{
+		{
+			"valid: isolated collateral backs isolated debt denom",
+			args{
+				depositCoins: cs(c("ukava", 10*KAVA_CF)),
+				borrowCoins:  cs(c("usdx", 15*USDX_CF)),
+			},
+			errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			"valid: non-isolated collateral backs isolated market borrows",
+			args{
+				depositCoins: cs(c("busd", 100*USDX_CF)),
+				borrowCoins:  cs(c("ukava", 10*KAVA_CF)),
+			},
+			errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			"invalid: isolated collateral backs other denom",
+			args{
+				depositCoins: cs(c("ukava", 10*KAVA_CF)),
+				borrowCoins:  cs(c("busd", 15*USDX_CF)),
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "borrow not allowed by isolated collateral",
+			},
+		},
+		{
+			"invalid: position with isolated and non-isolated collateral borrows other denom",
+			args{
+				depositCoins: cs(c("ukava", 10*KAVA_CF), c("busd", 100*USDX_CF)),
+				borrowCoins:  cs(c("busd", 15*USDX_CF)),
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "borrow not allowed by isolated collateral",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.setupIsolatedTest(borrower)
+
+			err := suite.keeper.Deposit(suite.ctx, borrower, tc.args.depositCoins)
+			suite.Require().NoError(err)
+
+			err = suite.keeper.Borrow(suite.ctx, borrower, tc.args.borrowCoins)
+			if tc.errArgs.expectPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errArgs.contains)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestIsolatedDeposit() {
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+
+	type args struct {
+		borrowCoins  sdk.Coins
+		depositCoins sdk.Coins
+	}
+	type errArgs struct {
+		expectPass bool
+		contains   string
+	}
+	type isolatedDepositTest struct {
+		name    string
+		args    args
+		errArgs errArgs
+	}
+
+	testCases := []isolatedDepositTest{
+		{
+			"valid: isolated collateral added to position borrowing isolated debt denom",
+			args{
+				borrowCoins:  cs(c("usdx", 15*USDX_CF)),
+				depositCoins: cs(c("ukava", 10*KAVA_CF)),
+			},
+			errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			"invalid: isolated collateral added to position borrowing other denom",
+			args{
+				borrowCoins:  cs(c("busd", 15*USDX_CF)),
+				depositCoins: cs(c("ukava", 10*KAVA_CF)),
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "borrow not allowed by isolated collateral",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.setupIsolatedTest(borrower)
+
+			err := suite.keeper.Deposit(suite.ctx, borrower, cs(c("busd", 50*USDX_CF)))
+			suite.Require().NoError(err)
+			err = suite.keeper.Borrow(suite.ctx, borrower, tc.args.borrowCoins)
+			suite.Require().NoError(err)
+
+			err = suite.keeper.Deposit(suite.ctx, borrower, tc.args.depositCoins)
+			if tc.errArgs.expectPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errArgs.contains)
+			}
+		})
+	}
+}
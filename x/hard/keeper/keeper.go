@@ -1,8 +1,10 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -15,36 +17,58 @@ import (
 // Keeper keeper for the hard module
 type Keeper struct {
 	key             storetypes.StoreKey
+	transientKey    storetypes.StoreKey
 	cdc             codec.Codec
 	paramSubspace   paramtypes.Subspace
 	accountKeeper   types.AccountKeeper
 	bankKeeper      types.BankKeeper
 	pricefeedKeeper types.PricefeedKeeper
 	auctionKeeper   types.AuctionKeeper
+	router          *baseapp.MsgServiceRouter
 	hooks           types.HARDHooks
+
+	// the address capable of executing governance operations such as withdrawing reserves. Usually the gov module account.
+	authority sdk.AccAddress
 }
 
 // NewKeeper creates a new keeper
-func NewKeeper(cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.Subspace,
+func NewKeeper(cdc codec.Codec, key, transientKey storetypes.StoreKey, paramstore paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper,
-	pfk types.PricefeedKeeper, auk types.AuctionKeeper,
+	pfk types.PricefeedKeeper, auk types.AuctionKeeper, router *baseapp.MsgServiceRouter,
+	authority sdk.AccAddress,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
 	}
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", err))
+	}
 
 	return Keeper{
 		key:             key,
+		transientKey:    transientKey,
 		cdc:             cdc,
 		paramSubspace:   paramstore,
 		accountKeeper:   ak,
 		bankKeeper:      bk,
 		pricefeedKeeper: pfk,
 		auctionKeeper:   auk,
+		router:          router,
 		hooks:           nil,
+		authority:       authority,
 	}
 }
 
+// Codec returns the codec used by the keeper to marshal store values.
+func (k Keeper) Codec() codec.Codec {
+	return k.cdc
+}
+
+// GetAuthority returns the hard module's authority.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
 // SetHooks adds hooks to the keeper.
 func (k *Keeper) SetHooks(hooks types.HARDHooks) *Keeper {
 	if k.hooks != nil {
@@ -54,6 +78,11 @@ func (k *Keeper) SetHooks(hooks types.HARDHooks) *Keeper {
 	return k
 }
 
+// ClearHooks clears the hooks on the keeper
+func (k *Keeper) ClearHooks() {
+	k.hooks = nil
+}
+
 // GetDeposit returns a deposit from the store for a particular depositor address, deposit denom
 func (k Keeper) GetDeposit(ctx sdk.Context, depositor sdk.AccAddress) (types.Deposit, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
@@ -206,6 +235,19 @@ func (k Keeper) GetMoneyMarket(ctx sdk.Context, denom string) (types.MoneyMarket
 	return moneyMarket, true
 }
 
+// GetMoneyMarketPrice returns the price of a money market's asset from its spot market, which is the time-weighted
+// average price when the money market has a twap duration
+func (k Keeper) GetMoneyMarketPrice(ctx sdk.Context, moneyMarket types.MoneyMarket) (sdk.Dec, error) {
+	if moneyMarket.TwapDuration > 0 {
+		return k.pricefeedKeeper.GetTwap(ctx, moneyMarket.SpotMarketID, moneyMarket.TwapDuration)
+	}
+	priceData, err := k.pricefeedKeeper.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return priceData.Price, nil
+}
+
 // SetMoneyMarket sets a money market in the store for a denom
 func (k Keeper) SetMoneyMarket(ctx sdk.Context, denom string, moneyMarket types.MoneyMarket) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.MoneyMarketsPrefix)
@@ -364,3 +406,35 @@ func (k Keeper) IterateSupplyInterestFactors(ctx sdk.Context, cb func(denom stri
 		}
 	}
 }
+
+// GetEModeCategory returns the e-mode category an account has enabled
+func (k Keeper) GetEModeCategory(ctx sdk.Context, addr sdk.AccAddress) (string, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.EModeCategoryPrefix)
+	bz := store.Get(addr.Bytes())
+	if len(bz) == 0 {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SetEModeCategory sets the e-mode category an account has enabled, or removes it if the category is empty
+func (k Keeper) SetEModeCategory(ctx sdk.Context, addr sdk.AccAddress, category string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.EModeCategoryPrefix)
+	if category == "" {
+		store.Delete(addr.Bytes())
+		return
+	}
+	store.Set(addr.Bytes(), []byte(category))
+}
+
+// IterateEModeCategories iterates over all accounts with an e-mode category enabled
+func (k Keeper) IterateEModeCategories(ctx sdk.Context, cb func(addr sdk.AccAddress, category string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.EModeCategoryPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Key()), string(iterator.Value())) {
+			break
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/suite"
@@ -18,6 +19,7 @@ import (
 	auctionkeeper "github.com/kava-labs/kava/x/auction/keeper"
 	"github.com/kava-labs/kava/x/hard/keeper"
 	"github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 // Test suite used for all keeper tests
@@ -205,6 +207,37 @@ func (suite *KeeperTestSuite) TestGetSetBorrowedCoins_Empty() {
 	suite.Require().Empty(coins)
 }
 
+func (suite *KeeperTestSuite) TestGetMoneyMarketPrice() {
+	pfKeeper := suite.app.GetPriceFeedKeeper()
+	pfKeeper.SetParams(suite.ctx, pricefeedtypes.Params{
+		Markets: pricefeedtypes.Markets{
+			{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+		},
+		TwapWindow: time.Hour,
+	})
+	setPrice := func(price sdk.Dec) {
+		_, err := pfKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, "bnb:usd", price, suite.ctx.BlockTime().Add(time.Hour))
+		suite.Require().NoError(err)
+		suite.Require().NoError(pfKeeper.SetCurrentPrices(suite.ctx, "bnb:usd"))
+	}
+
+	mm := types.MoneyMarket{Denom: "bnb", SpotMarketID: "bnb:usd"}
+	setPrice(sdk.MustNewDecFromStr("10.0"))
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(30 * time.Minute))
+	setPrice(sdk.MustNewDecFromStr("20.0"))
+
+	price, err := suite.keeper.GetMoneyMarketPrice(suite.ctx, mm)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("20.0"), price)
+
+	// markets with a twap duration are priced at the time-weighted average of their spot market
+	mm.TwapDuration = time.Hour
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(30 * time.Minute))
+	price, err = suite.keeper.GetMoneyMarketPrice(suite.ctx, mm)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("15.0"), price)
+}
+
 func (suite *KeeperTestSuite) getAccountCoins(acc authtypes.AccountI) sdk.Coins {
 	bk := suite.app.GetBankKeeper()
 	return bk.GetAllBalances(suite.ctx, acc.GetAddress())
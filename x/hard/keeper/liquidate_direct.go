@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// LiquidateDirect enables a liquidator to repay the whole borrow of a liquidatable position in exchange for the
+// position's deposits at a discount, without starting auctions. It returns the repaid and seized coins.
+func (k Keeper) LiquidateDirect(ctx sdk.Context, liquidator, borrower sdk.AccAddress) (sdk.Coins, sdk.Coins, error) {
+	deposit, found := k.GetDeposit(ctx, borrower)
+	if !found {
+		return nil, nil, types.ErrDepositNotFound
+	}
+
+	borrow, found := k.GetBorrow(ctx, borrower)
+	if !found {
+		return nil, nil, types.ErrBorrowNotFound
+	}
+
+	// Call incentive hooks
+	k.BeforeDepositModified(ctx, deposit)
+	k.BeforeBorrowModified(ctx, borrow)
+
+	k.SyncBorrowInterest(ctx, borrower)
+	k.SyncSupplyInterest(ctx, borrower)
+
+	deposit, _ = k.GetDeposit(ctx, borrower)
+	borrow, _ = k.GetBorrow(ctx, borrower)
+
+	isWithinRange, err := k.IsWithinValidLtvRange(ctx, deposit, borrow)
+	if err != nil {
+		return nil, nil, err
+	}
+	if isWithinRange {
+		return nil, nil, errorsmod.Wrapf(types.ErrBorrowNotLiquidatable, "position is within valid LTV range")
+	}
+
+	seizedCoins, err := k.CalculateDirectLiquidation(ctx, deposit, borrow)
+	if err != nil {
+		return nil, nil, err
+	}
+	repaidCoins := borrow.Amount
+
+	// Liquidator repays the whole borrow and receives the seized deposits
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, liquidator, types.ModuleAccountName, repaidCoins); err != nil {
+		return nil, nil, err
+	}
+	if err := k.DecrementBorrowedCoins(ctx, repaidCoins); err != nil {
+		return nil, nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, liquidator, seizedCoins); err != nil {
+		return nil, nil, err
+	}
+	if err := k.DecrementSuppliedCoins(ctx, seizedCoins); err != nil {
+		return nil, nil, err
+	}
+
+	// If any coin denoms have been completely seized reset the denom's supply index factor
+	for _, coin := range seizedCoins {
+		if coin.Amount.Equal(deposit.Amount.AmountOf(coin.Denom)) {
+			depositIndex, removed := deposit.Index.RemoveInterestFactor(coin.Denom)
+			if !removed {
+				return nil, nil, errorsmod.Wrapf(types.ErrInvalidIndexFactorDenom, "%s", coin.Denom)
+			}
+			deposit.Index = depositIndex
+		}
+	}
+	deposit.Amount = deposit.Amount.Sub(seizedCoins...)
+	if deposit.Amount.Empty() {
+		k.DeleteDeposit(ctx, deposit)
+	} else {
+		k.SetDeposit(ctx, deposit)
+	}
+	k.AfterDepositModified(ctx, deposit)
+
+	borrow.Amount = sdk.NewCoins()
+	k.DeleteBorrow(ctx, borrow)
+	k.AfterBorrowModified(ctx, borrow)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardLiquidateDirect,
+			sdk.NewAttribute(types.AttributeKeyLiquidatedOwner, borrower.String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidator, liquidator.String()),
+			sdk.NewAttribute(types.AttributeKeyRepayCoins, repaidCoins.String()),
+			sdk.NewAttribute(types.AttributeKeySeizedCoins, seizedCoins.String()),
+		),
+	)
+	return repaidCoins, seizedCoins, nil
+}
+
+// CalculateDirectLiquidation calculates the deposit coins sold to a liquidator that repays a position's whole borrow.
+// Deposits are sold in proportion to their USD value, with each deposit market's direct liquidation discount applied,
+// up to the whole deposit. It returns an error if the position's borrow USD value is above the smallest direct
+// liquidation threshold of the borrowed markets.
+func (k Keeper) CalculateDirectLiquidation(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (sdk.Coins, error) {
+	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	if err != nil {
+		return nil, err
+	}
+
+	var threshold sdk.Dec
+	totalBorrowedUSDAmount := sdk.ZeroDec()
+	for _, coin := range borrow.Amount {
+		mm, _ := k.GetMoneyMarket(ctx, coin.Denom)
+		if mm.DirectLiquidationThreshold.IsNil() || !mm.DirectLiquidationThreshold.IsPositive() {
+			return nil, errorsmod.Wrapf(types.ErrDirectLiquidationNotAllowed, "money market %s has no direct liquidation threshold", coin.Denom)
+		}
+		if threshold.IsNil() || mm.DirectLiquidationThreshold.LT(threshold) {
+			threshold = mm.DirectLiquidationThreshold
+		}
+
+		lData := liqMap[coin.Denom]
+		usdValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
+		totalBorrowedUSDAmount = totalBorrowedUSDAmount.Add(usdValue)
+	}
+	if totalBorrowedUSDAmount.GT(threshold) {
+		return nil, errorsmod.Wrapf(types.ErrDirectLiquidationNotAllowed,
+			"borrow value $%s is above the direct liquidation threshold $%s", totalBorrowedUSDAmount, threshold)
+	}
+
+	// The USD value paid by the liquidator for every deposit, after discounts
+	totalDiscountedUSDAmount := sdk.ZeroDec()
+	for _, coin := range deposit.Amount {
+		mm, _ := k.GetMoneyMarket(ctx, coin.Denom)
+		discount := sdk.ZeroDec()
+		if !mm.DirectLiquidationDiscount.IsNil() {
+			discount = mm.DirectLiquidationDiscount
+		}
+
+		lData := liqMap[coin.Denom]
+		usdValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
+		totalDiscountedUSDAmount = totalDiscountedUSDAmount.Add(usdValue.Mul(sdk.OneDec().Sub(discount)))
+	}
+
+	// The liquidator receives the whole deposit if it doesn't cover the borrow after discounts
+	if !totalDiscountedUSDAmount.IsPositive() || totalBorrowedUSDAmount.GTE(totalDiscountedUSDAmount) {
+		return deposit.Amount, nil
+	}
+
+	// Round in the borrower's favor, selling less of the deposit
+	seizedFraction := totalBorrowedUSDAmount.Quo(totalDiscountedUSDAmount)
+	seizedCoins := sdk.NewCoins()
+	for _, coin := range deposit.Amount {
+		seizedCoins = seizedCoins.Add(sdk.NewCoin(coin.Denom, seizedFraction.MulInt(coin.Amount).TruncateInt()))
+	}
+	return seizedCoins, nil
+}
@@ -0,0 +1,223 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func (suite *KeeperTestSuite) TestLiquidateDirect() {
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+	liquidator := sdk.AccAddress(crypto.AddressHash([]byte("direct liquidator")))
+
+	type args struct {
+		threshold       sdk.Dec
+		discount        sdk.Dec
+		liquidatable    bool
+		liquidatorCoins sdk.Coins
+		expectedRepaid  sdk.Coins
+		expectedSeized  sdk.Coins
+	}
+	type errArgs struct {
+		expectPass bool
+		contains   string
+	}
+	type liquidateDirectTest struct {
+		name    string
+		args    args
+		errArgs errArgs
+	}
+
+	testCases := []liquidateDirectTest{
+		{
+			"valid: deposit sold at a discount",
+			args{
+				threshold:       sdk.NewDec(200),
+				discount:        sdk.MustNewDecFromStr("0.05"),
+				liquidatable:    true,
+				liquidatorCoins: cs(c("usdx", 200*USDX_CF)),
+				expectedRepaid:  cs(c("usdx", 150*USDX_CF)),
+				// $150 / ($2 * 0.95) = 78.947368 KAVA, rounded down
+				expectedSeized: cs(c("ukava", 78_947_368)),
+			},
+			errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			"valid: whole deposit sold when it doesn't cover the borrow after the discount",
+			args{
+				threshold:       sdk.NewDec(200),
+				discount:        sdk.MustNewDecFromStr("0.3"),
+				liquidatable:    true,
+				liquidatorCoins: cs(c("usdx", 200*USDX_CF)),
+				expectedRepaid:  cs(c("usdx", 150*USDX_CF)),
+				expectedSeized:  cs(c("ukava", 100*KAVA_CF)),
+			},
+			errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			"invalid: within valid LTV range",
+			args{
+				threshold:       sdk.NewDec(200),
+				discount:        sdk.MustNewDecFromStr("0.05"),
+				liquidatable:    false,
+				liquidatorCoins: cs(c("usdx", 200*USDX_CF)),
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "position is within valid LTV range",
+			},
+		},
+		{
+			"invalid: borrow above threshold",
+			args{
+				threshold:       sdk.NewDec(100),
+				discount:        sdk.MustNewDecFromStr("0.05"),
+				liquidatable:    true,
+				liquidatorCoins: cs(c("usdx", 200*USDX_CF)),
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "above the direct liquidation threshold",
+			},
+		},
+		{
+			"invalid: no threshold",
+			args{
+				threshold:       sdk.ZeroDec(),
+				discount:        sdk.MustNewDecFromStr("0.05"),
+				liquidatable:    true,
+				liquidatorCoins: cs(c("usdx", 200*USDX_CF)),
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "has no direct liquidation threshold",
+			},
+		},
+		{
+			"invalid: liquidator can't repay borrow",
+			args{
+				threshold:       sdk.NewDec(200),
+				discount:        sdk.MustNewDecFromStr("0.05"),
+				liquidatable:    true,
+				liquidatorCoins: cs(c("usdx", 100*USDX_CF)),
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "insufficient funds",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+
+			authGS := app.NewFundedGenStateWithCoins(
+				tApp.AppCodec(),
+				[]sdk.Coins{cs(c("ukava", 100*KAVA_CF)), tc.args.liquidatorCoins},
+				[]sdk.AccAddress{borrower, liquidator},
+			)
+
+			model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+			usdxMarket := types.NewMoneyMarket("usdx", types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.8")), "usdx:usd", sdkmath.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+			usdxMarket.DirectLiquidationThreshold = tc.args.threshold
+			kavaMarket := types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")), "kava:usd", sdkmath.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+			kavaMarket.DirectLiquidationDiscount = tc.args.discount
+			hardGS := types.NewGenesisState(types.NewParams(
+				types.MoneyMarkets{usdxMarket, kavaMarket},
+				sdk.NewDec(10),
+			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+			)
+
+			pricefeedGS := pricefeedtypes.GenesisState{
+				Params: pricefeedtypes.Params{
+					Markets: []pricefeedtypes.Market{
+						{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+					},
+				},
+				PostedPrices: []pricefeedtypes.PostedPrice{
+					{
+						MarketID:      "usdx:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("1.00"),
+						Expiry:        time.Now().Add(1 * time.Hour),
+					},
+					{
+						MarketID:      "kava:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("2.00"),
+						Expiry:        time.Now().Add(1 * time.Hour),
+					},
+				},
+			}
+
+			tApp.InitializeFromGenesisStates(authGS,
+				app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+				app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)},
+			)
+
+			bankKeeper := tApp.GetBankKeeper()
+			err := bankKeeper.MintCoins(ctx, types.ModuleAccountName, cs(c("usdx", 1000*USDX_CF)))
+			suite.Require().NoError(err)
+
+			suite.app = tApp
+			suite.ctx = ctx
+			suite.keeper = tApp.GetHardKeeper()
+
+			hard.BeginBlocker(suite.ctx, suite.keeper)
+
+			err = suite.keeper.Deposit(suite.ctx, borrower, cs(c("ukava", 100*KAVA_CF)))
+			suite.Require().NoError(err)
+			err = suite.keeper.Borrow(suite.ctx, borrower, cs(c("usdx", 150*USDX_CF)))
+			suite.Require().NoError(err)
+
+			if tc.args.liquidatable {
+				// Lower the kava LTV so the position is liquidatable
+				params := suite.keeper.GetParams(suite.ctx)
+				params.MoneyMarkets[1].BorrowLimit.LoanToValue = sdk.MustNewDecFromStr("0.7")
+				suite.keeper.SetParams(suite.ctx, params)
+				hard.BeginBlocker(suite.ctx, suite.keeper)
+			}
+
+			repaid, seized, err := suite.keeper.LiquidateDirect(suite.ctx, liquidator, borrower)
+			if tc.errArgs.expectPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.args.expectedRepaid, repaid)
+				suite.Require().Equal(tc.args.expectedSeized, seized)
+
+				// Liquidator pays the borrow and receives the seized deposit
+				expectedLiquidatorCoins := tc.args.liquidatorCoins.Sub(tc.args.expectedRepaid...).Add(tc.args.expectedSeized...)
+				suite.Require().Equal(expectedLiquidatorCoins, bankKeeper.GetAllBalances(suite.ctx, liquidator))
+
+				// Borrow is closed and the rest of the deposit remains
+				_, found := suite.keeper.GetBorrow(suite.ctx, borrower)
+				suite.Require().False(found)
+				deposit, _ := suite.keeper.GetDeposit(suite.ctx, borrower)
+				suite.Require().Equal(cs(c("ukava", 100*KAVA_CF)).Sub(tc.args.expectedSeized...), sdk.NewCoins(deposit.Amount...))
+
+				// No auctions are started
+				auctions := tApp.GetAuctionKeeper().GetAllAuctions(suite.ctx)
+				suite.Require().Empty(auctions)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errArgs.contains)
+			}
+		})
+	}
+}
@@ -54,6 +54,14 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper sdk.AccAddress,
 		return errorsmod.Wrapf(types.ErrBorrowNotLiquidatable, "position is within valid LTV range")
 	}
 
+	seizedCoins, repaidCoins, isPartial, err := k.CalculatePartialLiquidation(ctx, deposit, borrow)
+	if err != nil {
+		return err
+	}
+	if isPartial {
+		return k.partiallyLiquidate(ctx, keeper, deposit, borrow, seizedCoins, repaidCoins)
+	}
+
 	// Sending coins to auction module with keeper address getting % of the profits
 	borrowDenoms := getDenoms(borrow.Amount)
 	depositDenoms := getDenoms(deposit.Amount)
@@ -72,15 +80,155 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper sdk.AccAddress,
 	return nil
 }
 
-// SeizeDeposits seizes a list of deposits and sends them to auction
-func (k Keeper) SeizeDeposits(ctx sdk.Context, keeper sdk.AccAddress, deposit types.Deposit,
-	borrow types.Borrow, dDenoms, bDenoms []string,
+// partiallyLiquidate seizes part of a position's deposits to repay part of its borrow, leaving the rest of the
+// position in place.
+func (k Keeper) partiallyLiquidate(ctx sdk.Context, keeper sdk.AccAddress, deposit types.Deposit, borrow types.Borrow,
+	seizedCoins, repaidCoins sdk.Coins,
 ) error {
-	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	// The seized coins are worth the repaid borrow plus the liquidation bonus. The bonus is paid to the keeper, and
+	// the rest is auctioned for the repaid borrow.
+	liquidationBonusCoins := sdk.Coins{}
+	for _, coin := range seizedCoins {
+		mm, _ := k.GetMoneyMarket(ctx, coin.Denom)
+		if mm.LiquidationBonus.IsNil() || !mm.LiquidationBonus.IsPositive() {
+			continue
+		}
+		bonus := mm.LiquidationBonus.MulInt(coin.Amount).Quo(sdk.OneDec().Add(mm.LiquidationBonus)).TruncateInt()
+		if bonus.IsPositive() {
+			liquidationBonusCoins = append(liquidationBonusCoins, sdk.NewCoin(coin.Denom, bonus))
+		}
+	}
+
+	seizedDeposit := types.NewDeposit(deposit.Depositor, seizedCoins, types.SupplyInterestFactors{})
+	repaidBorrow := types.NewBorrow(borrow.Borrower, repaidCoins, types.BorrowInterestFactors{})
+	err := k.seizeDepositsWithKeeperReward(ctx, keeper, seizedDeposit, repaidBorrow, liquidationBonusCoins)
 	if err != nil {
 		return err
 	}
 
+	// If any coin denoms have been completely seized reset the denom's supply index factor
+	for _, coin := range seizedCoins {
+		if coin.Amount.Equal(deposit.Amount.AmountOf(coin.Denom)) {
+			depositIndex, removed := deposit.Index.RemoveInterestFactor(coin.Denom)
+			if !removed {
+				return errorsmod.Wrapf(types.ErrInvalidIndexFactorDenom, "%s", coin.Denom)
+			}
+			deposit.Index = depositIndex
+		}
+	}
+	deposit.Amount = deposit.Amount.Sub(seizedCoins...)
+	if deposit.Amount.Empty() {
+		k.DeleteDeposit(ctx, deposit)
+	} else {
+		k.SetDeposit(ctx, deposit)
+	}
+	k.AfterDepositModified(ctx, deposit)
+
+	// If any coin denoms have been completely repaid reset the denom's borrow index factor
+	for _, coin := range repaidCoins {
+		if coin.Amount.Equal(borrow.Amount.AmountOf(coin.Denom)) {
+			borrowIndex, removed := borrow.Index.RemoveInterestFactor(coin.Denom)
+			if !removed {
+				return errorsmod.Wrapf(types.ErrInvalidIndexFactorDenom, "%s", coin.Denom)
+			}
+			borrow.Index = borrowIndex
+		}
+	}
+	borrow.Amount = borrow.Amount.Sub(repaidCoins...)
+	if borrow.Amount.Empty() {
+		k.DeleteBorrow(ctx, borrow)
+	} else {
+		k.SetBorrow(ctx, borrow)
+	}
+	k.AfterBorrowModified(ctx, borrow)
+
+	return nil
+}
+
+// CalculatePartialLiquidation calculates the deposit coins seized and the borrow coins repaid to bring a position
+// back within its valid LTV range. Deposits are seized in proportion to their USD value, with each deposit market's
+// liquidation bonus added to the debt value it repays, and borrows are repaid in proportion to their USD value.
+// The repaid borrow is limited by the smallest close factor of the borrowed markets.
+// The boolean returned is false if the whole position should be liquidated instead: if a borrowed market has no
+// close factor, if seizing every deposit would not restore the position, or if the remaining borrow would be below
+// the minimum borrow USD value.
+func (k Keeper) CalculatePartialLiquidation(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (sdk.Coins, sdk.Coins, bool, error) {
+	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	closeFactor := sdk.OneDec()
+	totalBorrowedUSDAmount := sdk.ZeroDec()
+	for _, coin := range borrow.Amount {
+		mm, _ := k.GetMoneyMarket(ctx, coin.Denom)
+		if mm.CloseFactor.IsNil() || !mm.CloseFactor.IsPositive() {
+			return nil, nil, false, nil
+		}
+		closeFactor = sdk.MinDec(closeFactor, mm.CloseFactor)
+
+		lData := liqMap[coin.Denom]
+		usdValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
+		totalBorrowedUSDAmount = totalBorrowedUSDAmount.Add(usdValue)
+	}
+
+	// The USD value of debt repaid by seizing every deposit, after liquidation bonuses
+	totalRepayableUSDAmount := sdk.ZeroDec()
+	totalBorrowableUSDAmount := sdk.ZeroDec()
+	for _, coin := range deposit.Amount {
+		mm, _ := k.GetMoneyMarket(ctx, coin.Denom)
+		liquidationBonus := sdk.ZeroDec()
+		if !mm.LiquidationBonus.IsNil() {
+			liquidationBonus = mm.LiquidationBonus
+		}
+
+		lData := liqMap[coin.Denom]
+		usdValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
+		totalRepayableUSDAmount = totalRepayableUSDAmount.Add(usdValue.Quo(sdk.OneDec().Add(liquidationBonus)))
+		totalBorrowableUSDAmount = totalBorrowableUSDAmount.Add(usdValue.Mul(lData.ltv))
+	}
+
+	// Seizing a fraction f of every deposit repays f * totalRepayable of debt and reduces the borrowable amount by
+	// f * totalBorrowable, so the position is restored when f >= shortfall / (totalRepayable - totalBorrowable)
+	restorableUSDAmount := totalRepayableUSDAmount.Sub(totalBorrowableUSDAmount)
+	if !restorableUSDAmount.IsPositive() || !totalBorrowedUSDAmount.IsPositive() {
+		return nil, nil, false, nil
+	}
+	seizedFraction := totalBorrowedUSDAmount.Sub(totalBorrowableUSDAmount).Quo(restorableUSDAmount)
+
+	maxSeizedFraction := totalBorrowedUSDAmount.Mul(closeFactor).Quo(totalRepayableUSDAmount)
+	seizedFraction = sdk.MinDec(seizedFraction, maxSeizedFraction)
+	if seizedFraction.GTE(sdk.OneDec()) {
+		return nil, nil, false, nil
+	}
+
+	repaidUSDAmount := totalRepayableUSDAmount.Mul(seizedFraction)
+	if totalBorrowedUSDAmount.Sub(repaidUSDAmount).LT(k.GetMinimumBorrowUSDValue(ctx)) {
+		return nil, nil, false, nil
+	}
+	repaidFraction := repaidUSDAmount.Quo(totalBorrowedUSDAmount)
+
+	// Round in the borrower's favor, seizing less and repaying more
+	seizedCoins := sdk.NewCoins()
+	for _, coin := range deposit.Amount {
+		seizedCoins = seizedCoins.Add(sdk.NewCoin(coin.Denom, seizedFraction.MulInt(coin.Amount).TruncateInt()))
+	}
+	repaidCoins := sdk.NewCoins()
+	for _, coin := range borrow.Amount {
+		repaid := sdkmath.MinInt(repaidFraction.MulInt(coin.Amount).Ceil().TruncateInt(), coin.Amount)
+		repaidCoins = repaidCoins.Add(sdk.NewCoin(coin.Denom, repaid))
+	}
+	if seizedCoins.IsZero() || repaidCoins.IsZero() {
+		return nil, nil, false, nil
+	}
+
+	return seizedCoins, repaidCoins, true, nil
+}
+
+// SeizeDeposits seizes a list of deposits and sends them to auction
+func (k Keeper) SeizeDeposits(ctx sdk.Context, keeper sdk.AccAddress, deposit types.Deposit,
+	borrow types.Borrow, dDenoms, bDenoms []string,
+) error {
 	// Seize % of every deposit and send to the keeper
 	keeperRewardCoins := sdk.Coins{}
 	for _, depCoin := range deposit.Amount {
@@ -92,6 +240,20 @@ func (k Keeper) SeizeDeposits(ctx sdk.Context, keeper sdk.AccAddress, deposit ty
 			keeperRewardCoins = append(keeperRewardCoins, keeperCoin)
 		}
 	}
+
+	return k.seizeDepositsWithKeeperReward(ctx, keeper, deposit, borrow, keeperRewardCoins)
+}
+
+// seizeDepositsWithKeeperReward sends the keeper reward coins from the seized deposits to the keeper and sends the
+// rest of the seized deposits to auction
+func (k Keeper) seizeDepositsWithKeeperReward(ctx sdk.Context, keeper sdk.AccAddress, deposit types.Deposit,
+	borrow types.Borrow, keeperRewardCoins sdk.Coins,
+) error {
+	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	if err != nil {
+		return err
+	}
+
 	if !keeperRewardCoins.Empty() {
 		if err := k.DecrementSuppliedCoins(ctx, keeperRewardCoins); err != nil {
 			return err
@@ -384,6 +546,7 @@ func (k Keeper) LoadLiquidationData(ctx sdk.Context, deposit types.Deposit, borr
 	borrowDenoms := getDenoms(borrow.Amount)
 	depositDenoms := getDenoms(deposit.Amount)
 	denoms := removeDuplicates(borrowDenoms, depositDenoms)
+	emodeCategory, _ := k.GetEModeCategory(ctx, deposit.Depositor)
 
 	// Load required liquidation data for every deposit/borrow denom
 	for _, denom := range denoms {
@@ -392,12 +555,12 @@ func (k Keeper) LoadLiquidationData(ctx sdk.Context, deposit types.Deposit, borr
 			return liqMap, errorsmod.Wrapf(types.ErrMarketNotFound, "no market found for denom %s", denom)
 		}
 
-		priceData, err := k.pricefeedKeeper.GetCurrentPrice(ctx, mm.SpotMarketID)
+		price, err := k.GetMoneyMarketPrice(ctx, mm)
 		if err != nil {
 			return liqMap, err
 		}
 
-		liqMap[denom] = LiqData{priceData.Price, mm.BorrowLimit.LoanToValue, mm.ConversionFactor}
+		liqMap[denom] = LiqData{price, mm.LoanToValueForEMode(emodeCategory), mm.ConversionFactor}
 	}
 
 	return liqMap, nil
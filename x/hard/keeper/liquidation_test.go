@@ -763,3 +763,161 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestKeeperPartialLiquidation() {
+	type args struct {
+		closeFactor          sdk.Dec
+		liquidationBonus     sdk.Dec
+		expectedSeizedCoins  sdk.Coins
+		expectedRepaidCoins  sdk.Coins
+		expectedKeeperCoins  sdk.Coins
+		expectWithinLtvRange bool
+	}
+
+	type liqTest struct {
+		name string
+		args args
+	}
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	reserveFactor := sdk.MustNewDecFromStr("0.05")
+	keeperRewardPercent := sdk.MustNewDecFromStr("0.05")
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("testborrower")))
+	keeper := sdk.AccAddress(crypto.AddressHash([]byte("testkeeper")))
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("testdepositor")))
+
+	testCases := []liqTest{
+		{
+			// $200 of kava backs $150 of usdx at an LTV of 0.7, a shortfall of $10. Seizing 19.81% of the kava repays
+			// $37.74 of usdx with a 5% bonus, leaving $112.26 of usdx backed by $160.38 of kava.
+			"valid: seizes enough collateral to restore the LTV",
+			args{
+				closeFactor:          sdk.MustNewDecFromStr("0.5"),
+				liquidationBonus:     sdk.MustNewDecFromStr("0.05"),
+				expectedSeizedCoins:  sdk.NewCoins(sdk.NewInt64Coin("ukava", 19811320)),
+				expectedRepaidCoins:  sdk.NewCoins(sdk.NewInt64Coin("usdx", 37735850)),
+				expectedKeeperCoins:  sdk.NewCoins(sdk.NewInt64Coin("ukava", 943396)),
+				expectWithinLtvRange: true,
+			},
+		},
+		{
+			// The close factor limits the repaid borrow to 10% of $150, leaving the position liquidatable
+			"valid: close factor limits the repaid borrow",
+			args{
+				closeFactor:          sdk.MustNewDecFromStr("0.1"),
+				liquidationBonus:     sdk.MustNewDecFromStr("0.05"),
+				expectedSeizedCoins:  sdk.NewCoins(sdk.NewInt64Coin("ukava", 7875000)),
+				expectedRepaidCoins:  sdk.NewCoins(sdk.NewInt64Coin("usdx", 15000000)),
+				expectedKeeperCoins:  sdk.NewCoins(sdk.NewInt64Coin("ukava", 375000)),
+				expectWithinLtvRange: false,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+			authGS := app.NewFundedGenStateWithCoins(
+				tApp.AppCodec(),
+				[]sdk.Coins{
+					sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF))),
+					sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1000*KAVA_CF))),
+				},
+				[]sdk.AccAddress{borrower, depositor},
+			)
+
+			usdxMarket := types.NewMoneyMarket("usdx",
+				types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.9")),
+				"usdx:usd", sdkmath.NewInt(KAVA_CF), model, reserveFactor, keeperRewardPercent)
+			usdxMarket.CloseFactor = tc.args.closeFactor
+			kavaMarket := types.NewMoneyMarket("ukava",
+				types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")),
+				"kava:usd", sdkmath.NewInt(KAVA_CF), model, reserveFactor, keeperRewardPercent)
+			kavaMarket.LiquidationBonus = tc.args.liquidationBonus
+			hardGS := types.NewGenesisState(types.NewParams(
+				types.MoneyMarkets{usdxMarket, kavaMarket},
+				sdk.NewDec(10),
+			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+			)
+
+			pricefeedGS := pricefeedtypes.GenesisState{
+				Params: pricefeedtypes.Params{
+					Markets: []pricefeedtypes.Market{
+						{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+					},
+				},
+				PostedPrices: []pricefeedtypes.PostedPrice{
+					{
+						MarketID:      "usdx:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("1.00"),
+						Expiry:        time.Now().Add(100 * time.Hour),
+					},
+					{
+						MarketID:      "kava:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("2.00"),
+						Expiry:        time.Now().Add(100 * time.Hour),
+					},
+				},
+			}
+
+			tApp.InitializeFromGenesisStates(authGS,
+				app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+				app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)})
+
+			suite.app = tApp
+			suite.ctx = ctx
+			suite.keeper = tApp.GetHardKeeper()
+			suite.auctionKeeper = tApp.GetAuctionKeeper()
+
+			hard.BeginBlocker(suite.ctx, suite.keeper)
+
+			err := suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1000*KAVA_CF))))
+			suite.Require().NoError(err)
+			depositCoins := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF)))
+			err = suite.keeper.Deposit(suite.ctx, borrower, depositCoins)
+			suite.Require().NoError(err)
+			borrowCoins := sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(150*KAVA_CF)))
+			err = suite.keeper.Borrow(suite.ctx, borrower, borrowCoins)
+			suite.Require().NoError(err)
+
+			// Lower the kava LTV so the position is liquidatable
+			params := suite.keeper.GetParams(suite.ctx)
+			params.MoneyMarkets[1].BorrowLimit.LoanToValue = sdk.MustNewDecFromStr("0.7")
+			suite.keeper.SetParams(suite.ctx, params)
+			hard.BeginBlocker(suite.ctx, suite.keeper)
+
+			err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, keeper, borrower)
+			suite.Require().NoError(err)
+
+			// The rest of the position remains
+			deposit, found := suite.keeper.GetDeposit(suite.ctx, borrower)
+			suite.Require().True(found)
+			suite.Require().Equal(depositCoins.Sub(tc.args.expectedSeizedCoins...), deposit.Amount)
+			borrow, found := suite.keeper.GetBorrow(suite.ctx, borrower)
+			suite.Require().True(found)
+			suite.Require().Equal(borrowCoins.Sub(tc.args.expectedRepaidCoins...), borrow.Amount)
+
+			withinRange, err := suite.keeper.IsWithinValidLtvRange(suite.ctx, deposit, borrow)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.args.expectWithinLtvRange, withinRange)
+
+			// The keeper is paid the liquidation bonus, 5/105 of the seized collateral, instead of the keeper reward
+			accKeeper := suite.getAccountAtCtx(keeper, suite.ctx)
+			suite.Require().Equal(tc.args.expectedKeeperCoins, suite.getAccountCoins(accKeeper))
+
+			// The rest of the seized collateral is auctioned for the repaid borrow
+			auctions := suite.auctionKeeper.GetAllAuctions(suite.ctx)
+			suite.Require().Len(auctions, 1)
+			auction, ok := auctions[0].(*auctiontypes.CollateralAuction)
+			suite.Require().True(ok)
+			suite.Require().Equal(tc.args.expectedRepaidCoins[0], auction.MaxBid)
+			suite.Require().Equal(tc.args.expectedSeizedCoins.Sub(tc.args.expectedKeeperCoins...)[0], auction.Lot)
+		})
+	}
+}
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/kava-labs/kava/x/hard/types"
 )
@@ -144,3 +146,177 @@ func (k msgServer) Liquidate(goCtx context.Context, msg *types.MsgLiquidate) (*t
 	)
 	return &types.MsgLiquidateResponse{}, nil
 }
+
+func (k msgServer) FlashBorrow(goCtx context.Context, msg *types.MsgFlashBorrow) (*types.MsgFlashBorrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	fee, err := k.keeper.FlashBorrow(ctx, borrower, msg.Amount, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Borrower),
+		),
+	)
+	return &types.MsgFlashBorrowResponse{Fee: fee}, nil
+}
+
+func (k msgServer) SetEMode(goCtx context.Context, msg *types.MsgSetEMode) (*types.MsgSetEModeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.keeper.SetEMode(ctx, sender, msg.Category); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgSetEModeResponse{}, nil
+}
+
+func (k msgServer) DepositAndBorrow(goCtx context.Context, msg *types.MsgDepositAndBorrow) (*types.MsgDepositAndBorrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.DepositAndBorrow(ctx, depositor, msg.DepositAmount, msg.BorrowAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor),
+		),
+	)
+	return &types.MsgDepositAndBorrowResponse{}, nil
+}
+
+func (k msgServer) RepayAndWithdraw(goCtx context.Context, msg *types.MsgRepayAndWithdraw) (*types.MsgRepayAndWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.RepayAndWithdraw(ctx, sender, msg.RepayAmount, msg.WithdrawAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgRepayAndWithdrawResponse{}, nil
+}
+
+func (k msgServer) LiquidateDirect(goCtx context.Context, msg *types.MsgLiquidateDirect) (*types.MsgLiquidateDirectResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	liquidator, err := sdk.AccAddressFromBech32(msg.Liquidator)
+	if err != nil {
+		return nil, err
+	}
+
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, err
+	}
+
+	repaidCoins, seizedCoins, err := k.keeper.LiquidateDirect(ctx, liquidator, borrower)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Liquidator),
+		),
+	)
+	return &types.MsgLiquidateDirectResponse{
+		RepaidCoins: repaidCoins,
+		SeizedCoins: seizedCoins,
+	}, nil
+}
+
+func (k msgServer) TransferPosition(goCtx context.Context, msg *types.MsgTransferPosition) (*types.MsgTransferPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.keeper.TransferPosition(ctx, sender, recipient); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgTransferPositionResponse{}, nil
+}
+
+// WithdrawReserves moves accrued reserves out of the hard module. It can only be executed by the module authority.
+func (k msgServer) WithdrawReserves(goCtx context.Context, msg *types.MsgWithdrawReserves) (*types.MsgWithdrawReservesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.keeper.GetAuthority().String() != msg.Authority {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority; expected %s, got %s",
+			k.keeper.GetAuthority(),
+			msg.Authority,
+		)
+	}
+
+	if err := k.keeper.WithdrawReserves(ctx, msg.Amount, msg.RecipientModule); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawReservesResponse{}, nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// AddRatePoint adds a rate point to a denom's rate history, overwriting the oldest rate point once the history holds
+// types.RateHistoryLength rate points
+func (k Keeper) AddRatePoint(ctx sdk.Context, denom string, point types.RatePoint) {
+	count := k.getRateHistoryCount(ctx, denom)
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RateHistoryPrefix)
+	bz := k.cdc.MustMarshal(&point)
+	store.Set(types.RateHistoryKey(denom, count%types.RateHistoryLength), bz)
+
+	k.setRateHistoryCount(ctx, denom, count+1)
+}
+
+// GetRateHistory returns a denom's stored rate points, ordered from oldest to newest
+func (k Keeper) GetRateHistory(ctx sdk.Context, denom string) types.RatePoints {
+	count := k.getRateHistoryCount(ctx, denom)
+
+	// Once the history is full, the oldest rate point is in the slot the next rate point is written to
+	first, length := uint64(0), count
+	if count > types.RateHistoryLength {
+		first, length = count%types.RateHistoryLength, types.RateHistoryLength
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RateHistoryPrefix)
+	points := make(types.RatePoints, 0, length)
+	for i := uint64(0); i < length; i++ {
+		var point types.RatePoint
+		k.cdc.MustUnmarshal(store.Get(types.RateHistoryKey(denom, (first+i)%types.RateHistoryLength)), &point)
+		points = append(points, point)
+	}
+	return points
+}
+
+// getRateHistoryCount returns the number of rate points ever added to a denom's rate history
+func (k Keeper) getRateHistoryCount(ctx sdk.Context, denom string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RateHistoryCountPrefix)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setRateHistoryCount sets the number of rate points ever added to a denom's rate history
+func (k Keeper) setRateHistoryCount(ctx sdk.Context, denom string, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RateHistoryCountPrefix)
+	store.Set([]byte(denom), sdk.Uint64ToBigEndian(count))
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+func (suite *KeeperTestSuite) TestRateHistory() {
+	testCases := []struct {
+		name          string
+		pointsAdded   int
+		expectedFirst int64
+		expectedLast  int64
+	}{
+		{"empty", 0, 0, 0},
+		{"partially filled", 10, 1, 10},
+		{"full", types.RateHistoryLength, 1, types.RateHistoryLength},
+		{"wrapped around", types.RateHistoryLength + 5, 6, types.RateHistoryLength + 5},
+		{"wrapped around twice", 2*types.RateHistoryLength + 1, types.RateHistoryLength + 2, 2*types.RateHistoryLength + 1},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+			tApp.InitializeFromGenesisStates()
+			hardKeeper := tApp.GetHardKeeper()
+
+			// Use the height to identify each rate point
+			for height := int64(1); height <= int64(tc.pointsAdded); height++ {
+				rate := sdk.NewDec(height)
+				point := types.NewRatePoint(height, ctx.BlockTime().Add(time.Duration(height)*time.Second), rate, rate, rate)
+				hardKeeper.AddRatePoint(ctx, "ukava", point)
+			}
+			// Points of other denoms are stored separately, including denoms prefixed by the denom
+			hardKeeper.AddRatePoint(ctx, "ukava2", types.NewRatePoint(0, ctx.BlockTime(), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()))
+
+			history := hardKeeper.GetRateHistory(ctx, "ukava")
+			if tc.pointsAdded == 0 {
+				suite.Require().Empty(history)
+				return
+			}
+
+			suite.Require().Len(history, int(tc.expectedLast-tc.expectedFirst+1))
+			for i, point := range history {
+				height := tc.expectedFirst + int64(i)
+				suite.Require().Equal(height, point.Height)
+				suite.Require().Equal(sdk.NewDec(height), point.BorrowRate)
+			}
+			suite.Require().Len(hardKeeper.GetRateHistory(ctx, "ukava2"), 1)
+		})
+	}
+}
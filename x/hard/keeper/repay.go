@@ -20,8 +20,16 @@ func (k Keeper) Repay(ctx sdk.Context, sender, owner sdk.AccAddress, coins sdk.C
 	// Sync borrow interest so loan is up-to-date
 	k.SyncBorrowInterest(ctx, owner)
 
-	// Refresh borrow after syncing interest
-	borrow, _ = k.GetBorrow(ctx, owner)
+	return k.repay(ctx, sender, owner, coins)
+}
+
+// repay transfers coins from the sender to the module account to repay the owner's borrow. The owner's borrow
+// interest must already be synced.
+func (k Keeper) repay(ctx sdk.Context, sender, owner sdk.AccAddress, coins sdk.Coins) error {
+	borrow, found := k.GetBorrow(ctx, owner)
+	if !found {
+		return types.ErrBorrowNotFound
+	}
 
 	// cap the repayment by what's available to repay (the borrow amount)
 	payment, err := k.CalculatePaymentAmount(borrow.Amount, coins)
@@ -97,12 +105,12 @@ func (k Keeper) ValidateRepay(ctx sdk.Context, sender, owner sdk.AccAddress, coi
 
 			assetPrice, ok := assetPriceCache[coin.Denom]
 			if !ok { // Fetch current asset price and store in local cache
-				assetPriceInfo, err := k.pricefeedKeeper.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
+				price, err := k.GetMoneyMarketPrice(ctx, moneyMarket)
 				if err != nil {
 					return errorsmod.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
 				}
-				assetPriceCache[coin.Denom] = assetPriceInfo.Price
-				assetPrice = assetPriceInfo.Price
+				assetPriceCache[coin.Denom] = price
+				assetPrice = price
 			}
 
 			// Calculate this borrow coin's USD value and add it to the total previous borrowed USD value
@@ -127,12 +135,12 @@ func (k Keeper) ValidateRepay(ctx sdk.Context, sender, owner sdk.AccAddress, coi
 		// Calculate this coin's USD value and add it to the repay's total USD value
 		assetPrice, ok := assetPriceCache[repayCoin.Denom]
 		if !ok { // Fetch current asset price and store in local cache
-			assetPriceInfo, err := k.pricefeedKeeper.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
+			price, err := k.GetMoneyMarketPrice(ctx, moneyMarket)
 			if err != nil {
 				return errorsmod.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
 			}
-			assetPriceCache[repayCoin.Denom] = assetPriceInfo.Price
-			assetPrice = assetPriceInfo.Price
+			assetPriceCache[repayCoin.Denom] = price
+			assetPrice = price
 		}
 		coinUSDValue := sdk.NewDecFromInt(repayCoin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPrice)
 		repayTotalUSDValue = repayTotalUSDValue.Add(coinUSDValue)
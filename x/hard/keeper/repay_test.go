@@ -317,6 +317,23 @@ func (suite *KeeperTestSuite) TestRepay() {
 				mAcc := suite.getModuleAccount(types.ModuleAccountName)
 				suite.Require().Equal(expectedModuleCoins, bankKeeper.GetAllBalances(suite.ctx, mAcc.GetAddress()))
 
+				// Check the repay event records the repayer and the borrower
+				suite.Require().Contains(suite.ctx.EventManager().Events(), sdk.NewEvent(
+					types.EventTypeHardRepay,
+					sdk.NewAttribute(types.AttributeKeySender, tc.args.repayer.String()),
+					sdk.NewAttribute(types.AttributeKeyOwner, tc.args.borrower.String()),
+					sdk.NewAttribute(types.AttributeKeyRepayCoins, repaymentCoins.String()),
+				))
+
+				// Check the borrower's deposit is unchanged by a repayment from another account
+				deposit, foundDeposit := suite.keeper.GetDeposit(suite.ctx, tc.args.borrower)
+				suite.Require().True(foundDeposit)
+				suite.Require().Equal(sdk.NewCoins(tc.args.depositCoins...), deposit.Amount)
+				if !tc.args.repayer.Equals(tc.args.borrower) {
+					_, foundDeposit = suite.keeper.GetDeposit(suite.ctx, tc.args.repayer)
+					suite.Require().False(foundDeposit)
+				}
+
 				// Check user's borrow object
 				borrow, foundBorrow := suite.keeper.GetBorrow(suite.ctx, tc.args.borrower)
 				expectedBorrowCoins := tc.args.borrowCoins.Sub(repaymentCoins...)
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	communitytypes "github.com/kava-labs/kava/x/community/types"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// WithdrawReserves sends accrued reserves of a money market from the hard module account to a module account.
+// The reserves are sent to the community pool when recipientModule is empty.
+func (k Keeper) WithdrawReserves(ctx sdk.Context, amount sdk.Coin, recipientModule string) error {
+	if recipientModule == "" {
+		recipientModule = communitytypes.ModuleAccountName
+	}
+	if recipientModule == types.ModuleAccountName {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "reserves cannot be withdrawn to the %s module", types.ModuleAccountName)
+	}
+	if k.accountKeeper.GetModuleAccount(ctx, recipientModule) == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule)
+	}
+
+	// Accrue interest first so reserves include interest up to the current block
+	if err := k.AccrueInterest(ctx, amount.Denom); err != nil {
+		return err
+	}
+
+	reserves, _ := k.GetTotalReserves(ctx)
+	if reserves.AmountOf(amount.Denom).LT(amount.Amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientReserves, "%s > %s%s", amount, reserves.AmountOf(amount.Denom), amount.Denom)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleAccountName, recipientModule, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+	k.SetTotalReserves(ctx, reserves.Sub(amount))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardWithdrawReserves,
+			sdk.NewAttribute(types.AttributeKeyReserveCoins, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipientModule, recipientModule),
+		),
+	)
+
+	return nil
+}
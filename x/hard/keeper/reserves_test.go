@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/kava-labs/kava/app"
+	communitytypes "github.com/kava-labs/kava/x/community/types"
+	"github.com/kava-labs/kava/x/hard/keeper"
+	"github.com/kava-labs/kava/x/hard/types"
+	kavadisttypes "github.com/kava-labs/kava/x/kavadist/types"
+)
+
+func (suite *KeeperTestSuite) TestWithdrawReserves() {
+	type args struct {
+		reserves         sdk.Coins
+		amount           sdk.Coin
+		recipientModule  string
+		expectedReceiver string
+	}
+	type errArgs struct {
+		expectPass bool
+		contains   string
+	}
+	type withdrawReservesTest struct {
+		name    string
+		args    args
+		errArgs errArgs
+	}
+
+	testCases := []withdrawReservesTest{
+		{
+			"valid: defaults to the community pool",
+			args{
+				reserves:         cs(c("ukava", 10*KAVA_CF), c("usdx", 5*USDX_CF)),
+				amount:           c("ukava", 4*KAVA_CF),
+				recipientModule:  "",
+				expectedReceiver: communitytypes.ModuleAccountName,
+			},
+			errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			"valid: specified module account",
+			args{
+				reserves:         cs(c("ukava", 10*KAVA_CF)),
+				amount:           c("ukava", 10*KAVA_CF),
+				recipientModule:  kavadisttypes.KavaDistMacc,
+				expectedReceiver: kavadisttypes.KavaDistMacc,
+			},
+			errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			"invalid: exceeds reserves",
+			args{
+				reserves:        cs(c("ukava", 10*KAVA_CF)),
+				amount:          c("ukava", 11*KAVA_CF),
+				recipientModule: "",
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "insufficient reserves",
+			},
+		},
+		{
+			"invalid: no reserves of denom",
+			args{
+				reserves:        cs(c("ukava", 10*KAVA_CF)),
+				amount:          c("usdx", 1*USDX_CF),
+				recipientModule: "",
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "insufficient reserves",
+			},
+		},
+		{
+			"invalid: unknown module account",
+			args{
+				reserves:        cs(c("ukava", 10*KAVA_CF)),
+				amount:          c("ukava", 1*KAVA_CF),
+				recipientModule: "unknown",
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "module account unknown does not exist",
+			},
+		},
+		{
+			"invalid: hard module account",
+			args{
+				reserves:        cs(c("ukava", 10*KAVA_CF)),
+				amount:          c("ukava", 1*KAVA_CF),
+				recipientModule: types.ModuleAccountName,
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "reserves cannot be withdrawn to the hard module",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+			tApp.InitializeFromGenesisStates()
+
+			// Reserves are held by the hard module account
+			err := tApp.GetBankKeeper().MintCoins(ctx, types.ModuleAccountName, tc.args.reserves)
+			suite.Require().NoError(err)
+			hardKeeper := tApp.GetHardKeeper()
+			hardKeeper.SetTotalReserves(ctx, tc.args.reserves)
+
+			accountKeeper := tApp.GetAccountKeeper()
+			bankKeeper := tApp.GetBankKeeper()
+			hardAddr := accountKeeper.GetModuleAddress(types.ModuleAccountName)
+
+			err = hardKeeper.WithdrawReserves(ctx, tc.args.amount, tc.args.recipientModule)
+			if tc.errArgs.expectPass {
+				suite.Require().NoError(err)
+
+				reserves, _ := hardKeeper.GetTotalReserves(ctx)
+				suite.Require().Equal(tc.args.reserves.Sub(tc.args.amount), reserves)
+				suite.Require().Equal(tc.args.reserves.Sub(tc.args.amount), bankKeeper.GetAllBalances(ctx, hardAddr))
+
+				receiverAddr := accountKeeper.GetModuleAddress(tc.args.expectedReceiver)
+				suite.Require().Equal(tc.args.amount, bankKeeper.GetBalance(ctx, receiverAddr, tc.args.amount.Denom))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errArgs.contains)
+
+				reserves, _ := hardKeeper.GetTotalReserves(ctx)
+				suite.Require().Equal(tc.args.reserves, reserves)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgWithdrawReserves_Authority() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	tApp.InitializeFromGenesisStates()
+
+	reserves := cs(c("ukava", 10*KAVA_CF))
+	suite.Require().NoError(tApp.GetBankKeeper().MintCoins(ctx, types.ModuleAccountName, reserves))
+	hardKeeper := tApp.GetHardKeeper()
+	hardKeeper.SetTotalReserves(ctx, reserves)
+
+	msgServer := keeper.NewMsgServerImpl(hardKeeper)
+
+	invalidAuthority := sdk.AccAddress("invalid authority").String()
+	msg := types.NewMsgWithdrawReserves(invalidAuthority, c("ukava", KAVA_CF), "")
+	_, err := msgServer.WithdrawReserves(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	govAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	msg = types.NewMsgWithdrawReserves(govAuthority, c("ukava", KAVA_CF), "")
+	_, err = msgServer.WithdrawReserves(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().NoError(err)
+
+	remaining, _ := hardKeeper.GetTotalReserves(ctx)
+	suite.Require().Equal(cs(c("ukava", 9*KAVA_CF)), remaining)
+}
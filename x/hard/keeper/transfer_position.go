@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// TransferPosition moves all of the sender's deposits and borrows to the recipient, adding them to any existing
+// position of the recipient. The recipient's combined position must be within the valid LTV range.
+func (k Keeper) TransferPosition(ctx sdk.Context, sender, recipient sdk.AccAddress) error {
+	if sender.Equals(recipient) {
+		return errorsmod.Wrapf(types.ErrInvalidPositionTransfer, "cannot transfer a position to its owner")
+	}
+
+	senderDeposit, hasSenderDeposit := k.GetDeposit(ctx, sender)
+	senderBorrow, hasSenderBorrow := k.GetBorrow(ctx, sender)
+	if !hasSenderDeposit && !hasSenderBorrow {
+		return errorsmod.Wrapf(types.ErrInvalidPositionTransfer, "no deposit or borrow found for %s", sender)
+	}
+
+	// Call incentive hooks
+	if hasSenderDeposit {
+		k.BeforeDepositModified(ctx, senderDeposit)
+	}
+	if hasSenderBorrow {
+		k.BeforeBorrowModified(ctx, senderBorrow)
+	}
+	recipientDeposit, hasRecipientDeposit := k.GetDeposit(ctx, recipient)
+	if hasRecipientDeposit {
+		k.BeforeDepositModified(ctx, recipientDeposit)
+	}
+	recipientBorrow, hasRecipientBorrow := k.GetBorrow(ctx, recipient)
+	if hasRecipientBorrow {
+		k.BeforeBorrowModified(ctx, recipientBorrow)
+	}
+
+	// Sync interest so both positions are indexed at the current interest factors
+	k.SyncSupplyInterest(ctx, sender)
+	k.SyncBorrowInterest(ctx, sender)
+	k.SyncSupplyInterest(ctx, recipient)
+	k.SyncBorrowInterest(ctx, recipient)
+
+	depositCoins, borrowCoins := sdk.NewCoins(), sdk.NewCoins()
+	if deposit, found := k.GetDeposit(ctx, sender); found {
+		depositCoins = deposit.Amount
+	}
+	if borrow, found := k.GetBorrow(ctx, sender); found {
+		borrowCoins = borrow.Amount
+	}
+
+	proposedDeposit, proposedBorrow := k.mergeIntoPosition(ctx, recipient, depositCoins, borrowCoins)
+
+	// The recipient's e-mode category and isolated collateral restrict what the combined position can borrow
+	if emodeCategory, inEMode := k.GetEModeCategory(ctx, recipient); inEMode {
+		if err := k.validateEModeBorrow(ctx, emodeCategory, proposedBorrow.Amount); err != nil {
+			return err
+		}
+	}
+	if err := k.validateIsolatedBorrow(ctx, proposedDeposit.Amount, proposedBorrow.Amount); err != nil {
+		return err
+	}
+
+	valid, err := k.IsWithinValidLtvRange(ctx, proposedDeposit, proposedBorrow)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return errorsmod.Wrapf(types.ErrInsufficientLoanToValue, "transferred position would exceed the allowable amount for %s", recipient)
+	}
+
+	// Remove the sender's position. The total supplied and borrowed coins don't change.
+	if hasSenderDeposit {
+		emptyDeposit := types.NewDeposit(sender, sdk.NewCoins(), types.SupplyInterestFactors{})
+		k.DeleteDeposit(ctx, emptyDeposit)
+		k.AfterDepositModified(ctx, emptyDeposit)
+	}
+	if hasSenderBorrow {
+		emptyBorrow := types.NewBorrow(sender, sdk.NewCoins(), types.BorrowInterestFactors{})
+		k.DeleteBorrow(ctx, emptyBorrow)
+		k.AfterBorrowModified(ctx, emptyBorrow)
+	}
+
+	if !proposedDeposit.Amount.Empty() {
+		k.SetDeposit(ctx, proposedDeposit)
+		if hasRecipientDeposit {
+			k.AfterDepositModified(ctx, proposedDeposit)
+		} else {
+			k.AfterDepositCreated(ctx, proposedDeposit)
+		}
+	}
+	if !proposedBorrow.Amount.Empty() {
+		k.SetBorrow(ctx, proposedBorrow)
+		if hasRecipientBorrow {
+			k.AfterBorrowModified(ctx, proposedBorrow)
+		} else {
+			k.AfterBorrowCreated(ctx, proposedBorrow)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardTransferPosition,
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositCoins, depositCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyBorrowCoins, borrowCoins.String()),
+		),
+	)
+	return nil
+}
+
+// mergeIntoPosition returns an account's deposit and borrow with the coins added, indexed at the current interest
+// factors. The account's supply and borrow interest must already be synced.
+func (k Keeper) mergeIntoPosition(ctx sdk.Context, addr sdk.AccAddress, depositCoins, borrowCoins sdk.Coins) (types.Deposit, types.Borrow) {
+	deposit, found := k.GetDeposit(ctx, addr)
+	if !found {
+		deposit = types.NewDeposit(addr, sdk.NewCoins(), types.SupplyInterestFactors{})
+	}
+	deposit.Amount = deposit.Amount.Add(depositCoins...)
+	for _, coin := range depositCoins {
+		if factor, found := k.GetSupplyInterestFactor(ctx, coin.Denom); found {
+			deposit.Index = deposit.Index.SetInterestFactor(coin.Denom, factor)
+		}
+	}
+
+	borrow, found := k.GetBorrow(ctx, addr)
+	if !found {
+		borrow = types.NewBorrow(addr, sdk.NewCoins(), types.BorrowInterestFactors{})
+	}
+	borrow.Amount = borrow.Amount.Add(borrowCoins...)
+	for _, coin := range borrowCoins {
+		if factor, found := k.GetBorrowInterestFactor(ctx, coin.Denom); found {
+			borrow.Index = borrow.Index.SetInterestFactor(coin.Denom, factor)
+		}
+	}
+
+	return deposit, borrow
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"github.com/cometbft/cometbft/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+func (suite *KeeperTestSuite) TestTransferPosition() {
+	sender := sdk.AccAddress(crypto.AddressHash([]byte("sender")))
+	recipient := sdk.AccAddress(crypto.AddressHash([]byte("recipient")))
+
+	type args struct {
+		senderDeposit    sdk.Coins
+		senderBorrow     sdk.Coins
+		recipientDeposit sdk.Coins
+		recipientBorrow  sdk.Coins
+		expectedDeposit  sdk.Coins
+		expectedBorrow   sdk.Coins
+	}
+	type errArgs struct {
+		expectPass bool
+		contains   string
+	}
+	type transferPositionTest struct {
+		name    string
+		args    args
+		errArgs errArgs
+	}
+
+	testCases := []transferPositionTest{
+		{
+			"valid: recipient without a position",
+			args{
+				senderDeposit:   cs(c("ukava", 80*KAVA_CF)),
+				senderBorrow:    cs(c("usdx", 100*USDX_CF)),
+				expectedDeposit: cs(c("ukava", 80*KAVA_CF)),
+				expectedBorrow:  cs(c("usdx", 100*USDX_CF)),
+			},
+			errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			"valid: deposit only",
+			args{
+				senderDeposit:   cs(c("ukava", 80*KAVA_CF)),
+				expectedDeposit: cs(c("ukava", 80*KAVA_CF)),
+				expectedBorrow:  sdk.NewCoins(),
+			},
+			errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			"valid: merged into recipient position",
+			args{
+				senderDeposit:    cs(c("ukava", 50*KAVA_CF)),
+				senderBorrow:     cs(c("usdx", 80*USDX_CF)),
+				recipientDeposit: cs(c("ukava", 20*KAVA_CF)),
+				recipientBorrow:  cs(c("usdx", 30*USDX_CF)),
+				expectedDeposit:  cs(c("ukava", 70*KAVA_CF)),
+				expectedBorrow:   cs(c("usdx", 110*USDX_CF)),
+			},
+			errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			"invalid: no position",
+			args{},
+			errArgs{
+				expectPass: false,
+				contains:   "no deposit or borrow found",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.setupCompositeTest(sender)
+
+			// The sender funds the recipient's position
+			if !tc.args.recipientDeposit.Empty() {
+				err := suite.app.GetBankKeeper().SendCoins(suite.ctx, sender, recipient, tc.args.recipientDeposit)
+				suite.Require().NoError(err)
+				err = suite.keeper.DepositAndBorrow(suite.ctx, recipient, tc.args.recipientDeposit, tc.args.recipientBorrow)
+				suite.Require().NoError(err)
+			}
+			if !tc.args.senderDeposit.Empty() {
+				err := suite.keeper.Deposit(suite.ctx, sender, tc.args.senderDeposit)
+				suite.Require().NoError(err)
+			}
+			if !tc.args.senderBorrow.Empty() {
+				err := suite.keeper.Borrow(suite.ctx, sender, tc.args.senderBorrow)
+				suite.Require().NoError(err)
+			}
+			suppliedBefore, _ := suite.keeper.GetSuppliedCoins(suite.ctx)
+			borrowedBefore, _ := suite.keeper.GetBorrowedCoins(suite.ctx)
+
+			err := suite.keeper.TransferPosition(suite.ctx, sender, recipient)
+			if tc.errArgs.expectPass {
+				suite.Require().NoError(err)
+
+				_, found := suite.keeper.GetDeposit(suite.ctx, sender)
+				suite.Require().False(found)
+				_, found = suite.keeper.GetBorrow(suite.ctx, sender)
+				suite.Require().False(found)
+
+				deposit, found := suite.keeper.GetDeposit(suite.ctx, recipient)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.args.expectedDeposit, deposit.Amount)
+				borrow, found := suite.keeper.GetBorrow(suite.ctx, recipient)
+				suite.Require().Equal(!tc.args.expectedBorrow.Empty(), found)
+				if found {
+					suite.Require().Equal(tc.args.expectedBorrow, borrow.Amount)
+				}
+
+				suppliedAfter, _ := suite.keeper.GetSuppliedCoins(suite.ctx)
+				suite.Require().Equal(suppliedBefore, suppliedAfter)
+				borrowedAfter, _ := suite.keeper.GetBorrowedCoins(suite.ctx)
+				suite.Require().Equal(borrowedBefore, borrowedAfter)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errArgs.contains)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTransferPositionEMode() {
+	sender := sdk.AccAddress(crypto.AddressHash([]byte("sender")))
+	recipient := sdk.AccAddress(crypto.AddressHash([]byte("recipient")))
+
+	suite.Run("invalid: recipient outside of sender's e-mode category exceeds loan to value", func() {
+		suite.setupEModeTest(sender)
+		suite.Require().NoError(suite.keeper.SetEMode(suite.ctx, sender, "usd"))
+		suite.Require().NoError(suite.keeper.DepositAndBorrow(suite.ctx, sender, cs(c("busd", 100*USDX_CF)), cs(c("usdx", 90*USDX_CF))))
+
+		err := suite.keeper.TransferPosition(suite.ctx, sender, recipient)
+		suite.Require().ErrorIs(err, types.ErrInsufficientLoanToValue)
+	})
+
+	suite.Run("valid: recipient in sender's e-mode category", func() {
+		suite.setupEModeTest(sender)
+		suite.Require().NoError(suite.keeper.SetEMode(suite.ctx, sender, "usd"))
+		suite.Require().NoError(suite.keeper.SetEMode(suite.ctx, recipient, "usd"))
+		suite.Require().NoError(suite.keeper.DepositAndBorrow(suite.ctx, sender, cs(c("busd", 100*USDX_CF)), cs(c("usdx", 90*USDX_CF))))
+
+		err := suite.keeper.TransferPosition(suite.ctx, sender, recipient)
+		suite.Require().NoError(err)
+	})
+
+	suite.Run("invalid: borrow outside of recipient's e-mode category", func() {
+		suite.setupEModeTest(sender)
+		suite.Require().NoError(suite.keeper.SetEMode(suite.ctx, recipient, "usd"))
+		suite.Require().NoError(suite.keeper.DepositAndBorrow(suite.ctx, sender, cs(c("busd", 100*USDX_CF)), cs(c("ukava", 10*KAVA_CF))))
+
+		err := suite.keeper.TransferPosition(suite.ctx, sender, recipient)
+		suite.Require().ErrorIs(err, types.ErrInvalidEModeBorrow)
+	})
+}
@@ -25,8 +25,16 @@ func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Co
 	k.SyncBorrowInterest(ctx, depositor)
 	k.SyncSupplyInterest(ctx, depositor)
 
-	// Refresh Deposit after syncing interest
-	deposit, _ := k.GetDeposit(ctx, depositor)
+	return k.withdraw(ctx, depositor, coins)
+}
+
+// withdraw validates the withdrawal and transfers coins from the module account to the depositor, updating their
+// deposit. The depositor's supply and borrow interest must already be synced.
+func (k Keeper) withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error {
+	deposit, found := k.GetDeposit(ctx, depositor)
+	if !found {
+		return errorsmod.Wrapf(types.ErrDepositNotFound, "no deposit found for %s", depositor)
+	}
 
 	amount, err := k.CalculateWithdrawAmount(deposit.Amount, coins)
 	if err != nil {
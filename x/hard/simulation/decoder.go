@@ -0,0 +1,79 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding hard type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.DepositsKeyPrefix):
+			var depositA, depositB types.Deposit
+			cdc.MustUnmarshal(kvA.Value, &depositA)
+			cdc.MustUnmarshal(kvB.Value, &depositB)
+			return fmt.Sprintf("%v\n%v", depositA, depositB)
+
+		case bytes.Equal(kvA.Key[:1], types.BorrowsKeyPrefix):
+			var borrowA, borrowB types.Borrow
+			cdc.MustUnmarshal(kvA.Value, &borrowA)
+			cdc.MustUnmarshal(kvB.Value, &borrowB)
+			return fmt.Sprintf("%v\n%v", borrowA, borrowB)
+
+		case bytes.Equal(kvA.Key[:1], types.BorrowedCoinsPrefix),
+			bytes.Equal(kvA.Key[:1], types.SuppliedCoinsPrefix),
+			bytes.Equal(kvA.Key[:1], types.TotalReservesPrefix):
+			var coinsA, coinsB types.CoinsProto
+			cdc.MustUnmarshal(kvA.Value, &coinsA)
+			cdc.MustUnmarshal(kvB.Value, &coinsB)
+			return fmt.Sprintf("%s\n%s", coinsA.Coins, coinsB.Coins)
+
+		case bytes.Equal(kvA.Key[:1], types.MoneyMarketsPrefix):
+			var moneyMarketA, moneyMarketB types.MoneyMarket
+			cdc.MustUnmarshal(kvA.Value, &moneyMarketA)
+			cdc.MustUnmarshal(kvB.Value, &moneyMarketB)
+			return fmt.Sprintf("%v\n%v", moneyMarketA, moneyMarketB)
+
+		case bytes.Equal(kvA.Key[:1], types.PreviousAccrualTimePrefix):
+			var timeA, timeB time.Time
+			if err := timeA.UnmarshalBinary(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := timeB.UnmarshalBinary(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%s\n%s", timeA, timeB)
+
+		case bytes.Equal(kvA.Key[:1], types.BorrowInterestFactorPrefix),
+			bytes.Equal(kvA.Key[:1], types.SupplyInterestFactorPrefix):
+			var factorA, factorB sdk.DecProto
+			cdc.MustUnmarshal(kvA.Value, &factorA)
+			cdc.MustUnmarshal(kvB.Value, &factorB)
+			return fmt.Sprintf("%s\n%s", factorA.Dec, factorB.Dec)
+
+		case bytes.Equal(kvA.Key[:1], types.EModeCategoryPrefix):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.RateHistoryPrefix):
+			var pointA, pointB types.RatePoint
+			cdc.MustUnmarshal(kvA.Value, &pointA)
+			cdc.MustUnmarshal(kvB.Value, &pointB)
+			return fmt.Sprintf("%v\n%v", pointA, pointB)
+
+		case bytes.Equal(kvA.Key[:1], types.RateHistoryCountPrefix):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		default:
+			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
+		}
+	}
+}
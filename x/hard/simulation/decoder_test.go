@@ -0,0 +1,77 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard/simulation"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	addr := sdk.AccAddress("test_address")
+	factor := sdk.MustNewDecFromStr("1.05")
+	deposit := types.NewDeposit(addr, sdk.NewCoins(sdk.NewInt64Coin("ukava", 10)), types.SupplyInterestFactors{types.NewSupplyInterestFactor("ukava", factor)})
+	borrow := types.NewBorrow(addr, sdk.NewCoins(sdk.NewInt64Coin("usdx", 10)), types.BorrowInterestFactors{types.NewBorrowInterestFactor("usdx", factor)})
+	coins := sdk.NewCoins(sdk.NewInt64Coin("usdx", 100))
+	accrualTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+	accrualTimeBz, err := accrualTime.MarshalBinary()
+	require.NoError(t, err)
+	point := types.NewRatePoint(10, accrualTime, sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.04"))
+
+	mm := types.NewMoneyMarket("usdx", types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")), "usdx:usd", sdk.NewInt(1e6),
+		types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+		sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: append(types.DepositsKeyPrefix, addr...), Value: cdc.MustMarshal(&deposit)},
+			{Key: append(types.BorrowsKeyPrefix, addr...), Value: cdc.MustMarshal(&borrow)},
+			{Key: types.SuppliedCoinsPrefix, Value: cdc.MustMarshal(&types.CoinsProto{Coins: coins})},
+			{Key: append(types.MoneyMarketsPrefix, []byte("usdx")...), Value: cdc.MustMarshal(&mm)},
+			{Key: append(types.PreviousAccrualTimePrefix, []byte("usdx")...), Value: accrualTimeBz},
+			{Key: append(types.BorrowInterestFactorPrefix, []byte("usdx")...), Value: cdc.MustMarshal(&sdk.DecProto{Dec: factor})},
+			{Key: append(types.EModeCategoryPrefix, addr...), Value: []byte("usd")},
+			{Key: append(types.RateHistoryPrefix, types.RateHistoryKey("usdx", 0)...), Value: cdc.MustMarshal(&point)},
+			{Key: append(types.RateHistoryCountPrefix, []byte("usdx")...), Value: sdk.Uint64ToBigEndian(3)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Deposit", fmt.Sprintf("%v\n%v", deposit, deposit)},
+		{"Borrow", fmt.Sprintf("%v\n%v", borrow, borrow)},
+		{"SuppliedCoins", fmt.Sprintf("%s\n%s", coins, coins)},
+		{"MoneyMarket", fmt.Sprintf("%v\n%v", mm, mm)},
+		{"PreviousAccrualTime", fmt.Sprintf("%s\n%s", accrualTime, accrualTime)},
+		{"BorrowInterestFactor", fmt.Sprintf("%s\n%s", factor, factor)},
+		{"EModeCategory", "usd\nusd"},
+		{"RatePoint", fmt.Sprintf("%v\n%v", point, point)},
+		{"RateHistoryCount", "3\n3"},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
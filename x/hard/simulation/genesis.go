@@ -0,0 +1,64 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// RandomizedGenState generates a random GenesisState for hard.
+//
+// A money market is created for the bond denom, as it is the only denom the
+// simulated accounts hold. Its spot market is expected to be "<denom>:usd";
+// borrows, withdrawals and liquidations are only simulated once it has a price.
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.NewParams(
+		types.MoneyMarkets{RandomMoneyMarket(simState.Rand, simState.BondDenom, simState.BondDenom+":usd")},
+		sdk.NewDec(int64(simtypes.RandIntBetween(simState.Rand, 1, 20))),
+	)
+
+	hardGenesis := types.DefaultGenesisState()
+	hardGenesis.Params = params
+
+	bz, err := json.MarshalIndent(hardGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, bz)
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&hardGenesis)
+}
+
+// RandomMoneyMarket returns a money market for a denom with a random loan to value, interest rate model and reserve
+// factor. It has no borrow limit and a conversion factor of 10^6.
+func RandomMoneyMarket(r *rand.Rand, denom, spotMarketID string) types.MoneyMarket {
+	kink := randomDecBetween(r, 50, 95)
+	model := types.NewInterestRateModel(
+		randomDecBetween(r, 0, 10),
+		randomDecBetween(r, 1, 100),
+		kink,
+		sdk.NewDec(int64(simtypes.RandIntBetween(r, 1, 20))),
+	)
+
+	return types.NewMoneyMarket(
+		denom,
+		types.NewBorrowLimit(false, sdk.ZeroDec(), randomDecBetween(r, 10, 90)),
+		spotMarketID,
+		sdkmath.NewInt(1e6),
+		model,
+		randomDecBetween(r, 0, 25),
+		randomDecBetween(r, 0, 10),
+	)
+}
+
+// randomDecBetween returns a random percentage in [min, max) as a decimal.
+func randomDecBetween(r *rand.Rand, min, max int) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, min, max)), 2)
+}
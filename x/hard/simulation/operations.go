@@ -0,0 +1,307 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/kava-labs/kava/x/hard/keeper"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgDeposit   = "op_weight_msg_deposit"   //nolint:gosec
+	OpWeightMsgWithdraw  = "op_weight_msg_withdraw"  //nolint:gosec
+	OpWeightMsgBorrow    = "op_weight_msg_borrow"    //nolint:gosec
+	OpWeightMsgRepay     = "op_weight_msg_repay"     //nolint:gosec
+	OpWeightMsgLiquidate = "op_weight_msg_liquidate" //nolint:gosec
+
+	DefaultWeightMsgDeposit   = 100
+	DefaultWeightMsgWithdraw  = 50
+	DefaultWeightMsgBorrow    = 80
+	DefaultWeightMsgRepay     = 50
+	DefaultWeightMsgLiquidate = 20
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, k keeper.Keeper, bk types.BankKeeper, ak types.AccountKeeper,
+) simulation.WeightedOperations {
+	var weightMsgDeposit, weightMsgWithdraw, weightMsgBorrow, weightMsgRepay, weightMsgLiquidate int
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgDeposit, &weightMsgDeposit, nil,
+		func(_ *rand.Rand) {
+			weightMsgDeposit = DefaultWeightMsgDeposit
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgWithdraw, &weightMsgWithdraw, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdraw = DefaultWeightMsgWithdraw
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgBorrow, &weightMsgBorrow, nil,
+		func(_ *rand.Rand) {
+			weightMsgBorrow = DefaultWeightMsgBorrow
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgRepay, &weightMsgRepay, nil,
+		func(_ *rand.Rand) {
+			weightMsgRepay = DefaultWeightMsgRepay
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgLiquidate, &weightMsgLiquidate, nil,
+		func(_ *rand.Rand) {
+			weightMsgLiquidate = DefaultWeightMsgLiquidate
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgDeposit, SimulateMsgDeposit(k, bk)),
+		simulation.NewWeightedOperation(weightMsgWithdraw, SimulateMsgWithdraw(k, bk, ak)),
+		simulation.NewWeightedOperation(weightMsgBorrow, SimulateMsgBorrow(k, bk, ak)),
+		simulation.NewWeightedOperation(weightMsgRepay, SimulateMsgRepay(k, bk)),
+		simulation.NewWeightedOperation(weightMsgLiquidate, SimulateMsgLiquidate(k)),
+	}
+}
+
+// SimulateMsgDeposit deposits a random amount of a random account's balance of a random money market denom.
+func SimulateMsgDeposit(k keeper.Keeper, bk types.BankKeeper) simtypes.Operation {
+	msgType := sdk.MsgTypeURL(&types.MsgDeposit{})
+
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		acc, _ := simtypes.RandomAcc(r, accs)
+
+		denom, found := randomMoneyMarketDenom(r, k.GetParams(ctx))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no money markets"), nil, nil
+		}
+		amount := randomAmount(r, bk.SpendableCoins(ctx, acc.Address).AmountOf(denom))
+		if amount.IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no balance"), nil, nil
+		}
+
+		msg := types.NewMsgDeposit(acc.Address, sdk.NewCoins(sdk.NewCoin(denom, amount)))
+		if err := deliver(ctx, func(ctx sdk.Context) error {
+			_, err := keeper.NewMsgServerImpl(k).Deposit(sdk.WrapSDKContext(ctx), &msg)
+			return err
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deposit"), nil, err
+		}
+
+		return simtypes.NewOperationMsgBasic(types.ModuleName, msgType, "", true, nil), nil, nil
+	}
+}
+
+// SimulateMsgWithdraw withdraws a random amount of a random deposited coin of a random account, if the account's
+// borrow stays within its loan to value after the withdrawal.
+func SimulateMsgWithdraw(k keeper.Keeper, bk types.BankKeeper, ak types.AccountKeeper) simtypes.Operation {
+	msgType := sdk.MsgTypeURL(&types.MsgWithdraw{})
+
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		acc, _ := simtypes.RandomAcc(r, accs)
+
+		deposit, found := k.GetSyncedDeposit(ctx, acc.Address)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no deposit"), nil, nil
+		}
+		coin := deposit.Amount[r.Intn(len(deposit.Amount))]
+
+		cash := bk.GetBalance(ctx, ak.GetModuleAddress(types.ModuleAccountName), coin.Denom).Amount
+		amount := randomAmount(r, sdkmath.MinInt(coin.Amount, cash))
+		if amount.IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no funds available to withdraw"), nil, nil
+		}
+		withdrawCoins := sdk.NewCoins(sdk.NewCoin(coin.Denom, amount))
+
+		if _, found := k.GetBorrow(ctx, acc.Address); found {
+			health, err := k.SimulateAccountHealth(ctx, acc.Address, nil, withdrawCoins, nil, nil)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to value position"), nil, nil
+			}
+			if health.BorrowUSDValue.GT(health.BorrowLimitUSDValue) {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "withdraw would exceed loan to value"), nil, nil
+			}
+		}
+
+		msg := types.NewMsgWithdraw(acc.Address, withdrawCoins)
+		if err := deliver(ctx, func(ctx sdk.Context) error {
+			_, err := keeper.NewMsgServerImpl(k).Withdraw(sdk.WrapSDKContext(ctx), &msg)
+			return err
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to withdraw"), nil, err
+		}
+
+		return simtypes.NewOperationMsgBasic(types.ModuleName, msgType, "", true, nil), nil, nil
+	}
+}
+
+// SimulateMsgBorrow borrows a random amount of a random money market denom against a random account's deposit.
+// The amount is halved until the borrow is within the account's loan to value.
+func SimulateMsgBorrow(k keeper.Keeper, bk types.BankKeeper, ak types.AccountKeeper) simtypes.Operation {
+	msgType := sdk.MsgTypeURL(&types.MsgBorrow{})
+
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		acc, _ := simtypes.RandomAcc(r, accs)
+
+		if _, found := k.GetDeposit(ctx, acc.Address); !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no deposit"), nil, nil
+		}
+		denom, found := randomMoneyMarketDenom(r, k.GetParams(ctx))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no money markets"), nil, nil
+		}
+
+		// Reserves are not available to borrow
+		cash := bk.GetBalance(ctx, ak.GetModuleAddress(types.ModuleAccountName), denom).Amount
+		reserves, _ := k.GetTotalReserves(ctx)
+		available := cash.Sub(reserves.AmountOf(denom))
+		if !available.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no funds available to borrow"), nil, nil
+		}
+
+		for amount := randomAmount(r, available); amount.IsPositive(); amount = amount.QuoRaw(2) {
+			borrowCoins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+			health, err := k.SimulateAccountHealth(ctx, acc.Address, nil, nil, borrowCoins, nil)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to value position"), nil, nil
+			}
+			if health.BorrowUSDValue.LT(k.GetMinimumBorrowUSDValue(ctx)) {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "borrow below minimum borrow value"), nil, nil
+			}
+			if health.BorrowUSDValue.GT(health.BorrowLimitUSDValue) {
+				continue
+			}
+
+			msg := types.NewMsgBorrow(acc.Address, borrowCoins)
+			if err := deliver(ctx, func(ctx sdk.Context) error {
+				_, err := keeper.NewMsgServerImpl(k).Borrow(sdk.WrapSDKContext(ctx), &msg)
+				return err
+			}); err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to borrow"), nil, err
+			}
+			return simtypes.NewOperationMsgBasic(types.ModuleName, msgType, "", true, nil), nil, nil
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "borrow would exceed loan to value"), nil, nil
+	}
+}
+
+// SimulateMsgRepay repays a random amount of a random borrowed coin of a random account, paid by another random
+// account.
+func SimulateMsgRepay(k keeper.Keeper, bk types.BankKeeper) simtypes.Operation {
+	msgType := sdk.MsgTypeURL(&types.MsgRepay{})
+
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, _ := simtypes.RandomAcc(r, accs)
+		sender, _ := simtypes.RandomAcc(r, accs)
+
+		borrow, found := k.GetSyncedBorrow(ctx, owner.Address)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no borrow"), nil, nil
+		}
+		coin := borrow.Amount[r.Intn(len(borrow.Amount))]
+
+		amount := randomAmount(r, sdkmath.MinInt(coin.Amount, bk.SpendableCoins(ctx, sender.Address).AmountOf(coin.Denom)))
+		if amount.IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no balance"), nil, nil
+		}
+		repayCoins := sdk.NewCoins(sdk.NewCoin(coin.Denom, amount))
+
+		health, err := k.SimulateAccountHealth(ctx, owner.Address, nil, nil, nil, repayCoins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to value position"), nil, nil
+		}
+		if health.BorrowUSDValue.IsPositive() && health.BorrowUSDValue.LT(k.GetMinimumBorrowUSDValue(ctx)) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "remaining borrow below minimum borrow value"), nil, nil
+		}
+
+		msg := types.NewMsgRepay(sender.Address, owner.Address, repayCoins)
+		if err := deliver(ctx, func(ctx sdk.Context) error {
+			_, err := keeper.NewMsgServerImpl(k).Repay(sdk.WrapSDKContext(ctx), &msg)
+			return err
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to repay"), nil, err
+		}
+
+		return simtypes.NewOperationMsgBasic(types.ModuleName, msgType, "", true, nil), nil, nil
+	}
+}
+
+// SimulateMsgLiquidate liquidates a random borrow that exceeds its loan to value, with a random account as the keeper.
+func SimulateMsgLiquidate(k keeper.Keeper) simtypes.Operation {
+	msgType := sdk.MsgTypeURL(&types.MsgLiquidate{})
+
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		liquidator, _ := simtypes.RandomAcc(r, accs)
+
+		var borrowers []sdk.AccAddress
+		k.IterateBorrows(ctx, func(borrow types.Borrow) bool {
+			borrowers = append(borrowers, borrow.Borrower)
+			return false
+		})
+		if len(borrowers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no borrows"), nil, nil
+		}
+		borrower := borrowers[r.Intn(len(borrowers))]
+
+		health, err := k.GetAccountHealth(ctx, borrower)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to value position"), nil, nil
+		}
+		if health.BorrowUSDValue.LTE(health.BorrowLimitUSDValue) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "borrow within loan to value"), nil, nil
+		}
+
+		msg := types.NewMsgLiquidate(liquidator.Address, borrower)
+		if err := deliver(ctx, func(ctx sdk.Context) error {
+			_, err := keeper.NewMsgServerImpl(k).Liquidate(sdk.WrapSDKContext(ctx), &msg)
+			return err
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to liquidate"), nil, err
+		}
+
+		return simtypes.NewOperationMsgBasic(types.ModuleName, msgType, "", true, nil), nil, nil
+	}
+}
+
+// deliver runs a msg handler in a cached context, writing its state changes only if it succeeds, as a tx would.
+func deliver(ctx sdk.Context, handle func(ctx sdk.Context) error) error {
+	cacheCtx, write := ctx.CacheContext()
+	if err := handle(cacheCtx); err != nil {
+		return err
+	}
+	write()
+	return nil
+}
+
+// randomMoneyMarketDenom returns the denom of a random money market.
+func randomMoneyMarketDenom(r *rand.Rand, params types.Params) (string, bool) {
+	if len(params.MoneyMarkets) == 0 {
+		return "", false
+	}
+	return params.MoneyMarkets[r.Intn(len(params.MoneyMarkets))].Denom, true
+}
+
+// randomAmount returns a random amount in [0, max], or zero if max is not positive.
+func randomAmount(r *rand.Rand, max sdkmath.Int) sdkmath.Int {
+	if !max.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	return simtypes.RandomAmount(r, max)
+}
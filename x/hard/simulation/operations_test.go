@@ -0,0 +1,133 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/hard/keeper"
+	"github.com/kava-labs/kava/x/hard/simulation"
+	"github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+type operationsTestSuite struct {
+	suite.Suite
+
+	tApp app.TestApp
+	ctx  sdk.Context
+	accs []simtypes.Account
+}
+
+func TestOperationsTestSuite(t *testing.T) {
+	suite.Run(t, new(operationsTestSuite))
+}
+
+func (suite *operationsTestSuite) SetupTest() {
+	r := rand.New(rand.NewSource(1))
+	suite.accs = simtypes.RandomAccounts(r, 5)
+
+	addrs := make([]sdk.AccAddress, len(suite.accs))
+	for i, acc := range suite.accs {
+		addrs[i] = acc.Address
+	}
+
+	suite.tApp = app.NewTestApp()
+	genesisTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.tApp.NewContext(true, tmproto.Header{Height: 1, Time: genesisTime})
+
+	moneyMarket := simulation.RandomMoneyMarket(r, "ukava", "kava:usd")
+	moneyMarket.BorrowLimit.LoanToValue = sdk.MustNewDecFromStr("0.8")
+	hardGS := types.DefaultGenesisState()
+	hardGS.Params = types.NewParams(types.MoneyMarkets{moneyMarket}, sdk.NewDec(10))
+
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{
+				MarketID:      "kava:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("2.00"),
+				Expiry:        genesisTime.Add(365 * 24 * time.Hour),
+			},
+		},
+	}
+
+	suite.tApp.InitializeFromGenesisStatesWithTime(genesisTime,
+		app.NewFundedGenStateWithSameCoins(suite.tApp.AppCodec(), sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e12)), addrs),
+		app.GenesisState{pricefeedtypes.ModuleName: suite.tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: suite.tApp.AppCodec().MustMarshalJSON(&hardGS)},
+	)
+}
+
+func (suite *operationsTestSuite) TestWeightedOperations() {
+	ops := simulation.WeightedOperations(
+		make(simtypes.AppParams), suite.tApp.AppCodec(), suite.tApp.GetHardKeeper(), suite.tApp.GetBankKeeper(), suite.tApp.GetAccountKeeper(),
+	)
+
+	expected := []int{
+		simulation.DefaultWeightMsgDeposit,
+		simulation.DefaultWeightMsgWithdraw,
+		simulation.DefaultWeightMsgBorrow,
+		simulation.DefaultWeightMsgRepay,
+		simulation.DefaultWeightMsgLiquidate,
+	}
+	suite.Require().Len(ops, len(expected))
+	for i, op := range ops {
+		suite.Equal(expected[i], op.Weight())
+	}
+}
+
+func (suite *operationsTestSuite) TestOperations() {
+	r := rand.New(rand.NewSource(2))
+	k := suite.tApp.GetHardKeeper()
+	ops := simulation.WeightedOperations(
+		make(simtypes.AppParams), suite.tApp.AppCodec(), k, suite.tApp.GetBankKeeper(), suite.tApp.GetAccountKeeper(),
+	)
+
+	executed := make(map[string]int)
+	for i := 0; i < 600; i++ {
+		if r.Intn(5) == 0 {
+			// accrue interest
+			suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).
+				WithBlockTime(suite.ctx.BlockTime().Add(time.Duration(simtypes.RandIntBetween(r, 1, 24)) * time.Hour))
+			hard.BeginBlocker(suite.ctx, k)
+		}
+		if i == 400 {
+			// lower the loan to value so that borrows can be liquidated
+			params := k.GetParams(suite.ctx)
+			params.MoneyMarkets[0].BorrowLimit.LoanToValue = sdk.MustNewDecFromStr("0.2")
+			k.SetParams(suite.ctx, params)
+			hard.BeginBlocker(suite.ctx, k)
+		}
+
+		op := ops[r.Intn(len(ops))].Op()
+		opMsg, futureOps, err := op(r, suite.tApp.BaseApp, suite.ctx, suite.accs, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+		suite.Require().Len(futureOps, 0)
+		if opMsg.OK {
+			executed[opMsg.Name]++
+		}
+
+		msg, broken := keeper.AllInvariants(k)(suite.ctx)
+		suite.Require().False(broken, msg)
+	}
+
+	for _, msg := range []sdk.Msg{
+		&types.MsgDeposit{}, &types.MsgWithdraw{}, &types.MsgBorrow{}, &types.MsgRepay{}, &types.MsgLiquidate{},
+	} {
+		suite.Greater(executed[sdk.MsgTypeURL(msg)], 0, sdk.MsgTypeURL(msg))
+	}
+}
@@ -15,6 +15,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBorrow{}, "hard/MsgBorrow", nil)
 	cdc.RegisterConcrete(&MsgLiquidate{}, "hard/MsgLiquidate", nil)
 	cdc.RegisterConcrete(&MsgRepay{}, "hard/MsgRepay", nil)
+	cdc.RegisterConcrete(&MsgFlashBorrow{}, "hard/MsgFlashBorrow", nil)
+	cdc.RegisterConcrete(&MsgSetEMode{}, "hard/MsgSetEMode", nil)
+	cdc.RegisterConcrete(&MsgDepositAndBorrow{}, "hard/MsgDepositAndBorrow", nil)
+	cdc.RegisterConcrete(&MsgRepayAndWithdraw{}, "hard/MsgRepayAndWithdraw", nil)
+	cdc.RegisterConcrete(&MsgLiquidateDirect{}, "hard/MsgLiquidateDirect", nil)
+	cdc.RegisterConcrete(&MsgWithdrawReserves{}, "hard/MsgWithdrawReserves", nil)
+	cdc.RegisterConcrete(&MsgTransferPosition{}, "hard/MsgTransferPosition", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -24,6 +31,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBorrow{},
 		&MsgLiquidate{},
 		&MsgRepay{},
+		&MsgFlashBorrow{},
+		&MsgSetEMode{},
+		&MsgDepositAndBorrow{},
+		&MsgRepayAndWithdraw{},
+		&MsgLiquidateDirect{},
+		&MsgWithdrawReserves{},
+		&MsgTransferPosition{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewEModeAccount returns a new EModeAccount
+func NewEModeAccount(address sdk.AccAddress, category string) EModeAccount {
+	return EModeAccount{
+		Address:  address,
+		Category: category,
+	}
+}
+
+// Validate performs basic validation of an EModeAccount
+func (ea EModeAccount) Validate() error {
+	if ea.Address.Empty() {
+		return fmt.Errorf("e-mode account address cannot be empty")
+	}
+	if ea.Category == "" {
+		return fmt.Errorf("e-mode category cannot be empty for %s", ea.Address)
+	}
+	return nil
+}
+
+// EModeAccounts is a slice of EModeAccount
+type EModeAccounts []EModeAccount
+
+// Validate performs basic validation of EModeAccounts
+func (eas EModeAccounts) Validate() error {
+	addresses := make(map[string]bool)
+	for _, ea := range eas {
+		if err := ea.Validate(); err != nil {
+			return err
+		}
+		if addresses[ea.Address.String()] {
+			return fmt.Errorf("duplicate e-mode account %s", ea.Address)
+		}
+		addresses[ea.Address.String()] = true
+	}
+	return nil
+}
+
+// LoanToValueForEMode returns the loan to value of the money market's deposits for an account in an e-mode category.
+// Accounts not in the market's e-mode category use the market's borrow limit loan to value.
+func (mm MoneyMarket) LoanToValueForEMode(category string) sdk.Dec {
+	if category != "" && mm.EModeCategory == category {
+		return mm.EModeLoanToValue
+	}
+	return mm.BorrowLimit.LoanToValue
+}
+
+// HasEModeCategory returns true if a money market belongs to the e-mode category.
+func (mms MoneyMarkets) HasEModeCategory(category string) bool {
+	for _, mm := range mms {
+		if mm.EModeCategory == category {
+			return true
+		}
+	}
+	return false
+}
@@ -67,4 +67,20 @@ var (
 	ErrExceedsProtocolBorrowableBalance = errorsmod.Register(ModuleName, 31, "exceeds borrowable module account balance")
 	// ErrReservesExceedCash for when the protocol is insolvent because available reserves exceeds available cash
 	ErrReservesExceedCash = errorsmod.Register(ModuleName, 32, "insolvency - protocol reserves exceed available cash")
+	// ErrInvalidFlashLoanMsg error for when a msg executed by a flash loan is invalid or not signed by the borrower
+	ErrInvalidFlashLoanMsg = errorsmod.Register(ModuleName, 33, "invalid flash loan msg")
+	// ErrFlashLoanNotRepaid error for when a flash loan and its fee are not repaid by the end of the flash loan
+	ErrFlashLoanNotRepaid = errorsmod.Register(ModuleName, 34, "flash loan not repaid")
+	// ErrEModeCategoryNotFound error for when no money market belongs to an e-mode category
+	ErrEModeCategoryNotFound = errorsmod.Register(ModuleName, 35, "e-mode category not found")
+	// ErrInvalidEModeBorrow error for when an account in e-mode borrows a coin outside of its e-mode category
+	ErrInvalidEModeBorrow = errorsmod.Register(ModuleName, 36, "borrow not in e-mode category")
+	// ErrInvalidIsolatedBorrow error for when a borrow is not allowed by the isolated market collateral it is backed by
+	ErrInvalidIsolatedBorrow = errorsmod.Register(ModuleName, 37, "borrow not allowed by isolated collateral")
+	// ErrDirectLiquidationNotAllowed error for when a position can't be liquidated directly
+	ErrDirectLiquidationNotAllowed = errorsmod.Register(ModuleName, 38, "direct liquidation not allowed")
+	// ErrInsufficientReserves error for when a reserves withdrawal exceeds the accrued reserves of a money market
+	ErrInsufficientReserves = errorsmod.Register(ModuleName, 39, "insufficient reserves")
+	// ErrInvalidPositionTransfer error for when an account's position can't be transferred to another account
+	ErrInvalidPositionTransfer = errorsmod.Register(ModuleName, 40, "invalid position transfer")
 )
@@ -7,6 +7,11 @@ const (
 	EventTypeHardBorrow           = "hard_borrow"
 	EventTypeHardLiquidation      = "hard_liquidation"
 	EventTypeHardRepay            = "hard_repay"
+	EventTypeHardFlashBorrow      = "hard_flash_borrow"
+	EventTypeHardSetEMode         = "hard_set_emode"
+	EventTypeHardLiquidateDirect  = "hard_liquidate_direct"
+	EventTypeHardWithdrawReserves = "hard_withdraw_reserves"
+	EventTypeHardTransferPosition = "hard_transfer_position"
 	AttributeValueCategory        = ModuleName
 	AttributeKeyDeposit           = "deposit"
 	AttributeKeyDepositDenom      = "deposit_denom"
@@ -22,4 +27,11 @@ const (
 	AttributeKeyKeeper            = "keeper"
 	AttributeKeyKeeperRewardCoins = "keeper_reward_coins"
 	AttributeKeyOwner             = "owner"
+	AttributeKeyFlashLoanFee      = "flash_loan_fee"
+	AttributeKeyEModeCategory     = "emode_category"
+	AttributeKeyLiquidator        = "liquidator"
+	AttributeKeySeizedCoins       = "seized_coins"
+	AttributeKeyReserveCoins      = "reserve_coins"
+	AttributeKeyRecipientModule   = "recipient_module"
+	AttributeKeyRecipient         = "recipient"
 )
@@ -1,6 +1,8 @@
 package types // noalias
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -42,6 +44,7 @@ type StakingKeeper interface {
 // PricefeedKeeper defines the expected interface for the pricefeed
 type PricefeedKeeper interface {
 	GetCurrentPrice(sdk.Context, string) (pftypes.CurrentPrice, error)
+	GetTwap(sdk.Context, string, time.Duration) (sdk.Dec, error)
 }
 
 // AuctionKeeper expected interface for the auction keeper (noalias)
@@ -57,4 +60,5 @@ type HARDHooks interface {
 	AfterBorrowCreated(ctx sdk.Context, borrow Borrow)
 	BeforeBorrowModified(ctx sdk.Context, borrow Borrow)
 	AfterBorrowModified(ctx sdk.Context, borrow Borrow)
+	AfterInterestAccrued(ctx sdk.Context, denom string, suppliedInterest, reserveInterest sdkmath.Int)
 }
@@ -61,6 +61,15 @@ func (gs GenesisState) Validate() error {
 	if !gs.TotalReserves.IsValid() {
 		return fmt.Errorf("invalid total reserves coins: %s", gs.TotalReserves)
 	}
+
+	if err := gs.EModeAccounts.Validate(); err != nil {
+		return err
+	}
+	for _, ea := range gs.EModeAccounts {
+		if !gs.Params.MoneyMarkets.HasEModeCategory(ea.Category) {
+			return fmt.Errorf("e-mode category %s not found for %s", ea.Category, ea.Address)
+		}
+	}
 	return nil
 }
 
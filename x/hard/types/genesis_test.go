@@ -26,6 +26,11 @@ type GenesisTestSuite struct {
 }
 
 func (suite *GenesisTestSuite) TestGenesisValidation() {
+	emodeMarket := types.NewMoneyMarket("usdx", types.NewBorrowLimit(true, sdk.MustNewDecFromStr("100000000000"), sdk.MustNewDecFromStr("0.8")), "usdx:usd", sdkmath.NewInt(USDX_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	emodeMarket.EModeCategory = "usd"
+	emodeMarket.EModeLoanToValue = sdk.MustNewDecFromStr("0.95")
+	emodeMarkets := types.MoneyMarkets{emodeMarket}
+
 	type args struct {
 		params types.Params
 		gats   types.GenesisAccumulationTimes
@@ -34,6 +39,7 @@ func (suite *GenesisTestSuite) TestGenesisValidation() {
 		ts     sdk.Coins
 		tb     sdk.Coins
 		tr     sdk.Coins
+		emas   types.EModeAccounts
 	}
 	testCases := []struct {
 		name        string
@@ -76,10 +82,81 @@ func (suite *GenesisTestSuite) TestGenesisValidation() {
 			expectPass:  true,
 			expectedErr: "",
 		},
+		{
+			name: "valid: e-mode accounts",
+			args: args{
+				params: types.NewParams(emodeMarkets, sdk.MustNewDecFromStr("10")),
+				gats:   types.DefaultAccumulationTimes,
+				deps:   types.DefaultDeposits,
+				brws:   types.DefaultBorrows,
+				ts:     types.DefaultTotalSupplied,
+				tb:     types.DefaultTotalBorrowed,
+				tr:     types.DefaultTotalReserves,
+				emas: types.EModeAccounts{
+					types.NewEModeAccount(sdk.AccAddress("test1"), "usd"),
+					types.NewEModeAccount(sdk.AccAddress("test2"), "usd"),
+				},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: e-mode category not found",
+			args: args{
+				params: types.NewParams(emodeMarkets, sdk.MustNewDecFromStr("10")),
+				gats:   types.DefaultAccumulationTimes,
+				deps:   types.DefaultDeposits,
+				brws:   types.DefaultBorrows,
+				ts:     types.DefaultTotalSupplied,
+				tb:     types.DefaultTotalBorrowed,
+				tr:     types.DefaultTotalReserves,
+				emas: types.EModeAccounts{
+					types.NewEModeAccount(sdk.AccAddress("test1"), "eth"),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "e-mode category eth not found",
+		},
+		{
+			name: "invalid: duplicate e-mode account",
+			args: args{
+				params: types.NewParams(emodeMarkets, sdk.MustNewDecFromStr("10")),
+				gats:   types.DefaultAccumulationTimes,
+				deps:   types.DefaultDeposits,
+				brws:   types.DefaultBorrows,
+				ts:     types.DefaultTotalSupplied,
+				tb:     types.DefaultTotalBorrowed,
+				tr:     types.DefaultTotalReserves,
+				emas: types.EModeAccounts{
+					types.NewEModeAccount(sdk.AccAddress("test1"), "usd"),
+					types.NewEModeAccount(sdk.AccAddress("test1"), "usd"),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "duplicate e-mode account",
+		},
+		{
+			name: "invalid: empty e-mode category",
+			args: args{
+				params: types.NewParams(emodeMarkets, sdk.MustNewDecFromStr("10")),
+				gats:   types.DefaultAccumulationTimes,
+				deps:   types.DefaultDeposits,
+				brws:   types.DefaultBorrows,
+				ts:     types.DefaultTotalSupplied,
+				tb:     types.DefaultTotalBorrowed,
+				tr:     types.DefaultTotalReserves,
+				emas: types.EModeAccounts{
+					types.NewEModeAccount(sdk.AccAddress("test1"), ""),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "e-mode category cannot be empty",
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
 			gs := types.NewGenesisState(tc.args.params, tc.args.gats, tc.args.deps, tc.args.brws, tc.args.ts, tc.args.tb, tc.args.tr)
+			gs.EModeAccounts = tc.args.emas
 			err := gs.Validate()
 			if tc.expectPass {
 				suite.NoError(err)
@@ -10,15 +10,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -74,6 +79,34 @@ type MoneyMarket struct {
 	InterestRateModel      InterestRateModel                      `protobuf:"bytes,5,opt,name=interest_rate_model,json=interestRateModel,proto3" json:"interest_rate_model"`
 	ReserveFactor          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=reserve_factor,json=reserveFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reserve_factor"`
 	KeeperRewardPercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=keeper_reward_percentage,json=keeperRewardPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"keeper_reward_percentage"`
+	// flash_loan_fee is the fraction of a flash loan charged as a fee, which is credited to suppliers.
+	FlashLoanFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=flash_loan_fee,json=flashLoanFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"flash_loan_fee"`
+	// close_factor is the maximum fraction of a borrow of this market that can be repaid in a single liquidation.
+	// Liquidations of positions borrowing a market without a close factor seize the whole position.
+	CloseFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=close_factor,json=closeFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"close_factor"`
+	// liquidation_bonus is the fraction of repaid debt value seized from deposits of this market in addition to the
+	// debt value in a partial liquidation.
+	LiquidationBonus github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=liquidation_bonus,json=liquidationBonus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_bonus"`
+	// emode_category is the efficiency mode category of correlated assets this market belongs to, if any.
+	EModeCategory string `protobuf:"bytes,11,opt,name=emode_category,json=emodeCategory,proto3" json:"emode_category,omitempty"`
+	// emode_loan_to_value is the loan to value of deposits of this market for accounts in its efficiency mode category.
+	EModeLoanToValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=emode_loan_to_value,json=emodeLoanToValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"emode_loan_to_value"`
+	// isolated restricts deposits of this market to only back borrows of the isolated_debt_denoms.
+	Isolated bool `protobuf:"varint,13,opt,name=isolated,proto3" json:"isolated,omitempty"`
+	// isolated_debt_denoms are the denoms that deposits of an isolated market can back borrows of.
+	IsolatedDebtDenoms []string `protobuf:"bytes,14,rep,name=isolated_debt_denoms,json=isolatedDebtDenoms,proto3" json:"isolated_debt_denoms,omitempty"`
+	// direct_liquidation_threshold is the maximum USD value of a borrow of this market that can be liquidated directly
+	// by a liquidator instead of at auction. Zero disables direct liquidation.
+	DirectLiquidationThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=direct_liquidation_threshold,json=directLiquidationThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"direct_liquidation_threshold"`
+	// direct_liquidation_discount is the discount on the USD value of deposits of this market bought by a liquidator
+	// in a direct liquidation.
+	DirectLiquidationDiscount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=direct_liquidation_discount,json=directLiquidationDiscount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"direct_liquidation_discount"`
+	// max_accrual_interval is the longest time this market goes without accruing interest in the begin blocker while
+	// no deposits or borrows of it change. Zero accrues interest every block.
+	MaxAccrualInterval time.Duration `protobuf:"bytes,17,opt,name=max_accrual_interval,json=maxAccrualInterval,proto3,stdduration" json:"max_accrual_interval"`
+	// twap_duration is the duration of the time-weighted average of the spot market used to price this market instead
+	// of its current median price. Zero uses the current price.
+	TwapDuration time.Duration `protobuf:"bytes,18,opt,name=twap_duration,json=twapDuration,proto3,stdduration" json:"twap_duration"`
 }
 
 func (m *MoneyMarket) Reset()         { *m = MoneyMarket{} }
@@ -155,6 +188,14 @@ type InterestRateModel struct {
 	BaseMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_multiplier,json=baseMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_multiplier"`
 	Kink           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=kink,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"kink"`
 	JumpMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=jump_multiplier,json=jumpMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"jump_multiplier"`
+	// model selects the rate model used to calculate the borrow rate, either "jump" or "two_kink". It defaults to
+	// "jump" when empty.
+	Model string `protobuf:"bytes,5,opt,name=model,proto3" json:"model,omitempty"`
+	// second_kink is the utilization above which the second_jump_multiplier applies. It is only used by the
+	// "two_kink" model.
+	SecondKink github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=second_kink,json=secondKink,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"second_kink"`
+	// second_jump_multiplier is the multiplier applied above the second_kink. It is only used by the "two_kink" model.
+	SecondJumpMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=second_jump_multiplier,json=secondJumpMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"second_jump_multiplier"`
 }
 
 func (m *InterestRateModel) Reset()         { *m = InterestRateModel{} }
@@ -190,6 +231,45 @@ func (m *InterestRateModel) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InterestRateModel proto.InternalMessageInfo
 
+// EModeAccount defines the efficiency mode category an account has enabled.
+type EModeAccount struct {
+	Address  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	Category string                                        `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (m *EModeAccount) Reset()         { *m = EModeAccount{} }
+func (m *EModeAccount) String() string { return proto.CompactTextString(m) }
+func (*EModeAccount) ProtoMessage()    {}
+func (*EModeAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a5de800263a2ff, []int{4}
+}
+func (m *EModeAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EModeAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EModeAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EModeAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EModeAccount.Merge(m, src)
+}
+func (m *EModeAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *EModeAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_EModeAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EModeAccount proto.InternalMessageInfo
+
 // Deposit defines an amount of coins deposited into a hard module account.
 type Deposit struct {
 	Depositor github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=depositor,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"depositor,omitempty"`
@@ -201,7 +281,7 @@ func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{4}
+	return fileDescriptor_23a5de800263a2ff, []int{5}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,7 +321,7 @@ func (m *Borrow) Reset()         { *m = Borrow{} }
 func (m *Borrow) String() string { return proto.CompactTextString(m) }
 func (*Borrow) ProtoMessage()    {}
 func (*Borrow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{5}
+	return fileDescriptor_23a5de800263a2ff, []int{6}
 }
 func (m *Borrow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,7 +360,7 @@ func (m *SupplyInterestFactor) Reset()         { *m = SupplyInterestFactor{} }
 func (m *SupplyInterestFactor) String() string { return proto.CompactTextString(m) }
 func (*SupplyInterestFactor) ProtoMessage()    {}
 func (*SupplyInterestFactor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{6}
+	return fileDescriptor_23a5de800263a2ff, []int{7}
 }
 func (m *SupplyInterestFactor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +399,7 @@ func (m *BorrowInterestFactor) Reset()         { *m = BorrowInterestFactor{} }
 func (m *BorrowInterestFactor) String() string { return proto.CompactTextString(m) }
 func (*BorrowInterestFactor) ProtoMessage()    {}
 func (*BorrowInterestFactor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{7}
+	return fileDescriptor_23a5de800263a2ff, []int{8}
 }
 func (m *BorrowInterestFactor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,7 +437,7 @@ func (m *CoinsProto) Reset()         { *m = CoinsProto{} }
 func (m *CoinsProto) String() string { return proto.CompactTextString(m) }
 func (*CoinsProto) ProtoMessage()    {}
 func (*CoinsProto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{8}
+	return fileDescriptor_23a5de800263a2ff, []int{9}
 }
 func (m *CoinsProto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,79 +466,157 @@ func (m *CoinsProto) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CoinsProto proto.InternalMessageInfo
 
+// RatePoint defines the utilization and interest rates of a money market at an interest accrual.
+type RatePoint struct {
+	Height int64     `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// utilization_ratio is the fraction of the money market's supply that is borrowed.
+	UtilizationRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=utilization_ratio,json=utilizationRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"utilization_ratio"`
+	// borrow_rate is the borrow APY.
+	BorrowRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=borrow_rate,json=borrowRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"borrow_rate"`
+	// supply_rate is the supply APY, after the reserve factor.
+	SupplyRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=supply_rate,json=supplyRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"supply_rate"`
+}
+
+func (m *RatePoint) Reset()         { *m = RatePoint{} }
+func (m *RatePoint) String() string { return proto.CompactTextString(m) }
+func (*RatePoint) ProtoMessage()    {}
+func (*RatePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a5de800263a2ff, []int{10}
+}
+func (m *RatePoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RatePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RatePoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RatePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RatePoint.Merge(m, src)
+}
+func (m *RatePoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *RatePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_RatePoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RatePoint proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kava.hard.v1beta1.Params")
 	proto.RegisterType((*MoneyMarket)(nil), "kava.hard.v1beta1.MoneyMarket")
 	proto.RegisterType((*BorrowLimit)(nil), "kava.hard.v1beta1.BorrowLimit")
 	proto.RegisterType((*InterestRateModel)(nil), "kava.hard.v1beta1.InterestRateModel")
+	proto.RegisterType((*EModeAccount)(nil), "kava.hard.v1beta1.EModeAccount")
 	proto.RegisterType((*Deposit)(nil), "kava.hard.v1beta1.Deposit")
 	proto.RegisterType((*Borrow)(nil), "kava.hard.v1beta1.Borrow")
 	proto.RegisterType((*SupplyInterestFactor)(nil), "kava.hard.v1beta1.SupplyInterestFactor")
 	proto.RegisterType((*BorrowInterestFactor)(nil), "kava.hard.v1beta1.BorrowInterestFactor")
 	proto.RegisterType((*CoinsProto)(nil), "kava.hard.v1beta1.CoinsProto")
+	proto.RegisterType((*RatePoint)(nil), "kava.hard.v1beta1.RatePoint")
 }
 
 func init() { proto.RegisterFile("kava/hard/v1beta1/hard.proto", fileDescriptor_23a5de800263a2ff) }
 
 var fileDescriptor_23a5de800263a2ff = []byte{
-	// 911 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xbd, 0x8f, 0x1b, 0x45,
-	0x14, 0xf7, 0x9e, 0x3f, 0x92, 0x8c, 0xed, 0x23, 0xde, 0xdc, 0xa1, 0x4d, 0x04, 0xeb, 0xc8, 0x42,
-	0x70, 0x8d, 0x6d, 0x02, 0x82, 0x8a, 0xe6, 0x16, 0x0b, 0x38, 0x81, 0x25, 0x6b, 0x8f, 0x20, 0x25,
-	0x42, 0x5a, 0xc6, 0xbb, 0x2f, 0x77, 0x83, 0x3d, 0x3b, 0xab, 0x99, 0xb1, 0x63, 0x77, 0xb4, 0x34,
-	0x88, 0x3f, 0x82, 0x8a, 0x0e, 0xe9, 0xfe, 0x88, 0x2b, 0xa3, 0x54, 0x88, 0xc2, 0x80, 0xaf, 0xa3,
-	0xa6, 0xa2, 0x42, 0xf3, 0xe1, 0x8f, 0x5c, 0x1c, 0x29, 0xa7, 0x58, 0x88, 0x6a, 0x77, 0xe6, 0xbd,
-	0xf9, 0xbd, 0xdf, 0xfb, 0xcd, 0x9b, 0x99, 0x87, 0xde, 0x18, 0xe0, 0x31, 0x6e, 0x9f, 0x62, 0x9e,
-	0xb4, 0xc7, 0xf7, 0xfa, 0x20, 0xf1, 0x3d, 0x3d, 0x68, 0x65, 0x9c, 0x49, 0xe6, 0xd6, 0x94, 0xb5,
-	0xa5, 0x27, 0xac, 0xf5, 0x8e, 0x1f, 0x33, 0x41, 0x99, 0x68, 0xf7, 0xb1, 0x80, 0xe5, 0x92, 0x98,
-	0x91, 0xd4, 0x2c, 0xb9, 0x73, 0xdb, 0xd8, 0x23, 0x3d, 0x6a, 0x9b, 0x81, 0x35, 0xed, 0x9d, 0xb0,
-	0x13, 0x66, 0xe6, 0xd5, 0x9f, 0x99, 0x6d, 0xfc, 0xed, 0xa0, 0x52, 0x0f, 0x73, 0x4c, 0x85, 0xfb,
-	0x00, 0x55, 0x29, 0x4b, 0x61, 0x1a, 0x51, 0xcc, 0x07, 0x20, 0x85, 0xe7, 0xdc, 0xcd, 0x1f, 0x94,
-	0xdf, 0xf3, 0x5b, 0xcf, 0xd1, 0x68, 0x75, 0x95, 0x5f, 0x57, 0xbb, 0x05, 0x7b, 0xe7, 0xb3, 0x7a,
-	0xee, 0xe7, 0xdf, 0xeb, 0x95, 0xb5, 0x49, 0x11, 0x56, 0xe8, 0xda, 0xc8, 0xfd, 0xc1, 0x41, 0x1e,
-	0x25, 0x29, 0xa1, 0x23, 0x1a, 0xf5, 0x19, 0xe7, 0xec, 0x71, 0x34, 0x12, 0x49, 0x34, 0xc6, 0xc3,
-	0x11, 0x78, 0x3b, 0x77, 0x9d, 0x83, 0x1b, 0xc1, 0x7d, 0x05, 0xf3, 0xdb, 0xac, 0xfe, 0xf6, 0x09,
-	0x91, 0xa7, 0xa3, 0x7e, 0x2b, 0x66, 0xd4, 0xf2, 0xb7, 0x9f, 0xa6, 0x48, 0x06, 0x6d, 0x39, 0xcd,
-	0x40, 0xb4, 0x3a, 0x10, 0xcf, 0x67, 0xf5, 0xfd, 0xae, 0x41, 0x0c, 0x34, 0xe0, 0xfd, 0xe3, 0xce,
-	0x57, 0x0a, 0xee, 0xe9, 0x59, 0x13, 0xd9, 0xbc, 0x3b, 0x10, 0x87, 0xfb, 0xf4, 0x19, 0x27, 0x91,
-	0x68, 0xa7, 0xc6, 0x79, 0x01, 0x95, 0xd7, 0xf8, 0xba, 0x7b, 0xa8, 0x98, 0x40, 0xca, 0xa8, 0xe7,
-	0x28, 0x32, 0xa1, 0x19, 0xb8, 0x9f, 0xa2, 0x8a, 0x65, 0x3b, 0x24, 0x94, 0x48, 0xcd, 0x74, 0xb3,
-	0x20, 0x06, 0xfe, 0x0b, 0xe5, 0x15, 0x14, 0x54, 0x26, 0x61, 0xb9, 0xbf, 0x9a, 0x72, 0x3f, 0x44,
-	0xbb, 0x22, 0x63, 0xd2, 0x2a, 0x1b, 0x91, 0xc4, 0xcb, 0xeb, 0xa4, 0x6f, 0xce, 0x67, 0xf5, 0xca,
-	0x71, 0xc6, 0xa4, 0xa1, 0x71, 0xd4, 0x09, 0x2b, 0x62, 0x35, 0x4a, 0x5c, 0x82, 0x6a, 0x31, 0x4b,
-	0xc7, 0xc0, 0x05, 0x61, 0x69, 0xf4, 0x08, 0xc7, 0x92, 0x71, 0xaf, 0xa0, 0x97, 0x7e, 0x74, 0x05,
-	0xbd, 0x8e, 0x52, 0xb9, 0x26, 0xcb, 0x51, 0x2a, 0xc3, 0x9b, 0x2b, 0xd8, 0x4f, 0x34, 0xaa, 0xfb,
-	0x10, 0xdd, 0x22, 0xa9, 0x04, 0x0e, 0x42, 0x46, 0x1c, 0x4b, 0x88, 0x28, 0x4b, 0x60, 0xe8, 0x15,
-	0x75, 0xca, 0x6f, 0x6d, 0x48, 0xf9, 0xc8, 0x7a, 0x87, 0x58, 0x42, 0x57, 0xf9, 0xda, 0xc4, 0x6b,
-	0xe4, 0xb2, 0xc1, 0x8d, 0xd1, 0x2e, 0x07, 0x01, 0x7c, 0x0c, 0x8b, 0x1c, 0x4a, 0x57, 0xce, 0xa1,
-	0x03, 0xf1, 0xa5, 0xad, 0xad, 0x5a, 0x4c, 0x9b, 0xc0, 0x18, 0x79, 0x03, 0x80, 0x0c, 0x78, 0xc4,
-	0xe1, 0x31, 0xe6, 0x49, 0x94, 0x01, 0x8f, 0x21, 0x95, 0xf8, 0x04, 0xbc, 0x6b, 0x5b, 0x08, 0xf7,
-	0xba, 0x41, 0x0f, 0x35, 0x78, 0x6f, 0x89, 0xdd, 0xf8, 0x7e, 0x07, 0x95, 0xd7, 0xb6, 0xdf, 0xfd,
-	0x00, 0x55, 0x4f, 0xb1, 0x88, 0x28, 0x9e, 0xd8, 0xaa, 0x51, 0x25, 0x75, 0x3d, 0xa8, 0xfd, 0x35,
-	0xab, 0x3f, 0x6b, 0x08, 0xcb, 0xa7, 0x58, 0x74, 0xf1, 0xc4, 0x2c, 0xc3, 0xa8, 0x4a, 0xf1, 0x44,
-	0x9f, 0x90, 0x55, 0xb1, 0xbd, 0x2a, 0xe7, 0x8a, 0x85, 0x34, 0x21, 0xbe, 0x41, 0xd5, 0x21, 0xc3,
-	0x69, 0x24, 0x99, 0x3d, 0x79, 0xf9, 0x2d, 0x84, 0x28, 0x2b, 0xc8, 0x2f, 0x99, 0x39, 0x56, 0x3f,
-	0xe5, 0x51, 0xed, 0xb9, 0xba, 0x70, 0x19, 0xaa, 0xaa, 0xfb, 0xca, 0x94, 0x15, 0xce, 0xa6, 0xe6,
-	0x90, 0x05, 0x9f, 0x5f, 0xf9, 0xc4, 0x97, 0x03, 0x2c, 0x40, 0xe1, 0x1e, 0xf6, 0x1e, 0x5c, 0xa6,
-	0xd1, 0x5f, 0x98, 0xb2, 0xa9, 0x0b, 0xe8, 0x35, 0x1d, 0x90, 0x8e, 0x86, 0x92, 0x64, 0x43, 0x02,
-	0x7c, 0x2b, 0x6a, 0xee, 0x2a, 0xd0, 0xee, 0x12, 0xd3, 0xed, 0xa1, 0xc2, 0x80, 0xa4, 0x83, 0xad,
-	0xc8, 0xa8, 0x91, 0x14, 0xf1, 0x6f, 0x47, 0x34, 0x5b, 0x27, 0x5e, 0xd8, 0x06, 0x71, 0x05, 0xba,
-	0x22, 0xde, 0x38, 0xdb, 0x41, 0xd7, 0x3a, 0x90, 0x31, 0x41, 0xa4, 0xfb, 0x08, 0xdd, 0x48, 0xcc,
-	0x2f, 0xe3, 0x76, 0x63, 0x3e, 0xfb, 0x67, 0x56, 0x6f, 0xbe, 0x44, 0xa0, 0xc3, 0x38, 0x3e, 0x4c,
-	0x12, 0x0e, 0x42, 0x3c, 0x3d, 0x6b, 0xde, 0xb2, 0xf1, 0xec, 0x4c, 0x30, 0x95, 0x20, 0xc2, 0x15,
-	0xb4, 0x1b, 0xa3, 0x12, 0xa6, 0x6c, 0x94, 0xaa, 0xc2, 0x56, 0xcf, 0xca, 0xed, 0x96, 0x5d, 0xa0,
-	0x44, 0x5d, 0x5e, 0x2a, 0x1f, 0x33, 0x92, 0x06, 0xef, 0xda, 0x17, 0xe5, 0xe0, 0x25, 0x38, 0xa8,
-	0x05, 0x22, 0xb4, 0xd0, 0xee, 0xd7, 0xa8, 0x48, 0xd2, 0x04, 0x26, 0x5e, 0x5e, 0xc7, 0x78, 0x67,
-	0xc3, 0xb5, 0x75, 0x3c, 0xca, 0xb2, 0xe1, 0x74, 0x51, 0xa4, 0xe6, 0xee, 0x08, 0xde, 0xb4, 0x11,
-	0xf7, 0x37, 0x59, 0x45, 0x68, 0x40, 0x1b, 0xbf, 0xec, 0xa0, 0x92, 0x39, 0xe9, 0x6e, 0x82, 0xae,
-	0x9b, 0xfb, 0x1d, 0xb6, 0x2f, 0xda, 0x12, 0xf9, 0x7f, 0xa3, 0x99, 0x49, 0xfa, 0x45, 0x9a, 0x6d,
-	0xb2, 0x2e, 0x35, 0xfb, 0xce, 0x41, 0x7b, 0x9b, 0x44, 0x7d, 0xc1, 0x8b, 0x1b, 0xa2, 0xe2, 0x7a,
-	0x53, 0xf0, 0x6a, 0x65, 0x6f, 0xa0, 0x34, 0x85, 0x4d, 0x1c, 0xff, 0x43, 0x0a, 0x0c, 0x21, 0x2d,
-	0x7a, 0x4f, 0xf7, 0x75, 0x18, 0x15, 0x55, 0xcb, 0xb6, 0x68, 0xb0, 0xb6, 0xba, 0xab, 0x06, 0x39,
-	0xe8, 0x9c, 0xff, 0xe9, 0xe7, 0xce, 0xe7, 0xbe, 0xf3, 0x64, 0xee, 0x3b, 0x7f, 0xcc, 0x7d, 0xe7,
-	0xc7, 0x0b, 0x3f, 0xf7, 0xe4, 0xc2, 0xcf, 0xfd, 0x7a, 0xe1, 0xe7, 0x1e, 0xae, 0xe7, 0xa2, 0x76,
-	0xbb, 0x39, 0xc4, 0x7d, 0xa1, 0xff, 0xda, 0x13, 0xd3, 0x8d, 0x6a, 0xc8, 0x7e, 0x49, 0xf7, 0x88,
-	0xef, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x40, 0xda, 0xa7, 0xd8, 0xa7, 0x0a, 0x00, 0x00,
+	// 1396 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xbd, 0x6f, 0x1b, 0xc7,
+	0x12, 0x17, 0x25, 0x51, 0x96, 0x86, 0xa4, 0x2c, 0xae, 0x69, 0xe3, 0xa4, 0xe7, 0x47, 0x0a, 0xc4,
+	0xc3, 0x7b, 0x6a, 0x44, 0xda, 0x2f, 0x48, 0xe0, 0x22, 0x8d, 0x68, 0xc6, 0xb1, 0x62, 0x0b, 0x20,
+	0xce, 0x76, 0x00, 0x1b, 0x09, 0x2e, 0x7b, 0x77, 0x2b, 0x72, 0xa3, 0xbb, 0xdb, 0xcb, 0xed, 0x1e,
+	0x2d, 0x06, 0x41, 0x92, 0x2a, 0x40, 0x8a, 0x18, 0x2e, 0x53, 0xa6, 0x4e, 0x17, 0xc0, 0x7f, 0x84,
+	0x4b, 0xc3, 0x55, 0x90, 0x82, 0x4e, 0xe4, 0x2e, 0x75, 0xaa, 0x54, 0xc1, 0x7e, 0xf0, 0x43, 0x12,
+	0x0d, 0x58, 0xf0, 0x21, 0x48, 0x23, 0xdd, 0xec, 0xec, 0xfc, 0x66, 0xe6, 0x77, 0x7b, 0x33, 0xb3,
+	0x84, 0xcb, 0x07, 0xb8, 0x8f, 0x9b, 0x3d, 0x9c, 0xf8, 0xcd, 0xfe, 0x55, 0x97, 0x08, 0x7c, 0x55,
+	0x09, 0x8d, 0x38, 0x61, 0x82, 0xa1, 0xb2, 0xd4, 0x36, 0xd4, 0x82, 0xd1, 0x6e, 0x54, 0x3d, 0xc6,
+	0x43, 0xc6, 0x9b, 0x2e, 0xe6, 0x64, 0x6c, 0xe2, 0x31, 0x1a, 0x69, 0x93, 0x8d, 0x75, 0xad, 0x77,
+	0x94, 0xd4, 0xd4, 0x82, 0x51, 0x55, 0xba, 0xac, 0xcb, 0xf4, 0xba, 0x7c, 0x32, 0xab, 0xd5, 0x2e,
+	0x63, 0xdd, 0x80, 0x34, 0x95, 0xe4, 0xa6, 0xfb, 0x4d, 0x3f, 0x4d, 0xb0, 0xa0, 0x6c, 0x04, 0x58,
+	0x3b, 0xa9, 0x17, 0x34, 0x24, 0x5c, 0xe0, 0x30, 0xd6, 0x1b, 0xea, 0x7f, 0xe4, 0x60, 0xa9, 0x83,
+	0x13, 0x1c, 0x72, 0x74, 0x1f, 0x4a, 0x21, 0x8b, 0xc8, 0xc0, 0x09, 0x71, 0x72, 0x40, 0x04, 0xb7,
+	0x72, 0x9b, 0x0b, 0x5b, 0x85, 0xff, 0x57, 0x1b, 0xa7, 0xf2, 0x68, 0xec, 0xc9, 0x7d, 0x7b, 0x6a,
+	0x5b, 0xab, 0xf2, 0x74, 0x58, 0x9b, 0xfb, 0xf1, 0x45, 0xad, 0x38, 0xb5, 0xc8, 0xed, 0x62, 0x38,
+	0x25, 0xa1, 0x47, 0x39, 0xb0, 0x42, 0x1a, 0xd1, 0x30, 0x0d, 0x1d, 0x97, 0x25, 0x09, 0x7b, 0xe8,
+	0xa4, 0xdc, 0x77, 0xfa, 0x38, 0x48, 0x89, 0x35, 0xbf, 0x99, 0xdb, 0x5a, 0x69, 0xdd, 0x93, 0x30,
+	0xbf, 0x0c, 0x6b, 0xff, 0xed, 0x52, 0xd1, 0x4b, 0xdd, 0x86, 0xc7, 0x42, 0x43, 0x80, 0xf9, 0xb7,
+	0xcd, 0xfd, 0x83, 0xa6, 0x18, 0xc4, 0x84, 0x37, 0xda, 0xc4, 0x3b, 0x1a, 0xd6, 0x2e, 0xee, 0x69,
+	0xc4, 0x96, 0x02, 0xbc, 0x77, 0xa7, 0xfd, 0xa1, 0x84, 0x7b, 0xfe, 0x64, 0x1b, 0x0c, 0x71, 0x6d,
+	0xe2, 0xd9, 0x17, 0xc3, 0x63, 0x9b, 0xb8, 0xaf, 0x36, 0xd5, 0x7f, 0x28, 0x42, 0x61, 0x2a, 0x5e,
+	0x54, 0x81, 0xbc, 0x4f, 0x22, 0x16, 0x5a, 0x39, 0x19, 0x8c, 0xad, 0x05, 0xf4, 0x3e, 0x14, 0x4d,
+	0xb4, 0x01, 0x0d, 0xa9, 0x50, 0x91, 0xce, 0x26, 0x44, 0xc3, 0xdf, 0x96, 0xbb, 0x5a, 0x8b, 0x32,
+	0x13, 0xbb, 0xe0, 0x4e, 0x96, 0xd0, 0x3b, 0xb0, 0xca, 0x63, 0x26, 0x0c, 0xb3, 0x0e, 0xf5, 0xad,
+	0x05, 0x95, 0xf4, 0xda, 0xd1, 0xb0, 0x56, 0xbc, 0x13, 0x33, 0xa1, 0xc3, 0xd8, 0x6d, 0xdb, 0x45,
+	0x3e, 0x91, 0x7c, 0x44, 0xa1, 0xec, 0xb1, 0xa8, 0x4f, 0x12, 0x4e, 0x59, 0xe4, 0xec, 0x63, 0x4f,
+	0xb0, 0xc4, 0x5a, 0x54, 0xa6, 0xef, 0x9e, 0x81, 0xaf, 0xdd, 0x48, 0x4c, 0xd1, 0xb2, 0x1b, 0x09,
+	0x7b, 0x6d, 0x02, 0x7b, 0x43, 0xa1, 0xa2, 0x07, 0x70, 0x81, 0x46, 0x82, 0x24, 0x84, 0x0b, 0x27,
+	0xc1, 0x82, 0x38, 0x21, 0xf3, 0x49, 0x60, 0xe5, 0x55, 0xca, 0xff, 0x99, 0x91, 0xf2, 0xae, 0xd9,
+	0x6d, 0x63, 0x41, 0xf6, 0xe4, 0x5e, 0x93, 0x78, 0x99, 0x9e, 0x54, 0x20, 0x0f, 0x56, 0x13, 0xc2,
+	0x49, 0xd2, 0x27, 0xa3, 0x1c, 0x96, 0xce, 0x9c, 0x43, 0x9b, 0x78, 0x27, 0x5e, 0x6d, 0xc9, 0x60,
+	0x9a, 0x04, 0xfa, 0x60, 0x1d, 0x10, 0x12, 0x93, 0xc4, 0x49, 0xc8, 0x43, 0x9c, 0xf8, 0x4e, 0x4c,
+	0x12, 0x8f, 0x44, 0x02, 0x77, 0x89, 0x75, 0x2e, 0x03, 0x77, 0x97, 0x34, 0xba, 0xad, 0xc0, 0x3b,
+	0x63, 0x6c, 0xe4, 0xc2, 0xea, 0x7e, 0x80, 0x79, 0xcf, 0x09, 0x18, 0x8e, 0x9c, 0x7d, 0x42, 0xac,
+	0xe5, 0x0c, 0xbc, 0x15, 0x15, 0xe6, 0x6d, 0x86, 0xa3, 0x1b, 0x84, 0x20, 0x07, 0x8a, 0x5e, 0xc0,
+	0xf8, 0x98, 0xbe, 0x95, 0x0c, 0x3c, 0x14, 0x14, 0xa2, 0x21, 0x8f, 0x42, 0x39, 0xa0, 0x9f, 0xa5,
+	0xd4, 0x57, 0xc5, 0xc3, 0x71, 0x59, 0x94, 0x72, 0x0b, 0x32, 0xf0, 0xb2, 0x36, 0x05, 0xdb, 0x92,
+	0xa8, 0xe8, 0x1a, 0xac, 0x12, 0x79, 0xb6, 0x1c, 0x0f, 0x0b, 0xd2, 0x65, 0xc9, 0xc0, 0x2a, 0x28,
+	0x3f, 0xe5, 0xa3, 0x61, 0xad, 0xf4, 0x9e, 0x3c, 0x30, 0xd7, 0x8d, 0xc2, 0x2e, 0x91, 0x70, 0x4a,
+	0x44, 0x5f, 0xc1, 0x05, 0x6d, 0xa9, 0x98, 0x16, 0xcc, 0xd4, 0x8f, 0xa2, 0x32, 0xef, 0x9c, 0xb9,
+	0x7e, 0xac, 0x29, 0x67, 0x92, 0xe2, 0xbb, 0x6c, 0x56, 0xe9, 0x58, 0x23, 0xe1, 0x71, 0x3d, 0xda,
+	0x80, 0x65, 0xca, 0x59, 0x80, 0x05, 0xf1, 0xad, 0xd2, 0x66, 0x6e, 0x6b, 0xd9, 0x1e, 0xcb, 0xe8,
+	0x0a, 0x54, 0x46, 0xcf, 0x8e, 0x4f, 0x5c, 0xe1, 0xa8, 0x12, 0xc2, 0xad, 0xd5, 0xcd, 0x85, 0xad,
+	0x15, 0x1b, 0x8d, 0x74, 0x6d, 0xe2, 0x8a, 0xb6, 0xd2, 0xa0, 0x2f, 0xe1, 0xb2, 0x4f, 0x13, 0xe2,
+	0x09, 0x67, 0x9a, 0x7a, 0xd1, 0x4b, 0x08, 0xef, 0xb1, 0xc0, 0xb7, 0xce, 0x67, 0x40, 0xff, 0x86,
+	0xf6, 0x70, 0x7b, 0xe2, 0xe0, 0xee, 0x08, 0x1f, 0x7d, 0x01, 0xff, 0x9a, 0xe1, 0xdf, 0xa7, 0xdc,
+	0x63, 0x69, 0x24, 0xac, 0xb5, 0x0c, 0xdc, 0xaf, 0x9f, 0x72, 0xdf, 0x36, 0xf0, 0xe8, 0x1e, 0x54,
+	0x42, 0x7c, 0xe8, 0x60, 0xcf, 0x4b, 0x52, 0x1c, 0x38, 0xaa, 0x68, 0xf4, 0x71, 0x60, 0x95, 0x55,
+	0xc1, 0x59, 0x6f, 0xe8, 0xc6, 0xd5, 0x18, 0x35, 0xae, 0x46, 0xdb, 0x34, 0xb6, 0xd6, 0xb2, 0x8c,
+	0xe8, 0xfb, 0x17, 0xb5, 0x9c, 0x8d, 0x42, 0x7c, 0xb8, 0xa3, 0xed, 0x77, 0x8d, 0x39, 0xba, 0x09,
+	0x25, 0xf1, 0x10, 0xc7, 0xce, 0xa8, 0x0f, 0x5a, 0xe8, 0xf5, 0xf1, 0x8a, 0xd2, 0x72, 0xb4, 0x5e,
+	0xff, 0x76, 0x1e, 0x0a, 0x53, 0x65, 0x1d, 0xbd, 0x0d, 0xa5, 0x1e, 0xe6, 0x8e, 0x0c, 0x5a, 0x77,
+	0x03, 0xd9, 0x2a, 0x96, 0x5b, 0xe5, 0xdf, 0x87, 0xb5, 0xe3, 0x0a, 0xbb, 0xd0, 0xc3, 0x7c, 0x0f,
+	0x1f, 0x6a, 0x33, 0x0c, 0xa5, 0x10, 0x1f, 0xaa, 0xce, 0x37, 0x69, 0x22, 0x6f, 0x5c, 0x1d, 0x0c,
+	0xa4, 0x76, 0xf1, 0x09, 0x94, 0x8e, 0x7f, 0x11, 0x0b, 0x59, 0x94, 0x87, 0x60, 0x72, 0xf0, 0xeb,
+	0xdf, 0xe5, 0xa1, 0x7c, 0xaa, 0xde, 0x23, 0x06, 0x25, 0x39, 0xc8, 0xe8, 0x76, 0x81, 0xe3, 0x81,
+	0x6e, 0x9e, 0xad, 0x5b, 0x67, 0xfe, 0x12, 0x0b, 0x2d, 0xcc, 0x89, 0xc4, 0xdd, 0xe9, 0xdc, 0x3f,
+	0x19, 0x86, 0x3b, 0x52, 0xc5, 0x03, 0x44, 0xe0, 0xbc, 0x72, 0x18, 0xa6, 0x81, 0xa0, 0x71, 0x40,
+	0x49, 0x92, 0x09, 0x9b, 0xab, 0x12, 0x74, 0x6f, 0x8c, 0x89, 0x3a, 0xb0, 0x78, 0x40, 0xa3, 0x83,
+	0x4c, 0x68, 0x54, 0x48, 0x32, 0xf0, 0x4f, 0xd3, 0x30, 0x9e, 0x0e, 0x7c, 0x31, 0x8b, 0xc0, 0x25,
+	0xe8, 0x54, 0xe0, 0x15, 0xc8, 0x4f, 0xba, 0xf6, 0x8a, 0xad, 0x05, 0xf4, 0x31, 0x14, 0x38, 0xf1,
+	0x58, 0xe4, 0x3b, 0x2a, 0xab, 0x2c, 0x5a, 0x2f, 0x68, 0xc0, 0x5b, 0x32, 0xb7, 0x04, 0x2e, 0x19,
+	0xf8, 0x93, 0x29, 0x66, 0xd1, 0x75, 0x2b, 0x1a, 0xfb, 0x83, 0x63, 0x89, 0xd6, 0x1f, 0xe5, 0xa0,
+	0xa8, 0xaa, 0xf7, 0x8e, 0xa7, 0xab, 0x89, 0x0b, 0xe7, 0xb0, 0xef, 0x27, 0x84, 0x73, 0x73, 0x08,
+	0x6f, 0xfe, 0x39, 0xac, 0x6d, 0xbf, 0x86, 0xc7, 0x1d, 0xcf, 0xdb, 0xd1, 0x86, 0xcf, 0x9f, 0x6c,
+	0x5f, 0x30, 0x8e, 0xcd, 0x4a, 0x6b, 0x20, 0x08, 0xb7, 0x47, 0xc0, 0xb2, 0xfa, 0x8f, 0x5b, 0x96,
+	0x3a, 0x76, 0xf6, 0x58, 0xae, 0x3f, 0x99, 0x87, 0x73, 0x6d, 0x12, 0x33, 0x4e, 0x05, 0xda, 0x87,
+	0x15, 0x5f, 0x3f, 0xb2, 0x24, 0xf3, 0x68, 0x26, 0xd0, 0xc8, 0x83, 0x25, 0x1c, 0xaa, 0x52, 0x3d,
+	0xaf, 0x06, 0xf5, 0xf5, 0x86, 0x31, 0x90, 0xc7, 0x79, 0x3c, 0xa6, 0x5d, 0x67, 0x34, 0x6a, 0x5d,
+	0x31, 0x33, 0xfa, 0xd6, 0x6b, 0xc4, 0x20, 0x0d, 0xb8, 0x6d, 0xa0, 0xd1, 0x47, 0x90, 0xa7, 0x91,
+	0x4f, 0x0e, 0xad, 0x05, 0xe5, 0xe3, 0x7f, 0x33, 0x06, 0xc1, 0x3b, 0x69, 0x1c, 0x07, 0x83, 0x51,
+	0x79, 0xd0, 0x03, 0x45, 0xeb, 0xdf, 0xc6, 0xe3, 0xc5, 0x59, 0x5a, 0x6e, 0x6b, 0xd0, 0xfa, 0x4f,
+	0xf3, 0xb0, 0xa4, 0x6b, 0x2c, 0xf2, 0x61, 0x59, 0x4f, 0xcc, 0x24, 0x7b, 0xd2, 0xc6, 0xc8, 0xff,
+	0x18, 0xce, 0x74, 0xd2, 0xaf, 0xe2, 0x6c, 0x96, 0x76, 0xcc, 0xd9, 0xd7, 0x39, 0xa8, 0xcc, 0x22,
+	0xf5, 0x15, 0x77, 0x18, 0x1b, 0xf2, 0xd3, 0xd7, 0xac, 0x37, 0xfb, 0x1a, 0x35, 0x94, 0x0a, 0x61,
+	0x56, 0x8c, 0x7f, 0x63, 0x08, 0x0c, 0x40, 0x91, 0xde, 0x51, 0x57, 0x6d, 0x0c, 0x79, 0x79, 0x8b,
+	0x1e, 0x5d, 0x59, 0x33, 0x7d, 0xab, 0x1a, 0xb9, 0xfe, 0xcd, 0x02, 0xac, 0xc8, 0x3e, 0xd4, 0x61,
+	0x34, 0x12, 0xe8, 0x12, 0x2c, 0xf5, 0x08, 0xed, 0xf6, 0xf4, 0x14, 0xb0, 0x60, 0x1b, 0x09, 0x5d,
+	0x83, 0x45, 0x79, 0xc3, 0x36, 0x37, 0xc5, 0x8d, 0x53, 0x53, 0xc7, 0xdd, 0xd1, 0xf5, 0x5b, 0x8f,
+	0x1d, 0x8f, 0xe5, 0xd8, 0xa1, 0x2c, 0xe4, 0x04, 0x9e, 0x0a, 0x1a, 0xd0, 0xcf, 0xf5, 0x18, 0xa6,
+	0x86, 0x90, 0x4c, 0x3a, 0xd0, 0xda, 0x14, 0xac, 0x2d, 0xff, 0xca, 0x86, 0x60, 0xae, 0xb5, 0xb2,
+	0x73, 0x67, 0xd2, 0x89, 0x40, 0x03, 0x4a, 0x82, 0x24, 0x3c, 0x57, 0xe7, 0x53, 0xc3, 0xe7, 0xb3,
+	0x80, 0xd7, 0x80, 0x12, 0xbe, 0xd5, 0x7e, 0xfa, 0x5b, 0x75, 0xee, 0xe9, 0x51, 0x35, 0xf7, 0xec,
+	0xa8, 0x9a, 0xfb, 0xf5, 0xa8, 0x9a, 0x7b, 0xfc, 0xb2, 0x3a, 0xf7, 0xec, 0x65, 0x75, 0xee, 0xe7,
+	0x97, 0xd5, 0xb9, 0x07, 0xd3, 0xf8, 0xf2, 0xb3, 0xdb, 0x0e, 0xb0, 0xcb, 0xd5, 0x53, 0xf3, 0x50,
+	0xff, 0x52, 0xa3, 0x7c, 0xb8, 0x4b, 0xea, 0x95, 0xbc, 0xf5, 0xd7, 0x00, 0x7a, 0x22, 0xa6, 0x25,
+	0xc3, 0x11, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -528,6 +686,114 @@ func (m *MoneyMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintHard(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAccrualInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAccrualInterval):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintHard(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.DirectLiquidationDiscount.Size()
+		i -= size
+		if _, err := m.DirectLiquidationDiscount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.DirectLiquidationThreshold.Size()
+		i -= size
+		if _, err := m.DirectLiquidationThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.IsolatedDebtDenoms) > 0 {
+		for iNdEx := len(m.IsolatedDebtDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IsolatedDebtDenoms[iNdEx])
+			copy(dAtA[i:], m.IsolatedDebtDenoms[iNdEx])
+			i = encodeVarintHard(dAtA, i, uint64(len(m.IsolatedDebtDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.Isolated {
+		i--
+		if m.Isolated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.EModeLoanToValue.Size()
+		i -= size
+		if _, err := m.EModeLoanToValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.EModeCategory) > 0 {
+		i -= len(m.EModeCategory)
+		copy(dAtA[i:], m.EModeCategory)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.EModeCategory)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	{
+		size := m.LiquidationBonus.Size()
+		i -= size
+		if _, err := m.LiquidationBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.CloseFactor.Size()
+		i -= size
+		if _, err := m.CloseFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.FlashLoanFee.Size()
+		i -= size
+		if _, err := m.FlashLoanFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size := m.KeeperRewardPercentage.Size()
 		i -= size
@@ -668,6 +934,33 @@ func (m *InterestRateModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SecondJumpMultiplier.Size()
+		i -= size
+		if _, err := m.SecondJumpMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SecondKink.Size()
+		i -= size
+		if _, err := m.SecondKink.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Model) > 0 {
+		i -= len(m.Model)
+		copy(dAtA[i:], m.Model)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Model)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.JumpMultiplier.Size()
 		i -= size
@@ -711,6 +1004,43 @@ func (m *InterestRateModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EModeAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EModeAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EModeAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -944,6 +1274,72 @@ func (m *CoinsProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RatePoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RatePoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RatePoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SupplyRate.Size()
+		i -= size
+		if _, err := m.SupplyRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BorrowRate.Size()
+		i -= size
+		if _, err := m.BorrowRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.UtilizationRatio.Size()
+		i -= size
+		if _, err := m.UtilizationRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintHard(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintHard(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintHard(dAtA []byte, offset int, v uint64) int {
 	offset -= sovHard(v)
 	base := offset
@@ -996,6 +1392,35 @@ func (m *MoneyMarket) Size() (n int) {
 	n += 1 + l + sovHard(uint64(l))
 	l = m.KeeperRewardPercentage.Size()
 	n += 1 + l + sovHard(uint64(l))
+	l = m.FlashLoanFee.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.CloseFactor.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.LiquidationBonus.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = len(m.EModeCategory)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = m.EModeLoanToValue.Size()
+	n += 1 + l + sovHard(uint64(l))
+	if m.Isolated {
+		n += 2
+	}
+	if len(m.IsolatedDebtDenoms) > 0 {
+		for _, s := range m.IsolatedDebtDenoms {
+			l = len(s)
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	l = m.DirectLiquidationThreshold.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.DirectLiquidationDiscount.Size()
+	n += 2 + l + sovHard(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAccrualInterval)
+	n += 2 + l + sovHard(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapDuration)
+	n += 2 + l + sovHard(uint64(l))
 	return n
 }
 
@@ -1029,6 +1454,31 @@ func (m *InterestRateModel) Size() (n int) {
 	n += 1 + l + sovHard(uint64(l))
 	l = m.JumpMultiplier.Size()
 	n += 1 + l + sovHard(uint64(l))
+	l = len(m.Model)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = m.SecondKink.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.SecondJumpMultiplier.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *EModeAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
 	return n
 }
 
@@ -1127,6 +1577,26 @@ func (m *CoinsProto) Size() (n int) {
 	return n
 }
 
+func (m *RatePoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovHard(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovHard(uint64(l))
+	l = m.UtilizationRatio.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.BorrowRate.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.SupplyRate.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
 func sovHard(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1512,14 +1982,368 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipHard(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlashLoanFee", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthHard
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlashLoanFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CloseFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationBonus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EModeCategory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EModeCategory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EModeLoanToValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EModeLoanToValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Isolated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Isolated = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolatedDebtDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsolatedDebtDenoms = append(m.IsolatedDebtDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectLiquidationThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DirectLiquidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectLiquidationDiscount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DirectLiquidationDiscount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAccrualInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxAccrualInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -1702,7 +2526,175 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseRateAPY", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRateAPY", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseRateAPY.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kink", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Kink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JumpMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JumpMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Model = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondKink", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1730,13 +2722,13 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseRateAPY.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecondKink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondJumpMultiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1764,13 +2756,63 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecondJumpMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EModeAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EModeAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EModeAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1798,13 +2840,11 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Kink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JumpMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1832,9 +2872,7 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.JumpMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Category = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2473,6 +3511,210 @@ func (m *CoinsProto) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RatePoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RatePoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RatePoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UtilizationRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UtilizationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BorrowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipHard(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
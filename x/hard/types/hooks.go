@@ -1,6 +1,9 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // MultiHARDHooks combine multiple HARD hooks, all hook functions are run in array sequence
 type MultiHARDHooks []HARDHooks
@@ -51,3 +54,10 @@ func (h MultiHARDHooks) AfterBorrowModified(ctx sdk.Context, borrow Borrow) {
 		h[i].AfterBorrowModified(ctx, borrow)
 	}
 }
+
+// AfterInterestAccrued runs after interest is accrued for a money market
+func (h MultiHARDHooks) AfterInterestAccrued(ctx sdk.Context, denom string, suppliedInterest, reserveInterest sdkmath.Int) {
+	for i := range h {
+		h[i].AfterInterestAccrued(ctx, denom, suppliedInterest, reserveInterest)
+	}
+}
@@ -0,0 +1,15 @@
+package types
+
+// CanBackBorrow returns true if deposits of the money market can back borrows of the denom. Deposits of isolated
+// money markets can only back borrows of the market's isolated debt denoms.
+func (mm MoneyMarket) CanBackBorrow(denom string) bool {
+	if !mm.Isolated {
+		return true
+	}
+	for _, debtDenom := range mm.IsolatedDebtDenoms {
+		if debtDenom == denom {
+			return true
+		}
+	}
+	return false
+}
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName name that will be used throughout the module
 	ModuleName = "hard"
@@ -10,6 +14,9 @@ const (
 	// StoreKey Top level store key where all module items will be stored
 	StoreKey = ModuleName
 
+	// TransientStoreKey key for the transient store, which is cleared at the end of every block
+	TransientStoreKey = "transient_" + ModuleName
+
 	// RouterKey Top level router key
 	RouterKey = ModuleName
 
@@ -28,6 +35,14 @@ var (
 	BorrowInterestFactorPrefix    = []byte{0x08} // denom -> sdk.Dec
 	SupplyInterestFactorPrefix    = []byte{0x09} // denom -> sdk.Dec
 	DelegatorInterestFactorPrefix = []byte{0x10} // denom -> sdk.Dec
+	EModeCategoryPrefix           = []byte{0x11} // address -> e-mode category
+	RateHistoryPrefix             = []byte{0x12} // denom -> slot -> RatePoint
+	RateHistoryCountPrefix        = []byte{0x13} // denom -> number of rate points recorded
+)
+
+// Transient store key prefixes
+var (
+	AccruedMarketsPrefix = []byte{0x01} // denom -> block time, for money markets that accrued interest in the current block
 )
 
 // DepositTypeIteratorKey returns an interator prefix for interating over deposits by deposit denom
@@ -35,6 +50,17 @@ func DepositTypeIteratorKey(denom string) []byte {
 	return createKey([]byte(denom))
 }
 
+// RateHistoryKey returns the key of a rate point slot in a denom's rate history
+func RateHistoryKey(denom string, slot uint64) []byte {
+	return createKey(RateHistoryDenomKey(denom), sdk.Uint64ToBigEndian(slot))
+}
+
+// RateHistoryDenomKey returns the key prefix of a denom's rate history. The denom is terminated by a zero byte so
+// that no denom's history is a prefix of another's.
+func RateHistoryDenomKey(denom string) []byte {
+	return createKey([]byte(denom), []byte{0x00})
+}
+
 func createKey(bytes ...[]byte) (r []byte) {
 	for _, b := range bytes {
 		r = append(r, b...)
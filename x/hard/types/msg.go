@@ -2,8 +2,11 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
 )
 
 // ensure Msg interface compliance at compile time
@@ -13,6 +16,15 @@ var (
 	_ sdk.Msg = &MsgBorrow{}
 	_ sdk.Msg = &MsgRepay{}
 	_ sdk.Msg = &MsgLiquidate{}
+	_ sdk.Msg = &MsgFlashBorrow{}
+	_ sdk.Msg = &MsgSetEMode{}
+	_ sdk.Msg = &MsgDepositAndBorrow{}
+	_ sdk.Msg = &MsgRepayAndWithdraw{}
+	_ sdk.Msg = &MsgLiquidateDirect{}
+	_ sdk.Msg = &MsgWithdrawReserves{}
+	_ sdk.Msg = &MsgTransferPosition{}
+
+	_ codectypes.UnpackInterfacesMessage = &MsgFlashBorrow{}
 )
 
 // NewMsgDeposit returns a new MsgDeposit
@@ -226,3 +238,381 @@ func (msg MsgLiquidate) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{keeper}
 }
+
+// FlashLoanAllowedMsgTypeURLs are the type urls of the msgs that can be executed within a flash loan.
+// Msgs executed within a flash loan skip the ante handler, so only msgs that move funds between the borrower and
+// kava's money markets, pools, and cdps are allowed. Notably, nested flash loans and authz msgs are not allowed.
+var FlashLoanAllowedMsgTypeURLs = []string{
+	"/cosmos.bank.v1beta1.MsgSend",
+
+	"/kava.hard.v1beta1.MsgDeposit",
+	"/kava.hard.v1beta1.MsgWithdraw",
+	"/kava.hard.v1beta1.MsgBorrow",
+	"/kava.hard.v1beta1.MsgRepay",
+	"/kava.hard.v1beta1.MsgLiquidate",
+	"/kava.hard.v1beta1.MsgDepositAndBorrow",
+	"/kava.hard.v1beta1.MsgRepayAndWithdraw",
+	"/kava.hard.v1beta1.MsgLiquidateDirect",
+
+	"/kava.swap.v1beta1.MsgDeposit",
+	"/kava.swap.v1beta1.MsgDepositSingleSided",
+	"/kava.swap.v1beta1.MsgWithdraw",
+	"/kava.swap.v1beta1.MsgSwapExactForTokens",
+	"/kava.swap.v1beta1.MsgSwapForExactTokens",
+	"/kava.swap.v1beta1.MsgSwapExactForTokensRoute",
+
+	"/kava.cdp.v1beta1.MsgCreateCDP",
+	"/kava.cdp.v1beta1.MsgDeposit",
+	"/kava.cdp.v1beta1.MsgWithdraw",
+	"/kava.cdp.v1beta1.MsgDrawDebt",
+	"/kava.cdp.v1beta1.MsgRepayDebt",
+	"/kava.cdp.v1beta1.MsgLiquidate",
+}
+
+// IsFlashLoanMsgAllowed returns true if a msg type can be executed within a flash loan.
+func IsFlashLoanMsgAllowed(msgTypeURL string) bool {
+	for _, allowed := range FlashLoanAllowedMsgTypeURLs {
+		if msgTypeURL == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// NewMsgFlashBorrow returns a new MsgFlashBorrow
+func NewMsgFlashBorrow(borrower sdk.AccAddress, amount sdk.Coins, msgs []sdk.Msg) (MsgFlashBorrow, error) {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return MsgFlashBorrow{}, err
+	}
+	return MsgFlashBorrow{
+		Borrower: borrower.String(),
+		Amount:   amount,
+		Msgs:     anys,
+	}, nil
+}
+
+// GetMessages returns the msgs executed with the flash loan.
+func (msg MsgFlashBorrow) GetMessages() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Msgs, "MsgFlashBorrow")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgFlashBorrow) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, msg.Msgs)
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgFlashBorrow) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgFlashBorrow) Type() string { return "hard_flash_borrow" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgFlashBorrow) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "flash borrow amount %s", msg.Amount)
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+	if len(msgs) == 0 {
+		return errorsmod.Wrap(ErrInvalidFlashLoanMsg, "flash loan must execute at least one msg")
+	}
+	for i, m := range msgs {
+		if !IsFlashLoanMsgAllowed(sdk.MsgTypeURL(m)) {
+			return errorsmod.Wrapf(ErrInvalidFlashLoanMsg, "msg %d type %s cannot be executed within a flash loan", i, sdk.MsgTypeURL(m))
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+// The authz amino codec is used as every module registers its msgs on it, so the inner msgs can be serialized.
+func (msg MsgFlashBorrow) GetSignBytes() []byte {
+	bz := authzcodec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgFlashBorrow) GetSigners() []sdk.AccAddress {
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{borrower}
+}
+
+// NewMsgSetEMode returns a new MsgSetEMode
+func NewMsgSetEMode(sender sdk.AccAddress, category string) MsgSetEMode {
+	return MsgSetEMode{
+		Sender:   sender.String(),
+		Category: category,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSetEMode) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSetEMode) Type() string { return "hard_set_emode" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSetEMode) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSetEMode) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSetEMode) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgDepositAndBorrow returns a new MsgDepositAndBorrow
+func NewMsgDepositAndBorrow(depositor sdk.AccAddress, depositAmount, borrowAmount sdk.Coins) MsgDepositAndBorrow {
+	return MsgDepositAndBorrow{
+		Depositor:     depositor.String(),
+		DepositAmount: depositAmount,
+		BorrowAmount:  borrowAmount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgDepositAndBorrow) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgDepositAndBorrow) Type() string { return "hard_deposit_and_borrow" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgDepositAndBorrow) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !msg.DepositAmount.IsValid() || msg.DepositAmount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "deposit amount %s", msg.DepositAmount)
+	}
+	if !msg.BorrowAmount.IsValid() || msg.BorrowAmount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "borrow amount %s", msg.BorrowAmount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgDepositAndBorrow) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgDepositAndBorrow) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{depositor}
+}
+
+// NewMsgRepayAndWithdraw returns a new MsgRepayAndWithdraw
+func NewMsgRepayAndWithdraw(sender sdk.AccAddress, repayAmount, withdrawAmount sdk.Coins) MsgRepayAndWithdraw {
+	return MsgRepayAndWithdraw{
+		Sender:         sender.String(),
+		RepayAmount:    repayAmount,
+		WithdrawAmount: withdrawAmount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRepayAndWithdraw) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRepayAndWithdraw) Type() string { return "hard_repay_and_withdraw" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRepayAndWithdraw) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !msg.RepayAmount.IsValid() || msg.RepayAmount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "repay amount %s", msg.RepayAmount)
+	}
+	if !msg.WithdrawAmount.IsValid() || msg.WithdrawAmount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "withdraw amount %s", msg.WithdrawAmount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRepayAndWithdraw) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRepayAndWithdraw) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgLiquidateDirect returns a new MsgLiquidateDirect
+func NewMsgLiquidateDirect(liquidator, borrower sdk.AccAddress) MsgLiquidateDirect {
+	return MsgLiquidateDirect{
+		Liquidator: liquidator.String(),
+		Borrower:   borrower.String(),
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgLiquidateDirect) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgLiquidateDirect) Type() string { return "hard_liquidate_direct" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgLiquidateDirect) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Liquidator)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgLiquidateDirect) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgLiquidateDirect) GetSigners() []sdk.AccAddress {
+	liquidator, err := sdk.AccAddressFromBech32(msg.Liquidator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{liquidator}
+}
+
+// NewMsgWithdrawReserves returns a new MsgWithdrawReserves
+func NewMsgWithdrawReserves(authority string, amount sdk.Coin, recipientModule string) MsgWithdrawReserves {
+	return MsgWithdrawReserves{
+		Authority:       authority,
+		Amount:          amount,
+		RecipientModule: recipientModule,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgWithdrawReserves) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgWithdrawReserves) Type() string { return "hard_withdraw_reserves" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgWithdrawReserves) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "withdraw amount %s", msg.Amount)
+	}
+	if msg.RecipientModule == ModuleAccountName {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "reserves cannot be withdrawn to the %s module", ModuleAccountName)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgWithdrawReserves) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgWithdrawReserves) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgTransferPosition returns a new MsgTransferPosition
+func NewMsgTransferPosition(sender, recipient sdk.AccAddress) MsgTransferPosition {
+	return MsgTransferPosition{
+		Sender:    sender.String(),
+		Recipient: recipient.String(),
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgTransferPosition) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgTransferPosition) Type() string { return "hard_transfer_position" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgTransferPosition) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if sender.Equals(recipient) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "sender and recipient cannot be the same")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgTransferPosition) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+// The recipient must sign as the sender's borrows are added to its position.
+func (msg MsgTransferPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender, recipient}
+}
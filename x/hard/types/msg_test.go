@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/kava-labs/kava/x/hard/types"
 )
@@ -190,6 +191,373 @@ func (suite *MsgTestSuite) TestMsgRepay() {
 	}
 }
 
+func (suite *MsgTestSuite) TestMsgFlashBorrow() {
+	type args struct {
+		borrower sdk.AccAddress
+		amount   sdk.Coins
+		msgs     []sdk.Msg
+	}
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("test1"),
+		sdk.AccAddress("test2"),
+	}
+	sendMsg := banktypes.NewMsgSend(addrs[0], addrs[1], sdk.NewCoins(sdk.NewCoin("test", sdkmath.NewInt(1000000))))
+	testCases := []struct {
+		name        string
+		args        args
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			args: args{
+				borrower: addrs[0],
+				amount:   sdk.NewCoins(sdk.NewCoin("test", sdkmath.NewInt(1000000))),
+				msgs:     []sdk.Msg{sendMsg},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: empty amount",
+			args: args{
+				borrower: addrs[0],
+				amount:   sdk.NewCoins(),
+				msgs:     []sdk.Msg{sendMsg},
+			},
+			expectPass:  false,
+			expectedErr: "flash borrow amount",
+		},
+		{
+			name: "invalid: no msgs",
+			args: args{
+				borrower: addrs[0],
+				amount:   sdk.NewCoins(sdk.NewCoin("test", sdkmath.NewInt(1000000))),
+				msgs:     []sdk.Msg{},
+			},
+			expectPass:  false,
+			expectedErr: "flash loan must execute at least one msg",
+		},
+		{
+			name: "invalid: invalid inner msg",
+			args: args{
+				borrower: addrs[0],
+				amount:   sdk.NewCoins(sdk.NewCoin("test", sdkmath.NewInt(1000000))),
+				msgs:     []sdk.Msg{banktypes.NewMsgSend(addrs[0], addrs[1], sdk.Coins{})},
+			},
+			expectPass:  false,
+			expectedErr: "invalid coins",
+		},
+		{
+			name: "invalid: nested flash borrow",
+			args: args{
+				borrower: addrs[0],
+				amount:   sdk.NewCoins(sdk.NewCoin("test", sdkmath.NewInt(1000000))),
+				msgs:     []sdk.Msg{&types.MsgFlashBorrow{Borrower: addrs[0].String()}},
+			},
+			expectPass:  false,
+			expectedErr: "cannot be executed within a flash loan",
+		},
+		{
+			name: "invalid: msg type not allowed",
+			args: args{
+				borrower: addrs[0],
+				amount:   sdk.NewCoins(sdk.NewCoin("test", sdkmath.NewInt(1000000))),
+				msgs:     []sdk.Msg{banktypes.NewMsgMultiSend([]banktypes.Input{banktypes.NewInput(addrs[0], sdk.NewCoins(sdk.NewCoin("test", sdkmath.NewInt(1))))}, []banktypes.Output{banktypes.NewOutput(addrs[1], sdk.NewCoins(sdk.NewCoin("test", sdkmath.NewInt(1))))})},
+			},
+			expectPass:  false,
+			expectedErr: "cannot be executed within a flash loan",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg, err := types.NewMsgFlashBorrow(tc.args.borrower, tc.args.amount, tc.args.msgs)
+			suite.Require().NoError(err)
+			err = msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+				suite.NotPanics(func() { msg.GetSignBytes() })
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgSetEMode() {
+	testCases := []struct {
+		name        string
+		msg         types.MsgSetEMode
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name:        "valid",
+			msg:         types.NewMsgSetEMode(sdk.AccAddress("test1"), "usd"),
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name:        "valid: disable e-mode",
+			msg:         types.NewMsgSetEMode(sdk.AccAddress("test1"), ""),
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name:        "invalid: empty sender",
+			msg:         types.MsgSetEMode{Category: "usd"},
+			expectPass:  false,
+			expectedErr: "invalid address",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgDepositAndBorrow() {
+	coins := sdk.NewCoins(sdk.NewCoin("test", sdkmath.NewInt(1000000)))
+	testCases := []struct {
+		name        string
+		msg         types.MsgDepositAndBorrow
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name:        "valid",
+			msg:         types.NewMsgDepositAndBorrow(sdk.AccAddress("test1"), coins, coins),
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name:        "invalid: empty depositor",
+			msg:         types.MsgDepositAndBorrow{DepositAmount: coins, BorrowAmount: coins},
+			expectPass:  false,
+			expectedErr: "invalid address",
+		},
+		{
+			name:        "invalid: empty deposit amount",
+			msg:         types.NewMsgDepositAndBorrow(sdk.AccAddress("test1"), sdk.NewCoins(), coins),
+			expectPass:  false,
+			expectedErr: "deposit amount",
+		},
+		{
+			name:        "invalid: empty borrow amount",
+			msg:         types.NewMsgDepositAndBorrow(sdk.AccAddress("test1"), coins, sdk.NewCoins()),
+			expectPass:  false,
+			expectedErr: "borrow amount",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgRepayAndWithdraw() {
+	coins := sdk.NewCoins(sdk.NewCoin("test", sdkmath.NewInt(1000000)))
+	testCases := []struct {
+		name        string
+		msg         types.MsgRepayAndWithdraw
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name:        "valid",
+			msg:         types.NewMsgRepayAndWithdraw(sdk.AccAddress("test1"), coins, coins),
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name:        "invalid: empty sender",
+			msg:         types.MsgRepayAndWithdraw{RepayAmount: coins, WithdrawAmount: coins},
+			expectPass:  false,
+			expectedErr: "invalid address",
+		},
+		{
+			name:        "invalid: empty repay amount",
+			msg:         types.NewMsgRepayAndWithdraw(sdk.AccAddress("test1"), sdk.NewCoins(), coins),
+			expectPass:  false,
+			expectedErr: "repay amount",
+		},
+		{
+			name:        "invalid: empty withdraw amount",
+			msg:         types.NewMsgRepayAndWithdraw(sdk.AccAddress("test1"), coins, sdk.NewCoins()),
+			expectPass:  false,
+			expectedErr: "withdraw amount",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgLiquidateDirect() {
+	testCases := []struct {
+		name        string
+		msg         types.MsgLiquidateDirect
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name:        "valid",
+			msg:         types.NewMsgLiquidateDirect(sdk.AccAddress("test1"), sdk.AccAddress("test2")),
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name:        "invalid: empty liquidator",
+			msg:         types.MsgLiquidateDirect{Borrower: sdk.AccAddress("test2").String()},
+			expectPass:  false,
+			expectedErr: "invalid address",
+		},
+		{
+			name:        "invalid: empty borrower",
+			msg:         types.MsgLiquidateDirect{Liquidator: sdk.AccAddress("test1").String()},
+			expectPass:  false,
+			expectedErr: "invalid address",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgWithdrawReserves() {
+	authority := sdk.AccAddress("test1").String()
+	testCases := []struct {
+		name        string
+		msg         types.MsgWithdrawReserves
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name:        "valid",
+			msg:         types.NewMsgWithdrawReserves(authority, sdk.NewInt64Coin("ukava", 1), ""),
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name:        "valid: recipient module",
+			msg:         types.NewMsgWithdrawReserves(authority, sdk.NewInt64Coin("ukava", 1), "kavadist"),
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name:        "invalid: empty authority",
+			msg:         types.NewMsgWithdrawReserves("", sdk.NewInt64Coin("ukava", 1), ""),
+			expectPass:  false,
+			expectedErr: "invalid address",
+		},
+		{
+			name:        "invalid: zero amount",
+			msg:         types.NewMsgWithdrawReserves(authority, sdk.NewInt64Coin("ukava", 0), ""),
+			expectPass:  false,
+			expectedErr: "invalid coins",
+		},
+		{
+			name:        "invalid: hard recipient module",
+			msg:         types.NewMsgWithdrawReserves(authority, sdk.NewInt64Coin("ukava", 1), types.ModuleAccountName),
+			expectPass:  false,
+			expectedErr: "reserves cannot be withdrawn to the hard module",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgTransferPosition() {
+	testCases := []struct {
+		name        string
+		msg         types.MsgTransferPosition
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name:        "valid",
+			msg:         types.NewMsgTransferPosition(sdk.AccAddress("test1"), sdk.AccAddress("test2")),
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name:        "invalid: empty sender",
+			msg:         types.MsgTransferPosition{Recipient: sdk.AccAddress("test2").String()},
+			expectPass:  false,
+			expectedErr: "invalid address",
+		},
+		{
+			name:        "invalid: empty recipient",
+			msg:         types.MsgTransferPosition{Sender: sdk.AccAddress("test1").String()},
+			expectPass:  false,
+			expectedErr: "invalid address",
+		},
+		{
+			name:        "invalid: sender is recipient",
+			msg:         types.NewMsgTransferPosition(sdk.AccAddress("test1"), sdk.AccAddress("test1")),
+			expectPass:  false,
+			expectedErr: "sender and recipient cannot be the same",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgTransferPosition_GetSigners() {
+	sender, recipient := sdk.AccAddress("test1"), sdk.AccAddress("test2")
+	msg := types.NewMsgTransferPosition(sender, recipient)
+	suite.Equal([]sdk.AccAddress{sender, recipient}, msg.GetSigners())
+}
+
 func TestMsgTestSuite(t *testing.T) {
 	suite.Run(t, new(MsgTestSuite))
 }
@@ -65,13 +65,19 @@ func NewMoneyMarket(denom string, borrowLimit BorrowLimit, spotMarketID string,
 	interestRateModel InterestRateModel, reserveFactor, keeperRewardPercentage sdk.Dec,
 ) MoneyMarket {
 	return MoneyMarket{
-		Denom:                  denom,
-		BorrowLimit:            borrowLimit,
-		SpotMarketID:           spotMarketID,
-		ConversionFactor:       conversionFactor,
-		InterestRateModel:      interestRateModel,
-		ReserveFactor:          reserveFactor,
-		KeeperRewardPercentage: keeperRewardPercentage,
+		Denom:                      denom,
+		BorrowLimit:                borrowLimit,
+		SpotMarketID:               spotMarketID,
+		ConversionFactor:           conversionFactor,
+		InterestRateModel:          interestRateModel,
+		ReserveFactor:              reserveFactor,
+		KeeperRewardPercentage:     keeperRewardPercentage,
+		FlashLoanFee:               sdk.ZeroDec(),
+		CloseFactor:                sdk.ZeroDec(),
+		LiquidationBonus:           sdk.ZeroDec(),
+		EModeLoanToValue:           sdk.ZeroDec(),
+		DirectLiquidationThreshold: sdk.ZeroDec(),
+		DirectLiquidationDiscount:  sdk.ZeroDec(),
 	}
 }
 
@@ -101,6 +107,63 @@ func (mm MoneyMarket) Validate() error {
 		return fmt.Errorf("keeper reward percentage must be between 0.0-1.0")
 	}
 
+	// flash loan fees are unset on money markets that predate them, which charge no fee
+	if !mm.FlashLoanFee.IsNil() && (mm.FlashLoanFee.IsNegative() || mm.FlashLoanFee.GT(sdk.OneDec())) {
+		return fmt.Errorf("flash loan fee must be between 0.0-1.0")
+	}
+
+	// close factors and liquidation bonuses are unset on money markets that predate partial liquidations
+	if !mm.CloseFactor.IsNil() && (mm.CloseFactor.IsNegative() || mm.CloseFactor.GT(sdk.OneDec())) {
+		return fmt.Errorf("close factor must be between 0.0-1.0")
+	}
+
+	if !mm.LiquidationBonus.IsNil() && (mm.LiquidationBonus.IsNegative() || mm.LiquidationBonus.GT(sdk.OneDec())) {
+		return fmt.Errorf("liquidation bonus must be between 0.0-1.0")
+	}
+
+	if mm.EModeCategory != "" {
+		if mm.EModeLoanToValue.IsNil() || mm.EModeLoanToValue.LT(mm.BorrowLimit.LoanToValue) || mm.EModeLoanToValue.GT(sdk.OneDec()) {
+			return fmt.Errorf("e-mode loan to value must be between the loan to value and 1.0")
+		}
+	} else if !mm.EModeLoanToValue.IsNil() && !mm.EModeLoanToValue.IsZero() {
+		return fmt.Errorf("e-mode loan to value requires an e-mode category")
+	}
+
+	if mm.Isolated {
+		if len(mm.IsolatedDebtDenoms) == 0 {
+			return fmt.Errorf("isolated money market %s must have at least one isolated debt denom", mm.Denom)
+		}
+		seenDenoms := make(map[string]bool)
+		for _, denom := range mm.IsolatedDebtDenoms {
+			if err := sdk.ValidateDenom(denom); err != nil {
+				return fmt.Errorf("invalid isolated debt denom: %w", err)
+			}
+			if seenDenoms[denom] {
+				return fmt.Errorf("duplicate isolated debt denom %s", denom)
+			}
+			seenDenoms[denom] = true
+		}
+	} else if len(mm.IsolatedDebtDenoms) > 0 {
+		return fmt.Errorf("isolated debt denoms require the money market to be isolated")
+	}
+
+	// direct liquidation thresholds and discounts are unset on money markets that predate direct liquidations
+	if !mm.DirectLiquidationThreshold.IsNil() && mm.DirectLiquidationThreshold.IsNegative() {
+		return fmt.Errorf("direct liquidation threshold cannot be negative")
+	}
+
+	if !mm.DirectLiquidationDiscount.IsNil() && (mm.DirectLiquidationDiscount.IsNegative() || mm.DirectLiquidationDiscount.GTE(sdk.OneDec())) {
+		return fmt.Errorf("direct liquidation discount must be between 0.0-1.0 exclusive of 1.0")
+	}
+
+	if mm.MaxAccrualInterval < 0 {
+		return fmt.Errorf("max accrual interval cannot be negative")
+	}
+
+	if mm.TwapDuration < 0 {
+		return fmt.Errorf("twap duration cannot be negative")
+	}
+
 	return nil
 }
 
@@ -127,6 +190,44 @@ func (mm MoneyMarket) Equal(mmCompareTo MoneyMarket) bool {
 	if !mm.KeeperRewardPercentage.Equal(mmCompareTo.KeeperRewardPercentage) {
 		return false
 	}
+	if !decEqualOrUnset(mm.FlashLoanFee, mmCompareTo.FlashLoanFee) {
+		return false
+	}
+	if !decEqualOrUnset(mm.CloseFactor, mmCompareTo.CloseFactor) {
+		return false
+	}
+	if !decEqualOrUnset(mm.LiquidationBonus, mmCompareTo.LiquidationBonus) {
+		return false
+	}
+	if mm.EModeCategory != mmCompareTo.EModeCategory {
+		return false
+	}
+	if !decEqualOrUnset(mm.EModeLoanToValue, mmCompareTo.EModeLoanToValue) {
+		return false
+	}
+	if mm.Isolated != mmCompareTo.Isolated {
+		return false
+	}
+	if len(mm.IsolatedDebtDenoms) != len(mmCompareTo.IsolatedDebtDenoms) {
+		return false
+	}
+	for i, denom := range mm.IsolatedDebtDenoms {
+		if denom != mmCompareTo.IsolatedDebtDenoms[i] {
+			return false
+		}
+	}
+	if !decEqualOrUnset(mm.DirectLiquidationThreshold, mmCompareTo.DirectLiquidationThreshold) {
+		return false
+	}
+	if !decEqualOrUnset(mm.DirectLiquidationDiscount, mmCompareTo.DirectLiquidationDiscount) {
+		return false
+	}
+	if mm.MaxAccrualInterval != mmCompareTo.MaxAccrualInterval {
+		return false
+	}
+	if mm.TwapDuration != mmCompareTo.TwapDuration {
+		return false
+	}
 	return true
 }
 
@@ -146,10 +247,25 @@ func (mms MoneyMarkets) Validate() error {
 // NewInterestRateModel returns a new InterestRateModel
 func NewInterestRateModel(baseRateAPY, baseMultiplier, kink, jumpMultiplier sdk.Dec) InterestRateModel {
 	return InterestRateModel{
-		BaseRateAPY:    baseRateAPY,
-		BaseMultiplier: baseMultiplier,
-		Kink:           kink,
-		JumpMultiplier: jumpMultiplier,
+		BaseRateAPY:          baseRateAPY,
+		BaseMultiplier:       baseMultiplier,
+		Kink:                 kink,
+		JumpMultiplier:       jumpMultiplier,
+		SecondKink:           sdk.ZeroDec(),
+		SecondJumpMultiplier: sdk.ZeroDec(),
+	}
+}
+
+// NewTwoKinkInterestRateModel returns a new InterestRateModel using the two kink rate model
+func NewTwoKinkInterestRateModel(baseRateAPY, baseMultiplier, kink, jumpMultiplier, secondKink, secondJumpMultiplier sdk.Dec) InterestRateModel {
+	return InterestRateModel{
+		BaseRateAPY:          baseRateAPY,
+		BaseMultiplier:       baseMultiplier,
+		Kink:                 kink,
+		JumpMultiplier:       jumpMultiplier,
+		Model:                InterestRateModelTwoKink,
+		SecondKink:           secondKink,
+		SecondJumpMultiplier: secondJumpMultiplier,
 	}
 }
 
@@ -171,6 +287,20 @@ func (irm InterestRateModel) Validate() error {
 		return fmt.Errorf("jump multiplier must not be negative")
 	}
 
+	if _, err := irm.RateModel(); err != nil {
+		return err
+	}
+
+	if irm.Model == InterestRateModelTwoKink {
+		if irm.SecondKink.IsNil() || irm.SecondKink.LT(irm.Kink) || irm.SecondKink.GT(sdk.OneDec()) {
+			return fmt.Errorf("second kink must be in the inclusive range kink-1.0")
+		}
+
+		if irm.SecondJumpMultiplier.IsNil() || irm.SecondJumpMultiplier.IsNegative() {
+			return fmt.Errorf("second jump multiplier must not be negative")
+		}
+	}
+
 	return nil
 }
 
@@ -188,9 +318,30 @@ func (irm InterestRateModel) Equal(irmCompareTo InterestRateModel) bool {
 	if !irm.JumpMultiplier.Equal(irmCompareTo.JumpMultiplier) {
 		return false
 	}
+	if irm.Model != irmCompareTo.Model {
+		return false
+	}
+	// second kink fields are unset on models that predate them
+	if !decEqualOrUnset(irm.SecondKink, irmCompareTo.SecondKink) {
+		return false
+	}
+	if !decEqualOrUnset(irm.SecondJumpMultiplier, irmCompareTo.SecondJumpMultiplier) {
+		return false
+	}
 	return true
 }
 
+// decEqualOrUnset compares two decimals, treating unset decimals as zero.
+func decEqualOrUnset(a, b sdk.Dec) bool {
+	if a.IsNil() {
+		a = sdk.ZeroDec()
+	}
+	if b.IsNil() {
+		b = sdk.ZeroDec()
+	}
+	return a.Equal(b)
+}
+
 // InterestRateModels slice of InterestRateModel
 type InterestRateModels []InterestRateModel
 
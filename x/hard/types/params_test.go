@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -57,6 +58,323 @@ func (suite *ParamTestSuite) TestParamValidation() {
 			expectPass:  false,
 			expectedErr: "conversion '0' factor must be ≥ one",
 		},
+		{
+			name: "invalid: flash loan fee > one",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "btcb",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.5"),
+						),
+						SpotMarketID:           "btc:usd",
+						ConversionFactor:       sdkmath.NewInt(100000000),
+						InterestRateModel:      types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						FlashLoanFee:           sdk.MustNewDecFromStr("1.01"),
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "flash loan fee must be between 0.0-1.0",
+		},
+		{
+			name: "valid: e-mode category",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "usdx",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.8"),
+						),
+						SpotMarketID:           "usdx:usd",
+						ConversionFactor:       sdkmath.NewInt(1000000),
+						InterestRateModel:      types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						EModeCategory:          "usd",
+						EModeLoanToValue:       sdk.MustNewDecFromStr("0.95"),
+					},
+				},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: e-mode loan to value below loan to value",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "usdx",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.8"),
+						),
+						SpotMarketID:           "usdx:usd",
+						ConversionFactor:       sdkmath.NewInt(1000000),
+						InterestRateModel:      types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						EModeCategory:          "usd",
+						EModeLoanToValue:       sdk.MustNewDecFromStr("0.7"),
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "e-mode loan to value must be between the loan to value and 1.0",
+		},
+		{
+			name: "invalid: e-mode loan to value without category",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "usdx",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.8"),
+						),
+						SpotMarketID:           "usdx:usd",
+						ConversionFactor:       sdkmath.NewInt(1000000),
+						InterestRateModel:      types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						EModeLoanToValue:       sdk.MustNewDecFromStr("0.95"),
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "e-mode loan to value requires an e-mode category",
+		},
+		{
+			name: "valid: isolated market",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "ukava",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.6"),
+						),
+						SpotMarketID:           "kava:usd",
+						ConversionFactor:       sdkmath.NewInt(1000000),
+						InterestRateModel:      types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						Isolated:               true,
+						IsolatedDebtDenoms:     []string{"usdx", "busd"},
+					},
+				},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: isolated market without debt denoms",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "ukava",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.6"),
+						),
+						SpotMarketID:           "kava:usd",
+						ConversionFactor:       sdkmath.NewInt(1000000),
+						InterestRateModel:      types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						Isolated:               true,
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "must have at least one isolated debt denom",
+		},
+		{
+			name: "invalid: isolated market with duplicate debt denoms",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "ukava",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.6"),
+						),
+						SpotMarketID:           "kava:usd",
+						ConversionFactor:       sdkmath.NewInt(1000000),
+						InterestRateModel:      types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						Isolated:               true,
+						IsolatedDebtDenoms:     []string{"usdx", "usdx"},
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "duplicate isolated debt denom usdx",
+		},
+		{
+			name: "invalid: debt denoms without isolated market",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "ukava",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.6"),
+						),
+						SpotMarketID:           "kava:usd",
+						ConversionFactor:       sdkmath.NewInt(1000000),
+						InterestRateModel:      types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						IsolatedDebtDenoms:     []string{"usdx"},
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "isolated debt denoms require the money market to be isolated",
+		},
+		{
+			name: "valid: direct liquidation",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "ukava",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.6"),
+						),
+						SpotMarketID:               "kava:usd",
+						ConversionFactor:           sdkmath.NewInt(1000000),
+						InterestRateModel:          types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+						ReserveFactor:              sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage:     sdk.MustNewDecFromStr("0.05"),
+						DirectLiquidationThreshold: sdk.MustNewDecFromStr("100"),
+						DirectLiquidationDiscount:  sdk.MustNewDecFromStr("0.05"),
+					},
+				},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: negative direct liquidation threshold",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "ukava",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.6"),
+						),
+						SpotMarketID:               "kava:usd",
+						ConversionFactor:           sdkmath.NewInt(1000000),
+						InterestRateModel:          types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+						ReserveFactor:              sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage:     sdk.MustNewDecFromStr("0.05"),
+						DirectLiquidationThreshold: sdk.MustNewDecFromStr("-1"),
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "direct liquidation threshold cannot be negative",
+		},
+		{
+			name: "invalid: direct liquidation discount of one",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "ukava",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.6"),
+						),
+						SpotMarketID:              "kava:usd",
+						ConversionFactor:          sdkmath.NewInt(1000000),
+						InterestRateModel:         types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+						ReserveFactor:             sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage:    sdk.MustNewDecFromStr("0.05"),
+						DirectLiquidationDiscount: sdk.OneDec(),
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "direct liquidation discount must be between 0.0-1.0 exclusive of 1.0",
+		},
+		{
+			name: "invalid: negative max accrual interval",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "ukava",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.6"),
+						),
+						SpotMarketID:           "kava:usd",
+						ConversionFactor:       sdkmath.NewInt(1000000),
+						InterestRateModel:      types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						MaxAccrualInterval:     -time.Hour,
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "max accrual interval cannot be negative",
+		},
+		{
+			name: "invalid: negative twap duration",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					{
+						Denom: "ukava",
+						BorrowLimit: types.NewBorrowLimit(
+							false,
+							sdk.MustNewDecFromStr("100000000000"),
+							sdk.MustNewDecFromStr("0.6"),
+						),
+						SpotMarketID:           "kava:usd",
+						ConversionFactor:       sdkmath.NewInt(1000000),
+						InterestRateModel:      types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
+						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
+						TwapDuration:           -time.Hour,
+					},
+				},
+			},
+			expectPass:  false,
+			expectedErr: "twap duration cannot be negative",
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RateHistoryLength is the maximum number of rate points stored for each money market. Once reached, each new rate
+// point overwrites the oldest one.
+const RateHistoryLength = 1024
+
+// NewRatePoint returns a new RatePoint instance
+func NewRatePoint(height int64, blockTime time.Time, utilizationRatio, borrowRate, supplyRate sdk.Dec) RatePoint {
+	return RatePoint{
+		Height:           height,
+		Time:             blockTime,
+		UtilizationRatio: utilizationRatio,
+		BorrowRate:       borrowRate,
+		SupplyRate:       supplyRate,
+	}
+}
+
+// RatePoints is a slice of RatePoint
+type RatePoints []RatePoint
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// InterestRateModelJump selects the JumpRateModel. It is used when a money market's model is empty.
+	InterestRateModelJump = "jump"
+	// InterestRateModelTwoKink selects the TwoKinkRateModel.
+	InterestRateModelTwoKink = "two_kink"
+)
+
+// RateModel calculates a money market's borrow rate APY from its utilization ratio.
+type RateModel interface {
+	BorrowRate(utilRatio sdk.Dec) sdk.Dec
+}
+
+var (
+	_ RateModel = JumpRateModel{}
+	_ RateModel = TwoKinkRateModel{}
+)
+
+// JumpRateModel increases the borrow rate linearly with utilization, switching to a steeper slope above a kink.
+type JumpRateModel struct {
+	BaseRateAPY    sdk.Dec
+	BaseMultiplier sdk.Dec
+	Kink           sdk.Dec
+	JumpMultiplier sdk.Dec
+}
+
+// BorrowRate returns the borrow rate APY for a utilization ratio.
+func (m JumpRateModel) BorrowRate(utilRatio sdk.Dec) sdk.Dec {
+	// Calculate normal borrow rate (under kink)
+	if utilRatio.LTE(m.Kink) {
+		return utilRatio.Mul(m.BaseMultiplier).Add(m.BaseRateAPY)
+	}
+
+	// Calculate jump borrow rate (over kink)
+	normalRate := m.Kink.Mul(m.BaseMultiplier).Add(m.BaseRateAPY)
+	excessUtil := utilRatio.Sub(m.Kink)
+	return excessUtil.Mul(m.JumpMultiplier).Add(normalRate)
+}
+
+// TwoKinkRateModel is a JumpRateModel with a second, steeper slope above a second kink.
+type TwoKinkRateModel struct {
+	JumpRateModel
+	SecondKink           sdk.Dec
+	SecondJumpMultiplier sdk.Dec
+}
+
+// BorrowRate returns the borrow rate APY for a utilization ratio.
+func (m TwoKinkRateModel) BorrowRate(utilRatio sdk.Dec) sdk.Dec {
+	if utilRatio.LTE(m.SecondKink) {
+		return m.JumpRateModel.BorrowRate(utilRatio)
+	}
+
+	jumpRate := m.JumpRateModel.BorrowRate(m.SecondKink)
+	excessUtil := utilRatio.Sub(m.SecondKink)
+	return excessUtil.Mul(m.SecondJumpMultiplier).Add(jumpRate)
+}
+
+// RateModel returns the rate model selected by the interest rate model's Model.
+func (irm InterestRateModel) RateModel() (RateModel, error) {
+	jumpModel := JumpRateModel{
+		BaseRateAPY:    irm.BaseRateAPY,
+		BaseMultiplier: irm.BaseMultiplier,
+		Kink:           irm.Kink,
+		JumpMultiplier: irm.JumpMultiplier,
+	}
+
+	switch irm.Model {
+	case "", InterestRateModelJump:
+		return jumpModel, nil
+	case InterestRateModelTwoKink:
+		return TwoKinkRateModel{
+			JumpRateModel:        jumpModel,
+			SecondKink:           irm.SecondKink,
+			SecondJumpMultiplier: irm.SecondJumpMultiplier,
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid interest rate model '%s'", irm.Model)
+	}
+}
@@ -0,0 +1,104 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+func TestInterestRateModel_RateModel(t *testing.T) {
+	jumpModel := types.NewInterestRateModel(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+
+	rateModel, err := jumpModel.RateModel()
+	require.NoError(t, err)
+	require.IsType(t, types.JumpRateModel{}, rateModel)
+
+	jumpModel.Model = types.InterestRateModelJump
+	rateModel, err = jumpModel.RateModel()
+	require.NoError(t, err)
+	require.IsType(t, types.JumpRateModel{}, rateModel)
+
+	twoKinkModel := types.NewTwoKinkInterestRateModel(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("2"))
+	rateModel, err = twoKinkModel.RateModel()
+	require.NoError(t, err)
+	require.IsType(t, types.TwoKinkRateModel{}, rateModel)
+
+	// the two kink model matches the jump model up to the second kink
+	require.Equal(t, sdk.MustNewDecFromStr("0.13"), rateModel.BorrowRate(sdk.MustNewDecFromStr("0.9")))
+	require.Equal(t, sdk.MustNewDecFromStr("0.33"), rateModel.BorrowRate(sdk.OneDec()))
+
+	jumpModel.Model = "unknown"
+	_, err = jumpModel.RateModel()
+	require.Error(t, err)
+}
+
+func TestInterestRateModel_Validate(t *testing.T) {
+	newTwoKinkModel := func(secondKink, secondJumpMultiplier string) types.InterestRateModel {
+		return types.NewTwoKinkInterestRateModel(
+			sdk.ZeroDec(),
+			sdk.MustNewDecFromStr("0.1"),
+			sdk.MustNewDecFromStr("0.8"),
+			sdk.MustNewDecFromStr("0.5"),
+			sdk.MustNewDecFromStr(secondKink),
+			sdk.MustNewDecFromStr(secondJumpMultiplier),
+		)
+	}
+
+	testCases := []struct {
+		name        string
+		model       types.InterestRateModel
+		expectedErr string
+	}{
+		{
+			name:  "valid jump model",
+			model: types.NewInterestRateModel(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5")),
+		},
+		{
+			name:  "valid two kink model",
+			model: newTwoKinkModel("0.9", "2"),
+		},
+		{
+			name:  "valid two kink model with equal kinks",
+			model: newTwoKinkModel("0.8", "2"),
+		},
+		{
+			name: "invalid model",
+			model: types.InterestRateModel{
+				BaseRateAPY:    sdk.ZeroDec(),
+				BaseMultiplier: sdk.ZeroDec(),
+				Kink:           sdk.ZeroDec(),
+				JumpMultiplier: sdk.ZeroDec(),
+				Model:          "unknown",
+			},
+			expectedErr: "invalid interest rate model 'unknown'",
+		},
+		{
+			name:        "second kink below kink",
+			model:       newTwoKinkModel("0.7", "2"),
+			expectedErr: "second kink must be in the inclusive range kink-1.0",
+		},
+		{
+			name:        "second kink above one",
+			model:       newTwoKinkModel("1.1", "2"),
+			expectedErr: "second kink must be in the inclusive range kink-1.0",
+		},
+		{
+			name:        "negative second jump multiplier",
+			model:       newTwoKinkModel("0.9", "-1"),
+			expectedErr: "second jump multiplier must not be negative",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.model.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -17,6 +17,13 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 
 	params := k.GetParams(ctx)
 
+	// The previous block time is not found on the first block, so no reward
+	// period can have started or ended since.
+	if previousBlockTime, found := k.GetPreviousBlockTime(ctx); found {
+		k.EmitRewardPeriodEvents(ctx, previousBlockTime)
+	}
+	k.SetPreviousBlockTime(ctx, ctx.BlockTime())
+
 	for _, rp := range params.USDXMintingRewardPeriods {
 		k.AccumulateUSDXMintingRewards(ctx, rp)
 	}
@@ -40,4 +47,7 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 			panic(fmt.Sprintf("failed to accumulate earn rewards: %s", err))
 		}
 	}
+
+	k.SnapshotRewardIndexes(ctx)
+	k.PruneDelegatorValidatorCheckpoints(ctx)
 }
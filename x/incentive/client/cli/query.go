@@ -3,13 +3,16 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/kava-labs/kava/x/incentive/keeper"
@@ -17,10 +20,12 @@ import (
 )
 
 const (
-	flagOwner    = "owner"
-	flagType     = "type"
-	flagUnsynced = "unsynced"
-	flagDenom    = "denom"
+	flagOwner     = "owner"
+	flagType      = "type"
+	flagUnsynced  = "unsynced"
+	flagDenom     = "denom"
+	flagMinAmount = "min-amount"
+	flagSource    = "source"
 )
 
 var rewardTypes = []string{
@@ -32,6 +37,23 @@ var rewardTypes = []string{
 	keeper.RewardTypeEarn,
 }
 
+var rewardSources = []string{
+	keeper.RewardSourceHardSupply,
+	keeper.RewardSourceHardBorrow,
+	keeper.RewardSourceSwap,
+	keeper.RewardSourceEarn,
+}
+
+var allRewardSources = []string{
+	keeper.RewardSourceUSDXMinting,
+	keeper.RewardSourceHardSupply,
+	keeper.RewardSourceHardBorrow,
+	keeper.RewardSourceDelegator,
+	keeper.RewardSourceSwap,
+	keeper.RewardSourceSavings,
+	keeper.RewardSourceEarn,
+}
+
 // GetQueryCmd returns the cli query commands for the incentive module
 func GetQueryCmd() *cobra.Command {
 	incentiveQueryCmd := &cobra.Command{
@@ -44,6 +66,10 @@ func GetQueryCmd() *cobra.Command {
 		queryRewardsCmd(),
 		queryRewardFactorsCmd(),
 		queryApyCmd(),
+		queryRewardScheduleCmd(),
+		queryUpcomingRewardPeriodsCmd(),
+		queryRewardCapsCmd(),
+		queryRewardsAtHeightCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -73,6 +99,7 @@ func queryRewardsCmd() *cobra.Command {
 			$ %[1]s query %[2]s rewards --type earn
 			$ %[1]s query %[2]s rewards --type hard --owner kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw
 			$ %[1]s query %[2]s rewards --type hard --unsynced
+			$ %[1]s query %[2]s rewards --type hard --denom ukava --min-amount 1000000 --limit 100
 			`,
 				version.AppName, types.ModuleName)),
 		Args: cobra.NoArgs,
@@ -85,6 +112,8 @@ func queryRewardsCmd() *cobra.Command {
 			strOwner, _ := cmd.Flags().GetString(flagOwner)
 			strType, _ := cmd.Flags().GetString(flagType)
 			boolUnsynced, _ := cmd.Flags().GetBool(flagUnsynced)
+			denom, _ := cmd.Flags().GetString(flagDenom)
+			strMinAmount, _ := cmd.Flags().GetString(flagMinAmount)
 
 			// Prepare params for querier
 			var owner sdk.AccAddress
@@ -94,12 +123,34 @@ func queryRewardsCmd() *cobra.Command {
 				}
 			}
 
+			var minAmount *sdkmath.Int
+			if strMinAmount != "" {
+				amount, ok := sdkmath.NewIntFromString(strMinAmount)
+				if !ok {
+					return fmt.Errorf("invalid min amount: %s", strMinAmount)
+				}
+				minAmount = &amount
+			}
+
 			rewardType := strings.ToLower(strType)
+
+			// Claims can only be paginated when querying a single reward type
+			// for all owners.
+			var pageReq *query.PageRequest
+			if rewardType != "" && owner.Empty() {
+				if pageReq, err = client.ReadPageRequest(cmd.Flags()); err != nil {
+					return err
+				}
+			}
+
 			queryClient := types.NewQueryClient(cliCtx)
 			request := types.QueryRewardsRequest{
 				RewardType:     rewardType,
 				Owner:          owner.String(),
 				Unsynchronized: boolUnsynced,
+				Denom:          denom,
+				MinAmount:      minAmount,
+				Pagination:     pageReq,
 			}
 			rewards, err := queryClient.Rewards(context.Background(), &request)
 			if err != nil {
@@ -111,8 +162,9 @@ func queryRewardsCmd() *cobra.Command {
 	cmd.Flags().String(flagOwner, "", "(optional) filter by owner address")
 	cmd.Flags().String(flagType, "", fmt.Sprintf("(optional) filter by a reward type: %s", strings.Join(rewardTypes, "|")))
 	cmd.Flags().Bool(flagUnsynced, false, "(optional) get unsynced claims")
-	cmd.Flags().Int(flags.FlagPage, 1, "pagination page rewards of to query for")
-	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of rewards to query for")
+	cmd.Flags().String(flagDenom, "", "(optional) filter by claims with rewards in a denom")
+	cmd.Flags().String(flagMinAmount, "", "(optional) filter by a minimum reward amount of denom")
+	flags.AddPaginationFlagsToCmd(cmd, "rewards")
 	return cmd
 }
 
@@ -183,3 +235,157 @@ func queryApyCmd() *cobra.Command {
 	}
 	return cmd
 }
+
+func queryRewardScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-schedule [source] [collateral-type]",
+		Short: "queries the reward period and current apy of a reward source",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the reward period paying out to a reward source, along with the USD value deposited in it and its current annualized reward rate.
+
+Valid sources are: %[3]s
+
+			Example:
+			$ %[1]s query %[2]s reward-schedule hard_borrow bnb
+			$ %[1]s query %[2]s reward-schedule swap ukava:usdx
+			$ %[1]s query %[2]s reward-schedule earn bkava`,
+				version.AppName, types.ModuleName, strings.Join(rewardSources, ", "),
+			)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.RewardSchedule(context.Background(), &types.QueryRewardScheduleRequest{
+				Source:         args[0],
+				CollateralType: args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintProto(res)
+		},
+	}
+	return cmd
+}
+
+func queryUpcomingRewardPeriodsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upcoming-reward-periods",
+		Short: "queries the reward periods that have not started yet",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the reward periods with a start time in the future, with an optional filter by reward source.
+
+			Example:
+			$ %[1]s query %[2]s upcoming-reward-periods
+			$ %[1]s query %[2]s upcoming-reward-periods --source hard_borrow`,
+				version.AppName, types.ModuleName,
+			)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			source, _ := cmd.Flags().GetString(flagSource)
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.UpcomingRewardPeriods(context.Background(), &types.QueryUpcomingRewardPeriodsRequest{
+				Source: source,
+			})
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagSource, "", fmt.Sprintf("(optional) filter by a reward source: %s", strings.Join(allRewardSources, "|")))
+	return cmd
+}
+
+func queryRewardCapsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-caps",
+		Short: "queries the remaining budget of capped reward periods",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the reward periods with a rewards cap, along with the rewards paid out and the remaining budget, with an optional filter by reward source.
+
+			Example:
+			$ %[1]s query %[2]s reward-caps
+			$ %[1]s query %[2]s reward-caps --source hard_borrow`,
+				version.AppName, types.ModuleName,
+			)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			source, _ := cmd.Flags().GetString(flagSource)
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.RewardCaps(context.Background(), &types.QueryRewardCapsRequest{
+				Source: source,
+			})
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagSource, "", fmt.Sprintf("(optional) filter by a reward source: %s", strings.Join(allRewardSources, "|")))
+	return cmd
+}
+
+func queryRewardsAtHeightCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards-at-height [owner] [height]",
+		Short: "queries the rewards an owner had accrued at a past block height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rewards an owner had accrued at a past block height, with an optional filter by reward type.
+			Rewards are calculated at the latest reward index snapshot at or before the height, which is returned with the rewards.
+
+			Example:
+			$ %[1]s query %[2]s rewards-at-height kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 1200
+			$ %[1]s query %[2]s rewards-at-height kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 1200 --type hard`,
+				version.AppName, types.ModuleName,
+			)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height: %s", args[1])
+			}
+
+			strType, _ := cmd.Flags().GetString(flagType)
+
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.RewardsAtHeight(context.Background(), &types.QueryRewardsAtHeightRequest{
+				Owner:      owner.String(),
+				RewardType: strings.ToLower(strType),
+				Height:     height,
+			})
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagType, "", fmt.Sprintf("(optional) filter by a reward type: %s", strings.Join(rewardTypes, "|")))
+	return cmd
+}
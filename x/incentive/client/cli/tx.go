@@ -17,6 +17,7 @@ import (
 const (
 	multiplierFlag      = "multiplier"
 	multiplierFlagShort = "m"
+	receiverFlag        = "receiver"
 )
 
 // GetTxCmd returns the transaction cli commands for the incentive module
@@ -33,9 +34,11 @@ func GetTxCmd() *cobra.Command {
 		getCmdClaimSwap(),
 		getCmdClaimSavings(),
 		getCmdClaimEarn(),
+		getCmdClaimAll(),
 	}
 
 	for _, cmd := range cmds {
+		cmd.Flags().String(receiverFlag, "", "(optional) address to pay the rewards out to, defaults to the sender")
 		flags.AddTxFlagsToCmd(cmd)
 	}
 
@@ -61,6 +64,7 @@ func getCmdClaimCdp() *cobra.Command {
 			multiplier := args[0]
 
 			msg := types.NewMsgClaimUSDXMintingReward(sender.String(), multiplier)
+			msg.Receiver, _ = cmd.Flags().GetString(receiverFlag)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -93,6 +97,7 @@ func getCmdClaimHard() *cobra.Command {
 			selections := types.NewSelectionsFromMap(denomsToClaim)
 
 			msg := types.NewMsgClaimHardReward(sender.String(), selections)
+			msg.Receiver, _ = cmd.Flags().GetString(receiverFlag)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -128,6 +133,7 @@ func getCmdClaimDelegator() *cobra.Command {
 			selections := types.NewSelectionsFromMap(denomsToClaim)
 
 			msg := types.NewMsgClaimDelegatorReward(sender.String(), selections)
+			msg.Receiver, _ = cmd.Flags().GetString(receiverFlag)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -163,6 +169,7 @@ func getCmdClaimSwap() *cobra.Command {
 			selections := types.NewSelectionsFromMap(denomsToClaim)
 
 			msg := types.NewMsgClaimSwapReward(sender.String(), selections)
+			msg.Receiver, _ = cmd.Flags().GetString(receiverFlag)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -198,6 +205,7 @@ func getCmdClaimSavings() *cobra.Command {
 			selections := types.NewSelectionsFromMap(denomsToClaim)
 
 			msg := types.NewMsgClaimSavingsReward(sender.String(), selections)
+			msg.Receiver, _ = cmd.Flags().GetString(receiverFlag)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -230,6 +238,43 @@ func getCmdClaimEarn() *cobra.Command {
 			selections := types.NewSelectionsFromMap(denomsToClaim)
 
 			msg := types.NewMsgClaimEarnReward(sender.String(), selections)
+			msg.Receiver, _ = cmd.Flags().GetString(receiverFlag)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().StringToStringVarP(&denomsToClaim, multiplierFlag, multiplierFlagShort, nil, "specify the denoms to claim, each with a multiplier lockup")
+	if err := cmd.MarkFlagRequired(multiplierFlag); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func getCmdClaimAll() *cobra.Command {
+	var denomsToClaim map[string]string
+
+	cmd := &cobra.Command{
+		Use:   "claim-all",
+		Short: "claim sender's rewards from every claim type using given multipliers",
+		Long:  `Claim sender's outstanding rewards from every claim type (USDX minting, hard, delegator, swap and earn) using given multipliers`,
+		Example: strings.Join([]string{
+			fmt.Sprintf(`  $ %s tx %s claim-all --%s hard=large --%s ukava=small`, version.AppName, types.ModuleName, multiplierFlag, multiplierFlag),
+			fmt.Sprintf(`  $ %s tx %s claim-all --%s hard=large,swp=small,ukava=large`, version.AppName, types.ModuleName, multiplierFlag),
+		}, "\n"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+			selections := types.NewSelectionsFromMap(denomsToClaim)
+
+			msg := types.NewMsgClaimAll(sender.String(), selections)
+			msg.Receiver, _ = cmd.Flags().GetString(receiverFlag)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
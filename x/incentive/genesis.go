@@ -55,6 +55,9 @@ func InitGenesis(
 		}
 		k.SetUSDXMintingRewardFactor(ctx, mri.CollateralType, factor)
 	}
+	for _, dr := range gs.USDXRewardState.DistributedRewards {
+		k.SetDistributedRewards(ctx, keeper.RewardSourceUSDXMinting, dr.CollateralType, dr.Amount)
+	}
 
 	// Hard Supply / Borrow
 	for _, claim := range gs.HardLiquidityProviderClaims {
@@ -69,6 +72,9 @@ func InitGenesis(
 	for _, mri := range gs.HardSupplyRewardState.MultiRewardIndexes {
 		k.SetHardSupplyRewardIndexes(ctx, mri.CollateralType, mri.RewardIndexes)
 	}
+	for _, dr := range gs.HardSupplyRewardState.DistributedRewards {
+		k.SetDistributedRewards(ctx, keeper.RewardSourceHardSupply, dr.CollateralType, dr.Amount)
+	}
 	for _, gat := range gs.HardBorrowRewardState.AccumulationTimes {
 		if err := ValidateAccumulationTime(gat.PreviousAccumulationTime); err != nil {
 			panic(err.Error())
@@ -78,10 +84,14 @@ func InitGenesis(
 	for _, mri := range gs.HardBorrowRewardState.MultiRewardIndexes {
 		k.SetHardBorrowRewardIndexes(ctx, mri.CollateralType, mri.RewardIndexes)
 	}
+	for _, dr := range gs.HardBorrowRewardState.DistributedRewards {
+		k.SetDistributedRewards(ctx, keeper.RewardSourceHardBorrow, dr.CollateralType, dr.Amount)
+	}
 
 	// Delegator
 	for _, claim := range gs.DelegatorClaims {
 		k.SetDelegatorClaim(ctx, claim)
+		k.SetDelegatorClaimCheckpointID(ctx, claim.Owner, k.GetNextDelegatorCheckpointID(ctx))
 	}
 	for _, gat := range gs.DelegatorRewardState.AccumulationTimes {
 		if err := ValidateAccumulationTime(gat.PreviousAccumulationTime); err != nil {
@@ -92,6 +102,9 @@ func InitGenesis(
 	for _, mri := range gs.DelegatorRewardState.MultiRewardIndexes {
 		k.SetDelegatorRewardIndexes(ctx, mri.CollateralType, mri.RewardIndexes)
 	}
+	for _, dr := range gs.DelegatorRewardState.DistributedRewards {
+		k.SetDistributedRewards(ctx, keeper.RewardSourceDelegator, dr.CollateralType, dr.Amount)
+	}
 
 	// Swap
 	for _, claim := range gs.SwapClaims {
@@ -106,6 +119,9 @@ func InitGenesis(
 	for _, mri := range gs.SwapRewardState.MultiRewardIndexes {
 		k.SetSwapRewardIndexes(ctx, mri.CollateralType, mri.RewardIndexes)
 	}
+	for _, dr := range gs.SwapRewardState.DistributedRewards {
+		k.SetDistributedRewards(ctx, keeper.RewardSourceSwap, dr.CollateralType, dr.Amount)
+	}
 
 	// Savings
 	for _, claim := range gs.SavingsClaims {
@@ -120,6 +136,9 @@ func InitGenesis(
 	for _, mri := range gs.SavingsRewardState.MultiRewardIndexes {
 		k.SetSavingsRewardIndexes(ctx, mri.CollateralType, mri.RewardIndexes)
 	}
+	for _, dr := range gs.SavingsRewardState.DistributedRewards {
+		k.SetDistributedRewards(ctx, keeper.RewardSourceSavings, dr.CollateralType, dr.Amount)
+	}
 
 	// Earn
 	for _, claim := range gs.EarnClaims {
@@ -134,6 +153,9 @@ func InitGenesis(
 	for _, mri := range gs.EarnRewardState.MultiRewardIndexes {
 		k.SetEarnRewardIndexes(ctx, mri.CollateralType, mri.RewardIndexes)
 	}
+	for _, dr := range gs.EarnRewardState.DistributedRewards {
+		k.SetDistributedRewards(ctx, keeper.RewardSourceEarn, dr.CollateralType, dr.Amount)
+	}
 }
 
 // ExportGenesis export genesis state for incentive module
@@ -142,22 +164,33 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 
 	usdxClaims := k.GetAllUSDXMintingClaims(ctx)
 	usdxRewardState := getUSDXMintingGenesisRewardState(ctx, k)
+	usdxRewardState.DistributedRewards = getDistributedRewards(ctx, k, keeper.RewardSourceUSDXMinting)
 
 	hardClaims := k.GetAllHardLiquidityProviderClaims(ctx)
 	hardSupplyRewardState := getHardSupplyGenesisRewardState(ctx, k)
+	hardSupplyRewardState.DistributedRewards = getDistributedRewards(ctx, k, keeper.RewardSourceHardSupply)
 	hardBorrowRewardState := getHardBorrowGenesisRewardState(ctx, k)
+	hardBorrowRewardState.DistributedRewards = getDistributedRewards(ctx, k, keeper.RewardSourceHardBorrow)
 
-	delegatorClaims := k.GetAllDelegatorClaims(ctx)
+	// Validator checkpoints are not exported, so delegator claims are exported synced up to the current block.
+	var delegatorClaims types.DelegatorClaims
+	for _, claim := range k.GetAllDelegatorClaims(ctx) {
+		delegatorClaims = append(delegatorClaims, k.SimulateDelegatorSynchronization(ctx, claim))
+	}
 	delegatorRewardState := getDelegatorGenesisRewardState(ctx, k)
+	delegatorRewardState.DistributedRewards = getDistributedRewards(ctx, k, keeper.RewardSourceDelegator)
 
 	swapClaims := k.GetAllSwapClaims(ctx)
 	swapRewardState := getSwapGenesisRewardState(ctx, k)
+	swapRewardState.DistributedRewards = getDistributedRewards(ctx, k, keeper.RewardSourceSwap)
 
 	savingsClaims := k.GetAllSavingsClaims(ctx)
 	savingsRewardState := getSavingsGenesisRewardState(ctx, k)
+	savingsRewardState.DistributedRewards = getDistributedRewards(ctx, k, keeper.RewardSourceSavings)
 
 	earnClaims := k.GetAllEarnClaims(ctx)
 	earnRewardState := getEarnGenesisRewardState(ctx, k)
+	earnRewardState.DistributedRewards = getDistributedRewards(ctx, k, keeper.RewardSourceEarn)
 
 	return types.NewGenesisState(
 		params,
@@ -286,6 +319,15 @@ func getEarnGenesisRewardState(ctx sdk.Context, keeper keeper.Keeper) types.Gene
 	return types.NewGenesisRewardState(ats, mris)
 }
 
+func getDistributedRewards(ctx sdk.Context, k keeper.Keeper, source string) types.DistributedRewardsList {
+	var drs types.DistributedRewardsList
+	k.IterateDistributedRewards(ctx, source, func(distributed types.DistributedRewards) bool {
+		drs = append(drs, distributed)
+		return false
+	})
+	return drs
+}
+
 func ValidateAccumulationTime(previousAccumulationTime time.Time) error {
 	if previousAccumulationTime.Equal(time.Time{}) {
 		return fmt.Errorf("accumulation time is not set")
@@ -275,6 +275,10 @@ func (suite *GenesisTestSuite) TestExportedGenesisMatchesImported() {
 			),
 		},
 	)
+	genesisState.Params.HardBorrowRewardPeriods[0].RewardsCap = cs(c("hard", 1e12))
+	genesisState.HardBorrowRewardState.DistributedRewards = types.DistributedRewardsList{
+		types.NewDistributedRewards("bnb", sdk.NewDecCoins(sdk.NewDecCoinFromDec("hard", d("1234.5")))),
+	}
 
 	tApp := app.NewTestApp()
 	ctx := tApp.NewContext(true, tmproto.Header{Height: 0, Time: genesisTime})
@@ -0,0 +1,42 @@
+package adapters
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// SourceAdapters reads source shares for each reward source with the source's adapter.
+type SourceAdapters struct {
+	adapters map[string]types.SourceAdapter
+}
+
+// NewSourceAdapters returns a new SourceAdapters from adapters keyed by reward source.
+func NewSourceAdapters(adapters map[string]types.SourceAdapter) SourceAdapters {
+	return SourceAdapters{
+		adapters: adapters,
+	}
+}
+
+// OwnerSharesBySource returns the shares an owner has in each source of a reward source.
+func (a SourceAdapters) OwnerSharesBySource(
+	ctx sdk.Context, rewardSource string, owner sdk.AccAddress, sourceIDs []string,
+) []types.SourceShares {
+	return a.adapter(rewardSource).OwnerSharesBySource(ctx, owner, sourceIDs)
+}
+
+// TotalSharesBySource returns the total shares in a source of a reward source.
+func (a SourceAdapters) TotalSharesBySource(ctx sdk.Context, rewardSource string, sourceID string) sdk.Dec {
+	return a.adapter(rewardSource).TotalSharesBySource(ctx, sourceID)
+}
+
+// adapter returns the adapter of a reward source, panicking if there is none as that is a programming error.
+func (a SourceAdapters) adapter(rewardSource string) types.SourceAdapter {
+	adapter, found := a.adapters[rewardSource]
+	if !found {
+		panic(fmt.Sprintf("no source adapter for reward source %s", rewardSource))
+	}
+	return adapter
+}
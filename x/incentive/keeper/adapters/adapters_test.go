@@ -0,0 +1,50 @@
+package adapters_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/incentive/keeper/adapters"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// fakeSourceAdapter returns the same shares for every owner.
+type fakeSourceAdapter struct {
+	shares map[string]sdk.Dec
+}
+
+func (a fakeSourceAdapter) OwnerSharesBySource(_ sdk.Context, _ sdk.AccAddress, sourceIDs []string) []types.SourceShares {
+	shares := make([]types.SourceShares, len(sourceIDs))
+	for i, id := range sourceIDs {
+		shares[i] = types.SourceShares{ID: id, Shares: a.shares[id]}
+	}
+	return shares
+}
+
+func (a fakeSourceAdapter) TotalSharesBySource(_ sdk.Context, sourceID string) sdk.Dec {
+	return a.shares[sourceID]
+}
+
+func TestSourceAdapters(t *testing.T) {
+	owner := sdk.AccAddress("owner")
+	sourceAdapters := adapters.NewSourceAdapters(map[string]types.SourceAdapter{
+		"source1": fakeSourceAdapter{shares: map[string]sdk.Dec{"a": sdk.NewDec(1)}},
+		"source2": fakeSourceAdapter{shares: map[string]sdk.Dec{"a": sdk.NewDec(2)}},
+	})
+
+	require.Equal(t, sdk.NewDec(1), sourceAdapters.TotalSharesBySource(sdk.Context{}, "source1", "a"))
+	require.Equal(t, sdk.NewDec(2), sourceAdapters.TotalSharesBySource(sdk.Context{}, "source2", "a"))
+	require.Equal(t,
+		[]types.SourceShares{{ID: "a", Shares: sdk.NewDec(2)}},
+		sourceAdapters.OwnerSharesBySource(sdk.Context{}, "source2", owner, []string{"a"}),
+	)
+
+	require.PanicsWithValue(t, "no source adapter for reward source unknown", func() {
+		sourceAdapters.TotalSharesBySource(sdk.Context{}, "unknown", "a")
+	})
+	require.PanicsWithValue(t, "no source adapter for reward source unknown", func() {
+		sourceAdapters.OwnerSharesBySource(sdk.Context{}, "unknown", owner, []string{"a"})
+	})
+}
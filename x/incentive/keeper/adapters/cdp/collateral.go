@@ -0,0 +1,49 @@
+package cdp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+var _ types.SourceAdapter = CollateralSourceAdapter{}
+
+// CollateralSourceAdapter reads the collateral deposited in cdps as source shares. Sources are collateral types, so
+// collateral providers can be rewarded independently of the debt they mint.
+//
+// Shares are the collateral amounts of cdps. Collateral held by a cdp as additional collateral of another collateral
+// type is not included.
+type CollateralSourceAdapter struct {
+	keeper types.CdpKeeper
+}
+
+// NewCollateralSourceAdapter returns a new CollateralSourceAdapter.
+func NewCollateralSourceAdapter(keeper types.CdpKeeper) CollateralSourceAdapter {
+	return CollateralSourceAdapter{
+		keeper: keeper,
+	}
+}
+
+// OwnerSharesBySource returns the collateral an owner has deposited in their cdp of each collateral type.
+func (a CollateralSourceAdapter) OwnerSharesBySource(
+	ctx sdk.Context, owner sdk.AccAddress, collateralTypes []string,
+) []types.SourceShares {
+	shares := make([]types.SourceShares, len(collateralTypes))
+	for i, collateralType := range collateralTypes {
+		amount := sdk.ZeroDec()
+		if cdp, found := a.keeper.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType); found {
+			amount = sdk.NewDecFromInt(cdp.Collateral.Amount)
+		}
+
+		shares[i] = types.SourceShares{
+			ID:     collateralType,
+			Shares: amount,
+		}
+	}
+	return shares
+}
+
+// TotalSharesBySource returns the total collateral deposited in cdps of a collateral type.
+func (a CollateralSourceAdapter) TotalSharesBySource(ctx sdk.Context, collateralType string) sdk.Dec {
+	return sdk.NewDecFromInt(a.keeper.GetTotalCollateral(ctx, collateralType))
+}
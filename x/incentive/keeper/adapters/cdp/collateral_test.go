@@ -0,0 +1,71 @@
+package cdp_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	cdptypes "github.com/kava-labs/kava/x/cdp/types"
+	"github.com/kava-labs/kava/x/incentive/keeper/adapters/cdp"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// fakeCdpKeeper is a stub cdp keeper holding cdps by owner and collateral type.
+type fakeCdpKeeper struct {
+	types.CdpKeeper
+
+	cdps []cdptypes.CDP
+}
+
+func (k fakeCdpKeeper) GetCdpByOwnerAndCollateralType(_ sdk.Context, owner sdk.AccAddress, collateralType string) (cdptypes.CDP, bool) {
+	for _, cdp := range k.cdps {
+		if cdp.Owner.Equals(owner) && cdp.Type == collateralType {
+			return cdp, true
+		}
+	}
+	return cdptypes.CDP{}, false
+}
+
+func (k fakeCdpKeeper) GetTotalCollateral(_ sdk.Context, collateralType string) sdkmath.Int {
+	total := sdk.ZeroInt()
+	for _, cdp := range k.cdps {
+		if cdp.Type == collateralType {
+			total = total.Add(cdp.Collateral.Amount)
+		}
+	}
+	return total
+}
+
+func TestCollateralSourceAdapter(t *testing.T) {
+	owner1, owner2 := sdk.AccAddress("owner1"), sdk.AccAddress("owner2")
+	adapter := cdp.NewCollateralSourceAdapter(fakeCdpKeeper{
+		cdps: []cdptypes.CDP{
+			{ID: 1, Owner: owner1, Type: "bnb-a", Collateral: sdk.NewInt64Coin("bnb", 100)},
+			{ID: 2, Owner: owner2, Type: "bnb-a", Collateral: sdk.NewInt64Coin("bnb", 50)},
+			{ID: 3, Owner: owner1, Type: "bnb-b", Collateral: sdk.NewInt64Coin("bnb", 25)},
+		},
+	})
+
+	require.Equal(t, sdk.NewDec(150), adapter.TotalSharesBySource(sdk.Context{}, "bnb-a"))
+	require.Equal(t, sdk.NewDec(25), adapter.TotalSharesBySource(sdk.Context{}, "bnb-b"))
+	require.Equal(t, sdk.ZeroDec(), adapter.TotalSharesBySource(sdk.Context{}, "xrp-a"))
+
+	require.Equal(t,
+		[]types.SourceShares{
+			{ID: "bnb-b", Shares: sdk.NewDec(25)},
+			{ID: "xrp-a", Shares: sdk.ZeroDec()},
+			{ID: "bnb-a", Shares: sdk.NewDec(100)},
+		},
+		adapter.OwnerSharesBySource(sdk.Context{}, owner1, []string{"bnb-b", "xrp-a", "bnb-a"}),
+	)
+	require.Equal(t,
+		[]types.SourceShares{
+			{ID: "bnb-a", Shares: sdk.NewDec(50)},
+			{ID: "bnb-b", Shares: sdk.ZeroDec()},
+		},
+		adapter.OwnerSharesBySource(sdk.Context{}, owner2, []string{"bnb-a", "bnb-b"}),
+	)
+	require.Empty(t, adapter.OwnerSharesBySource(sdk.Context{}, owner2, nil))
+}
@@ -0,0 +1,91 @@
+package earn
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// ShareClassLiquid is the share class of earn vault shares that can be withdrawn at any time.
+const ShareClassLiquid = "liquid"
+
+// ShareClassKeeper reads the shares of each share class in earn vaults, such as locked and liquid shares.
+// Shares are returned as source shares with share classes as IDs.
+type ShareClassKeeper interface {
+	GetVaultShareClasses(ctx sdk.Context, vaultDenom string) []types.SourceShares
+	GetVaultAccountShareClasses(ctx sdk.Context, owner sdk.AccAddress, vaultDenom string) []types.SourceShares
+}
+
+var _ ShareClassKeeper = LiquidShareClassKeeper{}
+
+// LiquidShareClassKeeper reads earn vault shares as a single liquid share class, as earn vaults do not lock shares.
+type LiquidShareClassKeeper struct {
+	keeper types.EarnKeeper
+}
+
+// NewLiquidShareClassKeeper returns a new LiquidShareClassKeeper.
+func NewLiquidShareClassKeeper(keeper types.EarnKeeper) LiquidShareClassKeeper {
+	return LiquidShareClassKeeper{
+		keeper: keeper,
+	}
+}
+
+// GetVaultShareClasses returns the total shares of a vault as liquid shares.
+func (k LiquidShareClassKeeper) GetVaultShareClasses(ctx sdk.Context, vaultDenom string) []types.SourceShares {
+	amount := sdk.ZeroDec()
+	if totalShares, found := k.keeper.GetVaultTotalShares(ctx, vaultDenom); found {
+		amount = totalShares.Amount
+	}
+	return []types.SourceShares{{ID: ShareClassLiquid, Shares: amount}}
+}
+
+// GetVaultAccountShareClasses returns an account's shares in a vault as liquid shares.
+func (k LiquidShareClassKeeper) GetVaultAccountShareClasses(
+	ctx sdk.Context, owner sdk.AccAddress, vaultDenom string,
+) []types.SourceShares {
+	amount := sdk.ZeroDec()
+	if accountShares, found := k.keeper.GetVaultAccountShares(ctx, owner); found {
+		amount = accountShares.AmountOf(vaultDenom)
+	}
+	return []types.SourceShares{{ID: ShareClassLiquid, Shares: amount}}
+}
+
+var _ types.SourceAdapter = VaultSourceAdapter{}
+
+// VaultSourceAdapter reads earn vault deposits as source shares. Sources are vault denoms.
+//
+// Shares are the vault shares of each share class multiplied by the share class's reward weight, so share classes
+// within a vault can be rewarded at different rates. Weights are read for each vault as rewards are calculated.
+type VaultSourceAdapter struct {
+	keeper  ShareClassKeeper
+	weights func(ctx sdk.Context, vaultDenom string) types.ShareClassWeights
+}
+
+// NewVaultSourceAdapter returns a new VaultSourceAdapter.
+func NewVaultSourceAdapter(
+	keeper ShareClassKeeper, weights func(ctx sdk.Context, vaultDenom string) types.ShareClassWeights,
+) VaultSourceAdapter {
+	return VaultSourceAdapter{
+		keeper:  keeper,
+		weights: weights,
+	}
+}
+
+// OwnerSharesBySource returns the weighted shares an owner has in each vault.
+func (a VaultSourceAdapter) OwnerSharesBySource(
+	ctx sdk.Context, owner sdk.AccAddress, vaultDenoms []string,
+) []types.SourceShares {
+	shares := make([]types.SourceShares, len(vaultDenoms))
+	for i, vaultDenom := range vaultDenoms {
+		shares[i] = types.SourceShares{
+			ID:     vaultDenom,
+			Shares: a.weights(ctx, vaultDenom).WeighShares(a.keeper.GetVaultAccountShareClasses(ctx, owner, vaultDenom)),
+		}
+	}
+	return shares
+}
+
+// TotalSharesBySource returns the total weighted shares in a vault.
+func (a VaultSourceAdapter) TotalSharesBySource(ctx sdk.Context, vaultDenom string) sdk.Dec {
+	return a.weights(ctx, vaultDenom).WeighShares(a.keeper.GetVaultShareClasses(ctx, vaultDenom))
+}
@@ -0,0 +1,136 @@
+package earn_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	earntypes "github.com/kava-labs/kava/x/earn/types"
+	"github.com/kava-labs/kava/x/incentive/keeper/adapters/earn"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// fakeEarnKeeper is a stub earn keeper holding vault shares by account.
+type fakeEarnKeeper struct {
+	types.EarnKeeper
+
+	accountShares map[string]earntypes.VaultShares
+}
+
+func (k fakeEarnKeeper) GetVaultTotalShares(_ sdk.Context, denom string) (earntypes.VaultShare, bool) {
+	total := sdk.ZeroDec()
+	found := false
+	for _, shares := range k.accountShares {
+		if shares.AmountOf(denom).IsPositive() {
+			total = total.Add(shares.AmountOf(denom))
+			found = true
+		}
+	}
+	return earntypes.NewVaultShare(denom, total), found
+}
+
+func (k fakeEarnKeeper) GetVaultAccountShares(_ sdk.Context, acc sdk.AccAddress) (earntypes.VaultShares, bool) {
+	shares, found := k.accountShares[acc.String()]
+	return shares, found
+}
+
+// fakeShareClassKeeper is a stub share class keeper holding shares by vault, account and share class.
+type fakeShareClassKeeper struct {
+	shares map[string]map[string][]types.SourceShares
+}
+
+func (k fakeShareClassKeeper) GetVaultShareClasses(_ sdk.Context, vaultDenom string) []types.SourceShares {
+	totals := make(map[string]sdk.Dec)
+	var classes []string
+	for _, accountShares := range k.shares[vaultDenom] {
+		for _, s := range accountShares {
+			if _, found := totals[s.ID]; !found {
+				totals[s.ID] = sdk.ZeroDec()
+				classes = append(classes, s.ID)
+			}
+			totals[s.ID] = totals[s.ID].Add(s.Shares)
+		}
+	}
+
+	var shares []types.SourceShares
+	for _, class := range classes {
+		shares = append(shares, types.SourceShares{ID: class, Shares: totals[class]})
+	}
+	return shares
+}
+
+func (k fakeShareClassKeeper) GetVaultAccountShareClasses(
+	_ sdk.Context, owner sdk.AccAddress, vaultDenom string,
+) []types.SourceShares {
+	return k.shares[vaultDenom][owner.String()]
+}
+
+func TestLiquidShareClassKeeper(t *testing.T) {
+	owner1, owner2 := sdk.AccAddress("owner1"), sdk.AccAddress("owner2")
+	keeper := earn.NewLiquidShareClassKeeper(fakeEarnKeeper{
+		accountShares: map[string]earntypes.VaultShares{
+			owner1.String(): earntypes.NewVaultShares(earntypes.NewVaultShare("usdx", sdk.NewDec(100))),
+			owner2.String(): earntypes.NewVaultShares(earntypes.NewVaultShare("usdx", sdk.NewDec(50))),
+		},
+	})
+
+	require.Equal(t,
+		[]types.SourceShares{{ID: earn.ShareClassLiquid, Shares: sdk.NewDec(150)}},
+		keeper.GetVaultShareClasses(sdk.Context{}, "usdx"),
+	)
+	require.Equal(t,
+		[]types.SourceShares{{ID: earn.ShareClassLiquid, Shares: sdk.ZeroDec()}},
+		keeper.GetVaultShareClasses(sdk.Context{}, "ukava"),
+	)
+
+	require.Equal(t,
+		[]types.SourceShares{{ID: earn.ShareClassLiquid, Shares: sdk.NewDec(100)}},
+		keeper.GetVaultAccountShareClasses(sdk.Context{}, owner1, "usdx"),
+	)
+	require.Equal(t,
+		[]types.SourceShares{{ID: earn.ShareClassLiquid, Shares: sdk.ZeroDec()}},
+		keeper.GetVaultAccountShareClasses(sdk.Context{}, sdk.AccAddress("owner3"), "usdx"),
+	)
+}
+
+func TestVaultSourceAdapter(t *testing.T) {
+	owner1, owner2 := sdk.AccAddress("owner1"), sdk.AccAddress("owner2")
+	adapter := earn.NewVaultSourceAdapter(
+		fakeShareClassKeeper{
+			shares: map[string]map[string][]types.SourceShares{
+				"usdx": {
+					owner1.String(): {{ID: "liquid", Shares: sdk.NewDec(100)}, {ID: "locked", Shares: sdk.NewDec(100)}},
+					owner2.String(): {{ID: "liquid", Shares: sdk.NewDec(50)}},
+				},
+				"ukava": {
+					owner1.String(): {{ID: "locked", Shares: sdk.NewDec(10)}},
+				},
+			},
+		},
+		func(_ sdk.Context, vaultDenom string) types.ShareClassWeights {
+			if vaultDenom != "usdx" {
+				return nil
+			}
+			return types.ShareClassWeights{types.NewShareClassWeight("locked", sdk.NewDec(3))}
+		},
+	)
+
+	require.Equal(t, sdk.NewDec(100+50+3*100), adapter.TotalSharesBySource(sdk.Context{}, "usdx"))
+	// vaults without weights count shares of every share class equally
+	require.Equal(t, sdk.NewDec(10), adapter.TotalSharesBySource(sdk.Context{}, "ukava"))
+	require.Equal(t, sdk.ZeroDec(), adapter.TotalSharesBySource(sdk.Context{}, "hard"))
+
+	require.Equal(t,
+		[]types.SourceShares{
+			{ID: "ukava", Shares: sdk.NewDec(10)},
+			{ID: "hard", Shares: sdk.ZeroDec()},
+			{ID: "usdx", Shares: sdk.NewDec(100 + 3*100)},
+		},
+		adapter.OwnerSharesBySource(sdk.Context{}, owner1, []string{"ukava", "hard", "usdx"}),
+	)
+	require.Equal(t,
+		[]types.SourceShares{{ID: "usdx", Shares: sdk.NewDec(50)}},
+		adapter.OwnerSharesBySource(sdk.Context{}, owner2, []string{"usdx"}),
+	)
+}
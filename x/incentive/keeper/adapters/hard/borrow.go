@@ -0,0 +1,68 @@
+package hard
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+var _ types.SourceAdapter = BorrowSourceAdapter{}
+
+// BorrowSourceAdapter reads hard borrows as source shares. Sources are borrowed denoms.
+//
+// Shares are normalized borrow amounts, the borrowed amount divided by the borrow interest factor. Normalized amounts
+// only change through user input, unlike the borrowed amounts which grow every block with interest.
+type BorrowSourceAdapter struct {
+	keeper types.HardKeeper
+}
+
+// NewBorrowSourceAdapter returns a new BorrowSourceAdapter.
+func NewBorrowSourceAdapter(keeper types.HardKeeper) BorrowSourceAdapter {
+	return BorrowSourceAdapter{
+		keeper: keeper,
+	}
+}
+
+// OwnerSharesBySource returns the normalized amount an owner has borrowed of each denom.
+func (a BorrowSourceAdapter) OwnerSharesBySource(
+	ctx sdk.Context, owner sdk.AccAddress, denoms []string,
+) []types.SourceShares {
+	normalizedBorrows := sdk.NewDecCoins()
+	if borrow, found := a.keeper.GetBorrow(ctx, owner); found {
+		var err error
+		normalizedBorrows, err = borrow.NormalizedBorrow()
+		if err != nil {
+			panic(fmt.Sprintf("could not get normalized borrow for %s: %s", owner, err))
+		}
+	}
+
+	shares := make([]types.SourceShares, len(denoms))
+	for i, denom := range denoms {
+		shares[i] = types.SourceShares{
+			ID:     denom,
+			Shares: normalizedBorrows.AmountOf(denom),
+		}
+	}
+	return shares
+}
+
+// TotalSharesBySource returns the normalized total borrowed of a denom.
+func (a BorrowSourceAdapter) TotalSharesBySource(ctx sdk.Context, denom string) sdk.Dec {
+	totalBorrowedCoins, found := a.keeper.GetBorrowedCoins(ctx)
+	if !found {
+		// assume no coins have been borrowed
+		totalBorrowedCoins = sdk.NewCoins()
+	}
+	totalBorrowed := totalBorrowedCoins.AmountOf(denom)
+
+	interestFactor, found := a.keeper.GetBorrowInterestFactor(ctx, denom)
+	if !found {
+		// assume nothing has been borrowed so the factor starts at it's default value
+		interestFactor = sdk.OneDec()
+	}
+
+	// return borrowed/factor to get the "pre interest" value of the current total borrowed
+	return sdk.NewDecFromInt(totalBorrowed).Quo(interestFactor)
+}
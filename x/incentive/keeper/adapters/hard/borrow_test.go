@@ -0,0 +1,75 @@
+package hard_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	"github.com/kava-labs/kava/x/incentive/keeper/adapters/hard"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// fakeHardKeeper is a stub hard keeper holding borrows, the total borrowed and borrow interest factors.
+type fakeHardKeeper struct {
+	types.HardKeeper
+
+	borrows         []hardtypes.Borrow
+	borrowed        sdk.Coins
+	interestFactors map[string]sdk.Dec
+}
+
+func (k fakeHardKeeper) GetBorrow(_ sdk.Context, borrower sdk.AccAddress) (hardtypes.Borrow, bool) {
+	for _, borrow := range k.borrows {
+		if borrow.Borrower.Equals(borrower) {
+			return borrow, true
+		}
+	}
+	return hardtypes.Borrow{}, false
+}
+
+func (k fakeHardKeeper) GetBorrowedCoins(_ sdk.Context) (sdk.Coins, bool) {
+	return k.borrowed, k.borrowed != nil
+}
+
+func (k fakeHardKeeper) GetBorrowInterestFactor(_ sdk.Context, denom string) (sdk.Dec, bool) {
+	factor, found := k.interestFactors[denom]
+	return factor, found
+}
+
+func TestBorrowSourceAdapter(t *testing.T) {
+	borrower, other := sdk.AccAddress("borrower"), sdk.AccAddress("other")
+	adapter := hard.NewBorrowSourceAdapter(fakeHardKeeper{
+		borrows: []hardtypes.Borrow{
+			hardtypes.NewBorrow(
+				borrower,
+				sdk.NewCoins(sdk.NewInt64Coin("bnb", 100), sdk.NewInt64Coin("ukava", 30)),
+				hardtypes.BorrowInterestFactors{
+					hardtypes.NewBorrowInterestFactor("bnb", sdk.NewDec(2)),
+					hardtypes.NewBorrowInterestFactor("ukava", sdk.OneDec()),
+				},
+			),
+		},
+		borrowed:        sdk.NewCoins(sdk.NewInt64Coin("bnb", 400), sdk.NewInt64Coin("ukava", 30)),
+		interestFactors: map[string]sdk.Dec{"bnb": sdk.NewDec(4)},
+	})
+
+	require.Equal(t, sdk.NewDec(100), adapter.TotalSharesBySource(sdk.Context{}, "bnb"))
+	// the interest factor defaults to one when not found
+	require.Equal(t, sdk.NewDec(30), adapter.TotalSharesBySource(sdk.Context{}, "ukava"))
+	require.Equal(t, sdk.ZeroDec(), adapter.TotalSharesBySource(sdk.Context{}, "xrp"))
+
+	require.Equal(t,
+		[]types.SourceShares{
+			{ID: "bnb", Shares: sdk.NewDec(50)},
+			{ID: "xrp", Shares: sdk.ZeroDec()},
+			{ID: "ukava", Shares: sdk.NewDec(30)},
+		},
+		adapter.OwnerSharesBySource(sdk.Context{}, borrower, []string{"bnb", "xrp", "ukava"}),
+	)
+	require.Equal(t,
+		[]types.SourceShares{{ID: "bnb", Shares: sdk.ZeroDec()}},
+		adapter.OwnerSharesBySource(sdk.Context{}, other, []string{"bnb"}),
+	)
+}
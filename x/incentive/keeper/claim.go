@@ -45,14 +45,24 @@ func (k Keeper) ClaimUSDXMintingReward(ctx sdk.Context, owner, receiver sdk.AccA
 
 	k.ZeroUSDXMintingClaim(ctx, claim)
 
-	ctx.EventManager().EmitEvent(
+	k.emitEvent(ctx,
+		&types.EventClaimReward{
+			Owner:          owner.String(),
+			Receiver:       receiver.String(),
+			ClaimType:      claim.GetType(),
+			MultiplierName: multiplierName,
+			Claimed:        sdk.NewCoins(claim.Reward),
+			PaidOut:        sdk.NewCoins(rewardCoin),
+		},
 		sdk.NewEvent(
 			types.EventTypeClaim,
 			sdk.NewAttribute(types.AttributeKeyClaimedBy, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimReceiver, receiver.String()),
 			sdk.NewAttribute(types.AttributeKeyClaimAmount, claim.Reward.String()),
 			sdk.NewAttribute(types.AttributeKeyClaimType, claim.GetType()),
 		),
 	)
+	k.AfterClaimPayout(ctx, owner, sdk.NewCoins(rewardCoin), claim.GetType())
 	return nil
 }
 
@@ -95,14 +105,24 @@ func (k Keeper) ClaimHardReward(ctx sdk.Context, owner, receiver sdk.AccAddress,
 	syncedClaim.Reward = syncedClaim.Reward.Sub(claimingCoins...)
 	k.SetHardLiquidityProviderClaim(ctx, syncedClaim)
 
-	ctx.EventManager().EmitEvent(
+	k.emitEvent(ctx,
+		&types.EventClaimReward{
+			Owner:          owner.String(),
+			Receiver:       receiver.String(),
+			ClaimType:      syncedClaim.GetType(),
+			MultiplierName: multiplierName,
+			Claimed:        claimingCoins,
+			PaidOut:        rewardCoins,
+		},
 		sdk.NewEvent(
 			types.EventTypeClaim,
 			sdk.NewAttribute(types.AttributeKeyClaimedBy, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimReceiver, receiver.String()),
 			sdk.NewAttribute(types.AttributeKeyClaimAmount, claimingCoins.String()),
 			sdk.NewAttribute(types.AttributeKeyClaimType, syncedClaim.GetType()),
 		),
 	)
+	k.AfterClaimPayout(ctx, owner, rewardCoins, syncedClaim.GetType())
 	return nil
 }
 
@@ -149,14 +169,24 @@ func (k Keeper) ClaimDelegatorReward(ctx sdk.Context, owner, receiver sdk.AccAdd
 	syncedClaim.Reward = syncedClaim.Reward.Sub(claimingCoins...)
 	k.SetDelegatorClaim(ctx, syncedClaim)
 
-	ctx.EventManager().EmitEvent(
+	k.emitEvent(ctx,
+		&types.EventClaimReward{
+			Owner:          owner.String(),
+			Receiver:       receiver.String(),
+			ClaimType:      syncedClaim.GetType(),
+			MultiplierName: multiplierName,
+			Claimed:        claimingCoins,
+			PaidOut:        rewardCoins,
+		},
 		sdk.NewEvent(
 			types.EventTypeClaim,
 			sdk.NewAttribute(types.AttributeKeyClaimedBy, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimReceiver, receiver.String()),
 			sdk.NewAttribute(types.AttributeKeyClaimAmount, claimingCoins.String()),
 			sdk.NewAttribute(types.AttributeKeyClaimType, syncedClaim.GetType()),
 		),
 	)
+	k.AfterClaimPayout(ctx, owner, rewardCoins, syncedClaim.GetType())
 	return nil
 }
 
@@ -197,14 +227,24 @@ func (k Keeper) ClaimSwapReward(ctx sdk.Context, owner, receiver sdk.AccAddress,
 	syncedClaim.Reward = syncedClaim.Reward.Sub(claimingCoins...)
 	k.SetSwapClaim(ctx, syncedClaim)
 
-	ctx.EventManager().EmitEvent(
+	k.emitEvent(ctx,
+		&types.EventClaimReward{
+			Owner:          owner.String(),
+			Receiver:       receiver.String(),
+			ClaimType:      syncedClaim.GetType(),
+			MultiplierName: multiplierName,
+			Claimed:        claimingCoins,
+			PaidOut:        rewardCoins,
+		},
 		sdk.NewEvent(
 			types.EventTypeClaim,
 			sdk.NewAttribute(types.AttributeKeyClaimedBy, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimReceiver, receiver.String()),
 			sdk.NewAttribute(types.AttributeKeyClaimAmount, claimingCoins.String()),
 			sdk.NewAttribute(types.AttributeKeyClaimType, syncedClaim.GetType()),
 		),
 	)
+	k.AfterClaimPayout(ctx, owner, rewardCoins, syncedClaim.GetType())
 	return nil
 }
 
@@ -246,14 +286,24 @@ func (k Keeper) ClaimSavingsReward(ctx sdk.Context, owner, receiver sdk.AccAddre
 	syncedClaim.Reward = syncedClaim.Reward.Sub(claimingCoins...)
 	k.SetSavingsClaim(ctx, syncedClaim)
 
-	ctx.EventManager().EmitEvent(
+	k.emitEvent(ctx,
+		&types.EventClaimReward{
+			Owner:          owner.String(),
+			Receiver:       receiver.String(),
+			ClaimType:      syncedClaim.GetType(),
+			MultiplierName: multiplierName,
+			Claimed:        claimingCoins,
+			PaidOut:        rewardCoins,
+		},
 		sdk.NewEvent(
 			types.EventTypeClaim,
 			sdk.NewAttribute(types.AttributeKeyClaimedBy, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimReceiver, receiver.String()),
 			sdk.NewAttribute(types.AttributeKeyClaimAmount, claimingCoins.String()),
 			sdk.NewAttribute(types.AttributeKeyClaimType, syncedClaim.GetType()),
 		),
 	)
+	k.AfterClaimPayout(ctx, owner, rewardCoins, syncedClaim.GetType())
 	return nil
 }
 
@@ -294,13 +344,252 @@ func (k Keeper) ClaimEarnReward(ctx sdk.Context, owner, receiver sdk.AccAddress,
 	syncedClaim.Reward = syncedClaim.Reward.Sub(claimingCoins...)
 	k.SetEarnClaim(ctx, syncedClaim)
 
-	ctx.EventManager().EmitEvent(
+	k.emitEvent(ctx,
+		&types.EventClaimReward{
+			Owner:          owner.String(),
+			Receiver:       receiver.String(),
+			ClaimType:      syncedClaim.GetType(),
+			MultiplierName: multiplierName,
+			Claimed:        claimingCoins,
+			PaidOut:        rewardCoins,
+		},
 		sdk.NewEvent(
 			types.EventTypeClaim,
 			sdk.NewAttribute(types.AttributeKeyClaimedBy, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimReceiver, receiver.String()),
 			sdk.NewAttribute(types.AttributeKeyClaimAmount, claimingCoins.String()),
 			sdk.NewAttribute(types.AttributeKeyClaimType, syncedClaim.GetType()),
 		),
 	)
+	k.AfterClaimPayout(ctx, owner, rewardCoins, syncedClaim.GetType())
 	return nil
 }
+
+// RevokeClaim removes rewards from an owner's claim without paying them out, leaving them in the incentive module account.
+// The claim is synchronized first so rewards accumulated up to the current block can be revoked. All of the claim's
+// rewards are removed when amount is empty.
+func (k Keeper) RevokeClaim(ctx sdk.Context, owner sdk.AccAddress, claimType string, amount sdk.Coins) (sdk.Coins, error) {
+	var revoked, remaining sdk.Coins
+
+	switch claimType {
+	case types.USDXMintingClaimType:
+		claim, found := k.GetUSDXMintingClaim(ctx, owner)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
+		}
+		claim, err := k.SynchronizeUSDXMintingClaim(ctx, claim)
+		if err != nil {
+			return nil, err
+		}
+		revoked, err = revokedRewards(sdk.NewCoins(claim.Reward), amount)
+		if err != nil {
+			return nil, err
+		}
+		claim.Reward = claim.Reward.Sub(sdk.NewCoin(claim.Reward.Denom, revoked.AmountOf(claim.Reward.Denom)))
+		k.SetUSDXMintingClaim(ctx, claim)
+		remaining = sdk.NewCoins(claim.Reward)
+
+	case types.HardLiquidityProviderClaimType:
+		k.SynchronizeHardLiquidityProviderClaim(ctx, owner)
+		claim, found := k.GetHardLiquidityProviderClaim(ctx, owner)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
+		}
+		var err error
+		revoked, err = revokedRewards(claim.Reward, amount)
+		if err != nil {
+			return nil, err
+		}
+		claim.Reward = claim.Reward.Sub(revoked...)
+		k.SetHardLiquidityProviderClaim(ctx, claim)
+		remaining = claim.Reward
+
+	case types.DelegatorClaimType:
+		claim, found := k.GetDelegatorClaim(ctx, owner)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
+		}
+		claim, err := k.SynchronizeDelegatorClaim(ctx, claim)
+		if err != nil {
+			return nil, err
+		}
+		revoked, err = revokedRewards(claim.Reward, amount)
+		if err != nil {
+			return nil, err
+		}
+		claim.Reward = claim.Reward.Sub(revoked...)
+		k.SetDelegatorClaim(ctx, claim)
+		remaining = claim.Reward
+
+	case types.SwapClaimType:
+		claim, found := k.GetSynchronizedSwapClaim(ctx, owner)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
+		}
+		var err error
+		revoked, err = revokedRewards(claim.Reward, amount)
+		if err != nil {
+			return nil, err
+		}
+		claim.Reward = claim.Reward.Sub(revoked...)
+		k.SetSwapClaim(ctx, claim)
+		remaining = claim.Reward
+
+	case types.SavingsClaimType:
+		k.SynchronizeSavingsClaim(ctx, owner)
+		claim, found := k.GetSavingsClaim(ctx, owner)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
+		}
+		var err error
+		revoked, err = revokedRewards(claim.Reward, amount)
+		if err != nil {
+			return nil, err
+		}
+		claim.Reward = claim.Reward.Sub(revoked...)
+		k.SetSavingsClaim(ctx, claim)
+		remaining = claim.Reward
+
+	case types.EarnClaimType:
+		claim, found := k.GetSynchronizedEarnClaim(ctx, owner)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
+		}
+		var err error
+		revoked, err = revokedRewards(claim.Reward, amount)
+		if err != nil {
+			return nil, err
+		}
+		claim.Reward = claim.Reward.Sub(revoked...)
+		k.SetEarnClaim(ctx, claim)
+		remaining = claim.Reward
+
+	default:
+		return nil, errorsmod.Wrapf(types.ErrInvalidClaimType, "%s", claimType)
+	}
+
+	k.emitEvent(ctx,
+		&types.EventRevokeClaim{
+			Owner:     owner.String(),
+			ClaimType: claimType,
+			Revoked:   revoked,
+			Remaining: remaining,
+		},
+		sdk.NewEvent(
+			types.EventTypeRevokeClaim,
+			sdk.NewAttribute(types.AttributeKeyClaimOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimType, claimType),
+			sdk.NewAttribute(types.AttributeKeyRevokedAmount, revoked.String()),
+			sdk.NewAttribute(types.AttributeKeyRemainingReward, remaining.String()),
+		),
+	)
+	return revoked, nil
+}
+
+// revokedRewards returns the rewards to remove from a claim, which are all of the claim's rewards when amount is empty.
+func revokedRewards(reward, amount sdk.Coins) (sdk.Coins, error) {
+	if amount.Empty() {
+		amount = reward
+	}
+	if amount.IsZero() {
+		return nil, errorsmod.Wrap(types.ErrInvalidRevokeAmount, "claim has no rewards to revoke")
+	}
+	if !reward.IsAllGTE(amount) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRevokeAmount, "amount %s exceeds claim rewards %s", amount, reward)
+	}
+	return amount, nil
+}
+
+// MergeClaims merges all claims of from into the claims of to, then deletes the claims of from. It is used when
+// accounts are consolidated, so rewards accrued by the old address are not lost.
+// Claim rewards are summed. Reward indexes take the smaller factor of the two claims, so the merged claim is never
+// synced from a later index than either claim was.
+func (k Keeper) MergeClaims(ctx sdk.Context, from, to sdk.AccAddress) error {
+	if from.Equals(to) {
+		return errorsmod.Wrap(types.ErrInvalidClaimMerge, "from and to addresses must be different")
+	}
+
+	var claimTypes []string
+
+	if fromClaim, found := k.GetUSDXMintingClaim(ctx, from); found {
+		claim := types.NewUSDXMintingClaim(to, fromClaim.Reward, fromClaim.RewardIndexes)
+		if toClaim, found := k.GetUSDXMintingClaim(ctx, to); found {
+			claim.Reward = claim.Reward.Add(toClaim.Reward)
+			claim.RewardIndexes = claim.RewardIndexes.Min(toClaim.RewardIndexes)
+		}
+		k.SetUSDXMintingClaim(ctx, claim)
+		k.DeleteUSDXMintingClaim(ctx, from)
+		claimTypes = append(claimTypes, claim.GetType())
+	}
+
+	if fromClaim, found := k.GetHardLiquidityProviderClaim(ctx, from); found {
+		claim := types.NewHardLiquidityProviderClaim(to, fromClaim.Reward, fromClaim.SupplyRewardIndexes, fromClaim.BorrowRewardIndexes)
+		if toClaim, found := k.GetHardLiquidityProviderClaim(ctx, to); found {
+			claim.Reward = claim.Reward.Add(toClaim.Reward...)
+			claim.SupplyRewardIndexes = claim.SupplyRewardIndexes.Min(toClaim.SupplyRewardIndexes)
+			claim.BorrowRewardIndexes = claim.BorrowRewardIndexes.Min(toClaim.BorrowRewardIndexes)
+		}
+		k.SetHardLiquidityProviderClaim(ctx, claim)
+		k.DeleteHardLiquidityProviderClaim(ctx, from)
+		claimTypes = append(claimTypes, claim.GetType())
+	}
+
+	if fromClaim, found := k.GetDelegatorClaim(ctx, from); found {
+		claim := types.NewDelegatorClaim(to, fromClaim.Reward, fromClaim.RewardIndexes)
+		checkpointID := k.GetDelegatorClaimCheckpointID(ctx, from)
+		if toClaim, found := k.GetDelegatorClaim(ctx, to); found {
+			claim.Reward = claim.Reward.Add(toClaim.Reward...)
+			claim.RewardIndexes = claim.RewardIndexes.Min(toClaim.RewardIndexes)
+			if toCheckpointID := k.GetDelegatorClaimCheckpointID(ctx, to); toCheckpointID < checkpointID {
+				checkpointID = toCheckpointID
+			}
+		}
+		k.SetDelegatorClaim(ctx, claim)
+		k.SetDelegatorClaimCheckpointID(ctx, to, checkpointID)
+		k.DeleteDelegatorClaim(ctx, from)
+		claimTypes = append(claimTypes, claim.GetType())
+	}
+
+	if fromClaim, found := k.GetSwapClaim(ctx, from); found {
+		claim := types.NewSwapClaim(to, fromClaim.Reward, fromClaim.RewardIndexes)
+		if toClaim, found := k.GetSwapClaim(ctx, to); found {
+			claim.Reward = claim.Reward.Add(toClaim.Reward...)
+			claim.RewardIndexes = claim.RewardIndexes.Min(toClaim.RewardIndexes)
+		}
+		k.SetSwapClaim(ctx, claim)
+		k.DeleteSwapClaim(ctx, from)
+		claimTypes = append(claimTypes, claim.GetType())
+	}
+
+	if fromClaim, found := k.GetSavingsClaim(ctx, from); found {
+		claim := types.NewSavingsClaim(to, fromClaim.Reward, fromClaim.RewardIndexes)
+		if toClaim, found := k.GetSavingsClaim(ctx, to); found {
+			claim.Reward = claim.Reward.Add(toClaim.Reward...)
+			claim.RewardIndexes = claim.RewardIndexes.Min(toClaim.RewardIndexes)
+		}
+		k.SetSavingsClaim(ctx, claim)
+		k.DeleteSavingsClaim(ctx, from)
+		claimTypes = append(claimTypes, claim.GetType())
+	}
+
+	if fromClaim, found := k.GetEarnClaim(ctx, from); found {
+		claim := types.NewEarnClaim(to, fromClaim.Reward, fromClaim.RewardIndexes)
+		if toClaim, found := k.GetEarnClaim(ctx, to); found {
+			claim.Reward = claim.Reward.Add(toClaim.Reward...)
+			claim.RewardIndexes = claim.RewardIndexes.Min(toClaim.RewardIndexes)
+		}
+		k.SetEarnClaim(ctx, claim)
+		k.DeleteEarnClaim(ctx, from)
+		claimTypes = append(claimTypes, claim.GetType())
+	}
+
+	if len(claimTypes) == 0 {
+		return errorsmod.Wrapf(types.ErrNoClaimsFound, "address: %s", from)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMergeClaims{
+		From:       from.String(),
+		To:         to.String(),
+		ClaimTypes: claimTypes,
+	})
+}
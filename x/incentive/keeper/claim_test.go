@@ -5,8 +5,12 @@ import (
 	"testing"
 	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/kava-labs/kava/x/incentive/keeper"
 	"github.com/kava-labs/kava/x/incentive/types"
 )
 
@@ -84,3 +88,218 @@ func (suite *ClaimTests) TestCannotClaimAfterEndTime() {
 	err := suite.keeper.ClaimDelegatorReward(suite.ctx, claim.Owner, claim.Owner, "hard", "small")
 	suite.ErrorIs(err, types.ErrClaimExpired)
 }
+
+func (suite *ClaimTests) TestRevokeClaimRemovesSynchronizedRewards() {
+	owner := arbitraryAddress()
+	poolID := "busd:ukava"
+
+	swapKeeper := newFakeSwapKeeper().addDeposit(poolID, owner, i(1e9))
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, nil, swapKeeper, nil, nil, nil)
+
+	globalIndexes := types.MultiRewardIndexes{
+		{
+			CollateralType: poolID,
+			RewardIndexes:  types.RewardIndexes{{CollateralType: "swp", RewardFactor: d("0.002")}},
+		},
+	}
+	suite.storeGlobalSwapIndexes(globalIndexes)
+	suite.storeSwapClaim(types.SwapClaim{
+		BaseMultiClaim: types.BaseMultiClaim{
+			Owner:  owner,
+			Reward: cs(c("swp", 1000), c("ukava", 500)),
+		},
+		RewardIndexes: types.MultiRewardIndexes{
+			{
+				CollateralType: poolID,
+				RewardIndexes:  types.RewardIndexes{{CollateralType: "swp", RewardFactor: d("0.001")}},
+			},
+		},
+	})
+
+	// rewards accumulated since the claim was last synced can be revoked
+	revoked, err := suite.keeper.RevokeClaim(suite.ctx, owner, types.SwapClaimType, cs(c("swp", 1e6)))
+	suite.Require().NoError(err)
+	suite.Equal(cs(c("swp", 1e6)), revoked)
+
+	claim, found := suite.keeper.GetSwapClaim(suite.ctx, owner)
+	suite.Require().True(found)
+	suite.Equal(cs(c("swp", 1000), c("ukava", 500)), claim.Reward)
+	suite.Equal(globalIndexes, claim.RewardIndexes)
+
+	suite.Equal(
+		sdk.NewEvent(
+			types.EventTypeRevokeClaim,
+			sdk.NewAttribute(types.AttributeKeyClaimOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimType, types.SwapClaimType),
+			sdk.NewAttribute(types.AttributeKeyRevokedAmount, "1000000swp"),
+			sdk.NewAttribute(types.AttributeKeyRemainingReward, "1000swp,500ukava"),
+		),
+		suite.ctx.EventManager().Events()[0],
+	)
+	event, err := sdk.ParseTypedEvent(abci.Event(suite.ctx.EventManager().Events()[1]))
+	suite.Require().NoError(err)
+	suite.Equal(&types.EventRevokeClaim{
+		Owner:     owner.String(),
+		ClaimType: types.SwapClaimType,
+		Revoked:   cs(c("swp", 1e6)),
+		Remaining: cs(c("swp", 1000), c("ukava", 500)),
+	}, event)
+
+	// an empty amount revokes all remaining rewards
+	revoked, err = suite.keeper.RevokeClaim(suite.ctx, owner, types.SwapClaimType, nil)
+	suite.Require().NoError(err)
+	suite.Equal(cs(c("swp", 1000), c("ukava", 500)), revoked)
+
+	claim, found = suite.keeper.GetSwapClaim(suite.ctx, owner)
+	suite.Require().True(found)
+	suite.True(claim.Reward.IsZero())
+
+	// nothing is left to revoke
+	_, err = suite.keeper.RevokeClaim(suite.ctx, owner, types.SwapClaimType, nil)
+	suite.ErrorIs(err, types.ErrInvalidRevokeAmount)
+}
+
+func (suite *ClaimTests) TestRevokeClaimUSDXMinting() {
+	owner := arbitraryAddress()
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, newFakeCDPKeeper(), nil, nil, nil, nil, nil, nil, nil)
+
+	suite.keeper.SetUSDXMintingClaim(suite.ctx, types.NewUSDXMintingClaim(owner, c(types.USDXMintingRewardDenom, 1e6), nil))
+
+	revoked, err := suite.keeper.RevokeClaim(suite.ctx, owner, types.USDXMintingClaimType, cs(c(types.USDXMintingRewardDenom, 4e5)))
+	suite.Require().NoError(err)
+	suite.Equal(cs(c(types.USDXMintingRewardDenom, 4e5)), revoked)
+
+	claim, found := suite.keeper.GetUSDXMintingClaim(suite.ctx, owner)
+	suite.Require().True(found)
+	suite.Equal(c(types.USDXMintingRewardDenom, 6e5), claim.Reward)
+}
+
+func (suite *ClaimTests) TestCannotRevokeInvalidClaim() {
+	owner := arbitraryAddress()
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	suite.storeDelegatorClaim(types.DelegatorClaim{
+		BaseMultiClaim: types.BaseMultiClaim{
+			Owner:  owner,
+			Reward: cs(c("hard", 1000)),
+		},
+	})
+
+	_, err := suite.keeper.RevokeClaim(suite.ctx, sdk.AccAddress("unknown owner"), types.DelegatorClaimType, nil)
+	suite.ErrorIs(err, types.ErrClaimNotFound)
+
+	_, err = suite.keeper.RevokeClaim(suite.ctx, owner, "unknown", nil)
+	suite.ErrorIs(err, types.ErrInvalidClaimType)
+
+	_, err = suite.keeper.RevokeClaim(suite.ctx, owner, types.EarnClaimType, nil)
+	suite.ErrorIs(err, types.ErrClaimNotFound)
+
+	// revoked amounts cannot exceed the claim rewards
+	_, err = suite.keeper.RevokeClaim(suite.ctx, owner, types.DelegatorClaimType, cs(c("hard", 1001)))
+	suite.ErrorIs(err, types.ErrInvalidRevokeAmount)
+	_, err = suite.keeper.RevokeClaim(suite.ctx, owner, types.DelegatorClaimType, cs(c("ukava", 1)))
+	suite.ErrorIs(err, types.ErrInvalidRevokeAmount)
+}
+
+func (suite *ClaimTests) TestRevokeClaimRequiresAuthority() {
+	owner := arbitraryAddress()
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+
+	suite.storeDelegatorClaim(types.DelegatorClaim{
+		BaseMultiClaim: types.BaseMultiClaim{
+			Owner:  owner,
+			Reward: cs(c("hard", 1000)),
+		},
+	})
+
+	msg := types.NewMsgRevokeClaim(owner.String(), owner.String(), types.DelegatorClaimType, nil)
+	_, err := msgServer.RevokeClaim(sdk.WrapSDKContext(suite.ctx), &msg)
+	suite.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	msg.Authority = suite.keeper.GetAuthority().String()
+	_, err = msgServer.RevokeClaim(sdk.WrapSDKContext(suite.ctx), &msg)
+	suite.Require().NoError(err)
+
+	claim, found := suite.keeper.GetDelegatorClaim(suite.ctx, owner)
+	suite.Require().True(found)
+	suite.True(claim.Reward.IsZero())
+}
+
+func (suite *ClaimTests) TestMergeClaims() {
+	from, to := sdk.AccAddress("from address"), sdk.AccAddress("to address")
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	suite.storeSwapClaim(types.NewSwapClaim(from, cs(c("swp", 1000)), types.MultiRewardIndexes{
+		types.NewMultiRewardIndex("busd:ukava", types.RewardIndexes{types.NewRewardIndex("swp", d("0.1"))}),
+		types.NewMultiRewardIndex("busd:hard", types.RewardIndexes{types.NewRewardIndex("swp", d("0.3"))}),
+	}))
+	suite.storeSwapClaim(types.NewSwapClaim(to, cs(c("swp", 500), c("ukava", 200)), types.MultiRewardIndexes{
+		types.NewMultiRewardIndex("busd:ukava", types.RewardIndexes{types.NewRewardIndex("swp", d("0.2"))}),
+	}))
+	// claims only held by from are moved to to
+	suite.storeDelegatorClaim(types.NewDelegatorClaim(from, cs(c("hard", 100)), types.MultiRewardIndexes{
+		types.NewMultiRewardIndex(types.BondDenom, types.RewardIndexes{types.NewRewardIndex("hard", d("0.5"))}),
+	}))
+	suite.keeper.SetUSDXMintingClaim(suite.ctx, types.NewUSDXMintingClaim(from, c(types.USDXMintingRewardDenom, 300), nil))
+	suite.keeper.SetUSDXMintingClaim(suite.ctx, types.NewUSDXMintingClaim(to, c(types.USDXMintingRewardDenom, 400), nil))
+
+	suite.Require().NoError(suite.keeper.MergeClaims(suite.ctx, from, to))
+
+	swapClaim, found := suite.keeper.GetSwapClaim(suite.ctx, to)
+	suite.Require().True(found)
+	suite.Equal(types.NewSwapClaim(to, cs(c("swp", 1500), c("ukava", 200)), types.MultiRewardIndexes{
+		types.NewMultiRewardIndex("busd:ukava", types.RewardIndexes{types.NewRewardIndex("swp", d("0.1"))}),
+		types.NewMultiRewardIndex("busd:hard", types.RewardIndexes{types.NewRewardIndex("swp", d("0.3"))}),
+	}), swapClaim)
+
+	delegatorClaim, found := suite.keeper.GetDelegatorClaim(suite.ctx, to)
+	suite.Require().True(found)
+	suite.Equal(types.NewDelegatorClaim(to, cs(c("hard", 100)), types.MultiRewardIndexes{
+		types.NewMultiRewardIndex(types.BondDenom, types.RewardIndexes{types.NewRewardIndex("hard", d("0.5"))}),
+	}), delegatorClaim)
+
+	usdxClaim, found := suite.keeper.GetUSDXMintingClaim(suite.ctx, to)
+	suite.Require().True(found)
+	suite.Equal(c(types.USDXMintingRewardDenom, 700), usdxClaim.Reward)
+
+	_, found = suite.keeper.GetSwapClaim(suite.ctx, from)
+	suite.False(found)
+	_, found = suite.keeper.GetDelegatorClaim(suite.ctx, from)
+	suite.False(found)
+	_, found = suite.keeper.GetUSDXMintingClaim(suite.ctx, from)
+	suite.False(found)
+
+	events := suite.ctx.EventManager().Events()
+	event, err := sdk.ParseTypedEvent(abci.Event(events[len(events)-1]))
+	suite.Require().NoError(err)
+	suite.Equal(&types.EventMergeClaims{
+		From:       from.String(),
+		To:         to.String(),
+		ClaimTypes: []string{types.USDXMintingClaimType, types.DelegatorClaimType, types.SwapClaimType},
+	}, event)
+
+	// nothing is left to merge
+	suite.ErrorIs(suite.keeper.MergeClaims(suite.ctx, from, to), types.ErrNoClaimsFound)
+	suite.ErrorIs(suite.keeper.MergeClaims(suite.ctx, to, to), types.ErrInvalidClaimMerge)
+}
+
+func (suite *ClaimTests) TestMergeClaimsRequiresAuthority() {
+	from, to := sdk.AccAddress("from address"), sdk.AccAddress("to address")
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+
+	suite.storeEarnClaim(types.NewEarnClaim(from, cs(c("hard", 1000)), nil))
+
+	msg := types.NewMsgMergeClaims(from.String(), from.String(), to.String())
+	_, err := msgServer.MergeClaims(sdk.WrapSDKContext(suite.ctx), &msg)
+	suite.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	msg.Authority = suite.keeper.GetAuthority().String()
+	_, err = msgServer.MergeClaims(sdk.WrapSDKContext(suite.ctx), &msg)
+	suite.Require().NoError(err)
+
+	claim, found := suite.keeper.GetEarnClaim(suite.ctx, to)
+	suite.Require().True(found)
+	suite.Equal(cs(c("hard", 1000)), claim.Reward)
+}
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	proto "github.com/cosmos/gogoproto/proto"
+)
+
+// emitEvent emits a typed event, preceded by its legacy untyped event unless legacy events are disabled.
+func (k Keeper) emitEvent(ctx sdk.Context, event proto.Message, legacyEvent sdk.Event) {
+	if !k.legacyEventsDisabled {
+		ctx.EventManager().EmitEvent(legacyEvent)
+	}
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		// typed events only fail to marshal if they are not registered proto messages
+		panic(fmt.Sprintf("failed to emit %s event: %s", proto.MessageName(event), err))
+	}
+}
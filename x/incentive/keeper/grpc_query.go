@@ -2,9 +2,13 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -21,6 +25,17 @@ const (
 	RewardTypeEarn        = "earn"
 )
 
+const (
+	RewardSourceUSDXMinting   = "usdx_minting"
+	RewardSourceHardSupply    = "hard_supply"
+	RewardSourceHardBorrow    = "hard_borrow"
+	RewardSourceDelegator     = "delegator"
+	RewardSourceSwap          = "swap"
+	RewardSourceSavings       = "savings"
+	RewardSourceEarn          = "earn"
+	RewardSourceCdpCollateral = "cdp_collateral"
+)
+
 type queryServer struct {
 	keeper Keeper
 }
@@ -73,6 +88,23 @@ func (s queryServer) Rewards(
 		owner = addr
 	}
 
+	filter := rewardsFilter{denom: req.Denom, minAmount: req.MinAmount}
+	if err := filter.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Claims of a single reward type can be paginated over the store, as
+	// there's at most one claim of each type for an owner.
+	if req.Pagination != nil && !hasOwner {
+		pageRes, err := s.paginateRewards(sdkCtx, &res, req.RewardType, req.Pagination, !req.Unsynchronized, filter)
+		if err != nil {
+			return nil, err
+		}
+		res.Pagination = pageRes
+
+		return &res, nil
+	}
+
 	if err := s.queryRewards(sdkCtx, &res, owner, hasOwner, req.RewardType); err != nil {
 		return nil, err
 	}
@@ -83,6 +115,8 @@ func (s queryServer) Rewards(
 		}
 	}
 
+	filter.filterRewards(&res)
+
 	return &res, nil
 }
 
@@ -96,56 +130,16 @@ func (s queryServer) RewardFactors(
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	var usdxFactors types.RewardIndexes
-	s.keeper.IterateUSDXMintingRewardFactors(sdkCtx, func(collateralType string, factor sdk.Dec) (stop bool) {
-		usdxFactors = usdxFactors.With(collateralType, factor)
-		return false
-	})
-
-	var supplyFactors types.MultiRewardIndexes
-	s.keeper.IterateHardSupplyRewardIndexes(sdkCtx, func(denom string, indexes types.RewardIndexes) (stop bool) {
-		supplyFactors = supplyFactors.With(denom, indexes)
-		return false
-	})
-
-	var borrowFactors types.MultiRewardIndexes
-	s.keeper.IterateHardBorrowRewardIndexes(sdkCtx, func(denom string, indexes types.RewardIndexes) (stop bool) {
-		borrowFactors = borrowFactors.With(denom, indexes)
-		return false
-	})
-
-	var delegatorFactors types.MultiRewardIndexes
-	s.keeper.IterateDelegatorRewardIndexes(sdkCtx, func(denom string, indexes types.RewardIndexes) (stop bool) {
-		delegatorFactors = delegatorFactors.With(denom, indexes)
-		return false
-	})
-
-	var swapFactors types.MultiRewardIndexes
-	s.keeper.IterateSwapRewardIndexes(sdkCtx, func(poolID string, indexes types.RewardIndexes) (stop bool) {
-		swapFactors = swapFactors.With(poolID, indexes)
-		return false
-	})
-
-	var savingsFactors types.MultiRewardIndexes
-	s.keeper.IterateSavingsRewardIndexes(sdkCtx, func(denom string, indexes types.RewardIndexes) (stop bool) {
-		savingsFactors = savingsFactors.With(denom, indexes)
-		return false
-	})
-
-	var earnFactors types.MultiRewardIndexes
-	s.keeper.IterateEarnRewardIndexes(sdkCtx, func(denom string, indexes types.RewardIndexes) (stop bool) {
-		earnFactors = earnFactors.With(denom, indexes)
-		return false
-	})
+	indexes := s.keeper.GetCurrentRewardIndexes(sdkCtx)
 
 	return &types.QueryRewardFactorsResponse{
-		UsdxMintingRewardFactors: usdxFactors,
-		HardSupplyRewardFactors:  supplyFactors,
-		HardBorrowRewardFactors:  borrowFactors,
-		DelegatorRewardFactors:   delegatorFactors,
-		SwapRewardFactors:        swapFactors,
-		SavingsRewardFactors:     savingsFactors,
-		EarnRewardFactors:        earnFactors,
+		UsdxMintingRewardFactors: indexes.USDXMintingRewardIndexes,
+		HardSupplyRewardFactors:  indexes.HardSupplyRewardIndexes,
+		HardBorrowRewardFactors:  indexes.HardBorrowRewardIndexes,
+		DelegatorRewardFactors:   indexes.DelegatorRewardIndexes,
+		SwapRewardFactors:        indexes.SwapRewardIndexes,
+		SavingsRewardFactors:     indexes.SavingsRewardIndexes,
+		EarnRewardFactors:        indexes.EarnRewardIndexes,
 	}, nil
 }
 
@@ -195,6 +189,217 @@ func (s queryServer) Apy(
 	}, nil
 }
 
+func (s queryServer) RewardSchedule(
+	ctx context.Context,
+	req *types.QueryRewardScheduleRequest,
+) (*types.QueryRewardScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := s.keeper.GetParams(sdkCtx)
+
+	var rewardPeriods types.MultiRewardPeriods
+	switch req.Source {
+	case RewardSourceHardSupply:
+		rewardPeriods = params.HardSupplyRewardPeriods
+	case RewardSourceHardBorrow:
+		rewardPeriods = params.HardBorrowRewardPeriods
+	case RewardSourceSwap:
+		rewardPeriods = params.SwapRewardPeriods
+	case RewardSourceEarn:
+		rewardPeriods = params.EarnRewardPeriods
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reward source %q", req.Source)
+	}
+
+	rewardPeriod, found := rewardPeriods.GetMultiRewardPeriod(req.CollateralType)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no %s reward period for %q", req.Source, req.CollateralType)
+	}
+
+	// Sources or rewards without a price are reported with a zero value and
+	// apy rather than failing the query.
+	totalValue, priced, err := s.sourceUSDValue(sdkCtx, req.Source, req.CollateralType)
+	if err != nil {
+		return nil, err
+	}
+
+	apy := sdk.ZeroDec()
+	blockTime := sdkCtx.BlockTime()
+	if priced && rewardPeriod.Active && !blockTime.Before(rewardPeriod.Start) && blockTime.Before(rewardPeriod.End) {
+		if rewardAPY, found := GetAPYFromUSDValue(sdkCtx, s.keeper, rewardPeriod, totalValue); found {
+			apy = rewardAPY
+		}
+	}
+
+	return &types.QueryRewardScheduleResponse{
+		RewardPeriod: rewardPeriod,
+		TotalValue:   totalValue,
+		Apy:          apy,
+	}, nil
+}
+
+func (s queryServer) UpcomingRewardPeriods(
+	ctx context.Context,
+	req *types.QueryUpcomingRewardPeriodsRequest,
+) (*types.QueryUpcomingRewardPeriodsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	sources := GetRewardPeriodsBySource(s.keeper.GetParams(sdkCtx))
+	if req.Source != "" && !rewardSourceIsValid(sources, req.Source) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reward source %q", req.Source)
+	}
+
+	rewardPeriods := []types.UpcomingRewardPeriod{}
+	for _, source := range sources {
+		if req.Source != "" && req.Source != source.Source {
+			continue
+		}
+		for _, period := range source.Periods {
+			if period.Start.After(sdkCtx.BlockTime()) {
+				rewardPeriods = append(rewardPeriods, types.UpcomingRewardPeriod{
+					Source:       source.Source,
+					RewardPeriod: period,
+				})
+			}
+		}
+	}
+
+	return &types.QueryUpcomingRewardPeriodsResponse{
+		RewardPeriods: rewardPeriods,
+	}, nil
+}
+
+func (s queryServer) RewardCaps(
+	ctx context.Context,
+	req *types.QueryRewardCapsRequest,
+) (*types.QueryRewardCapsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Source != "" && !rewardSourceIsValid(GetRewardPeriodsBySource(s.keeper.GetParams(sdkCtx)), req.Source) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reward source %q", req.Source)
+	}
+
+	return &types.QueryRewardCapsResponse{
+		RewardCaps: s.keeper.GetRewardCaps(sdkCtx, req.Source),
+	}, nil
+}
+
+func (s queryServer) RewardsAtHeight(
+	ctx context.Context,
+	req *types.QueryRewardsAtHeightRequest,
+) (*types.QueryRewardsAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	if req.Height <= 0 || req.Height > sdkCtx.BlockHeight() {
+		return nil, status.Errorf(codes.InvalidArgument, "height must be between 1 and the current height %d", sdkCtx.BlockHeight())
+	}
+
+	snapshot, found := s.keeper.GetLatestRewardIndexesSnapshot(sdkCtx, req.Height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no reward index snapshot found at or before height %d", req.Height)
+	}
+
+	var rewards types.QueryRewardsResponse
+	if err := s.queryRewards(sdkCtx, &rewards, owner, true, req.RewardType); err != nil {
+		return nil, err
+	}
+
+	// Claims are synchronized whenever their source shares change, so the current shares are the ones held since the
+	// claim's last synchronization. If that happened after the snapshot, the claim holds newer reward indexes than the
+	// snapshot and its rewards at the snapshot are unknown.
+	if claimIndexesExceed(rewards, snapshot) {
+		return nil, status.Errorf(codes.FailedPrecondition, "rewards of %s were updated after snapshot height %d", owner, snapshot.Height)
+	}
+
+	// Synchronize the claims against the snapshot's reward indexes, in a branch of the store that is discarded.
+	snapshotCtx, _ := sdkCtx.CacheContext()
+	s.keeper.restoreRewardIndexes(snapshotCtx, snapshot)
+	if err := s.synchronizeRewards(snapshotCtx, &rewards); err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRewardsAtHeightResponse{
+		SnapshotHeight:              snapshot.Height,
+		USDXMintingClaims:           rewards.USDXMintingClaims,
+		HardLiquidityProviderClaims: rewards.HardLiquidityProviderClaims,
+		DelegatorClaims:             rewards.DelegatorClaims,
+		SwapClaims:                  rewards.SwapClaims,
+		SavingsClaims:               rewards.SavingsClaims,
+		EarnClaims:                  rewards.EarnClaims,
+	}, nil
+}
+
+// sourceUSDValue returns the USD value of everything deposited in a reward
+// source. It returns false if any of the deposited denoms cannot be priced.
+func (s queryServer) sourceUSDValue(ctx sdk.Context, source, collateralType string) (sdk.Dec, bool, error) {
+	switch source {
+	case RewardSourceHardSupply:
+		supplied, _ := s.keeper.hardKeeper.GetSuppliedCoins(ctx)
+		value, found := GetUSDValue(ctx, s.keeper, collateralType, supplied.AmountOf(collateralType))
+		return value, found, nil
+
+	case RewardSourceHardBorrow:
+		borrowed, _ := s.keeper.hardKeeper.GetBorrowedCoins(ctx)
+		value, found := GetUSDValue(ctx, s.keeper, collateralType, borrowed.AmountOf(collateralType))
+		return value, found, nil
+
+	case RewardSourceSwap:
+		pool, found := s.keeper.swapKeeper.GetPool(ctx, collateralType)
+		if !found {
+			return sdk.ZeroDec(), true, nil
+		}
+		valueA, foundA := GetUSDValue(ctx, s.keeper, pool.ReservesA.Denom, pool.ReservesA.Amount)
+		valueB, foundB := GetUSDValue(ctx, s.keeper, pool.ReservesB.Denom, pool.ReservesB.Amount)
+		if !foundA || !foundB {
+			return sdk.ZeroDec(), false, nil
+		}
+		return valueA.Add(valueB), true, nil
+
+	case RewardSourceEarn:
+		// bkava rewards are paid to all bkava derivatives deposited in earn,
+		// which are valued in ukava.
+		if collateralType == liquidtypes.DefaultDerivativeDenom {
+			total, err := GetTotalEarnBkavaDeposited(ctx, s.keeper)
+			if err != nil {
+				return sdk.ZeroDec(), false, err
+			}
+			value, found := GetUSDValue(ctx, s.keeper, types.BondDenom, total)
+			return value, found, nil
+		}
+		if _, found := s.keeper.earnKeeper.GetVaultTotalShares(ctx, collateralType); !found {
+			return sdk.ZeroDec(), true, nil
+		}
+		vaultValue, err := s.keeper.earnKeeper.GetVaultTotalValue(ctx, collateralType)
+		if err != nil {
+			return sdk.ZeroDec(), false, err
+		}
+		value, found := GetUSDValue(ctx, s.keeper, collateralType, vaultValue.Amount)
+		return value, found, nil
+	}
+
+	return sdk.ZeroDec(), false, status.Errorf(codes.InvalidArgument, "invalid reward source %q", source)
+}
+
 // queryRewards queries the rewards for a given owner and reward type, updating
 // the response with the results in place.
 func (s queryServer) queryRewards(
@@ -331,6 +536,237 @@ func (s queryServer) synchronizeRewards(
 	return nil
 }
 
+// paginateRewards queries a page of the claims of a single reward type that
+// match the filter, updating the response with the results in place.
+func (s queryServer) paginateRewards(
+	ctx sdk.Context,
+	res *types.QueryRewardsResponse,
+	rewardType string,
+	pageReq *query.PageRequest,
+	synchronize bool,
+	filter rewardsFilter,
+) (*query.PageResponse, error) {
+	switch strings.ToLower(rewardType) {
+	case RewardTypeUSDXMinting:
+		return s.paginateClaims(ctx, types.USDXMintingClaimKeyPrefix, pageReq, filter, func(value []byte) (sdk.Coins, func(), error) {
+			var claim types.USDXMintingClaim
+			if err := s.keeper.cdc.Unmarshal(value, &claim); err != nil {
+				return nil, nil, err
+			}
+			if synchronize {
+				claim = s.keeper.SimulateUSDXMintingSynchronization(ctx, claim)
+			}
+			return sdk.Coins{claim.Reward}, func() { res.USDXMintingClaims = append(res.USDXMintingClaims, claim) }, nil
+		})
+
+	case RewardTypeHard:
+		return s.paginateClaims(ctx, types.HardLiquidityClaimKeyPrefix, pageReq, filter, func(value []byte) (sdk.Coins, func(), error) {
+			var claim types.HardLiquidityProviderClaim
+			if err := s.keeper.cdc.Unmarshal(value, &claim); err != nil {
+				return nil, nil, err
+			}
+			if synchronize {
+				claim = s.keeper.SimulateHardSynchronization(ctx, claim)
+			}
+			return claim.Reward, func() { res.HardLiquidityProviderClaims = append(res.HardLiquidityProviderClaims, claim) }, nil
+		})
+
+	case RewardTypeDelegator:
+		return s.paginateClaims(ctx, types.DelegatorClaimKeyPrefix, pageReq, filter, func(value []byte) (sdk.Coins, func(), error) {
+			var claim types.DelegatorClaim
+			if err := s.keeper.cdc.Unmarshal(value, &claim); err != nil {
+				return nil, nil, err
+			}
+			if synchronize {
+				claim = s.keeper.SimulateDelegatorSynchronization(ctx, claim)
+			}
+			return claim.Reward, func() { res.DelegatorClaims = append(res.DelegatorClaims, claim) }, nil
+		})
+
+	case RewardTypeSwap:
+		return s.paginateClaims(ctx, types.SwapClaimKeyPrefix, pageReq, filter, func(value []byte) (sdk.Coins, func(), error) {
+			var claim types.SwapClaim
+			if err := s.keeper.cdc.Unmarshal(value, &claim); err != nil {
+				return nil, nil, err
+			}
+			if synchronize {
+				syncedClaim, found := s.keeper.GetSynchronizedSwapClaim(ctx, claim.Owner)
+				if !found {
+					return nil, nil, status.Errorf(codes.Internal, "previously found swap claim for owner %s should still be found", claim.Owner)
+				}
+				claim = syncedClaim
+			}
+			return claim.Reward, func() { res.SwapClaims = append(res.SwapClaims, claim) }, nil
+		})
+
+	case RewardTypeSavings:
+		return s.paginateClaims(ctx, types.SavingsClaimKeyPrefix, pageReq, filter, func(value []byte) (sdk.Coins, func(), error) {
+			var claim types.SavingsClaim
+			if err := s.keeper.cdc.Unmarshal(value, &claim); err != nil {
+				return nil, nil, err
+			}
+			if synchronize {
+				syncedClaim, found := s.keeper.GetSynchronizedSavingsClaim(ctx, claim.Owner)
+				if !found {
+					return nil, nil, status.Errorf(codes.Internal, "previously found savings claim for owner %s should still be found", claim.Owner)
+				}
+				claim = syncedClaim
+			}
+			return claim.Reward, func() { res.SavingsClaims = append(res.SavingsClaims, claim) }, nil
+		})
+
+	case RewardTypeEarn:
+		return s.paginateClaims(ctx, types.EarnClaimKeyPrefix, pageReq, filter, func(value []byte) (sdk.Coins, func(), error) {
+			var claim types.EarnClaim
+			if err := s.keeper.cdc.Unmarshal(value, &claim); err != nil {
+				return nil, nil, err
+			}
+			if synchronize {
+				syncedClaim, found := s.keeper.GetSynchronizedEarnClaim(ctx, claim.Owner)
+				if !found {
+					return nil, nil, status.Errorf(codes.Internal, "previously found earn claim for owner %s should still be found", claim.Owner)
+				}
+				claim = syncedClaim
+			}
+			return claim.Reward, func() { res.EarnClaims = append(res.EarnClaims, claim) }, nil
+		})
+
+	case "":
+		return nil, status.Error(codes.InvalidArgument, "pagination requires a reward type or an owner")
+
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reward type: %s", rewardType)
+	}
+}
+
+// paginateClaims paginates over the claims stored under a key prefix, only
+// counting the claims that match the filter. decode returns the claim's reward
+// along with a function that adds the claim to the response.
+func (s queryServer) paginateClaims(
+	ctx sdk.Context,
+	keyPrefix []byte,
+	pageReq *query.PageRequest,
+	filter rewardsFilter,
+	decode func(value []byte) (sdk.Coins, func(), error),
+) (*query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(s.keeper.key), keyPrefix)
+
+	return query.FilteredPaginate(store, pageReq, func(_, value []byte, accumulate bool) (bool, error) {
+		reward, appendClaim, err := decode(value)
+		if err != nil {
+			return false, err
+		}
+		if !filter.matches(reward) {
+			return false, nil
+		}
+		if accumulate {
+			appendClaim()
+		}
+		return true, nil
+	})
+}
+
+// rewardsFilter selects claims by the reward they hold.
+type rewardsFilter struct {
+	denom     string
+	minAmount *sdkmath.Int
+}
+
+// Validate returns an error if the filter is invalid.
+func (f rewardsFilter) Validate() error {
+	if f.denom != "" {
+		if err := sdk.ValidateDenom(f.denom); err != nil {
+			return err
+		}
+	}
+	if f.minAmount != nil {
+		if f.denom == "" {
+			return fmt.Errorf("min amount requires a denom")
+		}
+		if f.minAmount.IsNegative() {
+			return fmt.Errorf("min amount cannot be negative: %s", f.minAmount)
+		}
+	}
+	return nil
+}
+
+// matches returns true if a claim's reward passes the filter.
+func (f rewardsFilter) matches(reward sdk.Coins) bool {
+	if f.denom == "" {
+		return true
+	}
+	amount := reward.AmountOf(f.denom)
+	if f.minAmount != nil {
+		return amount.GTE(*f.minAmount)
+	}
+	return amount.IsPositive()
+}
+
+// filterRewards removes the claims that don't match the filter from the
+// response in place.
+func (f rewardsFilter) filterRewards(res *types.QueryRewardsResponse) {
+	if f.denom == "" {
+		return
+	}
+
+	var usdxMintingClaims types.USDXMintingClaims
+	for _, claim := range res.USDXMintingClaims {
+		if f.matches(sdk.Coins{claim.Reward}) {
+			usdxMintingClaims = append(usdxMintingClaims, claim)
+		}
+	}
+	res.USDXMintingClaims = usdxMintingClaims
+
+	var hardClaims types.HardLiquidityProviderClaims
+	for _, claim := range res.HardLiquidityProviderClaims {
+		if f.matches(claim.Reward) {
+			hardClaims = append(hardClaims, claim)
+		}
+	}
+	res.HardLiquidityProviderClaims = hardClaims
+
+	var delegatorClaims types.DelegatorClaims
+	for _, claim := range res.DelegatorClaims {
+		if f.matches(claim.Reward) {
+			delegatorClaims = append(delegatorClaims, claim)
+		}
+	}
+	res.DelegatorClaims = delegatorClaims
+
+	var swapClaims types.SwapClaims
+	for _, claim := range res.SwapClaims {
+		if f.matches(claim.Reward) {
+			swapClaims = append(swapClaims, claim)
+		}
+	}
+	res.SwapClaims = swapClaims
+
+	var savingsClaims types.SavingsClaims
+	for _, claim := range res.SavingsClaims {
+		if f.matches(claim.Reward) {
+			savingsClaims = append(savingsClaims, claim)
+		}
+	}
+	res.SavingsClaims = savingsClaims
+
+	var earnClaims types.EarnClaims
+	for _, claim := range res.EarnClaims {
+		if f.matches(claim.Reward) {
+			earnClaims = append(earnClaims, claim)
+		}
+	}
+	res.EarnClaims = earnClaims
+}
+
+func rewardSourceIsValid(sources []SourceRewardPeriods, source string) bool {
+	for _, s := range sources {
+		if s.Source == source {
+			return true
+		}
+	}
+	return false
+}
+
 func rewardTypeIsValid(rewardType string) bool {
 	return rewardType == "" ||
 		rewardType == RewardTypeHard ||
@@ -8,10 +8,12 @@ import (
 	tmprototypes "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/kava-labs/kava/app"
 	hardtypes "github.com/kava-labs/kava/x/hard/types"
 	"github.com/kava-labs/kava/x/incentive/keeper"
 	"github.com/kava-labs/kava/x/incentive/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -57,6 +59,7 @@ func (suite *grpcQueryTestSuite) SetupTest() {
 			hardtypes.MoneyMarkets{
 				hardtypes.NewMoneyMarket("ukava", hardtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "kava:usd", sdkmath.NewInt(1000000), hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
 				hardtypes.NewMoneyMarket("bnb", hardtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "bnb:usd", sdkmath.NewInt(1000000), hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+				hardtypes.NewMoneyMarket("hard", hardtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "hard:usd", sdkmath.NewInt(1000000), hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
 			},
 			sdk.NewDec(10),
 		),
@@ -310,6 +313,87 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryRewards_RewardType_and_Owner() {
 	suite.Empty(res.EarnClaims)
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcQueryRewards_Denom() {
+	res, err := suite.queryClient.Rewards(sdk.WrapSDKContext(suite.ctx), &types.QueryRewardsRequest{
+		Denom:          "ukava",
+		Unsynchronized: true,
+	})
+	suite.Require().NoError(err)
+
+	// only addrs[0] has ukava hard rewards, while both usdx minting claims hold ukava
+	suite.Equal(suite.genesisState.HardLiquidityProviderClaims[:1], res.HardLiquidityProviderClaims)
+	suite.Equal(suite.genesisState.USDXMintingClaims, res.USDXMintingClaims)
+	suite.Empty(res.DelegatorClaims)
+	suite.Empty(res.SwapClaims)
+	suite.Empty(res.SavingsClaims)
+	suite.Empty(res.EarnClaims)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryRewards_MinAmount() {
+	minAmount := sdkmath.NewInt(2)
+	res, err := suite.queryClient.Rewards(sdk.WrapSDKContext(suite.ctx), &types.QueryRewardsRequest{
+		RewardType:     keeper.RewardTypeHard,
+		Denom:          "hard",
+		MinAmount:      &minAmount,
+		Unsynchronized: true,
+	})
+	suite.Require().NoError(err)
+
+	// addrs[1] only has 1 hard
+	suite.Equal(suite.genesisState.HardLiquidityProviderClaims[:1], res.HardLiquidityProviderClaims)
+
+	_, err = suite.queryClient.Rewards(sdk.WrapSDKContext(suite.ctx), &types.QueryRewardsRequest{
+		MinAmount: &minAmount,
+	})
+	suite.Require().ErrorContains(err, "min amount requires a denom")
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryRewards_Pagination() {
+	res, err := suite.queryClient.Rewards(sdk.WrapSDKContext(suite.ctx), &types.QueryRewardsRequest{
+		RewardType:     keeper.RewardTypeHard,
+		Unsynchronized: true,
+		Pagination:     &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+
+	suite.Len(res.HardLiquidityProviderClaims, 1)
+	suite.Equal(uint64(2), res.Pagination.Total)
+	suite.NotNil(res.Pagination.NextKey)
+
+	next, err := suite.queryClient.Rewards(sdk.WrapSDKContext(suite.ctx), &types.QueryRewardsRequest{
+		RewardType:     keeper.RewardTypeHard,
+		Unsynchronized: true,
+		Pagination:     &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+
+	suite.Len(next.HardLiquidityProviderClaims, 1)
+	suite.Nil(next.Pagination.NextKey)
+	suite.ElementsMatch(
+		suite.genesisState.HardLiquidityProviderClaims,
+		append(res.HardLiquidityProviderClaims, next.HardLiquidityProviderClaims...),
+	)
+
+	// filters are applied before paginating
+	minAmount := sdkmath.NewInt(2)
+	filtered, err := suite.queryClient.Rewards(sdk.WrapSDKContext(suite.ctx), &types.QueryRewardsRequest{
+		RewardType:     keeper.RewardTypeHard,
+		Denom:          "hard",
+		MinAmount:      &minAmount,
+		Unsynchronized: true,
+		Pagination:     &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+
+	suite.Equal(suite.genesisState.HardLiquidityProviderClaims[:1], filtered.HardLiquidityProviderClaims)
+	suite.Equal(uint64(1), filtered.Pagination.Total)
+
+	_, err = suite.queryClient.Rewards(sdk.WrapSDKContext(suite.ctx), &types.QueryRewardsRequest{
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	suite.Require().ErrorContains(err, "pagination requires a reward type")
+}
+
 func (suite *grpcQueryTestSuite) TestGrpcQueryRewardFactors() {
 	res, err := suite.queryClient.RewardFactors(sdk.WrapSDKContext(suite.ctx), &types.QueryRewardFactorsRequest{})
 	suite.Require().NoError(err)
@@ -323,6 +407,270 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryRewardFactors() {
 	suite.NotEmpty(res.EarnRewardFactors)
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcQueryRewardSchedule() {
+	// block time is after the end of every reward period
+	suite.setRewardSchedulePrices(suite.ctx, map[string]sdk.Dec{"bnb": d("10"), "hard": d("2")})
+
+	res, err := suite.queryClient.RewardSchedule(sdk.WrapSDKContext(suite.ctx), &types.QueryRewardScheduleRequest{
+		Source:         keeper.RewardSourceHardBorrow,
+		CollateralType: "bnb",
+	})
+	suite.Require().NoError(err)
+
+	period, found := suite.genesisState.Params.HardBorrowRewardPeriods.GetMultiRewardPeriod("bnb")
+	suite.Require().True(found)
+	suite.Equal(period, res.RewardPeriod)
+	suite.Equal(sdk.ZeroDec(), res.TotalValue)
+	suite.Equal(sdk.ZeroDec(), res.Apy)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryRewardSchedule_Active() {
+	ctx := suite.ctx.WithBlockTime(suite.genesisTime)
+	suite.setRewardSchedulePrices(ctx, map[string]sdk.Dec{"bnb": d("10"), "hard": d("2")})
+
+	depositor := suite.addrs[4]
+	deposit := cs(c("bnb", 1e12))
+	suite.Require().NoError(suite.tApp.FundAccount(ctx, depositor, deposit))
+	suite.Require().NoError(suite.tApp.GetHardKeeper().Deposit(ctx, depositor, deposit))
+
+	res, err := keeper.NewQueryServerImpl(suite.keeper).RewardSchedule(sdk.WrapSDKContext(ctx), &types.QueryRewardScheduleRequest{
+		Source:         keeper.RewardSourceHardSupply,
+		CollateralType: "bnb",
+	})
+	suite.Require().NoError(err)
+
+	// 1e12 bnb with a conversion factor of 1e6 at $10
+	suite.Equal(d("10000000"), res.TotalValue)
+	// 122354 hard per second with a conversion factor of 1e6 at $2 for a year, over the total value
+	suite.Equal(d("0.7717111488"), res.Apy)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryRewardSchedule_Unpriced() {
+	ctx := suite.ctx.WithBlockTime(suite.genesisTime)
+	suite.setRewardSchedulePrices(ctx, map[string]sdk.Dec{"bnb": d("10")})
+
+	depositor := suite.addrs[4]
+	deposit := cs(c("bnb", 1e12))
+	suite.Require().NoError(suite.tApp.FundAccount(ctx, depositor, deposit))
+	suite.Require().NoError(suite.tApp.GetHardKeeper().Deposit(ctx, depositor, deposit))
+
+	queryServer := keeper.NewQueryServerImpl(suite.keeper)
+
+	// the hard reward has no price
+	res, err := queryServer.RewardSchedule(sdk.WrapSDKContext(ctx), &types.QueryRewardScheduleRequest{
+		Source:         keeper.RewardSourceHardSupply,
+		CollateralType: "bnb",
+	})
+	suite.Require().NoError(err)
+	suite.Equal(d("10000000"), res.TotalValue)
+	suite.Equal(sdk.ZeroDec(), res.Apy)
+
+	// swp has no money market, so the btcb/usdx pool cannot be valued
+	res, err = queryServer.RewardSchedule(sdk.WrapSDKContext(ctx), &types.QueryRewardScheduleRequest{
+		Source:         keeper.RewardSourceSwap,
+		CollateralType: "btcb/usdx",
+	})
+	suite.Require().NoError(err)
+	suite.Equal(sdk.ZeroDec(), res.TotalValue)
+	suite.Equal(sdk.ZeroDec(), res.Apy)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryRewardSchedule_Invalid() {
+	_, err := suite.queryClient.RewardSchedule(sdk.WrapSDKContext(suite.ctx), &types.QueryRewardScheduleRequest{
+		Source:         "invalid",
+		CollateralType: "bnb",
+	})
+	suite.Require().ErrorContains(err, "invalid reward source")
+
+	_, err = suite.queryClient.RewardSchedule(sdk.WrapSDKContext(suite.ctx), &types.QueryRewardScheduleRequest{
+		Source:         keeper.RewardSourceHardBorrow,
+		CollateralType: "xrp",
+	})
+	suite.Require().ErrorContains(err, "no hard_borrow reward period")
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryUpcomingRewardPeriods() {
+	// block time is after the start of every reward period
+	res, err := suite.queryClient.UpcomingRewardPeriods(sdk.WrapSDKContext(suite.ctx), &types.QueryUpcomingRewardPeriodsRequest{})
+	suite.Require().NoError(err)
+	suite.Empty(res.RewardPeriods)
+
+	// block time is before the start of every reward period
+	ctx := suite.ctx.WithBlockTime(suite.genesisTime.Add(-2 * oneYear))
+	queryServer := keeper.NewQueryServerImpl(suite.keeper)
+
+	res, err = queryServer.UpcomingRewardPeriods(sdk.WrapSDKContext(ctx), &types.QueryUpcomingRewardPeriodsRequest{})
+	suite.Require().NoError(err)
+	suite.Len(res.RewardPeriods, 6)
+
+	res, err = queryServer.UpcomingRewardPeriods(sdk.WrapSDKContext(ctx), &types.QueryUpcomingRewardPeriodsRequest{
+		Source: keeper.RewardSourceSwap,
+	})
+	suite.Require().NoError(err)
+	suite.Equal([]types.UpcomingRewardPeriod{
+		{Source: keeper.RewardSourceSwap, RewardPeriod: suite.genesisState.Params.SwapRewardPeriods[0]},
+	}, res.RewardPeriods)
+
+	_, err = queryServer.UpcomingRewardPeriods(sdk.WrapSDKContext(ctx), &types.QueryUpcomingRewardPeriodsRequest{
+		Source: "invalid",
+	})
+	suite.Require().ErrorContains(err, "invalid reward source")
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryRewardCaps() {
+	// genesis reward periods are uncapped
+	res, err := suite.queryClient.RewardCaps(sdk.WrapSDKContext(suite.ctx), &types.QueryRewardCapsRequest{})
+	suite.Require().NoError(err)
+	suite.Empty(res.RewardCaps)
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.SwapRewardPeriods[0].RewardsCap = cs(c("swp", 1e9))
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.keeper.SetDistributedRewards(
+		suite.ctx, keeper.RewardSourceSwap, params.SwapRewardPeriods[0].CollateralType, sdk.NewDecCoins(sdk.NewInt64DecCoin("swp", 1e8)),
+	)
+
+	res, err = suite.queryClient.RewardCaps(sdk.WrapSDKContext(suite.ctx), &types.QueryRewardCapsRequest{
+		Source: keeper.RewardSourceSwap,
+	})
+	suite.Require().NoError(err)
+	suite.Equal([]types.RewardCap{
+		{
+			Source:         keeper.RewardSourceSwap,
+			CollateralType: params.SwapRewardPeriods[0].CollateralType,
+			RewardsCap:     cs(c("swp", 1e9)),
+			Distributed:    sdk.NewDecCoins(sdk.NewInt64DecCoin("swp", 1e8)),
+			Remaining:      sdk.NewDecCoins(sdk.NewInt64DecCoin("swp", 9e8)),
+		},
+	}, res.RewardCaps)
+
+	res, err = suite.queryClient.RewardCaps(sdk.WrapSDKContext(suite.ctx), &types.QueryRewardCapsRequest{
+		Source: keeper.RewardSourceHardBorrow,
+	})
+	suite.Require().NoError(err)
+	suite.Empty(res.RewardCaps)
+
+	_, err = suite.queryClient.RewardCaps(sdk.WrapSDKContext(suite.ctx), &types.QueryRewardCapsRequest{
+		Source: "invalid",
+	})
+	suite.Require().ErrorContains(err, "invalid reward source")
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryRewardsAtHeight() {
+	ctx := suite.ctx.WithBlockTime(suite.genesisTime).WithBlockHeight(1000)
+	suite.setRewardSchedulePrices(ctx, map[string]sdk.Dec{"bnb": d("10"), "hard": d("2")})
+
+	// depositing creates a claim synced to the current supply index of 0.1
+	depositor := suite.addrs[4]
+	deposit := cs(c("bnb", 1e6))
+	suite.Require().NoError(suite.tApp.FundAccount(ctx, depositor, deposit))
+	suite.Require().NoError(suite.tApp.GetHardKeeper().Deposit(ctx, depositor, deposit))
+
+	snapshot := suite.keeper.GetCurrentRewardIndexes(ctx)
+	snapshot.Height = 600
+	snapshot.HardSupplyRewardIndexes = snapshot.HardSupplyRewardIndexes.With("bnb", types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.3")}})
+	suite.keeper.SetRewardIndexesSnapshot(ctx, snapshot)
+
+	// rewards keep accumulating after the snapshot
+	suite.keeper.SetHardSupplyRewardIndexes(ctx, "bnb", types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.5")}})
+
+	queryServer := keeper.NewQueryServerImpl(suite.keeper)
+	res, err := queryServer.RewardsAtHeight(sdk.WrapSDKContext(ctx), &types.QueryRewardsAtHeightRequest{
+		Owner:      depositor.String(),
+		RewardType: keeper.RewardTypeHard,
+		Height:     700,
+	})
+	suite.Require().NoError(err)
+
+	suite.Equal(int64(600), res.SnapshotHeight)
+	suite.Require().Len(res.HardLiquidityProviderClaims, 1)
+	// (0.3 - 0.1) * 1e6
+	suite.Equal(cs(c("hard", 2e5)), res.HardLiquidityProviderClaims[0].Reward)
+
+	// the stored indexes are not rewound by the query
+	indexes, found := suite.keeper.GetHardSupplyRewardIndexes(ctx, "bnb")
+	suite.Require().True(found)
+	suite.Equal(types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.5")}}, indexes)
+
+	// the claim was synced to a supply index above the one of an earlier snapshot
+	snapshot.Height = 300
+	snapshot.HardSupplyRewardIndexes = snapshot.HardSupplyRewardIndexes.With("bnb", types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.05")}})
+	suite.keeper.SetRewardIndexesSnapshot(ctx, snapshot)
+
+	_, err = queryServer.RewardsAtHeight(sdk.WrapSDKContext(ctx), &types.QueryRewardsAtHeightRequest{
+		Owner:      depositor.String(),
+		RewardType: keeper.RewardTypeHard,
+		Height:     400,
+	})
+	suite.Require().ErrorContains(err, "updated after snapshot height 300")
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryRewardsAtHeight_Invalid() {
+	ctx := suite.ctx.WithBlockHeight(1000)
+	queryServer := keeper.NewQueryServerImpl(suite.keeper)
+
+	_, err := queryServer.RewardsAtHeight(sdk.WrapSDKContext(ctx), &types.QueryRewardsAtHeightRequest{
+		Owner:  "invalid",
+		Height: 700,
+	})
+	suite.Require().ErrorContains(err, "invalid address")
+
+	for _, height := range []int64{0, 1001} {
+		_, err = queryServer.RewardsAtHeight(sdk.WrapSDKContext(ctx), &types.QueryRewardsAtHeightRequest{
+			Owner:  suite.addrs[0].String(),
+			Height: height,
+		})
+		suite.Require().ErrorContains(err, "height must be between 1 and the current height 1000")
+	}
+
+	_, err = queryServer.RewardsAtHeight(sdk.WrapSDKContext(ctx), &types.QueryRewardsAtHeightRequest{
+		Owner:  suite.addrs[0].String(),
+		Height: 700,
+	})
+	suite.Require().ErrorContains(err, "no reward index snapshot found at or before height 700")
+}
+
+// setRewardSchedulePrices sets a current price for each denom on the spot
+// market of its hard money market, which the reward schedule query reads
+// prices from.
+func (suite *grpcQueryTestSuite) setRewardSchedulePrices(ctx sdk.Context, prices map[string]sdk.Dec) {
+	pricefeedKeeper := suite.tApp.GetPriceFeedKeeper()
+	oracle := suite.addrs[0]
+
+	marketIDs := make(map[string]string)
+	for denom := range prices {
+		moneyMarket, found := suite.tApp.GetHardKeeper().GetMoneyMarket(ctx, denom)
+		suite.Require().True(found)
+		marketIDs[moneyMarket.SpotMarketID] = denom
+	}
+
+	var markets pricefeedtypes.Markets
+	for _, market := range pricefeedKeeper.GetMarkets(ctx) {
+		if _, found := marketIDs[market.MarketID]; !found {
+			markets = append(markets, market)
+		}
+	}
+	for marketID, denom := range marketIDs {
+		markets = append(markets, pricefeedtypes.Market{
+			MarketID: marketID, BaseAsset: denom, QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true,
+		})
+	}
+	pricefeedKeeper.SetParams(ctx, pricefeedtypes.NewParams(markets))
+
+	for marketID, denom := range marketIDs {
+		// overwrite any prices posted in genesis so they don't skew the median
+		oracles := []sdk.AccAddress{oracle}
+		for _, rawPrice := range pricefeedKeeper.GetRawPrices(ctx, marketID) {
+			oracles = append(oracles, rawPrice.OracleAddress)
+		}
+		for _, o := range oracles {
+			_, err := pricefeedKeeper.SetPrice(ctx, o, marketID, prices[denom], ctx.BlockTime().Add(time.Hour))
+			suite.Require().NoError(err)
+		}
+		suite.Require().NoError(pricefeedKeeper.SetCurrentPrices(ctx, marketID))
+	}
+}
+
 func TestGrpcQueryTestSuite(t *testing.T) {
 	suite.Run(t, new(grpcQueryTestSuite))
 }
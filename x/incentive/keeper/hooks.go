@@ -3,11 +3,14 @@ package keeper
 import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	earntypes "github.com/kava-labs/kava/x/earn/types"
 	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	"github.com/kava-labs/kava/x/incentive/types"
+	liquidtypes "github.com/kava-labs/kava/x/liquid/types"
 	savingstypes "github.com/kava-labs/kava/x/savings/types"
 	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
@@ -24,6 +27,7 @@ var (
 	_ swaptypes.SwapHooks       = Hooks{}
 	_ savingstypes.SavingsHooks = Hooks{}
 	_ earntypes.EarnHooks       = Hooks{}
+	_ liquidtypes.LiquidHooks   = Hooks{}
 )
 
 // Hooks create new incentive hooks
@@ -43,6 +47,11 @@ func (h Hooks) BeforeCDPModified(ctx sdk.Context, cdp cdptypes.CDP) {
 	h.k.SynchronizeUSDXMintingReward(ctx, cdp)
 }
 
+// AfterDebtChanged function that runs after the debt of a cdp changes
+// USDX minting rewards are synchronized in BeforeCDPModified, so nothing is done here
+func (h Hooks) AfterDebtChanged(ctx sdk.Context, owner sdk.AccAddress, collateralType string, delta sdkmath.Int) {
+}
+
 // ------------------- Hard Module Hooks -------------------
 
 // AfterDepositCreated function that runs after a deposit is created
@@ -75,16 +84,21 @@ func (h Hooks) AfterBorrowModified(ctx sdk.Context, borrow hardtypes.Borrow) {
 	h.k.UpdateHardBorrowIndexDenoms(ctx, borrow)
 }
 
+// AfterInterestAccrued is implemented to ensure HARDHooks interface compliance, rewards don't depend on accrued interest
+func (h Hooks) AfterInterestAccrued(_ sdk.Context, _ string, _, _ sdkmath.Int) {}
+
 /* ------------------- Staking Module Hooks -------------------
 
 Rewards are calculated based on total delegated tokens to bonded validators (not shares).
-We need to sync the claim before the user's delegated tokens are changed.
+We need to sync the claim before the user's delegation shares are changed.
 
-When delegated tokens (to bonded validators) are changed:
+When delegation shares are changed:
 - user creates new delegation
-  - total bonded delegation increases
 - user delegates or beginUnbonding or beginRedelegate an existing delegation
-  - total bonded delegation increases or decreases
+
+Delegated tokens also change when a validator's state changes, but syncing every delegator of a validator is too slow.
+Instead a checkpoint of the global indexes and the validator's tokens and bonded status is recorded, and the rewards for
+each period between checkpoints are calculated when the delegator's claim is next synced. This happens when:
 - validator is slashed and Jailed/Tombstoned (tokens reduce, and validator is unbonded)
   - slash: total bonded delegation decreases (less tokens)
   - jail: total bonded delegation decreases (tokens no longer bonded (after end blocker runs))
@@ -93,6 +107,9 @@ When delegated tokens (to bonded validators) are changed:
 - validator becomes bonded (ie when they're promoted into the top 100)
   - total bonded delegation increases (tokens become bonded)
 
+Staking derivatives are counted as delegations to their validator for the accounts holding them.
+Holders are synced by the liquid hooks before derivatives are transferred, and use the same validator checkpoints.
+
 */
 
 // BeforeDelegationCreated runs before a delegation is created
@@ -106,7 +123,7 @@ func (h Hooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress,
 // BeforeDelegationSharesModified runs before an existing delegation is modified
 func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
 	// Sync rewards based on total delegated to bonded validators.
-	h.k.SynchronizeDelegatorRewards(ctx, delAddr, nil, false)
+	h.k.SynchronizeDelegatorRewards(ctx, delAddr)
 
 	return nil
 }
@@ -114,11 +131,12 @@ func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAd
 // BeforeValidatorSlashed is called before a validator is slashed
 // Validator status is not updated when Slash or Jail is called
 func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
-	// Sync all claims for users delegated to this validator.
-	// For each claim, sync based on the total delegated to bonded validators.
-	for _, delegation := range h.k.stakingKeeper.GetValidatorDelegations(ctx, valAddr) {
-		h.k.SynchronizeDelegatorRewards(ctx, delegation.GetDelegatorAddr(), nil, false)
+	// Checkpoint the validator's tokens before they are reduced.
+	validator, found := h.k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil
 	}
+	h.k.CheckpointDelegatorValidator(ctx, valAddr, validator.GetStatus() == stakingtypes.Bonded)
 
 	return nil
 }
@@ -126,12 +144,8 @@ func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, f
 // AfterValidatorBeginUnbonding is called after a validator begins unbonding
 // Validator status is set to Unbonding prior to hook running
 func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
-	// Sync all claims for users delegated to this validator.
-	// For each claim, sync based on the total delegated to bonded validators, and also delegations to valAddr.
-	// valAddr's status has just been set to Unbonding, but we want to include delegations to it in the sync.
-	for _, delegation := range h.k.stakingKeeper.GetValidatorDelegations(ctx, valAddr) {
-		h.k.SynchronizeDelegatorRewards(ctx, delegation.GetDelegatorAddr(), valAddr, true)
-	}
+	// valAddr's status has just been set to Unbonding, but delegations to it were bonded until now.
+	h.k.CheckpointDelegatorValidator(ctx, valAddr, true)
 
 	return nil
 }
@@ -139,12 +153,8 @@ func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAd
 // AfterValidatorBonded is called after a validator is bonded
 // Validator status is set to Bonded prior to hook running
 func (h Hooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
-	// Sync all claims for users delegated to this validator.
-	// For each claim, sync based on the total delegated to bonded validators, except for delegations to valAddr.
-	// valAddr's status has just been set to Bonded, but we don't want to include delegations to it in the sync
-	for _, delegation := range h.k.stakingKeeper.GetValidatorDelegations(ctx, valAddr) {
-		h.k.SynchronizeDelegatorRewards(ctx, delegation.GetDelegatorAddr(), valAddr, false)
-	}
+	// valAddr's status has just been set to Bonded, but delegations to it were not bonded until now.
+	h.k.CheckpointDelegatorValidator(ctx, valAddr, false)
 
 	return nil
 }
@@ -221,7 +231,45 @@ func (h Hooks) BeforeVaultDepositModified(
 	ctx sdk.Context,
 	vaultDenom string,
 	depositor sdk.AccAddress,
-	sharesOwned sdk.Dec,
+	_ sdk.Dec,
 ) {
-	h.k.SynchronizeEarnReward(ctx, vaultDenom, depositor, sharesOwned)
+	// Source shares are weighted by share class, so they are read from the earn adapter rather than the raw shares owned.
+	h.k.SynchronizeEarnReward(ctx, vaultDenom, depositor, h.k.getEarnOwnerSourceShares(ctx, vaultDenom, depositor))
+}
+
+// AfterVaultSharesModified is implemented to ensure EarnHooks interface compliance, rewards are synchronized before shares are modified
+func (h Hooks) AfterVaultSharesModified(_ sdk.Context, _ sdk.AccAddress, _ string, _, _ sdk.Dec) {}
+
+// ------------------- Liquid Module Hooks -------------------
+
+// BeforeDerivativeTransfer runs before staking derivatives are sent from one account to another.
+// Derivatives are counted as delegations, so the delegator rewards of both accounts are synchronized, and rewards earned
+// before the transfer use the balances held before it.
+func (h Hooks) BeforeDerivativeTransfer(ctx sdk.Context, sender, recipient sdk.AccAddress, _ sdk.Coins) {
+	h.k.SynchronizeDelegatorRewards(ctx, sender)
+	h.k.SynchronizeDelegatorRewards(ctx, recipient)
+}
+
+// AfterDerivativeTransfer runs after staking derivatives are sent from one account to another.
+// It adds a delegator claim for the recipient if one doesn't exist, so accounts without delegations accrue rewards on the
+// derivatives they receive. Module accounts are skipped, as derivatives they hold are not counted as delegations.
+func (h Hooks) AfterDerivativeTransfer(ctx sdk.Context, _, recipient sdk.AccAddress, _ sdk.Coins) {
+	if _, found := h.k.GetDelegatorClaim(ctx, recipient); found {
+		return
+	}
+	if _, ok := h.k.accountKeeper.GetAccount(ctx, recipient).(authtypes.ModuleAccountI); ok {
+		return
+	}
+	h.k.InitializeDelegatorReward(ctx, recipient)
+}
+
+// ------------------- Incentive Hooks -------------------
+
+var _ types.IncentiveHooks = Keeper{}
+
+// AfterClaimPayout - call hook if registered
+func (k Keeper) AfterClaimPayout(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, claimType string) {
+	if k.hooks != nil {
+		k.hooks.AfterClaimPayout(ctx, owner, coins, claimType)
+	}
 }
@@ -229,9 +229,12 @@ func NewSwapGenesisState(cdc codec.JSONCodec) app.GenesisState {
 		swaptypes.NewParams(
 			swaptypes.NewAllowedPools(swaptypes.NewAllowedPool("busd", "ukava")),
 			d("0.0"),
+			swaptypes.DefaultTwapMaxWindowSeconds,
 		),
 		swaptypes.DefaultPoolRecords,
 		swaptypes.DefaultShareRecords,
+		swaptypes.DefaultLimitOrders,
+		swaptypes.DefaultNextLimitOrderID,
 	)
 	return app.GenesisState{
 		swaptypes.ModuleName: cdc.MustMarshalJSON(&genesis),
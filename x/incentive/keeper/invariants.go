@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// RegisterInvariants registers the incentive module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "claims", ClaimsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "reward-indexes", RewardIndexesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "claim-indexes", ClaimIndexesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the incentive module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if res, stop := ClaimsInvariant(k)(ctx); stop {
+			return res, stop
+		}
+
+		if res, stop := RewardIndexesInvariant(k)(ctx); stop {
+			return res, stop
+		}
+
+		return ClaimIndexesInvariant(k)(ctx)
+	}
+}
+
+// ClaimsInvariant iterates all claims and asserts that they are valid
+func ClaimsInvariant(k Keeper) sdk.Invariant {
+	message := sdk.FormatInvariant(types.ModuleName, "validate claims broken", "claim invalid")
+
+	return func(ctx sdk.Context) (string, bool) {
+		broken := false
+
+		k.IterateUSDXMintingClaims(ctx, func(claim types.USDXMintingClaim) bool {
+			broken = claim.Validate() != nil
+			return broken
+		})
+		if broken {
+			return message, broken
+		}
+
+		k.IterateHardLiquidityProviderClaims(ctx, func(claim types.HardLiquidityProviderClaim) bool {
+			broken = claim.Validate() != nil
+			return broken
+		})
+		if broken {
+			return message, broken
+		}
+
+		k.IterateDelegatorClaims(ctx, func(claim types.DelegatorClaim) bool {
+			broken = claim.Validate() != nil
+			return broken
+		})
+		if broken {
+			return message, broken
+		}
+
+		k.IterateSwapClaims(ctx, func(claim types.SwapClaim) bool {
+			broken = claim.Validate() != nil
+			return broken
+		})
+		if broken {
+			return message, broken
+		}
+
+		k.IterateSavingsClaims(ctx, func(claim types.SavingsClaim) bool {
+			broken = claim.Validate() != nil
+			return broken
+		})
+		if broken {
+			return message, broken
+		}
+
+		k.IterateEarnClaims(ctx, func(claim types.EarnClaim) bool {
+			broken = claim.Validate() != nil
+			return broken
+		})
+
+		return message, broken
+	}
+}
+
+// RewardIndexesInvariant asserts that all global reward indexes are valid
+func RewardIndexesInvariant(k Keeper) sdk.Invariant {
+	message := sdk.FormatInvariant(types.ModuleName, "validate reward indexes broken", "global reward index invalid")
+
+	return func(ctx sdk.Context) (string, bool) {
+		indexes := k.GetCurrentRewardIndexes(ctx)
+
+		for _, err := range []error{
+			indexes.USDXMintingRewardIndexes.Validate(),
+			indexes.HardSupplyRewardIndexes.Validate(),
+			indexes.HardBorrowRewardIndexes.Validate(),
+			indexes.DelegatorRewardIndexes.Validate(),
+			indexes.SwapRewardIndexes.Validate(),
+			indexes.SavingsRewardIndexes.Validate(),
+			indexes.EarnRewardIndexes.Validate(),
+		} {
+			if err != nil {
+				return message, true
+			}
+		}
+		return message, false
+	}
+}
+
+// ClaimIndexesInvariant asserts that no claim holds a reward index above the global reward index.
+// Global reward indexes only increase, so a claim index above the global one means the claim was synchronized with
+// wrong indexes, and syncing it again would calculate a negative reward.
+func ClaimIndexesInvariant(k Keeper) sdk.Invariant {
+	message := sdk.FormatInvariant(types.ModuleName, "claim reward indexes broken", "claim reward index above global reward index")
+
+	return func(ctx sdk.Context) (string, bool) {
+		var rewards types.QueryRewardsResponse
+		rewards.USDXMintingClaims = k.GetAllUSDXMintingClaims(ctx)
+		rewards.HardLiquidityProviderClaims = k.GetAllHardLiquidityProviderClaims(ctx)
+		rewards.DelegatorClaims = k.GetAllDelegatorClaims(ctx)
+		rewards.SwapClaims = k.GetAllSwapClaims(ctx)
+		rewards.SavingsClaims = k.GetAllSavingsClaims(ctx)
+		rewards.EarnClaims = k.GetAllEarnClaims(ctx)
+
+		broken := claimIndexesExceed(rewards, k.GetCurrentRewardIndexes(ctx))
+		return message, broken
+	}
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/incentive/keeper"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+type invariantTestSuite struct {
+	unitTester
+
+	invariants map[string]map[string]sdk.Invariant
+}
+
+func TestInvariantTestSuite(t *testing.T) {
+	suite.Run(t, new(invariantTestSuite))
+}
+
+func (suite *invariantTestSuite) SetupTest() {
+	suite.unitTester.SetupTest()
+
+	suite.invariants = make(map[string]map[string]sdk.Invariant)
+	keeper.RegisterInvariants(suite, suite.keeper)
+}
+
+func (suite *invariantTestSuite) RegisterRoute(moduleName string, route string, invariant sdk.Invariant) {
+	_, exists := suite.invariants[moduleName]
+
+	if !exists {
+		suite.invariants[moduleName] = make(map[string]sdk.Invariant)
+	}
+
+	suite.invariants[moduleName][route] = invariant
+}
+
+func (suite *invariantTestSuite) SetupValidState() {
+	suite.storeGlobalSupplyIndexes(types.MultiRewardIndexes{
+		{CollateralType: "bnb", RewardIndexes: types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.2")}}},
+	})
+	suite.keeper.SetHardLiquidityProviderClaim(suite.ctx, types.NewHardLiquidityProviderClaim(
+		arbitraryAddress(),
+		cs(c("hard", 1e6)),
+		types.MultiRewardIndexes{
+			{CollateralType: "bnb", RewardIndexes: types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.1")}}},
+		},
+		nil,
+	))
+}
+
+func (suite *invariantTestSuite) runInvariant(route string, invariant func(k keeper.Keeper) sdk.Invariant) (string, bool) {
+	ctx := suite.ctx
+	registeredInvariant := suite.invariants[types.ModuleName][route]
+	suite.Require().NotNil(registeredInvariant)
+
+	// direct call
+	dMessage, dBroken := invariant(suite.keeper)(ctx)
+	// registered call
+	rMessage, rBroken := registeredInvariant(ctx)
+	// all call
+	aMessage, aBroken := keeper.AllInvariants(suite.keeper)(ctx)
+
+	// require matching values for direct call and registered call
+	suite.Require().Equal(dMessage, rMessage, "expected registered invariant message to match")
+	suite.Require().Equal(dBroken, rBroken, "expected registered invariant broken to match")
+	// require matching values for direct call and all invariants call if broken
+	suite.Require().Equal(dBroken, aBroken, "expected all invariant broken to match")
+	if dBroken {
+		suite.Require().Equal(dMessage, aMessage, "expected all invariant message to match")
+	}
+
+	// return message, broken
+	return dMessage, dBroken
+}
+
+func (suite *invariantTestSuite) TestClaimsInvariant() {
+	message, broken := suite.runInvariant("claims", keeper.ClaimsInvariant)
+	suite.Equal("incentive: validate claims broken invariant\nclaim invalid\n", message)
+	suite.Equal(false, broken)
+
+	suite.SetupValidState()
+	_, broken = suite.runInvariant("claims", keeper.ClaimsInvariant)
+	suite.Equal(false, broken)
+
+	// broken with a claim with an invalid reward index
+	suite.keeper.SetSwapClaim(suite.ctx, types.NewSwapClaim(
+		arbitraryAddress(),
+		nil,
+		types.MultiRewardIndexes{
+			{CollateralType: "busd:ukava", RewardIndexes: types.RewardIndexes{{CollateralType: "swp", RewardFactor: d("-0.1")}}},
+		},
+	))
+	_, broken = suite.runInvariant("claims", keeper.ClaimsInvariant)
+	suite.Equal(true, broken)
+}
+
+func (suite *invariantTestSuite) TestRewardIndexesInvariant() {
+	message, broken := suite.runInvariant("reward-indexes", keeper.RewardIndexesInvariant)
+	suite.Equal("incentive: validate reward indexes broken invariant\nglobal reward index invalid\n", message)
+	suite.Equal(false, broken)
+
+	suite.SetupValidState()
+	_, broken = suite.runInvariant("reward-indexes", keeper.RewardIndexesInvariant)
+	suite.Equal(false, broken)
+
+	// broken with a negative global reward factor
+	suite.keeper.SetEarnRewardIndexes(suite.ctx, "usdx", types.RewardIndexes{{CollateralType: "ukava", RewardFactor: d("-0.1")}})
+	_, broken = suite.runInvariant("reward-indexes", keeper.RewardIndexesInvariant)
+	suite.Equal(true, broken)
+}
+
+func (suite *invariantTestSuite) TestClaimIndexesInvariant() {
+	message, broken := suite.runInvariant("claim-indexes", keeper.ClaimIndexesInvariant)
+	suite.Equal("incentive: claim reward indexes broken invariant\nclaim reward index above global reward index\n", message)
+	suite.Equal(false, broken)
+
+	suite.SetupValidState()
+	_, broken = suite.runInvariant("claim-indexes", keeper.ClaimIndexesInvariant)
+	suite.Equal(false, broken)
+
+	// broken with a claim synced past the global reward index
+	suite.storeGlobalSupplyIndexes(types.MultiRewardIndexes{
+		{CollateralType: "bnb", RewardIndexes: types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.05")}}},
+	})
+	_, broken = suite.runInvariant("claim-indexes", keeper.ClaimIndexesInvariant)
+	suite.Equal(true, broken)
+}
@@ -1,13 +1,19 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 
+	"github.com/kava-labs/kava/x/incentive/keeper/adapters"
+	"github.com/kava-labs/kava/x/incentive/keeper/adapters/cdp"
+	"github.com/kava-labs/kava/x/incentive/keeper/adapters/earn"
+	"github.com/kava-labs/kava/x/incentive/keeper/adapters/hard"
 	"github.com/kava-labs/kava/x/incentive/types"
 )
 
@@ -26,10 +32,22 @@ type Keeper struct {
 	liquidKeeper  types.LiquidKeeper
 	earnKeeper    types.EarnKeeper
 
+	// adapters read the source shares of reward sources from other modules
+	adapters adapters.SourceAdapters
+
 	// Keepers used for APY queries
 	mintKeeper      types.MintKeeper
 	distrKeeper     types.DistrKeeper
 	pricefeedKeeper types.PricefeedKeeper
+
+	hooks types.IncentiveHooks
+
+	// legacyEventsDisabled stops the untyped events emitted alongside typed events. Legacy events are kept for one
+	// release while indexers move to typed events.
+	legacyEventsDisabled bool
+
+	// the address capable of executing governance operations such as revoking claims. Usually the gov module account.
+	authority sdk.AccAddress
 }
 
 // NewKeeper creates a new keeper
@@ -38,12 +56,16 @@ func NewKeeper(
 	cdpk types.CdpKeeper, hk types.HardKeeper, ak types.AccountKeeper, stk types.StakingKeeper,
 	swpk types.SwapKeeper, svk types.SavingsKeeper, lqk types.LiquidKeeper, ek types.EarnKeeper,
 	mk types.MintKeeper, dk types.DistrKeeper, pfk types.PricefeedKeeper,
+	authority sdk.AccAddress,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
 	}
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", err))
+	}
 
-	return Keeper{
+	k := Keeper{
 		accountKeeper: ak,
 		cdc:           cdc,
 		key:           key,
@@ -60,7 +82,44 @@ func NewKeeper(
 		mintKeeper:      mk,
 		distrKeeper:     dk,
 		pricefeedKeeper: pfk,
+
+		authority: authority,
 	}
+	k.adapters = adapters.NewSourceAdapters(map[string]types.SourceAdapter{
+		RewardSourceHardBorrow:    hard.NewBorrowSourceAdapter(hk),
+		RewardSourceCdpCollateral: cdp.NewCollateralSourceAdapter(cdpk),
+		RewardSourceEarn:          earn.NewVaultSourceAdapter(earn.NewLiquidShareClassKeeper(ek), k.getEarnShareClassWeights),
+	})
+	return k
+}
+
+// GetAuthority returns the incentive module's authority.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
+// SetHooks adds hooks to the keeper.
+func (k *Keeper) SetHooks(hooks types.IncentiveHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set incentive hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
+// ClearHooks clears the hooks on the keeper
+func (k *Keeper) ClearHooks() {
+	k.hooks = nil
+}
+
+// DisableLegacyEvents stops the keeper emitting legacy untyped events, so only typed events are emitted.
+func (k *Keeper) DisableLegacyEvents() {
+	k.legacyEventsDisabled = true
+}
+
+// Codec returns the codec used by the keeper to marshal store values.
+func (k Keeper) Codec() codec.Codec {
+	return k.cdc
 }
 
 // GetUSDXMintingClaim returns the claim in the store corresponding the input address collateral type and id and a boolean for if the claim was found
@@ -263,6 +322,13 @@ func (k Keeper) SetDelegatorClaim(ctx sdk.Context, c types.DelegatorClaim) {
 func (k Keeper) DeleteDelegatorClaim(ctx sdk.Context, owner sdk.AccAddress) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorClaimKeyPrefix)
 	store.Delete(owner)
+
+	checkpointIDStore := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorClaimCheckpointIDKeyPrefix)
+	if bz := checkpointIDStore.Get(owner); bz != nil {
+		indexStore := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorClaimCheckpointIDIndexPrefix)
+		indexStore.Delete(types.DelegatorClaimCheckpointIDIndexKey(sdk.BigEndianToUint64(bz), owner))
+	}
+	checkpointIDStore.Delete(owner)
 }
 
 // IterateDelegatorClaims iterates over all claim  objects in the store and preforms a callback function
@@ -883,3 +949,182 @@ func (k Keeper) IterateEarnRewardAccrualTimes(ctx sdk.Context, cb func(string, t
 		}
 	}
 }
+
+// GetPreviousBlockTime returns the block time of the previous begin blocker.
+func (k Keeper) GetPreviousBlockTime(ctx sdk.Context) (blockTime time.Time, found bool) {
+	store := ctx.KVStore(k.key)
+	b := store.Get(types.PreviousBlockTimeKey)
+	if b == nil {
+		return time.Time{}, false
+	}
+	if err := blockTime.UnmarshalBinary(b); err != nil {
+		panic(err)
+	}
+	return blockTime, true
+}
+
+// SetPreviousBlockTime stores the block time of the current begin blocker.
+func (k Keeper) SetPreviousBlockTime(ctx sdk.Context, blockTime time.Time) {
+	store := ctx.KVStore(k.key)
+	bz, err := blockTime.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.PreviousBlockTimeKey, bz)
+}
+
+// GetDistributedRewards returns the rewards paid out so far for a capped reward period's source and collateral type.
+func (k Keeper) GetDistributedRewards(ctx sdk.Context, source, collateralType string) (sdk.DecCoins, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DistributedRewardsKeyPrefix)
+	bz := store.Get(types.DistributedRewardsKey(source, collateralType))
+	if bz == nil {
+		return sdk.DecCoins{}, false
+	}
+	var distributed types.DistributedRewards
+	k.cdc.MustUnmarshal(bz, &distributed)
+	return distributed.Amount, true
+}
+
+// SetDistributedRewards stores the rewards paid out so far for a capped reward period's source and collateral type.
+func (k Keeper) SetDistributedRewards(ctx sdk.Context, source, collateralType string, amount sdk.DecCoins) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DistributedRewardsKeyPrefix)
+	bz := k.cdc.MustMarshal(&types.DistributedRewards{
+		CollateralType: collateralType,
+		Amount:         amount,
+	})
+	store.Set(types.DistributedRewardsKey(source, collateralType), bz)
+}
+
+// IterateDistributedRewards iterates over the rewards paid out for all capped reward periods of a source and performs a callback function
+func (k Keeper) IterateDistributedRewards(ctx sdk.Context, source string, cb func(distributed types.DistributedRewards) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DistributedRewardsKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, address.MustLengthPrefix([]byte(source)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var distributed types.DistributedRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &distributed)
+		if cb(distributed) {
+			break
+		}
+	}
+}
+
+// SetRewardIndexesSnapshot stores a snapshot of the global reward indexes at the snapshot's height.
+func (k Keeper) SetRewardIndexesSnapshot(ctx sdk.Context, snapshot types.RewardIndexesSnapshot) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RewardIndexesSnapshotKeyPrefix)
+	bz := k.cdc.MustMarshal(&snapshot)
+	store.Set(types.RewardIndexesSnapshotKey(snapshot.Height), bz)
+}
+
+// GetLatestRewardIndexesSnapshot returns the latest snapshot of the global reward indexes taken at or before a height.
+func (k Keeper) GetLatestRewardIndexesSnapshot(ctx sdk.Context, height int64) (types.RewardIndexesSnapshot, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RewardIndexesSnapshotKeyPrefix)
+	iterator := store.ReverseIterator(nil, types.RewardIndexesSnapshotKey(height+1))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.RewardIndexesSnapshot{}, false
+	}
+	var snapshot types.RewardIndexesSnapshot
+	k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+	return snapshot, true
+}
+
+// SetDelegatorValidatorCheckpoint stores a checkpoint of a validator's delegator reward period.
+func (k Keeper) SetDelegatorValidatorCheckpoint(
+	ctx sdk.Context, valAddr sdk.ValAddress, id uint64, checkpoint types.DelegatorValidatorCheckpoint,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorValidatorCheckpointKeyPrefix)
+	bz := k.cdc.MustMarshal(&checkpoint)
+	store.Set(types.DelegatorValidatorCheckpointKey(valAddr, id), bz)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorValidatorCheckpointIDIndexPrefix)
+	indexStore.Set(types.DelegatorValidatorCheckpointIDIndexKey(id, valAddr), sdk.Uint64ToBigEndian(id))
+}
+
+// IterateDelegatorValidatorCheckpoints iterates over a validator's checkpoints with IDs from startID onwards, in the
+// order they were recorded, and performs a callback function
+func (k Keeper) IterateDelegatorValidatorCheckpoints(
+	ctx sdk.Context, valAddr sdk.ValAddress, startID uint64, cb func(checkpoint types.DelegatorValidatorCheckpoint) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorValidatorCheckpointKeyPrefix)
+	iterator := store.Iterator(
+		types.DelegatorValidatorCheckpointKey(valAddr, startID),
+		sdk.PrefixEndBytes(address.MustLengthPrefix(valAddr)),
+	)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var checkpoint types.DelegatorValidatorCheckpoint
+		k.cdc.MustUnmarshal(iterator.Value(), &checkpoint)
+		if cb(checkpoint) {
+			break
+		}
+	}
+}
+
+// GetNextDelegatorCheckpointID returns the ID of the next delegator reward checkpoint to be recorded.
+func (k Keeper) GetNextDelegatorCheckpointID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.NextDelegatorCheckpointIDKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextDelegatorCheckpointID stores the ID of the next delegator reward checkpoint to be recorded.
+func (k Keeper) SetNextDelegatorCheckpointID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.NextDelegatorCheckpointIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetDelegatorClaimCheckpointID returns the ID of the first checkpoint recorded after a delegator claim was last synced.
+func (k Keeper) GetDelegatorClaimCheckpointID(ctx sdk.Context, owner sdk.AccAddress) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorClaimCheckpointIDKeyPrefix)
+	bz := store.Get(owner)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetDelegatorClaimCheckpointID stores the ID of the first checkpoint recorded after a delegator claim was last synced.
+// Claims are also indexed by the checkpoint ID, so checkpoints no claim still needs can be pruned.
+func (k Keeper) SetDelegatorClaimCheckpointID(ctx sdk.Context, owner sdk.AccAddress, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorClaimCheckpointIDKeyPrefix)
+	indexStore := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorClaimCheckpointIDIndexPrefix)
+	if bz := store.Get(owner); bz != nil {
+		indexStore.Delete(types.DelegatorClaimCheckpointIDIndexKey(sdk.BigEndianToUint64(bz), owner))
+	}
+	store.Set(owner, sdk.Uint64ToBigEndian(id))
+	indexStore.Set(types.DelegatorClaimCheckpointIDIndexKey(id, owner), sdk.Uint64ToBigEndian(id))
+}
+
+// PruneDelegatorValidatorCheckpoints deletes the validator checkpoints recorded before the earliest checkpoint any
+// delegator claim has not yet been synced to. Claims are only synced from checkpoints with IDs from their own, so
+// these checkpoints are no longer read.
+func (k Keeper) PruneDelegatorValidatorCheckpoints(ctx sdk.Context) {
+	pruneBeforeID := k.GetNextDelegatorCheckpointID(ctx)
+
+	claimIndexStore := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorClaimCheckpointIDIndexPrefix)
+	claimIterator := claimIndexStore.Iterator(nil, nil)
+	if claimIterator.Valid() {
+		pruneBeforeID = sdk.BigEndianToUint64(claimIterator.Value())
+	}
+	claimIterator.Close()
+
+	checkpointStore := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorValidatorCheckpointKeyPrefix)
+	checkpointIndexStore := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorValidatorCheckpointIDIndexPrefix)
+	iterator := checkpointIndexStore.Iterator(nil, sdk.Uint64ToBigEndian(pruneBeforeID))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		id := sdk.BigEndianToUint64(key[:8])
+		valAddr := sdk.ValAddress(key[8:])
+		checkpointStore.Delete(types.DelegatorValidatorCheckpointKey(valAddr, id))
+		checkpointIndexStore.Delete(key)
+	}
+}
@@ -624,6 +624,94 @@ func (suite *KeeperTestSuite) TestIterateEarnRewardAccrualTimes() {
 	suite.Equal(expectedAccrualTimes, actualAccrualTimes)
 }
 
+func (suite *KeeperTestSuite) TestIterateDelegatorValidatorCheckpoints() {
+	suite.SetupApp()
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress("validator_one"), sdk.ValAddress("validator_one_two")}
+	indexes := types.RewardIndexes{types.NewRewardIndex("hard", d("0.1"))}
+	checkpoints := []types.DelegatorValidatorCheckpoint{
+		types.NewDelegatorValidatorCheckpoint(indexes, true, i(1000), d("1000")),
+		types.NewDelegatorValidatorCheckpoint(indexes.Mul(d("2")), false, i(500), d("1000")),
+		types.NewDelegatorValidatorCheckpoint(indexes.Mul(d("3")), true, i(500), d("1000")),
+	}
+	suite.keeper.SetDelegatorValidatorCheckpoint(suite.ctx, valAddrs[0], 1, checkpoints[0])
+	suite.keeper.SetDelegatorValidatorCheckpoint(suite.ctx, valAddrs[1], 2, checkpoints[1])
+	suite.keeper.SetDelegatorValidatorCheckpoint(suite.ctx, valAddrs[0], 3, checkpoints[2])
+
+	var actual []types.DelegatorValidatorCheckpoint
+	suite.keeper.IterateDelegatorValidatorCheckpoints(suite.ctx, valAddrs[0], 0, func(checkpoint types.DelegatorValidatorCheckpoint) bool {
+		actual = append(actual, checkpoint)
+		return false
+	})
+	// checkpoints of validators with a prefixed address are not included
+	suite.Equal([]types.DelegatorValidatorCheckpoint{checkpoints[0], checkpoints[2]}, actual)
+
+	actual = nil
+	suite.keeper.IterateDelegatorValidatorCheckpoints(suite.ctx, valAddrs[0], 2, func(checkpoint types.DelegatorValidatorCheckpoint) bool {
+		actual = append(actual, checkpoint)
+		return false
+	})
+	suite.Equal([]types.DelegatorValidatorCheckpoint{checkpoints[2]}, actual)
+}
+
+func (suite *KeeperTestSuite) TestGetSetDeleteDelegatorClaimCheckpointID() {
+	suite.SetupApp()
+
+	claim := types.NewDelegatorClaim(suite.addrs[0], cs(c("hard", 1)), nil)
+	suite.keeper.SetDelegatorClaim(suite.ctx, claim)
+	suite.Equal(uint64(0), suite.keeper.GetDelegatorClaimCheckpointID(suite.ctx, claim.Owner))
+
+	suite.keeper.SetDelegatorClaimCheckpointID(suite.ctx, claim.Owner, 7)
+	suite.Equal(uint64(7), suite.keeper.GetDelegatorClaimCheckpointID(suite.ctx, claim.Owner))
+
+	suite.keeper.DeleteDelegatorClaim(suite.ctx, claim.Owner)
+	suite.Equal(uint64(0), suite.keeper.GetDelegatorClaimCheckpointID(suite.ctx, claim.Owner))
+}
+
+func (suite *KeeperTestSuite) TestPruneDelegatorValidatorCheckpoints() {
+	suite.SetupApp()
+	for _, claim := range suite.keeper.GetAllDelegatorClaims(suite.ctx) {
+		suite.keeper.DeleteDelegatorClaim(suite.ctx, claim.Owner)
+	}
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress("validator_one"), sdk.ValAddress("validator_two")}
+	checkpoint := types.NewDelegatorValidatorCheckpoint(types.RewardIndexes{types.NewRewardIndex("hard", d("0.1"))}, true, i(1000), d("1000"))
+	for id := uint64(0); id < 4; id++ {
+		suite.keeper.SetDelegatorValidatorCheckpoint(suite.ctx, valAddrs[id%2], id, checkpoint)
+	}
+	suite.keeper.SetNextDelegatorCheckpointID(suite.ctx, 4)
+
+	countCheckpoints := func() int {
+		count := 0
+		for _, valAddr := range valAddrs {
+			suite.keeper.IterateDelegatorValidatorCheckpoints(suite.ctx, valAddr, 0, func(types.DelegatorValidatorCheckpoint) bool {
+				count++
+				return false
+			})
+		}
+		return count
+	}
+
+	// checkpoints from the earliest one a claim has not been synced to are kept
+	suite.keeper.SetDelegatorClaim(suite.ctx, types.NewDelegatorClaim(suite.addrs[0], nil, nil))
+	suite.keeper.SetDelegatorClaimCheckpointID(suite.ctx, suite.addrs[0], 3)
+	suite.keeper.SetDelegatorClaim(suite.ctx, types.NewDelegatorClaim(suite.addrs[1], nil, nil))
+	suite.keeper.SetDelegatorClaimCheckpointID(suite.ctx, suite.addrs[1], 1)
+	suite.keeper.PruneDelegatorValidatorCheckpoints(suite.ctx)
+	suite.Equal(3, countCheckpoints())
+
+	// the claim is synced, so only the checkpoints before the other claim's are no longer needed
+	suite.keeper.SetDelegatorClaimCheckpointID(suite.ctx, suite.addrs[1], 4)
+	suite.keeper.PruneDelegatorValidatorCheckpoints(suite.ctx)
+	suite.Equal(1, countCheckpoints())
+
+	// without claims, all checkpoints are pruned
+	suite.keeper.DeleteDelegatorClaim(suite.ctx, suite.addrs[0])
+	suite.keeper.DeleteDelegatorClaim(suite.ctx, suite.addrs[1])
+	suite.keeper.PruneDelegatorValidatorCheckpoints(suite.ctx)
+	suite.Equal(0, countCheckpoints())
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// Staking derivatives are counted as delegations from version 2, so all delegator claims are first synchronized using
+// only native delegations. Otherwise, the next sync would pay rewards for derivatives held before the migration.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	for _, claim := range m.keeper.GetAllDelegatorClaims(ctx) {
+		m.keeper.synchronizeDelegatorRewards(ctx, claim.Owner, false)
+	}
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// Delegator claims are synchronized lazily from version 3, using checkpoints recorded when validators change status or
+// are slashed. Existing claims were synchronized by the hooks up to now, so they start from the next checkpoint.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	nextID := m.keeper.GetNextDelegatorCheckpointID(ctx)
+	for _, claim := range m.keeper.GetAllDelegatorClaims(ctx) {
+		m.keeper.SetDelegatorClaimCheckpointID(ctx, claim.Owner, nextID)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/incentive/keeper"
+	"github.com/kava-labs/kava/x/incentive/testutil"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+func (suite *DelegatorRewardsTestSuite) TestMigrate1to2_SyncsDelegatorClaimsWithoutDerivatives() {
+	holder, delegator := suite.addrs[0], suite.addrs[1]
+
+	authBuilder := app.NewAuthBankGenesisBuilder().
+		WithSimpleAccount(holder, cs(c("ukava", 1e9))).
+		WithSimpleAccount(delegator, cs(c("ukava", 1e9))).
+		WithSimpleAccount(sdk.AccAddress(suite.validatorAddrs[0]), cs(c("ukava", 1e9)))
+
+	bondDenom := "ukava"
+	incentBuilder := testutil.NewIncentiveGenesisBuilder().
+		WithGenesisTime(suite.genesisTime).
+		WithSimpleDelegatorRewardPeriod(bondDenom, cs(c("hard", 122354)))
+
+	suite.SetupWithGenState(authBuilder, incentBuilder)
+
+	blockDuration := 10 * time.Second
+
+	err := suite.deliverMsgCreateValidator(suite.ctx, suite.validatorAddrs[0], c(bondDenom, 10_000_000))
+	suite.Require().NoError(err)
+
+	// End the block so the validator becomes bonded
+	_ = suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{})
+	suite.ctx = suite.ctx.WithBlockTime(suite.genesisTime.Add(1 * blockDuration))
+	_ = suite.app.BeginBlocker(suite.ctx, abci.RequestBeginBlock{})
+
+	// the holder has half their delegation as derivatives, the delegator has the same native delegation only
+	err = suite.deliverMsgDelegate(suite.ctx, holder, suite.validatorAddrs[0], c(bondDenom, 2_000_000))
+	suite.Require().NoError(err)
+	_, err = suite.app.GetLiquidKeeper().MintDerivative(suite.ctx, holder, suite.validatorAddrs[0], c(bondDenom, 1_000_000))
+	suite.Require().NoError(err)
+	err = suite.deliverMsgDelegate(suite.ctx, delegator, suite.validatorAddrs[0], c(bondDenom, 1_000_000))
+	suite.Require().NoError(err)
+
+	_ = suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{})
+	suite.ctx = suite.ctx.WithBlockTime(suite.genesisTime.Add(2 * blockDuration))
+	_ = suite.app.BeginBlocker(suite.ctx, abci.RequestBeginBlock{})
+
+	err = keeper.NewMigrator(suite.keeper).Migrate1to2(suite.ctx)
+	suite.Require().NoError(err)
+
+	// rewards earned before the migration only count native delegations
+	holderClaim, found := suite.keeper.GetDelegatorClaim(suite.ctx, holder)
+	suite.Require().True(found)
+	delegatorClaim, found := suite.keeper.GetDelegatorClaim(suite.ctx, delegator)
+	suite.Require().True(found)
+	suite.Require().False(delegatorClaim.Reward.Empty())
+	suite.Equal(delegatorClaim.Reward, holderClaim.Reward)
+
+	globalIndexes, found := suite.keeper.GetDelegatorRewardIndexes(suite.ctx, bondDenom)
+	suite.Require().True(found)
+	for _, claim := range suite.keeper.GetAllDelegatorClaims(suite.ctx) {
+		claimIndexes, found := claim.RewardIndexes.GetRewardIndex(bondDenom)
+		suite.Require().True(found)
+		suite.Equal(globalIndexes, claimIndexes.RewardIndexes)
+	}
+
+	// rewards earned after the migration count derivatives
+	_ = suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{})
+	suite.ctx = suite.ctx.WithBlockTime(suite.genesisTime.Add(3 * blockDuration))
+	_ = suite.app.BeginBlocker(suite.ctx, abci.RequestBeginBlock{})
+
+	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, holder)
+	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, delegator)
+	updatedHolderClaim, _ := suite.keeper.GetDelegatorClaim(suite.ctx, holder)
+	updatedDelegatorClaim, _ := suite.keeper.GetDelegatorClaim(suite.ctx, delegator)
+
+	holderEarned := updatedHolderClaim.Reward.Sub(holderClaim.Reward...)
+	delegatorEarned := updatedDelegatorClaim.Reward.Sub(delegatorClaim.Reward...)
+	// derivative values are truncated, so allow for rounding
+	suite.InDelta(
+		delegatorEarned.AmountOf("hard").MulRaw(2).Int64(),
+		holderEarned.AmountOf("hard").Int64(),
+		1,
+	)
+}
+
+func (suite *DelegatorRewardsTestSuite) TestMigrate2to3_StartsDelegatorClaimsFromNextCheckpoint() {
+	suite.SetupApp()
+
+	indexes := types.RewardIndexes{types.NewRewardIndex("hard", d("0.1"))}
+	suite.keeper.SetDelegatorValidatorCheckpoint(
+		suite.ctx, suite.validatorAddrs[0], 0, types.NewDelegatorValidatorCheckpoint(indexes, true, i(1000), d("1000")),
+	)
+	suite.keeper.SetNextDelegatorCheckpointID(suite.ctx, 1)
+
+	for _, owner := range suite.addrs[:2] {
+		suite.keeper.SetDelegatorClaim(suite.ctx, types.NewDelegatorClaim(owner, nil, nil))
+	}
+
+	err := keeper.NewMigrator(suite.keeper).Migrate2to3(suite.ctx)
+	suite.Require().NoError(err)
+
+	// claims were synced up to the migration, so checkpoints recorded before it are not rewarded
+	for _, owner := range suite.addrs[:2] {
+		suite.Equal(uint64(1), suite.keeper.GetDelegatorClaimCheckpointID(suite.ctx, owner))
+	}
+	suite.Equal(uint64(0), suite.keeper.GetDelegatorClaimCheckpointID(suite.ctx, suite.addrs[2]))
+}
@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/kava-labs/kava/x/incentive/types"
 )
@@ -25,12 +27,12 @@ var _ types.MsgServer = msgServer{}
 func (k msgServer) ClaimUSDXMintingReward(goCtx context.Context, msg *types.MsgClaimUSDXMintingReward) (*types.MsgClaimUSDXMintingRewardResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	sender, receiver, err := claimAddresses(msg.Sender, msg.Receiver)
 	if err != nil {
 		return nil, err
 	}
 
-	err = k.keeper.ClaimUSDXMintingReward(ctx, sender, sender, msg.MultiplierName)
+	err = k.keeper.ClaimUSDXMintingReward(ctx, sender, receiver, msg.MultiplierName)
 	if err != nil {
 		return nil, err
 	}
@@ -41,13 +43,13 @@ func (k msgServer) ClaimUSDXMintingReward(goCtx context.Context, msg *types.MsgC
 func (k msgServer) ClaimHardReward(goCtx context.Context, msg *types.MsgClaimHardReward) (*types.MsgClaimHardRewardResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	sender, receiver, err := claimAddresses(msg.Sender, msg.Receiver)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, selection := range msg.DenomsToClaim {
-		err := k.keeper.ClaimHardReward(ctx, sender, sender, selection.Denom, selection.MultiplierName)
+		err := k.keeper.ClaimHardReward(ctx, sender, receiver, selection.Denom, selection.MultiplierName)
 		if err != nil {
 			return nil, err
 		}
@@ -60,13 +62,13 @@ func (k msgServer) ClaimHardReward(goCtx context.Context, msg *types.MsgClaimHar
 func (k msgServer) ClaimDelegatorReward(goCtx context.Context, msg *types.MsgClaimDelegatorReward) (*types.MsgClaimDelegatorRewardResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	sender, receiver, err := claimAddresses(msg.Sender, msg.Receiver)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, selection := range msg.DenomsToClaim {
-		err := k.keeper.ClaimDelegatorReward(ctx, sender, sender, selection.Denom, selection.MultiplierName)
+		err := k.keeper.ClaimDelegatorReward(ctx, sender, receiver, selection.Denom, selection.MultiplierName)
 		if err != nil {
 			return nil, err
 		}
@@ -78,13 +80,13 @@ func (k msgServer) ClaimDelegatorReward(goCtx context.Context, msg *types.MsgCla
 func (k msgServer) ClaimSwapReward(goCtx context.Context, msg *types.MsgClaimSwapReward) (*types.MsgClaimSwapRewardResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	sender, receiver, err := claimAddresses(msg.Sender, msg.Receiver)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, selection := range msg.DenomsToClaim {
-		err := k.keeper.ClaimSwapReward(ctx, sender, sender, selection.Denom, selection.MultiplierName)
+		err := k.keeper.ClaimSwapReward(ctx, sender, receiver, selection.Denom, selection.MultiplierName)
 		if err != nil {
 			return nil, err
 		}
@@ -101,13 +103,13 @@ func (k msgServer) ClaimSavingsReward(goCtx context.Context, msg *types.MsgClaim
 func (k msgServer) ClaimEarnReward(goCtx context.Context, msg *types.MsgClaimEarnReward) (*types.MsgClaimEarnRewardResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	sender, receiver, err := claimAddresses(msg.Sender, msg.Receiver)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, selection := range msg.DenomsToClaim {
-		err := k.keeper.ClaimEarnReward(ctx, sender, sender, selection.Denom, selection.MultiplierName)
+		err := k.keeper.ClaimEarnReward(ctx, sender, receiver, selection.Denom, selection.MultiplierName)
 		if err != nil {
 			return nil, err
 		}
@@ -115,3 +117,126 @@ func (k msgServer) ClaimEarnReward(goCtx context.Context, msg *types.MsgClaimEar
 
 	return &types.MsgClaimEarnRewardResponse{}, nil
 }
+
+// ClaimAll claims the selected denoms from every claim type the sender holds.
+// Claim types the sender has no claim for, or no rewards in a selected denom,
+// are skipped. The message fails if nothing could be claimed at all.
+func (k msgServer) ClaimAll(goCtx context.Context, msg *types.MsgClaimAll) (*types.MsgClaimAllResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, receiver, err := claimAddresses(msg.Sender, msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := false
+	claim := func(err error) error {
+		if errors.Is(err, types.ErrClaimNotFound) || errors.Is(err, types.ErrZeroClaim) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		claimed = true
+		return nil
+	}
+
+	claimers := []func(ctx sdk.Context, owner, receiver sdk.AccAddress, denom string, multiplierName string) error{
+		k.keeper.ClaimHardReward,
+		k.keeper.ClaimDelegatorReward,
+		k.keeper.ClaimSwapReward,
+		k.keeper.ClaimEarnReward,
+		k.keeper.ClaimSavingsReward,
+	}
+
+	for _, selection := range msg.DenomsToClaim {
+		if selection.Denom == types.USDXMintingRewardDenom {
+			if err := claim(k.keeper.ClaimUSDXMintingReward(ctx, sender, receiver, selection.MultiplierName)); err != nil {
+				return nil, err
+			}
+		}
+		for _, claimer := range claimers {
+			if err := claim(claimer(ctx, sender, receiver, selection.Denom, selection.MultiplierName)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if !claimed {
+		return nil, errorsmod.Wrapf(types.ErrZeroClaim, "address: %s", sender)
+	}
+
+	return &types.MsgClaimAllResponse{}, nil
+}
+
+// RevokeClaim removes rewards from an owner's claim. It can only be executed by the module authority.
+func (k msgServer) RevokeClaim(goCtx context.Context, msg *types.MsgRevokeClaim) (*types.MsgRevokeClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.keeper.GetAuthority().String() != msg.Authority {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority; expected %s, got %s",
+			k.keeper.GetAuthority(),
+			msg.Authority,
+		)
+	}
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := k.keeper.RevokeClaim(ctx, owner, msg.ClaimType, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeClaimResponse{}, nil
+}
+
+// MergeClaims merges the claims of an address into the claims of another address. It can only be executed by the
+// module authority.
+func (k msgServer) MergeClaims(goCtx context.Context, msg *types.MsgMergeClaims) (*types.MsgMergeClaimsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.keeper.GetAuthority().String() != msg.Authority {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority; expected %s, got %s",
+			k.keeper.GetAuthority(),
+			msg.Authority,
+		)
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.To)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.keeper.MergeClaims(ctx, from, to); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMergeClaimsResponse{}, nil
+}
+
+// claimAddresses parses the sender of a claim msg, and the receiver the rewards are paid out to.
+// The receiver defaults to the sender when not set.
+func claimAddresses(sender, receiver string) (sdk.AccAddress, sdk.AccAddress, error) {
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return nil, nil, err
+	}
+	if receiver == "" {
+		return senderAddr, senderAddr, nil
+	}
+	receiverAddr, err := sdk.AccAddressFromBech32(receiver)
+	if err != nil {
+		return nil, nil, err
+	}
+	return senderAddr, receiverAddr, nil
+}
@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+func (suite *HandlerTestSuite) TestPayoutAllClaimMultiType() {
+	userAddr := suite.addrs[0]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("bnb", 1e12), c("ukava", 1e12), c("busd", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSupplyRewardPeriod("bnb", cs(c("hard", 1e6), c("swap", 1e6))).
+		WithSimpleBorrowRewardPeriod("bnb", cs(c("hard", 1e6), c("swap", 1e6))).
+		WithSimpleSwapRewardPeriod("busd:ukava", cs(c("hard", 1e6), c("swap", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	// create a hard deposit and borrow, and a swap pool deposit
+	suite.NoError(suite.DeliverHardMsgDeposit(userAddr, cs(c("bnb", 1e11))))
+	suite.NoError(suite.DeliverHardMsgBorrow(userAddr, cs(c("bnb", 1e10))))
+	suite.NoError(
+		suite.DeliverSwapMsgDeposit(userAddr, c("ukava", 1e9), c("busd", 1e9), d("1.0")),
+	)
+
+	// accumulate some rewards
+	suite.NextBlockAfter(7 * time.Second)
+
+	preClaimBal := suite.GetBalance(userAddr)
+
+	msg := types.NewMsgClaimAll(
+		userAddr.String(),
+		types.Selections{
+			types.NewSelection("hard", "small"),
+			types.NewSelection("swap", "medium"),
+		},
+	)
+
+	// Claim denoms from every claim type
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.Require().NoError(err)
+
+	// Check rewards from both the hard and swap claims were paid out
+	expectedRewardsHard := c("hard", int64(0.2*float64(2*7*1e6))+int64(0.2*float64(7*1e6)))
+	expectedRewardsSwap := c("swap", int64(0.5*float64(2*7*1e6))+int64(0.5*float64(7*1e6)))
+	suite.BalanceEquals(userAddr, preClaimBal.Add(expectedRewardsHard, expectedRewardsSwap))
+
+	// Check that claimed coins have been removed from each claim's reward
+	suite.HardRewardEquals(userAddr, nil)
+	suite.SwapRewardEquals(userAddr, nil)
+}
+
+func (suite *HandlerTestSuite) TestPayoutAllClaimSavings() {
+	userAddr := suite.addrs[0]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("bnb", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSupplyRewardPeriod("bnb", cs(c("hard", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	suite.NoError(suite.DeliverHardMsgDeposit(userAddr, cs(c("bnb", 1e11))))
+
+	// give the user unclaimed savings rewards alongside their hard rewards
+	suite.App.GetIncentiveKeeper().SetSavingsClaim(
+		suite.Ctx,
+		types.NewSavingsClaim(userAddr, cs(c("hard", 1e6), c("swap", 1e6)), nil),
+	)
+
+	suite.NextBlockAfter(7 * time.Second)
+
+	preClaimBal := suite.GetBalance(userAddr)
+
+	msg := types.NewMsgClaimAll(
+		userAddr.String(),
+		types.Selections{
+			types.NewSelection("hard", "large"),
+			types.NewSelection("swap", "large"),
+		},
+	)
+
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.Require().NoError(err)
+
+	// Check rewards from both the hard and savings claims were paid out
+	expectedRewards := cs(c("hard", 7*1e6+1e6), c("swap", 1e6))
+	suite.BalanceEquals(userAddr, preClaimBal.Add(expectedRewards...))
+
+	suite.HardRewardEquals(userAddr, nil)
+	savingsClaim, found := suite.App.GetIncentiveKeeper().GetSavingsClaim(suite.Ctx, userAddr)
+	suite.Require().True(found)
+	suite.Empty(savingsClaim.Reward)
+}
+
+func (suite *HandlerTestSuite) TestPayoutAllClaimSkipsUnselectedDenoms() {
+	userAddr := suite.addrs[0]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("bnb", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSupplyRewardPeriod("bnb", cs(c("hard", 1e6), c("swap", 1e6))).
+		WithSimpleBorrowRewardPeriod("bnb", cs(c("hard", 1e6), c("swap", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	suite.NoError(suite.DeliverHardMsgDeposit(userAddr, cs(c("bnb", 1e11))))
+	suite.NoError(suite.DeliverHardMsgBorrow(userAddr, cs(c("bnb", 1e10))))
+
+	suite.NextBlockAfter(7 * time.Second)
+
+	preClaimBal := suite.GetBalance(userAddr)
+
+	msg := types.NewMsgClaimAll(
+		userAddr.String(),
+		types.Selections{
+			types.NewSelection("swap", "large"),
+		},
+	)
+
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.Require().NoError(err)
+
+	expectedRewards := c("swap", 2*7*1e6)
+	suite.BalanceEquals(userAddr, preClaimBal.Add(expectedRewards))
+
+	suite.HardRewardEquals(userAddr, cs(c("hard", 2*7*1e6)))
+}
+
+func (suite *HandlerTestSuite) TestPayoutAllClaimNothingToClaim() {
+	userAddr := suite.addrs[0]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("bnb", 1e12)))
+
+	suite.SetupWithGenState(authBulder, suite.incentiveBuilder())
+
+	msg := types.NewMsgClaimAll(
+		userAddr.String(),
+		types.Selections{
+			types.NewSelection("hard", "large"),
+		},
+	)
+
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.ErrorIs(err, types.ErrZeroClaim)
+}
+
+func (suite *HandlerTestSuite) TestPayoutAllClaimInvalidMultiplier() {
+	userAddr := suite.addrs[0]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("bnb", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSupplyRewardPeriod("bnb", cs(c("hard", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	suite.NoError(suite.DeliverHardMsgDeposit(userAddr, cs(c("bnb", 1e11))))
+
+	suite.NextBlockAfter(7 * time.Second)
+
+	msg := types.NewMsgClaimAll(
+		userAddr.String(),
+		types.Selections{
+			types.NewSelection("hard", "medium"),
+		},
+	)
+
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.ErrorIs(err, types.ErrInvalidMultiplier)
+}
@@ -4,9 +4,11 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 
 	"github.com/kava-labs/kava/x/incentive/types"
+	liquidtypes "github.com/kava-labs/kava/x/liquid/types"
 )
 
 func (suite *HandlerTestSuite) TestPayoutDelegatorClaimMultiDenom() {
@@ -110,3 +112,158 @@ func (suite *HandlerTestSuite) TestPayoutDelegatorClaimSingleDenom() {
 	// Check that claimed coins have been removed from a claim's reward
 	suite.DelegatorRewardEquals(userAddr, cs(c("hard", 2*7*1e6)))
 }
+
+func (suite *HandlerTestSuite) TestPayoutDelegatorClaimAfterDerivativeTransfer() {
+	userAddr := suite.addrs[0]
+	receiverAddr := suite.addrs[1]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("ukava", 1e12))).
+		WithSimpleAccount(receiverAddr, cs(c("ukava", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleDelegatorRewardPeriod(types.BondDenom, cs(c("hard", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	valAddr := sdk.ValAddress(userAddr)
+	suite.NoError(
+		suite.DeliverMsgCreateValidator(valAddr, c("ukava", 1e9)),
+	)
+	suite.NoError(
+		suite.DeliverMsgDelegate(receiverAddr, valAddr, c("ukava", 1e9)),
+	)
+
+	// Delete genesis validator to not influence rewards
+	suite.App.DeleteGenesisValidator(suite.T(), suite.Ctx)
+
+	// new block required to bond validator
+	suite.NextBlockAfter(7 * time.Second)
+
+	derivative, err := suite.DeliverMsgMintDerivative(userAddr, valAddr, c("ukava", 500e6))
+	suite.Require().NoError(err)
+
+	// accumulate some delegator rewards
+	suite.NextBlockAfter(7 * time.Second)
+
+	ik := suite.App.GetIncentiveKeeper()
+	syncedCtx, _ := suite.Ctx.CacheContext()
+	ik.SynchronizeDelegatorRewards(syncedCtx, userAddr)
+	ik.SynchronizeDelegatorRewards(syncedCtx, receiverAddr)
+	expectedUserClaim, found := ik.GetDelegatorClaim(syncedCtx, userAddr)
+	suite.Require().True(found)
+	expectedReceiverClaim, found := ik.GetDelegatorClaim(syncedCtx, receiverAddr)
+	suite.Require().True(found)
+	suite.Require().False(expectedReceiverClaim.Reward.IsZero())
+
+	// send the derivatives through the bank keeper rather than a bank msg, as other modules do
+	err = suite.App.GetBankKeeper().SendCoins(suite.Ctx, userAddr, receiverAddr, cs(derivative))
+	suite.Require().NoError(err)
+
+	// the claims of both accounts are synchronized by the transfer
+	userClaim, found := ik.GetDelegatorClaim(suite.Ctx, userAddr)
+	suite.Require().True(found)
+	suite.Equal(expectedUserClaim, userClaim)
+	receiverClaim, found := ik.GetDelegatorClaim(suite.Ctx, receiverAddr)
+	suite.Require().True(found)
+	suite.Equal(expectedReceiverClaim, receiverClaim)
+
+	preClaimBal := suite.GetBalance(receiverAddr)
+
+	msg := types.NewMsgClaimDelegatorReward(
+		receiverAddr.String(),
+		types.Selections{
+			types.NewSelection("hard", "large"),
+		},
+	)
+	err = suite.DeliverIncentiveMsg(&msg)
+	suite.Require().NoError(err)
+
+	suite.BalanceEquals(receiverAddr, preClaimBal.Add(expectedReceiverClaim.Reward...))
+	suite.DelegatorRewardEquals(receiverAddr, nil)
+}
+
+func (suite *HandlerTestSuite) TestPayoutDelegatorClaimForDerivatives() {
+	userAddr := suite.addrs[0]
+	holderAddr := suite.addrs[1]
+	receiverAddr := suite.addrs[2]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("ukava", 1e12))).
+		WithSimpleAccount(holderAddr, cs(c("ukava", 1e12))).
+		WithSimpleAccount(receiverAddr, cs(c("ukava", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleDelegatorRewardPeriod(types.BondDenom, cs(c("hard", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	valAddr := sdk.ValAddress(userAddr)
+	suite.NoError(
+		suite.DeliverMsgCreateValidator(valAddr, c("ukava", 1e9)),
+	)
+	suite.NoError(
+		suite.DeliverMsgDelegate(holderAddr, valAddr, c("ukava", 1e9)),
+	)
+
+	// Delete genesis validator to not influence rewards
+	suite.App.DeleteGenesisValidator(suite.T(), suite.Ctx)
+
+	// new block required to bond validator
+	suite.NextBlockAfter(7 * time.Second)
+
+	// convert the holder's whole delegation into derivatives
+	derivative, err := suite.DeliverMsgMintDerivative(holderAddr, valAddr, c("ukava", 1e9))
+	suite.Require().NoError(err)
+
+	// accumulate some delegator rewards
+	suite.NextBlockAfter(7 * time.Second)
+
+	ik := suite.App.GetIncentiveKeeper()
+	liquidAddr := authtypes.NewModuleAddress(liquidtypes.ModuleAccountName)
+	for _, addr := range []sdk.AccAddress{userAddr, holderAddr, liquidAddr} {
+		ik.SynchronizeDelegatorRewards(suite.Ctx, addr)
+	}
+
+	// derivatives earn the same rewards as the delegation they were minted from
+	userClaim, found := ik.GetDelegatorClaim(suite.Ctx, userAddr)
+	suite.Require().True(found)
+	suite.Require().False(userClaim.Reward.IsZero())
+	suite.DelegatorRewardEquals(holderAddr, userClaim.Reward)
+	// the liquid module's delegation is rewarded to the derivative holders instead
+	suite.DelegatorRewardEquals(liquidAddr, nil)
+
+	// the receiver has no delegations, so has a claim created when receiving derivatives
+	_, found = ik.GetDelegatorClaim(suite.Ctx, receiverAddr)
+	suite.Require().False(found)
+	err = suite.App.GetBankKeeper().SendCoins(suite.Ctx, holderAddr, receiverAddr, cs(c(derivative.Denom, 500e6)))
+	suite.Require().NoError(err)
+	suite.DelegatorRewardEquals(receiverAddr, nil)
+
+	holderClaim, found := ik.GetDelegatorClaim(suite.Ctx, holderAddr)
+	suite.Require().True(found)
+
+	// accumulate rewards split between the holder and receiver
+	suite.NextBlockAfter(7 * time.Second)
+
+	ik.SynchronizeDelegatorRewards(suite.Ctx, holderAddr)
+	ik.SynchronizeDelegatorRewards(suite.Ctx, receiverAddr)
+	receiverClaim, found := ik.GetDelegatorClaim(suite.Ctx, receiverAddr)
+	suite.Require().True(found)
+	suite.Require().False(receiverClaim.Reward.IsZero())
+	suite.DelegatorRewardEquals(holderAddr, holderClaim.Reward.Add(receiverClaim.Reward...))
+
+	preClaimBal := suite.GetBalance(receiverAddr)
+
+	msg := types.NewMsgClaimDelegatorReward(
+		receiverAddr.String(),
+		types.Selections{
+			types.NewSelection("hard", "large"),
+		},
+	)
+	err = suite.DeliverIncentiveMsg(&msg)
+	suite.Require().NoError(err)
+
+	suite.BalanceEquals(receiverAddr, preClaimBal.Add(receiverClaim.Reward...))
+	suite.DelegatorRewardEquals(receiverAddr, nil)
+}
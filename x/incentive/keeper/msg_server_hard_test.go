@@ -98,3 +98,50 @@ func (suite *HandlerTestSuite) TestPayoutHardClaimSingleDenom() {
 	// Check that claimed coins have been removed from a claim's reward
 	suite.HardRewardEquals(userAddr, cs(c("hard", 2*7*1e6)))
 }
+
+func (suite *HandlerTestSuite) TestPayoutHardClaimToReceiver() {
+	userAddr, receiverAddr := suite.addrs[0], suite.addrs[1]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("bnb", 1e12))).
+		WithSimpleAccount(receiverAddr, nil)
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSupplyRewardPeriod("bnb", cs(c("hard", 1e6))).
+		WithSimpleBorrowRewardPeriod("bnb", cs(c("hard", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	// create a deposit and borrow
+	suite.NoError(suite.DeliverHardMsgDeposit(userAddr, cs(c("bnb", 1e11))))
+	suite.NoError(suite.DeliverHardMsgBorrow(userAddr, cs(c("bnb", 1e10))))
+
+	// accumulate some rewards
+	suite.NextBlockAfter(7 * time.Second)
+
+	preClaimBal := suite.GetBalance(userAddr)
+
+	msg := types.NewMsgClaimHardReward(
+		userAddr.String(),
+		types.Selections{
+			types.NewSelection("hard", "large"),
+		},
+	)
+	msg.Receiver = receiverAddr.String()
+
+	// Claim rewards
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.Require().NoError(err)
+
+	// Check rewards were paid out to the receiver, not the claim owner
+	expectedRewards := c("hard", 2*7*1e6)
+	suite.BalanceEquals(receiverAddr, cs(expectedRewards))
+	suite.BalanceEquals(userAddr, preClaimBal)
+
+	suite.VestingPeriodsEqual(receiverAddr, []vestingtypes.Period{
+		{Length: (17+31+28+31+30+31+30+31+31+30+31+30+31)*secondsPerDay - 7, Amount: cs(expectedRewards)},
+	})
+
+	// Check that claimed coins have been removed from the owner's claim
+	suite.HardRewardEquals(userAddr, nil)
+}
@@ -4,12 +4,15 @@ import (
 	"testing"
 	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	proto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/incentive/keeper"
 	"github.com/kava-labs/kava/x/incentive/testutil"
 	"github.com/kava-labs/kava/x/incentive/types"
 	kavadisttypes "github.com/kava-labs/kava/x/kavadist/types"
@@ -189,3 +192,162 @@ func (suite *HandlerTestSuite) TestPayoutSwapClaimSingleDenom() {
 	// Check that claimed coins have been removed from a claim's reward
 	suite.SwapRewardEquals(userAddr, cs(c("hard", 7*1e6)))
 }
+
+// claimPayout records a call to the AfterClaimPayout hook
+type claimPayout struct {
+	owner     sdk.AccAddress
+	coins     sdk.Coins
+	claimType string
+}
+
+// fakeIncentiveHooks records the claim payouts it is called with
+type fakeIncentiveHooks struct {
+	payouts []claimPayout
+}
+
+func (h *fakeIncentiveHooks) AfterClaimPayout(_ sdk.Context, owner sdk.AccAddress, coins sdk.Coins, claimType string) {
+	h.payouts = append(h.payouts, claimPayout{owner: owner, coins: coins, claimType: claimType})
+}
+
+func (suite *HandlerTestSuite) TestPayoutSwapClaimCallsHooks() {
+	userAddr, receiverAddr := suite.addrs[0], suite.addrs[1]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("ukava", 1e12), c("busd", 1e12))).
+		WithSimpleAccount(receiverAddr, cs(c("ukava", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSwapRewardPeriod("busd:ukava", cs(c("hard", 1e6), c("swap", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	// deposit into a swap pool
+	suite.NoError(
+		suite.DeliverSwapMsgDeposit(userAddr, c("ukava", 1e9), c("busd", 1e9), d("1.0")),
+	)
+	// accumulate some swap rewards
+	suite.NextBlockAfter(7 * time.Second)
+
+	incentiveKeeper := suite.App.GetIncentiveKeeper()
+	incentiveKeeper.ClearHooks()
+	hooks := &fakeIncentiveHooks{}
+	incentiveKeeper.SetHooks(hooks)
+
+	msg := types.NewMsgClaimSwapReward(
+		userAddr.String(),
+		types.Selections{
+			types.NewSelection("hard", "small"),
+			types.NewSelection("swap", "medium"),
+		},
+	)
+	msg.Receiver = receiverAddr.String()
+	_, err := keeper.NewMsgServerImpl(incentiveKeeper).ClaimSwapReward(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().NoError(err)
+
+	// hooks are called once per claimed denom with the coins paid out, and the claim owner rather than the receiver
+	suite.Equal([]claimPayout{
+		{owner: userAddr, coins: cs(c("hard", int64(0.2*float64(7*1e6)))), claimType: types.SwapClaimType},
+		{owner: userAddr, coins: cs(c("swap", int64(0.5*float64(7*1e6)))), claimType: types.SwapClaimType},
+	}, hooks.payouts)
+
+	// failed claims do not call hooks
+	_, err = keeper.NewMsgServerImpl(incentiveKeeper).ClaimSwapReward(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().Error(err)
+	suite.Len(hooks.payouts, 2)
+}
+
+func (suite *HandlerTestSuite) TestPayoutSwapClaimEmitsTypedEvent() {
+	userAddr, receiverAddr := suite.addrs[0], suite.addrs[1]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("ukava", 1e12), c("busd", 1e12))).
+		WithSimpleAccount(receiverAddr, cs(c("ukava", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSwapRewardPeriod("busd:ukava", cs(c("hard", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	// deposit into a swap pool
+	suite.NoError(
+		suite.DeliverSwapMsgDeposit(userAddr, c("ukava", 1e9), c("busd", 1e9), d("1.0")),
+	)
+	// accumulate some swap rewards
+	suite.NextBlockAfter(7 * time.Second)
+
+	msg := types.NewMsgClaimSwapReward(
+		userAddr.String(),
+		types.Selections{
+			types.NewSelection("hard", "small"),
+		},
+	)
+	msg.Receiver = receiverAddr.String()
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err := keeper.NewMsgServerImpl(suite.App.GetIncentiveKeeper()).ClaimSwapReward(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().NoError(err)
+
+	var claimEvents []proto.Message
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != proto.MessageName(&types.EventClaimReward{}) {
+			continue
+		}
+		claimEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+		suite.Require().NoError(err)
+		claimEvents = append(claimEvents, claimEvent)
+	}
+
+	// the typed event records both the claimed rewards and the multiplied payout
+	suite.Equal([]proto.Message{
+		&types.EventClaimReward{
+			Owner:          userAddr.String(),
+			Receiver:       receiverAddr.String(),
+			ClaimType:      types.SwapClaimType,
+			MultiplierName: "small",
+			Claimed:        cs(c("hard", 7*1e6)),
+			PaidOut:        cs(c("hard", int64(0.2*float64(7*1e6)))),
+		},
+	}, claimEvents)
+}
+
+func (suite *HandlerTestSuite) TestPayoutSwapClaimAfterShareTransfer() {
+	userAddr := suite.addrs[0]
+	recipientAddr := suite.addrs[1]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("ukava", 1e12), c("busd", 1e12))).
+		WithSimpleAccount(recipientAddr, cs(c("ukava", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSwapRewardPeriod("busd:ukava", cs(c("swap", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	// deposit into a swap pool
+	suite.NoError(
+		suite.DeliverSwapMsgDeposit(userAddr, c("ukava", 1e9), c("busd", 1e9), d("1.0")),
+	)
+	// accumulate some swap rewards
+	suite.NextBlockAfter(7 * time.Second)
+
+	// transfer all shares, syncing the rewards of the sender
+	shares, found := suite.App.GetSwapKeeper().GetDepositorSharesAmount(suite.Ctx, userAddr, "busd:ukava")
+	suite.Require().True(found)
+	suite.NoError(
+		suite.DeliverSwapMsgTransferShares(userAddr, recipientAddr, "busd:ukava", shares),
+	)
+	// accumulate more swap rewards, which are earned by the recipient
+	suite.NextBlockAfter(7 * time.Second)
+
+	selections := types.Selections{types.NewSelection("swap", "large")}
+	expectedRewards := c("swap", 7*1e6)
+
+	preClaimBal := suite.GetBalance(userAddr)
+	msg := types.NewMsgClaimSwapReward(userAddr.String(), selections)
+	suite.Require().NoError(suite.DeliverIncentiveMsg(&msg))
+	suite.BalanceEquals(userAddr, preClaimBal.Add(expectedRewards))
+
+	preClaimBal = suite.GetBalance(recipientAddr)
+	msg = types.NewMsgClaimSwapReward(recipientAddr.String(), selections)
+	suite.Require().NoError(suite.DeliverIncentiveMsg(&msg))
+	suite.BalanceEquals(recipientAddr, preClaimBal.Add(expectedRewards))
+}
@@ -39,7 +39,26 @@ func GetStakingAPR(ctx sdk.Context, k Keeper, params types.Params) (sdk.Dec, err
 
 	// Total amount of bkava in earn vaults, this may be lower than total bank
 	// supply of bkava as some bkava may not be deposited in earn vaults
-	totalEarnBkavaDeposited := sdk.ZeroInt()
+	totalEarnBkavaDeposited, err := GetTotalEarnBkavaDeposited(ctx, k)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	// Incentive APR = rewards per second * seconds per year / total supplied to earn vaults
+	// Override collateral type to use "kava" instead of "bkava" when fetching
+	incentiveAPY, err := GetAPYFromMultiRewardPeriod(ctx, k, types.BondDenom, bkavaRewardPeriod, totalEarnBkavaDeposited)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	totalAPY := stakingAPR.Add(incentiveAPY)
+	return totalAPY, nil
+}
+
+// GetTotalEarnBkavaDeposited returns the total value of all bkava derivatives
+// deposited in earn vaults, in ukava.
+func GetTotalEarnBkavaDeposited(ctx sdk.Context, k Keeper) (sdkmath.Int, error) {
+	total := sdk.ZeroInt()
 
 	var iterErr error
 	k.earnKeeper.IterateVaultRecords(ctx, func(record earntypes.VaultRecord) (stop bool) {
@@ -53,24 +72,15 @@ func GetStakingAPR(ctx sdk.Context, k Keeper, params types.Params) (sdk.Dec, err
 			return false
 		}
 
-		totalEarnBkavaDeposited = totalEarnBkavaDeposited.Add(vaultValue.Amount)
+		total = total.Add(vaultValue.Amount)
 
 		return false
 	})
 
 	if iterErr != nil {
-		return sdk.ZeroDec(), iterErr
-	}
-
-	// Incentive APR = rewards per second * seconds per year / total supplied to earn vaults
-	// Override collateral type to use "kava" instead of "bkava" when fetching
-	incentiveAPY, err := GetAPYFromMultiRewardPeriod(ctx, k, types.BondDenom, bkavaRewardPeriod, totalEarnBkavaDeposited)
-	if err != nil {
-		return sdk.ZeroDec(), err
+		return sdkmath.Int{}, iterErr
 	}
-
-	totalAPY := stakingAPR.Add(incentiveAPY)
-	return totalAPY, nil
+	return total, nil
 }
 
 // GetAPYFromMultiRewardPeriod calculates the APY for a given MultiRewardPeriod
@@ -121,6 +131,53 @@ func GetAPYFromMultiRewardPeriod(
 	return apy, nil
 }
 
+// GetAPYFromUSDValue calculates the APY for a given MultiRewardPeriod paying
+// out to deposits with a total USD value. It returns false if any of the
+// reward denoms cannot be priced.
+func GetAPYFromUSDValue(
+	ctx sdk.Context,
+	k Keeper,
+	rewardPeriod types.MultiRewardPeriod,
+	totalUSDValue sdk.Dec,
+) (sdk.Dec, bool) {
+	if !totalUSDValue.IsPositive() {
+		return sdk.ZeroDec(), true
+	}
+
+	totalUSDRewardsPerSecond := sdk.ZeroDec()
+	for _, reward := range rewardPeriod.RewardsPerSecond {
+		rewardPerSecond, found := GetUSDValue(ctx, k, reward.Denom, reward.Amount)
+		if !found {
+			return sdk.ZeroDec(), false
+		}
+		totalUSDRewardsPerSecond = totalUSDRewardsPerSecond.Add(rewardPerSecond)
+	}
+
+	// APY = USD rewards per second * seconds per year / USD total value
+	apy := totalUSDRewardsPerSecond.
+		MulInt64(SecondsPerYear).
+		Quo(totalUSDValue)
+
+	return apy, true
+}
+
+// GetUSDValue returns the USD value of an amount of a denom, using the spot
+// market and conversion factor of its hard money market. It returns false if
+// the denom has no money market or its market has no current price.
+func GetUSDValue(ctx sdk.Context, k Keeper, denom string, amount sdkmath.Int) (sdk.Dec, bool) {
+	moneyMarket, found := k.hardKeeper.GetMoneyMarket(ctx, denom)
+	if !found {
+		return sdk.ZeroDec(), false
+	}
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
+	if err != nil {
+		return sdk.ZeroDec(), false
+	}
+	return sdk.NewDecFromInt(amount).
+		QuoInt(moneyMarket.ConversionFactor).
+		Mul(price.Price), true
+}
+
 func getMarketID(denom string) string {
 	// Rewrite denoms as pricefeed has different names for some assets,
 	// e.g. "ukava" -> "kava", "erc20/multichain/usdc" -> "usdc"
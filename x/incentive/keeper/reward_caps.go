@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// capRewardsPerSecond returns the rewards per second to accumulate for a reward period, lowered where needed so the
+// rewards paid out since the previous accrual time do not exceed the period's rewards cap.
+// The rewards paid out are recorded against the cap. Denoms missing from the cap are not limited.
+func (k Keeper) capRewardsPerSecond(
+	ctx sdk.Context,
+	source string,
+	period types.MultiRewardPeriod,
+	rewardsPerSecond sdk.DecCoins,
+	previousAccrualTime time.Time,
+	totalSourceShares sdk.Dec,
+) sdk.DecCoins {
+	if period.RewardsCap.Empty() || !totalSourceShares.IsPositive() {
+		// With no source shares the accumulator drops the block's rewards, so nothing is paid out against the cap.
+		return rewardsPerSecond
+	}
+
+	rewards, _ := types.CalculatePerSecondRewards(period.Start, period.End, rewardsPerSecond, previousAccrualTime, ctx.BlockTime())
+	if rewards.IsZero() {
+		return rewardsPerSecond
+	}
+
+	distributed, _ := k.GetDistributedRewards(ctx, source, period.CollateralType)
+
+	var capped, paid sdk.DecCoins
+	for _, rate := range rewardsPerSecond {
+		capAmount := period.RewardsCap.AmountOf(rate.Denom)
+		if capAmount.IsZero() {
+			capped = capped.Add(rate)
+			continue
+		}
+
+		remaining := sdk.NewDecFromInt(capAmount).Sub(distributed.AmountOf(rate.Denom))
+		if !remaining.IsPositive() {
+			continue
+		}
+
+		reward := rewards.AmountOf(rate.Denom)
+		if reward.GT(remaining) {
+			// Scale the rate down so exactly the remaining budget is paid out.
+			rate = sdk.NewDecCoinFromDec(rate.Denom, rate.Amount.Mul(remaining).Quo(reward))
+			reward = remaining
+		}
+		capped = capped.Add(rate)
+		paid = paid.Add(sdk.NewDecCoinFromDec(rate.Denom, reward))
+	}
+
+	if !paid.IsZero() {
+		k.SetDistributedRewards(ctx, source, period.CollateralType, distributed.Add(paid...))
+	}
+	return capped
+}
+
+// GetRewardCaps returns the budget of every reward period with a rewards cap. If source is not empty, only reward
+// periods of that source are returned.
+func (k Keeper) GetRewardCaps(ctx sdk.Context, source string) []types.RewardCap {
+	rewardCaps := []types.RewardCap{}
+	for _, sourcePeriods := range GetRewardPeriodsBySource(k.GetParams(ctx)) {
+		if source != "" && source != sourcePeriods.Source {
+			continue
+		}
+		for _, period := range sourcePeriods.Periods {
+			if period.RewardsCap.Empty() {
+				continue
+			}
+			distributed, _ := k.GetDistributedRewards(ctx, sourcePeriods.Source, period.CollateralType)
+			rewardCaps = append(rewardCaps, types.RewardCap{
+				Source:         sourcePeriods.Source,
+				CollateralType: period.CollateralType,
+				RewardsCap:     period.RewardsCap,
+				Distributed:    distributed,
+				Remaining:      remainingRewards(period.RewardsCap, distributed),
+			})
+		}
+	}
+	return rewardCaps
+}
+
+// remainingRewards returns the part of a rewards cap that has not been paid out yet.
+func remainingRewards(rewardsCap sdk.Coins, distributed sdk.DecCoins) sdk.DecCoins {
+	var remaining sdk.DecCoins
+	for _, coin := range rewardsCap {
+		amount := sdk.NewDecFromInt(coin.Amount).Sub(distributed.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			remaining = remaining.Add(sdk.NewDecCoinFromDec(coin.Denom, amount))
+		}
+	}
+	return remaining
+}
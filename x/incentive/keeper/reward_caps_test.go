@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/incentive/keeper"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+type RewardCapsTests struct {
+	unitTester
+}
+
+func TestRewardCaps(t *testing.T) {
+	suite.Run(t, new(RewardCapsTests))
+}
+
+func (suite *RewardCapsTests) storedIndexesEqual(denom string, expected types.RewardIndexes) {
+	storedIndexes, found := suite.keeper.GetHardBorrowRewardIndexes(suite.ctx, denom)
+	suite.True(found)
+	suite.Equal(expected, storedIndexes)
+}
+
+func (suite *RewardCapsTests) storedDistributedEqual(denom string, expected sdk.DecCoins) {
+	distributed, found := suite.keeper.GetDistributedRewards(suite.ctx, keeper.RewardSourceHardBorrow, denom)
+	suite.Equal(expected != nil, found)
+	if found {
+		suite.Equal(expected, distributed)
+	} else {
+		suite.Empty(distributed)
+	}
+}
+
+func (suite *RewardCapsTests) cappedPeriod(denom string, rewardsCap sdk.Coins) types.MultiRewardPeriod {
+	period := types.NewMultiRewardPeriod(
+		true,
+		denom,
+		time.Unix(0, 0), // ensure the test is within start and end times
+		distantFuture,
+		cs(c("hard", 2000), c("ukava", 1000)),
+	)
+	period.RewardsCap = rewardsCap
+	return period
+}
+
+func (suite *RewardCapsTests) TestAccumulationStopsWhenCapIsReached() {
+	denom := "bnb"
+
+	hardKeeper := newFakeHardKeeper().addTotalBorrow(c(denom, 1e6), d("1"))
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, hardKeeper, nil, nil, nil, nil, nil, nil)
+
+	previousAccrualTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.keeper.SetPreviousHardBorrowRewardAccrualTime(suite.ctx, denom, previousAccrualTime)
+
+	// only hard is capped, to less than the 7.2e6 hard paid out in an hour
+	period := suite.cappedPeriod(denom, cs(c("hard", 1e6)))
+
+	suite.ctx = suite.ctx.WithBlockTime(previousAccrualTime.Add(1 * time.Hour))
+	suite.keeper.AccumulateHardBorrowRewards(suite.ctx, period)
+
+	suite.storedIndexesEqual(denom, types.RewardIndexes{
+		{CollateralType: "hard", RewardFactor: d("1")},
+		{CollateralType: "ukava", RewardFactor: d("3.6")},
+	})
+	suite.storedDistributedEqual(denom, sdk.NewDecCoins(sdk.NewInt64DecCoin("hard", 1e6)))
+
+	// once the cap is reached, only the uncapped denom accumulates
+	suite.ctx = suite.ctx.WithBlockTime(previousAccrualTime.Add(2 * time.Hour))
+	suite.keeper.AccumulateHardBorrowRewards(suite.ctx, period)
+
+	suite.storedIndexesEqual(denom, types.RewardIndexes{
+		{CollateralType: "hard", RewardFactor: d("1")},
+		{CollateralType: "ukava", RewardFactor: d("7.2")},
+	})
+	suite.storedDistributedEqual(denom, sdk.NewDecCoins(sdk.NewInt64DecCoin("hard", 1e6)))
+}
+
+func (suite *RewardCapsTests) TestAccumulationPaysOutRemainingBudget() {
+	denom := "bnb"
+
+	hardKeeper := newFakeHardKeeper().addTotalBorrow(c(denom, 1e6), d("1"))
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, hardKeeper, nil, nil, nil, nil, nil, nil)
+
+	previousAccrualTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.keeper.SetPreviousHardBorrowRewardAccrualTime(suite.ctx, denom, previousAccrualTime)
+	suite.keeper.SetDistributedRewards(suite.ctx, keeper.RewardSourceHardBorrow, denom, sdk.NewDecCoins(sdk.NewInt64DecCoin("hard", 5e5)))
+
+	period := suite.cappedPeriod(denom, cs(c("hard", 1e6), c("ukava", 1e7)))
+
+	suite.ctx = suite.ctx.WithBlockTime(previousAccrualTime.Add(1 * time.Hour))
+	suite.keeper.AccumulateHardBorrowRewards(suite.ctx, period)
+
+	// ukava is below its cap so it is paid out in full
+	suite.storedIndexesEqual(denom, types.RewardIndexes{
+		{CollateralType: "hard", RewardFactor: d("0.5")},
+		{CollateralType: "ukava", RewardFactor: d("3.6")},
+	})
+	suite.storedDistributedEqual(denom, sdk.NewDecCoins(
+		sdk.NewInt64DecCoin("hard", 1e6),
+		sdk.NewInt64DecCoin("ukava", 3.6e6),
+	))
+}
+
+func (suite *RewardCapsTests) TestNothingDistributedWithoutSourceShares() {
+	denom := "bnb"
+
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, newFakeHardKeeper(), nil, nil, nil, nil, nil, nil)
+
+	previousAccrualTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.keeper.SetPreviousHardBorrowRewardAccrualTime(suite.ctx, denom, previousAccrualTime)
+
+	suite.ctx = suite.ctx.WithBlockTime(previousAccrualTime.Add(1 * time.Hour))
+	suite.keeper.AccumulateHardBorrowRewards(suite.ctx, suite.cappedPeriod(denom, cs(c("hard", 1e6))))
+
+	suite.storedDistributedEqual(denom, nil)
+}
+
+func (suite *RewardCapsTests) TestGetRewardCaps() {
+	params := types.DefaultParams()
+	params.HardBorrowRewardPeriods = types.MultiRewardPeriods{
+		suite.cappedPeriod("bnb", cs(c("hard", 1e6), c("ukava", 1e6))),
+		suite.cappedPeriod("btcb", nil),
+	}
+	params.SwapRewardPeriods = types.MultiRewardPeriods{
+		suite.cappedPeriod("busd:ukava", cs(c("swp", 1e6))),
+	}
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{params: params}, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	suite.keeper.SetDistributedRewards(suite.ctx, keeper.RewardSourceHardBorrow, "bnb", sdk.NewDecCoins(
+		sdk.NewInt64DecCoin("hard", 4e5),
+		sdk.NewInt64DecCoin("ukava", 1e6),
+	))
+
+	suite.Equal([]types.RewardCap{
+		{
+			Source:         keeper.RewardSourceHardBorrow,
+			CollateralType: "bnb",
+			RewardsCap:     cs(c("hard", 1e6), c("ukava", 1e6)),
+			Distributed:    sdk.NewDecCoins(sdk.NewInt64DecCoin("hard", 4e5), sdk.NewInt64DecCoin("ukava", 1e6)),
+			Remaining:      sdk.NewDecCoins(sdk.NewInt64DecCoin("hard", 6e5)),
+		},
+	}, suite.keeper.GetRewardCaps(suite.ctx, keeper.RewardSourceHardBorrow))
+
+	rewardCaps := suite.keeper.GetRewardCaps(suite.ctx, "")
+	suite.Len(rewardCaps, 2)
+	suite.Equal(keeper.RewardSourceSwap, rewardCaps[1].Source)
+	suite.Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("swp", 1e6)), rewardCaps[1].Remaining)
+}
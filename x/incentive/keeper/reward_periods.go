@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// SourceRewardPeriods are the reward periods paying out to one reward source.
+type SourceRewardPeriods struct {
+	Source  string
+	Periods types.MultiRewardPeriods
+}
+
+// GetRewardPeriodsBySource returns the reward periods in params grouped by
+// their reward source. USDX minting reward periods are converted to
+// MultiRewardPeriods.
+func GetRewardPeriodsBySource(params types.Params) []SourceRewardPeriods {
+	usdxMintingPeriods := make(types.MultiRewardPeriods, len(params.USDXMintingRewardPeriods))
+	for i, period := range params.USDXMintingRewardPeriods {
+		usdxMintingPeriods[i] = types.NewMultiRewardPeriodFromRewardPeriod(period)
+	}
+
+	return []SourceRewardPeriods{
+		{Source: RewardSourceUSDXMinting, Periods: usdxMintingPeriods},
+		{Source: RewardSourceHardSupply, Periods: params.HardSupplyRewardPeriods},
+		{Source: RewardSourceHardBorrow, Periods: params.HardBorrowRewardPeriods},
+		{Source: RewardSourceDelegator, Periods: params.DelegatorRewardPeriods},
+		{Source: RewardSourceSwap, Periods: params.SwapRewardPeriods},
+		{Source: RewardSourceSavings, Periods: params.SavingsRewardPeriods},
+		{Source: RewardSourceEarn, Periods: params.EarnRewardPeriods},
+	}
+}
+
+// EmitRewardPeriodEvents emits an event for each reward period that started or
+// ended after the previous block time, up to and including the current block
+// time.
+func (k Keeper) EmitRewardPeriodEvents(ctx sdk.Context, previousBlockTime time.Time) {
+	blockTime := ctx.BlockTime()
+
+	for _, source := range GetRewardPeriodsBySource(k.GetParams(ctx)) {
+		for _, period := range source.Periods {
+			if previousBlockTime.Before(period.Start) && !blockTime.Before(period.Start) {
+				k.emitEvent(ctx,
+					&types.EventRewardPeriodActivated{
+						RewardSource:     source.Source,
+						CollateralType:   period.CollateralType,
+						RewardsPerSecond: period.RewardsPerSecond,
+					},
+					newRewardPeriodEvent(types.EventTypeRewardPeriodActivated, source.Source, period),
+				)
+			}
+			if previousBlockTime.Before(period.End) && !blockTime.Before(period.End) {
+				k.emitEvent(ctx,
+					&types.EventRewardPeriodExpired{
+						RewardSource:     source.Source,
+						CollateralType:   period.CollateralType,
+						RewardsPerSecond: period.RewardsPerSecond,
+					},
+					newRewardPeriodEvent(types.EventTypeRewardPeriodExpired, source.Source, period),
+				)
+			}
+		}
+	}
+}
+
+func newRewardPeriodEvent(eventType, source string, period types.MultiRewardPeriod) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(types.AttributeKeyRewardSource, source),
+		sdk.NewAttribute(types.AttributeKeyCollateralType, period.CollateralType),
+		sdk.NewAttribute(types.AttributeKeyRewardsPerSecond, period.RewardsPerSecond.String()),
+	)
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/incentive/keeper"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+type RewardPeriodsTests struct {
+	unitTester
+}
+
+func TestRewardPeriods(t *testing.T) {
+	suite.Run(t, new(RewardPeriodsTests))
+}
+
+func (suite *RewardPeriodsTests) TestGetRewardPeriodsBySource() {
+	start := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	params := types.DefaultParams()
+	params.USDXMintingRewardPeriods = types.RewardPeriods{
+		types.NewRewardPeriod(true, "bnb-a", start, end, c(types.USDXMintingRewardDenom, 10)),
+	}
+	params.SwapRewardPeriods = types.MultiRewardPeriods{
+		types.NewMultiRewardPeriod(true, "busd:ukava", start, end, cs(c("swp", 10))),
+	}
+
+	sources := keeper.GetRewardPeriodsBySource(params)
+	suite.Len(sources, 7)
+
+	for _, source := range sources {
+		switch source.Source {
+		case keeper.RewardSourceUSDXMinting:
+			suite.Equal(types.MultiRewardPeriods{
+				types.NewMultiRewardPeriod(true, "bnb-a", start, end, cs(c(types.USDXMintingRewardDenom, 10))),
+			}, source.Periods)
+		case keeper.RewardSourceSwap:
+			suite.Equal(params.SwapRewardPeriods, source.Periods)
+		default:
+			suite.Empty(source.Periods)
+		}
+	}
+}
+
+func (suite *RewardPeriodsTests) TestEmitRewardPeriodEvents() {
+	previousBlockTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+	blockTime := previousBlockTime.Add(6 * time.Second)
+
+	params := types.DefaultParams()
+	params.HardSupplyRewardPeriods = types.MultiRewardPeriods{
+		// starts this block
+		types.NewMultiRewardPeriod(true, "bnb", blockTime, blockTime.Add(time.Hour), cs(c("hard", 10))),
+		// started in a previous block
+		types.NewMultiRewardPeriod(true, "ukava", previousBlockTime, blockTime.Add(time.Hour), cs(c("hard", 10))),
+	}
+	params.EarnRewardPeriods = types.MultiRewardPeriods{
+		// ends this block
+		types.NewMultiRewardPeriod(true, "usdx", previousBlockTime.Add(-time.Hour), previousBlockTime.Add(time.Second), cs(c("hard", 10))),
+		// starts in a future block
+		types.NewMultiRewardPeriod(true, "bkava", blockTime.Add(time.Second), blockTime.Add(time.Hour), cs(c("hard", 10))),
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	ctx := suite.ctx.WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+	suite.keeper.EmitRewardPeriodEvents(ctx, previousBlockTime)
+
+	activated, err := sdk.TypedEventToEvent(&types.EventRewardPeriodActivated{
+		RewardSource:     keeper.RewardSourceHardSupply,
+		CollateralType:   "bnb",
+		RewardsPerSecond: cs(c("hard", 10)),
+	})
+	suite.Require().NoError(err)
+	expired, err := sdk.TypedEventToEvent(&types.EventRewardPeriodExpired{
+		RewardSource:     keeper.RewardSourceEarn,
+		CollateralType:   "usdx",
+		RewardsPerSecond: cs(c("hard", 10)),
+	})
+	suite.Require().NoError(err)
+
+	suite.Equal(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRewardPeriodActivated,
+			sdk.NewAttribute(types.AttributeKeyRewardSource, keeper.RewardSourceHardSupply),
+			sdk.NewAttribute(types.AttributeKeyCollateralType, "bnb"),
+			sdk.NewAttribute(types.AttributeKeyRewardsPerSecond, "10hard"),
+		),
+		activated,
+		sdk.NewEvent(
+			types.EventTypeRewardPeriodExpired,
+			sdk.NewAttribute(types.AttributeKeyRewardSource, keeper.RewardSourceEarn),
+			sdk.NewAttribute(types.AttributeKeyCollateralType, "usdx"),
+			sdk.NewAttribute(types.AttributeKeyRewardsPerSecond, "10hard"),
+		),
+		expired,
+	}, ctx.EventManager().Events())
+}
+
+func (suite *RewardPeriodsTests) TestEmitRewardPeriodEventsWithoutLegacyEvents() {
+	blockTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	params := types.DefaultParams()
+	params.HardSupplyRewardPeriods = types.MultiRewardPeriods{
+		types.NewMultiRewardPeriod(true, "bnb", blockTime, blockTime.Add(time.Hour), cs(c("hard", 10))),
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.keeper.DisableLegacyEvents()
+
+	ctx := suite.ctx.WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+	suite.keeper.EmitRewardPeriodEvents(ctx, blockTime.Add(-6*time.Second))
+
+	events := ctx.EventManager().Events()
+	suite.Require().Len(events, 1)
+	event, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	suite.Require().NoError(err)
+	suite.Equal(&types.EventRewardPeriodActivated{
+		RewardSource:     keeper.RewardSourceHardSupply,
+		CollateralType:   "bnb",
+		RewardsPerSecond: cs(c("hard", 10)),
+	}, event)
+}
@@ -28,7 +28,10 @@ func (k Keeper) AccumulateHardBorrowRewards(ctx sdk.Context, rewardPeriod types.
 
 	totalSource := k.getHardBorrowTotalSourceShares(ctx, rewardPeriod.CollateralType)
 
-	acc.Accumulate(rewardPeriod, totalSource, ctx.BlockTime())
+	rewardsPerSecond := k.capRewardsPerSecond(
+		ctx, RewardSourceHardBorrow, rewardPeriod, sdk.NewDecCoinsFromCoins(rewardPeriod.RewardsPerSecond...), previousAccrualTime, totalSource,
+	)
+	acc.AccumulateDecCoins(rewardPeriod.Start, rewardPeriod.End, rewardsPerSecond, totalSource, ctx.BlockTime())
 
 	k.SetPreviousHardBorrowRewardAccrualTime(ctx, rewardPeriod.CollateralType, acc.PreviousAccumulationTime)
 	if len(acc.Indexes) > 0 {
@@ -47,21 +50,7 @@ func (k Keeper) AccumulateHardBorrowRewards(ctx sdk.Context, rewardPeriod types.
 // user input. This is essential as claims must be synced before any change to a source shares amount. The actual borrowed amounts cannot
 // be used as they increase every block due to interest.
 func (k Keeper) getHardBorrowTotalSourceShares(ctx sdk.Context, denom string) sdk.Dec {
-	totalBorrowedCoins, found := k.hardKeeper.GetBorrowedCoins(ctx)
-	if !found {
-		// assume no coins have been borrowed
-		totalBorrowedCoins = sdk.NewCoins()
-	}
-	totalBorrowed := totalBorrowedCoins.AmountOf(denom)
-
-	interestFactor, found := k.hardKeeper.GetBorrowInterestFactor(ctx, denom)
-	if !found {
-		// assume nothing has been borrowed so the factor starts at it's default value
-		interestFactor = sdk.OneDec()
-	}
-
-	// return borrowed/factor to get the "pre interest" value of the current total borrowed
-	return sdk.NewDecFromInt(totalBorrowed).Quo(interestFactor)
+	return k.adapters.TotalSharesBySource(ctx, RewardSourceHardBorrow, denom)
 }
 
 // InitializeHardBorrowReward initializes the borrow-side of a hard liquidity provider claim
@@ -3,10 +3,13 @@ package keeper
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/kava-labs/kava/x/incentive/types"
+	liquidtypes "github.com/kava-labs/kava/x/liquid/types"
 )
 
 // AccumulateDelegatorRewards calculates new rewards to distribute this block and updates the global indexes to reflect this.
@@ -26,7 +29,10 @@ func (k Keeper) AccumulateDelegatorRewards(ctx sdk.Context, rewardPeriod types.M
 
 	totalSource := k.getDelegatorTotalSourceShares(ctx, rewardPeriod.CollateralType)
 
-	acc.Accumulate(rewardPeriod, totalSource, ctx.BlockTime())
+	rewardsPerSecond := k.capRewardsPerSecond(
+		ctx, RewardSourceDelegator, rewardPeriod, sdk.NewDecCoinsFromCoins(rewardPeriod.RewardsPerSecond...), previousAccrualTime, totalSource,
+	)
+	acc.AccumulateDecCoins(rewardPeriod.Start, rewardPeriod.End, rewardsPerSecond, totalSource, ctx.BlockTime())
 
 	k.SetPreviousDelegatorRewardAccrualTime(ctx, rewardPeriod.CollateralType, acc.PreviousAccumulationTime)
 	if len(acc.Indexes) > 0 {
@@ -37,6 +43,7 @@ func (k Keeper) AccumulateDelegatorRewards(ctx sdk.Context, rewardPeriod types.M
 
 // getDelegatorTotalSourceShares fetches the sum of all source shares for a delegator reward.
 // In the case of delegation, this is the total tokens staked to bonded validators.
+// This includes the liquid module's delegations, which are rewarded to the holders of the staking derivatives they back.
 func (k Keeper) getDelegatorTotalSourceShares(ctx sdk.Context, denom string) sdk.Dec {
 	totalBonded := k.stakingKeeper.TotalBondedTokens(ctx)
 
@@ -49,7 +56,7 @@ func (k Keeper) InitializeDelegatorReward(ctx sdk.Context, delegator sdk.AccAddr
 	if !found {
 		claim = types.NewDelegatorClaim(delegator, sdk.Coins{}, nil)
 	} else {
-		k.SynchronizeDelegatorRewards(ctx, delegator, nil, false)
+		k.SynchronizeDelegatorRewards(ctx, delegator)
 		claim, _ = k.GetDelegatorClaim(ctx, delegator)
 	}
 
@@ -61,11 +68,12 @@ func (k Keeper) InitializeDelegatorReward(ctx sdk.Context, delegator sdk.AccAddr
 	rewardIndexes = rewardIndexes.With(types.BondDenom, globalRewardIndexes)
 	claim.RewardIndexes = rewardIndexes
 	k.SetDelegatorClaim(ctx, claim)
+	k.SetDelegatorClaimCheckpointID(ctx, delegator, k.GetNextDelegatorCheckpointID(ctx))
 }
 
 // SynchronizeDelegatorClaim is a wrapper around SynchronizeDelegatorRewards that returns the synced claim
 func (k Keeper) SynchronizeDelegatorClaim(ctx sdk.Context, claim types.DelegatorClaim) (types.DelegatorClaim, error) {
-	k.SynchronizeDelegatorRewards(ctx, claim.Owner, nil, false)
+	k.SynchronizeDelegatorRewards(ctx, claim.Owner)
 
 	claim, found := k.GetDelegatorClaim(ctx, claim.Owner)
 	if !found {
@@ -75,10 +83,17 @@ func (k Keeper) SynchronizeDelegatorClaim(ctx sdk.Context, claim types.Delegator
 }
 
 // SynchronizeDelegatorRewards updates the claim object by adding any accumulated rewards, and setting the reward indexes to the global values.
-// valAddr and shouldIncludeValidator are used to ignore or include delegations to a particular validator when summing up the total delegation.
-// Normally only delegations to Bonded validators are included in the total. This is needed as staking hooks are sometimes called on the wrong
-// side of a validator's state update (from this module's perspective).
-func (k Keeper) SynchronizeDelegatorRewards(ctx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress, shouldIncludeValidator bool) {
+//
+// Claims are synchronized lazily, when the delegator's delegations or derivatives change or their rewards are accessed.
+// Validator status changes and slashes are not synchronized to each delegator, instead they record checkpoints of the
+// global indexes and the validator's state, so the rewards of delegations to the validator can be calculated per period.
+func (k Keeper) SynchronizeDelegatorRewards(ctx sdk.Context, delegator sdk.AccAddress) {
+	k.synchronizeDelegatorRewards(ctx, delegator, true)
+}
+
+// synchronizeDelegatorRewards updates the claim object by adding any accumulated rewards.
+// Staking derivatives held by the delegator are only counted if includeDerivatives is true.
+func (k Keeper) synchronizeDelegatorRewards(ctx sdk.Context, delegator sdk.AccAddress, includeDerivatives bool) {
 	claim, found := k.GetDelegatorClaim(ctx, delegator)
 	if !found {
 		return
@@ -103,106 +118,158 @@ func (k Keeper) SynchronizeDelegatorRewards(ctx sdk.Context, delegator sdk.AccAd
 		userRewardIndexes = types.RewardIndexes{}
 	}
 
-	totalDelegated := k.GetTotalDelegated(ctx, delegator, valAddr, shouldIncludeValidator)
-
-	rewardsEarned, err := k.CalculateRewards(userRewardIndexes, globalRewardIndexes, totalDelegated)
-	if err != nil {
+	if userRewardIndexes.Exceeds(globalRewardIndexes) {
 		// Global reward factors should never decrease, as it would lead to a negative update to claim.Rewards.
-		// This panics if a global reward factor decreases or disappears between the old and new indexes.
-		panic(fmt.Sprintf("corrupted global reward indexes found: %v", err))
+		panic(fmt.Sprintf(
+			"corrupted global reward indexes found: %v",
+			errorsmod.Wrapf(types.ErrDecreasingRewardFactor, "old: %v, new: %v", userRewardIndexes, globalRewardIndexes),
+		))
+	}
+
+	checkpointID := k.GetDelegatorClaimCheckpointID(ctx, delegator)
+	rewardsEarned := sdk.NewDecCoins()
+	for _, delegated := range k.getDelegatorValidatorShares(ctx, delegator, includeDerivatives) {
+		validator, found := k.stakingKeeper.GetValidator(ctx, delegated.validator)
+		if !found {
+			continue
+		}
+
+		// Rewards are accrued for each period between the validator's checkpoints since the claim was last synchronized,
+		// then for the current period, which ends now.
+		lastIndexes := userRewardIndexes
+		k.IterateDelegatorValidatorCheckpoints(ctx, delegated.validator, checkpointID, func(checkpoint types.DelegatorValidatorCheckpoint) bool {
+			rewardsEarned, lastIndexes = accrueDelegatorRewards(rewardsEarned, lastIndexes, checkpoint, delegated.shares)
+			return false
+		})
+		current := types.NewDelegatorValidatorCheckpoint(
+			globalRewardIndexes, validator.GetStatus() == stakingtypes.Bonded, validator.GetTokens(), validator.GetDelegatorShares(),
+		)
+		rewardsEarned, _ = accrueDelegatorRewards(rewardsEarned, lastIndexes, current, delegated.shares)
 	}
 
-	claim.Reward = claim.Reward.Add(rewardsEarned...)
+	var rewards sdk.Coins
+	for _, reward := range rewardsEarned {
+		rewards = rewards.Add(sdk.NewCoin(reward.Denom, reward.Amount.RoundInt()))
+	}
+
+	claim.Reward = claim.Reward.Add(rewards...)
 	claim.RewardIndexes = claim.RewardIndexes.With(types.BondDenom, globalRewardIndexes)
 	k.SetDelegatorClaim(ctx, claim)
+	k.SetDelegatorClaimCheckpointID(ctx, delegator, k.GetNextDelegatorCheckpointID(ctx))
 }
 
-func (k Keeper) GetTotalDelegated(ctx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress, shouldIncludeValidator bool) sdk.Dec {
-	totalDelegated := sdk.ZeroDec()
+// accrueDelegatorRewards adds the rewards earned by delegation shares in a validator between the last indexes and a
+// checkpoint, returning the new rewards and the indexes the next period starts from.
+// Checkpoints recorded before the last indexes earn nothing, as factors never decrease.
+func accrueDelegatorRewards(
+	rewards sdk.DecCoins, lastIndexes types.RewardIndexes, checkpoint types.DelegatorValidatorCheckpoint, shares sdk.Dec,
+) (sdk.DecCoins, types.RewardIndexes) {
+	tokens := checkpoint.TokensFromShares(shares)
 
-	delegations := k.stakingKeeper.GetDelegatorDelegations(ctx, delegator, 200)
-	for _, delegation := range delegations {
-		validator, found := k.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+	for _, index := range checkpoint.RewardIndexes {
+		lastFactor, found := lastIndexes.Get(index.CollateralType)
 		if !found {
+			lastFactor = sdk.ZeroDec()
+		}
+		if !index.RewardFactor.GT(lastFactor) {
 			continue
 		}
 
-		if validator.GetOperator().Equals(valAddr) {
-			if shouldIncludeValidator {
-				// do nothing, so the validator is included regardless of bonded status
-			} else {
-				// skip this validator
-				continue
-			}
-		} else {
-			// skip any not bonded validator
-			if validator.GetStatus() != stakingtypes.Bonded {
-				continue
-			}
+		reward := index.RewardFactor.Sub(lastFactor).Mul(tokens)
+		if reward.IsPositive() {
+			rewards = rewards.Add(sdk.NewDecCoinFromDec(index.CollateralType, reward))
 		}
+		lastIndexes = lastIndexes.With(index.CollateralType, index.RewardFactor)
+	}
+	return rewards, lastIndexes
+}
+
+// delegatorValidatorShares are the delegation shares an account is rewarded for in a validator.
+type delegatorValidatorShares struct {
+	validator sdk.ValAddress
+	shares    sdk.Dec
+}
+
+// getDelegatorValidatorShares returns the delegation shares an account is rewarded for in each validator.
+// These are the account's delegations through the staking module, along with the shares backing the staking derivatives
+// it holds, if includeDerivatives is true.
+//
+// The liquid module's delegations are not counted, as they back staking derivatives, which are counted for their holders.
+// Derivatives held by module accounts are not counted, as derivatives deposited into modules, such as earn vaults, are
+// rewarded by them.
+func (k Keeper) getDelegatorValidatorShares(ctx sdk.Context, owner sdk.AccAddress, includeDerivatives bool) []delegatorValidatorShares {
+	var delegated []delegatorValidatorShares
+
+	if owner.Equals(authtypes.NewModuleAddress(liquidtypes.ModuleAccountName)) {
+		return delegated
+	}
 
-		if validator.GetTokens().IsZero() {
+	for _, delegation := range k.stakingKeeper.GetDelegatorDelegations(ctx, owner, 200) {
+		delegated = append(delegated, delegatorValidatorShares{
+			validator: delegation.GetValidatorAddr(),
+			shares:    delegation.GetShares(),
+		})
+	}
+
+	if !includeDerivatives {
+		return delegated
+	}
+
+	var derivatives []delegatorValidatorShares
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, owner) {
+		if !k.liquidKeeper.IsDerivativeDenom(ctx, coin.Denom) {
 			continue
 		}
 
-		delegatedTokens := validator.TokensFromShares(delegation.GetShares())
-		if delegatedTokens.IsNegative() {
+		valAddr, err := liquidtypes.ParseLiquidStakingTokenDenom(coin.Denom)
+		if err != nil {
 			continue
 		}
-		totalDelegated = totalDelegated.Add(delegatedTokens)
+		derivatives = append(derivatives, delegatorValidatorShares{
+			validator: valAddr,
+			shares:    k.liquidKeeper.GetDerivativeShares(ctx, valAddr, coin.Amount),
+		})
 	}
-	return totalDelegated
+
+	if len(derivatives) == 0 {
+		return delegated
+	}
+	if _, ok := k.accountKeeper.GetAccount(ctx, owner).(authtypes.ModuleAccountI); ok {
+		return delegated
+	}
+	return append(delegated, derivatives...)
+}
+
+// CheckpointDelegatorValidator records the global delegator reward indexes and the state of a validator, so delegations
+// to it can be rewarded for the period that ends now when their claims are next synchronized.
+// bonded is whether the validator was bonded over the period, as hooks are sometimes called after the validator's status
+// has been updated.
+func (k Keeper) CheckpointDelegatorValidator(ctx sdk.Context, valAddr sdk.ValAddress, bonded bool) {
+	globalRewardIndexes, found := k.GetDelegatorRewardIndexes(ctx, types.BondDenom)
+	if !found {
+		// no rewards have accumulated, so there is nothing to checkpoint
+		return
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return
+	}
+
+	id := k.GetNextDelegatorCheckpointID(ctx)
+	k.SetDelegatorValidatorCheckpoint(ctx, valAddr, id, types.NewDelegatorValidatorCheckpoint(
+		globalRewardIndexes, bonded, validator.GetTokens(), validator.GetDelegatorShares(),
+	))
+	k.SetNextDelegatorCheckpointID(ctx, id+1)
 }
 
 // SimulateDelegatorSynchronization calculates a user's outstanding delegator rewards by simulating reward synchronization
 func (k Keeper) SimulateDelegatorSynchronization(ctx sdk.Context, claim types.DelegatorClaim) types.DelegatorClaim {
-	for _, ri := range claim.RewardIndexes {
-		// For each Delegator reward index (there's only one: the bond denom 'ukava')
-		globalRewardIndexes, foundGlobalRewardIndexes := k.GetDelegatorRewardIndexes(ctx, ri.CollateralType)
-		if !foundGlobalRewardIndexes {
-			continue
-		}
+	cacheCtx, _ := ctx.CacheContext()
 
-		userRewardIndexes, foundUserRewardIndexes := claim.RewardIndexes.GetRewardIndex(ri.CollateralType)
-		if !foundUserRewardIndexes {
-			continue
-		}
+	k.SetDelegatorClaim(cacheCtx, claim)
+	k.SynchronizeDelegatorRewards(cacheCtx, claim.Owner)
 
-		userRewardIndexIndex, foundUserRewardIndexIndex := claim.RewardIndexes.GetRewardIndexIndex(ri.CollateralType)
-		if !foundUserRewardIndexIndex {
-			continue
-		}
-
-		amtDelegated := k.GetTotalDelegated(ctx, claim.GetOwner(), sdk.ValAddress(claim.Owner.String()), true)
-
-		for _, globalRewardIndex := range globalRewardIndexes {
-			userRewardIndex, foundUserRewardIndex := userRewardIndexes.RewardIndexes.GetRewardIndex(globalRewardIndex.CollateralType)
-			if !foundUserRewardIndex {
-				userRewardIndex = types.NewRewardIndex(globalRewardIndex.CollateralType, sdk.ZeroDec())
-				userRewardIndexes.RewardIndexes = append(userRewardIndexes.RewardIndexes, userRewardIndex)
-				claim.RewardIndexes[userRewardIndexIndex].RewardIndexes = append(claim.RewardIndexes[userRewardIndexIndex].RewardIndexes, userRewardIndex)
-			}
-
-			globalRewardFactor := globalRewardIndex.RewardFactor
-			userRewardFactor := userRewardIndex.RewardFactor
-			rewardsAccumulatedFactor := globalRewardFactor.Sub(userRewardFactor)
-			if rewardsAccumulatedFactor.IsZero() {
-				continue
-			}
-
-			rewardsEarned := rewardsAccumulatedFactor.Mul(amtDelegated).RoundInt()
-			if rewardsEarned.IsZero() || rewardsEarned.IsNegative() {
-				continue
-			}
-
-			factorIndex, foundFactorIndex := userRewardIndexes.RewardIndexes.GetFactorIndex(globalRewardIndex.CollateralType)
-			if !foundFactorIndex {
-				continue
-			}
-			claim.RewardIndexes[userRewardIndexIndex].RewardIndexes[factorIndex].RewardFactor = globalRewardIndex.RewardFactor
-			newRewardsCoin := sdk.NewCoin(userRewardIndex.CollateralType, rewardsEarned)
-			claim.Reward = claim.Reward.Add(newRewardsCoin)
-		}
-	}
-	return claim
+	syncedClaim, _ := k.GetDelegatorClaim(cacheCtx, claim.Owner)
+	return syncedClaim
 }
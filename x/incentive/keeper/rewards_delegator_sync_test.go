@@ -15,7 +15,7 @@ import (
 // inputs
 // - claim in store if it exists (only claim.DelegatorRewardIndexes and claim.Reward)
 // - global index in store
-// - function args: delegator address, validator address, shouldIncludeValidator flag
+// - validator checkpoints recorded since the claim was last synced
 // - delegator's delegations and the corresponding validators
 //
 // outputs
@@ -37,7 +37,7 @@ func (suite *SynchronizeDelegatorRewardTests) TestClaimIndexesAreUnchangedWhenGl
 	delegator := arbitraryAddress()
 
 	stakingKeeper := &fakeStakingKeeper{} // use an empty staking keeper that returns no delegations
-	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, stakingKeeper, nil, nil, nil, nil)
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, newFakeBankKeeper(), nil, nil, nil, stakingKeeper, nil, nil, nil, nil)
 
 	claim := types.DelegatorClaim{
 		BaseMultiClaim: types.BaseMultiClaim{
@@ -49,7 +49,7 @@ func (suite *SynchronizeDelegatorRewardTests) TestClaimIndexesAreUnchangedWhenGl
 
 	suite.storeGlobalDelegatorFactor(claim.RewardIndexes)
 
-	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, claim.Owner, nil, false)
+	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, claim.Owner)
 
 	syncedClaim, _ := suite.keeper.GetDelegatorClaim(suite.ctx, claim.Owner)
 	suite.Equal(claim.RewardIndexes, syncedClaim.RewardIndexes)
@@ -58,7 +58,7 @@ func (suite *SynchronizeDelegatorRewardTests) TestClaimIndexesAreUnchangedWhenGl
 func (suite *SynchronizeDelegatorRewardTests) TestClaimIndexesAreUpdatedWhenGlobalFactorIncreased() {
 	delegator := arbitraryAddress()
 
-	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, &fakeStakingKeeper{}, nil, nil, nil, nil)
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, newFakeBankKeeper(), nil, nil, nil, &fakeStakingKeeper{}, nil, nil, nil, nil)
 
 	claim := types.DelegatorClaim{
 		BaseMultiClaim: types.BaseMultiClaim{
@@ -76,7 +76,7 @@ func (suite *SynchronizeDelegatorRewardTests) TestClaimIndexesAreUpdatedWhenGlob
 	claim.RewardIndexes[bondIndex].RewardIndexes = globalIndexes
 	suite.storeGlobalDelegatorFactor(claim.RewardIndexes)
 
-	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, claim.Owner, nil, false)
+	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, claim.Owner)
 
 	syncedClaim, _ := suite.keeper.GetDelegatorClaim(suite.ctx, claim.Owner)
 	suite.Equal(globalIndexes, syncedClaim.RewardIndexes[bondIndex].RewardIndexes)
@@ -97,7 +97,7 @@ func (suite *SynchronizeDelegatorRewardTests) TestRewardIsUnchangedWhenGlobalFac
 			unslashedBondedValidator(validatorAddress),
 		},
 	}
-	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, stakingKeeper, nil, nil, nil, nil)
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, newFakeBankKeeper(), nil, nil, nil, stakingKeeper, nil, nil, nil, nil)
 
 	claim := types.DelegatorClaim{
 		BaseMultiClaim: types.BaseMultiClaim{
@@ -120,7 +120,7 @@ func (suite *SynchronizeDelegatorRewardTests) TestRewardIsUnchangedWhenGlobalFac
 
 	suite.storeGlobalDelegatorFactor(claim.RewardIndexes)
 
-	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, claim.Owner, nil, false)
+	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, claim.Owner)
 
 	syncedClaim, _ := suite.keeper.GetDelegatorClaim(suite.ctx, claim.Owner)
 
@@ -142,7 +142,7 @@ func (suite *SynchronizeDelegatorRewardTests) TestRewardIsIncreasedWhenNewReward
 			unslashedBondedValidator(validatorAddress),
 		},
 	}
-	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, stakingKeeper, nil, nil, nil, nil)
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, newFakeBankKeeper(), nil, nil, nil, stakingKeeper, nil, nil, nil, nil)
 
 	claim := types.DelegatorClaim{
 		BaseMultiClaim: types.BaseMultiClaim{
@@ -166,7 +166,7 @@ func (suite *SynchronizeDelegatorRewardTests) TestRewardIsIncreasedWhenNewReward
 	}}
 	suite.storeGlobalDelegatorIndexes(newGlobalIndexes)
 
-	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, claim.Owner, nil, false)
+	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, claim.Owner)
 
 	syncedClaim, _ := suite.keeper.GetDelegatorClaim(suite.ctx, claim.Owner)
 
@@ -192,7 +192,7 @@ func (suite *SynchronizeDelegatorRewardTests) TestRewardIsIncreasedWhenGlobalFac
 			unslashedBondedValidator(validatorAddress),
 		},
 	}
-	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, stakingKeeper, nil, nil, nil, nil)
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, newFakeBankKeeper(), nil, nil, nil, stakingKeeper, nil, nil, nil, nil)
 
 	claim := types.DelegatorClaim{
 		BaseMultiClaim: types.BaseMultiClaim{
@@ -229,7 +229,7 @@ func (suite *SynchronizeDelegatorRewardTests) TestRewardIsIncreasedWhenGlobalFac
 		},
 	)
 
-	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, claim.Owner, nil, false)
+	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, claim.Owner)
 
 	syncedClaim, _ := suite.keeper.GetDelegatorClaim(suite.ctx, claim.Owner)
 
@@ -263,8 +263,7 @@ func unslashedNotBondedValidator(address sdk.ValAddress) stakingtypes.Validator
 	}
 }
 
-func (suite *SynchronizeDelegatorRewardTests) TestGetDelegatedWhenValAddrIsNil() {
-	// when valAddr is nil, get total delegated to bonded validators
+func (suite *SynchronizeDelegatorRewardTests) TestRewardIsOnlyIncreasedForBondedValidators() {
 	delegator := arbitraryAddress()
 	validatorAddresses := generateValidatorAddresses(4)
 	stakingKeeper := &fakeStakingKeeper{
@@ -299,98 +298,103 @@ func (suite *SynchronizeDelegatorRewardTests) TestGetDelegatedWhenValAddrIsNil()
 			unslashedNotBondedValidator(validatorAddresses[3]),
 		},
 	}
-	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, stakingKeeper, nil, nil, nil, nil)
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, newFakeBankKeeper(), nil, nil, nil, stakingKeeper, nil, nil, nil, nil)
 
-	suite.Equal(
-		d("11"), // delegation to bonded validators
-		suite.keeper.GetTotalDelegated(suite.ctx, delegator, nil, false),
-	)
-}
-
-func (suite *SynchronizeDelegatorRewardTests) TestGetDelegatedWhenExcludingAValidator() {
-	// when valAddr is x, get total delegated to bonded validators excluding those to x
-	delegator := arbitraryAddress()
-	validatorAddresses := generateValidatorAddresses(4)
-	stakingKeeper := &fakeStakingKeeper{
-		delegations: stakingtypes.Delegations{
-			// bonded
-			{
-				DelegatorAddress: delegator.String(),
-				ValidatorAddress: validatorAddresses[0].String(),
-				Shares:           d("1"),
-			},
-			{
-				DelegatorAddress: delegator.String(),
-				ValidatorAddress: validatorAddresses[1].String(),
-				Shares:           d("10"),
-			},
-			// not bonded
-			{
-				DelegatorAddress: delegator.String(),
-				ValidatorAddress: validatorAddresses[2].String(),
-				Shares:           d("100"),
-			},
-			{
-				DelegatorAddress: delegator.String(),
-				ValidatorAddress: validatorAddresses[3].String(),
-				Shares:           d("1000"),
-			},
+	claim := types.DelegatorClaim{
+		BaseMultiClaim: types.BaseMultiClaim{
+			Owner: delegator,
 		},
-		validators: stakingtypes.Validators{
-			unslashedBondedValidator(validatorAddresses[0]),
-			unslashedBondedValidator(validatorAddresses[1]),
-			unslashedNotBondedValidator(validatorAddresses[2]),
-			unslashedNotBondedValidator(validatorAddresses[3]),
+		RewardIndexes: types.MultiRewardIndexes{
+			types.NewMultiRewardIndex(types.BondDenom, types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("1")}}),
 		},
 	}
-	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, stakingKeeper, nil, nil, nil, nil)
+	suite.storeDelegatorClaim(claim)
+	suite.storeGlobalDelegatorIndexes(types.MultiRewardIndexes{
+		types.NewMultiRewardIndex(types.BondDenom, types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("3")}}),
+	})
 
-	suite.Equal(
-		d("10"),
-		suite.keeper.GetTotalDelegated(suite.ctx, delegator, validatorAddresses[0], false),
-	)
+	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, claim.Owner)
+
+	syncedClaim, _ := suite.keeper.GetDelegatorClaim(suite.ctx, claim.Owner)
+	// only delegations to bonded validators are rewarded
+	suite.Equal(cs(c("hard", 22)), syncedClaim.Reward)
 }
 
-func (suite *SynchronizeDelegatorRewardTests) TestGetDelegatedWhenIncludingAValidator() {
-	// when valAddr is x, get total delegated to bonded validators including those to x
+func (suite *SynchronizeDelegatorRewardTests) TestRewardIsCalculatedFromValidatorCheckpoints() {
 	delegator := arbitraryAddress()
-	validatorAddresses := generateValidatorAddresses(4)
+	validatorAddresses := generateValidatorAddresses(3)
 	stakingKeeper := &fakeStakingKeeper{
 		delegations: stakingtypes.Delegations{
-			// bonded
 			{
 				DelegatorAddress: delegator.String(),
 				ValidatorAddress: validatorAddresses[0].String(),
-				Shares:           d("1"),
+				Shares:           d("2"),
 			},
 			{
 				DelegatorAddress: delegator.String(),
 				ValidatorAddress: validatorAddresses[1].String(),
 				Shares:           d("10"),
 			},
-			// not bonded
 			{
 				DelegatorAddress: delegator.String(),
 				ValidatorAddress: validatorAddresses[2].String(),
 				Shares:           d("100"),
 			},
-			{
-				DelegatorAddress: delegator.String(),
-				ValidatorAddress: validatorAddresses[3].String(),
-				Shares:           d("1000"),
-			},
 		},
 		validators: stakingtypes.Validators{
 			unslashedBondedValidator(validatorAddresses[0]),
 			unslashedBondedValidator(validatorAddresses[1]),
 			unslashedNotBondedValidator(validatorAddresses[2]),
-			unslashedNotBondedValidator(validatorAddresses[3]),
 		},
 	}
-	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, stakingKeeper, nil, nil, nil, nil)
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, newFakeBankKeeper(), nil, nil, nil, stakingKeeper, nil, nil, nil, nil)
+
+	claim := types.DelegatorClaim{
+		BaseMultiClaim: types.BaseMultiClaim{
+			Owner: delegator,
+		},
+		RewardIndexes: types.MultiRewardIndexes{
+			types.NewMultiRewardIndex(types.BondDenom, types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("1")}}),
+		},
+	}
+	suite.storeDelegatorClaim(claim)
 
+	suite.storeGlobalDelegatorIndexes(types.MultiRewardIndexes{
+		types.NewMultiRewardIndex(types.BondDenom, types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("2")}}),
+	})
+	// validator 0 is slashed by half
+	suite.keeper.CheckpointDelegatorValidator(suite.ctx, validatorAddresses[0], true)
+	stakingKeeper.validators[0].Tokens = i(5e11)
+	// validator 1 begins unbonding
+	stakingKeeper.validators[1].Status = stakingtypes.Unbonding
+	suite.keeper.CheckpointDelegatorValidator(suite.ctx, validatorAddresses[1], true)
+	// validator 2 becomes bonded
+	stakingKeeper.validators[2].Status = stakingtypes.Bonded
+	suite.keeper.CheckpointDelegatorValidator(suite.ctx, validatorAddresses[2], false)
+
+	suite.storeGlobalDelegatorIndexes(types.MultiRewardIndexes{
+		types.NewMultiRewardIndex(types.BondDenom, types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("5")}}),
+	})
+
+	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, claim.Owner)
+
+	syncedClaim, _ := suite.keeper.GetDelegatorClaim(suite.ctx, claim.Owner)
 	suite.Equal(
-		d("111"),
-		suite.keeper.GetTotalDelegated(suite.ctx, delegator, validatorAddresses[2], true),
+		cs(c("hard",
+			2*1+1*3+ // validator 0 is rewarded for its full tokens until slashed
+				10*1+ // validator 1 is only rewarded until it began unbonding
+				100*3, // validator 2 is only rewarded after it became bonded
+		)),
+		syncedClaim.Reward,
 	)
+
+	// checkpoints recorded before the claim was synced are not rewarded again
+	suite.storeGlobalDelegatorIndexes(types.MultiRewardIndexes{
+		types.NewMultiRewardIndex(types.BondDenom, types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("6")}}),
+	})
+
+	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, claim.Owner)
+
+	resyncedClaim, _ := suite.keeper.GetDelegatorClaim(suite.ctx, claim.Owner)
+	suite.Equal(syncedClaim.Reward.Add(c("hard", 1+100)), resyncedClaim.Reward)
 }
@@ -284,7 +284,7 @@ func (suite *DelegatorRewardsTestSuite) TestSynchronizeDelegatorReward() {
 
 			// After we've accumulated, run synchronize
 			suite.Require().NotPanics(func() {
-				suite.keeper.SynchronizeDelegatorRewards(suite.ctx, suite.addrs[0], nil, false)
+				suite.keeper.SynchronizeDelegatorRewards(suite.ctx, suite.addrs[0])
 			})
 
 			// Check that reward factor and claim have been updated as expected
@@ -518,6 +518,9 @@ func (suite *DelegatorRewardsTestSuite) TestUnbondingValidatorSyncsClaim() {
 	_ = suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{})
 	// but don't start the next block as it will accumulate delegator rewards and we won't be able to tell if the user's reward was synced.
 
+	// Sync the user's claim, which uses the checkpoint recorded when the validator's status changed.
+	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, suite.addrs[0])
+
 	// Check that the user's claim has been synced. ie rewards added, index updated
 	claim, found := suite.keeper.GetDelegatorClaim(suite.ctx, suite.addrs[0])
 	suite.Require().True(found)
@@ -539,7 +542,7 @@ func (suite *DelegatorRewardsTestSuite) TestUnbondingValidatorSyncsClaim() {
 	suite.ctx = suite.ctx.WithBlockTime(suite.genesisTime.Add(3 * blockDuration))
 	_ = suite.app.BeginBlocker(suite.ctx, abci.RequestBeginBlock{})
 
-	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, suite.addrs[0], nil, false)
+	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, suite.addrs[0])
 
 	// rewards are the same as before
 	laterClaim, found := suite.keeper.GetDelegatorClaim(suite.ctx, suite.addrs[0])
@@ -612,6 +615,9 @@ func (suite *DelegatorRewardsTestSuite) TestBondingValidatorSyncsClaim() {
 	_ = suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{})
 	// but don't start the next block as it will accumulate delegator rewards and we won't be able to tell if the user's reward was synced.
 
+	// Sync the user's claim, which uses the checkpoint recorded when the validator's status changed.
+	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, suite.addrs[0])
+
 	// Check that the user's claim has been synced. ie rewards added, index updated
 	claim, found := suite.keeper.GetDelegatorClaim(suite.ctx, suite.addrs[0])
 	suite.Require().True(found)
@@ -633,7 +639,7 @@ func (suite *DelegatorRewardsTestSuite) TestBondingValidatorSyncsClaim() {
 	suite.ctx = suite.ctx.WithBlockTime(suite.genesisTime.Add(3 * blockDuration))
 	_ = suite.app.BeginBlocker(suite.ctx, abci.RequestBeginBlock{})
 
-	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, suite.addrs[0], nil, false)
+	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, suite.addrs[0])
 
 	// rewards are greater than before
 	laterClaim, found := suite.keeper.GetDelegatorClaim(suite.ctx, suite.addrs[0])
@@ -650,7 +656,7 @@ func (suite *DelegatorRewardsTestSuite) TestBondingValidatorSyncsClaim() {
 	suite.Require().Equal(globalIndex, laterClaimIndex.RewardIndexes[0].RewardFactor)
 }
 
-// If a validator is slashed delegators should have their claims synced
+// If a validator is slashed delegators should be rewarded for their tokens before the slash when their claims are synced
 func (suite *DelegatorRewardsTestSuite) TestSlashingValidatorSyncsClaim() {
 	authBuilder := app.NewAuthBankGenesisBuilder().
 		WithSimpleAccount(suite.addrs[0], cs(c("ukava", 1e9))).
@@ -716,6 +722,9 @@ func (suite *DelegatorRewardsTestSuite) TestSlashingValidatorSyncsClaim() {
 
 	stakingKeeper.Slash(suite.ctx, consAddr, suite.ctx.BlockHeight(), 10, fraction)
 
+	// Sync the user's claim, which uses the checkpoint recorded before the validator was slashed.
+	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, suite.addrs[0])
+
 	// Check that the user's claim has been synced. ie rewards added, index updated
 	claim, found := suite.keeper.GetDelegatorClaim(suite.ctx, suite.addrs[0])
 	suite.Require().True(found)
@@ -735,6 +744,79 @@ func (suite *DelegatorRewardsTestSuite) TestSlashingValidatorSyncsClaim() {
 	suite.True(claimIndex.RewardIndexes[0].RewardFactor.GT(initialClaimIndex.RewardIndexes[0].RewardFactor))
 }
 
+// Given staking derivatives of a bonded validator, when the validator is slashed, the account holding them is rewarded for
+// their value before the slash when its claim is synced
+func (suite *DelegatorRewardsTestSuite) TestSlashingValidatorSyncsDerivativeHolderClaim() {
+	authBuilder := app.NewAuthBankGenesisBuilder().
+		WithSimpleAccount(suite.addrs[0], cs(c("ukava", 1e9))).
+		WithSimpleAccount(suite.addrs[1], cs(c("ukava", 1e9))).
+		WithSimpleAccount(sdk.AccAddress(suite.validatorAddrs[0]), cs(c("ukava", 1e9)))
+
+	rewardsPerSecond := cs(c("hard", 122354))
+	bondDenom := "ukava"
+
+	incentBuilder := testutil.NewIncentiveGenesisBuilder().
+		WithGenesisTime(suite.genesisTime).
+		WithSimpleDelegatorRewardPeriod(bondDenom, rewardsPerSecond)
+
+	suite.SetupWithGenState(authBuilder, incentBuilder)
+
+	blockDuration := 10 * time.Second
+
+	err := suite.deliverMsgCreateValidator(suite.ctx, suite.validatorAddrs[0], c(bondDenom, 10_000_000))
+	suite.Require().NoError(err)
+
+	// End the block so the validator becomes bonded
+	_ = suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{})
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.genesisTime.Add(1 * blockDuration))
+	_ = suite.app.BeginBlocker(suite.ctx, abci.RequestBeginBlock{}) // height and time in header are ignored by module begin blockers
+
+	// Mint derivatives from a delegation, then send them to an account without any delegations
+	err = suite.deliverMsgDelegate(suite.ctx, suite.addrs[0], suite.validatorAddrs[0], c(bondDenom, 1_000_000))
+	suite.Require().NoError(err)
+	derivative, err := suite.app.GetLiquidKeeper().MintDerivative(suite.ctx, suite.addrs[0], suite.validatorAddrs[0], c(bondDenom, 1_000_000))
+	suite.Require().NoError(err)
+	err = suite.app.GetBankKeeper().SendCoins(suite.ctx, suite.addrs[0], suite.addrs[1], cs(derivative))
+	suite.Require().NoError(err)
+
+	initialClaim, found := suite.keeper.GetDelegatorClaim(suite.ctx, suite.addrs[1])
+	suite.Require().True(found)
+	initialClaimIndex, found := initialClaim.RewardIndexes.GetRewardIndex(bondDenom)
+	suite.True(found)
+	suite.True(initialClaim.Reward.Empty())
+
+	// Start a new block to accumulate some delegation rewards for the holder.
+	_ = suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{})
+	suite.ctx = suite.ctx.WithBlockTime(suite.genesisTime.Add(2 * blockDuration))
+	_ = suite.app.BeginBlocker(suite.ctx, abci.RequestBeginBlock{}) // height and time in header are ignored by module begin blockers
+
+	// Fetch validator and slash them
+	stakingKeeper := suite.app.GetStakingKeeper()
+	validator, found := stakingKeeper.GetValidator(suite.ctx, suite.validatorAddrs[0])
+	suite.Require().True(found)
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+
+	stakingKeeper.Slash(suite.ctx, consAddr, suite.ctx.BlockHeight(), 10, sdk.NewDecWithPrec(5, 1))
+
+	// Sync the holder's claim, which uses the checkpoint recorded before the validator was slashed.
+	suite.keeper.SynchronizeDelegatorRewards(suite.ctx, suite.addrs[1])
+
+	// Check that the holder's claim has been synced. ie rewards added, index updated
+	claim, found := suite.keeper.GetDelegatorClaim(suite.ctx, suite.addrs[1])
+	suite.Require().True(found)
+	globalIndex, found := suite.keeper.GetDelegatorRewardIndexes(suite.ctx, bondDenom)
+	suite.Require().True(found)
+	claimIndex, found := claim.RewardIndexes.GetRewardIndex(bondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(globalIndex, claimIndex.RewardIndexes)
+	suite.True(claimIndex.RewardIndexes[0].RewardFactor.GT(initialClaimIndex.RewardIndexes[0].RewardFactor))
+
+	// Check that rewards were added
+	suite.Require().False(claim.Reward.Empty())
+}
+
 // Given a delegation to a bonded validator, when a user redelegates everything to another (bonded) validator, the user's claim is synced
 func (suite *DelegatorRewardsTestSuite) TestRedelegationSyncsClaim() {
 	authBuilder := app.NewAuthBankGenesisBuilder().
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"sort"
-	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -22,13 +21,7 @@ func (k Keeper) AccumulateEarnRewards(ctx sdk.Context, rewardPeriod types.MultiR
 		return k.accumulateEarnBkavaRewards(ctx, rewardPeriod)
 	}
 
-	k.accumulateEarnRewards(
-		ctx,
-		rewardPeriod.CollateralType,
-		rewardPeriod.Start,
-		rewardPeriod.End,
-		sdk.NewDecCoinsFromCoins(rewardPeriod.RewardsPerSecond...),
-	)
+	k.accumulateEarnRewards(ctx, rewardPeriod)
 
 	return nil
 }
@@ -111,8 +104,7 @@ func (k Keeper) accumulateEarnBkavaRewards(ctx sdk.Context, rewardPeriod types.M
 		k.accumulateBkavaEarnRewards(
 			ctx,
 			bkavaDenom,
-			rewardPeriod.Start,
-			rewardPeriod.End,
+			rewardPeriod,
 			GetProportionalRewardsPerSecond(
 				rewardPeriod,
 				totalBkavaValue.Amount,
@@ -124,15 +116,17 @@ func (k Keeper) accumulateEarnBkavaRewards(ctx sdk.Context, rewardPeriod types.M
 	return nil
 }
 
+// The bkava reward period's rewards cap is shared by all bkava vaults.
 func (k Keeper) accumulateBkavaEarnRewards(
 	ctx sdk.Context,
 	collateralType string,
-	periodStart time.Time,
-	periodEnd time.Time,
+	rewardPeriod types.MultiRewardPeriod,
 	periodRewardsPerSecond sdk.DecCoins,
 ) {
-	// Collect staking rewards for this validator, does not have any start/end
-	// period time restrictions.
+	totalSourceShares := k.getEarnTotalSourceShares(ctx, collateralType)
+
+	// Collect the vault's staking rewards for this validator, does not have any
+	// start/end period time restrictions.
 	stakingRewards := k.collectDerivativeStakingRewards(ctx, collateralType)
 
 	// Collect incentive rewards
@@ -140,9 +134,9 @@ func (k Keeper) accumulateBkavaEarnRewards(
 	perSecondRewards := k.collectPerSecondRewards(
 		ctx,
 		collateralType,
-		periodStart,
-		periodEnd,
+		rewardPeriod,
 		periodRewardsPerSecond,
+		totalSourceShares,
 	)
 
 	// **Total rewards** for vault per second, NOT per share
@@ -154,7 +148,6 @@ func (k Keeper) accumulateBkavaEarnRewards(
 		indexes = types.RewardIndexes{}
 	}
 
-	totalSourceShares := k.getEarnTotalSourceShares(ctx, collateralType)
 	var increment types.RewardIndexes
 	if totalSourceShares.GT(sdk.ZeroDec()) {
 		// Divide total rewards by total shares to get the reward **per share**
@@ -169,8 +162,15 @@ func (k Keeper) accumulateBkavaEarnRewards(
 	}
 }
 
+// collectDerivativeStakingRewards collects the staking rewards earned by the bkava deposited in an earn vault. The
+// rewards of the bkava held outside the vault are restaked by the liquid module instead.
 func (k Keeper) collectDerivativeStakingRewards(ctx sdk.Context, collateralType string) sdk.DecCoins {
-	rewards, err := k.liquidKeeper.CollectStakingRewardsByDenom(ctx, collateralType, types.IncentiveMacc)
+	heldAmount := sdk.ZeroInt()
+	if vaultValue, err := k.earnKeeper.GetVaultTotalValue(ctx, collateralType); err == nil {
+		heldAmount = vaultValue.Amount
+	}
+
+	rewards, err := k.liquidKeeper.CollectStakingRewardsByDenom(ctx, collateralType, heldAmount, types.IncentiveMacc)
 	if err != nil {
 		if !errors.Is(err, distrtypes.ErrNoValidatorDistInfo) &&
 			!errors.Is(err, distrtypes.ErrEmptyDelegationDistInfo) {
@@ -197,18 +197,22 @@ func (k Keeper) collectDerivativeStakingRewards(ctx sdk.Context, collateralType
 func (k Keeper) collectPerSecondRewards(
 	ctx sdk.Context,
 	collateralType string,
-	periodStart time.Time,
-	periodEnd time.Time,
+	rewardPeriod types.MultiRewardPeriod,
 	periodRewardsPerSecond sdk.DecCoins,
+	totalSourceShares sdk.Dec,
 ) sdk.DecCoins {
 	previousAccrualTime, found := k.GetEarnRewardAccrualTime(ctx, collateralType)
 	if !found {
 		previousAccrualTime = ctx.BlockTime()
 	}
 
+	periodRewardsPerSecond = k.capRewardsPerSecond(
+		ctx, RewardSourceEarn, rewardPeriod, periodRewardsPerSecond, previousAccrualTime, totalSourceShares,
+	)
+
 	rewards, accumulatedTo := types.CalculatePerSecondRewards(
-		periodStart,
-		periodEnd,
+		rewardPeriod.Start,
+		rewardPeriod.End,
 		periodRewardsPerSecond,
 		previousAccrualTime,
 		ctx.BlockTime(),
@@ -220,13 +224,9 @@ func (k Keeper) collectPerSecondRewards(
 	return rewards
 }
 
-func (k Keeper) accumulateEarnRewards(
-	ctx sdk.Context,
-	collateralType string,
-	periodStart time.Time,
-	periodEnd time.Time,
-	periodRewardsPerSecond sdk.DecCoins,
-) {
+func (k Keeper) accumulateEarnRewards(ctx sdk.Context, rewardPeriod types.MultiRewardPeriod) {
+	collateralType := rewardPeriod.CollateralType
+
 	previousAccrualTime, found := k.GetEarnRewardAccrualTime(ctx, collateralType)
 	if !found {
 		previousAccrualTime = ctx.BlockTime()
@@ -241,10 +241,14 @@ func (k Keeper) accumulateEarnRewards(
 
 	totalSourceShares := k.getEarnTotalSourceShares(ctx, collateralType)
 
+	rewardsPerSecond := k.capRewardsPerSecond(
+		ctx, RewardSourceEarn, rewardPeriod, sdk.NewDecCoinsFromCoins(rewardPeriod.RewardsPerSecond...), previousAccrualTime, totalSourceShares,
+	)
+
 	acc.AccumulateDecCoins(
-		periodStart,
-		periodEnd,
-		periodRewardsPerSecond,
+		rewardPeriod.Start,
+		rewardPeriod.End,
+		rewardsPerSecond,
 		totalSourceShares,
 		ctx.BlockTime(),
 	)
@@ -257,13 +261,29 @@ func (k Keeper) accumulateEarnRewards(
 }
 
 // getEarnTotalSourceShares fetches the sum of all source shares for a earn reward.
-// In the case of earn, these are the total (earn module) shares in a particular vault.
+// In the case of earn, these are the total (earn module) shares in a particular vault, weighted by share class.
 func (k Keeper) getEarnTotalSourceShares(ctx sdk.Context, vaultDenom string) sdk.Dec {
-	totalShares, found := k.earnKeeper.GetVaultTotalShares(ctx, vaultDenom)
-	if !found {
-		return sdk.ZeroDec()
+	return k.adapters.TotalSharesBySource(ctx, RewardSourceEarn, vaultDenom)
+}
+
+// getEarnOwnerSourceShares fetches the source shares an owner has in an earn vault, weighted by share class.
+func (k Keeper) getEarnOwnerSourceShares(ctx sdk.Context, vaultDenom string, owner sdk.AccAddress) sdk.Dec {
+	return k.adapters.OwnerSharesBySource(ctx, RewardSourceEarn, owner, []string{vaultDenom})[0].Shares
+}
+
+// getEarnShareClassWeights returns the reward weights of the share classes in an earn vault.
+// They are set in the vault's reward period, or the bkava reward period for bkava vaults.
+func (k Keeper) getEarnShareClassWeights(ctx sdk.Context, vaultDenom string) types.ShareClassWeights {
+	earnRewardPeriods := k.GetParams(ctx).EarnRewardPeriods
+
+	if rewardPeriod, found := earnRewardPeriods.GetMultiRewardPeriod(vaultDenom); found {
+		return rewardPeriod.ShareClassWeights
+	}
+	if rewardPeriod, found := earnRewardPeriods.GetMultiRewardPeriod("bkava"); found &&
+		k.liquidKeeper.IsDerivativeDenom(ctx, vaultDenom) {
+		return rewardPeriod.ShareClassWeights
 	}
-	return totalShares.Amount
+	return nil
 }
 
 // InitializeEarnReward creates a new claim with zero rewards and indexes matching the global indexes.
@@ -347,17 +367,15 @@ func (k Keeper) GetSynchronizedEarnClaim(ctx sdk.Context, owner sdk.AccAddress)
 		return types.EarnClaim{}, false
 	}
 
-	shares, found := k.earnKeeper.GetVaultAccountShares(ctx, owner)
-	if !found {
-		shares = earntypes.NewVaultShares()
-	}
-
+	var vaultDenoms []string
 	k.IterateEarnRewardIndexes(ctx, func(vaultDenom string, _ types.RewardIndexes) bool {
-		vaultAmount := shares.AmountOf(vaultDenom)
-		claim = k.synchronizeEarnReward(ctx, claim, vaultDenom, owner, vaultAmount)
-
+		vaultDenoms = append(vaultDenoms, vaultDenom)
 		return false
 	})
 
+	for _, shares := range k.adapters.OwnerSharesBySource(ctx, RewardSourceEarn, owner, vaultDenoms) {
+		claim = k.synchronizeEarnReward(ctx, claim, shares.ID, owner, shares.Shares)
+	}
+
 	return claim, true
 }
@@ -292,13 +292,17 @@ func (suite *AccumulateEarnRewardsIntegrationTests) TestStateUpdatedWhenBlockTim
 	suite.StoredEarnTimeEquals(derivative0.Denom, suite.Ctx.BlockTime())
 	suite.StoredEarnTimeEquals(derivative1.Denom, suite.Ctx.BlockTime())
 
-	// Divided by deposit amounts, not bank supply amounts
+	// Only the rewards of the deposited bkava are collected, divided by deposit amounts
 	stakingRewardIndexes0 := sdk.NewDecFromInt(validatorRewards[suite.valAddrs[0].String()].
-		AmountOf("ukava")).
+		AmountOf("ukava").
+		Mul(depositAmount0.Amount).
+		Quo(derivative0.Amount)).
 		Quo(sdk.NewDecFromInt(depositAmount0.Amount))
 
 	stakingRewardIndexes1 := sdk.NewDecFromInt(validatorRewards[suite.valAddrs[1].String()].
-		AmountOf("ukava")).
+		AmountOf("ukava").
+		Mul(depositAmount1.Amount).
+		Quo(derivative1.Amount)).
 		Quo(sdk.NewDecFromInt(depositAmount1.Amount))
 
 	// Slightly increased rewards due to less bkava deposited
@@ -4,7 +4,6 @@ import (
 	"testing"
 	"time"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
 	earntypes "github.com/kava-labs/kava/x/earn/types"
@@ -261,10 +260,10 @@ func (suite *AccumulateEarnRewardsTests) TestStateUpdatedWhenBlockTimeHasIncreas
 		{
 			CollateralType: "ukava",
 			RewardFactor: d("4.154285714285714286"). // base incentive
-									Add(sdk.NewDecFromInt(vaultDenom1Supply). // staking rewards
-															QuoInt64(10).
-															MulInt64(3600).
-															Quo(vault1Shares),
+									Add(vault1Shares. // staking rewards of the deposited bkava
+												QuoInt64(10).
+												MulInt64(3600).
+												Quo(vault1Shares),
 				),
 		},
 	})
@@ -292,7 +291,7 @@ func (suite *AccumulateEarnRewardsTests) TestStateUpdatedWhenBlockTimeHasIncreas
 		{
 			CollateralType: "ukava",
 			RewardFactor: d("7.24").
-				Add(sdk.NewDecFromInt(vaultDenom2Supply).
+				Add(vault2Shares.
 					QuoInt64(10).
 					MulInt64(3600).
 					Quo(vault2Shares),
@@ -302,6 +301,67 @@ func (suite *AccumulateEarnRewardsTests) TestStateUpdatedWhenBlockTimeHasIncreas
 	suite.storedIndexesEqual(vaultDenom2, vault2expectedIndexes)
 }
 
+func (suite *AccumulateEarnRewardsTests) TestStateUpdatedWhenBlockTimeHasIncreased_shareClassWeights() {
+	vaultDenom := "usdx"
+
+	period := types.NewMultiRewardPeriod(
+		true,
+		vaultDenom,
+		time.Unix(0, 0), // ensure the test is within start and end times
+		distantFuture,
+		cs(c("earn", 2000), c("ukava", 1000)), // same denoms as in global indexes
+	)
+	period.ShareClassWeights = types.ShareClassWeights{
+		types.NewShareClassWeight("liquid", d("2")),
+	}
+
+	earnKeeper := newFakeEarnKeeper().addVault(vaultDenom, earntypes.NewVaultShare(vaultDenom, d("1000000")))
+	subspace := &fakeParamSubspace{
+		params: types.Params{
+			EarnRewardPeriods: types.MultiRewardPeriods{period},
+		},
+	}
+	suite.keeper = suite.NewKeeper(subspace, nil, nil, nil, nil, nil, nil, nil, nil, earnKeeper)
+
+	suite.storeGlobalEarnIndexes(types.MultiRewardIndexes{
+		{
+			CollateralType: vaultDenom,
+			RewardIndexes: types.RewardIndexes{
+				{
+					CollateralType: "earn",
+					RewardFactor:   d("0.02"),
+				},
+				{
+					CollateralType: "ukava",
+					RewardFactor:   d("0.04"),
+				},
+			},
+		},
+	})
+	previousAccrualTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.keeper.SetEarnRewardAccrualTime(suite.ctx, vaultDenom, previousAccrualTime)
+
+	newAccrualTime := previousAccrualTime.Add(1 * time.Hour)
+	suite.ctx = suite.ctx.WithBlockTime(newAccrualTime)
+
+	suite.keeper.AccumulateEarnRewards(suite.ctx, period)
+
+	// check time and factors
+
+	suite.storedTimeEquals(vaultDenom, newAccrualTime)
+	// doubling the weight of the vault's shares halves the index increments
+	suite.storedIndexesEqual(vaultDenom, types.RewardIndexes{
+		{
+			CollateralType: "earn",
+			RewardFactor:   d("3.62"),
+		},
+		{
+			CollateralType: "ukava",
+			RewardFactor:   d("1.84"),
+		},
+	})
+}
+
 func (suite *AccumulateEarnRewardsTests) TestStateUnchangedWhenBlockTimeHasNotIncreased() {
 	vaultDenom := "usdx"
 
@@ -4,12 +4,16 @@ import (
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/kava-labs/kava/app"
 	earntypes "github.com/kava-labs/kava/x/earn/types"
 	"github.com/kava-labs/kava/x/incentive/testutil"
 	"github.com/kava-labs/kava/x/incentive/types"
+	"github.com/kava-labs/kava/x/liquid"
+	liquidtypes "github.com/kava-labs/kava/x/liquid/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -163,15 +167,20 @@ func (suite *EarnStakingRewardsIntegrationTestSuite) TestStakingRewardsDistribut
 	suite.Require().Contains(validatorRewards, suite.valAddrs[0].String(), "there should be claim events for validator 1")
 	suite.Require().Contains(validatorRewards, suite.valAddrs[1].String(), "there should be claim events for validator 2")
 
-	// Total staking rewards / total source shares (**deposited in earn** not total minted)
+	// Only the staking rewards of the bkava **deposited in earn** are collected,
+	// the rewards of the bkava not in earn are restaked by the liquid module.
+	totalRewards0 := validatorRewards[suite.valAddrs[0].String()].AmountOf("ukava")
+	totalRewards1 := validatorRewards[suite.valAddrs[1].String()].AmountOf("ukava")
+	collected0 := totalRewards0.Mul(userDepositAmount0).Quo(derivative0.Amount)
+	collected1 := totalRewards1.Mul(userDepositAmount1).Quo(derivative1.Amount)
+
+	// Collected staking rewards / total source shares (**deposited in earn** not total minted)
 	// types.RewardIndexes.Quo() uses Dec.Quo() which uses bankers rounding.
 	// So we need to use Dec.Quo() to also round vs Dec.QuoInt() which truncates
-	expectedIndexes1 := sdk.NewDecFromInt(validatorRewards[suite.valAddrs[0].String()].
-		AmountOf("ukava")).
+	expectedIndexes1 := sdk.NewDecFromInt(collected0).
 		Quo(sdk.NewDecFromInt(userDepositAmount0))
 
-	expectedIndexes2 := sdk.NewDecFromInt(validatorRewards[suite.valAddrs[1].String()].
-		AmountOf("ukava")).
+	expectedIndexes2 := sdk.NewDecFromInt(collected1).
 		Quo(sdk.NewDecFromInt(userDepositAmount1))
 
 	// Only contains staking rewards
@@ -188,4 +197,22 @@ func (suite *EarnStakingRewardsIntegrationTestSuite) TestStakingRewardsDistribut
 			RewardFactor:   initialVault2RewardFactor.Add(expectedIndexes2),
 		},
 	})
+
+	// The rest of the rewards are restaked and increase the value of each derivative
+	expectedValue0 := derivative0.Amount.Add(totalRewards0.Sub(collected0))
+	expectedValue1 := derivative1.Amount.Add(totalRewards1.Sub(collected1))
+	suite.derivativeValueEquals(vaultDenom1, expectedValue0)
+	suite.derivativeValueEquals(vaultDenom2, expectedValue1)
+
+	// Restaking at the end of the block does not claim the collected rewards again
+	liquid.EndBlocker(suite.Ctx, lq)
+	suite.derivativeValueEquals(vaultDenom1, expectedValue0)
+	suite.derivativeValueEquals(vaultDenom2, expectedValue1)
+	suite.BalanceEquals(authtypes.NewModuleAddress(liquidtypes.ModuleAccountName), sdk.NewCoins())
+}
+
+func (suite *EarnStakingRewardsIntegrationTestSuite) derivativeValueEquals(denom string, expected sdkmath.Int) {
+	value, err := suite.App.GetLiquidKeeper().GetDerivativeValue(suite.Ctx, denom)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewCoin("ukava", expected), value)
 }
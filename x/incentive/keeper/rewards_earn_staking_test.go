@@ -3,7 +3,6 @@ package keeper_test
 import (
 	"time"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	earntypes "github.com/kava-labs/kava/x/earn/types"
 	"github.com/kava-labs/kava/x/incentive/types"
 )
@@ -26,7 +25,7 @@ func (suite *AccumulateEarnRewardsTests) TestStakingRewardsDistributed() {
 	vault2Shares := d("100000")
 
 	// More bkava minted than deposited into earn
-	// Rewards are higher per-share as a result
+	// Only the rewards of the deposited bkava are distributed, the rest are restaked
 	earnKeeper := newFakeEarnKeeper().
 		addVault(vaultDenom1, earntypes.NewVaultShare(vaultDenom1, vault1Shares)).
 		addVault(vaultDenom2, earntypes.NewVaultShare(vaultDenom2, vault2Shares))
@@ -84,7 +83,7 @@ func (suite *AccumulateEarnRewardsTests) TestStakingRewardsDistributed() {
 		{
 			CollateralType: "ukava",
 			RewardFactor: initialVault1RewardFactor.
-				Add(sdk.NewDecFromInt(vaultDenom1Supply).
+				Add(vault1Shares.
 					QuoInt64(10).
 					MulInt64(3600).
 					Quo(vault1Shares)),
@@ -95,7 +94,7 @@ func (suite *AccumulateEarnRewardsTests) TestStakingRewardsDistributed() {
 		{
 			CollateralType: "ukava",
 			RewardFactor: initialVault2RewardFactor.
-				Add(sdk.NewDecFromInt(vaultDenom2Supply).
+				Add(vault2Shares.
 					QuoInt64(10).
 					MulInt64(3600).
 					Quo(vault2Shares)),
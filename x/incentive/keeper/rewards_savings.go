@@ -25,9 +25,12 @@ func (k Keeper) AccumulateSavingsRewards(ctx sdk.Context, rewardPeriod types.Mul
 
 	savingsMacc := k.accountKeeper.GetModuleAccount(ctx, savingstypes.ModuleName)
 	maccCoins := k.bankKeeper.GetAllBalances(ctx, savingsMacc.GetAddress())
-	denomBalance := maccCoins.AmountOf(rewardPeriod.CollateralType)
+	totalSource := sdk.NewDecFromInt(maccCoins.AmountOf(rewardPeriod.CollateralType))
 
-	acc.Accumulate(rewardPeriod, sdk.NewDecFromInt(denomBalance), ctx.BlockTime())
+	rewardsPerSecond := k.capRewardsPerSecond(
+		ctx, RewardSourceSavings, rewardPeriod, sdk.NewDecCoinsFromCoins(rewardPeriod.RewardsPerSecond...), previousAccrualTime, totalSource,
+	)
+	acc.AccumulateDecCoins(rewardPeriod.Start, rewardPeriod.End, rewardsPerSecond, totalSource, ctx.BlockTime())
 
 	k.SetSavingsRewardAccrualTime(ctx, rewardPeriod.CollateralType, acc.PreviousAccumulationTime)
 
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// SnapshotRewardIndexes stores the current global reward indexes if the block height is on a snapshot interval.
+// The snapshots allow rewards to be calculated at past heights.
+func (k Keeper) SnapshotRewardIndexes(ctx sdk.Context) {
+	if ctx.BlockHeight()%types.RewardIndexesSnapshotInterval != 0 {
+		return
+	}
+	k.SetRewardIndexesSnapshot(ctx, k.GetCurrentRewardIndexes(ctx))
+}
+
+// GetCurrentRewardIndexes returns the global reward indexes of every reward source at the current block height.
+func (k Keeper) GetCurrentRewardIndexes(ctx sdk.Context) types.RewardIndexesSnapshot {
+	snapshot := types.RewardIndexesSnapshot{Height: ctx.BlockHeight()}
+
+	k.IterateUSDXMintingRewardFactors(ctx, func(collateralType string, factor sdk.Dec) (stop bool) {
+		snapshot.USDXMintingRewardIndexes = snapshot.USDXMintingRewardIndexes.With(collateralType, factor)
+		return false
+	})
+	k.IterateHardSupplyRewardIndexes(ctx, func(denom string, indexes types.RewardIndexes) (stop bool) {
+		snapshot.HardSupplyRewardIndexes = snapshot.HardSupplyRewardIndexes.With(denom, indexes)
+		return false
+	})
+	k.IterateHardBorrowRewardIndexes(ctx, func(denom string, indexes types.RewardIndexes) (stop bool) {
+		snapshot.HardBorrowRewardIndexes = snapshot.HardBorrowRewardIndexes.With(denom, indexes)
+		return false
+	})
+	k.IterateDelegatorRewardIndexes(ctx, func(denom string, indexes types.RewardIndexes) (stop bool) {
+		snapshot.DelegatorRewardIndexes = snapshot.DelegatorRewardIndexes.With(denom, indexes)
+		return false
+	})
+	k.IterateSwapRewardIndexes(ctx, func(poolID string, indexes types.RewardIndexes) (stop bool) {
+		snapshot.SwapRewardIndexes = snapshot.SwapRewardIndexes.With(poolID, indexes)
+		return false
+	})
+	k.IterateSavingsRewardIndexes(ctx, func(denom string, indexes types.RewardIndexes) (stop bool) {
+		snapshot.SavingsRewardIndexes = snapshot.SavingsRewardIndexes.With(denom, indexes)
+		return false
+	})
+	k.IterateEarnRewardIndexes(ctx, func(vaultDenom string, indexes types.RewardIndexes) (stop bool) {
+		snapshot.EarnRewardIndexes = snapshot.EarnRewardIndexes.With(vaultDenom, indexes)
+		return false
+	})
+
+	return snapshot
+}
+
+// restoreRewardIndexes replaces the global reward indexes in the store with those of a snapshot.
+// It must only be used on a cached context that is never written, as it rewinds reward accumulation.
+func (k Keeper) restoreRewardIndexes(ctx sdk.Context, snapshot types.RewardIndexesSnapshot) {
+	for _, keyPrefix := range [][]byte{
+		types.USDXMintingRewardFactorKeyPrefix,
+		types.HardSupplyRewardIndexesKeyPrefix,
+		types.HardBorrowRewardIndexesKeyPrefix,
+		types.DelegatorRewardIndexesKeyPrefix,
+		types.SwapRewardIndexesKeyPrefix,
+		types.SavingsRewardIndexesKeyPrefix,
+		types.EarnRewardIndexesKeyPrefix,
+	} {
+		k.deleteAllWithPrefix(ctx, keyPrefix)
+	}
+
+	for _, index := range snapshot.USDXMintingRewardIndexes {
+		k.SetUSDXMintingRewardFactor(ctx, index.CollateralType, index.RewardFactor)
+	}
+	for _, mri := range snapshot.HardSupplyRewardIndexes {
+		k.SetHardSupplyRewardIndexes(ctx, mri.CollateralType, mri.RewardIndexes)
+	}
+	for _, mri := range snapshot.HardBorrowRewardIndexes {
+		k.SetHardBorrowRewardIndexes(ctx, mri.CollateralType, mri.RewardIndexes)
+	}
+	for _, mri := range snapshot.DelegatorRewardIndexes {
+		k.SetDelegatorRewardIndexes(ctx, mri.CollateralType, mri.RewardIndexes)
+	}
+	for _, mri := range snapshot.SwapRewardIndexes {
+		k.SetSwapRewardIndexes(ctx, mri.CollateralType, mri.RewardIndexes)
+	}
+	for _, mri := range snapshot.SavingsRewardIndexes {
+		k.SetSavingsRewardIndexes(ctx, mri.CollateralType, mri.RewardIndexes)
+	}
+	for _, mri := range snapshot.EarnRewardIndexes {
+		k.SetEarnRewardIndexes(ctx, mri.CollateralType, mri.RewardIndexes)
+	}
+}
+
+// deleteAllWithPrefix removes every entry under a key prefix from the store.
+func (k Keeper) deleteAllWithPrefix(ctx sdk.Context, keyPrefix []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.key), keyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// claimIndexesExceed returns true if any claim holds a reward index above the corresponding global index in a snapshot.
+func claimIndexesExceed(rewards types.QueryRewardsResponse, snapshot types.RewardIndexesSnapshot) bool {
+	for _, claim := range rewards.USDXMintingClaims {
+		if claim.RewardIndexes.Exceeds(snapshot.USDXMintingRewardIndexes) {
+			return true
+		}
+	}
+	for _, claim := range rewards.HardLiquidityProviderClaims {
+		if claim.SupplyRewardIndexes.Exceeds(snapshot.HardSupplyRewardIndexes) ||
+			claim.BorrowRewardIndexes.Exceeds(snapshot.HardBorrowRewardIndexes) {
+			return true
+		}
+	}
+	for _, claim := range rewards.DelegatorClaims {
+		if claim.RewardIndexes.Exceeds(snapshot.DelegatorRewardIndexes) {
+			return true
+		}
+	}
+	for _, claim := range rewards.SwapClaims {
+		if claim.RewardIndexes.Exceeds(snapshot.SwapRewardIndexes) {
+			return true
+		}
+	}
+	for _, claim := range rewards.SavingsClaims {
+		if claim.RewardIndexes.Exceeds(snapshot.SavingsRewardIndexes) {
+			return true
+		}
+	}
+	for _, claim := range rewards.EarnClaims {
+		if claim.RewardIndexes.Exceeds(snapshot.EarnRewardIndexes) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+type RewardIndexesSnapshotTests struct {
+	unitTester
+}
+
+func TestRewardIndexesSnapshots(t *testing.T) {
+	suite.Run(t, new(RewardIndexesSnapshotTests))
+}
+
+func (suite *RewardIndexesSnapshotTests) TestSnapshotIsOnlyTakenOnInterval() {
+	suite.storeGlobalSupplyIndexes(types.MultiRewardIndexes{
+		{CollateralType: "bnb", RewardIndexes: types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.1")}}},
+	})
+
+	suite.ctx = suite.ctx.WithBlockHeight(types.RewardIndexesSnapshotInterval - 1)
+	suite.keeper.SnapshotRewardIndexes(suite.ctx)
+
+	_, found := suite.keeper.GetLatestRewardIndexesSnapshot(suite.ctx, types.RewardIndexesSnapshotInterval-1)
+	suite.False(found)
+
+	suite.ctx = suite.ctx.WithBlockHeight(types.RewardIndexesSnapshotInterval)
+	suite.keeper.SnapshotRewardIndexes(suite.ctx)
+
+	snapshot, found := suite.keeper.GetLatestRewardIndexesSnapshot(suite.ctx, types.RewardIndexesSnapshotInterval)
+	suite.True(found)
+	suite.Equal(types.RewardIndexesSnapshot{
+		Height: types.RewardIndexesSnapshotInterval,
+		HardSupplyRewardIndexes: types.MultiRewardIndexes{
+			{CollateralType: "bnb", RewardIndexes: types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.1")}}},
+		},
+	}, snapshot)
+}
+
+func (suite *RewardIndexesSnapshotTests) TestGetLatestSnapshot() {
+	suite.keeper.SetRewardIndexesSnapshot(suite.ctx, types.RewardIndexesSnapshot{Height: 600})
+	suite.keeper.SetRewardIndexesSnapshot(suite.ctx, types.RewardIndexesSnapshot{Height: 1200})
+
+	_, found := suite.keeper.GetLatestRewardIndexesSnapshot(suite.ctx, 599)
+	suite.False(found)
+
+	for height, expected := range map[int64]int64{600: 600, 1199: 600, 1200: 1200, 5000: 1200} {
+		snapshot, found := suite.keeper.GetLatestRewardIndexesSnapshot(suite.ctx, height)
+		suite.True(found)
+		suite.Equal(expected, snapshot.Height)
+	}
+}
@@ -26,7 +26,10 @@ func (k Keeper) AccumulateHardSupplyRewards(ctx sdk.Context, rewardPeriod types.
 
 	totalSource := k.getHardSupplyTotalSourceShares(ctx, rewardPeriod.CollateralType)
 
-	acc.Accumulate(rewardPeriod, totalSource, ctx.BlockTime())
+	rewardsPerSecond := k.capRewardsPerSecond(
+		ctx, RewardSourceHardSupply, rewardPeriod, sdk.NewDecCoinsFromCoins(rewardPeriod.RewardsPerSecond...), previousAccrualTime, totalSource,
+	)
+	acc.AccumulateDecCoins(rewardPeriod.Start, rewardPeriod.End, rewardsPerSecond, totalSource, ctx.BlockTime())
 
 	k.SetPreviousHardSupplyRewardAccrualTime(ctx, rewardPeriod.CollateralType, acc.PreviousAccumulationTime)
 	if len(acc.Indexes) > 0 {
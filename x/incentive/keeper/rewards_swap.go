@@ -26,7 +26,10 @@ func (k Keeper) AccumulateSwapRewards(ctx sdk.Context, rewardPeriod types.MultiR
 
 	totalSource := k.getSwapTotalSourceShares(ctx, rewardPeriod.CollateralType)
 
-	acc.Accumulate(rewardPeriod, totalSource, ctx.BlockTime())
+	rewardsPerSecond := k.capRewardsPerSecond(
+		ctx, RewardSourceSwap, rewardPeriod, sdk.NewDecCoinsFromCoins(rewardPeriod.RewardsPerSecond...), previousAccrualTime, totalSource,
+	)
+	acc.AccumulateDecCoins(rewardPeriod.Start, rewardPeriod.End, rewardsPerSecond, totalSource, ctx.BlockTime())
 
 	k.SetSwapRewardAccrualTime(ctx, rewardPeriod.CollateralType, acc.PreviousAccumulationTime)
 	if len(acc.Indexes) > 0 {
@@ -28,7 +28,11 @@ func (k Keeper) AccumulateUSDXMintingRewards(ctx sdk.Context, rewardPeriod types
 
 	totalSource := k.getUSDXTotalSourceShares(ctx, rewardPeriod.CollateralType)
 
-	acc.Accumulate(types.NewMultiRewardPeriodFromRewardPeriod(rewardPeriod), totalSource, ctx.BlockTime())
+	multiRewardPeriod := types.NewMultiRewardPeriodFromRewardPeriod(rewardPeriod)
+	rewardsPerSecond := k.capRewardsPerSecond(
+		ctx, RewardSourceUSDXMinting, multiRewardPeriod, sdk.NewDecCoinsFromCoins(multiRewardPeriod.RewardsPerSecond...), previousAccrualTime, totalSource,
+	)
+	acc.AccumulateDecCoins(rewardPeriod.Start, rewardPeriod.End, rewardsPerSecond, totalSource, ctx.BlockTime())
 
 	k.SetPreviousUSDXMintingAccrualTime(ctx, rewardPeriod.CollateralType, acc.PreviousAccumulationTime)
 
@@ -12,6 +12,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -26,6 +28,7 @@ import (
 	hardtypes "github.com/kava-labs/kava/x/hard/types"
 	"github.com/kava-labs/kava/x/incentive/keeper"
 	"github.com/kava-labs/kava/x/incentive/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
 
 // NewTestContext sets up a basic context with an in-memory db
@@ -82,6 +85,7 @@ func (suite *unitTester) NewKeeper(
 		suite.cdc, suite.incentiveStoreKey, paramSubspace,
 		bk, cdpk, hk, ak, stk, swk, svk, lqk, ek,
 		nil, nil, nil,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
 	)
 }
 
@@ -228,6 +232,7 @@ func (tk *TestKeeperBuilder) Build() keeper.Keeper {
 		tk.bankKeeper, tk.cdpKeeper, tk.hardKeeper, tk.accountKeeper,
 		tk.stakingKeeper, tk.swapKeeper, tk.savingsKeeper, tk.liquidKeeper,
 		tk.earnKeeper, tk.mintKeeper, tk.distrKeeper, tk.pricefeedKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
 	)
 }
 
@@ -288,6 +293,10 @@ func (k *fakeSwapKeeper) GetPoolShares(_ sdk.Context, poolID string) (sdkmath.In
 	return shares, ok
 }
 
+func (k *fakeSwapKeeper) GetPool(_ sdk.Context, poolID string) (swaptypes.PoolRecord, bool) {
+	return swaptypes.PoolRecord{}, false
+}
+
 func (k *fakeSwapKeeper) GetDepositorSharesAmount(_ sdk.Context, depositor sdk.AccAddress, poolID string) (sdkmath.Int, bool) {
 	shares, found := k.depositShares[poolID][depositor.String()]
 	return shares, found
@@ -365,6 +374,10 @@ func (k *fakeHardKeeper) GetDeposit(_ sdk.Context, _ sdk.AccAddress) (hardtypes.
 	panic("unimplemented")
 }
 
+func (k *fakeHardKeeper) GetMoneyMarket(_ sdk.Context, _ string) (hardtypes.MoneyMarket, bool) {
+	panic("unimplemented")
+}
+
 // fakeStakingKeeper is a stub staking keeper.
 // It can be used to return values to the incentive keeper without having to initialize a full staking keeper.
 type fakeStakingKeeper struct {
@@ -458,6 +471,10 @@ func (k *fakeCDPKeeper) GetTotalPrincipal(_ sdk.Context, collateralType string,
 	return k.totalPrincipal
 }
 
+func (k *fakeCDPKeeper) GetTotalCollateral(_ sdk.Context, collateralType string) sdkmath.Int {
+	return sdk.ZeroInt()
+}
+
 func (k *fakeCDPKeeper) GetCdpByOwnerAndCollateralType(_ sdk.Context, owner sdk.AccAddress, collateralType string) (cdptypes.CDP, bool) {
 	return cdptypes.CDP{}, false
 }
@@ -574,6 +591,10 @@ func (k *fakeLiquidKeeper) GetAllDerivativeDenoms(ctx sdk.Context) (denoms []str
 	return denoms
 }
 
+func (k *fakeLiquidKeeper) GetDerivativeShares(ctx sdk.Context, valAddr sdk.ValAddress, amount sdkmath.Int) sdk.Dec {
+	return sdk.NewDecFromInt(amount)
+}
+
 func (k *fakeLiquidKeeper) GetTotalDerivativeValue(ctx sdk.Context) (sdk.Coin, error) {
 	totalSupply := sdk.ZeroInt()
 	for _, supply := range k.derivatives {
@@ -595,9 +616,13 @@ func (k *fakeLiquidKeeper) GetDerivativeValue(ctx sdk.Context, denom string) (sd
 func (k *fakeLiquidKeeper) CollectStakingRewardsByDenom(
 	ctx sdk.Context,
 	derivativeDenom string,
+	heldAmount sdkmath.Int,
 	destinationModAccount string,
 ) (sdk.Coins, error) {
 	amt := k.getRewardAmount(ctx, derivativeDenom)
+	if supply, found := k.derivatives[derivativeDenom]; found && supply.IsPositive() {
+		amt = amt.Mul(sdkmath.MinInt(heldAmount, supply)).Quo(supply)
+	}
 
 	return sdk.NewCoins(sdk.NewCoin("ukava", amt)), nil
 }
@@ -720,7 +745,7 @@ func (k *fakeBankKeeper) SendCoinsFromModuleToAccount(
 }
 
 func (k *fakeBankKeeper) GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
-	panic("not implemented")
+	return sdk.NewCoins()
 }
 
 func (k *fakeBankKeeper) GetSupply(ctx sdk.Context, denom string) sdk.Coin {
@@ -0,0 +1,122 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding incentive type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.USDXMintingClaimKeyPrefix):
+			var claimA, claimB types.USDXMintingClaim
+			cdc.MustUnmarshal(kvA.Value, &claimA)
+			cdc.MustUnmarshal(kvB.Value, &claimB)
+			return fmt.Sprintf("%v\n%v", claimA, claimB)
+
+		case bytes.Equal(kvA.Key[:1], types.HardLiquidityClaimKeyPrefix):
+			var claimA, claimB types.HardLiquidityProviderClaim
+			cdc.MustUnmarshal(kvA.Value, &claimA)
+			cdc.MustUnmarshal(kvB.Value, &claimB)
+			return fmt.Sprintf("%v\n%v", claimA, claimB)
+
+		case bytes.Equal(kvA.Key[:1], types.DelegatorClaimKeyPrefix):
+			var claimA, claimB types.DelegatorClaim
+			cdc.MustUnmarshal(kvA.Value, &claimA)
+			cdc.MustUnmarshal(kvB.Value, &claimB)
+			return fmt.Sprintf("%v\n%v", claimA, claimB)
+
+		case bytes.Equal(kvA.Key[:1], types.SwapClaimKeyPrefix):
+			var claimA, claimB types.SwapClaim
+			cdc.MustUnmarshal(kvA.Value, &claimA)
+			cdc.MustUnmarshal(kvB.Value, &claimB)
+			return fmt.Sprintf("%v\n%v", claimA, claimB)
+
+		case bytes.Equal(kvA.Key[:1], types.SavingsClaimKeyPrefix):
+			var claimA, claimB types.SavingsClaim
+			cdc.MustUnmarshal(kvA.Value, &claimA)
+			cdc.MustUnmarshal(kvB.Value, &claimB)
+			return fmt.Sprintf("%v\n%v", claimA, claimB)
+
+		case bytes.Equal(kvA.Key[:1], types.EarnClaimKeyPrefix):
+			var claimA, claimB types.EarnClaim
+			cdc.MustUnmarshal(kvA.Value, &claimA)
+			cdc.MustUnmarshal(kvB.Value, &claimB)
+			return fmt.Sprintf("%v\n%v", claimA, claimB)
+
+		case bytes.Equal(kvA.Key[:1], types.USDXMintingRewardFactorKeyPrefix):
+			var factorA, factorB sdk.Dec
+			if err := factorA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := factorB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%s\n%s", factorA, factorB)
+
+		case bytes.Equal(kvA.Key[:1], types.HardSupplyRewardIndexesKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.HardBorrowRewardIndexesKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.DelegatorRewardIndexesKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.SwapRewardIndexesKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.SavingsRewardIndexesKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.EarnRewardIndexesKeyPrefix):
+			var indexesA, indexesB types.RewardIndexesProto
+			cdc.MustUnmarshal(kvA.Value, &indexesA)
+			cdc.MustUnmarshal(kvB.Value, &indexesB)
+			return fmt.Sprintf("%v\n%v", indexesA.RewardIndexes, indexesB.RewardIndexes)
+
+		case bytes.Equal(kvA.Key[:1], types.PreviousUSDXMintingRewardAccrualTimeKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.PreviousHardSupplyRewardAccrualTimeKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.PreviousHardBorrowRewardAccrualTimeKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.PreviousDelegatorRewardAccrualTimeKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.PreviousSwapRewardAccrualTimeKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.PreviousSavingsRewardAccrualTimeKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.PreviousEarnRewardAccrualTimeKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.PreviousBlockTimeKey):
+			var timeA, timeB time.Time
+			if err := timeA.UnmarshalBinary(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := timeB.UnmarshalBinary(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%s\n%s", timeA, timeB)
+
+		case bytes.Equal(kvA.Key[:1], types.DistributedRewardsKeyPrefix):
+			var distributedA, distributedB types.DistributedRewards
+			cdc.MustUnmarshal(kvA.Value, &distributedA)
+			cdc.MustUnmarshal(kvB.Value, &distributedB)
+			return fmt.Sprintf("%v\n%v", distributedA, distributedB)
+
+		case bytes.Equal(kvA.Key[:1], types.RewardIndexesSnapshotKeyPrefix):
+			var snapshotA, snapshotB types.RewardIndexesSnapshot
+			cdc.MustUnmarshal(kvA.Value, &snapshotA)
+			cdc.MustUnmarshal(kvB.Value, &snapshotB)
+			return fmt.Sprintf("%v\n%v", snapshotA, snapshotB)
+
+		case bytes.Equal(kvA.Key[:1], types.DelegatorValidatorCheckpointKeyPrefix):
+			var checkpointA, checkpointB types.DelegatorValidatorCheckpoint
+			cdc.MustUnmarshal(kvA.Value, &checkpointA)
+			cdc.MustUnmarshal(kvB.Value, &checkpointB)
+			return fmt.Sprintf("%v\n%v", checkpointA, checkpointB)
+
+		case bytes.Equal(kvA.Key[:1], types.NextDelegatorCheckpointIDKey),
+			bytes.Equal(kvA.Key[:1], types.DelegatorClaimCheckpointIDKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.DelegatorClaimCheckpointIDIndexPrefix),
+			bytes.Equal(kvA.Key[:1], types.DelegatorValidatorCheckpointIDIndexPrefix):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		default:
+			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
+		}
+	}
+}
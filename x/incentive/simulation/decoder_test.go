@@ -0,0 +1,91 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/incentive/simulation"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	addr := sdk.AccAddress("test_address")
+	indexes := types.RewardIndexes{types.NewRewardIndex("hard", sdk.MustNewDecFromStr("0.1"))}
+	multiIndexes := types.MultiRewardIndexes{types.NewMultiRewardIndex("bnb", indexes)}
+
+	usdxClaim := types.NewUSDXMintingClaim(addr, sdk.NewInt64Coin("ukava", 10), indexes)
+	hardClaim := types.NewHardLiquidityProviderClaim(addr, sdk.NewCoins(sdk.NewInt64Coin("hard", 10)), multiIndexes, multiIndexes)
+	swapClaim := types.NewSwapClaim(addr, sdk.NewCoins(sdk.NewInt64Coin("swp", 10)), multiIndexes)
+	factor := sdk.MustNewDecFromStr("0.5")
+	factorBz, err := factor.Marshal()
+	require.NoError(t, err)
+	accrualTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+	accrualTimeBz, err := accrualTime.MarshalBinary()
+	require.NoError(t, err)
+	distributed := types.NewDistributedRewards("bnb", sdk.NewDecCoins(sdk.NewInt64DecCoin("hard", 10)))
+	snapshot := types.RewardIndexesSnapshot{Height: 600, HardSupplyRewardIndexes: multiIndexes}
+	valAddr := sdk.ValAddress("test_validator")
+	checkpoint := types.NewDelegatorValidatorCheckpoint(indexes, true, sdk.NewInt(1e9), sdk.NewDec(1e9))
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: append(types.USDXMintingClaimKeyPrefix, addr...), Value: cdc.MustMarshal(&usdxClaim)},
+			{Key: append(types.HardLiquidityClaimKeyPrefix, addr...), Value: cdc.MustMarshal(&hardClaim)},
+			{Key: append(types.SwapClaimKeyPrefix, addr...), Value: cdc.MustMarshal(&swapClaim)},
+			{Key: append(types.USDXMintingRewardFactorKeyPrefix, []byte("bnb-a")...), Value: factorBz},
+			{Key: append(types.HardSupplyRewardIndexesKeyPrefix, []byte("bnb")...), Value: cdc.MustMarshal(&types.RewardIndexesProto{RewardIndexes: indexes})},
+			{Key: append(types.PreviousEarnRewardAccrualTimeKeyPrefix, []byte("usdx")...), Value: accrualTimeBz},
+			{Key: types.PreviousBlockTimeKey, Value: accrualTimeBz},
+			{Key: append(types.DistributedRewardsKeyPrefix, types.DistributedRewardsKey("hard_supply", "bnb")...), Value: cdc.MustMarshal(&distributed)},
+			{Key: append(types.RewardIndexesSnapshotKeyPrefix, types.RewardIndexesSnapshotKey(600)...), Value: cdc.MustMarshal(&snapshot)},
+			{Key: append(types.DelegatorValidatorCheckpointKeyPrefix, types.DelegatorValidatorCheckpointKey(valAddr, 5)...), Value: cdc.MustMarshal(&checkpoint)},
+			{Key: types.NextDelegatorCheckpointIDKey, Value: sdk.Uint64ToBigEndian(6)},
+			{Key: append(types.DelegatorClaimCheckpointIDKeyPrefix, addr...), Value: sdk.Uint64ToBigEndian(5)},
+			{Key: append(types.DelegatorClaimCheckpointIDIndexPrefix, types.DelegatorClaimCheckpointIDIndexKey(5, addr)...), Value: sdk.Uint64ToBigEndian(5)},
+			{Key: append(types.DelegatorValidatorCheckpointIDIndexPrefix, types.DelegatorValidatorCheckpointIDIndexKey(5, valAddr)...), Value: sdk.Uint64ToBigEndian(5)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"USDXMintingClaim", fmt.Sprintf("%v\n%v", usdxClaim, usdxClaim)},
+		{"HardLiquidityProviderClaim", fmt.Sprintf("%v\n%v", hardClaim, hardClaim)},
+		{"SwapClaim", fmt.Sprintf("%v\n%v", swapClaim, swapClaim)},
+		{"USDXMintingRewardFactor", fmt.Sprintf("%s\n%s", factor, factor)},
+		{"RewardIndexes", fmt.Sprintf("%v\n%v", indexes, indexes)},
+		{"PreviousAccrualTime", fmt.Sprintf("%s\n%s", accrualTime, accrualTime)},
+		{"PreviousBlockTime", fmt.Sprintf("%s\n%s", accrualTime, accrualTime)},
+		{"DistributedRewards", fmt.Sprintf("%v\n%v", distributed, distributed)},
+		{"RewardIndexesSnapshot", fmt.Sprintf("%v\n%v", snapshot, snapshot)},
+		{"DelegatorValidatorCheckpoint", fmt.Sprintf("%v\n%v", checkpoint, checkpoint)},
+		{"NextDelegatorCheckpointID", "6\n6"},
+		{"DelegatorClaimCheckpointID", "5\n5"},
+		{"DelegatorClaimCheckpointIDIndex", "5\n5"},
+		{"DelegatorValidatorCheckpointIDIndex", "5\n5"},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
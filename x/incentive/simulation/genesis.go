@@ -0,0 +1,77 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// rewardDenoms are the denoms simulated reward periods pay out and claim multipliers are generated for.
+var rewardDenoms = []string{"ukava", "hard", "swp"}
+
+// RandomizedGenState generates a random GenesisState for incentive.
+//
+// Delegator rewards are the only rewards paid out at genesis, as delegations are
+// the only reward source the other simulated modules create. Claims are created
+// by the staking hooks as delegations are made.
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.DefaultParams()
+	params.ClaimMultipliers = RandomMultipliers(simState.Rand)
+	params.ClaimEnd = simState.GenTimestamp.Add(time.Duration(simtypes.RandIntBetween(simState.Rand, 1, 365*24)) * time.Hour)
+	params.DelegatorRewardPeriods = types.MultiRewardPeriods{
+		types.NewMultiRewardPeriod(
+			true,
+			types.BondDenom,
+			simState.GenTimestamp,
+			params.ClaimEnd,
+			RandomRewardsPerSecond(simState.Rand),
+		),
+	}
+
+	incentiveGenesis := types.DefaultGenesisState()
+	incentiveGenesis.Params = params
+
+	bz, err := json.MarshalIndent(incentiveGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, bz)
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&incentiveGenesis)
+}
+
+// RandomMultipliers returns claim multipliers for every reward denom with random lockups and factors.
+func RandomMultipliers(r *rand.Rand) types.MultipliersPerDenoms {
+	var multipliers types.MultipliersPerDenoms
+	for _, denom := range rewardDenoms {
+		multipliers = append(multipliers, types.MultipliersPerDenom{
+			Denom: denom,
+			Multipliers: types.Multipliers{
+				types.NewMultiplier("small", int64(simtypes.RandIntBetween(r, 0, 2)), sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 50)), 2)),
+				types.NewMultiplier("large", int64(simtypes.RandIntBetween(r, 6, 13)), sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 50, 101)), 2)),
+			},
+		})
+	}
+	return multipliers
+}
+
+// RandomRewardsPerSecond returns a random reward rate in a random subset of the reward denoms.
+func RandomRewardsPerSecond(r *rand.Rand) sdk.Coins {
+	var rewards sdk.Coins
+	for _, denom := range rewardDenoms {
+		if r.Intn(2) == 0 {
+			rewards = rewards.Add(sdk.NewInt64Coin(denom, int64(simtypes.RandIntBetween(r, 1, 1_000_000))))
+		}
+	}
+	if rewards.Empty() {
+		rewards = sdk.NewCoins(sdk.NewInt64Coin(rewardDenoms[0], int64(simtypes.RandIntBetween(r, 1, 1_000_000))))
+	}
+	return rewards
+}
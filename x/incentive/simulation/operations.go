@@ -0,0 +1,290 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/kava-labs/kava/x/incentive/keeper"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgClaimUSDXMintingReward = "op_weight_msg_claim_usdx_minting_reward" //nolint:gosec
+	OpWeightMsgClaimHardReward        = "op_weight_msg_claim_hard_reward"         //nolint:gosec
+	OpWeightMsgClaimDelegatorReward   = "op_weight_msg_claim_delegator_reward"    //nolint:gosec
+	OpWeightMsgClaimSwapReward        = "op_weight_msg_claim_swap_reward"         //nolint:gosec
+	OpWeightMsgClaimSavingsReward     = "op_weight_msg_claim_savings_reward"      //nolint:gosec
+	OpWeightMsgClaimEarnReward        = "op_weight_msg_claim_earn_reward"         //nolint:gosec
+	OpWeightSynchronizeClaims         = "op_weight_synchronize_claims"            //nolint:gosec
+
+	DefaultWeightMsgClaimReward    = 20
+	DefaultWeightSynchronizeClaims = 50
+
+	// OpSynchronizeClaims names the simulated claim synchronization, which is
+	// not a msg, as claims are synchronized by the hooks of other modules.
+	OpSynchronizeClaims = "synchronize_claims"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, k keeper.Keeper, bk types.BankKeeper, ak types.AccountKeeper,
+) simulation.WeightedOperations {
+	var weightOps simulation.WeightedOperations
+	for _, ct := range claimTypes(k) {
+		var weight int
+		appParams.GetOrGenerate(cdc, ct.opWeightName, &weight, nil,
+			func(_ *rand.Rand) {
+				weight = DefaultWeightMsgClaimReward
+			},
+		)
+		weightOps = append(weightOps, simulation.NewWeightedOperation(weight, SimulateMsgClaimReward(k, bk, ak, ct)))
+	}
+
+	var weightSynchronizeClaims int
+	appParams.GetOrGenerate(cdc, OpWeightSynchronizeClaims, &weightSynchronizeClaims, nil,
+		func(_ *rand.Rand) {
+			weightSynchronizeClaims = DefaultWeightSynchronizeClaims
+		},
+	)
+
+	return append(weightOps, simulation.NewWeightedOperation(weightSynchronizeClaims, SimulateSynchronizeClaims(k)))
+}
+
+// ClaimType groups the functions needed to simulate claiming the rewards of one type of claim.
+type ClaimType struct {
+	opWeightName string
+	msgType      string
+
+	// syncedRewards returns the rewards of an owner's claim synchronized to the current block, without storing them.
+	syncedRewards func(ctx sdk.Context, owner sdk.AccAddress) (sdk.Coins, bool)
+	// claim delivers a claim msg for a single reward denom.
+	claim func(ctx sdk.Context, sender, receiver sdk.AccAddress, selection types.Selection) error
+}
+
+// claimTypes returns the simulated claim types, one for each claim msg.
+func claimTypes(k keeper.Keeper) []ClaimType {
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	return []ClaimType{
+		{
+			opWeightName: OpWeightMsgClaimUSDXMintingReward,
+			msgType:      sdk.MsgTypeURL(&types.MsgClaimUSDXMintingReward{}),
+			syncedRewards: func(ctx sdk.Context, owner sdk.AccAddress) (sdk.Coins, bool) {
+				claim, found := k.GetUSDXMintingClaim(ctx, owner)
+				if !found {
+					return nil, false
+				}
+				return sdk.NewCoins(k.SimulateUSDXMintingSynchronization(ctx, claim).Reward), true
+			},
+			claim: func(ctx sdk.Context, sender, receiver sdk.AccAddress, selection types.Selection) error {
+				msg := types.NewMsgClaimUSDXMintingReward(sender.String(), selection.MultiplierName)
+				msg.Receiver = receiver.String()
+				_, err := msgServer.ClaimUSDXMintingReward(sdk.WrapSDKContext(ctx), &msg)
+				return err
+			},
+		},
+		{
+			opWeightName: OpWeightMsgClaimHardReward,
+			msgType:      sdk.MsgTypeURL(&types.MsgClaimHardReward{}),
+			syncedRewards: func(ctx sdk.Context, owner sdk.AccAddress) (sdk.Coins, bool) {
+				claim, found := k.GetHardLiquidityProviderClaim(ctx, owner)
+				if !found {
+					return nil, false
+				}
+				return k.SimulateHardSynchronization(ctx, claim).Reward, true
+			},
+			claim: func(ctx sdk.Context, sender, receiver sdk.AccAddress, selection types.Selection) error {
+				msg := types.NewMsgClaimHardReward(sender.String(), types.Selections{selection})
+				msg.Receiver = receiver.String()
+				_, err := msgServer.ClaimHardReward(sdk.WrapSDKContext(ctx), &msg)
+				return err
+			},
+		},
+		{
+			opWeightName: OpWeightMsgClaimDelegatorReward,
+			msgType:      sdk.MsgTypeURL(&types.MsgClaimDelegatorReward{}),
+			syncedRewards: func(ctx sdk.Context, owner sdk.AccAddress) (sdk.Coins, bool) {
+				claim, found := k.GetDelegatorClaim(ctx, owner)
+				if !found {
+					return nil, false
+				}
+				return k.SimulateDelegatorSynchronization(ctx, claim).Reward, true
+			},
+			claim: func(ctx sdk.Context, sender, receiver sdk.AccAddress, selection types.Selection) error {
+				msg := types.NewMsgClaimDelegatorReward(sender.String(), types.Selections{selection})
+				msg.Receiver = receiver.String()
+				_, err := msgServer.ClaimDelegatorReward(sdk.WrapSDKContext(ctx), &msg)
+				return err
+			},
+		},
+		{
+			opWeightName: OpWeightMsgClaimSwapReward,
+			msgType:      sdk.MsgTypeURL(&types.MsgClaimSwapReward{}),
+			syncedRewards: func(ctx sdk.Context, owner sdk.AccAddress) (sdk.Coins, bool) {
+				claim, found := k.GetSynchronizedSwapClaim(ctx, owner)
+				return claim.Reward, found
+			},
+			claim: func(ctx sdk.Context, sender, receiver sdk.AccAddress, selection types.Selection) error {
+				msg := types.NewMsgClaimSwapReward(sender.String(), types.Selections{selection})
+				msg.Receiver = receiver.String()
+				_, err := msgServer.ClaimSwapReward(sdk.WrapSDKContext(ctx), &msg)
+				return err
+			},
+		},
+		{
+			opWeightName: OpWeightMsgClaimSavingsReward,
+			msgType:      sdk.MsgTypeURL(&types.MsgClaimSavingsReward{}),
+			syncedRewards: func(ctx sdk.Context, owner sdk.AccAddress) (sdk.Coins, bool) {
+				claim, found := k.GetSynchronizedSavingsClaim(ctx, owner)
+				return claim.Reward, found
+			},
+			claim: func(ctx sdk.Context, sender, receiver sdk.AccAddress, selection types.Selection) error {
+				msg := types.NewMsgClaimSavingsReward(sender.String(), types.Selections{selection})
+				msg.Receiver = receiver.String()
+				_, err := msgServer.ClaimSavingsReward(sdk.WrapSDKContext(ctx), &msg)
+				return err
+			},
+		},
+		{
+			opWeightName: OpWeightMsgClaimEarnReward,
+			msgType:      sdk.MsgTypeURL(&types.MsgClaimEarnReward{}),
+			syncedRewards: func(ctx sdk.Context, owner sdk.AccAddress) (sdk.Coins, bool) {
+				claim, found := k.GetSynchronizedEarnClaim(ctx, owner)
+				return claim.Reward, found
+			},
+			claim: func(ctx sdk.Context, sender, receiver sdk.AccAddress, selection types.Selection) error {
+				msg := types.NewMsgClaimEarnReward(sender.String(), types.Selections{selection})
+				msg.Receiver = receiver.String()
+				_, err := msgServer.ClaimEarnReward(sdk.WrapSDKContext(ctx), &msg)
+				return err
+			},
+		},
+	}
+}
+
+// SimulateMsgClaimReward claims a random reward denom of a random account's claim of one claim type, paying out to
+// a random account. After claiming, synchronizing the claim again must not find any more rewards of the claimed
+// denom in the same block, which would mean the claim was left with stale reward indexes.
+func SimulateMsgClaimReward(k keeper.Keeper, bk types.BankKeeper, ak types.AccountKeeper, ct ClaimType) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		sender, _ := simtypes.RandomAcc(r, accs)
+		receiver, _ := simtypes.RandomAcc(r, accs)
+
+		if ctx.BlockTime().After(k.GetClaimEnd(ctx)) {
+			return simtypes.NoOpMsg(types.ModuleName, ct.msgType, "claim period ended"), nil, nil
+		}
+
+		rewards, found := ct.syncedRewards(ctx, sender.Address)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, ct.msgType, "no claim"), nil, nil
+		}
+		rewards = rewards.Sort()
+		if rewards.IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, ct.msgType, "no rewards"), nil, nil
+		}
+		reward := rewards[r.Intn(len(rewards))]
+
+		multipliers := claimMultipliers(k.GetParams(ctx), reward.Denom)
+		if len(multipliers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, ct.msgType, "no claim multipliers"), nil, nil
+		}
+		multiplier := multipliers[r.Intn(len(multipliers))]
+
+		payout := sdk.NewDecFromInt(reward.Amount).Mul(multiplier.Factor).RoundInt()
+		if payout.IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, ct.msgType, "zero payout"), nil, nil
+		}
+		incentiveMacc := ak.GetModuleAccount(ctx, types.IncentiveMacc)
+		if bk.GetAllBalances(ctx, incentiveMacc.GetAddress()).AmountOf(reward.Denom).LT(payout) {
+			return simtypes.NoOpMsg(types.ModuleName, ct.msgType, "insufficient reward funds"), nil, nil
+		}
+
+		if err := ct.claim(ctx, sender.Address, receiver.Address, types.NewSelection(reward.Denom, multiplier.Name)); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, ct.msgType, "unable to claim"), nil, err
+		}
+
+		rewardsAfter, _ := ct.syncedRewards(ctx, sender.Address)
+		if !rewardsAfter.AmountOf(reward.Denom).IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, ct.msgType, "rewards remain after claim"), nil,
+				fmt.Errorf("expected no %s rewards for %s after claiming, got %s", reward.Denom, sender.Address, rewardsAfter)
+		}
+
+		return simtypes.NewOperationMsgBasic(types.ModuleName, ct.msgType, "", true, nil), nil, nil
+	}
+}
+
+// SimulateSynchronizeClaims synchronizes the claims of a random account twice, as the hooks of other modules do
+// before the account's source shares change. The second synchronization in the same block must not change the
+// claims, as they already hold the current global reward indexes.
+func SimulateSynchronizeClaims(k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		acc, _ := simtypes.RandomAcc(r, accs)
+
+		synced := false
+		for _, synchronize := range []func() (fmt.Stringer, bool){
+			func() (fmt.Stringer, bool) {
+				claim, found := k.GetUSDXMintingClaim(ctx, acc.Address)
+				if !found {
+					return nil, false
+				}
+				claim, _ = k.SynchronizeUSDXMintingClaim(ctx, claim)
+				return &claim, true
+			},
+			func() (fmt.Stringer, bool) {
+				k.SynchronizeHardLiquidityProviderClaim(ctx, acc.Address)
+				claim, found := k.GetHardLiquidityProviderClaim(ctx, acc.Address)
+				return &claim, found
+			},
+			func() (fmt.Stringer, bool) {
+				claim, found := k.GetDelegatorClaim(ctx, acc.Address)
+				if !found {
+					return nil, false
+				}
+				claim, _ = k.SynchronizeDelegatorClaim(ctx, claim)
+				return &claim, true
+			},
+			func() (fmt.Stringer, bool) {
+				k.SynchronizeSavingsClaim(ctx, acc.Address)
+				claim, found := k.GetSavingsClaim(ctx, acc.Address)
+				return &claim, found
+			},
+		} {
+			first, found := synchronize()
+			if !found {
+				continue
+			}
+			second, _ := synchronize()
+			if first.String() != second.String() {
+				return simtypes.NoOpMsg(types.ModuleName, OpSynchronizeClaims, "claim changed on resync"), nil,
+					fmt.Errorf("expected claim to be unchanged when synchronized again in the same block, got %s then %s", first, second)
+			}
+			synced = true
+		}
+
+		if !synced {
+			return simtypes.NoOpMsg(types.ModuleName, OpSynchronizeClaims, "no claims"), nil, nil
+		}
+		return simtypes.NewOperationMsgBasic(types.ModuleName, OpSynchronizeClaims, "", true, nil), nil, nil
+	}
+}
+
+// claimMultipliers returns the claim multipliers of a reward denom.
+func claimMultipliers(params types.Params, denom string) types.Multipliers {
+	for _, dm := range params.ClaimMultipliers {
+		if dm.Denom == denom {
+			return dm.Multipliers
+		}
+	}
+	return nil
+}
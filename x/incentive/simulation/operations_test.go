@@ -0,0 +1,113 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/incentive/keeper"
+	"github.com/kava-labs/kava/x/incentive/simulation"
+	"github.com/kava-labs/kava/x/incentive/testutil"
+	kavadisttypes "github.com/kava-labs/kava/x/kavadist/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+type operationsTestSuite struct {
+	testutil.IntegrationTester
+}
+
+func TestOperationsTestSuite(t *testing.T) {
+	suite.Run(t, new(operationsTestSuite))
+}
+
+func (suite *operationsTestSuite) TestWeightedOperations() {
+	appParams := make(simtypes.AppParams)
+	tApp := suite.App
+	ops := simulation.WeightedOperations(
+		appParams, tApp.AppCodec(), tApp.GetIncentiveKeeper(), tApp.GetBankKeeper(), tApp.GetAccountKeeper(),
+	)
+
+	expected := []int{
+		simulation.DefaultWeightMsgClaimReward,
+		simulation.DefaultWeightMsgClaimReward,
+		simulation.DefaultWeightMsgClaimReward,
+		simulation.DefaultWeightMsgClaimReward,
+		simulation.DefaultWeightMsgClaimReward,
+		simulation.DefaultWeightMsgClaimReward,
+		simulation.DefaultWeightSynchronizeClaims,
+	}
+	suite.Require().Len(ops, len(expected))
+	for i, op := range ops {
+		suite.Equal(expected[i], op.Weight())
+	}
+}
+
+func (suite *operationsTestSuite) TestOperations() {
+	r := rand.New(rand.NewSource(1))
+	accs := simtypes.RandomAccounts(r, 3)
+
+	authBuilder := app.NewAuthBankGenesisBuilder().
+		WithSimpleModuleAccount(kavadisttypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("hard", 1e15), sdk.NewInt64Coin("swp", 1e15)))
+	for _, acc := range accs {
+		authBuilder.WithSimpleAccount(acc.Address, sdk.NewCoins(sdk.NewInt64Coin("busd", 1e12), sdk.NewInt64Coin("ukava", 1e12)))
+	}
+	incentiveBuilder := testutil.NewIncentiveGenesisBuilder().
+		WithGenesisTime(suite.GenesisTime).
+		WithMultipliers(simulation.RandomMultipliers(r)).
+		WithSimpleSwapRewardPeriod("busd:ukava", sdk.NewCoins(sdk.NewInt64Coin("hard", 1e6), sdk.NewInt64Coin("swp", 1e6)))
+	swapGenesis := swaptypes.NewGenesisState(
+		swaptypes.NewParams(swaptypes.NewAllowedPools(swaptypes.NewAllowedPool("busd", "ukava")), sdk.ZeroDec(), swaptypes.DefaultTwapMaxWindowSeconds),
+		swaptypes.DefaultPoolRecords,
+		swaptypes.DefaultShareRecords,
+		swaptypes.DefaultLimitOrders,
+		swaptypes.DefaultNextLimitOrderID,
+	)
+
+	suite.StartChain(
+		authBuilder.BuildMarshalled(suite.App.AppCodec()),
+		incentiveBuilder.BuildMarshalled(suite.App.AppCodec()),
+		app.GenesisState{swaptypes.ModuleName: suite.App.AppCodec().MustMarshalJSON(&swapGenesis)},
+	)
+
+	for _, acc := range accs {
+		err := suite.DeliverSwapMsgDeposit(acc.Address, sdk.NewInt64Coin("busd", 1e9), sdk.NewInt64Coin("ukava", 1e9), sdk.OneDec())
+		suite.Require().NoError(err)
+	}
+
+	k := suite.App.GetIncentiveKeeper()
+	claimOps := simulation.WeightedOperations(
+		make(simtypes.AppParams), suite.App.AppCodec(), k, suite.App.GetBankKeeper(), suite.App.GetAccountKeeper(),
+	)
+
+	executed := 0
+	for i := 0; i < 300; i++ {
+		if r.Intn(3) == 0 {
+			// accumulate rewards
+			suite.NextBlockAfter(time.Duration(simtypes.RandIntBetween(r, 1, 600)) * time.Second)
+		}
+
+		op := claimOps[r.Intn(len(claimOps))].Op()
+		opMsg, futureOps, err := op(r, suite.App.BaseApp, suite.Ctx, accs, suite.Ctx.ChainID())
+		suite.Require().NoError(err)
+		suite.Require().Len(futureOps, 0)
+		if opMsg.OK {
+			executed++
+		}
+
+		for _, invariant := range []sdk.Invariant{
+			keeper.ClaimsInvariant(k),
+			keeper.RewardIndexesInvariant(k),
+			keeper.ClaimIndexesInvariant(k),
+		} {
+			msg, broken := invariant(suite.Ctx)
+			suite.Require().False(broken, msg)
+		}
+	}
+	suite.Greater(executed, 0)
+}
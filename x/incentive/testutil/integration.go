@@ -152,6 +152,8 @@ func (suite *IntegrationTester) DeliverIncentiveMsg(msg sdk.Msg) error {
 		_, err = msgServer.ClaimDelegatorReward(sdk.WrapSDKContext(suite.Ctx), msg)
 	case *types.MsgClaimEarnReward:
 		_, err = msgServer.ClaimEarnReward(sdk.WrapSDKContext(suite.Ctx), msg)
+	case *types.MsgClaimAll:
+		_, err = msgServer.ClaimAll(sdk.WrapSDKContext(suite.Ctx), msg)
 	default:
 		panic("unhandled incentive msg")
 	}
@@ -243,6 +245,14 @@ func (suite *IntegrationTester) DeliverSwapMsgDeposit(depositor sdk.AccAddress,
 	return err
 }
 
+func (suite *IntegrationTester) DeliverSwapMsgTransferShares(sender, recipient sdk.AccAddress, poolID string, shares sdkmath.Int) error {
+	msg := swaptypes.NewMsgTransferShares(sender.String(), recipient.String(), poolID, shares)
+	msgServer := swapkeeper.NewMsgServerImpl(suite.App.GetSwapKeeper())
+	_, err := msgServer.TransferShares(sdk.WrapSDKContext(suite.Ctx), msg)
+
+	return err
+}
+
 func (suite *IntegrationTester) DeliverHardMsgDeposit(owner sdk.AccAddress, deposit sdk.Coins) error {
 	msg := hardtypes.NewMsgDeposit(owner, deposit)
 	msgServer := hardkeeper.NewMsgServerImpl(suite.App.GetHardKeeper())
@@ -580,7 +590,9 @@ func (suite *IntegrationTester) GetBeginBlockClaimedStakingRewards(
 			amount = amount.Add(parsedAmt)
 		}
 
-		blockRewardsClaimed[validator] = amount
+		// Restaking rewards modifies the delegation and withdraws again, so
+		// there can be multiple events for a validator.
+		blockRewardsClaimed[validator] = blockRewardsClaimed[validator].Add(amount...)
 	}
 
 	totalClaimedRewards := sdk.NewCoins()
@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SourceShares is the amount of shares an owner has in a reward source, such as a collateral type or a pool.
+type SourceShares struct {
+	ID     string
+	Shares sdk.Dec
+}
+
+// SourceAdapter reads the source shares of positions in another module, so rewards can be distributed to their owners.
+type SourceAdapter interface {
+	// OwnerSharesBySource returns the shares an owner has in each of the sources, in the same order as the source IDs.
+	// Sources the owner has no position in have zero shares.
+	OwnerSharesBySource(ctx sdk.Context, owner sdk.AccAddress, sourceIDs []string) []SourceShares
+	// TotalSharesBySource returns the sum of all owners' shares in a source.
+	TotalSharesBySource(ctx sdk.Context, sourceID string) sdk.Dec
+}
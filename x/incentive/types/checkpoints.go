@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDelegatorValidatorCheckpoint returns a new DelegatorValidatorCheckpoint
+func NewDelegatorValidatorCheckpoint(
+	indexes RewardIndexes, bonded bool, tokens sdkmath.Int, delegatorShares sdk.Dec,
+) DelegatorValidatorCheckpoint {
+	return DelegatorValidatorCheckpoint{
+		RewardIndexes:   indexes,
+		Bonded:          bonded,
+		Tokens:          tokens,
+		DelegatorShares: delegatorShares,
+	}
+}
+
+// TokensFromShares returns the tokens delegation shares in the validator were rewarded for over the checkpoint's period.
+// Delegations to a validator that was not bonded are not rewarded.
+func (cp DelegatorValidatorCheckpoint) TokensFromShares(shares sdk.Dec) sdk.Dec {
+	if !cp.Bonded || cp.Tokens.IsZero() || cp.DelegatorShares.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	tokens := shares.MulInt(cp.Tokens).Quo(cp.DelegatorShares)
+	if tokens.IsNegative() {
+		return sdk.ZeroDec()
+	}
+	return tokens
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelegatorValidatorCheckpoint_TokensFromShares(t *testing.T) {
+	indexes := RewardIndexes{NewRewardIndex("hard", d("0.1"))}
+
+	testCases := []struct {
+		name       string
+		checkpoint DelegatorValidatorCheckpoint
+		shares     sdk.Dec
+		expected   sdk.Dec
+	}{
+		{
+			"bonded validator converts shares at the validator's exchange rate",
+			NewDelegatorValidatorCheckpoint(indexes, true, sdk.NewInt(500), d("1000")),
+			d("100"),
+			d("50"),
+		},
+		{
+			"not bonded validator is not rewarded",
+			NewDelegatorValidatorCheckpoint(indexes, false, sdk.NewInt(1000), d("1000")),
+			d("100"),
+			sdk.ZeroDec(),
+		},
+		{
+			"validator without tokens is not rewarded",
+			NewDelegatorValidatorCheckpoint(indexes, true, sdk.ZeroInt(), d("1000")),
+			d("100"),
+			sdk.ZeroDec(),
+		},
+		{
+			"validator without shares is not rewarded",
+			NewDelegatorValidatorCheckpoint(indexes, true, sdk.NewInt(1000), sdk.ZeroDec()),
+			d("100"),
+			sdk.ZeroDec(),
+		},
+		{
+			"negative shares are not rewarded",
+			NewDelegatorValidatorCheckpoint(indexes, true, sdk.NewInt(1000), d("1000")),
+			d("-100"),
+			sdk.ZeroDec(),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.checkpoint.TokensFromShares(tc.shares))
+		})
+	}
+}
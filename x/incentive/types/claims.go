@@ -510,6 +510,39 @@ func (ris RewardIndexes) Add(addend RewardIndexes) RewardIndexes {
 	return newIndexes
 }
 
+// Exceeds returns true if any reward factor is greater than the factor of the same CollateralType in other.
+// CollateralTypes missing from other are compared against a factor of zero.
+func (ris RewardIndexes) Exceeds(other RewardIndexes) bool {
+	for _, ri := range ris {
+		otherFactor, found := other.Get(ri.CollateralType)
+		if !found {
+			otherFactor = sdk.ZeroDec()
+		}
+		if ri.RewardFactor.GT(otherFactor) {
+			return true
+		}
+	}
+	return false
+}
+
+// Min combines two reward indexes by taking the smaller factor of those with the same CollateralType.
+// Any CollateralTypes unique to either reward indexes are included in the output as is.
+func (ris RewardIndexes) Min(other RewardIndexes) RewardIndexes {
+	newIndexes := ris.copy()
+
+	for _, otherRi := range other {
+		i, found := newIndexes.GetFactorIndex(otherRi.CollateralType)
+		if !found {
+			newIndexes = append(newIndexes, otherRi)
+			continue
+		}
+		if otherRi.RewardFactor.LT(newIndexes[i].RewardFactor) {
+			newIndexes[i].RewardFactor = otherRi.RewardFactor
+		}
+	}
+	return newIndexes
+}
+
 // copy returns a copy of the reward indexes slice and underlying array
 func (ris RewardIndexes) copy() RewardIndexes {
 	if ris == nil { // return nil rather than empty slice when ris is nil
@@ -628,6 +661,33 @@ func (mris MultiRewardIndexes) Validate() error {
 	return nil
 }
 
+// Exceeds returns true if any reward factor is greater than the factor of the same CollateralType in other.
+func (mris MultiRewardIndexes) Exceeds(other MultiRewardIndexes) bool {
+	for _, mri := range mris {
+		otherIndexes, _ := other.Get(mri.CollateralType)
+		if mri.RewardIndexes.Exceeds(otherIndexes) {
+			return true
+		}
+	}
+	return false
+}
+
+// Min combines two multi reward indexes by taking the smaller factors of the reward indexes with the same
+// CollateralType. Any CollateralTypes unique to either multi reward indexes are included in the output as is.
+func (mris MultiRewardIndexes) Min(other MultiRewardIndexes) MultiRewardIndexes {
+	newIndexes := mris.copy()
+
+	for _, otherMri := range other {
+		indexes, found := newIndexes.Get(otherMri.CollateralType)
+		if !found {
+			newIndexes = append(newIndexes, otherMri)
+			continue
+		}
+		newIndexes = newIndexes.With(otherMri.CollateralType, indexes.Min(otherMri.RewardIndexes))
+	}
+	return newIndexes
+}
+
 // copy returns a copy of the slice and underlying array
 func (mris MultiRewardIndexes) copy() MultiRewardIndexes {
 	newIndexes := make(MultiRewardIndexes, len(mris))
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -258,6 +259,97 @@ func (m *MultiRewardIndexesProto) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MultiRewardIndexesProto proto.InternalMessageInfo
 
+// RewardIndexesSnapshot stores the global reward indexes of every reward source at a block height.
+type RewardIndexesSnapshot struct {
+	Height                   int64              `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	USDXMintingRewardIndexes RewardIndexes      `protobuf:"bytes,2,rep,name=usdx_minting_reward_indexes,json=usdxMintingRewardIndexes,proto3,castrepeated=RewardIndexes" json:"usdx_minting_reward_indexes"`
+	HardSupplyRewardIndexes  MultiRewardIndexes `protobuf:"bytes,3,rep,name=hard_supply_reward_indexes,json=hardSupplyRewardIndexes,proto3,castrepeated=MultiRewardIndexes" json:"hard_supply_reward_indexes"`
+	HardBorrowRewardIndexes  MultiRewardIndexes `protobuf:"bytes,4,rep,name=hard_borrow_reward_indexes,json=hardBorrowRewardIndexes,proto3,castrepeated=MultiRewardIndexes" json:"hard_borrow_reward_indexes"`
+	DelegatorRewardIndexes   MultiRewardIndexes `protobuf:"bytes,5,rep,name=delegator_reward_indexes,json=delegatorRewardIndexes,proto3,castrepeated=MultiRewardIndexes" json:"delegator_reward_indexes"`
+	SwapRewardIndexes        MultiRewardIndexes `protobuf:"bytes,6,rep,name=swap_reward_indexes,json=swapRewardIndexes,proto3,castrepeated=MultiRewardIndexes" json:"swap_reward_indexes"`
+	SavingsRewardIndexes     MultiRewardIndexes `protobuf:"bytes,7,rep,name=savings_reward_indexes,json=savingsRewardIndexes,proto3,castrepeated=MultiRewardIndexes" json:"savings_reward_indexes"`
+	EarnRewardIndexes        MultiRewardIndexes `protobuf:"bytes,8,rep,name=earn_reward_indexes,json=earnRewardIndexes,proto3,castrepeated=MultiRewardIndexes" json:"earn_reward_indexes"`
+}
+
+func (m *RewardIndexesSnapshot) Reset()         { *m = RewardIndexesSnapshot{} }
+func (m *RewardIndexesSnapshot) String() string { return proto.CompactTextString(m) }
+func (*RewardIndexesSnapshot) ProtoMessage()    {}
+func (*RewardIndexesSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f7515029623a895, []int{6}
+}
+func (m *RewardIndexesSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardIndexesSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardIndexesSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardIndexesSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardIndexesSnapshot.Merge(m, src)
+}
+func (m *RewardIndexesSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardIndexesSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardIndexesSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardIndexesSnapshot proto.InternalMessageInfo
+
+// DelegatorValidatorCheckpoint records a validator's state over a period of delegator reward accumulation, which ended
+// when the validator changed bonded status or was slashed. Delegator claims are synchronized over each period when they
+// are next accessed.
+type DelegatorValidatorCheckpoint struct {
+	// reward_indexes are the global delegator reward indexes at the end of the period
+	RewardIndexes RewardIndexes `protobuf:"bytes,1,rep,name=reward_indexes,json=rewardIndexes,proto3,castrepeated=RewardIndexes" json:"reward_indexes"`
+	// bonded is whether delegations to the validator were rewarded over the period
+	Bonded bool `protobuf:"varint,2,opt,name=bonded,proto3" json:"bonded,omitempty"`
+	// tokens and delegator_shares are the validator's tokens and shares over the period
+	Tokens          cosmossdk_io_math.Int                  `protobuf:"bytes,3,opt,name=tokens,proto3,customtype=cosmossdk.io/math.Int" json:"tokens"`
+	DelegatorShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=delegator_shares,json=delegatorShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegator_shares"`
+}
+
+func (m *DelegatorValidatorCheckpoint) Reset()         { *m = DelegatorValidatorCheckpoint{} }
+func (m *DelegatorValidatorCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*DelegatorValidatorCheckpoint) ProtoMessage()    {}
+func (*DelegatorValidatorCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f7515029623a895, []int{7}
+}
+func (m *DelegatorValidatorCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorValidatorCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorValidatorCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorValidatorCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorValidatorCheckpoint.Merge(m, src)
+}
+func (m *DelegatorValidatorCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorValidatorCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorValidatorCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorValidatorCheckpoint proto.InternalMessageInfo
+
 // USDXMintingClaim is for USDX minting rewards
 type USDXMintingClaim struct {
 	BaseClaim     `protobuf:"bytes,1,opt,name=base_claim,json=baseClaim,proto3,embedded=base_claim" json:"base_claim"`
@@ -268,7 +360,7 @@ func (m *USDXMintingClaim) Reset()         { *m = USDXMintingClaim{} }
 func (m *USDXMintingClaim) String() string { return proto.CompactTextString(m) }
 func (*USDXMintingClaim) ProtoMessage()    {}
 func (*USDXMintingClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{6}
+	return fileDescriptor_5f7515029623a895, []int{8}
 }
 func (m *USDXMintingClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,7 +400,7 @@ func (m *HardLiquidityProviderClaim) Reset()         { *m = HardLiquidityProvide
 func (m *HardLiquidityProviderClaim) String() string { return proto.CompactTextString(m) }
 func (*HardLiquidityProviderClaim) ProtoMessage()    {}
 func (*HardLiquidityProviderClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{7}
+	return fileDescriptor_5f7515029623a895, []int{9}
 }
 func (m *HardLiquidityProviderClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +439,7 @@ func (m *DelegatorClaim) Reset()         { *m = DelegatorClaim{} }
 func (m *DelegatorClaim) String() string { return proto.CompactTextString(m) }
 func (*DelegatorClaim) ProtoMessage()    {}
 func (*DelegatorClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{8}
+	return fileDescriptor_5f7515029623a895, []int{10}
 }
 func (m *DelegatorClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +478,7 @@ func (m *SwapClaim) Reset()         { *m = SwapClaim{} }
 func (m *SwapClaim) String() string { return proto.CompactTextString(m) }
 func (*SwapClaim) ProtoMessage()    {}
 func (*SwapClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{9}
+	return fileDescriptor_5f7515029623a895, []int{11}
 }
 func (m *SwapClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,7 +517,7 @@ func (m *SavingsClaim) Reset()         { *m = SavingsClaim{} }
 func (m *SavingsClaim) String() string { return proto.CompactTextString(m) }
 func (*SavingsClaim) ProtoMessage()    {}
 func (*SavingsClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{10}
+	return fileDescriptor_5f7515029623a895, []int{12}
 }
 func (m *SavingsClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -464,7 +556,7 @@ func (m *EarnClaim) Reset()         { *m = EarnClaim{} }
 func (m *EarnClaim) String() string { return proto.CompactTextString(m) }
 func (*EarnClaim) ProtoMessage()    {}
 func (*EarnClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{11}
+	return fileDescriptor_5f7515029623a895, []int{13}
 }
 func (m *EarnClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -500,6 +592,8 @@ func init() {
 	proto.RegisterType((*RewardIndexesProto)(nil), "kava.incentive.v1beta1.RewardIndexesProto")
 	proto.RegisterType((*MultiRewardIndex)(nil), "kava.incentive.v1beta1.MultiRewardIndex")
 	proto.RegisterType((*MultiRewardIndexesProto)(nil), "kava.incentive.v1beta1.MultiRewardIndexesProto")
+	proto.RegisterType((*RewardIndexesSnapshot)(nil), "kava.incentive.v1beta1.RewardIndexesSnapshot")
+	proto.RegisterType((*DelegatorValidatorCheckpoint)(nil), "kava.incentive.v1beta1.DelegatorValidatorCheckpoint")
 	proto.RegisterType((*USDXMintingClaim)(nil), "kava.incentive.v1beta1.USDXMintingClaim")
 	proto.RegisterType((*HardLiquidityProviderClaim)(nil), "kava.incentive.v1beta1.HardLiquidityProviderClaim")
 	proto.RegisterType((*DelegatorClaim)(nil), "kava.incentive.v1beta1.DelegatorClaim")
@@ -513,51 +607,67 @@ func init() {
 }
 
 var fileDescriptor_5f7515029623a895 = []byte{
-	// 691 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4f, 0x4f, 0x13, 0x4d,
-	0x18, 0xef, 0xc0, 0x0b, 0x79, 0x3b, 0x94, 0xbe, 0x64, 0x81, 0x57, 0xe8, 0x61, 0x8b, 0x25, 0xc1,
-	0x26, 0xa6, 0xbb, 0x82, 0x07, 0x13, 0x6f, 0x2c, 0x68, 0xc0, 0x48, 0x24, 0x5b, 0x4d, 0x8c, 0x07,
-	0x9b, 0xd9, 0xdd, 0xb1, 0x4e, 0xd8, 0xee, 0xd4, 0x99, 0x6d, 0x4b, 0x3f, 0x83, 0x17, 0xfd, 0x02,
-	0x7e, 0x00, 0x2f, 0x5e, 0xf8, 0x10, 0xc4, 0x78, 0x20, 0xc6, 0xc4, 0x3f, 0x87, 0x8a, 0x70, 0xf5,
-	0x13, 0x78, 0x32, 0xf3, 0x07, 0x58, 0xa0, 0x25, 0xc4, 0x14, 0x0f, 0x9c, 0x76, 0xe7, 0x99, 0x67,
-	0x9e, 0xdf, 0x9f, 0x79, 0x76, 0x76, 0xe0, 0xec, 0x06, 0x6a, 0x22, 0x9b, 0x44, 0x3e, 0x8e, 0x62,
-	0xd2, 0xc4, 0x76, 0x73, 0xde, 0xc3, 0x31, 0x9a, 0xb7, 0xfd, 0x10, 0x91, 0x1a, 0xb7, 0xea, 0x8c,
-	0xc6, 0xd4, 0xf8, 0x5f, 0x24, 0x59, 0x87, 0x49, 0x96, 0x4e, 0xca, 0x99, 0x3e, 0xe5, 0x35, 0xca,
-	0x6d, 0x0f, 0xf1, 0xc4, 0x4a, 0x4a, 0x22, 0xb5, 0x2e, 0x37, 0xad, 0xe6, 0x2b, 0x72, 0x64, 0xab,
-	0x81, 0x9e, 0x9a, 0xa8, 0xd2, 0x2a, 0x55, 0x71, 0xf1, 0xa6, 0xa2, 0x85, 0x77, 0x00, 0xa6, 0x1d,
-	0xc4, 0xf1, 0x92, 0x40, 0x37, 0x9e, 0xc2, 0x21, 0xda, 0x8a, 0x30, 0x9b, 0x02, 0x33, 0xa0, 0x98,
-	0x71, 0x56, 0x7e, 0x75, 0xf2, 0xa5, 0x2a, 0x89, 0x9f, 0x37, 0x3c, 0xcb, 0xa7, 0x35, 0x5d, 0x4f,
-	0x3f, 0x4a, 0x3c, 0xd8, 0xb0, 0xe3, 0x76, 0x1d, 0x73, 0x6b, 0xd1, 0xf7, 0x17, 0x83, 0x80, 0x61,
-	0xce, 0x3f, 0x6e, 0x95, 0xc6, 0x35, 0xaa, 0x8e, 0x38, 0xed, 0x18, 0x73, 0x57, 0x95, 0x35, 0x6e,
-	0xc1, 0x61, 0x86, 0x5b, 0x88, 0x05, 0x53, 0x03, 0x33, 0xa0, 0x38, 0xb2, 0x30, 0x6d, 0xe9, 0x64,
-	0xa1, 0xe7, 0x40, 0xa4, 0xb5, 0x44, 0x49, 0xe4, 0xfc, 0xb3, 0xdd, 0xc9, 0xa7, 0x5c, 0x9d, 0x7e,
-	0x3b, 0xfd, 0x7e, 0xab, 0x34, 0x24, 0x39, 0x16, 0x76, 0x01, 0xcc, 0x0a, 0xc6, 0x6b, 0x8d, 0x30,
-	0x26, 0x7f, 0x87, 0xb6, 0x9f, 0xa0, 0x3d, 0x78, 0x36, 0xed, 0x1b, 0x82, 0xf6, 0xdb, 0xef, 0xf9,
-	0xe2, 0x39, 0xf0, 0xc5, 0x02, 0xde, 0x4d, 0xe2, 0x4b, 0x00, 0x47, 0x5c, 0x19, 0x5d, 0x8d, 0x02,
-	0xbc, 0x69, 0x5c, 0x83, 0xff, 0xf9, 0x34, 0x0c, 0x51, 0x8c, 0x19, 0x0a, 0x2b, 0x62, 0xb1, 0x54,
-	0x9a, 0x76, 0xb3, 0x47, 0xe1, 0x87, 0xed, 0x3a, 0x36, 0xca, 0x70, 0x54, 0x55, 0xab, 0x3c, 0x43,
-	0x7e, 0x4c, 0x99, 0xb4, 0x39, 0xe3, 0x58, 0x82, 0xd4, 0xb7, 0x4e, 0x7e, 0xee, 0x1c, 0xa4, 0x96,
-	0xb1, 0xef, 0x66, 0x54, 0x91, 0xbb, 0xb2, 0x46, 0xa1, 0x05, 0x8d, 0x04, 0x19, 0xcc, 0xd7, 0x65,
-	0x87, 0x22, 0x98, 0xd5, 0x50, 0x44, 0x85, 0xa7, 0x80, 0xf4, 0x66, 0xd6, 0xea, 0xde, 0xba, 0x56,
-	0xa2, 0x86, 0x33, 0xa9, 0x5d, 0x1a, 0x3d, 0x56, 0xd8, 0xd5, 0xe4, 0xf5, 0xb0, 0xf0, 0x06, 0xc0,
-	0x31, 0xb9, 0xcb, 0x7f, 0xe4, 0xc5, 0x69, 0x82, 0x03, 0xfd, 0x26, 0xf8, 0x1a, 0xc0, 0x2b, 0x27,
-	0x09, 0x1e, 0xf8, 0xd3, 0x84, 0x13, 0x35, 0x31, 0x55, 0xe9, 0xea, 0x52, 0xb1, 0x17, 0x89, 0x93,
-	0xe5, 0x9c, 0x9c, 0x66, 0x62, 0x9c, 0x06, 0x72, 0x8d, 0xda, 0xa9, 0x58, 0xe1, 0x03, 0x80, 0x63,
-	0x8f, 0xca, 0xcb, 0x8f, 0xd7, 0x48, 0x14, 0x93, 0xa8, 0xaa, 0x3e, 0x90, 0x7b, 0x10, 0x8a, 0x56,
-	0xad, 0xc8, 0x33, 0x46, 0xfa, 0x35, 0xb2, 0x70, 0xb5, 0x17, 0x85, 0xc3, 0xe3, 0xc0, 0xf9, 0x57,
-	0x60, 0xef, 0x74, 0xf2, 0xc0, 0x4d, 0x7b, 0x87, 0x67, 0xc4, 0xc5, 0xfb, 0x9a, 0xfc, 0x14, 0x7e,
-	0x0e, 0xc0, 0xdc, 0x0a, 0x62, 0xc1, 0x7d, 0xf2, 0xa2, 0x41, 0x02, 0x12, 0xb7, 0xd7, 0x19, 0x6d,
-	0x92, 0x00, 0x33, 0x45, 0xe6, 0x41, 0x17, 0x61, 0x73, 0x67, 0x09, 0x3b, 0x3a, 0x35, 0xba, 0xab,
-	0xdb, 0x84, 0x93, 0xbc, 0x51, 0xaf, 0x87, 0xed, 0x4a, 0x57, 0x91, 0xfd, 0xd9, 0xb7, 0x71, 0x05,
-	0x71, 0x2c, 0x28, 0x90, 0x3d, 0xca, 0x18, 0x6d, 0x9d, 0x44, 0x1e, 0xec, 0x27, 0xb2, 0x82, 0x70,
-	0x7b, 0xd9, 0xfd, 0x15, 0xc0, 0xec, 0x32, 0x0e, 0x71, 0x15, 0xc5, 0xf4, 0xa2, 0x2c, 0xde, 0xe8,
-	0xd1, 0x40, 0xfd, 0x51, 0xd8, 0xbb, 0x95, 0x3e, 0x01, 0x98, 0x2e, 0xb7, 0x50, 0xfd, 0x92, 0xc9,
-	0xfa, 0x0c, 0x60, 0xa6, 0x8c, 0x9a, 0x24, 0xaa, 0xf2, 0x4b, 0xb8, 0x61, 0x77, 0x10, 0x8b, 0x2e,
-	0x97, 0x2c, 0x67, 0x75, 0xfb, 0x87, 0x99, 0xda, 0xde, 0x33, 0xc1, 0xce, 0x9e, 0x09, 0x76, 0xf7,
-	0x4c, 0xf0, 0x6a, 0xdf, 0x4c, 0xed, 0xec, 0x9b, 0xa9, 0x2f, 0xfb, 0x66, 0xea, 0xc9, 0xf5, 0xc4,
-	0x3f, 0x5a, 0xf0, 0x28, 0x85, 0xc8, 0xe3, 0xf2, 0xcd, 0xde, 0x4c, 0xdc, 0x1a, 0xe5, 0xcf, 0xda,
-	0x1b, 0x96, 0x97, 0xb8, 0x9b, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x88, 0xd7, 0x8a, 0x4f, 0x54,
-	0x0a, 0x00, 0x00,
+	// 950 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x97, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0x33, 0xe9, 0x36, 0xdb, 0x4c, 0x7f, 0x6c, 0x71, 0x9b, 0x6e, 0x36, 0xa0, 0x64, 0xc9,
+	0x4a, 0x4b, 0xa4, 0x55, 0x1c, 0xba, 0x1c, 0x90, 0xb8, 0xad, 0x5b, 0xd0, 0x16, 0xb1, 0x62, 0xe5,
+	0x00, 0x02, 0x0e, 0x44, 0x63, 0x7b, 0x48, 0x46, 0x71, 0x3c, 0x66, 0x66, 0xf2, 0x8b, 0x03, 0x12,
+	0x67, 0x2e, 0x70, 0xe0, 0xca, 0x1f, 0xc0, 0x85, 0x4b, 0x25, 0xc4, 0x7f, 0x50, 0x21, 0x0e, 0xd5,
+	0x0a, 0x89, 0x1f, 0x87, 0xb0, 0xb4, 0x57, 0xfe, 0x02, 0x4e, 0x68, 0xc6, 0x6e, 0xea, 0x38, 0xce,
+	0x6a, 0x55, 0x99, 0x1e, 0x7a, 0x8a, 0xe7, 0x79, 0xe6, 0x7d, 0x3f, 0xef, 0x79, 0xe6, 0xbd, 0x09,
+	0xbc, 0xd3, 0x45, 0x03, 0xd4, 0x20, 0x9e, 0x8d, 0x3d, 0x41, 0x06, 0xb8, 0x31, 0xd8, 0xb5, 0xb0,
+	0x40, 0xbb, 0x0d, 0xdb, 0x45, 0xa4, 0xc7, 0x75, 0x9f, 0x51, 0x41, 0xb5, 0x1d, 0x39, 0x49, 0x9f,
+	0x4e, 0xd2, 0xc3, 0x49, 0xa5, 0xb2, 0x4d, 0x79, 0x8f, 0xf2, 0x86, 0x85, 0x78, 0x64, 0x25, 0x25,
+	0x5e, 0xb0, 0xae, 0x74, 0x2b, 0x78, 0xdf, 0x52, 0xa3, 0x46, 0x30, 0x08, 0x5f, 0x6d, 0xb7, 0x69,
+	0x9b, 0x06, 0x76, 0xf9, 0x14, 0x58, 0xab, 0x3f, 0x00, 0x98, 0x37, 0x10, 0xc7, 0x7b, 0x52, 0x5d,
+	0xfb, 0x04, 0x2e, 0xd3, 0xa1, 0x87, 0x59, 0x11, 0xdc, 0x06, 0xb5, 0x35, 0xe3, 0xe1, 0xbf, 0x93,
+	0x4a, 0xbd, 0x4d, 0x44, 0xa7, 0x6f, 0xe9, 0x36, 0xed, 0x85, 0xfe, 0xc2, 0x9f, 0x3a, 0x77, 0xba,
+	0x0d, 0x31, 0xf6, 0x31, 0xd7, 0x1f, 0xd8, 0xf6, 0x03, 0xc7, 0x61, 0x98, 0xf3, 0x27, 0x87, 0xf5,
+	0xad, 0x50, 0x35, 0xb4, 0x18, 0x63, 0x81, 0xb9, 0x19, 0xb8, 0xd5, 0x5e, 0x87, 0x39, 0x86, 0x87,
+	0x88, 0x39, 0xc5, 0xec, 0x6d, 0x50, 0x5b, 0xbd, 0x7f, 0x4b, 0x0f, 0x27, 0xcb, 0x78, 0xce, 0x82,
+	0xd4, 0xf7, 0x28, 0xf1, 0x8c, 0x6b, 0x47, 0x93, 0x4a, 0xc6, 0x0c, 0xa7, 0xbf, 0x91, 0xff, 0xf9,
+	0xb0, 0xbe, 0xac, 0x18, 0xab, 0x4f, 0x01, 0xdc, 0x90, 0xc4, 0x8f, 0xfa, 0xae, 0x20, 0x97, 0x83,
+	0x6d, 0x47, 0xb0, 0x97, 0x9e, 0x8d, 0xfd, 0xaa, 0xc4, 0xfe, 0xfe, 0xaf, 0x4a, 0xed, 0x39, 0xf4,
+	0xe5, 0x02, 0x9e, 0x14, 0xe2, 0x57, 0x00, 0xae, 0x9a, 0xca, 0x7a, 0xe0, 0x39, 0x78, 0xa4, 0xbd,
+	0x02, 0x6f, 0xd8, 0xd4, 0x75, 0x91, 0xc0, 0x0c, 0xb9, 0x2d, 0xb9, 0x58, 0x45, 0x9a, 0x37, 0x37,
+	0xce, 0xcd, 0xef, 0x8d, 0x7d, 0xac, 0x35, 0xe1, 0x7a, 0xe0, 0xad, 0xf5, 0x29, 0xb2, 0x05, 0x65,
+	0x2a, 0xcd, 0x6b, 0x86, 0x2e, 0xa1, 0xfe, 0x9c, 0x54, 0xee, 0x3e, 0x07, 0xd4, 0x3e, 0xb6, 0xcd,
+	0xb5, 0xc0, 0xc9, 0x5b, 0xca, 0x47, 0x75, 0x08, 0xb5, 0x08, 0x0c, 0xe6, 0x8f, 0xd5, 0x0e, 0x45,
+	0x70, 0x23, 0x94, 0x22, 0x81, 0xb9, 0x08, 0x54, 0x6e, 0xee, 0xe8, 0xc9, 0x5b, 0x57, 0x8f, 0xf8,
+	0x30, 0x0a, 0x61, 0x96, 0xd6, 0x67, 0x1c, 0x9b, 0xeb, 0x2c, 0x3a, 0xac, 0x7e, 0x07, 0xe0, 0xa6,
+	0xfa, 0xca, 0x17, 0xca, 0xc5, 0x3c, 0x60, 0x36, 0x6d, 0xc0, 0x6f, 0x00, 0xbc, 0x19, 0x07, 0x3c,
+	0xcb, 0xcf, 0x00, 0x6e, 0xf7, 0xe4, 0xab, 0x56, 0x62, 0x96, 0x6a, 0x8b, 0x20, 0xe2, 0xee, 0x8c,
+	0x52, 0x48, 0xa2, 0xcd, 0x0b, 0x99, 0x5a, 0x6f, 0xce, 0x56, 0xfd, 0xf1, 0x3a, 0x2c, 0xcc, 0x58,
+	0x9a, 0x1e, 0xf2, 0x79, 0x87, 0x0a, 0x6d, 0x07, 0xe6, 0x3a, 0x98, 0xb4, 0x3b, 0x42, 0x25, 0x6c,
+	0xc9, 0x0c, 0x47, 0xda, 0xb7, 0x00, 0xbe, 0xd8, 0xe7, 0xce, 0xa8, 0xd5, 0x23, 0x9e, 0x20, 0x5e,
+	0xbb, 0x75, 0xf1, 0xb4, 0xed, 0x4a, 0xd8, 0x93, 0x49, 0xa5, 0xf8, 0x7e, 0x73, 0xff, 0xc3, 0x47,
+	0x81, 0xbb, 0x19, 0x98, 0xf9, 0x94, 0x16, 0xa5, 0x74, 0xd2, 0x54, 0xed, 0x4b, 0x00, 0x4b, 0x1d,
+	0x09, 0xc2, 0xfb, 0xbe, 0xef, 0x8e, 0xe3, 0x58, 0x4b, 0x29, 0x26, 0xf2, 0xa6, 0xd4, 0x69, 0x2a,
+	0x99, 0x05, 0x0c, 0x16, 0x65, 0x8c, 0x0e, 0xe3, 0x0c, 0xd7, 0xd2, 0x66, 0x30, 0x94, 0xcc, 0x2c,
+	0xc3, 0x17, 0xb0, 0xe8, 0x60, 0x17, 0xb7, 0x91, 0xa0, 0x2c, 0x0e, 0xb0, 0x9c, 0x22, 0xc0, 0xce,
+	0x54, 0x65, 0x56, 0x5f, 0xc0, 0x2d, 0x3e, 0x44, 0x7e, 0x5c, 0x3a, 0x97, 0xa2, 0xf4, 0x0b, 0x52,
+	0x60, 0x56, 0xf5, 0x73, 0xb8, 0xc3, 0xd1, 0x80, 0x78, 0x6d, 0x1e, 0x17, 0xbe, 0x9e, 0xa2, 0xf0,
+	0x76, 0xa8, 0x31, 0x17, 0x31, 0x46, 0xcc, 0x8b, 0x0b, 0xaf, 0xa4, 0x19, 0xb1, 0x14, 0x98, 0x3d,
+	0xb9, 0x3f, 0x65, 0xe1, 0x4b, 0xfb, 0x67, 0x9f, 0xe0, 0x03, 0xe4, 0x12, 0x47, 0x3e, 0xec, 0x75,
+	0xb0, 0xdd, 0xf5, 0x29, 0xf1, 0xc4, 0x25, 0x94, 0x5c, 0x59, 0x23, 0x2c, 0xea, 0x39, 0x38, 0x68,
+	0xd0, 0x2b, 0x66, 0x38, 0xd2, 0xf6, 0x60, 0x4e, 0xd0, 0x2e, 0xf6, 0xe4, 0xb1, 0x03, 0xb5, 0xbc,
+	0x71, 0x2f, 0xec, 0x28, 0x85, 0xa0, 0x7f, 0x70, 0xa7, 0xab, 0x13, 0xda, 0xe8, 0x21, 0xd1, 0xd1,
+	0x0f, 0x3c, 0xf1, 0xe4, 0xb0, 0x0e, 0x83, 0x17, 0x72, 0x64, 0x86, 0x4b, 0xb5, 0x8f, 0xe0, 0xe6,
+	0xf9, 0x46, 0xe6, 0x1d, 0xc4, 0xd4, 0x09, 0xba, 0x48, 0x83, 0xba, 0x31, 0xf5, 0xd3, 0x54, 0x6e,
+	0xaa, 0xbf, 0x00, 0xb8, 0x19, 0xa9, 0x39, 0xc1, 0xb5, 0xe0, 0x6d, 0x08, 0x65, 0x83, 0x6e, 0xa9,
+	0x9b, 0x95, 0x2a, 0x7a, 0xab, 0xf7, 0x5f, 0x5e, 0x94, 0xab, 0xe9, 0x25, 0xc8, 0x58, 0x91, 0x30,
+	0xc7, 0x93, 0x0a, 0x30, 0xf3, 0xd6, 0x99, 0xf1, 0x12, 0xba, 0x49, 0xf4, 0x02, 0xf0, 0x4f, 0x16,
+	0x96, 0x1e, 0x22, 0xe6, 0xbc, 0x43, 0x3e, 0xeb, 0x13, 0x87, 0x88, 0xf1, 0x63, 0x46, 0x07, 0xc4,
+	0xc1, 0x2c, 0x80, 0x79, 0x37, 0x21, 0xb0, 0xbb, 0xcf, 0x0a, 0xec, 0xfc, 0xae, 0x94, 0x1c, 0xdd,
+	0x08, 0x16, 0x92, 0x8b, 0x6c, 0x36, 0xc5, 0x2d, 0xbf, 0xc5, 0x13, 0x0a, 0xec, 0x08, 0x16, 0x92,
+	0x4b, 0x6b, 0x9a, 0xe5, 0x7d, 0xcb, 0x9a, 0x2f, 0xab, 0xd1, 0x74, 0xff, 0x01, 0xe0, 0xc6, 0xf4,
+	0xe4, 0xfd, 0x4f, 0x29, 0xee, 0x2e, 0xd8, 0x40, 0xe9, 0x44, 0xb8, 0x78, 0x2b, 0xfd, 0x0a, 0x60,
+	0xbe, 0x39, 0x44, 0xfe, 0x15, 0x0b, 0xeb, 0x37, 0x00, 0xd7, 0x9a, 0x41, 0xed, 0xbe, 0x82, 0x1f,
+	0xec, 0x4d, 0xc4, 0xbc, 0xab, 0x15, 0x96, 0x71, 0x70, 0xf4, 0x77, 0x39, 0x73, 0x74, 0x52, 0x06,
+	0xc7, 0x27, 0x65, 0xf0, 0xf4, 0xa4, 0x0c, 0xbe, 0x3e, 0x2d, 0x67, 0x8e, 0x4f, 0xcb, 0x99, 0xdf,
+	0x4f, 0xcb, 0x99, 0x8f, 0xef, 0x45, 0x0a, 0xbf, 0xe4, 0xa8, 0xbb, 0xc8, 0xe2, 0xea, 0xa9, 0x31,
+	0x8a, 0xfc, 0x57, 0x56, 0x1d, 0xc0, 0xca, 0xa9, 0xbf, 0xae, 0xaf, 0xfd, 0x37, 0x00, 0x38, 0xd0,
+	0x5a, 0x00, 0x4a, 0x0f, 0x00, 0x00,
 }
 
 func (m *BaseClaim) Marshal() (dAtA []byte, err error) {
@@ -802,6 +912,199 @@ func (m *MultiRewardIndexesProto) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *RewardIndexesSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardIndexesSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardIndexesSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EarnRewardIndexes) > 0 {
+		for iNdEx := len(m.EarnRewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EarnRewardIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClaims(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SavingsRewardIndexes) > 0 {
+		for iNdEx := len(m.SavingsRewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SavingsRewardIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClaims(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SwapRewardIndexes) > 0 {
+		for iNdEx := len(m.SwapRewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapRewardIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClaims(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DelegatorRewardIndexes) > 0 {
+		for iNdEx := len(m.DelegatorRewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorRewardIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClaims(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.HardBorrowRewardIndexes) > 0 {
+		for iNdEx := len(m.HardBorrowRewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HardBorrowRewardIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClaims(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.HardSupplyRewardIndexes) > 0 {
+		for iNdEx := len(m.HardSupplyRewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HardSupplyRewardIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClaims(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.USDXMintingRewardIndexes) > 0 {
+		for iNdEx := len(m.USDXMintingRewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.USDXMintingRewardIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClaims(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintClaims(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorValidatorCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorValidatorCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorValidatorCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DelegatorShares.Size()
+		i -= size
+		if _, err := m.DelegatorShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaims(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaims(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Bonded {
+		i--
+		if m.Bonded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RewardIndexes) > 0 {
+		for iNdEx := len(m.RewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClaims(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *USDXMintingClaim) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1207,6 +1510,82 @@ func (m *MultiRewardIndexesProto) Size() (n int) {
 	return n
 }
 
+func (m *RewardIndexesSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovClaims(uint64(m.Height))
+	}
+	if len(m.USDXMintingRewardIndexes) > 0 {
+		for _, e := range m.USDXMintingRewardIndexes {
+			l = e.Size()
+			n += 1 + l + sovClaims(uint64(l))
+		}
+	}
+	if len(m.HardSupplyRewardIndexes) > 0 {
+		for _, e := range m.HardSupplyRewardIndexes {
+			l = e.Size()
+			n += 1 + l + sovClaims(uint64(l))
+		}
+	}
+	if len(m.HardBorrowRewardIndexes) > 0 {
+		for _, e := range m.HardBorrowRewardIndexes {
+			l = e.Size()
+			n += 1 + l + sovClaims(uint64(l))
+		}
+	}
+	if len(m.DelegatorRewardIndexes) > 0 {
+		for _, e := range m.DelegatorRewardIndexes {
+			l = e.Size()
+			n += 1 + l + sovClaims(uint64(l))
+		}
+	}
+	if len(m.SwapRewardIndexes) > 0 {
+		for _, e := range m.SwapRewardIndexes {
+			l = e.Size()
+			n += 1 + l + sovClaims(uint64(l))
+		}
+	}
+	if len(m.SavingsRewardIndexes) > 0 {
+		for _, e := range m.SavingsRewardIndexes {
+			l = e.Size()
+			n += 1 + l + sovClaims(uint64(l))
+		}
+	}
+	if len(m.EarnRewardIndexes) > 0 {
+		for _, e := range m.EarnRewardIndexes {
+			l = e.Size()
+			n += 1 + l + sovClaims(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegatorValidatorCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardIndexes) > 0 {
+		for _, e := range m.RewardIndexes {
+			l = e.Size()
+			n += 1 + l + sovClaims(uint64(l))
+		}
+	}
+	if m.Bonded {
+		n += 2
+	}
+	l = m.Tokens.Size()
+	n += 1 + l + sovClaims(uint64(l))
+	l = m.DelegatorShares.Size()
+	n += 1 + l + sovClaims(uint64(l))
+	return n
+}
+
 func (m *USDXMintingClaim) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1955,6 +2334,484 @@ func (m *MultiRewardIndexesProto) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RewardIndexesSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardIndexesSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardIndexesSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field USDXMintingRewardIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.USDXMintingRewardIndexes = append(m.USDXMintingRewardIndexes, RewardIndex{})
+			if err := m.USDXMintingRewardIndexes[len(m.USDXMintingRewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HardSupplyRewardIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HardSupplyRewardIndexes = append(m.HardSupplyRewardIndexes, MultiRewardIndex{})
+			if err := m.HardSupplyRewardIndexes[len(m.HardSupplyRewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HardBorrowRewardIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HardBorrowRewardIndexes = append(m.HardBorrowRewardIndexes, MultiRewardIndex{})
+			if err := m.HardBorrowRewardIndexes[len(m.HardBorrowRewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorRewardIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorRewardIndexes = append(m.DelegatorRewardIndexes, MultiRewardIndex{})
+			if err := m.DelegatorRewardIndexes[len(m.DelegatorRewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRewardIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapRewardIndexes = append(m.SwapRewardIndexes, MultiRewardIndex{})
+			if err := m.SwapRewardIndexes[len(m.SwapRewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SavingsRewardIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SavingsRewardIndexes = append(m.SavingsRewardIndexes, MultiRewardIndex{})
+			if err := m.SavingsRewardIndexes[len(m.SavingsRewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarnRewardIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EarnRewardIndexes = append(m.EarnRewardIndexes, MultiRewardIndex{})
+			if err := m.EarnRewardIndexes[len(m.EarnRewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorValidatorCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorValidatorCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorValidatorCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardIndexes = append(m.RewardIndexes, RewardIndex{})
+			if err := m.RewardIndexes[len(m.RewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bonded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Bonded = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *USDXMintingClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -544,6 +544,93 @@ func TestRewardIndexes(t *testing.T) {
 			})
 		}
 	})
+	t.Run("Exceeds", func(t *testing.T) {
+		testcases := []struct {
+			name          string
+			rewardIndexes RewardIndexes
+			other         RewardIndexes
+			expected      bool
+		}{
+			{
+				name:          "equal indexes do not exceed",
+				rewardIndexes: normalRewardIndexes,
+				other:         normalRewardIndexes,
+				expected:      false,
+			},
+			{
+				name:          "lower indexes do not exceed",
+				rewardIndexes: normalRewardIndexes,
+				other:         normalRewardIndexes.Mul(sdk.MustNewDecFromStr("2")),
+				expected:      false,
+			},
+			{
+				name:          "a higher factor exceeds",
+				rewardIndexes: normalRewardIndexes.With("ukava", sdk.MustNewDecFromStr("0.2")),
+				other:         normalRewardIndexes,
+				expected:      true,
+			},
+			{
+				name:          "a positive factor missing from other exceeds",
+				rewardIndexes: appendUniqueRewardIndex(normalRewardIndexes),
+				other:         normalRewardIndexes,
+				expected:      true,
+			},
+			{
+				name:          "a zero factor missing from other does not exceed",
+				rewardIndexes: normalRewardIndexes.With("uniquereward", sdk.ZeroDec()),
+				other:         normalRewardIndexes,
+				expected:      false,
+			},
+			{
+				name:          "empty indexes do not exceed",
+				rewardIndexes: nil,
+				other:         normalRewardIndexes,
+				expected:      false,
+			},
+		}
+		for _, tc := range testcases {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.expected, tc.rewardIndexes.Exceeds(tc.other))
+			})
+		}
+	})
+	t.Run("Min", func(t *testing.T) {
+		testcases := []struct {
+			name          string
+			rewardIndexes RewardIndexes
+			other         RewardIndexes
+			expected      RewardIndexes
+		}{
+			{
+				name:          "smaller factors are kept",
+				rewardIndexes: normalRewardIndexes.With("ukava", sdk.MustNewDecFromStr("0.2")),
+				other:         normalRewardIndexes.With("hard", sdk.MustNewDecFromStr("0.1")),
+				expected:      normalRewardIndexes,
+			},
+			{
+				name:          "factors unique to either indexes are kept",
+				rewardIndexes: RewardIndexes{NewRewardIndex("hard", d("0.1"))},
+				other:         RewardIndexes{NewRewardIndex("ukava", d("0.2"))},
+				expected: RewardIndexes{
+					NewRewardIndex("hard", d("0.1")),
+					NewRewardIndex("ukava", d("0.2")),
+				},
+			},
+			{
+				name:          "nil indexes return other",
+				rewardIndexes: nil,
+				other:         normalRewardIndexes,
+				expected:      normalRewardIndexes,
+			},
+		}
+		for _, tc := range testcases {
+			t.Run(tc.name, func(t *testing.T) {
+				original := tc.rewardIndexes.copy()
+				require.Equal(t, tc.expected, tc.rewardIndexes.Min(tc.other))
+				require.Equal(t, original, tc.rewardIndexes)
+			})
+		}
+	})
 }
 
 func TestMultiRewardIndexes(t *testing.T) {
@@ -767,6 +854,36 @@ func TestMultiRewardIndexes(t *testing.T) {
 			})
 		}
 	})
+	t.Run("Exceeds", func(t *testing.T) {
+		multiRewardIndexes := MultiRewardIndexes{
+			{CollateralType: "bnb", RewardIndexes: normalRewardIndexes},
+		}
+
+		require.False(t, multiRewardIndexes.Exceeds(multiRewardIndexes))
+		require.True(t, multiRewardIndexes.Exceeds(MultiRewardIndexes{
+			{CollateralType: "bnb", RewardIndexes: normalRewardIndexes.Quo(sdk.MustNewDecFromStr("2"))},
+		}))
+		require.True(t, multiRewardIndexes.Exceeds(nil))
+		require.False(t, MultiRewardIndexes{}.Exceeds(multiRewardIndexes))
+	})
+	t.Run("Min", func(t *testing.T) {
+		multiRewardIndexes := MultiRewardIndexes{
+			{CollateralType: "bnb", RewardIndexes: normalRewardIndexes},
+			{CollateralType: "btcb", RewardIndexes: normalRewardIndexes},
+		}
+		other := MultiRewardIndexes{
+			{CollateralType: "bnb", RewardIndexes: normalRewardIndexes.Quo(d("2"))},
+			{CollateralType: "xrpb", RewardIndexes: arbitraryRewardIndexes},
+		}
+
+		require.Equal(t, MultiRewardIndexes{
+			{CollateralType: "bnb", RewardIndexes: normalRewardIndexes.Quo(d("2"))},
+			{CollateralType: "btcb", RewardIndexes: normalRewardIndexes},
+			{CollateralType: "xrpb", RewardIndexes: arbitraryRewardIndexes},
+		}, multiRewardIndexes.Min(other))
+		require.Equal(t, normalRewardIndexes, multiRewardIndexes[0].RewardIndexes)
+		require.Equal(t, other, MultiRewardIndexes(nil).Min(other))
+	})
 }
 
 var normalRewardIndexes = RewardIndexes{
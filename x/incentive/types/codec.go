@@ -18,6 +18,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgClaimSwapReward{}, "incentive/MsgClaimSwapReward", nil)
 	cdc.RegisterConcrete(&MsgClaimSavingsReward{}, "incentive/MsgClaimSavingsReward", nil)
 	cdc.RegisterConcrete(&MsgClaimEarnReward{}, "incentive/MsgClaimEarnReward", nil)
+	cdc.RegisterConcrete(&MsgClaimAll{}, "incentive/MsgClaimAll", nil)
+	cdc.RegisterConcrete(&MsgRevokeClaim{}, "incentive/MsgRevokeClaim", nil)
+	cdc.RegisterConcrete(&MsgMergeClaims{}, "incentive/MsgMergeClaims", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -28,6 +31,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgClaimSwapReward{},
 		&MsgClaimSavingsReward{},
 		&MsgClaimEarnReward{},
+		&MsgClaimAll{},
+		&MsgRevokeClaim{},
+		&MsgMergeClaims{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
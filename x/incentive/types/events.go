@@ -7,10 +7,24 @@ const (
 	EventTypeClaimPeriod       = "new_claim_period"
 	EventTypeClaimPeriodExpiry = "claim_period_expiry"
 
-	AttributeValueCategory   = ModuleName
-	AttributeKeyClaimedBy    = "claimed_by"
-	AttributeKeyClaimAmount  = "claim_amount"
-	AttributeKeyClaimType    = "claim_type"
-	AttributeKeyRewardPeriod = "reward_period"
-	AttributeKeyClaimPeriod  = "claim_period"
+	EventTypeRewardPeriodActivated = "reward_period_activated"
+	EventTypeRewardPeriodExpired   = "reward_period_expired"
+
+	AttributeValueCategory    = ModuleName
+	AttributeKeyClaimedBy     = "claimed_by"
+	AttributeKeyClaimReceiver = "receiver"
+	AttributeKeyClaimAmount   = "claim_amount"
+	AttributeKeyClaimType     = "claim_type"
+	AttributeKeyRewardPeriod  = "reward_period"
+	AttributeKeyClaimPeriod   = "claim_period"
+
+	AttributeKeyRewardSource     = "reward_source"
+	AttributeKeyCollateralType   = "collateral_type"
+	AttributeKeyRewardsPerSecond = "rewards_per_second"
+
+	EventTypeRevokeClaim = "revoke_claim"
+
+	AttributeKeyClaimOwner      = "owner"
+	AttributeKeyRevokedAmount   = "revoked_amount"
+	AttributeKeyRemainingReward = "remaining_reward"
 )
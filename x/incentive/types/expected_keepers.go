@@ -12,6 +12,7 @@ import (
 	hardtypes "github.com/kava-labs/kava/x/hard/types"
 	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 	savingstypes "github.com/kava-labs/kava/x/savings/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
 
 // ParamSubspace defines the expected Subspace interfacace
@@ -41,6 +42,7 @@ type StakingKeeper interface {
 type CdpKeeper interface {
 	GetInterestFactor(ctx sdk.Context, collateralType string) (sdk.Dec, bool)
 	GetTotalPrincipal(ctx sdk.Context, collateralType string, principalDenom string) (total sdkmath.Int)
+	GetTotalCollateral(ctx sdk.Context, collateralType string) sdkmath.Int
 	GetCdpByOwnerAndCollateralType(ctx sdk.Context, owner sdk.AccAddress, collateralType string) (cdptypes.CDP, bool)
 	GetCollateral(ctx sdk.Context, collateralType string) (cdptypes.CollateralParam, bool)
 }
@@ -54,12 +56,14 @@ type HardKeeper interface {
 	GetBorrowInterestFactor(ctx sdk.Context, denom string) (sdk.Dec, bool)
 	GetBorrowedCoins(ctx sdk.Context) (coins sdk.Coins, found bool)
 	GetSuppliedCoins(ctx sdk.Context) (coins sdk.Coins, found bool)
+	GetMoneyMarket(ctx sdk.Context, denom string) (hardtypes.MoneyMarket, bool)
 }
 
 // SwapKeeper defines the required methods needed by this modules keeper
 type SwapKeeper interface {
 	GetPoolShares(ctx sdk.Context, poolID string) (shares sdkmath.Int, found bool)
 	GetDepositorSharesAmount(ctx sdk.Context, depositor sdk.AccAddress, poolID string) (shares sdkmath.Int, found bool)
+	GetPool(ctx sdk.Context, poolID string) (swaptypes.PoolRecord, bool)
 }
 
 // SavingsKeeper defines the required methods needed by this module's keeper
@@ -79,11 +83,13 @@ type EarnKeeper interface {
 // LiquidKeeper defines the required methods needed by this modules keeper
 type LiquidKeeper interface {
 	IsDerivativeDenom(ctx sdk.Context, denom string) bool
+	GetDerivativeShares(ctx sdk.Context, valAddr sdk.ValAddress, amount sdkmath.Int) sdk.Dec
 	GetTotalDerivativeValue(ctx sdk.Context) (sdk.Coin, error)
 	GetDerivativeValue(ctx sdk.Context, denom string) (sdk.Coin, error)
 	CollectStakingRewardsByDenom(
 		ctx sdk.Context,
 		derivativeDenom string,
+		heldAmount sdkmath.Int,
 		destinationModAccount string,
 	) (sdk.Coins, error)
 }
@@ -124,4 +130,10 @@ type HARDHooks interface {
 	AfterBorrowCreated(ctx sdk.Context, borrow hardtypes.Borrow)
 	BeforeBorrowModified(ctx sdk.Context, borrow hardtypes.Borrow)
 	AfterBorrowModified(ctx sdk.Context, deposit hardtypes.Deposit)
+	AfterInterestAccrued(ctx sdk.Context, denom string, suppliedInterest, reserveInterest sdkmath.Int)
+}
+
+// IncentiveHooks event hooks for other keepers to run code in response to incentive reward payouts
+type IncentiveHooks interface {
+	AfterClaimPayout(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, claimType string)
 }
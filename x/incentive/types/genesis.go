@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
@@ -127,7 +129,10 @@ func (grs GenesisRewardState) Validate() error {
 	if err := grs.AccumulationTimes.Validate(); err != nil {
 		return err
 	}
-	return grs.MultiRewardIndexes.Validate()
+	if err := grs.MultiRewardIndexes.Validate(); err != nil {
+		return err
+	}
+	return grs.DistributedRewards.Validate()
 }
 
 // NewAccumulationTime returns a new GenesisAccumulationTime
@@ -158,3 +163,40 @@ func (gats AccumulationTimes) Validate() error {
 	}
 	return nil
 }
+
+// NewDistributedRewards returns a new DistributedRewards
+func NewDistributedRewards(ctype string, amount sdk.DecCoins) DistributedRewards {
+	return DistributedRewards{
+		CollateralType: ctype,
+		Amount:         amount,
+	}
+}
+
+// Validate performs validation of DistributedRewards
+func (dr DistributedRewards) Validate() error {
+	if len(dr.CollateralType) == 0 {
+		return fmt.Errorf("distributed rewards' collateral type must be defined")
+	}
+	if !dr.Amount.IsValid() {
+		return fmt.Errorf("invalid distributed rewards amount: %s", dr.Amount)
+	}
+	return nil
+}
+
+// DistributedRewardsList slice of DistributedRewards
+type DistributedRewardsList []DistributedRewards
+
+// Validate performs validation of DistributedRewardsList
+func (drs DistributedRewardsList) Validate() error {
+	seen := make(map[string]bool)
+	for _, dr := range drs {
+		if err := dr.Validate(); err != nil {
+			return err
+		}
+		if seen[dr.CollateralType] {
+			return fmt.Errorf("duplicate distributed rewards for collateral type %s", dr.CollateralType)
+		}
+		seen[dr.CollateralType] = true
+	}
+	return nil
+}
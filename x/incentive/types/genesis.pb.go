@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -68,8 +70,9 @@ var xxx_messageInfo_AccumulationTime proto.InternalMessageInfo
 
 // GenesisRewardState groups together the global state for a particular reward so it can be exported in genesis.
 type GenesisRewardState struct {
-	AccumulationTimes  AccumulationTimes  `protobuf:"bytes,1,rep,name=accumulation_times,json=accumulationTimes,proto3,castrepeated=AccumulationTimes" json:"accumulation_times"`
-	MultiRewardIndexes MultiRewardIndexes `protobuf:"bytes,2,rep,name=multi_reward_indexes,json=multiRewardIndexes,proto3,castrepeated=MultiRewardIndexes" json:"multi_reward_indexes"`
+	AccumulationTimes  AccumulationTimes      `protobuf:"bytes,1,rep,name=accumulation_times,json=accumulationTimes,proto3,castrepeated=AccumulationTimes" json:"accumulation_times"`
+	MultiRewardIndexes MultiRewardIndexes     `protobuf:"bytes,2,rep,name=multi_reward_indexes,json=multiRewardIndexes,proto3,castrepeated=MultiRewardIndexes" json:"multi_reward_indexes"`
+	DistributedRewards DistributedRewardsList `protobuf:"bytes,3,rep,name=distributed_rewards,json=distributedRewards,proto3,castrepeated=DistributedRewardsList" json:"distributed_rewards"`
 }
 
 func (m *GenesisRewardState) Reset()         { *m = GenesisRewardState{} }
@@ -105,6 +108,45 @@ func (m *GenesisRewardState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisRewardState proto.InternalMessageInfo
 
+// DistributedRewards stores the total rewards paid out for a collateral type with a capped reward period.
+type DistributedRewards struct {
+	CollateralType string                                      `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	Amount         github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"amount"`
+}
+
+func (m *DistributedRewards) Reset()         { *m = DistributedRewards{} }
+func (m *DistributedRewards) String() string { return proto.CompactTextString(m) }
+func (*DistributedRewards) ProtoMessage()    {}
+func (*DistributedRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b76737885d05afd, []int{2}
+}
+func (m *DistributedRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributedRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributedRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributedRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributedRewards.Merge(m, src)
+}
+func (m *DistributedRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributedRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributedRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributedRewards proto.InternalMessageInfo
+
 // GenesisState is the state that must be provided at genesis.
 type GenesisState struct {
 	Params                      Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
@@ -127,7 +169,7 @@ func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8b76737885d05afd, []int{2}
+	return fileDescriptor_8b76737885d05afd, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,6 +201,7 @@ var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*AccumulationTime)(nil), "kava.incentive.v1beta1.AccumulationTime")
 	proto.RegisterType((*GenesisRewardState)(nil), "kava.incentive.v1beta1.GenesisRewardState")
+	proto.RegisterType((*DistributedRewards)(nil), "kava.incentive.v1beta1.DistributedRewards")
 	proto.RegisterType((*GenesisState)(nil), "kava.incentive.v1beta1.GenesisState")
 }
 
@@ -167,57 +210,63 @@ func init() {
 }
 
 var fileDescriptor_8b76737885d05afd = []byte{
-	// 785 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcf, 0x4f, 0xdb, 0x48,
-	0x14, 0xc7, 0x63, 0x60, 0x59, 0x98, 0x00, 0x21, 0xb3, 0x01, 0xb2, 0x41, 0x72, 0x58, 0x40, 0xbb,
-	0xd1, 0x56, 0xb5, 0x45, 0x7a, 0xed, 0xa5, 0x2e, 0x55, 0x8b, 0x54, 0x24, 0xe4, 0x50, 0x54, 0x55,
-	0x95, 0xa2, 0x71, 0x3c, 0x98, 0x69, 0xfd, 0xab, 0x1e, 0x3b, 0x21, 0xb7, 0x1e, 0x7b, 0xe4, 0x0f,
-	0xa8, 0xd4, 0x7b, 0xff, 0x8f, 0x4a, 0x1c, 0x39, 0xf6, 0x04, 0x6d, 0xf8, 0x47, 0xaa, 0x19, 0x8f,
-	0x83, 0x9d, 0x60, 0xaa, 0xa6, 0xb7, 0xc9, 0x9b, 0x37, 0xdf, 0xcf, 0xf7, 0xcd, 0x7b, 0x13, 0x83,
-	0xed, 0xb7, 0xa8, 0x8b, 0x54, 0xe2, 0x76, 0xb0, 0x1b, 0x92, 0x2e, 0x56, 0xbb, 0x3b, 0x06, 0x0e,
-	0xd1, 0x8e, 0x6a, 0x61, 0x17, 0x53, 0x42, 0x15, 0x3f, 0xf0, 0x42, 0x0f, 0xae, 0xb2, 0x2c, 0x65,
-	0x98, 0xa5, 0x88, 0xac, 0x5a, 0xc5, 0xf2, 0x2c, 0x8f, 0xa7, 0xa8, 0x6c, 0x15, 0x67, 0xd7, 0xea,
-	0x96, 0xe7, 0x59, 0x36, 0x56, 0xf9, 0x2f, 0x23, 0x3a, 0x56, 0x43, 0xe2, 0x60, 0x1a, 0x22, 0xc7,
-	0x17, 0x09, 0x5b, 0x39, 0xd0, 0x8e, 0x8d, 0x88, 0x43, 0x7f, 0x92, 0xe4, 0xa3, 0x00, 0x25, 0x49,
-	0x9b, 0x9f, 0x24, 0xb0, 0xfc, 0xa8, 0xd3, 0x89, 0x9c, 0xc8, 0x46, 0x21, 0xf1, 0xdc, 0x43, 0xe2,
-	0x60, 0xf8, 0x1f, 0x28, 0x75, 0x3c, 0xdb, 0x46, 0x21, 0x0e, 0x90, 0xdd, 0x0e, 0xfb, 0x3e, 0xae,
-	0x4a, 0x1b, 0x52, 0x63, 0x5e, 0x5f, 0xba, 0x09, 0x1f, 0xf6, 0x7d, 0x0c, 0x0d, 0x50, 0xf3, 0x03,
-	0xdc, 0x25, 0x5e, 0x44, 0xdb, 0x28, 0xa5, 0xd2, 0x66, 0x86, 0xab, 0x53, 0x1b, 0x52, 0xa3, 0xd8,
-	0xac, 0x29, 0x71, 0x35, 0x4a, 0x52, 0x8d, 0x72, 0x98, 0x54, 0xa3, 0xcd, 0x9d, 0x5f, 0xd6, 0x0b,
-	0x67, 0x57, 0x75, 0x49, 0xaf, 0x26, 0x3a, 0xa3, 0x66, 0x36, 0xdf, 0x4f, 0x01, 0xf8, 0x34, 0xbe,
-	0x4c, 0x1d, 0xf7, 0x50, 0x60, 0xb6, 0x42, 0x14, 0x62, 0x18, 0x00, 0x38, 0x46, 0xa4, 0x55, 0x69,
-	0x63, 0xba, 0x51, 0x6c, 0x36, 0x94, 0xdb, 0xaf, 0x5b, 0x19, 0x15, 0xd7, 0xfe, 0x66, 0x06, 0x3e,
-	0x5f, 0xd5, 0xcb, 0xa3, 0x3b, 0x54, 0x2f, 0xa3, 0xd1, 0x10, 0xec, 0x82, 0x8a, 0x13, 0xd9, 0x21,
-	0x69, 0x07, 0xdc, 0x48, 0x9b, 0xb8, 0x26, 0x3e, 0xc5, 0xb4, 0x3a, 0x75, 0x37, 0x75, 0x9f, 0x9d,
-	0x89, 0xbd, 0xef, 0xb1, 0x13, 0x5a, 0x4d, 0x50, 0xe1, 0xe8, 0x0e, 0xa6, 0x3a, 0x74, 0xc6, 0x62,
-	0x9b, 0x5f, 0x8a, 0x60, 0x41, 0x5c, 0x41, 0x5c, 0xfc, 0x43, 0x30, 0x1b, 0x77, 0x91, 0xf7, 0xa5,
-	0xd8, 0x94, 0xf3, 0xd0, 0x07, 0x3c, 0x4b, 0x9b, 0x61, 0x40, 0x5d, 0x9c, 0x81, 0x1e, 0x28, 0x47,
-	0xd4, 0x3c, 0x4d, 0xaa, 0xa0, 0x4c, 0x52, 0x34, 0xeb, 0xff, 0x3c, 0xa1, 0xf1, 0x0e, 0x68, 0x6b,
-	0x4c, 0x74, 0x70, 0x59, 0x2f, 0xbd, 0x68, 0xed, 0xbe, 0x4c, 0x6d, 0xe8, 0x25, 0xa6, 0x9e, 0xee,
-	0x15, 0x01, 0xd5, 0x13, 0x4e, 0x8a, 0x7c, 0xdf, 0xee, 0x67, 0xb9, 0xd3, 0xbf, 0xcc, 0x8d, 0x8b,
-	0x59, 0x61, 0x8a, 0x2d, 0x2e, 0x78, 0x1b, 0xca, 0xf0, 0x82, 0xc0, 0xeb, 0x65, 0x51, 0x33, 0xbf,
-	0x83, 0xd2, 0xb8, 0x60, 0x1a, 0x75, 0x0c, 0x56, 0x4d, 0x6c, 0x63, 0x0b, 0x85, 0x5e, 0x90, 0x05,
-	0xfd, 0x31, 0x21, 0xa8, 0x32, 0xd4, 0x4b, 0x73, 0x5e, 0x83, 0x32, 0xed, 0x21, 0x3f, 0x8b, 0x98,
-	0x9d, 0x10, 0x51, 0x62, 0x52, 0x69, 0xf5, 0x0f, 0x12, 0xf8, 0x8b, 0x4f, 0x83, 0x43, 0xdc, 0x90,
-	0xb8, 0x56, 0x3b, 0xfe, 0x0f, 0xa9, 0xfe, 0x79, 0xf7, 0x4c, 0xb3, 0x9e, 0xef, 0xc7, 0x27, 0x1e,
-	0xb3, 0x03, 0x9a, 0x22, 0xa6, 0xa1, 0x3c, 0xba, 0x43, 0xd9, 0xf3, 0x1a, 0x0b, 0xea, 0x7c, 0x04,
-	0x33, 0x21, 0xf8, 0x51, 0x02, 0x32, 0x6f, 0x9e, 0x4d, 0xde, 0x45, 0xc4, 0x24, 0x61, 0xbf, 0xed,
-	0x07, 0x5e, 0x97, 0x98, 0x38, 0x48, 0x5c, 0xcd, 0x71, 0x57, 0xcd, 0x3c, 0x57, 0xcf, 0x50, 0x60,
-	0x3e, 0x4f, 0x0e, 0x1f, 0x88, 0xb3, 0xb1, 0xbf, 0x2d, 0xf1, 0xe6, 0xd6, 0xf3, 0x73, 0xa8, 0xbe,
-	0x7e, 0x92, 0xbf, 0x09, 0xdf, 0x80, 0xe5, 0x9b, 0x7e, 0x0b, 0x3f, 0xf3, 0xdc, 0xcf, 0xbf, 0x79,
-	0x7e, 0x76, 0x93, 0xfc, 0xd8, 0xc3, 0x9a, 0xf0, 0x50, 0xca, 0xc6, 0xa9, 0x5e, 0x32, 0xb3, 0x01,
-	0x78, 0x04, 0x8a, 0xbc, 0xe7, 0x02, 0x03, 0x38, 0xe6, 0x9f, 0x3c, 0x4c, 0xab, 0x87, 0xfc, 0x98,
-	0x00, 0x05, 0x01, 0x0c, 0x43, 0x54, 0x07, 0x74, 0xb8, 0x86, 0x06, 0xa8, 0x50, 0xd4, 0x25, 0xae,
-	0x45, 0xb3, 0xe3, 0x54, 0x9c, 0x70, 0x9c, 0xa0, 0x50, 0x4b, 0x4f, 0x94, 0x01, 0x96, 0x12, 0x86,
-	0xb0, 0xbf, 0xc0, 0xed, 0x6f, 0xe7, 0xda, 0x8f, 0xb3, 0xe3, 0x0a, 0x56, 0x44, 0x05, 0x8b, 0xe9,
-	0x28, 0xd5, 0x17, 0x69, 0xfa, 0x27, 0x7b, 0x13, 0x18, 0x05, 0x6e, 0xb6, 0x88, 0xc5, 0x49, 0xdf,
-	0x04, 0x93, 0x4a, 0x57, 0x70, 0x04, 0x8a, 0x5c, 0x5d, 0xd8, 0x5f, 0xba, 0xfb, 0xf6, 0x9f, 0xa0,
-	0xc0, 0x1d, 0xb9, 0xfd, 0x61, 0x88, 0xea, 0x00, 0x0f, 0xd7, 0xda, 0xde, 0xf9, 0x77, 0xb9, 0x70,
-	0x3e, 0x90, 0xa5, 0x8b, 0x81, 0x2c, 0x7d, 0x1b, 0xc8, 0xd2, 0xd9, 0xb5, 0x5c, 0xb8, 0xb8, 0x96,
-	0x0b, 0x5f, 0xaf, 0xe5, 0xc2, 0xab, 0x7b, 0x16, 0x09, 0x4f, 0x22, 0x43, 0xe9, 0x78, 0x8e, 0xca,
-	0x50, 0xf7, 0x6d, 0x64, 0x50, 0xbe, 0x52, 0x4f, 0x53, 0x9f, 0x71, 0xf6, 0x39, 0xa6, 0xc6, 0x2c,
-	0xff, 0x9a, 0x3e, 0xf8, 0x11, 0x00, 0x00, 0xff, 0xff, 0xbc, 0xa8, 0x1c, 0xb0, 0x7f, 0x08, 0x00,
-	0x00,
+	// 895 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xdf, 0x6e, 0xdc, 0xc4,
+	0x17, 0xc7, 0xd7, 0x49, 0x7f, 0xf9, 0xb5, 0xb3, 0x4d, 0xb6, 0x3b, 0x4d, 0xd3, 0x65, 0x8b, 0xbc,
+	0x21, 0xad, 0x20, 0x02, 0xd5, 0x56, 0xd3, 0x5b, 0x6e, 0x70, 0x8b, 0xa0, 0x52, 0x2b, 0x55, 0x4e,
+	0xa8, 0x10, 0x42, 0x5a, 0x8d, 0xed, 0xa9, 0x33, 0xd4, 0xf6, 0x18, 0x9f, 0xf1, 0x26, 0x11, 0x2f,
+	0xc0, 0x65, 0x1f, 0x00, 0x89, 0x5b, 0xd4, 0xf7, 0x40, 0xca, 0x65, 0x2f, 0xb9, 0x6a, 0x20, 0x79,
+	0x0e, 0x24, 0x34, 0x7f, 0xbc, 0x6b, 0xef, 0xc6, 0x01, 0xc2, 0xd5, 0xce, 0x9e, 0x39, 0xe7, 0xfb,
+	0xf9, 0xce, 0xcc, 0x19, 0xdb, 0xe8, 0xde, 0x2b, 0x32, 0x21, 0x2e, 0xcb, 0x42, 0x9a, 0x09, 0x36,
+	0xa1, 0xee, 0xe4, 0x41, 0x40, 0x05, 0x79, 0xe0, 0xc6, 0x34, 0xa3, 0xc0, 0xc0, 0xc9, 0x0b, 0x2e,
+	0x38, 0xde, 0x90, 0x59, 0xce, 0x34, 0xcb, 0x31, 0x59, 0x43, 0x3b, 0xe4, 0x90, 0x72, 0x70, 0x03,
+	0x02, 0xb3, 0xd2, 0x90, 0xb3, 0x4c, 0xd7, 0x0d, 0xd7, 0x63, 0x1e, 0x73, 0x35, 0x74, 0xe5, 0xc8,
+	0x44, 0x47, 0x31, 0xe7, 0x71, 0x42, 0x5d, 0xf5, 0x2f, 0x28, 0x5f, 0xba, 0x82, 0xa5, 0x14, 0x04,
+	0x49, 0x73, 0x93, 0x70, 0xb7, 0xc5, 0x54, 0x98, 0x10, 0x96, 0xc2, 0xdf, 0x24, 0xe5, 0xa4, 0x20,
+	0x55, 0xd2, 0xd6, 0xcf, 0x16, 0xba, 0xf1, 0x59, 0x18, 0x96, 0x69, 0x99, 0x10, 0xc1, 0x78, 0xb6,
+	0xc7, 0x52, 0x8a, 0x3f, 0x42, 0xbd, 0x90, 0x27, 0x09, 0x11, 0xb4, 0x20, 0xc9, 0x58, 0x1c, 0xe5,
+	0x74, 0x60, 0x6d, 0x5a, 0xdb, 0xd7, 0xfc, 0xb5, 0x59, 0x78, 0xef, 0x28, 0xa7, 0x38, 0x40, 0xc3,
+	0xbc, 0xa0, 0x13, 0xc6, 0x4b, 0x18, 0x93, 0x9a, 0xca, 0x58, 0x1a, 0x1e, 0x2c, 0x6d, 0x5a, 0xdb,
+	0xdd, 0x9d, 0xa1, 0xa3, 0x57, 0xe3, 0x54, 0xab, 0x71, 0xf6, 0xaa, 0xd5, 0x78, 0x57, 0x8f, 0xdf,
+	0x8d, 0x3a, 0xaf, 0x4f, 0x46, 0x96, 0x3f, 0xa8, 0x74, 0xe6, 0xcd, 0x6c, 0xfd, 0xb9, 0x84, 0xf0,
+	0x17, 0x7a, 0xb3, 0x7d, 0x7a, 0x40, 0x8a, 0x68, 0x57, 0x10, 0x41, 0x71, 0x81, 0xf0, 0x02, 0x11,
+	0x06, 0xd6, 0xe6, 0xf2, 0x76, 0x77, 0x67, 0xdb, 0x39, 0xff, 0x38, 0x9c, 0x79, 0x71, 0xef, 0x3d,
+	0x69, 0xe0, 0xcd, 0xc9, 0xa8, 0x3f, 0x3f, 0x03, 0x7e, 0x9f, 0xcc, 0x87, 0xf0, 0x04, 0xad, 0xa7,
+	0x65, 0x22, 0xd8, 0xb8, 0x50, 0x46, 0xc6, 0x2c, 0x8b, 0xe8, 0x21, 0x85, 0xc1, 0xd2, 0xc5, 0xd4,
+	0x67, 0xb2, 0x46, 0x7b, 0x7f, 0x22, 0x2b, 0xbc, 0xa1, 0xa1, 0xe2, 0xf9, 0x19, 0x0a, 0x3e, 0x4e,
+	0x17, 0x62, 0xf8, 0x07, 0x74, 0x33, 0x62, 0x20, 0x0a, 0x16, 0x94, 0x82, 0x46, 0x86, 0x0e, 0x83,
+	0x65, 0x85, 0xfd, 0xb8, 0x0d, 0xfb, 0x78, 0x56, 0xa2, 0xe5, 0xc0, 0xb3, 0x0d, 0x78, 0x63, 0x71,
+	0xee, 0x29, 0x03, 0xe1, 0xe3, 0x68, 0x21, 0xbe, 0xf5, 0x8b, 0x85, 0xf0, 0x62, 0xfa, 0x3f, 0xef,
+	0x11, 0x86, 0x56, 0x48, 0xca, 0xcb, 0x4c, 0x98, 0x6d, 0x7a, 0xdf, 0xd1, 0x77, 0xc2, 0x91, 0x77,
+	0x62, 0x66, 0x96, 0x86, 0x8f, 0x38, 0xcb, 0xbc, 0x87, 0xc6, 0xe1, 0x27, 0x31, 0x13, 0xfb, 0x65,
+	0xe0, 0x84, 0x3c, 0x75, 0xcd, 0x1d, 0xd2, 0x3f, 0xf7, 0x21, 0x7a, 0xe5, 0x4a, 0x1c, 0x54, 0x35,
+	0xe0, 0x1b, 0xc0, 0xd6, 0xaf, 0x5d, 0x74, 0xdd, 0xb4, 0x8a, 0x6e, 0x92, 0x4f, 0xd1, 0x8a, 0xee,
+	0x76, 0xe5, 0xad, 0xbb, 0x63, 0xb7, 0xed, 0xd5, 0x73, 0x95, 0xe5, 0x5d, 0x91, 0x74, 0xdf, 0xd4,
+	0x60, 0x8e, 0xfa, 0x25, 0x44, 0x87, 0xd5, 0x69, 0x83, 0x94, 0x34, 0x4d, 0xdd, 0xba, 0xe9, 0x8b,
+	0x9d, 0xea, 0xdd, 0x96, 0xa2, 0xa7, 0xef, 0x46, 0xbd, 0xaf, 0x76, 0x1f, 0x7f, 0x5d, 0x9b, 0xf0,
+	0x7b, 0x52, 0xbd, 0xde, 0xd3, 0x0c, 0x0d, 0xf6, 0x15, 0xa9, 0xcc, 0xf3, 0xe4, 0xa8, 0xc9, 0x5d,
+	0xfe, 0xd7, 0x5c, 0xbd, 0x98, 0x5b, 0x52, 0x71, 0x57, 0x09, 0x9e, 0x87, 0x0a, 0x78, 0x51, 0xf0,
+	0x83, 0x26, 0xea, 0xca, 0x7f, 0x41, 0x79, 0x4a, 0xb0, 0x8e, 0x7a, 0x89, 0x36, 0x22, 0x9a, 0xd0,
+	0x98, 0x08, 0x5e, 0x34, 0x41, 0xff, 0xbb, 0x24, 0x68, 0x7d, 0xaa, 0x57, 0xe7, 0x7c, 0x8b, 0xfa,
+	0x70, 0x40, 0xf2, 0x26, 0x62, 0xe5, 0x92, 0x88, 0x9e, 0x94, 0xaa, 0xab, 0xff, 0x68, 0xa1, 0x9b,
+	0xaa, 0x1b, 0x52, 0x96, 0x09, 0x96, 0xc5, 0x63, 0xfd, 0xac, 0x1d, 0xfc, 0xff, 0xe2, 0xbb, 0x2f,
+	0xcf, 0xfc, 0x99, 0xae, 0x78, 0x24, 0x0b, 0x3c, 0xc7, 0x74, 0x43, 0x7f, 0x7e, 0x06, 0xde, 0x9c,
+	0x9c, 0x13, 0xf4, 0x55, 0x0b, 0x36, 0x42, 0xf8, 0x27, 0x0b, 0xd9, 0xea, 0xf0, 0x12, 0xf6, 0x7d,
+	0xc9, 0x22, 0x26, 0x8e, 0xc6, 0x79, 0xc1, 0x27, 0x2c, 0xa2, 0x45, 0xe5, 0xea, 0xaa, 0x72, 0xb5,
+	0xd3, 0xe6, 0xea, 0x4b, 0x52, 0x44, 0x4f, 0xab, 0xe2, 0xe7, 0xa6, 0x56, 0xfb, 0xbb, 0x6b, 0x2e,
+	0xe0, 0x9d, 0xf6, 0x1c, 0xf0, 0xef, 0xec, 0xb7, 0x4f, 0xe2, 0xef, 0xd0, 0x8d, 0xd9, 0x79, 0x1b,
+	0x3f, 0xd7, 0x94, 0x9f, 0x0f, 0x5b, 0x1f, 0x55, 0x55, 0xbe, 0xf6, 0x70, 0xdb, 0x78, 0xe8, 0x35,
+	0xe3, 0xe0, 0xf7, 0xa2, 0x66, 0x00, 0xbf, 0x40, 0x5d, 0x75, 0xe6, 0x06, 0x83, 0x14, 0xe6, 0x83,
+	0x36, 0xcc, 0xee, 0x01, 0xc9, 0x35, 0x01, 0x1b, 0x02, 0x9a, 0x86, 0xc0, 0x47, 0x30, 0x1d, 0xe3,
+	0x00, 0xad, 0x03, 0x99, 0xb0, 0x2c, 0x86, 0x66, 0x3b, 0x75, 0x2f, 0xd9, 0x4e, 0xd8, 0xa8, 0xd5,
+	0x3b, 0x2a, 0x40, 0x6b, 0x15, 0xc3, 0xd8, 0xbf, 0xae, 0xec, 0xdf, 0x6b, 0xb5, 0xaf, 0xb3, 0xf5,
+	0x0a, 0x6e, 0x99, 0x15, 0xac, 0xd6, 0xa3, 0xe0, 0xaf, 0x42, 0xfd, 0xaf, 0xbc, 0x13, 0x94, 0x14,
+	0x59, 0x73, 0x11, 0xab, 0x97, 0xbd, 0x13, 0x52, 0xaa, 0xbe, 0x82, 0x17, 0xa8, 0xab, 0xd4, 0x8d,
+	0xfd, 0xb5, 0x8b, 0x77, 0xff, 0x73, 0x52, 0x64, 0x73, 0xbb, 0x3f, 0x0d, 0x81, 0x8f, 0xe8, 0x74,
+	0xec, 0x3d, 0x39, 0xfe, 0xc3, 0xee, 0x1c, 0x9f, 0xda, 0xd6, 0xdb, 0x53, 0xdb, 0xfa, 0xfd, 0xd4,
+	0xb6, 0x5e, 0x9f, 0xd9, 0x9d, 0xb7, 0x67, 0x76, 0xe7, 0xb7, 0x33, 0xbb, 0xf3, 0x4d, 0xfd, 0xd5,
+	0x20, 0x51, 0xf7, 0x13, 0x12, 0x80, 0x1a, 0xb9, 0x87, 0xb5, 0xcf, 0x1d, 0xf5, 0x8e, 0x08, 0x56,
+	0xd4, 0x57, 0xc7, 0xc3, 0xbf, 0x06, 0x00, 0xe3, 0x49, 0xb0, 0xf4, 0xc7, 0x09, 0x00, 0x00,
 }
 
 func (m *AccumulationTime) Marshal() (dAtA []byte, err error) {
@@ -278,6 +327,20 @@ func (m *GenesisRewardState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DistributedRewards) > 0 {
+		for iNdEx := len(m.DistributedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.MultiRewardIndexes) > 0 {
 		for iNdEx := len(m.MultiRewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -309,6 +372,50 @@ func (m *GenesisRewardState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DistributedRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributedRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributedRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -540,6 +647,31 @@ func (m *GenesisRewardState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DistributedRewards) > 0 {
+		for _, e := range m.DistributedRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DistributedRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -822,6 +954,156 @@ func (m *GenesisRewardState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedRewards = append(m.DistributedRewards, DistributedRewards{})
+			if err := m.DistributedRewards[len(m.DistributedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributedRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributedRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributedRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.DecCoin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
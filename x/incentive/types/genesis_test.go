@@ -188,4 +188,58 @@ func TestGenesisAccumulationTimes_Validate(t *testing.T) {
 	}
 }
 
+func TestDistributedRewardsList_Validate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		drs     DistributedRewardsList
+		wantErr bool
+	}{
+		{
+			name: "normal",
+			drs: DistributedRewardsList{
+				NewDistributedRewards("btcb", sdk.NewDecCoins(sdk.NewInt64DecCoin("hard", 1e6))),
+				NewDistributedRewards("bnb", nil),
+			},
+			wantErr: false,
+		},
+		{
+			name:    "empty",
+			drs:     nil,
+			wantErr: false,
+		},
+		{
+			name: "empty collateral type",
+			drs: DistributedRewardsList{
+				NewDistributedRewards("", sdk.NewDecCoins(sdk.NewInt64DecCoin("hard", 1e6))),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid amount",
+			drs: DistributedRewardsList{
+				NewDistributedRewards("btcb", sdk.DecCoins{{Denom: "hard", Amount: sdk.NewDec(-1)}}),
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate collateral type",
+			drs: DistributedRewardsList{
+				NewDistributedRewards("btcb", nil),
+				NewDistributedRewards("btcb", nil),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.drs.Validate()
+			if tc.wantErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
 var normalAccumulationtime = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
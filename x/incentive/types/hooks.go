@@ -0,0 +1,20 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// MultiIncentiveHooks combine multiple incentive hooks, all hook functions are run in array sequence
+type MultiIncentiveHooks []IncentiveHooks
+
+var _ IncentiveHooks = MultiIncentiveHooks{}
+
+// NewMultiIncentiveHooks returns a new MultiIncentiveHooks
+func NewMultiIncentiveHooks(hooks ...IncentiveHooks) MultiIncentiveHooks {
+	return hooks
+}
+
+// AfterClaimPayout runs after the rewards of a claim are paid out
+func (h MultiIncentiveHooks) AfterClaimPayout(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, claimType string) {
+	for i := range h {
+		h[i].AfterClaimPayout(ctx, owner, coins, claimType)
+	}
+}
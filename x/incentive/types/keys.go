@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "incentive"
@@ -36,4 +41,41 @@ var (
 	EarnClaimKeyPrefix                            = []byte{0x18} // prefix for keys that store earn claims
 	EarnRewardIndexesKeyPrefix                    = []byte{0x19} // prefix for key that stores earn reward indexes
 	PreviousEarnRewardAccrualTimeKeyPrefix        = []byte{0x20} // prefix for key that stores the previous time earn rewards accrued
+	PreviousBlockTimeKey                          = []byte{0x21} // key for the block time of the previous begin blocker
+	DistributedRewardsKeyPrefix                   = []byte{0x22} // prefix for keys that store the rewards paid out by capped reward periods
+	RewardIndexesSnapshotKeyPrefix                = []byte{0x23} // prefix for keys that store snapshots of the global reward indexes
+	DelegatorValidatorCheckpointKeyPrefix         = []byte{0x24} // prefix for keys that store checkpoints of validators' delegator reward periods
+	NextDelegatorCheckpointIDKey                  = []byte{0x25} // key for the ID of the next delegator reward checkpoint
+	DelegatorClaimCheckpointIDKeyPrefix           = []byte{0x26} // prefix for keys that store the first checkpoint ID not yet synced to a delegator claim
+	DelegatorClaimCheckpointIDIndexPrefix         = []byte{0x27} // prefix for keys that index delegator claims by the first checkpoint ID not yet synced to them
+	DelegatorValidatorCheckpointIDIndexPrefix     = []byte{0x28} // prefix for keys that index validators' checkpoints by ID
 )
+
+// RewardIndexesSnapshotInterval is the number of blocks between snapshots of the global reward indexes.
+const RewardIndexesSnapshotInterval int64 = 600
+
+// RewardIndexesSnapshotKey returns the key storing the reward indexes snapshot taken at a block height.
+func RewardIndexesSnapshotKey(height int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(height))
+}
+
+// DelegatorValidatorCheckpointKey returns the key storing a checkpoint of a validator's delegator reward period.
+func DelegatorValidatorCheckpointKey(valAddr sdk.ValAddress, id uint64) []byte {
+	return append(address.MustLengthPrefix(valAddr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// DelegatorClaimCheckpointIDIndexKey returns the key indexing a delegator claim by the first checkpoint ID not yet synced
+// to it.
+func DelegatorClaimCheckpointIDIndexKey(id uint64, owner sdk.AccAddress) []byte {
+	return append(sdk.Uint64ToBigEndian(id), owner...)
+}
+
+// DelegatorValidatorCheckpointIDIndexKey returns the key indexing a validator's checkpoint by its ID.
+func DelegatorValidatorCheckpointIDIndexKey(id uint64, valAddr sdk.ValAddress) []byte {
+	return append(sdk.Uint64ToBigEndian(id), valAddr...)
+}
+
+// DistributedRewardsKey returns the key storing the rewards paid out for a reward source and collateral type.
+func DistributedRewardsKey(source, collateralType string) []byte {
+	return append(address.MustLengthPrefix([]byte(source)), []byte(collateralType)...)
+}
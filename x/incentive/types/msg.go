@@ -17,6 +17,9 @@ var (
 	_ sdk.Msg = &MsgClaimSwapReward{}
 	_ sdk.Msg = &MsgClaimSavingsReward{}
 	_ sdk.Msg = &MsgClaimEarnReward{}
+	_ sdk.Msg = &MsgClaimAll{}
+	_ sdk.Msg = &MsgRevokeClaim{}
+	_ sdk.Msg = &MsgMergeClaims{}
 
 	_ legacytx.LegacyMsg = &MsgClaimUSDXMintingReward{}
 	_ legacytx.LegacyMsg = &MsgClaimHardReward{}
@@ -24,6 +27,9 @@ var (
 	_ legacytx.LegacyMsg = &MsgClaimSwapReward{}
 	_ legacytx.LegacyMsg = &MsgClaimSavingsReward{}
 	_ legacytx.LegacyMsg = &MsgClaimEarnReward{}
+	_ legacytx.LegacyMsg = &MsgClaimAll{}
+	_ legacytx.LegacyMsg = &MsgRevokeClaim{}
+	_ legacytx.LegacyMsg = &MsgMergeClaims{}
 )
 
 const (
@@ -33,8 +39,22 @@ const (
 	TypeMsgClaimSwapReward        = "claim_swap_reward"
 	TypeMsgClaimSavingsReward     = "claim_savings_reward"
 	TypeMsgClaimEarnReward        = "claim_earn_reward"
+	TypeMsgClaimAll               = "claim_all"
+	TypeMsgRevokeClaim            = "revoke_claim"
+	TypeMsgMergeClaims            = "merge_claims"
 )
 
+// validateReceiver checks the optional receiver address of a claim msg.
+func validateReceiver(receiver string) error {
+	if receiver == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(receiver); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "receiver address is invalid")
+	}
+	return nil
+}
+
 // NewMsgClaimUSDXMintingReward returns a new MsgClaimUSDXMintingReward.
 func NewMsgClaimUSDXMintingReward(sender string, multiplierName string) MsgClaimUSDXMintingReward {
 	return MsgClaimUSDXMintingReward{
@@ -55,6 +75,9 @@ func (msg MsgClaimUSDXMintingReward) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty or invalid")
 	}
+	if err := validateReceiver(msg.Receiver); err != nil {
+		return err
+	}
 	if msg.MultiplierName == "" {
 		return errorsmod.Wrap(ErrInvalidMultiplier, "multiplier name cannot be empty")
 	}
@@ -98,6 +121,9 @@ func (msg MsgClaimHardReward) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty or invalid")
 	}
+	if err := validateReceiver(msg.Receiver); err != nil {
+		return err
+	}
 	if err := msg.DenomsToClaim.Validate(); err != nil {
 		return err
 	}
@@ -141,6 +167,9 @@ func (msg MsgClaimDelegatorReward) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty or invalid")
 	}
+	if err := validateReceiver(msg.Receiver); err != nil {
+		return err
+	}
 	if err := msg.DenomsToClaim.Validate(); err != nil {
 		return err
 	}
@@ -184,6 +213,9 @@ func (msg MsgClaimSwapReward) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty or invalid")
 	}
+	if err := validateReceiver(msg.Receiver); err != nil {
+		return err
+	}
 	if err := msg.DenomsToClaim.Validate(); err != nil {
 		return err
 	}
@@ -227,6 +259,9 @@ func (msg MsgClaimSavingsReward) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty or invalid")
 	}
+	if err := validateReceiver(msg.Receiver); err != nil {
+		return err
+	}
 	if err := msg.DenomsToClaim.Validate(); err != nil {
 		return err
 	}
@@ -270,6 +305,9 @@ func (msg MsgClaimEarnReward) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty or invalid")
 	}
+	if err := validateReceiver(msg.Receiver); err != nil {
+		return err
+	}
 	if err := msg.DenomsToClaim.Validate(); err != nil {
 		return err
 	}
@@ -290,3 +328,152 @@ func (msg MsgClaimEarnReward) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// NewMsgClaimAll returns a new MsgClaimAll.
+func NewMsgClaimAll(sender string, denomsToClaim Selections) MsgClaimAll {
+	return MsgClaimAll{
+		Sender:        sender,
+		DenomsToClaim: denomsToClaim,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgClaimAll) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgClaimAll) Type() string {
+	return TypeMsgClaimAll
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgClaimAll) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty or invalid")
+	}
+	if err := validateReceiver(msg.Receiver); err != nil {
+		return err
+	}
+	if err := msg.DenomsToClaim.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgClaimAll) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgClaimAll) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgRevokeClaim returns a new MsgRevokeClaim.
+func NewMsgRevokeClaim(authority, owner, claimType string, amount sdk.Coins) MsgRevokeClaim {
+	return MsgRevokeClaim{
+		Authority: authority,
+		Owner:     owner,
+		ClaimType: claimType,
+		Amount:    amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRevokeClaim) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRevokeClaim) Type() string {
+	return TypeMsgRevokeClaim
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgRevokeClaim) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "authority address cannot be empty or invalid")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty or invalid")
+	}
+	switch msg.ClaimType {
+	case USDXMintingClaimType, HardLiquidityProviderClaimType, DelegatorClaimType, SwapClaimType, SavingsClaimType, EarnClaimType:
+	default:
+		return errorsmod.Wrapf(ErrInvalidClaimType, "%s", msg.ClaimType)
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidRevokeAmount, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRevokeClaim) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRevokeClaim) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgMergeClaims returns a new MsgMergeClaims.
+func NewMsgMergeClaims(authority, from, to string) MsgMergeClaims {
+	return MsgMergeClaims{
+		Authority: authority,
+		From:      from,
+		To:        to,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgMergeClaims) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgMergeClaims) Type() string {
+	return TypeMsgMergeClaims
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgMergeClaims) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "authority address cannot be empty or invalid")
+	}
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "from address cannot be empty or invalid")
+	}
+	to, err := sdk.AccAddressFromBech32(msg.To)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "to address cannot be empty or invalid")
+	}
+	if from.Equals(to) {
+		return errorsmod.Wrap(ErrInvalidClaimMerge, "from and to addresses must be different")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgMergeClaims) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgMergeClaims) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
@@ -23,6 +23,7 @@ func TestMsgClaim_Validate(t *testing.T) {
 	type msgArgs struct {
 		sender        string
 		denomsToClaim types.Selections
+		receiver      string
 	}
 	tests := []struct {
 		name    string
@@ -94,6 +95,38 @@ func TestMsgClaim_Validate(t *testing.T) {
 				wraps: sdkerrors.ErrInvalidAddress,
 			},
 		},
+		{
+			name: "receiver is valid",
+			msgArgs: msgArgs{
+				sender: validAddress,
+				denomsToClaim: types.Selections{
+					{
+						Denom:          "hard",
+						MultiplierName: "medium",
+					},
+				},
+				receiver: sdk.AccAddress(crypto.AddressHash([]byte("KavaTest2"))).String(),
+			},
+			expect: expectedErr{
+				pass: true,
+			},
+		},
+		{
+			name: "invalid receiver",
+			msgArgs: msgArgs{
+				sender: validAddress,
+				denomsToClaim: types.Selections{
+					{
+						Denom:          "hard",
+						MultiplierName: "medium",
+					},
+				},
+				receiver: "invalid",
+			},
+			expect: expectedErr{
+				wraps: sdkerrors.ErrInvalidAddress,
+			},
+		},
 		{
 			name: "invalid claim denom",
 			msgArgs: msgArgs{
@@ -145,7 +178,13 @@ func TestMsgClaim_Validate(t *testing.T) {
 		msgClaimDelegatorReward := types.NewMsgClaimDelegatorReward(tc.msgArgs.sender, tc.msgArgs.denomsToClaim)
 		msgClaimSwapReward := types.NewMsgClaimSwapReward(tc.msgArgs.sender, tc.msgArgs.denomsToClaim)
 		msgClaimSavingsReward := types.NewMsgClaimSavingsReward(tc.msgArgs.sender, tc.msgArgs.denomsToClaim)
-		msgs := []sdk.Msg{&msgClaimHardReward, &msgClaimDelegatorReward, &msgClaimSwapReward, &msgClaimSavingsReward}
+		msgClaimAll := types.NewMsgClaimAll(tc.msgArgs.sender, tc.msgArgs.denomsToClaim)
+		msgClaimHardReward.Receiver = tc.msgArgs.receiver
+		msgClaimDelegatorReward.Receiver = tc.msgArgs.receiver
+		msgClaimSwapReward.Receiver = tc.msgArgs.receiver
+		msgClaimSavingsReward.Receiver = tc.msgArgs.receiver
+		msgClaimAll.Receiver = tc.msgArgs.receiver
+		msgs := []sdk.Msg{&msgClaimHardReward, &msgClaimDelegatorReward, &msgClaimSwapReward, &msgClaimSavingsReward, &msgClaimAll}
 		for _, msg := range msgs {
 			t.Run(tc.name, func(t *testing.T) {
 				err := msg.ValidateBasic()
@@ -221,6 +260,197 @@ func TestMsgClaimUSDXMintingReward_Validate(t *testing.T) {
 	}
 }
 
+func TestMsgRevokeClaim_Validate(t *testing.T) {
+	validAddress := sdk.AccAddress(crypto.AddressHash([]byte("KavaTest1"))).String()
+
+	type expectedErr struct {
+		wraps error
+		pass  bool
+	}
+	type msgArgs struct {
+		authority string
+		owner     string
+		claimType string
+		amount    sdk.Coins
+	}
+	tests := []struct {
+		name    string
+		msgArgs msgArgs
+		expect  expectedErr
+	}{
+		{
+			name: "empty amount is valid",
+			msgArgs: msgArgs{
+				authority: validAddress,
+				owner:     validAddress,
+				claimType: types.SwapClaimType,
+			},
+			expect: expectedErr{
+				pass: true,
+			},
+		},
+		{
+			name: "amount is valid",
+			msgArgs: msgArgs{
+				authority: validAddress,
+				owner:     validAddress,
+				claimType: types.USDXMintingClaimType,
+				amount:    sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6)),
+			},
+			expect: expectedErr{
+				pass: true,
+			},
+		},
+		{
+			name: "invalid authority",
+			msgArgs: msgArgs{
+				authority: "",
+				owner:     validAddress,
+				claimType: types.SwapClaimType,
+			},
+			expect: expectedErr{
+				wraps: sdkerrors.ErrInvalidAddress,
+			},
+		},
+		{
+			name: "invalid owner",
+			msgArgs: msgArgs{
+				authority: validAddress,
+				owner:     "",
+				claimType: types.SwapClaimType,
+			},
+			expect: expectedErr{
+				wraps: sdkerrors.ErrInvalidAddress,
+			},
+		},
+		{
+			name: "invalid claim type",
+			msgArgs: msgArgs{
+				authority: validAddress,
+				owner:     validAddress,
+				claimType: "swap_claim",
+			},
+			expect: expectedErr{
+				wraps: types.ErrInvalidClaimType,
+			},
+		},
+		{
+			name: "invalid amount",
+			msgArgs: msgArgs{
+				authority: validAddress,
+				owner:     validAddress,
+				claimType: types.SwapClaimType,
+				amount:    sdk.Coins{sdk.Coin{Denom: "ukava", Amount: sdk.NewInt(-1)}},
+			},
+			expect: expectedErr{
+				wraps: types.ErrInvalidRevokeAmount,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgRevokeClaim(tc.msgArgs.authority, tc.msgArgs.owner, tc.msgArgs.claimType, tc.msgArgs.amount)
+
+			err := msg.ValidateBasic()
+			if tc.expect.pass {
+				require.NoError(t, err)
+			} else {
+				require.Truef(t, errors.Is(err, tc.expect.wraps), "expected error '%s' was not actual '%s'", tc.expect.wraps, err)
+			}
+		})
+	}
+}
+
+func TestMsgMergeClaims_Validate(t *testing.T) {
+	validAddress := sdk.AccAddress(crypto.AddressHash([]byte("KavaTest1"))).String()
+	otherAddress := sdk.AccAddress(crypto.AddressHash([]byte("KavaTest2"))).String()
+
+	type expectedErr struct {
+		wraps error
+		pass  bool
+	}
+	type msgArgs struct {
+		authority string
+		from      string
+		to        string
+	}
+	tests := []struct {
+		name    string
+		msgArgs msgArgs
+		expect  expectedErr
+	}{
+		{
+			name: "valid",
+			msgArgs: msgArgs{
+				authority: validAddress,
+				from:      validAddress,
+				to:        otherAddress,
+			},
+			expect: expectedErr{
+				pass: true,
+			},
+		},
+		{
+			name: "invalid authority",
+			msgArgs: msgArgs{
+				authority: "",
+				from:      validAddress,
+				to:        otherAddress,
+			},
+			expect: expectedErr{
+				wraps: sdkerrors.ErrInvalidAddress,
+			},
+		},
+		{
+			name: "invalid from",
+			msgArgs: msgArgs{
+				authority: validAddress,
+				from:      "",
+				to:        otherAddress,
+			},
+			expect: expectedErr{
+				wraps: sdkerrors.ErrInvalidAddress,
+			},
+		},
+		{
+			name: "invalid to",
+			msgArgs: msgArgs{
+				authority: validAddress,
+				from:      validAddress,
+				to:        "",
+			},
+			expect: expectedErr{
+				wraps: sdkerrors.ErrInvalidAddress,
+			},
+		},
+		{
+			name: "from and to are the same",
+			msgArgs: msgArgs{
+				authority: validAddress,
+				from:      otherAddress,
+				to:        otherAddress,
+			},
+			expect: expectedErr{
+				wraps: types.ErrInvalidClaimMerge,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgMergeClaims(tc.msgArgs.authority, tc.msgArgs.from, tc.msgArgs.to)
+
+			err := msg.ValidateBasic()
+			if tc.expect.pass {
+				require.NoError(t, err)
+			} else {
+				require.Truef(t, errors.Is(err, tc.expect.wraps), "expected error '%s' was not actual '%s'", tc.expect.wraps, err)
+			}
+		})
+	}
+}
+
 func tooManySelections() types.Selections {
 	selections := make(types.Selections, types.MaxDenomsToClaim+1)
 	for i := range selections {
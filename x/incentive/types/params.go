@@ -180,13 +180,15 @@ func NewRewardPeriod(active bool, collateralType string, start time.Time, end ti
 // NewMultiRewardPeriodFromRewardPeriod converts a RewardPeriod into a MultiRewardPeriod.
 // It's useful for compatibility between single and multi denom rewards.
 func NewMultiRewardPeriodFromRewardPeriod(period RewardPeriod) MultiRewardPeriod {
-	return NewMultiRewardPeriod(
+	multiPeriod := NewMultiRewardPeriod(
 		period.Active,
 		period.CollateralType,
 		period.Start,
 		period.End,
 		sdk.NewCoins(period.RewardsPerSecond),
 	)
+	multiPeriod.RewardsCap = period.RewardsCap
+	return multiPeriod
 }
 
 // Validate performs a basic check of a RewardPeriod fields.
@@ -211,6 +213,9 @@ func (rp RewardPeriod) Validate() error {
 	if rp.RewardsPerSecond.Amount.IsZero() {
 		return fmt.Errorf("reward amount cannot be zero: %v", rp.RewardsPerSecond)
 	}
+	if err := validateRewardsCap(rp.RewardsCap); err != nil {
+		return err
+	}
 
 	if strings.TrimSpace(rp.CollateralType) == "" {
 		return fmt.Errorf("reward period collateral type cannot be blank: %v", rp)
@@ -267,12 +272,85 @@ func (mrp MultiRewardPeriod) Validate() error {
 	if !mrp.RewardsPerSecond.IsValid() {
 		return fmt.Errorf("invalid reward amount: %s", mrp.RewardsPerSecond)
 	}
+	if err := validateRewardsCap(mrp.RewardsCap); err != nil {
+		return err
+	}
+	if err := mrp.ShareClassWeights.Validate(); err != nil {
+		return err
+	}
 	if strings.TrimSpace(mrp.CollateralType) == "" {
 		return fmt.Errorf("reward period collateral type cannot be blank: %v", mrp)
 	}
 	return nil
 }
 
+// validateRewardsCap checks a reward period's optional rewards cap. An empty cap leaves the period uncapped.
+func validateRewardsCap(rewardsCap sdk.Coins) error {
+	// This also ensures there are no 0 amount coins.
+	if !rewardsCap.Empty() && !rewardsCap.IsValid() {
+		return fmt.Errorf("invalid rewards cap: %s", rewardsCap)
+	}
+	return nil
+}
+
+// NewShareClassWeight returns a new ShareClassWeight
+func NewShareClassWeight(shareClass string, weight sdk.Dec) ShareClassWeight {
+	return ShareClassWeight{
+		ShareClass: shareClass,
+		Weight:     weight,
+	}
+}
+
+// Validate performs a basic check of a ShareClassWeight.
+func (w ShareClassWeight) Validate() error {
+	if strings.TrimSpace(w.ShareClass) == "" {
+		return errors.New("share class cannot be blank")
+	}
+	if w.Weight.IsNil() || w.Weight.IsNegative() {
+		return fmt.Errorf("share class %s weight must be non-negative: %s", w.ShareClass, w.Weight)
+	}
+	return nil
+}
+
+// ShareClassWeights array of ShareClassWeight
+type ShareClassWeights []ShareClassWeight
+
+// Get returns the weight of a share class. Share classes that are not weighted have a weight of one.
+func (ws ShareClassWeights) Get(shareClass string) sdk.Dec {
+	for _, w := range ws {
+		if w.ShareClass == shareClass {
+			return w.Weight
+		}
+	}
+	return sdk.OneDec()
+}
+
+// WeighShares returns the sum of shares in each share class multiplied by the share class weight.
+// The shares' IDs are their share classes.
+func (ws ShareClassWeights) WeighShares(shares []SourceShares) sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, s := range shares {
+		total = total.Add(s.Shares.Mul(ws.Get(s.ID)))
+	}
+	return total
+}
+
+// Validate checks if all the ShareClassWeights are valid and there are no duplicated share classes.
+func (ws ShareClassWeights) Validate() error {
+	seenShareClasses := make(map[string]bool)
+	for _, w := range ws {
+		if seenShareClasses[w.ShareClass] {
+			return fmt.Errorf("duplicated share class weight for %s", w.ShareClass)
+		}
+
+		if err := w.Validate(); err != nil {
+			return err
+		}
+		seenShareClasses[w.ShareClass] = true
+	}
+	return nil
+}
+
 // MultiRewardPeriods array of MultiRewardPeriod
 type MultiRewardPeriods []MultiRewardPeriod
 
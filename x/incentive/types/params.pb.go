@@ -36,6 +36,9 @@ type RewardPeriod struct {
 	Start            time.Time  `protobuf:"bytes,3,opt,name=start,proto3,stdtime" json:"start"`
 	End              time.Time  `protobuf:"bytes,4,opt,name=end,proto3,stdtime" json:"end"`
 	RewardsPerSecond types.Coin `protobuf:"bytes,5,opt,name=rewards_per_second,json=rewardsPerSecond,proto3" json:"rewards_per_second"`
+	// rewards_cap optionally limits the total rewards paid out for the collateral type.
+	// Once reached, the reward period stops accumulating rewards. Empty means uncapped.
+	RewardsCap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=rewards_cap,json=rewardsCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards_cap"`
 }
 
 func (m *RewardPeriod) Reset()         { *m = RewardPeriod{} }
@@ -78,6 +81,12 @@ type MultiRewardPeriod struct {
 	Start            time.Time                                `protobuf:"bytes,3,opt,name=start,proto3,stdtime" json:"start"`
 	End              time.Time                                `protobuf:"bytes,4,opt,name=end,proto3,stdtime" json:"end"`
 	RewardsPerSecond github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=rewards_per_second,json=rewardsPerSecond,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards_per_second"`
+	// rewards_cap optionally limits the total rewards paid out for the collateral type, per reward denom.
+	// Once a denom's cap is reached, the reward period stops accumulating that denom. Empty means uncapped.
+	RewardsCap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=rewards_cap,json=rewardsCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards_cap"`
+	// share_class_weights optionally weights the rewards of each share class in an earn vault, such as locked and liquid
+	// shares. Share classes that are not listed have a weight of one. Weights are only used by earn reward periods.
+	ShareClassWeights ShareClassWeights `protobuf:"bytes,7,rep,name=share_class_weights,json=shareClassWeights,proto3,castrepeated=ShareClassWeights" json:"share_class_weights"`
 }
 
 func (m *MultiRewardPeriod) Reset()         { *m = MultiRewardPeriod{} }
@@ -113,6 +122,46 @@ func (m *MultiRewardPeriod) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MultiRewardPeriod proto.InternalMessageInfo
 
+// ShareClassWeight is the reward weight of a share class in an earn vault. Shares of the class are multiplied by the
+// weight when rewards are split between the vault's depositors.
+type ShareClassWeight struct {
+	ShareClass string                                 `protobuf:"bytes,1,opt,name=share_class,json=shareClass,proto3" json:"share_class,omitempty"`
+	Weight     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *ShareClassWeight) Reset()         { *m = ShareClassWeight{} }
+func (m *ShareClassWeight) String() string { return proto.CompactTextString(m) }
+func (*ShareClassWeight) ProtoMessage()    {}
+func (*ShareClassWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb8833f5d745eac9, []int{2}
+}
+func (m *ShareClassWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShareClassWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShareClassWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShareClassWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareClassWeight.Merge(m, src)
+}
+func (m *ShareClassWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShareClassWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareClassWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShareClassWeight proto.InternalMessageInfo
+
 // Multiplier amount the claim rewards get increased by, along with how long the claim rewards are locked
 type Multiplier struct {
 	Name         string                                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -124,7 +173,7 @@ func (m *Multiplier) Reset()         { *m = Multiplier{} }
 func (m *Multiplier) String() string { return proto.CompactTextString(m) }
 func (*Multiplier) ProtoMessage()    {}
 func (*Multiplier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb8833f5d745eac9, []int{2}
+	return fileDescriptor_bb8833f5d745eac9, []int{3}
 }
 func (m *Multiplier) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -163,7 +212,7 @@ func (m *MultipliersPerDenom) Reset()         { *m = MultipliersPerDenom{} }
 func (m *MultipliersPerDenom) String() string { return proto.CompactTextString(m) }
 func (*MultipliersPerDenom) ProtoMessage()    {}
 func (*MultipliersPerDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb8833f5d745eac9, []int{3}
+	return fileDescriptor_bb8833f5d745eac9, []int{4}
 }
 func (m *MultipliersPerDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -209,7 +258,7 @@ func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb8833f5d745eac9, []int{4}
+	return fileDescriptor_bb8833f5d745eac9, []int{5}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,6 +290,7 @@ var xxx_messageInfo_Params proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*RewardPeriod)(nil), "kava.incentive.v1beta1.RewardPeriod")
 	proto.RegisterType((*MultiRewardPeriod)(nil), "kava.incentive.v1beta1.MultiRewardPeriod")
+	proto.RegisterType((*ShareClassWeight)(nil), "kava.incentive.v1beta1.ShareClassWeight")
 	proto.RegisterType((*Multiplier)(nil), "kava.incentive.v1beta1.Multiplier")
 	proto.RegisterType((*MultipliersPerDenom)(nil), "kava.incentive.v1beta1.MultipliersPerDenom")
 	proto.RegisterType((*Params)(nil), "kava.incentive.v1beta1.Params")
@@ -251,56 +301,61 @@ func init() {
 }
 
 var fileDescriptor_bb8833f5d745eac9 = []byte{
-	// 774 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0xcf, 0x6e, 0xd3, 0x4a,
-	0x14, 0xc6, 0xe3, 0xfc, 0xbb, 0xc9, 0xb4, 0xbd, 0xb7, 0x9d, 0x46, 0xb9, 0xbe, 0xb9, 0xc8, 0xa9,
-	0x52, 0x04, 0x41, 0x55, 0x6d, 0x0a, 0x12, 0x0b, 0x76, 0x98, 0x82, 0x84, 0x44, 0xa5, 0xca, 0x2d,
-	0x12, 0xb0, 0x89, 0x26, 0xf6, 0xd4, 0xb5, 0x6a, 0x7b, 0xac, 0x99, 0x49, 0xda, 0x88, 0x05, 0x12,
-	0x0b, 0x76, 0x48, 0x15, 0x0b, 0x1e, 0xa2, 0xaf, 0xc1, 0xa6, 0xcb, 0x8a, 0x15, 0x62, 0xd1, 0x42,
-	0xfa, 0x22, 0x68, 0xc6, 0x6e, 0xe3, 0xa4, 0x69, 0xa1, 0x52, 0x36, 0xac, 0x32, 0x3e, 0x73, 0xce,
-	0xf9, 0x7d, 0xfe, 0xc6, 0x67, 0x14, 0xb0, 0xb8, 0x83, 0xba, 0xc8, 0xf0, 0x42, 0x1b, 0x87, 0xdc,
-	0xeb, 0x62, 0xa3, 0xbb, 0xd2, 0xc6, 0x1c, 0xad, 0x18, 0x11, 0xa2, 0x28, 0x60, 0x7a, 0x44, 0x09,
-	0x27, 0xb0, 0x2a, 0x92, 0xf4, 0xf3, 0x24, 0x3d, 0x49, 0xaa, 0x69, 0x36, 0x61, 0x01, 0x61, 0x46,
-	0x1b, 0xb1, 0x41, 0xa5, 0x4d, 0xbc, 0x30, 0xae, 0xab, 0x55, 0x5c, 0xe2, 0x12, 0xb9, 0x34, 0xc4,
-	0x2a, 0x89, 0xd6, 0x5d, 0x42, 0x5c, 0x1f, 0x1b, 0xf2, 0xa9, 0xdd, 0xd9, 0x32, 0xb8, 0x17, 0x60,
-	0xc6, 0x51, 0x10, 0xc5, 0x09, 0x8d, 0x8f, 0x59, 0x30, 0x6d, 0xe1, 0x5d, 0x44, 0x9d, 0x75, 0x4c,
-	0x3d, 0xe2, 0xc0, 0x2a, 0x28, 0x22, 0x5b, 0x90, 0x55, 0x65, 0x41, 0x69, 0x96, 0xac, 0xe4, 0x09,
-	0xde, 0x06, 0xff, 0xd8, 0xc4, 0xf7, 0x11, 0xc7, 0x14, 0xf9, 0x2d, 0xde, 0x8b, 0xb0, 0x9a, 0x5d,
-	0x50, 0x9a, 0x65, 0xeb, 0xef, 0x41, 0x78, 0xb3, 0x17, 0x61, 0xf8, 0x10, 0x14, 0x18, 0x47, 0x94,
-	0xab, 0xb9, 0x05, 0xa5, 0x39, 0x75, 0xaf, 0xa6, 0xc7, 0x12, 0xf4, 0x33, 0x09, 0xfa, 0xe6, 0x99,
-	0x04, 0xb3, 0x74, 0x78, 0x5c, 0xcf, 0xec, 0x9f, 0xd4, 0x15, 0x2b, 0x2e, 0x81, 0x0f, 0x40, 0x0e,
-	0x87, 0x8e, 0x9a, 0xbf, 0x46, 0xa5, 0x28, 0x80, 0x6b, 0x00, 0x52, 0xf9, 0x12, 0xac, 0x15, 0x61,
-	0xda, 0x62, 0xd8, 0x26, 0xa1, 0xa3, 0x16, 0x64, 0x9b, 0xff, 0xf4, 0xd8, 0x39, 0x5d, 0x38, 0x77,
-	0x66, 0xa7, 0xfe, 0x98, 0x78, 0xa1, 0x99, 0x17, 0x5d, 0xac, 0xd9, 0xa4, 0x74, 0x1d, 0xd3, 0x0d,
-	0x59, 0xd8, 0xf8, 0x9c, 0x05, 0x73, 0x6b, 0x1d, 0x9f, 0x7b, 0x7f, 0xbe, 0x33, 0xbd, 0x4b, 0x9c,
-	0xc9, 0x5d, 0xed, 0xcc, 0x5d, 0xd1, 0xe5, 0xe0, 0xa4, 0xde, 0x74, 0x3d, 0xbe, 0xdd, 0x69, 0xeb,
-	0x36, 0x09, 0x8c, 0xe4, 0x03, 0x8c, 0x7f, 0x96, 0x99, 0xb3, 0x63, 0x88, 0x77, 0x65, 0xb2, 0x80,
-	0x8d, 0x71, 0xf1, 0x83, 0x02, 0x80, 0x74, 0x31, 0xf2, 0x3d, 0x4c, 0x21, 0x04, 0xf9, 0x10, 0x05,
-	0xb1, 0x79, 0x65, 0x4b, 0xae, 0xe1, 0x22, 0x98, 0x09, 0x48, 0xc8, 0xb7, 0x59, 0xcb, 0x27, 0xf6,
-	0x4e, 0x27, 0x92, 0xc6, 0xe5, 0xac, 0xe9, 0x38, 0xf8, 0x5c, 0xc6, 0xe0, 0x53, 0x50, 0xdc, 0x42,
-	0x36, 0x27, 0x54, 0xfa, 0x36, 0x6d, 0xea, 0x42, 0xdb, 0xb7, 0xe3, 0xfa, 0xad, 0xdf, 0xd0, 0xb6,
-	0x8a, 0x6d, 0x2b, 0xa9, 0x6e, 0xbc, 0x57, 0xc0, 0xfc, 0x40, 0x8f, 0x10, 0xba, 0x8a, 0x43, 0x12,
-	0xc0, 0x0a, 0x28, 0x38, 0x62, 0x91, 0x28, 0x8b, 0x1f, 0xe0, 0x2b, 0x30, 0x15, 0x0c, 0x92, 0xd5,
-	0xac, 0x74, 0xac, 0xa1, 0x8f, 0x9f, 0x4e, 0x7d, 0xd0, 0xd7, 0x9c, 0x4f, 0xac, 0x9b, 0x4a, 0xb1,
-	0xac, 0x74, 0xaf, 0xc6, 0x97, 0x12, 0x28, 0xae, 0xcb, 0x99, 0x87, 0x9f, 0x14, 0xf0, 0x7f, 0x87,
-	0x39, 0x7b, 0xad, 0xc0, 0x0b, 0xb9, 0x17, 0xba, 0xad, 0xd8, 0x45, 0x71, 0x56, 0x1e, 0x71, 0x98,
-	0xaa, 0x48, 0xec, 0xcd, 0xcb, 0xb0, 0xe9, 0xef, 0xd3, 0x5c, 0x11, 0xe0, 0xfe, 0x71, 0x5d, 0x7d,
-	0xb1, 0xb1, 0xfa, 0x72, 0x2d, 0xee, 0x97, 0x4e, 0x60, 0x07, 0x27, 0xf5, 0x99, 0xa1, 0x80, 0xa5,
-	0x0a, 0xf6, 0xb8, 0x54, 0xf8, 0x4e, 0x01, 0xb5, 0x6d, 0xa1, 0x84, 0x75, 0xa2, 0xc8, 0xef, 0x8d,
-	0xea, 0x8a, 0xed, 0xb8, 0x73, 0xa5, 0x1d, 0x43, 0xe2, 0x6a, 0x89, 0x2b, 0xf0, 0xc2, 0x16, 0xb3,
-	0xfe, 0x15, 0xa0, 0x0d, 0xc9, 0xb9, 0x44, 0x44, 0x9b, 0x50, 0x4a, 0x76, 0x47, 0x45, 0xe4, 0x26,
-	0x2e, 0xc2, 0x94, 0x9c, 0x61, 0x11, 0x6f, 0x81, 0xea, 0x60, 0x1f, 0xbb, 0x88, 0x13, 0x3a, 0xaa,
-	0x20, 0x3f, 0x49, 0x05, 0xd5, 0x73, 0xcc, 0xb0, 0x80, 0x0e, 0x98, 0x67, 0xbb, 0x28, 0x1a, 0x65,
-	0x17, 0x26, 0xc9, 0x9e, 0x13, 0x84, 0x61, 0x6c, 0x17, 0xcc, 0xd9, 0x3e, 0xf2, 0x82, 0x56, 0x7a,
-	0x0c, 0x8a, 0x12, 0xba, 0xf4, 0xeb, 0x31, 0x38, 0x1f, 0x2f, 0xf3, 0x46, 0x82, 0xad, 0x8c, 0xd9,
-	0x64, 0xd6, 0xac, 0x64, 0xa4, 0xb6, 0xe0, 0x23, 0x50, 0x8e, 0xb9, 0xe2, 0xbe, 0xfb, 0xeb, 0x1a,
-	0xf7, 0x5d, 0x49, 0x96, 0x3d, 0x09, 0x1d, 0xf8, 0x06, 0x54, 0x19, 0xea, 0x7a, 0xa1, 0xcb, 0x46,
-	0x4d, 0x2b, 0x4d, 0xd2, 0xb4, 0x4a, 0x02, 0xb9, 0x70, 0x5c, 0x18, 0xd1, 0x70, 0x94, 0x5c, 0x9e,
-	0xe8, 0x71, 0x09, 0xc2, 0x50, 0xc8, 0x7c, 0x76, 0xf8, 0x43, 0xcb, 0x1c, 0xf6, 0x35, 0xe5, 0xa8,
-	0xaf, 0x29, 0xdf, 0xfb, 0x9a, 0xb2, 0x7f, 0xaa, 0x65, 0x8e, 0x4e, 0xb5, 0xcc, 0xd7, 0x53, 0x2d,
-	0xf3, 0x7a, 0x29, 0x75, 0x57, 0x0a, 0x05, 0xcb, 0x3e, 0x6a, 0x33, 0xb9, 0x32, 0xf6, 0x52, 0xff,
-	0x48, 0xe4, 0xa5, 0xd9, 0x2e, 0x4a, 0x9b, 0xef, 0xff, 0x0c, 0x00, 0x00, 0xff, 0xff, 0xca, 0x77,
-	0xf8, 0x09, 0xb0, 0x08, 0x00, 0x00,
+	// 863 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0xc7, 0xe3, 0xcd, 0xcb, 0x26, 0x93, 0x2e, 0x34, 0x93, 0x28, 0x78, 0x03, 0xb2, 0xa3, 0x2c,
+	0x82, 0xa0, 0xd5, 0xda, 0x14, 0x24, 0x0e, 0xdc, 0x70, 0x0b, 0x12, 0x12, 0x95, 0x2a, 0x67, 0x11,
+	0x2f, 0x17, 0x6b, 0x62, 0xcf, 0x3a, 0x56, 0x6d, 0x8f, 0x35, 0x33, 0x49, 0x36, 0xda, 0x03, 0x88,
+	0x03, 0x37, 0xa4, 0x3d, 0xf1, 0x11, 0x38, 0xec, 0x27, 0xe9, 0x71, 0xc5, 0x09, 0x71, 0x68, 0x21,
+	0xfd, 0x22, 0x68, 0xc6, 0x4e, 0xe3, 0x78, 0x9b, 0x42, 0x51, 0x24, 0xc4, 0x29, 0xe3, 0x99, 0xe7,
+	0xff, 0xfc, 0xfe, 0x7e, 0x9e, 0x99, 0x71, 0xc0, 0x83, 0x53, 0x34, 0x43, 0x66, 0x10, 0xbb, 0x38,
+	0xe6, 0xc1, 0x0c, 0x9b, 0xb3, 0x83, 0x31, 0xe6, 0xe8, 0xc0, 0x4c, 0x10, 0x45, 0x11, 0x33, 0x12,
+	0x4a, 0x38, 0x81, 0x5d, 0x11, 0x64, 0x5c, 0x05, 0x19, 0x59, 0x50, 0x4f, 0x73, 0x09, 0x8b, 0x08,
+	0x33, 0xc7, 0x88, 0xad, 0x95, 0x2e, 0x09, 0xe2, 0x54, 0xd7, 0xeb, 0xf8, 0xc4, 0x27, 0x72, 0x68,
+	0x8a, 0x51, 0x36, 0xab, 0xfb, 0x84, 0xf8, 0x21, 0x36, 0xe5, 0xd3, 0x78, 0xfa, 0xc4, 0xe4, 0x41,
+	0x84, 0x19, 0x47, 0x51, 0x92, 0x06, 0x0c, 0xbe, 0x2f, 0x83, 0x3d, 0x1b, 0xcf, 0x11, 0xf5, 0x4e,
+	0x30, 0x0d, 0x88, 0x07, 0xbb, 0xa0, 0x86, 0x5c, 0x41, 0x56, 0x95, 0xbe, 0x32, 0xac, 0xdb, 0xd9,
+	0x13, 0x7c, 0x17, 0xbc, 0xee, 0x92, 0x30, 0x44, 0x1c, 0x53, 0x14, 0x3a, 0x7c, 0x91, 0x60, 0xf5,
+	0x4e, 0x5f, 0x19, 0x36, 0xec, 0xd7, 0xd6, 0xd3, 0x8f, 0x17, 0x09, 0x86, 0x1f, 0x83, 0x2a, 0xe3,
+	0x88, 0x72, 0xb5, 0xdc, 0x57, 0x86, 0xcd, 0x0f, 0x7a, 0x46, 0x6a, 0xc1, 0x58, 0x59, 0x30, 0x1e,
+	0xaf, 0x2c, 0x58, 0xf5, 0xb3, 0x73, 0xbd, 0xf4, 0xfc, 0x42, 0x57, 0xec, 0x54, 0x02, 0x3f, 0x02,
+	0x65, 0x1c, 0x7b, 0x6a, 0xe5, 0x16, 0x4a, 0x21, 0x80, 0xc7, 0x00, 0x52, 0xf9, 0x12, 0xcc, 0x49,
+	0x30, 0x75, 0x18, 0x76, 0x49, 0xec, 0xa9, 0x55, 0x99, 0xe6, 0xbe, 0x91, 0x56, 0xce, 0x10, 0x95,
+	0x5b, 0x95, 0xd3, 0x38, 0x24, 0x41, 0x6c, 0x55, 0x44, 0x16, 0x7b, 0x3f, 0x93, 0x9e, 0x60, 0x3a,
+	0x92, 0x42, 0x18, 0x82, 0xe6, 0x2a, 0x9d, 0x8b, 0x12, 0xb5, 0xd6, 0x2f, 0xdf, 0x9c, 0xe7, 0x7d,
+	0x91, 0xe7, 0xc5, 0x85, 0x3e, 0xf4, 0x03, 0x3e, 0x99, 0x8e, 0x0d, 0x97, 0x44, 0x66, 0xd6, 0xae,
+	0xf4, 0xe7, 0x11, 0xf3, 0x4e, 0x4d, 0x51, 0x33, 0x26, 0x05, 0xcc, 0x06, 0x59, 0xfe, 0x43, 0x94,
+	0x0c, 0x7e, 0xa9, 0x80, 0xd6, 0xf1, 0x34, 0xe4, 0xc1, 0xff, 0xbf, 0x0f, 0x8b, 0x2d, 0x7d, 0xd8,
+	0x79, 0xfd, 0xfe, 0xe3, 0x9e, 0x41, 0x06, 0xda, 0x6c, 0x82, 0x28, 0x76, 0xdc, 0x10, 0x31, 0xe6,
+	0xcc, 0x71, 0xe0, 0x4f, 0x38, 0x53, 0xef, 0x4a, 0xea, 0xd0, 0xb8, 0xfe, 0x0c, 0x1b, 0x23, 0x21,
+	0x39, 0x14, 0x8a, 0xaf, 0xa4, 0xc0, 0xba, 0x9f, 0x99, 0x68, 0x15, 0x57, 0x98, 0xdd, 0x62, 0xc5,
+	0xa9, 0xc1, 0x33, 0xb0, 0x5f, 0x8c, 0x83, 0x3a, 0x68, 0xe6, 0x8c, 0xc8, 0xbd, 0xd2, 0xb0, 0xc1,
+	0x5a, 0x0b, 0x3f, 0x03, 0xb5, 0xd4, 0x9d, 0xdc, 0x26, 0x7b, 0x96, 0x21, 0x90, 0xbf, 0x9f, 0xeb,
+	0xef, 0xfc, 0x83, 0xf7, 0x3e, 0xc2, 0xae, 0x9d, 0xa9, 0x07, 0x3f, 0x29, 0x00, 0xc8, 0x5d, 0x9a,
+	0x84, 0x01, 0xa6, 0x10, 0x82, 0x4a, 0x8c, 0x22, 0x9c, 0x01, 0xe5, 0x18, 0x3e, 0x00, 0xf7, 0x22,
+	0x12, 0xf3, 0x09, 0x73, 0x42, 0xe2, 0x9e, 0x4e, 0x13, 0x49, 0x2c, 0xdb, 0x7b, 0xe9, 0xe4, 0x17,
+	0x72, 0x4e, 0xf8, 0x79, 0x82, 0x5c, 0x4e, 0xa8, 0x5a, 0xfe, 0x77, 0x7e, 0x52, 0xf5, 0xe0, 0x47,
+	0x05, 0xb4, 0xd7, 0x7e, 0xc4, 0x46, 0x38, 0xc2, 0x31, 0x89, 0x60, 0x07, 0x54, 0x3d, 0x31, 0xc8,
+	0x9c, 0xa5, 0x0f, 0xf0, 0x1b, 0xd0, 0x8c, 0xd6, 0xc1, 0xea, 0x1d, 0xd9, 0xa7, 0xc1, 0xb6, 0x3e,
+	0xad, 0xf3, 0x5a, 0xed, 0xac, 0x43, 0xcd, 0x1c, 0xcb, 0xce, 0xe7, 0x1a, 0xfc, 0x5a, 0x07, 0xb5,
+	0x13, 0x79, 0x83, 0xc3, 0x9f, 0x15, 0xf0, 0xe6, 0x94, 0x79, 0x4f, 0x9d, 0x28, 0x88, 0x79, 0x10,
+	0xfb, 0x4e, 0xba, 0x63, 0xc4, 0x59, 0x08, 0x88, 0x27, 0xba, 0x23, 0xb0, 0x6f, 0x6f, 0xc3, 0xe6,
+	0xcf, 0xbf, 0x75, 0x20, 0xc0, 0xcb, 0x73, 0x5d, 0xfd, 0x72, 0x74, 0xf4, 0xf5, 0x71, 0x9a, 0x2f,
+	0x1f, 0xc0, 0x5e, 0x5c, 0xe8, 0xf7, 0x36, 0x26, 0x6c, 0x55, 0xb0, 0xaf, 0x0b, 0x85, 0x3f, 0x28,
+	0xa0, 0x37, 0x11, 0x4e, 0xd8, 0x34, 0x49, 0xc2, 0x45, 0xd1, 0x57, 0x5a, 0x8e, 0xf7, 0x6e, 0x2c,
+	0xc7, 0x86, 0xb9, 0x5e, 0x56, 0x15, 0xf8, 0xca, 0x12, 0xb3, 0xdf, 0x10, 0xa0, 0x91, 0xe4, 0x6c,
+	0x31, 0x31, 0x26, 0x94, 0x92, 0x79, 0xd1, 0x44, 0x79, 0xe7, 0x26, 0x2c, 0xc9, 0xd9, 0x34, 0xf1,
+	0x1d, 0x50, 0x3d, 0x1c, 0x62, 0x1f, 0x71, 0x42, 0x8b, 0x0e, 0x2a, 0xbb, 0x74, 0xd0, 0xbd, 0xc2,
+	0x6c, 0x1a, 0x98, 0x82, 0x36, 0x9b, 0xa3, 0xa4, 0xc8, 0xae, 0xee, 0x92, 0xdd, 0x12, 0x84, 0x4d,
+	0xec, 0x0c, 0xb4, 0xdc, 0x10, 0x05, 0x91, 0x93, 0x3f, 0x06, 0xe9, 0x25, 0xf9, 0xf0, 0xef, 0x8f,
+	0xc1, 0xd5, 0xf1, 0xb2, 0xde, 0xca, 0xb0, 0x9d, 0x6b, 0x16, 0x99, 0xbd, 0x2f, 0x19, 0xb9, 0x25,
+	0xf8, 0x09, 0x68, 0xa4, 0x5c, 0xf1, 0x3d, 0xb9, 0x7b, 0x8b, 0xef, 0x49, 0x5d, 0xca, 0x3e, 0x8d,
+	0x3d, 0xf8, 0x0c, 0x74, 0x19, 0x9a, 0x05, 0xb1, 0xcf, 0x8a, 0x45, 0xab, 0xef, 0xb2, 0x68, 0x9d,
+	0x0c, 0xf2, 0x4a, 0xbb, 0x30, 0xa2, 0x71, 0x91, 0xdc, 0xd8, 0x69, 0xbb, 0x04, 0x61, 0x63, 0xca,
+	0xfa, 0xfc, 0xec, 0x4f, 0xad, 0x74, 0xb6, 0xd4, 0x94, 0x97, 0x4b, 0x4d, 0xf9, 0x63, 0xa9, 0x29,
+	0xcf, 0x2f, 0xb5, 0xd2, 0xcb, 0x4b, 0xad, 0xf4, 0xdb, 0xa5, 0x56, 0xfa, 0xf6, 0x61, 0xee, 0xae,
+	0x14, 0x0e, 0x1e, 0x85, 0x68, 0xcc, 0xe4, 0xc8, 0x7c, 0x9a, 0xfb, 0x7f, 0x29, 0x2f, 0xcd, 0x71,
+	0x4d, 0x96, 0xf9, 0xc3, 0xbf, 0x06, 0x00, 0xe2, 0x0b, 0x79, 0x31, 0x7e, 0x0a, 0x00, 0x00,
 }
 
 func (m *RewardPeriod) Marshal() (dAtA []byte, err error) {
@@ -323,6 +378,20 @@ func (m *RewardPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RewardsCap) > 0 {
+		for iNdEx := len(m.RewardsCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size, err := m.RewardsPerSecond.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -389,6 +458,34 @@ func (m *MultiRewardPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ShareClassWeights) > 0 {
+		for iNdEx := len(m.ShareClassWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShareClassWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RewardsCap) > 0 {
+		for iNdEx := len(m.RewardsCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.RewardsPerSecond) > 0 {
 		for iNdEx := len(m.RewardsPerSecond) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -439,6 +536,46 @@ func (m *MultiRewardPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ShareClassWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShareClassWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareClassWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ShareClass) > 0 {
+		i -= len(m.ShareClass)
+		copy(dAtA[i:], m.ShareClass)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ShareClass)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Multiplier) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -701,6 +838,12 @@ func (m *RewardPeriod) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.RewardsPerSecond.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if len(m.RewardsCap) > 0 {
+		for _, e := range m.RewardsCap {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -727,6 +870,33 @@ func (m *MultiRewardPeriod) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if len(m.RewardsCap) > 0 {
+		for _, e := range m.RewardsCap {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.ShareClassWeights) > 0 {
+		for _, e := range m.ShareClassWeights {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ShareClassWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ShareClass)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -1012,6 +1182,40 @@ func (m *RewardPeriod) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsCap = append(m.RewardsCap, types.Coin{})
+			if err := m.RewardsCap[len(m.RewardsCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1214,6 +1418,189 @@ func (m *MultiRewardPeriod) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsCap = append(m.RewardsCap, types.Coin{})
+			if err := m.RewardsCap[len(m.RewardsCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareClassWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareClassWeights = append(m.ShareClassWeights, ShareClassWeight{})
+			if err := m.ShareClassWeights[len(m.ShareClassWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShareClassWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShareClassWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShareClassWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareClass", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareClass = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -387,6 +387,94 @@ func (suite *ParamTestSuite) TestMultiRewardPeriods() {
 					contains: "invalid reward amount",
 				},
 			},
+			{
+				name: "reward period with rewards cap is valid",
+				periods: types.MultiRewardPeriods{
+					func() types.MultiRewardPeriod {
+						period := validMultiRewardPeriod
+						period.RewardsCap = sdk.NewCoins(sdk.NewInt64Coin("hard", 1e12))
+						return period
+					}(),
+				},
+				expect: err{
+					pass: true,
+				},
+			},
+			{
+				name: "reward period with zero rewards cap is invalid",
+				periods: types.MultiRewardPeriods{
+					func() types.MultiRewardPeriod {
+						period := validMultiRewardPeriod
+						period.RewardsCap = sdk.Coins{sdk.NewInt64Coin("hard", 0)}
+						return period
+					}(),
+				},
+				expect: err{
+					contains: "invalid rewards cap",
+				},
+			},
+			{
+				name: "reward period with share class weights is valid",
+				periods: types.MultiRewardPeriods{
+					func() types.MultiRewardPeriod {
+						period := validMultiRewardPeriod
+						period.ShareClassWeights = types.ShareClassWeights{
+							types.NewShareClassWeight("liquid", sdk.OneDec()),
+							types.NewShareClassWeight("locked", sdk.MustNewDecFromStr("2.5")),
+						}
+						return period
+					}(),
+				},
+				expect: err{
+					pass: true,
+				},
+			},
+			{
+				name: "reward period with negative share class weight is invalid",
+				periods: types.MultiRewardPeriods{
+					func() types.MultiRewardPeriod {
+						period := validMultiRewardPeriod
+						period.ShareClassWeights = types.ShareClassWeights{
+							types.NewShareClassWeight("locked", sdk.NewDec(-1)),
+						}
+						return period
+					}(),
+				},
+				expect: err{
+					contains: "weight must be non-negative",
+				},
+			},
+			{
+				name: "reward period with blank share class is invalid",
+				periods: types.MultiRewardPeriods{
+					func() types.MultiRewardPeriod {
+						period := validMultiRewardPeriod
+						period.ShareClassWeights = types.ShareClassWeights{
+							types.NewShareClassWeight(" ", sdk.OneDec()),
+						}
+						return period
+					}(),
+				},
+				expect: err{
+					contains: "share class cannot be blank",
+				},
+			},
+			{
+				name: "reward period with duplicated share class weights is invalid",
+				periods: types.MultiRewardPeriods{
+					func() types.MultiRewardPeriod {
+						period := validMultiRewardPeriod
+						period.ShareClassWeights = types.ShareClassWeights{
+							types.NewShareClassWeight("locked", sdk.OneDec()),
+							types.NewShareClassWeight("locked", sdk.NewDec(2)),
+						}
+						return period
+					}(),
+				},
+				expect: err{
+					contains: "duplicated share class weight",
+				},
+			},
 		}
 		for _, tc := range testCases {
 
@@ -402,6 +490,26 @@ func (suite *ParamTestSuite) TestMultiRewardPeriods() {
 	})
 }
 
+func (suite *ParamTestSuite) TestShareClassWeights_WeighShares() {
+	weights := types.ShareClassWeights{
+		types.NewShareClassWeight("locked", sdk.MustNewDecFromStr("2.5")),
+		types.NewShareClassWeight("unrewarded", sdk.ZeroDec()),
+	}
+
+	suite.Equal(sdk.MustNewDecFromStr("2.5"), weights.Get("locked"))
+	suite.Equal(sdk.OneDec(), weights.Get("liquid"))
+
+	suite.Equal(
+		sdk.NewDec(100+250), // unweighted share classes have a weight of one
+		weights.WeighShares([]types.SourceShares{
+			{ID: "liquid", Shares: sdk.NewDec(100)},
+			{ID: "locked", Shares: sdk.NewDec(100)},
+			{ID: "unrewarded", Shares: sdk.NewDec(100)},
+		}),
+	)
+	suite.Equal(sdk.ZeroDec(), weights.WeighShares(nil))
+}
+
 func TestParamTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamTestSuite))
 }
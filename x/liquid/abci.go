@@ -0,0 +1,30 @@
+package liquid
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/liquid/keeper"
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// BeginBlocker unbonds instantly redeemed delegations and funds the redemption buffer from the community pool
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.UndelegateRedeemedDelegations(ctx)
+
+	if err := k.FundRedemptionBuffer(ctx); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("could not fund redemption buffer: %s", err))
+	}
+}
+
+// EndBlocker restakes the staking rewards of the delegations backing derivatives
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.RestakeDerivativeRewards(ctx)
+}
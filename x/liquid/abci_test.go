@@ -0,0 +1,49 @@
+package liquid_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/liquid"
+	"github.com/kava-labs/kava/x/liquid/keeper"
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+func TestBeginBlockerAfterMigrationFromV1Params(t *testing.T) {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)})
+	tApp.InitializeFromGenesisStates()
+	liquidKeeper := tApp.GetLiquidKeeper()
+
+	// Remove all liquid params, leaving the store as it is on chains running v1
+	paramStore := ctx.KVStore(tApp.GetKVStoreKey(paramstypes.StoreKey))
+	for _, key := range [][]byte{
+		types.KeyRedemptionBufferTarget,
+		types.KeyMaxInstantRedemption,
+		types.KeyAllowedValidators,
+		types.KeyMaxValidatorShare,
+		types.KeyValidatorShareCapThreshold,
+	} {
+		paramStore.Delete(append([]byte(types.ModuleName+"/"), key...))
+	}
+	require.Panics(t, func() { liquid.BeginBlocker(ctx, liquidKeeper) })
+
+	err := keeper.NewMigrator(liquidKeeper).Migrate1to2(ctx)
+	require.NoError(t, err)
+
+	require.NotPanics(t, func() { liquid.BeginBlocker(ctx, liquidKeeper) })
+	params := liquidKeeper.GetParams(ctx)
+	require.Empty(t, params.AllowedValidators)
+	require.Equal(t, types.DefaultMaxValidatorShare, params.MaxValidatorShare)
+	require.Equal(t, types.DefaultValidatorShareCapThreshold, params.ValidatorShareCapThreshold)
+	require.NoError(t, params.Validate())
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithBlockHeight(2)
+	require.NotPanics(t, func() { liquid.BeginBlocker(ctx, liquidKeeper) })
+}
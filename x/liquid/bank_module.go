@@ -0,0 +1,67 @@
+package liquid
+
+import (
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/kava-labs/kava/x/liquid/keeper"
+)
+
+// BankAppModule wraps the x/bank app module so bank messages are handled by a DerivativeBankKeeper, calling the
+// liquid hooks when staking derivatives are sent with MsgSend or MsgMultiSend.
+//
+// The bank module cannot be created with the DerivativeBankKeeper directly as its migrations require the bank base
+// keeper, so only the bank msg server is replaced. Other modules are created with the DerivativeBankKeeper.
+type BankAppModule struct {
+	bank.AppModule
+	bankKeeper keeper.DerivativeBankKeeper
+}
+
+// NewBankAppModule returns a new BankAppModule wrapping a bank app module.
+func NewBankAppModule(bankModule bank.AppModule, bankKeeper keeper.DerivativeBankKeeper) BankAppModule {
+	return BankAppModule{
+		AppModule:  bankModule,
+		bankKeeper: bankKeeper,
+	}
+}
+
+// RegisterServices registers the bank module services, using the DerivativeBankKeeper for the bank msg server.
+func (am BankAppModule) RegisterServices(cfg module.Configurator) {
+	am.AppModule.RegisterServices(bankConfigurator{
+		Configurator: cfg,
+		msgServer: bankMsgServiceRegistrar{
+			Server:    cfg.MsgServer(),
+			msgServer: bankkeeper.NewMsgServerImpl(am.bankKeeper),
+		},
+	})
+}
+
+// bankConfigurator is a configurator that registers msg services with a bankMsgServiceRegistrar.
+type bankConfigurator struct {
+	module.Configurator
+	msgServer gogogrpc.Server
+}
+
+// MsgServer returns the server used to register the bank module msg services.
+func (c bankConfigurator) MsgServer() gogogrpc.Server {
+	return c.msgServer
+}
+
+// bankMsgServiceRegistrar replaces the bank msg server when it is registered.
+type bankMsgServiceRegistrar struct {
+	gogogrpc.Server
+	msgServer banktypes.MsgServer
+}
+
+// RegisterService registers a msg service, replacing the implementation of the bank msg service.
+func (s bankMsgServiceRegistrar) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
+	if _, ok := ss.(banktypes.MsgServer); ok {
+		ss = s.msgServer
+	}
+	s.Server.RegisterService(sd, ss)
+}
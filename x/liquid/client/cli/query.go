@@ -1,10 +1,15 @@
 package cli
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/kava-labs/kava/x/liquid/types"
 )
@@ -19,7 +24,13 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmds := []*cobra.Command{}
+	cmds := []*cobra.Command{
+		queryParamsCmd(),
+		queryRedemptionBufferCmd(),
+		queryExchangeRateCmd(),
+		queryDerivativeToTokensCmd(),
+		queryTokensToDerivativeCmd(),
+	}
 
 	for _, cmd := range cmds {
 		flags.AddQueryFlagsToCmd(cmd)
@@ -29,3 +40,145 @@ func GetQueryCmd() *cobra.Command {
 
 	return liquidQueryCmd
 }
+
+func queryParamsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "get the liquid module parameters",
+		Long:  "Get the current liquid module parameters.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+}
+
+func queryRedemptionBufferCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "redemption-buffer",
+		Short: "get the redemption buffer capacity",
+		Long:  "Get the staking tokens available for instant redemptions and the tokens being unbonded to refill the buffer.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RedemptionBuffer(context.Background(), &types.QueryRedemptionBufferRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryExchangeRateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "exchange-rate [denom]",
+		Short: "get the exchange rate of a staking derivative",
+		Long:  "Get the amount of staking tokens a unit of a staking derivative can be redeemed for.",
+		Example: fmt.Sprintf(
+			`%s q %s exchange-rate bkava-kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ExchangeRate(context.Background(), &types.QueryExchangeRateRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryDerivativeToTokensCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "derivative-to-tokens [derivative]",
+		Short: "convert an amount of a staking derivative to staking tokens",
+		Long:  "Get the amount of staking tokens an amount of a staking derivative can be redeemed for at the current exchange rate.",
+		Example: fmt.Sprintf(
+			`%s q %s derivative-to-tokens 1000000bkava-kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			derivative, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DerivativeToTokens(context.Background(), &types.QueryDerivativeToTokensRequest{
+				Denom:  derivative.Denom,
+				Amount: derivative.Amount.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryTokensToDerivativeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "tokens-to-derivative [validator] [amount]",
+		Short: "convert an amount of staking tokens to a staking derivative",
+		Long:  "Get the amount of a validator's staking derivative minted for an amount of staking tokens delegated to the validator at the current exchange rate.",
+		Example: fmt.Sprintf(
+			`%s q %s tokens-to-derivative kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd 1000000`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TokensToDerivative(context.Background(), &types.QueryTokensToDerivativeRequest{
+				Validator: args[0],
+				Amount:    args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/kava-labs/kava/x/liquid/types"
 )
 
+const flagInstant = "instant"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	liquidTxCmd := &cobra.Command{
@@ -75,12 +77,15 @@ func getCmdMintDerivative() *cobra.Command {
 }
 
 func getCmdBurnDerivative() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "burn [amount]",
 		Short: "burns staking derivative to redeem a delegation",
-		Long:  "Burn removes some staking derivative from a user's account and converts it back to a staking delegation.",
+		Long: `Burn removes some staking derivative from a user's account and converts it back to a staking delegation.
+With --instant, the derivative is instead redeemed for staking tokens from the redemption buffer.`,
 		Example: fmt.Sprintf(
-			`%s tx %s burn 10000000bkava-kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd --from <key>`, version.AppName, types.ModuleName,
+			`%[1]s tx %[2]s burn 10000000bkava-kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd --from <key>
+%[1]s tx %[2]s burn 10000000bkava-kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd --instant --from <key>`,
+			version.AppName, types.ModuleName,
 		),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -99,11 +104,21 @@ func getCmdBurnDerivative() *cobra.Command {
 				return errorsmod.Wrap(types.ErrInvalidDenom, err.Error())
 			}
 
+			instant, err := cmd.Flags().GetBool(flagInstant)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgBurnDerivative(clientCtx.GetFromAddress(), valAddr, amount)
+			msg.Instant = instant
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().Bool(flagInstant, false, "redeem staking tokens from the redemption buffer instead of a delegation")
+
+	return cmd
 }
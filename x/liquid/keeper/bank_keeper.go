@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ bankkeeper.Keeper = DerivativeBankKeeper{}
+
+// DerivativeBankKeeper is a bank keeper wrapper that calls the liquid hooks when staking derivatives are sent
+// between accounts, so modules tracking derivative balances can stay in sync when derivatives change owner.
+//
+// It is used as the bank keeper of every module, so derivatives moved by bank messages, IBC transfers, evm
+// conversions, router messages, and module deposits and withdrawals are all tracked. Minting and burning derivatives
+// sends them from and to the liquid module account, so these call the hooks too.
+//
+// The liquid keeper is held by reference, as the bank keeper must be created before the liquid keeper and its hooks.
+type DerivativeBankKeeper struct {
+	bankkeeper.Keeper
+	liquidKeeper *Keeper
+}
+
+// NewDerivativeBankKeeper returns a new DerivativeBankKeeper wrapping a bank keeper.
+func NewDerivativeBankKeeper(liquidKeeper *Keeper, bk bankkeeper.Keeper) DerivativeBankKeeper {
+	return DerivativeBankKeeper{
+		Keeper:       bk,
+		liquidKeeper: liquidKeeper,
+	}
+}
+
+// SendCoins transfers coins from one account to another, calling the liquid hooks for any staking derivatives sent.
+func (k DerivativeBankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.sendWithHooks(ctx, fromAddr, toAddr, amt, func() error {
+		return k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+	})
+}
+
+// SendCoinsFromModuleToAccount transfers coins from a module account to an account, calling the liquid hooks for any
+// staking derivatives sent.
+func (k DerivativeBankKeeper) SendCoinsFromModuleToAccount(
+	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+) error {
+	return k.sendWithHooks(ctx, authtypes.NewModuleAddress(senderModule), recipientAddr, amt, func() error {
+		return k.Keeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	})
+}
+
+// SendCoinsFromAccountToModule transfers coins from an account to a module account, calling the liquid hooks for any
+// staking derivatives sent.
+func (k DerivativeBankKeeper) SendCoinsFromAccountToModule(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+) error {
+	return k.sendWithHooks(ctx, senderAddr, authtypes.NewModuleAddress(recipientModule), amt, func() error {
+		return k.Keeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	})
+}
+
+// SendCoinsFromModuleToModule transfers coins from one module account to another, calling the liquid hooks for any
+// staking derivatives sent.
+func (k DerivativeBankKeeper) SendCoinsFromModuleToModule(
+	ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins,
+) error {
+	return k.sendWithHooks(ctx, authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule), amt, func() error {
+		return k.Keeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt)
+	})
+}
+
+// InputOutputCoins performs a multi-send, calling the liquid hooks for any staking derivatives sent to each output.
+// Multi-sends with more than one input are rejected by the bank module, so the single input is the sender of every
+// output.
+func (k DerivativeBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	if len(inputs) != 1 {
+		return k.Keeper.InputOutputCoins(ctx, inputs, outputs)
+	}
+
+	sender, err := sdk.AccAddressFromBech32(inputs[0].Address)
+	if err != nil {
+		return err
+	}
+
+	recipients := make([]sdk.AccAddress, len(outputs))
+	transfers := make([]sdk.Coins, len(outputs))
+	for i, out := range outputs {
+		recipient, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+		recipients[i] = recipient
+		transfers[i] = k.derivativeCoins(ctx, out.Coins)
+	}
+
+	for i, derivatives := range transfers {
+		if !derivatives.IsZero() {
+			k.liquidKeeper.BeforeDerivativeTransfer(ctx, sender, recipients[i], derivatives)
+		}
+	}
+
+	if err := k.Keeper.InputOutputCoins(ctx, inputs, outputs); err != nil {
+		return err
+	}
+
+	for i, derivatives := range transfers {
+		if !derivatives.IsZero() {
+			k.liquidKeeper.AfterDerivativeTransfer(ctx, sender, recipients[i], derivatives)
+		}
+	}
+
+	return nil
+}
+
+// sendWithHooks runs a send of coins between two accounts, calling the liquid hooks around it if any staking
+// derivatives are sent.
+func (k DerivativeBankKeeper) sendWithHooks(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins, send func() error,
+) error {
+	derivatives := k.derivativeCoins(ctx, amt)
+	if derivatives.IsZero() {
+		return send()
+	}
+
+	k.liquidKeeper.BeforeDerivativeTransfer(ctx, fromAddr, toAddr, derivatives)
+
+	if err := send(); err != nil {
+		return err
+	}
+
+	k.liquidKeeper.AfterDerivativeTransfer(ctx, fromAddr, toAddr, derivatives)
+	return nil
+}
+
+// derivativeCoins returns the staking derivatives in a set of coins.
+func (k DerivativeBankKeeper) derivativeCoins(ctx sdk.Context, coins sdk.Coins) sdk.Coins {
+	derivatives := sdk.NewCoins()
+	for _, coin := range coins {
+		if k.liquidKeeper.IsDerivativeDenom(ctx, coin.Denom) {
+			derivatives = derivatives.Add(coin)
+		}
+	}
+	return derivatives
+}
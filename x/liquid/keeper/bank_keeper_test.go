@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/liquid/keeper"
+	"github.com/kava-labs/kava/x/liquid/types"
+	"github.com/kava-labs/kava/x/liquid/types/mocks"
+)
+
+func (suite *KeeperTestSuite) TestDerivativeBankKeeper_SendCoins() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	valAccAddr, sender, recipient := addrs[0], addrs[1], addrs[2]
+	valAddr := sdk.ValAddress(valAccAddr)
+
+	suite.CreateAccountWithAddress(valAccAddr, suite.NewBondCoins(i(1e9)))
+	suite.CreateAccountWithAddress(sender, suite.NewBondCoins(i(1e9)))
+
+	suite.CreateNewUnbondedValidator(valAddr, i(1e9))
+	suite.CreateDelegation(valAddr, sender, i(500e6))
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	derivative, err := suite.Keeper.MintDerivative(suite.Ctx, sender, valAddr, suite.NewBondCoin(i(500e6)))
+	suite.Require().NoError(err)
+
+	liquidHooks := mocks.NewLiquidHooks(suite.T())
+	suite.Keeper.ClearHooks()
+	suite.Keeper.SetHooks(liquidHooks)
+	bankKeeper := keeper.NewDerivativeBankKeeper(&suite.Keeper, suite.BankKeeper)
+
+	// sending derivatives calls hooks with only the derivatives sent
+	derivatives := sdk.NewCoins(c(derivative.Denom, 100e6))
+	liquidHooks.On("BeforeDerivativeTransfer", mock.Anything, sender, recipient, derivatives).Once()
+	liquidHooks.On("AfterDerivativeTransfer", mock.Anything, sender, recipient, derivatives).Once()
+	err = bankKeeper.SendCoins(suite.Ctx, sender, recipient, derivatives.Add(suite.NewBondCoin(i(1e6))))
+	suite.Require().NoError(err)
+
+	// sending other coins does not call hooks
+	err = bankKeeper.SendCoins(suite.Ctx, sender, recipient, suite.NewBondCoins(i(1e6)))
+	suite.Require().NoError(err)
+
+	// failed sends do not call after hooks
+	tooLarge := sdk.NewCoins(c(derivative.Denom, 1e9))
+	liquidHooks.On("BeforeDerivativeTransfer", mock.Anything, sender, recipient, tooLarge).Once()
+	err = bankKeeper.SendCoins(suite.Ctx, sender, recipient, tooLarge)
+	suite.Require().Error(err)
+
+	suite.AccountBalanceEqual(recipient, sdk.NewCoins(c(derivative.Denom, 100e6), suite.NewBondCoin(i(2e6))))
+}
+
+func (suite *KeeperTestSuite) TestDerivativeBankKeeper_InputOutputCoins() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	valAccAddr, sender, recipient1, recipient2 := addrs[0], addrs[1], addrs[2], addrs[3]
+	valAddr := sdk.ValAddress(valAccAddr)
+
+	suite.CreateAccountWithAddress(valAccAddr, suite.NewBondCoins(i(1e9)))
+	suite.CreateAccountWithAddress(sender, suite.NewBondCoins(i(1e9)))
+
+	suite.CreateNewUnbondedValidator(valAddr, i(1e9))
+	suite.CreateDelegation(valAddr, sender, i(500e6))
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	derivative, err := suite.Keeper.MintDerivative(suite.Ctx, sender, valAddr, suite.NewBondCoin(i(500e6)))
+	suite.Require().NoError(err)
+
+	liquidHooks := mocks.NewLiquidHooks(suite.T())
+	suite.Keeper.ClearHooks()
+	suite.Keeper.SetHooks(liquidHooks)
+	bankKeeper := keeper.NewDerivativeBankKeeper(&suite.Keeper, suite.BankKeeper)
+
+	derivatives := sdk.NewCoins(c(derivative.Denom, 100e6))
+	bondCoins := suite.NewBondCoins(i(1e6))
+
+	// hooks are only called for outputs receiving derivatives
+	liquidHooks.On("BeforeDerivativeTransfer", mock.Anything, sender, recipient1, derivatives).Once()
+	liquidHooks.On("AfterDerivativeTransfer", mock.Anything, sender, recipient1, derivatives).Once()
+	err = bankKeeper.InputOutputCoins(
+		suite.Ctx,
+		[]banktypes.Input{banktypes.NewInput(sender, derivatives.Add(bondCoins...))},
+		[]banktypes.Output{
+			banktypes.NewOutput(recipient1, derivatives),
+			banktypes.NewOutput(recipient2, bondCoins),
+		},
+	)
+	suite.Require().NoError(err)
+
+	suite.AccountBalanceEqual(recipient1, derivatives)
+	suite.AccountBalanceEqual(recipient2, bondCoins)
+}
+
+func (suite *KeeperTestSuite) TestDerivativeBankKeeper_ModuleSends() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	valAccAddr, user := addrs[0], addrs[1]
+	valAddr := sdk.ValAddress(valAccAddr)
+
+	suite.CreateAccountWithAddress(valAccAddr, suite.NewBondCoins(i(1e9)))
+	suite.CreateAccountWithAddress(user, suite.NewBondCoins(i(1e9)))
+
+	suite.CreateNewUnbondedValidator(valAddr, i(1e9))
+	suite.CreateDelegation(valAddr, user, i(500e6))
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	derivative, err := suite.Keeper.MintDerivative(suite.Ctx, user, valAddr, suite.NewBondCoin(i(500e6)))
+	suite.Require().NoError(err)
+
+	liquidHooks := mocks.NewLiquidHooks(suite.T())
+	suite.Keeper.ClearHooks()
+	suite.Keeper.SetHooks(liquidHooks)
+	bankKeeper := keeper.NewDerivativeBankKeeper(&suite.Keeper, suite.BankKeeper)
+
+	derivatives := sdk.NewCoins(c(derivative.Denom, 100e6))
+	liquidAddr := authtypes.NewModuleAddress(types.ModuleAccountName)
+	distrAddr := authtypes.NewModuleAddress(distrtypes.ModuleName)
+
+	liquidHooks.On("BeforeDerivativeTransfer", mock.Anything, user, liquidAddr, derivatives).Once()
+	liquidHooks.On("AfterDerivativeTransfer", mock.Anything, user, liquidAddr, derivatives).Once()
+	err = bankKeeper.SendCoinsFromAccountToModule(suite.Ctx, user, types.ModuleAccountName, derivatives)
+	suite.Require().NoError(err)
+
+	liquidHooks.On("BeforeDerivativeTransfer", mock.Anything, liquidAddr, distrAddr, derivatives).Once()
+	liquidHooks.On("AfterDerivativeTransfer", mock.Anything, liquidAddr, distrAddr, derivatives).Once()
+	err = bankKeeper.SendCoinsFromModuleToModule(suite.Ctx, types.ModuleAccountName, distrtypes.ModuleName, derivatives)
+	suite.Require().NoError(err)
+
+	liquidHooks.On("BeforeDerivativeTransfer", mock.Anything, distrAddr, user, derivatives).Once()
+	liquidHooks.On("AfterDerivativeTransfer", mock.Anything, distrAddr, user, derivatives).Once()
+	err = bankKeeper.SendCoinsFromModuleToAccount(suite.Ctx, distrtypes.ModuleName, user, derivatives)
+	suite.Require().NoError(err)
+
+	// sending other coins does not call hooks
+	err = bankKeeper.SendCoinsFromAccountToModule(suite.Ctx, user, types.ModuleAccountName, suite.NewBondCoins(i(1e6)))
+	suite.Require().NoError(err)
+
+	suite.AccountBalanceEqual(user, sdk.NewCoins(c(derivative.Denom, 500e6), suite.NewBondCoin(i(499e6))))
+}
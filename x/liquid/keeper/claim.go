@@ -3,26 +3,25 @@ package keeper
 import (
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/kava-labs/kava/x/liquid/types"
 )
 
+// CollectStakingRewards withdraws the staking rewards of the delegation backing a validator's derivative. The rewards
+// earned by heldAmount of the derivative supply are sent to the destination module account and returned. The staking
+// denom rewards earned by the rest of the supply are restaked, as in RestakeDerivativeRewards, so that they accrue to
+// every holder of the derivative.
 func (k Keeper) CollectStakingRewards(
 	ctx sdk.Context,
 	validator sdk.ValAddress,
+	heldAmount sdkmath.Int,
 	destinationModAccount string,
 ) (sdk.Coins, error) {
 	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
-
-	// Ensure withdraw address is as expected
-	withdrawAddr := k.distributionKeeper.GetDelegatorWithdrawAddr(ctx, macc.GetAddress())
-	if !withdrawAddr.Equals(macc.GetAddress()) {
-		panic(fmt.Sprintf(
-			"unexpected withdraw address for liquid staking module account, expected %s, got %s",
-			macc.GetAddress(), withdrawAddr,
-		))
-	}
+	k.ensureWithdrawAddress(ctx, macc.GetAddress())
 
 	rewards, err := k.distributionKeeper.WithdrawDelegationRewards(ctx, macc.GetAddress(), validator)
 	if err != nil {
@@ -33,17 +32,27 @@ func (k Keeper) CollectStakingRewards(
 		return rewards, nil
 	}
 
-	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleAccountName, destinationModAccount, rewards)
-	if err != nil {
-		return nil, err
+	collected := k.heldRewards(ctx, validator, heldAmount, rewards)
+	if !collected.IsZero() {
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleAccountName, destinationModAccount, collected)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	remaining := sdk.NewCoin(bondDenom, rewards.AmountOf(bondDenom).Sub(collected.AmountOf(bondDenom)))
+	if remaining.IsPositive() {
+		k.restakeRemainingRewards(ctx, macc.GetAddress(), validator, remaining)
 	}
 
-	return rewards, nil
+	return collected, nil
 }
 
 func (k Keeper) CollectStakingRewardsByDenom(
 	ctx sdk.Context,
 	derivativeDenom string,
+	heldAmount sdkmath.Int,
 	destinationModAccount string,
 ) (sdk.Coins, error) {
 	valAddr, err := types.ParseLiquidStakingTokenDenom(derivativeDenom)
@@ -51,5 +60,131 @@ func (k Keeper) CollectStakingRewardsByDenom(
 		return nil, err
 	}
 
-	return k.CollectStakingRewards(ctx, valAddr, destinationModAccount)
+	return k.CollectStakingRewards(ctx, valAddr, heldAmount, destinationModAccount)
+}
+
+// heldRewards returns the part of the rewards earned by heldAmount of a validator's derivative supply.
+func (k Keeper) heldRewards(
+	ctx sdk.Context,
+	validator sdk.ValAddress,
+	heldAmount sdkmath.Int,
+	rewards sdk.Coins,
+) sdk.Coins {
+	supply := k.bankKeeper.GetSupply(ctx, k.GetLiquidStakingTokenDenom(validator)).Amount
+	if !supply.IsPositive() || !heldAmount.IsPositive() {
+		return sdk.NewCoins()
+	}
+	if heldAmount.GT(supply) {
+		heldAmount = supply
+	}
+
+	held := sdk.NewCoins()
+	for _, reward := range rewards {
+		held = held.Add(sdk.NewCoin(reward.Denom, reward.Amount.Mul(heldAmount).Quo(supply)))
+	}
+	return held
+}
+
+// restakeRemainingRewards delegates withdrawn rewards back to the validator. If the validator cannot currently be
+// delegated to, the rewards are left in the module account.
+func (k Keeper) restakeRemainingRewards(ctx sdk.Context, modAddr sdk.AccAddress, valAddr sdk.ValAddress, restaked sdk.Coin) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	newShares, err := k.delegateFromAccount(cacheCtx, valAddr, modAddr, restaked.Amount)
+	if err != nil {
+		k.Logger(ctx).Info(fmt.Sprintf("could not restake derivative rewards for %s: %s", valAddr, err))
+		return
+	}
+	writeCache()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRestakeRewards,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, restaked.String()),
+			sdk.NewAttribute(types.AttributeKeySharesReceived, newShares.String()),
+		),
+	)
+}
+
+// RestakeDerivativeRewards claims the staking rewards of the delegations backing derivatives and delegates them back
+// to the same validators. This increases the delegation shares backing each derivative, and so the derivative exchange
+// rate. Validators that cannot currently be delegated to keep their rewards unclaimed until a following block.
+//
+// Only rewards in the staking denom are restaked. Rewards collected earlier in the block by CollectStakingRewards have
+// already been split between the collector and the restaked remainder.
+func (k Keeper) RestakeDerivativeRewards(ctx sdk.Context) {
+	modAddr := k.accountKeeper.GetModuleAddress(types.ModuleAccountName)
+
+	var delegations []stakingtypes.Delegation
+	k.stakingKeeper.IterateDelegatorDelegations(ctx, modAddr, func(delegation stakingtypes.Delegation) bool {
+		delegations = append(delegations, delegation)
+		return false
+	})
+	if len(delegations) == 0 {
+		return
+	}
+
+	k.ensureWithdrawAddress(ctx, modAddr)
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+
+	for _, delegation := range delegations {
+		valAddr := delegation.GetValidatorAddr()
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		restaked, newShares, err := k.restakeValidatorRewards(cacheCtx, modAddr, valAddr, bondDenom)
+		if err != nil {
+			k.Logger(ctx).Info(fmt.Sprintf("could not restake derivative rewards for %s: %s", valAddr, err))
+			continue
+		}
+		if restaked.IsZero() {
+			continue
+		}
+		writeCache()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRestakeRewards,
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, restaked.String()),
+				sdk.NewAttribute(types.AttributeKeySharesReceived, newShares.String()),
+			),
+		)
+	}
+}
+
+// restakeValidatorRewards withdraws the module's rewards from a validator and delegates the staking denom rewards back
+// to it.
+func (k Keeper) restakeValidatorRewards(
+	ctx sdk.Context,
+	modAddr sdk.AccAddress,
+	valAddr sdk.ValAddress,
+	bondDenom string,
+) (sdk.Coin, sdk.Dec, error) {
+	rewards, err := k.distributionKeeper.WithdrawDelegationRewards(ctx, modAddr, valAddr)
+	if err != nil {
+		return sdk.Coin{}, sdk.Dec{}, err
+	}
+
+	restaked := sdk.NewCoin(bondDenom, rewards.AmountOf(bondDenom))
+	if restaked.IsZero() {
+		return restaked, sdk.ZeroDec(), nil
+	}
+
+	newShares, err := k.delegateFromAccount(ctx, valAddr, modAddr, restaked.Amount)
+	if err != nil {
+		return sdk.Coin{}, sdk.Dec{}, err
+	}
+
+	return restaked, newShares, nil
+}
+
+// ensureWithdrawAddress panics if the rewards of the module account are not withdrawn to itself.
+func (k Keeper) ensureWithdrawAddress(ctx sdk.Context, modAddr sdk.AccAddress) {
+	withdrawAddr := k.distributionKeeper.GetDelegatorWithdrawAddr(ctx, modAddr)
+	if !withdrawAddr.Equals(modAddr) {
+		panic(fmt.Sprintf(
+			"unexpected withdraw address for liquid staking module account, expected %s, got %s",
+			modAddr, withdrawAddr,
+		))
+	}
 }
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/liquid/types"
@@ -62,7 +63,7 @@ func (suite *KeeperTestSuite) TestCollectStakingRewards() {
 	suite.Run("collect staking rewards", func() {
 		// Collect rewards
 		derivativeDenom := suite.Keeper.GetLiquidStakingTokenDenom(valAddr1)
-		rewards, err := suite.Keeper.CollectStakingRewardsByDenom(suite.Ctx, derivativeDenom, types.ModuleName)
+		rewards, err := suite.Keeper.CollectStakingRewardsByDenom(suite.Ctx, derivativeDenom, delegateAmount, types.ModuleName)
 		suite.Require().NoError(err)
 		suite.Require().Equal(truncatedRewards, rewards)
 
@@ -75,15 +76,192 @@ func (suite *KeeperTestSuite) TestCollectStakingRewards() {
 	suite.Run("collect staking rewards with non-validator", func() {
 		// acc2 not a validator
 		derivativeDenom := suite.Keeper.GetLiquidStakingTokenDenom(sdk.ValAddress(addrs[2]))
-		_, err := suite.Keeper.CollectStakingRewardsByDenom(suite.Ctx, derivativeDenom, types.ModuleName)
+		_, err := suite.Keeper.CollectStakingRewardsByDenom(suite.Ctx, derivativeDenom, delegateAmount, types.ModuleName)
 		suite.Require().Error(err)
 		suite.Require().Equal("no validator distribution info", err.Error())
 	})
 
 	suite.Run("collect staking rewards with invalid denom", func() {
 		derivativeDenom := "bkava"
-		_, err := suite.Keeper.CollectStakingRewardsByDenom(suite.Ctx, derivativeDenom, types.ModuleName)
+		_, err := suite.Keeper.CollectStakingRewardsByDenom(suite.Ctx, derivativeDenom, delegateAmount, types.ModuleName)
 		suite.Require().Error(err)
 		suite.Require().Equal("cannot parse denom bkava", err.Error())
 	})
 }
+
+func (suite *KeeperTestSuite) TestCollectStakingRewards_RestakesUnheldRewards() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(5)
+	valAccAddr1, delegator := addrs[0], addrs[1]
+	valAddr1 := sdk.ValAddress(valAccAddr1)
+
+	initialBalance := i(1e9)
+	delegateAmount := i(100e6)
+
+	suite.NoError(suite.App.FundModuleAccount(
+		suite.Ctx,
+		distrtypes.ModuleName,
+		sdk.NewCoins(
+			sdk.NewCoin("ukava", initialBalance),
+		),
+	))
+
+	suite.CreateAccountWithAddress(valAccAddr1, suite.NewBondCoins(initialBalance))
+	suite.CreateAccountWithAddress(delegator, suite.NewBondCoins(initialBalance))
+
+	suite.CreateNewUnbondedValidator(valAddr1, initialBalance)
+	suite.CreateDelegation(valAddr1, delegator, delegateAmount)
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	derivative, err := suite.Keeper.MintDerivative(suite.Ctx, delegator, valAddr1, suite.NewBondCoin(delegateAmount))
+	suite.Require().NoError(err)
+
+	validator, found := suite.StakingKeeper.GetValidator(suite.Ctx, valAddr1)
+	suite.Require().True(found)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(2)
+
+	distrKeeper := suite.App.GetDistrKeeper()
+	liquidMacc := suite.App.GetAccountKeeper().GetModuleAccount(suite.Ctx, types.ModuleAccountName)
+	distrMacc := suite.App.GetAccountKeeper().GetModuleAccount(suite.Ctx, distrtypes.ModuleName)
+
+	rewardCoins := sdk.NewDecCoins(sdk.NewDecCoin("ukava", sdkmath.NewInt(500e6)))
+	distrKeeper.AllocateTokensToValidator(suite.Ctx, validator, rewardCoins)
+
+	delegation, found := suite.StakingKeeper.GetDelegation(suite.Ctx, liquidMacc.GetAddress(), valAddr1)
+	suite.Require().True(found)
+
+	endingPeriod := distrKeeper.IncrementValidatorPeriod(suite.Ctx, validator)
+	delegationRewards := distrKeeper.CalculateDelegationRewards(suite.Ctx, validator, delegation, endingPeriod)
+	truncatedRewards, _ := delegationRewards.TruncateDecimal()
+	totalRewards := truncatedRewards.AmountOf("ukava")
+
+	// a quarter of the derivative supply is held by the collector
+	distrBalance := suite.BankKeeper.GetBalance(suite.Ctx, distrMacc.GetAddress(), "ukava")
+	collected, err := suite.Keeper.CollectStakingRewardsByDenom(suite.Ctx, derivative.Denom, delegateAmount.QuoRaw(4), distrtypes.ModuleName)
+	suite.Require().NoError(err)
+
+	expectedCollected := totalRewards.QuoRaw(4)
+	restaked := totalRewards.Sub(expectedCollected)
+	suite.Equal(suite.NewBondCoins(expectedCollected), collected)
+	suite.Equal(distrBalance.Amount.Sub(totalRewards).Add(expectedCollected), suite.BankKeeper.GetBalance(suite.Ctx, distrMacc.GetAddress(), "ukava").Amount)
+
+	// the rest is restaked for all holders of the derivative
+	suite.AccountBalanceEqual(liquidMacc.GetAddress(), sdk.NewCoins())
+	suite.DelegationSharesEqual(valAddr1, liquidMacc.GetAddress(), sdk.NewDecFromInt(delegateAmount.Add(restaked)))
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeRestakeRewards,
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddr1.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, suite.NewBondCoin(restaked).String()),
+		sdk.NewAttribute(types.AttributeKeySharesReceived, sdk.NewDecFromInt(restaked).String()),
+	))
+
+	// the rewards are not restaked again at the end of the block
+	suite.Keeper.RestakeDerivativeRewards(suite.Ctx)
+	suite.DelegationSharesEqual(valAddr1, liquidMacc.GetAddress(), sdk.NewDecFromInt(delegateAmount.Add(restaked)))
+}
+
+func (suite *KeeperTestSuite) TestRestakeDerivativeRewards() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(5)
+	valAccAddr1, delegator := addrs[0], addrs[1]
+	valAddr1 := sdk.ValAddress(valAccAddr1)
+
+	initialBalance := i(1e9)
+	delegateAmount := i(100e6)
+
+	suite.NoError(suite.App.FundModuleAccount(
+		suite.Ctx,
+		distrtypes.ModuleName,
+		sdk.NewCoins(
+			sdk.NewCoin("ukava", initialBalance),
+		),
+	))
+
+	suite.CreateAccountWithAddress(valAccAddr1, suite.NewBondCoins(initialBalance))
+	suite.CreateAccountWithAddress(delegator, suite.NewBondCoins(initialBalance))
+
+	suite.CreateNewUnbondedValidator(valAddr1, initialBalance)
+	suite.CreateDelegation(valAddr1, delegator, delegateAmount)
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	// restaking without rewards does not change the delegation
+	suite.Keeper.RestakeDerivativeRewards(suite.Ctx)
+
+	derivative, err := suite.Keeper.MintDerivative(suite.Ctx, delegator, valAddr1, suite.NewBondCoin(delegateAmount))
+	suite.Require().NoError(err)
+	suite.Equal(delegateAmount, derivative.Amount)
+
+	validator, found := suite.StakingKeeper.GetValidator(suite.Ctx, valAddr1)
+	suite.Require().True(found)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(2)
+
+	distrKeeper := suite.App.GetDistrKeeper()
+	liquidMacc := suite.App.GetAccountKeeper().GetModuleAccount(suite.Ctx, types.ModuleAccountName)
+
+	rewardCoins := sdk.NewDecCoins(sdk.NewDecCoin("ukava", sdkmath.NewInt(500e6)))
+	distrKeeper.AllocateTokensToValidator(suite.Ctx, validator, rewardCoins)
+
+	delegation, found := suite.StakingKeeper.GetDelegation(suite.Ctx, liquidMacc.GetAddress(), valAddr1)
+	suite.Require().True(found)
+
+	endingPeriod := distrKeeper.IncrementValidatorPeriod(suite.Ctx, validator)
+	delegationRewards := distrKeeper.CalculateDelegationRewards(suite.Ctx, validator, delegation, endingPeriod)
+	truncatedRewards, _ := delegationRewards.TruncateDecimal()
+	restaked := truncatedRewards.AmountOf("ukava")
+	suite.Require().True(restaked.IsPositive())
+
+	suite.Keeper.RestakeDerivativeRewards(suite.Ctx)
+
+	// rewards are delegated, not left in the module account
+	suite.AccountBalanceEqual(liquidMacc.GetAddress(), sdk.NewCoins())
+	expectedShares := sdk.NewDecFromInt(delegateAmount.Add(restaked))
+	suite.DelegationSharesEqual(valAddr1, liquidMacc.GetAddress(), expectedShares)
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeRestakeRewards,
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddr1.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, suite.NewBondCoin(restaked).String()),
+		sdk.NewAttribute(types.AttributeKeySharesReceived, sdk.NewDecFromInt(restaked).String()),
+	))
+
+	// the derivative supply is unchanged so each derivative is worth more
+	rate, err := suite.Keeper.GetDerivativeExchangeRate(suite.Ctx, derivative.Denom)
+	suite.Require().NoError(err)
+	suite.Equal(expectedShares.QuoInt(delegateAmount), rate)
+
+	value, err := suite.Keeper.GetDerivativeValue(suite.Ctx, derivative.Denom)
+	suite.Require().NoError(err)
+	suite.Equal(suite.NewBondCoin(delegateAmount.Add(restaked)), value)
+
+	// burning all derivatives returns the restaked rewards
+	received, err := suite.Keeper.BurnDerivative(suite.Ctx, delegator, valAddr1, derivative)
+	suite.Require().NoError(err)
+	suite.Equal(expectedShares, received)
+	suite.DelegationSharesEqual(valAddr1, liquidMacc.GetAddress(), sdk.ZeroDec())
+}
+
+func (suite *KeeperTestSuite) TestMintDerivative_AfterRestake() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(5)
+	valAccAddr, delegator1, delegator2 := addrs[0], addrs[1], addrs[2]
+	valAddr := sdk.ValAddress(valAccAddr)
+
+	suite.CreateAccountWithAddress(valAccAddr, suite.NewBondCoins(i(1e9)))
+	suite.CreateAccountWithAddress(delegator1, suite.NewBondCoins(i(1e9)))
+	suite.CreateAccountWithAddress(delegator2, suite.NewBondCoins(i(1e9)))
+
+	suite.CreateNewUnbondedValidator(valAddr, i(1e9))
+	suite.CreateDelegation(valAddr, delegator1, i(100e6))
+	suite.CreateDelegation(valAddr, delegator2, i(100e6))
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	_, err := suite.Keeper.MintDerivative(suite.Ctx, delegator1, valAddr, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+
+	// simulate restaked rewards doubling the shares backing the derivatives
+	suite.AddCoinsToModule(types.ModuleAccountName, suite.NewBondCoins(i(100e6)))
+	suite.CreateDelegation(valAddr, authtypes.NewModuleAddress(types.ModuleAccountName), i(100e6))
+
+	derivative, err := suite.Keeper.MintDerivative(suite.Ctx, delegator2, valAddr, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+	suite.Equal(i(50e6), derivative.Amount)
+}
@@ -6,6 +6,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/kava-labs/kava/x/liquid/types"
 )
@@ -20,6 +21,10 @@ func (k Keeper) MintDerivative(ctx sdk.Context, delegatorAddr sdk.AccAddress, va
 		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidDenom, "expected %s", bondDenom)
 	}
 
+	if err := k.validateMintValidator(ctx, valAddr, amount.Amount); err != nil {
+		return sdk.Coin{}, err
+	}
+
 	derivativeAmount, shares, err := k.CalculateDerivativeSharesFromTokens(ctx, delegatorAddr, valAddr, amount.Amount)
 	if err != nil {
 		return sdk.Coin{}, err
@@ -51,8 +56,63 @@ func (k Keeper) MintDerivative(ctx sdk.Context, delegatorAddr sdk.AccAddress, va
 	return liquidToken, nil
 }
 
+// validateMintValidator checks derivatives can be minted for a validator from a staking token amount, enforcing the
+// validator allow-list and the cap on a validator's share of the total derivative backed stake.
+func (k Keeper) validateMintValidator(ctx sdk.Context, valAddr sdk.ValAddress, tokens sdkmath.Int) error {
+	params := k.GetParams(ctx)
+
+	if !params.IsValidatorAllowed(valAddr) {
+		return errorsmod.Wrap(types.ErrValidatorNotAllowed, valAddr.String())
+	}
+
+	if params.MaxValidatorShare.GTE(sdk.OneDec()) {
+		return nil
+	}
+
+	validatorStake, totalStake := k.GetDerivativeBackedStake(ctx, valAddr)
+	totalStake = totalStake.Add(tokens)
+	if totalStake.LT(params.ValidatorShareCapThreshold) {
+		return nil
+	}
+
+	validatorStake = validatorStake.Add(tokens)
+	maxValidatorStake := params.MaxValidatorShare.MulInt(totalStake)
+	if sdk.NewDecFromInt(validatorStake).GT(maxValidatorStake) {
+		return errorsmod.Wrapf(
+			types.ErrValidatorShareCapExceeded,
+			"%s stake would be %s of %s total", valAddr, validatorStake, totalStake,
+		)
+	}
+
+	return nil
+}
+
+// GetDerivativeBackedStake returns the staking tokens delegated by the module to a validator, and to all validators.
+func (k Keeper) GetDerivativeBackedStake(ctx sdk.Context, valAddr sdk.ValAddress) (sdkmath.Int, sdkmath.Int) {
+	validatorStake := sdk.ZeroInt()
+	totalStake := sdk.ZeroInt()
+
+	modAddr := k.accountKeeper.GetModuleAddress(types.ModuleAccountName)
+	k.stakingKeeper.IterateDelegatorDelegations(ctx, modAddr, func(delegation stakingtypes.Delegation) bool {
+		validator, found := k.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			panic(fmt.Sprintf("validator %s for delegation not found", delegation.GetValidatorAddr()))
+		}
+
+		tokens := validator.TokensFromSharesTruncated(delegation.GetShares()).TruncateInt()
+		totalStake = totalStake.Add(tokens)
+		if validator.GetOperator().Equals(valAddr) {
+			validatorStake = tokens
+		}
+
+		return false
+	})
+
+	return validatorStake, totalStake
+}
+
 // CalculateDerivativeSharesFromTokens converts a staking token amount into its equivalent delegation shares, and staking derivative amount.
-// This combines the code for calculating the shares to be transferred, and the derivative coins to be minted.
+// This combines the code for calculating the shares to be transferred, and the derivative coins to be minted at the current exchange rate.
 func (k Keeper) CalculateDerivativeSharesFromTokens(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress, tokens sdkmath.Int) (sdkmath.Int, sdk.Dec, error) {
 	if !tokens.IsPositive() {
 		return sdkmath.Int{}, sdk.Dec{}, errorsmod.Wrap(types.ErrUntransferableShares, "token amount must be positive")
@@ -61,24 +121,26 @@ func (k Keeper) CalculateDerivativeSharesFromTokens(ctx sdk.Context, delegator s
 	if err != nil {
 		return sdkmath.Int{}, sdk.Dec{}, err
 	}
-	return shares.TruncateInt(), shares, nil
+	return k.derivativeFromShares(ctx, validator, shares), shares, nil
 }
 
 // BurnDerivative burns an user's staking derivative coins and returns them an equivalent staking delegation.
 //
-// The derivative coins are burned, and an equivalent number of shares in the module's staking delegation are transferred back to the user.
+// The derivative coins are burned, and the shares in the module's staking delegation backing them are transferred back to the user.
 func (k Keeper) BurnDerivative(ctx sdk.Context, delegatorAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) (sdk.Dec, error) {
 
 	if amount.Denom != k.GetLiquidStakingTokenDenom(valAddr) {
 		return sdk.Dec{}, errorsmod.Wrap(types.ErrInvalidDenom, "derivative denom does not match validator")
 	}
 
+	// shares must be calculated before the derivatives are burned as burning changes the exchange rate
+	shares := k.sharesFromDerivative(ctx, valAddr, amount.Amount)
+
 	if err := k.burnCoins(ctx, delegatorAddr, sdk.NewCoins(amount)); err != nil {
 		return sdk.Dec{}, err
 	}
 
 	modAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
-	shares := sdk.NewDecFromInt(amount.Amount)
 	receivedShares, err := k.TransferDelegation(ctx, valAddr, modAcc.GetAddress(), delegatorAddr, shares)
 	if err != nil {
 		return sdk.Dec{}, err
@@ -128,8 +190,8 @@ func (k Keeper) GetStakedTokensForDerivatives(ctx sdk.Context, coins sdk.Coins)
 			return sdk.Coin{}, fmt.Errorf("invalid derivative denom %s: validator not found", coin.Denom)
 		}
 
-		// bkava is 1:1 to delegation shares
-		valTokens := validator.TokensFromSharesTruncated(sdk.NewDecFromInt(coin.Amount))
+		shares := k.sharesFromDerivative(ctx, valAddr, coin.Amount)
+		valTokens := validator.TokensFromSharesTruncated(shares)
 		total = total.Add(valTokens.TruncateInt())
 	}
 
@@ -62,11 +62,16 @@ func (suite *KeeperTestSuite) TestBurnDerivative() {
 			expectedErr:      sdkerrors.ErrInsufficientFunds,
 		},
 		{
-			name:             "error when backing delegation isn't large enough",
+			name:             "user burns at the exchange rate when backing delegation is smaller than supply",
 			balance:          c(liquidDenom, 1e9),
 			moduleDelegation: i(999_999_999),
 			burnAmount:       c(liquidDenom, 1e9),
-			expectedErr:      stakingtypes.ErrNotEnoughDelegationShares,
+		},
+		{
+			name:             "user burns at the exchange rate when backing delegation is larger than supply",
+			balance:          c(liquidDenom, 1e9),
+			moduleDelegation: i(2e9),
+			burnAmount:       c(liquidDenom, 5e8),
 		},
 	}
 
@@ -96,7 +101,8 @@ func (suite *KeeperTestSuite) TestBurnDerivative() {
 			suite.AccountBalanceEqual(user, sdk.NewCoins(tc.balance.Sub(tc.burnAmount)))
 			suite.AccountBalanceEqual(moduleAccAddress, modBalance) // ensure derivatives are burned, and not in module account
 
-			sharesTransferred := sdk.NewDecFromInt(tc.burnAmount.Amount)
+			// derivatives are backed by the module delegation shares in proportion to their supply
+			sharesTransferred := sdk.NewDecFromInt(tc.moduleDelegation).MulInt(tc.burnAmount.Amount).QuoInt(tc.balance.Amount)
 			suite.DelegationSharesEqual(valAddr, user, sharesTransferred)
 			suite.DelegationSharesEqual(valAddr, moduleAccAddress, sdk.NewDecFromInt(tc.moduleDelegation).Sub(sharesTransferred))
 
@@ -549,3 +555,97 @@ func (suite *KeeperTestSuite) TestDerivativeFromTokens() {
 	expected := sdk.NewCoin(fmt.Sprintf("bkava-%s", valAddr), initialBalance)
 	suite.Equal(expected, derivatives)
 }
+
+func (suite *KeeperTestSuite) TestMintDerivative_ValidatorLimits() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(5)
+	valAccAddr1, valAccAddr2, delegator := addrs[0], addrs[1], addrs[2]
+	valAddr1, valAddr2 := sdk.ValAddress(valAccAddr1), sdk.ValAddress(valAccAddr2)
+
+	testCases := []struct {
+		name              string
+		allowedValidators []string
+		maxValidatorShare sdk.Dec
+		threshold         sdkmath.Int
+		mintAmount        sdkmath.Int
+		expectedErr       error
+	}{
+		{
+			name:              "validators are allowed when the allow-list is empty",
+			maxValidatorShare: sdk.OneDec(),
+			threshold:         i(0),
+			mintAmount:        i(100e6),
+		},
+		{
+			name:              "allowed validator can back derivatives",
+			allowedValidators: []string{valAddr1.String(), valAddr2.String()},
+			maxValidatorShare: sdk.OneDec(),
+			threshold:         i(0),
+			mintAmount:        i(100e6),
+		},
+		{
+			name:              "error when validator is not allowed",
+			allowedValidators: []string{valAddr1.String()},
+			maxValidatorShare: sdk.OneDec(),
+			threshold:         i(0),
+			mintAmount:        i(100e6),
+			expectedErr:       types.ErrValidatorNotAllowed,
+		},
+		{
+			name:              "validator can back derivatives up to its max share",
+			maxValidatorShare: sdk.MustNewDecFromStr("0.5"),
+			threshold:         i(0),
+			mintAmount:        i(100e6),
+		},
+		{
+			name:              "error when validator exceeds its max share",
+			maxValidatorShare: sdk.MustNewDecFromStr("0.5"),
+			threshold:         i(0),
+			mintAmount:        i(100e6 + 1),
+			expectedErr:       types.ErrValidatorShareCapExceeded,
+		},
+		{
+			name:              "max share is not enforced below the threshold",
+			maxValidatorShare: sdk.MustNewDecFromStr("0.5"),
+			threshold:         i(300e6 + 1),
+			mintAmount:        i(200e6),
+		},
+		{
+			name:              "error when max share is exceeded at the threshold",
+			maxValidatorShare: sdk.MustNewDecFromStr("0.5"),
+			threshold:         i(300e6),
+			mintAmount:        i(200e6),
+			expectedErr:       types.ErrValidatorShareCapExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.CreateAccountWithAddress(valAccAddr1, suite.NewBondCoins(i(1e9)))
+			suite.CreateAccountWithAddress(valAccAddr2, suite.NewBondCoins(i(1e9)))
+			suite.CreateAccountWithAddress(delegator, suite.NewBondCoins(i(1e9)))
+
+			suite.CreateNewUnbondedValidator(valAddr1, i(1e9))
+			suite.CreateNewUnbondedValidator(valAddr2, i(1e9))
+			suite.CreateDelegation(valAddr1, delegator, i(100e6))
+			suite.CreateDelegation(valAddr2, delegator, i(500e6))
+			staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+			// validator 1 backs 100e6 of derivatives before the limits are set
+			_, err := suite.Keeper.MintDerivative(suite.Ctx, delegator, valAddr1, suite.NewBondCoin(i(100e6)))
+			suite.Require().NoError(err)
+
+			suite.Keeper.SetParams(suite.Ctx, types.NewParams(
+				sdkmath.ZeroInt(), sdkmath.ZeroInt(), tc.allowedValidators, tc.maxValidatorShare, tc.threshold,
+			))
+
+			derivative, err := suite.Keeper.MintDerivative(suite.Ctx, delegator, valAddr2, suite.NewBondCoin(tc.mintAmount))
+			suite.Require().ErrorIs(err, tc.expectedErr)
+			if tc.expectedErr != nil {
+				return
+			}
+			suite.Equal(tc.mintAmount, derivative.Amount)
+		})
+	}
+}
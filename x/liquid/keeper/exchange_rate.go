@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// GetDerivativeExchangeRate returns the amount of staking tokens a single unit of a derivative can be redeemed for.
+//
+// Derivatives start out 1:1 to the delegation shares backing them, restaked rewards increase the shares backing each
+// derivative over time.
+func (k Keeper) GetDerivativeExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	valAddr, err := types.ParseLiquidStakingTokenDenom(denom)
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("invalid derivative denom: %w", err)
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Dec{}, fmt.Errorf("invalid derivative denom %s: validator not found", denom)
+	}
+
+	return validator.TokensFromShares(k.sharesFromDerivative(ctx, valAddr, sdk.OneInt())), nil
+}
+
+// GetDerivativesForStakedTokens returns the amount of derivatives minted for staked tokens delegated to a validator,
+// at the current exchange rate.
+func (k Keeper) GetDerivativesForStakedTokens(ctx sdk.Context, valAddr sdk.ValAddress, tokens sdkmath.Int) (sdk.Coin, error) {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	shares, err := validator.SharesFromTokens(tokens)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(k.GetLiquidStakingTokenDenom(valAddr), k.derivativeFromShares(ctx, valAddr, shares)), nil
+}
+
+// GetDerivativeShares returns the delegation shares backing an amount of a validator's staking derivative.
+func (k Keeper) GetDerivativeShares(ctx sdk.Context, valAddr sdk.ValAddress, amount sdkmath.Int) sdk.Dec {
+	return k.sharesFromDerivative(ctx, valAddr, amount)
+}
+
+// derivativeBacking returns the delegation shares held by the module for a validator, and the supply of the
+// validator's derivative they back.
+func (k Keeper) derivativeBacking(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Dec, sdkmath.Int) {
+	supply := k.bankKeeper.GetSupply(ctx, k.GetLiquidStakingTokenDenom(valAddr)).Amount
+
+	modAddr := k.accountKeeper.GetModuleAddress(types.ModuleAccountName)
+	delegation, found := k.stakingKeeper.GetDelegation(ctx, modAddr, valAddr)
+	if !found {
+		return sdk.ZeroDec(), supply
+	}
+
+	return delegation.GetShares(), supply
+}
+
+// sharesFromDerivative converts a derivative amount into the delegation shares backing it, rounding down.
+func (k Keeper) sharesFromDerivative(ctx sdk.Context, valAddr sdk.ValAddress, amount sdkmath.Int) sdk.Dec {
+	moduleShares, supply := k.derivativeBacking(ctx, valAddr)
+	if supply.IsZero() || moduleShares.IsZero() {
+		return sdk.NewDecFromInt(amount)
+	}
+
+	return moduleShares.MulInt(amount).QuoInt(supply)
+}
+
+// derivativeFromShares converts delegation shares into the derivative amount they back, rounding down.
+func (k Keeper) derivativeFromShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) sdkmath.Int {
+	moduleShares, supply := k.derivativeBacking(ctx, valAddr)
+	if supply.IsZero() || moduleShares.IsZero() {
+		return shares.TruncateInt()
+	}
+
+	return shares.MulInt(supply).QuoTruncate(moduleShares).TruncateInt()
+}
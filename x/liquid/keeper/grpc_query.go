@@ -68,6 +68,102 @@ func (s queryServer) TotalSupply(
 	}, nil
 }
 
+func (s queryServer) Params(
+	goCtx context.Context,
+	req *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{
+		Params: s.keeper.GetParams(ctx),
+	}, nil
+}
+
+func (s queryServer) RedemptionBuffer(
+	goCtx context.Context,
+	req *types.QueryRedemptionBufferRequest,
+) (*types.QueryRedemptionBufferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := s.keeper.GetParams(ctx)
+	bondDenom := s.keeper.stakingKeeper.BondDenom(ctx)
+
+	return &types.QueryRedemptionBufferResponse{
+		Target:               sdk.NewCoin(bondDenom, params.RedemptionBufferTarget),
+		MaxInstantRedemption: sdk.NewCoin(bondDenom, params.MaxInstantRedemption),
+		Available:            s.keeper.GetRedemptionBufferBalance(ctx),
+		PendingRefill:        s.keeper.GetRedemptionBufferPendingRefill(ctx),
+	}, nil
+}
+
+func (s queryServer) ExchangeRate(
+	goCtx context.Context,
+	req *types.QueryExchangeRateRequest,
+) (*types.QueryExchangeRateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rate, err := s.keeper.GetDerivativeExchangeRate(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryExchangeRateResponse{
+		Rate: rate,
+	}, nil
+}
+
+func (s queryServer) DerivativeToTokens(
+	goCtx context.Context,
+	req *types.QueryDerivativeToTokensRequest,
+) (*types.QueryDerivativeToTokensResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	amount, ok := sdkmath.NewIntFromString(req.Amount)
+	if !ok || amount.IsNegative() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %s", req.Amount)
+	}
+
+	derivative := sdk.Coin{Denom: req.Denom, Amount: amount}
+	if err := derivative.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tokens, err := s.keeper.GetStakedTokensForDerivatives(ctx, sdk.NewCoins(derivative))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryDerivativeToTokensResponse{
+		Tokens: tokens,
+	}, nil
+}
+
+func (s queryServer) TokensToDerivative(
+	goCtx context.Context,
+	req *types.QueryTokensToDerivativeRequest,
+) (*types.QueryTokensToDerivativeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %s", err)
+	}
+
+	amount, ok := sdkmath.NewIntFromString(req.Amount)
+	if !ok || amount.IsNegative() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %s", req.Amount)
+	}
+
+	derivative, err := s.keeper.GetDerivativesForStakedTokens(ctx, valAddr, amount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryTokensToDerivativeResponse{
+		Derivative: derivative,
+	}, nil
+}
+
 func (s queryServer) getDelegatedBalance(ctx sdk.Context, delegator sdk.AccAddress) sdkmath.Int {
 	balance := sdk.ZeroDec()
 
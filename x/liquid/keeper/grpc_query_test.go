@@ -7,10 +7,12 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/kava-labs/kava/app"
+	communitytypes "github.com/kava-labs/kava/x/community/types"
 	"github.com/kava-labs/kava/x/liquid/keeper"
 	"github.com/kava-labs/kava/x/liquid/types"
 )
@@ -290,3 +292,179 @@ func (suite *grpcQueryTestSuite) TestQueryTotalSupply() {
 		})
 	}
 }
+
+func (suite *grpcQueryTestSuite) TestQueryParams() {
+	params := types.NewParams(i(1e9), i(1e8), nil, sdk.OneDec(), sdkmath.ZeroInt())
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	res, err := suite.queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(params, res.Params)
+}
+
+func (suite *grpcQueryTestSuite) TestQueryRedemptionBuffer() {
+	initBalance := suite.NewBondCoin(i(1e9))
+	valAcc := suite.CreateAccount(sdk.NewCoins(initBalance), 0)
+	delAcc := suite.CreateAccount(sdk.NewCoins(initBalance), 1)
+	valAddr := sdk.ValAddress(valAcc.GetAddress())
+
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(i(1e9), i(1e8), nil, sdk.OneDec(), sdkmath.ZeroInt()))
+	suite.AddCoinsToModule(communitytypes.ModuleAccountName, suite.NewBondCoins(i(2e9)))
+
+	suite.CreateNewUnbondedValidator(valAddr, initBalance.Amount)
+	suite.CreateDelegation(valAddr, delAcc.GetAddress(), initBalance.Amount)
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper) // bond the validator
+
+	derivative, err := suite.Keeper.MintDerivative(suite.Ctx, delAcc.GetAddress(), valAddr, initBalance)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.Keeper.FundRedemptionBuffer(suite.Ctx))
+
+	_, err = suite.Keeper.InstantRedeemDerivative(suite.Ctx, delAcc.GetAddress(), valAddr, c(derivative.Denom, 1e8))
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.RedemptionBuffer(context.Background(), &types.QueryRedemptionBufferRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(&types.QueryRedemptionBufferResponse{
+		Target:               suite.NewBondCoin(i(1e9)),
+		MaxInstantRedemption: suite.NewBondCoin(i(1e8)),
+		Available:            suite.NewBondCoin(i(9e8)),
+		PendingRefill:        suite.NewBondCoin(i(1e8)),
+	}, res)
+}
+
+func (suite *grpcQueryTestSuite) TestQueryExchangeRate() {
+	initBalance := suite.NewBondCoin(i(1e9))
+	valAcc := suite.CreateAccount(sdk.NewCoins(initBalance), 0)
+	delAcc := suite.CreateAccount(sdk.NewCoins(initBalance), 1)
+	valAddr := sdk.ValAddress(valAcc.GetAddress())
+	denom := suite.Keeper.GetLiquidStakingTokenDenom(valAddr)
+
+	suite.CreateNewUnbondedValidator(valAddr, initBalance.Amount)
+	suite.CreateDelegation(valAddr, delAcc.GetAddress(), initBalance.Amount)
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper) // bond the validator
+
+	res, err := suite.queryClient.ExchangeRate(context.Background(), &types.QueryExchangeRateRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Equal(sdk.OneDec(), res.Rate, "derivatives without supply should be 1:1")
+
+	_, err = suite.Keeper.MintDerivative(suite.Ctx, delAcc.GetAddress(), valAddr, suite.NewBondCoin(i(4e8)))
+	suite.Require().NoError(err)
+
+	// restaked rewards increase the exchange rate
+	suite.AddCoinsToModule(types.ModuleAccountName, suite.NewBondCoins(i(1e8)))
+	suite.CreateDelegation(valAddr, authtypes.NewModuleAddress(types.ModuleAccountName), i(1e8))
+
+	res, err = suite.queryClient.ExchangeRate(context.Background(), &types.QueryExchangeRateRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("1.25"), res.Rate)
+
+	_, err = suite.queryClient.ExchangeRate(context.Background(), &types.QueryExchangeRateRequest{Denom: "bkava"})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.ExchangeRate(context.Background(), &types.QueryExchangeRateRequest{
+		Denom: suite.Keeper.GetLiquidStakingTokenDenom(sdk.ValAddress(delAcc.GetAddress())),
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *grpcQueryTestSuite) TestQueryDerivativeToTokens() {
+	initBalance := suite.NewBondCoin(i(1e9))
+	valAcc := suite.CreateAccount(sdk.NewCoins(initBalance), 0)
+	delAcc := suite.CreateAccount(sdk.NewCoins(initBalance), 1)
+	valAddr := sdk.ValAddress(valAcc.GetAddress())
+	denom := suite.Keeper.GetLiquidStakingTokenDenom(valAddr)
+
+	suite.CreateNewUnbondedValidator(valAddr, initBalance.Amount)
+	suite.CreateDelegation(valAddr, delAcc.GetAddress(), initBalance.Amount)
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper) // bond the validator
+
+	res, err := suite.queryClient.DerivativeToTokens(context.Background(), &types.QueryDerivativeToTokensRequest{
+		Denom:  denom,
+		Amount: "100000000",
+	})
+	suite.Require().NoError(err)
+	suite.Equal(suite.NewBondCoin(i(1e8)), res.Tokens, "derivatives without supply should be 1:1")
+
+	_, err = suite.Keeper.MintDerivative(suite.Ctx, delAcc.GetAddress(), valAddr, suite.NewBondCoin(i(4e8)))
+	suite.Require().NoError(err)
+
+	// restaked rewards increase the tokens derivatives can be redeemed for
+	suite.AddCoinsToModule(types.ModuleAccountName, suite.NewBondCoins(i(1e8)))
+	suite.CreateDelegation(valAddr, authtypes.NewModuleAddress(types.ModuleAccountName), i(1e8))
+
+	res, err = suite.queryClient.DerivativeToTokens(context.Background(), &types.QueryDerivativeToTokensRequest{
+		Denom:  denom,
+		Amount: "100000000",
+	})
+	suite.Require().NoError(err)
+	suite.Equal(suite.NewBondCoin(i(125e6)), res.Tokens)
+
+	_, err = suite.queryClient.DerivativeToTokens(context.Background(), &types.QueryDerivativeToTokensRequest{
+		Denom:  denom,
+		Amount: "-1",
+	})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.DerivativeToTokens(context.Background(), &types.QueryDerivativeToTokensRequest{
+		Denom:  "bkava",
+		Amount: "100000000",
+	})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.DerivativeToTokens(context.Background(), &types.QueryDerivativeToTokensRequest{
+		Denom:  suite.Keeper.GetLiquidStakingTokenDenom(sdk.ValAddress(delAcc.GetAddress())),
+		Amount: "100000000",
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *grpcQueryTestSuite) TestQueryTokensToDerivative() {
+	initBalance := suite.NewBondCoin(i(1e9))
+	valAcc := suite.CreateAccount(sdk.NewCoins(initBalance), 0)
+	delAcc := suite.CreateAccount(sdk.NewCoins(initBalance), 1)
+	valAddr := sdk.ValAddress(valAcc.GetAddress())
+	denom := suite.Keeper.GetLiquidStakingTokenDenom(valAddr)
+
+	suite.CreateNewUnbondedValidator(valAddr, initBalance.Amount)
+	suite.CreateDelegation(valAddr, delAcc.GetAddress(), initBalance.Amount)
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper) // bond the validator
+
+	res, err := suite.queryClient.TokensToDerivative(context.Background(), &types.QueryTokensToDerivativeRequest{
+		Validator: valAddr.String(),
+		Amount:    "125000000",
+	})
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewCoin(denom, i(125e6)), res.Derivative, "derivatives without supply should be 1:1")
+
+	_, err = suite.Keeper.MintDerivative(suite.Ctx, delAcc.GetAddress(), valAddr, suite.NewBondCoin(i(4e8)))
+	suite.Require().NoError(err)
+
+	// restaked rewards decrease the derivatives minted for tokens
+	suite.AddCoinsToModule(types.ModuleAccountName, suite.NewBondCoins(i(1e8)))
+	suite.CreateDelegation(valAddr, authtypes.NewModuleAddress(types.ModuleAccountName), i(1e8))
+
+	res, err = suite.queryClient.TokensToDerivative(context.Background(), &types.QueryTokensToDerivativeRequest{
+		Validator: valAddr.String(),
+		Amount:    "125000000",
+	})
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewCoin(denom, i(1e8)), res.Derivative)
+
+	_, err = suite.queryClient.TokensToDerivative(context.Background(), &types.QueryTokensToDerivativeRequest{
+		Validator: valAddr.String(),
+		Amount:    "invalid",
+	})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.TokensToDerivative(context.Background(), &types.QueryTokensToDerivativeRequest{
+		Validator: delAcc.GetAddress().String(),
+		Amount:    "125000000",
+	})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.TokensToDerivative(context.Background(), &types.QueryTokensToDerivativeRequest{
+		Validator: sdk.ValAddress(delAcc.GetAddress()).String(),
+		Amount:    "125000000",
+	})
+	suite.Require().Error(err)
+}
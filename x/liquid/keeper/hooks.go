@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// Implements LiquidHooks interface
+var _ types.LiquidHooks = Keeper{}
+
+// BeforeDerivativeTransfer - call hook if registered
+func (k Keeper) BeforeDerivativeTransfer(ctx sdk.Context, sender, recipient sdk.AccAddress, derivatives sdk.Coins) {
+	if k.hooks != nil {
+		k.hooks.BeforeDerivativeTransfer(ctx, sender, recipient, derivatives)
+	}
+}
+
+// AfterDerivativeTransfer - call hook if registered
+func (k Keeper) AfterDerivativeTransfer(ctx sdk.Context, sender, recipient sdk.AccAddress, derivatives sdk.Coins) {
+	if k.hooks != nil {
+		k.hooks.AfterDerivativeTransfer(ctx, sender, recipient, derivatives)
+	}
+}
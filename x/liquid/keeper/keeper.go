@@ -6,13 +6,15 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/kava-labs/kava/x/liquid/types"
 )
 
 // Keeper struct for the liquid module.
 type Keeper struct {
-	cdc codec.Codec
+	cdc           codec.Codec
+	paramSubspace paramtypes.Subspace
 
 	accountKeeper      types.AccountKeeper
 	bankKeeper         types.BankKeeper
@@ -20,17 +22,22 @@ type Keeper struct {
 	distributionKeeper types.DistributionKeeper
 
 	derivativeDenom string
+	hooks           types.LiquidHooks
 }
 
 // NewKeeper returns a new keeper for the liquid module.
 func NewKeeper(
-	cdc codec.Codec,
+	cdc codec.Codec, paramstore paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, dk types.DistributionKeeper,
 	derivativeDenom string,
 ) Keeper {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
 
 	return Keeper{
 		cdc:                cdc,
+		paramSubspace:      paramstore,
 		accountKeeper:      ak,
 		bankKeeper:         bk,
 		stakingKeeper:      sk,
@@ -41,11 +48,25 @@ func NewKeeper(
 
 // NewDefaultKeeper returns a new keeper for the liquid module with default values.
 func NewDefaultKeeper(
-	cdc codec.Codec,
+	cdc codec.Codec, paramstore paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, dk types.DistributionKeeper,
 ) Keeper {
 
-	return NewKeeper(cdc, ak, bk, sk, dk, types.DefaultDerivativeDenom)
+	return NewKeeper(cdc, paramstore, ak, bk, sk, dk, types.DefaultDerivativeDenom)
+}
+
+// SetHooks adds hooks to the keeper.
+func (k *Keeper) SetHooks(hooks types.LiquidHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set liquid hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
+// ClearHooks clears the hooks on the keeper
+func (k *Keeper) ClearHooks() {
+	k.hooks = nil
 }
 
 // Logger returns a module-specific logger.
@@ -66,7 +66,13 @@ func (k msgServer) BurnDerivative(goCtx context.Context, msg *types.MsgBurnDeriv
 		return nil, err
 	}
 
-	sharesReceived, err := k.keeper.BurnDerivative(ctx, sender, validator, msg.Amount)
+	sharesReceived := sdk.ZeroDec()
+	redeemed := sdk.NewCoin(k.keeper.stakingKeeper.BondDenom(ctx), sdk.ZeroInt())
+	if msg.Instant {
+		redeemed, err = k.keeper.InstantRedeemDerivative(ctx, sender, validator, msg.Amount)
+	} else {
+		sharesReceived, err = k.keeper.BurnDerivative(ctx, sender, validator, msg.Amount)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -80,5 +86,6 @@ func (k msgServer) BurnDerivative(goCtx context.Context, msg *types.MsgBurnDeriv
 	)
 	return &types.MsgBurnDerivativeResponse{
 		Received: sharesReceived,
+		Redeemed: redeemed,
 	}, nil
 }
@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	communitytypes "github.com/kava-labs/kava/x/community/types"
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// InstantRedeemDerivative burns an user's staking derivative coins and sends them the equivalent staking tokens from
+// the redemption buffer.
+//
+// The shares in the module's staking delegation backing the derivatives are transferred to the redemption buffer,
+// where they are undelegated to refill the buffer once the unbonding period has passed.
+func (k Keeper) InstantRedeemDerivative(ctx sdk.Context, delegatorAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) (sdk.Coin, error) {
+	if amount.Denom != k.GetLiquidStakingTokenDenom(valAddr) {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrInvalidDenom, "derivative denom does not match validator")
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	shares := k.sharesFromDerivative(ctx, valAddr, amount.Amount)
+	redeemed := sdk.NewCoin(
+		k.stakingKeeper.BondDenom(ctx),
+		validator.TokensFromSharesTruncated(shares).TruncateInt(),
+	)
+	if !redeemed.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrUntransferableShares, "redeemed amount must be positive")
+	}
+
+	maxRedemption := k.GetParams(ctx).MaxInstantRedemption
+	if redeemed.Amount.GT(maxRedemption) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInstantRedemptionTooLarge, "%s > %s", redeemed.Amount, maxRedemption)
+	}
+
+	available := k.GetRedemptionBufferBalance(ctx)
+	if available.IsLT(redeemed) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInsufficientBuffer, "%s < %s", available, redeemed)
+	}
+
+	if err := k.burnCoins(ctx, delegatorAddr, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	modAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
+	bufferAcc := k.accountKeeper.GetModuleAccount(ctx, types.RedemptionBufferAccountName)
+	if _, err := k.TransferDelegation(ctx, valAddr, modAcc.GetAddress(), bufferAcc.GetAddress(), shares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		types.RedemptionBufferAccountName,
+		delegatorAddr,
+		sdk.NewCoins(redeemed),
+	); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnDerivative,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegatorAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeySharesTransferred, shares.String()),
+			sdk.NewAttribute(types.AttributeKeyRedeemed, redeemed.String()),
+		),
+	)
+
+	return redeemed, nil
+}
+
+// UndelegateRedeemedDelegations starts unbonding the delegations received by the redemption buffer from instant
+// redemptions. Delegations that cannot currently be undelegated, such as when the maximum number of unbonding entries
+// has been reached, are retried in following blocks.
+func (k Keeper) UndelegateRedeemedDelegations(ctx sdk.Context) {
+	bufferAddr := k.accountKeeper.GetModuleAddress(types.RedemptionBufferAccountName)
+
+	var delegations []stakingtypes.Delegation
+	k.stakingKeeper.IterateDelegatorDelegations(ctx, bufferAddr, func(delegation stakingtypes.Delegation) bool {
+		delegations = append(delegations, delegation)
+		return false
+	})
+
+	for _, delegation := range delegations {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if _, err := k.stakingKeeper.Undelegate(cacheCtx, bufferAddr, delegation.GetValidatorAddr(), delegation.GetShares()); err != nil {
+			k.Logger(ctx).Info(fmt.Sprintf(
+				"could not undelegate redemption buffer delegation to %s: %s", delegation.GetValidatorAddr(), err,
+			))
+			continue
+		}
+		writeCache()
+	}
+}
+
+// FundRedemptionBuffer moves funds between the community pool and the redemption buffer so that the buffer, including
+// funds that are being unbonded into it, matches the redemption buffer target. The buffer is only funded up to the
+// available community pool balance.
+func (k Keeper) FundRedemptionBuffer(ctx sdk.Context) error {
+	target := k.GetParams(ctx).RedemptionBufferTarget
+	available := k.GetRedemptionBufferBalance(ctx)
+	total := available.Amount.Add(k.GetRedemptionBufferPendingRefill(ctx).Amount)
+
+	switch {
+	case total.LT(target):
+		communityPool := k.accountKeeper.GetModuleAddress(communitytypes.ModuleAccountName)
+		communityBalance := k.bankKeeper.GetBalance(ctx, communityPool, available.Denom)
+
+		funding := sdk.NewCoin(available.Denom, sdkmath.MinInt(target.Sub(total), communityBalance.Amount))
+		if funding.IsZero() {
+			return nil
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(
+			ctx,
+			communitytypes.ModuleAccountName,
+			types.RedemptionBufferAccountName,
+			sdk.NewCoins(funding),
+		); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeFundBuffer,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, funding.String()),
+			),
+		)
+	case total.GT(target):
+		excess := sdk.NewCoin(available.Denom, sdkmath.MinInt(total.Sub(target), available.Amount))
+		if excess.IsZero() {
+			return nil
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(
+			ctx,
+			types.RedemptionBufferAccountName,
+			communitytypes.ModuleAccountName,
+			sdk.NewCoins(excess),
+		); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDefundBuffer,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, excess.String()),
+			),
+		)
+	}
+
+	return nil
+}
+
+// GetRedemptionBufferBalance returns the staking tokens available in the redemption buffer for instant redemptions.
+func (k Keeper) GetRedemptionBufferBalance(ctx sdk.Context) sdk.Coin {
+	bufferAddr := k.accountKeeper.GetModuleAddress(types.RedemptionBufferAccountName)
+	return k.bankKeeper.GetBalance(ctx, bufferAddr, k.stakingKeeper.BondDenom(ctx))
+}
+
+// GetRedemptionBufferPendingRefill returns the staking tokens of instantly redeemed delegations that have not yet been
+// unbonded into the redemption buffer.
+func (k Keeper) GetRedemptionBufferPendingRefill(ctx sdk.Context) sdk.Coin {
+	bufferAddr := k.accountKeeper.GetModuleAddress(types.RedemptionBufferAccountName)
+
+	pending := k.stakingKeeper.GetDelegatorUnbonding(ctx, bufferAddr)
+	k.stakingKeeper.IterateDelegatorDelegations(ctx, bufferAddr, func(delegation stakingtypes.Delegation) bool {
+		validator, found := k.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			panic(fmt.Sprintf("validator %s for delegation not found", delegation.GetValidatorAddr()))
+		}
+		pending = pending.Add(validator.TokensFromSharesTruncated(delegation.GetShares()).TruncateInt())
+		return false
+	})
+
+	return sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), pending)
+}
@@ -0,0 +1,240 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/kava-labs/kava/app"
+	communitytypes "github.com/kava-labs/kava/x/community/types"
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+func (suite *KeeperTestSuite) TestInstantRedeemDerivative() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(5)
+	valAccAddr, user := addrs[0], addrs[1]
+	valAddr := sdk.ValAddress(valAccAddr)
+
+	liquidDenom := suite.Keeper.GetLiquidStakingTokenDenom(valAddr)
+
+	testCases := []struct {
+		name          string
+		buffer        sdkmath.Int
+		maxRedemption sdkmath.Int
+		burnAmount    sdk.Coin
+		expectedErr   error
+	}{
+		{
+			name:          "user can redeem up to the max instant redemption",
+			buffer:        i(1e9),
+			maxRedemption: i(1e8),
+			burnAmount:    c(liquidDenom, 1e8),
+		},
+		{
+			name:          "user can redeem the entire buffer",
+			buffer:        i(1e8),
+			maxRedemption: i(1e9),
+			burnAmount:    c(liquidDenom, 1e8),
+		},
+		{
+			name:          "error when denom does not match validator",
+			buffer:        i(1e9),
+			maxRedemption: i(1e9),
+			burnAmount:    c(fmt.Sprintf("ckava-%s", valAddr), 1e6),
+			expectedErr:   types.ErrInvalidDenom,
+		},
+		{
+			name:          "error when redeemed amount is 0",
+			buffer:        i(1e9),
+			maxRedemption: i(1e9),
+			burnAmount:    c(liquidDenom, 0),
+			expectedErr:   types.ErrUntransferableShares,
+		},
+		{
+			name:          "error when amount exceeds max instant redemption",
+			buffer:        i(1e9),
+			maxRedemption: i(1e8),
+			burnAmount:    c(liquidDenom, 1e8+1),
+			expectedErr:   types.ErrInstantRedemptionTooLarge,
+		},
+		{
+			name:          "error when instant redemptions are disabled",
+			buffer:        i(1e9),
+			maxRedemption: i(0),
+			burnAmount:    c(liquidDenom, 1),
+			expectedErr:   types.ErrInstantRedemptionTooLarge,
+		},
+		{
+			name:          "error when buffer is too small",
+			buffer:        i(1e8 - 1),
+			maxRedemption: i(1e9),
+			burnAmount:    c(liquidDenom, 1e8),
+			expectedErr:   types.ErrInsufficientBuffer,
+		},
+		{
+			name:          "error when user doesn't have enough funds",
+			buffer:        i(1e10),
+			maxRedemption: i(1e10),
+			burnAmount:    c(liquidDenom, 1e9+1),
+			expectedErr:   sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.Keeper.SetParams(suite.Ctx, types.NewParams(sdkmath.ZeroInt(), tc.maxRedemption, nil, sdk.OneDec(), sdkmath.ZeroInt()))
+
+			userBalance := c(liquidDenom, 1e9)
+			suite.CreateAccountWithAddress(valAccAddr, suite.NewBondCoins(i(1e6)))
+			suite.CreateAccountWithAddress(user, sdk.NewCoins(userBalance))
+			suite.AddCoinsToModule(types.ModuleAccountName, suite.NewBondCoins(i(1e9)))
+			suite.AddCoinsToModule(types.RedemptionBufferAccountName, suite.NewBondCoins(tc.buffer))
+
+			// create delegation from module account to back the derivatives
+			moduleAccAddress := authtypes.NewModuleAddress(types.ModuleAccountName)
+			bufferAccAddress := authtypes.NewModuleAddress(types.RedemptionBufferAccountName)
+			suite.CreateNewUnbondedValidator(valAddr, i(1e6))
+			suite.CreateDelegation(valAddr, moduleAccAddress, i(1e9))
+			staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+			redeemed, err := suite.Keeper.InstantRedeemDerivative(suite.Ctx, user, valAddr, tc.burnAmount)
+
+			suite.Require().ErrorIs(err, tc.expectedErr)
+			if tc.expectedErr != nil {
+				return
+			}
+
+			expectedRedeemed := suite.NewBondCoin(tc.burnAmount.Amount)
+			suite.Equal(expectedRedeemed, redeemed)
+			suite.AccountBalanceEqual(user, sdk.NewCoins(userBalance.Sub(tc.burnAmount), expectedRedeemed))
+			suite.AccountBalanceEqual(bufferAccAddress, suite.NewBondCoins(tc.buffer.Sub(tc.burnAmount.Amount)))
+
+			sharesTransferred := sdk.NewDecFromInt(tc.burnAmount.Amount)
+			suite.DelegationSharesEqual(valAddr, user, sdk.ZeroDec())
+			suite.DelegationSharesEqual(valAddr, bufferAccAddress, sharesTransferred)
+			suite.DelegationSharesEqual(valAddr, moduleAccAddress, sdk.NewDec(1e9).Sub(sharesTransferred))
+
+			suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+				types.EventTypeBurnDerivative,
+				sdk.NewAttribute(types.AttributeKeyDelegator, user.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, tc.burnAmount.String()),
+				sdk.NewAttribute(types.AttributeKeySharesTransferred, sharesTransferred.String()),
+				sdk.NewAttribute(types.AttributeKeyRedeemed, expectedRedeemed.String()),
+			))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestInstantRedeemDerivative_SlashedValidator() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(5)
+	valAccAddr, user := addrs[0], addrs[1]
+	valAddr := sdk.ValAddress(valAccAddr)
+	liquidDenom := suite.Keeper.GetLiquidStakingTokenDenom(valAddr)
+
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(sdkmath.ZeroInt(), i(1e9), nil, sdk.OneDec(), sdkmath.ZeroInt()))
+
+	suite.CreateAccountWithAddress(valAccAddr, suite.NewBondCoins(i(1e9)))
+	suite.CreateAccountWithAddress(user, sdk.NewCoins(c(liquidDenom, 1e9)))
+	suite.AddCoinsToModule(types.ModuleAccountName, suite.NewBondCoins(i(1e9)))
+	suite.AddCoinsToModule(types.RedemptionBufferAccountName, suite.NewBondCoins(i(1e9)))
+
+	moduleAccAddress := authtypes.NewModuleAddress(types.ModuleAccountName)
+	suite.CreateNewUnbondedValidator(valAddr, i(1e9))
+	suite.CreateDelegation(valAddr, moduleAccAddress, i(1e9))
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	suite.SlashValidator(valAddr, sdk.MustNewDecFromStr("0.5"))
+
+	// derivatives are redeemed at the value of their delegation shares
+	redeemed, err := suite.Keeper.InstantRedeemDerivative(suite.Ctx, user, valAddr, c(liquidDenom, 1e8))
+	suite.Require().NoError(err)
+	suite.Equal(suite.NewBondCoin(i(5e7)), redeemed)
+}
+
+func (suite *KeeperTestSuite) TestUndelegateRedeemedDelegations() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(5)
+	valAccAddr, user := addrs[0], addrs[1]
+	valAddr := sdk.ValAddress(valAccAddr)
+	liquidDenom := suite.Keeper.GetLiquidStakingTokenDenom(valAddr)
+
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(i(1e9), i(1e9), nil, sdk.OneDec(), sdkmath.ZeroInt()))
+
+	suite.CreateAccountWithAddress(valAccAddr, suite.NewBondCoins(i(1e6)))
+	suite.CreateAccountWithAddress(user, sdk.NewCoins(c(liquidDenom, 1e9)))
+	suite.AddCoinsToModule(types.ModuleAccountName, suite.NewBondCoins(i(1e9)))
+	suite.AddCoinsToModule(communitytypes.ModuleAccountName, suite.NewBondCoins(i(1e9)))
+
+	moduleAccAddress := authtypes.NewModuleAddress(types.ModuleAccountName)
+	bufferAccAddress := authtypes.NewModuleAddress(types.RedemptionBufferAccountName)
+	suite.CreateNewUnbondedValidator(valAddr, i(1e6))
+	suite.CreateDelegation(valAddr, moduleAccAddress, i(1e9))
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	suite.Require().NoError(suite.Keeper.FundRedemptionBuffer(suite.Ctx))
+	suite.Equal(suite.NewBondCoin(i(1e9)), suite.Keeper.GetRedemptionBufferBalance(suite.Ctx))
+
+	_, err := suite.Keeper.InstantRedeemDerivative(suite.Ctx, user, valAddr, c(liquidDenom, 3e8))
+	suite.Require().NoError(err)
+
+	suite.Equal(suite.NewBondCoin(i(7e8)), suite.Keeper.GetRedemptionBufferBalance(suite.Ctx))
+	suite.Equal(suite.NewBondCoin(i(3e8)), suite.Keeper.GetRedemptionBufferPendingRefill(suite.Ctx))
+
+	suite.Keeper.UndelegateRedeemedDelegations(suite.Ctx)
+
+	suite.DelegationSharesEqual(valAddr, bufferAccAddress, sdk.ZeroDec())
+	suite.Equal(i(3e8), suite.StakingKeeper.GetDelegatorUnbonding(suite.Ctx, bufferAccAddress))
+	suite.Equal(suite.NewBondCoin(i(3e8)), suite.Keeper.GetRedemptionBufferPendingRefill(suite.Ctx))
+
+	// redeemed funds being unbonded count towards the buffer target
+	suite.Require().NoError(suite.Keeper.FundRedemptionBuffer(suite.Ctx))
+	suite.Equal(suite.NewBondCoin(i(7e8)), suite.Keeper.GetRedemptionBufferBalance(suite.Ctx))
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(suite.StakingKeeper.UnbondingTime(suite.Ctx)))
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	suite.Equal(suite.NewBondCoin(i(1e9)), suite.Keeper.GetRedemptionBufferBalance(suite.Ctx))
+	suite.Equal(suite.NewBondCoin(i(0)), suite.Keeper.GetRedemptionBufferPendingRefill(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestFundRedemptionBuffer() {
+	communityAccAddress := authtypes.NewModuleAddress(communitytypes.ModuleAccountName)
+	bufferAccAddress := authtypes.NewModuleAddress(types.RedemptionBufferAccountName)
+
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(i(1e9), i(1e8), nil, sdk.OneDec(), sdkmath.ZeroInt()))
+	suite.AddCoinsToModule(communitytypes.ModuleAccountName, suite.NewBondCoins(i(5e8)))
+
+	// the buffer is funded up to the community pool balance
+	suite.Require().NoError(suite.Keeper.FundRedemptionBuffer(suite.Ctx))
+	suite.AccountBalanceEqual(bufferAccAddress, suite.NewBondCoins(i(5e8)))
+	suite.AccountBalanceEqual(communityAccAddress, sdk.NewCoins())
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeFundBuffer,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, suite.NewBondCoin(i(5e8)).String()),
+	))
+
+	suite.AddCoinsToModule(communitytypes.ModuleAccountName, suite.NewBondCoins(i(1e9)))
+
+	suite.Require().NoError(suite.Keeper.FundRedemptionBuffer(suite.Ctx))
+	suite.AccountBalanceEqual(bufferAccAddress, suite.NewBondCoins(i(1e9)))
+	suite.AccountBalanceEqual(communityAccAddress, suite.NewBondCoins(i(5e8)))
+
+	// excess funds are returned when the target is lowered
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(i(3e8), i(1e8), nil, sdk.OneDec(), sdkmath.ZeroInt()))
+
+	suite.Require().NoError(suite.Keeper.FundRedemptionBuffer(suite.Ctx))
+	suite.AccountBalanceEqual(bufferAccAddress, suite.NewBondCoins(i(3e8)))
+	suite.AccountBalanceEqual(communityAccAddress, suite.NewBondCoins(i(12e8)))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeDefundBuffer,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, suite.NewBondCoin(i(7e8)).String()),
+	))
+}
@@ -0,0 +1,39 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// MigrateStore performs in-place store migrations for consensus version 2
+// V2 adds the liquid module's first params, the redemption buffer target, max
+// instant redemption, allowed validators, max validator share and validator
+// share cap threshold, seeded with their defaults.
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	migrateParamsStore(ctx, paramstore)
+	return nil
+}
+
+// migrateParamsStore ensures the param key table exists and has the redemption buffer & validator properties
+func migrateParamsStore(ctx sdk.Context, paramstore paramtypes.Subspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+	if !paramstore.Has(ctx, types.KeyRedemptionBufferTarget) {
+		paramstore.Set(ctx, types.KeyRedemptionBufferTarget, types.DefaultRedemptionBufferTarget)
+	}
+	if !paramstore.Has(ctx, types.KeyMaxInstantRedemption) {
+		paramstore.Set(ctx, types.KeyMaxInstantRedemption, types.DefaultMaxInstantRedemption)
+	}
+	if !paramstore.Has(ctx, types.KeyAllowedValidators) {
+		paramstore.Set(ctx, types.KeyAllowedValidators, types.DefaultAllowedValidators)
+	}
+	if !paramstore.Has(ctx, types.KeyMaxValidatorShare) {
+		paramstore.Set(ctx, types.KeyMaxValidatorShare, types.DefaultMaxValidatorShare)
+	}
+	if !paramstore.Has(ctx, types.KeyValidatorShareCapThreshold) {
+		paramstore.Set(ctx, types.KeyValidatorShareCapThreshold, types.DefaultValidatorShareCapThreshold)
+	}
+}
@@ -0,0 +1,67 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v2liquid "github.com/kava-labs/kava/x/liquid/migrations/v2"
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+func TestStoreMigrationAddsKeyTableIncludingNewParams(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	liquidKey := sdk.NewKVStoreKey(types.ModuleName)
+	tLiquidKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(liquidKey, tLiquidKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, liquidKey, tLiquidKey, types.ModuleName)
+
+	// Check params don't exist before
+	require.False(t, paramstore.Has(ctx, types.KeyRedemptionBufferTarget))
+	require.False(t, paramstore.Has(ctx, types.KeyMaxInstantRedemption))
+	require.False(t, paramstore.Has(ctx, types.KeyAllowedValidators))
+	require.False(t, paramstore.Has(ctx, types.KeyMaxValidatorShare))
+	require.False(t, paramstore.Has(ctx, types.KeyValidatorShareCapThreshold))
+
+	// Run migrations.
+	err := v2liquid.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	require.True(t, paramstore.Has(ctx, types.KeyRedemptionBufferTarget))
+	require.True(t, paramstore.Has(ctx, types.KeyMaxInstantRedemption))
+	require.True(t, paramstore.Has(ctx, types.KeyAllowedValidators))
+	require.True(t, paramstore.Has(ctx, types.KeyMaxValidatorShare))
+	require.True(t, paramstore.Has(ctx, types.KeyValidatorShareCapThreshold))
+}
+
+func TestStoreMigrationKeepsExistingParams(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	liquidKey := sdk.NewKVStoreKey(types.ModuleName)
+	tLiquidKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(liquidKey, tLiquidKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, liquidKey, tLiquidKey, types.ModuleName)
+	paramstore.WithKeyTable(types.ParamKeyTable())
+
+	target := sdkmath.NewInt(1e9)
+	paramstore.Set(ctx, types.KeyRedemptionBufferTarget, target)
+
+	// Run migrations.
+	err := v2liquid.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the existing params are untouched and the new params are defaults.
+	var params types.Params
+	paramstore.GetParamSet(ctx, &params)
+	require.Equal(t, target, params.RedemptionBufferTarget)
+	require.Equal(t, types.DefaultMaxInstantRedemption, params.MaxInstantRedemption)
+	require.Empty(t, params.AllowedValidators)
+	require.Equal(t, types.DefaultMaxValidatorShare, params.MaxValidatorShare)
+	require.Equal(t, types.DefaultValidatorShareCapThreshold, params.ValidatorShareCapThreshold)
+}
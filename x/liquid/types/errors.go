@@ -10,4 +10,8 @@ var (
 	ErrRedelegationsNotCompleted  = errorsmod.Register(ModuleName, 6, "active redelegations cannot be transferred")
 	ErrUntransferableShares       = errorsmod.Register(ModuleName, 7, "shares cannot be transferred")
 	ErrSelfDelegationBelowMinimum = errorsmod.Register(ModuleName, 8, "validator's self delegation must be greater than their minimum self delegation")
+	ErrInstantRedemptionTooLarge  = errorsmod.Register(ModuleName, 9, "instant redemption exceeds maximum")
+	ErrInsufficientBuffer         = errorsmod.Register(ModuleName, 10, "insufficient redemption buffer")
+	ErrValidatorNotAllowed        = errorsmod.Register(ModuleName, 11, "validator is not allowed to back derivatives")
+	ErrValidatorShareCapExceeded  = errorsmod.Register(ModuleName, 12, "validator share of derivative backed stake exceeds maximum")
 )
@@ -3,9 +3,14 @@ package types
 const (
 	EventTypeMintDerivative = "mint_derivative"
 	EventTypeBurnDerivative = "burn_derivative"
+	EventTypeFundBuffer     = "fund_redemption_buffer"
+	EventTypeDefundBuffer   = "defund_redemption_buffer"
+	EventTypeRestakeRewards = "restake_derivative_rewards"
 
 	AttributeValueCategory        = ModuleName
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyValidator         = "validator"
 	AttributeKeySharesTransferred = "shares_transferred"
+	AttributeKeyRedeemed          = "redeemed"
+	AttributeKeySharesReceived    = "shares_received"
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -20,6 +22,7 @@ type BankKeeper interface {
 
 	IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool)
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 }
 
 // AccountKeeper defines the expected keeper interface for interacting with account
@@ -49,9 +52,19 @@ type StakingKeeper interface {
 	Unbond(
 		ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec,
 	) (amount sdkmath.Int, err error)
+	Undelegate(
+		ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec,
+	) (time.Time, error)
+	GetDelegatorUnbonding(ctx sdk.Context, delegator sdk.AccAddress) sdkmath.Int
 }
 
 type DistributionKeeper interface {
 	GetDelegatorWithdrawAddr(ctx sdk.Context, delAddr sdk.AccAddress) sdk.AccAddress
 	WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error)
 }
+
+// LiquidHooks are event hooks called when staking derivatives are transferred between accounts.
+type LiquidHooks interface {
+	BeforeDerivativeTransfer(ctx sdk.Context, sender, recipient sdk.AccAddress, derivatives sdk.Coins)
+	AfterDerivativeTransfer(ctx sdk.Context, sender, recipient sdk.AccAddress, derivatives sdk.Coins)
+}
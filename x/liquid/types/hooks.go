@@ -0,0 +1,27 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// MultiLiquidHooks combine multiple liquid hooks, all hook functions are run in array sequence
+type MultiLiquidHooks []LiquidHooks
+
+var _ LiquidHooks = MultiLiquidHooks{}
+
+// NewMultiLiquidHooks returns a new MultiLiquidHooks
+func NewMultiLiquidHooks(hooks ...LiquidHooks) MultiLiquidHooks {
+	return hooks
+}
+
+// BeforeDerivativeTransfer runs before staking derivatives are sent from one account to another
+func (h MultiLiquidHooks) BeforeDerivativeTransfer(ctx sdk.Context, sender, recipient sdk.AccAddress, derivatives sdk.Coins) {
+	for i := range h {
+		h[i].BeforeDerivativeTransfer(ctx, sender, recipient, derivatives)
+	}
+}
+
+// AfterDerivativeTransfer runs after staking derivatives are sent from one account to another
+func (h MultiLiquidHooks) AfterDerivativeTransfer(ctx sdk.Context, sender, recipient sdk.AccAddress, derivatives sdk.Coins) {
+	for i := range h {
+		h[i].AfterDerivativeTransfer(ctx, sender, recipient, derivatives)
+	}
+}
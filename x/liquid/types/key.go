@@ -17,6 +17,10 @@ const (
 	// ModuleAccountName is the module account's name
 	ModuleAccountName = ModuleName
 
+	// RedemptionBufferAccountName is the name of the module account holding the
+	// staking tokens used for instant redemptions
+	RedemptionBufferAccountName = "liquid-redemption-buffer"
+
 	DefaultDerivativeDenom = "bkava"
 
 	DenomSeparator = "-"
@@ -0,0 +1,176 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter keys and default values
+var (
+	KeyRedemptionBufferTarget         = []byte("RedemptionBufferTarget")
+	KeyMaxInstantRedemption           = []byte("MaxInstantRedemption")
+	KeyAllowedValidators              = []byte("AllowedValidators")
+	KeyMaxValidatorShare              = []byte("MaxValidatorShare")
+	KeyValidatorShareCapThreshold     = []byte("ValidatorShareCapThreshold")
+	DefaultRedemptionBufferTarget     = sdkmath.ZeroInt()
+	DefaultMaxInstantRedemption       = sdkmath.ZeroInt()
+	DefaultAllowedValidators          = []string{}
+	DefaultMaxValidatorShare          = sdk.OneDec()
+	DefaultValidatorShareCapThreshold = sdkmath.ZeroInt()
+)
+
+// NewParams returns a new params object
+func NewParams(
+	redemptionBufferTarget, maxInstantRedemption sdkmath.Int,
+	allowedValidators []string,
+	maxValidatorShare sdk.Dec,
+	validatorShareCapThreshold sdkmath.Int,
+) Params {
+	return Params{
+		RedemptionBufferTarget:     redemptionBufferTarget,
+		MaxInstantRedemption:       maxInstantRedemption,
+		AllowedValidators:          allowedValidators,
+		MaxValidatorShare:          maxValidatorShare,
+		ValidatorShareCapThreshold: validatorShareCapThreshold,
+	}
+}
+
+// DefaultParams returns default params for liquid module
+func DefaultParams() Params {
+	return NewParams(
+		DefaultRedemptionBufferTarget,
+		DefaultMaxInstantRedemption,
+		DefaultAllowedValidators,
+		DefaultMaxValidatorShare,
+		DefaultValidatorShareCapThreshold,
+	)
+}
+
+// ParamKeyTable for liquid module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of liquid module's parameters.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRedemptionBufferTarget, &p.RedemptionBufferTarget, validateRedemptionBufferTarget),
+		paramtypes.NewParamSetPair(KeyMaxInstantRedemption, &p.MaxInstantRedemption, validateMaxInstantRedemption),
+		paramtypes.NewParamSetPair(KeyAllowedValidators, &p.AllowedValidators, validateAllowedValidators),
+		paramtypes.NewParamSetPair(KeyMaxValidatorShare, &p.MaxValidatorShare, validateMaxValidatorShare),
+		paramtypes.NewParamSetPair(KeyValidatorShareCapThreshold, &p.ValidatorShareCapThreshold, validateValidatorShareCapThreshold),
+	}
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if err := validateRedemptionBufferTarget(p.RedemptionBufferTarget); err != nil {
+		return err
+	}
+
+	if err := validateMaxInstantRedemption(p.MaxInstantRedemption); err != nil {
+		return err
+	}
+
+	if err := validateAllowedValidators(p.AllowedValidators); err != nil {
+		return err
+	}
+
+	if err := validateMaxValidatorShare(p.MaxValidatorShare); err != nil {
+		return err
+	}
+
+	return validateValidatorShareCapThreshold(p.ValidatorShareCapThreshold)
+}
+
+// IsValidatorAllowed returns true if derivatives can be minted for a validator.
+func (p Params) IsValidatorAllowed(valAddr sdk.ValAddress) bool {
+	if len(p.AllowedValidators) == 0 {
+		return true
+	}
+
+	for _, allowed := range p.AllowedValidators {
+		if allowed == valAddr.String() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateRedemptionBufferTarget(i interface{}) error {
+	target, ok := i.(sdkmath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if target.IsNil() || target.IsNegative() {
+		return fmt.Errorf("redemption buffer target must be non-negative: %s", target)
+	}
+
+	return nil
+}
+
+func validateMaxInstantRedemption(i interface{}) error {
+	max, ok := i.(sdkmath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if max.IsNil() || max.IsNegative() {
+		return fmt.Errorf("max instant redemption must be non-negative: %s", max)
+	}
+
+	return nil
+}
+
+func validateAllowedValidators(i interface{}) error {
+	allowedValidators, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenValidators := make(map[string]bool)
+	for _, validator := range allowedValidators {
+		if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+			return fmt.Errorf("invalid allowed validator %s: %w", validator, err)
+		}
+
+		if seenValidators[validator] {
+			return fmt.Errorf("duplicated allowed validator %s", validator)
+		}
+		seenValidators[validator] = true
+	}
+
+	return nil
+}
+
+func validateMaxValidatorShare(i interface{}) error {
+	share, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if share.IsNil() || !share.IsPositive() || share.GT(sdk.OneDec()) {
+		return fmt.Errorf("max validator share must be greater than 0 and at most 1: %s", share)
+	}
+
+	return nil
+}
+
+func validateValidatorShareCapThreshold(i interface{}) error {
+	threshold, ok := i.(sdkmath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if threshold.IsNil() || threshold.IsNegative() {
+		return fmt.Errorf("validator share cap threshold must be non-negative: %s", threshold)
+	}
+
+	return nil
+}
@@ -0,0 +1,538 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/liquid/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the liquid module.
+type Params struct {
+	// redemption_buffer_target is the amount of staking tokens the redemption
+	// buffer is kept funded with from the community pool. If zero, the buffer is
+	// not funded and any funds in it are returned to the community pool.
+	RedemptionBufferTarget github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=redemption_buffer_target,json=redemptionBufferTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"redemption_buffer_target"`
+	// max_instant_redemption is the largest amount of staking tokens a single
+	// derivative burn can redeem instantly from the redemption buffer. If zero,
+	// instant redemptions are disabled.
+	MaxInstantRedemption github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=max_instant_redemption,json=maxInstantRedemption,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_instant_redemption"`
+	// allowed_validators are the validators derivatives can be minted for. If
+	// empty, derivatives can be minted for any validator.
+	AllowedValidators []string `protobuf:"bytes,3,rep,name=allowed_validators,json=allowedValidators,proto3" json:"allowed_validators,omitempty"`
+	// max_validator_share is the largest fraction of the total derivative backed
+	// stake that can be delegated to a single validator.
+	MaxValidatorShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_validator_share,json=maxValidatorShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_validator_share"`
+	// validator_share_cap_threshold is the total derivative backed stake below
+	// which max_validator_share is not enforced, so that derivatives can be
+	// minted while few validators back them.
+	ValidatorShareCapThreshold github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=validator_share_cap_threshold,json=validatorShareCapThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"validator_share_cap_threshold"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5095dfc5eac0281, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAllowedValidators() []string {
+	if m != nil {
+		return m.AllowedValidators
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "kava.liquid.v1beta1.Params")
+}
+
+func init() { proto.RegisterFile("kava/liquid/v1beta1/params.proto", fileDescriptor_d5095dfc5eac0281) }
+
+var fileDescriptor_d5095dfc5eac0281 = []byte{
+	// 398 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xbf, 0x8e, 0x9b, 0x40,
+	0x10, 0x87, 0x21, 0x77, 0x39, 0xe9, 0xb6, 0x3b, 0xee, 0x64, 0x11, 0x4b, 0xc6, 0x4e, 0x8a, 0xc8,
+	0x0d, 0x20, 0x2b, 0x6d, 0x9a, 0x10, 0x37, 0xee, 0x2c, 0x6c, 0xa5, 0x48, 0xb3, 0x1a, 0xd8, 0x35,
+	0x20, 0x03, 0x4b, 0x76, 0xd7, 0x84, 0x54, 0x79, 0x85, 0x3c, 0x8c, 0xf3, 0x0e, 0x2e, 0x2d, 0x57,
+	0x51, 0x0a, 0x2b, 0xb2, 0x5f, 0x24, 0xe2, 0x8f, 0xb1, 0x93, 0xee, 0x24, 0x57, 0x2c, 0x33, 0x3f,
+	0xbe, 0x8f, 0x59, 0x0d, 0x1a, 0x2c, 0x21, 0x07, 0x3b, 0x8e, 0xbe, 0xac, 0x22, 0x62, 0xe7, 0x23,
+	0x8f, 0x4a, 0x18, 0xd9, 0x19, 0x70, 0x48, 0x84, 0x95, 0x71, 0x26, 0x99, 0xf6, 0x58, 0x26, 0xac,
+	0x3a, 0x61, 0x35, 0x89, 0xee, 0x2b, 0x9f, 0x89, 0x84, 0x09, 0x5c, 0x45, 0xec, 0xfa, 0xa5, 0xce,
+	0x77, 0x9f, 0x02, 0x16, 0xb0, 0xba, 0x5e, 0x9e, 0xea, 0xea, 0x9b, 0x9f, 0xb7, 0xe8, 0x6e, 0x5a,
+	0x61, 0xb5, 0x1c, 0xe9, 0x9c, 0x12, 0x9a, 0x64, 0x32, 0x62, 0x29, 0xf6, 0x56, 0x8b, 0x05, 0xe5,
+	0x58, 0x02, 0x0f, 0xa8, 0xd4, 0xd5, 0x81, 0x3a, 0xbc, 0x77, 0xde, 0x6f, 0xf6, 0x7d, 0xe5, 0xf7,
+	0xbe, 0xff, 0x36, 0x88, 0x64, 0xb8, 0xf2, 0x2c, 0x9f, 0x25, 0x8d, 0xa3, 0x79, 0x98, 0x82, 0x2c,
+	0x6d, 0xf9, 0x2d, 0xa3, 0xc2, 0x9a, 0xa4, 0x72, 0xb7, 0x36, 0x51, 0xf3, 0x0b, 0x93, 0x54, 0xba,
+	0x9d, 0x33, 0xdd, 0xa9, 0xe0, 0xf3, 0x8a, 0xad, 0x71, 0xd4, 0x49, 0xa0, 0xc0, 0x51, 0x2a, 0x24,
+	0xa4, 0x12, 0x9f, 0x53, 0xfa, 0x8b, 0x2b, 0x58, 0x9f, 0x12, 0x28, 0x26, 0x35, 0xda, 0x6d, 0xc9,
+	0xda, 0x14, 0x69, 0x10, 0xc7, 0xec, 0x2b, 0x25, 0x38, 0x87, 0x38, 0x22, 0x20, 0x19, 0x17, 0xfa,
+	0xcd, 0xe0, 0x66, 0x78, 0xef, 0xbc, 0xde, 0xad, 0xcd, 0x5e, 0x43, 0xf8, 0x74, 0x6a, 0x7e, 0x20,
+	0x84, 0x53, 0x21, 0x66, 0x92, 0x47, 0x69, 0xe0, 0x3e, 0x34, 0x1f, 0xb7, 0x6d, 0xa1, 0xc5, 0xe8,
+	0xb1, 0x9c, 0xa2, 0xa5, 0x61, 0x11, 0x02, 0xa7, 0xfa, 0xed, 0xb3, 0x47, 0x18, 0x53, 0xff, 0x62,
+	0x84, 0x31, 0xf5, 0xdd, 0x87, 0x04, 0x8a, 0xd6, 0x34, 0x2b, 0xb1, 0xda, 0x77, 0xd4, 0xfb, 0xcf,
+	0x84, 0x7d, 0xc8, 0xb0, 0x0c, 0x39, 0x15, 0x21, 0x8b, 0x89, 0xfe, 0xf2, 0x0a, 0x57, 0xd7, 0xcd,
+	0xff, 0x91, 0x7e, 0x84, 0x6c, 0x7e, 0xe2, 0x3b, 0xce, 0xe6, 0x60, 0xa8, 0xdb, 0x83, 0xa1, 0xfe,
+	0x39, 0x18, 0xea, 0x8f, 0xa3, 0xa1, 0x6c, 0x8f, 0x86, 0xf2, 0xeb, 0x68, 0x28, 0x9f, 0x87, 0x17,
+	0xae, 0x72, 0x45, 0xcd, 0x18, 0x3c, 0x51, 0x9d, 0xec, 0xe2, 0xb4, 0xd0, 0x95, 0xd1, 0xbb, 0xab,
+	0x56, 0xf0, 0xdd, 0xdf, 0x01, 0x00, 0x5d, 0xd7, 0x6b, 0x24, 0xec, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ValidatorShareCapThreshold.Size()
+		i -= size
+		if _, err := m.ValidatorShareCapThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxValidatorShare.Size()
+		i -= size
+		if _, err := m.MaxValidatorShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.AllowedValidators) > 0 {
+		for iNdEx := len(m.AllowedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedValidators[iNdEx])
+			copy(dAtA[i:], m.AllowedValidators[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.MaxInstantRedemption.Size()
+		i -= size
+		if _, err := m.MaxInstantRedemption.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.RedemptionBufferTarget.Size()
+		i -= size
+		if _, err := m.RedemptionBufferTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RedemptionBufferTarget.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxInstantRedemption.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.AllowedValidators) > 0 {
+		for _, s := range m.AllowedValidators {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.MaxValidatorShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.ValidatorShareCapThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionBufferTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionBufferTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInstantRedemption", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxInstantRedemption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedValidators = append(m.AllowedValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValidatorShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorShareCapThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorShareCapThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,92 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+func TestParams_Validate(t *testing.T) {
+	validValidator := "kavavaloper1ze7y9qwdddejmy7jlw4cymqqlt2wh05y6cpt5a"
+
+	tests := []struct {
+		name    string
+		params  types.Params
+		wantErr string
+	}{
+		{
+			name:   "default params are valid",
+			params: types.DefaultParams(),
+		},
+		{
+			name: "valid params",
+			params: types.NewParams(
+				sdkmath.NewInt(1e9), sdkmath.NewInt(1e8), []string{validValidator}, sdk.MustNewDecFromStr("0.2"), sdkmath.NewInt(1e12),
+			),
+		},
+		{
+			name: "negative redemption buffer target",
+			params: types.NewParams(
+				sdkmath.NewInt(-1), sdkmath.ZeroInt(), nil, sdk.OneDec(), sdkmath.ZeroInt(),
+			),
+			wantErr: "redemption buffer target must be non-negative",
+		},
+		{
+			name: "negative max instant redemption",
+			params: types.NewParams(
+				sdkmath.ZeroInt(), sdkmath.NewInt(-1), nil, sdk.OneDec(), sdkmath.ZeroInt(),
+			),
+			wantErr: "max instant redemption must be non-negative",
+		},
+		{
+			name: "invalid allowed validator",
+			params: types.NewParams(
+				sdkmath.ZeroInt(), sdkmath.ZeroInt(), []string{"kava1ze7y9qwdddejmy7jlw4cymqqlt2wh05yhwet7k"}, sdk.OneDec(), sdkmath.ZeroInt(),
+			),
+			wantErr: "invalid allowed validator",
+		},
+		{
+			name: "duplicated allowed validator",
+			params: types.NewParams(
+				sdkmath.ZeroInt(), sdkmath.ZeroInt(), []string{validValidator, validValidator}, sdk.OneDec(), sdkmath.ZeroInt(),
+			),
+			wantErr: "duplicated allowed validator",
+		},
+		{
+			name: "zero max validator share",
+			params: types.NewParams(
+				sdkmath.ZeroInt(), sdkmath.ZeroInt(), nil, sdk.ZeroDec(), sdkmath.ZeroInt(),
+			),
+			wantErr: "max validator share must be greater than 0 and at most 1",
+		},
+		{
+			name: "max validator share above one",
+			params: types.NewParams(
+				sdkmath.ZeroInt(), sdkmath.ZeroInt(), nil, sdk.MustNewDecFromStr("1.01"), sdkmath.ZeroInt(),
+			),
+			wantErr: "max validator share must be greater than 0 and at most 1",
+		},
+		{
+			name: "negative validator share cap threshold",
+			params: types.NewParams(
+				sdkmath.ZeroInt(), sdkmath.ZeroInt(), nil, sdk.OneDec(), sdkmath.NewInt(-1),
+			),
+			wantErr: "validator share cap threshold must be non-negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -149,6 +149,9 @@ type MsgBurnDerivative struct {
 	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
 	// amount is the quantity of derivatives to be converted
 	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// instant redeems the derivatives for staking tokens from the redemption
+	// buffer instead of converting them into a delegation
+	Instant bool `protobuf:"varint,4,opt,name=instant,proto3" json:"instant,omitempty"`
 }
 
 func (m *MsgBurnDerivative) Reset()         { *m = MsgBurnDerivative{} }
@@ -205,10 +208,21 @@ func (m *MsgBurnDerivative) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgBurnDerivative) GetInstant() bool {
+	if m != nil {
+		return m.Instant
+	}
+	return false
+}
+
 // MsgBurnDerivativeResponse defines the Msg/BurnDerivative response type.
 type MsgBurnDerivativeResponse struct {
-	// received is the number of delegation shares sent to the sender
+	// received is the number of delegation shares sent to the sender, it is
+	// zero for instant redemptions
 	Received github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=received,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"received"`
+	// redeemed is the amount of staking tokens sent to the sender by an instant
+	// redemption
+	Redeemed types.Coin `protobuf:"bytes,2,opt,name=redeemed,proto3" json:"redeemed"`
 }
 
 func (m *MsgBurnDerivativeResponse) Reset()         { *m = MsgBurnDerivativeResponse{} }
@@ -244,6 +258,13 @@ func (m *MsgBurnDerivativeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgBurnDerivativeResponse proto.InternalMessageInfo
 
+func (m *MsgBurnDerivativeResponse) GetRedeemed() types.Coin {
+	if m != nil {
+		return m.Redeemed
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*MsgMintDerivative)(nil), "kava.liquid.v1beta1.MsgMintDerivative")
 	proto.RegisterType((*MsgMintDerivativeResponse)(nil), "kava.liquid.v1beta1.MsgMintDerivativeResponse")
@@ -254,34 +275,36 @@ func init() {
 func init() { proto.RegisterFile("kava/liquid/v1beta1/tx.proto", fileDescriptor_738981106e50f269) }
 
 var fileDescriptor_738981106e50f269 = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0xbd, 0xae, 0xda, 0x30,
-	0x14, 0x8e, 0x7b, 0x2b, 0x54, 0x5c, 0xe9, 0x4a, 0x4d, 0xef, 0x10, 0xd0, 0x55, 0x40, 0x0c, 0x88,
-	0x25, 0x4e, 0xa1, 0x43, 0x87, 0x76, 0x69, 0xca, 0xca, 0x92, 0x2e, 0xa8, 0x4b, 0xe5, 0x24, 0x56,
-	0xb0, 0x00, 0x9b, 0xda, 0x4e, 0x44, 0xdf, 0xa2, 0x0f, 0xd0, 0xc7, 0xe0, 0x21, 0x18, 0x11, 0x53,
-	0xdb, 0x01, 0x55, 0xf0, 0x22, 0x55, 0x12, 0x13, 0xca, 0x9f, 0xc4, 0x78, 0x27, 0xdb, 0xe7, 0x3b,
-	0xdf, 0xf1, 0xf9, 0xbe, 0x63, 0xc3, 0xc7, 0x31, 0x4e, 0xb1, 0x3b, 0xa1, 0xdf, 0x12, 0x1a, 0xb9,
-	0x69, 0x37, 0x20, 0x0a, 0x77, 0x5d, 0x35, 0x47, 0x33, 0xc1, 0x15, 0x37, 0x5f, 0x67, 0x28, 0x2a,
-	0x50, 0xa4, 0xd1, 0xba, 0x1d, 0x72, 0x39, 0xe5, 0xd2, 0x0d, 0xb0, 0x24, 0x25, 0x25, 0xe4, 0x94,
-	0x15, 0xa4, 0x7a, 0xad, 0xc0, 0xbf, 0xe6, 0x27, 0xb7, 0x38, 0x68, 0xe8, 0x21, 0xe6, 0x31, 0x2f,
-	0xe2, 0xd9, 0xae, 0x88, 0xb6, 0x7e, 0x02, 0xf8, 0x6a, 0x20, 0xe3, 0x01, 0x65, 0xaa, 0x4f, 0x04,
-	0x4d, 0xb1, 0xa2, 0x29, 0x31, 0xdf, 0xc0, 0x8a, 0x24, 0x2c, 0x22, 0xc2, 0x02, 0x4d, 0xd0, 0xa9,
-	0x7a, 0xd6, 0x7a, 0xe1, 0x3c, 0xe8, 0x6a, 0x1f, 0xa3, 0x48, 0x10, 0x29, 0x3f, 0x2b, 0x41, 0x59,
-	0xec, 0xeb, 0x3c, 0xf3, 0x11, 0x56, 0x53, 0x3c, 0xa1, 0x11, 0x56, 0x5c, 0x58, 0xcf, 0x32, 0x92,
-	0x7f, 0x08, 0x98, 0xef, 0x60, 0x05, 0x4f, 0x79, 0xc2, 0x94, 0x75, 0xd7, 0x04, 0x9d, 0x97, 0xbd,
-	0x1a, 0xd2, 0xc5, 0x32, 0x1d, 0x7b, 0x71, 0xe8, 0x13, 0xa7, 0xcc, 0x7b, 0xbe, 0xdc, 0x34, 0x0c,
-	0x5f, 0xa7, 0xb7, 0x86, 0xb0, 0x76, 0xd6, 0x9d, 0x4f, 0xe4, 0x8c, 0x33, 0x49, 0xcc, 0xf7, 0xf0,
-	0x85, 0x20, 0x21, 0xa1, 0x29, 0x89, 0xf2, 0x3e, 0x6f, 0xa8, 0x5b, 0x12, 0xf6, 0xc2, 0xbd, 0x44,
-	0xb0, 0xa7, 0x28, 0x3c, 0xc9, 0x85, 0x1f, 0x77, 0x57, 0x0a, 0x1f, 0x9e, 0x08, 0xaf, 0x7a, 0x1f,
-	0x32, 0xf2, 0x9f, 0x4d, 0xa3, 0x1d, 0x53, 0x35, 0x4a, 0x02, 0x14, 0xf2, 0xa9, 0x9e, 0xbe, 0x5e,
-	0x1c, 0x19, 0x8d, 0x5d, 0xf5, 0x7d, 0x46, 0x24, 0xea, 0x93, 0x70, 0xbd, 0x70, 0xa0, 0x6e, 0xa4,
-	0x4f, 0xc2, 0x83, 0x2b, 0xbd, 0xdf, 0x00, 0xde, 0x0d, 0x64, 0x6c, 0x8e, 0xe0, 0xfd, 0xc9, 0x93,
-	0x68, 0xa3, 0x0b, 0xef, 0x11, 0x9d, 0x0d, 0xa7, 0x8e, 0x6e, 0xcb, 0x2b, 0xb5, 0x8c, 0xe0, 0xfd,
-	0xc9, 0x0c, 0xae, 0xde, 0x74, 0x9c, 0x77, 0xfd, 0xa6, 0xcb, 0xae, 0x79, 0xde, 0x72, 0x6b, 0x83,
-	0xd5, 0xd6, 0x06, 0x7f, 0xb7, 0x36, 0xf8, 0xb1, 0xb3, 0x8d, 0xd5, 0xce, 0x36, 0x7e, 0xed, 0x6c,
-	0xe3, 0x4b, 0xe7, 0x3f, 0xd7, 0xb2, 0x9a, 0xce, 0x04, 0x07, 0x32, 0xdf, 0xb9, 0xf3, 0xfd, 0xff,
-	0xcc, 0xbd, 0x0b, 0x2a, 0xf9, 0xaf, 0x79, 0xfb, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x40, 0x82, 0xe3,
-	0xbf, 0xbb, 0x03, 0x00, 0x00,
+	// 453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0xbf, 0x6e, 0x13, 0x31,
+	0x18, 0x8f, 0xdb, 0x2a, 0x34, 0x46, 0xaa, 0xc4, 0xd1, 0xc1, 0x89, 0xaa, 0x6b, 0x94, 0xa1, 0xca,
+	0x12, 0x1f, 0x2d, 0x03, 0x03, 0x2c, 0x1c, 0x59, 0xb3, 0x1c, 0x4b, 0xc5, 0x82, 0x7c, 0xe7, 0x4f,
+	0x17, 0xab, 0x89, 0x1d, 0x6c, 0xe7, 0x54, 0xde, 0x82, 0x07, 0xe0, 0x29, 0x50, 0xc5, 0x33, 0x74,
+	0xac, 0x3a, 0x01, 0x43, 0x85, 0x92, 0x17, 0x41, 0x17, 0x3b, 0x17, 0x48, 0x40, 0xba, 0x91, 0xe9,
+	0xee, 0xfb, 0xf3, 0xfb, 0xf9, 0xfb, 0xfd, 0xfc, 0x19, 0x9f, 0x5c, 0xb1, 0x82, 0x45, 0x13, 0xf1,
+	0x61, 0x2e, 0x78, 0x54, 0x9c, 0xa7, 0x60, 0xd9, 0x79, 0x64, 0xaf, 0xe9, 0x4c, 0x2b, 0xab, 0x82,
+	0xa7, 0x65, 0x95, 0xba, 0x2a, 0xf5, 0xd5, 0x4e, 0x98, 0x29, 0x33, 0x55, 0x26, 0x4a, 0x99, 0x81,
+	0x0a, 0x92, 0x29, 0x21, 0x1d, 0xa8, 0xd3, 0x76, 0xf5, 0xf7, 0xab, 0x28, 0x72, 0x81, 0x2f, 0x1d,
+	0xe7, 0x2a, 0x57, 0x2e, 0x5f, 0xfe, 0xb9, 0x6c, 0xef, 0x33, 0xc2, 0x4f, 0x46, 0x26, 0x1f, 0x09,
+	0x69, 0x87, 0xa0, 0x45, 0xc1, 0xac, 0x28, 0x20, 0x78, 0x86, 0x9b, 0x06, 0x24, 0x07, 0x4d, 0x50,
+	0x17, 0xf5, 0x5b, 0x31, 0xb9, 0xbf, 0x19, 0x1c, 0x7b, 0xb6, 0xd7, 0x9c, 0x6b, 0x30, 0xe6, 0xad,
+	0xd5, 0x42, 0xe6, 0x89, 0xef, 0x0b, 0x4e, 0x70, 0xab, 0x60, 0x13, 0xc1, 0x99, 0x55, 0x9a, 0xec,
+	0x95, 0xa0, 0x64, 0x93, 0x08, 0x5e, 0xe0, 0x26, 0x9b, 0xaa, 0xb9, 0xb4, 0x64, 0xbf, 0x8b, 0xfa,
+	0x8f, 0x2f, 0xda, 0xd4, 0x93, 0x95, 0x3a, 0xd6, 0xe2, 0xe8, 0x1b, 0x25, 0x64, 0x7c, 0x70, 0xfb,
+	0x70, 0xda, 0x48, 0x7c, 0x7b, 0xef, 0x12, 0xb7, 0x77, 0xa6, 0x4b, 0xc0, 0xcc, 0x94, 0x34, 0x10,
+	0xbc, 0xc4, 0x87, 0x1a, 0x32, 0x10, 0x05, 0x70, 0x82, 0xea, 0xf1, 0x56, 0x80, 0xde, 0x57, 0x27,
+	0x3c, 0x9e, 0x6b, 0xf9, 0x1f, 0x0a, 0x0f, 0x08, 0x7e, 0x24, 0xa4, 0xb1, 0x4c, 0x5a, 0x72, 0xd0,
+	0x45, 0xfd, 0xc3, 0x64, 0x1d, 0xf6, 0xbe, 0x20, 0xdc, 0xde, 0x19, 0xbc, 0xf2, 0xe4, 0x72, 0xcb,
+	0x93, 0x56, 0xfc, 0xaa, 0xe4, 0xfd, 0xf1, 0x70, 0x7a, 0x96, 0x0b, 0x3b, 0x9e, 0xa7, 0x34, 0x53,
+	0x53, 0xbf, 0x18, 0xfe, 0x33, 0x30, 0xfc, 0x2a, 0xb2, 0x1f, 0x67, 0x60, 0xe8, 0x10, 0xb2, 0xfb,
+	0x9b, 0x01, 0xf6, 0x33, 0x0e, 0x21, 0xdb, 0x18, 0xe6, 0xdc, 0xe6, 0x00, 0x53, 0xe0, 0x64, 0xaf,
+	0x9e, 0x98, 0x0a, 0x70, 0xf1, 0x1d, 0xe1, 0xfd, 0x91, 0xc9, 0x83, 0x31, 0x3e, 0xda, 0x5a, 0xb5,
+	0x33, 0xfa, 0x97, 0x3d, 0xa7, 0x3b, 0x97, 0xde, 0xa1, 0xf5, 0xfa, 0x2a, 0x23, 0xc6, 0xf8, 0x68,
+	0xeb, 0x6e, 0xff, 0x79, 0xd2, 0x9f, 0x7d, 0x1d, 0x5a, 0xaf, 0x6f, 0x7d, 0x52, 0x1c, 0xdf, 0x2e,
+	0x42, 0x74, 0xb7, 0x08, 0xd1, 0xcf, 0x45, 0x88, 0x3e, 0x2d, 0xc3, 0xc6, 0xdd, 0x32, 0x6c, 0x7c,
+	0x5b, 0x86, 0x8d, 0x77, 0xfd, 0xdf, 0x2c, 0x2f, 0x39, 0x07, 0x13, 0x96, 0x9a, 0xd5, 0x5f, 0x74,
+	0xbd, 0x7e, 0xf7, 0x2b, 0xe3, 0xd3, 0xe6, 0xea, 0x35, 0x3e, 0xff, 0x35, 0x00, 0x4c, 0xf5, 0x1e,
+	0x54, 0x13, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -298,7 +321,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// MintDerivative defines a method for converting a delegation into staking deriviatives.
 	MintDerivative(ctx context.Context, in *MsgMintDerivative, opts ...grpc.CallOption) (*MsgMintDerivativeResponse, error)
-	// BurnDerivative defines a method for converting staking deriviatives into a delegation.
+	// BurnDerivative defines a method for converting staking deriviatives into a delegation, or into
+	// staking tokens from the redemption buffer.
 	BurnDerivative(ctx context.Context, in *MsgBurnDerivative, opts ...grpc.CallOption) (*MsgBurnDerivativeResponse, error)
 }
 
@@ -332,7 +356,8 @@ func (c *msgClient) BurnDerivative(ctx context.Context, in *MsgBurnDerivative, o
 type MsgServer interface {
 	// MintDerivative defines a method for converting a delegation into staking deriviatives.
 	MintDerivative(context.Context, *MsgMintDerivative) (*MsgMintDerivativeResponse, error)
-	// BurnDerivative defines a method for converting staking deriviatives into a delegation.
+	// BurnDerivative defines a method for converting staking deriviatives into a delegation, or into
+	// staking tokens from the redemption buffer.
 	BurnDerivative(context.Context, *MsgBurnDerivative) (*MsgBurnDerivativeResponse, error)
 }
 
@@ -504,6 +529,16 @@ func (m *MsgBurnDerivative) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Instant {
+		i--
+		if m.Instant {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -551,6 +586,16 @@ func (m *MsgBurnDerivativeResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Redeemed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size := m.Received.Size()
 		i -= size
@@ -621,6 +666,9 @@ func (m *MsgBurnDerivative) Size() (n int) {
 	}
 	l = m.Amount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.Instant {
+		n += 2
+	}
 	return n
 }
 
@@ -632,6 +680,8 @@ func (m *MsgBurnDerivativeResponse) Size() (n int) {
 	_ = l
 	l = m.Received.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = m.Redeemed.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -997,6 +1047,26 @@ func (m *MsgBurnDerivative) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instant", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Instant = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1081,6 +1151,39 @@ func (m *MsgBurnDerivativeResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redeemed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Redeemed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14,4 +14,5 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
 	k.SetCurrentPricesForAllMarkets(ctx)
+	k.SetDerivedPrices(ctx)
 }
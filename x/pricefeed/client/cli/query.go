@@ -2,15 +2,22 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/kava-labs/kava/x/pricefeed/types"
 )
 
+const flagAllowStale = "allow-stale"
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	// Group nameservice queries under a subcommand
@@ -24,9 +31,13 @@ func GetQueryCmd() *cobra.Command {
 
 	cmds := []*cobra.Command{
 		GetCmdPrice(),
+		GetCmdLastGoodPrice(),
+		GetCmdPriceHistory(),
+		GetCmdTwap(),
 		GetCmdQueryPrices(),
 		GetCmdRawPrices(),
 		GetCmdOracles(),
+		GetCmdOracleStatistics(),
 		GetCmdMarkets(),
 		GetCmdQueryParams(),
 	}
@@ -70,12 +81,51 @@ func GetCmdOracles() *cobra.Command {
 	}
 }
 
+// GetCmdOracleStatistics queries the posting statistics of the oracles of a market
+func GetCmdOracleStatistics() *cobra.Command {
+	return &cobra.Command{
+		Use:   "oracle-statistics [marketID] [oracle-address]",
+		Short: "get the posting statistics of the oracles of a market, optionally for a single oracle",
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s q %s oracle-statistics bnb:usd", version.AppName, types.ModuleName),
+			fmt.Sprintf("%s q %s oracle-statistics bnb:usd kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em", version.AppName, types.ModuleName),
+		}, "\n"),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryOracleStatisticsRequest{
+				MarketId: args[0],
+			}
+			if len(args) == 2 {
+				params.OracleAddress = args[1]
+			}
+
+			res, err := queryClient.OracleStatistics(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
 // GetCmdPrice queries the current price of an asset
 func GetCmdPrice() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "price [marketID]",
 		Short: "get the current price for the input market",
-		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(`Get the current price for the input market.
+
+With --allow-stale, the market's last valid price is returned with stale set to true, instead of an error,
+when the market has no valid current price and the last valid price is within the stale price grace period.`),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -86,8 +136,14 @@ func GetCmdPrice() *cobra.Command {
 
 			marketID := args[0]
 
+			allowStale, err := cmd.Flags().GetBool(flagAllowStale)
+			if err != nil {
+				return err
+			}
+
 			params := types.QueryPriceRequest{
-				MarketId: marketID,
+				MarketId:   marketID,
+				AllowStale: allowStale,
 			}
 
 			res, err := queryClient.Price(context.Background(), &params)
@@ -98,6 +154,114 @@ func GetCmdPrice() *cobra.Command {
 			return clientCtx.PrintProto(res)
 		},
 	}
+
+	cmd.Flags().Bool(flagAllowStale, false, "return the last valid price if it is within the stale price grace period")
+
+	return cmd
+}
+
+// GetCmdLastGoodPrice queries the last valid price of an asset
+func GetCmdLastGoodPrice() *cobra.Command {
+	return &cobra.Command{
+		Use:     "last-good-price [marketID]",
+		Short:   "get the last valid price for the input market and its age",
+		Example: fmt.Sprintf("%s q %s last-good-price bnb:usd", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryLastGoodPriceRequest{
+				MarketId: args[0],
+			}
+
+			res, err := queryClient.LastGoodPrice(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// GetCmdPriceHistory queries the historical prices of an asset between two block heights
+func GetCmdPriceHistory() *cobra.Command {
+	return &cobra.Command{
+		Use:     "price-history [marketID] [from-height] [to-height]",
+		Short:   "get the historical prices for the input market between two block heights, inclusive",
+		Example: fmt.Sprintf("%s q %s price-history bnb:usd 1000 2000", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			fromHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid from-height: %w", err)
+			}
+			toHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid to-height: %w", err)
+			}
+
+			params := types.QueryPriceHistoryRequest{
+				MarketId:   args[0],
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+			}
+
+			res, err := queryClient.PriceHistory(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// GetCmdTwap queries the time-weighted average price of an asset
+func GetCmdTwap() *cobra.Command {
+	return &cobra.Command{
+		Use:     "twap [marketID] [duration]",
+		Short:   "get the time-weighted average price for the input market over a duration",
+		Example: fmt.Sprintf("%s q %s twap bnb:usd 1h", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := types.QueryTwapRequest{
+				MarketId: args[0],
+				Duration: duration,
+			}
+
+			res, err := queryClient.Twap(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 }
 
 // GetCmdQueryPrices queries the pricefeed module for current prices
@@ -30,6 +30,7 @@ func GetTxCmd() *cobra.Command {
 
 	cmds := []*cobra.Command{
 		GetCmdPostPrice(),
+		GetCmdPostPrices(),
 	}
 
 	for _, cmd := range cmds {
@@ -55,24 +56,52 @@ func GetCmdPostPrice() *cobra.Command {
 				return err
 			}
 
-			price, err := sdk.NewDecFromStr(args[1])
+			price, expiry, err := parsePriceAndExpiry(args[1], args[2])
 			if err != nil {
 				return err
 			}
 
-			expiryInt, err := strconv.ParseInt(args[2], 10, 64)
-			if err != nil {
-				return fmt.Errorf("invalid expiry %s: %w", args[2], err)
+			from := clientCtx.GetFromAddress()
+			msg := types.NewMsgPostPrice(from.String(), args[0], price, expiry)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
 			}
 
-			if expiryInt > types.MaxExpiry {
-				return fmt.Errorf("invalid expiry; got %d, max: %d", expiryInt, types.MaxExpiry)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+// GetCmdPostPrices cli command for posting prices for multiple markets in a single message.
+func GetCmdPostPrices() *cobra.Command {
+	return &cobra.Command{
+		Use:   "postprices [marketID] [price] [expiry] [[marketID] [price] [expiry]...]",
+		Short: "post the latest prices for multiple markets, each with a given expiry as a UNIX time",
+		Example: fmt.Sprintf("%s tx %s postprices bnb:usd 25 9999999999 xrp:usd 0.5 9999999999 --from validator",
+			version.AppName, types.ModuleName),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 || len(args)%3 != 0 {
+				return fmt.Errorf("expected arguments in groups of [marketID] [price] [expiry], got %d args", len(args))
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
 			}
 
-			expiry := tmtime.Canonical(time.Unix(expiryInt, 0))
+			var prices []types.PriceInput
+			for i := 0; i < len(args); i += 3 {
+				price, expiry, err := parsePriceAndExpiry(args[i+1], args[i+2])
+				if err != nil {
+					return err
+				}
+				prices = append(prices, types.NewPriceInput(args[i], price, expiry))
+			}
 
 			from := clientCtx.GetFromAddress()
-			msg := types.NewMsgPostPrice(from.String(), args[0], price, expiry)
+			msg := types.NewMsgPostPrices(from.String(), prices)
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -81,3 +110,22 @@ func GetCmdPostPrice() *cobra.Command {
 		},
 	}
 }
+
+// parsePriceAndExpiry parses a decimal price and an expiry given as a UNIX time.
+func parsePriceAndExpiry(priceArg, expiryArg string) (sdk.Dec, time.Time, error) {
+	price, err := sdk.NewDecFromStr(priceArg)
+	if err != nil {
+		return sdk.Dec{}, time.Time{}, err
+	}
+
+	expiryInt, err := strconv.ParseInt(expiryArg, 10, 64)
+	if err != nil {
+		return sdk.Dec{}, time.Time{}, fmt.Errorf("invalid expiry %s: %w", expiryArg, err)
+	}
+
+	if expiryInt > types.MaxExpiry {
+		return sdk.Dec{}, time.Time{}, fmt.Errorf("invalid expiry; got %d, max: %d", expiryInt, types.MaxExpiry)
+	}
+
+	return price, tmtime.Canonical(time.Unix(expiryInt, 0)), nil
+}
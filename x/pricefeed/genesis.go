@@ -1,6 +1,9 @@
 package pricefeed
 
 import (
+	"errors"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/pricefeed/keeper"
@@ -12,6 +15,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	// Set the markets and oracles from params
 	k.SetParams(ctx, gs.Params)
 
+	// Bind the port that receives oracle price packets
+	if !k.IsBound(ctx, types.PortID) {
+		if err := k.BindPort(ctx, types.PortID); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+
 	// Iterate through the posted prices and set them in the store if they are not expired
 	for _, pp := range gs.PostedPrices {
 		if pp.Expiry.After(ctx.BlockTime()) {
@@ -33,11 +43,15 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 		if len(rps) == 0 {
 			continue
 		}
+		// markets without enough unexpired prices for their oracle quorum are left without a current price
 		err := k.SetCurrentPrices(ctx, market.MarketID)
-		if err != nil {
+		if err != nil && !errors.Is(err, types.ErrNoValidPrice) {
 			panic(err)
 		}
 	}
+	for _, stats := range gs.OracleStatistics {
+		k.SetOracleStatistics(ctx, stats)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -51,5 +65,5 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		postedPrices = append(postedPrices, pp...)
 	}
 
-	return types.NewGenesisState(params, postedPrices)
+	return types.NewGenesisState(params, postedPrices, k.GetAllOracleStatistics(ctx))
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/pricefeed"
 	"github.com/kava-labs/kava/x/pricefeed/keeper"
+	"github.com/kava-labs/kava/x/pricefeed/types"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -48,6 +49,10 @@ func (suite *GenesisTestSuite) TestValidGenState() {
 
 func (suite *GenesisTestSuite) TestInitExportGenState() {
 	gs := NewPricefeedGen()
+	stats := types.NewOracleStatistics("btc:usd", sdk.AccAddress("oracle1"))
+	stats.IncludedWindows, stats.MissedWindows = 10, 2
+	stats.CumulativeDeviation, stats.LastDeviation = sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.01")
+	gs.OracleStatistics = types.OracleStatisticsList{stats}
 
 	suite.NotPanics(func() {
 		pricefeed.InitGenesis(suite.ctx, suite.keeper, gs)
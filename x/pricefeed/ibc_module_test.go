@@ -0,0 +1,171 @@
+package pricefeed_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed"
+	"github.com/kava-labs/kava/x/pricefeed/keeper"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+type IBCModuleTestSuite struct {
+	suite.Suite
+
+	tApp      app.TestApp
+	ctx       sdk.Context
+	keeper    keeper.Keeper
+	ibcModule pricefeed.IBCModule
+}
+
+func (suite *IBCModuleTestSuite) SetupTest() {
+	suite.tApp = app.NewTestApp()
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+
+	ibcOracles := append([]sdk.AccAddress{types.IBCOracleAddress}, addrs...)
+
+	params := types.NewParams([]types.Market{
+		{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: ibcOracles, Active: true},
+		{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: ibcOracles, Active: true},
+		{MarketID: "eth:usd", BaseAsset: "eth", QuoteAsset: "usd", Oracles: addrs, Active: true},
+	})
+	params.IBCOracleChannels = []string{"channel-1"}
+	genState := types.NewGenesisState(params, []types.PostedPrice{}, []types.OracleStatistics{})
+
+	suite.tApp.InitializeFromGenesisStates(
+		app.GenesisState{types.ModuleName: suite.tApp.AppCodec().MustMarshalJSON(&genState)},
+	)
+	suite.ctx = suite.tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	suite.keeper = suite.tApp.GetPriceFeedKeeper()
+	suite.ibcModule = pricefeed.NewIBCModule(suite.keeper)
+}
+
+func (suite *IBCModuleTestSuite) TestPortBound() {
+	suite.True(suite.keeper.IsBound(suite.ctx, types.PortID))
+}
+
+func (suite *IBCModuleTestSuite) TestOnChanOpenInit() {
+	testCases := []struct {
+		name       string
+		order      channeltypes.Order
+		portID     string
+		version    string
+		expVersion string
+		expPass    bool
+	}{
+		{"valid", channeltypes.UNORDERED, types.PortID, types.Version, types.Version, true},
+		{"empty version", channeltypes.UNORDERED, types.PortID, "", types.Version, true},
+		{"ordered channel", channeltypes.ORDERED, types.PortID, types.Version, "", false},
+		{"invalid port", channeltypes.UNORDERED, "transfer", types.Version, "", false},
+		{"invalid version", channeltypes.UNORDERED, types.PortID, "ics20-1", "", false},
+	}
+	for i, tc := range testCases {
+		suite.Run(tc.name, func() {
+			channelID := channeltypes.FormatChannelIdentifier(uint64(i))
+			chanCap, err := suite.tApp.ScopedIBCKeeper.NewCapability(suite.ctx, host.ChannelCapabilityPath(tc.portID, channelID))
+			suite.Require().NoError(err)
+
+			version, err := suite.ibcModule.OnChanOpenInit(
+				suite.ctx, tc.order, []string{"connection-0"}, tc.portID, channelID, chanCap,
+				channeltypes.NewCounterparty("oracle", "channel-0"), tc.version,
+			)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Equal(tc.expVersion, version)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *IBCModuleTestSuite) TestOnRecvPacket() {
+	expiry := suite.ctx.BlockTime().Add(time.Hour)
+	validData := types.NewOraclePricePacketData([]types.PriceInput{
+		types.NewPriceInput("btc:usd", sdk.MustNewDecFromStr("20000.0"), expiry),
+		types.NewPriceInput("xrp:usd", sdk.MustNewDecFromStr("0.5"), expiry),
+	})
+
+	testCases := []struct {
+		name      string
+		channelID string
+		data      []byte
+		expPass   bool
+	}{
+		{"valid", "channel-1", validData.GetBytes(), true},
+		{"channel not allowed", "channel-2", validData.GetBytes(), false},
+		{"invalid data", "channel-1", []byte("invalid"), false},
+		{
+			"empty prices",
+			"channel-1",
+			types.NewOraclePricePacketData(nil).GetBytes(),
+			false,
+		},
+		{
+			"unknown market",
+			"channel-1",
+			types.NewOraclePricePacketData([]types.PriceInput{
+				types.NewPriceInput("btc:usd", sdk.MustNewDecFromStr("20000.0"), expiry),
+				types.NewPriceInput("atom:usd", sdk.MustNewDecFromStr("10.0"), expiry),
+			}).GetBytes(),
+			false,
+		},
+		{
+			"market without ibc oracle",
+			"channel-1",
+			types.NewOraclePricePacketData([]types.PriceInput{
+				types.NewPriceInput("btc:usd", sdk.MustNewDecFromStr("20000.0"), expiry),
+				types.NewPriceInput("eth:usd", sdk.MustNewDecFromStr("1500.0"), expiry),
+			}).GetBytes(),
+			false,
+		},
+		{
+			"expired price",
+			"channel-1",
+			types.NewOraclePricePacketData([]types.PriceInput{
+				types.NewPriceInput("btc:usd", sdk.MustNewDecFromStr("20000.0"), suite.ctx.BlockTime().Add(-time.Hour)),
+			}).GetBytes(),
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			packet := channeltypes.NewPacket(tc.data, 1, "oracle", "channel-0", types.PortID, tc.channelID, clienttypes.NewHeight(0, 100), 0)
+
+			// use a cache context as IBC core does, only writing state for successful acknowledgements
+			cacheCtx, write := suite.ctx.CacheContext()
+			ack := suite.ibcModule.OnRecvPacket(cacheCtx, packet, sdk.AccAddress{})
+			if ack.Success() {
+				write()
+			}
+
+			if tc.expPass {
+				suite.Require().True(ack.Success())
+				for _, pi := range validData.Prices {
+					suite.Contains(
+						suite.keeper.GetRawPrices(suite.ctx, pi.MarketID),
+						types.NewPostedPrice(pi.MarketID, types.IBCOracleAddress, pi.Price, pi.Expiry),
+					)
+				}
+			} else {
+				suite.Require().False(ack.Success())
+				suite.Empty(suite.keeper.GetRawPrices(suite.ctx, "btc:usd"))
+			}
+		})
+	}
+}
+
+func TestIBCModuleTestSuite(t *testing.T) {
+	suite.Run(t, new(IBCModuleTestSuite))
+}
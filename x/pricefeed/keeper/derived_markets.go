@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// SetDerivedPrices updates the current price of each active derived market to the product of the current prices of
+// its components. Derived markets are updated in order, so a derived market can use the price of an earlier one.
+func (k Keeper) SetDerivedPrices(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	for _, dm := range params.DerivedMarkets {
+		if !dm.Active {
+			continue
+		}
+
+		validPrevPrice := true
+		prevPrice, err := k.GetCurrentPrice(ctx, dm.MarketID)
+		if err != nil {
+			validPrevPrice = false
+		}
+
+		price, err := k.CalculateDerivedPrice(ctx, dm)
+		if err != nil {
+			// zero out the current price so the derived market has no valid price while a component has none
+			k.setCurrentPrice(ctx, dm.MarketID, types.CurrentPrice{})
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeNoValidPrices,
+					sdk.NewAttribute(types.AttributeMarketID, dm.MarketID),
+				),
+			)
+			continue
+		}
+
+		if validPrevPrice && !price.Equal(prevPrice.Price) {
+			// only emit event if price has changed
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeMarketPriceUpdated,
+					sdk.NewAttribute(types.AttributeMarketID, dm.MarketID),
+					sdk.NewAttribute(types.AttributeMarketPrice, price.String()),
+				),
+			)
+		}
+
+		k.setCurrentPrice(ctx, dm.MarketID, types.NewCurrentPrice(dm.MarketID, price))
+		k.recordPriceObservation(ctx, dm.MarketID, price, params.TwapWindow)
+	}
+}
+
+// CalculateDerivedPrice returns the product of the current prices of a derived market's components, using the
+// reciprocal of inverted components. It returns an error if any component has no valid current price.
+func (k Keeper) CalculateDerivedPrice(ctx sdk.Context, dm types.DerivedMarket) (sdk.Dec, error) {
+	price := sdk.OneDec()
+	for _, c := range dm.Components {
+		cp, err := k.GetCurrentPrice(ctx, c.MarketID)
+		if err != nil {
+			return sdk.Dec{}, errorsmod.Wrapf(err, "derived market %s component %s", dm.MarketID, c.MarketID)
+		}
+		if c.Invert {
+			price = price.Quo(cp.Price)
+		} else {
+			price = price.Mul(cp.Price)
+		}
+	}
+	if !price.IsPositive() {
+		return sdk.Dec{}, errorsmod.Wrap(types.ErrNoValidPrice, dm.MarketID)
+	}
+	return price, nil
+}
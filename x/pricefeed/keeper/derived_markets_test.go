@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_SetDerivedPrices tests pricing derived markets from the current prices of their components
+func TestKeeper_SetDerivedPrices(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	keeper := tApp.GetPriceFeedKeeper()
+
+	keeper.SetParams(ctx, types.Params{
+		Markets: []types.Market{
+			{MarketID: "atom:btc", BaseAsset: "atom", QuoteAsset: "btc", Oracles: addrs, Active: true},
+			{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: addrs, Active: true},
+		},
+		DerivedMarkets: []types.DerivedMarket{
+			types.NewDerivedMarket("atom:usd", "atom", "usd", []types.DerivedMarketComponent{
+				types.NewDerivedMarketComponent("atom:btc", false),
+				types.NewDerivedMarketComponent("btc:usd", false),
+			}, true),
+			types.NewDerivedMarket("usd:atom", "usd", "atom", []types.DerivedMarketComponent{
+				types.NewDerivedMarketComponent("atom:usd", true),
+			}, true),
+			types.NewDerivedMarket("usd:btc", "usd", "btc", []types.DerivedMarketComponent{
+				types.NewDerivedMarketComponent("btc:usd", true),
+			}, false),
+		},
+	})
+
+	// derived markets have no price without prices for their components
+	_, err := keeper.SetPrice(ctx, addrs[0], "btc:usd", sdk.MustNewDecFromStr("20000.0"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	keeper.SetCurrentPricesForAllMarkets(ctx)
+	keeper.SetDerivedPrices(ctx)
+
+	_, err = keeper.GetCurrentPrice(ctx, "atom:usd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+	_, err = keeper.GetCurrentPrice(ctx, "usd:atom")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+
+	_, err = keeper.SetPrice(ctx, addrs[0], "atom:btc", sdk.MustNewDecFromStr("0.0005"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	keeper.SetCurrentPricesForAllMarkets(ctx)
+	keeper.SetDerivedPrices(ctx)
+
+	price, err := keeper.GetCurrentPrice(ctx, "atom:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("10.0"), price.Price)
+
+	price, err = keeper.GetCurrentPrice(ctx, "usd:atom")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), price.Price)
+
+	// inactive derived markets are not priced
+	_, err = keeper.GetCurrentPrice(ctx, "usd:btc")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+
+	// derived markets lose their price when a component's prices expire
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	keeper.SetCurrentPricesForAllMarkets(ctx)
+	keeper.SetDerivedPrices(ctx)
+
+	_, err = keeper.GetCurrentPrice(ctx, "atom:usd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+	_, err = keeper.GetCurrentPrice(ctx, "usd:atom")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// emitMedianPriceUpdated emits a typed event for a market's new current price with the spread of the unexpired oracle
+// prices it was calculated from
+func (k Keeper) emitMedianPriceUpdated(ctx sdk.Context, marketID string, medianPrice, previousPrice sdk.Dec, prices types.PostedPrices) {
+	minPrice, maxPrice := prices[0].Price, prices[0].Price
+	for _, pp := range prices[1:] {
+		minPrice = sdk.MinDec(minPrice, pp.Price)
+		maxPrice = sdk.MaxDec(maxPrice, pp.Price)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMedianPriceUpdated{
+		MarketId:      marketID,
+		MedianPrice:   medianPrice,
+		PreviousPrice: previousPrice,
+		OracleCount:   uint32(len(prices)),
+		MinPrice:      minPrice,
+		MaxPrice:      maxPrice,
+	}); err != nil {
+		// typed events only fail to marshal if they are not registered proto messages
+		panic(fmt.Sprintf("failed to emit median price updated event: %s", err))
+	}
+}
@@ -38,12 +38,26 @@ func (s queryServer) Params(c context.Context, req *types.QueryParamsRequest) (*
 func (s queryServer) Price(c context.Context, req *types.QueryPriceRequest) (*types.QueryPriceResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	_, found := s.keeper.GetMarket(ctx, req.MarketId)
-	if !found {
+	if !s.marketOrDerivedMarketExists(ctx, req.MarketId) {
 		return nil, status.Error(codes.NotFound, "invalid market ID")
 	}
+	if req.AllowStale {
+		currentPrice, stale, err := s.keeper.GetCurrentPriceAllowStale(ctx, req.MarketId)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.QueryPriceResponse{
+			Price: types.CurrentPriceResponse(currentPrice),
+			Stale: stale,
+		}, nil
+	}
 	currentPrice, sdkErr := s.keeper.GetCurrentPrice(ctx, req.MarketId)
 	if sdkErr != nil {
+		// surface markets invalidated by too few oracle prices
+		if err := s.keeper.ValidateOracleQuorum(ctx, req.MarketId); err != nil {
+			return nil, err
+		}
 		return nil, sdkErr
 	}
 
@@ -52,6 +66,75 @@ func (s queryServer) Price(c context.Context, req *types.QueryPriceRequest) (*ty
 	}, nil
 }
 
+// LastGoodPrice implements the gRPC service handler for querying the last valid price of a market.
+func (s queryServer) LastGoodPrice(c context.Context, req *types.QueryLastGoodPriceRequest) (*types.QueryLastGoodPriceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !s.marketOrDerivedMarketExists(ctx, req.MarketId) {
+		return nil, status.Error(codes.NotFound, "invalid market ID")
+	}
+	lastGoodPrice, found := s.keeper.GetLastGoodPrice(ctx, req.MarketId)
+	if !found {
+		return nil, types.ErrNoValidPrice
+	}
+
+	_, err := s.keeper.GetCurrentPrice(ctx, req.MarketId)
+	stale := err != nil
+
+	return &types.QueryLastGoodPriceResponse{
+		MarketId:          lastGoodPrice.MarketID,
+		Price:             lastGoodPrice.Price,
+		UpdatedAt:         lastGoodPrice.UpdatedAt,
+		Age:               ctx.BlockTime().Sub(lastGoodPrice.UpdatedAt),
+		Stale:             stale,
+		WithinGracePeriod: !stale || s.keeper.IsWithinStalePriceGracePeriod(ctx, lastGoodPrice),
+	}, nil
+}
+
+// PriceHistory implements the gRPC service handler for querying the historical prices of a market between two block
+// heights.
+func (s queryServer) PriceHistory(c context.Context, req *types.QueryPriceHistoryRequest) (*types.QueryPriceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if req.FromHeight < 0 || req.ToHeight < req.FromHeight {
+		return nil, status.Error(codes.InvalidArgument, "invalid height range")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !s.marketOrDerivedMarketExists(ctx, req.MarketId) {
+		return nil, status.Error(codes.NotFound, "invalid market ID")
+	}
+
+	return &types.QueryPriceHistoryResponse{
+		Prices: s.keeper.GetHistoricalPrices(ctx, req.MarketId, req.FromHeight, req.ToHeight),
+	}, nil
+}
+
+// Twap implements the gRPC service handler for querying the time-weighted average price of a market.
+func (s queryServer) Twap(c context.Context, req *types.QueryTwapRequest) (*types.QueryTwapResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !s.marketOrDerivedMarketExists(ctx, req.MarketId) {
+		return nil, status.Error(codes.NotFound, "invalid market ID")
+	}
+	twap, err := s.keeper.GetTwap(ctx, req.MarketId, req.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTwapResponse{Price: twap}, nil
+}
+
 func (s queryServer) Prices(c context.Context, req *types.QueryPricesRequest) (*types.QueryPricesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -108,6 +191,40 @@ func (s queryServer) Oracles(c context.Context, req *types.QueryOraclesRequest)
 	}, nil
 }
 
+// OracleStatistics implements the gRPC service handler for querying the posting statistics of a market's oracles.
+func (s queryServer) OracleStatistics(c context.Context, req *types.QueryOracleStatisticsRequest) (*types.QueryOracleStatisticsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, found := s.keeper.GetMarket(ctx, req.MarketId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "invalid market ID")
+	}
+
+	var statistics types.OracleStatisticsResponses
+	if req.OracleAddress != "" {
+		oracle, err := sdk.AccAddressFromBech32(req.OracleAddress)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid oracle address")
+		}
+		if stats, found := s.keeper.GetOracleStatistics(ctx, req.MarketId, oracle); found {
+			statistics = append(statistics, stats.ToOracleStatisticsResponse())
+		}
+	} else {
+		s.keeper.IterateOracleStatisticsByMarket(ctx, req.MarketId, func(stats types.OracleStatistics) (stop bool) {
+			statistics = append(statistics, stats.ToOracleStatisticsResponse())
+			return false
+		})
+	}
+
+	return &types.QueryOracleStatisticsResponse{
+		OracleStatistics: statistics,
+	}, nil
+}
+
 func (s queryServer) Markets(c context.Context, req *types.QueryMarketsRequest) (*types.QueryMarketsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -120,3 +237,12 @@ func (s queryServer) Markets(c context.Context, req *types.QueryMarketsRequest)
 		Markets: markets,
 	}, nil
 }
+
+// marketOrDerivedMarketExists returns true if the market ID is a market or a derived market.
+func (s queryServer) marketOrDerivedMarketExists(ctx sdk.Context, marketID string) bool {
+	if _, found := s.keeper.GetMarket(ctx, marketID); found {
+		return true
+	}
+	_, found := s.keeper.GetDerivedMarket(ctx, marketID)
+	return found
+}
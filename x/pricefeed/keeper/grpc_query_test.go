@@ -97,6 +97,19 @@ func (suite *grpcQueryTestSuite) TestGrpcPrice_NoPriceSet() {
 	suite.ErrorIs(types.ErrNoValidPrice, err)
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcPrice_OracleQuorumNotMet() {
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Market{
+		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true, MinOracles: 4},
+	}))
+	_, err := suite.keeper.SetPrice(suite.ctx, suite.addrs[0], "tstusd", sdk.MustNewDecFromStr("0.33"), suite.now.Add(time.Hour))
+	suite.NoError(err)
+	suite.keeper.SetCurrentPricesForAllMarkets(suite.ctx)
+
+	_, err = suite.queryServer.Price(sdk.WrapSDKContext(suite.ctx), &types.QueryPriceRequest{MarketId: "tstusd"})
+	suite.ErrorIs(err, types.ErrNoValidPrice)
+	suite.ErrorContains(err, "1 of 4 required oracle prices")
+}
+
 func (suite *grpcQueryTestSuite) TestGrpcPrice_InvalidMarket() {
 	suite.setTestParams()
 	suite.setTstPrice()
@@ -105,6 +118,129 @@ func (suite *grpcQueryTestSuite) TestGrpcPrice_InvalidMarket() {
 	suite.Equal("rpc error: code = NotFound desc = invalid market ID", err.Error())
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcTwap() {
+	params := types.NewParams([]types.Market{
+		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+	})
+	params.TwapWindow = time.Hour
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.setTstPrice()
+
+	res, err := suite.queryServer.Twap(sdk.WrapSDKContext(suite.ctx), &types.QueryTwapRequest{MarketId: "tstusd", Duration: time.Hour})
+	suite.NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("0.34"), res.Price)
+
+	_, err = suite.queryServer.Twap(sdk.WrapSDKContext(suite.ctx), &types.QueryTwapRequest{MarketId: "tstusd", Duration: 2 * time.Hour})
+	suite.ErrorIs(err, types.ErrInvalidTwapDuration)
+
+	_, err = suite.queryServer.Twap(sdk.WrapSDKContext(suite.ctx), &types.QueryTwapRequest{MarketId: "invalid", Duration: time.Hour})
+	suite.Equal("rpc error: code = NotFound desc = invalid market ID", err.Error())
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcPriceHistory() {
+	params := types.NewParams([]types.Market{
+		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+	})
+	params.PriceHistoryRetention = 100
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+	suite.setTstPrice()
+
+	res, err := suite.queryServer.PriceHistory(sdk.WrapSDKContext(suite.ctx), &types.QueryPriceHistoryRequest{MarketId: "tstusd", FromHeight: 1, ToHeight: 10})
+	suite.NoError(err)
+	suite.Equal(types.HistoricalPrices{
+		types.NewHistoricalPrice("tstusd", 10, suite.ctx.BlockTime(), sdk.MustNewDecFromStr("0.34")),
+	}, res.Prices)
+
+	res, err = suite.queryServer.PriceHistory(sdk.WrapSDKContext(suite.ctx), &types.QueryPriceHistoryRequest{MarketId: "tstusd", FromHeight: 1, ToHeight: 9})
+	suite.NoError(err)
+	suite.Empty(res.Prices)
+
+	_, err = suite.queryServer.PriceHistory(sdk.WrapSDKContext(suite.ctx), &types.QueryPriceHistoryRequest{MarketId: "tstusd", FromHeight: 10, ToHeight: 1})
+	suite.Equal("rpc error: code = InvalidArgument desc = invalid height range", err.Error())
+
+	_, err = suite.queryServer.PriceHistory(sdk.WrapSDKContext(suite.ctx), &types.QueryPriceHistoryRequest{MarketId: "invalid", FromHeight: 1, ToHeight: 10})
+	suite.Equal("rpc error: code = NotFound desc = invalid market ID", err.Error())
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcPrice_AllowStale() {
+	params := types.NewParams([]types.Market{
+		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+	})
+	params.StalePriceGracePeriod = 2 * time.Hour
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.setTstPrice()
+
+	// current prices are not stale
+	res, err := suite.queryServer.Price(sdk.WrapSDKContext(suite.ctx), &types.QueryPriceRequest{MarketId: "tstusd", AllowStale: true})
+	suite.NoError(err)
+	suite.Equal(types.NewCurrentPriceResponse("tstusd", sdk.MustNewDecFromStr("0.34")), res.Price)
+	suite.False(res.Stale)
+
+	// all posted prices expire
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(90 * time.Minute))
+	err = suite.keeper.SetCurrentPrices(suite.ctx, "tstusd")
+	suite.ErrorIs(err, types.ErrNoValidPrice)
+
+	_, err = suite.queryServer.Price(sdk.WrapSDKContext(suite.ctx), &types.QueryPriceRequest{MarketId: "tstusd"})
+	suite.ErrorIs(err, types.ErrNoValidPrice)
+
+	res, err = suite.queryServer.Price(sdk.WrapSDKContext(suite.ctx), &types.QueryPriceRequest{MarketId: "tstusd", AllowStale: true})
+	suite.NoError(err)
+	suite.Equal(types.NewCurrentPriceResponse("tstusd", sdk.MustNewDecFromStr("0.34")), res.Price)
+	suite.True(res.Stale)
+
+	// stale prices are not returned after the grace period
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	_, err = suite.queryServer.Price(sdk.WrapSDKContext(suite.ctx), &types.QueryPriceRequest{MarketId: "tstusd", AllowStale: true})
+	suite.ErrorIs(err, types.ErrNoValidPrice)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcLastGoodPrice() {
+	params := types.NewParams([]types.Market{
+		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+	})
+	params.StalePriceGracePeriod = 2 * time.Hour
+	suite.keeper.SetParams(suite.ctx, params)
+
+	_, err := suite.queryServer.LastGoodPrice(sdk.WrapSDKContext(suite.ctx), &types.QueryLastGoodPriceRequest{MarketId: "tstusd"})
+	suite.ErrorIs(err, types.ErrNoValidPrice)
+
+	_, err = suite.queryServer.LastGoodPrice(sdk.WrapSDKContext(suite.ctx), &types.QueryLastGoodPriceRequest{MarketId: "invalid"})
+	suite.Equal("rpc error: code = NotFound desc = invalid market ID", err.Error())
+
+	suite.setTstPrice()
+	updatedAt := suite.ctx.BlockTime()
+
+	suite.ctx = suite.ctx.WithBlockTime(updatedAt.Add(30 * time.Minute))
+	res, err := suite.queryServer.LastGoodPrice(sdk.WrapSDKContext(suite.ctx), &types.QueryLastGoodPriceRequest{MarketId: "tstusd"})
+	suite.NoError(err)
+	suite.Equal(&types.QueryLastGoodPriceResponse{
+		MarketId:          "tstusd",
+		Price:             sdk.MustNewDecFromStr("0.34"),
+		UpdatedAt:         updatedAt,
+		Age:               30 * time.Minute,
+		Stale:             false,
+		WithinGracePeriod: true,
+	}, res)
+
+	// the last good price is kept when all posted prices expire
+	suite.ctx = suite.ctx.WithBlockTime(updatedAt.Add(150 * time.Minute))
+	err = suite.keeper.SetCurrentPrices(suite.ctx, "tstusd")
+	suite.ErrorIs(err, types.ErrNoValidPrice)
+
+	res, err = suite.queryServer.LastGoodPrice(sdk.WrapSDKContext(suite.ctx), &types.QueryLastGoodPriceRequest{MarketId: "tstusd"})
+	suite.NoError(err)
+	suite.Equal(&types.QueryLastGoodPriceResponse{
+		MarketId:          "tstusd",
+		Price:             sdk.MustNewDecFromStr("0.34"),
+		UpdatedAt:         updatedAt,
+		Age:               150 * time.Minute,
+		Stale:             true,
+		WithinGracePeriod: false,
+	}, res)
+}
+
 func (suite *grpcQueryTestSuite) TestGrpcPrices() {
 	suite.setTestParams()
 	suite.setTstPrice()
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// Implements PricefeedHooks interface
+var _ types.PricefeedHooks = Keeper{}
+
+// AfterOraclePriceIncluded - call hook if registered
+func (k Keeper) AfterOraclePriceIncluded(ctx sdk.Context, marketID string, oracle sdk.AccAddress, deviation sdk.Dec) {
+	if k.hooks != nil {
+		k.hooks.AfterOraclePriceIncluded(ctx, marketID, oracle, deviation)
+	}
+}
+
+// AfterOracleWindowMissed - call hook if registered
+func (k Keeper) AfterOracleWindowMissed(ctx sdk.Context, marketID string, oracle sdk.AccAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterOracleWindowMissed(ctx, marketID, oracle)
+	}
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// IsBound checks if the pricefeed module is already bound to the desired port
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort defines a wrapper function for the port keeper's function in
+// order to expose it to module's InitGenesis function
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	capability := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, capability, host.PortPath(portID))
+}
+
+// ClaimCapability allows the pricefeed module to claim a capability that the IBC module passes to it
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
+
+// IsIBCOracleChannel returns true if the channel is allowed to post prices with oracle price packets
+func (k Keeper) IsIBCOracleChannel(ctx sdk.Context, channelID string) bool {
+	for _, channel := range k.GetParams(ctx).IBCOracleChannels {
+		if channel == channelID {
+			return true
+		}
+	}
+	return false
+}
+
+// OnRecvOraclePricePacket posts the prices of an oracle price packet received on an allow-listed channel as the raw
+// prices of the IBC oracle address. All prices must be for existing markets that list the IBC oracle address as an
+// oracle, and not expired.
+func (k Keeper) OnRecvOraclePricePacket(ctx sdk.Context, channelID string, data types.OraclePricePacketData) error {
+	if !k.IsIBCOracleChannel(ctx, channelID) {
+		return errorsmod.Wrap(types.ErrChannelNotAllowed, channelID)
+	}
+
+	for _, pi := range data.Prices {
+		if _, err := k.GetOracle(ctx, pi.MarketID, types.IBCOracleAddress); err != nil {
+			return err
+		}
+		if _, err := k.SetPrice(ctx, types.IBCOracleAddress, pi.MarketID, pi.Price, pi.Expiry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
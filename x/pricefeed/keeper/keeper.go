@@ -24,11 +24,16 @@ type Keeper struct {
 	cdc codec.Codec
 	// The reference to the Paramstore to get and set pricefeed specific params
 	paramSubspace paramtypes.Subspace
+	portKeeper    types.PortKeeper
+	scopedKeeper  types.ScopedKeeper
+	stakingKeeper types.StakingKeeper
+	hooks         types.PricefeedHooks
 }
 
 // NewKeeper returns a new keeper for the pricefeed module.
 func NewKeeper(
 	cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.Subspace,
+	portKeeper types.PortKeeper, scopedKeeper types.ScopedKeeper, stakingKeeper types.StakingKeeper,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -38,9 +43,27 @@ func NewKeeper(
 		cdc:           cdc,
 		key:           key,
 		paramSubspace: paramstore,
+		portKeeper:    portKeeper,
+		scopedKeeper:  scopedKeeper,
+		stakingKeeper: stakingKeeper,
+		hooks:         nil,
 	}
 }
 
+// SetHooks adds hooks to the keeper.
+func (k *Keeper) SetHooks(hooks types.PricefeedHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set pricefeed hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
+// ClearHooks clears the hooks on the keeper
+func (k *Keeper) ClearHooks() {
+	k.hooks = nil
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -81,7 +104,7 @@ func (k Keeper) SetPrice(
 
 // SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs
 func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
-	_, ok := k.GetMarket(ctx, marketID)
+	market, ok := k.GetMarket(ctx, marketID)
 	if !ok {
 		return errorsmod.Wrap(types.ErrInvalidMarket, marketID)
 	}
@@ -94,11 +117,11 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
 
 	prices := k.GetRawPrices(ctx, marketID)
 
-	var notExpiredPrices []types.CurrentPrice
+	var notExpiredPrices types.PostedPrices
 	// filter out expired prices
 	for _, v := range prices {
 		if v.Expiry.After(ctx.BlockTime()) {
-			notExpiredPrices = append(notExpiredPrices, types.NewCurrentPrice(v.MarketID, v.Price))
+			notExpiredPrices = append(notExpiredPrices, v)
 		}
 	}
 
@@ -110,8 +133,17 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
 		k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
 		return types.ErrNoValidPrice
 	}
+	if !market.HasOracleQuorum(len(notExpiredPrices)) {
+		// too few oracles have posted unexpired prices for the median to be trusted
+		k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
+		return errorsmod.Wrapf(types.ErrNoValidPrice, "%d of %d required oracle prices", len(notExpiredPrices), market.MinOracles)
+	}
 
-	medianPrice := k.CalculateMedianPrice(notExpiredPrices)
+	medianPrice, ok := k.calculateMarketMedianPrice(ctx, market, notExpiredPrices)
+	if !ok {
+		k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
+		return errorsmod.Wrap(types.ErrNoValidPrice, "no weighted oracle prices")
+	}
 
 	// check case that market price was not set in genesis
 	if validPrevPrice && !medianPrice.Equal(prevPrice.Price) {
@@ -123,10 +155,12 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
 				sdk.NewAttribute(types.AttributeMarketPrice, medianPrice.String()),
 			),
 		)
+		k.emitMedianPriceUpdated(ctx, marketID, medianPrice, prevPrice.Price, notExpiredPrices)
 	}
 
 	currentPrice := types.NewCurrentPrice(marketID, medianPrice)
 	k.setCurrentPrice(ctx, marketID, currentPrice)
+	k.recordPriceObservation(ctx, marketID, medianPrice, k.GetParams(ctx).TwapWindow)
 
 	return nil
 }
@@ -135,11 +169,15 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
 func (k Keeper) SetCurrentPricesForAllMarkets(ctx sdk.Context) {
 	orderedMarkets := []string{}
 	marketPricesByID := make(map[string]types.CurrentPrices)
+	postedPricesByID := make(map[string]types.PostedPrices)
+	marketsByID := make(map[string]types.Market)
 
-	for _, market := range k.GetMarkets(ctx) {
+	params := k.GetParams(ctx)
+	for _, market := range params.Markets {
 		if market.Active {
 			orderedMarkets = append(orderedMarkets, market.MarketID)
 			marketPricesByID[market.MarketID] = types.CurrentPrices{}
+			marketsByID[market.MarketID] = market
 		}
 	}
 
@@ -156,6 +194,7 @@ func (k Keeper) SetCurrentPricesForAllMarkets(ctx sdk.Context) {
 		// filter out expired prices
 		if postedPrice.Expiry.After(ctx.BlockTime()) {
 			marketPricesByID[postedPrice.MarketID] = append(prices, types.NewCurrentPrice(postedPrice.MarketID, postedPrice.Price))
+			postedPricesByID[postedPrice.MarketID] = append(postedPricesByID[postedPrice.MarketID], postedPrice)
 		}
 	}
 	iterator.Close()
@@ -170,16 +209,24 @@ func (k Keeper) SetCurrentPricesForAllMarkets(ctx sdk.Context) {
 
 		notExpiredPrices, _ := marketPricesByID[marketID]
 
-		if len(notExpiredPrices) == 0 {
+		if !marketsByID[marketID].HasOracleQuorum(len(notExpiredPrices)) {
 			// NOTE: The current price stored will continue storing the most recent (expired)
 			// price if this is not set.
 			// This zero's out the current price stored value for that market and ensures
 			// that CDP methods that GetCurrentPrice will return error.
+			// Markets with unexpired prices from fewer oracles than their quorum are also cleared.
 			k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
+			k.updateOracleStatistics(ctx, marketID, marketsByID[marketID].Oracles, sdk.ZeroDec(), postedPricesByID[marketID])
 			continue
 		}
 
-		medianPrice := k.CalculateMedianPrice(notExpiredPrices)
+		medianPrice, ok := k.calculateMarketMedianPrice(ctx, marketsByID[marketID], postedPricesByID[marketID])
+		if !ok {
+			// none of the unexpired prices has a positive weight
+			k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
+			k.updateOracleStatistics(ctx, marketID, marketsByID[marketID].Oracles, sdk.ZeroDec(), postedPricesByID[marketID])
+			continue
+		}
 
 		// check case that market price was not set in genesis
 		//if validPrevPrice && !medianPrice.Equal(prevPrice.Price) {
@@ -192,16 +239,26 @@ func (k Keeper) SetCurrentPricesForAllMarkets(ctx sdk.Context) {
 					sdk.NewAttribute(types.AttributeMarketPrice, medianPrice.String()),
 				),
 			)
+			k.emitMedianPriceUpdated(ctx, marketID, medianPrice, prevPrice.Price, postedPricesByID[marketID])
 		}
 
 		currentPrice := types.NewCurrentPrice(marketID, medianPrice)
 		k.setCurrentPrice(ctx, marketID, currentPrice)
+		k.recordPriceObservation(ctx, marketID, medianPrice, params.TwapWindow)
+		k.updateOracleStatistics(ctx, marketID, marketsByID[marketID].Oracles, medianPrice, postedPricesByID[marketID])
 	}
 }
 
 func (k Keeper) setCurrentPrice(ctx sdk.Context, marketID string, currentPrice types.CurrentPrice) {
 	store := ctx.KVStore(k.key)
 	store.Set(types.CurrentPriceKey(marketID), k.cdc.MustMarshal(&currentPrice))
+
+	// keep the last valid price, which is not cleared when the market has no valid prices
+	validPrice := !currentPrice.Price.IsNil() && currentPrice.Price.IsPositive()
+	if validPrice {
+		k.setLastGoodPrice(ctx, types.NewLastGoodPrice(marketID, currentPrice.Price, ctx.BlockTime()))
+	}
+	k.recordHistoricalPrice(ctx, marketID, currentPrice.Price, validPrice)
 }
 
 // CalculateMedianPrice calculates the median prices for the input prices.
@@ -250,6 +307,26 @@ func (k Keeper) GetCurrentPrice(ctx sdk.Context, marketID string) (types.Current
 	return price, nil
 }
 
+// ValidateOracleQuorum returns an error if fewer oracles than a market's quorum have unexpired prices. Derived markets
+// have no quorum.
+func (k Keeper) ValidateOracleQuorum(ctx sdk.Context, marketID string) error {
+	market, found := k.GetMarket(ctx, marketID)
+	if !found {
+		return nil
+	}
+	priceCount := 0
+	k.IterateRawPricesByMarket(ctx, marketID, func(pp types.PostedPrice) (stop bool) {
+		if pp.Expiry.After(ctx.BlockTime()) {
+			priceCount++
+		}
+		return false
+	})
+	if priceCount < int(market.MinOracles) {
+		return errorsmod.Wrapf(types.ErrNoValidPrice, "%d of %d required oracle prices", priceCount, market.MinOracles)
+	}
+	return nil
+}
+
 // IterateCurrentPrices iterates over all current price objects in the store and performs a callback function
 func (k Keeper) IterateCurrentPrices(ctx sdk.Context, cb func(cp types.CurrentPrice) (stop bool)) {
 	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.CurrentPricePrefix)
@@ -240,6 +240,47 @@ func TestKeeper_ExpiredSetCurrentPrices(t *testing.T) {
 	require.ErrorIs(t, types.ErrNoValidPrice, err, "current prices should be invalid")
 }
 
+func TestKeeper_SetCurrentPrices_OracleQuorum(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	keeper := tApp.GetPriceFeedKeeper()
+
+	keeper.SetParams(ctx, types.NewParams([]types.Market{
+		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true, MinOracles: 2},
+	}))
+
+	_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("0.33"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	_, err = keeper.SetPrice(ctx, addrs[1], "tstusd", sdk.MustNewDecFromStr("0.35"), ctx.BlockTime().Add(2*time.Hour))
+	require.NoError(t, err)
+
+	err = keeper.SetCurrentPrices(ctx, "tstusd")
+	require.NoError(t, err)
+	price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.34"), price.Price)
+	require.NoError(t, keeper.ValidateOracleQuorum(ctx, "tstusd"))
+
+	// a single oracle cannot set the price once the other's price expires
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(90 * time.Minute))
+	err = keeper.SetCurrentPrices(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+	_, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+	require.ErrorIs(t, keeper.ValidateOracleQuorum(ctx, "tstusd"), types.ErrNoValidPrice)
+
+	keeper.SetCurrentPricesForAllMarkets(ctx)
+	_, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+
+	// the posting oracle is still counted as included in the window
+	stats, found := keeper.GetOracleStatistics(ctx, "tstusd", addrs[1])
+	require.True(t, found)
+	require.Equal(t, uint64(1), stats.IncludedWindows)
+}
+
 func TestKeeper_SetCurrentPricesForAllMarkets_PriceUpdate(t *testing.T) {
 	testutil.SetCurrentPrices_PriceCalculations(t, func(ctx sdk.Context, keeper keeper.Keeper) {
 		keeper.SetCurrentPricesForAllMarkets(ctx)
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// GetLastGoodPrice returns the last valid current price of a market
+func (k Keeper) GetLastGoodPrice(ctx sdk.Context, marketID string) (types.LastGoodPrice, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.LastGoodPriceKey(marketID))
+	if bz == nil {
+		return types.LastGoodPrice{}, false
+	}
+	var price types.LastGoodPrice
+	k.cdc.MustUnmarshal(bz, &price)
+	return price, true
+}
+
+func (k Keeper) setLastGoodPrice(ctx sdk.Context, price types.LastGoodPrice) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.LastGoodPriceKey(price.MarketID), k.cdc.MustMarshal(&price))
+}
+
+// IsWithinStalePriceGracePeriod returns true if a last good price can be used as a stale price at the block time
+func (k Keeper) IsWithinStalePriceGracePeriod(ctx sdk.Context, price types.LastGoodPrice) bool {
+	gracePeriod := k.GetParams(ctx).StalePriceGracePeriod
+	return gracePeriod > 0 && ctx.BlockTime().Sub(price.UpdatedAt) <= gracePeriod
+}
+
+// GetCurrentPriceAllowStale returns the current price of a market, or its last good price flagged as stale if the
+// market has no valid current price and the last good price is within the stale price grace period. Consumers that can
+// tolerate a recently expired price use it instead of GetCurrentPrice, and decide what to do with stale prices.
+func (k Keeper) GetCurrentPriceAllowStale(ctx sdk.Context, marketID string) (price types.CurrentPrice, stale bool, err error) {
+	currentPrice, err := k.GetCurrentPrice(ctx, marketID)
+	if err == nil {
+		return currentPrice, false, nil
+	}
+
+	lastGoodPrice, found := k.GetLastGoodPrice(ctx, marketID)
+	if !found || !k.IsWithinStalePriceGracePeriod(ctx, lastGoodPrice) {
+		return types.CurrentPrice{}, false, errorsmod.Wrap(err, marketID)
+	}
+	return types.NewCurrentPrice(marketID, lastGoodPrice.Price), true, nil
+}
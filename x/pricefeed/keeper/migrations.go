@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// V2 binds the port that receives oracle price packets, which is otherwise only bound in InitGenesis.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if m.keeper.IsBound(ctx, types.PortID) {
+		return nil
+	}
+	return m.keeper.BindPort(ctx, types.PortID)
+}
@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmprototypes "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/keeper"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func TestMigrate1to2_BindsPort(t *testing.T) {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{})
+	k := tApp.GetPriceFeedKeeper()
+
+	// the port is only bound in InitGenesis, which has not run
+	require.False(t, k.IsBound(ctx, types.PortID))
+
+	m := keeper.NewMigrator(k)
+	require.NoError(t, m.Migrate1to2(ctx))
+	require.True(t, k.IsBound(ctx, types.PortID))
+
+	// migrating an already bound port is a no-op
+	require.NoError(t, m.Migrate1to2(ctx))
+	require.True(t, k.IsBound(ctx, types.PortID))
+}
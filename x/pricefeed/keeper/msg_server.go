@@ -48,3 +48,34 @@ func (k msgServer) PostPrice(goCtx context.Context, msg *types.MsgPostPrice) (*t
 
 	return &types.MsgPostPriceResponse{}, nil
 }
+
+func (k msgServer) PostPrices(goCtx context.Context, msg *types.MsgPostPrices) (*types.MsgPostPricesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pi := range msg.Prices {
+		_, err = k.keeper.GetOracle(ctx, pi.MarketID, from)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = k.keeper.SetPrice(ctx, from, pi.MarketID, pi.Price, pi.Expiry)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From),
+		),
+	)
+
+	return &types.MsgPostPricesResponse{}, nil
+}
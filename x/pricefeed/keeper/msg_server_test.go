@@ -61,3 +61,97 @@ func TestKeeper_PostPrice(t *testing.T) {
 		})
 	}
 }
+
+func TestKeeper_PostPrices(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	k := tApp.GetPriceFeedKeeper()
+	msgSrv := keeper.NewMsgServerImpl(k)
+
+	authorizedOracles := addrs[:2]
+	unauthorizedAddrs := addrs[2:]
+
+	mp := types.Params{
+		Markets: []types.Market{
+			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: authorizedOracles, Active: true},
+			{MarketID: "xyzusd", BaseAsset: "xyz", QuoteAsset: "usd", Oracles: authorizedOracles[:1], Active: true},
+		},
+	}
+	k.SetParams(ctx, mp)
+
+	now := time.Now().UTC()
+	price := sdk.MustNewDecFromStr("0.5")
+
+	tests := []struct {
+		giveMsg      string
+		giveOracle   sdk.AccAddress
+		givePrices   []types.PriceInput
+		wantAccepted bool
+		errorKind    error
+	}{
+		{
+			"authorized",
+			authorizedOracles[0],
+			[]types.PriceInput{
+				types.NewPriceInput("tstusd", price, now.Add(time.Hour*1)),
+				types.NewPriceInput("xyzusd", price, now.Add(time.Hour*1)),
+			},
+			true,
+			nil,
+		},
+		{
+			"expired",
+			authorizedOracles[0],
+			[]types.PriceInput{
+				types.NewPriceInput("tstusd", price, now.Add(time.Hour*1)),
+				types.NewPriceInput("xyzusd", price, now.Add(-time.Hour*1)),
+			},
+			false,
+			types.ErrExpired,
+		},
+		{
+			"invalid",
+			authorizedOracles[0],
+			[]types.PriceInput{types.NewPriceInput("invalid", price, now.Add(time.Hour*1))},
+			false,
+			types.ErrInvalidMarket,
+		},
+		{
+			"unauthorized for one market",
+			authorizedOracles[1],
+			[]types.PriceInput{
+				types.NewPriceInput("tstusd", price, now.Add(time.Hour*1)),
+				types.NewPriceInput("xyzusd", price, now.Add(time.Hour*1)),
+			},
+			false,
+			types.ErrInvalidOracle,
+		},
+		{
+			"unauthorized",
+			unauthorizedAddrs[0],
+			[]types.PriceInput{types.NewPriceInput("tstusd", price, now.Add(time.Hour*1))},
+			false,
+			types.ErrInvalidOracle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.giveMsg, func(t *testing.T) {
+			msg := types.NewMsgPostPrices(tt.giveOracle.String(), tt.givePrices)
+			_, err := msgSrv.PostPrices(sdk.WrapSDKContext(ctx), msg)
+
+			if tt.wantAccepted {
+				require.NoError(t, err)
+				for _, pi := range tt.givePrices {
+					rawPrices := k.GetRawPrices(ctx, pi.MarketID)
+					require.Contains(t, rawPrices, types.NewPostedPrice(pi.MarketID, tt.giveOracle, pi.Price, pi.Expiry))
+				}
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, tt.errorKind, err)
+			}
+		})
+	}
+}
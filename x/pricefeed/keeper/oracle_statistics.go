@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// updateOracleStatistics records for each oracle of a market whether it had an unexpired price when the market's
+// current price was set, and how far that price deviated from the median
+func (k Keeper) updateOracleStatistics(ctx sdk.Context, marketID string, oracles []sdk.AccAddress, medianPrice sdk.Dec, prices types.PostedPrices) {
+	pricesByOracle := make(map[string]sdk.Dec, len(prices))
+	for _, pp := range prices {
+		pricesByOracle[pp.OracleAddress.String()] = pp.Price
+	}
+
+	for _, oracle := range oracles {
+		stats, found := k.GetOracleStatistics(ctx, marketID, oracle)
+		if !found {
+			stats = types.NewOracleStatistics(marketID, oracle)
+		}
+
+		price, included := pricesByOracle[oracle.String()]
+		if !included {
+			stats.MissedWindows++
+			k.SetOracleStatistics(ctx, stats)
+			k.AfterOracleWindowMissed(ctx, marketID, oracle)
+			continue
+		}
+
+		deviation := sdk.ZeroDec()
+		if medianPrice.IsPositive() {
+			deviation = price.Sub(medianPrice).Abs().Quo(medianPrice)
+		}
+		stats.IncludedWindows++
+		stats.CumulativeDeviation = stats.CumulativeDeviation.Add(deviation)
+		stats.LastDeviation = deviation
+		k.SetOracleStatistics(ctx, stats)
+		k.AfterOraclePriceIncluded(ctx, marketID, oracle, deviation)
+	}
+}
+
+// GetOracleStatistics returns the statistics of an oracle for a market
+func (k Keeper) GetOracleStatistics(ctx sdk.Context, marketID string, oracle sdk.AccAddress) (types.OracleStatistics, bool) {
+	bz := ctx.KVStore(k.key).Get(types.OracleStatisticsKey(marketID, oracle))
+	if bz == nil {
+		return types.OracleStatistics{}, false
+	}
+	var stats types.OracleStatistics
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats, true
+}
+
+// SetOracleStatistics sets the statistics of an oracle for a market
+func (k Keeper) SetOracleStatistics(ctx sdk.Context, stats types.OracleStatistics) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.OracleStatisticsKey(stats.MarketID, stats.OracleAddress), k.cdc.MustMarshal(&stats))
+}
+
+// IterateOracleStatisticsByMarket iterates over the oracle statistics of a market and performs a callback function
+func (k Keeper) IterateOracleStatisticsByMarket(ctx sdk.Context, marketID string, cb func(stats types.OracleStatistics) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.OracleStatisticsIteratorKey(marketID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.OracleStatistics
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+		if cb(stats) {
+			break
+		}
+	}
+}
+
+// IterateOracleStatistics iterates over the oracle statistics of all markets and performs a callback function
+func (k Keeper) IterateOracleStatistics(ctx sdk.Context, cb func(stats types.OracleStatistics) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.OracleStatisticsPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.OracleStatistics
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+		if cb(stats) {
+			break
+		}
+	}
+}
+
+// GetAllOracleStatistics returns the oracle statistics of all markets
+func (k Keeper) GetAllOracleStatistics(ctx sdk.Context) types.OracleStatisticsList {
+	var statistics types.OracleStatisticsList
+	k.IterateOracleStatistics(ctx, func(stats types.OracleStatistics) (stop bool) {
+		statistics = append(statistics, stats)
+		return false
+	})
+	return statistics
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/keeper"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// oracleWindow is a call to a pricefeed hook
+type oracleWindow struct {
+	marketID  string
+	oracle    sdk.AccAddress
+	included  bool
+	deviation sdk.Dec
+}
+
+// fakePricefeedHooks records the oracle windows it is called with
+type fakePricefeedHooks struct {
+	windows []oracleWindow
+}
+
+var _ types.PricefeedHooks = &fakePricefeedHooks{}
+
+func (h *fakePricefeedHooks) AfterOraclePriceIncluded(_ sdk.Context, marketID string, oracle sdk.AccAddress, deviation sdk.Dec) {
+	h.windows = append(h.windows, oracleWindow{marketID: marketID, oracle: oracle, included: true, deviation: deviation})
+}
+
+func (h *fakePricefeedHooks) AfterOracleWindowMissed(_ sdk.Context, marketID string, oracle sdk.AccAddress) {
+	h.windows = append(h.windows, oracleWindow{marketID: marketID, oracle: oracle})
+}
+
+// TestKeeper_OracleStatistics tests tracking oracle statistics when current prices are set
+func TestKeeper_OracleStatistics(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{Time: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)})
+	k := tApp.GetPriceFeedKeeper()
+	hooks := &fakePricefeedHooks{}
+	k.SetHooks(hooks)
+
+	k.SetParams(ctx, types.Params{
+		Markets: []types.Market{
+			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true},
+		},
+	})
+
+	// no oracle has posted a price
+	k.SetCurrentPricesForAllMarkets(ctx)
+	for _, addr := range addrs {
+		stats, found := k.GetOracleStatistics(ctx, "tstusd", addr)
+		require.True(t, found)
+		require.Equal(t, uint64(1), stats.MissedWindows)
+		require.Equal(t, uint64(0), stats.IncludedWindows)
+	}
+
+	for i, price := range []string{"0.9", "1.0", "1.2"} {
+		_, err := k.SetPrice(ctx, addrs[i], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+	}
+	k.SetCurrentPricesForAllMarkets(ctx)
+
+	// the third oracle's price expires
+	_, err := k.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("1.1"), ctx.BlockTime().Add(2*time.Hour))
+	require.NoError(t, err)
+	_, err = k.SetPrice(ctx, addrs[1], "tstusd", sdk.MustNewDecFromStr("1.1"), ctx.BlockTime().Add(2*time.Hour))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(90 * time.Minute))
+	k.SetCurrentPricesForAllMarkets(ctx)
+
+	stats, found := k.GetOracleStatistics(ctx, "tstusd", addrs[0])
+	require.True(t, found)
+	require.Equal(t, uint64(2), stats.IncludedWindows)
+	require.Equal(t, uint64(1), stats.MissedWindows)
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), stats.CumulativeDeviation)
+	require.Equal(t, sdk.ZeroDec(), stats.LastDeviation)
+	require.Equal(t, sdk.MustNewDecFromStr("0.05"), stats.MeanDeviation())
+
+	stats, found = k.GetOracleStatistics(ctx, "tstusd", addrs[2])
+	require.True(t, found)
+	require.Equal(t, uint64(1), stats.IncludedWindows)
+	require.Equal(t, uint64(2), stats.MissedWindows)
+	require.Equal(t, sdk.MustNewDecFromStr("0.2"), stats.LastDeviation)
+
+	require.Len(t, k.GetAllOracleStatistics(ctx), 3)
+
+	// hooks are called for each oracle in each window
+	require.Len(t, hooks.windows, 9)
+	require.Equal(t, oracleWindow{marketID: "tstusd", oracle: addrs[2], included: true, deviation: sdk.MustNewDecFromStr("0.2")}, hooks.windows[5])
+	require.Equal(t, oracleWindow{marketID: "tstusd", oracle: addrs[2]}, hooks.windows[8])
+
+	// the statistics can be queried for a market or a single oracle
+	queryServer := keeper.NewQueryServerImpl(k)
+	res, err := queryServer.OracleStatistics(sdk.WrapSDKContext(ctx), &types.QueryOracleStatisticsRequest{MarketId: "tstusd"})
+	require.NoError(t, err)
+	require.Len(t, res.OracleStatistics, 3)
+	res, err = queryServer.OracleStatistics(sdk.WrapSDKContext(ctx), &types.QueryOracleStatisticsRequest{MarketId: "tstusd", OracleAddress: addrs[2].String()})
+	require.NoError(t, err)
+	require.Equal(t, types.OracleStatisticsResponses{stats.ToOracleStatisticsResponse()}, res.OracleStatistics)
+	_, err = queryServer.OracleStatistics(sdk.WrapSDKContext(ctx), &types.QueryOracleStatisticsRequest{MarketId: "invalid"})
+	require.Error(t, err)
+}
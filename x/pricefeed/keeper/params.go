@@ -10,7 +10,7 @@ import (
 // GetParams returns the params from the store
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	var p types.Params
-	k.paramSubspace.GetParamSet(ctx, &p)
+	k.paramSubspace.GetParamSetIfExists(ctx, &p)
 	return p
 }
 
@@ -61,6 +61,23 @@ func (k Keeper) GetMarket(ctx sdk.Context, marketID string) (types.Market, bool)
 	return types.Market{}, false
 }
 
+// GetDerivedMarkets returns the derived markets from params
+func (k Keeper) GetDerivedMarkets(ctx sdk.Context) types.DerivedMarkets {
+	return k.GetParams(ctx).DerivedMarkets
+}
+
+// GetDerivedMarket returns the derived market if it is in the pricefeed system
+func (k Keeper) GetDerivedMarket(ctx sdk.Context, marketID string) (types.DerivedMarket, bool) {
+	derivedMarkets := k.GetDerivedMarkets(ctx)
+
+	for i := range derivedMarkets {
+		if derivedMarkets[i].MarketID == marketID {
+			return derivedMarkets[i], true
+		}
+	}
+	return types.DerivedMarket{}, false
+}
+
 // GetAuthorizedAddresses returns a list of addresses that have special authorization within this module, eg the oracles of all markets.
 func (k Keeper) GetAuthorizedAddresses(ctx sdk.Context) []sdk.AccAddress {
 	var oracles []sdk.AccAddress
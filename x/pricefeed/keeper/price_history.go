@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// recordHistoricalPrice stores a valid current price of a market as a historical price at the block height and
+// prunes historical prices older than the retention
+func (k Keeper) recordHistoricalPrice(ctx sdk.Context, marketID string, price sdk.Dec, valid bool) {
+	retention := k.GetParams(ctx).PriceHistoryRetention
+	if valid && retention > 0 {
+		historicalPrice := types.NewHistoricalPrice(marketID, ctx.BlockHeight(), ctx.BlockTime(), price)
+		store := ctx.KVStore(k.key)
+		store.Set(types.HistoricalPriceKey(marketID, ctx.BlockHeight()), k.cdc.MustMarshal(&historicalPrice))
+	}
+
+	// prices at or before the cutoff height are outside the retention
+	cutoff := ctx.BlockHeight() - int64(retention)
+	if cutoff >= 0 {
+		k.pruneHistoricalPrices(ctx, marketID, cutoff)
+	}
+}
+
+// pruneHistoricalPrices deletes the historical prices of a market at or before the cutoff height
+func (k Keeper) pruneHistoricalPrices(ctx sdk.Context, marketID string, cutoff int64) {
+	store := ctx.KVStore(k.key)
+	iterator := store.Iterator(
+		types.HistoricalPriceIteratorKey(marketID),
+		sdk.InclusiveEndBytes(types.HistoricalPriceKey(marketID, cutoff)),
+	)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IterateHistoricalPrices iterates over the historical prices of a market between two block heights, inclusive, in
+// height order and performs a callback function
+func (k Keeper) IterateHistoricalPrices(ctx sdk.Context, marketID string, fromHeight, toHeight int64, cb func(price types.HistoricalPrice) (stop bool)) {
+	iterator := ctx.KVStore(k.key).Iterator(
+		types.HistoricalPriceKey(marketID, fromHeight),
+		sdk.InclusiveEndBytes(types.HistoricalPriceKey(marketID, toHeight)),
+	)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.HistoricalPrice
+		k.cdc.MustUnmarshal(iterator.Value(), &price)
+		if cb(price) {
+			break
+		}
+	}
+}
+
+// GetHistoricalPrices returns the historical prices of a market between two block heights, inclusive, in height order
+func (k Keeper) GetHistoricalPrices(ctx sdk.Context, marketID string, fromHeight, toHeight int64) types.HistoricalPrices {
+	var prices types.HistoricalPrices
+	k.IterateHistoricalPrices(ctx, marketID, fromHeight, toHeight, func(price types.HistoricalPrice) (stop bool) {
+		prices = append(prices, price)
+		return false
+	})
+	return prices
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_HistoricalPrices tests recording, pruning and querying historical prices by height
+func TestKeeper_HistoricalPrices(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, tmprototypes.Header{Time: start, Height: 1})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	params := types.NewParams([]types.Market{
+		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true},
+	})
+	params.PriceHistoryRetention = 3
+	keeper.SetParams(ctx, params)
+
+	setPrice := func(height int64, price string) {
+		ctx = ctx.WithBlockHeight(height).WithBlockTime(start.Add(time.Duration(height) * time.Minute))
+		_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+		keeper.SetCurrentPricesForAllMarkets(ctx)
+	}
+
+	setPrice(1, "1.0")
+	setPrice(2, "2.0")
+	setPrice(3, "3.0")
+	require.Equal(t, types.HistoricalPrices{
+		types.NewHistoricalPrice("tstusd", 2, start.Add(2*time.Minute), sdk.MustNewDecFromStr("2.0")),
+		types.NewHistoricalPrice("tstusd", 3, start.Add(3*time.Minute), sdk.MustNewDecFromStr("3.0")),
+	}, keeper.GetHistoricalPrices(ctx, "tstusd", 2, 10))
+
+	// prices older than the retention are pruned
+	setPrice(4, "4.0")
+	prices := keeper.GetHistoricalPrices(ctx, "tstusd", 0, 10)
+	require.Len(t, prices, 3)
+	require.Equal(t, int64(2), prices[0].Height)
+
+	// heights without a valid price have no historical price
+	ctx = ctx.WithBlockHeight(5).WithBlockTime(start.Add(2 * time.Hour))
+	keeper.SetCurrentPricesForAllMarkets(ctx)
+	prices = keeper.GetHistoricalPrices(ctx, "tstusd", 0, 10)
+	require.Len(t, prices, 2)
+	require.Equal(t, int64(3), prices[0].Height)
+	require.Equal(t, int64(4), prices[1].Height)
+
+	// disabling the history prunes the market's prices when its price is next set
+	params.PriceHistoryRetention = 0
+	keeper.SetParams(ctx, params)
+	setPrice(6, "6.0")
+	require.Empty(t, keeper.GetHistoricalPrices(ctx, "tstusd", 0, 10))
+}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// recordPriceObservation stores the current price of a market as an observation at the block time and prunes
+// observations that have left the twap window
+func (k Keeper) recordPriceObservation(ctx sdk.Context, marketID string, price sdk.Dec, window time.Duration) {
+	if window <= 0 {
+		k.pruneObservations(ctx, marketID, ctx.BlockTime().Add(time.Nanosecond), 0)
+		return
+	}
+
+	cumulativePrice := sdk.ZeroDec()
+	if prev, found := k.getLatestObservationBefore(ctx, marketID, ctx.BlockTime()); found {
+		cumulativePrice = prev.CumulativePriceAt(ctx.BlockTime())
+	}
+	observation := types.NewPriceObservation(marketID, price, ctx.BlockTime(), cumulativePrice)
+
+	store := ctx.KVStore(k.key)
+	store.Set(types.PriceObservationKey(marketID, ctx.BlockTime()), k.cdc.MustMarshal(&observation))
+
+	// the latest observation before the window is kept to price the start of the window
+	k.pruneObservations(ctx, marketID, ctx.BlockTime().Add(-window), 1)
+}
+
+// pruneObservations deletes the observations of a market before the cutoff time, except for the latest keep ones
+func (k Keeper) pruneObservations(ctx sdk.Context, marketID string, cutoff time.Time, keep int) {
+	store := ctx.KVStore(k.key)
+	iterator := store.ReverseIterator(
+		types.PriceObservationIteratorKey(marketID),
+		types.PriceObservationKey(marketID, cutoff),
+	)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if keep > 0 {
+			keep--
+			continue
+		}
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// getLatestObservationBefore returns the latest observation of a market strictly before the input time
+func (k Keeper) getLatestObservationBefore(ctx sdk.Context, marketID string, t time.Time) (types.PriceObservation, bool) {
+	return k.getLatestObservation(ctx, marketID, types.PriceObservationKey(marketID, t))
+}
+
+// getLatestObservationAt returns the latest observation of a market at or before the input time
+func (k Keeper) getLatestObservationAt(ctx sdk.Context, marketID string, t time.Time) (types.PriceObservation, bool) {
+	return k.getLatestObservation(ctx, marketID, sdk.InclusiveEndBytes(types.PriceObservationKey(marketID, t)))
+}
+
+func (k Keeper) getLatestObservation(ctx sdk.Context, marketID string, end []byte) (types.PriceObservation, bool) {
+	iterator := ctx.KVStore(k.key).ReverseIterator(types.PriceObservationIteratorKey(marketID), end)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.PriceObservation{}, false
+	}
+	var observation types.PriceObservation
+	k.cdc.MustUnmarshal(iterator.Value(), &observation)
+	return observation, true
+}
+
+// IteratePriceObservationsByMarket iterates over the price observations of a market in time order and performs a
+// callback function
+func (k Keeper) IteratePriceObservationsByMarket(ctx sdk.Context, marketID string, cb func(observation types.PriceObservation) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.PriceObservationIteratorKey(marketID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var observation types.PriceObservation
+		k.cdc.MustUnmarshal(iterator.Value(), &observation)
+		if cb(observation) {
+			break
+		}
+	}
+}
+
+// GetPriceObservations returns the price observations of a market in time order
+func (k Keeper) GetPriceObservations(ctx sdk.Context, marketID string) types.PriceObservations {
+	var observations types.PriceObservations
+	k.IteratePriceObservationsByMarket(ctx, marketID, func(observation types.PriceObservation) (stop bool) {
+		observations = append(observations, observation)
+		return false
+	})
+	return observations
+}
+
+// GetTwap returns the time-weighted average of a market's current price over the input duration ending at the block
+// time. Each observed price is weighted by the time until the next observation. If the market has not been observed
+// for the whole duration, the average is taken from its first observation.
+func (k Keeper) GetTwap(ctx sdk.Context, marketID string, duration time.Duration) (sdk.Dec, error) {
+	if duration <= 0 || duration > k.GetParams(ctx).TwapWindow {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidTwapDuration, "%s", duration)
+	}
+	// a market without a valid price has no twap, as the last observation would otherwise be carried forward
+	if _, err := k.GetCurrentPrice(ctx, marketID); err != nil {
+		return sdk.Dec{}, err
+	}
+
+	now := ctx.BlockTime()
+	end, found := k.getLatestObservationAt(ctx, marketID, now)
+	if !found {
+		return sdk.Dec{}, errorsmod.Wrap(types.ErrNoPriceObservations, marketID)
+	}
+
+	startTime := now.Add(-duration)
+	start, found := k.getLatestObservationAt(ctx, marketID, startTime)
+	if !found {
+		var first types.PriceObservation
+		k.IteratePriceObservationsByMarket(ctx, marketID, func(observation types.PriceObservation) (stop bool) {
+			first = observation
+			return true
+		})
+		start, startTime = first, first.Time
+	}
+
+	elapsed := sdk.NewDecWithPrec(now.Sub(startTime).Milliseconds(), 3)
+	if !elapsed.IsPositive() {
+		return end.Price, nil
+	}
+	return end.CumulativePriceAt(now).Sub(start.CumulativePriceAt(startTime)).Quo(elapsed), nil
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_GetTwap tests recording price observations and averaging them over time
+func TestKeeper_GetTwap(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, tmprototypes.Header{Time: start})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	keeper.SetParams(ctx, types.Params{
+		Markets: []types.Market{
+			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true},
+		},
+		TwapWindow: 2 * time.Hour,
+	})
+
+	setPrice := func(offset time.Duration, price string) {
+		ctx = ctx.WithBlockTime(start.Add(offset))
+		_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(24*time.Hour))
+		require.NoError(t, err)
+		keeper.SetCurrentPricesForAllMarkets(ctx)
+	}
+
+	_, err := keeper.GetTwap(ctx, "tstusd", time.Hour)
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+
+	setPrice(0, "1.0")
+	twap, err := keeper.GetTwap(ctx, "tstusd", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1.0"), twap)
+
+	// the average is taken from the first observation when the market has not been observed for the whole duration
+	setPrice(30*time.Minute, "2.0")
+	twap, err = keeper.GetTwap(ctx, "tstusd", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1.0"), twap)
+
+	setPrice(90*time.Minute, "4.0")
+	ctx = ctx.WithBlockTime(start.Add(2 * time.Hour))
+
+	// 30 minutes at 2.0 and 30 minutes at 4.0
+	twap, err = keeper.GetTwap(ctx, "tstusd", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("3.0"), twap)
+
+	// 30 minutes at 1.0, 60 minutes at 2.0 and 30 minutes at 4.0
+	twap, err = keeper.GetTwap(ctx, "tstusd", 2*time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("2.25"), twap)
+
+	_, err = keeper.GetTwap(ctx, "tstusd", 3*time.Hour)
+	require.ErrorIs(t, err, types.ErrInvalidTwapDuration)
+	_, err = keeper.GetTwap(ctx, "tstusd", 0)
+	require.ErrorIs(t, err, types.ErrInvalidTwapDuration)
+
+	// observations before the window are pruned, except the latest one
+	setPrice(3*time.Hour, "4.0")
+	observations := keeper.GetPriceObservations(ctx, "tstusd")
+	require.Len(t, observations, 3)
+	require.Equal(t, start.Add(30*time.Minute), observations[0].Time)
+
+	// 30 minutes at 2.0 and 90 minutes at 4.0
+	twap, err = keeper.GetTwap(ctx, "tstusd", 2*time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("3.5"), twap)
+
+	// disabling the twap window removes the observations
+	params := keeper.GetParams(ctx)
+	params.TwapWindow = 0
+	keeper.SetParams(ctx, params)
+	setPrice(4*time.Hour, "4.0")
+	require.Empty(t, keeper.GetPriceObservations(ctx, "tstusd"))
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// weightedPrice is an oracle price with the weight it has in a market's median price
+type weightedPrice struct {
+	price  sdk.Dec
+	weight sdkmath.Int
+}
+
+// calculateMarketMedianPrice calculates the median of a market's unexpired oracle prices, weighted by the market's
+// oracle weighting. It returns false if none of the prices has a positive weight.
+func (k Keeper) calculateMarketMedianPrice(ctx sdk.Context, market types.Market, prices types.PostedPrices) (sdk.Dec, bool) {
+	if market.OracleWeighting == types.ORACLE_WEIGHTING_UNSPECIFIED {
+		currentPrices := make([]types.CurrentPrice, len(prices))
+		for i, pp := range prices {
+			currentPrices[i] = types.NewCurrentPrice(pp.MarketID, pp.Price)
+		}
+		return k.CalculateMedianPrice(currentPrices), true
+	}
+
+	var weightedPrices []weightedPrice
+	for _, pp := range prices {
+		weight := k.getOracleWeight(ctx, market, pp.OracleAddress)
+		if weight.IsPositive() {
+			weightedPrices = append(weightedPrices, weightedPrice{price: pp.Price, weight: weight})
+		}
+	}
+	if len(weightedPrices) == 0 {
+		return sdk.Dec{}, false
+	}
+	return calculateWeightedMedianPrice(weightedPrices), true
+}
+
+// getOracleWeight returns the weight of an oracle's prices in a market's median price
+func (k Keeper) getOracleWeight(ctx sdk.Context, market types.Market, oracle sdk.AccAddress) sdkmath.Int {
+	switch market.OracleWeighting {
+	case types.ORACLE_WEIGHTING_CONFIGURED:
+		for _, ow := range market.OracleWeights {
+			if ow.OracleAddress.Equals(oracle) {
+				return sdkmath.NewIntFromUint64(ow.Weight)
+			}
+		}
+		return sdkmath.ZeroInt()
+	case types.ORACLE_WEIGHTING_STAKE:
+		// an oracle is weighted by the stake of the validator it operates, if it is bonded
+		validator, found := k.stakingKeeper.GetValidator(ctx, sdk.ValAddress(oracle))
+		if !found || !validator.IsBonded() {
+			return sdkmath.ZeroInt()
+		}
+		return validator.GetBondedTokens()
+	default:
+		return sdkmath.OneInt()
+	}
+}
+
+// calculateWeightedMedianPrice returns the price at which the cumulative weight of the sorted prices reaches half of
+// the total weight. If it is reached exactly between two prices, their mean is returned, so that equal weights give
+// the same result as the unweighted median.
+func calculateWeightedMedianPrice(prices []weightedPrice) sdk.Dec {
+	sort.SliceStable(prices, func(i, j int) bool {
+		return prices[i].price.LT(prices[j].price)
+	})
+
+	totalWeight := sdkmath.ZeroInt()
+	for _, wp := range prices {
+		totalWeight = totalWeight.Add(wp.weight)
+	}
+
+	cumulativeWeight := sdkmath.ZeroInt()
+	for i, wp := range prices {
+		cumulativeWeight = cumulativeWeight.Add(wp.weight)
+		// compare twice the cumulative weight to the total weight to avoid halving odd totals
+		doubled := cumulativeWeight.MulRaw(2)
+		if doubled.GT(totalWeight) {
+			return wp.price
+		}
+		if doubled.Equal(totalWeight) && i+1 < len(prices) {
+			return wp.price.Add(prices[i+1].price).Quo(sdk.NewDec(2))
+		}
+	}
+	return prices[len(prices)-1].price
+}
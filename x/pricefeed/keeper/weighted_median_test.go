@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	tmprototypes "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_WeightedMedianPrice tests weighting oracle prices by configured weights and by validator stake
+func TestKeeper_WeightedMedianPrice(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	prices := []string{"1.0", "2.0", "3.0"}
+
+	testCases := []struct {
+		name          string
+		weighting     types.OracleWeighting
+		weights       []types.OracleWeight
+		bondedTokens  []int64
+		expectedPrice string
+		expectErr     bool
+	}{
+		{
+			name:          "equal weights",
+			weighting:     types.ORACLE_WEIGHTING_UNSPECIFIED,
+			expectedPrice: "2.0",
+		},
+		{
+			name:      "configured weights",
+			weighting: types.ORACLE_WEIGHTING_CONFIGURED,
+			weights: []types.OracleWeight{
+				{OracleAddress: addrs[0], Weight: 1},
+				{OracleAddress: addrs[1], Weight: 1},
+				{OracleAddress: addrs[2], Weight: 3},
+			},
+			expectedPrice: "3.0",
+		},
+		{
+			name:      "configured weights split at the middle",
+			weighting: types.ORACLE_WEIGHTING_CONFIGURED,
+			weights: []types.OracleWeight{
+				{OracleAddress: addrs[0], Weight: 2},
+				{OracleAddress: addrs[1], Weight: 1},
+				{OracleAddress: addrs[2], Weight: 1},
+			},
+			expectedPrice: "1.5",
+		},
+		{
+			name:      "oracles without a configured weight are excluded",
+			weighting: types.ORACLE_WEIGHTING_CONFIGURED,
+			weights: []types.OracleWeight{
+				{OracleAddress: addrs[0], Weight: 1},
+			},
+			expectedPrice: "1.0",
+		},
+		{
+			name:          "stake weights",
+			weighting:     types.ORACLE_WEIGHTING_STAKE,
+			bondedTokens:  []int64{10, 10, 100},
+			expectedPrice: "3.0",
+		},
+		{
+			name:          "oracles without bonded stake are excluded",
+			weighting:     types.ORACLE_WEIGHTING_STAKE,
+			bondedTokens:  []int64{10, 0, 0},
+			expectedPrice: "1.0",
+		},
+		{
+			name:      "no weighted prices",
+			weighting: types.ORACLE_WEIGHTING_STAKE,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(time.Now().UTC())
+			keeper := tApp.GetPriceFeedKeeper()
+
+			keeper.SetParams(ctx, types.NewParams([]types.Market{
+				{
+					MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true,
+					OracleWeighting: tc.weighting, OracleWeights: tc.weights,
+				},
+			}))
+
+			for i, tokens := range tc.bondedTokens {
+				if tokens == 0 {
+					continue
+				}
+				validator, err := stakingtypes.NewValidator(sdk.ValAddress(addrs[i]), ed25519.GenPrivKey().PubKey(), stakingtypes.Description{})
+				require.NoError(t, err)
+				validator.Status = stakingtypes.Bonded
+				validator.Tokens = sdkmath.NewInt(tokens)
+				tApp.GetStakingKeeper().SetValidator(ctx, validator)
+			}
+
+			for i, price := range prices {
+				_, err := keeper.SetPrice(ctx, addrs[i], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Hour))
+				require.NoError(t, err)
+			}
+
+			err := keeper.SetCurrentPrices(ctx, "tstusd")
+			if tc.expectErr {
+				require.ErrorIs(t, err, types.ErrNoValidPrice)
+				return
+			}
+			require.NoError(t, err)
+
+			currentPrice, err := keeper.GetCurrentPrice(ctx, "tstusd")
+			require.NoError(t, err)
+			require.Equal(t, sdk.MustNewDecFromStr(tc.expectedPrice), currentPrice.Price)
+
+			// the end blocker calculates the same price
+			keeper.SetCurrentPricesForAllMarkets(ctx)
+			currentPrice, err = keeper.GetCurrentPrice(ctx, "tstusd")
+			require.NoError(t, err)
+			require.Equal(t, sdk.MustNewDecFromStr(tc.expectedPrice), currentPrice.Price)
+		})
+	}
+}
@@ -87,7 +87,10 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"min_oracles": 0,
+					"oracle_weighting": "ORACLE_WEIGHTING_UNSPECIFIED",
+					"oracle_weights": []
 				},
 				{
 					"market_id": "bnb:usd:30",
@@ -96,7 +99,10 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"min_oracles": 0,
+					"oracle_weighting": "ORACLE_WEIGHTING_UNSPECIFIED",
+					"oracle_weights": []
 				},
 				{
 					"market_id": "atom:usd",
@@ -105,7 +111,10 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"min_oracles": 0,
+					"oracle_weighting": "ORACLE_WEIGHTING_UNSPECIFIED",
+					"oracle_weights": []
 				},
 				{
 					"market_id": "atom:usd:30",
@@ -114,7 +123,10 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"min_oracles": 0,
+					"oracle_weighting": "ORACLE_WEIGHTING_UNSPECIFIED",
+					"oracle_weights": []
 				},
 				{
 					"market_id": "akt:usd",
@@ -123,7 +135,10 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"min_oracles": 0,
+					"oracle_weighting": "ORACLE_WEIGHTING_UNSPECIFIED",
+					"oracle_weights": []
 				},
 				{
 					"market_id": "akt:usd:30",
@@ -132,7 +147,10 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"min_oracles": 0,
+					"oracle_weighting": "ORACLE_WEIGHTING_UNSPECIFIED",
+					"oracle_weights": []
 				},
 				{
 					"market_id": "luna:usd",
@@ -141,7 +159,10 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"min_oracles": 0,
+					"oracle_weighting": "ORACLE_WEIGHTING_UNSPECIFIED",
+					"oracle_weights": []
 				},
 				{
 					"market_id": "luna:usd:30",
@@ -150,7 +171,10 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"min_oracles": 0,
+					"oracle_weighting": "ORACLE_WEIGHTING_UNSPECIFIED",
+					"oracle_weights": []
 				},
 				{
 					"market_id": "osmo:usd",
@@ -159,7 +183,10 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"min_oracles": 0,
+					"oracle_weighting": "ORACLE_WEIGHTING_UNSPECIFIED",
+					"oracle_weights": []
 				},
 				{
 					"market_id": "osmo:usd:30",
@@ -168,7 +195,10 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"min_oracles": 0,
+					"oracle_weighting": "ORACLE_WEIGHTING_UNSPECIFIED",
+					"oracle_weights": []
 				},
 				{
 					"market_id": "ust:usd",
@@ -177,7 +207,10 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"min_oracles": 0,
+					"oracle_weighting": "ORACLE_WEIGHTING_UNSPECIFIED",
+					"oracle_weights": []
 				},
 				{
 					"market_id": "ust:usd:30",
@@ -186,9 +219,17 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 					"oracles": [
 						"kava1acge4tcvhf3q6fh53fgwaa7vsq40wvx6wn50em"
 					],
-					"active": true
+					"active": true,
+					"min_oracles": 0,
+					"oracle_weighting": "ORACLE_WEIGHTING_UNSPECIFIED",
+					"oracle_weights": []
 				}
-			]
+			],
+			"twap_window": "0s",
+			"derived_markets": [],
+			"ibc_oracle_channels": [],
+			"stale_price_grace_period": "0s",
+			"price_history_retention": "0"
 		},
 		"posted_prices": [
 			{
@@ -203,7 +244,8 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 				"price": "217.962650000000001782",
 				"expiry": "2022-07-20T00:00:00Z"
 			}
-		]
+		],
+		"oracle_statistics": []
 	}`
 
 	err := s.legacyCdc.UnmarshalJSON([]byte(v15Params), &s.v15genstate)
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmprototypes "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
@@ -218,8 +219,8 @@ func SetCurrentPrices_EventEmission(t *testing.T, f func(ctx sdk.Context, keeper
 	ctx = ctx.WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
 	f(ctx, keeper)
 
-	// price is changes so event should be emitted
-	require.Equal(t, 1, len(ctx.EventManager().Events()))
+	// price is changes so legacy and typed events should be emitted
+	require.Equal(t, 2, len(ctx.EventManager().Events()))
 
 	event := ctx.EventManager().Events()[0]
 
@@ -233,4 +234,15 @@ func SetCurrentPrices_EventEmission(t *testing.T, f func(ctx sdk.Context, keeper
 	// attributes have correct values
 	assert.Equal(t, "asset1:usd", marketID.Value)
 	assert.Equal(t, sdk.MustNewDecFromStr("2").String(), marketPrice.Value)
+
+	typedEvent, err := sdk.ParseTypedEvent(abci.Event(ctx.EventManager().Events()[1]))
+	require.NoError(t, err)
+	assert.Equal(t, &types.EventMedianPriceUpdated{
+		MarketId:      "asset1:usd",
+		MedianPrice:   sdk.MustNewDecFromStr("2"),
+		PreviousPrice: sdk.MustNewDecFromStr("1"),
+		OracleCount:   5,
+		MinPrice:      sdk.MustNewDecFromStr("1"),
+		MaxPrice:      sdk.MustNewDecFromStr("10"),
+	}, typedEvent)
 }
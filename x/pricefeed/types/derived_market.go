@@ -0,0 +1,98 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDerivedMarket returns a new DerivedMarket
+func NewDerivedMarket(id, base, quote string, components []DerivedMarketComponent, active bool) DerivedMarket {
+	return DerivedMarket{
+		MarketID:   id,
+		BaseAsset:  base,
+		QuoteAsset: quote,
+		Components: components,
+		Active:     active,
+	}
+}
+
+// NewDerivedMarketComponent returns a new DerivedMarketComponent
+func NewDerivedMarketComponent(marketID string, invert bool) DerivedMarketComponent {
+	return DerivedMarketComponent{
+		MarketID: marketID,
+		Invert:   invert,
+	}
+}
+
+// Validate performs a basic validation of the derived market params
+func (dm DerivedMarket) Validate() error {
+	if strings.TrimSpace(dm.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if err := sdk.ValidateDenom(dm.BaseAsset); err != nil {
+		return fmt.Errorf("invalid base asset: %w", err)
+	}
+	if err := sdk.ValidateDenom(dm.QuoteAsset); err != nil {
+		return fmt.Errorf("invalid quote asset: %w", err)
+	}
+	if len(dm.Components) == 0 {
+		return fmt.Errorf("derived market %s has no components", dm.MarketID)
+	}
+	seenComponents := make(map[string]bool)
+	for _, c := range dm.Components {
+		if strings.TrimSpace(c.MarketID) == "" {
+			return fmt.Errorf("derived market %s has a component with a blank market id", dm.MarketID)
+		}
+		if c.MarketID == dm.MarketID {
+			return fmt.Errorf("derived market %s cannot be its own component", dm.MarketID)
+		}
+		if seenComponents[c.MarketID] {
+			return fmt.Errorf("derived market %s has duplicated component %s", dm.MarketID, c.MarketID)
+		}
+		seenComponents[c.MarketID] = true
+	}
+	return nil
+}
+
+// DerivedMarkets is a slice of DerivedMarket
+type DerivedMarkets []DerivedMarket
+
+// Validate checks if all the derived markets are valid and there are no
+// duplicated entries.
+func (dms DerivedMarkets) Validate() error {
+	seenMarkets := make(map[string]bool)
+	for _, dm := range dms {
+		if seenMarkets[dm.MarketID] {
+			return fmt.Errorf("duplicated derived market %s", dm.MarketID)
+		}
+		if err := dm.Validate(); err != nil {
+			return err
+		}
+		seenMarkets[dm.MarketID] = true
+	}
+	return nil
+}
+
+// validateComponents checks that derived markets do not share ids with markets, and that their components are
+// markets or derived markets listed before them, so derived prices can be computed in order.
+func (dms DerivedMarkets) validateComponents(markets Markets) error {
+	knownMarkets := make(map[string]bool)
+	for _, m := range markets {
+		knownMarkets[m.MarketID] = true
+	}
+	for _, dm := range dms {
+		if knownMarkets[dm.MarketID] {
+			return fmt.Errorf("derived market %s is already a market", dm.MarketID)
+		}
+		for _, c := range dm.Components {
+			if !knownMarkets[c.MarketID] {
+				return fmt.Errorf("derived market %s component %s is not a market or an earlier derived market", dm.MarketID, c.MarketID)
+			}
+		}
+		knownMarkets[dm.MarketID] = true
+	}
+	return nil
+}
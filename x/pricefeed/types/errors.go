@@ -17,4 +17,14 @@ var (
 	ErrInvalidOracle = errorsmod.Register(ModuleName, 6, "oracle does not exist or not authorized")
 	// ErrAssetNotFound error for not found asset
 	ErrAssetNotFound = errorsmod.Register(ModuleName, 7, "asset not found")
+	// ErrInvalidTwapDuration error for twap durations that are not positive or exceed the twap window
+	ErrInvalidTwapDuration = errorsmod.Register(ModuleName, 8, "invalid twap duration")
+	// ErrNoPriceObservations error for twaps of markets without price observations
+	ErrNoPriceObservations = errorsmod.Register(ModuleName, 9, "no price observations")
+	// ErrInvalidPacket error for oracle price packets that cannot be decoded or are invalid
+	ErrInvalidPacket = errorsmod.Register(ModuleName, 10, "invalid oracle price packet")
+	// ErrInvalidVersion error for IBC channels with an unsupported version
+	ErrInvalidVersion = errorsmod.Register(ModuleName, 11, "invalid pricefeed version")
+	// ErrChannelNotAllowed error for oracle price packets received on channels that are not allow-listed
+	ErrChannelNotAllowed = errorsmod.Register(ModuleName, 12, "channel not allowed to post prices")
 )
@@ -0,0 +1,545 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/pricefeed/v1beta1/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventMedianPriceUpdated is emitted when the current price of a market changes, including when it is first set.
+type EventMedianPriceUpdated struct {
+	// market_id is the market whose current price changed.
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// median_price is the new current price of the market.
+	MedianPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=median_price,json=medianPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"median_price"`
+	// previous_price is the market's previous current price, zero if it had no valid price.
+	PreviousPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=previous_price,json=previousPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"previous_price"`
+	// oracle_count is the number of unexpired oracle prices the median was calculated from.
+	OracleCount uint32 `protobuf:"varint,4,opt,name=oracle_count,json=oracleCount,proto3" json:"oracle_count,omitempty"`
+	// min_price is the lowest of the unexpired oracle prices.
+	MinPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=min_price,json=minPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_price"`
+	// max_price is the highest of the unexpired oracle prices.
+	MaxPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_price,json=maxPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price"`
+}
+
+func (m *EventMedianPriceUpdated) Reset()         { *m = EventMedianPriceUpdated{} }
+func (m *EventMedianPriceUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMedianPriceUpdated) ProtoMessage()    {}
+func (*EventMedianPriceUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb5ecf1a829811ae, []int{0}
+}
+func (m *EventMedianPriceUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMedianPriceUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMedianPriceUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMedianPriceUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMedianPriceUpdated.Merge(m, src)
+}
+func (m *EventMedianPriceUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMedianPriceUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMedianPriceUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMedianPriceUpdated proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*EventMedianPriceUpdated)(nil), "kava.pricefeed.v1beta1.EventMedianPriceUpdated")
+}
+
+func init() {
+	proto.RegisterFile("kava/pricefeed/v1beta1/events.proto", fileDescriptor_eb5ecf1a829811ae)
+}
+
+var fileDescriptor_eb5ecf1a829811ae = []byte{
+	// 330 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x41, 0x4b, 0x3a, 0x41,
+	0x18, 0xc6, 0x77, 0xff, 0xfe, 0x13, 0x1d, 0xb5, 0xc3, 0x12, 0x25, 0x05, 0xa3, 0x15, 0x84, 0x10,
+	0xee, 0x20, 0x7d, 0x03, 0xab, 0x83, 0x44, 0x50, 0x82, 0x97, 0x2e, 0x32, 0xbb, 0xf3, 0x66, 0x83,
+	0x8e, 0xb3, 0xec, 0x8c, 0x8b, 0x7d, 0x8b, 0xbe, 0x40, 0xdf, 0xc7, 0xa3, 0xc7, 0xe8, 0x20, 0xa5,
+	0x5f, 0x24, 0x66, 0x76, 0x2d, 0xcf, 0x7b, 0xda, 0x97, 0x67, 0x9f, 0xe7, 0xf7, 0xf0, 0xf2, 0x0e,
+	0x3a, 0x1f, 0xd3, 0x84, 0x92, 0x28, 0xe6, 0x21, 0x3c, 0x03, 0x30, 0x92, 0x74, 0x02, 0xd0, 0xb4,
+	0x43, 0x20, 0x81, 0xa9, 0x56, 0x7e, 0x14, 0x4b, 0x2d, 0xbd, 0x43, 0x63, 0xf2, 0x7f, 0x4d, 0x7e,
+	0x66, 0x3a, 0x3e, 0x18, 0xc9, 0x91, 0xb4, 0x16, 0x62, 0xa6, 0xd4, 0x7d, 0xf6, 0x5e, 0x40, 0x47,
+	0xb7, 0x26, 0x7e, 0x0f, 0x8c, 0xd3, 0xe9, 0x83, 0x89, 0x0d, 0x22, 0x46, 0x35, 0x30, 0xef, 0x04,
+	0x95, 0x05, 0x8d, 0xc7, 0xa0, 0x87, 0x9c, 0xd5, 0xdd, 0xa6, 0xdb, 0x2a, 0xf7, 0x4b, 0xa9, 0xd0,
+	0x63, 0xde, 0x23, 0xaa, 0x0a, 0x1b, 0x19, 0xda, 0xaa, 0xfa, 0x3f, 0xf3, 0xbf, 0xeb, 0x2f, 0x56,
+	0x0d, 0xe7, 0x73, 0xd5, 0xb8, 0x18, 0x71, 0xfd, 0x32, 0x0b, 0xfc, 0x50, 0x0a, 0x12, 0x4a, 0x25,
+	0xa4, 0xca, 0x3e, 0x6d, 0xc5, 0xc6, 0x44, 0xbf, 0x46, 0xa0, 0xfc, 0x1b, 0x08, 0xfb, 0x15, 0xf1,
+	0x57, 0xeb, 0x0d, 0xd0, 0x7e, 0x14, 0x43, 0xc2, 0xe5, 0x4c, 0x65, 0xd0, 0x42, 0x2e, 0x68, 0x6d,
+	0x4b, 0x49, 0xb1, 0xa7, 0xa8, 0x2a, 0x63, 0x1a, 0x4e, 0x60, 0x18, 0xca, 0xd9, 0x54, 0xd7, 0xff,
+	0x37, 0xdd, 0x56, 0xad, 0x5f, 0x49, 0xb5, 0x6b, 0x23, 0x79, 0x77, 0xa8, 0x2c, 0xf8, 0x76, 0x93,
+	0xbd, 0x5c, 0xa5, 0x25, 0xc1, 0xb3, 0x35, 0x0c, 0x8c, 0xce, 0x33, 0x58, 0x31, 0x27, 0x8c, 0xce,
+	0x2d, 0xac, 0xdb, 0x5b, 0x7c, 0x63, 0x67, 0xb1, 0xc6, 0xee, 0x72, 0x8d, 0xdd, 0xaf, 0x35, 0x76,
+	0xdf, 0x36, 0xd8, 0x59, 0x6e, 0xb0, 0xf3, 0xb1, 0xc1, 0xce, 0xd3, 0xe5, 0x0e, 0xcf, 0x9c, 0xbd,
+	0x3d, 0xa1, 0x81, 0xb2, 0x13, 0x99, 0xef, 0xbc, 0x13, 0x0b, 0x0e, 0x8a, 0xf6, 0xe2, 0x57, 0x3f,
+	0x03, 0x00, 0xb7, 0x94, 0x9a, 0xdc, 0x46, 0x02, 0x00, 0x00,
+}
+
+func (m *EventMedianPriceUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMedianPriceUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMedianPriceUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxPrice.Size()
+		i -= size
+		if _, err := m.MaxPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinPrice.Size()
+		i -= size
+		if _, err := m.MinPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.OracleCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OracleCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.PreviousPrice.Size()
+		i -= size
+		if _, err := m.PreviousPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MedianPrice.Size()
+		i -= size
+		if _, err := m.MedianPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventMedianPriceUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.MedianPrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.PreviousPrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.OracleCount != 0 {
+		n += 1 + sovEvents(uint64(m.OracleCount))
+	}
+	l = m.MinPrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.MaxPrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventMedianPriceUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMedianPriceUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMedianPriceUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MedianPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleCount", wireType)
+			}
+			m.OracleCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// PricefeedHooks event hooks for oracles posting prices, which can be used to reward or penalize oracles
+type PricefeedHooks interface {
+	AfterOraclePriceIncluded(ctx sdk.Context, marketID string, oracle sdk.AccAddress, deviation sdk.Dec)
+	AfterOracleWindowMissed(ctx sdk.Context, marketID string, oracle sdk.AccAddress)
+}
+
+// PortKeeper defines the expected IBC port keeper
+type PortKeeper interface {
+	BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability
+}
+
+// ScopedKeeper defines the expected capability keeper scoped to the pricefeed module
+type ScopedKeeper interface {
+	GetCapability(ctx sdk.Context, name string) (*capabilitytypes.Capability, bool)
+	ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error
+}
+
+// StakingKeeper defines the expected staking keeper used to weight oracle prices by bonded stake
+type StakingKeeper interface {
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+}
@@ -1,10 +1,11 @@
 package types
 
 // NewGenesisState creates a new genesis state for the pricefeed module
-func NewGenesisState(p Params, pp []PostedPrice) GenesisState {
+func NewGenesisState(p Params, pp []PostedPrice, stats []OracleStatistics) GenesisState {
 	return GenesisState{
-		Params:       p,
-		PostedPrices: pp,
+		Params:           p,
+		PostedPrices:     pp,
+		OracleStatistics: stats,
 	}
 }
 
@@ -13,6 +14,7 @@ func DefaultGenesisState() GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
 		[]PostedPrice{},
+		[]OracleStatistics{},
 	)
 }
 
@@ -23,5 +25,9 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	return gs.PostedPrices.Validate()
+	if err := gs.PostedPrices.Validate(); err != nil {
+		return err
+	}
+
+	return gs.OracleStatistics.Validate()
 }
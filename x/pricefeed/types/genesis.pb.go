@@ -26,8 +26,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the pricefeed module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params       Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PostedPrices PostedPrices `protobuf:"bytes,2,rep,name=posted_prices,json=postedPrices,proto3,castrepeated=PostedPrices" json:"posted_prices"`
+	Params           Params               `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PostedPrices     PostedPrices         `protobuf:"bytes,2,rep,name=posted_prices,json=postedPrices,proto3,castrepeated=PostedPrices" json:"posted_prices"`
+	OracleStatistics OracleStatisticsList `protobuf:"bytes,3,rep,name=oracle_statistics,json=oracleStatistics,proto3,castrepeated=OracleStatisticsList" json:"oracle_statistics"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +78,13 @@ func (m *GenesisState) GetPostedPrices() PostedPrices {
 	return nil
 }
 
+func (m *GenesisState) GetOracleStatistics() OracleStatisticsList {
+	if m != nil {
+		return m.OracleStatistics
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.pricefeed.v1beta1.GenesisState")
 }
@@ -86,24 +94,27 @@ func init() {
 }
 
 var fileDescriptor_fffec798191784d2 = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x2f, 0x28, 0xca, 0x4c, 0x4e, 0x4d, 0x4b, 0x4d, 0x4d, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x03, 0xa9, 0xd2, 0x83, 0xab, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x94, 0x70, 0x98, 0x59, 0x5c, 0x92, 0x5f, 0x94,
-	0x0a, 0x51, 0xa3, 0xb4, 0x86, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x47, 0x70, 0x49, 0x62, 0x49, 0xaa,
-	0x90, 0x0d, 0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7,
-	0x91, 0x9c, 0x1e, 0x76, 0x3b, 0xf5, 0x02, 0xc0, 0xaa, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08,
-	0x82, 0xea, 0x11, 0x8a, 0xe3, 0xe2, 0x2d, 0xc8, 0x2f, 0x2e, 0x49, 0x4d, 0x89, 0x07, 0x6b, 0x28,
-	0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xc6, 0x69, 0x08, 0x58, 0x71, 0x00, 0x48, 0xdc,
-	0x49, 0x04, 0x64, 0xd2, 0xaa, 0xfb, 0xf2, 0x3c, 0x48, 0x82, 0xc5, 0x41, 0x3c, 0x05, 0x48, 0x3c,
-	0x27, 0xdf, 0x07, 0x0f, 0xe5, 0x18, 0x57, 0x3c, 0x92, 0x63, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2,
-	0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1,
-	0xc6, 0x63, 0x39, 0x86, 0x28, 0xed, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c,
-	0x7d, 0x90, 0xa5, 0xba, 0x39, 0x89, 0x49, 0xc5, 0x60, 0x96, 0x7e, 0x05, 0x52, 0x58, 0x94, 0x54,
-	0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x03, 0xc1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xf3,
-	0x1e, 0xef, 0x7e, 0x01, 0x00, 0x00,
+	// 310 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4e, 0xea, 0x40,
+	0x14, 0x86, 0x3b, 0x70, 0xc3, 0xa2, 0x70, 0x13, 0x6d, 0x88, 0x21, 0xc4, 0x0c, 0x04, 0x5d, 0x90,
+	0x18, 0x67, 0x02, 0x6e, 0x5d, 0xb1, 0x71, 0xa3, 0x91, 0xc0, 0xce, 0x85, 0x64, 0x5a, 0xc6, 0x3a,
+	0x11, 0x9c, 0x49, 0xcf, 0x91, 0xe8, 0x5b, 0xf8, 0x0a, 0xee, 0x8c, 0x4f, 0xc2, 0x92, 0xa5, 0x2b,
+	0xc5, 0xf2, 0x22, 0xa6, 0xd3, 0x86, 0x34, 0xc4, 0xee, 0x4e, 0x4f, 0xbf, 0xff, 0xff, 0x26, 0x33,
+	0xee, 0xf1, 0x83, 0x58, 0x08, 0x6e, 0x22, 0x15, 0xc8, 0x3b, 0x29, 0xa7, 0x7c, 0xd1, 0xf3, 0x25,
+	0x8a, 0x1e, 0x0f, 0xe5, 0xa3, 0x04, 0x05, 0xcc, 0x44, 0x1a, 0xb5, 0x77, 0x90, 0x50, 0x6c, 0x4b,
+	0xb1, 0x8c, 0x6a, 0xd6, 0x43, 0x1d, 0x6a, 0x8b, 0xf0, 0x64, 0x4a, 0xe9, 0x66, 0xa7, 0xa0, 0x13,
+	0x50, 0x47, 0x32, 0x65, 0x3a, 0x6f, 0x25, 0xb7, 0x76, 0x91, 0x3a, 0xc6, 0x28, 0x50, 0x7a, 0xe7,
+	0x6e, 0xc5, 0x88, 0x48, 0xcc, 0xa1, 0x41, 0xda, 0xa4, 0x5b, 0xed, 0x53, 0xf6, 0xb7, 0x93, 0x0d,
+	0x2d, 0x35, 0xf8, 0xb7, 0xfc, 0x6a, 0x39, 0xa3, 0x2c, 0xe3, 0xdd, 0xba, 0xff, 0x8d, 0x06, 0x94,
+	0xd3, 0x89, 0x0d, 0x40, 0xa3, 0xd4, 0x2e, 0x77, 0xab, 0xfd, 0xa3, 0xc2, 0x12, 0x0b, 0x0f, 0x93,
+	0xfd, 0xa0, 0x9e, 0x34, 0x7d, 0x7c, 0xb7, 0x6a, 0xb9, 0x25, 0x8c, 0x6a, 0x26, 0xf7, 0xe5, 0x81,
+	0xbb, 0xaf, 0x23, 0x11, 0xcc, 0xe4, 0x04, 0x50, 0xa0, 0x02, 0x54, 0x01, 0x34, 0xca, 0xd6, 0xd1,
+	0x2d, 0x72, 0x5c, 0xdb, 0xc0, 0x78, 0xcb, 0x0f, 0x0e, 0x33, 0x51, 0x7d, 0xf7, 0xcf, 0xa5, 0x02,
+	0x1c, 0xed, 0xe9, 0x5d, 0xfe, 0x6a, 0xfd, 0x43, 0xc9, 0x7b, 0x4c, 0xc9, 0x32, 0xa6, 0x64, 0x15,
+	0x53, 0xb2, 0x8e, 0x29, 0x79, 0xdd, 0x50, 0x67, 0xb5, 0xa1, 0xce, 0xe7, 0x86, 0x3a, 0x37, 0x27,
+	0xa1, 0xc2, 0xfb, 0x27, 0x9f, 0x05, 0x7a, 0xce, 0x93, 0x53, 0x9c, 0xce, 0x84, 0x0f, 0x76, 0xe2,
+	0xcf, 0xb9, 0x07, 0xc0, 0x17, 0x23, 0xc1, 0xaf, 0xd8, 0x9b, 0x3f, 0xfb, 0x1d, 0x00, 0x88, 0xc3,
+	0xf7, 0x8d, 0xf3, 0x01, 0x00, 0x00,
 }
 
 func (this *GenesisState) VerboseEqual(that interface{}) error {
@@ -142,6 +153,14 @@ func (this *GenesisState) VerboseEqual(that interface{}) error {
 			return fmt.Errorf("PostedPrices this[%v](%v) Not Equal that[%v](%v)", i, this.PostedPrices[i], i, that1.PostedPrices[i])
 		}
 	}
+	if len(this.OracleStatistics) != len(that1.OracleStatistics) {
+		return fmt.Errorf("OracleStatistics this(%v) Not Equal that(%v)", len(this.OracleStatistics), len(that1.OracleStatistics))
+	}
+	for i := range this.OracleStatistics {
+		if !this.OracleStatistics[i].Equal(&that1.OracleStatistics[i]) {
+			return fmt.Errorf("OracleStatistics this[%v](%v) Not Equal that[%v](%v)", i, this.OracleStatistics[i], i, that1.OracleStatistics[i])
+		}
+	}
 	return nil
 }
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -174,6 +193,14 @@ func (this *GenesisState) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.OracleStatistics) != len(that1.OracleStatistics) {
+		return false
+	}
+	for i := range this.OracleStatistics {
+		if !this.OracleStatistics[i].Equal(&that1.OracleStatistics[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -196,6 +223,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OracleStatistics) > 0 {
+		for iNdEx := len(m.OracleStatistics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleStatistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.PostedPrices) > 0 {
 		for iNdEx := len(m.PostedPrices) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -248,6 +289,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.OracleStatistics) > 0 {
+		for _, e := range m.OracleStatistics {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -353,6 +400,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleStatistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleStatistics = append(m.OracleStatistics, OracleStatistics{})
+			if err := m.OracleStatistics[len(m.OracleStatistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -32,9 +32,10 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "valid genesis",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
+					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true, 0, ORACLE_WEIGHTING_UNSPECIFIED, nil},
 				}),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				[]OracleStatistics{},
 			),
 			expPass: true,
 		},
@@ -42,9 +43,10 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "invalid param",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"", "xrp", "bnb", []sdk.AccAddress{addr}, true},
+					{"", "xrp", "bnb", []sdk.AccAddress{addr}, true, 0, ORACLE_WEIGHTING_UNSPECIFIED, nil},
 				}),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				[]OracleStatistics{},
 			),
 			expPass: false,
 		},
@@ -52,10 +54,162 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "dup market param",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
+					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true, 0, ORACLE_WEIGHTING_UNSPECIFIED, nil},
+					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true, 0, ORACLE_WEIGHTING_UNSPECIFIED, nil},
 				}),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				[]OracleStatistics{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "negative twap window",
+			genesisState: NewGenesisState(
+				Params{Markets: []Market{}, TwapWindow: -time.Hour},
+				[]PostedPrice{},
+				[]OracleStatistics{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid derived market",
+			genesisState: NewGenesisState(
+				Params{
+					Markets: []Market{
+						{"atom:btc", "atom", "btc", []sdk.AccAddress{addr}, true, 0, ORACLE_WEIGHTING_UNSPECIFIED, nil},
+						{"btc:usd", "btc", "usd", []sdk.AccAddress{addr}, true, 0, ORACLE_WEIGHTING_UNSPECIFIED, nil},
+					},
+					DerivedMarkets: []DerivedMarket{
+						NewDerivedMarket("atom:usd", "atom", "usd", []DerivedMarketComponent{
+							NewDerivedMarketComponent("atom:btc", false),
+							NewDerivedMarketComponent("btc:usd", false),
+						}, true),
+						NewDerivedMarket("usd:atom", "usd", "atom", []DerivedMarketComponent{
+							NewDerivedMarketComponent("atom:usd", true),
+						}, true),
+					},
+				},
+				[]PostedPrice{},
+				[]OracleStatistics{},
+			),
+			expPass: true,
+		},
+		{
+			msg: "derived market without components",
+			genesisState: NewGenesisState(
+				Params{
+					Markets:        []Market{},
+					DerivedMarkets: []DerivedMarket{NewDerivedMarket("atom:usd", "atom", "usd", nil, true)},
+				},
+				[]PostedPrice{},
+				[]OracleStatistics{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "derived market with unknown component",
+			genesisState: NewGenesisState(
+				Params{
+					Markets: []Market{{"atom:btc", "atom", "btc", []sdk.AccAddress{addr}, true, 0, ORACLE_WEIGHTING_UNSPECIFIED, nil}},
+					DerivedMarkets: []DerivedMarket{
+						NewDerivedMarket("atom:usd", "atom", "usd", []DerivedMarketComponent{
+							NewDerivedMarketComponent("atom:btc", false),
+							NewDerivedMarketComponent("btc:usd", false),
+						}, true),
+					},
+				},
+				[]PostedPrice{},
+				[]OracleStatistics{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "derived market with later derived component",
+			genesisState: NewGenesisState(
+				Params{
+					Markets: []Market{{"btc:usd", "btc", "usd", []sdk.AccAddress{addr}, true, 0, ORACLE_WEIGHTING_UNSPECIFIED, nil}},
+					DerivedMarkets: []DerivedMarket{
+						NewDerivedMarket("usd:usd", "usd", "usd", []DerivedMarketComponent{
+							NewDerivedMarketComponent("usd:btc", false),
+							NewDerivedMarketComponent("btc:usd", false),
+						}, true),
+						NewDerivedMarket("usd:btc", "usd", "btc", []DerivedMarketComponent{
+							NewDerivedMarketComponent("btc:usd", true),
+						}, true),
+					},
+				},
+				[]PostedPrice{},
+				[]OracleStatistics{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "derived market with market id",
+			genesisState: NewGenesisState(
+				Params{
+					Markets: []Market{{"btc:usd", "btc", "usd", []sdk.AccAddress{addr}, true, 0, ORACLE_WEIGHTING_UNSPECIFIED, nil}},
+					DerivedMarkets: []DerivedMarket{
+						NewDerivedMarket("btc:usd", "btc", "usd", []DerivedMarketComponent{
+							NewDerivedMarketComponent("btc:usd", false),
+						}, true),
+					},
+				},
+				[]PostedPrice{},
+				[]OracleStatistics{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "invalid ibc oracle channel",
+			genesisState: NewGenesisState(
+				Params{Markets: []Market{}, IBCOracleChannels: []string{"channel-0", "invalid channel"}},
+				[]PostedPrice{},
+				[]OracleStatistics{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated ibc oracle channel",
+			genesisState: NewGenesisState(
+				Params{Markets: []Market{}, IBCOracleChannels: []string{"channel-0", "channel-0"}},
+				[]PostedPrice{},
+				[]OracleStatistics{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "negative stale price grace period",
+			genesisState: NewGenesisState(
+				Params{Markets: []Market{}, StalePriceGracePeriod: -time.Hour},
+				[]PostedPrice{},
+				[]OracleStatistics{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid oracle statistics",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]OracleStatistics{NewOracleStatistics("xrp", addr)},
+			),
+			expPass: true,
+		},
+		{
+			msg: "duplicated oracle statistics",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]OracleStatistics{NewOracleStatistics("xrp", addr), NewOracleStatistics("xrp", addr)},
+			),
+			expPass: false,
+		},
+		{
+			msg: "invalid oracle statistics",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]OracleStatistics{NewOracleStatistics("xrp", nil)},
 			),
 			expPass: false,
 		},
@@ -64,6 +218,7 @@ func TestGenesisStateValidate(t *testing.T) {
 			genesisState: NewGenesisState(
 				NewParams([]Market{}),
 				[]PostedPrice{NewPostedPrice("xrp", nil, sdk.OneDec(), now)},
+				[]OracleStatistics{},
 			),
 			expPass: false,
 		},
@@ -75,6 +230,7 @@ func TestGenesisStateValidate(t *testing.T) {
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 				},
+				[]OracleStatistics{},
 			),
 			expPass: false,
 		},
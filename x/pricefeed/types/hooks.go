@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MultiPricefeedHooks combine multiple pricefeed hooks, all hook functions are run in array sequence
+type MultiPricefeedHooks []PricefeedHooks
+
+// NewMultiPricefeedHooks returns a new MultiPricefeedHooks
+func NewMultiPricefeedHooks(hooks ...PricefeedHooks) MultiPricefeedHooks {
+	return hooks
+}
+
+// AfterOraclePriceIncluded runs after an oracle's price is included in setting a market's current price
+func (h MultiPricefeedHooks) AfterOraclePriceIncluded(ctx sdk.Context, marketID string, oracle sdk.AccAddress, deviation sdk.Dec) {
+	for i := range h {
+		h[i].AfterOraclePriceIncluded(ctx, marketID, oracle, deviation)
+	}
+}
+
+// AfterOracleWindowMissed runs after a market's current price is set without a price from one of its oracles
+func (h MultiPricefeedHooks) AfterOracleWindowMissed(ctx sdk.Context, marketID string, oracle sdk.AccAddress) {
+	for i := range h {
+		h[i].AfterOracleWindowMissed(ctx, marketID, oracle)
+	}
+}
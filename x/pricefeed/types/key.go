@@ -1,6 +1,10 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName The name that will be used throughout the module
@@ -14,6 +18,12 @@ const (
 
 	// DefaultParamspace default namestore
 	DefaultParamspace = ModuleName
+
+	// PortID is the port the module binds to receive oracle price packets
+	PortID = ModuleName
+
+	// Version defines the current version of the pricefeed IBC oracle protocol
+	Version = "pricefeed-1"
 )
 
 var (
@@ -22,6 +32,18 @@ var (
 
 	// RawPriceFeedPrefix prefix for the raw pricefeed of an asset
 	RawPriceFeedPrefix = []byte{0x01}
+
+	// PriceObservationPrefix prefix for the price observations of an asset
+	PriceObservationPrefix = []byte{0x02}
+
+	// OracleStatisticsPrefix prefix for the posting statistics of an oracle
+	OracleStatisticsPrefix = []byte{0x03}
+
+	// LastGoodPricePrefix prefix for the last valid current price of an asset
+	LastGoodPricePrefix = []byte{0x04}
+
+	// HistoricalPricePrefix prefix for the historical prices of an asset
+	HistoricalPricePrefix = []byte{0x05}
 )
 
 // CurrentPriceKey returns the prefix for the current price
@@ -29,6 +51,11 @@ func CurrentPriceKey(marketID string) []byte {
 	return append(CurrentPricePrefix, []byte(marketID)...)
 }
 
+// LastGoodPriceKey returns the key for the last good price of a market
+func LastGoodPriceKey(marketID string) []byte {
+	return append(LastGoodPricePrefix, []byte(marketID)...)
+}
+
 // RawPriceIteratorKey returns the prefix for the raw price for a single market
 func RawPriceIteratorKey(marketID string) []byte {
 	return append(
@@ -45,6 +72,54 @@ func RawPriceKey(marketID string, oracleAddr sdk.AccAddress) []byte {
 	)
 }
 
+// PriceObservationIteratorKey returns the prefix for the price observations of a single market
+func PriceObservationIteratorKey(marketID string) []byte {
+	return append(
+		PriceObservationPrefix,
+		lengthPrefixWithByte([]byte(marketID))...,
+	)
+}
+
+// PriceObservationKey returns the key for the price observation of a market at a time
+func PriceObservationKey(marketID string, t time.Time) []byte {
+	return append(
+		PriceObservationIteratorKey(marketID),
+		sdk.FormatTimeBytes(t)...,
+	)
+}
+
+// HistoricalPriceIteratorKey returns the prefix for the historical prices of a single market
+func HistoricalPriceIteratorKey(marketID string) []byte {
+	return append(
+		HistoricalPricePrefix,
+		lengthPrefixWithByte([]byte(marketID))...,
+	)
+}
+
+// HistoricalPriceKey returns the key for the historical price of a market at a block height
+func HistoricalPriceKey(marketID string, height int64) []byte {
+	return append(
+		HistoricalPriceIteratorKey(marketID),
+		sdk.Uint64ToBigEndian(uint64(height))...,
+	)
+}
+
+// OracleStatisticsIteratorKey returns the prefix for the oracle statistics of a single market
+func OracleStatisticsIteratorKey(marketID string) []byte {
+	return append(
+		OracleStatisticsPrefix,
+		lengthPrefixWithByte([]byte(marketID))...,
+	)
+}
+
+// OracleStatisticsKey returns the key for the statistics of an oracle for a market
+func OracleStatisticsKey(marketID string, oracleAddr sdk.AccAddress) []byte {
+	return append(
+		OracleStatisticsIteratorKey(marketID),
+		lengthPrefixWithByte(oracleAddr)...,
+	)
+}
+
 // lengthPrefixWithByte returns the input bytes prefixes with one byte containing its length.
 // It panics if the input is greater than 255 in length.
 func lengthPrefixWithByte(bz []byte) []byte {
@@ -41,12 +41,39 @@ func (m Market) Validate() error {
 		}
 		seenOracles[oracle.String()] = true
 	}
+	if _, ok := OracleWeighting_name[int32(m.OracleWeighting)]; !ok {
+		return fmt.Errorf("invalid oracle weighting %d", m.OracleWeighting)
+	}
+	if m.OracleWeighting == ORACLE_WEIGHTING_CONFIGURED && len(m.OracleWeights) == 0 {
+		return errors.New("oracle weights cannot be empty for configured oracle weighting")
+	}
+	seenWeights := make(map[string]bool)
+	for i, ow := range m.OracleWeights {
+		if len(ow.OracleAddress) == 0 {
+			return fmt.Errorf("oracle weight %d address is empty", i)
+		}
+		if ow.Weight == 0 {
+			return fmt.Errorf("oracle weight for %s must be positive", ow.OracleAddress)
+		}
+		if seenWeights[ow.OracleAddress.String()] {
+			return fmt.Errorf("duplicated oracle weight %s", ow.OracleAddress)
+		}
+		seenWeights[ow.OracleAddress.String()] = true
+	}
 	return nil
 }
 
+// HasOracleQuorum returns true if the number of unexpired prices is enough to set the market's current price
+func (m Market) HasOracleQuorum(priceCount int) bool {
+	return priceCount > 0 && priceCount >= int(m.MinOracles)
+}
+
 // ToMarketResponse returns a new MarketResponse from a Market
 func (m Market) ToMarketResponse() MarketResponse {
-	return NewMarketResponse(m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active)
+	response := NewMarketResponse(m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active)
+	response.MinOracles = m.MinOracles
+	response.OracleWeighting = m.OracleWeighting
+	return response
 }
 
 // Markets is a slice of Market
@@ -103,6 +130,11 @@ func NewCurrentPriceResponse(marketID string, price sdk.Dec) CurrentPriceRespons
 // CurrentPriceResponses is a slice of CurrentPriceResponse
 type CurrentPriceResponses []CurrentPriceResponse
 
+// NewLastGoodPrice returns a new LastGoodPrice
+func NewLastGoodPrice(marketID string, price sdk.Dec, updatedAt time.Time) LastGoodPrice {
+	return LastGoodPrice{MarketID: marketID, Price: price, UpdatedAt: updatedAt}
+}
+
 // NewPostedPrice returns a new PostedPrice
 func NewPostedPrice(marketID string, oracle sdk.AccAddress, price sdk.Dec, expiry time.Time) PostedPrice {
 	return PostedPrice{
@@ -170,3 +202,36 @@ type SortDecs []sdk.Dec
 func (a SortDecs) Len() int           { return len(a) }
 func (a SortDecs) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (a SortDecs) Less(i, j int) bool { return a[i].LT(a[j]) }
+
+// NewHistoricalPrice returns a new HistoricalPrice
+func NewHistoricalPrice(marketID string, height int64, t time.Time, price sdk.Dec) HistoricalPrice {
+	return HistoricalPrice{
+		MarketID: marketID,
+		Height:   height,
+		Time:     t,
+		Price:    price,
+	}
+}
+
+// HistoricalPrices is a slice of HistoricalPrice
+type HistoricalPrices []HistoricalPrice
+
+// NewPriceObservation returns a new PriceObservation
+func NewPriceObservation(marketID string, price sdk.Dec, t time.Time, cumulativePrice sdk.Dec) PriceObservation {
+	return PriceObservation{
+		MarketID:        marketID,
+		Price:           price,
+		Time:            t,
+		CumulativePrice: cumulativePrice,
+	}
+}
+
+// CumulativePriceAt returns the cumulative price at a time after the observation, assuming the price is unchanged
+// since the observation.
+func (po PriceObservation) CumulativePriceAt(t time.Time) sdk.Dec {
+	seconds := sdk.NewDecWithPrec(t.Sub(po.Time).Milliseconds(), 3)
+	return po.CumulativePrice.Add(po.Price.Mul(seconds))
+}
+
+// PriceObservations is a slice of PriceObservation
+type PriceObservations []PriceObservation
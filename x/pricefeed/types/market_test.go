@@ -78,6 +78,60 @@ func TestMarketValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid configured oracle weights",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				Oracles:         []sdk.AccAddress{addr},
+				OracleWeighting: ORACLE_WEIGHTING_CONFIGURED,
+				OracleWeights:   []OracleWeight{{OracleAddress: addr, Weight: 10}},
+			},
+			true,
+		},
+		{
+			"invalid oracle weighting",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				OracleWeighting: OracleWeighting(3),
+			},
+			false,
+		},
+		{
+			"empty configured oracle weights",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				OracleWeighting: ORACLE_WEIGHTING_CONFIGURED,
+			},
+			false,
+		},
+		{
+			"zero oracle weight",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				OracleWeighting: ORACLE_WEIGHTING_CONFIGURED,
+				OracleWeights:   []OracleWeight{{OracleAddress: addr, Weight: 0}},
+			},
+			false,
+		},
+		{
+			"duplicated oracle weight",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				OracleWeighting: ORACLE_WEIGHTING_CONFIGURED,
+				OracleWeights:   []OracleWeight{{OracleAddress: addr, Weight: 1}, {OracleAddress: addr, Weight: 2}},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -14,13 +14,18 @@ import (
 const (
 	// TypeMsgPostPrice type of PostPrice msg
 	TypeMsgPostPrice = "post_price"
+	// TypeMsgPostPrices type of PostPrices msg
+	TypeMsgPostPrices = "post_prices"
 
 	// MaxExpiry defines the max expiry time defined as UNIX time (9999-12-31 23:59:59 +0000 UTC)
 	MaxExpiry = 253402300799
 )
 
 // ensure Msg interface compliance at compile time
-var _ sdk.Msg = &MsgPostPrice{}
+var (
+	_ sdk.Msg = &MsgPostPrice{}
+	_ sdk.Msg = &MsgPostPrices{}
+)
 
 // NewMsgPostPrice returns a new MsgPostPrice
 func NewMsgPostPrice(from string, marketID string, price sdk.Dec, expiry time.Time) *MsgPostPrice {
@@ -69,3 +74,81 @@ func (msg MsgPostPrice) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewPriceInput returns a new PriceInput
+func NewPriceInput(marketID string, price sdk.Dec, expiry time.Time) PriceInput {
+	return PriceInput{
+		MarketID: marketID,
+		Price:    price,
+		Expiry:   expiry,
+	}
+}
+
+// Validate performs a basic validation of the price input fields.
+func (pi PriceInput) Validate() error {
+	if strings.TrimSpace(pi.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if pi.Price.IsNil() || pi.Price.IsNegative() {
+		return fmt.Errorf("price cannot be negative: %s", pi.Price)
+	}
+	if pi.Expiry.Unix() <= 0 {
+		return errors.New("must set an expiration time")
+	}
+	return nil
+}
+
+// NewMsgPostPrices returns a new MsgPostPrices
+func NewMsgPostPrices(from string, prices []PriceInput) *MsgPostPrices {
+	return &MsgPostPrices{
+		From:   from,
+		Prices: prices,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgPostPrices) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgPostPrices) Type() string { return TypeMsgPostPrices }
+
+// GetSignBytes Implements Msg.
+func (msg MsgPostPrices) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgPostPrices) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgPostPrices) ValidateBasic() error {
+	if len(msg.From) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	return validatePriceInputs(msg.Prices)
+}
+
+// validatePriceInputs checks that prices are not empty, are valid and have at most one price per market.
+func validatePriceInputs(prices []PriceInput) error {
+	if len(prices) == 0 {
+		return errorsmod.Wrap(ErrEmptyInput, "prices cannot be empty")
+	}
+	seenMarkets := make(map[string]bool)
+	for _, pi := range prices {
+		if err := pi.Validate(); err != nil {
+			return err
+		}
+		if seenMarkets[pi.MarketID] {
+			return fmt.Errorf("duplicate market id: %s", pi.MarketID)
+		}
+		seenMarkets[pi.MarketID] = true
+	}
+	return nil
+}
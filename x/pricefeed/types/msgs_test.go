@@ -36,3 +36,32 @@ func TestMsgPlaceBid_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgPostPrices_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("someName"))
+	price, _ := sdk.NewDecFromStr("0.3005")
+	expiry := tmtime.Now()
+	negativePrice, _ := sdk.NewDecFromStr("-3.05")
+
+	tests := []struct {
+		name       string
+		msg        MsgPostPrices
+		expectPass bool
+	}{
+		{"normal", MsgPostPrices{addr.String(), []PriceInput{{"xrp", price, expiry}, {"bnb", price, expiry}}}, true},
+		{"emptyAddr", MsgPostPrices{"", []PriceInput{{"xrp", price, expiry}}}, false},
+		{"emptyPrices", MsgPostPrices{addr.String(), nil}, false},
+		{"emptyAsset", MsgPostPrices{addr.String(), []PriceInput{{"xrp", price, expiry}, {"", price, expiry}}}, false},
+		{"negativePrice", MsgPostPrices{addr.String(), []PriceInput{{"xrp", negativePrice, expiry}}}, false},
+		{"duplicateMarket", MsgPostPrices{addr.String(), []PriceInput{{"xrp", price, expiry}, {"xrp", price, expiry}}}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.Nil(t, tc.msg.ValidateBasic())
+			} else {
+				require.NotNil(t, tc.msg.ValidateBasic())
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewOracleStatistics returns new OracleStatistics without any windows
+func NewOracleStatistics(marketID string, oracle sdk.AccAddress) OracleStatistics {
+	return OracleStatistics{
+		MarketID:            marketID,
+		OracleAddress:       oracle,
+		CumulativeDeviation: sdk.ZeroDec(),
+		LastDeviation:       sdk.ZeroDec(),
+	}
+}
+
+// MeanDeviation returns the mean absolute deviation of the oracle's included prices from the median
+func (os OracleStatistics) MeanDeviation() sdk.Dec {
+	if os.IncludedWindows == 0 {
+		return sdk.ZeroDec()
+	}
+	return os.CumulativeDeviation.QuoInt64(int64(os.IncludedWindows))
+}
+
+// Validate performs a basic check of OracleStatistics
+func (os OracleStatistics) Validate() error {
+	if strings.TrimSpace(os.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if len(os.OracleAddress) == 0 {
+		return errors.New("oracle address cannot be empty")
+	}
+	if os.CumulativeDeviation.IsNil() || os.CumulativeDeviation.IsNegative() {
+		return fmt.Errorf("cumulative deviation cannot be negative %s", os.CumulativeDeviation)
+	}
+	if os.LastDeviation.IsNil() || os.LastDeviation.IsNegative() {
+		return fmt.Errorf("last deviation cannot be negative %s", os.LastDeviation)
+	}
+	return nil
+}
+
+// ToOracleStatisticsResponse returns the response type of the statistics
+func (os OracleStatistics) ToOracleStatisticsResponse() OracleStatisticsResponse {
+	return OracleStatisticsResponse{
+		MarketID:        os.MarketID,
+		OracleAddress:   os.OracleAddress.String(),
+		IncludedWindows: os.IncludedWindows,
+		MissedWindows:   os.MissedWindows,
+		MeanDeviation:   os.MeanDeviation(),
+		LastDeviation:   os.LastDeviation,
+	}
+}
+
+// OracleStatisticsList is a slice of OracleStatistics
+type OracleStatisticsList []OracleStatistics
+
+// Validate checks if all the oracle statistics are valid and there are no duplicated entries.
+func (osl OracleStatisticsList) Validate() error {
+	seenStatistics := make(map[string]bool)
+	for _, os := range osl {
+		if err := os.Validate(); err != nil {
+			return err
+		}
+		if seenStatistics[os.MarketID+os.OracleAddress.String()] {
+			return fmt.Errorf("duplicated oracle statistics for market id %s and oracle address %s", os.MarketID, os.OracleAddress)
+		}
+		seenStatistics[os.MarketID+os.OracleAddress.String()] = true
+	}
+	return nil
+}
+
+// OracleStatisticsResponses is a slice of OracleStatisticsResponse
+type OracleStatisticsResponses []OracleStatisticsResponse
@@ -0,0 +1,49 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// IBCOracleName is the name of the module account that posts the prices received in oracle price packets
+const IBCOracleName = "pricefeed_ibc_oracle"
+
+var (
+	// IBCOracleAddress is the oracle address of prices received in oracle price packets
+	IBCOracleAddress = authtypes.NewModuleAddress(IBCOracleName)
+
+	// packetCdc encodes oracle price packets as proto JSON, as other IBC applications do
+	packetCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+)
+
+// NewOraclePricePacketData returns a new OraclePricePacketData
+func NewOraclePricePacketData(prices []PriceInput) OraclePricePacketData {
+	return OraclePricePacketData{
+		Prices: prices,
+	}
+}
+
+// ParseOraclePricePacketData decodes the JSON data of an oracle price packet
+func ParseOraclePricePacketData(bz []byte) (OraclePricePacketData, error) {
+	var data OraclePricePacketData
+	if err := packetCdc.UnmarshalJSON(bz, &data); err != nil {
+		return OraclePricePacketData{}, errorsmod.Wrap(ErrInvalidPacket, err.Error())
+	}
+	return data, nil
+}
+
+// ValidateBasic performs a basic validation of the packet prices
+func (pd OraclePricePacketData) ValidateBasic() error {
+	if err := validatePriceInputs(pd.Prices); err != nil {
+		return errorsmod.Wrap(ErrInvalidPacket, err.Error())
+	}
+	return nil
+}
+
+// GetBytes returns the JSON encoding of the packet data
+func (pd OraclePricePacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(packetCdc.MustMarshalJSON(&pd))
+}
@@ -2,20 +2,37 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 )
 
 // Parameter keys
 var (
-	KeyMarkets     = []byte("Markets")
-	DefaultMarkets = []Market{}
+	KeyMarkets                   = []byte("Markets")
+	KeyTwapWindow                = []byte("TwapWindow")
+	KeyDerivedMarkets            = []byte("DerivedMarkets")
+	KeyIBCOracleChannels         = []byte("IBCOracleChannels")
+	KeyStalePriceGracePeriod     = []byte("StalePriceGracePeriod")
+	KeyPriceHistoryRetention     = []byte("PriceHistoryRetention")
+	DefaultMarkets               = []Market{}
+	DefaultTwapWindow            = time.Duration(0)
+	DefaultDerivedMarkets        = []DerivedMarket{}
+	DefaultIBCOracleChannels     = []string{}
+	DefaultStalePriceGracePeriod = time.Duration(0)
+	DefaultPriceHistoryRetention = uint64(0)
 )
 
 // NewParams creates a new AssetParams object
 func NewParams(markets []Market) Params {
 	return Params{
-		Markets: markets,
+		Markets:               markets,
+		TwapWindow:            DefaultTwapWindow,
+		DerivedMarkets:        DefaultDerivedMarkets,
+		IBCOracleChannels:     DefaultIBCOracleChannels,
+		StalePriceGracePeriod: DefaultStalePriceGracePeriod,
+		PriceHistoryRetention: DefaultPriceHistoryRetention,
 	}
 }
 
@@ -34,12 +51,35 @@ func ParamKeyTable() paramtypes.KeyTable {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMarkets, &p.Markets, validateMarketParams),
+		paramtypes.NewParamSetPair(KeyTwapWindow, &p.TwapWindow, validateTwapWindowParam),
+		paramtypes.NewParamSetPair(KeyDerivedMarkets, &p.DerivedMarkets, validateDerivedMarketsParam),
+		paramtypes.NewParamSetPair(KeyIBCOracleChannels, &p.IBCOracleChannels, validateIBCOracleChannelsParam),
+		paramtypes.NewParamSetPair(KeyStalePriceGracePeriod, &p.StalePriceGracePeriod, validateStalePriceGracePeriodParam),
+		paramtypes.NewParamSetPair(KeyPriceHistoryRetention, &p.PriceHistoryRetention, validatePriceHistoryRetentionParam),
 	}
 }
 
 // Validate ensure that params have valid values
 func (p Params) Validate() error {
-	return validateMarketParams(p.Markets)
+	if err := validateMarketParams(p.Markets); err != nil {
+		return err
+	}
+	if err := validateTwapWindowParam(p.TwapWindow); err != nil {
+		return err
+	}
+	if err := validateDerivedMarketsParam(p.DerivedMarkets); err != nil {
+		return err
+	}
+	if err := validateIBCOracleChannelsParam(p.IBCOracleChannels); err != nil {
+		return err
+	}
+	if err := validateStalePriceGracePeriodParam(p.StalePriceGracePeriod); err != nil {
+		return err
+	}
+	if err := validatePriceHistoryRetentionParam(p.PriceHistoryRetention); err != nil {
+		return err
+	}
+	return p.DerivedMarkets.validateComponents(p.Markets)
 }
 
 func validateMarketParams(i interface{}) error {
@@ -50,3 +90,66 @@ func validateMarketParams(i interface{}) error {
 
 	return markets.Validate()
 }
+
+func validateTwapWindowParam(i interface{}) error {
+	window, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if window < 0 {
+		return fmt.Errorf("twap window should not be negative: %s", window)
+	}
+
+	return nil
+}
+
+func validateDerivedMarketsParam(i interface{}) error {
+	derivedMarkets, ok := i.(DerivedMarkets)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return derivedMarkets.Validate()
+}
+
+func validateIBCOracleChannelsParam(i interface{}) error {
+	channels, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenChannels := make(map[string]bool)
+	for _, channel := range channels {
+		if err := host.ChannelIdentifierValidator(channel); err != nil {
+			return fmt.Errorf("invalid ibc oracle channel %s: %w", channel, err)
+		}
+		if seenChannels[channel] {
+			return fmt.Errorf("duplicated ibc oracle channel %s", channel)
+		}
+		seenChannels[channel] = true
+	}
+
+	return nil
+}
+
+func validateStalePriceGracePeriodParam(i interface{}) error {
+	period, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if period < 0 {
+		return fmt.Errorf("stale price grace period should not be negative: %s", period)
+	}
+
+	return nil
+}
+
+func validatePriceHistoryRetentionParam(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
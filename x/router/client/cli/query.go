@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/kava-labs/kava/x/router/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	routerQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the router module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmds := []*cobra.Command{
+		querySimulateRouteCmd(),
+		querySimulateMsgCmd(),
+	}
+
+	for _, cmd := range cmds {
+		flags.AddQueryFlagsToCmd(cmd)
+	}
+
+	routerQueryCmd.AddCommand(cmds...)
+
+	return routerQueryCmd
+}
+
+func querySimulateRouteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "simulate-route [sender] [route-file]",
+		Short: "simulate executing a route",
+		Long: `Execute the steps of a route against the current state without applying them, returning the sender's resulting
+balances, delegations and earn vault shares, or the error the route failed with.
+
+The route file uses the same format as the execute-route tx command.`,
+		Example: fmt.Sprintf(
+			`%s q %s simulate-route kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d route.json`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+			}
+
+			route, err := ParseRouteJSON(clientCtx.Codec, args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			route.Sender = args[0]
+			req, err := types.NewQuerySimulateRouteRequest(&route)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SimulateRoute(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func querySimulateMsgCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "simulate-msg [msg-file]",
+		Short: "simulate executing a router msg",
+		Long: `Execute a router msg against the current state without applying it, returning the signer's resulting
+balances, delegations and earn vault shares, or the error the msg failed with.
+
+The msg file contains a single router msg in JSON, including its type URL.`,
+		Example: fmt.Sprintf(
+			`%s q %s simulate-msg msg.json
+
+Where msg.json contains:
+{
+  "@type": "/kava.router.v1beta1.MsgDelegateMintDeposit",
+  "depositor": "kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d",
+  "validator": "kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd",
+  "amount": {
+    "denom": "ukava",
+    "amount": "1000000"
+  }
+}`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var msg sdk.Msg
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON(bz, &msg); err != nil {
+				return err
+			}
+
+			req, err := types.NewQuerySimulateRouteRequest(msg)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SimulateRoute(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
@@ -31,6 +31,8 @@ func GetTxCmd() *cobra.Command {
 		getCmdDelegateMintDeposit(),
 		getCmdWithdrawBurn(),
 		getCmdWithdrawBurnUndelegate(),
+		getCmdWithdrawAndRepay(),
+		getCmdExecuteRoute(),
 	}
 
 	for _, cmd := range cmds {
@@ -173,3 +175,79 @@ func getCmdWithdrawBurnUndelegate() *cobra.Command {
 		},
 	}
 }
+
+func getCmdWithdrawAndRepay() *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-and-repay [collateral-type] [amount]",
+		Short: "withdraws supplied funds from hard, then uses them to repay the debt of a cdp",
+		Example: fmt.Sprintf(
+			`%s tx %s withdraw-and-repay bnb-a 1000000usdx --from <key>`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawAndRepay(clientCtx.GetFromAddress(), args[0], amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdExecuteRoute() *cobra.Command {
+	return &cobra.Command{
+		Use:   "execute-route [route-file]",
+		Short: "executes an ordered list of actions atomically, failing if any step outputs less than its minimum",
+		Long: `Executes an ordered list of actions atomically, failing if any step outputs less than its minimum.
+
+Steps without an amount use the output of the previous step. The route file contains the steps as JSON:
+
+{
+  "steps": [
+    {
+      "action": "ROUTE_ACTION_TYPE_DELEGATE",
+      "validator": "kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd",
+      "amount": { "denom": "ukava", "amount": "10000000" }
+    },
+    {
+      "action": "ROUTE_ACTION_TYPE_MINT_DERIVATIVE",
+      "validator": "kavavaloper16lnfpgn6llvn4fstg5nfrljj6aaxyee9z59jqd"
+    },
+    {
+      "action": "ROUTE_ACTION_TYPE_EARN_DEPOSIT"
+    }
+  ]
+}`,
+		Example: fmt.Sprintf(
+			`%s tx %s execute-route route.json --from <key>`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			route, err := ParseRouteJSON(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExecuteRoute(clientCtx.GetFromAddress(), route.Steps)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
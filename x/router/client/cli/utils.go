@@ -0,0 +1,24 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/kava-labs/kava/x/router/types"
+)
+
+// ParseRouteJSON reads and parses the steps of a MsgExecuteRoute from a file.
+func ParseRouteJSON(cdc codec.JSONCodec, routeFile string) (types.MsgExecuteRoute, error) {
+	route := types.MsgExecuteRoute{}
+	contents, err := os.ReadFile(routeFile)
+	if err != nil {
+		return route, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &route); err != nil {
+		return route, err
+	}
+
+	return route, nil
+}
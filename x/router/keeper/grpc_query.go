@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kava-labs/kava/x/router/types"
+)
+
+type queryServer struct {
+	keeper Keeper
+}
+
+// NewQueryServerImpl creates a new server for handling gRPC queries.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return &queryServer{keeper: k}
+}
+
+var _ types.QueryServer = queryServer{}
+
+// SimulateRoute executes a router msg with the msg server in a cached context that is discarded, returning the signer's
+// balances and shares as they would be after the msg, or the error the msg failed with.
+func (s queryServer) SimulateRoute(
+	goCtx context.Context,
+	req *types.QuerySimulateRouteRequest,
+) (*types.QuerySimulateRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	msg := req.GetRouterMsg()
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "msg cannot be empty")
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	signer := msg.GetSigners()[0]
+
+	cacheCtx, _ := ctx.CacheContext()
+	outputs, err := s.executeMsg(cacheCtx, msg)
+
+	res := types.QuerySimulateRouteResponse{
+		Outputs: outputs,
+	}
+
+	// a failed msg is not applied, so the resulting state is the current state
+	resultCtx := cacheCtx
+	if err != nil {
+		resultCtx = ctx
+		res.Failed = true
+		res.Error = err.Error()
+	}
+
+	res.Balances = s.keeper.bankKeeper.GetAllBalances(resultCtx, signer)
+	res.Delegations = s.keeper.stakingKeeper.GetAllDelegatorDelegations(resultCtx, signer)
+	if shares, found := s.keeper.earnKeeper.GetVaultAccountShares(resultCtx, signer); found {
+		res.VaultShares = shares
+	}
+
+	return &res, nil
+}
+
+// executeMsg executes a router msg with the msg server, returning the outputs of an execute route msg.
+func (s queryServer) executeMsg(ctx sdk.Context, msg sdk.Msg) ([]sdk.Coin, error) {
+	msgServer := NewMsgServerImpl(s.keeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	var err error
+	switch msg := msg.(type) {
+	case *types.MsgMintDeposit:
+		_, err = msgServer.MintDeposit(goCtx, msg)
+	case *types.MsgDelegateMintDeposit:
+		_, err = msgServer.DelegateMintDeposit(goCtx, msg)
+	case *types.MsgWithdrawBurn:
+		_, err = msgServer.WithdrawBurn(goCtx, msg)
+	case *types.MsgWithdrawBurnUndelegate:
+		_, err = msgServer.WithdrawBurnUndelegate(goCtx, msg)
+	case *types.MsgWithdrawAndRepay:
+		_, err = msgServer.WithdrawAndRepay(goCtx, msg)
+	case *types.MsgExecuteRoute:
+		res, err := msgServer.ExecuteRoute(goCtx, msg)
+		if err != nil {
+			return nil, err
+		}
+		return res.Outputs, nil
+	default:
+		err = errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized router msg type: %T", msg)
+	}
+	return nil, err
+}
@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kava-labs/kava/app"
+	earntypes "github.com/kava-labs/kava/x/earn/types"
+	"github.com/kava-labs/kava/x/router/keeper"
+	"github.com/kava-labs/kava/x/router/testutil"
+	"github.com/kava-labs/kava/x/router/types"
+)
+
+type grpcQueryTestSuite struct {
+	testutil.Suite
+
+	queryClient types.QueryClient
+}
+
+func (suite *grpcQueryTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.Ctx, suite.App.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper.NewQueryServerImpl(suite.Keeper))
+
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestGrpcQueryTestSuite(t *testing.T) {
+	suite.Run(t, new(grpcQueryTestSuite))
+}
+
+func (suite *grpcQueryTestSuite) TestSimulateRoute() {
+	user, valAddr, balance := suite.setupValidatorAndVault()
+	derivativeDenom := fmt.Sprintf("bkava-%s", valAddr)
+
+	res := suite.simulate(types.NewMsgExecuteRoute(user, []types.RouteStep{
+		types.NewRouteStep(types.ROUTE_ACTION_TYPE_DELEGATE, valAddr, suite.NewBondCoin(balance.QuoRaw(2)), sdk.Coin{}),
+		types.NewRouteStep(types.ROUTE_ACTION_TYPE_MINT_DERIVATIVE, valAddr, sdk.Coin{}, sdk.Coin{}),
+		types.NewRouteStep(types.ROUTE_ACTION_TYPE_EARN_DEPOSIT, nil, sdk.Coin{}, sdk.Coin{}),
+	}))
+
+	suite.False(res.Failed)
+	suite.Empty(res.Error)
+	suite.Equal([]sdk.Coin{
+		suite.NewBondCoin(balance.QuoRaw(2)),
+		sdk.NewCoin(derivativeDenom, balance.QuoRaw(2)),
+		sdk.NewCoin(derivativeDenom, balance.QuoRaw(2)),
+	}, res.Outputs)
+	suite.Equal(suite.NewBondCoins(balance.QuoRaw(2)), res.Balances)
+	// the delegation is converted to derivatives, leaving no shares with the user
+	suite.Empty(res.Delegations)
+	suite.Equal(
+		earntypes.NewVaultShares(earntypes.NewVaultShare(derivativeDenom, sdk.NewDecFromInt(balance.QuoRaw(2)))),
+		res.VaultShares,
+	)
+
+	// the simulation is not applied
+	suite.AccountBalanceEqual(user, suite.NewBondCoins(balance))
+	_, found := suite.EarnKeeper.GetVaultAccountShares(suite.Ctx, user)
+	suite.False(found)
+}
+
+func (suite *grpcQueryTestSuite) TestSimulateRoute_Failed() {
+	user, valAddr, balance := suite.setupValidatorAndVault()
+	delegated := sdkmath.NewInt(100e6)
+	suite.CreateDelegation(valAddr, user, delegated)
+
+	res := suite.simulate(types.NewMsgExecuteRoute(user, []types.RouteStep{
+		types.NewRouteStep(types.ROUTE_ACTION_TYPE_DELEGATE, valAddr, suite.NewBondCoin(delegated), sdk.Coin{}),
+		types.NewRouteStep(types.ROUTE_ACTION_TYPE_MINT_DERIVATIVE, valAddr, sdk.Coin{}, sdk.Coin{}),
+		types.NewRouteStep(types.ROUTE_ACTION_TYPE_DELEGATE, valAddr, sdk.Coin{}, sdk.Coin{}),
+	}))
+
+	suite.True(res.Failed)
+	suite.Contains(res.Error, "route step 2")
+	suite.Empty(res.Outputs)
+
+	// the failed route is not applied, so the current state is returned
+	suite.Equal(suite.NewBondCoins(balance.Sub(delegated)), res.Balances)
+	suite.Require().Len(res.Delegations, 1)
+	suite.Equal(sdk.NewDecFromInt(delegated), res.Delegations[0].Shares)
+	suite.Empty(res.VaultShares)
+}
+
+func (suite *grpcQueryTestSuite) TestSimulateRoute_DelegateMintDeposit() {
+	user, valAddr, balance := suite.setupValidatorAndVault()
+	derivativeDenom := fmt.Sprintf("bkava-%s", valAddr)
+
+	res := suite.simulate(types.NewMsgDelegateMintDeposit(user, valAddr, suite.NewBondCoin(balance.QuoRaw(2))))
+
+	suite.False(res.Failed)
+	suite.Empty(res.Error)
+	suite.Empty(res.Outputs)
+	suite.Equal(suite.NewBondCoins(balance.QuoRaw(2)), res.Balances)
+	suite.Empty(res.Delegations)
+	suite.Equal(
+		earntypes.NewVaultShares(earntypes.NewVaultShare(derivativeDenom, sdk.NewDecFromInt(balance.QuoRaw(2)))),
+		res.VaultShares,
+	)
+
+	// the simulation is not applied
+	suite.AccountBalanceEqual(user, suite.NewBondCoins(balance))
+	_, found := suite.EarnKeeper.GetVaultAccountShares(suite.Ctx, user)
+	suite.False(found)
+}
+
+func (suite *grpcQueryTestSuite) TestSimulateRoute_MintDepositFailed() {
+	user, valAddr, balance := suite.setupValidatorAndVault()
+
+	// the user has no delegation to mint derivatives from
+	res := suite.simulate(types.NewMsgMintDeposit(user, valAddr, suite.NewBondCoin(sdkmath.NewInt(1e6))))
+
+	suite.True(res.Failed)
+	suite.NotEmpty(res.Error)
+	suite.Empty(res.Outputs)
+	suite.Equal(suite.NewBondCoins(balance), res.Balances)
+	suite.Empty(res.Delegations)
+	suite.Empty(res.VaultShares)
+}
+
+func (suite *grpcQueryTestSuite) TestSimulateRoute_InvalidRequest() {
+	user, valAddr, _ := suite.setupValidatorAndVault()
+
+	testCases := []struct {
+		name string
+		req  *types.QuerySimulateRouteRequest
+	}{
+		{
+			name: "no msg",
+			req:  &types.QuerySimulateRouteRequest{},
+		},
+		{
+			name: "invalid sender",
+			req: &types.QuerySimulateRouteRequest{
+				Msg: &types.QuerySimulateRouteRequest_ExecuteRoute{ExecuteRoute: &types.MsgExecuteRoute{
+					Sender: "invalid",
+					Steps: []types.RouteStep{
+						types.NewRouteStep(types.ROUTE_ACTION_TYPE_DELEGATE, valAddr, suite.NewBondCoin(sdkmath.NewInt(1e6)), sdk.Coin{}),
+					},
+				}},
+			},
+		},
+		{
+			name: "no steps",
+			req: &types.QuerySimulateRouteRequest{
+				Msg: &types.QuerySimulateRouteRequest_ExecuteRoute{ExecuteRoute: types.NewMsgExecuteRoute(user, nil)},
+			},
+		},
+		{
+			name: "invalid step",
+			req: &types.QuerySimulateRouteRequest{
+				Msg: &types.QuerySimulateRouteRequest_ExecuteRoute{ExecuteRoute: types.NewMsgExecuteRoute(
+					user, []types.RouteStep{{Amount: suite.NewBondCoin(sdkmath.NewInt(1e6))}},
+				)},
+			},
+		},
+		{
+			name: "invalid delegate mint deposit",
+			req: &types.QuerySimulateRouteRequest{
+				Msg: &types.QuerySimulateRouteRequest_DelegateMintDeposit{DelegateMintDeposit: types.NewMsgDelegateMintDeposit(
+					user, valAddr, sdk.Coin{Denom: "ukava", Amount: sdkmath.NewInt(-1)},
+				)},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.queryClient.SimulateRoute(sdk.WrapSDKContext(suite.Ctx), tc.req)
+			suite.Require().Error(err)
+			suite.Equal(codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+// simulate queries the simulation of a router msg, requiring the query to succeed.
+func (suite *grpcQueryTestSuite) simulate(msg sdk.Msg) *types.QuerySimulateRouteResponse {
+	req, err := types.NewQuerySimulateRouteRequest(msg)
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.SimulateRoute(sdk.WrapSDKContext(suite.Ctx), req)
+	suite.Require().NoError(err)
+	return res
+}
+
+// setupValidatorAndVault creates a validator, a user with 1000 kava and a bkava earn vault.
+func (suite *grpcQueryTestSuite) setupValidatorAndVault() (sdk.AccAddress, sdk.ValAddress, sdkmath.Int) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	valAccAddr, user := addrs[0], addrs[1]
+	valAddr := sdk.ValAddress(valAccAddr)
+
+	balance := sdkmath.NewInt(1e9)
+
+	suite.CreateAccountWithAddress(valAccAddr, suite.NewBondCoins(balance))
+	suite.CreateAccountWithAddress(user, suite.NewBondCoins(balance))
+
+	suite.CreateNewUnbondedValidator(valAddr, balance)
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	suite.CreateVault("bkava", earntypes.StrategyTypes{earntypes.STRATEGY_TYPE_SAVINGS}, false, nil)
+	suite.SetSavingsSupportedDenoms([]string{fmt.Sprintf("bkava-%s", valAddr)})
+
+	return user, valAddr, balance
+}
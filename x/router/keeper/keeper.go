@@ -9,6 +9,10 @@ type Keeper struct {
 	earnKeeper    types.EarnKeeper
 	liquidKeeper  types.LiquidKeeper
 	stakingKeeper types.StakingKeeper
+	hardKeeper    types.HardKeeper
+	cdpKeeper     types.CdpKeeper
+	swapKeeper    types.SwapKeeper
+	bankKeeper    types.BankKeeper
 }
 
 // NewKeeper creates a new keeper
@@ -16,11 +20,19 @@ func NewKeeper(
 	earnKeeper types.EarnKeeper,
 	liquidKeeper types.LiquidKeeper,
 	stakingKeeper types.StakingKeeper,
+	hardKeeper types.HardKeeper,
+	cdpKeeper types.CdpKeeper,
+	swapKeeper types.SwapKeeper,
+	bankKeeper types.BankKeeper,
 ) Keeper {
 
 	return Keeper{
 		earnKeeper:    earnKeeper,
 		liquidKeeper:  liquidKeeper,
 		stakingKeeper: stakingKeeper,
+		hardKeeper:    hardKeeper,
+		cdpKeeper:     cdpKeeper,
+		swapKeeper:    swapKeeper,
+		bankKeeper:    bankKeeper,
 	}
 }
@@ -200,3 +200,63 @@ func (m msgServer) WithdrawBurnUndelegate(goCtx context.Context, msg *types.MsgW
 	})
 	return &types.MsgWithdrawBurnUndelegateResponse{}, nil
 }
+
+// WithdrawAndRepay withdraws supplied funds from hard, then uses them to repay the debt of a cdp.
+//
+// The withdrawal fails if it would leave the sender's hard borrows undercollateralized, and the repayment is validated
+// by the cdp module, so either both steps succeed or neither is applied.
+func (m msgServer) WithdrawAndRepay(goCtx context.Context, msg *types.MsgWithdrawAndRepay) (*types.MsgWithdrawAndRepayResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	// hard withdraws the entire deposit when the amount exceeds it, so only the withdrawn funds are repaid
+	balanceBefore := m.keeper.bankKeeper.GetBalance(ctx, sender, msg.Amount.Denom)
+	if err := m.keeper.hardKeeper.Withdraw(ctx, sender, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+	withdrawn := m.keeper.bankKeeper.GetBalance(ctx, sender, msg.Amount.Denom).Sub(balanceBefore)
+
+	if err := m.keeper.cdpKeeper.RepayPrincipal(ctx, sender, msg.CollateralType, withdrawn); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+	)
+
+	return &types.MsgWithdrawAndRepayResponse{}, nil
+}
+
+// ExecuteRoute executes an ordered list of actions, such as delegating, minting derivatives, depositing into earn
+// and swapping. The route fails without applying any step if a step errors or outputs less than its minimum output.
+func (m msgServer) ExecuteRoute(goCtx context.Context, msg *types.MsgExecuteRoute) (*types.MsgExecuteRouteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs, err := m.keeper.ExecuteRoute(ctx, sender, msg.Steps)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+	)
+
+	return &types.MsgExecuteRouteResponse{Outputs: outputs}, nil
+}
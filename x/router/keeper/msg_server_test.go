@@ -6,16 +6,23 @@ import (
 	"time"
 
 	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/kava-labs/kava/app"
+	communitytestutil "github.com/kava-labs/kava/x/community/testutil"
 	earntypes "github.com/kava-labs/kava/x/earn/types"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 	"github.com/kava-labs/kava/x/router/keeper"
 	"github.com/kava-labs/kava/x/router/testutil"
 	"github.com/kava-labs/kava/x/router/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
 
 type msgServerTestSuite struct {
@@ -264,6 +271,221 @@ func (suite *msgServerTestSuite) TestDelegateMintDepositAndWithdrawBurnUndelegat
 	suite.UnbondingDelegationInDeltaBelow(valAddr, user, userBalance, sdkmath.NewInt(2))
 }
 
+func (suite *msgServerTestSuite) TestWithdrawAndRepay() {
+	user := suite.setupLendAndCdp()
+
+	cdpKeeper := suite.App.GetCDPKeeper()
+	hardKeeper := suite.App.GetHardKeeper()
+
+	msg := types.NewMsgWithdrawAndRepay(user, "kava-a", sdk.NewInt64Coin("usdx", 30e6))
+	_, err := suite.msgServer.WithdrawAndRepay(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+
+	deposit, found := hardKeeper.GetDeposit(suite.Ctx, user)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("usdx", 20e6)), deposit.Amount)
+
+	cdp, found := cdpKeeper.GetCdpByOwnerAndCollateralType(suite.Ctx, user, "kava-a")
+	suite.Require().True(found)
+	suite.Equal(sdk.NewInt64Coin("usdx", 70e6), cdp.GetTotalPrincipal())
+
+	// the withdrawn funds are used for the repayment
+	suite.AccountBalanceOfEqual(user, "usdx", sdkmath.NewInt(50e6))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(),
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, user.String()),
+		),
+	)
+}
+
+func (suite *msgServerTestSuite) TestWithdrawAndRepay_ExceedsDeposit() {
+	user := suite.setupLendAndCdp()
+
+	// only the entire hard deposit is withdrawn and repaid
+	msg := types.NewMsgWithdrawAndRepay(user, "kava-a", sdk.NewInt64Coin("usdx", 60e6))
+	_, err := suite.msgServer.WithdrawAndRepay(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+
+	_, found := suite.App.GetHardKeeper().GetDeposit(suite.Ctx, user)
+	suite.False(found)
+
+	cdp, found := suite.App.GetCDPKeeper().GetCdpByOwnerAndCollateralType(suite.Ctx, user, "kava-a")
+	suite.Require().True(found)
+	suite.Equal(sdk.NewInt64Coin("usdx", 50e6), cdp.GetTotalPrincipal())
+
+	suite.AccountBalanceOfEqual(user, "usdx", sdkmath.NewInt(50e6))
+}
+
+func (suite *msgServerTestSuite) TestWithdrawAndRepay_Errors() {
+	testCases := []struct {
+		name           string
+		collateralType string
+		amount         sdk.Coin
+		borrow         sdk.Coins
+	}{
+		{
+			name:           "no hard deposit of withdrawn denom",
+			collateralType: "kava-a",
+			amount:         sdk.NewInt64Coin("ukava", 30e6),
+		},
+		{
+			name:           "withdraw leaves hard borrow undercollateralized",
+			collateralType: "kava-a",
+			amount:         sdk.NewInt64Coin("usdx", 30e6),
+			borrow:         sdk.NewCoins(sdk.NewInt64Coin("ukava", 25e6)),
+		},
+		{
+			name:           "cdp does not exist",
+			collateralType: "bnb-a",
+			amount:         sdk.NewInt64Coin("usdx", 30e6),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			user := suite.setupLendAndCdp()
+
+			if tc.borrow != nil {
+				err := suite.App.GetHardKeeper().Borrow(suite.Ctx, user, tc.borrow)
+				suite.Require().NoError(err)
+			}
+
+			msg := types.NewMsgWithdrawAndRepay(user, tc.collateralType, tc.amount)
+			_, err := suite.msgServer.WithdrawAndRepay(sdk.WrapSDKContext(suite.Ctx), msg)
+			suite.Require().Error(err)
+		})
+	}
+}
+
+func (suite *msgServerTestSuite) TestExecuteRoute_DelegateMintDeposit() {
+	user, valAddr, balance := suite.setupValidator()
+	derivativeDenom := suite.setupEarnForDeposits(valAddr)
+
+	msg := types.NewMsgExecuteRoute(user, []types.RouteStep{
+		types.NewRouteStep(types.ROUTE_ACTION_TYPE_DELEGATE, valAddr, suite.NewBondCoin(balance), sdk.Coin{}),
+		types.NewRouteStep(types.ROUTE_ACTION_TYPE_MINT_DERIVATIVE, valAddr, sdk.Coin{}, sdk.NewCoin(derivativeDenom, balance)),
+		types.NewRouteStep(types.ROUTE_ACTION_TYPE_EARN_DEPOSIT, nil, sdk.Coin{}, sdk.Coin{}),
+	})
+	res, err := suite.msgServer.ExecuteRoute(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+
+	suite.Equal([]sdk.Coin{
+		suite.NewBondCoin(balance),
+		sdk.NewCoin(derivativeDenom, balance),
+		sdk.NewCoin(derivativeDenom, balance),
+	}, res.Outputs)
+
+	suite.AccountBalanceOfEqual(user, suite.StakingKeeper.BondDenom(suite.Ctx), sdk.ZeroInt())
+	suite.AccountBalanceOfEqual(user, derivativeDenom, sdk.ZeroInt())
+	suite.DelegationSharesEqual(valAddr, user, sdk.ZeroDec())
+	suite.VaultAccountValueEqual(user, sdk.NewCoin(derivativeDenom, balance))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(),
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, user.String()),
+		),
+	)
+	suite.EventsContains(suite.Ctx.EventManager().Events(),
+		sdk.NewEvent(
+			stakingtypes.EventTypeDelegate,
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, suite.NewBondCoin(balance).String()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyNewShares, sdk.NewDecFromInt(balance).String()),
+		),
+	)
+}
+
+func (suite *msgServerTestSuite) TestExecuteRoute_Swap() {
+	user := suite.setupSwapPool()
+
+	minOutput := sdk.NewInt64Coin("usdx", 1e6)
+	msg := types.NewMsgExecuteRoute(user, []types.RouteStep{
+		types.NewRouteStep(types.ROUTE_ACTION_TYPE_SWAP, nil, sdk.NewInt64Coin("ukava", 10e6), minOutput),
+	})
+	res, err := suite.msgServer.ExecuteRoute(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+
+	suite.Require().Len(res.Outputs, 1)
+	suite.Equal("usdx", res.Outputs[0].Denom)
+	suite.True(res.Outputs[0].IsGTE(minOutput))
+
+	suite.AccountBalanceOfEqual(user, "ukava", sdkmath.NewInt(90e6))
+	suite.AccountBalanceOfEqual(user, "usdx", res.Outputs[0].Amount)
+}
+
+func (suite *msgServerTestSuite) TestExecuteRoute_Errors() {
+	testCases := []struct {
+		name        string
+		steps       func(valAddr sdk.ValAddress, derivativeDenom string, balance sdkmath.Int) []types.RouteStep
+		expectedErr error
+	}{
+		{
+			name: "guard fails on a later step",
+			steps: func(valAddr sdk.ValAddress, derivativeDenom string, balance sdkmath.Int) []types.RouteStep {
+				return []types.RouteStep{
+					types.NewRouteStep(types.ROUTE_ACTION_TYPE_DELEGATE, valAddr, suite.NewBondCoin(balance), sdk.Coin{}),
+					types.NewRouteStep(types.ROUTE_ACTION_TYPE_MINT_DERIVATIVE, valAddr, sdk.Coin{}, sdk.NewCoin(derivativeDenom, balance.AddRaw(1))),
+					types.NewRouteStep(types.ROUTE_ACTION_TYPE_EARN_DEPOSIT, nil, sdk.Coin{}, sdk.Coin{}),
+				}
+			},
+			expectedErr: types.ErrRouteGuardFailed,
+		},
+		{
+			name: "guard denom does not match output",
+			steps: func(valAddr sdk.ValAddress, derivativeDenom string, balance sdkmath.Int) []types.RouteStep {
+				return []types.RouteStep{
+					types.NewRouteStep(types.ROUTE_ACTION_TYPE_DELEGATE, valAddr, suite.NewBondCoin(balance), sdk.NewInt64Coin("usdx", 1)),
+				}
+			},
+			expectedErr: types.ErrRouteGuardFailed,
+		},
+		{
+			name: "delegating non bond denom",
+			steps: func(valAddr sdk.ValAddress, derivativeDenom string, balance sdkmath.Int) []types.RouteStep {
+				return []types.RouteStep{
+					types.NewRouteStep(types.ROUTE_ACTION_TYPE_DELEGATE, valAddr, suite.NewBondCoin(balance), sdk.Coin{}),
+					types.NewRouteStep(types.ROUTE_ACTION_TYPE_MINT_DERIVATIVE, valAddr, sdk.Coin{}, sdk.Coin{}),
+					types.NewRouteStep(types.ROUTE_ACTION_TYPE_DELEGATE, valAddr, sdk.Coin{}, sdk.Coin{}),
+				}
+			},
+			expectedErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "final step fails",
+			steps: func(valAddr sdk.ValAddress, derivativeDenom string, balance sdkmath.Int) []types.RouteStep {
+				return []types.RouteStep{
+					types.NewRouteStep(types.ROUTE_ACTION_TYPE_DELEGATE, valAddr, suite.NewBondCoin(balance), sdk.Coin{}),
+					types.NewRouteStep(types.ROUTE_ACTION_TYPE_MINT_DERIVATIVE, valAddr, sdk.Coin{}, sdk.Coin{}),
+					types.NewRouteStep(types.ROUTE_ACTION_TYPE_EARN_DEPOSIT, nil, sdk.NewCoin(derivativeDenom, balance.AddRaw(1)), sdk.Coin{}),
+				}
+			},
+			expectedErr: sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			user, valAddr, balance := suite.setupValidator()
+			derivativeDenom := suite.setupEarnForDeposits(valAddr)
+
+			msg := types.NewMsgExecuteRoute(user, tc.steps(valAddr, derivativeDenom, balance))
+			_, err := suite.msgServer.ExecuteRoute(sdk.WrapSDKContext(suite.Ctx), msg)
+			suite.Require().ErrorIs(err, tc.expectedErr)
+
+			// no step of the route is applied
+			suite.AccountBalanceOfEqual(user, suite.StakingKeeper.BondDenom(suite.Ctx), balance)
+			suite.AccountBalanceOfEqual(user, derivativeDenom, sdk.ZeroInt())
+			_, found := suite.StakingKeeper.GetDelegation(suite.Ctx, user, valAddr)
+			suite.False(found)
+		})
+	}
+}
+
 func (suite *msgServerTestSuite) setupValidator() (sdk.AccAddress, sdk.ValAddress, sdkmath.Int) {
 	_, addrs := app.GeneratePrivKeyAddressPairs(5)
 	valAccAddr, user := addrs[0], addrs[1]
@@ -320,3 +542,68 @@ func (suite *msgServerTestSuite) setupDerivatives() (sdk.AccAddress, sdk.ValAddr
 
 	return user, valAddr, derivatives
 }
+
+// setupLendAndCdp re-initializes the app with hard and cdp markets and returns a user with a kava-a cdp owing
+// 100 usdx and 50 usdx supplied to hard.
+func (suite *msgServerTestSuite) setupLendAndCdp() sdk.AccAddress {
+	genTime := tmtime.Now()
+
+	hardGS, pricefeedGS := communitytestutil.NewLendGenesisBuilder().
+		WithMarket("ukava", "kava:usd", sdk.OneDec()).
+		WithMarket("usdx", "usdx:usd", sdk.OneDec()).
+		Build()
+
+	tApp := app.NewTestApp()
+	tApp.InitializeFromGenesisStatesWithTime(
+		genTime,
+		app.GenesisState{hardtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)},
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		communitytestutil.NewCDPGenState(tApp.AppCodec(), "ukava", "kava", sdk.NewDec(2)),
+	)
+
+	suite.App = tApp
+	suite.Ctx = tApp.NewContext(true, tmproto.Header{Height: 1, Time: genTime})
+	suite.Keeper = tApp.GetRouterKeeper()
+	suite.BankKeeper = tApp.GetBankKeeper()
+	suite.StakingKeeper = tApp.GetStakingKeeper()
+	suite.EarnKeeper = tApp.GetEarnKeeper()
+	suite.msgServer = keeper.NewMsgServerImpl(suite.Keeper)
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	user, supplier := addrs[0], addrs[1]
+	suite.CreateAccountWithAddress(user, suite.NewBondCoins(sdkmath.NewInt(1e9)))
+	suite.CreateAccountWithAddress(supplier, suite.NewBondCoins(sdkmath.NewInt(1e9)))
+
+	// supply hard with ukava that can be borrowed
+	err := tApp.GetHardKeeper().Deposit(suite.Ctx, supplier, suite.NewBondCoins(sdkmath.NewInt(1e9)))
+	suite.Require().NoError(err)
+
+	err = tApp.GetCDPKeeper().AddCdp(suite.Ctx, user, sdk.NewInt64Coin("ukava", 400e6), sdk.NewInt64Coin("usdx", 100e6), "kava-a")
+	suite.Require().NoError(err)
+
+	err = tApp.GetHardKeeper().Deposit(suite.Ctx, user, sdk.NewCoins(sdk.NewInt64Coin("usdx", 50e6)))
+	suite.Require().NoError(err)
+
+	return user
+}
+
+// setupSwapPool creates a ukava:usdx swap pool and returns a user holding 100 kava.
+func (suite *msgServerTestSuite) setupSwapPool() sdk.AccAddress {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	provider, user := addrs[0], addrs[1]
+
+	swapKeeper := suite.App.GetSwapKeeper()
+	swapKeeper.SetParams(suite.Ctx, swaptypes.NewParams(
+		swaptypes.NewAllowedPools(swaptypes.NewAllowedPool("ukava", "usdx")),
+		sdk.ZeroDec(),
+		swaptypes.DefaultTwapMaxWindowSeconds,
+	))
+
+	suite.CreateAccountWithAddress(provider, sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e12), sdk.NewInt64Coin("usdx", 1e12)))
+	suite.CreateAccountWithAddress(user, sdk.NewCoins(sdk.NewInt64Coin("ukava", 100e6)))
+
+	err := swapKeeper.Deposit(suite.Ctx, provider, sdk.NewInt64Coin("ukava", 1e12), sdk.NewInt64Coin("usdx", 1e12), sdk.ZeroDec())
+	suite.Require().NoError(err)
+
+	return user
+}
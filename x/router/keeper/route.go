@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	earntypes "github.com/kava-labs/kava/x/earn/types"
+	"github.com/kava-labs/kava/x/router/types"
+)
+
+// ExecuteRoute executes the steps of a route in order, returning the output of each step.
+//
+// Steps without an amount use the output of the previous step as their input. State changes are only written if
+// every step succeeds and passes its guard.
+func (k Keeper) ExecuteRoute(ctx sdk.Context, sender sdk.AccAddress, steps []types.RouteStep) ([]sdk.Coin, error) {
+	cacheCtx, write := ctx.CacheContext()
+
+	outputs, err := k.executeRoute(cacheCtx, sender, steps)
+	if err != nil {
+		return nil, err
+	}
+
+	write()
+	return outputs, nil
+}
+
+// executeRoute executes the steps of a route without caching state changes. If a step fails, the outputs of the
+// steps executed before it are returned with the error.
+func (k Keeper) executeRoute(ctx sdk.Context, sender sdk.AccAddress, steps []types.RouteStep) ([]sdk.Coin, error) {
+	outputs := make([]sdk.Coin, 0, len(steps))
+
+	var previous sdk.Coin
+	for i, step := range steps {
+		input := step.Amount
+		if !step.HasAmount() {
+			if i == 0 {
+				return outputs, errorsmod.Wrap(types.ErrInvalidRoute, "first step must set an amount")
+			}
+			input = previous
+		}
+
+		output, err := k.executeRouteStep(ctx, sender, step, input)
+		if err != nil {
+			return outputs, errorsmod.Wrapf(err, "route step %d", i)
+		}
+
+		if step.HasMinOutput() && (output.Denom != step.MinOutput.Denom || output.Amount.LT(step.MinOutput.Amount)) {
+			return outputs, errorsmod.Wrapf(
+				types.ErrRouteGuardFailed, "route step %d: got %s, expected at least %s", i, output, step.MinOutput,
+			)
+		}
+
+		outputs = append(outputs, output)
+		previous = output
+	}
+
+	return outputs, nil
+}
+
+// executeRouteStep executes a single route step with an input, returning the coins received by the sender.
+func (k Keeper) executeRouteStep(ctx sdk.Context, sender sdk.AccAddress, step types.RouteStep, input sdk.Coin) (sdk.Coin, error) {
+	switch step.Action {
+	case types.ROUTE_ACTION_TYPE_DELEGATE:
+		valAddr, err := sdk.ValAddressFromBech32(step.Validator)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			return sdk.Coin{}, stakingtypes.ErrNoValidatorFound
+		}
+		bondDenom := k.stakingKeeper.BondDenom(ctx)
+		if input.Denom != bondDenom {
+			return sdk.Coin{}, errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", input.Denom, bondDenom,
+			)
+		}
+		newShares, err := k.stakingKeeper.Delegate(ctx, sender, input.Amount, stakingtypes.Unbonded, validator, true)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				stakingtypes.EventTypeDelegate,
+				sdk.NewAttribute(stakingtypes.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, input.String()),
+				sdk.NewAttribute(stakingtypes.AttributeKeyNewShares, newShares.String()),
+			),
+		)
+		// the delegated tokens are the input of a following mint step
+		return input, nil
+
+	case types.ROUTE_ACTION_TYPE_MINT_DERIVATIVE:
+		valAddr, err := sdk.ValAddressFromBech32(step.Validator)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		return k.liquidKeeper.MintDerivative(ctx, sender, valAddr, input)
+
+	case types.ROUTE_ACTION_TYPE_EARN_DEPOSIT:
+		if err := k.earnKeeper.Deposit(ctx, sender, input, earntypes.STRATEGY_TYPE_SAVINGS); err != nil {
+			return sdk.Coin{}, err
+		}
+		return input, nil
+
+	case types.ROUTE_ACTION_TYPE_SWAP:
+		// the swap module checks slippage against the expected output, so the minimum output is used as the
+		// expected output with no slippage allowed below it
+		balanceBefore := k.bankKeeper.GetBalance(ctx, sender, step.MinOutput.Denom)
+		if err := k.swapKeeper.SwapExactForTokens(ctx, sender, input, step.MinOutput, sdk.ZeroDec()); err != nil {
+			return sdk.Coin{}, err
+		}
+		return k.bankKeeper.GetBalance(ctx, sender, step.MinOutput.Denom).Sub(balanceBefore), nil
+
+	default:
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidRoute, "invalid action: %s", step.Action)
+	}
+}
@@ -15,6 +15,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDelegateMintDeposit{}, "router/MsgDelegateMintDeposit", nil)
 	cdc.RegisterConcrete(&MsgWithdrawBurn{}, "router/MsgWithdrawBurn", nil)
 	cdc.RegisterConcrete(&MsgWithdrawBurnUndelegate{}, "router/MsgWithdrawBurnUndelegate", nil)
+	cdc.RegisterConcrete(&MsgWithdrawAndRepay{}, "router/MsgWithdrawAndRepay", nil)
+	cdc.RegisterConcrete(&MsgExecuteRoute{}, "router/MsgExecuteRoute", nil)
 }
 
 // RegisterInterfaces registers proto messages under their interfaces for unmarshalling,
@@ -25,6 +27,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDelegateMintDeposit{},
 		&MsgWithdrawBurn{},
 		&MsgWithdrawBurnUndelegate{},
+		&MsgWithdrawAndRepay{},
+		&MsgExecuteRoute{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
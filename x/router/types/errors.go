@@ -0,0 +1,8 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+var (
+	ErrInvalidRoute     = errorsmod.Register(ModuleName, 2, "invalid route")
+	ErrRouteGuardFailed = errorsmod.Register(ModuleName, 3, "route step output below minimum")
+)
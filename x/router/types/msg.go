@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strings"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -16,6 +18,10 @@ const (
 	TypeMsgWithdrawBurn = "withdraw_burn"
 	// TypeMsgWithdrawBurnUndelegate defines the type for MsgWithdrawBurnUndelegate
 	TypeMsgWithdrawBurnUndelegate = "withdraw_burn_undelegate"
+	// TypeMsgWithdrawAndRepay defines the type for MsgWithdrawAndRepay
+	TypeMsgWithdrawAndRepay = "withdraw_and_repay"
+	// TypeMsgExecuteRoute defines the type for MsgExecuteRoute
+	TypeMsgExecuteRoute = "execute_route"
 )
 
 var (
@@ -27,6 +33,10 @@ var (
 	_ legacytx.LegacyMsg = &MsgWithdrawBurn{}
 	_ sdk.Msg            = &MsgWithdrawBurnUndelegate{}
 	_ legacytx.LegacyMsg = &MsgWithdrawBurnUndelegate{}
+	_ sdk.Msg            = &MsgWithdrawAndRepay{}
+	_ legacytx.LegacyMsg = &MsgWithdrawAndRepay{}
+	_ sdk.Msg            = &MsgExecuteRoute{}
+	_ legacytx.LegacyMsg = &MsgExecuteRoute{}
 )
 
 // NewMsgMintDeposit returns a new MsgMintDeposit.
@@ -200,3 +210,81 @@ func (msg MsgWithdrawBurnUndelegate) GetSigners() []sdk.AccAddress {
 	from, _ := sdk.AccAddressFromBech32(msg.From)
 	return []sdk.AccAddress{from}
 }
+
+// NewMsgWithdrawAndRepay returns a new MsgWithdrawAndRepay.
+func NewMsgWithdrawAndRepay(sender sdk.AccAddress, collateralType string, amount sdk.Coin) *MsgWithdrawAndRepay {
+	return &MsgWithdrawAndRepay{
+		Sender:         sender.String(),
+		CollateralType: collateralType,
+		Amount:         amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgWithdrawAndRepay) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgWithdrawAndRepay) Type() string { return TypeMsgWithdrawAndRepay }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgWithdrawAndRepay) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	if strings.TrimSpace(msg.CollateralType) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "collateral type cannot be empty")
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "'%s'", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgWithdrawAndRepay) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgWithdrawAndRepay) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgExecuteRoute returns a new MsgExecuteRoute.
+func NewMsgExecuteRoute(sender sdk.AccAddress, steps []RouteStep) *MsgExecuteRoute {
+	return &MsgExecuteRoute{
+		Sender: sender.String(),
+		Steps:  steps,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgExecuteRoute) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgExecuteRoute) Type() string { return TypeMsgExecuteRoute }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgExecuteRoute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	return ValidateRouteSteps(msg.Steps)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgExecuteRoute) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgExecuteRoute) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
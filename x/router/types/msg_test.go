@@ -89,6 +89,24 @@ func TestMsgWithdrawBurnUndelegate_Signing(t *testing.T) {
 	assert.Equal(t, signBytes, msg.GetSignBytes())
 }
 
+func TestMsgWithdrawAndRepay_Signing(t *testing.T) {
+	address := mustAccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+
+	msg := types.NewMsgWithdrawAndRepay(
+		address,
+		"bnb-a",
+		sdk.NewCoin("usdx", sdkmath.NewInt(1e9)),
+	)
+
+	// checking for the "type" field ensures the msg is registered on the amino codec
+	signBytes := []byte(
+		`{"type":"router/MsgWithdrawAndRepay","value":{"amount":{"amount":"1000000000","denom":"usdx"},"collateral_type":"bnb-a","sender":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d"}}`,
+	)
+
+	assert.Equal(t, []sdk.AccAddress{address}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+}
+
 func TestMsg_Validate(t *testing.T) {
 	validAddress := "kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d"
 	validValidatorAddress := "kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42"
@@ -191,6 +209,53 @@ func TestMsg_Validate(t *testing.T) {
 	}
 }
 
+func TestMsgWithdrawAndRepay_Validate(t *testing.T) {
+	validAddress := "kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d"
+	validCoin := sdk.NewInt64Coin("usdx", 1e9)
+
+	tests := []struct {
+		name        string
+		msg         types.MsgWithdrawAndRepay
+		expectedErr error
+	}{
+		{
+			name: "valid",
+			msg:  types.MsgWithdrawAndRepay{Sender: validAddress, CollateralType: "bnb-a", Amount: validCoin},
+		},
+		{
+			name:        "invalid sender",
+			msg:         types.MsgWithdrawAndRepay{Sender: "invalid", CollateralType: "bnb-a", Amount: validCoin},
+			expectedErr: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:        "empty collateral type",
+			msg:         types.MsgWithdrawAndRepay{Sender: validAddress, CollateralType: " ", Amount: validCoin},
+			expectedErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:        "nil coin",
+			msg:         types.MsgWithdrawAndRepay{Sender: validAddress, CollateralType: "bnb-a", Amount: sdk.Coin{}},
+			expectedErr: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name:        "zero coin",
+			msg:         types.MsgWithdrawAndRepay{Sender: validAddress, CollateralType: "bnb-a", Amount: sdk.NewCoin("usdx", sdk.ZeroInt())},
+			expectedErr: sdkerrors.ErrInvalidCoins,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr, "expected error '%s' not found in actual '%s'", tc.expectedErr, err)
+			}
+		})
+	}
+}
+
 func mustAccAddressFromBech32(address string) sdk.AccAddress {
 	addr, err := sdk.AccAddressFromBech32(address)
 	if err != nil {
@@ -206,3 +271,136 @@ func mustValAddressFromBech32(address string) sdk.ValAddress {
 	}
 	return addr
 }
+
+func TestMsgExecuteRoute_Signing(t *testing.T) {
+	address := mustAccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	validatorAddress := mustValAddressFromBech32("kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42")
+
+	msg := types.NewMsgExecuteRoute(
+		address,
+		[]types.RouteStep{
+			types.NewRouteStep(types.ROUTE_ACTION_TYPE_DELEGATE, validatorAddress, sdk.NewInt64Coin("ukava", 1e9), sdk.Coin{}),
+			types.NewRouteStep(types.ROUTE_ACTION_TYPE_SWAP, nil, sdk.Coin{}, sdk.NewInt64Coin("usdx", 1e6)),
+		},
+	)
+
+	// checking for the "type" field ensures the msg is registered on the amino codec
+	signBytes := []byte(
+		`{"type":"router/MsgExecuteRoute","value":{"sender":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d","steps":[{"action":1,"amount":{"amount":"1000000000","denom":"ukava"},"min_output":{"amount":"0"},"validator":"kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42"},{"action":4,"amount":{"amount":"0"},"min_output":{"amount":"1000000","denom":"usdx"}}]}}`,
+	)
+
+	assert.Equal(t, []sdk.AccAddress{address}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+}
+
+func TestMsgExecuteRoute_Validate(t *testing.T) {
+	validAddress := "kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d"
+	validValidator := "kavavaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42"
+	validCoin := sdk.NewInt64Coin("ukava", 1e9)
+
+	delegate := types.RouteStep{Action: types.ROUTE_ACTION_TYPE_DELEGATE, Validator: validValidator, Amount: validCoin}
+	mint := types.RouteStep{Action: types.ROUTE_ACTION_TYPE_MINT_DERIVATIVE, Validator: validValidator}
+	deposit := types.RouteStep{Action: types.ROUTE_ACTION_TYPE_EARN_DEPOSIT}
+	swap := types.RouteStep{Action: types.ROUTE_ACTION_TYPE_SWAP, MinOutput: sdk.NewInt64Coin("usdx", 1e6)}
+
+	tests := []struct {
+		name        string
+		steps       []types.RouteStep
+		expectedErr error
+	}{
+		{
+			name:  "valid delegate, mint and deposit",
+			steps: []types.RouteStep{delegate, mint, deposit},
+		},
+		{
+			name: "valid swap with guard",
+			steps: []types.RouteStep{
+				{Action: types.ROUTE_ACTION_TYPE_SWAP, Amount: validCoin, MinOutput: sdk.NewInt64Coin("usdx", 1e6)},
+				{Action: types.ROUTE_ACTION_TYPE_EARN_DEPOSIT, MinOutput: sdk.NewInt64Coin("usdx", 1e6)},
+			},
+		},
+		{
+			name:        "no steps",
+			steps:       []types.RouteStep{},
+			expectedErr: types.ErrInvalidRoute,
+		},
+		{
+			name: "too many steps",
+			steps: func() []types.RouteStep {
+				steps := []types.RouteStep{delegate}
+				for i := 0; i < types.MaxRouteSteps; i++ {
+					steps = append(steps, deposit)
+				}
+				return steps
+			}(),
+			expectedErr: types.ErrInvalidRoute,
+		},
+		{
+			name:        "first step without amount",
+			steps:       []types.RouteStep{mint, deposit},
+			expectedErr: types.ErrInvalidRoute,
+		},
+		{
+			name:        "unspecified action",
+			steps:       []types.RouteStep{{Amount: validCoin}},
+			expectedErr: types.ErrInvalidRoute,
+		},
+		{
+			name:        "invalid validator",
+			steps:       []types.RouteStep{{Action: types.ROUTE_ACTION_TYPE_DELEGATE, Validator: "invalid", Amount: validCoin}},
+			expectedErr: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:        "validator set for deposit",
+			steps:       []types.RouteStep{{Action: types.ROUTE_ACTION_TYPE_EARN_DEPOSIT, Validator: validValidator, Amount: validCoin}},
+			expectedErr: types.ErrInvalidRoute,
+		},
+		{
+			name:        "swap without min output",
+			steps:       []types.RouteStep{{Action: types.ROUTE_ACTION_TYPE_SWAP, Amount: validCoin}},
+			expectedErr: types.ErrInvalidRoute,
+		},
+		{
+			name:        "swap for same denom",
+			steps:       []types.RouteStep{{Action: types.ROUTE_ACTION_TYPE_SWAP, Amount: validCoin, MinOutput: validCoin}},
+			expectedErr: types.ErrInvalidRoute,
+		},
+		{
+			name:        "zero amount",
+			steps:       []types.RouteStep{{Action: types.ROUTE_ACTION_TYPE_EARN_DEPOSIT, Amount: sdk.NewCoin("ukava", sdk.ZeroInt())}},
+			expectedErr: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name:        "amount without denom",
+			steps:       []types.RouteStep{delegate, {Action: types.ROUTE_ACTION_TYPE_EARN_DEPOSIT, Amount: sdk.Coin{Amount: sdkmath.NewInt(1)}}},
+			expectedErr: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name:        "zero min output",
+			steps:       []types.RouteStep{delegate, {Action: types.ROUTE_ACTION_TYPE_SWAP, MinOutput: sdk.NewCoin("usdx", sdk.ZeroInt())}},
+			expectedErr: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name:        "later invalid step",
+			steps:       []types.RouteStep{delegate, swap, mint, {Action: types.RouteActionType(100)}},
+			expectedErr: types.ErrInvalidRoute,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.MsgExecuteRoute{Sender: validAddress, Steps: tc.steps}
+			err := msg.ValidateBasic()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr, "expected error '%s' not found in actual '%s'", tc.expectedErr, err)
+			}
+		})
+	}
+
+	t.Run("invalid sender", func(t *testing.T) {
+		msg := types.MsgExecuteRoute{Sender: "invalid", Steps: []types.RouteStep{delegate}}
+		require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	})
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewQuerySimulateRouteRequest returns a request to simulate a router msg.
+func NewQuerySimulateRouteRequest(msg sdk.Msg) (*QuerySimulateRouteRequest, error) {
+	req := &QuerySimulateRouteRequest{}
+	switch msg := msg.(type) {
+	case *MsgMintDeposit:
+		req.Msg = &QuerySimulateRouteRequest_MintDeposit{MintDeposit: msg}
+	case *MsgDelegateMintDeposit:
+		req.Msg = &QuerySimulateRouteRequest_DelegateMintDeposit{DelegateMintDeposit: msg}
+	case *MsgWithdrawBurn:
+		req.Msg = &QuerySimulateRouteRequest_WithdrawBurn{WithdrawBurn: msg}
+	case *MsgWithdrawBurnUndelegate:
+		req.Msg = &QuerySimulateRouteRequest_WithdrawBurnUndelegate{WithdrawBurnUndelegate: msg}
+	case *MsgWithdrawAndRepay:
+		req.Msg = &QuerySimulateRouteRequest_WithdrawAndRepay{WithdrawAndRepay: msg}
+	case *MsgExecuteRoute:
+		req.Msg = &QuerySimulateRouteRequest_ExecuteRoute{ExecuteRoute: msg}
+	default:
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized router msg type: %T", msg)
+	}
+	return req, nil
+}
+
+// GetRouterMsg returns the router msg to simulate, or nil if none is set.
+func (m *QuerySimulateRouteRequest) GetRouterMsg() sdk.Msg {
+	switch {
+	case m.GetMintDeposit() != nil:
+		return m.GetMintDeposit()
+	case m.GetDelegateMintDeposit() != nil:
+		return m.GetDelegateMintDeposit()
+	case m.GetWithdrawBurn() != nil:
+		return m.GetWithdrawBurn()
+	case m.GetWithdrawBurnUndelegate() != nil:
+		return m.GetWithdrawBurnUndelegate()
+	case m.GetWithdrawAndRepay() != nil:
+		return m.GetWithdrawAndRepay()
+	case m.GetExecuteRoute() != nil:
+		return m.GetExecuteRoute()
+	default:
+		return nil
+	}
+}
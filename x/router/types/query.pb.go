@@ -0,0 +1,1344 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/router/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_kava_labs_kava_x_earn_types "github.com/kava-labs/kava/x/earn/types"
+	types2 "github.com/kava-labs/kava/x/earn/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QuerySimulateRouteRequest defines the request type for Query/SimulateRoute method.
+type QuerySimulateRouteRequest struct {
+	// msg is the router msg to simulate
+	//
+	// Types that are valid to be assigned to Msg:
+	//	*QuerySimulateRouteRequest_MintDeposit
+	//	*QuerySimulateRouteRequest_DelegateMintDeposit
+	//	*QuerySimulateRouteRequest_WithdrawBurn
+	//	*QuerySimulateRouteRequest_WithdrawBurnUndelegate
+	//	*QuerySimulateRouteRequest_WithdrawAndRepay
+	//	*QuerySimulateRouteRequest_ExecuteRoute
+	Msg isQuerySimulateRouteRequest_Msg `protobuf_oneof:"msg"`
+}
+
+func (m *QuerySimulateRouteRequest) Reset()         { *m = QuerySimulateRouteRequest{} }
+func (m *QuerySimulateRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateRouteRequest) ProtoMessage()    {}
+func (*QuerySimulateRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a4bf1d730afca1a, []int{0}
+}
+func (m *QuerySimulateRouteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateRouteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateRouteRequest.Merge(m, src)
+}
+func (m *QuerySimulateRouteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateRouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateRouteRequest proto.InternalMessageInfo
+
+type isQuerySimulateRouteRequest_Msg interface {
+	isQuerySimulateRouteRequest_Msg()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type QuerySimulateRouteRequest_MintDeposit struct {
+	MintDeposit *MsgMintDeposit `protobuf:"bytes,3,opt,name=mint_deposit,json=mintDeposit,proto3,oneof" json:"mint_deposit,omitempty"`
+}
+type QuerySimulateRouteRequest_DelegateMintDeposit struct {
+	DelegateMintDeposit *MsgDelegateMintDeposit `protobuf:"bytes,4,opt,name=delegate_mint_deposit,json=delegateMintDeposit,proto3,oneof" json:"delegate_mint_deposit,omitempty"`
+}
+type QuerySimulateRouteRequest_WithdrawBurn struct {
+	WithdrawBurn *MsgWithdrawBurn `protobuf:"bytes,5,opt,name=withdraw_burn,json=withdrawBurn,proto3,oneof" json:"withdraw_burn,omitempty"`
+}
+type QuerySimulateRouteRequest_WithdrawBurnUndelegate struct {
+	WithdrawBurnUndelegate *MsgWithdrawBurnUndelegate `protobuf:"bytes,6,opt,name=withdraw_burn_undelegate,json=withdrawBurnUndelegate,proto3,oneof" json:"withdraw_burn_undelegate,omitempty"`
+}
+type QuerySimulateRouteRequest_WithdrawAndRepay struct {
+	WithdrawAndRepay *MsgWithdrawAndRepay `protobuf:"bytes,7,opt,name=withdraw_and_repay,json=withdrawAndRepay,proto3,oneof" json:"withdraw_and_repay,omitempty"`
+}
+type QuerySimulateRouteRequest_ExecuteRoute struct {
+	ExecuteRoute *MsgExecuteRoute `protobuf:"bytes,8,opt,name=execute_route,json=executeRoute,proto3,oneof" json:"execute_route,omitempty"`
+}
+
+func (*QuerySimulateRouteRequest_MintDeposit) isQuerySimulateRouteRequest_Msg()            {}
+func (*QuerySimulateRouteRequest_DelegateMintDeposit) isQuerySimulateRouteRequest_Msg()    {}
+func (*QuerySimulateRouteRequest_WithdrawBurn) isQuerySimulateRouteRequest_Msg()           {}
+func (*QuerySimulateRouteRequest_WithdrawBurnUndelegate) isQuerySimulateRouteRequest_Msg() {}
+func (*QuerySimulateRouteRequest_WithdrawAndRepay) isQuerySimulateRouteRequest_Msg()       {}
+func (*QuerySimulateRouteRequest_ExecuteRoute) isQuerySimulateRouteRequest_Msg()           {}
+
+func (m *QuerySimulateRouteRequest) GetMsg() isQuerySimulateRouteRequest_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *QuerySimulateRouteRequest) GetMintDeposit() *MsgMintDeposit {
+	if x, ok := m.GetMsg().(*QuerySimulateRouteRequest_MintDeposit); ok {
+		return x.MintDeposit
+	}
+	return nil
+}
+
+func (m *QuerySimulateRouteRequest) GetDelegateMintDeposit() *MsgDelegateMintDeposit {
+	if x, ok := m.GetMsg().(*QuerySimulateRouteRequest_DelegateMintDeposit); ok {
+		return x.DelegateMintDeposit
+	}
+	return nil
+}
+
+func (m *QuerySimulateRouteRequest) GetWithdrawBurn() *MsgWithdrawBurn {
+	if x, ok := m.GetMsg().(*QuerySimulateRouteRequest_WithdrawBurn); ok {
+		return x.WithdrawBurn
+	}
+	return nil
+}
+
+func (m *QuerySimulateRouteRequest) GetWithdrawBurnUndelegate() *MsgWithdrawBurnUndelegate {
+	if x, ok := m.GetMsg().(*QuerySimulateRouteRequest_WithdrawBurnUndelegate); ok {
+		return x.WithdrawBurnUndelegate
+	}
+	return nil
+}
+
+func (m *QuerySimulateRouteRequest) GetWithdrawAndRepay() *MsgWithdrawAndRepay {
+	if x, ok := m.GetMsg().(*QuerySimulateRouteRequest_WithdrawAndRepay); ok {
+		return x.WithdrawAndRepay
+	}
+	return nil
+}
+
+func (m *QuerySimulateRouteRequest) GetExecuteRoute() *MsgExecuteRoute {
+	if x, ok := m.GetMsg().(*QuerySimulateRouteRequest_ExecuteRoute); ok {
+		return x.ExecuteRoute
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*QuerySimulateRouteRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*QuerySimulateRouteRequest_MintDeposit)(nil),
+		(*QuerySimulateRouteRequest_DelegateMintDeposit)(nil),
+		(*QuerySimulateRouteRequest_WithdrawBurn)(nil),
+		(*QuerySimulateRouteRequest_WithdrawBurnUndelegate)(nil),
+		(*QuerySimulateRouteRequest_WithdrawAndRepay)(nil),
+		(*QuerySimulateRouteRequest_ExecuteRoute)(nil),
+	}
+}
+
+// QuerySimulateRouteResponse defines the response type for Query/SimulateRoute method.
+type QuerySimulateRouteResponse struct {
+	// outputs are the outputs of each step of a simulated execute route msg, unset if the msg fails
+	Outputs []types.Coin `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs"`
+	// balances are the signer's balances after the msg, unchanged if the msg fails
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+	// delegations are the signer's delegations after the msg, unchanged if the msg fails
+	Delegations []types1.Delegation `protobuf:"bytes,3,rep,name=delegations,proto3" json:"delegations"`
+	// vault_shares are the signer's earn vault shares after the msg, unchanged if the msg fails
+	VaultShares github_com_kava_labs_kava_x_earn_types.VaultShares `protobuf:"bytes,4,rep,name=vault_shares,json=vaultShares,proto3,castrepeated=github.com/kava-labs/kava/x/earn/types.VaultShares" json:"vault_shares"`
+	// failed is true if the msg fails
+	Failed bool `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	// error is the error the msg failed with, only set if the msg fails
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *QuerySimulateRouteResponse) Reset()         { *m = QuerySimulateRouteResponse{} }
+func (m *QuerySimulateRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateRouteResponse) ProtoMessage()    {}
+func (*QuerySimulateRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a4bf1d730afca1a, []int{1}
+}
+func (m *QuerySimulateRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateRouteResponse.Merge(m, src)
+}
+func (m *QuerySimulateRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateRouteResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QuerySimulateRouteRequest)(nil), "kava.router.v1beta1.QuerySimulateRouteRequest")
+	proto.RegisterType((*QuerySimulateRouteResponse)(nil), "kava.router.v1beta1.QuerySimulateRouteResponse")
+}
+
+func init() { proto.RegisterFile("kava/router/v1beta1/query.proto", fileDescriptor_1a4bf1d730afca1a) }
+
+var fileDescriptor_1a4bf1d730afca1a = []byte{
+	// 722 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcb, 0x4e, 0xdb, 0x4a,
+	0x18, 0xb6, 0x73, 0x23, 0x67, 0x02, 0x52, 0x64, 0x38, 0xc8, 0x44, 0x07, 0x83, 0x02, 0x8b, 0xe8,
+	0x1c, 0x61, 0x1f, 0x72, 0x56, 0x87, 0x5d, 0x53, 0x5a, 0x45, 0xa9, 0x58, 0x74, 0x50, 0x2f, 0xea,
+	0xc6, 0x9a, 0xc4, 0x53, 0xe3, 0x92, 0xcc, 0x18, 0xcf, 0x38, 0x01, 0xa9, 0xab, 0x3e, 0x41, 0xa5,
+	0xbe, 0x40, 0xd7, 0x3c, 0x09, 0x6a, 0x37, 0x48, 0xdd, 0x74, 0xd5, 0x0b, 0xf4, 0x41, 0xaa, 0xb9,
+	0xc4, 0x24, 0x6d, 0xb8, 0xac, 0xec, 0x6f, 0xe6, 0xfb, 0xbe, 0x7f, 0xe6, 0xbf, 0x0c, 0x58, 0x3b,
+	0x44, 0x43, 0xe4, 0x25, 0x34, 0xe5, 0x38, 0xf1, 0x86, 0xdb, 0x5d, 0xcc, 0xd1, 0xb6, 0x77, 0x94,
+	0xe2, 0xe4, 0xc4, 0x8d, 0x13, 0xca, 0xa9, 0xb5, 0x28, 0x08, 0xae, 0x22, 0xb8, 0x9a, 0x50, 0x73,
+	0x7a, 0x94, 0x0d, 0x28, 0xf3, 0xba, 0x88, 0xe1, 0x4c, 0xd5, 0xa3, 0x11, 0x51, 0xa2, 0xda, 0xa6,
+	0xde, 0x67, 0x1c, 0x1d, 0x46, 0x24, 0xcc, 0x28, 0x1a, 0x6b, 0xd6, 0x8a, 0x62, 0xf9, 0x12, 0x79,
+	0x0a, 0xe8, 0xad, 0xa5, 0x90, 0x86, 0x54, 0xad, 0x8b, 0x3f, 0xbd, 0xfa, 0x57, 0x48, 0x69, 0xd8,
+	0xc7, 0x1e, 0x8a, 0x23, 0x0f, 0x11, 0x42, 0x39, 0xe2, 0x11, 0x25, 0x63, 0xcd, 0xaa, 0xbc, 0x0a,
+	0x46, 0x09, 0xc9, 0xe2, 0x0d, 0x51, 0xda, 0xe7, 0x63, 0xf1, 0xac, 0x9b, 0xf2, 0x63, 0xb5, 0x5b,
+	0xff, 0x50, 0x00, 0x2b, 0x8f, 0xc5, 0xb5, 0xf7, 0xa3, 0x41, 0xda, 0x47, 0x1c, 0x43, 0x41, 0x84,
+	0xf8, 0x28, 0xc5, 0x8c, 0x5b, 0x6d, 0x30, 0x3f, 0x88, 0x08, 0xf7, 0x03, 0x1c, 0x53, 0x16, 0x71,
+	0x3b, 0xbf, 0x6e, 0x36, 0x2a, 0xcd, 0x0d, 0x77, 0x46, 0x6e, 0xdc, 0x3d, 0x16, 0xee, 0x45, 0x84,
+	0xef, 0x2a, 0x6a, 0xdb, 0x80, 0x95, 0xc1, 0x15, 0xb4, 0x10, 0xf8, 0x33, 0xc0, 0x7d, 0x1c, 0x22,
+	0x8e, 0xfd, 0x29, 0xcb, 0x82, 0xb4, 0xfc, 0xe7, 0x3a, 0xcb, 0x5d, 0x2d, 0x9a, 0xb6, 0x5e, 0x0c,
+	0x7e, 0x5f, 0xb6, 0x1e, 0x81, 0x85, 0x51, 0xc4, 0x0f, 0x82, 0x04, 0x8d, 0xfc, 0x6e, 0x9a, 0x10,
+	0xbb, 0x28, 0xad, 0x37, 0xaf, 0xb3, 0x7e, 0xa6, 0xc9, 0xad, 0x34, 0x21, 0x6d, 0x03, 0xce, 0x8f,
+	0x26, 0xb0, 0xf5, 0x0a, 0xd8, 0x53, 0x66, 0x7e, 0x4a, 0xc6, 0x31, 0xed, 0x92, 0xf4, 0x75, 0xef,
+	0xe2, 0xfb, 0x24, 0x53, 0xb5, 0x0d, 0xb8, 0x3c, 0x9a, 0xb9, 0x63, 0x3d, 0x07, 0x56, 0x16, 0x0b,
+	0x91, 0xc0, 0x4f, 0x70, 0x8c, 0x4e, 0xec, 0x39, 0x19, 0xa5, 0x71, 0x5b, 0x94, 0x7b, 0x24, 0x80,
+	0x82, 0xdf, 0x36, 0x60, 0x75, 0xf4, 0xcb, 0x9a, 0x48, 0x09, 0x3e, 0xc6, 0xbd, 0x94, 0x63, 0x5f,
+	0x3a, 0xd8, 0xe5, 0x9b, 0x53, 0xf2, 0x40, 0x91, 0x65, 0x0f, 0x88, 0x94, 0xe0, 0x09, 0xdc, 0x2a,
+	0x82, 0xfc, 0x80, 0x85, 0x9d, 0x42, 0xd9, 0xac, 0xe6, 0x3a, 0x85, 0x72, 0xae, 0x9a, 0x87, 0x25,
+	0x86, 0x49, 0x80, 0x13, 0x58, 0x64, 0x1c, 0xc7, 0xac, 0xfe, 0x31, 0x0f, 0x6a, 0xb3, 0x9a, 0x89,
+	0xc5, 0x94, 0x30, 0x6c, 0xfd, 0x0f, 0xe6, 0x68, 0xca, 0xe3, 0x94, 0x33, 0xdb, 0x5c, 0xcf, 0x37,
+	0x2a, 0xcd, 0x15, 0x57, 0x37, 0xbf, 0x98, 0xa7, 0xec, 0x1c, 0xf7, 0x69, 0x44, 0x5a, 0x85, 0xb3,
+	0x2f, 0x6b, 0x06, 0x1c, 0xf3, 0xad, 0x10, 0x94, 0xbb, 0xa8, 0x8f, 0x48, 0x0f, 0x33, 0x3b, 0x77,
+	0x9b, 0xf6, 0x5f, 0xa1, 0x3d, 0xfd, 0xba, 0xd6, 0x08, 0x23, 0x7e, 0x90, 0x76, 0xdd, 0x1e, 0x1d,
+	0xe8, 0x29, 0xd3, 0x9f, 0x2d, 0x16, 0x1c, 0x7a, 0xfc, 0x24, 0xc6, 0x4c, 0x0a, 0x18, 0xcc, 0xcc,
+	0xad, 0x0e, 0xa8, 0xe8, 0xba, 0x88, 0x09, 0xb3, 0xf3, 0x32, 0x56, 0x7d, 0x1c, 0x6b, 0x3c, 0xc7,
+	0xe3, 0x70, 0xbb, 0x19, 0x55, 0x1f, 0x78, 0x52, 0x6c, 0xbd, 0x06, 0xf3, 0x72, 0x10, 0x7d, 0x76,
+	0x80, 0x12, 0xcc, 0xec, 0x82, 0x34, 0x5b, 0x55, 0xc9, 0x17, 0xf3, 0x9a, 0xf9, 0x3c, 0x15, 0xb4,
+	0x7d, 0xc1, 0x6a, 0xed, 0xe8, 0xc3, 0x37, 0x27, 0x0e, 0x2f, 0x04, 0x5b, 0x7d, 0xd4, 0x65, 0xf2,
+	0xcf, 0x3b, 0x56, 0xc3, 0xae, 0x2e, 0x70, 0x25, 0x65, 0xb0, 0x32, 0xbc, 0x02, 0xd6, 0x32, 0x28,
+	0xbd, 0x44, 0x51, 0x1f, 0x07, 0x72, 0x0e, 0xca, 0x50, 0x23, 0x6b, 0x09, 0x14, 0x71, 0x92, 0xd0,
+	0x44, 0x36, 0xd8, 0x1f, 0x50, 0x81, 0x4e, 0xa1, 0x5c, 0xaa, 0xce, 0xc1, 0x8a, 0xe2, 0xf8, 0xa2,
+	0x9c, 0xcd, 0x53, 0x13, 0x14, 0x65, 0x35, 0xad, 0xf7, 0x26, 0x58, 0x98, 0x2a, 0xa9, 0x35, 0xbb,
+	0xf9, 0xaf, 0x7d, 0x48, 0x6a, 0xde, 0x9d, 0xf9, 0xaa, 0x57, 0xea, 0xee, 0x9b, 0x4f, 0x3f, 0xde,
+	0xe5, 0x1a, 0x3b, 0xe6, 0xdf, 0xf5, 0x0d, 0x6f, 0xd6, 0x0b, 0xc6, 0xb4, 0x4c, 0x35, 0x76, 0xeb,
+	0xe1, 0xd9, 0x77, 0xc7, 0x38, 0xbb, 0x70, 0xcc, 0xf3, 0x0b, 0xc7, 0xfc, 0x76, 0xe1, 0x98, 0x6f,
+	0x2f, 0x1d, 0xe3, 0xfc, 0xd2, 0x31, 0x3e, 0x5f, 0x3a, 0xc6, 0x8b, 0xc6, 0x4d, 0xc9, 0xd4, 0xc6,
+	0x32, 0x9d, 0xdd, 0x92, 0x7c, 0x16, 0xff, 0xfb, 0x39, 0x00, 0x5e, 0x96, 0x67, 0x5c, 0x20, 0x06,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// SimulateRoute executes a router msg against the current state without applying it, returning the signer's
+	// resulting balances and shares, or the error the msg failed with.
+	SimulateRoute(ctx context.Context, in *QuerySimulateRouteRequest, opts ...grpc.CallOption) (*QuerySimulateRouteResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) SimulateRoute(ctx context.Context, in *QuerySimulateRouteRequest, opts ...grpc.CallOption) (*QuerySimulateRouteResponse, error) {
+	out := new(QuerySimulateRouteResponse)
+	err := c.cc.Invoke(ctx, "/kava.router.v1beta1.Query/SimulateRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// SimulateRoute executes a router msg against the current state without applying it, returning the signer's
+	// resulting balances and shares, or the error the msg failed with.
+	SimulateRoute(context.Context, *QuerySimulateRouteRequest) (*QuerySimulateRouteResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) SimulateRoute(ctx context.Context, req *QuerySimulateRouteRequest) (*QuerySimulateRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateRoute not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_SimulateRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.router.v1beta1.Query/SimulateRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateRoute(ctx, req.(*QuerySimulateRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kava.router.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SimulateRoute",
+			Handler:    _Query_SimulateRoute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kava/router/v1beta1/query.proto",
+}
+
+func (m *QuerySimulateRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size := m.Msg.Size()
+			i -= size
+			if _, err := m.Msg.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateRouteRequest_MintDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateRouteRequest_MintDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MintDeposit != nil {
+		{
+			size, err := m.MintDeposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *QuerySimulateRouteRequest_DelegateMintDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateRouteRequest_DelegateMintDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DelegateMintDeposit != nil {
+		{
+			size, err := m.DelegateMintDeposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *QuerySimulateRouteRequest_WithdrawBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateRouteRequest_WithdrawBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WithdrawBurn != nil {
+		{
+			size, err := m.WithdrawBurn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *QuerySimulateRouteRequest_WithdrawBurnUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateRouteRequest_WithdrawBurnUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WithdrawBurnUndelegate != nil {
+		{
+			size, err := m.WithdrawBurnUndelegate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *QuerySimulateRouteRequest_WithdrawAndRepay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateRouteRequest_WithdrawAndRepay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WithdrawAndRepay != nil {
+		{
+			size, err := m.WithdrawAndRepay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *QuerySimulateRouteRequest_ExecuteRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateRouteRequest_ExecuteRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ExecuteRoute != nil {
+		{
+			size, err := m.ExecuteRoute.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *QuerySimulateRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Failed {
+		i--
+		if m.Failed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.VaultShares) > 0 {
+		for iNdEx := len(m.VaultShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VaultShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySimulateRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		n += m.Msg.Size()
+	}
+	return n
+}
+
+func (m *QuerySimulateRouteRequest_MintDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MintDeposit != nil {
+		l = m.MintDeposit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *QuerySimulateRouteRequest_DelegateMintDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelegateMintDeposit != nil {
+		l = m.DelegateMintDeposit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *QuerySimulateRouteRequest_WithdrawBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WithdrawBurn != nil {
+		l = m.WithdrawBurn.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *QuerySimulateRouteRequest_WithdrawBurnUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WithdrawBurnUndelegate != nil {
+		l = m.WithdrawBurnUndelegate.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *QuerySimulateRouteRequest_WithdrawAndRepay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WithdrawAndRepay != nil {
+		l = m.WithdrawAndRepay.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *QuerySimulateRouteRequest_ExecuteRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExecuteRoute != nil {
+		l = m.ExecuteRoute.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *QuerySimulateRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.VaultShares) > 0 {
+		for _, e := range m.VaultShares {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Failed {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySimulateRouteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateRouteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgMintDeposit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Msg = &QuerySimulateRouteRequest_MintDeposit{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateMintDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgDelegateMintDeposit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Msg = &QuerySimulateRouteRequest_DelegateMintDeposit{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawBurn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgWithdrawBurn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Msg = &QuerySimulateRouteRequest_WithdrawBurn{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawBurnUndelegate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgWithdrawBurnUndelegate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Msg = &QuerySimulateRouteRequest_WithdrawBurnUndelegate{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAndRepay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgWithdrawAndRepay{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Msg = &QuerySimulateRouteRequest_WithdrawAndRepay{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteRoute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgExecuteRoute{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Msg = &QuerySimulateRouteRequest_ExecuteRoute{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, types.Coin{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, types1.Delegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultShares = append(m.VaultShares, types2.VaultShare{})
+			if err := m.VaultShares[len(m.VaultShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
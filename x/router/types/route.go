@@ -0,0 +1,101 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxRouteSteps is the maximum number of steps in a route.
+const MaxRouteSteps = 10
+
+// NewRouteStep returns a new RouteStep.
+func NewRouteStep(action RouteActionType, validator sdk.ValAddress, amount, minOutput sdk.Coin) RouteStep {
+	step := RouteStep{
+		Action:    action,
+		Amount:    amount,
+		MinOutput: minOutput,
+	}
+	if validator != nil {
+		step.Validator = validator.String()
+	}
+	return step
+}
+
+// HasAmount returns true if the step sets its input, rather than using the output of the previous step.
+func (s RouteStep) HasAmount() bool {
+	return s.Amount.Denom != ""
+}
+
+// HasMinOutput returns true if the step is guarded by a minimum output.
+func (s RouteStep) HasMinOutput() bool {
+	return s.MinOutput.Denom != ""
+}
+
+// Validate performs basic validation of a route step.
+func (s RouteStep) Validate() error {
+	switch s.Action {
+	case ROUTE_ACTION_TYPE_DELEGATE, ROUTE_ACTION_TYPE_MINT_DERIVATIVE:
+		if _, err := sdk.ValAddressFromBech32(s.Validator); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address: %s", err)
+		}
+	case ROUTE_ACTION_TYPE_EARN_DEPOSIT:
+		if s.Validator != "" {
+			return errorsmod.Wrapf(ErrInvalidRoute, "validator cannot be set for %s", s.Action)
+		}
+	case ROUTE_ACTION_TYPE_SWAP:
+		if s.Validator != "" {
+			return errorsmod.Wrapf(ErrInvalidRoute, "validator cannot be set for %s", s.Action)
+		}
+		if !s.HasMinOutput() {
+			return errorsmod.Wrapf(ErrInvalidRoute, "min output must be set for %s", s.Action)
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidRoute, "invalid action: %s", s.Action)
+	}
+
+	if err := validateOptionalCoin(s.Amount); err != nil {
+		return errorsmod.Wrap(err, "amount")
+	}
+	if err := validateOptionalCoin(s.MinOutput); err != nil {
+		return errorsmod.Wrap(err, "min output")
+	}
+	if s.Action == ROUTE_ACTION_TYPE_SWAP && s.HasAmount() && s.Amount.Denom == s.MinOutput.Denom {
+		return errorsmod.Wrapf(ErrInvalidRoute, "cannot swap %s for itself", s.Amount.Denom)
+	}
+	return nil
+}
+
+// ValidateRouteSteps performs basic validation of the steps of a route.
+func ValidateRouteSteps(steps []RouteStep) error {
+	if len(steps) == 0 {
+		return errorsmod.Wrap(ErrInvalidRoute, "route must contain at least one step")
+	}
+	if len(steps) > MaxRouteSteps {
+		return errorsmod.Wrapf(ErrInvalidRoute, "route cannot contain more than %d steps", MaxRouteSteps)
+	}
+	if !steps[0].HasAmount() {
+		return errorsmod.Wrap(ErrInvalidRoute, "first step must set an amount")
+	}
+
+	for i, step := range steps {
+		if err := step.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "step %d", i)
+		}
+	}
+	return nil
+}
+
+// validateOptionalCoin validates a coin that is either unset or positive.
+func validateOptionalCoin(coin sdk.Coin) error {
+	if coin.Denom == "" {
+		if !coin.IsNil() && !coin.IsZero() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "'%s'", coin)
+		}
+		return nil
+	}
+	if coin.IsNil() || !coin.IsValid() || coin.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "'%s'", coin)
+	}
+	return nil
+}
@@ -0,0 +1,492 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kava/router/v1beta1/route.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RouteActionType enumerates the actions that can be executed as steps of a route.
+type RouteActionType int32
+
+const (
+	// ROUTE_ACTION_TYPE_UNSPECIFIED represents an unspecified or invalid action.
+	ROUTE_ACTION_TYPE_UNSPECIFIED RouteActionType = 0
+	// ROUTE_ACTION_TYPE_DELEGATE delegates staking tokens to a validator.
+	ROUTE_ACTION_TYPE_DELEGATE RouteActionType = 1
+	// ROUTE_ACTION_TYPE_MINT_DERIVATIVE converts a delegation to a validator into staking derivatives.
+	ROUTE_ACTION_TYPE_MINT_DERIVATIVE RouteActionType = 2
+	// ROUTE_ACTION_TYPE_EARN_DEPOSIT deposits coins into an earn vault using the savings strategy.
+	ROUTE_ACTION_TYPE_EARN_DEPOSIT RouteActionType = 3
+	// ROUTE_ACTION_TYPE_SWAP swaps coins for the denom of the step's minimum output.
+	ROUTE_ACTION_TYPE_SWAP RouteActionType = 4
+)
+
+var RouteActionType_name = map[int32]string{
+	0: "ROUTE_ACTION_TYPE_UNSPECIFIED",
+	1: "ROUTE_ACTION_TYPE_DELEGATE",
+	2: "ROUTE_ACTION_TYPE_MINT_DERIVATIVE",
+	3: "ROUTE_ACTION_TYPE_EARN_DEPOSIT",
+	4: "ROUTE_ACTION_TYPE_SWAP",
+}
+
+var RouteActionType_value = map[string]int32{
+	"ROUTE_ACTION_TYPE_UNSPECIFIED":     0,
+	"ROUTE_ACTION_TYPE_DELEGATE":        1,
+	"ROUTE_ACTION_TYPE_MINT_DERIVATIVE": 2,
+	"ROUTE_ACTION_TYPE_EARN_DEPOSIT":    3,
+	"ROUTE_ACTION_TYPE_SWAP":            4,
+}
+
+func (x RouteActionType) String() string {
+	return proto.EnumName(RouteActionType_name, int32(x))
+}
+
+func (RouteActionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_58c1409934f9dab4, []int{0}
+}
+
+// RouteStep is a single action executed as part of a route.
+type RouteStep struct {
+	// action is the action executed by the step
+	Action RouteActionType `protobuf:"varint,1,opt,name=action,proto3,enum=kava.router.v1beta1.RouteActionType" json:"action,omitempty"`
+	// validator is the validator used by delegate and mint derivative actions
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// amount is the input of the step, an empty amount uses the output of the previous step
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// min_output guards the step, failing the route if the step outputs less. It is required for swaps, where its
+	// denom selects the denom swapped to.
+	MinOutput types.Coin `protobuf:"bytes,4,opt,name=min_output,json=minOutput,proto3" json:"min_output"`
+}
+
+func (m *RouteStep) Reset()         { *m = RouteStep{} }
+func (m *RouteStep) String() string { return proto.CompactTextString(m) }
+func (*RouteStep) ProtoMessage()    {}
+func (*RouteStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58c1409934f9dab4, []int{0}
+}
+func (m *RouteStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RouteStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteStep.Merge(m, src)
+}
+func (m *RouteStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteStep proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("kava.router.v1beta1.RouteActionType", RouteActionType_name, RouteActionType_value)
+	proto.RegisterType((*RouteStep)(nil), "kava.router.v1beta1.RouteStep")
+}
+
+func init() { proto.RegisterFile("kava/router/v1beta1/route.proto", fileDescriptor_58c1409934f9dab4) }
+
+var fileDescriptor_58c1409934f9dab4 = []byte{
+	// 410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6a, 0xd4, 0x40,
+	0x18, 0xc7, 0x33, 0xed, 0xb2, 0x90, 0x11, 0x34, 0x8c, 0x22, 0x31, 0xe8, 0x74, 0x5b, 0x14, 0x82,
+	0xe0, 0x84, 0xd6, 0x83, 0x17, 0x11, 0xd2, 0xcd, 0x54, 0x02, 0x9a, 0x84, 0x64, 0x5a, 0xd1, 0x4b,
+	0x98, 0xc4, 0xb0, 0x06, 0x9b, 0x4c, 0x48, 0x26, 0x8b, 0x7d, 0x03, 0x8f, 0xbe, 0x83, 0x4f, 0xe2,
+	0x6d, 0x8f, 0x3d, 0x7a, 0x92, 0xba, 0xfb, 0x22, 0x92, 0x64, 0xad, 0x60, 0x3c, 0x78, 0xfb, 0xe6,
+	0x9b, 0xdf, 0xef, 0x9b, 0x3f, 0x7c, 0x03, 0xf7, 0x3e, 0xf2, 0x25, 0xb7, 0x6a, 0xd1, 0xca, 0xac,
+	0xb6, 0x96, 0x87, 0x49, 0x26, 0xf9, 0xe1, 0x70, 0x24, 0x55, 0x2d, 0xa4, 0x40, 0xb7, 0x3b, 0x80,
+	0x0c, 0x00, 0xd9, 0x02, 0x06, 0x4e, 0x45, 0x53, 0x88, 0xc6, 0x4a, 0x78, 0x93, 0x5d, 0x5b, 0xa9,
+	0xc8, 0xcb, 0x41, 0x32, 0xee, 0x2c, 0xc4, 0x42, 0xf4, 0xa5, 0xd5, 0x55, 0x43, 0xf7, 0xe0, 0x0a,
+	0x40, 0x35, 0xec, 0x06, 0x45, 0x32, 0xab, 0xd0, 0x73, 0x38, 0xe5, 0xa9, 0xcc, 0x45, 0xa9, 0x83,
+	0x19, 0x30, 0x6f, 0x1e, 0x3d, 0x24, 0xff, 0x78, 0x89, 0xf4, 0xbc, 0xdd, 0x73, 0xec, 0xa2, 0xca,
+	0xc2, 0xad, 0x83, 0xee, 0x43, 0x75, 0xc9, 0xcf, 0xf3, 0xf7, 0x5c, 0x8a, 0x5a, 0xdf, 0x99, 0x01,
+	0x53, 0x0d, 0xff, 0x34, 0xd0, 0x33, 0x38, 0xe5, 0x85, 0x68, 0x4b, 0xa9, 0xef, 0xce, 0x80, 0x79,
+	0xe3, 0xe8, 0x1e, 0x19, 0x02, 0x93, 0x2e, 0xf0, 0xf5, 0xec, 0xb9, 0xc8, 0xcb, 0xe3, 0xc9, 0xea,
+	0xc7, 0x9e, 0x12, 0x6e, 0x71, 0xf4, 0x02, 0xc2, 0x22, 0x2f, 0x63, 0xd1, 0xca, 0xaa, 0x95, 0xfa,
+	0xe4, 0xff, 0x64, 0xb5, 0xc8, 0x4b, 0xbf, 0x37, 0x1e, 0x7f, 0x03, 0xf0, 0xd6, 0x5f, 0x91, 0xd1,
+	0x3e, 0x7c, 0x10, 0xfa, 0xa7, 0x8c, 0xc6, 0xf6, 0x9c, 0xb9, 0xbe, 0x17, 0xb3, 0xb7, 0x01, 0x8d,
+	0x4f, 0xbd, 0x28, 0xa0, 0x73, 0xf7, 0xc4, 0xa5, 0x8e, 0xa6, 0x20, 0x0c, 0x8d, 0x31, 0xe2, 0xd0,
+	0x57, 0xf4, 0xa5, 0xcd, 0xa8, 0x06, 0xd0, 0x23, 0xb8, 0x3f, 0xbe, 0x7f, 0xed, 0x7a, 0x2c, 0x76,
+	0x68, 0xe8, 0x9e, 0xd9, 0xcc, 0x3d, 0xa3, 0xda, 0x0e, 0x3a, 0x80, 0x78, 0x8c, 0x51, 0x3b, 0xf4,
+	0x62, 0x87, 0x06, 0x7e, 0xe4, 0x32, 0x6d, 0x17, 0x19, 0xf0, 0xee, 0x98, 0x89, 0xde, 0xd8, 0x81,
+	0x36, 0x31, 0x26, 0x9f, 0xbf, 0x62, 0xe5, 0xf8, 0x64, 0xf5, 0x13, 0x2b, 0xab, 0x35, 0x06, 0x97,
+	0x6b, 0x0c, 0xae, 0xd6, 0x18, 0x7c, 0xd9, 0x60, 0xe5, 0x72, 0x83, 0x95, 0xef, 0x1b, 0xac, 0xbc,
+	0x33, 0x17, 0xb9, 0xfc, 0xd0, 0x26, 0x24, 0x15, 0x85, 0xd5, 0x2d, 0xec, 0xc9, 0x39, 0x4f, 0x9a,
+	0xbe, 0xb2, 0x3e, 0xfd, 0xfe, 0x47, 0xf2, 0xa2, 0xca, 0x9a, 0x64, 0xda, 0x6f, 0xfd, 0xe9, 0xaf,
+	0x01, 0x00, 0x4d, 0x07, 0x95, 0x23, 0x63, 0x02, 0x00, 0x00,
+}
+
+func (m *RouteStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MinOutput.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintRoute(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Action != 0 {
+		i = encodeVarintRoute(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRoute(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRoute(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RouteStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovRoute(uint64(m.Action))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovRoute(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRoute(uint64(l))
+	l = m.MinOutput.Size()
+	n += 1 + l + sovRoute(uint64(l))
+	return n
+}
+
+func sovRoute(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRoute(x uint64) (n int) {
+	return sovRoute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RouteStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= RouteActionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRoute(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRoute
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRoute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRoute
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRoute
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRoute
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRoute        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRoute          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRoute = fmt.Errorf("proto: unexpected end of group")
+)
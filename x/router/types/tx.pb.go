@@ -352,6 +352,166 @@ func (m *MsgWithdrawBurnUndelegateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawBurnUndelegateResponse proto.InternalMessageInfo
 
+// MsgWithdrawAndRepay withdraws supplied funds from hard, then uses them to repay the debt of a cdp.
+type MsgWithdrawAndRepay struct {
+	// sender is the owner of the hard deposit and the cdp
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// collateral_type is the collateral type of the cdp to repay
+	CollateralType string `protobuf:"bytes,2,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	// amount is the debt denom amount to withdraw from hard and repay
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgWithdrawAndRepay) Reset()         { *m = MsgWithdrawAndRepay{} }
+func (m *MsgWithdrawAndRepay) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAndRepay) ProtoMessage()    {}
+func (*MsgWithdrawAndRepay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63015631bbbf9425, []int{8}
+}
+func (m *MsgWithdrawAndRepay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAndRepay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAndRepay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawAndRepay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAndRepay.Merge(m, src)
+}
+func (m *MsgWithdrawAndRepay) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAndRepay) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAndRepay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawAndRepay proto.InternalMessageInfo
+
+// MsgWithdrawAndRepayResponse defines the Msg/MsgWithdrawAndRepay response type.
+type MsgWithdrawAndRepayResponse struct {
+}
+
+func (m *MsgWithdrawAndRepayResponse) Reset()         { *m = MsgWithdrawAndRepayResponse{} }
+func (m *MsgWithdrawAndRepayResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAndRepayResponse) ProtoMessage()    {}
+func (*MsgWithdrawAndRepayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63015631bbbf9425, []int{9}
+}
+func (m *MsgWithdrawAndRepayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAndRepayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAndRepayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawAndRepayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAndRepayResponse.Merge(m, src)
+}
+func (m *MsgWithdrawAndRepayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAndRepayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAndRepayResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawAndRepayResponse proto.InternalMessageInfo
+
+// MsgExecuteRoute executes an ordered list of actions atomically, failing if any step does not pass its guard.
+type MsgExecuteRoute struct {
+	// sender is the account executing the route
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// steps are the actions to execute in order
+	Steps []RouteStep `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps"`
+}
+
+func (m *MsgExecuteRoute) Reset()         { *m = MsgExecuteRoute{} }
+func (m *MsgExecuteRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteRoute) ProtoMessage()    {}
+func (*MsgExecuteRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63015631bbbf9425, []int{10}
+}
+func (m *MsgExecuteRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecuteRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteRoute.Merge(m, src)
+}
+func (m *MsgExecuteRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecuteRoute proto.InternalMessageInfo
+
+// MsgExecuteRouteResponse defines the Msg/MsgExecuteRoute response type.
+type MsgExecuteRouteResponse struct {
+	// outputs are the outputs of each step of the route
+	Outputs []types.Coin `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs"`
+}
+
+func (m *MsgExecuteRouteResponse) Reset()         { *m = MsgExecuteRouteResponse{} }
+func (m *MsgExecuteRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteRouteResponse) ProtoMessage()    {}
+func (*MsgExecuteRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63015631bbbf9425, []int{11}
+}
+func (m *MsgExecuteRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecuteRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteRouteResponse.Merge(m, src)
+}
+func (m *MsgExecuteRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecuteRouteResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgMintDeposit)(nil), "kava.router.v1beta1.MsgMintDeposit")
 	proto.RegisterType((*MsgMintDepositResponse)(nil), "kava.router.v1beta1.MsgMintDepositResponse")
@@ -361,42 +521,56 @@ func init() {
 	proto.RegisterType((*MsgWithdrawBurnResponse)(nil), "kava.router.v1beta1.MsgWithdrawBurnResponse")
 	proto.RegisterType((*MsgWithdrawBurnUndelegate)(nil), "kava.router.v1beta1.MsgWithdrawBurnUndelegate")
 	proto.RegisterType((*MsgWithdrawBurnUndelegateResponse)(nil), "kava.router.v1beta1.MsgWithdrawBurnUndelegateResponse")
+	proto.RegisterType((*MsgWithdrawAndRepay)(nil), "kava.router.v1beta1.MsgWithdrawAndRepay")
+	proto.RegisterType((*MsgWithdrawAndRepayResponse)(nil), "kava.router.v1beta1.MsgWithdrawAndRepayResponse")
+	proto.RegisterType((*MsgExecuteRoute)(nil), "kava.router.v1beta1.MsgExecuteRoute")
+	proto.RegisterType((*MsgExecuteRouteResponse)(nil), "kava.router.v1beta1.MsgExecuteRouteResponse")
 }
 
 func init() { proto.RegisterFile("kava/router/v1beta1/tx.proto", fileDescriptor_63015631bbbf9425) }
 
 var fileDescriptor_63015631bbbf9425 = []byte{
-	// 472 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xde, 0x31, 0xa5, 0x90, 0x57, 0x51, 0xd8, 0x96, 0x9a, 0x2c, 0x65, 0x8c, 0xa9, 0x87, 0x80,
-	0xed, 0x2c, 0x6d, 0xa1, 0x9e, 0x8d, 0xc5, 0x5b, 0x2e, 0x11, 0x11, 0xbc, 0x94, 0xd9, 0xec, 0x38,
-	0x1d, 0x4c, 0x66, 0xc2, 0xcc, 0x6c, 0x5a, 0x6f, 0xfe, 0x09, 0x9e, 0xbc, 0xea, 0xcd, 0x7f, 0xc0,
-	0xbf, 0x41, 0x72, 0x2c, 0x9e, 0x3c, 0x89, 0x26, 0xff, 0x88, 0xec, 0xcf, 0x34, 0x65, 0x17, 0xd3,
-	0x83, 0xd0, 0xdb, 0xdb, 0xf7, 0x7d, 0xef, 0x7b, 0xdf, 0x07, 0x6f, 0x07, 0x76, 0xde, 0xd1, 0x09,
-	0xf5, 0xb5, 0x8a, 0x2c, 0xd3, 0xfe, 0xe4, 0x20, 0x60, 0x96, 0x1e, 0xf8, 0xf6, 0x82, 0x8c, 0xb5,
-	0xb2, 0xca, 0xdd, 0x8c, 0x51, 0x92, 0xa2, 0x24, 0x43, 0x3d, 0x3c, 0x50, 0x66, 0xa4, 0x8c, 0x1f,
-	0x50, 0xc3, 0x8a, 0x91, 0x81, 0x12, 0x32, 0x1d, 0xf2, 0x9a, 0x29, 0x7e, 0x9a, 0x7c, 0xf9, 0xe9,
-	0x47, 0x06, 0x6d, 0x71, 0xc5, 0x55, 0xda, 0x8f, 0xab, 0xb4, 0xdb, 0xfe, 0x8c, 0xe0, 0x5e, 0xcf,
-	0xf0, 0x9e, 0x90, 0xf6, 0x84, 0x8d, 0x95, 0x11, 0xd6, 0x3d, 0x86, 0x7a, 0x98, 0x96, 0x4a, 0x37,
-	0x50, 0x0b, 0x75, 0xea, 0xdd, 0xc6, 0x8f, 0x6f, 0xfb, 0x5b, 0x99, 0xda, 0xb3, 0x30, 0xd4, 0xcc,
-	0x98, 0x97, 0x56, 0x0b, 0xc9, 0xfb, 0x0b, 0xaa, 0xbb, 0x03, 0xf5, 0x09, 0x1d, 0x8a, 0x90, 0xc6,
-	0x73, 0x77, 0xe2, 0xb9, 0xfe, 0xa2, 0xe1, 0x3e, 0x85, 0x75, 0x3a, 0x52, 0x91, 0xb4, 0x8d, 0x5a,
-	0x0b, 0x75, 0x36, 0x0e, 0x9b, 0x24, 0xd3, 0x8b, 0xa3, 0xe4, 0xf9, 0xc8, 0x73, 0x25, 0x64, 0x77,
-	0x6d, 0xfa, 0xeb, 0xa1, 0xd3, 0xcf, 0xe8, 0xed, 0x06, 0x6c, 0x2f, 0x1b, 0xec, 0x33, 0x33, 0x56,
-	0xd2, 0xb0, 0xf6, 0x57, 0x94, 0x40, 0x27, 0x6c, 0xc8, 0x38, 0xb5, 0xec, 0x16, 0x67, 0x68, 0x01,
-	0x2e, 0x37, 0x5a, 0x64, 0xf9, 0x84, 0xe0, 0x7e, 0xcf, 0xf0, 0xd7, 0xc2, 0x9e, 0x85, 0x9a, 0x9e,
-	0x77, 0x23, 0x2d, 0xdd, 0x3d, 0x58, 0x7b, 0xab, 0xd5, 0xe8, 0x9f, 0xfe, 0x13, 0xd6, 0xff, 0xb2,
-	0xde, 0x84, 0x07, 0xd7, 0x7c, 0x15, 0x9e, 0xbf, 0x20, 0x68, 0x5e, 0xc3, 0x5e, 0xc9, 0x30, 0x0b,
-	0x79, 0x3b, 0xdc, 0xef, 0xc2, 0xa3, 0x4a, 0x87, 0x79, 0x8e, 0xc3, 0xef, 0x35, 0xa8, 0xf5, 0x0c,
-	0x77, 0x4f, 0x61, 0xe3, 0xea, 0x0d, 0xed, 0x92, 0x92, 0x3f, 0x90, 0x2c, 0xdf, 0xa2, 0xf7, 0x64,
-	0x05, 0x52, 0xbe, 0xc8, 0x3d, 0x87, 0xcd, 0xb2, 0x63, 0xad, 0xd4, 0x28, 0x21, 0x7b, 0x47, 0x37,
-	0x20, 0x17, 0x8b, 0x03, 0xb8, 0xbb, 0x74, 0x59, 0x8f, 0xab, 0x44, 0xae, 0xb2, 0xbc, 0xbd, 0x55,
-	0x58, 0xc5, 0x8e, 0x0f, 0x08, 0xb6, 0x2b, 0x4e, 0x81, 0xac, 0x22, 0xb4, 0xe0, 0x7b, 0xc7, 0x37,
-	0xe3, 0xe7, 0x16, 0xba, 0x2f, 0xa6, 0x7f, 0xb0, 0x33, 0x9d, 0x61, 0x74, 0x39, 0xc3, 0xe8, 0xf7,
-	0x0c, 0xa3, 0x8f, 0x73, 0xec, 0x5c, 0xce, 0xb1, 0xf3, 0x73, 0x8e, 0x9d, 0x37, 0x1d, 0x2e, 0xec,
-	0x59, 0x14, 0x90, 0x81, 0x1a, 0xf9, 0xb1, 0xfe, 0xfe, 0x90, 0x06, 0x26, 0xa9, 0xfc, 0x8b, 0xfc,
-	0x15, 0xb6, 0xef, 0xc7, 0xcc, 0x04, 0xeb, 0xc9, 0xdb, 0x78, 0xf4, 0x37, 0x00, 0x00, 0xff, 0xff,
-	0x14, 0x23, 0x1c, 0x49, 0xa1, 0x05, 0x00, 0x00,
+	// 626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xb3, 0x4d, 0x5b, 0xd4, 0x29, 0x6a, 0x91, 0x5b, 0xb5, 0x8e, 0x29, 0x6e, 0x48, 0x91,
+	0x88, 0x44, 0x6b, 0xf7, 0x43, 0x2a, 0x82, 0x5b, 0x43, 0xe1, 0x96, 0x8b, 0x5b, 0x84, 0xc4, 0xa5,
+	0x5a, 0xc7, 0x8b, 0x6b, 0xe1, 0x78, 0x2d, 0xef, 0x3a, 0x4d, 0x4e, 0xf0, 0x08, 0x9c, 0x38, 0x70,
+	0x81, 0x1b, 0xe2, 0xce, 0x43, 0xe4, 0x58, 0x71, 0xe2, 0x84, 0x20, 0x79, 0x11, 0xe4, 0xcf, 0x7c,
+	0xe0, 0x28, 0x6e, 0x25, 0xa4, 0xde, 0x76, 0x77, 0x7e, 0x33, 0xfb, 0xff, 0xaf, 0x3c, 0x63, 0xd8,
+	0x78, 0x8b, 0x5b, 0x58, 0xf5, 0xa8, 0xcf, 0x89, 0xa7, 0xb6, 0xf6, 0x74, 0xc2, 0xf1, 0x9e, 0xca,
+	0xdb, 0x8a, 0xeb, 0x51, 0x4e, 0x85, 0x95, 0x20, 0xaa, 0x44, 0x51, 0x25, 0x8e, 0x4a, 0x72, 0x83,
+	0xb2, 0x26, 0x65, 0xaa, 0x8e, 0x19, 0x49, 0x53, 0x1a, 0xd4, 0x72, 0xa2, 0x24, 0xa9, 0x14, 0xc5,
+	0xcf, 0xc2, 0x9d, 0x1a, 0x6d, 0xe2, 0xd0, 0xaa, 0x49, 0x4d, 0x1a, 0x9d, 0x07, 0xab, 0xf8, 0x74,
+	0x33, 0x4b, 0x43, 0xb8, 0x8d, 0x80, 0xca, 0x67, 0x04, 0x4b, 0x75, 0x66, 0xd6, 0x2d, 0x87, 0x1f,
+	0x13, 0x97, 0x32, 0x8b, 0x0b, 0x87, 0xb0, 0x60, 0x44, 0x4b, 0xea, 0x89, 0xa8, 0x8c, 0xaa, 0x0b,
+	0x35, 0xf1, 0xc7, 0xf7, 0x9d, 0xd5, 0xf8, 0xba, 0x23, 0xc3, 0xf0, 0x08, 0x63, 0x27, 0xdc, 0xb3,
+	0x1c, 0x53, 0x1b, 0xa0, 0xc2, 0x06, 0x2c, 0xb4, 0xb0, 0x6d, 0x19, 0x38, 0xc8, 0x9b, 0x09, 0xf2,
+	0xb4, 0xc1, 0x81, 0xf0, 0x18, 0xe6, 0x71, 0x93, 0xfa, 0x0e, 0x17, 0x8b, 0x65, 0x54, 0x5d, 0xdc,
+	0x2f, 0x29, 0x71, 0xbd, 0xc0, 0x6b, 0xf2, 0x00, 0xca, 0x33, 0x6a, 0x39, 0xb5, 0xd9, 0xee, 0xaf,
+	0xcd, 0x82, 0x16, 0xe3, 0x15, 0x11, 0xd6, 0x46, 0x05, 0x6a, 0x84, 0xb9, 0xd4, 0x61, 0xa4, 0xf2,
+	0x15, 0x85, 0xa1, 0x63, 0x62, 0x13, 0x13, 0x73, 0x72, 0x83, 0x3d, 0x94, 0x41, 0xce, 0x16, 0x9a,
+	0x7a, 0xf9, 0x88, 0x60, 0xb9, 0xce, 0xcc, 0x57, 0x16, 0x3f, 0x37, 0x3c, 0x7c, 0x51, 0xf3, 0x3d,
+	0x47, 0xd8, 0x86, 0xd9, 0x37, 0x1e, 0x6d, 0x4e, 0xd5, 0x1f, 0x52, 0xff, 0x4b, 0x7a, 0x09, 0xd6,
+	0xc7, 0x74, 0xa5, 0x9a, 0xbf, 0x20, 0x28, 0x8d, 0xc5, 0x5e, 0x3a, 0x46, 0x6c, 0xf2, 0x66, 0xa8,
+	0xdf, 0x82, 0xfb, 0x13, 0x15, 0xa6, 0x3e, 0xbe, 0x21, 0x58, 0x19, 0xa2, 0x8e, 0x1c, 0x43, 0x23,
+	0x2e, 0xee, 0x08, 0xbb, 0x30, 0xcf, 0x88, 0x63, 0x90, 0xe9, 0x5f, 0x50, 0xcc, 0x09, 0x0f, 0x61,
+	0xb9, 0x41, 0x6d, 0x1b, 0x73, 0xe2, 0x61, 0xfb, 0x8c, 0x77, 0x5c, 0x12, 0x7b, 0x59, 0x1a, 0x1c,
+	0x9f, 0x76, 0x5c, 0x72, 0x7d, 0x43, 0xf7, 0xe0, 0x6e, 0x86, 0xd4, 0xd4, 0xca, 0xbb, 0xf0, 0x2b,
+	0x7a, 0xde, 0x26, 0x0d, 0x9f, 0x13, 0x2d, 0xe8, 0xf3, 0x6b, 0xb8, 0x78, 0x0a, 0x73, 0x8c, 0x13,
+	0x97, 0x89, 0x33, 0xe5, 0x62, 0x75, 0x71, 0x5f, 0x56, 0x32, 0x46, 0x95, 0x12, 0x16, 0x3f, 0xe1,
+	0xc4, 0x8d, 0x05, 0x46, 0x29, 0x95, 0x53, 0x58, 0x1f, 0x13, 0x90, 0x68, 0x13, 0x9e, 0xc0, 0x2d,
+	0xea, 0x73, 0xd7, 0xe7, 0x4c, 0x44, 0xe5, 0x62, 0x1e, 0xd3, 0x09, 0xbf, 0xff, 0x69, 0x0e, 0x8a,
+	0x75, 0x66, 0x0a, 0x67, 0xb0, 0x38, 0xdc, 0xe5, 0x5b, 0x99, 0xca, 0x46, 0xa7, 0x85, 0xf4, 0x28,
+	0x07, 0x94, 0x6a, 0xbc, 0x80, 0x95, 0xac, 0x71, 0x32, 0xb1, 0x46, 0x06, 0x2c, 0x1d, 0x5c, 0x01,
+	0x4e, 0x2f, 0xd6, 0xe1, 0xf6, 0x48, 0xef, 0x3f, 0x98, 0x54, 0x64, 0x98, 0x92, 0xb6, 0xf3, 0x50,
+	0xe9, 0x1d, 0xef, 0x11, 0xac, 0x4d, 0x68, 0x56, 0x25, 0x4f, 0xa1, 0x01, 0x2f, 0x1d, 0x5e, 0x8d,
+	0x4f, 0x25, 0x38, 0x70, 0xe7, 0x9f, 0x36, 0xab, 0x4e, 0xab, 0x95, 0x90, 0xd2, 0x6e, 0x5e, 0x72,
+	0xf8, 0x59, 0x47, 0x9a, 0x61, 0xe2, 0xb3, 0x0e, 0x53, 0xd2, 0x76, 0x1e, 0x2a, 0xb9, 0xa3, 0xf6,
+	0xa2, 0xfb, 0x47, 0x2e, 0x74, 0x7b, 0x32, 0xba, 0xec, 0xc9, 0xe8, 0x77, 0x4f, 0x46, 0x1f, 0xfa,
+	0x72, 0xe1, 0xb2, 0x2f, 0x17, 0x7e, 0xf6, 0xe5, 0xc2, 0xeb, 0xaa, 0x69, 0xf1, 0x73, 0x5f, 0x57,
+	0x1a, 0xb4, 0xa9, 0x06, 0x55, 0x77, 0x6c, 0xac, 0xb3, 0x70, 0xa5, 0xb6, 0x93, 0x1f, 0x73, 0x30,
+	0x28, 0x98, 0x3e, 0x1f, 0xfe, 0x91, 0x0f, 0xfe, 0x0e, 0x00, 0xf6, 0xd5, 0x2c, 0xcc, 0x38, 0x08,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -421,6 +595,10 @@ type MsgClient interface {
 	// WithdrawBurnUndelegate removes staking derivatives from an earn vault, converts them to a staking delegation,
 	// then undelegates them from their validator.
 	WithdrawBurnUndelegate(ctx context.Context, in *MsgWithdrawBurnUndelegate, opts ...grpc.CallOption) (*MsgWithdrawBurnUndelegateResponse, error)
+	// WithdrawAndRepay withdraws supplied funds from hard, then uses them to repay the debt of a cdp.
+	WithdrawAndRepay(ctx context.Context, in *MsgWithdrawAndRepay, opts ...grpc.CallOption) (*MsgWithdrawAndRepayResponse, error)
+	// ExecuteRoute executes an ordered list of actions atomically, failing if any step does not pass its guard.
+	ExecuteRoute(ctx context.Context, in *MsgExecuteRoute, opts ...grpc.CallOption) (*MsgExecuteRouteResponse, error)
 }
 
 type msgClient struct {
@@ -467,6 +645,24 @@ func (c *msgClient) WithdrawBurnUndelegate(ctx context.Context, in *MsgWithdrawB
 	return out, nil
 }
 
+func (c *msgClient) WithdrawAndRepay(ctx context.Context, in *MsgWithdrawAndRepay, opts ...grpc.CallOption) (*MsgWithdrawAndRepayResponse, error) {
+	out := new(MsgWithdrawAndRepayResponse)
+	err := c.cc.Invoke(ctx, "/kava.router.v1beta1.Msg/WithdrawAndRepay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExecuteRoute(ctx context.Context, in *MsgExecuteRoute, opts ...grpc.CallOption) (*MsgExecuteRouteResponse, error) {
+	out := new(MsgExecuteRouteResponse)
+	err := c.cc.Invoke(ctx, "/kava.router.v1beta1.Msg/ExecuteRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// MintDeposit converts a delegation into staking derivatives and deposits it all into an earn vault.
@@ -479,6 +675,10 @@ type MsgServer interface {
 	// WithdrawBurnUndelegate removes staking derivatives from an earn vault, converts them to a staking delegation,
 	// then undelegates them from their validator.
 	WithdrawBurnUndelegate(context.Context, *MsgWithdrawBurnUndelegate) (*MsgWithdrawBurnUndelegateResponse, error)
+	// WithdrawAndRepay withdraws supplied funds from hard, then uses them to repay the debt of a cdp.
+	WithdrawAndRepay(context.Context, *MsgWithdrawAndRepay) (*MsgWithdrawAndRepayResponse, error)
+	// ExecuteRoute executes an ordered list of actions atomically, failing if any step does not pass its guard.
+	ExecuteRoute(context.Context, *MsgExecuteRoute) (*MsgExecuteRouteResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -497,6 +697,12 @@ func (*UnimplementedMsgServer) WithdrawBurn(ctx context.Context, req *MsgWithdra
 func (*UnimplementedMsgServer) WithdrawBurnUndelegate(ctx context.Context, req *MsgWithdrawBurnUndelegate) (*MsgWithdrawBurnUndelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawBurnUndelegate not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawAndRepay(ctx context.Context, req *MsgWithdrawAndRepay) (*MsgWithdrawAndRepayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAndRepay not implemented")
+}
+func (*UnimplementedMsgServer) ExecuteRoute(ctx context.Context, req *MsgExecuteRoute) (*MsgExecuteRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteRoute not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -574,6 +780,42 @@ func _Msg_WithdrawBurnUndelegate_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawAndRepay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawAndRepay)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawAndRepay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.router.v1beta1.Msg/WithdrawAndRepay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawAndRepay(ctx, req.(*MsgWithdrawAndRepay))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExecuteRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.router.v1beta1.Msg/ExecuteRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteRoute(ctx, req.(*MsgExecuteRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.router.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -594,6 +836,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawBurnUndelegate",
 			Handler:    _Msg_WithdrawBurnUndelegate_Handler,
 		},
+		{
+			MethodName: "WithdrawAndRepay",
+			Handler:    _Msg_WithdrawAndRepay_Handler,
+		},
+		{
+			MethodName: "ExecuteRoute",
+			Handler:    _Msg_ExecuteRoute_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/router/v1beta1/tx.proto",
@@ -879,6 +1129,157 @@ func (m *MsgWithdrawBurnUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawAndRepay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawAndRepay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAndRepay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawAndRepayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawAndRepayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAndRepayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -988,27 +1389,286 @@ func (m *MsgWithdrawBurnUndelegate) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawBurnUndelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawAndRepay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawAndRepayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExecuteRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExecuteRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgMintDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgWithdrawBurnUndelegateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgMintDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateMintDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1031,10 +1691,10 @@ func (m *MsgMintDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateMintDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateMintDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1155,7 +1815,7 @@ func (m *MsgMintDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateMintDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1178,10 +1838,10 @@ func (m *MsgMintDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateMintDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateMintDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1205,7 +1865,7 @@ func (m *MsgMintDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateMintDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawBurn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1228,15 +1888,15 @@ func (m *MsgDelegateMintDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateMintDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawBurn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateMintDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawBurn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1264,7 +1924,7 @@ func (m *MsgDelegateMintDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1352,7 +2012,7 @@ func (m *MsgDelegateMintDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateMintDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawBurnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1375,10 +2035,10 @@ func (m *MsgDelegateMintDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateMintDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawBurnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateMintDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1402,7 +2062,7 @@ func (m *MsgDelegateMintDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawBurn) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawBurnUndelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1425,10 +2085,10 @@ func (m *MsgWithdrawBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawBurnUndelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawBurnUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1549,7 +2209,7 @@ func (m *MsgWithdrawBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawBurnResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawBurnUndelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1572,10 +2232,10 @@ func (m *MsgWithdrawBurnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawBurnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawBurnUndelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawBurnUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1599,7 +2259,7 @@ func (m *MsgWithdrawBurnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawBurnUndelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawAndRepay) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1622,15 +2282,15 @@ func (m *MsgWithdrawBurnUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawBurnUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawAndRepay: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawBurnUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawAndRepay: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1658,11 +2318,11 @@ func (m *MsgWithdrawBurnUndelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1690,7 +2350,7 @@ func (m *MsgWithdrawBurnUndelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -1746,7 +2406,7 @@ func (m *MsgWithdrawBurnUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawBurnUndelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawAndRepayResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1769,12 +2429,212 @@ func (m *MsgWithdrawBurnUndelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawBurnUndelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawAndRepayResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawBurnUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawAndRepayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, RouteStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, types.Coin{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
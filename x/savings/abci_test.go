@@ -0,0 +1,43 @@
+package savings_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/savings"
+	"github.com/kava-labs/kava/x/savings/keeper"
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+func TestBeginBlockerAfterMigrationFromV1Params(t *testing.T) {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)})
+	tApp.InitializeFromGenesisStates()
+	savingsKeeper := tApp.GetSavingsKeeper()
+
+	// Remove the params added in v2, leaving the store as it is on chains running v1
+	paramStore := ctx.KVStore(tApp.GetKVStoreKey(paramstypes.StoreKey))
+	paramStore.Delete(append([]byte(types.ModuleName+"/"), types.KeyInterestRates...))
+	paramStore.Delete(append([]byte(types.ModuleName+"/"), types.KeyDepositLimits...))
+	require.Panics(t, func() { savings.BeginBlocker(ctx, savingsKeeper) })
+
+	err := keeper.NewMigrator(savingsKeeper).Migrate1to2(ctx)
+	require.NoError(t, err)
+
+	require.NotPanics(t, func() { savings.BeginBlocker(ctx, savingsKeeper) })
+	params := savingsKeeper.GetParams(ctx)
+	require.Empty(t, params.InterestRates)
+	require.Empty(t, params.DepositLimits)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithBlockHeight(2)
+	require.NotPanics(t, func() { savings.BeginBlocker(ctx, savingsKeeper) })
+	_, err = keeper.NewQueryServerImpl(savingsKeeper).DepositCapacity(sdk.WrapSDKContext(ctx), &types.QueryDepositCapacityRequest{})
+	require.NoError(t, err)
+}
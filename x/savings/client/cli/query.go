@@ -34,6 +34,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		queryDepositsCmd(),
 		GetCmdTotalSupply(),
+		GetCmdAccruedInterest(),
+		queryDepositCapacityCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -156,3 +158,73 @@ func GetCmdTotalSupply() *cobra.Command {
 		},
 	}
 }
+
+// GetCmdAccruedInterest returns the command that queries the interest accrued by a depositor
+func GetCmdAccruedInterest() *cobra.Command {
+	return &cobra.Command{
+		Use:     "accrued-interest [owner]",
+		Short:   "get the interest accrued by a depositor",
+		Long:    "Get the interest accrued by a depositor that has not yet been added to their deposit.",
+		Example: fmt.Sprintf(`%[1]s q %[2]s accrued-interest kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccruedInterest(context.Background(), &types.QueryAccruedInterestRequest{
+				Owner: owner.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryDepositCapacityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-capacity",
+		Short: "query the deposit capacity of supported denoms",
+		Long:  "Query the deposit caps, pause switches and remaining deposit capacity of all supported denoms or a specific denom.",
+		Example: fmt.Sprintf(`%[1]s q %[2]s deposit-capacity
+%[1]s q %[2]s deposit-capacity --denom ukava`, version.AppName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DepositCapacity(context.Background(), &types.QueryDepositCapacityRequest{
+				Denom: denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagDenom, "", "(optional) filter for a single denom")
+
+	return cmd
+}
@@ -27,6 +27,7 @@ func GetTxCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		getCmdDeposit(),
 		getCmdWithdraw(),
+		getCmdWithdrawAll(),
 	}
 
 	for _, cmd := range cmds {
@@ -89,3 +90,26 @@ func getCmdWithdraw() *cobra.Command {
 		},
 	}
 }
+
+func getCmdWithdrawAll() *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-all",
+		Short: "withdraw all deposited coins from savings",
+		Example: fmt.Sprintf(
+			`%s tx %s withdraw-all --from <key>`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawAll(clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
@@ -2,6 +2,7 @@ package savings
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -21,6 +22,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, ak types.AccountKeeper, gs ty
 		k.SetDeposit(ctx, deposit)
 	}
 
+	for _, gat := range gs.PreviousAccrualTimes {
+		k.SetPreviousAccrualTime(ctx, gat.Denom, gat.PreviousAccrualTime)
+		k.SetInterestIndex(ctx, gat.Denom, gat.InterestIndex)
+	}
+
+	k.SetInterestReserve(ctx, gs.InterestReserve)
+
 	// check if the module account exists
 	SavingsModuleAccount := ak.GetModuleAccount(ctx, types.ModuleAccountName)
 	if SavingsModuleAccount == nil {
@@ -32,5 +40,16 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, ak types.AccountKeeper, gs ty
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 	deposits := k.GetAllDeposits(ctx)
-	return types.NewGenesisState(params, deposits)
+
+	var gats types.GenesisAccrualTimes
+	k.IteratePreviousAccrualTimes(ctx, func(denom string, previousAccrualTime time.Time) bool {
+		interestIndex, found := k.GetInterestIndex(ctx, denom)
+		if !found {
+			interestIndex = sdk.ZeroDec()
+		}
+		gats = append(gats, types.NewGenesisAccrualTime(denom, previousAccrualTime, interestIndex))
+		return false
+	})
+
+	return types.NewGenesisState(params, deposits, gats, k.GetInterestReserve(ctx))
 }
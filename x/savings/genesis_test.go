@@ -41,6 +41,12 @@ func (suite *GenesisTestSuite) SetupTest() {
 func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	params := types.NewParams(
 		[]string{"btc", "ukava", "bnb"},
+		types.InterestRates{
+			types.NewInterestRate("ukava", sdk.MustNewDecFromStr("0.05")),
+		},
+		types.DepositLimits{
+			types.NewDepositLimit("bnb", sdkmath.NewInt(1e10), false),
+		},
 	)
 
 	depositAmt := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e8)))
@@ -51,10 +57,14 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 			depositAmt, // 100 ukava
 		),
 	}
-	savingsGenesis := types.NewGenesisState(params, deposits)
+	accrualTimes := types.GenesisAccrualTimes{
+		types.NewGenesisAccrualTime("ukava", suite.genTime, sdk.MustNewDecFromStr("0.01")),
+	}
+	interestReserve := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6)))
+	savingsGenesis := types.NewGenesisState(params, deposits, accrualTimes, interestReserve)
 
 	authBuilder := app.NewAuthBankGenesisBuilder().
-		WithSimpleModuleAccount(types.ModuleAccountName, depositAmt)
+		WithSimpleModuleAccount(types.ModuleAccountName, depositAmt.Add(interestReserve...))
 
 	cdc := suite.app.AppCodec()
 	suite.NotPanics(
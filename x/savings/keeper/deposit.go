@@ -24,10 +24,12 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 
 	deposit := types.NewDeposit(depositor, coins)
 	if foundDeposit {
+		currDeposit = k.SyncDepositInterest(ctx, currDeposit)
 		deposit.Amount = deposit.Amount.Add(currDeposit.Amount...)
 		k.BeforeSavingsDepositModified(ctx, deposit, setDifference(getDenoms(coins), getDenoms(deposit.Amount)))
 
 	}
+	deposit = k.updateInterestIndexes(ctx, deposit)
 
 	k.SetDeposit(ctx, deposit)
 
@@ -48,22 +50,67 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 
 // ValidateDeposit validates a deposit
 func (k Keeper) ValidateDeposit(ctx sdk.Context, coins sdk.Coins) error {
+	params := k.GetParams(ctx)
 	for _, coin := range coins {
 		supported := k.IsDenomSupported(ctx, coin.Denom)
 		if !supported {
 			return errorsmod.Wrapf(types.ErrInvalidDepositDenom, ": %s", coin.Denom)
 		}
+
+		limit, found := params.DepositLimits.Get(coin.Denom)
+		if !found {
+			continue
+		}
+		if limit.Paused {
+			return errorsmod.Wrapf(types.ErrDepositsPaused, "%s", coin.Denom)
+		}
+		if limit.IsCapped() {
+			totalDeposits := k.GetTotalDepositedPrincipal(ctx, coin.Denom).Add(coin.Amount)
+			if totalDeposits.GT(limit.MaxTotalDeposits) {
+				return errorsmod.Wrapf(
+					types.ErrExceedsDepositCap,
+					"%s exceeds the %s deposit cap by %s",
+					coin,
+					coin.Denom,
+					totalDeposits.Sub(limit.MaxTotalDeposits),
+				)
+			}
+		}
 	}
 
 	return nil
 }
 
-// GetTotalDeposited returns the total amount deposited for the deposit denom
+// GetRemainingDepositCapacity returns the amount of a denom that can still be
+// deposited before its deposit cap is reached, and false if the denom has no
+// cap.
+func (k Keeper) GetRemainingDepositCapacity(ctx sdk.Context, denom string) (sdkmath.Int, bool) {
+	limit, found := k.GetParams(ctx).DepositLimits.Get(denom)
+	if !found || !limit.IsCapped() {
+		return sdkmath.ZeroInt(), false
+	}
+
+	remaining := limit.MaxTotalDeposits.Sub(k.GetTotalDepositedPrincipal(ctx, denom))
+	if remaining.IsNegative() {
+		return sdkmath.ZeroInt(), true
+	}
+	return remaining, true
+}
+
+// GetTotalDeposited returns the total amount deposited for the deposit denom,
+// including accrued interest that has not yet been added to deposits
 func (k Keeper) GetTotalDeposited(ctx sdk.Context, depositDenom string) (total sdkmath.Int) {
 	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
 	return k.bankKeeper.GetBalance(ctx, macc.GetAddress(), depositDenom).Amount
 }
 
+// GetTotalDepositedPrincipal returns the total amount deposited for the deposit
+// denom, excluding accrued interest that has not yet been added to deposits
+func (k Keeper) GetTotalDepositedPrincipal(ctx sdk.Context, depositDenom string) sdkmath.Int {
+	reserve := k.GetInterestReserve(ctx)
+	return k.GetTotalDeposited(ctx, depositDenom).Sub(reserve.AmountOf(depositDenom))
+}
+
 // Set setDifference: A - B
 func setDifference(a, b []string) (diff []string) {
 	m := make(map[string]bool)
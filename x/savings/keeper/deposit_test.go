@@ -162,8 +162,10 @@ func (suite *KeeperTestSuite) TestDeposit() {
 				[]sdk.AccAddress{tc.args.depositor},
 			)
 			savingsGS := types.NewGenesisState(
-				types.NewParams(tc.args.allowedDenoms),
+				types.NewParams(tc.args.allowedDenoms, nil, nil),
 				types.Deposits{},
+				nil,
+				nil,
 			)
 
 			stakingParams := stakingtypes.DefaultParams()
@@ -212,3 +214,47 @@ func (suite *KeeperTestSuite) TestDeposit() {
 
 func c(denom string, amount int64) sdk.Coin { return sdk.NewInt64Coin(denom, amount) }
 func cs(coins ...sdk.Coin) sdk.Coins        { return sdk.NewCoins(coins...) }
+
+func (suite *KeeperTestSuite) TestDeposit_DepositLimits() {
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("test")))
+	suite.CreateAccountWithAddress(depositor, sdk.NewCoins(
+		sdk.NewCoin("bnb", sdkmath.NewInt(1000)),
+		sdk.NewCoin("btcb", sdkmath.NewInt(1000)),
+	))
+
+	suite.keeper.SetParams(suite.ctx, types.NewParams(
+		[]string{"bnb", "btcb"},
+		nil,
+		types.DepositLimits{
+			types.NewDepositLimit("bnb", sdkmath.NewInt(500), false),
+			types.NewDepositLimit("btcb", sdkmath.ZeroInt(), true),
+		},
+	))
+
+	err := suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(400))))
+	suite.Require().NoError(err)
+
+	remaining, capped := suite.keeper.GetRemainingDepositCapacity(suite.ctx, "bnb")
+	suite.Require().True(capped)
+	suite.Require().Equal(sdkmath.NewInt(100), remaining)
+
+	err = suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(101))))
+	suite.Require().ErrorIs(err, types.ErrExceedsDepositCap)
+
+	err = suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(100))))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("btcb", sdkmath.NewInt(1))))
+	suite.Require().ErrorIs(err, types.ErrDepositsPaused)
+
+	_, capped = suite.keeper.GetRemainingDepositCapacity(suite.ctx, "btcb")
+	suite.Require().False(capped)
+
+	// withdrawals of paused denoms are not affected
+	params := suite.keeper.GetParams(suite.ctx)
+	params.DepositLimits[0].Paused = true
+	suite.keeper.SetParams(suite.ctx, params)
+
+	err = suite.keeper.Withdraw(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("bnb", sdkmath.NewInt(500))))
+	suite.Require().NoError(err)
+}
@@ -140,3 +140,67 @@ func (s queryServer) TotalSupply(ctx context.Context, req *types.QueryTotalSuppl
 		Result: totalSupply,
 	}, nil
 }
+
+// AccruedInterest implements the gRPC service handler for querying the interest
+// accrued by a depositor.
+func (s queryServer) AccruedInterest(ctx context.Context, req *types.QueryAccruedInterestRequest) (*types.QueryAccruedInterestResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	interest := sdk.NewCoins()
+	deposit, found := s.keeper.GetDeposit(sdkCtx, owner)
+	if found {
+		interest = s.keeper.GetAccruedInterest(sdkCtx, deposit)
+	}
+
+	return &types.QueryAccruedInterestResponse{
+		Interest: interest,
+	}, nil
+}
+
+// DepositCapacity implements the gRPC service handler for querying the deposit
+// capacity of supported denoms.
+func (s queryServer) DepositCapacity(ctx context.Context, req *types.QueryDepositCapacityRequest) (*types.QueryDepositCapacityResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := s.keeper.GetParams(sdkCtx)
+
+	denoms := params.SupportedDenoms
+	if len(req.Denom) > 0 {
+		if !s.keeper.IsDenomSupported(sdkCtx, req.Denom) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidDepositDenom, ": %s", req.Denom)
+		}
+		denoms = []string{req.Denom}
+	}
+
+	capacities := []types.DepositCapacity{}
+	for _, denom := range denoms {
+		capacity := types.DepositCapacity{
+			Denom:            denom,
+			TotalDeposited:   s.keeper.GetTotalDepositedPrincipal(sdkCtx, denom),
+			MaxTotalDeposits: sdk.ZeroInt(),
+		}
+		if limit, found := params.DepositLimits.Get(denom); found {
+			capacity.MaxTotalDeposits = limit.MaxTotalDeposits
+			capacity.Paused = limit.Paused
+		}
+		capacity.RemainingCapacity, _ = s.keeper.GetRemainingDepositCapacity(sdkCtx, denom)
+
+		capacities = append(capacities, capacity)
+	}
+
+	return &types.QueryDepositCapacityResponse{
+		Capacities: capacities,
+	}, nil
+}
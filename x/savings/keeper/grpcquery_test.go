@@ -59,7 +59,11 @@ func (suite *grpcQueryTestSuite) SetupTest() {
 	suite.Require().NoError(err)
 
 	savingsGenesis := types.GenesisState{
-		Params: types.NewParams([]string{"bnb", "busd", bkava1, bkava2}),
+		Params: types.NewParams(
+			[]string{"bnb", "busd", bkava1, bkava2},
+			types.InterestRates{types.NewInterestRate("busd", sdk.MustNewDecFromStr("0.05"))},
+			types.DepositLimits{types.NewDepositLimit("bnb", sdkmath.NewInt(1e12), false)},
+		),
 	}
 	savingsGenState := app.GenesisState{types.ModuleName: suite.tApp.AppCodec().MustMarshalJSON(&savingsGenesis)}
 
@@ -82,7 +86,11 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryParams() {
 
 	var expected types.GenesisState
 	savingsGenesis := types.GenesisState{
-		Params: types.NewParams([]string{"bnb", "busd", bkava1, bkava2}),
+		Params: types.NewParams(
+			[]string{"bnb", "busd", bkava1, bkava2},
+			types.InterestRates{types.NewInterestRate("busd", sdk.MustNewDecFromStr("0.05"))},
+			types.DepositLimits{types.NewDepositLimit("bnb", sdkmath.NewInt(1e12), false)},
+		),
 	}
 	savingsGenState := app.GenesisState{types.ModuleName: suite.tApp.AppCodec().MustMarshalJSON(&savingsGenesis)}
 	suite.tApp.AppCodec().MustUnmarshalJSON(savingsGenState[types.ModuleName], &expected)
@@ -265,6 +273,52 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryTotalSupply() {
 	})
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcQueryDepositCapacity() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.DepositLimits = types.DepositLimits{
+		types.NewDepositLimit("bnb", sdkmath.NewInt(1e9), false),
+		types.NewDepositLimit("busd", sdkmath.ZeroInt(), true),
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	suite.addDeposits([]types.Deposit{
+		dep(suite.addrs[0], cs(c("bnb", 3e8))),
+		dep(suite.addrs[1], cs(c("bnb", 2e8))),
+	})
+
+	res, err := suite.queryServer.DepositCapacity(
+		sdk.WrapSDKContext(suite.ctx),
+		&types.QueryDepositCapacityRequest{Denom: "bnb"},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DepositCapacity{
+		{
+			Denom:             "bnb",
+			TotalDeposited:    sdkmath.NewInt(5e8),
+			MaxTotalDeposits:  sdkmath.NewInt(1e9),
+			RemainingCapacity: sdkmath.NewInt(5e8),
+			Paused:            false,
+		},
+	}, res.Capacities)
+
+	res, err = suite.queryServer.DepositCapacity(
+		sdk.WrapSDKContext(suite.ctx),
+		&types.QueryDepositCapacityRequest{},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Capacities, len(params.SupportedDenoms))
+	suite.Require().Equal("busd", res.Capacities[1].Denom)
+	suite.Require().True(res.Capacities[1].Paused)
+	suite.Require().True(res.Capacities[1].MaxTotalDeposits.IsZero())
+	suite.Require().True(res.Capacities[1].RemainingCapacity.IsZero())
+
+	_, err = suite.queryServer.DepositCapacity(
+		sdk.WrapSDKContext(suite.ctx),
+		&types.QueryDepositCapacityRequest{Denom: "unsupported"},
+	)
+	suite.Require().ErrorIs(err, types.ErrInvalidDepositDenom)
+}
+
 func (suite *grpcQueryTestSuite) addDeposits(deposits types.Deposits) {
 	for _, dep := range deposits {
 		suite.NotPanics(func() {
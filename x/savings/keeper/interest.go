@@ -0,0 +1,259 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	communitytypes "github.com/kava-labs/kava/x/community/types"
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+var secondsPerYear = sdk.NewDec(31536000)
+
+// AccrueInterest accrues interest for every denom with an interest rate. The
+// interest for the time elapsed since the previous accrual is transferred from
+// the community pool to the module account and held in the interest reserve
+// until it is added to deposits. Denoms whose interest cannot be funded by the
+// community pool do not accrue interest for the elapsed time.
+func (k Keeper) AccrueInterest(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+
+	rateDenoms := make(map[string]bool)
+	for _, interestRate := range params.InterestRates {
+		rateDenoms[interestRate.Denom] = true
+
+		if err := k.accrueDenomInterest(ctx, interestRate); err != nil {
+			k.Logger(ctx).Info(fmt.Sprintf("could not accrue %s savings interest: %s", interestRate.Denom, err))
+		}
+		k.SetPreviousAccrualTime(ctx, interestRate.Denom, ctx.BlockTime())
+	}
+
+	// Denoms removed from the params stop accruing interest, their accrual time
+	// is kept current so that interest does not accrue retroactively if an
+	// interest rate is set again.
+	var removedDenoms []string
+	k.IteratePreviousAccrualTimes(ctx, func(denom string, _ time.Time) bool {
+		if !rateDenoms[denom] {
+			removedDenoms = append(removedDenoms, denom)
+		}
+		return false
+	})
+	for _, denom := range removedDenoms {
+		k.SetPreviousAccrualTime(ctx, denom, ctx.BlockTime())
+	}
+}
+
+// accrueDenomInterest funds the interest accrued by the deposits of a denom
+// since the previous accrual and increases its interest index.
+func (k Keeper) accrueDenomInterest(ctx sdk.Context, interestRate types.InterestRate) error {
+	previousAccrualTime, found := k.GetPreviousAccrualTime(ctx, interestRate.Denom)
+	if !found {
+		k.SetInterestIndex(ctx, interestRate.Denom, sdk.ZeroDec())
+		return nil
+	}
+
+	timeElapsed := int64(math.RoundToEven(
+		ctx.BlockTime().Sub(previousAccrualTime).Seconds(),
+	))
+	if timeElapsed <= 0 || !interestRate.Rate.IsPositive() {
+		return nil
+	}
+
+	// Interest accrues on deposited principal only, interest that has not yet
+	// been added to deposits is held in the reserve.
+	deposited := k.GetTotalDepositedPrincipal(ctx, interestRate.Denom)
+	if !deposited.IsPositive() {
+		return nil
+	}
+
+	indexIncrease := interestRate.Rate.MulInt64(timeElapsed).Quo(secondsPerYear)
+	interest := sdk.NewCoin(
+		interestRate.Denom,
+		sdk.NewDecFromInt(deposited).Mul(indexIncrease).Ceil().TruncateInt(),
+	)
+	if interest.IsZero() {
+		return nil
+	}
+
+	communityPool := k.accountKeeper.GetModuleAddress(communitytypes.ModuleAccountName)
+	available := k.bankKeeper.GetBalance(ctx, communityPool, interestRate.Denom)
+	if available.IsLT(interest) {
+		return fmt.Errorf("insufficient community pool funds: %s < %s", available, interest)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(
+		ctx,
+		communitytypes.ModuleAccountName,
+		types.ModuleAccountName,
+		sdk.NewCoins(interest),
+	); err != nil {
+		return err
+	}
+
+	k.SetInterestReserve(ctx, k.GetInterestReserve(ctx).Add(interest))
+
+	interestIndex, _ := k.GetInterestIndex(ctx, interestRate.Denom)
+	interestIndex = interestIndex.Add(indexIncrease)
+	k.SetInterestIndex(ctx, interestRate.Denom, interestIndex)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInterestAccrued,
+			sdk.NewAttribute(types.AttributeKeyDenom, interestRate.Denom),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, interest.String()),
+			sdk.NewAttribute(types.AttributeKeyInterestIndex, interestIndex.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetAccruedInterest returns the interest accrued by a deposit that has not
+// yet been added to it.
+func (k Keeper) GetAccruedInterest(ctx sdk.Context, deposit types.Deposit) sdk.Coins {
+	interest := sdk.NewCoins()
+	for _, coin := range deposit.Amount {
+		globalIndex, found := k.GetInterestIndex(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+		userIndex, _ := deposit.InterestIndexes.GetInterestIndex(coin.Denom)
+
+		amount := sdk.NewDecFromInt(coin.Amount).Mul(globalIndex.Sub(userIndex)).TruncateInt()
+		interest = interest.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return interest
+}
+
+// SyncDepositInterest adds the accrued interest of a deposit to its amount and
+// updates its interest indexes to the current ones. The updated deposit is
+// returned, it is not written to the store.
+func (k Keeper) SyncDepositInterest(ctx sdk.Context, deposit types.Deposit) types.Deposit {
+	interest := k.GetAccruedInterest(ctx, deposit)
+	if !interest.IsZero() {
+		k.SetInterestReserve(ctx, k.GetInterestReserve(ctx).Sub(interest...))
+		deposit.Amount = deposit.Amount.Add(interest...)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeInterestPaid,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, interest.String()),
+				sdk.NewAttribute(types.AttributeKeyDepositor, deposit.Depositor.String()),
+			),
+		)
+	}
+
+	return k.updateInterestIndexes(ctx, deposit)
+}
+
+// updateInterestIndexes sets the interest indexes of a deposit to the current
+// ones for each denom in the deposit.
+func (k Keeper) updateInterestIndexes(ctx sdk.Context, deposit types.Deposit) types.Deposit {
+	indexes := types.InterestIndexes{}
+	for _, coin := range deposit.Amount {
+		globalIndex, found := k.GetInterestIndex(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+		indexes = indexes.SetInterestIndex(coin.Denom, globalIndex)
+	}
+
+	if len(indexes) == 0 {
+		indexes = nil
+	}
+	deposit.InterestIndexes = indexes
+	return deposit
+}
+
+// GetPreviousAccrualTime returns the last time a denom accrued interest
+func (k Keeper) GetPreviousAccrualTime(ctx sdk.Context, denom string) (time.Time, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousAccrualTimeKeyPrefix)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return time.Time{}, false
+	}
+
+	var previousAccrualTime time.Time
+	if err := previousAccrualTime.UnmarshalBinary(bz); err != nil {
+		panic(err)
+	}
+	return previousAccrualTime, true
+}
+
+// SetPreviousAccrualTime sets the most recent accrual time for a denom
+func (k Keeper) SetPreviousAccrualTime(ctx sdk.Context, denom string, previousAccrualTime time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousAccrualTimeKeyPrefix)
+	bz, err := previousAccrualTime.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(denom), bz)
+}
+
+// IteratePreviousAccrualTimes iterates over all previous accrual times in the
+// store and performs a callback function
+func (k Keeper) IteratePreviousAccrualTimes(ctx sdk.Context, cb func(denom string, previousAccrualTime time.Time) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousAccrualTimeKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var previousAccrualTime time.Time
+		if err := previousAccrualTime.UnmarshalBinary(iterator.Value()); err != nil {
+			panic(err)
+		}
+		if cb(string(iterator.Key()), previousAccrualTime) {
+			break
+		}
+	}
+}
+
+// GetInterestIndex returns the current interest index of a denom
+func (k Keeper) GetInterestIndex(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.InterestIndexKeyPrefix)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return sdk.ZeroDec(), false
+	}
+	var interestIndex sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &interestIndex)
+	return interestIndex.Dec, true
+}
+
+// SetInterestIndex sets the current interest index of a denom
+func (k Keeper) SetInterestIndex(ctx sdk.Context, denom string, interestIndex sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.InterestIndexKeyPrefix)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: interestIndex})
+	store.Set([]byte(denom), bz)
+}
+
+// GetInterestReserve returns the accrued interest that has not yet been added
+// to deposits
+func (k Keeper) GetInterestReserve(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.InterestReserveKey)
+	if len(bz) == 0 {
+		return sdk.NewCoins()
+	}
+	var reserve types.CoinsProto
+	k.cdc.MustUnmarshal(bz, &reserve)
+	return reserve.Coins
+}
+
+// SetInterestReserve sets the accrued interest that has not yet been added to
+// deposits
+func (k Keeper) SetInterestReserve(ctx sdk.Context, coins sdk.Coins) {
+	store := ctx.KVStore(k.key)
+	if coins.Empty() {
+		store.Delete(types.InterestReserveKey)
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&types.CoinsProto{
+		Coins: coins,
+	})
+	store.Set(types.InterestReserveKey, bz)
+}
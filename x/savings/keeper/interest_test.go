@@ -0,0 +1,255 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/app"
+	communitytypes "github.com/kava-labs/kava/x/community/types"
+	"github.com/kava-labs/kava/x/savings/keeper"
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+const secondsPerYear = 31536000
+
+type interestTestSuite struct {
+	suite.Suite
+
+	tApp   app.TestApp
+	ctx    sdk.Context
+	keeper keeper.Keeper
+	addrs  []sdk.AccAddress
+}
+
+func (suite *interestTestSuite) SetupTest() {
+	suite.tApp = app.NewTestApp()
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	suite.addrs = addrs
+
+	suite.ctx = suite.tApp.NewContext(true, tmproto.Header{Height: 1}).
+		WithBlockTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	suite.keeper = suite.tApp.GetSavingsKeeper()
+
+	savingsGenesis := types.GenesisState{
+		Params: types.NewParams(
+			[]string{"ukava", "busd"},
+			types.InterestRates{
+				types.NewInterestRate("ukava", sdk.MustNewDecFromStr("0.1")),
+			},
+			nil,
+		),
+	}
+
+	suite.tApp.InitializeFromGenesisStates(
+		app.GenesisState{types.ModuleName: suite.tApp.AppCodec().MustMarshalJSON(&savingsGenesis)},
+		app.NewFundedGenStateWithSameCoins(
+			suite.tApp.AppCodec(),
+			cs(c("ukava", 10e9), c("busd", 10e9)),
+			addrs,
+		),
+	)
+}
+
+func TestInterestTestSuite(t *testing.T) {
+	suite.Run(t, new(interestTestSuite))
+}
+
+func (suite *interestTestSuite) fundCommunityPool(coins sdk.Coins) {
+	err := suite.tApp.FundModuleAccount(suite.ctx, communitytypes.ModuleAccountName, coins)
+	suite.Require().NoError(err)
+}
+
+func (suite *interestTestSuite) accrueAfter(duration time.Duration) {
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(duration))
+	suite.keeper.AccrueInterest(suite.ctx)
+}
+
+func (suite *interestTestSuite) requireSolvent() {
+	_, broken := keeper.SolvencyInvariant(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *interestTestSuite) TestAccrueInterest_InitializesDenoms() {
+	suite.keeper.AccrueInterest(suite.ctx)
+
+	accrualTime, found := suite.keeper.GetPreviousAccrualTime(suite.ctx, "ukava")
+	suite.Require().True(found)
+	suite.Require().Equal(suite.ctx.BlockTime(), accrualTime)
+
+	index, found := suite.keeper.GetInterestIndex(suite.ctx, "ukava")
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.ZeroDec(), index)
+
+	_, found = suite.keeper.GetPreviousAccrualTime(suite.ctx, "busd")
+	suite.Require().False(found, "denoms without an interest rate should not accrue interest")
+}
+
+func (suite *interestTestSuite) TestAccrueInterest() {
+	suite.fundCommunityPool(cs(c("ukava", 1e9)))
+	suite.keeper.AccrueInterest(suite.ctx)
+
+	err := suite.keeper.Deposit(suite.ctx, suite.addrs[0], cs(c("ukava", 1e9), c("busd", 1e9)))
+	suite.Require().NoError(err)
+
+	suite.accrueAfter(secondsPerYear * time.Second)
+
+	index, found := suite.keeper.GetInterestIndex(suite.ctx, "ukava")
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.1"), index)
+	suite.Require().Equal(cs(c("ukava", 1e8)), suite.keeper.GetInterestReserve(suite.ctx))
+
+	communityPool := suite.tApp.GetAccountKeeper().GetModuleAddress(communitytypes.ModuleAccountName)
+	suite.Require().Equal(
+		c("ukava", 9e8),
+		suite.tApp.GetBankKeeper().GetBalance(suite.ctx, communityPool, "ukava"),
+	)
+
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("ukava", 1e8)), suite.keeper.GetAccruedInterest(suite.ctx, deposit))
+
+	suite.requireSolvent()
+}
+
+func (suite *interestTestSuite) TestAccrueInterest_InsufficientCommunityPool() {
+	suite.fundCommunityPool(cs(c("ukava", 1e6)))
+	suite.keeper.AccrueInterest(suite.ctx)
+
+	err := suite.keeper.Deposit(suite.ctx, suite.addrs[0], cs(c("ukava", 1e9)))
+	suite.Require().NoError(err)
+
+	suite.accrueAfter(secondsPerYear * time.Second)
+
+	index, _ := suite.keeper.GetInterestIndex(suite.ctx, "ukava")
+	suite.Require().Equal(sdk.ZeroDec(), index)
+	suite.Require().True(suite.keeper.GetInterestReserve(suite.ctx).IsZero())
+
+	accrualTime, _ := suite.keeper.GetPreviousAccrualTime(suite.ctx, "ukava")
+	suite.Require().Equal(suite.ctx.BlockTime(), accrualTime, "unfunded interest should not be accrued later")
+
+	suite.requireSolvent()
+}
+
+func (suite *interestTestSuite) TestAccrueInterest_RemovedRate() {
+	suite.fundCommunityPool(cs(c("ukava", 1e9)))
+	suite.keeper.AccrueInterest(suite.ctx)
+
+	err := suite.keeper.Deposit(suite.ctx, suite.addrs[0], cs(c("ukava", 1e9)))
+	suite.Require().NoError(err)
+
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]string{"ukava", "busd"}, nil, nil))
+	suite.accrueAfter(secondsPerYear * time.Second)
+
+	index, _ := suite.keeper.GetInterestIndex(suite.ctx, "ukava")
+	suite.Require().Equal(sdk.ZeroDec(), index)
+
+	// setting the rate again does not accrue interest for the time without one
+	suite.keeper.SetParams(suite.ctx, types.NewParams(
+		[]string{"ukava", "busd"},
+		types.InterestRates{types.NewInterestRate("ukava", sdk.MustNewDecFromStr("0.1"))},
+		nil,
+	))
+	suite.accrueAfter(secondsPerYear * time.Second / 2)
+
+	index, _ = suite.keeper.GetInterestIndex(suite.ctx, "ukava")
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.05"), index)
+}
+
+func (suite *interestTestSuite) TestWithdraw_PaysInterest() {
+	suite.fundCommunityPool(cs(c("ukava", 1e9)))
+	suite.keeper.AccrueInterest(suite.ctx)
+
+	err := suite.keeper.Deposit(suite.ctx, suite.addrs[0], cs(c("ukava", 1e9)))
+	suite.Require().NoError(err)
+
+	suite.accrueAfter(secondsPerYear * time.Second)
+
+	err = suite.keeper.Withdraw(suite.ctx, suite.addrs[0], cs(c("ukava", 2e9)))
+	suite.Require().NoError(err)
+
+	_, found := suite.keeper.GetDeposit(suite.ctx, suite.addrs[0])
+	suite.Require().False(found)
+	suite.Require().Equal(
+		sdkmath.NewInt(10e9+1e8),
+		suite.tApp.GetBankKeeper().GetBalance(suite.ctx, suite.addrs[0], "ukava").Amount,
+	)
+	suite.Require().True(suite.keeper.GetInterestReserve(suite.ctx).IsZero())
+
+	suite.requireSolvent()
+}
+
+func (suite *interestTestSuite) TestDeposit_SyncsInterest() {
+	suite.fundCommunityPool(cs(c("ukava", 1e9)))
+	suite.keeper.AccrueInterest(suite.ctx)
+
+	err := suite.keeper.Deposit(suite.ctx, suite.addrs[0], cs(c("ukava", 1e9)))
+	suite.Require().NoError(err)
+
+	suite.accrueAfter(secondsPerYear * time.Second)
+
+	// a later depositor does not earn interest accrued before their deposit
+	err = suite.keeper.Deposit(suite.ctx, suite.addrs[1], cs(c("ukava", 1e9)))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.Deposit(suite.ctx, suite.addrs[0], cs(c("ukava", 1e9)))
+	suite.Require().NoError(err)
+
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("ukava", 21e8)), deposit.Amount)
+	suite.Require().Equal(
+		types.InterestIndexes{types.NewInterestIndex("ukava", sdk.MustNewDecFromStr("0.1"))},
+		deposit.InterestIndexes,
+	)
+	suite.Require().True(suite.keeper.GetAccruedInterest(suite.ctx, deposit).IsZero())
+
+	laterDeposit, found := suite.keeper.GetDeposit(suite.ctx, suite.addrs[1])
+	suite.Require().True(found)
+	suite.Require().True(suite.keeper.GetAccruedInterest(suite.ctx, laterDeposit).IsZero())
+
+	suite.accrueAfter(secondsPerYear * time.Second)
+
+	deposit, _ = suite.keeper.GetDeposit(suite.ctx, suite.addrs[0])
+	suite.Require().Equal(cs(c("ukava", 21e7)), suite.keeper.GetAccruedInterest(suite.ctx, deposit))
+	laterDeposit, _ = suite.keeper.GetDeposit(suite.ctx, suite.addrs[1])
+	suite.Require().Equal(cs(c("ukava", 1e8)), suite.keeper.GetAccruedInterest(suite.ctx, laterDeposit))
+
+	suite.requireSolvent()
+}
+
+func (suite *interestTestSuite) TestGrpcQueryAccruedInterest() {
+	queryServer := keeper.NewQueryServerImpl(suite.keeper)
+
+	suite.fundCommunityPool(cs(c("ukava", 1e9)))
+	suite.keeper.AccrueInterest(suite.ctx)
+
+	err := suite.keeper.Deposit(suite.ctx, suite.addrs[0], cs(c("ukava", 1e9)))
+	suite.Require().NoError(err)
+
+	suite.accrueAfter(secondsPerYear * time.Second)
+
+	res, err := queryServer.AccruedInterest(
+		sdk.WrapSDKContext(suite.ctx),
+		&types.QueryAccruedInterestRequest{Owner: suite.addrs[0].String()},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(cs(c("ukava", 1e8)), res.Interest)
+
+	res, err = queryServer.AccruedInterest(
+		sdk.WrapSDKContext(suite.ctx),
+		&types.QueryAccruedInterestRequest{Owner: suite.addrs[1].String()},
+	)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Interest.IsZero())
+
+	_, err = queryServer.AccruedInterest(
+		sdk.WrapSDKContext(suite.ctx),
+		&types.QueryAccruedInterestRequest{Owner: "invalid"},
+	)
+	suite.Require().Error(err)
+}
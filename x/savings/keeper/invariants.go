@@ -46,14 +46,14 @@ func DepositsInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
-// SolvencyInvariant iterates all deposits and ensures the total amount matches the module account coins
+// SolvencyInvariant iterates all deposits and ensures the total amount plus the interest reserve matches the module account coins
 func SolvencyInvariant(k Keeper) sdk.Invariant {
 	message := sdk.FormatInvariant(types.ModuleName, "module solvency broken", "total deposited amount does not match module account")
 
 	return func(ctx sdk.Context) (string, bool) {
 		balance := k.GetSavingsModuleAccountBalances(ctx)
 
-		deposited := sdk.Coins{}
+		deposited := k.GetInterestReserve(ctx)
 		k.IterateDeposits(ctx, func(deposit types.Deposit) bool {
 			for _, coin := range deposit.Amount {
 				deposited = deposited.Add(coin)
@@ -65,3 +65,26 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*typ
 	)
 	return &types.MsgWithdrawResponse{}, nil
 }
+
+func (k msgServer) WithdrawAll(goCtx context.Context, msg *types.MsgWithdrawAll) (*types.MsgWithdrawAllResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := k.keeper.WithdrawAll(ctx, depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor),
+		),
+	)
+	return &types.MsgWithdrawAllResponse{Amount: amount}, nil
+}
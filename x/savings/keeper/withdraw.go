@@ -15,6 +15,7 @@ func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Co
 	if !found {
 		return errorsmod.Wrap(types.ErrNoDepositFound, fmt.Sprintf(" for address: %s", depositor.String()))
 	}
+	deposit = k.SyncDepositInterest(ctx, deposit)
 
 	amount, err := k.CalculateWithdrawAmount(deposit.Amount, coins)
 	if err != nil {
@@ -30,7 +31,7 @@ func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Co
 	if deposit.Amount.Empty() {
 		k.DeleteDeposit(ctx, deposit)
 	} else {
-		k.SetDeposit(ctx, deposit)
+		k.SetDeposit(ctx, k.updateInterestIndexes(ctx, deposit))
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -43,6 +44,37 @@ func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Co
 	return nil
 }
 
+// WithdrawAll returns the entire deposit of a depositor, including accrued
+// interest, back to the depositor in a single transfer. Deposit hooks are
+// called once for the whole deposit.
+func (k Keeper) WithdrawAll(ctx sdk.Context, depositor sdk.AccAddress) (sdk.Coins, error) {
+	deposit, found := k.GetDeposit(ctx, depositor)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrNoDepositFound, fmt.Sprintf(" for address: %s", depositor.String()))
+	}
+
+	k.BeforeSavingsDepositModified(ctx, deposit, nil)
+
+	deposit = k.SyncDepositInterest(ctx, deposit)
+	amount := deposit.Amount
+
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, depositor, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	k.DeleteDeposit(ctx, deposit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSavingsWithdrawal,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+		),
+	)
+	return amount, nil
+}
+
 // CalculateWithdrawAmount enables full withdraw of deposited coins by adjusting withdraw amount
 // to equal total deposit amount if the requested withdraw amount > current deposit amount
 func (k Keeper) CalculateWithdrawAmount(available sdk.Coins, request sdk.Coins) (sdk.Coins, error) {
@@ -148,8 +148,10 @@ func (suite *KeeperTestSuite) TestWithdraw() {
 				[]sdk.AccAddress{tc.args.depositor},
 			)
 			savingsGS := types.NewGenesisState(
-				types.NewParams(tc.args.allowedDenoms),
+				types.NewParams(tc.args.allowedDenoms, nil, nil),
 				types.Deposits{},
+				nil,
+				nil,
 			)
 
 			stakingParams := stakingtypes.DefaultParams()
@@ -200,3 +202,43 @@ func (suite *KeeperTestSuite) TestWithdraw() {
 		})
 	}
 }
+
+// recordingSavingsHooks records the deposits passed to savings hooks
+type recordingSavingsHooks struct {
+	modified *[]types.Deposit
+}
+
+func (h recordingSavingsHooks) AfterSavingsDepositCreated(sdk.Context, types.Deposit) {}
+
+func (h recordingSavingsHooks) BeforeSavingsDepositModified(_ sdk.Context, deposit types.Deposit, _ []string) {
+	*h.modified = append(*h.modified, deposit)
+}
+
+func (suite *KeeperTestSuite) TestWithdrawAll() {
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("test")))
+	initialBalance := cs(c("bnb", 1000), c("btcb", 1000), c("ukava", 1000))
+	suite.CreateAccountWithAddress(depositor, initialBalance)
+
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]string{"bnb", "btcb", "ukava"}, nil, nil))
+
+	err := suite.keeper.Deposit(suite.ctx, depositor, cs(c("bnb", 100), c("btcb", 200)))
+	suite.Require().NoError(err)
+
+	var modified []types.Deposit
+	suite.keeper.SetHooks(types.NewMultiSavingsHooks(recordingSavingsHooks{modified: &modified}))
+
+	amount, err := suite.keeper.WithdrawAll(suite.ctx, depositor)
+	suite.Require().NoError(err)
+	suite.Require().Equal(cs(c("bnb", 100), c("btcb", 200)), amount)
+
+	_, found := suite.keeper.GetDeposit(suite.ctx, depositor)
+	suite.Require().False(found)
+	suite.Require().Equal(initialBalance, suite.getAccountCoins(suite.getAccount(depositor)))
+	suite.Require().True(suite.getAccountCoins(suite.getModuleAccount(types.ModuleAccountName)).IsZero())
+
+	suite.Require().Len(modified, 1, "hooks should be called once for all denoms")
+	suite.Require().Equal(cs(c("bnb", 100), c("btcb", 200)), modified[0].Amount)
+
+	_, err = suite.keeper.WithdrawAll(suite.ctx, depositor)
+	suite.Require().ErrorIs(err, types.ErrNoDepositFound)
+}
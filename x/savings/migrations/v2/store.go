@@ -0,0 +1,28 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+// MigrateStore performs in-place store migrations for consensus version 2
+// V2 adds the interest_rates and deposit_limits params, seeded with no interest rates or deposit limits.
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	migrateParamsStore(ctx, paramstore)
+	return nil
+}
+
+// migrateParamsStore ensures the param key table exists and has the interest rates & deposit limits properties
+func migrateParamsStore(ctx sdk.Context, paramstore paramtypes.Subspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+	if !paramstore.Has(ctx, types.KeyInterestRates) {
+		paramstore.Set(ctx, types.KeyInterestRates, types.DefaultInterestRates)
+	}
+	if !paramstore.Has(ctx, types.KeyDepositLimits) {
+		paramstore.Set(ctx, types.KeyDepositLimits, types.DefaultDepositLimits)
+	}
+}
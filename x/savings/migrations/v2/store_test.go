@@ -0,0 +1,58 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v2savings "github.com/kava-labs/kava/x/savings/migrations/v2"
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+func TestStoreMigrationAddsKeyTableIncludingNewParams(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	savingsKey := sdk.NewKVStoreKey(types.ModuleName)
+	tSavingsKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(savingsKey, tSavingsKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, savingsKey, tSavingsKey, types.ModuleName)
+
+	// Check params don't exist before
+	require.False(t, paramstore.Has(ctx, types.KeyInterestRates))
+	require.False(t, paramstore.Has(ctx, types.KeyDepositLimits))
+
+	// Run migrations.
+	err := v2savings.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	require.True(t, paramstore.Has(ctx, types.KeyInterestRates))
+	require.True(t, paramstore.Has(ctx, types.KeyDepositLimits))
+}
+
+func TestStoreMigrationKeepsExistingParams(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	savingsKey := sdk.NewKVStoreKey(types.ModuleName)
+	tSavingsKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(savingsKey, tSavingsKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, savingsKey, tSavingsKey, types.ModuleName)
+	paramstore.WithKeyTable(types.ParamKeyTable())
+
+	supportedDenoms := []string{"ukava"}
+	paramstore.Set(ctx, types.KeySupportedDenoms, supportedDenoms)
+
+	// Run migrations.
+	err := v2savings.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the existing params are untouched and the new params are empty.
+	var params types.Params
+	paramstore.GetParamSet(ctx, &params)
+	require.Equal(t, supportedDenoms, params.SupportedDenoms)
+	require.Empty(t, params.InterestRates)
+	require.Empty(t, params.DepositLimits)
+}
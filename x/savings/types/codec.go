@@ -14,12 +14,14 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDeposit{}, "savings/MsgDeposit", nil)
 	cdc.RegisterConcrete(&MsgWithdraw{}, "savings/MsgWithdraw", nil)
+	cdc.RegisterConcrete(&MsgWithdrawAll{}, "savings/MsgWithdrawAll", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgDeposit{},
 		&MsgWithdraw{},
+		&MsgWithdrawAll{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
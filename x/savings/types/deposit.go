@@ -22,6 +22,9 @@ func (d Deposit) Validate() error {
 	if !d.Amount.IsValid() {
 		return fmt.Errorf("invalid deposit coins: %s", d.Amount)
 	}
+	if err := d.InterestIndexes.Validate(); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDepositLimit returns a new DepositLimit
+func NewDepositLimit(denom string, maxTotalDeposits sdk.Int, paused bool) DepositLimit {
+	return DepositLimit{
+		Denom:            denom,
+		MaxTotalDeposits: maxTotalDeposits,
+		Paused:           paused,
+	}
+}
+
+// Validate validates DepositLimit values
+func (dl DepositLimit) Validate() error {
+	if err := sdk.ValidateDenom(dl.Denom); err != nil {
+		return fmt.Errorf("invalid deposit limit denom: %w", err)
+	}
+	if dl.MaxTotalDeposits.IsNil() || dl.MaxTotalDeposits.IsNegative() {
+		return fmt.Errorf("max total deposits must be non-negative, is %s for %s", dl.MaxTotalDeposits, dl.Denom)
+	}
+	return nil
+}
+
+// IsCapped returns true if the total deposits of the denom are capped
+func (dl DepositLimit) IsCapped() bool {
+	return dl.MaxTotalDeposits.IsPositive()
+}
+
+// DepositLimits is a slice of DepositLimit
+type DepositLimits []DepositLimit
+
+// Get returns the deposit limit of a denom
+func (dls DepositLimits) Get(denom string) (DepositLimit, bool) {
+	for _, dl := range dls {
+		if dl.Denom == denom {
+			return dl, true
+		}
+	}
+	return DepositLimit{}, false
+}
+
+// Validate validates DepositLimits
+func (dls DepositLimits) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for _, dl := range dls {
+		if err := dl.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[dl.Denom] {
+			return fmt.Errorf("duplicated deposit limit denom %s", dl.Denom)
+		}
+		seenDenoms[dl.Denom] = true
+	}
+	return nil
+}
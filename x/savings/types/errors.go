@@ -13,4 +13,8 @@ var (
 	ErrInvalidDepositDenom = errorsmod.Register(ModuleName, 4, "invalid deposit denom")
 	// ErrInvalidWithdrawDenom error for invalid withdraw denoms
 	ErrInvalidWithdrawDenom = errorsmod.Register(ModuleName, 5, "invalid withdraw denom")
+	// ErrDepositsPaused error for deposits of a paused denom
+	ErrDepositsPaused = errorsmod.Register(ModuleName, 6, "deposits paused")
+	// ErrExceedsDepositCap error for deposits that exceed the deposit cap of a denom
+	ErrExceedsDepositCap = errorsmod.Register(ModuleName, 7, "deposit exceeds deposit cap")
 )
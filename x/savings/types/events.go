@@ -3,8 +3,12 @@ package types
 const (
 	EventTypeSavingsDeposit    = "deposit_savings"
 	EventTypeSavingsWithdrawal = "withdraw_savings"
+	EventTypeInterestAccrued   = "savings_interest_accrued"
+	EventTypeInterestPaid      = "savings_interest_paid"
 
-	AttributeValueCategory = ModuleName
-	AttributeKeyAmount     = "amount"
-	AttributeKeyDepositor  = "depositor"
+	AttributeValueCategory    = ModuleName
+	AttributeKeyAmount        = "amount"
+	AttributeKeyDepositor     = "depositor"
+	AttributeKeyDenom         = "denom"
+	AttributeKeyInterestIndex = "interest_index"
 )
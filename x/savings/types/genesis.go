@@ -1,10 +1,23 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // NewGenesisState creates a new genesis state for the savings module
-func NewGenesisState(p Params, deposits Deposits) GenesisState {
+func NewGenesisState(
+	p Params,
+	deposits Deposits,
+	prevAccrualTimes GenesisAccrualTimes,
+	interestReserve sdk.Coins,
+) GenesisState {
 	return GenesisState{
-		Params:   p,
-		Deposits: deposits,
+		Params:               p,
+		Deposits:             deposits,
+		PreviousAccrualTimes: prevAccrualTimes,
+		InterestReserve:      interestReserve,
 	}
 }
 
@@ -13,6 +26,8 @@ func DefaultGenesisState() GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
 		Deposits{},
+		GenesisAccrualTimes{},
+		sdk.Coins{},
 	)
 }
 
@@ -23,5 +38,17 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	return gs.Deposits.Validate()
+	if err := gs.Deposits.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.PreviousAccrualTimes.Validate(); err != nil {
+		return err
+	}
+
+	if !gs.InterestReserve.IsValid() {
+		return fmt.Errorf("invalid interest reserve coins: %s", gs.InterestReserve)
+	}
+
+	return nil
 }
@@ -5,17 +5,24 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,8 +33,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the savings module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params   Params   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Deposits Deposits `protobuf:"bytes,2,rep,name=deposits,proto3,castrepeated=Deposits" json:"deposits"`
+	Params               Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Deposits             Deposits            `protobuf:"bytes,2,rep,name=deposits,proto3,castrepeated=Deposits" json:"deposits"`
+	PreviousAccrualTimes GenesisAccrualTimes `protobuf:"bytes,3,rep,name=previous_accrual_times,json=previousAccrualTimes,proto3,castrepeated=GenesisAccrualTimes" json:"previous_accrual_times"`
+	// interest_reserve is the accrued interest held by the module account that
+	// has not yet been added to deposits.
+	InterestReserve github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=interest_reserve,json=interestReserve,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"interest_reserve"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,8 +88,78 @@ func (m *GenesisState) GetDeposits() Deposits {
 	return nil
 }
 
+func (m *GenesisState) GetPreviousAccrualTimes() GenesisAccrualTimes {
+	if m != nil {
+		return m.PreviousAccrualTimes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInterestReserve() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.InterestReserve
+	}
+	return nil
+}
+
+// GenesisAccrualTime stores the previous interest accrual time and the
+// interest index of a denom.
+type GenesisAccrualTime struct {
+	Denom               string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	PreviousAccrualTime time.Time                              `protobuf:"bytes,2,opt,name=previous_accrual_time,json=previousAccrualTime,proto3,stdtime" json:"previous_accrual_time"`
+	InterestIndex       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=interest_index,json=interestIndex,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"interest_index"`
+}
+
+func (m *GenesisAccrualTime) Reset()         { *m = GenesisAccrualTime{} }
+func (m *GenesisAccrualTime) String() string { return proto.CompactTextString(m) }
+func (*GenesisAccrualTime) ProtoMessage()    {}
+func (*GenesisAccrualTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5dcde4d417fcec8, []int{1}
+}
+func (m *GenesisAccrualTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisAccrualTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisAccrualTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisAccrualTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisAccrualTime.Merge(m, src)
+}
+func (m *GenesisAccrualTime) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisAccrualTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisAccrualTime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisAccrualTime proto.InternalMessageInfo
+
+func (m *GenesisAccrualTime) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *GenesisAccrualTime) GetPreviousAccrualTime() time.Time {
+	if m != nil {
+		return m.PreviousAccrualTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.savings.v1beta1.GenesisState")
+	proto.RegisterType((*GenesisAccrualTime)(nil), "kava.savings.v1beta1.GenesisAccrualTime")
 }
 
 func init() {
@@ -86,23 +167,39 @@ func init() {
 }
 
 var fileDescriptor_f5dcde4d417fcec8 = []byte{
-	// 245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xca, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x2f, 0x4e, 0x2c, 0xcb, 0xcc, 0x4b, 0x2f, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x01, 0xa9, 0xd1, 0x83, 0xaa, 0xd1, 0x83, 0xaa, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0x14, 0xb0, 0x9a, 0x57, 0x5c, 0x92, 0x5f, 0x94, 0x0a, 0x51,
-	0xa1, 0x34, 0x9d, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x7e, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x15,
-	0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x8c,
-	0x1e, 0x36, 0xfb, 0xf4, 0x02, 0xc0, 0x6a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xea,
-	0x10, 0xf2, 0xe6, 0xe2, 0x48, 0x49, 0x2d, 0xc8, 0x2f, 0xce, 0x2c, 0x29, 0x96, 0x60, 0x52, 0x60,
-	0xd6, 0xe0, 0x36, 0x92, 0xc5, 0xae, 0xdb, 0x05, 0xa2, 0xca, 0x49, 0x00, 0xa4, 0x7d, 0xd5, 0x7d,
-	0x79, 0x0e, 0xa8, 0x40, 0x71, 0x10, 0xdc, 0x00, 0x27, 0xe7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c,
-	0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e,
-	0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
-	0x07, 0x19, 0xaf, 0x9b, 0x93, 0x98, 0x54, 0x0c, 0x66, 0xe9, 0x57, 0xc0, 0x3d, 0x5b, 0x52, 0x59,
-	0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0xa5, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x68, 0xf5,
-	0x36, 0x59, 0x01, 0x00, 0x00,
+	// 497 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x9b, 0x52, 0x85, 0x2d, 0x3f, 0x95, 0x1b, 0x90, 0x1b, 0xc0, 0x8e, 0x72, 0x40, 0xe1,
+	0x90, 0x35, 0x2d, 0x37, 0xc4, 0x05, 0x37, 0x12, 0x42, 0x5c, 0x90, 0xe9, 0x01, 0x71, 0x89, 0xd6,
+	0xce, 0x60, 0x56, 0x8d, 0xbd, 0x96, 0x67, 0x63, 0x15, 0xf1, 0x0a, 0x1c, 0xfa, 0x1c, 0x9c, 0x79,
+	0x88, 0x1e, 0x2b, 0x4e, 0x88, 0x43, 0x8b, 0x92, 0x13, 0x6f, 0x81, 0xbc, 0xbb, 0xb6, 0x2a, 0xd5,
+	0x87, 0x9e, 0xec, 0x99, 0xf9, 0xbe, 0xf9, 0xe6, 0xdb, 0x19, 0x32, 0x3a, 0x66, 0x25, 0xf3, 0x91,
+	0x95, 0x3c, 0x4b, 0xd0, 0x2f, 0xf7, 0x23, 0x90, 0x6c, 0xdf, 0x4f, 0x20, 0x03, 0xe4, 0x48, 0xf3,
+	0x42, 0x48, 0x61, 0xf7, 0x2b, 0x0c, 0x35, 0x18, 0x6a, 0x30, 0x03, 0x37, 0x16, 0x98, 0x0a, 0xf4,
+	0x23, 0x86, 0xd0, 0x10, 0x63, 0xc1, 0x33, 0xcd, 0x1a, 0xec, 0xe9, 0xfa, 0x4c, 0x45, 0xbe, 0x0e,
+	0x4c, 0xa9, 0x9f, 0x88, 0x44, 0xe8, 0x7c, 0xf5, 0x67, 0xb2, 0x5e, 0x22, 0x44, 0xb2, 0x00, 0x5f,
+	0x45, 0xd1, 0xf2, 0xb3, 0x2f, 0x79, 0x0a, 0x28, 0x59, 0x9a, 0x1b, 0xc0, 0xb0, 0x75, 0x56, 0x94,
+	0xa2, 0x00, 0x8d, 0x18, 0x7d, 0xef, 0x92, 0x3b, 0x6f, 0xf4, 0xec, 0x1f, 0x24, 0x93, 0x60, 0xbf,
+	0x24, 0x5b, 0x39, 0x2b, 0x58, 0x8a, 0x8e, 0x35, 0xb4, 0xc6, 0xdb, 0x07, 0x8f, 0x69, 0x9b, 0x17,
+	0xfa, 0x5e, 0x61, 0x82, 0xcd, 0xb3, 0x0b, 0xaf, 0x13, 0x1a, 0x86, 0xfd, 0x8e, 0xf4, 0xe6, 0x90,
+	0x0b, 0xe4, 0x12, 0x9d, 0x8d, 0x61, 0x77, 0xbc, 0x7d, 0xf0, 0xa4, 0x9d, 0x3d, 0xd5, 0xa8, 0x60,
+	0xa7, 0xa2, 0xff, 0xb8, 0xf4, 0x7a, 0x26, 0x81, 0x61, 0xd3, 0xc0, 0xfe, 0x46, 0x1e, 0xe6, 0x05,
+	0x94, 0x5c, 0x2c, 0x71, 0xc6, 0xe2, 0xb8, 0x58, 0xb2, 0xc5, 0x4c, 0xf9, 0x73, 0xba, 0xaa, 0xf5,
+	0xb8, 0xbd, 0xb5, 0x31, 0xf3, 0x5a, 0x33, 0x8e, 0x78, 0x0a, 0xc1, 0x23, 0xa3, 0xb2, 0x7b, 0xbd,
+	0x86, 0x61, 0xbf, 0x16, 0xb9, 0x9a, 0xb5, 0x4b, 0xb2, 0xc3, 0x33, 0x09, 0x05, 0xa0, 0x9c, 0x15,
+	0x80, 0x50, 0x94, 0xe0, 0x6c, 0x2a, 0xd9, 0x3d, 0x6a, 0x16, 0x53, 0x6d, 0xb1, 0x51, 0x3d, 0x14,
+	0x3c, 0x0b, 0x9e, 0x1b, 0x9d, 0x71, 0xc2, 0xe5, 0x97, 0x65, 0x44, 0x63, 0x91, 0x9a, 0x2d, 0x9a,
+	0xcf, 0x04, 0xe7, 0xc7, 0xbe, 0xfc, 0x9a, 0x03, 0x2a, 0x02, 0x86, 0xf7, 0x6b, 0x91, 0x50, 0x6b,
+	0x8c, 0xfe, 0x59, 0xc4, 0xbe, 0x3e, 0xa5, 0xdd, 0x27, 0xb7, 0xe6, 0x90, 0x89, 0x54, 0xed, 0xe4,
+	0x76, 0xa8, 0x03, 0xfb, 0x23, 0x79, 0xd0, 0xfa, 0x42, 0xce, 0x86, 0xda, 0xdc, 0x80, 0xea, 0xf3,
+	0xa0, 0xf5, 0x79, 0xd0, 0xa3, 0xfa, 0x3c, 0x82, 0x5e, 0x35, 0xea, 0xe9, 0xa5, 0x67, 0x85, 0xbb,
+	0x2d, 0xfe, 0xed, 0x98, 0xdc, 0x6b, 0xec, 0xf3, 0x6c, 0x0e, 0x27, 0x4e, 0xb7, 0x12, 0x0e, 0x5e,
+	0x55, 0xb4, 0x3f, 0x17, 0xde, 0xd3, 0x1b, 0x38, 0x9c, 0x42, 0xfc, 0xeb, 0xe7, 0x84, 0x98, 0xd7,
+	0x9a, 0x42, 0x1c, 0xde, 0xad, 0x7b, 0xbe, 0xad, 0x5a, 0x06, 0x87, 0x67, 0x2b, 0xd7, 0x3a, 0x5f,
+	0xb9, 0xd6, 0xdf, 0x95, 0x6b, 0x9d, 0xae, 0xdd, 0xce, 0xf9, 0xda, 0xed, 0xfc, 0x5e, 0xbb, 0x9d,
+	0x4f, 0xcf, 0xae, 0xb4, 0xaf, 0x96, 0x3c, 0x59, 0xb0, 0x08, 0xd5, 0x9f, 0x7f, 0xd2, 0x5c, 0xb3,
+	0x52, 0x89, 0xb6, 0x94, 0xb9, 0x17, 0xff, 0x07, 0x00, 0xf4, 0xeb, 0xaa, 0x22, 0x96, 0x03, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +222,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InterestReserve) > 0 {
+		for iNdEx := len(m.InterestReserve) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InterestReserve[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PreviousAccrualTimes) > 0 {
+		for iNdEx := len(m.PreviousAccrualTimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousAccrualTimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Deposits) > 0 {
 		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -152,6 +277,54 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisAccrualTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisAccrualTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisAccrualTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InterestIndex.Size()
+		i -= size
+		if _, err := m.InterestIndex.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreviousAccrualTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreviousAccrualTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -177,6 +350,35 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PreviousAccrualTimes) > 0 {
+		for _, e := range m.PreviousAccrualTimes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InterestReserve) > 0 {
+		for _, e := range m.InterestReserve {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisAccrualTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreviousAccrualTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.InterestIndex.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -282,6 +484,223 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAccrualTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousAccrualTimes = append(m.PreviousAccrualTimes, GenesisAccrualTime{})
+			if err := m.PreviousAccrualTimes[len(m.PreviousAccrualTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestReserve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterestReserve = append(m.InterestReserve, types.Coin{})
+			if err := m.InterestReserve[len(m.InterestReserve)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisAccrualTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisAccrualTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisAccrualTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAccrualTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PreviousAccrualTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InterestIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
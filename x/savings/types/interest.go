@@ -0,0 +1,153 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewInterestRate returns a new InterestRate
+func NewInterestRate(denom string, rate sdk.Dec) InterestRate {
+	return InterestRate{
+		Denom: denom,
+		Rate:  rate,
+	}
+}
+
+// Validate validates InterestRate values
+func (ir InterestRate) Validate() error {
+	if err := sdk.ValidateDenom(ir.Denom); err != nil {
+		return fmt.Errorf("invalid interest rate denom: %w", err)
+	}
+	if ir.Rate.IsNil() || ir.Rate.IsNegative() {
+		return fmt.Errorf("interest rate must be non-negative, is %s for %s", ir.Rate, ir.Denom)
+	}
+	return nil
+}
+
+// InterestRates is a slice of InterestRate
+type InterestRates []InterestRate
+
+// Get returns the interest rate of a denom
+func (irs InterestRates) Get(denom string) (sdk.Dec, bool) {
+	for _, ir := range irs {
+		if ir.Denom == denom {
+			return ir.Rate, true
+		}
+	}
+	return sdk.ZeroDec(), false
+}
+
+// Validate validates InterestRates
+func (irs InterestRates) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for _, ir := range irs {
+		if err := ir.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[ir.Denom] {
+			return fmt.Errorf("duplicated interest rate denom %s", ir.Denom)
+		}
+		seenDenoms[ir.Denom] = true
+	}
+	return nil
+}
+
+// NewInterestIndex returns a new InterestIndex
+func NewInterestIndex(denom string, value sdk.Dec) InterestIndex {
+	return InterestIndex{
+		Denom: denom,
+		Value: value,
+	}
+}
+
+// Validate validates InterestIndex values
+func (ii InterestIndex) Validate() error {
+	if strings.TrimSpace(ii.Denom) == "" {
+		return fmt.Errorf("interest index denom cannot be empty")
+	}
+	if ii.Value.IsNil() || ii.Value.IsNegative() {
+		return fmt.Errorf("interest index value cannot be negative: %s", ii)
+	}
+	return nil
+}
+
+// InterestIndexes is a slice of InterestIndex, because Amino won't marshal maps
+type InterestIndexes []InterestIndex
+
+// GetInterestIndex returns a denom's interest index value
+func (iis InterestIndexes) GetInterestIndex(denom string) (sdk.Dec, bool) {
+	for _, ii := range iis {
+		if ii.Denom == denom {
+			return ii.Value, true
+		}
+	}
+	return sdk.ZeroDec(), false
+}
+
+// SetInterestIndex sets a denom's interest index value
+func (iis InterestIndexes) SetInterestIndex(denom string, value sdk.Dec) InterestIndexes {
+	for i, ii := range iis {
+		if ii.Denom == denom {
+			ii.Value = value
+			iis[i] = ii
+			return iis
+		}
+	}
+	return append(iis, NewInterestIndex(denom, value))
+}
+
+// Validate validates InterestIndexes
+func (iis InterestIndexes) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for _, ii := range iis {
+		if err := ii.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[ii.Denom] {
+			return fmt.Errorf("duplicated interest index denom %s", ii.Denom)
+		}
+		seenDenoms[ii.Denom] = true
+	}
+	return nil
+}
+
+// NewGenesisAccrualTime returns a new GenesisAccrualTime
+func NewGenesisAccrualTime(denom string, prevTime time.Time, interestIndex sdk.Dec) GenesisAccrualTime {
+	return GenesisAccrualTime{
+		Denom:               denom,
+		PreviousAccrualTime: prevTime,
+		InterestIndex:       interestIndex,
+	}
+}
+
+// Validate performs validation of GenesisAccrualTime
+func (gat GenesisAccrualTime) Validate() error {
+	if err := sdk.ValidateDenom(gat.Denom); err != nil {
+		return fmt.Errorf("invalid accrual time denom: %w", err)
+	}
+	if gat.InterestIndex.IsNil() || gat.InterestIndex.IsNegative() {
+		return fmt.Errorf("interest index cannot be negative, is %s for %s", gat.InterestIndex, gat.Denom)
+	}
+	return nil
+}
+
+// GenesisAccrualTimes slice of GenesisAccrualTime
+type GenesisAccrualTimes []GenesisAccrualTime
+
+// Validate performs validation of GenesisAccrualTimes
+func (gats GenesisAccrualTimes) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for _, gat := range gats {
+		if err := gat.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[gat.Denom] {
+			return fmt.Errorf("duplicated accrual time denom %s", gat.Denom)
+		}
+		seenDenoms[gat.Denom] = true
+	}
+	return nil
+}
@@ -17,4 +17,9 @@ const (
 	ModuleAccountName = ModuleName
 )
 
-var DepositsKeyPrefix = []byte{0x01}
+var (
+	DepositsKeyPrefix            = []byte{0x01}
+	PreviousAccrualTimeKeyPrefix = []byte{0x02}
+	InterestIndexKeyPrefix       = []byte{0x03}
+	InterestReserveKey           = []byte{0x04}
+)
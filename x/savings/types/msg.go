@@ -10,6 +10,7 @@ import (
 var (
 	_ sdk.Msg = &MsgDeposit{}
 	_ sdk.Msg = &MsgWithdraw{}
+	_ sdk.Msg = &MsgWithdrawAll{}
 )
 
 // NewMsgDeposit returns a new MsgDeposit
@@ -95,3 +96,40 @@ func (msg MsgWithdraw) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{depositor}
 }
+
+// NewMsgWithdrawAll returns a new MsgWithdrawAll
+func NewMsgWithdrawAll(depositor sdk.AccAddress) MsgWithdrawAll {
+	return MsgWithdrawAll{
+		Depositor: depositor.String(),
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgWithdrawAll) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgWithdrawAll) Type() string { return "savings_withdraw_all" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgWithdrawAll) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgWithdrawAll) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgWithdrawAll) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{depositor}
+}
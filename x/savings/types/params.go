@@ -9,19 +9,25 @@ import (
 // Parameter keys
 var (
 	KeySupportedDenoms     = []byte("SupportedDenoms")
+	KeyInterestRates       = []byte("InterestRates")
+	KeyDepositLimits       = []byte("DepositLimits")
 	DefaultSupportedDenoms = []string{}
+	DefaultInterestRates   = InterestRates{}
+	DefaultDepositLimits   = DepositLimits{}
 )
 
 // NewParams creates a new Params object
-func NewParams(supportedDenoms []string) Params {
+func NewParams(supportedDenoms []string, interestRates InterestRates, depositLimits DepositLimits) Params {
 	return Params{
 		SupportedDenoms: supportedDenoms,
+		InterestRates:   interestRates,
+		DepositLimits:   depositLimits,
 	}
 }
 
 // DefaultParams default params for savings
 func DefaultParams() Params {
-	return NewParams(DefaultSupportedDenoms)
+	return NewParams(DefaultSupportedDenoms, DefaultInterestRates, DefaultDepositLimits)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -34,12 +40,41 @@ func ParamKeyTable() paramtypes.KeyTable {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySupportedDenoms, &p.SupportedDenoms, validateSupportedDenoms),
+		paramtypes.NewParamSetPair(KeyInterestRates, &p.InterestRates, validateInterestRates),
+		paramtypes.NewParamSetPair(KeyDepositLimits, &p.DepositLimits, validateDepositLimits),
 	}
 }
 
 // Validate ensure that params have valid values
 func (p Params) Validate() error {
-	return validateSupportedDenoms(p.SupportedDenoms)
+	if err := validateSupportedDenoms(p.SupportedDenoms); err != nil {
+		return err
+	}
+
+	if err := validateInterestRates(p.InterestRates); err != nil {
+		return err
+	}
+
+	if err := validateDepositLimits(p.DepositLimits); err != nil {
+		return err
+	}
+
+	supportedDenoms := make(map[string]bool)
+	for _, denom := range p.SupportedDenoms {
+		supportedDenoms[denom] = true
+	}
+	for _, rate := range p.InterestRates {
+		if !supportedDenoms[rate.Denom] {
+			return fmt.Errorf("interest rate denom %s is not a supported denom", rate.Denom)
+		}
+	}
+	for _, limit := range p.DepositLimits {
+		if !supportedDenoms[limit.Denom] {
+			return fmt.Errorf("deposit limit denom %s is not a supported denom", limit.Denom)
+		}
+	}
+
+	return nil
 }
 
 func validateSupportedDenoms(i interface{}) error {
@@ -57,3 +92,21 @@ func validateSupportedDenoms(i interface{}) error {
 	}
 	return nil
 }
+
+func validateInterestRates(i interface{}) error {
+	interestRates, ok := i.(InterestRates)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return interestRates.Validate()
+}
+
+func validateDepositLimits(i interface{}) error {
+	depositLimits, ok := i.(DepositLimits)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return depositLimits.Validate()
+}
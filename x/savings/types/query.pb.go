@@ -318,6 +318,253 @@ func (m *QueryTotalSupplyResponse) GetResult() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryAccruedInterestRequest defines the request type for the
+// Query/AccruedInterest method.
+type QueryAccruedInterestRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *QueryAccruedInterestRequest) Reset()         { *m = QueryAccruedInterestRequest{} }
+func (m *QueryAccruedInterestRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccruedInterestRequest) ProtoMessage()    {}
+func (*QueryAccruedInterestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f78c91efc5db144f, []int{6}
+}
+func (m *QueryAccruedInterestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccruedInterestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccruedInterestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccruedInterestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccruedInterestRequest.Merge(m, src)
+}
+func (m *QueryAccruedInterestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccruedInterestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccruedInterestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccruedInterestRequest proto.InternalMessageInfo
+
+func (m *QueryAccruedInterestRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// QueryAccruedInterestResponse defines the response type for the
+// Query/AccruedInterest method.
+type QueryAccruedInterestResponse struct {
+	Interest github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=interest,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"interest"`
+}
+
+func (m *QueryAccruedInterestResponse) Reset()         { *m = QueryAccruedInterestResponse{} }
+func (m *QueryAccruedInterestResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccruedInterestResponse) ProtoMessage()    {}
+func (*QueryAccruedInterestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f78c91efc5db144f, []int{7}
+}
+func (m *QueryAccruedInterestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccruedInterestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccruedInterestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccruedInterestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccruedInterestResponse.Merge(m, src)
+}
+func (m *QueryAccruedInterestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccruedInterestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccruedInterestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccruedInterestResponse proto.InternalMessageInfo
+
+func (m *QueryAccruedInterestResponse) GetInterest() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Interest
+	}
+	return nil
+}
+
+// QueryDepositCapacityRequest defines the request type for the
+// Query/DepositCapacity method.
+type QueryDepositCapacityRequest struct {
+	// denom filters the response to a single supported denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryDepositCapacityRequest) Reset()         { *m = QueryDepositCapacityRequest{} }
+func (m *QueryDepositCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositCapacityRequest) ProtoMessage()    {}
+func (*QueryDepositCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f78c91efc5db144f, []int{8}
+}
+func (m *QueryDepositCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDepositCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositCapacityRequest.Merge(m, src)
+}
+func (m *QueryDepositCapacityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositCapacityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepositCapacityRequest proto.InternalMessageInfo
+
+func (m *QueryDepositCapacityRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDepositCapacityResponse defines the response type for the
+// Query/DepositCapacity method.
+type QueryDepositCapacityResponse struct {
+	Capacities []DepositCapacity `protobuf:"bytes,1,rep,name=capacities,proto3" json:"capacities"`
+}
+
+func (m *QueryDepositCapacityResponse) Reset()         { *m = QueryDepositCapacityResponse{} }
+func (m *QueryDepositCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositCapacityResponse) ProtoMessage()    {}
+func (*QueryDepositCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f78c91efc5db144f, []int{9}
+}
+func (m *QueryDepositCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDepositCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositCapacityResponse.Merge(m, src)
+}
+func (m *QueryDepositCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositCapacityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepositCapacityResponse proto.InternalMessageInfo
+
+func (m *QueryDepositCapacityResponse) GetCapacities() []DepositCapacity {
+	if m != nil {
+		return m.Capacities
+	}
+	return nil
+}
+
+// DepositCapacity defines the deposit capacity of a supported denom.
+type DepositCapacity struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// total_deposited is the total amount of the denom currently deposited.
+	TotalDeposited github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_deposited,json=totalDeposited,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_deposited"`
+	// max_total_deposits is the deposit cap of the denom. If zero, there is no
+	// cap.
+	MaxTotalDeposits github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max_total_deposits,json=maxTotalDeposits,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_total_deposits"`
+	// remaining_capacity is the amount of the denom that can still be deposited
+	// before the cap is reached. It is zero if the denom has no cap.
+	RemainingCapacity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=remaining_capacity,json=remainingCapacity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining_capacity"`
+	// paused is true if new deposits of the denom are rejected.
+	Paused bool `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *DepositCapacity) Reset()         { *m = DepositCapacity{} }
+func (m *DepositCapacity) String() string { return proto.CompactTextString(m) }
+func (*DepositCapacity) ProtoMessage()    {}
+func (*DepositCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f78c91efc5db144f, []int{10}
+}
+func (m *DepositCapacity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositCapacity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositCapacity.Merge(m, src)
+}
+func (m *DepositCapacity) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositCapacity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositCapacity proto.InternalMessageInfo
+
+func (m *DepositCapacity) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DepositCapacity) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "kava.savings.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "kava.savings.v1beta1.QueryParamsResponse")
@@ -325,51 +572,73 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "kava.savings.v1beta1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTotalSupplyRequest)(nil), "kava.savings.v1beta1.QueryTotalSupplyRequest")
 	proto.RegisterType((*QueryTotalSupplyResponse)(nil), "kava.savings.v1beta1.QueryTotalSupplyResponse")
+	proto.RegisterType((*QueryAccruedInterestRequest)(nil), "kava.savings.v1beta1.QueryAccruedInterestRequest")
+	proto.RegisterType((*QueryAccruedInterestResponse)(nil), "kava.savings.v1beta1.QueryAccruedInterestResponse")
+	proto.RegisterType((*QueryDepositCapacityRequest)(nil), "kava.savings.v1beta1.QueryDepositCapacityRequest")
+	proto.RegisterType((*QueryDepositCapacityResponse)(nil), "kava.savings.v1beta1.QueryDepositCapacityResponse")
+	proto.RegisterType((*DepositCapacity)(nil), "kava.savings.v1beta1.DepositCapacity")
 }
 
 func init() { proto.RegisterFile("kava/savings/v1beta1/query.proto", fileDescriptor_f78c91efc5db144f) }
 
 var fileDescriptor_f78c91efc5db144f = []byte{
-	// 619 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xb1, 0x8f, 0x12, 0x41,
-	0x14, 0xc6, 0x19, 0x38, 0xc8, 0x39, 0x34, 0x66, 0x5c, 0x75, 0x21, 0xb8, 0x90, 0xcd, 0xe5, 0xe4,
-	0x30, 0xec, 0x7a, 0xd8, 0x5d, 0x27, 0x67, 0xb4, 0xb0, 0xd1, 0x3d, 0x13, 0x13, 0x9b, 0xcb, 0x00,
-	0x93, 0x65, 0x73, 0xb0, 0xb3, 0xb7, 0x33, 0x8b, 0xd2, 0x6a, 0x63, 0x62, 0x63, 0x62, 0xa1, 0xa5,
-	0x85, 0x95, 0x89, 0x9d, 0xf1, 0x6f, 0xb8, 0xf2, 0xa2, 0x8d, 0x95, 0x1a, 0xf0, 0x0f, 0x31, 0x3b,
-	0x33, 0xbb, 0x07, 0xc7, 0xe6, 0xa4, 0x82, 0x79, 0xf3, 0x7d, 0xdf, 0xfc, 0xe6, 0xcd, 0x03, 0xd8,
-	0x38, 0xc2, 0x13, 0x6c, 0x33, 0x3c, 0xf1, 0x7c, 0x97, 0xd9, 0x93, 0xdd, 0x1e, 0xe1, 0x78, 0xd7,
-	0x3e, 0x8e, 0x48, 0x38, 0xb5, 0x82, 0x90, 0x72, 0x8a, 0xb4, 0x58, 0x61, 0x29, 0x85, 0xa5, 0x14,
-	0xd5, 0x56, 0x9f, 0xb2, 0x31, 0x65, 0x76, 0x0f, 0x33, 0x22, 0xe5, 0xa9, 0x39, 0xc0, 0xae, 0xe7,
-	0x63, 0xee, 0x51, 0x5f, 0x26, 0x54, 0x8d, 0x45, 0x6d, 0xa2, 0xea, 0x53, 0x2f, 0xd9, 0xaf, 0xc8,
-	0xfd, 0x43, 0xb1, 0xb2, 0xe5, 0x42, 0x6d, 0x69, 0x2e, 0x75, 0xa9, 0xac, 0xc7, 0xdf, 0x54, 0xb5,
-	0xe6, 0x52, 0xea, 0x8e, 0x88, 0x8d, 0x03, 0xcf, 0xc6, 0xbe, 0x4f, 0xb9, 0x38, 0x2d, 0xf1, 0x64,
-	0x5f, 0x89, 0x71, 0x1a, 0x12, 0xa9, 0x30, 0x35, 0x88, 0x1e, 0xc7, 0xc8, 0x8f, 0x70, 0x88, 0xc7,
-	0xcc, 0x21, 0xc7, 0x11, 0x61, 0xdc, 0x7c, 0x0a, 0xaf, 0x2c, 0x55, 0x59, 0x40, 0x7d, 0x46, 0xd0,
-	0x1e, 0x2c, 0x05, 0xa2, 0xa2, 0x83, 0x06, 0x68, 0x96, 0x3b, 0x35, 0x2b, 0xab, 0x21, 0x96, 0x74,
-	0x75, 0x37, 0x4e, 0x7e, 0xd5, 0x73, 0x8e, 0x72, 0xec, 0x6d, 0xbc, 0xfe, 0x58, 0xcf, 0x99, 0x9f,
-	0x00, 0xd4, 0x44, 0xf2, 0x3d, 0x12, 0x50, 0xe6, 0xf1, 0xe4, 0x44, 0xa4, 0xc1, 0xe2, 0x80, 0xf8,
-	0x74, 0x2c, 0x92, 0x2f, 0x39, 0x72, 0x81, 0x2c, 0x58, 0xa4, 0xcf, 0x7d, 0x12, 0xea, 0xf9, 0xb8,
-	0xda, 0xd5, 0xbf, 0x7f, 0x6d, 0x6b, 0xaa, 0x29, 0x77, 0x07, 0x83, 0x90, 0x30, 0x76, 0xc0, 0x43,
-	0xcf, 0x77, 0x1d, 0x29, 0x43, 0xf7, 0x21, 0x3c, 0x6b, 0xb9, 0x5e, 0x10, 0x90, 0xdb, 0x96, 0x72,
-	0xc4, 0x3d, 0xb7, 0xe4, 0x73, 0x9e, 0x91, 0xba, 0x44, 0x11, 0x38, 0x0b, 0x4e, 0xf3, 0x0b, 0x80,
-	0x57, 0xcf, 0x61, 0xaa, 0x16, 0x3c, 0x84, 0x9b, 0x03, 0x55, 0xd3, 0x41, 0xa3, 0xd0, 0x2c, 0x77,
-	0x6e, 0x64, 0x37, 0x41, 0x39, 0xbb, 0x97, 0xe3, 0x2e, 0x7c, 0xfe, 0x5d, 0xdf, 0x4c, 0xa3, 0xd2,
-	0x00, 0xf4, 0x60, 0x09, 0x37, 0x2f, 0x70, 0x6f, 0xfe, 0x17, 0x57, 0x92, 0x2c, 0xf1, 0x56, 0xe0,
-	0x75, 0x81, 0xfb, 0x84, 0x72, 0x3c, 0x3a, 0x88, 0x82, 0x60, 0x34, 0x4d, 0x9e, 0xf2, 0x3d, 0x80,
-	0xfa, 0xea, 0x9e, 0xba, 0xcd, 0x35, 0x58, 0x1a, 0x12, 0xcf, 0x1d, 0x72, 0xd1, 0xf6, 0x82, 0xa3,
-	0x56, 0xa8, 0x0f, 0x4b, 0x21, 0x61, 0xd1, 0x88, 0xeb, 0x79, 0x71, 0xc7, 0xca, 0x12, 0x54, 0x82,
-	0xb3, 0x4f, 0x3d, 0xbf, 0x7b, 0x5b, 0xdd, 0xaf, 0xe9, 0x7a, 0x7c, 0x18, 0xf5, 0xac, 0x3e, 0x1d,
-	0xab, 0xb9, 0x55, 0x1f, 0x6d, 0x36, 0x38, 0xb2, 0xf9, 0x34, 0x20, 0x4c, 0x18, 0x98, 0xa3, 0xa2,
-	0x3b, 0xdf, 0x0a, 0xb0, 0x28, 0xc8, 0xd0, 0x2b, 0x00, 0x4b, 0x72, 0x68, 0x50, 0x33, 0xbb, 0x9b,
-	0xab, 0x33, 0x5a, 0xdd, 0x59, 0x43, 0x29, 0xaf, 0x69, 0x6e, 0xbd, 0xfc, 0xf1, 0xf7, 0x5d, 0xde,
-	0x40, 0x35, 0x3b, 0xf3, 0xf7, 0x20, 0x27, 0x14, 0xbd, 0x01, 0x30, 0x7d, 0x24, 0xd4, 0xba, 0x20,
-	0xfd, 0xdc, 0xec, 0x56, 0x6f, 0xad, 0xa5, 0x55, 0x2c, 0xdb, 0x82, 0xa5, 0x81, 0x8c, 0x6c, 0x96,
-	0x74, 0x36, 0x3e, 0x00, 0x58, 0x5e, 0x78, 0x32, 0xd4, 0xbe, 0xe0, 0x90, 0xd5, 0x67, 0xaf, 0x5a,
-	0xeb, 0xca, 0x15, 0x56, 0x4b, 0x60, 0x6d, 0x21, 0x33, 0x1b, 0x8b, 0xc7, 0x96, 0x43, 0x26, 0x3c,
-	0xdd, 0xfd, 0x93, 0x99, 0x01, 0x4e, 0x67, 0x06, 0xf8, 0x33, 0x33, 0xc0, 0xdb, 0xb9, 0x91, 0x3b,
-	0x9d, 0x1b, 0xb9, 0x9f, 0x73, 0x23, 0xf7, 0x6c, 0x67, 0x61, 0x08, 0xe2, 0x9c, 0xf6, 0x08, 0xf7,
-	0x98, 0x4c, 0x7c, 0x91, 0x66, 0x8a, 0x59, 0xe8, 0x95, 0xc4, 0xff, 0xcf, 0x9d, 0x7f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xa9, 0x76, 0x1d, 0x1f, 0x76, 0x05, 0x00, 0x00,
+	// 887 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x8b, 0x23, 0x45,
+	0x14, 0x4e, 0x65, 0x66, 0xc2, 0x58, 0x03, 0xce, 0x5a, 0xc6, 0xb5, 0x27, 0xc6, 0x9e, 0xd0, 0xac,
+	0x31, 0x3b, 0x92, 0xee, 0x4d, 0x16, 0x3c, 0x2c, 0x5e, 0x36, 0xb3, 0x28, 0x83, 0x08, 0xda, 0xbb,
+	0x20, 0x78, 0x09, 0x95, 0xee, 0xa2, 0xa7, 0x4d, 0xd2, 0xd5, 0xdb, 0x55, 0x19, 0x27, 0x88, 0x17,
+	0x3d, 0x28, 0x78, 0x11, 0x14, 0xf4, 0xe8, 0xc1, 0x83, 0x08, 0x82, 0x87, 0xfd, 0x23, 0xf6, 0xb8,
+	0xac, 0x17, 0xf1, 0xb0, 0xca, 0x8c, 0xff, 0x87, 0xd2, 0x55, 0xaf, 0x7b, 0xf3, 0xa3, 0xcd, 0x66,
+	0x60, 0x4e, 0x49, 0xbd, 0x7a, 0xef, 0x7b, 0x5f, 0xbd, 0x1f, 0x5f, 0x82, 0x1b, 0x43, 0x7a, 0x42,
+	0x1d, 0x41, 0x4f, 0xc2, 0x28, 0x10, 0xce, 0x49, 0x67, 0xc0, 0x24, 0xed, 0x38, 0xf7, 0x27, 0x2c,
+	0x99, 0xda, 0x71, 0xc2, 0x25, 0x27, 0xd5, 0xd4, 0xc3, 0x06, 0x0f, 0x1b, 0x3c, 0x6a, 0x07, 0x1e,
+	0x17, 0x63, 0x2e, 0x9c, 0x01, 0x15, 0x4c, 0xbb, 0xe7, 0xc1, 0x31, 0x0d, 0xc2, 0x88, 0xca, 0x90,
+	0x47, 0x1a, 0xa1, 0x66, 0xce, 0xfa, 0x66, 0x5e, 0x1e, 0x0f, 0xb3, 0xfb, 0x3d, 0x7d, 0xdf, 0x57,
+	0x27, 0x47, 0x1f, 0xe0, 0xaa, 0x1a, 0xf0, 0x80, 0x6b, 0x7b, 0xfa, 0x0d, 0xac, 0xf5, 0x80, 0xf3,
+	0x60, 0xc4, 0x1c, 0x1a, 0x87, 0x0e, 0x8d, 0x22, 0x2e, 0x55, 0xb6, 0x2c, 0xa6, 0xf8, 0x49, 0x42,
+	0xf2, 0x84, 0x69, 0x0f, 0xab, 0x8a, 0xc9, 0x07, 0x29, 0xe5, 0xf7, 0x69, 0x42, 0xc7, 0xc2, 0x65,
+	0xf7, 0x27, 0x4c, 0x48, 0xeb, 0x43, 0xfc, 0xe2, 0x9c, 0x55, 0xc4, 0x3c, 0x12, 0x8c, 0xdc, 0xc2,
+	0x95, 0x58, 0x59, 0x0c, 0xd4, 0x40, 0xad, 0x9d, 0x6e, 0xdd, 0x2e, 0x2a, 0x88, 0xad, 0xa3, 0x7a,
+	0x9b, 0x0f, 0x9f, 0xec, 0x97, 0x5c, 0x88, 0xb8, 0xb5, 0xf9, 0xd5, 0x8f, 0xfb, 0x25, 0xeb, 0x27,
+	0x84, 0xab, 0x0a, 0xf9, 0x0e, 0x8b, 0xb9, 0x08, 0x65, 0x96, 0x91, 0x54, 0xf1, 0x96, 0xcf, 0x22,
+	0x3e, 0x56, 0xc8, 0xcf, 0xb9, 0xfa, 0x40, 0x6c, 0xbc, 0xc5, 0x3f, 0x89, 0x58, 0x62, 0x94, 0x53,
+	0x6b, 0xcf, 0x78, 0xfc, 0xa0, 0x5d, 0x85, 0xa2, 0xdc, 0xf6, 0xfd, 0x84, 0x09, 0x71, 0x57, 0x26,
+	0x61, 0x14, 0xb8, 0xda, 0x8d, 0xbc, 0x8d, 0xf1, 0xd3, 0x92, 0x1b, 0x1b, 0x8a, 0x64, 0xd3, 0x86,
+	0x88, 0xb4, 0xe6, 0xb6, 0x6e, 0xe7, 0x53, 0xa6, 0x01, 0x03, 0x06, 0xee, 0x4c, 0xa4, 0xf5, 0x2b,
+	0xc2, 0x2f, 0x2d, 0xd0, 0x84, 0x12, 0xbc, 0x8b, 0xb7, 0x7d, 0xb0, 0x19, 0xa8, 0xb1, 0xd1, 0xda,
+	0xe9, 0xbe, 0x5a, 0x5c, 0x04, 0x88, 0xec, 0x5d, 0x49, 0xab, 0xf0, 0xcb, 0x5f, 0xfb, 0xdb, 0x39,
+	0x54, 0x0e, 0x40, 0xde, 0x99, 0xa3, 0x5b, 0x56, 0x74, 0x5f, 0x7f, 0x26, 0x5d, 0xcd, 0x64, 0x8e,
+	0xef, 0x1e, 0x7e, 0x59, 0xd1, 0xbd, 0xc7, 0x25, 0x1d, 0xdd, 0x9d, 0xc4, 0xf1, 0x68, 0x9a, 0xb5,
+	0xf2, 0x7b, 0x84, 0x8d, 0xe5, 0x3b, 0x78, 0xcd, 0x55, 0x5c, 0x39, 0x66, 0x61, 0x70, 0x2c, 0x55,
+	0xd9, 0x37, 0x5c, 0x38, 0x11, 0x0f, 0x57, 0x12, 0x26, 0x26, 0x23, 0x69, 0x94, 0xd5, 0x1b, 0xf7,
+	0xe6, 0x48, 0x65, 0x74, 0x0e, 0x79, 0x18, 0xf5, 0x6e, 0xc0, 0xfb, 0x5a, 0x41, 0x28, 0x8f, 0x27,
+	0x03, 0xdb, 0xe3, 0x63, 0x98, 0x5b, 0xf8, 0x68, 0x0b, 0x7f, 0xe8, 0xc8, 0x69, 0xcc, 0x84, 0x0a,
+	0x10, 0x2e, 0x40, 0x5b, 0xef, 0xe1, 0x57, 0x14, 0xb1, 0xdb, 0x9e, 0x97, 0x4c, 0x98, 0x7f, 0x14,
+	0x49, 0x96, 0xa4, 0x8d, 0x80, 0x89, 0xc8, 0x7b, 0x8f, 0xd6, 0xea, 0xbd, 0xf5, 0x25, 0xc2, 0xf5,
+	0x62, 0x3c, 0x78, 0x6c, 0x80, 0xb7, 0x43, 0xb0, 0x19, 0xe8, 0xf2, 0x9f, 0x95, 0x83, 0x5b, 0x37,
+	0xe1, 0x61, 0xd0, 0xf1, 0x43, 0x1a, 0x53, 0x2f, 0x94, 0xd3, 0x95, 0xa3, 0x6e, 0x0d, 0x71, 0xbd,
+	0x38, 0x28, 0x1f, 0x3c, 0xec, 0x69, 0x5b, 0xc8, 0xb2, 0xd1, 0x7b, 0x6d, 0xe5, 0xe8, 0x65, 0x10,
+	0xb0, 0x88, 0x33, 0xe1, 0xd6, 0xbf, 0x65, 0xbc, 0xbb, 0xe0, 0xf5, 0x3f, 0x1b, 0xc8, 0xf0, 0xae,
+	0x4c, 0x07, 0xa7, 0x0f, 0x43, 0xcb, 0x7c, 0xd8, 0xc5, 0xb7, 0x52, 0xd0, 0x3f, 0x9f, 0xec, 0x37,
+	0xd7, 0x28, 0xd0, 0x51, 0x24, 0x1f, 0x3f, 0x68, 0x63, 0x28, 0xf6, 0x51, 0x24, 0xdd, 0xe7, 0x15,
+	0xe8, 0x9d, 0x0c, 0x93, 0x7c, 0x8c, 0xc9, 0x98, 0x9e, 0xf6, 0xe7, 0x52, 0x09, 0x63, 0xe3, 0x12,
+	0x32, 0x5d, 0x19, 0xd3, 0xd3, 0x7b, 0x33, 0xc9, 0x04, 0x19, 0x62, 0x92, 0xb0, 0x31, 0x0d, 0xa3,
+	0x30, 0x0a, 0xfa, 0x50, 0x94, 0xa9, 0xb1, 0x79, 0x09, 0xb9, 0x5e, 0xc8, 0x71, 0xf3, 0xaa, 0x5e,
+	0x4d, 0x25, 0x73, 0x22, 0x98, 0x6f, 0x6c, 0x35, 0x50, 0x6b, 0xdb, 0x85, 0x53, 0xf7, 0xbb, 0x0a,
+	0xde, 0x52, 0xfd, 0x26, 0x5f, 0x20, 0x5c, 0xd1, 0x8a, 0x49, 0x5a, 0xc5, 0xfd, 0x5c, 0x16, 0xe8,
+	0xda, 0xf5, 0x35, 0x3c, 0xf5, 0xe0, 0x58, 0xd7, 0x3e, 0xff, 0xfd, 0x9f, 0x6f, 0xcb, 0x26, 0xa9,
+	0x3b, 0x85, 0x3f, 0x06, 0x5a, 0x9e, 0xc9, 0xd7, 0x08, 0xe7, 0x0a, 0x45, 0x0e, 0x56, 0xa0, 0x2f,
+	0x08, 0x77, 0xed, 0x8d, 0xb5, 0x7c, 0x81, 0x4b, 0x53, 0x71, 0x69, 0x10, 0xb3, 0x98, 0x4b, 0x2e,
+	0x8c, 0x3f, 0x20, 0xbc, 0x33, 0xa3, 0x57, 0xa4, 0xbd, 0x22, 0xc9, 0xb2, 0xe6, 0xd5, 0xec, 0x75,
+	0xdd, 0x81, 0xd6, 0x81, 0xa2, 0x75, 0x8d, 0x58, 0xc5, 0xb4, 0xf4, 0x54, 0x0a, 0x4d, 0xe5, 0x37,
+	0x84, 0x77, 0x17, 0x14, 0x86, 0x74, 0x56, 0xe4, 0x2b, 0x56, 0xb7, 0x5a, 0xf7, 0x22, 0x21, 0x40,
+	0xf3, 0x4d, 0x45, 0xf3, 0x06, 0xb1, 0x8b, 0x69, 0x52, 0x1d, 0xd6, 0xcf, 0x74, 0xc8, 0xf9, 0x54,
+	0x09, 0xe3, 0x67, 0xe4, 0x67, 0xb4, 0xbc, 0xed, 0x9d, 0x67, 0xb7, 0x6d, 0x41, 0xb7, 0x6a, 0xdd,
+	0x8b, 0x84, 0x00, 0x65, 0x5b, 0x51, 0x6e, 0x91, 0xe6, 0xca, 0x86, 0xe7, 0x5b, 0xd8, 0x3b, 0x7c,
+	0x78, 0x66, 0xa2, 0x47, 0x67, 0x26, 0xfa, 0xfb, 0xcc, 0x44, 0xdf, 0x9c, 0x9b, 0xa5, 0x47, 0xe7,
+	0x66, 0xe9, 0x8f, 0x73, 0xb3, 0xf4, 0xd1, 0xf5, 0x99, 0x8d, 0x4c, 0xb1, 0xda, 0x23, 0x3a, 0x10,
+	0x1a, 0xf5, 0x34, 0xc7, 0x55, 0x8b, 0x39, 0xa8, 0xa8, 0xbf, 0x36, 0x37, 0xff, 0x1b, 0x00, 0xd1,
+	0xfa, 0xc6, 0xe0, 0xd1, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -390,6 +659,12 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TotalSupply returns the total sum of all coins currently locked into the savings module.
 	TotalSupply(ctx context.Context, in *QueryTotalSupplyRequest, opts ...grpc.CallOption) (*QueryTotalSupplyResponse, error)
+	// AccruedInterest queries the interest accrued by a depositor that has not
+	// yet been added to their deposit.
+	AccruedInterest(ctx context.Context, in *QueryAccruedInterestRequest, opts ...grpc.CallOption) (*QueryAccruedInterestResponse, error)
+	// DepositCapacity queries the deposit caps, pause switches and remaining
+	// deposit capacity of supported denoms.
+	DepositCapacity(ctx context.Context, in *QueryDepositCapacityRequest, opts ...grpc.CallOption) (*QueryDepositCapacityResponse, error)
 }
 
 type queryClient struct {
@@ -427,6 +702,24 @@ func (c *queryClient) TotalSupply(ctx context.Context, in *QueryTotalSupplyReque
 	return out, nil
 }
 
+func (c *queryClient) AccruedInterest(ctx context.Context, in *QueryAccruedInterestRequest, opts ...grpc.CallOption) (*QueryAccruedInterestResponse, error) {
+	out := new(QueryAccruedInterestResponse)
+	err := c.cc.Invoke(ctx, "/kava.savings.v1beta1.Query/AccruedInterest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DepositCapacity(ctx context.Context, in *QueryDepositCapacityRequest, opts ...grpc.CallOption) (*QueryDepositCapacityResponse, error) {
+	out := new(QueryDepositCapacityResponse)
+	err := c.cc.Invoke(ctx, "/kava.savings.v1beta1.Query/DepositCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters of the savings module.
@@ -435,6 +728,12 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TotalSupply returns the total sum of all coins currently locked into the savings module.
 	TotalSupply(context.Context, *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error)
+	// AccruedInterest queries the interest accrued by a depositor that has not
+	// yet been added to their deposit.
+	AccruedInterest(context.Context, *QueryAccruedInterestRequest) (*QueryAccruedInterestResponse, error)
+	// DepositCapacity queries the deposit caps, pause switches and remaining
+	// deposit capacity of supported denoms.
+	DepositCapacity(context.Context, *QueryDepositCapacityRequest) (*QueryDepositCapacityResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -450,6 +749,12 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TotalSupply(ctx context.Context, req *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalSupply not implemented")
 }
+func (*UnimplementedQueryServer) AccruedInterest(ctx context.Context, req *QueryAccruedInterestRequest) (*QueryAccruedInterestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccruedInterest not implemented")
+}
+func (*UnimplementedQueryServer) DepositCapacity(ctx context.Context, req *QueryDepositCapacityRequest) (*QueryDepositCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositCapacity not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -509,6 +814,42 @@ func _Query_TotalSupply_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccruedInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccruedInterestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccruedInterest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.savings.v1beta1.Query/AccruedInterest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccruedInterest(ctx, req.(*QueryAccruedInterestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DepositCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DepositCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.savings.v1beta1.Query/DepositCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositCapacity(ctx, req.(*QueryDepositCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.savings.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -525,6 +866,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TotalSupply",
 			Handler:    _Query_TotalSupply_Handler,
 		},
+		{
+			MethodName: "AccruedInterest",
+			Handler:    _Query_AccruedInterest_Handler,
+		},
+		{
+			MethodName: "DepositCapacity",
+			Handler:    _Query_DepositCapacity_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/savings/v1beta1/query.proto",
@@ -749,66 +1098,270 @@ func (m *QueryTotalSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAccruedInterestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryAccruedInterestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDepositsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAccruedInterestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDepositsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAccruedInterestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccruedInterestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccruedInterestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Deposits) > 0 {
-		for _, e := range m.Deposits {
+	if len(m.Interest) > 0 {
+		for iNdEx := len(m.Interest) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Interest[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Capacities) > 0 {
+		for iNdEx := len(m.Capacities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Capacities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositCapacity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.RemainingCapacity.Size()
+		i -= size
+		if _, err := m.RemainingCapacity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxTotalDeposits.Size()
+		i -= size
+		if _, err := m.MaxTotalDeposits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalDeposited.Size()
+		i -= size
+		if _, err := m.TotalDeposited.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -847,6 +1400,84 @@ func (m *QueryTotalSupplyResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAccruedInterestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccruedInterestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Interest) > 0 {
+		for _, e := range m.Interest {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDepositCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Capacities) > 0 {
+		for _, e := range m.Capacities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DepositCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TotalDeposited.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxTotalDeposits.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingCapacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1409,6 +2040,542 @@ func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAccruedInterestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccruedInterestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccruedInterestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccruedInterestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccruedInterestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccruedInterestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Interest = append(m.Interest, types.Coin{})
+			if err := m.Interest[len(m.Interest)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositCapacityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositCapacityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositCapacityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositCapacityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capacities = append(m.Capacities, DepositCapacity{})
+			if err := m.Capacities[len(m.Capacities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositCapacity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositCapacity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposited", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDeposited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalDeposits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTotalDeposits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingCapacity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
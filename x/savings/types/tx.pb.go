@@ -211,40 +211,136 @@ func (m *MsgWithdrawResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawResponse proto.InternalMessageInfo
 
+// MsgWithdrawAll defines the Msg/WithdrawAll request type.
+type MsgWithdrawAll struct {
+	Depositor string `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+}
+
+func (m *MsgWithdrawAll) Reset()         { *m = MsgWithdrawAll{} }
+func (m *MsgWithdrawAll) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAll) ProtoMessage()    {}
+func (*MsgWithdrawAll) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bf8679b144267a, []int{4}
+}
+func (m *MsgWithdrawAll) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAll) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAll.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawAll) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAll.Merge(m, src)
+}
+func (m *MsgWithdrawAll) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAll) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAll.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawAll proto.InternalMessageInfo
+
+func (m *MsgWithdrawAll) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+// MsgWithdrawAllResponse defines the Msg/WithdrawAll response type.
+type MsgWithdrawAllResponse struct {
+	// amount is the total amount of coins withdrawn.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawAllResponse) Reset()         { *m = MsgWithdrawAllResponse{} }
+func (m *MsgWithdrawAllResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAllResponse) ProtoMessage()    {}
+func (*MsgWithdrawAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bf8679b144267a, []int{5}
+}
+func (m *MsgWithdrawAllResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAllResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAllResponse.Merge(m, src)
+}
+func (m *MsgWithdrawAllResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAllResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawAllResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawAllResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgDeposit)(nil), "kava.savings.v1beta1.MsgDeposit")
 	proto.RegisterType((*MsgDepositResponse)(nil), "kava.savings.v1beta1.MsgDepositResponse")
 	proto.RegisterType((*MsgWithdraw)(nil), "kava.savings.v1beta1.MsgWithdraw")
 	proto.RegisterType((*MsgWithdrawResponse)(nil), "kava.savings.v1beta1.MsgWithdrawResponse")
+	proto.RegisterType((*MsgWithdrawAll)(nil), "kava.savings.v1beta1.MsgWithdrawAll")
+	proto.RegisterType((*MsgWithdrawAllResponse)(nil), "kava.savings.v1beta1.MsgWithdrawAllResponse")
 }
 
 func init() { proto.RegisterFile("kava/savings/v1beta1/tx.proto", fileDescriptor_c0bf8679b144267a) }
 
 var fileDescriptor_c0bf8679b144267a = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x52, 0xcd, 0x4e, 0xea, 0x40,
-	0x14, 0xee, 0x5c, 0x12, 0xee, 0x65, 0xd8, 0xf5, 0xd6, 0x04, 0x9a, 0x58, 0x90, 0x55, 0x59, 0x30,
-	0x15, 0x4c, 0xdc, 0x0b, 0x6e, 0xd9, 0x60, 0x8c, 0xc6, 0x8d, 0x99, 0xfe, 0x64, 0x98, 0x20, 0x9d,
-	0xa6, 0x67, 0x40, 0x7c, 0x0b, 0x5f, 0x43, 0xd6, 0xc6, 0x67, 0x60, 0x49, 0x5c, 0xb9, 0x52, 0x03,
-	0x2f, 0x62, 0xda, 0x4e, 0x81, 0x85, 0x86, 0xad, 0xab, 0x9e, 0x99, 0xef, 0x27, 0xdf, 0x7c, 0x3d,
-	0xf8, 0x70, 0x44, 0xa7, 0xd4, 0x01, 0x3a, 0xe5, 0x21, 0x03, 0x67, 0xda, 0x76, 0x03, 0x49, 0xdb,
-	0x8e, 0x9c, 0x91, 0x28, 0x16, 0x52, 0xe8, 0x46, 0x02, 0x13, 0x05, 0x13, 0x05, 0x9b, 0x96, 0x27,
-	0x60, 0x2c, 0xc0, 0x71, 0x29, 0x04, 0x1b, 0x8d, 0x27, 0x78, 0x98, 0xa9, 0xcc, 0x6a, 0x86, 0xdf,
-	0xa6, 0x27, 0x27, 0x3b, 0x28, 0xc8, 0x60, 0x82, 0x89, 0xec, 0x3e, 0x99, 0xb2, 0xdb, 0xc6, 0x13,
-	0xc2, 0xb8, 0x0f, 0xec, 0x3c, 0x88, 0x04, 0x70, 0xa9, 0x9f, 0xe2, 0x92, 0x9f, 0x8d, 0x22, 0xae,
-	0xa0, 0x3a, 0xb2, 0x4b, 0xdd, 0xca, 0xeb, 0x73, 0xcb, 0x50, 0x4e, 0x67, 0xbe, 0x1f, 0x07, 0x00,
-	0x17, 0x32, 0xe6, 0x21, 0x1b, 0x6c, 0xa9, 0xba, 0x87, 0x8b, 0x74, 0x2c, 0x26, 0xa1, 0xac, 0xfc,
-	0xa9, 0x17, 0xec, 0x72, 0xa7, 0x4a, 0x94, 0x22, 0x09, 0x9a, 0xa7, 0x27, 0x3d, 0xc1, 0xc3, 0xee,
-	0xf1, 0xe2, 0xbd, 0xa6, 0xcd, 0x3f, 0x6a, 0x36, 0xe3, 0x72, 0x38, 0x71, 0x89, 0x27, 0xc6, 0x2a,
-	0xa8, 0xfa, 0xb4, 0xc0, 0x1f, 0x39, 0xf2, 0x21, 0x0a, 0x20, 0x15, 0xc0, 0x40, 0x59, 0x37, 0x0c,
-	0xac, 0x6f, 0xa3, 0x0e, 0x02, 0x88, 0x44, 0x08, 0x41, 0x63, 0x8e, 0x70, 0xb9, 0x0f, 0xec, 0x8a,
-	0xcb, 0xa1, 0x1f, 0xd3, 0xfb, 0xdf, 0xfd, 0x84, 0x03, 0xfc, 0x7f, 0x27, 0x6b, 0xfe, 0x86, 0xce,
-	0x0b, 0xc2, 0x85, 0x3e, 0x30, 0xfd, 0x12, 0xff, 0xcd, 0xff, 0x44, 0x9d, 0x7c, 0xb7, 0x00, 0x64,
-	0x5b, 0x80, 0x69, 0xef, 0x63, 0xe4, 0xf6, 0xfa, 0x35, 0xfe, 0xb7, 0xa9, 0xe7, 0xe8, 0x47, 0x55,
-	0x4e, 0x31, 0x9b, 0x7b, 0x29, 0xb9, 0x73, 0xb7, 0xb7, 0x58, 0x59, 0x68, 0xb9, 0xb2, 0xd0, 0xe7,
-	0xca, 0x42, 0x8f, 0x6b, 0x4b, 0x5b, 0xae, 0x2d, 0xed, 0x6d, 0x6d, 0x69, 0x37, 0xcd, 0x9d, 0x6e,
-	0x12, 0xbb, 0xd6, 0x1d, 0x75, 0x21, 0x9d, 0x9c, 0xd9, 0x66, 0xeb, 0xd3, 0x8a, 0xdc, 0x62, 0xba,
-	0x8a, 0x27, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x17, 0x24, 0x24, 0x12, 0x03, 0x00, 0x00,
+	// 416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcd, 0xce, 0xd2, 0x40,
+	0x14, 0xed, 0x40, 0x82, 0x32, 0x24, 0x2e, 0x6a, 0x35, 0xd0, 0xc4, 0x82, 0x8d, 0x8b, 0x92, 0xc8,
+	0x54, 0x30, 0x71, 0x0f, 0xb8, 0x70, 0xc3, 0x06, 0x63, 0x34, 0x6e, 0xcc, 0xf4, 0x27, 0x43, 0x43,
+	0xe9, 0x34, 0xbd, 0x03, 0xe2, 0xc2, 0x77, 0x70, 0xeb, 0x23, 0xc8, 0xda, 0x87, 0x60, 0x49, 0x5c,
+	0xb9, 0x52, 0x03, 0x2f, 0x62, 0xda, 0x4e, 0x4b, 0x4d, 0x34, 0x98, 0x2f, 0xf9, 0x92, 0x6f, 0xd5,
+	0x3b, 0xf7, 0xde, 0x73, 0xe6, 0x9c, 0x3b, 0xd3, 0xc1, 0x0f, 0x96, 0x74, 0x43, 0x6d, 0xa0, 0x9b,
+	0x20, 0x62, 0x60, 0x6f, 0x86, 0x8e, 0x2f, 0xe8, 0xd0, 0x16, 0x5b, 0x12, 0x27, 0x5c, 0x70, 0x55,
+	0x4b, 0xcb, 0x44, 0x96, 0x89, 0x2c, 0xeb, 0x86, 0xcb, 0x61, 0xc5, 0xc1, 0x76, 0x28, 0xf8, 0x25,
+	0xc6, 0xe5, 0x41, 0x94, 0xa3, 0xf4, 0x4e, 0x5e, 0x7f, 0x97, 0xad, 0xec, 0x7c, 0x21, 0x4b, 0x1a,
+	0xe3, 0x8c, 0xe7, 0xf9, 0x34, 0xca, 0xb3, 0xe6, 0x17, 0x84, 0xf1, 0x0c, 0xd8, 0x73, 0x3f, 0xe6,
+	0x10, 0x08, 0xf5, 0x19, 0x6e, 0x7a, 0x79, 0xc8, 0x93, 0x36, 0xea, 0x21, 0xab, 0x39, 0x69, 0x7f,
+	0xfb, 0x3a, 0xd0, 0x24, 0xd3, 0xd8, 0xf3, 0x12, 0x1f, 0xe0, 0xa5, 0x48, 0x82, 0x88, 0xcd, 0xcf,
+	0xad, 0xaa, 0x8b, 0x1b, 0x74, 0xc5, 0xd7, 0x91, 0x68, 0xd7, 0x7a, 0x75, 0xab, 0x35, 0xea, 0x10,
+	0x89, 0x48, 0x85, 0x16, 0xea, 0xc9, 0x94, 0x07, 0xd1, 0xe4, 0xc9, 0xfe, 0x47, 0x57, 0xd9, 0xfd,
+	0xec, 0x5a, 0x2c, 0x10, 0x8b, 0xb5, 0x43, 0x5c, 0xbe, 0x92, 0x42, 0xe5, 0x67, 0x00, 0xde, 0xd2,
+	0x16, 0x1f, 0x62, 0x1f, 0x32, 0x00, 0xcc, 0x25, 0xb5, 0xa9, 0x61, 0xf5, 0x2c, 0x75, 0xee, 0x43,
+	0xcc, 0x23, 0xf0, 0xcd, 0x1d, 0xc2, 0xad, 0x19, 0xb0, 0xd7, 0x81, 0x58, 0x78, 0x09, 0x7d, 0x7f,
+	0xb3, 0x2d, 0xdc, 0xc3, 0x77, 0x2b, 0x5a, 0x4b, 0x0f, 0x2f, 0xf0, 0x9d, 0x4a, 0x7a, 0x1c, 0x86,
+	0x57, 0x75, 0x61, 0x7e, 0xc4, 0xf7, 0xff, 0x64, 0x2a, 0xf6, 0xa8, 0xf8, 0x43, 0xd7, 0xe6, 0x6f,
+	0xf4, 0xb9, 0x86, 0xeb, 0x33, 0x60, 0xea, 0x2b, 0x7c, 0xab, 0xb8, 0x52, 0x3d, 0xf2, 0xb7, 0x9b,
+	0x4c, 0xce, 0x27, 0xa9, 0x5b, 0x97, 0x3a, 0x4a, 0x0f, 0x6f, 0xf0, 0xed, 0xf2, 0x9c, 0x1f, 0xfe,
+	0x13, 0x55, 0xb4, 0xe8, 0xfd, 0x8b, 0x2d, 0x25, 0x33, 0xc5, 0xad, 0xea, 0xf8, 0x1f, 0x5d, 0x44,
+	0x8e, 0xc3, 0x50, 0x7f, 0xfc, 0x3f, 0x5d, 0xc5, 0x16, 0x93, 0xe9, 0xfe, 0x68, 0xa0, 0xc3, 0xd1,
+	0x40, 0xbf, 0x8e, 0x06, 0xfa, 0x74, 0x32, 0x94, 0xc3, 0xc9, 0x50, 0xbe, 0x9f, 0x0c, 0xe5, 0x6d,
+	0xbf, 0x32, 0xe7, 0x94, 0x71, 0x10, 0x52, 0x07, 0xb2, 0xc8, 0xde, 0x96, 0x2f, 0x44, 0x36, 0x6e,
+	0xa7, 0x91, 0xfd, 0xb6, 0x4f, 0x7f, 0x0f, 0x00, 0x9a, 0x46, 0x74, 0x9f, 0x3e, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -263,6 +359,9 @@ type MsgClient interface {
 	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
 	// Withdraw defines a method for withdrawing funds to the savings module account
 	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
+	// WithdrawAll defines a method for withdrawing all deposited funds of a
+	// depositor from the savings module account
+	WithdrawAll(ctx context.Context, in *MsgWithdrawAll, opts ...grpc.CallOption) (*MsgWithdrawAllResponse, error)
 }
 
 type msgClient struct {
@@ -291,12 +390,24 @@ func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) WithdrawAll(ctx context.Context, in *MsgWithdrawAll, opts ...grpc.CallOption) (*MsgWithdrawAllResponse, error) {
+	out := new(MsgWithdrawAllResponse)
+	err := c.cc.Invoke(ctx, "/kava.savings.v1beta1.Msg/WithdrawAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Deposit defines a method for depositing funds to the savings module account
 	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
 	// Withdraw defines a method for withdrawing funds to the savings module account
 	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
+	// WithdrawAll defines a method for withdrawing all deposited funds of a
+	// depositor from the savings module account
+	WithdrawAll(context.Context, *MsgWithdrawAll) (*MsgWithdrawAllResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -309,6 +420,9 @@ func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*M
 func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawAll(ctx context.Context, req *MsgWithdrawAll) (*MsgWithdrawAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAll not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -350,6 +464,24 @@ func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawAll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.savings.v1beta1.Msg/WithdrawAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawAll(ctx, req.(*MsgWithdrawAll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.savings.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -362,6 +494,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Withdraw",
 			Handler:    _Msg_Withdraw_Handler,
 		},
+		{
+			MethodName: "WithdrawAll",
+			Handler:    _Msg_WithdrawAll_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/savings/v1beta1/tx.proto",
@@ -501,6 +637,73 @@ func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawAll) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawAll) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -568,6 +771,34 @@ func (m *MsgWithdrawResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawAll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -906,6 +1137,172 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawAll) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawAll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawAll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
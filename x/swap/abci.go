@@ -0,0 +1,27 @@
+package swap
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/keeper"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// BeginBlocker records the cumulative prices of pools
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.UpdatePoolPriceObservations(ctx)
+}
+
+// EndBlocker fills limit orders that can be matched against the pool prices at the end of the block, then closes
+// expired limit orders
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.MatchLimitOrders(ctx)
+	k.ExpireLimitOrders(ctx)
+}
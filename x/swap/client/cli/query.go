@@ -3,11 +3,13 @@ package cli
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/swap/types"
 )
@@ -16,6 +18,7 @@ import (
 const (
 	flagOwner = "owner"
 	flagPool  = "pool"
+	flagRoute = "route"
 )
 
 // GetQueryCmd returns the cli query commands for the  module
@@ -32,6 +35,10 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		queryParamsCmd(queryRoute),
 		queryDepositsCmd(queryRoute),
 		queryPoolsCmd(queryRoute),
+		queryPoolTwapCmd(queryRoute),
+		queryPoolSwapFeeCmd(queryRoute),
+		queryLimitOrdersCmd(queryRoute),
+		querySwapQuoteCmd(queryRoute),
 	}
 
 	for _, cmd := range cmds {
@@ -152,3 +159,172 @@ func queryPoolsCmd(queryRoute string) *cobra.Command {
 	}
 	return cmd
 }
+
+func queryPoolTwapCmd(queryRoute string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pool-twap [pool-id] [window]",
+		Short: "get the time weighted average prices of a pool",
+		Long: strings.TrimSpace(`get the time weighted average prices of a liquidity pool over a window before the latest block:
+ 		Example:
+ 		$ kvcli q swap pool-twap ukava:usdx 30m`,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			window, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryPoolTwapRequest{
+				PoolId: args[0],
+				Window: window,
+			}
+			res, err := queryClient.PoolTwap(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryPoolSwapFeeCmd(queryRoute string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pool-swap-fee [pool-id]",
+		Short: "get the swap fee of a pool",
+		Long: strings.TrimSpace(`get the configured and currently charged swap fee of a liquidity pool:
+ 		Example:
+ 		$ kvcli q swap pool-swap-fee ukava:usdx`,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryPoolSwapFeeRequest{
+				PoolId: args[0],
+			}
+			res, err := queryClient.PoolSwapFee(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryLimitOrdersCmd(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit-orders",
+		Short: "get open limit orders",
+		Long: strings.TrimSpace(`get open limit orders:
+ 		Example:
+ 		$ kvcli q swap limit-orders --pool ukava:usdx
+ 		$ kvcli q swap limit-orders --owner kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny
+ 		$ kvcli q swap limit-orders --page=2 --limit=100
+ 		`,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bechOwnerAddr, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+			pool, err := cmd.Flags().GetString(flagPool)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryLimitOrdersRequest{
+				Owner:      bechOwnerAddr,
+				PoolId:     pool,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.LimitOrders(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "limit-orders")
+
+	cmd.Flags().String(flagPool, "", "pool name")
+	cmd.Flags().String(flagOwner, "", "owner of the limit orders")
+
+	return cmd
+}
+
+func querySwapQuoteCmd(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-quote [exact-input] [output-denom]",
+		Short: "get the output of a swap without executing it",
+		Long: strings.TrimSpace(`get the output, fees, and price impact of a swap with an exact input, optionally through a comma separated route of intermediate denoms:
+ 		Example:
+ 		$ kvcli q swap swap-quote 1000000ukava usdx
+ 		$ kvcli q swap swap-quote 1000000ukava hard --route usdx`,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			exactInput, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			route, err := cmd.Flags().GetStringSlice(flagRoute)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QuerySwapQuoteRequest{
+				ExactInput:         exactInput,
+				IntermediateDenoms: route,
+				OutputDenom:        args[1],
+			}
+			res, err := queryClient.SwapQuote(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagRoute, nil, "(optional) comma separated intermediate denoms to swap through")
+
+	return cmd
+}
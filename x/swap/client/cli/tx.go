@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -28,9 +29,16 @@ func GetTxCmd() *cobra.Command {
 
 	cmds := []*cobra.Command{
 		getCmdDeposit(),
+		getCmdDepositSingleSided(),
 		getCmdWithdraw(),
 		getCmdSwapExactForTokens(),
 		getCmdSwapForExactTokens(),
+		getCmdSwapExactForTokensRoute(),
+		getCmdPlaceLimitOrder(),
+		getCmdCancelLimitOrder(),
+		getCmdTransferShares(),
+		getCmdWrapShares(),
+		getCmdUnwrapShares(),
 	}
 
 	for _, cmd := range cmds {
@@ -88,6 +96,47 @@ func getCmdDeposit() *cobra.Command {
 	}
 }
 
+func getCmdDepositSingleSided() *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposit-single-sided [token] [pairedDenom] [slippage] [deadline]",
+		Short: "deposit a single coin to a swap liquidity pool, swapping part of it for the paired token",
+		Example: fmt.Sprintf(
+			`%s tx %s deposit-single-sided 10000000ukava usdx 0.01 1624224736 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			token, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			slippage, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			deadline, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgDepositSingleSided(signer.String(), token, args[1], slippage, deadline)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
 func getCmdWithdraw() *cobra.Command {
 	return &cobra.Command{
 		Use:   "withdraw [shares] [minCoinA] [minCoinB] [deadline]",
@@ -226,3 +275,213 @@ func getCmdSwapForExactTokens() *cobra.Command {
 		},
 	}
 }
+
+func getCmdSwapExactForTokensRoute() *cobra.Command {
+	return &cobra.Command{
+		Use:   "swap-exact-for-tokens-route [exactCoinA] [intermediateDenoms] [coinB] [slippage] [deadline]",
+		Short: "swap an exact amount of token a for token b through a comma separated list of intermediate denoms",
+		Example: fmt.Sprintf(
+			`%s tx %s swap-exact-for-tokens-route 1000000ukava usdx 1000000hard 0.01 1624224736 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			exactTokenA, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			intermediateDenoms := strings.Split(args[1], ",")
+
+			tokenB, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			slippage, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			deadline, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgSwapExactForTokensRoute(fromAddr.String(), exactTokenA, intermediateDenoms, tokenB, slippage, deadline)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdPlaceLimitOrder() *cobra.Command {
+	return &cobra.Command{
+		Use:   "place-limit-order [sell] [minBuy]",
+		Short: "place a limit order to sell an exact coin for at least a minimum coin once the pool price allows",
+		Example: fmt.Sprintf(
+			`%s tx %s place-limit-order 1000000ukava 6000000usdx --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sell, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			minBuy, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgPlaceLimitOrder(signer.String(), sell, minBuy)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdCancelLimitOrder() *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-limit-order [order-id]",
+		Short: "cancel an open limit order and return its sell coin",
+		Example: fmt.Sprintf(
+			`%s tx %s cancel-limit-order 1 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgCancelLimitOrder(signer.String(), orderID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdTransferShares() *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer-shares [recipient] [pool-id] [shares]",
+		Short: "transfer swap liquidity pool shares to another account",
+		Example: fmt.Sprintf(
+			`%s tx %s transfer-shares kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny ukava:usdx 153000 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			numShares, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			shares := sdkmath.NewInt(numShares)
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgTransferShares(signer.String(), args[0], args[1], shares)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdWrapShares() *cobra.Command {
+	return &cobra.Command{
+		Use:   "wrap-shares [pool-id] [shares]",
+		Short: "convert swap liquidity pool shares into share coins",
+		Example: fmt.Sprintf(
+			`%s tx %s wrap-shares ukava:usdx 153000 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			numShares, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			shares := sdkmath.NewInt(numShares)
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgWrapShares(signer.String(), args[0], shares)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdUnwrapShares() *cobra.Command {
+	return &cobra.Command{
+		Use:   "unwrap-shares [amount]",
+		Short: "convert share coins back into swap liquidity pool shares",
+		Example: fmt.Sprintf(
+			`%s tx %s unwrap-shares 153000swp-ukava:usdx --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgUnwrapShares(signer.String(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
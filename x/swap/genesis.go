@@ -22,6 +22,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, sh := range gs.ShareRecords {
 		k.SetDepositorShares(ctx, sh)
 	}
+	for _, lo := range gs.LimitOrders {
+		k.SetLimitOrder(ctx, lo)
+	}
+	k.SetNextLimitOrderID(ctx, gs.NextLimitOrderID)
 }
 
 // ExportGenesis exports the genesis state
@@ -29,6 +33,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 	pools := k.GetAllPools(ctx)
 	shares := k.GetAllDepositorShares(ctx)
+	limitOrders := k.GetAllLimitOrders(ctx)
+	nextLimitOrderID := k.GetNextLimitOrderID(ctx)
 
-	return types.NewGenesisState(params, pools, shares)
+	return types.NewGenesisState(params, pools, shares, limitOrders, nextLimitOrderID)
 }
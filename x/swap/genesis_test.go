@@ -2,6 +2,7 @@ package swap_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/swap"
@@ -20,10 +21,13 @@ type genesisTestSuite struct {
 func (suite *genesisTestSuite) Test_InitGenesis_ValidationPanic() {
 	invalidState := types.NewGenesisState(
 		types.Params{
-			SwapFee: sdk.NewDec(-1),
+			SwapFee:              sdk.NewDec(-1),
+			TwapMaxWindowSeconds: types.DefaultTwapMaxWindowSeconds,
 		},
 		types.PoolRecords{},
 		types.ShareRecords{},
+		types.DefaultLimitOrders,
+		types.DefaultNextLimitOrderID,
 	)
 
 	suite.Panics(func() {
@@ -40,8 +44,9 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 	// slices are sorted by key as stored in the data store, so init and export can be compared with equal
 	state := types.NewGenesisState(
 		types.Params{
-			AllowedPools: types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
-			SwapFee:      sdk.MustNewDecFromStr("0.00255"),
+			AllowedPools:         types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
+			SwapFee:              sdk.MustNewDecFromStr("0.00255"),
+			TwapMaxWindowSeconds: types.DefaultTwapMaxWindowSeconds,
 		},
 		types.PoolRecords{
 			types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(2e6))), sdkmath.NewInt(1e6)),
@@ -51,6 +56,10 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 			types.NewShareRecord(depositor_2, types.PoolID("hard", "usdx"), sdkmath.NewInt(1e6)),
 			types.NewShareRecord(depositor_1, types.PoolID("ukava", "usdx"), sdkmath.NewInt(3e6)),
 		},
+		types.LimitOrders{
+			types.NewLimitOrder(1, depositor_1, sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(6e6)), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		2,
 	)
 
 	swap.InitGenesis(suite.Ctx, suite.Keeper, state)
@@ -79,8 +88,9 @@ func (suite *genesisTestSuite) Test_Marshall() {
 	// slices are sorted by key as stored in the data store, so init and export can be compared with equal
 	state := types.NewGenesisState(
 		types.Params{
-			AllowedPools: types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
-			SwapFee:      sdk.MustNewDecFromStr("0.00255"),
+			AllowedPools:         types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
+			SwapFee:              sdk.MustNewDecFromStr("0.00255"),
+			TwapMaxWindowSeconds: types.DefaultTwapMaxWindowSeconds,
 		},
 		types.PoolRecords{
 			types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(2e6))), sdkmath.NewInt(1e6)),
@@ -90,6 +100,10 @@ func (suite *genesisTestSuite) Test_Marshall() {
 			types.NewShareRecord(depositor_2, types.PoolID("hard", "usdx"), sdkmath.NewInt(1e6)),
 			types.NewShareRecord(depositor_1, types.PoolID("ukava", "usdx"), sdkmath.NewInt(3e6)),
 		},
+		types.LimitOrders{
+			types.NewLimitOrder(1, depositor_1, sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(6e6)), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		2,
 	)
 
 	encodingCfg := app.MakeEncodingConfig()
@@ -114,8 +128,9 @@ func (suite *genesisTestSuite) Test_LegacyJSONConversion() {
 	// slices are sorted by key as stored in the data store, so init and export can be compared with equal
 	state := types.NewGenesisState(
 		types.Params{
-			AllowedPools: types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
-			SwapFee:      sdk.MustNewDecFromStr("0.00255"),
+			AllowedPools:         types.AllowedPools{types.NewAllowedPoolWithSwapFee("ukava", "usdx", sdk.MustNewDecFromStr("0.003"))},
+			SwapFee:              sdk.MustNewDecFromStr("0.00255"),
+			TwapMaxWindowSeconds: 3600,
 		},
 		types.PoolRecords{
 			types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(2e6))), sdkmath.NewInt(1e6)),
@@ -125,6 +140,10 @@ func (suite *genesisTestSuite) Test_LegacyJSONConversion() {
 			types.NewShareRecord(depositor_2, types.PoolID("hard", "usdx"), sdkmath.NewInt(1e6)),
 			types.NewShareRecord(depositor_1, types.PoolID("ukava", "usdx"), sdkmath.NewInt(3e6)),
 		},
+		types.LimitOrders{
+			types.NewLimitOrder(1, depositor_1, sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(6e6)), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		2,
 	)
 
 	encodingCfg := app.MakeEncodingConfig()
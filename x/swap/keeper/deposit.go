@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -78,13 +79,7 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coinA sdk.Coi
 	}
 
 	k.updatePool(ctx, poolID, pool)
-	if shareRecord, hasExistingShares := k.GetDepositorShares(ctx, depositor, poolID); hasExistingShares {
-		k.BeforePoolDepositModified(ctx, poolID, depositor, shareRecord.SharesOwned)
-		k.updateDepositorShares(ctx, depositor, poolID, shareRecord.SharesOwned.Add(shares))
-	} else {
-		k.updateDepositorShares(ctx, depositor, poolID, shares)
-		k.AfterPoolDepositCreated(ctx, poolID, depositor, shares)
-	}
+	k.addDepositorShares(ctx, depositor, poolID, shares)
 
 	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleAccountName, depositAmount)
 	if err != nil {
@@ -104,22 +99,123 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coinA sdk.Coi
 	return nil
 }
 
-func (k Keeper) depositAllowed(ctx sdk.Context, poolID string) bool {
-	params := k.GetParams(ctx)
-	for _, p := range params.AllowedPools {
-		if poolID == types.PoolID(p.TokenA, p.TokenB) {
-			return true
+// DepositSingleSided adds liquidity to an existing pool with only one of its tokens.  The portion of the coin
+// that balances the deposit at the pool price after the swap is swapped for the paired token within the pool,
+// paying the swap fee, and the rest of the coin and the swap output are added as liquidity.  Any swap output that
+// can not be added to the pool due to rounding is returned to the depositor.
+//
+// For a coin amount a, input reserves R, and swap fee f, the swapped amount s solves
+// (a - s) / (R + s) = (1 - f) * s / (R + (1 - f) * s), which is the ratio of the remaining coin to the input
+// reserves after the swap equal to the ratio of the swap output to the output reserves after the swap.  This gives
+// s = (sqrt(R^2 * (2 - f)^2 + 4 * (1 - f) * a * R) - R * (2 - f)) / (2 * (1 - f)).
+//
+// The swapped amount of stable swap pools is also calculated with the constant product formula, so only part of the
+// coin may be deposited, and the rest remains with the depositor.
+//
+// The slippage is that of the internal swap, calculated from the swap output compared to the output at the pool
+// price before the swap.  An error is returned when the slippage > slippageLimit.
+func (k Keeper) DepositSingleSided(ctx sdk.Context, depositor sdk.AccAddress, coin sdk.Coin, pairedDenom string, slippageLimit sdk.Dec) error {
+	poolID, pool, err := k.loadPool(ctx, coin.Denom, pairedDenom)
+	if err != nil {
+		return err
+	}
+
+	swapFee := k.GetPoolSwapFee(ctx, poolID)
+	inputReserves := pool.Reserves().AmountOf(coin.Denom)
+
+	swapAmount := calculateSingleSidedSwapAmount(coin.Amount, inputReserves, swapFee)
+	if swapAmount.IsZero() || swapAmount.GTE(coin.Amount) {
+		return errorsmod.Wrap(types.ErrInsufficientLiquidity, "deposit must be increased")
+	}
+	swapInput := sdk.NewCoin(coin.Denom, swapAmount)
+
+	poolPriceOutput := sdk.NewDecFromInt(swapAmount).Mul(pool.Price(coin.Denom))
+	swapOutput, feePaid := pool.SwapWithExactInput(swapInput, swapFee)
+	if swapOutput.IsZero() {
+		return errorsmod.Wrapf(types.ErrInsufficientLiquidity, "swap output rounds to zero, increase input amount")
+	}
+
+	priceChange := sdk.NewDecFromInt(swapOutput.Amount).Quo(poolPriceOutput)
+	if err := k.assertSlippageWithinLimit(priceChange, slippageLimit); err != nil {
+		return err
+	}
+
+	depositAmount, shares := pool.AddLiquidity(sdk.NewCoins(coin.Sub(swapInput), swapOutput))
+	if depositAmount.AmountOf(coin.Denom).IsZero() || depositAmount.AmountOf(pairedDenom).IsZero() || shares.IsZero() {
+		return errorsmod.Wrap(types.ErrInsufficientLiquidity, "deposit must be increased")
+	}
+
+	k.updatePool(ctx, poolID, pool)
+	k.addDepositorShares(ctx, depositor, poolID, shares)
+
+	depositorInput := swapInput.AddAmount(depositAmount.AmountOf(coin.Denom))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleAccountName, sdk.NewCoins(depositorInput)); err != nil {
+		return err
+	}
+
+	refund := swapOutput.SubAmount(depositAmount.AmountOf(pairedDenom))
+	if refund.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, depositor, sdk.NewCoins(refund)); err != nil {
+			panic(err)
 		}
 	}
-	return false
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapTrade,
+			sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+			sdk.NewAttribute(types.AttributeKeyRequester, depositor.String()),
+			sdk.NewAttribute(types.AttributeKeySwapInput, swapInput.String()),
+			sdk.NewAttribute(types.AttributeKeySwapOutput, swapOutput.String()),
+			sdk.NewAttribute(types.AttributeKeyFeePaid, feePaid.String()),
+			sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+		),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapDeposit,
+			sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, depositAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+		),
+	)
+
+	return nil
+}
+
+// calculateSingleSidedSwapAmount returns the amount of a single sided deposit to swap so that the rest of the
+// deposit and the swap output are in the ratio of the pool reserves after the swap
+func calculateSingleSidedSwapAmount(amount, inputReserves sdkmath.Int, swapFee sdk.Dec) sdkmath.Int {
+	twoMinusFee := sdk.NewDec(2).Sub(swapFee)
+	oneMinusFee := sdk.OneDec().Sub(swapFee)
+
+	reservesTerm := twoMinusFee.MulInt(inputReserves)
+	discriminant := reservesTerm.Mul(reservesTerm).Add(oneMinusFee.MulInt(amount).MulInt(inputReserves).MulInt64(4))
+	root := sdk.NewDecFromBigInt(new(big.Int).Sqrt(discriminant.TruncateInt().BigInt()))
+
+	return root.Sub(reservesTerm).Quo(oneMinusFee.MulInt64(2)).TruncateInt()
+}
+
+// addDepositorShares adds shares to the depositor's share record of a pool, calling the deposit hooks
+func (k Keeper) addDepositorShares(ctx sdk.Context, depositor sdk.AccAddress, poolID string, shares sdkmath.Int) {
+	if shareRecord, hasExistingShares := k.GetDepositorShares(ctx, depositor, poolID); hasExistingShares {
+		k.BeforePoolDepositModified(ctx, poolID, depositor, shareRecord.SharesOwned)
+		k.updateDepositorShares(ctx, depositor, poolID, shareRecord.SharesOwned.Add(shares))
+	} else {
+		k.updateDepositorShares(ctx, depositor, poolID, shares)
+		k.AfterPoolDepositCreated(ctx, poolID, depositor, shares)
+	}
 }
 
 func (k Keeper) initializePool(ctx sdk.Context, poolID string, depositor sdk.AccAddress, reserves sdk.Coins) (*types.DenominatedPool, sdk.Coins, sdkmath.Int, error) {
-	if allowed := k.depositAllowed(ctx, poolID); !allowed {
+	allowedPool, allowed := k.GetParams(ctx).AllowedPool(poolID)
+	if !allowed {
 		return nil, sdk.Coins{}, sdk.ZeroInt(), errorsmod.Wrap(types.ErrNotAllowed, fmt.Sprintf("can not create pool '%s'", poolID))
 	}
 
-	pool, err := types.NewDenominatedPool(reserves)
+	pool, err := types.NewDenominatedPoolWithAmplification(reserves, allowedPool.Amplification)
 	if err != nil {
 		return nil, sdk.Coins{}, sdk.ZeroInt(), err
 	}
@@ -128,7 +224,7 @@ func (k Keeper) initializePool(ctx sdk.Context, poolID string, depositor sdk.Acc
 }
 
 func (k Keeper) addLiquidityToPool(ctx sdk.Context, record types.PoolRecord, depositor sdk.AccAddress, desiredAmount sdk.Coins) (*types.DenominatedPool, sdk.Coins, sdkmath.Int, error) {
-	pool, err := types.NewDenominatedPoolWithExistingShares(record.Reserves(), record.TotalShares)
+	pool, err := types.NewDenominatedPoolFromRecord(record)
 	if err != nil {
 		return nil, sdk.Coins{}, sdk.ZeroInt(), err
 	}
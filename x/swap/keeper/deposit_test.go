@@ -59,7 +59,7 @@ func (suite *keeperTestSuite) TestDeposit_InsufficientFunds() {
 
 			pool := types.NewAllowedPool(tc.depositA.Denom, tc.depositB.Denom)
 			suite.Require().NoError(pool.Validate())
-			suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee))
+			suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee, types.DefaultTwapMaxWindowSeconds))
 
 			balance := sdk.NewCoins(tc.balanceA, tc.balanceB)
 			depositor := suite.CreateAccount(balance)
@@ -123,7 +123,7 @@ func (suite *keeperTestSuite) TestDeposit_InsufficientFunds_Vesting() {
 
 			pool := types.NewAllowedPool(tc.depositA.Denom, tc.depositB.Denom)
 			suite.Require().NoError(pool.Validate())
-			suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee))
+			suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee, types.DefaultTwapMaxWindowSeconds))
 
 			balance := sdk.NewCoins(tc.balanceA, tc.balanceB)
 			vesting := sdk.NewCoins(tc.vestingA, tc.vestingB)
@@ -147,7 +147,7 @@ func (suite *keeperTestSuite) TestDeposit_InsufficientFunds_Vesting() {
 func (suite *keeperTestSuite) TestDeposit_CreatePool() {
 	pool := types.NewAllowedPool("ukava", "usdx")
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee, types.DefaultTwapMaxWindowSeconds))
 
 	amountA := sdk.NewCoin(pool.TokenA, sdkmath.NewInt(11e6))
 	amountB := sdk.NewCoin(pool.TokenB, sdkmath.NewInt(51e6))
@@ -174,6 +174,38 @@ func (suite *keeperTestSuite) TestDeposit_CreatePool() {
 	))
 }
 
+func (suite *keeperTestSuite) TestDeposit_CreateStableSwapPool() {
+	pool := types.NewAllowedStableSwapPool("busd", "usdx", 100)
+	suite.Require().NoError(pool.Validate())
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee, types.DefaultTwapMaxWindowSeconds))
+
+	depositA := sdk.NewCoin(pool.TokenA, sdkmath.NewInt(10e6))
+	depositB := sdk.NewCoin(pool.TokenB, sdkmath.NewInt(10e6))
+	deposit := sdk.NewCoins(depositA, depositB)
+	depositor := suite.CreateAccount(deposit)
+
+	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), depositA, depositB, sdk.MustNewDecFromStr("0"))
+	suite.Require().NoError(err)
+	suite.PoolLiquidityEqual(deposit)
+	suite.PoolShareValueEqual(depositor, pool, deposit)
+
+	record, found := suite.Keeper.GetPool(suite.Ctx, pool.Name())
+	suite.Require().True(found)
+	suite.Equal(uint64(100), record.Amplification)
+	suite.Equal(sdkmath.NewInt(20e6), record.TotalShares)
+
+	// swaps near balanced reserves trade close to one to one
+	balance := sdk.NewCoins(sdk.NewCoin("busd", sdkmath.NewInt(1e6)))
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), balance[0], sdk.NewCoin("usdx", sdkmath.NewInt(1e6)), sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	expectedOutput := sdk.NewCoin("usdx", sdkmath.NewInt(999000))
+	suite.AccountBalanceEqual(requester.GetAddress(), sdk.NewCoins(expectedOutput))
+	suite.PoolLiquidityEqual(deposit.Add(balance...).Sub(expectedOutput))
+}
+
 func (suite *keeperTestSuite) TestDeposit_PoolExists() {
 	pool := types.NewAllowedPool("ukava", "usdx")
 	reserves := sdk.NewCoins(
@@ -339,3 +371,122 @@ func (suite *keeperTestSuite) TestDeposit_InsufficientLiquidity() {
 		})
 	}
 }
+
+func (suite *keeperTestSuite) TestDepositSingleSided_PoolNotFound() {
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6)))
+	depositor := suite.CreateAccount(balance)
+
+	err := suite.Keeper.DepositSingleSided(suite.Ctx, depositor.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("0.01"))
+	suite.EqualError(err, "pool ukava:usdx not found: invalid pool")
+}
+
+func (suite *keeperTestSuite) TestDepositSingleSided() {
+	pool := types.NewAllowedPool("ukava", "usdx")
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	)
+	err := suite.CreatePool(reserves)
+	suite.Require().NoError(err)
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+	)
+	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), balance)
+
+	ctx := suite.App.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+
+	err = suite.Keeper.DepositSingleSided(ctx, depositor.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("0.05"))
+	suite.Require().NoError(err)
+
+	expectedSwapInput := sdk.NewCoin("ukava", sdkmath.NewInt(488821))
+	expectedSwapOutput := sdk.NewCoin("usdx", sdkmath.NewInt(2323531))
+	expectedDeposit := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(511176)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(2323531)),
+	)
+	// the full swap output is deposited, leaving the reserves of the paired token unchanged
+	expectedReserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10999997)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	)
+
+	// the remainder of the deposit due to rounding is not transferred
+	suite.AccountBalanceEqual(depositor.GetAddress(), sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(3))))
+	suite.ModuleAccountBalanceEqual(expectedReserves)
+	suite.PoolLiquidityEqual(expectedReserves)
+	suite.PoolDepositorSharesEqual(depositor.GetAddress(), types.PoolID(pool.TokenA, pool.TokenB), sdkmath.NewInt(1089754))
+
+	suite.EventsContains(ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, types.PoolID(pool.TokenA, pool.TokenB)),
+		sdk.NewAttribute(types.AttributeKeyRequester, depositor.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, expectedSwapInput.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedSwapOutput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "1467ukava"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+
+	suite.EventsContains(ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapDeposit,
+		sdk.NewAttribute(types.AttributeKeyPoolID, types.PoolID(pool.TokenA, pool.TokenB)),
+		sdk.NewAttribute(types.AttributeKeyDepositor, depositor.GetAddress().String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, expectedDeposit.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, "1089754"),
+	))
+}
+
+func (suite *keeperTestSuite) TestDepositSingleSided_Slippage() {
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	)
+
+	testCases := []struct {
+		deposit     sdk.Coin
+		pairedDenom string
+		slippage    sdk.Dec
+		shouldFail  bool
+	}{
+		{sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("0.01"), true},
+		{sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("0.05"), false},
+		{sdk.NewCoin("usdx", sdkmath.NewInt(1e6)), "ukava", sdk.MustNewDecFromStr("0.01"), true},
+		{sdk.NewCoin("usdx", sdkmath.NewInt(1e6)), "ukava", sdk.MustNewDecFromStr("0.02"), false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("deposit=%s slippage=%s", tc.deposit, tc.slippage), func() {
+			suite.SetupTest()
+
+			err := suite.CreatePool(reserves)
+			suite.Require().NoError(err)
+
+			depositor := suite.CreateAccount(sdk.NewCoins(tc.deposit))
+
+			ctx := suite.App.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+
+			err = suite.Keeper.DepositSingleSided(ctx, depositor.GetAddress(), tc.deposit, tc.pairedDenom, tc.slippage)
+			if tc.shouldFail {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "slippage exceeded")
+			} else {
+				suite.NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *keeperTestSuite) TestDepositSingleSided_InsufficientLiquidity() {
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	)
+	err := suite.CreatePool(reserves)
+	suite.Require().NoError(err)
+
+	deposit := sdk.NewCoin("ukava", sdkmath.NewInt(1))
+	depositor := suite.CreateAccount(sdk.NewCoins(deposit))
+
+	err = suite.Keeper.DepositSingleSided(suite.Ctx, depositor.GetAddress(), deposit, "usdx", sdk.MustNewDecFromStr("1"))
+	suite.EqualError(err, "deposit must be increased: insufficient liquidity")
+}
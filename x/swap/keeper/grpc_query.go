@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"google.golang.org/grpc/codes"
@@ -59,7 +60,7 @@ func (s queryServer) Pools(c context.Context, req *types.QueryPoolsRequest) (*ty
 		}
 
 		if shouldAccumulate {
-			denominatedPool, err := types.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+			denominatedPool, err := types.NewDenominatedPoolFromRecord(poolRecord)
 			if err != nil {
 				return true, types.ErrInvalidPool
 			}
@@ -68,6 +69,7 @@ func (s queryServer) Pools(c context.Context, req *types.QueryPoolsRequest) (*ty
 				Name:        poolRecord.PoolID,
 				Coins:       totalCoins,
 				TotalShares: denominatedPool.TotalShares(),
+				SwapFee:     s.keeper.GetPoolSwapFee(ctx, poolRecord.PoolID),
 			}
 			queryResults = append(queryResults, queryResult)
 		}
@@ -148,3 +150,126 @@ func (s queryServer) Deposits(c context.Context, req *types.QueryDepositsRequest
 		Pagination: pageRes,
 	}, nil
 }
+
+// PoolTwap implements the Query/PoolTwap gRPC method
+func (s queryServer) PoolTwap(c context.Context, req *types.QueryPoolTwapRequest) (*types.QueryPoolTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := s.keeper.GetPool(ctx, req.PoolId); !found {
+		return nil, status.Error(codes.NotFound, "pool not found")
+	}
+
+	maxWindow := s.keeper.GetParams(ctx).TwapMaxWindow()
+	if req.Window <= 0 || req.Window > maxWindow {
+		return nil, status.Errorf(codes.InvalidArgument, "window must be positive and at most %s", maxWindow)
+	}
+
+	start, end, err := s.keeper.GetPoolTwapObservations(ctx, req.PoolId, req.Window)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	twapA, twapB := end.TimeWeightedAveragePrices(start)
+
+	return &types.QueryPoolTwapResponse{
+		PoolId:    req.PoolId,
+		TwapA:     twapA,
+		TwapB:     twapB,
+		StartTime: start.Time,
+		EndTime:   end.Time,
+	}, nil
+}
+
+// PoolSwapFee implements the Query/PoolSwapFee gRPC method
+func (s queryServer) PoolSwapFee(c context.Context, req *types.QueryPoolSwapFeeRequest) (*types.QueryPoolSwapFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := s.keeper.GetPool(ctx, req.PoolId); !found {
+		return nil, status.Error(codes.NotFound, "pool not found")
+	}
+
+	return &types.QueryPoolSwapFeeResponse{
+		PoolId:      req.PoolId,
+		BaseSwapFee: s.keeper.GetBasePoolSwapFee(ctx, req.PoolId),
+		SwapFee:     s.keeper.GetPoolSwapFee(ctx, req.PoolId),
+	}, nil
+}
+
+// LimitOrders implements the Query/LimitOrders gRPC method
+func (s queryServer) LimitOrders(c context.Context, req *types.QueryLimitOrdersRequest) (*types.QueryLimitOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(s.keeper.key), types.LimitOrderKeyPrefix)
+
+	orders := types.LimitOrders{}
+	pageRes, err := query.FilteredPaginate(
+		store,
+		req.Pagination,
+		func(key []byte, value []byte, accumulate bool) (bool, error) {
+			var order types.LimitOrder
+			if err := s.keeper.cdc.Unmarshal(value, &order); err != nil {
+				return false, err
+			}
+
+			// Filter for results match the request's pool ID/owner params if given
+			if len(req.Owner) > 0 && order.Owner.String() != req.Owner {
+				return false, nil
+			}
+			if len(req.PoolId) > 0 && order.PoolID() != req.PoolId {
+				return false, nil
+			}
+			if accumulate {
+				orders = append(orders, order)
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLimitOrdersResponse{
+		LimitOrders: orders,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// SwapQuote implements the Query/SwapQuote gRPC method
+func (s queryServer) SwapQuote(c context.Context, req *types.QuerySwapQuoteRequest) (*types.QuerySwapQuoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := req.ExactInput.Validate(); err != nil || !req.ExactInput.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid exact input %s", req.ExactInput)
+	}
+	if err := sdk.ValidateDenom(req.OutputDenom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	output, feesPaid, priceImpact, err := s.keeper.QuoteSwapExactForTokens(ctx, req.ExactInput, req.IntermediateDenoms, req.OutputDenom)
+	if err != nil {
+		if errors.Is(err, types.ErrInvalidPool) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &types.QuerySwapQuoteResponse{
+		Output:      output,
+		FeesPaid:    feesPaid,
+		PriceImpact: priceImpact,
+	}, nil
+}
@@ -16,7 +16,7 @@ func (suite *keeperTestSuite) TestHooks_DepositAndWithdraw() {
 
 	pool := types.NewAllowedPool("ukava", "usdx")
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee, types.DefaultTwapMaxWindowSeconds))
 
 	balance := sdk.NewCoins(
 		sdk.NewCoin(pool.TokenA, sdkmath.NewInt(1000e6)),
@@ -117,12 +117,51 @@ func (suite *keeperTestSuite) TestHooks_DepositAndWithdraw() {
 	swapHooks.AssertExpectations(suite.T())
 }
 
+func (suite *keeperTestSuite) TestHooks_TransferAndWrapShares() {
+	suite.Keeper.ClearHooks()
+	swapHooks := &mocks.SwapHooks{}
+	suite.Keeper.SetHooks(swapHooks)
+
+	owner := suite.CreateAccount(sdk.Coins{})
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	)
+	poolID := suite.setupPool(reserves, sdkmath.NewInt(30e6), owner.GetAddress())
+	recipient := sdk.AccAddress("recipient-----------")
+
+	// transfer to a new deposit - calls BeforePoolDepositModified for the sender and AfterPoolDepositCreated for
+	// the recipient
+	swapHooks.On("BeforePoolDepositModified", suite.Ctx, poolID, owner.GetAddress(), sdkmath.NewInt(30e6)).Once()
+	swapHooks.On("AfterPoolDepositCreated", suite.Ctx, poolID, recipient, sdkmath.NewInt(10e6)).Once()
+	err := suite.Keeper.TransferShares(suite.Ctx, owner.GetAddress(), recipient, poolID, sdkmath.NewInt(10e6))
+	suite.Require().NoError(err)
+
+	// transfer to an existing deposit - calls BeforePoolDepositModified for both depositors with their shares
+	// before the transfer
+	swapHooks.On("BeforePoolDepositModified", suite.Ctx, poolID, owner.GetAddress(), sdkmath.NewInt(20e6)).Once()
+	swapHooks.On("BeforePoolDepositModified", suite.Ctx, poolID, recipient, sdkmath.NewInt(10e6)).Once()
+	err = suite.Keeper.TransferShares(suite.Ctx, owner.GetAddress(), recipient, poolID, sdkmath.NewInt(5e6))
+	suite.Require().NoError(err)
+
+	// wrapping and unwrapping only calls hooks for the owner, as wrapped shares held by the module do not earn rewards
+	swapHooks.On("BeforePoolDepositModified", suite.Ctx, poolID, recipient, sdkmath.NewInt(15e6)).Once()
+	shareCoin, err := suite.Keeper.WrapShares(suite.Ctx, recipient, poolID, sdkmath.NewInt(15e6))
+	suite.Require().NoError(err)
+
+	swapHooks.On("AfterPoolDepositCreated", suite.Ctx, poolID, recipient, sdkmath.NewInt(15e6)).Once()
+	err = suite.Keeper.UnwrapShares(suite.Ctx, recipient, shareCoin)
+	suite.Require().NoError(err)
+
+	swapHooks.AssertExpectations(suite.T())
+}
+
 func (suite *keeperTestSuite) TestHooks_NoPanicsOnNilHooks() {
 	suite.Keeper.ClearHooks()
 
 	pool := types.NewAllowedPool("ukava", "usdx")
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee, types.DefaultTwapMaxWindowSeconds))
 
 	balance := sdk.NewCoins(
 		sdk.NewCoin(pool.TokenA, sdkmath.NewInt(1000e6)),
@@ -156,7 +195,7 @@ func (suite *keeperTestSuite) TestHooks_HookOrdering() {
 
 	pool := types.NewAllowedPool("ukava", "usdx")
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee, types.DefaultTwapMaxWindowSeconds))
 
 	balance := sdk.NewCoins(
 		sdk.NewCoin(pool.TokenA, sdkmath.NewInt(1000e6)),
@@ -13,6 +13,7 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "share-records", ShareRecordsInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "pool-reserves", PoolReservesInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "pool-shares", PoolSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "wrapped-shares", WrappedSharesInvariant(k))
 }
 
 // AllInvariants runs all invariants of the swap module
@@ -30,7 +31,11 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		res, stop := PoolSharesInvariant(k)(ctx)
+		if res, stop := PoolSharesInvariant(k)(ctx); stop {
+			return res, stop
+		}
+
+		res, stop := WrappedSharesInvariant(k)(ctx)
 		return res, stop
 	}
 }
@@ -71,7 +76,8 @@ func ShareRecordsInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
-// PoolReservesInvariant iterates all pools and ensures the total reserves matches the module account coins
+// PoolReservesInvariant iterates all pools and limit orders and ensures the total reserves and limit order sell
+// coins match the module account coins
 func PoolReservesInvariant(k Keeper) sdk.Invariant {
 	message := sdk.FormatInvariant(types.ModuleName, "pool reserves broken", "pool reserves do not match module account")
 
@@ -85,6 +91,10 @@ func PoolReservesInvariant(k Keeper) sdk.Invariant {
 			}
 			return false
 		})
+		k.IterateLimitOrders(ctx, func(order types.LimitOrder) bool {
+			reserves = reserves.Add(order.Sell)
+			return false
+		})
 
 		broken := !reserves.IsEqual(balance)
 		return message, broken
@@ -137,3 +147,21 @@ func PoolSharesInvariant(k Keeper) sdk.Invariant {
 		return message, broken
 	}
 }
+
+// WrappedSharesInvariant iterates the shares held by the module account and ensures they match the supply of share coins
+func WrappedSharesInvariant(k Keeper) sdk.Invariant {
+	broken := false
+	message := sdk.FormatInvariant(types.ModuleName, "wrapped shares broken", "module account shares do not match share coin supply")
+
+	return func(ctx sdk.Context) (string, bool) {
+		k.IterateDepositorSharesByOwner(ctx, k.GetSwapModuleAccount(ctx).GetAddress(), func(sr types.ShareRecord) bool {
+			if !k.bankKeeper.GetSupply(ctx, types.ShareDenom(sr.PoolID)).Amount.Equal(sr.SharesOwned) {
+				broken = true
+				return true
+			}
+			return false
+		})
+
+		return message, broken
+	}
+}
@@ -20,6 +20,7 @@ type Keeper struct {
 	cdc           codec.Codec
 	paramSubspace paramtypes.Subspace
 	hooks         types.SwapHooks
+	feeController types.SwapFeeController
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
 }
@@ -59,10 +60,19 @@ func (k *Keeper) ClearHooks() {
 	k.hooks = nil
 }
 
+// SetFeeController adds a swap fee controller to the keeper.
+func (k *Keeper) SetFeeController(fc types.SwapFeeController) *Keeper {
+	if k.feeController != nil {
+		panic("cannot set swap fee controller twice")
+	}
+	k.feeController = fc
+	return k
+}
+
 // GetParams returns the params from the store
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	var p types.Params
-	k.paramSubspace.GetParamSet(ctx, &p)
+	k.paramSubspace.GetParamSetIfExists(ctx, &p)
 	return p
 }
 
@@ -76,6 +86,27 @@ func (k Keeper) GetSwapFee(ctx sdk.Context) sdk.Dec {
 	return k.GetParams(ctx).SwapFee
 }
 
+// GetBasePoolSwapFee returns the swap fee configured for a pool in the module parameters
+func (k Keeper) GetBasePoolSwapFee(ctx sdk.Context, poolID string) sdk.Dec {
+	return k.GetParams(ctx).PoolSwapFee(poolID)
+}
+
+// GetPoolSwapFee returns the swap fee charged by a pool, which is the configured swap fee adjusted by the fee
+// controller if one is set.  Adjusted fees that are not within [0, MaxSwapFee) are ignored.
+func (k Keeper) GetPoolSwapFee(ctx sdk.Context, poolID string) sdk.Dec {
+	swapFee := k.GetBasePoolSwapFee(ctx, poolID)
+	if k.feeController == nil {
+		return swapFee
+	}
+
+	adjustedFee := k.feeController.AdjustSwapFee(ctx, poolID, swapFee)
+	if adjustedFee.IsNil() || adjustedFee.IsNegative() || adjustedFee.GTE(types.MaxSwapFee) {
+		return swapFee
+	}
+
+	return adjustedFee
+}
+
 // GetSwapModuleAccount returns the swap ModuleAccount
 func (k Keeper) GetSwapModuleAccount(ctx sdk.Context) authtypes.ModuleAccountI {
 	return k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
@@ -112,10 +143,11 @@ func (k Keeper) SetPool(ctx sdk.Context, record types.PoolRecord) {
 	k.SetPool_Raw(ctx, record)
 }
 
-// DeletePool deletes a pool record from the store
+// DeletePool deletes a pool record and its price observations from the store
 func (k Keeper) DeletePool(ctx sdk.Context, poolID string) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolKeyPrefix)
 	store.Delete(types.PoolKey(poolID))
+	k.DeletePoolPriceObservations(ctx, poolID)
 }
 
 // IteratePools iterates over all pool objects in the store and performs a callback function
@@ -263,7 +295,7 @@ func (k Keeper) loadDenominatedPool(ctx sdk.Context, poolID string) (*types.Deno
 	if !found {
 		return &types.DenominatedPool{}, types.ErrInvalidPool
 	}
-	denominatedPool, err := types.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+	denominatedPool, err := types.NewDenominatedPoolFromRecord(poolRecord)
 	if err != nil {
 		return &types.DenominatedPool{}, types.ErrInvalidPool
 	}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/swap/keeper"
 	"github.com/kava-labs/kava/x/swap/testutil"
 	"github.com/kava-labs/kava/x/swap/types"
 	"github.com/kava-labs/kava/x/swap/types/mocks"
@@ -12,6 +13,8 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestMain(m *testing.M) {
@@ -63,7 +66,8 @@ func (suite keeperTestSuite) TestParams_Persistance() {
 		AllowedPools: types.AllowedPools{
 			types.NewAllowedPool("ukava", "usdx"),
 		},
-		SwapFee: sdk.MustNewDecFromStr("0.03"),
+		SwapFee:              sdk.MustNewDecFromStr("0.03"),
+		TwapMaxWindowSeconds: types.DefaultTwapMaxWindowSeconds,
 	}
 	keeper.SetParams(suite.Ctx, params)
 	suite.Equal(keeper.GetParams(suite.Ctx), params)
@@ -73,7 +77,8 @@ func (suite keeperTestSuite) TestParams_Persistance() {
 		AllowedPools: types.AllowedPools{
 			types.NewAllowedPool("hard", "ukava"),
 		},
-		SwapFee: sdk.MustNewDecFromStr("0.01"),
+		SwapFee:              sdk.MustNewDecFromStr("0.01"),
+		TwapMaxWindowSeconds: types.DefaultTwapMaxWindowSeconds,
 	}
 	keeper.SetParams(suite.Ctx, params)
 	suite.NotEqual(keeper.GetParams(suite.Ctx), oldParams)
@@ -84,13 +89,106 @@ func (suite keeperTestSuite) TestParams_GetSwapFee() {
 	keeper := suite.Keeper
 
 	params := types.Params{
-		SwapFee: sdk.MustNewDecFromStr("0.00333"),
+		SwapFee:              sdk.MustNewDecFromStr("0.00333"),
+		TwapMaxWindowSeconds: types.DefaultTwapMaxWindowSeconds,
 	}
 	keeper.SetParams(suite.Ctx, params)
 
 	suite.Equal(keeper.GetSwapFee(suite.Ctx), params.SwapFee)
 }
 
+// fixedFeeController is a swap fee controller that charges a fixed fee for a single pool
+type fixedFeeController struct {
+	poolID  string
+	swapFee sdk.Dec
+}
+
+func (c fixedFeeController) AdjustSwapFee(_ sdk.Context, poolID string, baseSwapFee sdk.Dec) sdk.Dec {
+	if poolID != c.poolID {
+		return baseSwapFee
+	}
+	return c.swapFee
+}
+
+func (suite *keeperTestSuite) TestGetPoolSwapFee() {
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(
+		types.NewAllowedPools(
+			types.NewAllowedPool("ukava", "usdx"),
+			types.NewAllowedPoolWithSwapFee("hard", "usdx", sdk.MustNewDecFromStr("0.01")),
+		),
+		sdk.MustNewDecFromStr("0.003"),
+		types.DefaultTwapMaxWindowSeconds,
+	))
+
+	suite.Equal(sdk.MustNewDecFromStr("0.003"), suite.Keeper.GetPoolSwapFee(suite.Ctx, "ukava:usdx"))
+	suite.Equal(sdk.MustNewDecFromStr("0.01"), suite.Keeper.GetPoolSwapFee(suite.Ctx, "hard:usdx"))
+
+	testCases := []struct {
+		name        string
+		adjustedFee sdk.Dec
+		expectedFee sdk.Dec
+	}{
+		{"adjusted fee", sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")},
+		{"zero adjusted fee", sdk.ZeroDec(), sdk.ZeroDec()},
+		{"negative adjusted fee is ignored", sdk.MustNewDecFromStr("-0.01"), sdk.MustNewDecFromStr("0.01")},
+		{"adjusted fee equal to max is ignored", types.MaxSwapFee, sdk.MustNewDecFromStr("0.01")},
+		{"nil adjusted fee is ignored", sdk.Dec{}, sdk.MustNewDecFromStr("0.01")},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			swapKeeper := suite.Keeper
+			swapKeeper.SetFeeController(fixedFeeController{poolID: "hard:usdx", swapFee: tc.adjustedFee})
+
+			suite.Equal(tc.expectedFee, swapKeeper.GetPoolSwapFee(suite.Ctx, "hard:usdx"))
+			suite.Equal(sdk.MustNewDecFromStr("0.01"), swapKeeper.GetBasePoolSwapFee(suite.Ctx, "hard:usdx"))
+			suite.Equal(sdk.MustNewDecFromStr("0.003"), swapKeeper.GetPoolSwapFee(suite.Ctx, "ukava:usdx"))
+		})
+	}
+}
+
+func (suite *keeperTestSuite) TestSetFeeController_PanicsWhenSetTwice() {
+	swapKeeper := suite.Keeper
+	swapKeeper.SetFeeController(fixedFeeController{})
+
+	suite.PanicsWithValue("cannot set swap fee controller twice", func() {
+		swapKeeper.SetFeeController(fixedFeeController{})
+	})
+}
+
+func (suite *keeperTestSuite) TestGrpcPoolSwapFee() {
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(
+		types.NewAllowedPools(types.NewAllowedPoolWithSwapFee("ukava", "usdx", sdk.MustNewDecFromStr("0.01"))),
+		sdk.MustNewDecFromStr("0.003"),
+		types.DefaultTwapMaxWindowSeconds,
+	))
+	owner := suite.CreateAccount(sdk.Coins{})
+	poolID := suite.setupPool(sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	), sdkmath.NewInt(30e6), owner.GetAddress())
+
+	swapKeeper := suite.Keeper
+	swapKeeper.SetFeeController(fixedFeeController{poolID: poolID, swapFee: sdk.MustNewDecFromStr("0.02")})
+	queryServer := keeper.NewQueryServerImpl(swapKeeper)
+
+	res, err := queryServer.PoolSwapFee(sdk.WrapSDKContext(suite.Ctx), &types.QueryPoolSwapFeeRequest{PoolId: poolID})
+	suite.Require().NoError(err)
+	suite.Equal(&types.QueryPoolSwapFeeResponse{
+		PoolId:      poolID,
+		BaseSwapFee: sdk.MustNewDecFromStr("0.01"),
+		SwapFee:     sdk.MustNewDecFromStr("0.02"),
+	}, res)
+
+	poolsRes, err := queryServer.Pools(sdk.WrapSDKContext(suite.Ctx), &types.QueryPoolsRequest{PoolId: poolID})
+	suite.Require().NoError(err)
+	suite.Require().Len(poolsRes.Pools, 1)
+	suite.Equal(sdk.MustNewDecFromStr("0.02"), poolsRes.Pools[0].SwapFee)
+
+	_, err = queryServer.PoolSwapFee(sdk.WrapSDKContext(suite.Ctx), &types.QueryPoolSwapFeeRequest{PoolId: "hard:usdx"})
+	suite.Equal(codes.NotFound, status.Code(err))
+}
+
 func (suite *keeperTestSuite) TestPool_Persistance() {
 	reserves := sdk.NewCoins(
 		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
@@ -0,0 +1,320 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+const (
+	// MaxLimitOrderChecksPerBlock is the maximum number of limit orders checked for a fill each block
+	MaxLimitOrderChecksPerBlock = 100
+	// MaxLimitOrderExpiriesPerBlock is the maximum number of expired limit orders closed each block
+	MaxLimitOrderExpiriesPerBlock = 100
+)
+
+// PlaceLimitOrder opens an order to sell an exact coin for at least a minimum coin against the pool of the two
+// denoms, which must exist. The sell coin is held by the module account until the order is filled, cancelled or
+// expires. An owner can have at most MaxOpenLimitOrdersPerOwner open orders.
+func (k Keeper) PlaceLimitOrder(ctx sdk.Context, owner sdk.AccAddress, sell, minBuy sdk.Coin) (uint64, error) {
+	poolID, _, err := k.loadPool(ctx, sell.Denom, minBuy.Denom)
+	if err != nil {
+		return 0, err
+	}
+
+	if k.countLimitOrdersByOwner(ctx, owner) >= types.MaxOpenLimitOrdersPerOwner {
+		return 0, errorsmod.Wrapf(types.ErrTooManyLimitOrders, "%s has %d open orders", owner, types.MaxOpenLimitOrdersPerOwner)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleAccountName, sdk.NewCoins(sell)); err != nil {
+		return 0, err
+	}
+
+	id := k.GetNextLimitOrderID(ctx)
+	expiresAt := ctx.BlockTime().Add(types.LimitOrderLifetime)
+	k.SetLimitOrder(ctx, types.NewLimitOrder(id, owner, sell, minBuy, expiresAt))
+	k.SetNextLimitOrderID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePlaceLimitOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeySell, sell.String()),
+			sdk.NewAttribute(types.AttributeKeyMinBuy, minBuy.String()),
+		),
+	)
+
+	return id, nil
+}
+
+// CancelLimitOrder closes an open order placed by the owner and returns its sell coin
+func (k Keeper) CancelLimitOrder(ctx sdk.Context, owner sdk.AccAddress, id uint64) error {
+	order, found := k.GetLimitOrder(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrLimitOrderNotFound, "order %d", id)
+	}
+
+	if !order.Owner.Equals(owner) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "order %d is not owned by %s", id, owner)
+	}
+
+	k.DeleteLimitOrder(ctx, order)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, owner, sdk.NewCoins(order.Sell)); err != nil {
+		panic(err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelLimitOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyPoolID, order.PoolID()),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeySell, order.Sell.String()),
+		),
+	)
+
+	return nil
+}
+
+// MatchLimitOrders fills open orders whose sell coin swaps for at least their minimum buy coin against the current
+// pool prices. For each pool and sell denom, only orders with a limit price below the pool's marginal price can be
+// filled, and they are checked from the lowest limit price up. Each fill swaps against the pool as changed by the
+// fills before it. At most MaxLimitOrderChecksPerBlock orders are checked each call.
+func (k Keeper) MatchLimitOrders(ctx sdk.Context) {
+	remaining := MaxLimitOrderChecksPerBlock
+
+	// collect first, as pools cannot be changed while iterating the store
+	for _, record := range k.GetAllPools(ctx) {
+		for _, sellDenom := range []string{record.ReservesA.Denom, record.ReservesB.Denom} {
+			remaining -= k.matchPoolLimitOrders(ctx, record.PoolID, sellDenom, remaining)
+			if remaining <= 0 {
+				return
+			}
+		}
+	}
+}
+
+// matchPoolLimitOrders checks up to limit orders selling a denom against a pool for fills, and returns the number
+// of orders checked
+func (k Keeper) matchPoolLimitOrders(ctx sdk.Context, poolID, sellDenom string, limit int) int {
+	record, found := k.GetPool(ctx, poolID)
+	if !found {
+		return 0
+	}
+	pool, err := types.NewDenominatedPoolFromRecord(record)
+	if err != nil {
+		panic(fmt.Sprintf("invalid pool %s: %s", poolID, err))
+	}
+
+	// an order cannot be filled at a limit price at or above the marginal price, as a swap's average price is lower
+	orderIDs := k.getLimitOrderIDsBelowPrice(ctx, poolID, sellDenom, pool.Price(sellDenom), limit)
+	for _, id := range orderIDs {
+		order, found := k.GetLimitOrder(ctx, id)
+		if !found {
+			continue
+		}
+		k.fillLimitOrder(ctx, order)
+	}
+
+	return len(orderIDs)
+}
+
+// fillLimitOrder swaps the order's sell coin against its pool and closes the order if the swap returns at least the
+// minimum buy coin. Otherwise the order is left open.
+func (k Keeper) fillLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	poolID, pool, err := k.loadPool(ctx, order.Sell.Denom, order.MinBuy.Denom)
+	if err != nil {
+		return
+	}
+
+	swapOutput, feePaid := pool.SwapWithExactInput(order.Sell, k.GetPoolSwapFee(ctx, poolID))
+	if swapOutput.IsZero() || swapOutput.Amount.LT(order.MinBuy.Amount) {
+		return
+	}
+
+	k.SetPool(ctx, types.NewPoolRecordFromPool(pool))
+	k.DeleteLimitOrder(ctx, order)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, order.Owner, sdk.NewCoins(swapOutput)); err != nil {
+		panic(err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapTrade,
+			sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+			sdk.NewAttribute(types.AttributeKeyRequester, order.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeySwapInput, order.Sell.String()),
+			sdk.NewAttribute(types.AttributeKeySwapOutput, swapOutput.String()),
+			sdk.NewAttribute(types.AttributeKeyFeePaid, feePaid.String()),
+			sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+		),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFillLimitOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", order.ID)),
+			sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+			sdk.NewAttribute(types.AttributeKeyOwner, order.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeySell, order.Sell.String()),
+			sdk.NewAttribute(types.AttributeKeySwapOutput, swapOutput.String()),
+		),
+	)
+}
+
+// ExpireLimitOrders closes open orders that expired at or before the block time and returns their sell coins to
+// their owners, oldest first. At most MaxLimitOrderExpiriesPerBlock orders are closed each call.
+func (k Keeper) ExpireLimitOrders(ctx sdk.Context) {
+	// collect first, as orders cannot be deleted while iterating the store
+	var expired []types.LimitOrder
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderByExpiryPrefix)
+	iterator := store.Iterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())))
+	for ; iterator.Valid() && len(expired) < MaxLimitOrderExpiriesPerBlock; iterator.Next() {
+		order, found := k.GetLimitOrder(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if !found {
+			panic(fmt.Sprintf("limit order %d in expiry index not found", sdk.BigEndianToUint64(iterator.Value())))
+		}
+		expired = append(expired, order)
+	}
+	iterator.Close()
+
+	for _, order := range expired {
+		k.DeleteLimitOrder(ctx, order)
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, order.Owner, sdk.NewCoins(order.Sell)); err != nil {
+			panic(err)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireLimitOrder,
+				sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", order.ID)),
+				sdk.NewAttribute(types.AttributeKeyPoolID, order.PoolID()),
+				sdk.NewAttribute(types.AttributeKeyOwner, order.Owner.String()),
+				sdk.NewAttribute(types.AttributeKeySell, order.Sell.String()),
+			),
+		)
+	}
+}
+
+// GetNextLimitOrderID returns the id of the next limit order placed
+func (k Keeper) GetNextLimitOrderID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.key).Get(types.NextLimitOrderIDKey)
+	if bz == nil {
+		return types.DefaultNextLimitOrderID
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextLimitOrderID sets the id of the next limit order placed
+func (k Keeper) SetNextLimitOrderID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.key).Set(types.NextLimitOrderIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetLimitOrder retrieves a limit order from the store
+func (k Keeper) GetLimitOrder(ctx sdk.Context, id uint64) (types.LimitOrder, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderKeyPrefix)
+
+	bz := store.Get(types.LimitOrderKey(id))
+	if bz == nil {
+		return types.LimitOrder{}, false
+	}
+
+	var order types.LimitOrder
+	k.cdc.MustUnmarshal(bz, &order)
+
+	return order, true
+}
+
+// SetLimitOrder saves a new limit order to the store, indexed by price, expiry and owner, and panics if the order is
+// invalid. Orders are not updated once placed.
+func (k Keeper) SetLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	if err := order.Validate(); err != nil {
+		panic(fmt.Sprintf("invalid limit order: %s", err))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderKeyPrefix)
+	store.Set(types.LimitOrderKey(order.ID), k.cdc.MustMarshal(&order))
+
+	id := types.LimitOrderKey(order.ID)
+	priceStore := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderByPricePrefix)
+	priceStore.Set(types.LimitOrderByPriceKey(order.PoolID(), order.Sell.Denom, order.LimitPrice(), order.ID), id)
+	expiryStore := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderByExpiryPrefix)
+	expiryStore.Set(types.LimitOrderByExpiryKey(order.ExpiresAt, order.ID), id)
+	ownerStore := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderByOwnerPrefix)
+	ownerStore.Set(types.LimitOrderByOwnerKey(order.Owner, order.ID), id)
+}
+
+// DeleteLimitOrder deletes a limit order and its indexes from the store
+func (k Keeper) DeleteLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderKeyPrefix)
+	store.Delete(types.LimitOrderKey(order.ID))
+
+	priceStore := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderByPricePrefix)
+	priceStore.Delete(types.LimitOrderByPriceKey(order.PoolID(), order.Sell.Denom, order.LimitPrice(), order.ID))
+	expiryStore := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderByExpiryPrefix)
+	expiryStore.Delete(types.LimitOrderByExpiryKey(order.ExpiresAt, order.ID))
+	ownerStore := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderByOwnerPrefix)
+	ownerStore.Delete(types.LimitOrderByOwnerKey(order.Owner, order.ID))
+}
+
+// getLimitOrderIDsBelowPrice returns the ids of up to limit orders selling a denom against a pool with a limit price
+// below a price, in order of limit price
+func (k Keeper) getLimitOrderIDsBelowPrice(ctx sdk.Context, poolID, sellDenom string, price sdk.Dec, limit int) []uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderByPricePrefix)
+	start := types.LimitOrdersByPriceKey(poolID, sellDenom)
+	end := append(types.LimitOrdersByPriceKey(poolID, sellDenom), types.LimitOrderPriceBytes(price)...)
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	var ids []uint64
+	for ; iterator.Valid() && len(ids) < limit; iterator.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iterator.Value()))
+	}
+	return ids
+}
+
+// countLimitOrdersByOwner returns the number of open limit orders of an owner
+func (k Keeper) countLimitOrdersByOwner(ctx sdk.Context, owner sdk.AccAddress) int {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderByOwnerPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.LimitOrdersByOwnerKey(owner))
+	defer iterator.Close()
+
+	count := 0
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// IterateLimitOrders iterates over all limit orders in the store, in order of id, and performs a callback function
+func (k Keeper) IterateLimitOrders(ctx sdk.Context, cb func(order types.LimitOrder) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LimitOrderKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.LimitOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &order)
+		if cb(order) {
+			break
+		}
+	}
+}
+
+// GetAllLimitOrders returns all limit orders from the store
+func (k Keeper) GetAllLimitOrders(ctx sdk.Context) (orders types.LimitOrders) {
+	k.IterateLimitOrders(ctx, func(order types.LimitOrder) bool {
+		orders = append(orders, order)
+		return false
+	})
+	return
+}
@@ -0,0 +1,373 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/kava-labs/kava/x/swap/keeper"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+func (suite *keeperTestSuite) setupLimitOrderPool() (string, sdk.Coins) {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		AllowedPools:         types.NewAllowedPools(types.NewAllowedPool("ukava", "usdx")),
+		SwapFee:              sdk.MustNewDecFromStr("0.0025"),
+		TwapMaxWindowSeconds: types.DefaultTwapMaxWindowSeconds,
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	poolID := suite.setupPool(reserves, sdkmath.NewInt(30e6), owner.GetAddress())
+
+	return poolID, reserves
+}
+
+func (suite *keeperTestSuite) TestLimitOrder_Persistance() {
+	owner := sdk.AccAddress("owner---------------")
+	order := types.NewLimitOrder(1, owner, sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(6e6)), suite.Ctx.BlockTime())
+
+	suite.Keeper.SetLimitOrder(suite.Ctx, order)
+	savedOrder, ok := suite.Keeper.GetLimitOrder(suite.Ctx, order.ID)
+	suite.True(ok)
+	suite.Equal(order, savedOrder)
+
+	suite.Keeper.DeleteLimitOrder(suite.Ctx, order)
+	_, ok = suite.Keeper.GetLimitOrder(suite.Ctx, order.ID)
+	suite.False(ok)
+}
+
+func (suite *keeperTestSuite) TestLimitOrder_PanicsWhenInvalid() {
+	invalidOrder := types.NewLimitOrder(0, sdk.AccAddress("owner---------------"), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(6e6)), suite.Ctx.BlockTime())
+
+	suite.PanicsWithValue("invalid limit order: limit order id must be positive", func() {
+		suite.Keeper.SetLimitOrder(suite.Ctx, invalidOrder)
+	}, "expected invalid limit order to panic")
+}
+
+func (suite *keeperTestSuite) TestPlaceLimitOrder() {
+	poolID, reserves := suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)))
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	sell := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	minBuy := sdk.NewCoin("usdx", sdkmath.NewInt(6e6))
+
+	suite.Equal(types.DefaultNextLimitOrderID, suite.Keeper.GetNextLimitOrderID(suite.Ctx))
+
+	id, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, requester.GetAddress(), sell, minBuy)
+	suite.Require().NoError(err)
+	suite.Equal(uint64(1), id)
+	suite.Equal(uint64(2), suite.Keeper.GetNextLimitOrderID(suite.Ctx))
+
+	order, found := suite.Keeper.GetLimitOrder(suite.Ctx, id)
+	suite.Require().True(found)
+	suite.Equal(types.NewLimitOrder(id, requester.GetAddress(), sell, minBuy, suite.Ctx.BlockTime().Add(types.LimitOrderLifetime)), order)
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(sell))
+	suite.ModuleAccountBalanceEqual(reserves.Add(sell))
+	suite.PoolLiquidityEqual(reserves)
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypePlaceLimitOrder,
+		sdk.NewAttribute(types.AttributeKeyOrderID, "1"),
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeyOwner, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySell, sell.String()),
+		sdk.NewAttribute(types.AttributeKeyMinBuy, minBuy.String()),
+	))
+
+	id, err = suite.Keeper.PlaceLimitOrder(suite.Ctx, requester.GetAddress(), sell, minBuy)
+	suite.Require().NoError(err)
+	suite.Equal(uint64(2), id)
+}
+
+func (suite *keeperTestSuite) TestPlaceLimitOrder_PoolNotFound() {
+	suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(10e6)))
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	_, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, requester.GetAddress(), sdk.NewCoin("hard", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(1e6)))
+	suite.EqualError(err, "pool hard:usdx not found: invalid pool")
+	suite.AccountBalanceEqual(requester.GetAddress(), balance)
+}
+
+func (suite *keeperTestSuite) TestPlaceLimitOrder_InsufficientFunds() {
+	suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6)))
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	_, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, requester.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(2e6)), sdk.NewCoin("usdx", sdkmath.NewInt(10e6)))
+	suite.Require().True(errors.Is(err, sdkerrors.ErrInsufficientFunds), "expected insufficient funds error")
+	suite.Equal(types.DefaultNextLimitOrderID, suite.Keeper.GetNextLimitOrderID(suite.Ctx))
+}
+
+func (suite *keeperTestSuite) TestPlaceLimitOrder_TooManyOrders() {
+	suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100e6)))
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	sell := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	minBuy := sdk.NewCoin("usdx", sdkmath.NewInt(6e6))
+
+	for i := 0; i < types.MaxOpenLimitOrdersPerOwner; i++ {
+		_, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, requester.GetAddress(), sell, minBuy)
+		suite.Require().NoError(err)
+	}
+
+	_, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, requester.GetAddress(), sell, minBuy)
+	suite.Require().True(errors.Is(err, types.ErrTooManyLimitOrders), "expected too many limit orders error")
+
+	// an order can be placed once another is closed
+	suite.Require().NoError(suite.Keeper.CancelLimitOrder(suite.Ctx, requester.GetAddress(), 1))
+	_, err = suite.Keeper.PlaceLimitOrder(suite.Ctx, requester.GetAddress(), sell, minBuy)
+	suite.Require().NoError(err)
+}
+
+func (suite *keeperTestSuite) TestCancelLimitOrder() {
+	poolID, reserves := suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)))
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	sell := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+
+	id, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, requester.GetAddress(), sell, sdk.NewCoin("usdx", sdkmath.NewInt(6e6)))
+	suite.Require().NoError(err)
+
+	other := suite.CreateAccount(sdk.Coins{})
+	err = suite.Keeper.CancelLimitOrder(suite.Ctx, other.GetAddress(), id)
+	suite.Require().True(errors.Is(err, sdkerrors.ErrUnauthorized), "expected unauthorized error")
+
+	err = suite.Keeper.CancelLimitOrder(suite.Ctx, requester.GetAddress(), id)
+	suite.Require().NoError(err)
+
+	_, found := suite.Keeper.GetLimitOrder(suite.Ctx, id)
+	suite.False(found)
+	suite.AccountBalanceEqual(requester.GetAddress(), balance)
+	suite.ModuleAccountBalanceEqual(reserves)
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeCancelLimitOrder,
+		sdk.NewAttribute(types.AttributeKeyOrderID, "1"),
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeyOwner, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySell, sell.String()),
+	))
+
+	err = suite.Keeper.CancelLimitOrder(suite.Ctx, requester.GetAddress(), id)
+	suite.EqualError(err, "order 1: limit order not found")
+}
+
+func (suite *keeperTestSuite) TestMatchLimitOrders() {
+	poolID, reserves := suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)))
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	sell := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	// 1e6ukava swaps for 4982529usdx against the pool
+	expectedOutput := sdk.NewCoin("usdx", sdkmath.NewInt(4982529))
+
+	unfilledID, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, requester.GetAddress(), sell, expectedOutput.AddAmount(sdkmath.OneInt()))
+	suite.Require().NoError(err)
+	filledID, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, requester.GetAddress(), sell, expectedOutput)
+	suite.Require().NoError(err)
+
+	suite.Keeper.MatchLimitOrders(suite.Ctx)
+
+	_, found := suite.Keeper.GetLimitOrder(suite.Ctx, unfilledID)
+	suite.True(found, "expected order below the pool price to remain open")
+	_, found = suite.Keeper.GetLimitOrder(suite.Ctx, filledID)
+	suite.False(found, "expected order at the pool price to be filled")
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(sell).Sub(sell).Add(expectedOutput))
+	suite.PoolLiquidityEqual(reserves.Add(sell).Sub(expectedOutput))
+	suite.ModuleAccountBalanceEqual(reserves.Add(sell).Add(sell).Sub(expectedOutput))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, sell.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedOutput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "2500ukava"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeFillLimitOrder,
+		sdk.NewAttribute(types.AttributeKeyOrderID, "2"),
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeyOwner, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySell, sell.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedOutput.String()),
+	))
+
+	_, broken := keeper.PoolReservesInvariant(suite.Keeper)(suite.Ctx)
+	suite.False(broken, "expected escrowed limit orders to be accounted for in pool reserves invariant")
+}
+
+func (suite *keeperTestSuite) TestMatchLimitOrders_PriceMovesIntoRange() {
+	suite.setupLimitOrderPool()
+
+	seller := suite.NewAccountFromAddr(sdk.AccAddress("seller--------------"), sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(10e6))))
+	sell := sdk.NewCoin("usdx", sdkmath.NewInt(5e6))
+	minBuy := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+
+	id, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, seller.GetAddress(), sell, minBuy)
+	suite.Require().NoError(err)
+
+	suite.Keeper.MatchLimitOrders(suite.Ctx)
+	_, found := suite.Keeper.GetLimitOrder(suite.Ctx, id)
+	suite.Require().True(found, "expected order to remain open before price moves")
+
+	// a large ukava sale lowers the ukava price until the order can be filled
+	trader := suite.NewAccountFromAddr(sdk.AccAddress("trader--------------"), sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100e6))))
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, trader.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(100e6)), sdk.NewCoin("usdx", sdkmath.NewInt(450e6)), sdk.MustNewDecFromStr("0.1"))
+	suite.Require().NoError(err)
+
+	suite.Keeper.MatchLimitOrders(suite.Ctx)
+	_, found = suite.Keeper.GetLimitOrder(suite.Ctx, id)
+	suite.False(found, "expected order to be filled after price moves")
+
+	balance := suite.BankKeeper.GetBalance(suite.Ctx, seller.GetAddress(), "ukava")
+	suite.True(balance.IsGTE(minBuy), "expected seller to receive at least the minimum buy coin")
+
+	_, broken := keeper.PoolReservesInvariant(suite.Keeper)(suite.Ctx)
+	suite.False(broken)
+}
+
+func (suite *keeperTestSuite) TestMatchLimitOrders_LowestLimitPriceFirst() {
+	_, reserves := suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)))
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	sell := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+
+	// both orders can be filled alone, but the pool price after the first fill is too low for the second
+	higherID, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, requester.GetAddress(), sell, sdk.NewCoin("usdx", sdkmath.NewInt(4980e3)))
+	suite.Require().NoError(err)
+	lowerID, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, requester.GetAddress(), sell, sdk.NewCoin("usdx", sdkmath.NewInt(4970e3)))
+	suite.Require().NoError(err)
+
+	suite.Keeper.MatchLimitOrders(suite.Ctx)
+
+	_, found := suite.Keeper.GetLimitOrder(suite.Ctx, lowerID)
+	suite.False(found, "expected order with the lowest limit price to be filled first")
+	_, found = suite.Keeper.GetLimitOrder(suite.Ctx, higherID)
+	suite.True(found, "expected order above the pool price after the first fill to remain open")
+
+	suite.PoolLiquidityEqual(reserves.Add(sell).Sub(sdk.NewCoin("usdx", sdkmath.NewInt(4982529))))
+}
+
+func (suite *keeperTestSuite) TestMatchLimitOrders_OnlyChecksOrdersBelowPoolPrice() {
+	suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)))
+	sell := sdk.NewCoin("ukava", sdkmath.NewInt(1))
+
+	// fill the check limit with orders that cannot be filled as their limit price is above the pool price
+	for i := 0; i < keeper.MaxLimitOrderChecksPerBlock; i++ {
+		requester := suite.NewAccountFromAddr(sdk.AccAddress(fmt.Sprintf("requester-%10d", i)), balance)
+		_, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, requester.GetAddress(), sell, sdk.NewCoin("usdx", sdkmath.NewInt(6)))
+		suite.Require().NoError(err)
+	}
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	id, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, requester.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(4e6)))
+	suite.Require().NoError(err)
+
+	suite.Keeper.MatchLimitOrders(suite.Ctx)
+
+	_, found := suite.Keeper.GetLimitOrder(suite.Ctx, id)
+	suite.False(found, "expected order below the pool price to be filled")
+	suite.Len(suite.Keeper.GetAllLimitOrders(suite.Ctx), keeper.MaxLimitOrderChecksPerBlock)
+}
+
+func (suite *keeperTestSuite) TestMatchLimitOrders_ChecksAreCapped() {
+	suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)))
+	numOrders := keeper.MaxLimitOrderChecksPerBlock + 10
+	for i := 0; i < numOrders; i++ {
+		requester := suite.NewAccountFromAddr(sdk.AccAddress(fmt.Sprintf("requester-%10d", i)), balance)
+		_, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, requester.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1000)), sdk.NewCoin("usdx", sdkmath.NewInt(1)))
+		suite.Require().NoError(err)
+	}
+
+	suite.Keeper.MatchLimitOrders(suite.Ctx)
+	suite.Len(suite.Keeper.GetAllLimitOrders(suite.Ctx), numOrders-keeper.MaxLimitOrderChecksPerBlock)
+
+	suite.Keeper.MatchLimitOrders(suite.Ctx)
+	suite.Empty(suite.Keeper.GetAllLimitOrders(suite.Ctx))
+}
+
+func (suite *keeperTestSuite) TestExpireLimitOrders() {
+	poolID, reserves := suite.setupLimitOrderPool()
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)))
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	sell := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	minBuy := sdk.NewCoin("usdx", sdkmath.NewInt(6e6))
+
+	expiredID, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, requester.GetAddress(), sell, minBuy)
+	suite.Require().NoError(err)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+	openID, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, requester.GetAddress(), sell, minBuy)
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.LimitOrderLifetime - time.Hour))
+	suite.Keeper.ExpireLimitOrders(suite.Ctx)
+
+	_, found := suite.Keeper.GetLimitOrder(suite.Ctx, expiredID)
+	suite.False(found, "expected order to expire at its expiry time")
+	_, found = suite.Keeper.GetLimitOrder(suite.Ctx, openID)
+	suite.True(found, "expected order to remain open before its expiry time")
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(sell))
+	suite.ModuleAccountBalanceEqual(reserves.Add(sell))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeExpireLimitOrder,
+		sdk.NewAttribute(types.AttributeKeyOrderID, "1"),
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeyOwner, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySell, sell.String()),
+	))
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+	suite.Keeper.ExpireLimitOrders(suite.Ctx)
+	suite.Empty(suite.Keeper.GetAllLimitOrders(suite.Ctx))
+	suite.AccountBalanceEqual(requester.GetAddress(), balance)
+}
+
+func (suite *keeperTestSuite) TestGrpcLimitOrders() {
+	poolID, _ := suite.setupLimitOrderPool()
+
+	owner1 := suite.NewAccountFromAddr(sdk.AccAddress("owner1--------------"), sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6))))
+	owner2 := suite.NewAccountFromAddr(sdk.AccAddress("owner2--------------"), sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(10e6))))
+
+	_, err := suite.Keeper.PlaceLimitOrder(suite.Ctx, owner1.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(6e6)))
+	suite.Require().NoError(err)
+	_, err = suite.Keeper.PlaceLimitOrder(suite.Ctx, owner2.GetAddress(), sdk.NewCoin("usdx", sdkmath.NewInt(1e6)), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)))
+	suite.Require().NoError(err)
+
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+
+	res, err := queryServer.LimitOrders(sdk.WrapSDKContext(suite.Ctx), &types.QueryLimitOrdersRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(suite.Keeper.GetAllLimitOrders(suite.Ctx), res.LimitOrders)
+
+	res, err = queryServer.LimitOrders(sdk.WrapSDKContext(suite.Ctx), &types.QueryLimitOrdersRequest{Owner: owner2.GetAddress().String(), PoolId: poolID})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.LimitOrders, 1)
+	suite.Equal(uint64(2), res.LimitOrders[0].ID)
+
+	res, err = queryServer.LimitOrders(sdk.WrapSDKContext(suite.Ctx), &types.QueryLimitOrdersRequest{PoolId: "hard:usdx"})
+	suite.Require().NoError(err)
+	suite.Empty(res.LimitOrders)
+}
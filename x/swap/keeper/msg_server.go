@@ -49,6 +49,34 @@ func (m msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 	return &types.MsgDepositResponse{}, nil
 }
 
+// DepositSingleSided handles MsgDepositSingleSided messages
+func (m msgServer) DepositSingleSided(goCtx context.Context, msg *types.MsgDepositSingleSided) (*types.MsgDepositSingleSidedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := checkDeadline(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.DepositSingleSided(ctx, depositor, msg.Token, msg.PairedDenom, msg.Slippage); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, depositor.String()),
+		),
+	)
+
+	return &types.MsgDepositSingleSidedResponse{}, nil
+}
+
 // Withdraw handles MsgWithdraw messages
 func (m msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*types.MsgWithdrawResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -133,6 +161,34 @@ func (m msgServer) SwapForExactTokens(goCtx context.Context, msg *types.MsgSwapF
 	return &types.MsgSwapForExactTokensResponse{}, nil
 }
 
+// SwapExactForTokensRoute handles MsgSwapExactForTokensRoute messages
+func (m msgServer) SwapExactForTokensRoute(goCtx context.Context, msg *types.MsgSwapExactForTokensRoute) (*types.MsgSwapExactForTokensRouteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := checkDeadline(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	requester, err := sdk.AccAddressFromBech32(msg.Requester)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.SwapExactForTokensRoute(ctx, requester, msg.ExactTokenA, msg.IntermediateDenoms, msg.TokenB, msg.Slippage); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, requester.String()),
+		),
+	)
+
+	return &types.MsgSwapExactForTokensRouteResponse{}, nil
+}
+
 // checkDeadline returns an error if block time exceeds an included deadline
 func checkDeadline(ctx sdk.Context, msg sdk.Msg) error {
 	deadlineMsg, ok := msg.(types.MsgWithDeadline)
@@ -151,3 +207,129 @@ func checkDeadline(ctx sdk.Context, msg sdk.Msg) error {
 
 	return nil
 }
+
+// PlaceLimitOrder handles MsgPlaceLimitOrder messages
+func (m msgServer) PlaceLimitOrder(goCtx context.Context, msg *types.MsgPlaceLimitOrder) (*types.MsgPlaceLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	orderID, err := m.keeper.PlaceLimitOrder(ctx, owner, msg.Sell, msg.MinBuy)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		),
+	)
+
+	return &types.MsgPlaceLimitOrderResponse{OrderId: orderID}, nil
+}
+
+// CancelLimitOrder handles MsgCancelLimitOrder messages
+func (m msgServer) CancelLimitOrder(goCtx context.Context, msg *types.MsgCancelLimitOrder) (*types.MsgCancelLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.CancelLimitOrder(ctx, owner, msg.OrderId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		),
+	)
+
+	return &types.MsgCancelLimitOrderResponse{}, nil
+}
+
+// TransferShares handles MsgTransferShares messages
+func (m msgServer) TransferShares(goCtx context.Context, msg *types.MsgTransferShares) (*types.MsgTransferSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.TransferShares(ctx, sender, recipient, msg.PoolID, msg.Shares); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+	)
+
+	return &types.MsgTransferSharesResponse{}, nil
+}
+
+// WrapShares handles MsgWrapShares messages
+func (m msgServer) WrapShares(goCtx context.Context, msg *types.MsgWrapShares) (*types.MsgWrapSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := m.keeper.WrapShares(ctx, owner, msg.PoolID, msg.Shares); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		),
+	)
+
+	return &types.MsgWrapSharesResponse{}, nil
+}
+
+// UnwrapShares handles MsgUnwrapShares messages
+func (m msgServer) UnwrapShares(goCtx context.Context, msg *types.MsgUnwrapShares) (*types.MsgUnwrapSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.UnwrapShares(ctx, owner, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		),
+	)
+
+	return &types.MsgUnwrapSharesResponse{}, nil
+}
@@ -33,7 +33,7 @@ func (suite *msgServerTestSuite) SetupTest() {
 func (suite *msgServerTestSuite) TestDeposit_CreatePool() {
 	pool := types.NewAllowedPool("ukava", "usdx")
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee, types.DefaultTwapMaxWindowSeconds))
 
 	balance := sdk.NewCoins(
 		sdk.NewCoin(pool.TokenA, sdkmath.NewInt(10e6)),
@@ -83,7 +83,7 @@ func (suite *msgServerTestSuite) TestDeposit_CreatePool() {
 func (suite *msgServerTestSuite) TestDeposit_DeadlineExceeded() {
 	pool := types.NewAllowedPool("ukava", "usdx")
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee, types.DefaultTwapMaxWindowSeconds))
 
 	balance := sdk.NewCoins(
 		sdk.NewCoin(pool.TokenA, sdkmath.NewInt(10e6)),
@@ -198,6 +198,83 @@ func (suite *msgServerTestSuite) TestDeposit_ExistingPool_SlippageFailure() {
 	suite.Nil(res)
 }
 
+func (suite *msgServerTestSuite) TestDepositSingleSided() {
+	pool := types.NewAllowedPool("ukava", "usdx")
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	)
+	err := suite.CreatePool(reserves)
+	suite.Require().NoError(err)
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+	)
+	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), balance)
+
+	deposit := types.NewMsgDepositSingleSided(
+		depositor.GetAddress().String(),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		"usdx",
+		sdk.MustNewDecFromStr("0.05"),
+		time.Now().Add(10*time.Minute).Unix(),
+	)
+
+	res, err := suite.msgServer.DepositSingleSided(sdk.WrapSDKContext(suite.Ctx), deposit)
+	suite.Require().Equal(&types.MsgDepositSingleSidedResponse{}, res)
+	suite.Require().NoError(err)
+
+	expectedTransfer := sdk.NewCoin("ukava", sdkmath.NewInt(999997))
+	expectedDeposit := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(511176)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(2323531)),
+	)
+
+	suite.AccountBalanceEqual(depositor.GetAddress(), balance.Sub(expectedTransfer))
+	suite.ModuleAccountBalanceEqual(reserves.Add(expectedTransfer))
+	suite.PoolLiquidityEqual(reserves.Add(expectedTransfer))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, depositor.GetAddress().String()),
+	))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		bank.EventTypeTransfer,
+		sdk.NewAttribute(bank.AttributeKeyRecipient, swapModuleAccountAddress.String()),
+		sdk.NewAttribute(bank.AttributeKeySender, depositor.GetAddress().String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, expectedTransfer.String()),
+	))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeSwapDeposit,
+		sdk.NewAttribute(types.AttributeKeyPoolID, types.PoolID(pool.TokenA, pool.TokenB)),
+		sdk.NewAttribute(types.AttributeKeyDepositor, depositor.GetAddress().String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, expectedDeposit.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, "1089754"),
+	))
+}
+
+func (suite *msgServerTestSuite) TestDepositSingleSided_DeadlineExceeded() {
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+	)
+	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), balance)
+
+	deposit := types.NewMsgDepositSingleSided(
+		depositor.GetAddress().String(),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		"usdx",
+		sdk.MustNewDecFromStr("0.05"),
+		suite.Ctx.BlockTime().Add(-1*time.Second).Unix(),
+	)
+
+	res, err := suite.msgServer.DepositSingleSided(sdk.WrapSDKContext(suite.Ctx), deposit)
+	suite.EqualError(err, fmt.Sprintf("block time %d >= deadline %d: deadline exceeded", suite.Ctx.BlockTime().Unix(), deposit.GetDeadline().Unix()))
+	suite.Nil(res)
+}
+
 func (suite *msgServerTestSuite) TestWithdraw_AllShares() {
 	reserves := sdk.NewCoins(
 		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
@@ -206,7 +283,7 @@ func (suite *msgServerTestSuite) TestWithdraw_AllShares() {
 	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), reserves)
 	pool := types.NewAllowedPool(reserves[0].Denom, reserves[1].Denom)
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee, types.DefaultTwapMaxWindowSeconds))
 
 	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), reserves[0], reserves[1], sdk.MustNewDecFromStr("1"))
 	suite.Require().NoError(err)
@@ -259,7 +336,7 @@ func (suite *msgServerTestSuite) TestWithdraw_PartialShares() {
 	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), reserves)
 	pool := types.NewAllowedPool(reserves[0].Denom, reserves[1].Denom)
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee, types.DefaultTwapMaxWindowSeconds))
 
 	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), reserves[0], reserves[1], sdk.MustNewDecFromStr("1"))
 	suite.Require().NoError(err)
@@ -317,7 +394,7 @@ func (suite *msgServerTestSuite) TestWithdraw_SlippageFailure() {
 	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), reserves)
 	pool := types.NewAllowedPool(reserves[0].Denom, reserves[1].Denom)
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee, types.DefaultTwapMaxWindowSeconds))
 
 	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), reserves[0], reserves[1], sdk.MustNewDecFromStr("1"))
 	suite.Require().NoError(err)
@@ -589,3 +666,259 @@ func (suite *msgServerTestSuite) TestSwapForExactTokens_DeadlineExceeded() {
 func TestMsgServerTestSuite(t *testing.T) {
 	suite.Run(t, new(msgServerTestSuite))
 }
+
+func (suite *msgServerTestSuite) TestSwapExactForTokensRoute() {
+	suite.Require().NoError(suite.CreatePool(sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)))
+	suite.Require().NoError(suite.CreatePool(sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)))
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	swapInput := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	swapMsg := types.NewMsgSwapExactForTokensRoute(
+		requester.GetAddress().String(),
+		swapInput,
+		[]string{"usdx"},
+		sdk.NewCoin("hard", sdkmath.NewInt(2e6)),
+		sdk.MustNewDecFromStr("0.01"),
+		time.Now().Add(10*time.Minute).Unix(),
+	)
+
+	suite.Ctx = suite.App.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	res, err := suite.msgServer.SwapExactForTokensRoute(sdk.WrapSDKContext(suite.Ctx), swapMsg)
+	suite.Require().Equal(&types.MsgSwapExactForTokensRouteResponse{}, res)
+	suite.Require().NoError(err)
+
+	expectedSwapOutput := sdk.NewCoin("hard", sdkmath.NewInt(1984066))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(swapInput).Add(expectedSwapOutput))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, requester.GetAddress().String()),
+	))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		bank.EventTypeTransfer,
+		sdk.NewAttribute(bank.AttributeKeyRecipient, swapModuleAccountAddress.String()),
+		sdk.NewAttribute(bank.AttributeKeySender, requester.GetAddress().String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, swapInput.String()),
+	))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		bank.EventTypeTransfer,
+		sdk.NewAttribute(bank.AttributeKeyRecipient, requester.GetAddress().String()),
+		sdk.NewAttribute(bank.AttributeKeySender, swapModuleAccountAddress.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, expectedSwapOutput.String()),
+	))
+}
+
+func (suite *msgServerTestSuite) TestSwapExactForTokensRoute_DeadlineExceeded() {
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	swapMsg := types.NewMsgSwapExactForTokensRoute(
+		requester.GetAddress().String(),
+		sdk.NewCoin("ukava", sdkmath.NewInt(5e6)),
+		[]string{"usdx"},
+		sdk.NewCoin("hard", sdkmath.NewInt(1e7)),
+		sdk.MustNewDecFromStr("0.01"),
+		suite.Ctx.BlockTime().Add(-1*time.Second).Unix(),
+	)
+
+	res, err := suite.msgServer.SwapExactForTokensRoute(sdk.WrapSDKContext(suite.Ctx), swapMsg)
+	suite.Require().Nil(res)
+	suite.EqualError(err, fmt.Sprintf("block time %d >= deadline %d: deadline exceeded", suite.Ctx.BlockTime().Unix(), swapMsg.GetDeadline().Unix()))
+	suite.Nil(res)
+}
+
+func (suite *msgServerTestSuite) TestPlaceLimitOrder() {
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	)
+	err := suite.CreatePool(reserves)
+	suite.Require().NoError(err)
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), balance)
+	sell := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+
+	placeMsg := types.NewMsgPlaceLimitOrder(
+		owner.GetAddress().String(),
+		sell,
+		sdk.NewCoin("usdx", sdkmath.NewInt(6e6)),
+	)
+
+	res, err := suite.msgServer.PlaceLimitOrder(sdk.WrapSDKContext(suite.Ctx), placeMsg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.MsgPlaceLimitOrderResponse{OrderId: 1}, res)
+
+	suite.AccountBalanceEqual(owner.GetAddress(), balance.Sub(sell))
+	suite.ModuleAccountBalanceEqual(reserves.Add(sell))
+	suite.PoolLiquidityEqual(reserves)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, owner.GetAddress().String()),
+	))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		bank.EventTypeTransfer,
+		sdk.NewAttribute(bank.AttributeKeyRecipient, swapModuleAccountAddress.String()),
+		sdk.NewAttribute(bank.AttributeKeySender, owner.GetAddress().String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, sell.String()),
+	))
+}
+
+func (suite *msgServerTestSuite) TestCancelLimitOrder() {
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	)
+	err := suite.CreatePool(reserves)
+	suite.Require().NoError(err)
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	owner := suite.NewAccountFromAddr(sdk.AccAddress("owner---------------"), balance)
+
+	placeRes, err := suite.msgServer.PlaceLimitOrder(sdk.WrapSDKContext(suite.Ctx), types.NewMsgPlaceLimitOrder(
+		owner.GetAddress().String(),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(6e6)),
+	))
+	suite.Require().NoError(err)
+
+	cancelMsg := types.NewMsgCancelLimitOrder(owner.GetAddress().String(), placeRes.OrderId)
+	res, err := suite.msgServer.CancelLimitOrder(sdk.WrapSDKContext(suite.Ctx), cancelMsg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.MsgCancelLimitOrderResponse{}, res)
+
+	suite.AccountBalanceEqual(owner.GetAddress(), balance)
+	suite.ModuleAccountBalanceEqual(reserves)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, owner.GetAddress().String()),
+	))
+}
+
+func (suite *msgServerTestSuite) TestCancelLimitOrder_NotFound() {
+	owner := suite.CreateAccount(sdk.Coins{})
+
+	res, err := suite.msgServer.CancelLimitOrder(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCancelLimitOrder(owner.GetAddress().String(), 1))
+	suite.Require().Nil(res)
+	suite.EqualError(err, "order 1: limit order not found")
+}
+
+func (suite *msgServerTestSuite) TestTransferShares() {
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	)
+	err := suite.CreatePool(reserves)
+	suite.Require().NoError(err)
+
+	poolID := types.PoolID("ukava", "usdx")
+	sender := suite.CreateAccount(sdk.Coins{})
+	recipient := suite.NewAccountFromAddr(sdk.AccAddress("recipient-----------"), sdk.Coins{})
+	shares := sdkmath.NewInt(5e6)
+
+	msg := types.NewMsgTransferShares(sender.GetAddress().String(), recipient.GetAddress().String(), poolID, shares)
+
+	res, err := suite.msgServer.TransferShares(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.MsgTransferSharesResponse{}, res)
+
+	suite.PoolDepositorSharesEqual(sender.GetAddress(), poolID, sdkmath.NewInt(22360679).Sub(shares))
+	suite.PoolDepositorSharesEqual(recipient.GetAddress(), poolID, shares)
+	suite.PoolShareTotalEqual(poolID, sdkmath.NewInt(22360679))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.GetAddress().String()),
+	))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeTransferShares,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeySender, sender.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+	))
+}
+
+func (suite *msgServerTestSuite) TestWrapAndUnwrapShares() {
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	)
+	err := suite.CreatePool(reserves)
+	suite.Require().NoError(err)
+
+	poolID := types.PoolID("ukava", "usdx")
+	owner := suite.CreateAccount(sdk.Coins{})
+	shareCoin := sdk.NewCoin(types.ShareDenom(poolID), sdkmath.NewInt(5e6))
+
+	wrapMsg := types.NewMsgWrapShares(owner.GetAddress().String(), poolID, shareCoin.Amount)
+
+	wrapRes, err := suite.msgServer.WrapShares(sdk.WrapSDKContext(suite.Ctx), wrapMsg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.MsgWrapSharesResponse{}, wrapRes)
+
+	suite.AccountBalanceEqual(owner.GetAddress(), sdk.NewCoins(shareCoin))
+	suite.ModuleAccountBalanceEqual(reserves)
+	suite.PoolDepositorSharesEqual(owner.GetAddress(), poolID, sdkmath.NewInt(22360679).Sub(shareCoin.Amount))
+	suite.PoolDepositorSharesEqual(swapModuleAccountAddress, poolID, shareCoin.Amount)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, owner.GetAddress().String()),
+	))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeWrapShares,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyShares, shareCoin.Amount.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, shareCoin.String()),
+	))
+
+	unwrapMsg := types.NewMsgUnwrapShares(owner.GetAddress().String(), shareCoin)
+
+	unwrapRes, err := suite.msgServer.UnwrapShares(sdk.WrapSDKContext(suite.Ctx), unwrapMsg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.MsgUnwrapSharesResponse{}, unwrapRes)
+
+	suite.AccountBalanceEqual(owner.GetAddress(), sdk.Coins{})
+	suite.ModuleAccountBalanceEqual(reserves)
+	suite.PoolDepositorSharesEqual(owner.GetAddress(), poolID, sdkmath.NewInt(22360679))
+	suite.PoolSharesDeleted(swapModuleAccountAddress, "ukava", "usdx")
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeUnwrapShares,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyShares, shareCoin.Amount.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, shareCoin.String()),
+	))
+}
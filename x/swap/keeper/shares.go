@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// TransferShares moves pool shares from the sender's deposit to the recipient's deposit, creating the recipient's
+// deposit if it does not exist. The sender's deposit is deleted if all of its shares are transferred. Shares can not
+// be transferred to the module account, which only holds wrapped shares.
+func (k Keeper) TransferShares(ctx sdk.Context, sender, recipient sdk.AccAddress, poolID string, shares sdkmath.Int) error {
+	if recipient.Equals(k.GetSwapModuleAccount(ctx).GetAddress()) {
+		return errorsmod.Wrap(types.ErrNotAllowed, "cannot transfer shares to the swap module account")
+	}
+
+	if err := k.removeDepositorShares(ctx, sender, poolID, shares); err != nil {
+		return err
+	}
+	k.addDepositorShares(ctx, recipient, poolID, shares)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferShares,
+			sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+		),
+	)
+
+	return nil
+}
+
+// WrapShares converts pool shares of the owner's deposit into an equal amount of share coins. The shares are held by
+// the module account until the coins are unwrapped, and do not earn rewards while wrapped.
+//
+// Share coins can be sent like any other coin, and converted to an ERC20 token once their denom is allowed by evmutil.
+func (k Keeper) WrapShares(ctx sdk.Context, owner sdk.AccAddress, poolID string, shares sdkmath.Int) (sdk.Coin, error) {
+	if err := k.removeDepositorShares(ctx, owner, poolID, shares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	moduleAddress := k.GetSwapModuleAccount(ctx).GetAddress()
+	wrappedShares := shares
+	if shareRecord, found := k.GetDepositorShares(ctx, moduleAddress, poolID); found {
+		wrappedShares = wrappedShares.Add(shareRecord.SharesOwned)
+	}
+	k.updateDepositorShares(ctx, moduleAddress, poolID, wrappedShares)
+
+	shareCoin := sdk.NewCoin(types.ShareDenom(poolID), shares)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleAccountName, sdk.NewCoins(shareCoin)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, owner, sdk.NewCoins(shareCoin)); err != nil {
+		panic(err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWrapShares,
+			sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, shareCoin.String()),
+		),
+	)
+
+	return shareCoin, nil
+}
+
+// UnwrapShares burns the owner's share coins and returns an equal amount of pool shares to the owner's deposit
+func (k Keeper) UnwrapShares(ctx sdk.Context, owner sdk.AccAddress, shareCoin sdk.Coin) error {
+	poolID, err := types.ParseShareDenom(shareCoin.Denom)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidCoin, err.Error())
+	}
+
+	moduleAddress := k.GetSwapModuleAccount(ctx).GetAddress()
+	shareRecord, found := k.GetDepositorShares(ctx, moduleAddress, poolID)
+	if !found || shareRecord.SharesOwned.LT(shareCoin.Amount) {
+		return errorsmod.Wrapf(types.ErrInvalidShares, "unwrap of %s greater than wrapped shares of pool %s", shareCoin, poolID)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleAccountName, sdk.NewCoins(shareCoin)); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleAccountName, sdk.NewCoins(shareCoin)); err != nil {
+		panic(err)
+	}
+
+	k.updateDepositorShares(ctx, moduleAddress, poolID, shareRecord.SharesOwned.Sub(shareCoin.Amount))
+	k.addDepositorShares(ctx, owner, poolID, shareCoin.Amount)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnwrapShares,
+			sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shareCoin.Amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, shareCoin.String()),
+		),
+	)
+
+	return nil
+}
+
+// removeDepositorShares subtracts shares from a deposit, calling the deposit modified hook before the change
+func (k Keeper) removeDepositorShares(ctx sdk.Context, depositor sdk.AccAddress, poolID string, shares sdkmath.Int) error {
+	shareRecord, found := k.GetDepositorShares(ctx, depositor, poolID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrDepositNotFound, "no deposit for account %s and pool %s", depositor, poolID)
+	}
+
+	if shares.GT(shareRecord.SharesOwned) {
+		return errorsmod.Wrapf(types.ErrInvalidShares, "%s shares greater than %s shares owned", shares, shareRecord.SharesOwned)
+	}
+
+	if _, found := k.GetPool(ctx, poolID); !found {
+		panic(fmt.Sprintf("pool %s not found", poolID))
+	}
+
+	k.BeforePoolDepositModified(ctx, poolID, depositor, shareRecord.SharesOwned)
+	k.updateDepositorShares(ctx, depositor, poolID, shareRecord.SharesOwned.Sub(shares))
+
+	return nil
+}
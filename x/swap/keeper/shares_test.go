@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/kava-labs/kava/x/swap/keeper"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+func (suite *keeperTestSuite) setupSharesPool() (string, sdk.AccAddress) {
+	owner := suite.CreateAccount(sdk.Coins{})
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(50e6)),
+	)
+	poolID := suite.setupPool(reserves, sdkmath.NewInt(30e6), owner.GetAddress())
+
+	return poolID, owner.GetAddress()
+}
+
+func (suite *keeperTestSuite) TestTransferShares() {
+	poolID, sender := suite.setupSharesPool()
+	recipient := sdk.AccAddress("recipient-----------")
+
+	err := suite.Keeper.TransferShares(suite.Ctx, sender, recipient, poolID, sdkmath.NewInt(10e6))
+	suite.Require().NoError(err)
+
+	suite.PoolDepositorSharesEqual(sender, poolID, sdkmath.NewInt(20e6))
+	suite.PoolDepositorSharesEqual(recipient, poolID, sdkmath.NewInt(10e6))
+	suite.PoolShareTotalEqual(poolID, sdkmath.NewInt(30e6))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeTransferShares,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, "10000000"),
+	))
+
+	// transferring the remaining shares adds to the recipient's deposit and deletes the sender's deposit
+	err = suite.Keeper.TransferShares(suite.Ctx, sender, recipient, poolID, sdkmath.NewInt(20e6))
+	suite.Require().NoError(err)
+
+	suite.PoolSharesDeleted(sender, "ukava", "usdx")
+	suite.PoolDepositorSharesEqual(recipient, poolID, sdkmath.NewInt(30e6))
+
+	_, broken := keeper.PoolSharesInvariant(suite.Keeper)(suite.Ctx)
+	suite.False(broken)
+}
+
+func (suite *keeperTestSuite) TestTransferShares_Errors() {
+	poolID, sender := suite.setupSharesPool()
+	recipient := sdk.AccAddress("recipient-----------")
+
+	err := suite.Keeper.TransferShares(suite.Ctx, recipient, sender, poolID, sdkmath.NewInt(1))
+	suite.Require().True(errors.Is(err, types.ErrDepositNotFound), "expected deposit not found error")
+
+	err = suite.Keeper.TransferShares(suite.Ctx, sender, recipient, poolID, sdkmath.NewInt(30e6+1))
+	suite.EqualError(err, "30000001 shares greater than 30000000 shares owned: invalid shares")
+
+	err = suite.Keeper.TransferShares(suite.Ctx, sender, suite.Keeper.GetSwapModuleAccount(suite.Ctx).GetAddress(), poolID, sdkmath.NewInt(1))
+	suite.EqualError(err, "cannot transfer shares to the swap module account: not allowed")
+
+	suite.PoolDepositorSharesEqual(sender, poolID, sdkmath.NewInt(30e6))
+}
+
+func (suite *keeperTestSuite) TestWrapShares() {
+	poolID, owner := suite.setupSharesPool()
+	moduleAddress := suite.Keeper.GetSwapModuleAccount(suite.Ctx).GetAddress()
+
+	shareCoin, err := suite.Keeper.WrapShares(suite.Ctx, owner, poolID, sdkmath.NewInt(10e6))
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewCoin("swp-ukava:usdx", sdkmath.NewInt(10e6)), shareCoin)
+
+	suite.PoolDepositorSharesEqual(owner, poolID, sdkmath.NewInt(20e6))
+	suite.PoolDepositorSharesEqual(moduleAddress, poolID, sdkmath.NewInt(10e6))
+	suite.AccountBalanceEqual(owner, sdk.NewCoins(shareCoin))
+	suite.Equal(shareCoin, suite.BankKeeper.GetSupply(suite.Ctx, shareCoin.Denom))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeWrapShares,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, "10000000"),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, shareCoin.String()),
+	))
+
+	// share coins can be sent and unwrapped by another account
+	recipient := suite.NewAccountFromAddr(sdk.AccAddress("recipient-----------"), sdk.Coins{}).GetAddress()
+	err = suite.BankKeeper.SendCoins(suite.Ctx, owner, recipient, sdk.NewCoins(sdk.NewCoin(shareCoin.Denom, sdkmath.NewInt(4e6))))
+	suite.Require().NoError(err)
+
+	err = suite.Keeper.UnwrapShares(suite.Ctx, recipient, sdk.NewCoin(shareCoin.Denom, sdkmath.NewInt(4e6)))
+	suite.Require().NoError(err)
+
+	suite.PoolDepositorSharesEqual(recipient, poolID, sdkmath.NewInt(4e6))
+	suite.PoolDepositorSharesEqual(moduleAddress, poolID, sdkmath.NewInt(6e6))
+	suite.AccountBalanceEqual(recipient, sdk.Coins{})
+	suite.Equal(sdk.NewCoin(shareCoin.Denom, sdkmath.NewInt(6e6)), suite.BankKeeper.GetSupply(suite.Ctx, shareCoin.Denom))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeUnwrapShares,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeyOwner, recipient.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, "4000000"),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, "4000000swp-ukava:usdx"),
+	))
+
+	err = suite.Keeper.UnwrapShares(suite.Ctx, owner, sdk.NewCoin(shareCoin.Denom, sdkmath.NewInt(6e6)))
+	suite.Require().NoError(err)
+
+	suite.PoolDepositorSharesEqual(owner, poolID, sdkmath.NewInt(26e6))
+	suite.PoolSharesDeleted(moduleAddress, "ukava", "usdx")
+	suite.True(suite.BankKeeper.GetSupply(suite.Ctx, shareCoin.Denom).IsZero())
+
+	_, broken := keeper.AllInvariants(suite.Keeper)(suite.Ctx)
+	suite.False(broken)
+}
+
+func (suite *keeperTestSuite) TestWrapShares_Errors() {
+	poolID, owner := suite.setupSharesPool()
+
+	_, err := suite.Keeper.WrapShares(suite.Ctx, sdk.AccAddress("no deposit----------"), poolID, sdkmath.NewInt(1))
+	suite.Require().True(errors.Is(err, types.ErrDepositNotFound), "expected deposit not found error")
+
+	_, err = suite.Keeper.WrapShares(suite.Ctx, owner, poolID, sdkmath.NewInt(30e6+1))
+	suite.Require().True(errors.Is(err, types.ErrInvalidShares), "expected invalid shares error")
+}
+
+func (suite *keeperTestSuite) TestUnwrapShares_Errors() {
+	poolID, owner := suite.setupSharesPool()
+
+	_, err := suite.Keeper.WrapShares(suite.Ctx, owner, poolID, sdkmath.NewInt(1e6))
+	suite.Require().NoError(err)
+
+	err = suite.Keeper.UnwrapShares(suite.Ctx, owner, sdk.NewCoin("ukava", sdkmath.NewInt(1)))
+	suite.EqualError(err, "invalid denom prefix, expected swp-, got ukava: invalid coin")
+
+	err = suite.Keeper.UnwrapShares(suite.Ctx, owner, sdk.NewCoin("swp-hard:usdx", sdkmath.NewInt(1)))
+	suite.EqualError(err, "unwrap of 1swp-hard:usdx greater than wrapped shares of pool hard:usdx: invalid shares")
+
+	err = suite.Keeper.UnwrapShares(suite.Ctx, owner, sdk.NewCoin("swp-ukava:usdx", sdkmath.NewInt(1e6+1)))
+	suite.EqualError(err, "unwrap of 1000001swp-ukava:usdx greater than wrapped shares of pool ukava:usdx: invalid shares")
+
+	// shares can only be unwrapped from share coins the owner holds
+	other := suite.NewAccountFromAddr(sdk.AccAddress("other---------------"), sdk.Coins{}).GetAddress()
+	err = suite.Keeper.UnwrapShares(suite.Ctx, other, sdk.NewCoin("swp-ukava:usdx", sdkmath.NewInt(1e6)))
+	suite.Require().True(errors.Is(err, sdkerrors.ErrInsufficientFunds), "expected insufficient funds error")
+}
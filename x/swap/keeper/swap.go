@@ -16,7 +16,7 @@ func (k *Keeper) SwapExactForTokens(ctx sdk.Context, requester sdk.AccAddress, e
 		return err
 	}
 
-	swapOutput, feePaid := pool.SwapWithExactInput(exactCoinA, k.GetSwapFee(ctx))
+	swapOutput, feePaid := pool.SwapWithExactInput(exactCoinA, k.GetPoolSwapFee(ctx, poolID))
 	if swapOutput.IsZero() {
 		return errorsmod.Wrapf(types.ErrInsufficientLiquidity, "swap output rounds to zero, increase input amount")
 	}
@@ -47,7 +47,7 @@ func (k *Keeper) SwapForExactTokens(ctx sdk.Context, requester sdk.AccAddress, c
 		)
 	}
 
-	swapInput, feePaid := pool.SwapWithExactOutput(exactCoinB, k.GetSwapFee(ctx))
+	swapInput, feePaid := pool.SwapWithExactOutput(exactCoinB, k.GetPoolSwapFee(ctx, poolID))
 
 	priceChange := sdk.NewDecFromInt(coinA.Amount).Quo(sdk.NewDecFromInt(swapInput.Sub(feePaid).Amount))
 	if err := k.assertSlippageWithinLimit(priceChange, slippageLimit); err != nil {
@@ -61,6 +61,118 @@ func (k *Keeper) SwapForExactTokens(ctx sdk.Context, requester sdk.AccAddress, c
 	return nil
 }
 
+// SwapExactForTokensRoute swaps an exact coin a input for a coin b output through a route of intermediate denoms,
+// where each hop swaps the full output of the previous hop. Slippage is checked once against the final output.
+func (k *Keeper) SwapExactForTokensRoute(ctx sdk.Context, requester sdk.AccAddress, exactCoinA sdk.Coin, intermediateDenoms []string, coinB sdk.Coin, slippageLimit sdk.Dec) error {
+	hops, err := k.simulateRoute(ctx, exactCoinA, intermediateDenoms, coinB.Denom)
+	if err != nil {
+		return err
+	}
+
+	swapOutput := hops[len(hops)-1].swapOutput
+	priceChange := sdk.NewDecFromInt(swapOutput.Amount).Quo(sdk.NewDecFromInt(coinB.Amount))
+	if err := k.assertSlippageWithinLimit(priceChange, slippageLimit); err != nil {
+		return err
+	}
+
+	for _, hop := range hops {
+		k.SetPool(ctx, types.NewPoolRecordFromPool(hop.pool))
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, requester, types.ModuleAccountName, sdk.NewCoins(exactCoinA)); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, requester, sdk.NewCoins(swapOutput)); err != nil {
+		panic(err)
+	}
+
+	for _, hop := range hops {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSwapTrade,
+				sdk.NewAttribute(types.AttributeKeyPoolID, hop.poolID),
+				sdk.NewAttribute(types.AttributeKeyRequester, requester.String()),
+				sdk.NewAttribute(types.AttributeKeySwapInput, hop.swapInput.String()),
+				sdk.NewAttribute(types.AttributeKeySwapOutput, hop.swapOutput.String()),
+				sdk.NewAttribute(types.AttributeKeyFeePaid, hop.feePaid.String()),
+				sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+			),
+		)
+	}
+
+	return nil
+}
+
+// QuoteSwapExactForTokens returns the output and the fee paid to each pool of a swap of an exact coin a input for a
+// coin b output through a route of intermediate denoms, without committing the swap.  The price impact is the
+// fraction of the output lost to the change in pool prices caused by the swap, excluding fees.
+func (k Keeper) QuoteSwapExactForTokens(ctx sdk.Context, exactCoinA sdk.Coin, intermediateDenoms []string, denomB string) (sdk.Coin, []sdk.Coin, sdk.Dec, error) {
+	hops, err := k.simulateRoute(ctx, exactCoinA, intermediateDenoms, denomB)
+	if err != nil {
+		return sdk.Coin{}, nil, sdk.Dec{}, err
+	}
+
+	feesPaid := make([]sdk.Coin, 0, len(hops))
+	spotOutput := sdk.NewDecFromInt(exactCoinA.Amount)
+	for _, hop := range hops {
+		feesPaid = append(feesPaid, hop.feePaid)
+		spotOutput = spotOutput.Mul(sdk.OneDec().Sub(hop.swapFee)).Mul(hop.spotPrice)
+	}
+
+	swapOutput := hops[len(hops)-1].swapOutput
+	priceImpact := sdk.OneDec().Sub(sdk.NewDecFromInt(swapOutput.Amount).Quo(spotOutput))
+
+	return swapOutput, feesPaid, priceImpact, nil
+}
+
+// routeHop is a swap through a single pool of a route, which is committed once every hop of the route succeeds
+type routeHop struct {
+	poolID     string
+	pool       *types.DenominatedPool
+	swapInput  sdk.Coin
+	swapOutput sdk.Coin
+	feePaid    sdk.Coin
+	swapFee    sdk.Dec
+	// spotPrice is the price of the input in the output denom before the swap
+	spotPrice sdk.Dec
+}
+
+// simulateRoute swaps an exact input through the pools of a route without storing the updated pools
+func (k Keeper) simulateRoute(ctx sdk.Context, exactCoinA sdk.Coin, intermediateDenoms []string, denomB string) ([]routeHop, error) {
+	denoms := append(append([]string{exactCoinA.Denom}, intermediateDenoms...), denomB)
+
+	hops := make([]routeHop, 0, len(denoms)-1)
+	swapInput := exactCoinA
+	for _, denom := range denoms[1:] {
+		poolID, pool, err := k.loadPool(ctx, swapInput.Denom, denom)
+		if err != nil {
+			return nil, err
+		}
+
+		spotPrice := pool.Price(swapInput.Denom)
+
+		swapFee := k.GetPoolSwapFee(ctx, poolID)
+		swapOutput, feePaid := pool.SwapWithExactInput(swapInput, swapFee)
+		if swapOutput.IsZero() {
+			return nil, errorsmod.Wrapf(types.ErrInsufficientLiquidity, "swap output of pool %s rounds to zero, increase input amount", poolID)
+		}
+
+		hops = append(hops, routeHop{
+			poolID:     poolID,
+			pool:       pool,
+			swapInput:  swapInput,
+			swapOutput: swapOutput,
+			feePaid:    feePaid,
+			swapFee:    swapFee,
+			spotPrice:  spotPrice,
+		})
+		swapInput = swapOutput
+	}
+
+	return hops, nil
+}
+
 func (k Keeper) loadPool(ctx sdk.Context, denomA string, denomB string) (string, *types.DenominatedPool, error) {
 	poolID := types.PoolID(denomA, denomB)
 
@@ -69,7 +181,7 @@ func (k Keeper) loadPool(ctx sdk.Context, denomA string, denomB string) (string,
 		return poolID, nil, errorsmod.Wrapf(types.ErrInvalidPool, "pool %s not found", poolID)
 	}
 
-	pool, err := types.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+	pool, err := types.NewDenominatedPoolFromRecord(poolRecord)
 	if err != nil {
 		panic(fmt.Sprintf("invalid pool %s: %s", poolID, err))
 	}
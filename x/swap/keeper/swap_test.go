@@ -9,12 +9,16 @@ import (
 	tmtime "github.com/cometbft/cometbft/types/time"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/kava-labs/kava/x/swap/keeper"
 	"github.com/kava-labs/kava/x/swap/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (suite *keeperTestSuite) TestSwapExactForTokens() {
 	suite.Keeper.SetParams(suite.Ctx, types.Params{
-		SwapFee: sdk.MustNewDecFromStr("0.0025"),
+		SwapFee:              sdk.MustNewDecFromStr("0.0025"),
+		TwapMaxWindowSeconds: types.DefaultTwapMaxWindowSeconds,
 	})
 	owner := suite.CreateAccount(sdk.Coins{})
 	reserves := sdk.NewCoins(
@@ -51,6 +55,41 @@ func (suite *keeperTestSuite) TestSwapExactForTokens() {
 	))
 }
 
+func (suite *keeperTestSuite) TestSwapExactForTokens_PoolSwapFee() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		AllowedPools:         types.NewAllowedPools(types.NewAllowedPoolWithSwapFee("ukava", "usdx", sdk.MustNewDecFromStr("0.01"))),
+		SwapFee:              sdk.MustNewDecFromStr("0.0025"),
+		TwapMaxWindowSeconds: types.DefaultTwapMaxWindowSeconds,
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	totalShares := sdkmath.NewInt(30e6)
+	poolID := suite.setupPool(reserves, totalShares, owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	coinB := sdk.NewCoin("usdx", sdkmath.NewInt(5e6))
+
+	err := suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), coinA, coinB, sdk.MustNewDecFromStr("0.02"))
+	suite.Require().NoError(err)
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, coinA.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, "4945104usdx"),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "10000ukava"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+}
+
 func (suite *keeperTestSuite) TestSwapExactForTokens_OutputGreaterThanZero() {
 	owner := suite.CreateAccount(sdk.Coins{})
 	reserves := sdk.NewCoins(
@@ -131,7 +170,8 @@ func (suite *keeperTestSuite) TestSwapExactForTokens_Slippage() {
 		suite.Run(fmt.Sprintf("coinA=%s coinB=%s slippage=%s fee=%s", tc.coinA, tc.coinB, tc.slippage, tc.fee), func() {
 			suite.SetupTest()
 			suite.Keeper.SetParams(suite.Ctx, types.Params{
-				SwapFee: tc.fee,
+				SwapFee:              tc.fee,
+				TwapMaxWindowSeconds: types.DefaultTwapMaxWindowSeconds,
 			})
 			owner := suite.CreateAccount(sdk.Coins{})
 			reserves := sdk.NewCoins(
@@ -321,7 +361,8 @@ func (suite *keeperTestSuite) TestSwapExactForTokens_PanicOnInsufficientModuleAc
 
 func (suite *keeperTestSuite) TestSwapForExactTokens() {
 	suite.Keeper.SetParams(suite.Ctx, types.Params{
-		SwapFee: sdk.MustNewDecFromStr("0.0025"),
+		SwapFee:              sdk.MustNewDecFromStr("0.0025"),
+		TwapMaxWindowSeconds: types.DefaultTwapMaxWindowSeconds,
 	})
 	owner := suite.CreateAccount(sdk.Coins{})
 	reserves := sdk.NewCoins(
@@ -442,7 +483,8 @@ func (suite *keeperTestSuite) TestSwapForExactTokens_Slippage() {
 		suite.Run(fmt.Sprintf("coinA=%s coinB=%s slippage=%s fee=%s", tc.coinA, tc.coinB, tc.slippage, tc.fee), func() {
 			suite.SetupTest()
 			suite.Keeper.SetParams(suite.Ctx, types.Params{
-				SwapFee: tc.fee,
+				SwapFee:              tc.fee,
+				TwapMaxWindowSeconds: types.DefaultTwapMaxWindowSeconds,
 			})
 			owner := suite.CreateAccount(sdk.Coins{})
 			reserves := sdk.NewCoins(
@@ -629,3 +671,193 @@ func (suite *keeperTestSuite) TestSwapForExactTokens_PanicOnInsufficientModuleAc
 		_ = suite.Keeper.SwapForExactTokens(suite.Ctx, requester.GetAddress(), coinA, coinB, sdk.MustNewDecFromStr("0.01"))
 	}, "expected panic when module account does not have enough funds")
 }
+
+func (suite *keeperTestSuite) TestSwapExactForTokensRoute() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		SwapFee:              sdk.MustNewDecFromStr("0.0025"),
+		TwapMaxWindowSeconds: types.DefaultTwapMaxWindowSeconds,
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+	kavaReserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	kavaPoolID := suite.setupPool(kavaReserves, sdkmath.NewInt(30e6), owner.GetAddress())
+	hardReserves := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	hardPoolID := suite.setupPool(hardReserves, sdkmath.NewInt(30e6), owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	coinB := sdk.NewCoin("hard", sdkmath.NewInt(2e6))
+
+	err := suite.Keeper.SwapExactForTokensRoute(suite.Ctx, requester.GetAddress(), coinA, []string{"usdx"}, coinB, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	intermediateOutput := sdk.NewCoin("usdx", sdkmath.NewInt(4982529))
+	expectedOutput := sdk.NewCoin("hard", sdkmath.NewInt(1986054))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(coinA).Add(expectedOutput))
+	suite.ModuleAccountBalanceEqual(kavaReserves.Add(hardReserves...).Add(coinA).Sub(expectedOutput))
+	suite.PoolReservesEqual(kavaPoolID, kavaReserves.Add(coinA).Sub(intermediateOutput))
+	suite.PoolReservesEqual(hardPoolID, hardReserves.Add(intermediateOutput).Sub(expectedOutput))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, kavaPoolID),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, coinA.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, intermediateOutput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "2500ukava"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, hardPoolID),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, intermediateOutput.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedOutput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "12457usdx"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokensRoute_Slippage() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		SwapFee:              sdk.MustNewDecFromStr("0.0025"),
+		TwapMaxWindowSeconds: types.DefaultTwapMaxWindowSeconds,
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+	kavaReserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	kavaPoolID := suite.setupPool(kavaReserves, sdkmath.NewInt(30e6), owner.GetAddress())
+	hardReserves := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	hardPoolID := suite.setupPool(hardReserves, sdkmath.NewInt(30e6), owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+
+	// slippage is checked against the output of the whole route
+	coinB := sdk.NewCoin("hard", sdkmath.NewInt(2010e3))
+	err := suite.Keeper.SwapExactForTokensRoute(suite.Ctx, requester.GetAddress(), coinA, []string{"usdx"}, coinB, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().True(errors.Is(err, types.ErrSlippageExceeded), fmt.Sprintf("got err %s", err))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance)
+	suite.PoolReservesEqual(kavaPoolID, kavaReserves)
+	suite.PoolReservesEqual(hardPoolID, hardReserves)
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokensRoute_PoolNotFound() {
+	owner := suite.CreateAccount(sdk.Coins{})
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	poolID := suite.setupPool(reserves, sdkmath.NewInt(3000e6), owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	coinB := sdk.NewCoin("hard", sdkmath.NewInt(2e6))
+
+	err := suite.Keeper.SwapExactForTokensRoute(suite.Ctx, requester.GetAddress(), coinA, []string{"usdx"}, coinB, sdk.MustNewDecFromStr("0.01"))
+	suite.EqualError(err, "pool hard:usdx not found: invalid pool")
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance)
+	suite.PoolReservesEqual(poolID, reserves)
+}
+
+func (suite *keeperTestSuite) TestQuoteSwapExactForTokens() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		SwapFee:              sdk.MustNewDecFromStr("0.0025"),
+		TwapMaxWindowSeconds: types.DefaultTwapMaxWindowSeconds,
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+	kavaReserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	kavaPoolID := suite.setupPool(kavaReserves, sdkmath.NewInt(30e6), owner.GetAddress())
+	hardReserves := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	hardPoolID := suite.setupPool(hardReserves, sdkmath.NewInt(30e6), owner.GetAddress())
+
+	coinA := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+
+	output, feesPaid, priceImpact, err := suite.Keeper.QuoteSwapExactForTokens(suite.Ctx, coinA, nil, "usdx")
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewCoin("usdx", sdkmath.NewInt(4982529)), output)
+	suite.Equal([]sdk.Coin{sdk.NewCoin("ukava", sdkmath.NewInt(2500))}, feesPaid)
+	suite.Equal(sdk.MustNewDecFromStr("0.000996691729323308"), priceImpact)
+
+	// the quote of a route matches the output and fees of the swap
+	output, feesPaid, priceImpact, err = suite.Keeper.QuoteSwapExactForTokens(suite.Ctx, coinA, []string{"usdx"}, "hard")
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewCoin("hard", sdkmath.NewInt(1986054)), output)
+	suite.Equal([]sdk.Coin{sdk.NewCoin("ukava", sdkmath.NewInt(2500)), sdk.NewCoin("usdx", sdkmath.NewInt(12457))}, feesPaid)
+	suite.Equal(sdk.MustNewDecFromStr("0.001989183485028360"), priceImpact)
+
+	// quotes do not modify the pools
+	suite.PoolReservesEqual(kavaPoolID, kavaReserves)
+	suite.PoolReservesEqual(hardPoolID, hardReserves)
+
+	_, _, _, err = suite.Keeper.QuoteSwapExactForTokens(suite.Ctx, coinA, []string{"usdx"}, "busd")
+	suite.EqualError(err, "pool busd:usdx not found: invalid pool")
+
+	_, _, _, err = suite.Keeper.QuoteSwapExactForTokens(suite.Ctx, sdk.NewCoin("ukava", sdkmath.NewInt(1)), nil, "usdx")
+	suite.EqualError(err, "swap output of pool ukava:usdx rounds to zero, increase input amount: insufficient liquidity")
+}
+
+func (suite *keeperTestSuite) TestGrpcSwapQuote() {
+	owner := suite.CreateAccount(sdk.Coins{})
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	suite.setupPool(reserves, sdkmath.NewInt(30e6), owner.GetAddress())
+
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+
+	res, err := queryServer.SwapQuote(sdk.WrapSDKContext(suite.Ctx), &types.QuerySwapQuoteRequest{
+		ExactInput:  sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		OutputDenom: "usdx",
+	})
+	suite.Require().NoError(err)
+	output, feesPaid, priceImpact, err := suite.Keeper.QuoteSwapExactForTokens(suite.Ctx, sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), nil, "usdx")
+	suite.Require().NoError(err)
+	suite.Equal(&types.QuerySwapQuoteResponse{Output: output, FeesPaid: feesPaid, PriceImpact: priceImpact}, res)
+
+	_, err = queryServer.SwapQuote(sdk.WrapSDKContext(suite.Ctx), &types.QuerySwapQuoteRequest{
+		ExactInput:  sdk.NewCoin("ukava", sdkmath.ZeroInt()),
+		OutputDenom: "usdx",
+	})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = queryServer.SwapQuote(sdk.WrapSDKContext(suite.Ctx), &types.QuerySwapQuoteRequest{
+		ExactInput: sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+	})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = queryServer.SwapQuote(sdk.WrapSDKContext(suite.Ctx), &types.QuerySwapQuoteRequest{
+		ExactInput:  sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		OutputDenom: "hard",
+	})
+	suite.Equal(codes.NotFound, status.Code(err))
+}
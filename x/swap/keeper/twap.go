@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// UpdatePoolPriceObservations accumulates the prices of every pool since its latest observation and stores a new
+// observation at the block time. It is called before any swaps of the block, so the prices accumulated are those held
+// since the previous block. Observations older than the twap max window are pruned.
+func (k Keeper) UpdatePoolPriceObservations(ctx sdk.Context) {
+	cutoff := ctx.BlockTime().Add(-k.GetParams(ctx).TwapMaxWindow())
+
+	k.IteratePools(ctx, func(record types.PoolRecord) bool {
+		observation, found := k.GetLatestPoolPriceObservation(ctx, record.PoolID)
+		switch {
+		case !found:
+			observation = types.NewPoolPriceObservation(record.PoolID, sdk.ZeroDec(), sdk.ZeroDec(), ctx.BlockTime())
+		case observation.Time.Before(ctx.BlockTime()):
+			pool, err := types.NewDenominatedPoolFromRecord(record)
+			if err != nil {
+				panic(fmt.Sprintf("invalid pool %s: %s", record.PoolID, err))
+			}
+			observation = observation.Accumulate(
+				pool.Price(record.ReservesA.Denom), pool.Price(record.ReservesB.Denom), ctx.BlockTime(),
+			)
+		default:
+			return false
+		}
+		k.SetPoolPriceObservation(ctx, observation)
+		k.prunePoolPriceObservations(ctx, record.PoolID, cutoff)
+		return false
+	})
+}
+
+// SetPoolPriceObservation saves a pool price observation to the store
+func (k Keeper) SetPoolPriceObservation(ctx sdk.Context, observation types.PoolPriceObservation) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolPriceObservationPrefix)
+	store.Set(types.PoolPriceObservationKey(observation.PoolID, observation.Time), k.cdc.MustMarshal(&observation))
+}
+
+// GetLatestPoolPriceObservation returns the most recent price observation of a pool
+func (k Keeper) GetLatestPoolPriceObservation(ctx sdk.Context, poolID string) (types.PoolPriceObservation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolPriceObservationPrefix)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.PoolPriceObservationsKey(poolID))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.PoolPriceObservation{}, false
+	}
+	var observation types.PoolPriceObservation
+	k.cdc.MustUnmarshal(iterator.Value(), &observation)
+	return observation, true
+}
+
+// IteratePoolPriceObservations iterates over the price observations of a pool at or after a time, in time order,
+// and performs a callback function
+func (k Keeper) IteratePoolPriceObservations(ctx sdk.Context, poolID string, from time.Time, cb func(observation types.PoolPriceObservation) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolPriceObservationPrefix)
+	iterator := store.Iterator(
+		types.PoolPriceObservationKey(poolID, from),
+		sdk.PrefixEndBytes(types.PoolPriceObservationsKey(poolID)),
+	)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var observation types.PoolPriceObservation
+		k.cdc.MustUnmarshal(iterator.Value(), &observation)
+		if cb(observation) {
+			break
+		}
+	}
+}
+
+// GetPoolTwapObservations returns the earliest observation of a pool within a window before its latest observation,
+// and the latest observation, between which time weighted average prices are calculated
+func (k Keeper) GetPoolTwapObservations(ctx sdk.Context, poolID string, window time.Duration) (types.PoolPriceObservation, types.PoolPriceObservation, error) {
+	end, found := k.GetLatestPoolPriceObservation(ctx, poolID)
+	if !found {
+		return types.PoolPriceObservation{}, types.PoolPriceObservation{}, errorsmod.Wrapf(types.ErrInsufficientPriceData, "no price observations for pool %s", poolID)
+	}
+
+	var start types.PoolPriceObservation
+	k.IteratePoolPriceObservations(ctx, poolID, end.Time.Add(-window), func(observation types.PoolPriceObservation) bool {
+		start = observation
+		return true
+	})
+	if !start.Time.Before(end.Time) {
+		return types.PoolPriceObservation{}, types.PoolPriceObservation{}, errorsmod.Wrapf(types.ErrInsufficientPriceData, "no price observations for pool %s within %s before %s", poolID, window, end.Time)
+	}
+
+	return start, end, nil
+}
+
+// GetPoolTwap returns the time weighted average prices of token a in token b and token b in token a of a pool,
+// over at most a window before its latest observation
+func (k Keeper) GetPoolTwap(ctx sdk.Context, poolID string, window time.Duration) (sdk.Dec, sdk.Dec, error) {
+	start, end, err := k.GetPoolTwapObservations(ctx, poolID, window)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	twapA, twapB := end.TimeWeightedAveragePrices(start)
+	return twapA, twapB, nil
+}
+
+// DeletePoolPriceObservations deletes all price observations of a pool
+func (k Keeper) DeletePoolPriceObservations(ctx sdk.Context, poolID string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolPriceObservationPrefix)
+	k.deleteKeys(store, types.PoolPriceObservationsKey(poolID), sdk.PrefixEndBytes(types.PoolPriceObservationsKey(poolID)))
+}
+
+// prunePoolPriceObservations deletes the price observations of a pool before the cutoff time
+func (k Keeper) prunePoolPriceObservations(ctx sdk.Context, poolID string, cutoff time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolPriceObservationPrefix)
+	k.deleteKeys(store, types.PoolPriceObservationsKey(poolID), types.PoolPriceObservationKey(poolID, cutoff))
+}
+
+// deleteKeys deletes all keys of a store in the range [start, end)
+func (k Keeper) deleteKeys(store prefix.Store, start, end []byte) {
+	iterator := store.Iterator(start, end)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kava-labs/kava/x/swap/keeper"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// setupTwapPool creates a ukava:usdx pool with a price of 5 usdx per ukava and records observations 10 and 20
+// seconds after the block time, with the price changed to 6 usdx per ukava for the last 10 seconds
+func (suite *keeperTestSuite) setupTwapPool(twapMaxWindowSeconds uint64) (string, time.Time) {
+	params := types.DefaultParams()
+	params.TwapMaxWindowSeconds = twapMaxWindowSeconds
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	owner := suite.CreateAccount(sdk.Coins{})
+	poolID := suite.setupPool(sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	), sdkmath.NewInt(30e6), owner.GetAddress())
+
+	startTime := suite.Ctx.BlockTime()
+	suite.Keeper.UpdatePoolPriceObservations(suite.Ctx)
+	suite.Keeper.UpdatePoolPriceObservations(suite.Ctx.WithBlockTime(startTime.Add(10 * time.Second)))
+
+	suite.Keeper.SetPool(suite.Ctx, types.NewPoolRecord(sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(6000e6)),
+	), sdkmath.NewInt(30e6)))
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(20 * time.Second))
+	suite.Keeper.UpdatePoolPriceObservations(suite.Ctx)
+
+	return poolID, startTime
+}
+
+func (suite *keeperTestSuite) TestUpdatePoolPriceObservations() {
+	poolID, startTime := suite.setupTwapPool(3600)
+
+	observation, found := suite.Keeper.GetLatestPoolPriceObservation(suite.Ctx, poolID)
+	suite.Require().True(found)
+	suite.Equal(types.NewPoolPriceObservation(
+		poolID,
+		sdk.MustNewDecFromStr("110"),
+		sdk.MustNewDecFromStr("3.666666666666666670"),
+		startTime.Add(20*time.Second),
+	), observation)
+
+	// observations are only recorded once per block time
+	suite.Keeper.UpdatePoolPriceObservations(suite.Ctx)
+	var count int
+	suite.Keeper.IteratePoolPriceObservations(suite.Ctx, poolID, time.Time{}, func(types.PoolPriceObservation) bool {
+		count++
+		return false
+	})
+	suite.Equal(3, count)
+}
+
+func (suite *keeperTestSuite) TestUpdatePoolPriceObservations_Pruning() {
+	poolID, startTime := suite.setupTwapPool(10)
+
+	var observations []types.PoolPriceObservation
+	suite.Keeper.IteratePoolPriceObservations(suite.Ctx, poolID, time.Time{}, func(observation types.PoolPriceObservation) bool {
+		observations = append(observations, observation)
+		return false
+	})
+	suite.Require().Len(observations, 2)
+	suite.Equal(startTime.Add(10*time.Second), observations[0].Time)
+	suite.Equal(startTime.Add(20*time.Second), observations[1].Time)
+}
+
+func (suite *keeperTestSuite) TestGetPoolTwap() {
+	poolID, _ := suite.setupTwapPool(3600)
+
+	twapA, twapB, err := suite.Keeper.GetPoolTwap(suite.Ctx, poolID, 20*time.Second)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("5.5"), twapA)
+	suite.Equal(sdk.MustNewDecFromStr("0.183333333333333334"), twapB)
+
+	// the window starts at the earliest observation within it
+	twapA, _, err = suite.Keeper.GetPoolTwap(suite.Ctx, poolID, 15*time.Second)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("6"), twapA)
+
+	_, _, err = suite.Keeper.GetPoolTwap(suite.Ctx, poolID, 5*time.Second)
+	suite.True(errors.Is(err, types.ErrInsufficientPriceData))
+
+	_, _, err = suite.Keeper.GetPoolTwap(suite.Ctx, types.PoolID("hard", "usdx"), 20*time.Second)
+	suite.True(errors.Is(err, types.ErrInsufficientPriceData))
+}
+
+func (suite *keeperTestSuite) TestDeletePool_DeletesPriceObservations() {
+	poolID, _ := suite.setupTwapPool(3600)
+
+	suite.Keeper.DeletePool(suite.Ctx, poolID)
+
+	_, found := suite.Keeper.GetLatestPoolPriceObservation(suite.Ctx, poolID)
+	suite.False(found)
+}
+
+func (suite *keeperTestSuite) TestGrpcPoolTwap() {
+	poolID, startTime := suite.setupTwapPool(3600)
+	queryServer := keeper.NewQueryServerImpl(suite.Keeper)
+
+	res, err := queryServer.PoolTwap(sdk.WrapSDKContext(suite.Ctx), &types.QueryPoolTwapRequest{PoolId: poolID, Window: time.Hour})
+	suite.Require().NoError(err)
+	suite.Equal(&types.QueryPoolTwapResponse{
+		PoolId:    poolID,
+		TwapA:     sdk.MustNewDecFromStr("5.5"),
+		TwapB:     sdk.MustNewDecFromStr("0.183333333333333334"),
+		StartTime: startTime,
+		EndTime:   startTime.Add(20 * time.Second),
+	}, res)
+
+	_, err = queryServer.PoolTwap(sdk.WrapSDKContext(suite.Ctx), &types.QueryPoolTwapRequest{PoolId: poolID, Window: 2 * time.Hour})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = queryServer.PoolTwap(sdk.WrapSDKContext(suite.Ctx), &types.QueryPoolTwapRequest{PoolId: poolID, Window: time.Second})
+	suite.Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = queryServer.PoolTwap(sdk.WrapSDKContext(suite.Ctx), &types.QueryPoolTwapRequest{PoolId: types.PoolID("hard", "usdx"), Window: time.Hour})
+	suite.Equal(codes.NotFound, status.Code(err))
+}
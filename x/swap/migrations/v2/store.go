@@ -0,0 +1,40 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// MigrateStore performs in-place store migrations for consensus version 2
+// V2 moves the swap fee to each allowed pool, seeding it from the module swap fee, and adds the twap max window
+// param, seeded with its default.
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	migrateParamsStore(ctx, paramstore)
+	return nil
+}
+
+// migrateParamsStore sets the swap fee of every allowed pool without one to the module swap fee, and sets the twap max
+// window if it is not set
+func migrateParamsStore(ctx sdk.Context, paramstore paramtypes.Subspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	var params types.Params
+	paramstore.GetParamSetIfExists(ctx, &params)
+
+	for i, allowedPool := range params.AllowedPools {
+		if allowedPool.SwapFee == nil {
+			swapFee := params.SwapFee
+			params.AllowedPools[i].SwapFee = &swapFee
+		}
+	}
+
+	paramstore.Set(ctx, types.KeyAllowedPools, params.AllowedPools)
+
+	if !paramstore.Has(ctx, types.KeyTwapMaxWindowSeconds) {
+		paramstore.Set(ctx, types.KeyTwapMaxWindowSeconds, types.DefaultTwapMaxWindowSeconds)
+	}
+}
@@ -0,0 +1,70 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v2swap "github.com/kava-labs/kava/x/swap/migrations/v2"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+func TestStoreMigrationSeedsPoolSwapFees(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	swapKey := sdk.NewKVStoreKey(types.ModuleName)
+	tswapKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(swapKey, tswapKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, swapKey, tswapKey, types.ModuleName)
+	paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+
+	// The v1 params do not include the twap max window
+	swapFee := sdk.MustNewDecFromStr("0.0015")
+	paramstore.Set(ctx, types.KeyAllowedPools, types.NewAllowedPools(
+		types.NewAllowedPool("ukava", "usdx"),
+		types.NewAllowedPoolWithSwapFee("hard", "usdx", sdk.MustNewDecFromStr("0.01")),
+	))
+	paramstore.Set(ctx, types.KeySwapFee, swapFee)
+
+	// Run migrations.
+	err := v2swap.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Pools without a swap fee are seeded with the module swap fee, and configured fees are unchanged.
+	var params types.Params
+	paramstore.GetParamSet(ctx, &params)
+	require.Equal(t, types.NewAllowedPools(
+		types.NewAllowedPoolWithSwapFee("ukava", "usdx", swapFee),
+		types.NewAllowedPoolWithSwapFee("hard", "usdx", sdk.MustNewDecFromStr("0.01")),
+	), params.AllowedPools)
+	require.Equal(t, swapFee, params.SwapFee)
+	require.Equal(t, types.DefaultTwapMaxWindowSeconds, params.TwapMaxWindowSeconds)
+	require.NoError(t, params.Validate())
+}
+
+func TestStoreMigrationKeepsTwapMaxWindow(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	swapKey := sdk.NewKVStoreKey(types.ModuleName)
+	tswapKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(swapKey, tswapKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, swapKey, tswapKey, types.ModuleName)
+	paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+
+	params := types.NewParams(
+		types.NewAllowedPools(types.NewAllowedPoolWithSwapFee("ukava", "usdx", sdk.MustNewDecFromStr("0.0015"))),
+		sdk.MustNewDecFromStr("0.0015"),
+		600,
+	)
+	paramstore.SetParamSet(ctx, &params)
+
+	err := v2swap.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	var migrated types.Params
+	paramstore.GetParamSet(ctx, &migrated)
+	require.Equal(t, params, migrated)
+}
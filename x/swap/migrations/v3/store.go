@@ -0,0 +1,29 @@
+package v3
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// MigrateStore performs in-place store migrations for consensus version 3
+// V3 grants the swap module account the minter and burner permissions used to wrap pool shares into coins.
+func MigrateStore(ctx sdk.Context, accountKeeper types.AccountKeeper) error {
+	return migrateModuleAccountPermissions(ctx, accountKeeper)
+}
+
+// migrateModuleAccountPermissions sets the permissions of the existing swap module account
+func migrateModuleAccountPermissions(ctx sdk.Context, accountKeeper types.AccountKeeper) error {
+	moduleAccount, ok := accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName).(*authtypes.ModuleAccount)
+	if !ok {
+		return fmt.Errorf("%s module account has unexpected type", types.ModuleAccountName)
+	}
+
+	moduleAccount.Permissions = []string{authtypes.Minter, authtypes.Burner}
+	accountKeeper.SetModuleAccount(ctx, moduleAccount)
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package v3_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kava-labs/kava/app"
+	v3swap "github.com/kava-labs/kava/x/swap/migrations/v3"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+func TestStoreMigrationGrantsModuleAccountPermissions(t *testing.T) {
+	tApp := app.NewTestApp()
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Now()})
+	accountKeeper := tApp.GetAccountKeeper()
+
+	// Replace the module account with one created before the swap module account could mint and burn.
+	moduleAccount := accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName).(*authtypes.ModuleAccount)
+	moduleAccount.Permissions = nil
+	accountKeeper.SetModuleAccount(ctx, moduleAccount)
+
+	// Run migrations.
+	err := v3swap.MigrateStore(ctx, accountKeeper)
+	require.NoError(t, err)
+
+	migrated := accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
+	require.True(t, migrated.HasPermission(authtypes.Minter))
+	require.True(t, migrated.HasPermission(authtypes.Burner))
+	require.Equal(t, moduleAccount.GetAddress(), migrated.GetAddress())
+	require.Equal(t, moduleAccount.GetAccountNumber(), migrated.GetAccountNumber())
+}
@@ -335,6 +335,16 @@ func (p *BasePool) ShareValue(shares sdkmath.Int) (sdkmath.Int, sdkmath.Int) {
 	return sdkmath.NewIntFromBigInt(&resultA), sdkmath.NewIntFromBigInt(&resultB)
 }
 
+// PriceA returns the price of a in b, the ratio of the b reserves to the a reserves
+func (p *BasePool) PriceA() sdk.Dec {
+	return sdk.NewDecFromInt(p.reservesB).Quo(sdk.NewDecFromInt(p.reservesA))
+}
+
+// PriceB returns the price of b in a, the ratio of the a reserves to the b reserves
+func (p *BasePool) PriceB() sdk.Dec {
+	return sdk.NewDecFromInt(p.reservesA).Quo(sdk.NewDecFromInt(p.reservesB))
+}
+
 // assertInvariantAndUpdateRerserves asserts the constant product invariant is not violated, subtracting
 // any fees first, then updates the pool reserves.  Panics if invariant is violated.
 func (p *BasePool) assertInvariantAndUpdateReserves(newReservesA, feeA, newReservesB, feeB sdkmath.Int) {
@@ -13,9 +13,16 @@ import (
 // governance module.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDeposit{}, "swap/MsgDeposit", nil)
+	cdc.RegisterConcrete(&MsgDepositSingleSided{}, "swap/MsgDepositSingleSided", nil)
 	cdc.RegisterConcrete(&MsgWithdraw{}, "swap/MsgWithdraw", nil)
 	cdc.RegisterConcrete(&MsgSwapExactForTokens{}, "swap/MsgSwapExactForTokens", nil)
 	cdc.RegisterConcrete(&MsgSwapForExactTokens{}, "swap/MsgSwapForExactTokens", nil)
+	cdc.RegisterConcrete(&MsgSwapExactForTokensRoute{}, "swap/MsgSwapExactForTokensRoute", nil)
+	cdc.RegisterConcrete(&MsgPlaceLimitOrder{}, "swap/MsgPlaceLimitOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelLimitOrder{}, "swap/MsgCancelLimitOrder", nil)
+	cdc.RegisterConcrete(&MsgTransferShares{}, "swap/MsgTransferShares", nil)
+	cdc.RegisterConcrete(&MsgWrapShares{}, "swap/MsgWrapShares", nil)
+	cdc.RegisterConcrete(&MsgUnwrapShares{}, "swap/MsgUnwrapShares", nil)
 }
 
 // RegisterInterfaces registers proto messages under their interfaces for unmarshalling,
@@ -23,9 +30,16 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgDeposit{},
+		&MsgDepositSingleSided{},
 		&MsgWithdraw{},
 		&MsgSwapExactForTokens{},
 		&MsgSwapForExactTokens{},
+		&MsgSwapExactForTokensRoute{},
+		&MsgPlaceLimitOrder{},
+		&MsgCancelLimitOrder{},
+		&MsgTransferShares{},
+		&MsgWrapShares{},
+		&MsgUnwrapShares{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -8,13 +8,32 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// DenominatedPool implements a denominated constant-product liquidity pool
+// unitlessPool is implemented by the unitless constant product and stable swap pools
+type unitlessPool interface {
+	ReservesA() sdkmath.Int
+	ReservesB() sdkmath.Int
+	TotalShares() sdkmath.Int
+	IsEmpty() bool
+	AddLiquidity(desiredA sdkmath.Int, desiredB sdkmath.Int) (sdkmath.Int, sdkmath.Int, sdkmath.Int)
+	RemoveLiquidity(shares sdkmath.Int) (sdkmath.Int, sdkmath.Int)
+	ShareValue(shares sdkmath.Int) (sdkmath.Int, sdkmath.Int)
+	SwapExactAForB(a sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int)
+	SwapExactBForA(b sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int)
+	SwapAForExactB(b sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int)
+	SwapBForExactA(a sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int)
+	PriceA() sdk.Dec
+	PriceB() sdk.Dec
+}
+
+// DenominatedPool implements a denominated constant product or stable swap liquidity pool
 type DenominatedPool struct {
-	// all pool operations are implemented in a unitless base pool
-	pool *BasePool
-	// track units of the reserveA and reserveB in base pool
+	// all pool operations are implemented in a unitless pool
+	pool unitlessPool
+	// track units of the reserveA and reserveB in the unitless pool
 	denomA string
 	denomB string
+	// track the amplification of stable swap pools for storage
+	amplification uint64
 }
 
 // NewDenominatedPool creates a new denominated pool from reserve coins
@@ -63,6 +82,77 @@ func NewDenominatedPoolWithExistingShares(reserves sdk.Coins, totalShares sdkmat
 	}, nil
 }
 
+// NewDenominatedStableSwapPool creates a new denominated stable swap pool from reserve coins
+func NewDenominatedStableSwapPool(reserves sdk.Coins, amplification uint64) (*DenominatedPool, error) {
+	if len(reserves) != 2 {
+		return nil, errorsmod.Wrap(ErrInvalidPool, "reserves must have two denominations")
+	}
+
+	reservesA := reserves[0]
+	reservesB := reserves[1]
+
+	pool, err := NewStableSwapPool(reservesA.Amount, reservesB.Amount, amplification)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DenominatedPool{
+		pool:          pool,
+		denomA:        reservesA.Denom,
+		denomB:        reservesB.Denom,
+		amplification: amplification,
+	}, nil
+}
+
+// NewDenominatedStableSwapPoolWithExistingShares creates a new denominated stable swap pool from reserve coins
+func NewDenominatedStableSwapPoolWithExistingShares(reserves sdk.Coins, totalShares sdkmath.Int, amplification uint64) (*DenominatedPool, error) {
+	if len(reserves) != 2 {
+		return nil, errorsmod.Wrap(ErrInvalidPool, "reserves must have two denominations")
+	}
+
+	reservesA := reserves[0]
+	reservesB := reserves[1]
+
+	pool, err := NewStableSwapPoolWithExistingShares(reservesA.Amount, reservesB.Amount, totalShares, amplification)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DenominatedPool{
+		pool:          pool,
+		denomA:        reservesA.Denom,
+		denomB:        reservesB.Denom,
+		amplification: amplification,
+	}, nil
+}
+
+// NewDenominatedPoolWithAmplification creates a new denominated pool from reserve coins, which is a stable swap pool
+// if the amplification is positive and a constant product pool otherwise
+func NewDenominatedPoolWithAmplification(reserves sdk.Coins, amplification uint64) (*DenominatedPool, error) {
+	if amplification == 0 {
+		return NewDenominatedPool(reserves)
+	}
+	return NewDenominatedStableSwapPool(reserves, amplification)
+}
+
+// NewDenominatedPoolFromRecord creates a denominated pool of the type of a stored pool record
+func NewDenominatedPoolFromRecord(record PoolRecord) (*DenominatedPool, error) {
+	if record.Amplification == 0 {
+		return NewDenominatedPoolWithExistingShares(record.Reserves(), record.TotalShares)
+	}
+	return NewDenominatedStableSwapPoolWithExistingShares(record.Reserves(), record.TotalShares, record.Amplification)
+}
+
+// IsStableSwap returns true if the pool trades with the stable swap invariant
+func (p *DenominatedPool) IsStableSwap() bool {
+	return p.amplification > 0
+}
+
+// Amplification returns the amplification coefficient of stable swap pools, and zero for constant product pools
+func (p *DenominatedPool) Amplification() uint64 {
+	return p.amplification
+}
+
 // Reserves returns the reserves held in the pool
 func (p *DenominatedPool) Reserves() sdk.Coins {
 	return p.coins(p.pool.ReservesA(), p.pool.ReservesB())
@@ -144,6 +234,19 @@ func (p *DenominatedPool) SwapWithExactOutput(swapOutput sdk.Coin, fee sdk.Dec)
 	}
 }
 
+// Price returns the marginal price of a pool denom in the other pool denom.
+// Panics if the denom does not match the pool reserves.
+func (p *DenominatedPool) Price(denom string) sdk.Dec {
+	switch denom {
+	case p.denomA:
+		return p.pool.PriceA()
+	case p.denomB:
+		return p.pool.PriceB()
+	default:
+		panic(fmt.Sprintf("invalid denomination: denom '%s' does not match pool reserves", denom))
+	}
+}
+
 // coins returns a new coins slice with correct reserve denoms from ordered sdk.Ints
 func (p *DenominatedPool) coins(amountA, amountB sdkmath.Int) sdk.Coins {
 	return sdk.NewCoins(p.coinA(amountA), p.coinB(amountB))
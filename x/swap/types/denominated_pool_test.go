@@ -181,3 +181,52 @@ func TestDenominatedPool_SwapWithExactOuput(t *testing.T) {
 
 	assert.Panics(t, func() { pool.SwapWithExactOutput(hard(1e6), d("0.003")) }, "SwapWithExactOutput did not panic on invalid denomination")
 }
+
+func TestDenominatedPool_StableSwap_InitialState(t *testing.T) {
+	reserves := sdk.NewCoins(usdx(1e6), hard(1e6))
+
+	pool, err := types.NewDenominatedStableSwapPool(reserves, 100)
+	require.NoError(t, err)
+
+	assert.True(t, pool.IsStableSwap())
+	assert.Equal(t, uint64(100), pool.Amplification())
+	assert.Equal(t, reserves, pool.Reserves())
+	assert.Equal(t, i(2e6), pool.TotalShares())
+
+	_, err = types.NewDenominatedStableSwapPool(reserves, 0)
+	assert.EqualError(t, err, "amplification must be between 1 and 10000: invalid pool")
+}
+
+func TestDenominatedPool_NewDenominatedPoolFromRecord(t *testing.T) {
+	reserves := sdk.NewCoins(usdx(1e6), hard(2e6))
+
+	record := types.NewPoolRecord(reserves, i(3e6))
+	pool, err := types.NewDenominatedPoolFromRecord(record)
+	require.NoError(t, err)
+	assert.False(t, pool.IsStableSwap())
+	assert.Equal(t, reserves, pool.Reserves())
+	assert.Equal(t, i(3e6), pool.TotalShares())
+
+	record.Amplification = 50
+	pool, err = types.NewDenominatedPoolFromRecord(record)
+	require.NoError(t, err)
+	assert.True(t, pool.IsStableSwap())
+	assert.Equal(t, uint64(50), pool.Amplification())
+	assert.Equal(t, i(3e6), pool.TotalShares())
+}
+
+func TestDenominatedPool_Price(t *testing.T) {
+	reserves := sdk.NewCoins(usdx(4e6), hard(1e6))
+
+	pool, err := types.NewDenominatedPool(reserves)
+	require.NoError(t, err)
+	assert.Equal(t, d("4"), pool.Price("hard"))
+	assert.Equal(t, d("0.25"), pool.Price("usdx"))
+	assert.Panics(t, func() { pool.Price("ukava") }, "expected panic for unknown denom")
+
+	stablePool, err := types.NewDenominatedStableSwapPool(reserves, 100)
+	require.NoError(t, err)
+	assert.True(t, stablePool.Price("hard").GT(d("1")))
+	assert.True(t, stablePool.Price("hard").LT(d("4")))
+	assert.True(t, stablePool.Price("usdx").LT(d("1")))
+}
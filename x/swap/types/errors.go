@@ -15,4 +15,8 @@ var (
 	ErrDepositNotFound       = errorsmod.Register(ModuleName, 10, "deposit not found")
 	ErrInvalidCoin           = errorsmod.Register(ModuleName, 11, "invalid coin")
 	ErrNotImplemented        = errorsmod.Register(ModuleName, 12, "not implemented")
+	ErrInvalidRoute          = errorsmod.Register(ModuleName, 13, "invalid route")
+	ErrInsufficientPriceData = errorsmod.Register(ModuleName, 14, "insufficient price data")
+	ErrLimitOrderNotFound    = errorsmod.Register(ModuleName, 15, "limit order not found")
+	ErrTooManyLimitOrders    = errorsmod.Register(ModuleName, 16, "too many open limit orders")
 )
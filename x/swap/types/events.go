@@ -6,6 +6,13 @@ const (
 	EventTypeSwapDeposit       = "swap_deposit"
 	EventTypeSwapWithdraw      = "swap_withdraw"
 	EventTypeSwapTrade         = "swap_trade"
+	EventTypePlaceLimitOrder   = "swap_place_limit_order"
+	EventTypeCancelLimitOrder  = "swap_cancel_limit_order"
+	EventTypeFillLimitOrder    = "swap_fill_limit_order"
+	EventTypeExpireLimitOrder  = "swap_expire_limit_order"
+	EventTypeTransferShares    = "swap_transfer_shares"
+	EventTypeWrapShares        = "swap_wrap_shares"
+	EventTypeUnwrapShares      = "swap_unwrap_shares"
 	AttributeKeyPoolID         = "pool_id"
 	AttributeKeyDepositor      = "depositor"
 	AttributeKeyShares         = "shares"
@@ -15,4 +22,9 @@ const (
 	AttributeKeySwapOutput     = "output"
 	AttributeKeyFeePaid        = "fee"
 	AttributeKeyExactDirection = "exact"
+	AttributeKeyOrderID        = "order_id"
+	AttributeKeySell           = "sell"
+	AttributeKeyMinBuy         = "min_buy"
+	AttributeKeySender         = "sender"
+	AttributeKeyRecipient      = "recipient"
 )
@@ -19,10 +19,13 @@ type AccountKeeper interface {
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
 
 // SwapHooks are event hooks called when a user's deposit to a swap pool changes.
@@ -30,3 +33,9 @@ type SwapHooks interface {
 	AfterPoolDepositCreated(ctx sdk.Context, poolID string, depositor sdk.AccAddress, sharedOwned sdkmath.Int)
 	BeforePoolDepositModified(ctx sdk.Context, poolID string, depositor sdk.AccAddress, sharedOwned sdkmath.Int)
 }
+
+// SwapFeeController adjusts the swap fee charged by a pool, for example increasing it while prices are volatile.
+type SwapFeeController interface {
+	// AdjustSwapFee returns the swap fee to charge for a pool given the swap fee configured in the params
+	AdjustSwapFee(ctx sdk.Context, poolID string, baseSwapFee sdk.Dec) sdk.Dec
+}
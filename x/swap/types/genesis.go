@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 
 	sdkmath "cosmossdk.io/math"
@@ -20,11 +21,13 @@ var (
 )
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, poolRecords PoolRecords, shareRecords ShareRecords) GenesisState {
+func NewGenesisState(params Params, poolRecords PoolRecords, shareRecords ShareRecords, limitOrders LimitOrders, nextLimitOrderID uint64) GenesisState {
 	return GenesisState{
-		Params:       params,
-		PoolRecords:  poolRecords,
-		ShareRecords: shareRecords,
+		Params:           params,
+		PoolRecords:      poolRecords,
+		ShareRecords:     shareRecords,
+		LimitOrders:      limitOrders,
+		NextLimitOrderID: nextLimitOrderID,
 	}
 }
 
@@ -39,6 +42,17 @@ func (gs GenesisState) Validate() error {
 	if err := gs.ShareRecords.Validate(); err != nil {
 		return err
 	}
+	if err := gs.LimitOrders.Validate(); err != nil {
+		return err
+	}
+	if gs.NextLimitOrderID == 0 {
+		return errors.New("next limit order id must be positive")
+	}
+	for _, lo := range gs.LimitOrders {
+		if lo.ID >= gs.NextLimitOrderID {
+			return fmt.Errorf("limit order id %d must be less than next limit order id %d", lo.ID, gs.NextLimitOrderID)
+		}
+	}
 
 	totalShares := make(map[string]poolShares)
 	for _, pr := range gs.PoolRecords {
@@ -74,5 +88,7 @@ func DefaultGenesisState() GenesisState {
 		DefaultParams(),
 		DefaultPoolRecords,
 		DefaultShareRecords,
+		DefaultLimitOrders,
+		DefaultNextLimitOrderID,
 	)
 }
@@ -31,6 +31,10 @@ type GenesisState struct {
 	PoolRecords PoolRecords `protobuf:"bytes,2,rep,name=pool_records,json=poolRecords,proto3,castrepeated=PoolRecords" json:"pool_records"`
 	// share_records defines the owned shares of each pool
 	ShareRecords ShareRecords `protobuf:"bytes,3,rep,name=share_records,json=shareRecords,proto3,castrepeated=ShareRecords" json:"share_records"`
+	// limit_orders defines the open limit orders
+	LimitOrders LimitOrders `protobuf:"bytes,4,rep,name=limit_orders,json=limitOrders,proto3,castrepeated=LimitOrders" json:"limit_orders"`
+	// next_limit_order_id defines the id of the next limit order placed
+	NextLimitOrderID uint64 `protobuf:"varint,5,opt,name=next_limit_order_id,json=nextLimitOrderId,proto3" json:"next_limit_order_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +91,20 @@ func (m *GenesisState) GetShareRecords() ShareRecords {
 	return nil
 }
 
+func (m *GenesisState) GetLimitOrders() LimitOrders {
+	if m != nil {
+		return m.LimitOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextLimitOrderID() uint64 {
+	if m != nil {
+		return m.NextLimitOrderID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.swap.v1beta1.GenesisState")
 }
@@ -94,25 +112,29 @@ func init() {
 func init() { proto.RegisterFile("kava/swap/v1beta1/genesis.proto", fileDescriptor_b1a1a1687f484a21) }
 
 var fileDescriptor_b1a1a1687f484a21 = []byte{
-	// 283 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x2f, 0x2e, 0x4f, 0x2c, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x29, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58,
-	0x10, 0x85, 0x52, 0x32, 0x98, 0x26, 0x81, 0x75, 0x81, 0x65, 0x95, 0x7e, 0x32, 0x72, 0xf1, 0xb8,
-	0x43, 0x0c, 0x0e, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0x32, 0xe7, 0x62, 0x2b, 0x48, 0x2c, 0x4a, 0xcc,
-	0x2d, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd4, 0xc3, 0xb0, 0x48, 0x2f, 0x00, 0xac,
-	0xc0, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8, 0x72, 0xa1, 0x50, 0x2e, 0x9e, 0x82, 0xfc,
-	0xfc, 0x9c, 0xf8, 0xa2, 0xd4, 0xe4, 0xfc, 0xa2, 0x94, 0x62, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x6e,
-	0x23, 0x59, 0x6c, 0xda, 0xf3, 0xf3, 0x73, 0x82, 0xc0, 0xaa, 0x9c, 0x84, 0x41, 0x46, 0xac, 0xba,
-	0x2f, 0xcf, 0x8d, 0x10, 0x2b, 0x0e, 0xe2, 0x2e, 0x40, 0x70, 0x84, 0x22, 0xb9, 0x78, 0x8b, 0x33,
-	0x12, 0x8b, 0x52, 0xe1, 0xe6, 0x32, 0x83, 0xcd, 0x95, 0xc3, 0x62, 0x6e, 0x30, 0x48, 0x1d, 0xd4,
-	0x60, 0x11, 0xa8, 0xc1, 0x3c, 0x48, 0x82, 0xc5, 0x41, 0x3c, 0xc5, 0x48, 0x3c, 0x27, 0x87, 0x13,
-	0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86,
-	0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x4b, 0xcf, 0x2c, 0xc9, 0x28, 0x4d,
-	0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x07, 0xd9, 0xa3, 0x9b, 0x93, 0x98, 0x54, 0x0c, 0x66, 0xe9, 0x57,
-	0x40, 0x82, 0xb2, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x88, 0xc6, 0x80, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xca, 0xb8, 0xb9, 0x95, 0xae, 0x01, 0x00, 0x00,
+	// 352 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4e, 0xea, 0x40,
+	0x14, 0x86, 0xdb, 0x0b, 0x97, 0x45, 0xdb, 0x9b, 0x70, 0x0b, 0x8b, 0x4a, 0x74, 0x4a, 0x5c, 0x18,
+	0x36, 0xb6, 0x01, 0x17, 0x6e, 0x4d, 0x35, 0x31, 0x26, 0x46, 0x4d, 0x89, 0x0b, 0xdd, 0x34, 0x53,
+	0x3a, 0x29, 0x8d, 0x85, 0x69, 0xe6, 0x8c, 0x88, 0x6f, 0xe1, 0xc6, 0x97, 0xf0, 0x49, 0x58, 0xb2,
+	0x74, 0x85, 0xa6, 0xbc, 0x88, 0x99, 0xa1, 0xb1, 0x18, 0x70, 0x37, 0xe7, 0x9f, 0xef, 0x7c, 0xf3,
+	0x27, 0xa3, 0xd9, 0x0f, 0x78, 0x82, 0x5d, 0x78, 0xc2, 0x99, 0x3b, 0xe9, 0x86, 0x84, 0xe3, 0xae,
+	0x1b, 0x93, 0x31, 0x81, 0x04, 0x9c, 0x8c, 0x51, 0x4e, 0xcd, 0xff, 0x02, 0x70, 0x04, 0xe0, 0x14,
+	0x40, 0xab, 0x19, 0xd3, 0x98, 0xca, 0x5b, 0x57, 0x9c, 0x56, 0x60, 0x6b, 0x77, 0xd3, 0x24, 0xb7,
+	0xe4, 0xed, 0xfe, 0x6b, 0x45, 0x33, 0xce, 0x57, 0xe2, 0x3e, 0xc7, 0x9c, 0x98, 0xc7, 0x5a, 0x2d,
+	0xc3, 0x0c, 0x8f, 0xc0, 0x52, 0xdb, 0x6a, 0x47, 0xef, 0xed, 0x38, 0x1b, 0x0f, 0x39, 0x37, 0x12,
+	0xf0, 0xaa, 0xb3, 0x85, 0xad, 0xf8, 0x05, 0x6e, 0xde, 0x6a, 0x46, 0x46, 0x69, 0x1a, 0x30, 0x32,
+	0xa0, 0x2c, 0x02, 0xeb, 0x4f, 0xbb, 0xd2, 0xd1, 0x7b, 0x7b, 0xdb, 0xd6, 0x29, 0x4d, 0x7d, 0x49,
+	0x79, 0x0d, 0xa1, 0x78, 0xfb, 0xb0, 0xf5, 0x32, 0x03, 0x5f, 0xcf, 0xca, 0xc1, 0xbc, 0xd3, 0xfe,
+	0xc1, 0x10, 0x33, 0xf2, 0xed, 0xad, 0x48, 0x2f, 0xda, 0xe2, 0xed, 0x0b, 0xae, 0x10, 0x37, 0x0b,
+	0xb1, 0xb1, 0x16, 0x82, 0x6f, 0xc0, 0xda, 0x24, 0x1a, 0xa7, 0xc9, 0x28, 0xe1, 0x01, 0x65, 0x11,
+	0x61, 0x60, 0x55, 0x7f, 0x6d, 0x7c, 0x29, 0xb0, 0x6b, 0x41, 0x95, 0x8d, 0xcb, 0x0c, 0x7c, 0x3d,
+	0x2d, 0x07, 0xf3, 0x54, 0x6b, 0x8c, 0xc9, 0x94, 0x07, 0x6b, 0xee, 0x20, 0x89, 0xac, 0xbf, 0x6d,
+	0xb5, 0x53, 0xf5, 0x9a, 0xf9, 0xc2, 0xae, 0x5f, 0x91, 0x29, 0x2f, 0xd7, 0x2f, 0xce, 0xfc, 0xfa,
+	0xf8, 0x67, 0x12, 0x79, 0x27, 0xb3, 0x1c, 0xa9, 0xf3, 0x1c, 0xa9, 0x9f, 0x39, 0x52, 0x5f, 0x96,
+	0x48, 0x99, 0x2f, 0x91, 0xf2, 0xbe, 0x44, 0xca, 0xfd, 0x41, 0x9c, 0xf0, 0xe1, 0x63, 0xe8, 0x0c,
+	0xe8, 0xc8, 0x15, 0x4d, 0x0f, 0x53, 0x1c, 0x82, 0x3c, 0xb9, 0xd3, 0xd5, 0x37, 0xf3, 0xe7, 0x8c,
+	0x40, 0x58, 0x93, 0x1f, 0x7c, 0xf4, 0x35, 0x00, 0x7e, 0x9a, 0x27, 0x8c, 0x4a, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -135,6 +157,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextLimitOrderID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextLimitOrderID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.ShareRecords) > 0 {
 		for iNdEx := len(m.ShareRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -207,6 +248,15 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextLimitOrderID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextLimitOrderID))
+	}
 	return n
 }
 
@@ -346,6 +396,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextLimitOrderID", wireType)
+			}
+			m.NextLimitOrderID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextLimitOrderID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
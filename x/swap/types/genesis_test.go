@@ -50,9 +50,11 @@ func TestGenesis_Validate_SwapFee(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			genesisState := types.GenesisState{
 				Params: types.Params{
-					AllowedPools: types.DefaultAllowedPools,
-					SwapFee:      tc.swapFee,
+					AllowedPools:         types.DefaultAllowedPools,
+					SwapFee:              tc.swapFee,
+					TwapMaxWindowSeconds: types.DefaultTwapMaxWindowSeconds,
 				},
+				NextLimitOrderID: types.DefaultNextLimitOrderID,
 			}
 
 			err := genesisState.Validate()
@@ -94,9 +96,11 @@ func TestGenesis_Validate_AllowedPools(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			genesisState := types.GenesisState{
 				Params: types.Params{
-					AllowedPools: tc.pairs,
-					SwapFee:      types.DefaultSwapFee,
+					AllowedPools:         tc.pairs,
+					SwapFee:              types.DefaultSwapFee,
+					TwapMaxWindowSeconds: types.DefaultTwapMaxWindowSeconds,
 				},
+				NextLimitOrderID: types.DefaultNextLimitOrderID,
 			}
 
 			err := genesisState.Validate()
@@ -163,15 +167,21 @@ func TestGenesis_JSONEncoding(t *testing.T) {
 }
 
 func TestGenesis_YAMLEncoding(t *testing.T) {
-	expected := `params:
+	expected := `limit_orders: []
+next_limit_order_id: 1
+params:
   allowed_pools:
-  - token_a: ukava
+  - amplification: 0
+    token_a: ukava
     token_b: usdx
-  - token_a: hard
+  - amplification: 0
+    token_a: hard
     token_b: busd
   swap_fee: "0.003000000000000000"
+  twap_max_window_seconds: 3600
 pool_records:
-- pool_id: ukava:usdx
+- amplification: 0
+  pool_id: ukava:usdx
   reserves_a:
     amount: "1000000"
     denom: ukava
@@ -179,7 +189,8 @@ pool_records:
     amount: "5000000"
     denom: usdx
   total_shares: "3000000"
-- pool_id: hard:usdx
+- amplification: 0
+  pool_id: hard:usdx
   reserves_a:
     amount: "1000000"
     denom: hard
@@ -208,6 +219,7 @@ share_records:
 				types.NewAllowedPool("hard", "busd"),
 			),
 			sdk.MustNewDecFromStr("0.003"),
+			3600,
 		),
 		types.PoolRecords{
 			types.NewPoolRecord(sdk.NewCoins(ukava(1e6), usdx(5e6)), i(3e6)),
@@ -217,6 +229,8 @@ share_records:
 			types.NewShareRecord(depositor_1, types.PoolID("ukava", "usdx"), i(1e5)),
 			types.NewShareRecord(depositor_2, types.PoolID("hard", "usdx"), i(2e5)),
 		},
+		types.DefaultLimitOrders,
+		types.DefaultNextLimitOrderID,
 	)
 
 	data, err := yaml.Marshal(state)
@@ -232,6 +246,8 @@ func TestGenesis_ValidatePoolRecords(t *testing.T) {
 		types.DefaultParams(),
 		types.PoolRecords{invalidPoolRecord},
 		types.ShareRecords{},
+		types.DefaultLimitOrders,
+		types.DefaultNextLimitOrderID,
 	)
 
 	assert.Error(t, state.Validate())
@@ -247,11 +263,69 @@ func TestGenesis_ValidateShareRecords(t *testing.T) {
 		types.DefaultParams(),
 		types.PoolRecords{},
 		types.ShareRecords{invalidShareRecord},
+		types.DefaultLimitOrders,
+		types.DefaultNextLimitOrderID,
 	)
 
 	assert.Error(t, state.Validate())
 }
 
+func TestGenesis_ValidateLimitOrders(t *testing.T) {
+	owner, err := sdk.AccAddressFromBech32("kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name             string
+		limitOrders      types.LimitOrders
+		nextLimitOrderID uint64
+		expectedErr      string
+	}{
+		{
+			name:             "valid orders",
+			limitOrders:      types.LimitOrders{types.NewLimitOrder(1, owner, ukava(1e6), usdx(6e6), orderExpiry)},
+			nextLimitOrderID: 2,
+			expectedErr:      "",
+		},
+		{
+			name:             "invalid order",
+			limitOrders:      types.LimitOrders{types.NewLimitOrder(1, owner, ukava(1e6), ukava(6e6), orderExpiry)},
+			nextLimitOrderID: 2,
+			expectedErr:      "limit order 1 cannot sell and buy the same denom ukava",
+		},
+		{
+			name:             "zero next order id",
+			limitOrders:      types.DefaultLimitOrders,
+			nextLimitOrderID: 0,
+			expectedErr:      "next limit order id must be positive",
+		},
+		{
+			name:             "order id not below next order id",
+			limitOrders:      types.LimitOrders{types.NewLimitOrder(2, owner, ukava(1e6), usdx(6e6), orderExpiry)},
+			nextLimitOrderID: 2,
+			expectedErr:      "limit order id 2 must be less than next limit order id 2",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := types.NewGenesisState(
+				types.DefaultParams(),
+				types.PoolRecords{},
+				types.ShareRecords{},
+				tc.limitOrders,
+				tc.nextLimitOrderID,
+			)
+
+			err := state.Validate()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestGenesis_Validate_PoolShareIntegration(t *testing.T) {
 	depositor_1, err := sdk.AccAddressFromBech32("kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w")
 	require.NoError(t, err)
@@ -323,7 +397,7 @@ func TestGenesis_Validate_PoolShareIntegration(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			state := types.NewGenesisState(types.DefaultParams(), tc.poolRecords, tc.shareRecords)
+			state := types.NewGenesisState(types.DefaultParams(), tc.poolRecords, tc.shareRecords, types.DefaultLimitOrders, types.DefaultNextLimitOrderID)
 			err := state.Validate()
 
 			if tc.expectedErr == "" {
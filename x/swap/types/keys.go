@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -23,8 +26,14 @@ const (
 
 // key prefixes for store
 var (
-	PoolKeyPrefix             = []byte{0x01}
-	DepositorPoolSharesPrefix = []byte{0x02}
+	PoolKeyPrefix              = []byte{0x01}
+	DepositorPoolSharesPrefix  = []byte{0x02}
+	PoolPriceObservationPrefix = []byte{0x03}
+	LimitOrderKeyPrefix        = []byte{0x04}
+	NextLimitOrderIDKey        = []byte{0x05}
+	LimitOrderByPricePrefix    = []byte{0x06}
+	LimitOrderByExpiryPrefix   = []byte{0x07}
+	LimitOrderByOwnerPrefix    = []byte{0x08}
 
 	sep = []byte("|")
 )
@@ -39,6 +48,55 @@ func DepositorPoolSharesKey(depositor sdk.AccAddress, poolID string) []byte {
 	return createKey(depositor, sep, []byte(poolID))
 }
 
+// PoolPriceObservationsKey returns a key prefix for all price observations of a poolID
+func PoolPriceObservationsKey(poolID string) []byte {
+	return createKey([]byte(poolID), sep)
+}
+
+// PoolPriceObservationKey returns a key from a poolID and observation time
+func PoolPriceObservationKey(poolID string, observedAt time.Time) []byte {
+	return createKey(PoolPriceObservationsKey(poolID), sdk.FormatTimeBytes(observedAt))
+}
+
+// LimitOrderKey returns a key from a limit order id
+func LimitOrderKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// LimitOrdersByPriceKey returns a key prefix for all limit orders selling a denom against a poolID
+func LimitOrdersByPriceKey(poolID, sellDenom string) []byte {
+	return createKey([]byte(poolID), sep, []byte(sellDenom), sep)
+}
+
+// LimitOrderByPriceKey returns a key from a poolID, sell denom, limit price and limit order id
+func LimitOrderByPriceKey(poolID, sellDenom string, price sdk.Dec, id uint64) []byte {
+	return createKey(LimitOrdersByPriceKey(poolID, sellDenom), LimitOrderPriceBytes(price), LimitOrderKey(id))
+}
+
+// LimitOrderByExpiryKey returns a key from a limit order expiry time and id
+func LimitOrderByExpiryKey(expiresAt time.Time, id uint64) []byte {
+	return createKey(sdk.FormatTimeBytes(expiresAt), LimitOrderKey(id))
+}
+
+// LimitOrdersByOwnerKey returns a key prefix for all limit orders of an owner
+func LimitOrdersByOwnerKey(owner sdk.AccAddress) []byte {
+	return createKey(owner, sep)
+}
+
+// LimitOrderByOwnerKey returns a key from an owner and limit order id
+func LimitOrderByOwnerKey(owner sdk.AccAddress, id uint64) []byte {
+	return createKey(LimitOrdersByOwnerKey(owner), LimitOrderKey(id))
+}
+
+// LimitOrderPriceBytes returns a fixed width representation of a non-negative price that sorts in the
+// same order as the price. Prices of MaxLimitOrderPrice or more sort after all other prices.
+func LimitOrderPriceBytes(price sdk.Dec) []byte {
+	if price.GTE(MaxLimitOrderPrice) {
+		return []byte("max")
+	}
+	return []byte(fmt.Sprintf("%0*s", sdk.Precision*2+1, price.String()))
+}
+
 func createKey(bytes ...[]byte) (r []byte) {
 	for _, b := range bytes {
 		r = append(r, b...)
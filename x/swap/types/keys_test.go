@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kava-labs/kava/x/swap/types"
 
@@ -15,4 +16,35 @@ func TestKeys(t *testing.T) {
 
 	key = types.DepositorPoolSharesKey(sdk.AccAddress("testaddress1"), types.PoolID("ukava", "usdx"))
 	assert.Equal(t, string(sdk.AccAddress("testaddress1"))+"|"+types.PoolID("ukava", "usdx"), string(key))
+
+	observedAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	key = types.PoolPriceObservationKey(types.PoolID("ukava", "usdx"), observedAt)
+	assert.Equal(t, types.PoolID("ukava", "usdx")+"|"+string(sdk.FormatTimeBytes(observedAt)), string(key))
+
+	key = types.LimitOrderByPriceKey(types.PoolID("ukava", "usdx"), "ukava", sdk.MustNewDecFromStr("5.5"), 1)
+	assert.Equal(t, types.PoolID("ukava", "usdx")+"|ukava|"+string(types.LimitOrderPriceBytes(sdk.MustNewDecFromStr("5.5")))+string(types.LimitOrderKey(1)), string(key))
+
+	key = types.LimitOrderByExpiryKey(observedAt, 1)
+	assert.Equal(t, string(sdk.FormatTimeBytes(observedAt))+string(types.LimitOrderKey(1)), string(key))
+
+	key = types.LimitOrderByOwnerKey(sdk.AccAddress("testaddress1"), 1)
+	assert.Equal(t, string(sdk.AccAddress("testaddress1"))+"|"+string(types.LimitOrderKey(1)), string(key))
+}
+
+func TestLimitOrderPriceBytes_Sorted(t *testing.T) {
+	prices := []sdk.Dec{
+		sdk.ZeroDec(),
+		sdk.SmallestDec(),
+		sdk.MustNewDecFromStr("0.5"),
+		sdk.OneDec(),
+		sdk.MustNewDecFromStr("9.99"),
+		sdk.NewDec(10),
+		types.MaxLimitOrderPrice.Sub(sdk.SmallestDec()),
+		types.MaxLimitOrderPrice,
+	}
+	for i := 1; i < len(prices); i++ {
+		assert.Less(t, string(types.LimitOrderPriceBytes(prices[i-1])), string(types.LimitOrderPriceBytes(prices[i])))
+	}
+	// prices above the max sort with the max
+	assert.Equal(t, types.LimitOrderPriceBytes(types.MaxLimitOrderPrice), types.LimitOrderPriceBytes(types.MaxLimitOrderPrice.MulInt64(2)))
 }
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultNextLimitOrderID is the id of the first limit order placed
+	DefaultNextLimitOrderID = uint64(1)
+	// MaxOpenLimitOrdersPerOwner is the maximum number of open limit orders an account can have
+	MaxOpenLimitOrdersPerOwner = 10
+	// LimitOrderLifetime is how long a limit order stays open before it expires
+	LimitOrderLifetime = 30 * 24 * time.Hour
+)
+
+var (
+	// DefaultLimitOrders is used to set default limit orders in default genesis state
+	DefaultLimitOrders = LimitOrders{}
+	// MaxLimitOrderPrice is the largest limit price that is ordered in the limit order price index
+	MaxLimitOrderPrice = sdk.OneDec().Quo(sdk.SmallestDec())
+)
+
+// NewLimitOrder returns a new limit order selling an exact coin for at least a minimum coin until it expires
+func NewLimitOrder(id uint64, owner sdk.AccAddress, sell, minBuy sdk.Coin, expiresAt time.Time) LimitOrder {
+	return LimitOrder{
+		ID:        id,
+		Owner:     owner,
+		Sell:      sell,
+		MinBuy:    minBuy,
+		ExpiresAt: expiresAt,
+	}
+}
+
+// PoolID returns the id of the pool the order is filled against
+func (o LimitOrder) PoolID() string {
+	return PoolID(o.Sell.Denom, o.MinBuy.Denom)
+}
+
+// LimitPrice returns the minimum price of the sell denom in the buy denom the order is filled at, rounded down
+func (o LimitOrder) LimitPrice() sdk.Dec {
+	return sdk.NewDecFromInt(o.MinBuy.Amount).QuoTruncate(sdk.NewDecFromInt(o.Sell.Amount))
+}
+
+// Validate performs basic validation checks of the order data
+func (o LimitOrder) Validate() error {
+	if o.ID == 0 {
+		return errors.New("limit order id must be positive")
+	}
+
+	if o.Owner.Empty() {
+		return errors.New("limit order owner cannot be empty")
+	}
+
+	if !o.Sell.IsValid() || !o.Sell.IsPositive() {
+		return fmt.Errorf("limit order %d has invalid sell coin: %s", o.ID, o.Sell)
+	}
+
+	if !o.MinBuy.IsValid() || !o.MinBuy.IsPositive() {
+		return fmt.Errorf("limit order %d has invalid min buy coin: %s", o.ID, o.MinBuy)
+	}
+
+	if o.Sell.Denom == o.MinBuy.Denom {
+		return fmt.Errorf("limit order %d cannot sell and buy the same denom %s", o.ID, o.Sell.Denom)
+	}
+
+	if o.ExpiresAt.IsZero() {
+		return fmt.Errorf("limit order %d must have an expiry time", o.ID)
+	}
+
+	return nil
+}
+
+// LimitOrders is a slice of LimitOrder
+type LimitOrders []LimitOrder
+
+// Validate performs basic validation checks on all orders and checks for duplicate ids
+func (los LimitOrders) Validate() error {
+	seenIDs := make(map[uint64]bool)
+
+	for _, lo := range los {
+		if err := lo.Validate(); err != nil {
+			return err
+		}
+
+		if seenIDs[lo.ID] {
+			return fmt.Errorf("duplicate limit order id %d", lo.ID)
+		}
+		seenIDs[lo.ID] = true
+	}
+
+	return nil
+}
+
+// Escrow returns the total sell coins held for the orders
+func (los LimitOrders) Escrow() sdk.Coins {
+	escrow := sdk.NewCoins()
+	for _, lo := range los {
+		escrow = escrow.Add(lo.Sell)
+	}
+	return escrow
+}
@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	types "github.com/kava-labs/kava/x/swap/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var orderExpiry = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestLimitOrder_PoolID(t *testing.T) {
+	owner := sdk.AccAddress("test1")
+
+	assert.Equal(t, "ukava:usdx", types.NewLimitOrder(1, owner, ukava(1e6), usdx(6e6), orderExpiry).PoolID())
+	assert.Equal(t, "ukava:usdx", types.NewLimitOrder(1, owner, usdx(6e6), ukava(1e6), orderExpiry).PoolID())
+}
+
+func TestLimitOrder_Validations(t *testing.T) {
+	owner, err := sdk.AccAddressFromBech32("kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		order       types.LimitOrder
+		expectedErr string
+	}{
+		{
+			name:        "valid order",
+			order:       types.NewLimitOrder(1, owner, ukava(1e6), usdx(6e6), orderExpiry),
+			expectedErr: "",
+		},
+		{
+			name:        "zero id",
+			order:       types.NewLimitOrder(0, owner, ukava(1e6), usdx(6e6), orderExpiry),
+			expectedErr: "limit order id must be positive",
+		},
+		{
+			name:        "empty owner",
+			order:       types.NewLimitOrder(1, sdk.AccAddress{}, ukava(1e6), usdx(6e6), orderExpiry),
+			expectedErr: "limit order owner cannot be empty",
+		},
+		{
+			name:        "zero sell",
+			order:       types.NewLimitOrder(1, owner, ukava(0), usdx(6e6), orderExpiry),
+			expectedErr: "limit order 1 has invalid sell coin: 0ukava",
+		},
+		{
+			name:        "negative min buy",
+			order:       types.NewLimitOrder(1, owner, ukava(1e6), sdk.Coin{Denom: "usdx", Amount: sdkmath.NewInt(-1)}, orderExpiry),
+			expectedErr: "limit order 1 has invalid min buy coin: -1usdx",
+		},
+		{
+			name:        "same denoms",
+			order:       types.NewLimitOrder(1, owner, ukava(1e6), ukava(6e6), orderExpiry),
+			expectedErr: "limit order 1 cannot sell and buy the same denom ukava",
+		},
+		{
+			name:        "no expiry",
+			order:       types.NewLimitOrder(1, owner, ukava(1e6), usdx(6e6), time.Time{}),
+			expectedErr: "limit order 1 must have an expiry time",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.order.Validate()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestLimitOrders_Validation(t *testing.T) {
+	owner := sdk.AccAddress("test1")
+
+	orders := types.LimitOrders{
+		types.NewLimitOrder(1, owner, ukava(1e6), usdx(6e6), orderExpiry),
+		types.NewLimitOrder(2, owner, usdx(5e6), ukava(1e6), orderExpiry),
+	}
+	assert.NoError(t, orders.Validate())
+
+	orders = append(orders, types.NewLimitOrder(1, owner, ukava(2e6), usdx(12e6), orderExpiry))
+	assert.EqualError(t, orders.Validate(), "duplicate limit order id 1")
+}
+
+func TestLimitOrders_Escrow(t *testing.T) {
+	owner := sdk.AccAddress("test1")
+
+	orders := types.LimitOrders{
+		types.NewLimitOrder(1, owner, ukava(1e6), usdx(6e6), orderExpiry),
+		types.NewLimitOrder(2, owner, usdx(5e6), ukava(1e6), orderExpiry),
+		types.NewLimitOrder(3, owner, ukava(2e6), usdx(12e6), orderExpiry),
+	}
+	assert.Equal(t, sdk.NewCoins(ukava(3e6), usdx(5e6)), orders.Escrow())
+	assert.Equal(t, sdk.NewCoins(), types.LimitOrders{}.Escrow())
+}
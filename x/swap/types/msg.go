@@ -12,23 +12,46 @@ import (
 const (
 	// TypeMsgDeposit represents the type string for MsgDeposit
 	TypeMsgDeposit = "swap_deposit"
+	// TypeMsgDepositSingleSided represents the type string for MsgDepositSingleSided
+	TypeMsgDepositSingleSided = "swap_deposit_single_sided"
 	// TypeMsgWithdraw represents the type string for MsgWithdraw
 	TypeMsgWithdraw = "swap_withdraw"
 	// TypeSwapExactForTokens represents the type string for MsgSwapExactForTokens
 	TypeSwapExactForTokens = "swap_exact_for_tokens"
 	// TypeSwapForExactTokens represents the type string for MsgSwapForExactTokens
 	TypeSwapForExactTokens = "swap_for_exact_tokens"
+	// TypeSwapExactForTokensRoute represents the type string for MsgSwapExactForTokensRoute
+	TypeSwapExactForTokensRoute = "swap_exact_for_tokens_route"
+	// TypeMsgPlaceLimitOrder represents the type string for MsgPlaceLimitOrder
+	TypeMsgPlaceLimitOrder = "swap_place_limit_order"
+	// TypeMsgCancelLimitOrder represents the type string for MsgCancelLimitOrder
+	TypeMsgCancelLimitOrder = "swap_cancel_limit_order"
+	// TypeMsgTransferShares represents the type string for MsgTransferShares
+	TypeMsgTransferShares = "swap_transfer_shares"
+	// TypeMsgWrapShares represents the type string for MsgWrapShares
+	TypeMsgWrapShares = "swap_wrap_shares"
+	// TypeMsgUnwrapShares represents the type string for MsgUnwrapShares
+	TypeMsgUnwrapShares = "swap_unwrap_shares"
 )
 
 var (
 	_ sdk.Msg         = &MsgDeposit{}
 	_ MsgWithDeadline = &MsgDeposit{}
+	_ sdk.Msg         = &MsgDepositSingleSided{}
+	_ MsgWithDeadline = &MsgDepositSingleSided{}
 	_ sdk.Msg         = &MsgWithdraw{}
 	_ MsgWithDeadline = &MsgWithdraw{}
 	_ sdk.Msg         = &MsgSwapExactForTokens{}
 	_ MsgWithDeadline = &MsgSwapExactForTokens{}
 	_ sdk.Msg         = &MsgSwapForExactTokens{}
 	_ MsgWithDeadline = &MsgSwapForExactTokens{}
+	_ sdk.Msg         = &MsgSwapExactForTokensRoute{}
+	_ MsgWithDeadline = &MsgSwapExactForTokensRoute{}
+	_ sdk.Msg         = &MsgPlaceLimitOrder{}
+	_ sdk.Msg         = &MsgCancelLimitOrder{}
+	_ sdk.Msg         = &MsgTransferShares{}
+	_ sdk.Msg         = &MsgWrapShares{}
+	_ sdk.Msg         = &MsgUnwrapShares{}
 )
 
 // MsgWithDeadline allows messages to define a deadline of when they are considered invalid
@@ -113,6 +136,82 @@ func (msg MsgDeposit) DeadlineExceeded(blockTime time.Time) bool {
 	return blockTime.Unix() >= msg.Deadline
 }
 
+// NewMsgDepositSingleSided returns a new MsgDepositSingleSided
+func NewMsgDepositSingleSided(depositor string, token sdk.Coin, pairedDenom string, slippage sdk.Dec, deadline int64) *MsgDepositSingleSided {
+	return &MsgDepositSingleSided{
+		Depositor:   depositor,
+		Token:       token,
+		PairedDenom: pairedDenom,
+		Slippage:    slippage,
+		Deadline:    deadline,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgDepositSingleSided) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgDepositSingleSided) Type() string { return TypeMsgDepositSingleSided }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgDepositSingleSided) ValidateBasic() error {
+	if msg.Depositor == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "depositor address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor address: %s", err)
+	}
+
+	if !msg.Token.IsValid() || msg.Token.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "token deposit amount %s", msg.Token)
+	}
+
+	if err := sdk.ValidateDenom(msg.PairedDenom); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if msg.Token.Denom == msg.PairedDenom {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "denominations can not be equal")
+	}
+
+	if msg.Slippage.IsNil() {
+		return errorsmod.Wrapf(ErrInvalidSlippage, "slippage must be set")
+	}
+
+	if msg.Slippage.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidSlippage, "slippage can not be negative")
+	}
+
+	if msg.Deadline <= 0 {
+		return errorsmod.Wrapf(ErrInvalidDeadline, "deadline %d", msg.Deadline)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgDepositSingleSided) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgDepositSingleSided) GetSigners() []sdk.AccAddress {
+	depositor, _ := sdk.AccAddressFromBech32(msg.Depositor)
+	return []sdk.AccAddress{depositor}
+}
+
+// GetDeadline returns the time at which the msg is considered invalid
+func (msg MsgDepositSingleSided) GetDeadline() time.Time {
+	return time.Unix(msg.Deadline, 0)
+}
+
+// DeadlineExceeded returns if the msg has exceeded it's deadline
+func (msg MsgDepositSingleSided) DeadlineExceeded(blockTime time.Time) bool {
+	return blockTime.Unix() >= msg.Deadline
+}
+
 // NewMsgWithdraw returns a new MsgWithdraw
 func NewMsgWithdraw(from string, shares sdkmath.Int, minTokenA, minTokenB sdk.Coin, deadline int64) *MsgWithdraw {
 	return &MsgWithdraw{
@@ -340,3 +439,359 @@ func (msg MsgSwapForExactTokens) GetDeadline() time.Time {
 func (msg MsgSwapForExactTokens) DeadlineExceeded(blockTime time.Time) bool {
 	return blockTime.Unix() >= msg.Deadline
 }
+
+// NewMsgSwapExactForTokensRoute returns a new MsgSwapExactForTokensRoute
+func NewMsgSwapExactForTokensRoute(requester string, exactTokenA sdk.Coin, intermediateDenoms []string, tokenB sdk.Coin, slippage sdk.Dec, deadline int64) *MsgSwapExactForTokensRoute {
+	return &MsgSwapExactForTokensRoute{
+		Requester:          requester,
+		ExactTokenA:        exactTokenA,
+		IntermediateDenoms: intermediateDenoms,
+		TokenB:             tokenB,
+		Slippage:           slippage,
+		Deadline:           deadline,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSwapExactForTokensRoute) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSwapExactForTokensRoute) Type() string { return TypeSwapExactForTokensRoute }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSwapExactForTokensRoute) ValidateBasic() error {
+	if msg.Requester == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "requester address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Requester); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid requester address: %s", err)
+	}
+
+	if !msg.ExactTokenA.IsValid() || msg.ExactTokenA.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "exact token a deposit amount %s", msg.ExactTokenA)
+	}
+
+	if !msg.TokenB.IsValid() || msg.TokenB.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "token b deposit amount %s", msg.TokenB)
+	}
+
+	if len(msg.IntermediateDenoms) == 0 {
+		return errorsmod.Wrap(ErrInvalidRoute, "intermediate denoms must contain at least one denom")
+	}
+
+	// every denom in the path may only be visited once so that no pool is traded against twice
+	seenDenoms := make(map[string]bool)
+	for _, denom := range msg.Path() {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errorsmod.Wrap(ErrInvalidRoute, err.Error())
+		}
+		if seenDenoms[denom] {
+			return errorsmod.Wrapf(ErrInvalidRoute, "duplicate denom %s", denom)
+		}
+		seenDenoms[denom] = true
+	}
+
+	if msg.Slippage.IsNil() {
+		return errorsmod.Wrapf(ErrInvalidSlippage, "slippage must be set")
+	}
+
+	if msg.Slippage.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidSlippage, "slippage can not be negative")
+	}
+
+	if msg.Deadline <= 0 {
+		return errorsmod.Wrapf(ErrInvalidDeadline, "deadline %d", msg.Deadline)
+	}
+
+	return nil
+}
+
+// Path returns all denoms traded through, from token a through the intermediate denoms to token b.
+func (msg MsgSwapExactForTokensRoute) Path() []string {
+	path := make([]string, 0, len(msg.IntermediateDenoms)+2)
+	path = append(path, msg.ExactTokenA.Denom)
+	path = append(path, msg.IntermediateDenoms...)
+	return append(path, msg.TokenB.Denom)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSwapExactForTokensRoute) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSwapExactForTokensRoute) GetSigners() []sdk.AccAddress {
+	requester, _ := sdk.AccAddressFromBech32(msg.Requester)
+	return []sdk.AccAddress{requester}
+}
+
+// GetDeadline returns the time at which the msg is considered invalid
+func (msg MsgSwapExactForTokensRoute) GetDeadline() time.Time {
+	return time.Unix(msg.Deadline, 0)
+}
+
+// DeadlineExceeded returns if the msg has exceeded it's deadline
+func (msg MsgSwapExactForTokensRoute) DeadlineExceeded(blockTime time.Time) bool {
+	return blockTime.Unix() >= msg.Deadline
+}
+
+// NewMsgPlaceLimitOrder returns a new MsgPlaceLimitOrder
+func NewMsgPlaceLimitOrder(owner string, sell sdk.Coin, minBuy sdk.Coin) *MsgPlaceLimitOrder {
+	return &MsgPlaceLimitOrder{
+		Owner:  owner,
+		Sell:   sell,
+		MinBuy: minBuy,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgPlaceLimitOrder) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgPlaceLimitOrder) Type() string { return TypeMsgPlaceLimitOrder }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgPlaceLimitOrder) ValidateBasic() error {
+	if msg.Owner == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+
+	if !msg.Sell.IsValid() || msg.Sell.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "sell amount %s", msg.Sell)
+	}
+
+	if !msg.MinBuy.IsValid() || msg.MinBuy.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "min buy amount %s", msg.MinBuy)
+	}
+
+	if msg.Sell.Denom == msg.MinBuy.Denom {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "denominations can not be equal")
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgPlaceLimitOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgPlaceLimitOrder) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+// NewMsgCancelLimitOrder returns a new MsgCancelLimitOrder
+func NewMsgCancelLimitOrder(owner string, orderID uint64) *MsgCancelLimitOrder {
+	return &MsgCancelLimitOrder{
+		Owner:   owner,
+		OrderId: orderID,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgCancelLimitOrder) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgCancelLimitOrder) Type() string { return TypeMsgCancelLimitOrder }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgCancelLimitOrder) ValidateBasic() error {
+	if msg.Owner == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+
+	if msg.OrderId == 0 {
+		return errorsmod.Wrap(ErrLimitOrderNotFound, "order id must be positive")
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgCancelLimitOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgCancelLimitOrder) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+// NewMsgTransferShares returns a new MsgTransferShares
+func NewMsgTransferShares(sender string, recipient string, poolID string, shares sdkmath.Int) *MsgTransferShares {
+	return &MsgTransferShares{
+		Sender:    sender,
+		Recipient: recipient,
+		PoolID:    poolID,
+		Shares:    shares,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgTransferShares) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgTransferShares) Type() string { return TypeMsgTransferShares }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgTransferShares) ValidateBasic() error {
+	if msg.Sender == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	if msg.Recipient == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "recipient address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+
+	if msg.Sender == msg.Recipient {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender and recipient can not be equal")
+	}
+
+	if err := ValidatePoolID(msg.PoolID); err != nil {
+		return errorsmod.Wrap(ErrInvalidPool, err.Error())
+	}
+
+	if msg.Shares.IsNil() {
+		return errorsmod.Wrapf(ErrInvalidShares, "shares must be set")
+	}
+
+	if msg.Shares.IsZero() || msg.Shares.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidShares, msg.Shares.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgTransferShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgTransferShares) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgWrapShares returns a new MsgWrapShares
+func NewMsgWrapShares(owner string, poolID string, shares sdkmath.Int) *MsgWrapShares {
+	return &MsgWrapShares{
+		Owner:  owner,
+		PoolID: poolID,
+		Shares: shares,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgWrapShares) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgWrapShares) Type() string { return TypeMsgWrapShares }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgWrapShares) ValidateBasic() error {
+	if msg.Owner == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+
+	if err := ValidatePoolID(msg.PoolID); err != nil {
+		return errorsmod.Wrap(ErrInvalidPool, err.Error())
+	}
+
+	if msg.Shares.IsNil() {
+		return errorsmod.Wrapf(ErrInvalidShares, "shares must be set")
+	}
+
+	if msg.Shares.IsZero() || msg.Shares.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidShares, msg.Shares.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgWrapShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgWrapShares) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+// NewMsgUnwrapShares returns a new MsgUnwrapShares
+func NewMsgUnwrapShares(owner string, amount sdk.Coin) *MsgUnwrapShares {
+	return &MsgUnwrapShares{
+		Owner:  owner,
+		Amount: amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgUnwrapShares) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgUnwrapShares) Type() string { return TypeMsgUnwrapShares }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgUnwrapShares) ValidateBasic() error {
+	if msg.Owner == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "unwrap amount %s", msg.Amount)
+	}
+
+	if _, err := ParseShareDenom(msg.Amount.Denom); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgUnwrapShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgUnwrapShares) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
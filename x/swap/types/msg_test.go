@@ -201,6 +201,165 @@ func TestMsgDeposit_Deadline(t *testing.T) {
 	}
 }
 
+func TestMsgDepositSingleSided_Attributes(t *testing.T) {
+	msg := types.MsgDepositSingleSided{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_deposit_single_sided", msg.Type())
+}
+
+func TestMsgDepositSingleSided_Signing(t *testing.T) {
+	signData := `{"type":"swap/MsgDepositSingleSided","value":{"deadline":"1623606299","depositor":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d","paired_denom":"usdx","slippage":"0.010000000000000000","token":{"amount":"1000000","denom":"ukava"}}}`
+	signBytes := []byte(signData)
+
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	msg := types.NewMsgDepositSingleSided(addr.String(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), "usdx", sdk.MustNewDecFromStr("0.01"), 1623606299)
+	assert.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+}
+
+func TestMsgDepositSingleSided_Validation(t *testing.T) {
+	validMsg := types.NewMsgDepositSingleSided(
+		sdk.AccAddress("test1").String(),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		"usdx",
+		sdk.MustNewDecFromStr("0.01"),
+		1623606299,
+	)
+	require.NoError(t, validMsg.ValidateBasic())
+
+	testCases := []struct {
+		name        string
+		depositor   string
+		token       sdk.Coin
+		pairedDenom string
+		slippage    sdk.Dec
+		deadline    int64
+		expectedErr string
+	}{
+		{
+			name:        "empty address",
+			depositor:   sdk.AccAddress("").String(),
+			token:       validMsg.Token,
+			pairedDenom: validMsg.PairedDenom,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "depositor address cannot be empty: invalid address",
+		},
+		{
+			name:        "invalid address",
+			depositor:   "kava1abcde",
+			token:       validMsg.Token,
+			pairedDenom: validMsg.PairedDenom,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "invalid depositor address: decoding bech32 failed: invalid separator index 4: invalid address",
+		},
+		{
+			name:        "zero token",
+			depositor:   validMsg.Depositor,
+			token:       sdk.Coin{Denom: "ukava", Amount: sdkmath.NewInt(0)},
+			pairedDenom: validMsg.PairedDenom,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "token deposit amount 0ukava: invalid coins",
+		},
+		{
+			name:        "invalid paired denom",
+			depositor:   validMsg.Depositor,
+			token:       validMsg.Token,
+			pairedDenom: "",
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "invalid denom: : invalid coins",
+		},
+		{
+			name:        "equal denoms",
+			depositor:   validMsg.Depositor,
+			token:       validMsg.Token,
+			pairedDenom: "ukava",
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "denominations can not be equal: invalid coins",
+		},
+		{
+			name:        "negative slippage",
+			depositor:   validMsg.Depositor,
+			token:       validMsg.Token,
+			pairedDenom: validMsg.PairedDenom,
+			slippage:    sdk.MustNewDecFromStr("-0.01"),
+			deadline:    validMsg.Deadline,
+			expectedErr: "slippage can not be negative: invalid slippage",
+		},
+		{
+			name:        "nil slippage",
+			depositor:   validMsg.Depositor,
+			token:       validMsg.Token,
+			pairedDenom: validMsg.PairedDenom,
+			slippage:    sdk.Dec{},
+			deadline:    validMsg.Deadline,
+			expectedErr: "slippage must be set: invalid slippage",
+		},
+		{
+			name:        "zero deadline",
+			depositor:   validMsg.Depositor,
+			token:       validMsg.Token,
+			pairedDenom: validMsg.PairedDenom,
+			slippage:    validMsg.Slippage,
+			deadline:    0,
+			expectedErr: "deadline 0: invalid deadline",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgDepositSingleSided(tc.depositor, tc.token, tc.pairedDenom, tc.slippage, tc.deadline)
+			err := msg.ValidateBasic()
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestMsgDepositSingleSided_Deadline(t *testing.T) {
+	blockTime := time.Now()
+
+	testCases := []struct {
+		name       string
+		deadline   int64
+		isExceeded bool
+	}{
+		{
+			name:       "deadline in future",
+			deadline:   blockTime.Add(1 * time.Second).Unix(),
+			isExceeded: false,
+		},
+		{
+			name:       "deadline in past",
+			deadline:   blockTime.Add(-1 * time.Second).Unix(),
+			isExceeded: true,
+		},
+		{
+			name:       "deadline is equal",
+			deadline:   blockTime.Unix(),
+			isExceeded: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg := types.NewMsgDepositSingleSided(
+			sdk.AccAddress("test1").String(),
+			sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+			"usdx",
+			sdk.MustNewDecFromStr("0.01"),
+			tc.deadline,
+		)
+		require.NoError(t, msg.ValidateBasic())
+		assert.Equal(t, tc.isExceeded, msg.DeadlineExceeded(blockTime))
+		assert.Equal(t, time.Unix(tc.deadline, 0), msg.GetDeadline())
+	}
+}
+
 func TestMsgWithdraw_Attributes(t *testing.T) {
 	msg := types.MsgWithdraw{}
 	assert.Equal(t, "swap", msg.Route())
@@ -764,3 +923,538 @@ func TestMsgSwapForExactTokens_Deadline(t *testing.T) {
 		assert.Equal(t, time.Unix(tc.deadline, 0), msg.GetDeadline())
 	}
 }
+
+func TestMsgSwapExactForTokensRoute_Attributes(t *testing.T) {
+	msg := types.MsgSwapExactForTokensRoute{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_exact_for_tokens_route", msg.Type())
+}
+
+func TestMsgSwapExactForTokensRoute_Signing(t *testing.T) {
+	signData := `{"type":"swap/MsgSwapExactForTokensRoute","value":{"deadline":"1623606299","exact_token_a":{"amount":"1000000","denom":"ukava"},"intermediate_denoms":["usdx"],"requester":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d","slippage":"0.010000000000000000","token_b":{"amount":"2000000","denom":"hard"}}}`
+	signBytes := []byte(signData)
+
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	msg := types.NewMsgSwapExactForTokensRoute(addr.String(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), []string{"usdx"}, sdk.NewCoin("hard", sdkmath.NewInt(2e6)), sdk.MustNewDecFromStr("0.01"), 1623606299)
+	assert.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+}
+
+func TestMsgSwapExactForTokensRoute_Validation(t *testing.T) {
+	validMsg := types.NewMsgSwapExactForTokensRoute(
+		sdk.AccAddress("test1").String(),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		[]string{"usdx"},
+		sdk.NewCoin("hard", sdkmath.NewInt(2e6)),
+		sdk.MustNewDecFromStr("0.01"),
+		1623606299,
+	)
+	require.NoError(t, validMsg.ValidateBasic())
+	assert.Equal(t, []string{"ukava", "usdx", "hard"}, validMsg.Path())
+
+	testCases := []struct {
+		name               string
+		requester          string
+		exactTokenA        sdk.Coin
+		intermediateDenoms []string
+		tokenB             sdk.Coin
+		slippage           sdk.Dec
+		deadline           int64
+		expectedErr        string
+	}{
+		{
+			name:               "empty address",
+			requester:          sdk.AccAddress("").String(),
+			exactTokenA:        validMsg.ExactTokenA,
+			intermediateDenoms: validMsg.IntermediateDenoms,
+			tokenB:             validMsg.TokenB,
+			slippage:           validMsg.Slippage,
+			deadline:           validMsg.Deadline,
+			expectedErr:        "requester address cannot be empty: invalid address",
+		},
+		{
+			name:               "zero token a",
+			requester:          validMsg.Requester,
+			exactTokenA:        sdk.Coin{Denom: "ukava", Amount: sdkmath.NewInt(0)},
+			intermediateDenoms: validMsg.IntermediateDenoms,
+			tokenB:             validMsg.TokenB,
+			slippage:           validMsg.Slippage,
+			deadline:           validMsg.Deadline,
+			expectedErr:        "exact token a deposit amount 0ukava: invalid coins",
+		},
+		{
+			name:               "zero token b",
+			requester:          validMsg.Requester,
+			exactTokenA:        validMsg.ExactTokenA,
+			intermediateDenoms: validMsg.IntermediateDenoms,
+			tokenB:             sdk.Coin{Denom: "hard", Amount: sdkmath.NewInt(0)},
+			slippage:           validMsg.Slippage,
+			deadline:           validMsg.Deadline,
+			expectedErr:        "token b deposit amount 0hard: invalid coins",
+		},
+		{
+			name:               "no intermediate denoms",
+			requester:          validMsg.Requester,
+			exactTokenA:        validMsg.ExactTokenA,
+			intermediateDenoms: nil,
+			tokenB:             validMsg.TokenB,
+			slippage:           validMsg.Slippage,
+			deadline:           validMsg.Deadline,
+			expectedErr:        "intermediate denoms must contain at least one denom: invalid route",
+		},
+		{
+			name:               "invalid intermediate denom",
+			requester:          validMsg.Requester,
+			exactTokenA:        validMsg.ExactTokenA,
+			intermediateDenoms: []string{""},
+			tokenB:             validMsg.TokenB,
+			slippage:           validMsg.Slippage,
+			deadline:           validMsg.Deadline,
+			expectedErr:        "invalid denom: : invalid route",
+		},
+		{
+			name:               "intermediate denom equal to token a",
+			requester:          validMsg.Requester,
+			exactTokenA:        validMsg.ExactTokenA,
+			intermediateDenoms: []string{"ukava"},
+			tokenB:             validMsg.TokenB,
+			slippage:           validMsg.Slippage,
+			deadline:           validMsg.Deadline,
+			expectedErr:        "duplicate denom ukava: invalid route",
+		},
+		{
+			name:               "token a equal to token b",
+			requester:          validMsg.Requester,
+			exactTokenA:        validMsg.ExactTokenA,
+			intermediateDenoms: validMsg.IntermediateDenoms,
+			tokenB:             sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+			slippage:           validMsg.Slippage,
+			deadline:           validMsg.Deadline,
+			expectedErr:        "duplicate denom ukava: invalid route",
+		},
+		{
+			name:               "negative slippage",
+			requester:          validMsg.Requester,
+			exactTokenA:        validMsg.ExactTokenA,
+			intermediateDenoms: validMsg.IntermediateDenoms,
+			tokenB:             validMsg.TokenB,
+			slippage:           sdk.MustNewDecFromStr("-0.01"),
+			deadline:           validMsg.Deadline,
+			expectedErr:        "slippage can not be negative: invalid slippage",
+		},
+		{
+			name:               "nil slippage",
+			requester:          validMsg.Requester,
+			exactTokenA:        validMsg.ExactTokenA,
+			intermediateDenoms: validMsg.IntermediateDenoms,
+			tokenB:             validMsg.TokenB,
+			slippage:           sdk.Dec{},
+			deadline:           validMsg.Deadline,
+			expectedErr:        "slippage must be set: invalid slippage",
+		},
+		{
+			name:               "zero deadline",
+			requester:          validMsg.Requester,
+			exactTokenA:        validMsg.ExactTokenA,
+			intermediateDenoms: validMsg.IntermediateDenoms,
+			tokenB:             validMsg.TokenB,
+			slippage:           validMsg.Slippage,
+			deadline:           0,
+			expectedErr:        "deadline 0: invalid deadline",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgSwapExactForTokensRoute(tc.requester, tc.exactTokenA, tc.intermediateDenoms, tc.tokenB, tc.slippage, tc.deadline)
+			err := msg.ValidateBasic()
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestMsgPlaceLimitOrder_Attributes(t *testing.T) {
+	msg := types.MsgPlaceLimitOrder{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_place_limit_order", msg.Type())
+}
+
+func TestMsgPlaceLimitOrder_Signing(t *testing.T) {
+	signData := `{"type":"swap/MsgPlaceLimitOrder","value":{"min_buy":{"amount":"6000000","denom":"usdx"},"owner":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d","sell":{"amount":"1000000","denom":"ukava"}}}`
+	signBytes := []byte(signData)
+
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	msg := types.NewMsgPlaceLimitOrder(addr.String(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(6e6)))
+	assert.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+}
+
+func TestMsgPlaceLimitOrder_Validation(t *testing.T) {
+	validMsg := types.NewMsgPlaceLimitOrder(
+		sdk.AccAddress("test1").String(),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(6e6)),
+	)
+	require.NoError(t, validMsg.ValidateBasic())
+
+	testCases := []struct {
+		name        string
+		owner       string
+		sell        sdk.Coin
+		minBuy      sdk.Coin
+		expectedErr string
+	}{
+		{
+			name:        "empty address",
+			owner:       sdk.AccAddress("").String(),
+			sell:        validMsg.Sell,
+			minBuy:      validMsg.MinBuy,
+			expectedErr: "owner address cannot be empty: invalid address",
+		},
+		{
+			name:        "invalid address",
+			owner:       "kava1abcde",
+			sell:        validMsg.Sell,
+			minBuy:      validMsg.MinBuy,
+			expectedErr: "invalid owner address: decoding bech32 failed: invalid separator index 4: invalid address",
+		},
+		{
+			name:        "zero sell",
+			owner:       validMsg.Owner,
+			sell:        sdk.Coin{Denom: "ukava", Amount: sdkmath.NewInt(0)},
+			minBuy:      validMsg.MinBuy,
+			expectedErr: "sell amount 0ukava: invalid coins",
+		},
+		{
+			name:        "invalid sell denom",
+			owner:       validMsg.Owner,
+			sell:        sdk.Coin{Denom: "UKAVA!", Amount: sdkmath.NewInt(1e6)},
+			minBuy:      validMsg.MinBuy,
+			expectedErr: "sell amount 1000000UKAVA!: invalid coins",
+		},
+		{
+			name:        "zero min buy",
+			owner:       validMsg.Owner,
+			sell:        validMsg.Sell,
+			minBuy:      sdk.Coin{Denom: "usdx", Amount: sdkmath.NewInt(0)},
+			expectedErr: "min buy amount 0usdx: invalid coins",
+		},
+		{
+			name:        "equal denoms",
+			owner:       validMsg.Owner,
+			sell:        validMsg.Sell,
+			minBuy:      sdk.NewCoin("ukava", sdkmath.NewInt(2e6)),
+			expectedErr: "denominations can not be equal: invalid coins",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgPlaceLimitOrder(tc.owner, tc.sell, tc.minBuy)
+			err := msg.ValidateBasic()
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestMsgCancelLimitOrder_Attributes(t *testing.T) {
+	msg := types.MsgCancelLimitOrder{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_cancel_limit_order", msg.Type())
+}
+
+func TestMsgCancelLimitOrder_Signing(t *testing.T) {
+	signData := `{"type":"swap/MsgCancelLimitOrder","value":{"order_id":"1","owner":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d"}}`
+	signBytes := []byte(signData)
+
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	msg := types.NewMsgCancelLimitOrder(addr.String(), 1)
+	assert.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+}
+
+func TestMsgCancelLimitOrder_Validation(t *testing.T) {
+	validMsg := types.NewMsgCancelLimitOrder(sdk.AccAddress("test1").String(), 1)
+	require.NoError(t, validMsg.ValidateBasic())
+
+	testCases := []struct {
+		name        string
+		owner       string
+		orderID     uint64
+		expectedErr string
+	}{
+		{
+			name:        "empty address",
+			owner:       sdk.AccAddress("").String(),
+			orderID:     validMsg.OrderId,
+			expectedErr: "owner address cannot be empty: invalid address",
+		},
+		{
+			name:        "invalid address",
+			owner:       "kava1abcde",
+			orderID:     validMsg.OrderId,
+			expectedErr: "invalid owner address: decoding bech32 failed: invalid separator index 4: invalid address",
+		},
+		{
+			name:        "zero order id",
+			owner:       validMsg.Owner,
+			orderID:     0,
+			expectedErr: "order id must be positive: limit order not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgCancelLimitOrder(tc.owner, tc.orderID)
+			err := msg.ValidateBasic()
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestMsgTransferShares_Attributes(t *testing.T) {
+	msg := types.MsgTransferShares{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_transfer_shares", msg.Type())
+}
+
+func TestMsgTransferShares_Signing(t *testing.T) {
+	signData := `{"type":"swap/MsgTransferShares","value":{"pool_id":"ukava:usdx","recipient":"kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w","sender":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d","shares":"1500000"}}`
+	signBytes := []byte(signData)
+
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	msg := types.NewMsgTransferShares(addr.String(), "kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w", "ukava:usdx", sdkmath.NewInt(1500000))
+	assert.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+}
+
+func TestMsgTransferShares_Validation(t *testing.T) {
+	validMsg := types.NewMsgTransferShares(
+		sdk.AccAddress("test1").String(),
+		sdk.AccAddress("test2").String(),
+		"ukava:usdx",
+		sdkmath.NewInt(1500000),
+	)
+	require.NoError(t, validMsg.ValidateBasic())
+
+	testCases := []struct {
+		name        string
+		sender      string
+		recipient   string
+		poolID      string
+		shares      sdkmath.Int
+		expectedErr string
+	}{
+		{
+			name:        "empty sender",
+			sender:      sdk.AccAddress("").String(),
+			recipient:   validMsg.Recipient,
+			poolID:      validMsg.PoolID,
+			shares:      validMsg.Shares,
+			expectedErr: "sender address cannot be empty: invalid address",
+		},
+		{
+			name:        "invalid sender",
+			sender:      "kava1abcde",
+			recipient:   validMsg.Recipient,
+			poolID:      validMsg.PoolID,
+			shares:      validMsg.Shares,
+			expectedErr: "invalid sender address: decoding bech32 failed: invalid separator index 4: invalid address",
+		},
+		{
+			name:        "empty recipient",
+			sender:      validMsg.Sender,
+			recipient:   sdk.AccAddress("").String(),
+			poolID:      validMsg.PoolID,
+			shares:      validMsg.Shares,
+			expectedErr: "recipient address cannot be empty: invalid address",
+		},
+		{
+			name:        "invalid recipient",
+			sender:      validMsg.Sender,
+			recipient:   "kava1abcde",
+			poolID:      validMsg.PoolID,
+			shares:      validMsg.Shares,
+			expectedErr: "invalid recipient address: decoding bech32 failed: invalid separator index 4: invalid address",
+		},
+		{
+			name:        "equal sender and recipient",
+			sender:      validMsg.Sender,
+			recipient:   validMsg.Sender,
+			poolID:      validMsg.PoolID,
+			shares:      validMsg.Shares,
+			expectedErr: "sender and recipient can not be equal: invalid address",
+		},
+		{
+			name:        "unsorted pool id",
+			sender:      validMsg.Sender,
+			recipient:   validMsg.Recipient,
+			poolID:      "usdx:ukava",
+			shares:      validMsg.Shares,
+			expectedErr: "poolID 'usdx:ukava' is invalid: invalid pool",
+		},
+		{
+			name:        "nil shares",
+			sender:      validMsg.Sender,
+			recipient:   validMsg.Recipient,
+			poolID:      validMsg.PoolID,
+			shares:      sdkmath.Int{},
+			expectedErr: "shares must be set: invalid shares",
+		},
+		{
+			name:        "zero shares",
+			sender:      validMsg.Sender,
+			recipient:   validMsg.Recipient,
+			poolID:      validMsg.PoolID,
+			shares:      sdkmath.ZeroInt(),
+			expectedErr: "0: invalid shares",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgTransferShares(tc.sender, tc.recipient, tc.poolID, tc.shares)
+			err := msg.ValidateBasic()
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestMsgWrapShares_Attributes(t *testing.T) {
+	msg := types.MsgWrapShares{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_wrap_shares", msg.Type())
+}
+
+func TestMsgWrapShares_Signing(t *testing.T) {
+	signData := `{"type":"swap/MsgWrapShares","value":{"owner":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d","pool_id":"ukava:usdx","shares":"1500000"}}`
+	signBytes := []byte(signData)
+
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	msg := types.NewMsgWrapShares(addr.String(), "ukava:usdx", sdkmath.NewInt(1500000))
+	assert.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+}
+
+func TestMsgWrapShares_Validation(t *testing.T) {
+	validMsg := types.NewMsgWrapShares(sdk.AccAddress("test1").String(), "ukava:usdx", sdkmath.NewInt(1500000))
+	require.NoError(t, validMsg.ValidateBasic())
+
+	testCases := []struct {
+		name        string
+		owner       string
+		poolID      string
+		shares      sdkmath.Int
+		expectedErr string
+	}{
+		{
+			name:        "empty address",
+			owner:       sdk.AccAddress("").String(),
+			poolID:      validMsg.PoolID,
+			shares:      validMsg.Shares,
+			expectedErr: "owner address cannot be empty: invalid address",
+		},
+		{
+			name:        "invalid address",
+			owner:       "kava1abcde",
+			poolID:      validMsg.PoolID,
+			shares:      validMsg.Shares,
+			expectedErr: "invalid owner address: decoding bech32 failed: invalid separator index 4: invalid address",
+		},
+		{
+			name:        "empty pool id",
+			owner:       validMsg.Owner,
+			poolID:      "",
+			shares:      validMsg.Shares,
+			expectedErr: "poolID must be set: invalid pool",
+		},
+		{
+			name:        "negative shares",
+			owner:       validMsg.Owner,
+			poolID:      validMsg.PoolID,
+			shares:      sdkmath.NewInt(-1),
+			expectedErr: "-1: invalid shares",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgWrapShares(tc.owner, tc.poolID, tc.shares)
+			err := msg.ValidateBasic()
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestMsgUnwrapShares_Attributes(t *testing.T) {
+	msg := types.MsgUnwrapShares{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_unwrap_shares", msg.Type())
+}
+
+func TestMsgUnwrapShares_Signing(t *testing.T) {
+	signData := `{"type":"swap/MsgUnwrapShares","value":{"amount":{"amount":"1500000","denom":"swp-ukava:usdx"},"owner":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d"}}`
+	signBytes := []byte(signData)
+
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	msg := types.NewMsgUnwrapShares(addr.String(), sdk.NewCoin("swp-ukava:usdx", sdkmath.NewInt(1500000)))
+	assert.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+}
+
+func TestMsgUnwrapShares_Validation(t *testing.T) {
+	validMsg := types.NewMsgUnwrapShares(sdk.AccAddress("test1").String(), sdk.NewCoin("swp-ukava:usdx", sdkmath.NewInt(1500000)))
+	require.NoError(t, validMsg.ValidateBasic())
+
+	testCases := []struct {
+		name        string
+		owner       string
+		amount      sdk.Coin
+		expectedErr string
+	}{
+		{
+			name:        "empty address",
+			owner:       sdk.AccAddress("").String(),
+			amount:      validMsg.Amount,
+			expectedErr: "owner address cannot be empty: invalid address",
+		},
+		{
+			name:        "invalid address",
+			owner:       "kava1abcde",
+			amount:      validMsg.Amount,
+			expectedErr: "invalid owner address: decoding bech32 failed: invalid separator index 4: invalid address",
+		},
+		{
+			name:        "zero amount",
+			owner:       validMsg.Owner,
+			amount:      sdk.Coin{Denom: "swp-ukava:usdx", Amount: sdkmath.ZeroInt()},
+			expectedErr: "unwrap amount 0swp-ukava:usdx: invalid coins",
+		},
+		{
+			name:        "not a share denom",
+			owner:       validMsg.Owner,
+			amount:      sdk.NewCoin("ukava", sdkmath.NewInt(1500000)),
+			expectedErr: "invalid denom prefix, expected swp-, got ukava: invalid coins",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgUnwrapShares(tc.owner, tc.amount)
+			err := msg.ValidateBasic()
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -10,18 +11,21 @@ import (
 
 // Parameter keys and default values
 var (
-	KeyAllowedPools     = []byte("AllowedPools")
-	KeySwapFee          = []byte("SwapFee")
-	DefaultAllowedPools = AllowedPools{}
-	DefaultSwapFee      = sdk.ZeroDec()
-	MaxSwapFee          = sdk.OneDec()
+	KeyAllowedPools             = []byte("AllowedPools")
+	KeySwapFee                  = []byte("SwapFee")
+	KeyTwapMaxWindowSeconds     = []byte("TwapMaxWindowSeconds")
+	DefaultAllowedPools         = AllowedPools{}
+	DefaultSwapFee              = sdk.ZeroDec()
+	DefaultTwapMaxWindowSeconds = uint64(time.Hour.Seconds())
+	MaxSwapFee                  = sdk.OneDec()
 )
 
 // NewParams returns a new params object
-func NewParams(pairs AllowedPools, swapFee sdk.Dec) Params {
+func NewParams(pairs AllowedPools, swapFee sdk.Dec, twapMaxWindowSeconds uint64) Params {
 	return Params{
-		AllowedPools: pairs,
-		SwapFee:      swapFee,
+		AllowedPools:         pairs,
+		SwapFee:              swapFee,
+		TwapMaxWindowSeconds: twapMaxWindowSeconds,
 	}
 }
 
@@ -30,6 +34,7 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultAllowedPools,
 		DefaultSwapFee,
+		DefaultTwapMaxWindowSeconds,
 	)
 }
 
@@ -37,8 +42,9 @@ func DefaultParams() Params {
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
 	AllowedPools: %s
-	SwapFee: %s`,
-		p.AllowedPools, p.SwapFee)
+	SwapFee: %s
+	TwapMaxWindowSeconds: %d`,
+		p.AllowedPools, p.SwapFee, p.TwapMaxWindowSeconds)
 }
 
 // ParamKeyTable for swap module.
@@ -51,6 +57,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyAllowedPools, &p.AllowedPools, validateAllowedPoolsParams),
 		paramtypes.NewParamSetPair(KeySwapFee, &p.SwapFee, validateSwapFee),
+		paramtypes.NewParamSetPair(KeyTwapMaxWindowSeconds, &p.TwapMaxWindowSeconds, validateTwapMaxWindowSeconds),
 	}
 }
 
@@ -60,7 +67,11 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateSwapFee(p.SwapFee)
+	if err := validateSwapFee(p.SwapFee); err != nil {
+		return err
+	}
+
+	return validateTwapMaxWindowSeconds(p.TwapMaxWindowSeconds)
 }
 
 func validateAllowedPoolsParams(i interface{}) error {
@@ -85,6 +96,43 @@ func validateSwapFee(i interface{}) error {
 	return nil
 }
 
+func validateTwapMaxWindowSeconds(i interface{}) error {
+	twapMaxWindowSeconds, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if twapMaxWindowSeconds == 0 {
+		return fmt.Errorf("twap max window seconds must be positive")
+	}
+
+	return nil
+}
+
+// AllowedPool returns the allowed pool with a pool id, and false if the pool is not allowed
+func (p Params) AllowedPool(poolID string) (AllowedPool, bool) {
+	for _, allowedPool := range p.AllowedPools {
+		if allowedPool.Name() == poolID {
+			return allowedPool, true
+		}
+	}
+	return AllowedPool{}, false
+}
+
+// PoolSwapFee returns the swap fee configured for a pool, which is the swap fee of its allowed pool if set and
+// the module swap fee otherwise
+func (p Params) PoolSwapFee(poolID string) sdk.Dec {
+	if allowedPool, found := p.AllowedPool(poolID); found && allowedPool.SwapFee != nil {
+		return *allowedPool.SwapFee
+	}
+	return p.SwapFee
+}
+
+// TwapMaxWindow returns the longest window pool time weighted average prices can be calculated over
+func (p Params) TwapMaxWindow() time.Duration {
+	return time.Duration(p.TwapMaxWindowSeconds) * time.Second
+}
+
 // NewAllowedPool returns a new AllowedPool object
 func NewAllowedPool(tokenA, tokenB string) AllowedPool {
 	return AllowedPool{
@@ -93,6 +141,15 @@ func NewAllowedPool(tokenA, tokenB string) AllowedPool {
 	}
 }
 
+// NewAllowedPoolWithSwapFee returns a new AllowedPool object that overrides the module swap fee
+func NewAllowedPoolWithSwapFee(tokenA, tokenB string, swapFee sdk.Dec) AllowedPool {
+	return AllowedPool{
+		TokenA:  tokenA,
+		TokenB:  tokenB,
+		SwapFee: &swapFee,
+	}
+}
+
 // Validate validates allowedPool attributes and returns an error if invalid
 func (p AllowedPool) Validate() error {
 	err := sdk.ValidateDenom(p.TokenA)
@@ -129,6 +186,33 @@ func (p AllowedPool) Validate() error {
 		)
 	}
 
+	if err := validateAmplification(p.Amplification); err != nil {
+		return err
+	}
+
+	if p.SwapFee != nil {
+		return validateSwapFee(*p.SwapFee)
+	}
+
+	return nil
+}
+
+// NewAllowedStableSwapPool returns a new AllowedPool object for a stable swap pool
+func NewAllowedStableSwapPool(tokenA, tokenB string, amplification uint64) AllowedPool {
+	return AllowedPool{
+		TokenA:        tokenA,
+		TokenB:        tokenB,
+		Amplification: amplification,
+	}
+}
+
+// validateAmplification checks the amplification coefficient is within the allowed range, where zero is
+// a constant product pool
+func validateAmplification(amplification uint64) error {
+	if amplification > MaxAmplification {
+		return fmt.Errorf("invalid amplification %d: must be at most %d", amplification, MaxAmplification)
+	}
+
 	return nil
 }
 
@@ -139,11 +223,21 @@ func (p AllowedPool) Name() string {
 
 // String pretty prints the allowedPool
 func (p AllowedPool) String() string {
-	return fmt.Sprintf(`AllowedPool:
+	out := fmt.Sprintf(`AllowedPool:
   Name: %s
 	Token A: %s
 	Token B: %s
 `, p.Name(), p.TokenA, p.TokenB)
+
+	if p.SwapFee != nil {
+		out += fmt.Sprintf("\tSwap Fee: %s\n", p.SwapFee)
+	}
+
+	if p.Amplification > 0 {
+		out += fmt.Sprintf("\tAmplification: %d\n", p.Amplification)
+	}
+
+	return out
 }
 
 // AllowedPools is a slice of AllowedPool
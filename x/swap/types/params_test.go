@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/kava-labs/kava/x/swap/types"
 
@@ -52,8 +53,9 @@ func TestParams_MarshalYAML(t *testing.T) {
 	require.NoError(t, err)
 
 	p := types.Params{
-		AllowedPools: pools,
-		SwapFee:      fee,
+		AllowedPools:         pools,
+		SwapFee:              fee,
+		TwapMaxWindowSeconds: types.DefaultTwapMaxWindowSeconds,
 	}
 
 	data, err := yaml.Marshal(p)
@@ -79,6 +81,8 @@ func TestParams_Default(t *testing.T) {
 
 	assert.Equal(t, 0, len(defaultParams.AllowedPools))
 	assert.Equal(t, sdk.ZeroDec(), defaultParams.SwapFee)
+	assert.Equal(t, types.DefaultTwapMaxWindowSeconds, defaultParams.TwapMaxWindowSeconds)
+	assert.Equal(t, time.Hour, defaultParams.TwapMaxWindow())
 }
 
 func TestParams_ParamSetPairs_AllowedPools(t *testing.T) {
@@ -123,6 +127,28 @@ func TestParams_ParamSetPairs_SwapFee(t *testing.T) {
 	assert.EqualError(t, paramSetPair.ValidatorFn(struct{}{}), "invalid parameter type: struct {}")
 }
 
+func TestParams_ParamSetPairs_TwapMaxWindowSeconds(t *testing.T) {
+	assert.Equal(t, []byte("TwapMaxWindowSeconds"), types.KeyTwapMaxWindowSeconds)
+	defaultParams := types.DefaultParams()
+
+	var paramSetPair *paramstypes.ParamSetPair
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if bytes.Equal(pair.Key, types.KeyTwapMaxWindowSeconds) {
+			paramSetPair = &pair
+			break
+		}
+	}
+	require.NotNil(t, paramSetPair)
+
+	twapMaxWindowSeconds, ok := paramSetPair.Value.(*uint64)
+	require.True(t, ok)
+	assert.Equal(t, twapMaxWindowSeconds, &defaultParams.TwapMaxWindowSeconds)
+
+	assert.Nil(t, paramSetPair.ValidatorFn(*twapMaxWindowSeconds))
+	assert.EqualError(t, paramSetPair.ValidatorFn(uint64(0)), "twap max window seconds must be positive")
+	assert.EqualError(t, paramSetPair.ValidatorFn(struct{}{}), "invalid parameter type: struct {}")
+}
+
 func TestParams_Validation(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -216,6 +242,7 @@ func TestParams_String(t *testing.T) {
 			types.NewAllowedPool("ukava", "usdx"),
 		),
 		sdk.MustNewDecFromStr("0.5"),
+		3600,
 	)
 
 	require.NoError(t, params.Validate())
@@ -224,6 +251,7 @@ func TestParams_String(t *testing.T) {
 	assert.Contains(t, output, types.PoolID("hard", "ukava"))
 	assert.Contains(t, output, types.PoolID("ukava", "usdx"))
 	assert.Contains(t, output, "0.5")
+	assert.Contains(t, output, "3600")
 }
 
 func TestAllowedPool_Validation(t *testing.T) {
@@ -277,6 +305,21 @@ func TestAllowedPool_Validation(t *testing.T) {
 			allowedPool: types.NewAllowedPool("ukava", "u:kava"),
 			expectedErr: "tokenB cannot have colons in the denom: u:kava",
 		},
+		{
+			name:        "negative swap fee",
+			allowedPool: types.NewAllowedPoolWithSwapFee("ukava", "usdx", sdk.MustNewDecFromStr("-0.01")),
+			expectedErr: "invalid swap fee: -0.010000000000000000",
+		},
+		{
+			name:        "swap fee equal to max",
+			allowedPool: types.NewAllowedPoolWithSwapFee("ukava", "usdx", types.MaxSwapFee),
+			expectedErr: "invalid swap fee: 1.000000000000000000",
+		},
+		{
+			name:        "amplification above max",
+			allowedPool: types.NewAllowedStableSwapPool("ukava", "usdx", types.MaxAmplification+1),
+			expectedErr: "invalid amplification 10001: must be at most 10000",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -311,6 +354,65 @@ func TestAllowedPool_String(t *testing.T) {
 	Token B: ukava
 `
 	assert.Equal(t, output, allowedPool.String())
+
+	allowedPool = types.NewAllowedPoolWithSwapFee("hard", "ukava", sdk.MustNewDecFromStr("0.003"))
+	require.NoError(t, allowedPool.Validate())
+
+	output = `AllowedPool:
+  Name: hard:ukava
+	Token A: hard
+	Token B: ukava
+	Swap Fee: 0.003000000000000000
+`
+	assert.Equal(t, output, allowedPool.String())
+
+	allowedPool = types.NewAllowedStableSwapPool("busd", "usdx", 100)
+	require.NoError(t, allowedPool.Validate())
+
+	output = `AllowedPool:
+  Name: busd:usdx
+	Token A: busd
+	Token B: usdx
+	Amplification: 100
+`
+	assert.Equal(t, output, allowedPool.String())
+}
+
+func TestParams_AllowedPool(t *testing.T) {
+	params := types.NewParams(
+		types.NewAllowedPools(
+			types.NewAllowedPool("ukava", "usdx"),
+			types.NewAllowedStableSwapPool("busd", "usdx", 100),
+		),
+		types.DefaultSwapFee,
+		types.DefaultTwapMaxWindowSeconds,
+	)
+
+	allowedPool, found := params.AllowedPool("busd:usdx")
+	require.True(t, found)
+	assert.Equal(t, uint64(100), allowedPool.Amplification)
+
+	allowedPool, found = params.AllowedPool("ukava:usdx")
+	require.True(t, found)
+	assert.Equal(t, uint64(0), allowedPool.Amplification)
+
+	_, found = params.AllowedPool("hard:usdx")
+	assert.False(t, found)
+}
+
+func TestParams_PoolSwapFee(t *testing.T) {
+	params := types.NewParams(
+		types.NewAllowedPools(
+			types.NewAllowedPool("ukava", "usdx"),
+			types.NewAllowedPoolWithSwapFee("hard", "usdx", sdk.MustNewDecFromStr("0.01")),
+		),
+		sdk.MustNewDecFromStr("0.003"),
+		types.DefaultTwapMaxWindowSeconds,
+	)
+
+	assert.Equal(t, sdk.MustNewDecFromStr("0.003"), params.PoolSwapFee("ukava:usdx"))
+	assert.Equal(t, sdk.MustNewDecFromStr("0.01"), params.PoolSwapFee("hard:usdx"))
+	assert.Equal(t, sdk.MustNewDecFromStr("0.003"), params.PoolSwapFee("busd:usdx"))
 }
 
 func TestAllowedPool_Name(t *testing.T) {
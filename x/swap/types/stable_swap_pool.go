@@ -0,0 +1,345 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxAmplification is the largest amplification coefficient of a stable swap pool
+const MaxAmplification = uint64(10_000)
+
+// stableSwapMaxIterations bounds the newton iterations used to solve the stable swap invariant
+const stableSwapMaxIterations = 255
+
+// StableSwapPool implements a unitless stable swap liquidity pool for tokens of similar value.
+//
+// The pool trades with the two token stable swap invariant
+//
+//	Ann*(x+y) + D = Ann*D + D^3/(4*x*y)
+//
+// where Ann is twice the amplification coefficient and D is the total value of the reserves when balanced.
+// Close to balanced reserves trades have a price near 1, approaching constant product pricing as the reserves
+// become imbalanced.  Higher amplification keeps the price near 1 over a wider range of reserves.
+//
+// Liquidity is added and removed in proportion to the reserves, as in a constant product pool, so only the
+// initial shares and swaps use the stable swap invariant.
+type StableSwapPool struct {
+	*BasePool
+	amplification uint64
+}
+
+// NewStableSwapPool returns a pointer to a stable swap pool with reserves and total shares initialized
+func NewStableSwapPool(reservesA, reservesB sdkmath.Int, amplification uint64) (*StableSwapPool, error) {
+	if amplification == 0 || amplification > MaxAmplification {
+		return nil, errorsmod.Wrapf(ErrInvalidPool, "amplification must be between 1 and %d", MaxAmplification)
+	}
+
+	if reservesA.LTE(zero) || reservesB.LTE(zero) {
+		return nil, errorsmod.Wrap(ErrInvalidPool, "reserves must be greater than zero")
+	}
+
+	totalShares := calculateStableSwapInvariant(reservesA.BigInt(), reservesB.BigInt(), amplification)
+
+	return &StableSwapPool{
+		BasePool: &BasePool{
+			reservesA:   reservesA,
+			reservesB:   reservesB,
+			totalShares: sdkmath.NewIntFromBigInt(totalShares),
+		},
+		amplification: amplification,
+	}, nil
+}
+
+// NewStableSwapPoolWithExistingShares returns a pointer to a stable swap pool with existing shares
+func NewStableSwapPoolWithExistingShares(reservesA, reservesB, totalShares sdkmath.Int, amplification uint64) (*StableSwapPool, error) {
+	if amplification == 0 || amplification > MaxAmplification {
+		return nil, errorsmod.Wrapf(ErrInvalidPool, "amplification must be between 1 and %d", MaxAmplification)
+	}
+
+	pool, err := NewBasePoolWithExistingShares(reservesA, reservesB, totalShares)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StableSwapPool{
+		BasePool:      pool,
+		amplification: amplification,
+	}, nil
+}
+
+// Amplification returns the amplification coefficient of the pool
+func (p *StableSwapPool) Amplification() uint64 {
+	return p.amplification
+}
+
+// AddLiquidity adds liquidity to the pool in proportion to the reserves.  If the pool is empty, it is
+// reinitialized and the initial shares are the stable swap invariant of the deposit.
+func (p *StableSwapPool) AddLiquidity(desiredA sdkmath.Int, desiredB sdkmath.Int) (sdkmath.Int, sdkmath.Int, sdkmath.Int) {
+	if !p.IsEmpty() {
+		return p.BasePool.AddLiquidity(desiredA, desiredB)
+	}
+
+	// Panics if provided values are zero
+	p.assertDepositsArePositive(desiredA, desiredB)
+
+	p.reservesA = desiredA
+	p.reservesB = desiredB
+	p.totalShares = sdkmath.NewIntFromBigInt(calculateStableSwapInvariant(desiredA.BigInt(), desiredB.BigInt(), p.amplification))
+
+	return p.ReservesA(), p.ReservesB(), p.TotalShares()
+}
+
+// SwapExactAForB trades an exact value of a for b.  Returns the positive amount b
+// that is removed from the pool and the portion of a that is used for paying the fee.
+func (p *StableSwapPool) SwapExactAForB(a sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	b, feeValue := p.calculateOutputForExactInput(a, p.reservesA, p.reservesB, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Add(a), feeValue, p.reservesB.Sub(b), sdk.ZeroInt(),
+	)
+
+	return b, feeValue
+}
+
+// SwapExactBForA trades an exact value of b for a.  Returns the positive amount a
+// that is removed from the pool and the portion of b that is used for paying the fee.
+func (p *StableSwapPool) SwapExactBForA(b sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	a, feeValue := p.calculateOutputForExactInput(b, p.reservesB, p.reservesA, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Sub(a), sdk.ZeroInt(), p.reservesB.Add(b), feeValue,
+	)
+
+	return a, feeValue
+}
+
+// calculateOutputForExactInput calculates the output amount of a swap using a fixed input, returning this amount in
+// addition to the amount of input that is used to pay the fee.
+//
+// The output is the decrease in the out reserves that keeps the invariant of the reserves after the input less
+// fees is added.  It is reduced by one to ensure the approximated invariant can never decrease.
+func (p *StableSwapPool) calculateOutputForExactInput(in, inReserves, outReserves sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	p.assertSwapInputIsValid(in)
+	p.assertFeeIsValid(fee)
+
+	inAfterFee := sdk.NewDecFromInt(in).Mul(sdk.OneDec().Sub(fee)).TruncateInt()
+
+	invariant := calculateStableSwapInvariant(inReserves.BigInt(), outReserves.BigInt(), p.amplification)
+	newOutReserves := calculateStableSwapReserves(inReserves.Add(inAfterFee).BigInt(), invariant, p.amplification)
+
+	var result big.Int
+	result.Sub(outReserves.BigInt(), newOutReserves).Sub(&result, big.NewInt(1))
+
+	out := sdkmath.NewIntFromBigInt(&result)
+	if out.IsNegative() {
+		out = sdk.ZeroInt()
+	}
+	feeValue := in.Sub(inAfterFee)
+
+	return out, feeValue
+}
+
+// SwapAForExactB trades a for an exact b.  Returns the positive amount a
+// that is added to the pool, and the portion of a that is used to pay the fee.
+func (p *StableSwapPool) SwapAForExactB(b sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	a, feeValue := p.calculateInputForExactOutput(b, p.reservesB, p.reservesA, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Add(a), feeValue, p.reservesB.Sub(b), sdk.ZeroInt(),
+	)
+
+	return a, feeValue
+}
+
+// SwapBForExactA trades b for an exact a.  Returns the positive amount b
+// that is added to the pool, and the portion of b that is used to pay the fee.
+func (p *StableSwapPool) SwapBForExactA(a sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	b, feeValue := p.calculateInputForExactOutput(a, p.reservesA, p.reservesB, fee)
+
+	p.assertInvariantAndUpdateReserves(
+		p.reservesA.Sub(a), sdk.ZeroInt(), p.reservesB.Add(b), feeValue,
+	)
+
+	return b, feeValue
+}
+
+// calculateInputForExactOutput calculates the input amount of a swap using a fixed output, returning this amount in
+// addition to the amount of input that is used to pay the fee.
+//
+// The input before fees is the increase in the in reserves that keeps the invariant of the reserves after the
+// output is removed.  It is increased by one to ensure the approximated invariant can never decrease.
+func (p *StableSwapPool) calculateInputForExactOutput(out, outReserves, inReserves sdkmath.Int, fee sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	p.assertSwapOutputIsValid(out, outReserves)
+	p.assertFeeIsValid(fee)
+
+	invariant := calculateStableSwapInvariant(inReserves.BigInt(), outReserves.BigInt(), p.amplification)
+	newInReserves := calculateStableSwapReserves(outReserves.Sub(out).BigInt(), invariant, p.amplification)
+
+	var result big.Int
+	result.Sub(newInReserves, inReserves.BigInt()).Add(&result, big.NewInt(1))
+
+	inWithoutFee := sdkmath.NewIntFromBigInt(&result)
+	if !inWithoutFee.IsPositive() {
+		inWithoutFee = sdk.OneInt()
+	}
+
+	in := sdk.NewDecFromInt(inWithoutFee).Quo(sdk.OneDec().Sub(fee)).Ceil().TruncateInt()
+	feeValue := in.Sub(inWithoutFee)
+
+	return in, feeValue
+}
+
+// PriceA returns the marginal price of a in b, the negative slope of the invariant curve at the reserves
+func (p *StableSwapPool) PriceA() sdk.Dec {
+	return p.marginalPrice(p.reservesA, p.reservesB)
+}
+
+// PriceB returns the marginal price of b in a, the negative slope of the invariant curve at the reserves
+func (p *StableSwapPool) PriceB() sdk.Dec {
+	return p.marginalPrice(p.reservesB, p.reservesA)
+}
+
+// marginalPrice returns the price of x in y from the partial derivatives of the invariant, which simplify to
+//
+//	(Ann + 2*rx^2*ry) / (Ann + 2*rx*ry^2)
+//
+// where rx = D/(2x) and ry = D/(2y) are close to one for balanced reserves
+func (p *StableSwapPool) marginalPrice(x, y sdkmath.Int) sdk.Dec {
+	invariant := sdk.NewDecFromBigInt(calculateStableSwapInvariant(x.BigInt(), y.BigInt(), p.amplification))
+	rx := invariant.Quo(sdk.NewDecFromInt(x.MulRaw(2)))
+	ry := invariant.Quo(sdk.NewDecFromInt(y.MulRaw(2)))
+	ann := sdk.NewDecFromInt(sdkmath.NewIntFromUint64(p.amplification).MulRaw(2))
+
+	numerator := ann.Add(rx.Mul(rx).Mul(ry).MulInt64(2))
+	denominator := ann.Add(rx.Mul(ry).Mul(ry).MulInt64(2))
+
+	return numerator.Quo(denominator)
+}
+
+// assertInvariantAndUpdateReserves asserts the stable swap invariant is not violated, subtracting
+// any fees first, then updates the pool reserves.  Panics if invariant is violated.
+//
+// Since the invariant is approximated, it is not recalculated for the new reserves.  Instead, the new reserves are
+// checked to be on or above the curve of the previous invariant, which is exact.
+func (p *StableSwapPool) assertInvariantAndUpdateReserves(newReservesA, feeA, newReservesB, feeB sdkmath.Int) {
+	invariant := calculateStableSwapInvariant(p.reservesA.BigInt(), p.reservesB.BigInt(), p.amplification)
+
+	if !isOnOrAboveStableSwapCurve(newReservesA.Sub(feeA).BigInt(), newReservesB.Sub(feeB).BigInt(), invariant, p.amplification) {
+		panic(fmt.Sprintf("invalid state: reserves %s and %s are below invariant %s", newReservesA.Sub(feeA), newReservesB.Sub(feeB), invariant))
+	}
+
+	p.reservesA = newReservesA
+	p.reservesB = newReservesB
+}
+
+// isOnOrAboveStableSwapCurve returns true if the invariant of reserves x and y is greater than or equal to D.
+// Since Ann*(x+y) + D - Ann*D - D^3/(4*x*y) decreases as D increases, this is true when
+//
+//	4*x*y*(Ann*(x+y) - (Ann-1)*D) >= D^3
+func isOnOrAboveStableSwapCurve(x, y, d *big.Int, amplification uint64) bool {
+	ann := new(big.Int).SetUint64(amplification)
+	ann.Lsh(ann, 1)
+
+	var lhs, term big.Int
+	lhs.Add(x, y).Mul(&lhs, ann)
+	term.Sub(ann, big.NewInt(1)).Mul(&term, d)
+	lhs.Sub(&lhs, &term)
+	lhs.Mul(&lhs, x).Mul(&lhs, y).Lsh(&lhs, 2)
+
+	var rhs big.Int
+	rhs.Mul(d, d).Mul(&rhs, d)
+
+	return lhs.Cmp(&rhs) >= 0
+}
+
+// calculateStableSwapInvariant solves the invariant D of reserves x and y with newton's method, starting from the
+// sum of the reserves.  Panics if the solution does not converge, which should not happen for positive reserves.
+func calculateStableSwapInvariant(x, y *big.Int, amplification uint64) *big.Int {
+	// order the reserves so truncation does not depend on the order of the pool tokens
+	if x.Cmp(y) > 0 {
+		x, y = y, x
+	}
+
+	var sum big.Int
+	sum.Add(x, y)
+	if sum.Sign() == 0 {
+		return new(big.Int)
+	}
+
+	ann := new(big.Int).SetUint64(amplification)
+	ann.Lsh(ann, 1)
+	two := big.NewInt(2)
+	three := big.NewInt(3)
+
+	d := new(big.Int).Set(&sum)
+	for i := 0; i < stableSwapMaxIterations; i++ {
+		// dP = D^3/(4xy)
+		var dP, denominator big.Int
+		dP.Mul(d, d).Quo(&dP, denominator.Mul(x, two))
+		dP.Mul(&dP, d).Quo(&dP, denominator.Mul(y, two))
+
+		// D = (Ann*S + 2*dP) * D / ((Ann-1)*D + 3*dP)
+		var numerator, term big.Int
+		numerator.Mul(ann, &sum).Add(&numerator, term.Mul(&dP, two)).Mul(&numerator, d)
+		denominator.Sub(ann, big.NewInt(1)).Mul(&denominator, d).Add(&denominator, term.Mul(&dP, three))
+
+		prev := d
+		d = new(big.Int).Quo(&numerator, &denominator)
+
+		if withinOne(d, prev) {
+			// newton's method may overshoot by a unit; the reserves must always satisfy their own invariant
+			for !isOnOrAboveStableSwapCurve(x, y, d, amplification) {
+				d.Sub(d, big.NewInt(1))
+			}
+			return d
+		}
+	}
+
+	panic(fmt.Sprintf("stable swap invariant of reserves %s and %s did not converge", x, y))
+}
+
+// calculateStableSwapReserves solves the reserves y that keep the invariant D with reserves x, using newton's method
+// starting from D.  Panics if the solution does not converge, which should not happen for positive reserves.
+func calculateStableSwapReserves(x, d *big.Int, amplification uint64) *big.Int {
+	ann := new(big.Int).SetUint64(amplification)
+	ann.Lsh(ann, 1)
+	two := big.NewInt(2)
+
+	// c = D^3/(4*x*Ann), b = x + D/Ann
+	var c, b, denominator big.Int
+	c.Mul(d, d).Quo(&c, denominator.Mul(x, two))
+	c.Mul(&c, d).Quo(&c, denominator.Mul(ann, two))
+	b.Quo(d, ann).Add(&b, x)
+
+	y := new(big.Int).Set(d)
+	for i := 0; i < stableSwapMaxIterations; i++ {
+		// y = (y^2 + c) / (2y + b - D)
+		var numerator big.Int
+		numerator.Mul(y, y).Add(&numerator, &c)
+		denominator.Mul(y, two).Add(&denominator, &b).Sub(&denominator, d)
+
+		prev := y
+		y = new(big.Int).Quo(&numerator, &denominator)
+
+		if withinOne(y, prev) {
+			// truncation may leave y a few units below the curve; round up so swaps never reduce the invariant
+			for !isOnOrAboveStableSwapCurve(x, y, d, amplification) {
+				y.Add(y, big.NewInt(1))
+			}
+			return y
+		}
+	}
+
+	panic(fmt.Sprintf("stable swap reserves of reserves %s and invariant %s did not converge", x, d))
+}
+
+// withinOne returns true if a and b differ by at most one
+func withinOne(a, b *big.Int) bool {
+	var diff big.Int
+	diff.Sub(a, b).Abs(&diff)
+	return diff.Cmp(big.NewInt(1)) <= 0
+}
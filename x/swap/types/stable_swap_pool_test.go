@@ -0,0 +1,282 @@
+package types_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	types "github.com/kava-labs/kava/x/swap/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStableSwapPool_NewPool_Validation(t *testing.T) {
+	testCases := []struct {
+		reservesA     sdkmath.Int
+		reservesB     sdkmath.Int
+		amplification uint64
+		expectedErr   string
+	}{
+		{i(0), i(1e6), 100, "reserves must be greater than zero: invalid pool"},
+		{i(1e6), i(-1), 100, "reserves must be greater than zero: invalid pool"},
+		{i(1e6), i(1e6), 0, "amplification must be between 1 and 10000: invalid pool"},
+		{i(1e6), i(1e6), 10001, "amplification must be between 1 and 10000: invalid pool"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s amplification=%d", tc.reservesA, tc.reservesB, tc.amplification), func(t *testing.T) {
+			pool, err := types.NewStableSwapPool(tc.reservesA, tc.reservesB, tc.amplification)
+			require.EqualError(t, err, tc.expectedErr)
+			assert.Nil(t, pool)
+		})
+	}
+}
+
+func TestStableSwapPool_NewPoolWithExistingShares_Validation(t *testing.T) {
+	testCases := []struct {
+		reservesA     sdkmath.Int
+		reservesB     sdkmath.Int
+		totalShares   sdkmath.Int
+		amplification uint64
+		expectedErr   string
+	}{
+		{i(0), i(1e6), i(1), 100, "reserves must be greater than zero: invalid pool"},
+		{i(1e6), i(1e6), i(0), 100, "total shares must be greater than zero: invalid pool"},
+		{i(1e6), i(1e6), i(1e6), 0, "amplification must be between 1 and 10000: invalid pool"},
+		{i(1e6), i(1e6), i(1e6), 10001, "amplification must be between 1 and 10000: invalid pool"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s totalShares=%s amplification=%d", tc.reservesA, tc.reservesB, tc.totalShares, tc.amplification), func(t *testing.T) {
+			pool, err := types.NewStableSwapPoolWithExistingShares(tc.reservesA, tc.reservesB, tc.totalShares, tc.amplification)
+			require.EqualError(t, err, tc.expectedErr)
+			assert.Nil(t, pool)
+		})
+	}
+}
+
+func TestStableSwapPool_InitialState(t *testing.T) {
+	testCases := []struct {
+		reservesA      sdkmath.Int
+		reservesB      sdkmath.Int
+		amplification  uint64
+		expectedShares sdkmath.Int
+	}{
+		{i(1), i(1), 100, i(2)},
+		{i(1e6), i(1e6), 1, i(2e6)},
+		{i(1e9), i(1e9), 100, i(2e9)},
+		{exp(i(2), 200), exp(i(2), 200), 10000, exp(i(2), 201)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s amplification=%d", tc.reservesA, tc.reservesB, tc.amplification), func(t *testing.T) {
+			pool, err := types.NewStableSwapPool(tc.reservesA, tc.reservesB, tc.amplification)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.reservesA, pool.ReservesA())
+			assert.Equal(t, tc.reservesB, pool.ReservesB())
+			assert.Equal(t, tc.expectedShares, pool.TotalShares())
+			assert.Equal(t, tc.amplification, pool.Amplification())
+		})
+	}
+}
+
+func TestStableSwapPool_InitialShares_Imbalanced(t *testing.T) {
+	// the invariant of imbalanced reserves is between the geometric mean and the sum of the reserves,
+	// approaching the sum as the amplification increases
+	reservesA, reservesB := i(1e6), i(4e6)
+
+	lowAmp, err := types.NewStableSwapPool(reservesA, reservesB, 1)
+	require.NoError(t, err)
+	highAmp, err := types.NewStableSwapPool(reservesA, reservesB, 10000)
+	require.NoError(t, err)
+
+	assert.True(t, lowAmp.TotalShares().GT(i(4e6)), "expected shares to be greater than twice the geometric mean")
+	assert.True(t, highAmp.TotalShares().GT(lowAmp.TotalShares()), "expected shares to increase with amplification")
+	assert.True(t, highAmp.TotalShares().LTE(i(5e6)), "expected shares to be at most the sum of the reserves")
+
+	symmetric, err := types.NewStableSwapPool(reservesB, reservesA, 1)
+	require.NoError(t, err)
+	assert.Equal(t, lowAmp.TotalShares(), symmetric.TotalShares(), "expected shares to not depend on reserve order")
+}
+
+func TestStableSwapPool_EmptyAndRefill(t *testing.T) {
+	testCases := []struct {
+		reservesA sdkmath.Int
+		reservesB sdkmath.Int
+	}{
+		{i(1), i(1)},
+		{i(100), i(10000000)},
+		{i(1e15), i(7e15)},
+		{i(1.345678e18), i(4.313456e18)},
+		{exp(i(2), 200), exp(i(2), 200)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s", tc.reservesA, tc.reservesB), func(t *testing.T) {
+			pool, err := types.NewStableSwapPool(tc.reservesA, tc.reservesB, 100)
+			require.NoError(t, err)
+
+			initialShares := pool.TotalShares()
+			pool.RemoveLiquidity(initialShares)
+
+			assert.True(t, pool.IsEmpty())
+			assert.True(t, pool.TotalShares().IsZero(), "total shares are not depleted")
+
+			pool.AddLiquidity(tc.reservesA, tc.reservesB)
+			assert.Equal(t, initialShares, pool.TotalShares(), "total shares not equal")
+		})
+	}
+}
+
+func TestStableSwapPool_Swap_ExactInput(t *testing.T) {
+	testCases := []struct {
+		reservesA      sdkmath.Int
+		reservesB      sdkmath.Int
+		amplification  uint64
+		exactInput     sdkmath.Int
+		fee            sdk.Dec
+		expectedOutput sdkmath.Int
+		expectedFee    sdkmath.Int
+	}{
+		{i(1e9), i(1e9), 100, i(1e6), d("0.003"), i(996989), i(3000)},
+		{i(1e9), i(1e9), 100, i(1e6), d("0"), i(999989), i(0)},
+		{i(1e9), i(1e9), 1, i(1e6), d("0"), i(999499), i(0)},
+		{i(1e9), i(1e9), 10000, i(1e6), d("0"), i(999998), i(0)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s amplification=%d exactInput=%s fee=%s", tc.reservesA, tc.reservesB, tc.amplification, tc.exactInput, tc.fee), func(t *testing.T) {
+			poolA, err := types.NewStableSwapPool(tc.reservesA, tc.reservesB, tc.amplification)
+			require.NoError(t, err)
+			swapA, feeA := poolA.SwapExactAForB(tc.exactInput, tc.fee)
+
+			poolB, err := types.NewStableSwapPool(tc.reservesB, tc.reservesA, tc.amplification)
+			require.NoError(t, err)
+			swapB, feeB := poolB.SwapExactBForA(tc.exactInput, tc.fee)
+
+			// pool must be symmetric - if we swap reserves, then swap opposite direction
+			// then the results should be equal
+			require.Equal(t, swapA, swapB, "expected swap methods to have equal swap results")
+			require.Equal(t, feeA, feeB, "expected swap methods to have equal fee results")
+
+			assert.Equal(t, tc.expectedOutput, swapA, "returned swap not equal")
+			assert.True(t, tc.expectedFee.Equal(feeA), "returned fee %s not equal to %s", feeA, tc.expectedFee)
+
+			assert.Equal(t, tc.reservesA.Add(tc.exactInput), poolA.ReservesA(), "expected new reserves A not equal")
+			assert.Equal(t, tc.reservesB.Sub(tc.expectedOutput), poolA.ReservesB(), "expected new reserves B not equal")
+		})
+	}
+}
+
+func TestStableSwapPool_Swap_ExactOutput(t *testing.T) {
+	testCases := []struct {
+		reservesA     sdkmath.Int
+		reservesB     sdkmath.Int
+		amplification uint64
+		exactOutput   sdkmath.Int
+		fee           sdk.Dec
+		expectedInput sdkmath.Int
+		expectedFee   sdkmath.Int
+	}{
+		{i(1e9), i(1e9), 100, i(996989), d("0.003"), i(1e6), i(3000)},
+		{i(1e9), i(1e9), 100, i(999989), d("0"), i(1e6), i(0)},
+		{i(1e9), i(1e9), 1, i(999499), d("0"), i(1e6), i(0)},
+		{i(1e9), i(1e9), 10000, i(999998), d("0"), i(1e6), i(0)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s amplification=%d exactOutput=%s fee=%s", tc.reservesA, tc.reservesB, tc.amplification, tc.exactOutput, tc.fee), func(t *testing.T) {
+			poolA, err := types.NewStableSwapPool(tc.reservesA, tc.reservesB, tc.amplification)
+			require.NoError(t, err)
+			swapA, feeA := poolA.SwapAForExactB(tc.exactOutput, tc.fee)
+
+			poolB, err := types.NewStableSwapPool(tc.reservesB, tc.reservesA, tc.amplification)
+			require.NoError(t, err)
+			swapB, feeB := poolB.SwapBForExactA(tc.exactOutput, tc.fee)
+
+			// pool must be symmetric - if we swap reserves, then swap opposite direction
+			// then the results should be equal
+			require.Equal(t, swapA, swapB, "expected swap methods to have equal swap results")
+			require.Equal(t, feeA, feeB, "expected swap methods to have equal fee results")
+
+			assert.Equal(t, tc.expectedInput, swapA, "returned swap not equal")
+			assert.True(t, tc.expectedFee.Equal(feeA), "returned fee %s not equal to %s", feeA, tc.expectedFee)
+
+			assert.Equal(t, tc.reservesA.Add(tc.expectedInput), poolA.ReservesA(), "expected new reserves A not equal")
+			assert.Equal(t, tc.reservesB.Sub(tc.exactOutput), poolA.ReservesB(), "expected new reserves B not equal")
+		})
+	}
+}
+
+func TestStableSwapPool_Swap_BetterThanConstantProduct(t *testing.T) {
+	for _, amplification := range []uint64{1, 10, 100, 1000, 10000} {
+		t.Run(fmt.Sprintf("amplification=%d", amplification), func(t *testing.T) {
+			stablePool, err := types.NewStableSwapPool(i(1e12), i(1e12), amplification)
+			require.NoError(t, err)
+			basePool, err := types.NewBasePool(i(1e12), i(1e12))
+			require.NoError(t, err)
+
+			stableOutput, _ := stablePool.SwapExactAForB(i(1e11), d("0.003"))
+			baseOutput, _ := basePool.SwapExactAForB(i(1e11), d("0.003"))
+
+			assert.True(t, stableOutput.GT(baseOutput), "expected stable swap output %s to be greater than %s", stableOutput, baseOutput)
+		})
+	}
+}
+
+func TestStableSwapPool_Price(t *testing.T) {
+	balanced, err := types.NewStableSwapPool(i(1e12), i(1e12), 100)
+	require.NoError(t, err)
+	assert.Equal(t, d("1"), balanced.PriceA())
+	assert.Equal(t, d("1"), balanced.PriceB())
+
+	imbalanced, err := types.NewStableSwapPool(i(1e12), i(3e12), 100)
+	require.NoError(t, err)
+	assert.True(t, imbalanced.PriceA().GT(d("1")), "expected a to be more valuable than b")
+	assert.True(t, imbalanced.PriceA().LT(d("3")), "expected price to be below the constant product price")
+	assert.True(t, imbalanced.PriceB().LT(d("1")), "expected b to be less valuable than a")
+}
+
+func TestStableSwapPool_Swap_NeverPanics(t *testing.T) {
+	// swaps of random pools must never decrease the invariant, which panics
+	r := rand.New(rand.NewSource(1))
+	scales := []int64{1e3, 1e9, 1e15, 1e18}
+
+	for n := 0; n < 2000; n++ {
+		scale := scales[r.Intn(len(scales))]
+		reservesA := i(r.Int63n(scale) + 1)
+		reservesB := i(r.Int63n(scale) + 1)
+		amplification := uint64(r.Int63n(int64(types.MaxAmplification)) + 1)
+
+		pool, err := types.NewStableSwapPool(reservesA, reservesB, amplification)
+		require.NoError(t, err)
+
+		require.NotPanics(t, func() {
+			input := i(r.Int63n(pool.ReservesA().Int64()) + 1)
+			pool.SwapExactAForB(input, d("0.003"))
+
+			output := i(r.Int63n(pool.ReservesA().Int64()-1) + 1)
+			pool.SwapBForExactA(output, d("0.001"))
+
+			input = i(r.Int63n(pool.ReservesB().Int64()) + 1)
+			pool.SwapExactBForA(input, d("0"))
+
+			output = i(r.Int63n(pool.ReservesB().Int64()-1) + 1)
+			pool.SwapAForExactB(output, d("0"))
+		}, "reservesA=%s reservesB=%s amplification=%d", reservesA, reservesB, amplification)
+	}
+}
+
+func TestStableSwapPool_Panics_Swap(t *testing.T) {
+	pool, err := types.NewStableSwapPool(i(1e6), i(1e6), 100)
+	require.NoError(t, err)
+
+	assert.Panics(t, func() { pool.SwapExactAForB(i(0), d("0.003")) }, "expected panic for zero input")
+	assert.Panics(t, func() { pool.SwapExactBForA(i(1), d("1")) }, "expected panic for invalid fee")
+	assert.Panics(t, func() { pool.SwapAForExactB(i(1e6), d("0.003")) }, "expected panic for output equal to reserves")
+	assert.Panics(t, func() { pool.SwapBForExactA(i(0), d("0.003")) }, "expected panic for zero output")
+}
@@ -27,6 +27,48 @@ func PoolID(denomA string, denomB string) string {
 	return fmt.Sprintf("%s%s%s", denomA, PoolIDSep, denomB)
 }
 
+// ValidatePoolID returns an error if the pool id is not two valid, different and sorted denoms
+func ValidatePoolID(poolID string) error {
+	if poolID == "" {
+		return errors.New("poolID must be set")
+	}
+
+	tokens := strings.Split(poolID, PoolIDSep)
+	if len(tokens) != 2 || tokens[0] == "" || tokens[1] == "" || tokens[1] < tokens[0] || tokens[0] == tokens[1] {
+		return fmt.Errorf("poolID '%s' is invalid", poolID)
+	}
+	if sdk.ValidateDenom(tokens[0]) != nil || sdk.ValidateDenom(tokens[1]) != nil {
+		return fmt.Errorf("poolID '%s' is invalid", poolID)
+	}
+
+	return nil
+}
+
+// ShareDenomPrefix is the prefix of the denom of coins representing wrapped pool shares
+const ShareDenomPrefix = "swp"
+
+// ShareDenomSep represents the separator used in share denoms to separate the prefix from the pool id
+const ShareDenomSep = "-"
+
+// ShareDenom returns the denom of coins representing wrapped shares of a pool
+func ShareDenom(poolID string) string {
+	return fmt.Sprintf("%s%s%s", ShareDenomPrefix, ShareDenomSep, poolID)
+}
+
+// ParseShareDenom extracts the pool id from the denom of wrapped pool shares
+func ParseShareDenom(denom string) (string, error) {
+	poolID, found := strings.CutPrefix(denom, ShareDenomPrefix+ShareDenomSep)
+	if !found {
+		return "", fmt.Errorf("invalid denom prefix, expected %s%s, got %s", ShareDenomPrefix, ShareDenomSep, denom)
+	}
+
+	if err := ValidatePoolID(poolID); err != nil {
+		return "", err
+	}
+
+	return poolID, nil
+}
+
 // NewPoolRecord takes reserve coins and total shares, returning
 // a new pool record with a id
 func NewPoolRecord(reserves sdk.Coins, totalShares sdkmath.Int) PoolRecord {
@@ -51,10 +93,11 @@ func NewPoolRecordFromPool(pool *DenominatedPool) PoolRecord {
 	poolID := PoolIDFromCoins(reserves)
 
 	return PoolRecord{
-		PoolID:      poolID,
-		ReservesA:   reserves[0],
-		ReservesB:   reserves[1],
-		TotalShares: pool.TotalShares(),
+		PoolID:        poolID,
+		ReservesA:     reserves[0],
+		ReservesB:     reserves[1],
+		TotalShares:   pool.TotalShares(),
+		Amplification: pool.Amplification(),
 	}
 }
 
@@ -87,6 +130,10 @@ func (p PoolRecord) Validate() error {
 		return fmt.Errorf("pool '%s' has invalid total shares: %s", p.PoolID, p.TotalShares)
 	}
 
+	if err := validateAmplification(p.Amplification); err != nil {
+		return fmt.Errorf("pool '%s' has %s", p.PoolID, err)
+	}
+
 	return nil
 }
 
@@ -129,16 +176,8 @@ func NewShareRecord(depositor sdk.AccAddress, poolID string, sharesOwned sdkmath
 
 // Validate performs basic validation checks of the record data
 func (sr ShareRecord) Validate() error {
-	if sr.PoolID == "" {
-		return errors.New("poolID must be set")
-	}
-
-	tokens := strings.Split(sr.PoolID, PoolIDSep)
-	if len(tokens) != 2 || tokens[0] == "" || tokens[1] == "" || tokens[1] < tokens[0] || tokens[0] == tokens[1] {
-		return fmt.Errorf("poolID '%s' is invalid", sr.PoolID)
-	}
-	if sdk.ValidateDenom(tokens[0]) != nil || sdk.ValidateDenom(tokens[1]) != nil {
-		return fmt.Errorf("poolID '%s' is invalid", sr.PoolID)
+	if err := ValidatePoolID(sr.PoolID); err != nil {
+		return err
 	}
 
 	if sr.Depositor.Empty() {
@@ -40,6 +40,23 @@ func TestState_PoolID(t *testing.T) {
 	}
 }
 
+func TestState_ShareDenom(t *testing.T) {
+	assert.Equal(t, "swp-ukava:usdx", types.ShareDenom("ukava:usdx"))
+
+	poolID, err := types.ParseShareDenom("swp-ukava:usdx")
+	require.NoError(t, err)
+	assert.Equal(t, "ukava:usdx", poolID)
+
+	_, err = types.ParseShareDenom("ukava")
+	assert.EqualError(t, err, "invalid denom prefix, expected swp-, got ukava")
+
+	_, err = types.ParseShareDenom("swp-usdx:ukava")
+	assert.EqualError(t, err, "poolID 'usdx:ukava' is invalid")
+
+	_, err = types.ParseShareDenom("swp-ukava")
+	assert.EqualError(t, err, "poolID 'ukava' is invalid")
+}
+
 func TestState_NewPoolRecord(t *testing.T) {
 	reserves := sdk.NewCoins(usdx(50e6), ukava(10e6))
 	totalShares := sdkmath.NewInt(30e6)
@@ -97,7 +114,8 @@ func TestState_PoolRecord_JSONEncoding(t *testing.T) {
 }
 
 func TestState_PoolRecord_YamlEncoding(t *testing.T) {
-	expected := `pool_id: ukava:usdx
+	expected := `amplification: 0
+pool_id: ukava:usdx
 reserves_a:
   amount: "1000000"
   denom: ukava
@@ -262,6 +280,18 @@ func TestState_PoolRecord_Validations(t *testing.T) {
 	}
 }
 
+func TestState_PoolRecord_Amplification(t *testing.T) {
+	pool, err := types.NewDenominatedStableSwapPool(sdk.NewCoins(usdx(500e6), ukava(100e6)), 100)
+	require.NoError(t, err)
+
+	record := types.NewPoolRecordFromPool(pool)
+	assert.Equal(t, uint64(100), record.Amplification)
+	assert.NoError(t, record.Validate())
+
+	record.Amplification = types.MaxAmplification + 1
+	assert.EqualError(t, record.Validate(), "pool 'ukava:usdx' has invalid amplification 10001: must be at most 10000")
+}
+
 func TestState_PoolRecord_OrderedReserves(t *testing.T) {
 	invalidOrder := types.NewPoolRecord(
 		// force order to not be sorted
@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -30,8 +34,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// allowed_pools defines that pools that are allowed to be created
 	AllowedPools AllowedPools `protobuf:"bytes,1,rep,name=allowed_pools,json=allowedPools,proto3,castrepeated=AllowedPools" json:"allowed_pools"`
-	// swap_fee defines the swap fee for all pools
+	// swap_fee defines the swap fee for pools without a swap fee configured in
+	// allowed_pools
 	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee"`
+	// twap_max_window_seconds defines the longest window in seconds pool time
+	// weighted average prices can be calculated over; older price observations
+	// are pruned
+	TwapMaxWindowSeconds uint64 `protobuf:"varint,3,opt,name=twap_max_window_seconds,json=twapMaxWindowSeconds,proto3" json:"twap_max_window_seconds,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -73,12 +82,26 @@ func (m *Params) GetAllowedPools() AllowedPools {
 	return nil
 }
 
+func (m *Params) GetTwapMaxWindowSeconds() uint64 {
+	if m != nil {
+		return m.TwapMaxWindowSeconds
+	}
+	return 0
+}
+
 // AllowedPool defines a pool that is allowed to be created
 type AllowedPool struct {
 	// token_a represents the a token allowed
 	TokenA string `protobuf:"bytes,1,opt,name=token_a,json=tokenA,proto3" json:"token_a,omitempty"`
 	// token_b represents the b token allowed
 	TokenB string `protobuf:"bytes,2,opt,name=token_b,json=tokenB,proto3" json:"token_b,omitempty"`
+	// swap_fee optionally overrides the swap fee of the module params for the
+	// pool
+	SwapFee *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee,omitempty"`
+	// amplification optionally makes the pool a stable swap pool for tokens of
+	// similar value, trading with the stable swap invariant amplified by the
+	// coefficient instead of the constant product invariant
+	Amplification uint64 `protobuf:"varint,4,opt,name=amplification,proto3" json:"amplification"`
 }
 
 func (m *AllowedPool) Reset()      { *m = AllowedPool{} }
@@ -127,6 +150,13 @@ func (m *AllowedPool) GetTokenB() string {
 	return ""
 }
 
+func (m *AllowedPool) GetAmplification() uint64 {
+	if m != nil {
+		return m.Amplification
+	}
+	return 0
+}
+
 // PoolRecord represents the state of a liquidity pool
 // and is used to store the state of a denominated pool
 type PoolRecord struct {
@@ -138,6 +168,9 @@ type PoolRecord struct {
 	ReservesB types.Coin `protobuf:"bytes,3,opt,name=reserves_b,json=reservesB,proto3" json:"reserves_b"`
 	// total_shares is the total distrubuted shares of the pool
 	TotalShares github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_shares,json=totalShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_shares"`
+	// amplification is the amplification coefficient of stable swap pools, and
+	// zero for constant product pools, set when the pool is created
+	Amplification uint64 `protobuf:"varint,5,opt,name=amplification,proto3" json:"amplification"`
 }
 
 func (m *PoolRecord) Reset()         { *m = PoolRecord{} }
@@ -194,6 +227,13 @@ func (m *PoolRecord) GetReservesB() types.Coin {
 	return types.Coin{}
 }
 
+func (m *PoolRecord) GetAmplification() uint64 {
+	if m != nil {
+		return m.Amplification
+	}
+	return 0
+}
+
 // ShareRecord stores the shares owned for a depositor and pool
 type ShareRecord struct {
 	// depositor represents the owner of the shares
@@ -251,50 +291,216 @@ func (m *ShareRecord) GetPoolID() string {
 	return ""
 }
 
+// PoolPriceObservation stores the cumulative prices of a pool at a block time,
+// from which time weighted average prices between two observations are
+// calculated
+type PoolPriceObservation struct {
+	// pool_id represents the unique id of the pool
+	PoolID string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// price_cumulative_a is the sum of the price of token a in token b weighted
+	// by the seconds each price was held
+	PriceCumulativeA github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price_cumulative_a,json=priceCumulativeA,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_cumulative_a"`
+	// price_cumulative_b is the sum of the price of token b in token a weighted
+	// by the seconds each price was held
+	PriceCumulativeB github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price_cumulative_b,json=priceCumulativeB,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_cumulative_b"`
+	// time is the block time of the observation
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *PoolPriceObservation) Reset()         { *m = PoolPriceObservation{} }
+func (m *PoolPriceObservation) String() string { return proto.CompactTextString(m) }
+func (*PoolPriceObservation) ProtoMessage()    {}
+func (*PoolPriceObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df359be90eb28cb, []int{4}
+}
+func (m *PoolPriceObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolPriceObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolPriceObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolPriceObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolPriceObservation.Merge(m, src)
+}
+func (m *PoolPriceObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolPriceObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolPriceObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolPriceObservation proto.InternalMessageInfo
+
+func (m *PoolPriceObservation) GetPoolID() string {
+	if m != nil {
+		return m.PoolID
+	}
+	return ""
+}
+
+func (m *PoolPriceObservation) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// LimitOrder represents an open order to sell an exact token for at least a
+// minimum amount of the other token of its pool
+type LimitOrder struct {
+	// id represents the unique id of the order
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner represents the address that placed the order
+	Owner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	// sell represents the exact token to sell, held by the module account
+	Sell types.Coin `protobuf:"bytes,3,opt,name=sell,proto3" json:"sell"`
+	// min_buy represents the minimum token to receive for the sold token
+	MinBuy types.Coin `protobuf:"bytes,4,opt,name=min_buy,json=minBuy,proto3" json:"min_buy"`
+	// expires_at is the time after which the order is closed and its sell token
+	// is returned to the owner if it has not been filled
+	ExpiresAt time.Time `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+}
+
+func (m *LimitOrder) Reset()         { *m = LimitOrder{} }
+func (m *LimitOrder) String() string { return proto.CompactTextString(m) }
+func (*LimitOrder) ProtoMessage()    {}
+func (*LimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df359be90eb28cb, []int{5}
+}
+func (m *LimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrder.Merge(m, src)
+}
+func (m *LimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrder proto.InternalMessageInfo
+
+func (m *LimitOrder) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *LimitOrder) GetSell() types.Coin {
+	if m != nil {
+		return m.Sell
+	}
+	return types.Coin{}
+}
+
+func (m *LimitOrder) GetMinBuy() types.Coin {
+	if m != nil {
+		return m.MinBuy
+	}
+	return types.Coin{}
+}
+
+func (m *LimitOrder) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kava.swap.v1beta1.Params")
 	proto.RegisterType((*AllowedPool)(nil), "kava.swap.v1beta1.AllowedPool")
 	proto.RegisterType((*PoolRecord)(nil), "kava.swap.v1beta1.PoolRecord")
 	proto.RegisterType((*ShareRecord)(nil), "kava.swap.v1beta1.ShareRecord")
+	proto.RegisterType((*PoolPriceObservation)(nil), "kava.swap.v1beta1.PoolPriceObservation")
+	proto.RegisterType((*LimitOrder)(nil), "kava.swap.v1beta1.LimitOrder")
 }
 
 func init() { proto.RegisterFile("kava/swap/v1beta1/swap.proto", fileDescriptor_9df359be90eb28cb) }
 
 var fileDescriptor_9df359be90eb28cb = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x3f, 0x6f, 0xd3, 0x5e,
-	0x14, 0x8d, 0xd3, 0x28, 0xf9, 0xe5, 0x25, 0xbf, 0x01, 0x53, 0x89, 0xb4, 0x42, 0x76, 0x15, 0x24,
-	0xd4, 0x25, 0xb6, 0x5a, 0x36, 0x84, 0x10, 0x31, 0x01, 0x91, 0x89, 0xca, 0x0c, 0x08, 0x96, 0xa7,
-	0x67, 0xfb, 0x36, 0xb5, 0xe2, 0xf8, 0x5a, 0x7e, 0x8f, 0x84, 0x7e, 0x0b, 0xc4, 0xc4, 0xc8, 0xcc,
-	0xdc, 0x4f, 0xc0, 0xd4, 0xb1, 0xea, 0x84, 0x18, 0x02, 0x4a, 0xbe, 0x05, 0x2c, 0xe8, 0xfd, 0xa1,
-	0x35, 0x42, 0x48, 0x54, 0x4c, 0xbe, 0xf7, 0x9e, 0x77, 0xce, 0xbd, 0xf7, 0x58, 0x97, 0xdc, 0x9c,
-	0xb2, 0x39, 0xf3, 0xf9, 0x82, 0x15, 0xfe, 0x7c, 0x2f, 0x02, 0xc1, 0xf6, 0x54, 0xe2, 0x15, 0x25,
-	0x0a, 0xb4, 0xaf, 0x49, 0xd4, 0x53, 0x05, 0x83, 0x6e, 0x3b, 0x31, 0xf2, 0x19, 0x72, 0x3f, 0x62,
-	0x1c, 0x2e, 0x28, 0x31, 0xa6, 0xb9, 0xa6, 0x6c, 0x6f, 0x69, 0x9c, 0xaa, 0xcc, 0xd7, 0x89, 0x81,
-	0x36, 0x27, 0x38, 0x41, 0x5d, 0x97, 0x91, 0xae, 0xf6, 0x3f, 0x5a, 0xa4, 0x79, 0xc0, 0x4a, 0x36,
-	0xe3, 0xf6, 0x0b, 0xf2, 0x3f, 0xcb, 0x32, 0x5c, 0x40, 0x42, 0x0b, 0xc4, 0x8c, 0xf7, 0xac, 0x9d,
-	0x8d, 0xdd, 0xce, 0xbe, 0xe3, 0xfd, 0x36, 0x86, 0x37, 0xd4, 0xef, 0x0e, 0x10, 0xb3, 0x60, 0xf3,
-	0x74, 0xe9, 0xd6, 0x3e, 0x7c, 0x71, 0xbb, 0x95, 0x22, 0x0f, 0xbb, 0xac, 0x92, 0xd9, 0xcf, 0xc9,
-	0x7f, 0x92, 0x4f, 0x0f, 0x01, 0x7a, 0xf5, 0x1d, 0x6b, 0xb7, 0x1d, 0xdc, 0x93, 0xac, 0xcf, 0x4b,
-	0xf7, 0xf6, 0x24, 0x15, 0x47, 0xaf, 0x22, 0x2f, 0xc6, 0x99, 0x19, 0xd7, 0x7c, 0x06, 0x3c, 0x99,
-	0xfa, 0xe2, 0xb8, 0x00, 0xee, 0x8d, 0x20, 0x3e, 0x3f, 0x19, 0x10, 0xb3, 0xcd, 0x08, 0xe2, 0xb0,
-	0x25, 0xd5, 0x1e, 0x03, 0xdc, 0x6d, 0xbc, 0x7b, 0xef, 0xd6, 0xfa, 0x8f, 0x48, 0xa7, 0xd2, 0xdc,
-	0xbe, 0x41, 0x5a, 0x02, 0xa7, 0x90, 0x53, 0xd6, 0xb3, 0x64, 0xb3, 0xb0, 0xa9, 0xd2, 0xe1, 0x25,
-	0x10, 0xe9, 0x29, 0x0c, 0x10, 0x18, 0x99, 0xb7, 0x75, 0x42, 0xa4, 0x40, 0x08, 0x31, 0x96, 0x89,
-	0x7d, 0x8b, 0xb4, 0xa4, 0x0f, 0x34, 0x4d, 0xb4, 0x4c, 0x40, 0x56, 0x4b, 0xb7, 0x29, 0x1f, 0x8c,
-	0x47, 0x61, 0x53, 0x42, 0xe3, 0xc4, 0xbe, 0x4f, 0x48, 0x09, 0x1c, 0xca, 0x39, 0x70, 0xca, 0x94,
-	0x6a, 0x67, 0x7f, 0xcb, 0x33, 0xa3, 0xca, 0xbf, 0x74, 0xe1, 0xd9, 0x43, 0x4c, 0xf3, 0xa0, 0x21,
-	0xd7, 0x0e, 0xdb, 0x3f, 0x29, 0xc3, 0x5f, 0xf8, 0x51, 0x6f, 0xe3, 0x8a, 0xfc, 0xc0, 0xa6, 0xa4,
-	0x2b, 0x50, 0xb0, 0x8c, 0xf2, 0x23, 0x56, 0x02, 0xef, 0x35, 0xae, 0xec, 0xee, 0x38, 0x17, 0x15,
-	0x77, 0xc7, 0xb9, 0x08, 0x3b, 0x4a, 0xf1, 0x99, 0x12, 0xec, 0x7f, 0xb7, 0x48, 0x47, 0x85, 0xc6,
-	0x95, 0x43, 0xd2, 0x4e, 0xa0, 0x40, 0x9e, 0x0a, 0x2c, 0x95, 0x2f, 0xdd, 0xe0, 0xc9, 0xb7, 0xa5,
-	0x3b, 0xf8, 0x8b, 0x4e, 0xc3, 0x38, 0x1e, 0x26, 0x49, 0x09, 0x9c, 0x9f, 0x9f, 0x0c, 0xae, 0x9b,
-	0x86, 0xa6, 0x12, 0x1c, 0x0b, 0xe0, 0xe1, 0xa5, 0x74, 0xd5, 0xfd, 0xfa, 0x1f, 0xdd, 0xa7, 0xa4,
-	0xab, 0xf7, 0xa6, 0xb8, 0xc8, 0x21, 0x51, 0xfe, 0xfd, 0xf3, 0xf6, 0x5a, 0xf1, 0xa9, 0x14, 0x0c,
-	0x1e, 0x9c, 0xae, 0x1c, 0xeb, 0x6c, 0xe5, 0x58, 0x5f, 0x57, 0x8e, 0xf5, 0x66, 0xed, 0xd4, 0xce,
-	0xd6, 0x4e, 0xed, 0xd3, 0xda, 0xa9, 0xbd, 0xac, 0x8a, 0xcb, 0x03, 0x19, 0x64, 0x2c, 0xe2, 0x2a,
-	0xf2, 0x5f, 0xeb, 0x8b, 0x56, 0x0d, 0xa2, 0xa6, 0xba, 0xb3, 0x3b, 0x3f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0xee, 0x4a, 0x16, 0x69, 0xeb, 0x03, 0x00, 0x00,
+	// 800 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4d, 0x6b, 0xeb, 0x46,
+	0x14, 0xb5, 0x64, 0xc5, 0x8e, 0xc7, 0x0e, 0x34, 0xaa, 0x69, 0x94, 0x50, 0xa4, 0xe0, 0x42, 0xc9,
+	0xc6, 0x12, 0x49, 0x28, 0x0d, 0xa5, 0x94, 0x5a, 0x31, 0xa5, 0x86, 0x96, 0x04, 0xa5, 0x10, 0xda,
+	0x45, 0xc5, 0x48, 0x1a, 0x3b, 0xd3, 0x48, 0x1a, 0xa1, 0x19, 0x7f, 0xfd, 0x8b, 0x2c, 0xbb, 0xec,
+	0xba, 0xeb, 0x2c, 0xfa, 0x13, 0x02, 0xa5, 0x10, 0xb2, 0x69, 0xe9, 0xc2, 0x29, 0xce, 0xae, 0xff,
+	0xa0, 0x7d, 0x9b, 0xc7, 0x8c, 0x14, 0xc7, 0x26, 0xef, 0x81, 0x43, 0xf2, 0x56, 0xd6, 0x9d, 0xa3,
+	0x73, 0xe6, 0xde, 0x73, 0xaf, 0xaf, 0xc0, 0x87, 0xe7, 0x70, 0x00, 0x2d, 0x3a, 0x84, 0x89, 0x35,
+	0xd8, 0xf5, 0x10, 0x83, 0xbb, 0x22, 0x30, 0x93, 0x94, 0x30, 0xa2, 0xae, 0x73, 0xd4, 0x14, 0x07,
+	0x39, 0xba, 0xa5, 0xfb, 0x84, 0x46, 0x84, 0x5a, 0x1e, 0xa4, 0x68, 0x46, 0xf1, 0x09, 0x8e, 0x33,
+	0xca, 0xd6, 0x66, 0x86, 0xbb, 0x22, 0xb2, 0xb2, 0x20, 0x87, 0xea, 0x3d, 0xd2, 0x23, 0xd9, 0x39,
+	0x7f, 0xca, 0x4f, 0x8d, 0x1e, 0x21, 0xbd, 0x10, 0x59, 0x22, 0xf2, 0xfa, 0x5d, 0x8b, 0xe1, 0x08,
+	0x51, 0x06, 0xa3, 0x3c, 0x89, 0xc6, 0x7f, 0x12, 0x28, 0x1d, 0xc3, 0x14, 0x46, 0x54, 0xfd, 0x1e,
+	0xac, 0xc1, 0x30, 0x24, 0x43, 0x14, 0xb8, 0x09, 0x21, 0x21, 0xd5, 0xa4, 0xed, 0xe2, 0x4e, 0x75,
+	0x4f, 0x37, 0x1f, 0xe5, 0x69, 0xb6, 0xb2, 0xf7, 0x8e, 0x09, 0x09, 0xed, 0xfa, 0xd5, 0xc4, 0x28,
+	0xfc, 0x7a, 0x6b, 0xd4, 0xe6, 0x0e, 0xa9, 0x53, 0x83, 0x73, 0x91, 0x7a, 0x0a, 0x56, 0x39, 0xdf,
+	0xed, 0x22, 0xa4, 0xc9, 0xdb, 0xd2, 0x4e, 0xc5, 0xfe, 0x9c, 0xb3, 0xfe, 0x9e, 0x18, 0x1f, 0xf7,
+	0x30, 0x3b, 0xeb, 0x7b, 0xa6, 0x4f, 0xa2, 0xbc, 0x9e, 0xfc, 0xa7, 0x49, 0x83, 0x73, 0x8b, 0x8d,
+	0x13, 0x44, 0xcd, 0x36, 0xf2, 0x6f, 0x2e, 0x9b, 0x20, 0x2f, 0xb7, 0x8d, 0x7c, 0xa7, 0xcc, 0xd5,
+	0xbe, 0x42, 0x48, 0xfd, 0x04, 0x6c, 0x30, 0x2e, 0x1c, 0xc1, 0x91, 0x3b, 0xc4, 0x71, 0x40, 0x86,
+	0x2e, 0x45, 0x3e, 0x89, 0x03, 0xaa, 0x15, 0xb7, 0xa5, 0x1d, 0xc5, 0xa9, 0x73, 0xf8, 0x5b, 0x38,
+	0x3a, 0x15, 0xe0, 0x49, 0x86, 0x7d, 0xa6, 0xfc, 0xfc, 0x8b, 0x51, 0x68, 0xfc, 0x29, 0x81, 0xea,
+	0x5c, 0xd2, 0xea, 0x06, 0x28, 0x33, 0x72, 0x8e, 0x62, 0x17, 0x6a, 0x12, 0x4f, 0xd2, 0x29, 0x89,
+	0xb0, 0xf5, 0x00, 0x78, 0x9a, 0x3c, 0x07, 0xd8, 0x0b, 0x75, 0x15, 0x67, 0x75, 0x49, 0xcf, 0xaf,
+	0xeb, 0x53, 0xb0, 0x06, 0xa3, 0x24, 0xc4, 0x5d, 0xec, 0x43, 0x86, 0x49, 0xac, 0x29, 0xbc, 0x1a,
+	0x7b, 0xfd, 0xdf, 0x89, 0xb1, 0x08, 0x38, 0x8b, 0x61, 0x5e, 0xd9, 0xef, 0x32, 0x00, 0xbc, 0x24,
+	0x07, 0xf9, 0x24, 0x0d, 0xd4, 0x8f, 0x40, 0x99, 0x77, 0xd4, 0xc5, 0x41, 0x56, 0x98, 0x0d, 0xa6,
+	0x13, 0xa3, 0xc4, 0x5f, 0xe8, 0xb4, 0x9d, 0x12, 0x87, 0x3a, 0x81, 0xfa, 0x05, 0x00, 0x29, 0xa2,
+	0x28, 0x1d, 0x20, 0xea, 0x42, 0x51, 0x67, 0x75, 0x6f, 0xd3, 0xcc, 0x93, 0xe3, 0x03, 0x39, 0xeb,
+	0xfe, 0x21, 0xc1, 0xb1, 0xad, 0xf0, 0x06, 0x3a, 0x95, 0x7b, 0x4a, 0x6b, 0x81, 0xef, 0x69, 0xc5,
+	0x27, 0xf2, 0x6d, 0xd5, 0x05, 0x35, 0x46, 0x18, 0x0c, 0x5d, 0x7a, 0x06, 0x53, 0x44, 0x35, 0x65,
+	0xe6, 0xe7, 0xb2, 0x73, 0xd2, 0x89, 0xd9, 0x9c, 0x9f, 0x9d, 0x98, 0x39, 0x55, 0xa1, 0x78, 0x22,
+	0x04, 0x1f, 0x7b, 0xba, 0xb2, 0x9c, 0xa7, 0x8d, 0x57, 0x12, 0xa8, 0x0a, 0x8d, 0xdc, 0xce, 0x2e,
+	0xa8, 0x04, 0x28, 0x21, 0x14, 0x33, 0x92, 0x0a, 0x43, 0x6b, 0xf6, 0xd7, 0xff, 0x4f, 0x8c, 0xe6,
+	0x12, 0x29, 0xb6, 0x7c, 0xbf, 0x15, 0x04, 0x29, 0xa2, 0xf4, 0xe6, 0xb2, 0xf9, 0x7e, 0x9e, 0x69,
+	0x7e, 0x62, 0x8f, 0x19, 0xa2, 0xce, 0x83, 0xf4, 0x7c, 0xdb, 0xe4, 0xb7, 0xb6, 0xcd, 0x05, 0xb5,
+	0xcc, 0x30, 0x97, 0x0c, 0x63, 0x14, 0x68, 0xc5, 0x97, 0xb0, 0x2d, 0x53, 0x3c, 0xe2, 0x82, 0x8d,
+	0x3f, 0x64, 0x50, 0xe7, 0x77, 0x1e, 0xa7, 0xd8, 0x47, 0x47, 0x1e, 0x6f, 0x97, 0xb0, 0x65, 0xb9,
+	0xa9, 0xfa, 0x09, 0xa8, 0x09, 0x27, 0xba, 0x7e, 0x3f, 0xea, 0x87, 0x90, 0xe1, 0x01, 0xca, 0xa7,
+	0xeb, 0xb9, 0x3b, 0xe0, 0x3d, 0xa1, 0x7b, 0x38, 0x93, 0x6d, 0xbd, 0xf1, 0x2e, 0x4f, 0x2b, 0xbe,
+	0x83, 0xbb, 0x6c, 0xf5, 0x00, 0x28, 0x7c, 0x95, 0x8a, 0x29, 0xad, 0xee, 0x6d, 0x99, 0xd9, 0x9e,
+	0x35, 0xef, 0xf7, 0xac, 0xf9, 0xdd, 0xfd, 0x9e, 0xb5, 0x57, 0xf9, 0xcd, 0x17, 0xb7, 0x86, 0xe4,
+	0x08, 0x46, 0xe3, 0x37, 0x19, 0x80, 0x6f, 0x70, 0x84, 0xd9, 0x51, 0x1a, 0xa0, 0x54, 0xfd, 0x00,
+	0xc8, 0xb9, 0x81, 0x8a, 0x5d, 0x9a, 0x4e, 0x0c, 0xb9, 0xd3, 0x76, 0x64, 0x1c, 0xa8, 0x3f, 0x82,
+	0x15, 0xde, 0xd0, 0x54, 0x93, 0x5f, 0x78, 0xc0, 0x32, 0x59, 0x75, 0x1f, 0x28, 0x14, 0x85, 0xe1,
+	0xb2, 0x7f, 0x54, 0xf1, 0xb2, 0x7a, 0x00, 0xca, 0x11, 0x8e, 0x5d, 0xaf, 0x3f, 0xd6, 0x94, 0xe5,
+	0x78, 0xa5, 0x08, 0xc7, 0x76, 0x7f, 0xac, 0x1e, 0x02, 0x80, 0x46, 0x09, 0xe6, 0x73, 0x0a, 0x99,
+	0xb6, 0xf2, 0x04, 0xd7, 0x2a, 0x39, 0xaf, 0xc5, 0xec, 0x2f, 0xaf, 0xa6, 0xba, 0x74, 0x3d, 0xd5,
+	0xa5, 0x7f, 0xa6, 0xba, 0x74, 0x71, 0xa7, 0x17, 0xae, 0xef, 0xf4, 0xc2, 0x5f, 0x77, 0x7a, 0xe1,
+	0x87, 0xf9, 0xb6, 0xf2, 0xcf, 0x55, 0x33, 0x84, 0x1e, 0x15, 0x4f, 0xd6, 0x28, 0xfb, 0x00, 0x0b,
+	0x7b, 0xbc, 0x92, 0xb8, 0x6a, 0xff, 0xf5, 0x00, 0x8c, 0x07, 0x2c, 0xa8, 0x9a, 0x07, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -317,6 +523,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TwapMaxWindowSeconds != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.TwapMaxWindowSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.SwapFee.Size()
 		i -= size
@@ -364,6 +575,23 @@ func (m *AllowedPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Amplification != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SwapFee != nil {
+		{
+			size := m.SwapFee.Size()
+			i -= size
+			if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintSwap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.TokenB) > 0 {
 		i -= len(m.TokenB)
 		copy(dAtA[i:], m.TokenB)
@@ -401,6 +629,11 @@ func (m *PoolRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Amplification != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.TotalShares.Size()
 		i -= size
@@ -488,6 +721,127 @@ func (m *ShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolPriceObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolPriceObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolPriceObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSwap(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PriceCumulativeB.Size()
+		i -= size
+		if _, err := m.PriceCumulativeB.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PriceCumulativeA.Size()
+		i -= size
+		if _, err := m.PriceCumulativeA.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PoolID) > 0 {
+		i -= len(m.PoolID)
+		copy(dAtA[i:], m.PoolID)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.PoolID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSwap(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.MinBuy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Sell.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSwap(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSwap(v)
 	base := offset
@@ -513,6 +867,9 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.SwapFee.Size()
 	n += 1 + l + sovSwap(uint64(l))
+	if m.TwapMaxWindowSeconds != 0 {
+		n += 1 + sovSwap(uint64(m.TwapMaxWindowSeconds))
+	}
 	return n
 }
 
@@ -530,6 +887,13 @@ func (m *AllowedPool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSwap(uint64(l))
 	}
+	if m.SwapFee != nil {
+		l = m.SwapFee.Size()
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if m.Amplification != 0 {
+		n += 1 + sovSwap(uint64(m.Amplification))
+	}
 	return n
 }
 
@@ -549,6 +913,9 @@ func (m *PoolRecord) Size() (n int) {
 	n += 1 + l + sovSwap(uint64(l))
 	l = m.TotalShares.Size()
 	n += 1 + l + sovSwap(uint64(l))
+	if m.Amplification != 0 {
+		n += 1 + sovSwap(uint64(m.Amplification))
+	}
 	return n
 }
 
@@ -571,6 +938,47 @@ func (m *ShareRecord) Size() (n int) {
 	return n
 }
 
+func (m *PoolPriceObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolID)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = m.PriceCumulativeA.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = m.PriceCumulativeB.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSwap(uint64(l))
+	return n
+}
+
+func (m *LimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovSwap(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = m.Sell.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = m.MinBuy.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovSwap(uint64(l))
+	return n
+}
+
 func sovSwap(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -674,6 +1082,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapMaxWindowSeconds", wireType)
+			}
+			m.TwapMaxWindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwapMaxWindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwap(dAtA[iNdEx:])
@@ -788,21 +1215,76 @@ func (m *AllowedPool) Unmarshal(dAtA []byte) error {
 			}
 			m.TokenB = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSwap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSwap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.SwapFee = &v
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -970,6 +1452,25 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwap(dAtA[iNdEx:])
@@ -1141,6 +1642,391 @@ func (m *ShareRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PoolPriceObservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolPriceObservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolPriceObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceCumulativeA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceCumulativeA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceCumulativeB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceCumulativeB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sell", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sell.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBuy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBuy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSwap(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
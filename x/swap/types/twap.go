@@ -0,0 +1,39 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPoolPriceObservation returns a new PoolPriceObservation
+func NewPoolPriceObservation(poolID string, priceCumulativeA, priceCumulativeB sdk.Dec, observedAt time.Time) PoolPriceObservation {
+	return PoolPriceObservation{
+		PoolID:           poolID,
+		PriceCumulativeA: priceCumulativeA,
+		PriceCumulativeB: priceCumulativeB,
+		Time:             observedAt,
+	}
+}
+
+// Accumulate returns the observation of a pool at a later time, adding the pool prices of token a and token b
+// weighted by the seconds since this observation to the cumulative prices
+func (o PoolPriceObservation) Accumulate(priceA, priceB sdk.Dec, observedAt time.Time) PoolPriceObservation {
+	elapsedSeconds := sdk.NewDec(observedAt.Sub(o.Time).Nanoseconds()).QuoInt64(int64(time.Second))
+
+	return NewPoolPriceObservation(
+		o.PoolID,
+		o.PriceCumulativeA.Add(priceA.Mul(elapsedSeconds)),
+		o.PriceCumulativeB.Add(priceB.Mul(elapsedSeconds)),
+		observedAt,
+	)
+}
+
+// TimeWeightedAveragePrices returns the average prices of token a and token b between an earlier observation and
+// this observation
+func (o PoolPriceObservation) TimeWeightedAveragePrices(start PoolPriceObservation) (sdk.Dec, sdk.Dec) {
+	elapsedSeconds := sdk.NewDec(o.Time.Sub(start.Time).Nanoseconds()).QuoInt64(int64(time.Second))
+	twapA := o.PriceCumulativeA.Sub(start.PriceCumulativeA).Quo(elapsedSeconds)
+	twapB := o.PriceCumulativeB.Sub(start.PriceCumulativeB).Quo(elapsedSeconds)
+	return twapA, twapB
+}